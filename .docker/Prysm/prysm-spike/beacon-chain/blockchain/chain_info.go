@@ -49,6 +49,20 @@ type HeadFetcher interface {
 	ProtoArrayStore() *protoarray.Store
 }
 
+// TargetStateFetcher retrieves the states advanced to the start of an epoch, the target states of
+// attestations, which are cached and shared across the validation of attestations and blocks and
+// the computation of duties.
+type TargetStateFetcher interface {
+	TargetState(ctx context.Context, c *ethpb.Checkpoint) (*state.BeaconState, error)
+}
+
+// HeadRecomputer defines a common interface for methods in blockchain service which flush
+// cached chain data and force a re-evaluation of the head, for recovering at runtime.
+type HeadRecomputer interface {
+	ClearCheckpointStateCache()
+	RecomputeHead(ctx context.Context) ([32]byte, error)
+}
+
 // ForkFetcher retrieves the current fork information of the Ethereum beacon chain.
 type ForkFetcher interface {
 	CurrentFork() *pb.Fork
@@ -76,6 +76,42 @@ func (s *Service) updateHead(ctx context.Context, balances []uint64) error {
 	return s.saveHead(ctx, headRoot)
 }
 
+// RecomputeHead recomputes the balances of the justified state, replacing the cached ones, and runs
+// fork choice with them. This recovers from stale justified balances at runtime, it returns the
+// resulting head root.
+func (s *Service) RecomputeHead(ctx context.Context) ([32]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "blockChain.RecomputeHead")
+	defer span.End()
+
+	// The checkpoints are updated and the head computed from them while processing blocks and
+	// attestations, which must not interleave with the recomputation.
+	s.forkChoiceLock.Lock()
+	defer s.forkChoiceLock.Unlock()
+	if s.justifiedCheckpt == nil {
+		return [32]byte{}, errors.New("no justified checkpoint to compute the head from")
+	}
+	justifiedRoot := bytesutil.ToBytes32(s.justifiedCheckpt.Root)
+	if justifiedRoot == params.BeaconConfig().ZeroHash {
+		justifiedRoot = s.genesisRoot
+	}
+	if err := s.cacheJustifiedStateBalances(ctx, justifiedRoot); err != nil {
+		return [32]byte{}, errors.Wrap(err, "could not recompute justified balances")
+	}
+	if err := s.updateHead(ctx, s.getJustifiedBalances()); err != nil {
+		return [32]byte{}, errors.Wrap(err, "could not update head")
+	}
+
+	s.headLock.RLock()
+	defer s.headLock.RUnlock()
+	return s.headRoot(), nil
+}
+
+// ClearCheckpointStateCache removes all states from the checkpoint state cache, they are
+// regenerated from the state gen service when needed again.
+func (s *Service) ClearCheckpointStateCache() {
+	s.checkpointStateCache.Clear()
+}
+
 // This saves head info to the local service cache, it also saves the
 // new head root to the DB.
 func (s *Service) saveHead(ctx context.Context, headRoot [32]byte) error {
@@ -122,11 +158,25 @@ func (s *Service) saveHead(ctx context.Context, headRoot [32]byte) error {
 			"newSlot": fmt.Sprintf("%d", newHeadBlock.Block.Slot),
 			"oldSlot": fmt.Sprintf("%d", headSlot),
 		}).Debug("Chain reorg occurred")
+		oldHeadRoot := bytesutil.ToBytes32(r)
+		depth, err := s.reorgDepth(ctx, oldHeadRoot, headSlot, headRoot, newHeadBlock.Block.Slot)
+		if err != nil {
+			log.WithError(err).Debug("Could not determine depth of reorg")
+		}
+		var oldHeadState [32]byte
+		if oldHeadBlock, err := s.HeadBlock(ctx); err == nil && oldHeadBlock != nil && oldHeadBlock.Block != nil {
+			oldHeadState = bytesutil.ToBytes32(oldHeadBlock.Block.StateRoot)
+		}
 		s.stateNotifier.StateFeed().Send(&feed.Event{
 			Type: statefeed.Reorg,
 			Data: &statefeed.ReorgData{
-				NewSlot: newHeadBlock.Block.Slot,
-				OldSlot: headSlot,
+				NewSlot:      newHeadBlock.Block.Slot,
+				OldSlot:      headSlot,
+				Depth:        depth,
+				OldHeadBlock: oldHeadRoot,
+				NewHeadBlock: headRoot,
+				OldHeadState: oldHeadState,
+				NewHeadState: bytesutil.ToBytes32(newHeadBlock.Block.StateRoot),
 			},
 		})
 
@@ -141,9 +191,69 @@ func (s *Service) saveHead(ctx context.Context, headRoot [32]byte) error {
 		return errors.Wrap(err, "could not save head root in DB")
 	}
 
+	previousDependentRoot, currentDependentRoot, err := dutyDependentRoots(newHeadState, headRoot)
+	if err != nil {
+		log.WithError(err).Debug("Could not determine duty dependent roots of head")
+	}
+	s.stateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.NewHead,
+		Data: &statefeed.NewHeadData{
+			Slot:                      newHeadBlock.Block.Slot,
+			BlockRoot:                 headRoot,
+			StateRoot:                 bytesutil.ToBytes32(newHeadBlock.Block.StateRoot),
+			PreviousDutyDependentRoot: previousDependentRoot,
+			CurrentDutyDependentRoot:  currentDependentRoot,
+		},
+	})
+
 	return nil
 }
 
+// reorgDepth returns the number of slots between the old head and the latest slot at which the old and the new
+// head have the same ancestor. The search stops at the finalized checkpoint, which both heads descend from.
+func (s *Service) reorgDepth(ctx context.Context, oldRoot [32]byte, oldSlot uint64, newRoot [32]byte, newSlot uint64) (uint64, error) {
+	finalizedSlot, err := helpers.StartSlot(s.FinalizedCheckpt().Epoch)
+	if err != nil {
+		return 0, err
+	}
+	slot := oldSlot
+	if newSlot < slot {
+		slot = newSlot
+	}
+	for ; slot > finalizedSlot; slot-- {
+		oldAncestor, err := s.ancestor(ctx, oldRoot[:], slot)
+		if err != nil {
+			return 0, err
+		}
+		newAncestor, err := s.ancestor(ctx, newRoot[:], slot)
+		if err != nil {
+			return 0, err
+		}
+		if bytes.Equal(oldAncestor, newAncestor) {
+			break
+		}
+	}
+	return oldSlot - slot, nil
+}
+
+// dutyDependentRoots returns the roots of the blocks the duties of the previous and the current epoch of the head
+// state depend on.
+func dutyDependentRoots(headState *stateTrie.BeaconState, headRoot [32]byte) ([32]byte, [32]byte, error) {
+	epoch := helpers.SlotToEpoch(headState.Slot())
+	current, err := helpers.DutyDependentRoot(headState, headRoot[:], epoch)
+	if err != nil {
+		return [32]byte{}, [32]byte{}, err
+	}
+	previous := current
+	if epoch > 0 {
+		previous, err = helpers.DutyDependentRoot(headState, headRoot[:], epoch-1)
+		if err != nil {
+			return [32]byte{}, [32]byte{}, err
+		}
+	}
+	return bytesutil.ToBytes32(previous), bytesutil.ToBytes32(current), nil
+}
+
 // This gets called to update canonical root mapping. It does not save head block
 // root in DB. With the inception of initial-sync-cache-state flag, it uses finalized
 // check point as anchors to resume sync therefore head is no longer needed to be saved on per slot basis.
@@ -121,3 +121,35 @@ func TestUpdateHead_MissingJustifiedRoot(t *testing.T) {
 
 	require.NoError(t, service.updateHead(context.Background(), []uint64{}))
 }
+
+func TestRecomputeHead_RefreshesJustifiedBalances(t *testing.T) {
+	ctx := context.Background()
+	db, sc := testDB.SetupDB(t)
+	service := setupBeaconChain(t, db, sc)
+
+	state, _ := testutil.DeterministicGenesisState(t, 16)
+	b := testutil.NewBeaconBlock()
+	require.NoError(t, service.beaconDB.SaveBlock(ctx, b))
+	r, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, service.beaconDB.SaveStateSummary(ctx, &pb.StateSummary{Root: r[:]}))
+	require.NoError(t, service.beaconDB.SaveState(ctx, state, r))
+	service.justifiedCheckpt = &ethpb.Checkpoint{Root: r[:]}
+	service.finalizedCheckpt = &ethpb.Checkpoint{}
+	service.bestJustifiedCheckpt = &ethpb.Checkpoint{}
+	service.justifiedBalances = []uint64{1}
+
+	headRoot, err := service.RecomputeHead(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, r, headRoot)
+	require.DeepEqual(t, state.Balances(), service.getJustifiedBalances())
+}
+
+func TestRecomputeHead_NoJustifiedCheckpoint(t *testing.T) {
+	db, sc := testDB.SetupDB(t)
+	service := setupBeaconChain(t, db, sc)
+	service.justifiedCheckpt = nil
+
+	_, err := service.RecomputeHead(context.Background())
+	assert.ErrorContains(t, "no justified checkpoint", err)
+}
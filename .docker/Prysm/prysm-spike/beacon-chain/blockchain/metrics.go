@@ -7,6 +7,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -94,6 +95,26 @@ var (
 		Name: "beacon_prev_epoch_head_gwei",
 		Help: "The total amount of ether, in gwei, that has been used in voting attestation head of previous epoch",
 	})
+	prevEpochParticipationRate = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "beacon_prev_epoch_participation_rate",
+		Help: "The ratio of the active balance voting the attestation target of previous epoch, justifying it at 2/3",
+	})
+	prevEpochTargetMissedValidators = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "beacon_prev_epoch_target_missed_validators",
+		Help: "The number of active, unslashed validators which did not vote the attestation target of previous epoch",
+	})
+	justificationBits = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "beacon_justification_bits",
+		Help: "The justification bits of the head state, bit 0 being the current epoch",
+	})
+	finalityDelay = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "beacon_finality_delay_epochs",
+		Help: "The number of epochs between previous epoch and the finalized epoch of the head state",
+	})
+	inactivityLeak = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "beacon_inactivity_leak",
+		Help: "Whether the chain is in an inactivity leak, 1 when the finality delay exceeds the inactivity penalty threshold",
+	})
 	reorgCount = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "beacon_reorg_total",
 		Help: "Count the number of times beacon chain has a reorg",
@@ -209,7 +230,7 @@ func reportEpochMetrics(ctx context.Context, postState, headState *stateTrie.Bea
 	if err != nil {
 		return err
 	}
-	_, b, err = precompute.ProcessAttestations(ctx, headState, v, b)
+	v, b, err = precompute.ProcessAttestations(ctx, headState, v, b)
 	if err != nil {
 		return err
 	}
@@ -217,6 +238,29 @@ func reportEpochMetrics(ctx context.Context, postState, headState *stateTrie.Bea
 	prevEpochSourceBalances.Set(float64(b.PrevEpochAttested))
 	prevEpochTargetBalances.Set(float64(b.PrevEpochTargetAttested))
 	prevEpochHeadBalances.Set(float64(b.PrevEpochHeadAttested))
+	if b.ActivePrevEpoch > 0 {
+		prevEpochParticipationRate.Set(float64(b.PrevEpochTargetAttested) / float64(b.ActivePrevEpoch))
+	}
+	targetMissed := 0
+	for _, val := range v {
+		if val.IsActivePrevEpoch && !val.IsSlashed && !val.IsPrevEpochTargetAttester {
+			targetMissed++
+		}
+	}
+	prevEpochTargetMissedValidators.Set(float64(targetMissed))
+
+	// Finality stalls, penalizing the validators missing the target once the delay exceeds the threshold.
+	if bits := headState.JustificationBits(); len(bits) > 0 {
+		justificationBits.Set(float64(bits[0]))
+	}
+	prevEpoch := helpers.PrevEpoch(headState)
+	finalizedEpoch := headState.FinalizedCheckpointEpoch()
+	finalityDelay.Set(float64(precompute.FinalityDelay(prevEpoch, finalizedEpoch)))
+	if precompute.IsInInactivityLeak(prevEpoch, finalizedEpoch) {
+		inactivityLeak.Set(1)
+	} else {
+		inactivityLeak.Set(0)
+	}
 
 	refMap := postState.FieldReferencesCount()
 	for name, val := range refMap {
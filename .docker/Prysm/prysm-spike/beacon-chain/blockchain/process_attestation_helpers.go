@@ -4,35 +4,51 @@ import (
 	"bytes"
 	"context"
 	"fmt"
-	"strconv"
 
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/shared/attestationutil"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
-	"github.com/prysmaticlabs/prysm/shared/mputil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 )
 
+// TargetState returns the state of the checkpoint root advanced to the start of the checkpoint
+// epoch. Target states are cached and shared by the validation of attestations and blocks and the
+// computation of duties, they must be copied before being mutated.
+func (s *Service) TargetState(ctx context.Context, c *ethpb.Checkpoint) (*stateTrie.BeaconState, error) {
+	return s.getAttPreState(ctx, c)
+}
+
 // getAttPreState retrieves the att pre state by either from the cache or the DB.
 func (s *Service) getAttPreState(ctx context.Context, c *ethpb.Checkpoint) (*stateTrie.BeaconState, error) {
-	// Use a multilock to allow scoped holding of a mutex by a checkpoint root + epoch
-	// allowing us to behave smarter in terms of how this function is used concurrently.
-	epochKey := strconv.FormatUint(c.Epoch, 10 /* base 10 */)
-	lock := mputil.NewMultilock(string(c.Root) + epochKey)
-	lock.Lock()
-	defer lock.Unlock()
-	cachedState, err := s.checkpointStateCache.StateByCheckpoint(c)
-	if err != nil {
-		return nil, errors.Wrap(err, "could not get cached checkpoint state")
-	}
-	if cachedState != nil {
-		return cachedState, nil
+	// Concurrent requests of the same checkpoint wait for a single computation of its state, rather
+	// than all processing the slots of the epoch transition.
+	for {
+		cachedState, err := s.checkpointStateCache.WaitForCheckpointState(ctx, c)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get cached checkpoint state")
+		}
+		if cachedState != nil {
+			return cachedState, nil
+		}
+		if err := s.checkpointStateCache.MarkInProgress(c); err != nil {
+			if errors.Is(err, cache.ErrAlreadyInProgress) {
+				continue
+			}
+			return nil, err
+		}
+		break
 	}
+	defer func() {
+		if err := s.checkpointStateCache.MarkNotInProgress(c); err != nil {
+			log.WithError(err).Error("Could not mark checkpoint state not in progress")
+		}
+	}()
 
 	baseState, err := s.stateGen.StateByRoot(ctx, bytesutil.ToBytes32(c.Root))
 	if err != nil {
@@ -20,6 +20,9 @@ import (
 // This defines size of the upper bound for initial sync block cache.
 var initialSyncBlockCacheSize = 2 * params.BeaconConfig().SlotsPerEpoch
 
+// errBatchSignatureVerification is returned when a batch of blocks holds an invalid signature.
+var errBatchSignatureVerification = errors.New("batch block signature verification failed")
+
 // onBlock is called when a gossip block is received. It runs regular state transition on the block.
 // The block's signing root should be computed before calling this method to avoid redundant
 // computation in this method and methods it calls into.
@@ -246,15 +249,10 @@ func (s *Service) onBlockBatch(ctx context.Context, blks []*ethpb.SignedBeaconBl
 
 	jCheckpoints := make([]*ethpb.Checkpoint, len(blks))
 	fCheckpoints := make([]*ethpb.Checkpoint, len(blks))
-	sigSet := &bls.SignatureSet{
-		Signatures: [][]byte{},
-		PublicKeys: []bls.PublicKey{},
-		Messages:   [][32]byte{},
-	}
-	var set *bls.SignatureSet
+	sigSets := make([]*bls.SignatureSet, len(blks))
 	boundaries := make(map[[32]byte]*stateTrie.BeaconState)
 	for i, b := range blks {
-		set, preState, err = state.ExecuteStateTransitionNoVerifyAnySig(ctx, preState, b)
+		sigSets[i], preState, err = state.ExecuteStateTransitionNoVerifyAnySig(ctx, preState, b)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -267,15 +265,10 @@ func (s *Service) onBlockBatch(ctx context.Context, blks []*ethpb.SignedBeaconBl
 		}
 		jCheckpoints[i] = preState.CurrentJustifiedCheckpoint()
 		fCheckpoints[i] = preState.FinalizedCheckpoint()
-		sigSet.Join(set)
 	}
-	verify, err := sigSet.Verify()
-	if err != nil {
+	if err := verifyBatchSignatureSets(ctx, blks, blockRoots, sigSets); err != nil {
 		return nil, nil, err
 	}
-	if !verify {
-		return nil, nil, errors.New("batch block signature verification failed")
-	}
 	for r, st := range boundaries {
 		if err := s.stateGen.SaveState(ctx, r, st); err != nil {
 			return nil, nil, err
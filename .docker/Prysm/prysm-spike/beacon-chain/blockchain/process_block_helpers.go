@@ -4,12 +4,17 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"runtime"
+	"sync"
 
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/shared/attestationutil"
+	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
@@ -239,6 +244,18 @@ func (s *Service) updateFinalized(ctx context.Context, cp *ethpb.Checkpoint) err
 		return errors.Wrap(err, "could not migrate to cold")
 	}
 
+	var fState [32]byte
+	if fBlock, err := s.beaconDB.Block(ctx, fRoot); err == nil && fBlock != nil && fBlock.Block != nil {
+		fState = bytesutil.ToBytes32(fBlock.Block.StateRoot)
+	}
+	s.stateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.FinalizedCheckpoint,
+		Data: &statefeed.FinalizedCheckpointData{
+			Epoch: cp.Epoch,
+			Block: fRoot,
+			State: fState,
+		},
+	})
 	return nil
 }
 
@@ -429,3 +446,73 @@ func (s *Service) ensureRootNotZeros(root [32]byte) [32]byte {
 	}
 	return root
 }
+
+// verifyBatchSignatureSets verifies the signature sets of a batch of blocks in parallel. The sets of consecutive
+// blocks are joined into one set per worker, so each worker verifies its share of the batch at once. When a worker
+// fails, the sets of its blocks are verified one by one to find the block with the invalid signature.
+func verifyBatchSignatureSets(ctx context.Context, blks []*ethpb.SignedBeaconBlock, blockRoots [][32]byte, sets []*bls.SignatureSet) error {
+	ctx, span := trace.StartSpan(ctx, "blockChain.verifyBatchSignatureSets")
+	defer span.End()
+
+	workers := runtime.GOMAXPROCS(0)
+	if workers > len(sets) {
+		workers = len(sets)
+	}
+	if workers == 0 {
+		return nil
+	}
+	perWorker := (len(sets) + workers - 1) / workers
+	// Rounding the share of each worker up can leave the last workers without sets.
+	workers = (len(sets) + perWorker - 1) / perWorker
+	valid := make([]bool, workers)
+	errs := make([]error, workers)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		start, end := w*perWorker, (w+1)*perWorker
+		if end > len(sets) {
+			end = len(sets)
+		}
+		wg.Add(1)
+		go func(w int, sets []*bls.SignatureSet) {
+			defer wg.Done()
+			joined := &bls.SignatureSet{
+				Signatures: [][]byte{},
+				PublicKeys: []bls.PublicKey{},
+				Messages:   [][32]byte{},
+			}
+			for _, set := range sets {
+				joined.Join(set)
+			}
+			valid[w], errs[w] = joined.Verify()
+		}(w, sets[start:end])
+	}
+	wg.Wait()
+
+	for w := 0; w < workers; w++ {
+		if errs[w] != nil {
+			return errs[w]
+		}
+		if valid[w] {
+			continue
+		}
+		start, end := w*perWorker, (w+1)*perWorker
+		if end > len(sets) {
+			end = len(sets)
+		}
+		for i := start; i < end; i++ {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			ok, err := sets[i].Verify()
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return fmt.Errorf("%w: invalid signature in block at slot %d with root %#x",
+					errBatchSignatureVerification, blks[i].Block.Slot, blockRoots[i])
+			}
+		}
+		return errBatchSignatureVerification
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package blockchain
 import (
 	"context"
 	"fmt"
+	"runtime"
 	"testing"
 	"time"
 
@@ -18,6 +19,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/attestationutil"
+	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
@@ -889,3 +891,51 @@ func TestHandleEpochBoundary_BadMetrics(t *testing.T) {
 	service.head = &head{}
 	require.ErrorContains(t, "failed to initialize precompute: nil inner state", service.handleEpochBoundary(ctx, s))
 }
+
+func signedBatch(t *testing.T, n int) ([]*ethpb.SignedBeaconBlock, [][32]byte, []*bls.SignatureSet) {
+	blks := make([]*ethpb.SignedBeaconBlock, n)
+	blockRoots := make([][32]byte, len(blks))
+	sets := make([]*bls.SignatureSet, len(blks))
+	for i := range blks {
+		blks[i] = testutil.NewBeaconBlock()
+		blks[i].Block.Slot = uint64(i)
+		blockRoots[i] = [32]byte{byte(i)}
+		priv, err := bls.RandKey()
+		require.NoError(t, err)
+		msg := [32]byte{'m', byte(i)}
+		sets[i] = &bls.SignatureSet{
+			Signatures: [][]byte{priv.Sign(msg[:]).Marshal()},
+			PublicKeys: []bls.PublicKey{priv.PublicKey()},
+			Messages:   [][32]byte{msg},
+		}
+	}
+	return blks, blockRoots, sets
+}
+
+func TestVerifyBatchSignatureSets(t *testing.T) {
+	ctx := context.Background()
+	blks, blockRoots, sets := signedBatch(t, 10)
+	require.NoError(t, verifyBatchSignatureSets(ctx, blks, blockRoots, sets))
+
+	// Sign the message of the block at slot 7 with another key.
+	priv, err := bls.RandKey()
+	require.NoError(t, err)
+	sets[7].Signatures[0] = priv.Sign(sets[7].Messages[0][:]).Marshal()
+	err = verifyBatchSignatureSets(ctx, blks, blockRoots, sets)
+	assert.ErrorContains(t, errBatchSignatureVerification.Error(), err)
+	assert.ErrorContains(t, "block at slot 7", err)
+}
+
+func TestVerifyBatchSignatureSets_UnevenWorkers(t *testing.T) {
+	// 5 sets on 4 workers are split in shares of 2, leaving the last worker without sets.
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(4))
+	ctx := context.Background()
+	blks, blockRoots, sets := signedBatch(t, 5)
+	require.NoError(t, verifyBatchSignatureSets(ctx, blks, blockRoots, sets))
+
+	priv, err := bls.RandKey()
+	require.NoError(t, err)
+	sets[4].Signatures[0] = priv.Sign(sets[4].Messages[0][:]).Marshal()
+	err = verifyBatchSignatureSets(ctx, blks, blockRoots, sets)
+	assert.ErrorContains(t, "block at slot 4", err)
+}
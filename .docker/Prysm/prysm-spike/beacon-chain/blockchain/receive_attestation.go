@@ -40,6 +40,8 @@ func (s *Service) ReceiveAttestationNoPubsub(ctx context.Context, att *ethpb.Att
 		return errors.Wrap(err, "could not process attestation")
 	}
 
+	s.forkChoiceLock.Lock()
+	defer s.forkChoiceLock.Unlock()
 	if err := s.updateHead(ctx, s.getJustifiedBalances()); err != nil {
 		log.Warnf("Resolving fork due to new attestation: %v", err)
 		return nil
@@ -36,7 +36,9 @@ func (s *Service) ReceiveBlock(ctx context.Context, block *ethpb.SignedBeaconBlo
 	blockCopy := stateTrie.CopySignedBeaconBlock(block)
 
 	// Apply state transition on the new block.
+	s.forkChoiceLock.Lock()
 	if err := s.onBlock(ctx, blockCopy, blockRoot); err != nil {
+		s.forkChoiceLock.Unlock()
 		err := errors.Wrap(err, "could not process block")
 		traceutil.AnnotateError(span, err)
 		return err
@@ -46,6 +48,7 @@ func (s *Service) ReceiveBlock(ctx context.Context, block *ethpb.SignedBeaconBlo
 	if err := s.updateHead(ctx, s.getJustifiedBalances()); err != nil {
 		log.WithError(err).Warn("Could not update head")
 	}
+	s.forkChoiceLock.Unlock()
 
 	// Send notification of the processed block to the state feed.
 	s.stateNotifier.StateFeed().Send(&feed.Event{
@@ -87,7 +90,10 @@ func (s *Service) ReceiveBlockInitialSync(ctx context.Context, block *ethpb.Sign
 	blockCopy := stateTrie.CopySignedBeaconBlock(block)
 
 	// Apply state transition on the new block.
-	if err := s.onBlockInitialSyncStateTransition(ctx, blockCopy, blockRoot); err != nil {
+	s.forkChoiceLock.Lock()
+	err := s.onBlockInitialSyncStateTransition(ctx, blockCopy, blockRoot)
+	s.forkChoiceLock.Unlock()
+	if err != nil {
 		err := errors.Wrap(err, "could not process block")
 		traceutil.AnnotateError(span, err)
 		return err
@@ -124,7 +130,9 @@ func (s *Service) ReceiveBlockBatch(ctx context.Context, blocks []*ethpb.SignedB
 	defer span.End()
 
 	// Apply state transition on the incoming newly received blockCopy without verifying its BLS contents.
+	s.forkChoiceLock.Lock()
 	fCheckpoints, jCheckpoints, err := s.onBlockBatch(ctx, blocks, blkRoots)
+	s.forkChoiceLock.Unlock()
 	if err != nil {
 		err := errors.Wrap(err, "could not process block in batch")
 		traceutil.AnnotateError(span, err)
@@ -133,7 +141,10 @@ func (s *Service) ReceiveBlockBatch(ctx context.Context, blocks []*ethpb.SignedB
 
 	for i, b := range blocks {
 		blockCopy := stateTrie.CopySignedBeaconBlock(b)
-		if err = s.handleBlockAfterBatchVerify(ctx, blockCopy, blkRoots[i], fCheckpoints[i], jCheckpoints[i]); err != nil {
+		s.forkChoiceLock.Lock()
+		err = s.handleBlockAfterBatchVerify(ctx, blockCopy, blkRoots[i], fCheckpoints[i], jCheckpoints[i])
+		s.forkChoiceLock.Unlock()
+		if err != nil {
 			traceutil.AnnotateError(span, err)
 			return err
 		}
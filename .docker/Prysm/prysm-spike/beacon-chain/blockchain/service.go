@@ -61,6 +61,7 @@ type Service struct {
 	stateNotifier         statefeed.Notifier
 	genesisRoot           [32]byte
 	forkChoiceStore       f.ForkChoicer
+	forkChoiceLock        sync.Mutex
 	justifiedCheckpt      *ethpb.Checkpoint
 	prevJustifiedCheckpt  *ethpb.Checkpoint
 	bestJustifiedCheckpt  *ethpb.Checkpoint
@@ -0,0 +1,107 @@
+package cache
+
+import (
+	"encoding/binary"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+)
+
+var (
+	// maxAggregatePubkeysPerEpoch defines the max number of aggregate public keys cached for an epoch.
+	// Allows a few distinct sets of attesters for each of the up to 64 committees of the 32 slots.
+	maxAggregatePubkeysPerEpoch = 8192
+
+	// Metrics.
+	aggregatePubkeyCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "aggregate_pubkey_cache_miss",
+		Help: "The number of aggregate public key requests that aren't present in the cache.",
+	})
+	aggregatePubkeyCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "aggregate_pubkey_cache_hit",
+		Help: "The number of aggregate public key requests that are present in the cache.",
+	})
+	aggregatePubkeyCacheSize = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "aggregate_pubkey_cache_size",
+		Help: "The number of aggregate public keys in the cache.",
+	})
+)
+
+// AggregatePubkeyCache is a struct with the aggregate public keys of the attesters of committees,
+// by target epoch. Aggregates over the same attesters of a committee, such as the aggregates of
+// several aggregators of a committee and the block including them, reuse the key rather than
+// aggregating the public keys of the committee again. The public key of a validator index never
+// changes, so keys only depend on the attesting indices. Keys are dropped once their epoch is no
+// longer the current or previous epoch of the latest epoch added, as attestations can't be
+// included in blocks after that.
+type AggregatePubkeyCache struct {
+	lock     sync.RWMutex
+	keys     map[uint64]map[[32]byte]bls.PublicKey
+	maxEpoch uint64
+}
+
+// NewAggregatePubkeyCache creates a new aggregate public key cache.
+func NewAggregatePubkeyCache() *AggregatePubkeyCache {
+	return &AggregatePubkeyCache{
+		keys: make(map[uint64]map[[32]byte]bls.PublicKey),
+	}
+}
+
+// AggregatePubkey returns a copy of the aggregate public key of the attesting indices at the
+// target epoch, or nil if it is not in the cache.
+func (c *AggregatePubkeyCache) AggregatePubkey(epoch uint64, indices []uint64) bls.PublicKey {
+	k := aggregatePubkeyKey(indices)
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	pk, ok := c.keys[epoch][k]
+	if !ok {
+		aggregatePubkeyCacheMiss.Inc()
+		return nil
+	}
+	aggregatePubkeyCacheHit.Inc()
+	return pk.Copy()
+}
+
+// AddAggregatePubkey adds the aggregate public key of the attesting indices at the target epoch to
+// the cache. An epoch later than all previous ones drops the keys of the epochs before its previous
+// epoch, and keys of those epochs are not added.
+func (c *AggregatePubkeyCache) AddAggregatePubkey(epoch uint64, indices []uint64, pk bls.PublicKey) {
+	k := aggregatePubkeyKey(indices)
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if epoch > c.maxEpoch {
+		c.maxEpoch = epoch
+		for e := range c.keys {
+			if e+1 < epoch {
+				delete(c.keys, e)
+			}
+		}
+	} else if epoch+1 < c.maxEpoch {
+		return
+	}
+	keys, ok := c.keys[epoch]
+	if !ok {
+		keys = make(map[[32]byte]bls.PublicKey)
+		c.keys[epoch] = keys
+	}
+	if len(keys) < maxAggregatePubkeysPerEpoch {
+		keys[k] = pk.Copy()
+	}
+	size := 0
+	for _, keys := range c.keys {
+		size += len(keys)
+	}
+	aggregatePubkeyCacheSize.Set(float64(size))
+}
+
+// aggregatePubkeyKey returns the cache key of the attesting indices.
+func aggregatePubkeyKey(indices []uint64) [32]byte {
+	b := make([]byte, 8*len(indices))
+	for i, idx := range indices {
+		binary.LittleEndian.PutUint64(b[8*i:], idx)
+	}
+	return hashutil.Hash(b)
+}
@@ -0,0 +1,50 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestAggregatePubkeyCache_AggregatePubkey(t *testing.T) {
+	c := NewAggregatePubkeyCache()
+	k1, err := bls.RandKey()
+	require.NoError(t, err)
+	k2, err := bls.RandKey()
+	require.NoError(t, err)
+	aggP := k1.PublicKey().Copy().Aggregate(k2.PublicKey())
+
+	assert.Equal(t, nil, c.AggregatePubkey(1, []uint64{1, 2}), "Expected no key in empty cache")
+	c.AddAggregatePubkey(1, []uint64{1, 2}, aggP)
+
+	got := c.AggregatePubkey(1, []uint64{1, 2})
+	require.NotNil(t, got)
+	assert.DeepEqual(t, aggP.Marshal(), got.Marshal(), "Incorrectly cached key")
+	assert.Equal(t, nil, c.AggregatePubkey(1, []uint64{1}), "Expected no key of other indices")
+	assert.Equal(t, nil, c.AggregatePubkey(2, []uint64{1, 2}), "Expected no key of other epoch")
+
+	// Aggregating into the returned key must not change the cached key.
+	got.Aggregate(k1.PublicKey())
+	assert.DeepEqual(t, aggP.Marshal(), c.AggregatePubkey(1, []uint64{1, 2}).Marshal(), "Cached key was modified")
+}
+
+func TestAggregatePubkeyCache_EpochChange(t *testing.T) {
+	c := NewAggregatePubkeyCache()
+	k, err := bls.RandKey()
+	require.NoError(t, err)
+	indices := []uint64{3, 5, 8}
+
+	c.AddAggregatePubkey(1, indices, k.PublicKey())
+	c.AddAggregatePubkey(2, indices, k.PublicKey())
+	assert.NotNil(t, c.AggregatePubkey(1, indices), "Expected key of previous epoch to be kept")
+
+	c.AddAggregatePubkey(3, indices, k.PublicKey())
+	assert.Equal(t, nil, c.AggregatePubkey(1, indices), "Expected key of epoch before previous epoch to be dropped")
+	assert.NotNil(t, c.AggregatePubkey(2, indices), "Expected key of previous epoch to be kept")
+	assert.NotNil(t, c.AggregatePubkey(3, indices), "Expected key of current epoch to be kept")
+
+	c.AddAggregatePubkey(1, indices, k.PublicKey())
+	assert.Equal(t, nil, c.AggregatePubkey(1, indices), "Expected key of old epoch not to be added")
+}
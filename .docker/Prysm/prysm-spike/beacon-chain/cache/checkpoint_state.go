@@ -1,7 +1,10 @@
 package cache
 
 import (
+	"context"
+	"math"
 	"sync"
+	"time"
 
 	lru "github.com/hashicorp/golang-lru"
 	"github.com/prometheus/client_golang/prometheus"
@@ -28,10 +31,14 @@ var (
 	})
 )
 
-// CheckpointStateCache is a struct with 1 queue for looking up state by checkpoint.
+// CheckpointStateCache is a struct with 1 queue for looking up state by checkpoint. The state of a
+// checkpoint is the state of its root advanced to the start of its epoch, the target state shared
+// by attestation validation, block validation and duty computation. Checkpoints can be marked as in
+// progress, so concurrent requests wait for a single computation of their state.
 type CheckpointStateCache struct {
-	cache *lru.Cache
-	lock  sync.RWMutex
+	cache      *lru.Cache
+	lock       sync.RWMutex
+	inProgress map[[32]byte]bool
 }
 
 // NewCheckpointStateCache creates a new checkpoint state cache for storing/accessing processed state.
@@ -41,7 +48,8 @@ func NewCheckpointStateCache() *CheckpointStateCache {
 		panic(err)
 	}
 	return &CheckpointStateCache{
-		cache: cache,
+		cache:      cache,
+		inProgress: make(map[[32]byte]bool),
 	}
 }
 
@@ -79,3 +87,65 @@ func (c *CheckpointStateCache) AddCheckpointState(cp *ethpb.Checkpoint, s *state
 	c.cache.Add(h, s)
 	return nil
 }
+
+// WaitForCheckpointState waits for any in progress computation of the state of a checkpoint to
+// complete before fetching the state by checkpoint, like StateByCheckpoint.
+func (c *CheckpointStateCache) WaitForCheckpointState(ctx context.Context, cp *ethpb.Checkpoint) (*stateTrie.BeaconState, error) {
+	h, err := hashutil.HashProto(cp)
+	if err != nil {
+		return nil, err
+	}
+	delay := minDelay
+	for {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		c.lock.RLock()
+		inProgress := c.inProgress[h]
+		c.lock.RUnlock()
+		if !inProgress {
+			break
+		}
+		// This increasing backoff is to decrease the CPU cycles while waiting
+		// for the in progress boolean to flip to false.
+		time.Sleep(time.Duration(delay) * time.Nanosecond)
+		delay *= delayFactor
+		delay = math.Min(delay, maxDelay)
+	}
+	return c.StateByCheckpoint(cp)
+}
+
+// MarkInProgress marks the computation of the state of a checkpoint as in progress, so that
+// other requests of the checkpoint wait in WaitForCheckpointState until MarkNotInProgress is called.
+func (c *CheckpointStateCache) MarkInProgress(cp *ethpb.Checkpoint) error {
+	h, err := hashutil.HashProto(cp)
+	if err != nil {
+		return err
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.inProgress[h] {
+		return ErrAlreadyInProgress
+	}
+	c.inProgress[h] = true
+	return nil
+}
+
+// MarkNotInProgress releases the requests waiting on the state of a checkpoint. This should be
+// called after AddCheckpointState.
+func (c *CheckpointStateCache) MarkNotInProgress(cp *ethpb.Checkpoint) error {
+	h, err := hashutil.HashProto(cp)
+	if err != nil {
+		return err
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.inProgress, h)
+	return nil
+}
+
+// Clear removes all cached checkpoint states. Checkpoints marked as in progress stay marked, so
+// requests waiting on them are still released by MarkNotInProgress.
+func (c *CheckpointStateCache) Clear() {
+	c.cache.Purge()
+}
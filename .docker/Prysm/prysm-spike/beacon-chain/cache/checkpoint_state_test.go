@@ -1,7 +1,9 @@
 package cache
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
@@ -66,3 +68,49 @@ func TestCheckpointStateCache_MaxSize(t *testing.T) {
 
 	assert.Equal(t, maxCheckpointStateSize, len(c.cache.Keys()))
 }
+
+func TestCheckpointStateCache_Clear(t *testing.T) {
+	c := NewCheckpointStateCache()
+	st, err := stateTrie.InitializeFromProto(&pb.BeaconState{Slot: 64})
+	require.NoError(t, err)
+	cp := &ethpb.Checkpoint{Epoch: 2, Root: bytesutil.PadTo([]byte{'A'}, 32)}
+	require.NoError(t, c.AddCheckpointState(cp, st))
+	require.NoError(t, c.MarkInProgress(&ethpb.Checkpoint{Epoch: 3, Root: make([]byte, 32)}))
+
+	c.Clear()
+	cached, err := c.StateByCheckpoint(cp)
+	require.NoError(t, err)
+	assert.Equal(t, (*stateTrie.BeaconState)(nil), cached)
+	assert.Equal(t, 1, len(c.inProgress))
+}
+
+func TestCheckpointStateCache_InProgress(t *testing.T) {
+	c := NewCheckpointStateCache()
+	cp := &ethpb.Checkpoint{Epoch: 1, Root: bytesutil.PadTo([]byte{'A'}, 32)}
+	st, err := stateTrie.InitializeFromProto(&pb.BeaconState{Slot: 32})
+	require.NoError(t, err)
+
+	require.NoError(t, c.MarkInProgress(cp))
+	assert.ErrorContains(t, ErrAlreadyInProgress.Error(), c.MarkInProgress(cp))
+
+	// Other checkpoints are not held by the one in progress.
+	other, err := c.WaitForCheckpointState(context.Background(), &ethpb.Checkpoint{Epoch: 2, Root: cp.Root})
+	require.NoError(t, err)
+	assert.Equal(t, (*stateTrie.BeaconState)(nil), other)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = c.WaitForCheckpointState(ctx, cp)
+	assert.ErrorContains(t, context.DeadlineExceeded.Error(), err)
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		require.NoError(t, c.AddCheckpointState(cp, st))
+		require.NoError(t, c.MarkNotInProgress(cp))
+	}()
+	waited, err := c.WaitForCheckpointState(context.Background(), cp)
+	require.NoError(t, err)
+	require.NotNil(t, waited, "Expected the state computed while waiting")
+	assert.Equal(t, uint64(32), waited.Slot())
+	require.NoError(t, c.MarkInProgress(cp))
+}
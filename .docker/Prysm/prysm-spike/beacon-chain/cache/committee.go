@@ -172,3 +172,10 @@ func startEndIndices(c *Committees, index uint64) (uint64, uint64) {
 func key(seed [32]byte) string {
 	return string(seed[:])
 }
+
+// Clear removes all shuffled lists from the cache.
+func (c *CommitteeCache) Clear() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.CommitteeCache = cache.NewFIFO(committeeKeyFn)
+}
@@ -48,3 +48,7 @@ func (c *FakeCommitteeCache) ProposerIndices(seed [32]byte) ([]uint64, error) {
 func (c *FakeCommitteeCache) HasEntry(string) bool {
 	return false
 }
+
+// Clear removes all shuffled lists from the cache.
+func (c *FakeCommitteeCache) Clear() {
+}
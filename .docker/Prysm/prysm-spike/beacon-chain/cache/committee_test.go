@@ -72,6 +72,21 @@ func TestCommitteeCache_ActiveIndices(t *testing.T) {
 	assert.DeepEqual(t, item.SortedIndices, indices)
 }
 
+func TestCommitteeCache_Clear(t *testing.T) {
+	cache := NewCommitteesCache()
+	item := &Committees{Seed: [32]byte{'A'}, SortedIndices: []uint64{1, 2, 3}}
+	require.NoError(t, cache.AddCommitteeShuffledList(item))
+
+	cache.Clear()
+	indices, err := cache.ActiveIndices(item.Seed)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(indices))
+	require.NoError(t, cache.AddCommitteeShuffledList(item))
+	indices, err = cache.ActiveIndices(item.Seed)
+	require.NoError(t, err)
+	assert.DeepEqual(t, item.SortedIndices, indices)
+}
+
 func TestCommitteeCache_ActiveCount(t *testing.T) {
 	cache := NewCommitteesCache()
 
@@ -8,6 +8,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/hex"
+	"fmt"
 	"math/big"
 	"sort"
 	"sync"
@@ -46,6 +47,14 @@ type FinalizedDeposits struct {
 	MerkleTrieIndex int64
 }
 
+// snapshotDeposits describes the deposits a cache was initialized with from a deposit snapshot.
+// Those deposits are only known through the finalized deposits trie and are never cached individually.
+type snapshotDeposits struct {
+	depositCount    uint64
+	depositRoot     [32]byte
+	eth1BlockHeight uint64
+}
+
 // DepositCache stores all in-memory deposit objects. This
 // stores all the deposit related data that is required by the beacon-node.
 type DepositCache struct {
@@ -53,6 +62,7 @@ type DepositCache struct {
 	pendingDeposits   []*dbpb.DepositContainer
 	deposits          []*dbpb.DepositContainer
 	finalizedDeposits *FinalizedDeposits
+	snapshot          *snapshotDeposits
 	depositsLock      sync.RWMutex
 }
 
@@ -114,6 +124,10 @@ func (dc *DepositCache) InsertFinalizedDeposits(ctx context.Context, eth1Deposit
 	dc.depositsLock.Lock()
 	defer dc.depositsLock.Unlock()
 
+	// Deposits imported from a snapshot may be ahead of the finalized state of a syncing node.
+	if eth1DepositIndex <= dc.finalizedDeposits.MerkleTrieIndex {
+		return
+	}
 	depositTrie := dc.finalizedDeposits.Deposits
 	insertIndex := int(dc.finalizedDeposits.MerkleTrieIndex + 1)
 	for _, d := range dc.deposits {
@@ -138,6 +152,56 @@ func (dc *DepositCache) InsertFinalizedDeposits(ctx context.Context, eth1Deposit
 	}
 }
 
+// InsertSnapshotDeposits initializes the finalized deposits with the trie of a deposit snapshot, which
+// holds every deposit up to the given eth1 block. Deposits of the snapshot are not cached individually,
+// so only deposits made after the snapshot may be inserted into the cache afterwards.
+func (dc *DepositCache) InsertSnapshotDeposits(ctx context.Context, trie *trieutil.SparseMerkleTrie, eth1BlockHeight uint64) {
+	ctx, span := trace.StartSpan(ctx, "DepositsCache.InsertSnapshotDeposits")
+	defer span.End()
+	dc.depositsLock.Lock()
+	defer dc.depositsLock.Unlock()
+
+	depositCount := trie.NumOfItems()
+	dc.finalizedDeposits = &FinalizedDeposits{
+		Deposits:        trie,
+		MerkleTrieIndex: int64(depositCount) - 1,
+	}
+	dc.snapshot = &snapshotDeposits{
+		depositCount:    depositCount,
+		depositRoot:     trie.HashTreeRoot(),
+		eth1BlockHeight: eth1BlockHeight,
+	}
+}
+
+// FinalizedDepositsSnapshot returns a snapshot of the finalized deposits trie, along with the height
+// of the eth1 block of the last finalized deposit. The hash of that block is left for the caller to
+// fill in. It returns nil if no deposit has been finalized yet.
+func (dc *DepositCache) FinalizedDepositsSnapshot(ctx context.Context) (*trieutil.DepositTreeSnapshot, error) {
+	ctx, span := trace.StartSpan(ctx, "DepositsCache.FinalizedDepositsSnapshot")
+	defer span.End()
+	dc.depositsLock.RLock()
+	defer dc.depositsLock.RUnlock()
+
+	lastIndex := dc.finalizedDeposits.MerkleTrieIndex
+	if lastIndex < 0 {
+		return nil, nil
+	}
+	snapshot, err := dc.finalizedDeposits.Deposits.Snapshot(uint64(lastIndex + 1))
+	if err != nil {
+		return nil, err
+	}
+	idx := sort.Search(len(dc.deposits), func(i int) bool { return dc.deposits[i].Index >= lastIndex })
+	switch {
+	case idx < len(dc.deposits) && dc.deposits[idx].Index == lastIndex:
+		snapshot.ExecutionBlockHeight = dc.deposits[idx].Eth1BlockHeight
+	case dc.snapshot != nil && dc.snapshot.depositCount == snapshot.DepositCount:
+		snapshot.ExecutionBlockHeight = dc.snapshot.eth1BlockHeight
+	default:
+		return nil, fmt.Errorf("could not find finalized deposit with index %d", lastIndex)
+	}
+	return snapshot, nil
+}
+
 // AllDepositContainers returns all historical deposit containers.
 func (dc *DepositCache) AllDepositContainers(ctx context.Context) []*dbpb.DepositContainer {
 	ctx, span := trace.StartSpan(ctx, "DepositsCache.AllDepositContainers")
@@ -173,12 +237,18 @@ func (dc *DepositCache) DepositsNumberAndRootAtHeight(ctx context.Context, block
 	dc.depositsLock.RLock()
 	defer dc.depositsLock.RUnlock()
 	heightIdx := sort.Search(len(dc.deposits), func(i int) bool { return dc.deposits[i].Eth1BlockHeight > blockHeight.Uint64() })
+	// Deposits of a snapshot have been finalized, hence were made before the requested block.
+	var snapshotCount uint64
+	var snapshotRoot [32]byte
+	if dc.snapshot != nil {
+		snapshotCount, snapshotRoot = dc.snapshot.depositCount, dc.snapshot.depositRoot
+	}
 	// send the deposit root of the empty trie, if eth1follow distance is greater than the time of the earliest
 	// deposit.
 	if heightIdx == 0 {
-		return 0, [32]byte{}
+		return snapshotCount, snapshotRoot
 	}
-	return uint64(heightIdx), bytesutil.ToBytes32(dc.deposits[heightIdx-1].DepositRoot)
+	return snapshotCount + uint64(heightIdx), bytesutil.ToBytes32(dc.deposits[heightIdx-1].DepositRoot)
 }
 
 // DepositByPubkey looks through historical deposits and finds one which contains
@@ -244,11 +314,10 @@ func (dc *DepositCache) PruneProofs(ctx context.Context, untilDepositIndex int64
 	dc.depositsLock.Lock()
 	defer dc.depositsLock.Unlock()
 
-	if untilDepositIndex >= int64(len(dc.deposits)) {
-		untilDepositIndex = int64(len(dc.deposits) - 1)
-	}
+	// Deposits are sorted by index, but the first cached deposit follows the ones of a deposit snapshot.
+	untilIdx := sort.Search(len(dc.deposits), func(i int) bool { return dc.deposits[i].Index > untilDepositIndex })
 
-	for i := untilDepositIndex; i >= 0; i-- {
+	for i := untilIdx - 1; i >= 0; i-- {
 		if ctx.Err() != nil {
 			return ctx.Err()
 		}
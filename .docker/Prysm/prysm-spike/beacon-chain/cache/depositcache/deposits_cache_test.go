@@ -743,6 +743,81 @@ func TestPruneProofs_CorrectlyHandleLastIndex(t *testing.T) {
 	assert.DeepEqual(t, ([][]byte)(nil), dc.deposits[3].Deposit.Proof)
 }
 
+func TestInsertSnapshotDeposits_DepositsAfterSnapshot(t *testing.T) {
+	dc, err := New()
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	var leaves [][]byte
+	var ctrs []*dbpb.DepositContainer
+	for i := 0; i < 5; i++ {
+		data := &ethpb.Deposit_Data{
+			PublicKey:             bytesutil.PadTo([]byte{byte(i)}, 48),
+			WithdrawalCredentials: make([]byte, 32),
+			Signature:             make([]byte, 96),
+		}
+		leaf, err := data.HashTreeRoot()
+		require.NoError(t, err)
+		leaves = append(leaves, leaf[:])
+		full, err := trieutil.GenerateTrieFromItems(leaves, params.BeaconConfig().DepositContractTreeDepth)
+		require.NoError(t, err)
+		root := full.HashTreeRoot()
+		ctrs = append(ctrs, &dbpb.DepositContainer{
+			Deposit:         &ethpb.Deposit{Data: data, Proof: makeDepositProof()},
+			Eth1BlockHeight: uint64(10 + i),
+			Index:           int64(i),
+			DepositRoot:     root[:],
+		})
+	}
+	full, err := trieutil.GenerateTrieFromItems(leaves[:3], params.BeaconConfig().DepositContractTreeDepth)
+	require.NoError(t, err)
+	snapshot, err := full.Snapshot(3)
+	require.NoError(t, err)
+	trie, err := trieutil.TrieFromSnapshot(snapshot, params.BeaconConfig().DepositContractTreeDepth)
+	require.NoError(t, err)
+
+	// Only the deposits following the snapshot are cached.
+	dc.InsertSnapshotDeposits(ctx, trie, 12)
+	dc.InsertDepositContainers(ctx, ctrs[3:])
+
+	n, root := dc.DepositsNumberAndRootAtHeight(ctx, big.NewInt(11))
+	assert.Equal(t, uint64(3), n)
+	assert.Equal(t, snapshot.DepositRoot, root)
+	n, root = dc.DepositsNumberAndRootAtHeight(ctx, big.NewInt(13))
+	assert.Equal(t, uint64(4), n)
+	assert.Equal(t, bytesutil.ToBytes32(ctrs[3].DepositRoot), root)
+
+	got, err := dc.FinalizedDepositsSnapshot(ctx)
+	require.NoError(t, err)
+	snapshot.ExecutionBlockHeight = 12
+	assert.DeepEqual(t, snapshot, got)
+
+	// Finalized states older than the snapshot do not rewind the finalized deposits.
+	dc.InsertFinalizedDeposits(ctx, 1)
+	assert.Equal(t, int64(2), dc.FinalizedDeposits(ctx).MerkleTrieIndex)
+	dc.InsertFinalizedDeposits(ctx, 3)
+	finalized := dc.FinalizedDeposits(ctx)
+	assert.Equal(t, int64(3), finalized.MerkleTrieIndex)
+	assert.Equal(t, bytesutil.ToBytes32(ctrs[3].DepositRoot), finalized.Deposits.HashTreeRoot())
+	got, err = dc.FinalizedDepositsSnapshot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(4), got.DepositCount)
+	assert.Equal(t, uint64(13), got.ExecutionBlockHeight)
+
+	require.NoError(t, dc.PruneProofs(ctx, 3))
+	assert.DeepEqual(t, ([][]byte)(nil), dc.deposits[0].Deposit.Proof)
+	assert.NotNil(t, dc.deposits[1].Deposit.Proof)
+}
+
+func TestFinalizedDepositsSnapshot_NoFinalizedDeposits(t *testing.T) {
+	dc, err := New()
+	require.NoError(t, err)
+
+	snapshot, err := dc.FinalizedDepositsSnapshot(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, (*trieutil.DepositTreeSnapshot)(nil), snapshot)
+}
+
 func makeDepositProof() [][]byte {
 	proof := make([][]byte, int(params.BeaconConfig().DepositContractTreeDepth)+1)
 	for i := range proof {
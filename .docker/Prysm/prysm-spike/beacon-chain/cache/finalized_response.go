@@ -0,0 +1,64 @@
+package cache
+
+import (
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	// maxFinalizedResponseCacheSize defines the max number of RPC responses the cache can contain.
+	// Responses may hold full blocks, so the size is kept well below the number of slots explorers
+	// usually page through.
+	maxFinalizedResponseCacheSize = 1024
+
+	// Metrics.
+	finalizedResponseCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "finalized_response_cache_miss",
+		Help: "The number of finalized data RPC requests that aren't present in the cache.",
+	})
+	finalizedResponseCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "finalized_response_cache_hit",
+		Help: "The number of finalized data RPC requests that are present in the cache.",
+	})
+)
+
+// FinalizedResponseCache stores RPC responses about finalized data, such as finalized blocks and
+// their roots, which can never change once finalized. Entries are keyed by the RPC method and an
+// immutable identifier of the data, such as a block root or slot, and must not be mutated by callers.
+type FinalizedResponseCache struct {
+	cache *lru.Cache
+}
+
+// NewFinalizedResponseCache creates a new finalized response cache.
+func NewFinalizedResponseCache() *FinalizedResponseCache {
+	cache, err := lru.New(maxFinalizedResponseCacheSize)
+	if err != nil {
+		panic(err)
+	}
+	return &FinalizedResponseCache{
+		cache: cache,
+	}
+}
+
+// Get returns the cached response of the key, if any. A nil cache never returns a response.
+func (c *FinalizedResponseCache) Get(key string) (interface{}, bool) {
+	if c == nil {
+		return nil, false
+	}
+	resp, ok := c.cache.Get(key)
+	if !ok {
+		finalizedResponseCacheMiss.Inc()
+		return nil, false
+	}
+	finalizedResponseCacheHit.Inc()
+	return resp, true
+}
+
+// Put caches the response of the key. Callers must only cache responses about finalized data.
+func (c *FinalizedResponseCache) Put(key string, resp interface{}) {
+	if c == nil {
+		return
+	}
+	c.cache.Add(key, resp)
+}
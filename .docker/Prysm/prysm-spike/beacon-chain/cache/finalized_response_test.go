@@ -0,0 +1,27 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+)
+
+func TestFinalizedResponseCache_GetPut(t *testing.T) {
+	cache := NewFinalizedResponseCache()
+	_, ok := cache.Get("GetBlock/0x01")
+	assert.Equal(t, false, ok)
+
+	cache.Put("GetBlock/0x01", "response")
+	resp, ok := cache.Get("GetBlock/0x01")
+	assert.Equal(t, true, ok)
+	assert.Equal(t, "response", resp)
+	_, ok = cache.Get("GetBlockRoot/0x01")
+	assert.Equal(t, false, ok)
+}
+
+func TestFinalizedResponseCache_Nil(t *testing.T) {
+	var cache *FinalizedResponseCache
+	cache.Put("GetBlock/0x01", "response")
+	_, ok := cache.Get("GetBlock/0x01")
+	assert.Equal(t, false, ok)
+}
@@ -0,0 +1,68 @@
+package cache
+
+import (
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	// maxHashTreeRootCacheSize defines the max number of hash tree roots the cache can contain.
+	// Entries keep their object alive, so the cache only covers the few epochs of blocks being
+	// validated, processed and served.
+	maxHashTreeRootCacheSize = 128
+
+	// Metrics.
+	hashTreeRootCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "hash_tree_root_cache_miss",
+		Help: "The number of hash tree root requests that aren't present in the cache.",
+	})
+	hashTreeRootCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "hash_tree_root_cache_hit",
+		Help: "The number of hash tree root requests that are present in the cache.",
+	})
+)
+
+// SSZHashable is an object with a hash tree root, such as a beacon block.
+type SSZHashable interface {
+	HashTreeRoot() ([32]byte, error)
+}
+
+// HashTreeRootCache memoizes hash tree roots of objects hashed repeatedly as they go through
+// validation, processing and RPC handlers, such as gossiped blocks. Entries are keyed by object
+// identity, so looking up a root costs nothing, and objects must not be mutated once hashed.
+// Entries reference their object, so an address is never reused for another object while cached.
+type HashTreeRootCache struct {
+	cache *lru.Cache
+}
+
+// NewHashTreeRootCache creates a new hash tree root cache.
+func NewHashTreeRootCache() *HashTreeRootCache {
+	cache, err := lru.New(maxHashTreeRootCacheSize)
+	if err != nil {
+		panic(err)
+	}
+	return &HashTreeRootCache{
+		cache: cache,
+	}
+}
+
+// HashTreeRoot returns the hash tree root of the object, from the cache if it was computed for the
+// same object before. The object must be a pointer. A nil cache computes the root without
+// memoization.
+func (c *HashTreeRootCache) HashTreeRoot(obj SSZHashable) ([32]byte, error) {
+	if c == nil {
+		return obj.HashTreeRoot()
+	}
+	if root, ok := c.cache.Get(obj); ok {
+		hashTreeRootCacheHit.Inc()
+		return root.([32]byte), nil
+	}
+	hashTreeRootCacheMiss.Inc()
+	root, err := obj.HashTreeRoot()
+	if err != nil {
+		return [32]byte{}, err
+	}
+	c.cache.Add(obj, root)
+	return root, nil
+}
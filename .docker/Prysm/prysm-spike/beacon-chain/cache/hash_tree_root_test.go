@@ -0,0 +1,52 @@
+package cache
+
+import (
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestHashTreeRootCache_HashTreeRoot(t *testing.T) {
+	cache := NewHashTreeRootCache()
+	header := testBlockHeader()
+	header.Slot = 5
+	want, err := header.HashTreeRoot()
+	require.NoError(t, err)
+
+	root, err := cache.HashTreeRoot(header)
+	require.NoError(t, err)
+	assert.Equal(t, want, root)
+	assert.Equal(t, 1, cache.cache.Len())
+	root, err = cache.HashTreeRoot(header)
+	require.NoError(t, err)
+	assert.Equal(t, want, root)
+	assert.Equal(t, 1, cache.cache.Len())
+
+	// An equal object is another entry.
+	other := testBlockHeader()
+	other.Slot = 5
+	root, err = cache.HashTreeRoot(other)
+	require.NoError(t, err)
+	assert.Equal(t, want, root)
+	assert.Equal(t, 2, cache.cache.Len())
+}
+
+func TestHashTreeRootCache_Nil(t *testing.T) {
+	var cache *HashTreeRootCache
+	header := testBlockHeader()
+	want, err := header.HashTreeRoot()
+	require.NoError(t, err)
+	root, err := cache.HashTreeRoot(header)
+	require.NoError(t, err)
+	assert.Equal(t, want, root)
+}
+
+func testBlockHeader() *ethpb.BeaconBlockHeader {
+	return &ethpb.BeaconBlockHeader{
+		ParentRoot: make([]byte, 32),
+		StateRoot:  make([]byte, 32),
+		BodyRoot:   make([]byte, 32),
+	}
+}
@@ -86,3 +86,10 @@ func (c *ProposerIndicesCache) ProposerIndices(r [32]byte) ([]uint64, error) {
 
 	return item.ProposerIndices, nil
 }
+
+// Clear removes all proposer indices from the cache.
+func (c *ProposerIndicesCache) Clear() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.ProposerIndicesCache = cache.NewFIFO(proposerIndicesKeyFn)
+}
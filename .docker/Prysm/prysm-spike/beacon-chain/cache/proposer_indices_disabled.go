@@ -22,3 +22,7 @@ func (c *FakeProposerIndicesCache) AddProposerIndices(p *ProposerIndices) error
 func (c *FakeProposerIndicesCache) ProposerIndices(r [32]byte) ([]uint64, error) {
 	return nil, nil
 }
+
+// Clear removes all proposer indices from the cache.
+func (c *FakeProposerIndicesCache) Clear() {
+}
@@ -50,6 +50,17 @@ func TestProposerCache_AddProposerIndicesList(t *testing.T) {
 	assert.DeepEqual(t, item.ProposerIndices, received)
 }
 
+func TestProposerCache_Clear(t *testing.T) {
+	cache := NewProposerIndicesCache()
+	item := &ProposerIndices{BlockRoot: [32]byte{'A'}, ProposerIndices: []uint64{1, 2, 3}}
+	require.NoError(t, cache.AddProposerIndices(item))
+
+	cache.Clear()
+	received, err := cache.ProposerIndices(item.BlockRoot)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(received))
+}
+
 func TestProposerCache_CanRotate(t *testing.T) {
 	cache := NewProposerIndicesCache()
 	for i := 0; i < int(maxProposerIndicesCacheSize)+1; i++ {
@@ -0,0 +1,28 @@
+package blocks_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func BenchmarkProcessAttestations(b *testing.B) {
+	beaconState, privKeys := testutil.DeterministicGenesisState(b, 1024)
+	blk, err := testutil.GenerateFullBlock(beaconState, privKeys, &testutil.BlockGenConfig{NumAttestations: 1}, 1)
+	require.NoError(b, err)
+	require.NoError(b, beaconState.SetSlot(1))
+	require.Equal(b, 1, len(blk.Block.Body.Attestations))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		st := beaconState.Copy()
+		b.StartTimer()
+		_, err := blocks.ProcessAttestations(context.Background(), st, blk)
+		require.NoError(b, err)
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
@@ -14,6 +15,8 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/params"
 )
 
+var aggregatePubkeyCache = cache.NewAggregatePubkeyCache()
+
 // retrieves the signature set from the raw data, public key,signature and domain provided.
 func retrieveSignatureSet(signedData, pub, signature, domain []byte) (*bls.SignatureSet, error) {
 	publicKey, err := bls.PublicKeyFromBytes(pub)
@@ -142,13 +145,7 @@ func createAttestationSignatureSet(ctx context.Context, beaconState *stateTrie.B
 		if err := attestationutil.IsValidAttestationIndices(ctx, ia); err != nil {
 			return nil, err
 		}
-		indices := ia.AttestingIndices
-		pubkeys := make([][]byte, len(indices))
-		for i := 0; i < len(indices); i++ {
-			pubkeyAtIdx := beaconState.PubkeyAtIndex(indices[i])
-			pubkeys[i] = pubkeyAtIdx[:]
-		}
-		aggP, err := bls.AggregatePublicKeys(pubkeys)
+		aggP, err := aggregateAttestingPubkeys(beaconState, ia)
 		if err != nil {
 			return nil, err
 		}
@@ -167,6 +164,31 @@ func createAttestationSignatureSet(ctx context.Context, beaconState *stateTrie.B
 	}, nil
 }
 
+// aggregateAttestingPubkeys returns the aggregate public key of the attesters of the attestation,
+// from the cache of aggregate public keys when the same attesters were aggregated before. Keys are
+// only cached for target epochs up to the current epoch of the state, so attestations of made up
+// epochs don't drop the keys of the current epochs from the cache.
+func aggregateAttestingPubkeys(beaconState *stateTrie.BeaconState, ia *ethpb.IndexedAttestation) (bls.PublicKey, error) {
+	indices := ia.AttestingIndices
+	epoch := ia.Data.Target.Epoch
+	if aggP := aggregatePubkeyCache.AggregatePubkey(epoch, indices); aggP != nil {
+		return aggP, nil
+	}
+	pubkeys := make([][]byte, len(indices))
+	for i := 0; i < len(indices); i++ {
+		pubkeyAtIdx := beaconState.PubkeyAtIndex(indices[i])
+		pubkeys[i] = pubkeyAtIdx[:]
+	}
+	aggP, err := bls.AggregatePublicKeys(pubkeys)
+	if err != nil {
+		return nil, err
+	}
+	if epoch <= helpers.CurrentEpoch(beaconState) {
+		aggregatePubkeyCache.AddAggregatePubkey(epoch, indices, aggP)
+	}
+	return aggP, nil
+}
+
 // AttestationSignatureSet retrieves all the related attestation signature data such as the relevant public keys,
 // signatures and attestation signing data and collate it into a signature set object.
 func AttestationSignatureSet(ctx context.Context, beaconState *stateTrie.BeaconState, atts []*ethpb.Attestation) (*bls.SignatureSet, error) {
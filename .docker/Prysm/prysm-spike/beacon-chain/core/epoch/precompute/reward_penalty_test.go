@@ -361,19 +361,19 @@ func TestFinalityDelay(t *testing.T) {
 		finalizedEpoch = state.FinalizedCheckpointEpoch()
 	}
 	setVal()
-	d := finalityDelay(prevEpoch, finalizedEpoch)
+	d := FinalityDelay(prevEpoch, finalizedEpoch)
 	w := helpers.PrevEpoch(state) - state.FinalizedCheckpointEpoch()
 	assert.Equal(t, w, d, "Did not get wanted finality delay")
 
 	require.NoError(t, state.SetFinalizedCheckpoint(&ethpb.Checkpoint{Epoch: 4}))
 	setVal()
-	d = finalityDelay(prevEpoch, finalizedEpoch)
+	d = FinalityDelay(prevEpoch, finalizedEpoch)
 	w = helpers.PrevEpoch(state) - state.FinalizedCheckpointEpoch()
 	assert.Equal(t, w, d, "Did not get wanted finality delay")
 
 	require.NoError(t, state.SetFinalizedCheckpoint(&ethpb.Checkpoint{Epoch: 5}))
 	setVal()
-	d = finalityDelay(prevEpoch, finalizedEpoch)
+	d = FinalityDelay(prevEpoch, finalizedEpoch)
 	w = helpers.PrevEpoch(state) - state.FinalizedCheckpointEpoch()
 	assert.Equal(t, w, d, "Did not get wanted finality delay")
 }
@@ -391,11 +391,11 @@ func TestIsInInactivityLeak(t *testing.T) {
 		finalizedEpoch = state.FinalizedCheckpointEpoch()
 	}
 	setVal()
-	assert.Equal(t, true, isInInactivityLeak(prevEpoch, finalizedEpoch), "Wanted inactivity leak true")
+	assert.Equal(t, true, IsInInactivityLeak(prevEpoch, finalizedEpoch), "Wanted inactivity leak true")
 	require.NoError(t, state.SetFinalizedCheckpoint(&ethpb.Checkpoint{Epoch: 4}))
 	setVal()
-	assert.Equal(t, true, isInInactivityLeak(prevEpoch, finalizedEpoch), "Wanted inactivity leak true")
+	assert.Equal(t, true, IsInInactivityLeak(prevEpoch, finalizedEpoch), "Wanted inactivity leak true")
 	require.NoError(t, state.SetFinalizedCheckpoint(&ethpb.Checkpoint{Epoch: 5}))
 	setVal()
-	assert.Equal(t, false, isInInactivityLeak(prevEpoch, finalizedEpoch), "Wanted inactivity leak false")
+	assert.Equal(t, false, IsInInactivityLeak(prevEpoch, finalizedEpoch), "Wanted inactivity leak false")
 }
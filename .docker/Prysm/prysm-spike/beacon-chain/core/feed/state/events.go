@@ -17,6 +17,10 @@ const (
 	// Reorg is an event sent when the new head state's slot after a block
 	// transition is lower than its previous head state slot value.
 	Reorg
+	// NewHead is sent after the head of the chain changed to another block.
+	NewHead
+	// FinalizedCheckpoint is sent after a new checkpoint was finalized.
+	FinalizedCheckpoint
 )
 
 // BlockProcessedData is the data sent with BlockProcessed events.
@@ -55,4 +59,38 @@ type ReorgData struct {
 	NewSlot uint64
 	// OldSlot is the slot of the head state before the reorg.
 	OldSlot uint64
+	// Depth is the number of slots between the old head and the latest slot both heads share.
+	Depth uint64
+	// OldHeadBlock is the root of the head block before the reorg.
+	OldHeadBlock [32]byte
+	// NewHeadBlock is the root of the head block after the reorg.
+	NewHeadBlock [32]byte
+	// OldHeadState is the state root of the head block before the reorg.
+	OldHeadState [32]byte
+	// NewHeadState is the state root of the head block after the reorg.
+	NewHeadState [32]byte
+}
+
+// NewHeadData is the data sent with NewHead events.
+type NewHeadData struct {
+	// Slot of the new head block.
+	Slot uint64
+	// BlockRoot of the new head block.
+	BlockRoot [32]byte
+	// StateRoot of the new head block.
+	StateRoot [32]byte
+	// PreviousDutyDependentRoot is the root of the block the duties of the previous epoch depend on.
+	PreviousDutyDependentRoot [32]byte
+	// CurrentDutyDependentRoot is the root of the block the duties of the current epoch depend on.
+	CurrentDutyDependentRoot [32]byte
+}
+
+// FinalizedCheckpointData is the data sent with FinalizedCheckpoint events.
+type FinalizedCheckpointData struct {
+	// Epoch of the checkpoint.
+	Epoch uint64
+	// Block is the root of the checkpoint block.
+	Block [32]byte
+	// State is the state root of the checkpoint block.
+	State [32]byte
 }
@@ -52,3 +52,20 @@ func BlockRoot(state *stateTrie.BeaconState, epoch uint64) ([]byte, error) {
 	}
 	return BlockRootAtSlot(state, s)
 }
+
+// DutyDependentRoot returns the root of the latest block before the epoch starts, which the
+// proposer shuffling of the epoch and the committees of the epoch after depend on. The duties of
+// the genesis epoch depend on the genesis block, which is the head block of a genesis state.
+func DutyDependentRoot(state *stateTrie.BeaconState, headRoot []byte, epoch uint64) ([]byte, error) {
+	if epoch == 0 {
+		if state.Slot() == 0 {
+			return headRoot, nil
+		}
+		return BlockRootAtSlot(state, 0)
+	}
+	startSlot, err := StartSlot(epoch)
+	if err != nil {
+		return nil, err
+	}
+	return BlockRootAtSlot(state, startSlot-1)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	beaconstate "github.com/prysmaticlabs/prysm/beacon-chain/state"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
@@ -116,3 +117,32 @@ func TestBlockRootAtSlot_OutOfBounds(t *testing.T) {
 		assert.ErrorContains(t, tt.expectedErr, err)
 	}
 }
+
+func TestDutyDependentRoot(t *testing.T) {
+	var blockRoots [][]byte
+	for i := uint64(0); i < params.BeaconConfig().SlotsPerHistoricalRoot; i++ {
+		blockRoots = append(blockRoots, bytesutil.PadTo([]byte{byte(i)}, 32))
+	}
+	headRoot := []byte{'h'}
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+
+	tests := []struct {
+		name      string
+		stateSlot uint64
+		epoch     uint64
+		want      []byte
+	}{
+		{name: "genesis state", stateSlot: 0, epoch: 0, want: headRoot},
+		{name: "genesis epoch", stateSlot: 5, epoch: 0, want: bytesutil.PadTo([]byte{0}, 32)},
+		{name: "last slot before epoch", stateSlot: 3 * slotsPerEpoch, epoch: 2, want: bytesutil.PadTo([]byte{byte(2*slotsPerEpoch - 1)}, 32)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := beaconstate.InitializeFromProto(&pb.BeaconState{Slot: tt.stateSlot, BlockRoots: blockRoots})
+			require.NoError(t, err)
+			root, err := helpers.DutyDependentRoot(s, headRoot, tt.epoch)
+			require.NoError(t, err)
+			assert.DeepEqual(t, tt.want, root)
+		})
+	}
+}
@@ -368,10 +368,11 @@ func UpdateProposerIndicesInCache(state *stateTrie.BeaconState, epoch uint64) er
 	})
 }
 
-// ClearCache clears the committee cache
+// ClearCache clears the committee and proposer indices caches. The caches are cleared in place,
+// so it is safe to call while they are in use.
 func ClearCache() {
-	committeeCache = cache.NewCommitteesCache()
-	proposerIndicesCache = cache.NewProposerIndicesCache()
+	committeeCache.Clear()
+	proposerIndicesCache.Clear()
 }
 
 // This computes proposer indices of the current epoch and returns a list of proposer indices,
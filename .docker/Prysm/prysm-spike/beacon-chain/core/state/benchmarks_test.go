@@ -11,6 +11,7 @@ import (
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/benchutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 )
 
@@ -78,6 +79,23 @@ func BenchmarkProcessEpoch_2FullEpochs(b *testing.B) {
 	}
 }
 
+// BenchmarkProcessEpoch_DeterministicState measures the epoch transition of a generated state, so it
+// runs from a plain test binary without the pregenerated benchmark files.
+func BenchmarkProcessEpoch_DeterministicState(b *testing.B) {
+	beaconState, _ := testutil.DeterministicGenesisState(b, 1024)
+	require.NoError(b, beaconState.SetSlot(3*params.BeaconConfig().SlotsPerEpoch-1))
+	require.NoError(b, helpers.UpdateCommitteeCache(beaconState, helpers.CurrentEpoch(beaconState)))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		st := beaconState.Copy()
+		b.StartTimer()
+		_, err := state.ProcessEpochPrecompute(context.Background(), st)
+		require.NoError(b, err)
+	}
+}
+
 func BenchmarkHashTreeRoot_FullState(b *testing.B) {
 	beaconState, err := benchutil.PreGenState2FullEpochs()
 	require.NoError(b, err)
@@ -18,7 +18,9 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state/interop"
 	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/shared/bls"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/mathutil"
+	"github.com/prysmaticlabs/prysm/shared/p2putils"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
 	"github.com/sirupsen/logrus"
@@ -343,6 +345,13 @@ func ProcessSlots(ctx context.Context, state *stateTrie.BeaconState, slot uint64
 			traceutil.AnnotateError(span, err)
 			return nil, errors.Wrap(err, "failed to increment state slot")
 		}
+		if helpers.IsEpochStart(state.Slot()) {
+			state, err = ProcessForkUpgrade(state)
+			if err != nil {
+				traceutil.AnnotateError(span, err)
+				return nil, errors.Wrap(err, "could not process fork upgrade")
+			}
+		}
 	}
 
 	if highestSlot < state.Slot() {
@@ -573,6 +582,29 @@ func CanProcessEpoch(state *stateTrie.BeaconState) bool {
 	return (state.Slot()+1)%params.BeaconConfig().SlotsPerEpoch == 0
 }
 
+// ProcessForkUpgrade moves the fork of a state entering a new epoch to the fork scheduled at the epoch
+// in the fork version schedule, if any. Validators sign with the scheduled fork from its epoch on, so
+// the state has to verify their signatures with it too.
+func ProcessForkUpgrade(state *stateTrie.BeaconState) (*stateTrie.BeaconState, error) {
+	epoch := helpers.CurrentEpoch(state)
+	scheduled, err := p2putils.Fork(epoch)
+	if err != nil {
+		return nil, err
+	}
+	currentFork := state.Fork()
+	if scheduled.Epoch != epoch || currentFork == nil || bytes.Equal(currentFork.CurrentVersion, scheduled.CurrentVersion) {
+		return state, nil
+	}
+	if err := state.SetFork(&pb.Fork{
+		PreviousVersion: currentFork.CurrentVersion,
+		CurrentVersion:  scheduled.CurrentVersion,
+		Epoch:           epoch,
+	}); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
 // ProcessEpochPrecompute describes the per epoch operations that are performed on the beacon state.
 // It's optimized by pre computing validator attested info and epoch total/attested balances upfront.
 func ProcessEpochPrecompute(ctx context.Context, state *stateTrie.BeaconState) (*stateTrie.BeaconState, error) {
@@ -955,3 +955,28 @@ func TestProcessSlots_LowerSlotAsParentState(t *testing.T) {
 	_, err = state.ProcessSlots(context.Background(), parentState, slot-1)
 	assert.ErrorContains(t, "expected state.slot 2 < slot 1", err)
 }
+
+func TestProcessSlots_UpgradesScheduledFork(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.ForkVersionSchedule = map[uint64][]byte{
+		2: {1, 0, 0, 0},
+	}
+	params.OverrideBeaconConfig(cfg)
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	genesisFork := beaconState.Fork()
+
+	// The fork is kept until the scheduled epoch.
+	beaconState, err := state.ProcessSlots(context.Background(), beaconState, 2*params.BeaconConfig().SlotsPerEpoch-1)
+	require.NoError(t, err)
+	assert.DeepEqual(t, genesisFork, beaconState.Fork())
+
+	// And upgraded when entering it.
+	beaconState, err = state.ProcessSlots(context.Background(), beaconState, 2*params.BeaconConfig().SlotsPerEpoch+1)
+	require.NoError(t, err)
+	assert.DeepEqual(t, &pb.Fork{
+		PreviousVersion: genesisFork.CurrentVersion,
+		CurrentVersion:  []byte{1, 0, 0, 0},
+		Epoch:           2,
+	}, beaconState.Fork())
+}
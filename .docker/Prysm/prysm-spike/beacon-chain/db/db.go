@@ -8,6 +8,6 @@ import (
 )
 
 // NewDB initializes a new DB.
-func NewDB(dirPath string, stateSummaryCache *cache.StateSummaryCache) (Database, error) {
-	return kv.NewKVStore(dirPath, stateSummaryCache)
+func NewDB(dirPath string, stateSummaryCache *cache.StateSummaryCache, cfg *kv.Config) (Database, error) {
+	return kv.NewKVStore(dirPath, stateSummaryCache, cfg)
 }
@@ -9,8 +9,8 @@ import (
 )
 
 // NewDB initializes a new DB with kafka wrapper.
-func NewDB(dirPath string, stateSummaryCache *cache.StateSummaryCache) (Database, error) {
-	db, err := kv.NewKVStore(dirPath, stateSummaryCache)
+func NewDB(dirPath string, stateSummaryCache *cache.StateSummaryCache, cfg *kv.Config) (Database, error) {
+	db, err := kv.NewKVStore(dirPath, stateSummaryCache, cfg)
 	if err != nil {
 		return nil, err
 	}
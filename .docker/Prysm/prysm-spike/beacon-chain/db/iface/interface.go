@@ -41,6 +41,13 @@ type ReadOnlyDatabase interface {
 	// Block operations.
 	VoluntaryExit(ctx context.Context, exitRoot [32]byte) (*eth.VoluntaryExit, error)
 	HasVoluntaryExit(ctx context.Context, exitRoot [32]byte) bool
+	// Operation indices, the roots of the blocks including the operations of a validator.
+	DepositBlockRoots(ctx context.Context, pubKey []byte) ([][32]byte, error)
+	VoluntaryExitBlockRoots(ctx context.Context, validatorIndex uint64) ([][32]byte, error)
+	ProposerSlashingBlockRoots(ctx context.Context, validatorIndex uint64) ([][32]byte, error)
+	AttesterSlashingBlockRoots(ctx context.Context, validatorIndex uint64) ([][32]byte, error)
+	// Locally submitted operations.
+	LocalOperations(ctx context.Context) ([]*db.LocalOperation, error)
 	// Checkpoint operations.
 	JustifiedCheckpoint(ctx context.Context) (*eth.Checkpoint, error)
 	FinalizedCheckpoint(ctx context.Context) (*eth.Checkpoint, error)
@@ -52,6 +59,10 @@ type ReadOnlyDatabase interface {
 	DepositContractAddress(ctx context.Context) ([]byte, error)
 	// Powchain operations.
 	PowchainData(ctx context.Context) (*db.ETH1ChainData, error)
+	// Initial sync operations.
+	InitialSyncProgress(ctx context.Context) (*db.InitialSyncProgress, error)
+	// Gossip operations.
+	SeenAttestations(ctx context.Context, startEpoch uint64) ([][]byte, error)
 }
 
 // NoHeadAccessDatabase defines a struct without access to chain head data.
@@ -61,6 +72,7 @@ type NoHeadAccessDatabase interface {
 	// Block related methods.
 	SaveBlock(ctx context.Context, block *eth.SignedBeaconBlock) error
 	SaveBlocks(ctx context.Context, blocks []*eth.SignedBeaconBlock) error
+	SaveBackfilledBlocks(ctx context.Context, blocks []*eth.SignedBeaconBlock, childRoot [32]byte) error
 	SaveGenesisBlockRoot(ctx context.Context, blockRoot [32]byte) error
 	// State related methods.
 	SaveState(ctx context.Context, state *state.BeaconState, blockRoot [32]byte) error
@@ -74,6 +86,9 @@ type NoHeadAccessDatabase interface {
 	SaveAttesterSlashing(ctx context.Context, slashing *eth.AttesterSlashing) error
 	// Block operations.
 	SaveVoluntaryExit(ctx context.Context, exit *eth.VoluntaryExit) error
+	// Locally submitted operations.
+	SaveLocalOperation(ctx context.Context, root [32]byte, op *db.LocalOperation) error
+	DeleteLocalOperation(ctx context.Context, root [32]byte) error
 	// Checkpoint operations.
 	SaveJustifiedCheckpoint(ctx context.Context, checkpoint *eth.Checkpoint) error
 	SaveFinalizedCheckpoint(ctx context.Context, checkpoint *eth.Checkpoint) error
@@ -81,6 +96,12 @@ type NoHeadAccessDatabase interface {
 	SaveDepositContractAddress(ctx context.Context, addr common.Address) error
 	// Powchain operations.
 	SavePowchainData(ctx context.Context, data *db.ETH1ChainData) error
+	// Initial sync operations.
+	SaveInitialSyncProgress(ctx context.Context, progress *db.InitialSyncProgress) error
+	DeleteInitialSyncProgress(ctx context.Context) error
+	// Gossip operations.
+	SaveSeenAttestations(ctx context.Context, epoch uint64, digests [][]byte) error
+	DeleteSeenAttestationsBefore(ctx context.Context, epoch uint64) error
 
 	// Run any required database migrations.
 	RunMigrations(ctx context.Context) error
@@ -107,4 +128,6 @@ type Database interface {
 
 	// Backup and restore methods
 	Backup(ctx context.Context, outputDir string) error
+	// Snapshot writes a copy of the database for read-only replicas.
+	Snapshot(ctx context.Context, dir string) error
 }
@@ -110,3 +110,16 @@ func (e Exporter) SaveBlocks(ctx context.Context, blocks []*eth.SignedBeaconBloc
 
 	return e.db.SaveBlocks(ctx, blocks)
 }
+
+// SaveBackfilledBlocks publishes to the kafka topic for beacon blocks.
+func (e Exporter) SaveBackfilledBlocks(ctx context.Context, blocks []*eth.SignedBeaconBlock, childRoot [32]byte) error {
+	go func() {
+		for _, block := range blocks {
+			if err := e.publish(ctx, "beacon_block", block); err != nil {
+				log.WithError(err).Error("Failed to publish block")
+			}
+		}
+	}()
+
+	return e.db.SaveBackfilledBlocks(ctx, blocks, childRoot)
+}
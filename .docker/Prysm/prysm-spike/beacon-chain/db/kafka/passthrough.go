@@ -26,6 +26,11 @@ func (e Exporter) Backup(ctx context.Context, outputDir string) error {
 	return e.db.Backup(ctx, outputDir)
 }
 
+// Snapshot -- passthrough.
+func (e Exporter) Snapshot(ctx context.Context, dir string) error {
+	return e.db.Snapshot(ctx, dir)
+}
+
 // Block -- passthrough.
 func (e Exporter) Block(ctx context.Context, blockRoot [32]byte) (*eth.SignedBeaconBlock, error) {
 	return e.db.Block(ctx, blockRoot)
@@ -96,6 +101,26 @@ func (e Exporter) HasVoluntaryExit(ctx context.Context, exitRoot [32]byte) bool
 	return e.db.HasVoluntaryExit(ctx, exitRoot)
 }
 
+// DepositBlockRoots -- passthrough.
+func (e Exporter) DepositBlockRoots(ctx context.Context, pubKey []byte) ([][32]byte, error) {
+	return e.db.DepositBlockRoots(ctx, pubKey)
+}
+
+// VoluntaryExitBlockRoots -- passthrough.
+func (e Exporter) VoluntaryExitBlockRoots(ctx context.Context, validatorIndex uint64) ([][32]byte, error) {
+	return e.db.VoluntaryExitBlockRoots(ctx, validatorIndex)
+}
+
+// ProposerSlashingBlockRoots -- passthrough.
+func (e Exporter) ProposerSlashingBlockRoots(ctx context.Context, validatorIndex uint64) ([][32]byte, error) {
+	return e.db.ProposerSlashingBlockRoots(ctx, validatorIndex)
+}
+
+// AttesterSlashingBlockRoots -- passthrough.
+func (e Exporter) AttesterSlashingBlockRoots(ctx context.Context, validatorIndex uint64) ([][32]byte, error) {
+	return e.db.AttesterSlashingBlockRoots(ctx, validatorIndex)
+}
+
 // JustifiedCheckpoint -- passthrough.
 func (e Exporter) JustifiedCheckpoint(ctx context.Context) (*eth.Checkpoint, error) {
 	return e.db.JustifiedCheckpoint(ctx)
@@ -206,6 +231,21 @@ func (e Exporter) FinalizedChildBlock(ctx context.Context, blockRoot [32]byte) (
 	return e.db.FinalizedChildBlock(ctx, blockRoot)
 }
 
+// LocalOperations -- passthrough.
+func (e Exporter) LocalOperations(ctx context.Context) ([]*db.LocalOperation, error) {
+	return e.db.LocalOperations(ctx)
+}
+
+// SaveLocalOperation -- passthrough.
+func (e Exporter) SaveLocalOperation(ctx context.Context, root [32]byte, op *db.LocalOperation) error {
+	return e.db.SaveLocalOperation(ctx, root, op)
+}
+
+// DeleteLocalOperation -- passthrough.
+func (e Exporter) DeleteLocalOperation(ctx context.Context, root [32]byte) error {
+	return e.db.DeleteLocalOperation(ctx, root)
+}
+
 // PowchainData -- passthrough
 func (e Exporter) PowchainData(ctx context.Context) (*db.ETH1ChainData, error) {
 	return e.db.PowchainData(ctx)
@@ -216,6 +256,36 @@ func (e Exporter) SavePowchainData(ctx context.Context, data *db.ETH1ChainData)
 	return e.db.SavePowchainData(ctx, data)
 }
 
+// InitialSyncProgress -- passthrough
+func (e Exporter) InitialSyncProgress(ctx context.Context) (*db.InitialSyncProgress, error) {
+	return e.db.InitialSyncProgress(ctx)
+}
+
+// SaveInitialSyncProgress -- passthrough
+func (e Exporter) SaveInitialSyncProgress(ctx context.Context, progress *db.InitialSyncProgress) error {
+	return e.db.SaveInitialSyncProgress(ctx, progress)
+}
+
+// DeleteInitialSyncProgress -- passthrough
+func (e Exporter) DeleteInitialSyncProgress(ctx context.Context) error {
+	return e.db.DeleteInitialSyncProgress(ctx)
+}
+
+// SeenAttestations -- passthrough
+func (e Exporter) SeenAttestations(ctx context.Context, startEpoch uint64) ([][]byte, error) {
+	return e.db.SeenAttestations(ctx, startEpoch)
+}
+
+// SaveSeenAttestations -- passthrough
+func (e Exporter) SaveSeenAttestations(ctx context.Context, epoch uint64, digests [][]byte) error {
+	return e.db.SaveSeenAttestations(ctx, epoch, digests)
+}
+
+// DeleteSeenAttestationsBefore -- passthrough
+func (e Exporter) DeleteSeenAttestationsBefore(ctx context.Context, epoch uint64) error {
+	return e.db.DeleteSeenAttestationsBefore(ctx, epoch)
+}
+
 // ArchivedPointRoot -- passthrough
 func (e Exporter) ArchivedPointRoot(ctx context.Context, index uint64) [32]byte {
 	return e.db.ArchivedPointRoot(ctx, index)
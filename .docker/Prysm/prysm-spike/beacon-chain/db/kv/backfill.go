@@ -0,0 +1,62 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// SaveBackfilledBlocks saves blocks backfilled below the lowest block of the db, and adds them to
+// the finalized block roots index, so they are served to peers like the blocks synced forward. The
+// blocks are ordered by ascending slot, each is the parent of the next one, and the last one is the
+// parent of the block with the child root. A backfilled genesis block is saved as the genesis block.
+func (s *Store) SaveBackfilledBlocks(ctx context.Context, blocks []*ethpb.SignedBeaconBlock, childRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveBackfilledBlocks")
+	defer span.End()
+
+	roots := make([][32]byte, len(blocks))
+	for i, blk := range blocks {
+		root, err := blk.Block.HashTreeRoot()
+		if err != nil {
+			return err
+		}
+		roots[i] = root
+	}
+	for i := 0; i+1 < len(blocks); i++ {
+		if !bytes.Equal(blocks[i+1].Block.ParentRoot, roots[i][:]) {
+			return fmt.Errorf("backfilled block %#x is not the parent of the next block", roots[i])
+		}
+	}
+
+	return s.update(func(tx *bolt.Tx) error {
+		if _, err := s.saveBlocks(ctx, tx, blocks); err != nil {
+			return err
+		}
+		bkt := tx.Bucket(finalizedBlockRootsIndexBucket)
+		for i, blk := range blocks {
+			child := childRoot
+			if i+1 < len(blocks) {
+				child = roots[i+1]
+			}
+			enc, err := encode(ctx, &dbpb.FinalizedBlockRootContainer{
+				ParentRoot: blk.Block.ParentRoot,
+				ChildRoot:  child[:],
+			})
+			if err != nil {
+				return err
+			}
+			if err := bkt.Put(roots[i][:], enc); err != nil {
+				return err
+			}
+		}
+		if len(blocks) > 0 && blocks[0].Block.Slot == 0 {
+			return tx.Bucket(blocksBucket).Put(genesisBlockRootKey, roots[0][:])
+		}
+		return nil
+	})
+}
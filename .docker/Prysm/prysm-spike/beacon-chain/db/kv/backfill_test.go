@@ -0,0 +1,50 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_SaveBackfilledBlocks(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	genesis := testutil.NewBeaconBlock()
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	blks := makeBlocks(t, 0, 8, genesisRoot)
+	roots := make([][32]byte, len(blks))
+	for i, blk := range blks {
+		roots[i], err = blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+	}
+	require.NoError(t, db.SaveBlock(ctx, blks[7]))
+
+	unlinked := []*ethpb.SignedBeaconBlock{blks[1], blks[0]}
+	require.ErrorContains(t, "is not the parent of the next block", db.SaveBackfilledBlocks(ctx, unlinked, roots[7]))
+	assert.Equal(t, false, db.HasBlock(ctx, roots[0]))
+
+	require.NoError(t, db.SaveBackfilledBlocks(ctx, blks[3:7], roots[7]))
+	for i := 3; i < 7; i++ {
+		assert.Equal(t, true, db.HasBlock(ctx, roots[i]), "Block %d was not saved", i)
+		assert.Equal(t, true, db.IsFinalizedBlock(ctx, roots[i]), "Block %d was not finalized", i)
+	}
+	child, err := db.FinalizedChildBlock(ctx, roots[6])
+	require.NoError(t, err)
+	assert.DeepEqual(t, blks[7], child)
+	child, err = db.FinalizedChildBlock(ctx, roots[3])
+	require.NoError(t, err)
+	assert.DeepEqual(t, blks[4], child)
+
+	// Backfilling the genesis block saves it as the genesis block.
+	require.NoError(t, db.SaveBackfilledBlocks(ctx, append([]*ethpb.SignedBeaconBlock{genesis}, blks[:3]...), roots[3]))
+	saved, err := db.GenesisBlock(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, genesis, saved)
+	assert.Equal(t, true, db.IsFinalizedBlock(ctx, roots[0]))
+}
@@ -0,0 +1,117 @@
+package kv
+
+import (
+	"context"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// DepositBlockRoots retrieves the roots of the blocks including a deposit to the public key.
+func (s *Store) DepositBlockRoots(ctx context.Context, pubKey []byte) ([][32]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.DepositBlockRoots")
+	defer span.End()
+	return s.blockRootsAtOperationIndex(depositBlockIndicesBucket, pubKey)
+}
+
+// VoluntaryExitBlockRoots retrieves the roots of the blocks including an exit of the validator.
+func (s *Store) VoluntaryExitBlockRoots(ctx context.Context, validatorIndex uint64) ([][32]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.VoluntaryExitBlockRoots")
+	defer span.End()
+	return s.blockRootsAtOperationIndex(voluntaryExitBlockIndicesBucket, bytesutil.Uint64ToBytesBigEndian(validatorIndex))
+}
+
+// ProposerSlashingBlockRoots retrieves the roots of the blocks including a proposer slashing of the validator.
+func (s *Store) ProposerSlashingBlockRoots(ctx context.Context, validatorIndex uint64) ([][32]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.ProposerSlashingBlockRoots")
+	defer span.End()
+	return s.blockRootsAtOperationIndex(proposerSlashingBlockIndicesBucket, bytesutil.Uint64ToBytesBigEndian(validatorIndex))
+}
+
+// AttesterSlashingBlockRoots retrieves the roots of the blocks including an attester slashing of the validator.
+func (s *Store) AttesterSlashingBlockRoots(ctx context.Context, validatorIndex uint64) ([][32]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.AttesterSlashingBlockRoots")
+	defer span.End()
+	return s.blockRootsAtOperationIndex(attesterSlashingBlockIndicesBucket, bytesutil.Uint64ToBytesBigEndian(validatorIndex))
+}
+
+func (s *Store) blockRootsAtOperationIndex(bucket, key []byte) ([][32]byte, error) {
+	var roots [][32]byte
+	err := s.db.View(func(tx *bolt.Tx) error {
+		values := tx.Bucket(bucket).Get(key)
+		for i := 0; i+32 <= len(values); i += 32 {
+			roots = append(roots, bytesutil.ToBytes32(values[i:i+32]))
+		}
+		return nil
+	})
+	return roots, err
+}
+
+// operationIndex is a key of an operation index bucket.
+type operationIndex struct {
+	bucket []byte
+	key    []byte
+}
+
+// createOperationIndicesFromBlock returns the operation index keys of the deposits, exits and
+// slashings of the block: deposits by public key, the other operations by the index of the
+// validator they exit or slash.
+func createOperationIndicesFromBlock(ctx context.Context, block *ethpb.BeaconBlock) []operationIndex {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.createOperationIndicesFromBlock")
+	defer span.End()
+	body := block.Body
+	if body == nil {
+		return nil
+	}
+	var indices []operationIndex
+	for _, d := range body.Deposits {
+		if d.Data != nil {
+			indices = append(indices, operationIndex{depositBlockIndicesBucket, d.Data.PublicKey})
+		}
+	}
+	for _, e := range body.VoluntaryExits {
+		if e.Exit != nil {
+			key := bytesutil.Uint64ToBytesBigEndian(e.Exit.ValidatorIndex)
+			indices = append(indices, operationIndex{voluntaryExitBlockIndicesBucket, key})
+		}
+	}
+	for _, ps := range body.ProposerSlashings {
+		if ps.Header_1 != nil && ps.Header_1.Header != nil {
+			key := bytesutil.Uint64ToBytesBigEndian(ps.Header_1.Header.ProposerIndex)
+			indices = append(indices, operationIndex{proposerSlashingBlockIndicesBucket, key})
+		}
+	}
+	for _, as := range body.AttesterSlashings {
+		if as.Attestation_1 == nil || as.Attestation_2 == nil {
+			continue
+		}
+		for _, i := range sliceutil.IntersectionUint64(as.Attestation_1.AttestingIndices, as.Attestation_2.AttestingIndices) {
+			key := bytesutil.Uint64ToBytesBigEndian(i)
+			indices = append(indices, operationIndex{attesterSlashingBlockIndicesBucket, key})
+		}
+	}
+	return indices
+}
+
+// updateOperationIndices adds the block root to the operation indices.
+func updateOperationIndices(ctx context.Context, indices []operationIndex, blockRoot []byte, tx *bolt.Tx) error {
+	for _, idx := range indices {
+		if err := updateValueForIndices(ctx, map[string][]byte{string(idx.bucket): idx.key}, blockRoot, tx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteOperationIndices removes the block root from the operation indices.
+func deleteOperationIndices(ctx context.Context, indices []operationIndex, blockRoot []byte, tx *bolt.Tx) error {
+	for _, idx := range indices {
+		if err := deleteValueForIndices(ctx, map[string][]byte{string(idx.bucket): idx.key}, blockRoot, tx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func testDeposit(pubKey []byte) *ethpb.Deposit {
+	proof := make([][]byte, 33)
+	for i := range proof {
+		proof[i] = make([]byte, 32)
+	}
+	return &ethpb.Deposit{Proof: proof, Data: &ethpb.Deposit_Data{
+		PublicKey:             pubKey,
+		WithdrawalCredentials: make([]byte, 32),
+		Signature:             make([]byte, 96),
+	}}
+}
+
+func testHeader(proposerIndex uint64) *ethpb.SignedBeaconBlockHeader {
+	return &ethpb.SignedBeaconBlockHeader{
+		Header: &ethpb.BeaconBlockHeader{
+			ProposerIndex: proposerIndex,
+			ParentRoot:    make([]byte, 32),
+			StateRoot:     make([]byte, 32),
+			BodyRoot:      make([]byte, 32),
+		},
+		Signature: make([]byte, 96),
+	}
+}
+
+func testIndexedAttestation(indices ...uint64) *ethpb.IndexedAttestation {
+	return &ethpb.IndexedAttestation{
+		AttestingIndices: indices,
+		Data: &ethpb.AttestationData{
+			BeaconBlockRoot: make([]byte, 32),
+			Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+			Target:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+		},
+		Signature: make([]byte, 96),
+	}
+}
+
+func TestStore_OperationBlockRoots(t *testing.T) {
+	store := setupDB(t)
+	ctx := context.Background()
+	pubKey := bytesutil.PadTo([]byte{'k'}, 48)
+
+	first := testutil.NewBeaconBlock()
+	first.Block.Slot = 1
+	first.Block.Body.Deposits = []*ethpb.Deposit{testDeposit(pubKey)}
+	first.Block.Body.VoluntaryExits = []*ethpb.SignedVoluntaryExit{{
+		Exit:      &ethpb.VoluntaryExit{ValidatorIndex: 3},
+		Signature: make([]byte, 96),
+	}}
+	first.Block.Body.ProposerSlashings = []*ethpb.ProposerSlashing{{Header_1: testHeader(4), Header_2: testHeader(4)}}
+	// Validators 6 and 7 attested to both attestations, 5 and 8 to only one of them.
+	first.Block.Body.AttesterSlashings = []*ethpb.AttesterSlashing{{
+		Attestation_1: testIndexedAttestation(5, 6, 7),
+		Attestation_2: testIndexedAttestation(6, 7, 8),
+	}}
+	second := testutil.NewBeaconBlock()
+	second.Block.Slot = 2
+	second.Block.Body.Deposits = []*ethpb.Deposit{testDeposit(pubKey)}
+	require.NoError(t, store.SaveBlocks(ctx, []*ethpb.SignedBeaconBlock{first, second}))
+	firstRoot, err := first.Block.HashTreeRoot()
+	require.NoError(t, err)
+	secondRoot, err := second.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	roots, err := store.DepositBlockRoots(ctx, pubKey)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][32]byte{firstRoot, secondRoot}, roots)
+	roots, err = store.VoluntaryExitBlockRoots(ctx, 3)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][32]byte{firstRoot}, roots)
+	roots, err = store.ProposerSlashingBlockRoots(ctx, 4)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][32]byte{firstRoot}, roots)
+	for _, i := range []uint64{6, 7} {
+		roots, err = store.AttesterSlashingBlockRoots(ctx, i)
+		require.NoError(t, err)
+		assert.DeepEqual(t, [][32]byte{firstRoot}, roots)
+	}
+	for _, i := range []uint64{5, 8} {
+		roots, err = store.AttesterSlashingBlockRoots(ctx, i)
+		require.NoError(t, err)
+		assert.Equal(t, 0, len(roots), "Validator %d was not slashed", i)
+	}
+	roots, err = store.VoluntaryExitBlockRoots(ctx, 4)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(roots))
+
+	require.NoError(t, store.deleteBlock(ctx, firstRoot))
+	roots, err = store.DepositBlockRoots(ctx, pubKey)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][32]byte{secondRoot}, roots)
+	roots, err = store.VoluntaryExitBlockRoots(ctx, 3)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(roots))
+}
@@ -138,7 +138,7 @@ func (s *Store) HasBlock(ctx context.Context, blockRoot [32]byte) bool {
 func (s *Store) deleteBlock(ctx context.Context, blockRoot [32]byte) error {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.deleteBlock")
 	defer span.End()
-	return s.db.Update(func(tx *bolt.Tx) error {
+	return s.update(func(tx *bolt.Tx) error {
 		bkt := tx.Bucket(blocksBucket)
 		enc := bkt.Get(blockRoot[:])
 		if enc == nil {
@@ -152,6 +152,9 @@ func (s *Store) deleteBlock(ctx context.Context, blockRoot [32]byte) error {
 		if err := deleteValueForIndices(ctx, indicesByBucket, blockRoot[:], tx); err != nil {
 			return errors.Wrap(err, "could not delete root for DB indices")
 		}
+		if err := deleteOperationIndices(ctx, createOperationIndicesFromBlock(ctx, block.Block), blockRoot[:], tx); err != nil {
+			return errors.Wrap(err, "could not delete root for operation indices")
+		}
 		s.blockCache.Del(string(blockRoot[:]))
 		return bkt.Delete(blockRoot[:])
 	})
@@ -162,7 +165,7 @@ func (s *Store) deleteBlocks(ctx context.Context, blockRoots [][32]byte) error {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.deleteBlocks")
 	defer span.End()
 
-	return s.db.Update(func(tx *bolt.Tx) error {
+	return s.update(func(tx *bolt.Tx) error {
 		bkt := tx.Bucket(blocksBucket)
 		for _, blockRoot := range blockRoots {
 			enc := bkt.Get(blockRoot[:])
@@ -177,6 +180,9 @@ func (s *Store) deleteBlocks(ctx context.Context, blockRoots [][32]byte) error {
 			if err := deleteValueForIndices(ctx, indicesByBucket, blockRoot[:], tx); err != nil {
 				return errors.Wrap(err, "could not delete root for DB indices")
 			}
+			if err := deleteOperationIndices(ctx, createOperationIndicesFromBlock(ctx, block.Block), blockRoot[:], tx); err != nil {
+				return errors.Wrap(err, "could not delete root for operation indices")
+			}
 			s.blockCache.Del(string(blockRoot[:]))
 			if err := bkt.Delete(blockRoot[:]); err != nil {
 				return err
@@ -206,42 +212,59 @@ func (s *Store) SaveBlocks(ctx context.Context, blocks []*ethpb.SignedBeaconBloc
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveBlocks")
 	defer span.End()
 
-	return s.db.Update(func(tx *bolt.Tx) error {
-		bkt := tx.Bucket(blocksBucket)
-		for _, block := range blocks {
-			blockRoot, err := block.Block.HashTreeRoot()
-			if err != nil {
-				return err
-			}
+	written := 0
+	if err := s.updateBlocks(func(tx *bolt.Tx) error {
+		var err error
+		written, err = s.saveBlocks(ctx, tx, blocks)
+		return err
+	}); err != nil {
+		return err
+	}
+	return s.trackUnsyncedBlocks(written)
+}
 
-			if existingBlock := bkt.Get(blockRoot[:]); existingBlock != nil {
-				continue
-			}
-			enc, err := encode(ctx, block)
-			if err != nil {
-				return err
-			}
-			indicesByBucket := createBlockIndicesFromBlock(ctx, block.Block)
-			if err := updateValueForIndices(ctx, indicesByBucket, blockRoot[:], tx); err != nil {
-				return errors.Wrap(err, "could not update DB indices")
-			}
-			s.blockCache.Set(string(blockRoot[:]), block, int64(len(enc)))
+// saveBlocks saves the blocks which are not in the db yet and their indices in the transaction, and
+// returns the number of blocks written.
+func (s *Store) saveBlocks(ctx context.Context, tx *bolt.Tx, blocks []*ethpb.SignedBeaconBlock) (int, error) {
+	bkt := tx.Bucket(blocksBucket)
+	written := 0
+	for _, block := range blocks {
+		blockRoot, err := block.Block.HashTreeRoot()
+		if err != nil {
+			return written, err
+		}
 
-			if err := bkt.Put(blockRoot[:], enc); err != nil {
-				return err
-			}
+		if existingBlock := bkt.Get(blockRoot[:]); existingBlock != nil {
+			continue
 		}
-		return nil
-	})
+		enc, err := encode(ctx, block)
+		if err != nil {
+			return written, err
+		}
+		indicesByBucket := createBlockIndicesFromBlock(ctx, block.Block)
+		if err := updateValueForIndices(ctx, indicesByBucket, blockRoot[:], tx); err != nil {
+			return written, errors.Wrap(err, "could not update DB indices")
+		}
+		if err := updateOperationIndices(ctx, createOperationIndicesFromBlock(ctx, block.Block), blockRoot[:], tx); err != nil {
+			return written, errors.Wrap(err, "could not update operation indices")
+		}
+		s.blockCache.Set(string(blockRoot[:]), block, int64(len(enc)))
+
+		if err := bkt.Put(blockRoot[:], enc); err != nil {
+			return written, err
+		}
+		written++
+	}
+	return written, nil
 }
 
 // SaveHeadBlockRoot to the db.
 func (s *Store) SaveHeadBlockRoot(ctx context.Context, blockRoot [32]byte) error {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveHeadBlockRoot")
 	defer span.End()
-	return s.db.Update(func(tx *bolt.Tx) error {
+	return s.update(func(tx *bolt.Tx) error {
 		hasStateSummaryInCache := s.stateSummaryCache.Has(blockRoot)
-		hasStateSummaryInDB := tx.Bucket(stateSummaryBucket).Get(blockRoot[:]) != nil
+		hasStateSummaryInDB := s.hasStateSummaryInDB(tx, blockRoot[:])
 		hasStateInDB := tx.Bucket(stateBucket).Get(blockRoot[:]) != nil
 		if !(hasStateInDB || hasStateSummaryInDB || hasStateSummaryInCache) {
 			return errors.New("no state or state summary found with head block root")
@@ -274,7 +297,7 @@ func (s *Store) GenesisBlock(ctx context.Context) (*ethpb.SignedBeaconBlock, err
 func (s *Store) SaveGenesisBlockRoot(ctx context.Context, blockRoot [32]byte) error {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveGenesisBlockRoot")
 	defer span.End()
-	return s.db.Update(func(tx *bolt.Tx) error {
+	return s.update(func(tx *bolt.Tx) error {
 		bucket := tx.Bucket(blocksBucket)
 		return bucket.Put(genesisBlockRootKey, blockRoot[:])
 	})
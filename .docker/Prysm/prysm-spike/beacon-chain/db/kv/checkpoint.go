@@ -2,9 +2,10 @@ package kv
 
 import (
 	"context"
-	"errors"
 
+	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	bolt "go.etcd.io/bbolt"
@@ -58,9 +59,9 @@ func (s *Store) SaveJustifiedCheckpoint(ctx context.Context, checkpoint *ethpb.C
 	if err != nil {
 		return err
 	}
-	return s.db.Update(func(tx *bolt.Tx) error {
+	return s.update(func(tx *bolt.Tx) error {
 		bucket := tx.Bucket(checkpointBucket)
-		hasStateSummaryInDB := tx.Bucket(stateSummaryBucket).Get(checkpoint.Root) != nil
+		hasStateSummaryInDB := s.hasStateSummaryInDB(tx, checkpoint.Root)
 		hasStateSummaryInCache := s.stateSummaryCache.Has(bytesutil.ToBytes32(checkpoint.Root))
 		hasStateInDB := tx.Bucket(stateBucket).Get(checkpoint.Root) != nil
 		if !(hasStateInDB || hasStateSummaryInDB || hasStateSummaryInCache) {
@@ -79,9 +80,9 @@ func (s *Store) SaveFinalizedCheckpoint(ctx context.Context, checkpoint *ethpb.C
 	if err != nil {
 		return err
 	}
-	return s.db.Update(func(tx *bolt.Tx) error {
+	if err := s.update(func(tx *bolt.Tx) error {
 		bucket := tx.Bucket(checkpointBucket)
-		hasStateSummaryInDB := tx.Bucket(stateSummaryBucket).Get(checkpoint.Root) != nil
+		hasStateSummaryInDB := s.hasStateSummaryInDB(tx, checkpoint.Root)
 		hasStateSummaryInCache := s.stateSummaryCache.Has(bytesutil.ToBytes32(checkpoint.Root))
 		hasStateInDB := tx.Bucket(stateBucket).Get(checkpoint.Root) != nil
 		if !(hasStateInDB || hasStateSummaryInDB || hasStateSummaryInCache) {
@@ -92,5 +93,16 @@ func (s *Store) SaveFinalizedCheckpoint(ctx context.Context, checkpoint *ethpb.C
 		}
 
 		return s.updateFinalizedBlockRoots(ctx, tx, checkpoint)
-	})
+	}); err != nil {
+		return err
+	}
+	finalizedSlot, err := helpers.StartSlot(checkpoint.Epoch)
+	if err != nil {
+		return err
+	}
+	if err := s.archiveStateSummaries(ctx, finalizedSlot); err != nil {
+		return errors.Wrap(err, "could not archive finalized state summaries")
+	}
+	// Finalization is a natural durability barrier for batched block writes.
+	return s.syncBarrier()
 }
@@ -0,0 +1,66 @@
+package kv
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// InitialSyncProgress retrieves the progress saved by the initial sync, or nil if there is none.
+func (s *Store) InitialSyncProgress(ctx context.Context) (*db.InitialSyncProgress, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.InitialSyncProgress")
+	defer span.End()
+
+	var progress *db.InitialSyncProgress
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(chainMetadataBucket)
+		enc := bkt.Get(initialSyncProgressKey)
+		if len(enc) == 0 {
+			return nil
+		}
+		progress = &db.InitialSyncProgress{}
+		return proto.Unmarshal(enc, progress)
+	})
+	return progress, err
+}
+
+// SaveInitialSyncProgress saves the progress of the initial sync. In batched sync mode the
+// progress is flushed to disk together with the blocks it refers to.
+func (s *Store) SaveInitialSyncProgress(ctx context.Context, progress *db.InitialSyncProgress) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveInitialSyncProgress")
+	defer span.End()
+
+	if progress == nil {
+		err := errors.New("cannot save nil initial sync progress")
+		traceutil.AnnotateError(span, err)
+		return err
+	}
+	enc, err := proto.Marshal(progress)
+	if err != nil {
+		return err
+	}
+	if err := s.update(func(tx *bolt.Tx) error {
+		return tx.Bucket(chainMetadataBucket).Put(initialSyncProgressKey, enc)
+	}); err != nil {
+		traceutil.AnnotateError(span, err)
+		return err
+	}
+	return s.syncBarrier()
+}
+
+// DeleteInitialSyncProgress removes the progress saved by the initial sync.
+func (s *Store) DeleteInitialSyncProgress(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.DeleteInitialSyncProgress")
+	defer span.End()
+
+	err := s.update(func(tx *bolt.Tx) error {
+		return tx.Bucket(chainMetadataBucket).Delete(initialSyncProgressKey)
+	})
+	traceutil.AnnotateError(span, err)
+	return err
+}
@@ -0,0 +1,37 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_InitialSyncProgress(t *testing.T) {
+	store := setupDB(t)
+	ctx := context.Background()
+
+	progress, err := store.InitialSyncProgress(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, (*db.InitialSyncProgress)(nil), progress)
+	assert.ErrorContains(t, "cannot save nil", store.SaveInitialSyncProgress(ctx, nil))
+
+	want := &db.InitialSyncProgress{
+		BatchStartSlot: 64,
+		ProcessedSlot:  127,
+		ProcessedRoot:  bytesutil.PadTo([]byte("root"), 32),
+		Peers:          []string{"peerA", "peerB"},
+	}
+	require.NoError(t, store.SaveInitialSyncProgress(ctx, want))
+	progress, err = store.InitialSyncProgress(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, progress)
+
+	require.NoError(t, store.DeleteInitialSyncProgress(ctx))
+	progress, err = store.InitialSyncProgress(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, (*db.InitialSyncProgress)(nil), progress)
+}
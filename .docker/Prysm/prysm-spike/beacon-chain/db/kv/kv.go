@@ -3,8 +3,10 @@
 package kv
 
 import (
+	"fmt"
 	"os"
 	"path"
+	"sync"
 	"time"
 
 	"github.com/dgraph-io/ristretto"
@@ -13,6 +15,7 @@ import (
 	prombolt "github.com/prysmaticlabs/prombbolt"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	"github.com/prysmaticlabs/prysm/shared/exitcode"
 	"github.com/prysmaticlabs/prysm/shared/fileutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	bolt "go.etcd.io/bbolt"
@@ -41,12 +44,25 @@ type Store struct {
 	blockCache          *ristretto.Cache
 	validatorIndexCache *ristretto.Cache
 	stateSummaryCache   *cache.StateSummaryCache
+	stateSummaryLog     *stateSummaryLog
+	syncBatchSize       uint64
+	unsyncedBlocks      uint64
+	syncLock            sync.Mutex
+	readOnly            bool
+}
+
+// Config options for the beacon db.
+type Config struct {
+	// BlockSyncBatchSize is the number of blocks saved without fsync between durability barriers.
+	// 0 syncs every block save to disk.
+	BlockSyncBatchSize uint64
 }
 
 // NewKVStore initializes a new boltDB key-value store at the directory
 // path specified, creates the kv-buckets based on the schema, and stores
-// an open connection db object as a property of the Store struct.
-func NewKVStore(dirPath string, stateSummaryCache *cache.StateSummaryCache) (*Store, error) {
+// an open connection db object as a property of the Store struct. A nil
+// config uses the defaults.
+func NewKVStore(dirPath string, stateSummaryCache *cache.StateSummaryCache, cfg *Config) (*Store, error) {
 	hasDir, err := fileutil.HasDir(dirPath)
 	if err != nil {
 		return nil, err
@@ -62,33 +78,18 @@ func NewKVStore(dirPath string, stateSummaryCache *cache.StateSummaryCache) (*St
 		if errors.Is(err, bolt.ErrTimeout) {
 			return nil, errors.New("cannot obtain database lock, database may be in use by another process")
 		}
+		if errors.Is(err, bolt.ErrInvalid) || errors.Is(err, bolt.ErrChecksum) || errors.Is(err, bolt.ErrVersionMismatch) {
+			return nil, exitcode.Wrap(errors.Wrapf(err, "database file %s is corrupted", datafile), exitcode.DatabaseCorrupted)
+		}
 		return nil, err
 	}
 	boltDB.AllocSize = boltAllocSize
-	blockCache, err := ristretto.NewCache(&ristretto.Config{
-		NumCounters: 1000,           // number of keys to track frequency of (1000).
-		MaxCost:     BlockCacheSize, // maximum cost of cache (1000 Blocks).
-		BufferItems: 64,             // number of keys per Get buffer.
-	})
+	kv, err := newStore(boltDB, dirPath, stateSummaryCache)
 	if err != nil {
 		return nil, err
 	}
-
-	validatorCache, err := ristretto.NewCache(&ristretto.Config{
-		NumCounters: NumOfVotes,     // number of keys to track frequency of (1M).
-		MaxCost:     VotesCacheSize, // maximum cost of cache (8MB).
-		BufferItems: 64,             // number of keys per Get buffer.
-	})
-	if err != nil {
-		return nil, err
-	}
-
-	kv := &Store{
-		db:                  boltDB,
-		databasePath:        dirPath,
-		blockCache:          blockCache,
-		validatorIndexCache: validatorCache,
-		stateSummaryCache:   stateSummaryCache,
+	if cfg != nil {
+		kv.syncBatchSize = cfg.BlockSyncBatchSize
 	}
 
 	if err := kv.db.Update(func(tx *bolt.Tx) error {
@@ -100,10 +101,14 @@ func NewKVStore(dirPath string, stateSummaryCache *cache.StateSummaryCache) (*St
 			proposerSlashingsBucket,
 			attesterSlashingsBucket,
 			voluntaryExitsBucket,
+			localOperationsBucket,
 			chainMetadataBucket,
 			checkpointBucket,
 			powchainBucket,
+			seenAttestationsBucket,
 			stateSummaryBucket,
+			stateSummaryLogBucket,
+			stateSummaryArchiveBucket,
 			// Indices buckets.
 			attestationHeadBlockRootBucket,
 			attestationSourceRootIndicesBucket,
@@ -114,6 +119,10 @@ func NewKVStore(dirPath string, stateSummaryCache *cache.StateSummaryCache) (*St
 			stateSlotIndicesBucket,
 			blockParentRootIndicesBucket,
 			finalizedBlockRootsIndexBucket,
+			depositBlockIndicesBucket,
+			voluntaryExitBlockIndicesBucket,
+			proposerSlashingBlockIndicesBucket,
+			attesterSlashingBlockIndicesBucket,
 			// New State Management service bucket.
 			newStateServiceCompatibleBucket,
 			// Migrations
@@ -123,13 +132,80 @@ func NewKVStore(dirPath string, stateSummaryCache *cache.StateSummaryCache) (*St
 		return nil, err
 	}
 
+	kv.stateSummaryLog, err = loadStateSummaryLog(kv.db)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not load state summary log")
+	}
+
 	err = prometheus.Register(createBoltCollector(kv.db))
 
 	return kv, err
 }
 
+// NewKVStoreReadOnly opens the database at the directory path without write access, such as a
+// snapshot written by Snapshot. The database must exist, and its buckets are not created. Bolt
+// only lets a database file be opened by one process when any of them writes to it, so the
+// database of a running beacon node cannot be opened, only its snapshots.
+func NewKVStoreReadOnly(dirPath string, stateSummaryCache *cache.StateSummaryCache) (*Store, error) {
+	datafile := path.Join(dirPath, databaseFileName)
+	if !fileutil.FileExists(datafile) {
+		return nil, fmt.Errorf("no database at %s", datafile)
+	}
+	boltDB, err := bolt.Open(datafile, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{Timeout: 1 * time.Second, ReadOnly: true})
+	if err != nil {
+		if errors.Is(err, bolt.ErrTimeout) {
+			return nil, errors.New("cannot obtain database lock, database may be written by another process")
+		}
+		if errors.Is(err, bolt.ErrInvalid) || errors.Is(err, bolt.ErrChecksum) || errors.Is(err, bolt.ErrVersionMismatch) {
+			return nil, exitcode.Wrap(errors.Wrapf(err, "database file %s is corrupted", datafile), exitcode.DatabaseCorrupted)
+		}
+		return nil, err
+	}
+	kv, err := newStore(boltDB, dirPath, stateSummaryCache)
+	if err != nil {
+		return nil, err
+	}
+	kv.readOnly = true
+	kv.stateSummaryLog, err = loadStateSummaryLog(kv.db)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not load state summary log")
+	}
+	return kv, nil
+}
+
+func newStore(boltDB *bolt.DB, dirPath string, stateSummaryCache *cache.StateSummaryCache) (*Store, error) {
+	blockCache, err := ristretto.NewCache(&ristretto.Config{
+		NumCounters: 1000,           // number of keys to track frequency of (1000).
+		MaxCost:     BlockCacheSize, // maximum cost of cache (1000 Blocks).
+		BufferItems: 64,             // number of keys per Get buffer.
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	validatorCache, err := ristretto.NewCache(&ristretto.Config{
+		NumCounters: NumOfVotes,     // number of keys to track frequency of (1M).
+		MaxCost:     VotesCacheSize, // maximum cost of cache (8MB).
+		BufferItems: 64,             // number of keys per Get buffer.
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Store{
+		db:                  boltDB,
+		databasePath:        dirPath,
+		blockCache:          blockCache,
+		validatorIndexCache: validatorCache,
+		stateSummaryCache:   stateSummaryCache,
+	}, nil
+}
+
 // ClearDB removes the previously stored database in the data directory.
 func (s *Store) ClearDB() error {
+	if s.readOnly {
+		return errors.New("cannot clear a read-only database")
+	}
 	if _, err := os.Stat(s.databasePath); os.IsNotExist(err) {
 		return nil
 	}
@@ -142,7 +218,13 @@ func (s *Store) ClearDB() error {
 
 // Close closes the underlying BoltDB database.
 func (s *Store) Close() error {
+	if s.readOnly {
+		return s.db.Close()
+	}
 	prometheus.Unregister(createBoltCollector(s.db))
+	if err := s.syncBarrier(); err != nil {
+		return errors.Wrap(err, "could not sync database before closing")
+	}
 	return s.db.Close()
 }
 
@@ -1,18 +1,36 @@
 package kv
 
 import (
+	"io/ioutil"
+	"path/filepath"
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/shared/exitcode"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 )
 
 // setupDB instantiates and returns a Store instance.
 func setupDB(t testing.TB) *Store {
-	db, err := NewKVStore(t.TempDir(), cache.NewStateSummaryCache())
+	db, err := NewKVStore(t.TempDir(), cache.NewStateSummaryCache(), nil)
 	require.NoError(t, err, "Failed to instantiate DB")
 	t.Cleanup(func() {
 		require.NoError(t, db.Close(), "Failed to close database")
 	})
 	return db
 }
+
+func TestNewKVStore_CorruptedDatabase(t *testing.T) {
+	dir := t.TempDir()
+	garbage := make([]byte, 8192)
+	for i := range garbage {
+		garbage[i] = byte(i)
+	}
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, databaseFileName), garbage, params.BeaconIoConfig().ReadWritePermissions))
+
+	_, err := NewKVStore(dir, cache.NewStateSummaryCache(), nil)
+	require.ErrorContains(t, "is corrupted", err)
+	assert.Equal(t, exitcode.DatabaseCorrupted, exitcode.FromError(err))
+}
@@ -0,0 +1,50 @@
+package kv
+
+import (
+	"context"
+
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// LocalOperations retrieves every voluntary exit and slashing submitted to this node
+// which is still awaiting inclusion.
+func (s *Store) LocalOperations(ctx context.Context) ([]*dbpb.LocalOperation, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.LocalOperations")
+	defer span.End()
+	ops := make([]*dbpb.LocalOperation, 0)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(localOperationsBucket).ForEach(func(k, v []byte) error {
+			op := &dbpb.LocalOperation{}
+			if err := decode(ctx, v, op); err != nil {
+				return err
+			}
+			ops = append(ops, op)
+			return nil
+		})
+	})
+	return ops, err
+}
+
+// SaveLocalOperation saves a locally submitted operation to the db by its hash tree root.
+func (s *Store) SaveLocalOperation(ctx context.Context, root [32]byte, op *dbpb.LocalOperation) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveLocalOperation")
+	defer span.End()
+	enc, err := encode(ctx, op)
+	if err != nil {
+		return err
+	}
+	return s.update(func(tx *bolt.Tx) error {
+		return tx.Bucket(localOperationsBucket).Put(root[:], enc)
+	})
+}
+
+// DeleteLocalOperation clears a locally submitted operation from the db by its hash tree root.
+func (s *Store) DeleteLocalOperation(ctx context.Context, root [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.DeleteLocalOperation")
+	defer span.End()
+	return s.update(func(tx *bolt.Tx) error {
+		return tx.Bucket(localOperationsBucket).Delete(root[:])
+	})
+}
@@ -0,0 +1,57 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_LocalOperations_CRUD(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	exit := &dbpb.LocalOperation{
+		Operation: &dbpb.LocalOperation_VoluntaryExit{
+			VoluntaryExit: &ethpb.SignedVoluntaryExit{
+				Exit:      &ethpb.VoluntaryExit{Epoch: 5, ValidatorIndex: 3},
+				Signature: make([]byte, 96),
+			},
+		},
+		SubmittedEpoch:    5,
+		BroadcastAttempts: 1,
+	}
+	slashing := &dbpb.LocalOperation{
+		Operation: &dbpb.LocalOperation_ProposerSlashing{
+			ProposerSlashing: &ethpb.ProposerSlashing{
+				Header_1: &ethpb.SignedBeaconBlockHeader{Header: &ethpb.BeaconBlockHeader{ProposerIndex: 2}},
+				Header_2: &ethpb.SignedBeaconBlockHeader{Header: &ethpb.BeaconBlockHeader{ProposerIndex: 2}},
+			},
+		},
+		SubmittedEpoch:    6,
+		BroadcastAttempts: 2,
+	}
+
+	ops, err := db.LocalOperations(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(ops))
+
+	require.NoError(t, db.SaveLocalOperation(ctx, [32]byte{'a'}, exit))
+	require.NoError(t, db.SaveLocalOperation(ctx, [32]byte{'b'}, slashing))
+	ops, err = db.LocalOperations(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(ops))
+	assert.Equal(t, true, proto.Equal(exit, ops[0]), "Wanted %v, received %v", exit, ops[0])
+	assert.Equal(t, true, proto.Equal(slashing, ops[1]), "Wanted %v, received %v", slashing, ops[1])
+
+	slashing.BroadcastAttempts++
+	require.NoError(t, db.SaveLocalOperation(ctx, [32]byte{'b'}, slashing))
+	require.NoError(t, db.DeleteLocalOperation(ctx, [32]byte{'a'}))
+	ops, err = db.LocalOperations(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(ops))
+	assert.Equal(t, uint64(3), ops[0].BroadcastAttempts)
+}
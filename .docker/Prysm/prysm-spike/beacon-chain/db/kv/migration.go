@@ -13,6 +13,7 @@ type migration func(*bolt.Tx) error
 var migrations = []migration{
 	migrateArchivedIndex,
 	migrateBlockSlotIndex,
+	migrateStateSummaryLog,
 }
 
 // RunMigrations defined in the migrations array.
@@ -26,5 +27,6 @@ func (s *Store) RunMigrations(ctx context.Context) error {
 			return err
 		}
 	}
-	return nil
+	// Migrations may have appended to the state summary log.
+	return s.stateSummaryLog.reload(s.db)
 }
@@ -0,0 +1,68 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	bolt "go.etcd.io/bbolt"
+)
+
+var migrationStateSummaryLog0Key = []byte("state_summary_log_0")
+
+// stateSummaryMigrationSegmentSize bounds the number of summaries in each migrated snapshot segment.
+const stateSummaryMigrationSegmentSize = 1 << 14
+
+// migrateStateSummaryLog moves the summaries of unfinalized slots out of the deprecated state
+// summary bucket into snapshot segments of the state summary log, ordered by slot. The finalized
+// summaries are left in the bucket, which lookups missing the log and the archive fall back to.
+func migrateStateSummaryLog(tx *bolt.Tx) error {
+	mb := tx.Bucket(migrationsBucket)
+	if b := mb.Get(migrationStateSummaryLog0Key); bytes.Equal(b, migrationCompleted) {
+		return nil // Migration already completed.
+	}
+
+	ctx := context.Background()
+	finalizedSlot, err := finalizedSlotInTx(ctx, tx)
+	if err != nil {
+		return err
+	}
+	bkt := tx.Bucket(stateSummaryBucket)
+	var entries []stateSummaryEntry
+	if err := bkt.ForEach(func(k, v []byte) error {
+		summary := &pb.StateSummary{}
+		if err := decode(ctx, v, summary); err != nil {
+			return err
+		}
+		if summary.Slot >= finalizedSlot {
+			entries = append(entries, stateSummaryEntry{slot: summary.Slot, root: bytesutil.ToBytes32(k)})
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].slot < entries[j].slot
+	})
+
+	logBkt := tx.Bucket(stateSummaryLogBucket)
+	for i := 0; i < len(entries); i += stateSummaryMigrationSegmentSize {
+		end := i + stateSummaryMigrationSegmentSize
+		if end > len(entries) {
+			end = len(entries)
+		}
+		enc := encodeStateSummarySegment(stateSummarySnapshotSegment, 0, entries[i:end])
+		if _, err := appendStateSummarySegment(logBkt, enc); err != nil {
+			return err
+		}
+	}
+	for _, e := range entries {
+		if err := bkt.Delete(e.root[:]); err != nil {
+			return err
+		}
+	}
+
+	return mb.Put(migrationStateSummaryLog0Key, migrationCompleted)
+}
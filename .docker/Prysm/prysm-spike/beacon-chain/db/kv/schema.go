@@ -7,21 +7,27 @@ package kv
 // it easy to scan for keys that have a certain shard number as a prefix and return those
 // corresponding attestations.
 var (
-	attestationsBucket      = []byte("attestations")
-	blocksBucket            = []byte("blocks")
-	stateBucket             = []byte("state")
-	stateSummaryBucket      = []byte("state-summary")
-	proposerSlashingsBucket = []byte("proposer-slashings")
-	attesterSlashingsBucket = []byte("attester-slashings")
-	voluntaryExitsBucket    = []byte("voluntary-exits")
-	chainMetadataBucket     = []byte("chain-metadata")
-	checkpointBucket        = []byte("check-point")
-	powchainBucket          = []byte("powchain")
+	attestationsBucket        = []byte("attestations")
+	blocksBucket              = []byte("blocks")
+	stateBucket               = []byte("state")
+	stateSummaryLogBucket     = []byte("state-summary-log")
+	stateSummaryArchiveBucket = []byte("state-summary-archive")
+	proposerSlashingsBucket   = []byte("proposer-slashings")
+	attesterSlashingsBucket   = []byte("attester-slashings")
+	voluntaryExitsBucket      = []byte("voluntary-exits")
+	localOperationsBucket     = []byte("local-operations")
+	chainMetadataBucket       = []byte("chain-metadata")
+	checkpointBucket          = []byte("check-point")
+	powchainBucket            = []byte("powchain")
+	seenAttestationsBucket    = []byte("seen-attestations")
 
 	// Deprecated: This bucket was migrated in PR 6461. Do not use, except for migrations.
 	slotsHasObjectBucket = []byte("slots-has-objects")
 	// Deprecated: This bucket was migrated in PR 6461. Do not use, except for migrations.
 	archivedRootBucket = []byte("archived-index-root")
+	// Deprecated: This bucket is migrated to the state summary log. Do not use, except for migrations
+	// and reading the finalized summaries which the migration left in it.
+	stateSummaryBucket = []byte("state-summary")
 
 	// Key indices buckets.
 	blockParentRootIndicesBucket        = []byte("block-parent-root-indices")
@@ -33,6 +39,10 @@ var (
 	attestationTargetRootIndicesBucket  = []byte("attestation-target-root-indices")
 	attestationTargetEpochIndicesBucket = []byte("attestation-target-epoch-indices")
 	finalizedBlockRootsIndexBucket      = []byte("finalized-block-roots-index")
+	depositBlockIndicesBucket           = []byte("deposit-block-indices")
+	voluntaryExitBlockIndicesBucket     = []byte("voluntary-exit-block-indices")
+	proposerSlashingBlockIndicesBucket  = []byte("proposer-slashing-block-indices")
+	attesterSlashingBlockIndicesBucket  = []byte("attester-slashing-block-indices")
 
 	// Specific item keys.
 	headBlockRootKey          = []byte("head-root")
@@ -41,6 +51,7 @@ var (
 	justifiedCheckpointKey    = []byte("justified-checkpoint")
 	finalizedCheckpointKey    = []byte("finalized-checkpoint")
 	powchainDataKey           = []byte("powchain-data")
+	initialSyncProgressKey    = []byte("initial-sync-progress")
 
 	// Deprecated: This index key was migrated in PR 6461. Do not use, except for migrations.
 	lastArchivedIndexKey = []byte("last-archived")
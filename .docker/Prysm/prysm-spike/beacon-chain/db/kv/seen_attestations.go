@@ -0,0 +1,68 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// SeenAttestations retrieves the digests of the attestations seen on the wire from the epoch
+// onwards, as saved by SaveSeenAttestations.
+func (s *Store) SeenAttestations(ctx context.Context, startEpoch uint64) ([][]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SeenAttestations")
+	defer span.End()
+
+	var digests [][]byte
+	err := s.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(seenAttestationsBucket).Cursor()
+		for k, _ := c.Seek(bytesutil.Uint64ToBytesBigEndian(startEpoch)); k != nil; k, _ = c.Next() {
+			digests = append(digests, append([]byte{}, k[8:]...))
+		}
+		return nil
+	})
+	return digests, err
+}
+
+// SaveSeenAttestations saves the digests of attestations seen on the wire during the epoch, so a
+// restarted node keeps ignoring their duplicates.
+func (s *Store) SaveSeenAttestations(ctx context.Context, epoch uint64, digests [][]byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveSeenAttestations")
+	defer span.End()
+
+	prefix := bytesutil.Uint64ToBytesBigEndian(epoch)
+	return s.update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(seenAttestationsBucket)
+		for _, d := range digests {
+			if err := bkt.Put(append(prefix[:8:8], d...), []byte{}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// DeleteSeenAttestationsBefore deletes the digests of the attestations seen during the epochs
+// before the given one.
+func (s *Store) DeleteSeenAttestationsBefore(ctx context.Context, epoch uint64) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.DeleteSeenAttestationsBefore")
+	defer span.End()
+
+	end := bytesutil.Uint64ToBytesBigEndian(epoch)
+	return s.update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(seenAttestationsBucket)
+		var keys [][]byte
+		c := bkt.Cursor()
+		for k, _ := c.First(); k != nil && bytes.Compare(k[:8], end) < 0; k, _ = c.Next() {
+			keys = append(keys, k)
+		}
+		for _, k := range keys {
+			if err := bkt.Delete(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
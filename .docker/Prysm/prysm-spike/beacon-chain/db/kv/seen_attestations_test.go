@@ -0,0 +1,27 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_SeenAttestations(t *testing.T) {
+	store := setupDB(t)
+	ctx := context.Background()
+
+	require.NoError(t, store.SaveSeenAttestations(ctx, 3, [][]byte{{'a'}, {'b'}}))
+	require.NoError(t, store.SaveSeenAttestations(ctx, 4, [][]byte{{'c'}}))
+	require.NoError(t, store.SaveSeenAttestations(ctx, 300, [][]byte{{'d'}}))
+
+	digests, err := store.SeenAttestations(ctx, 4)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][]byte{{'c'}, {'d'}}, digests, "Digests should be ordered by epoch")
+
+	require.NoError(t, store.DeleteSeenAttestationsBefore(ctx, 4))
+	digests, err = store.SeenAttestations(ctx, 0)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][]byte{{'c'}, {'d'}}, digests)
+}
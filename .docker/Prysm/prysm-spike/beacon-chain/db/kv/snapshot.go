@@ -0,0 +1,64 @@
+package kv
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	log "github.com/sirupsen/logrus"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// Snapshot writes a consistent copy of the database to the directory, for read-only replicas to
+// open with NewKVStoreReadOnly. The copy is written next to the previous snapshot and renamed
+// over it, so a replica never opens a partially written snapshot. The copy is read in a single
+// read transaction, which does not block writes to the database.
+func (s *Store) Snapshot(ctx context.Context, dir string) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.Snapshot")
+	defer span.End()
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := fileutil.MkdirAll(dir); err != nil {
+		return err
+	}
+	tmpFile, err := ioutil.TempFile(dir, databaseFileName+".tmp")
+	if err != nil {
+		return errors.Wrap(err, "could not create snapshot file")
+	}
+	defer func() {
+		if err := os.Remove(tmpFile.Name()); err != nil && !os.IsNotExist(err) {
+			log.WithError(err).Error("Could not remove temporary snapshot file")
+		}
+	}()
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		_, err := tx.WriteTo(tmpFile)
+		return err
+	}); err != nil {
+		if closeErr := tmpFile.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Could not close temporary snapshot file")
+		}
+		return errors.Wrap(err, "could not write snapshot")
+	}
+	if err := tmpFile.Sync(); err != nil {
+		return errors.Wrap(err, "could not sync snapshot")
+	}
+	if err := tmpFile.Close(); err != nil {
+		return errors.Wrap(err, "could not close snapshot")
+	}
+	return os.Rename(tmpFile.Name(), SnapshotPath(dir))
+}
+
+// SnapshotPath returns the path of the database file Snapshot writes to the directory.
+func SnapshotPath(dir string) string {
+	return DatabaseFilePath(dir)
+}
+
+// DatabaseFilePath returns the path of the database file in the database directory.
+func DatabaseFilePath(dir string) string {
+	return path.Join(dir, databaseFileName)
+}
@@ -0,0 +1,46 @@
+package kv
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_Snapshot(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	head := testutil.NewBeaconBlock()
+	head.Block.Slot = 5000
+	require.NoError(t, db.SaveBlock(ctx, head))
+	root, err := head.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, testutil.NewBeaconState(), root))
+	require.NoError(t, db.SaveHeadBlockRoot(ctx, root))
+
+	dir := filepath.Join(t.TempDir(), "replica")
+	require.NoError(t, db.Snapshot(ctx, dir))
+	// A second snapshot replaces the first one.
+	require.NoError(t, db.Snapshot(ctx, dir))
+
+	replica, err := NewKVStoreReadOnly(dir, cache.NewStateSummaryCache())
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, replica.Close())
+	}()
+	replicaHead, err := replica.HeadBlock(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, head, replicaHead)
+	assert.NotNil(t, replica.SaveBlock(ctx, testutil.NewBeaconBlock()), "Expected writes to a read-only database to fail")
+	assert.ErrorContains(t, "read-only", replica.ClearDB())
+}
+
+func TestNewKVStoreReadOnly_NoDatabase(t *testing.T) {
+	_, err := NewKVStoreReadOnly(t.TempDir(), cache.NewStateSummaryCache())
+	assert.ErrorContains(t, "no database", err)
+}
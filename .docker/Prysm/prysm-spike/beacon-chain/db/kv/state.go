@@ -91,7 +91,7 @@ func (s *Store) SaveStates(ctx context.Context, states []*state.BeaconState, blo
 		}
 	}
 
-	return s.db.Update(func(tx *bolt.Tx) error {
+	return s.update(func(tx *bolt.Tx) error {
 		bucket := tx.Bucket(stateBucket)
 		for i, rt := range blockRoots {
 			indicesByBucket := createStateIndicesFromStateSlot(ctx, states[i].Slot())
@@ -122,7 +122,7 @@ func (s *Store) DeleteState(ctx context.Context, blockRoot [32]byte) error {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.DeleteState")
 	defer span.End()
 
-	return s.db.Update(func(tx *bolt.Tx) error {
+	return s.update(func(tx *bolt.Tx) error {
 		bkt := tx.Bucket(blocksBucket)
 		genesisBlockRoot := bkt.Get(genesisBlockRootKey)
 
@@ -143,7 +143,7 @@ func (s *Store) DeleteState(ctx context.Context, blockRoot [32]byte) error {
 			return errors.New("cannot delete genesis, finalized, or head state")
 		}
 
-		slot, err := slotByBlockRoot(ctx, tx, blockRoot[:])
+		slot, err := s.slotByBlockRoot(ctx, tx, blockRoot[:])
 		if err != nil {
 			return err
 		}
@@ -193,10 +193,13 @@ func (s *Store) stateBytes(ctx context.Context, blockRoot [32]byte) ([]byte, err
 }
 
 // slotByBlockRoot retrieves the corresponding slot of the input block root.
-func slotByBlockRoot(ctx context.Context, tx *bolt.Tx, blockRoot []byte) (uint64, error) {
+func (s *Store) slotByBlockRoot(ctx context.Context, tx *bolt.Tx, blockRoot []byte) (uint64, error) {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.slotByBlockRoot")
 	defer span.End()
 
+	if slot, ok := s.stateSummaryLog.slot(bytesutil.ToBytes32(blockRoot)); ok {
+		return slot, nil
+	}
 	bkt := tx.Bucket(stateSummaryBucket)
 	enc := bkt.Get(blockRoot)
 
@@ -212,14 +215,14 @@ func slotByBlockRoot(ctx context.Context, tx *bolt.Tx, blockRoot []byte) (uint64
 			if enc == nil {
 				return 0, errors.New("state enc can't be nil")
 			}
-			s, err := createState(ctx, enc)
+			st, err := createState(ctx, enc)
 			if err != nil {
 				return 0, err
 			}
-			if s == nil {
+			if st == nil {
 				return 0, errors.New("state can't be nil")
 			}
-			return s.Slot, nil
+			return st.Slot, nil
 		}
 		b := &ethpb.SignedBeaconBlock{}
 		err := decode(ctx, enc, b)
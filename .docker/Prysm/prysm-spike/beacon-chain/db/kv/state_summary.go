@@ -3,7 +3,9 @@ package kv
 import (
 	"context"
 
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	bolt "go.etcd.io/bbolt"
 	"go.opencensus.io/trace"
 )
@@ -21,60 +23,131 @@ func (s *Store) SaveStateSummaries(ctx context.Context, summaries []*pb.StateSum
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveStateSummaries")
 	defer span.End()
 
-	return s.db.Update(func(tx *bolt.Tx) error {
-		bucket := tx.Bucket(stateSummaryBucket)
-		for _, summary := range summaries {
-			enc, err := encode(ctx, summary)
-			if err != nil {
-				return err
-			}
-			if err := bucket.Put(summary.Root, enc); err != nil {
-				return err
-			}
-		}
-		return nil
-	})
+	entries := make([]stateSummaryEntry, len(summaries))
+	for i, summary := range summaries {
+		entries[i] = stateSummaryEntry{slot: summary.Slot, root: bytesutil.ToBytes32(summary.Root)}
+	}
+
+	l := s.stateSummaryLog
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	var added []stateSummaryEntry
+	var tail stateSummaryLogTail
+	if err := s.update(func(tx *bolt.Tx) error {
+		var err error
+		added, tail, err = l.save(tx, entries)
+		return err
+	}); err != nil {
+		return err
+	}
+	l.commit(added, tail)
+	return nil
+}
+
+// archiveStateSummaries moves the summaries of slots before the finalized slot out of the state
+// summary log and its index, into the archive.
+func (s *Store) archiveStateSummaries(ctx context.Context, finalizedSlot uint64) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.archiveStateSummaries")
+	defer span.End()
+
+	l := s.stateSummaryLog
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	var kept []stateSummaryEntry
+	var archived bool
+	if err := s.update(func(tx *bolt.Tx) error {
+		var err error
+		kept, archived, err = l.archive(tx, finalizedSlot)
+		return err
+	}); err != nil {
+		return err
+	}
+	if archived {
+		l.reset(kept)
+	}
+	if finalizedSlot > l.finalizedSlot {
+		l.finalizedSlot = finalizedSlot
+	}
+	return nil
 }
 
 // StateSummary returns the state summary object from the db using input block root.
 func (s *Store) StateSummary(ctx context.Context, blockRoot [32]byte) (*pb.StateSummary, error) {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.StateSummary")
 	defer span.End()
-	enc, err := s.stateSummaryBytes(ctx, blockRoot)
-	if err != nil {
-		return nil, err
+	if slot, ok := s.stateSummaryLog.slot(blockRoot); ok {
+		return &pb.StateSummary{Slot: slot, Root: blockRoot[:]}, nil
 	}
-	if len(enc) == 0 {
-		return nil, nil
-	}
-	summary := &pb.StateSummary{}
-	if err := decode(ctx, enc, summary); err != nil {
-		return nil, err
-	}
-	return summary, nil
+	var summary *pb.StateSummary
+	err := s.db.View(func(tx *bolt.Tx) error {
+		var err error
+		summary, err = s.finalizedStateSummary(ctx, tx, blockRoot)
+		return err
+	})
+	return summary, err
 }
 
 // HasStateSummary returns true if a state summary exists in DB.
 func (s *Store) HasStateSummary(ctx context.Context, blockRoot [32]byte) bool {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.HasStateSummary")
 	defer span.End()
-	enc, err := s.stateSummaryBytes(ctx, blockRoot)
-	if err != nil {
+	if _, ok := s.stateSummaryLog.slot(blockRoot); ok {
+		return true
+	}
+	var summary *pb.StateSummary
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		var err error
+		summary, err = s.finalizedStateSummary(ctx, tx, blockRoot)
+		return err
+	}); err != nil {
 		panic(err)
 	}
-	return len(enc) > 0
+	return summary != nil
 }
 
-func (s *Store) stateSummaryBytes(ctx context.Context, blockRoot [32]byte) ([]byte, error) {
-	ctx, span := trace.StartSpan(ctx, "BeaconDB.stateSummaryBytes")
-	defer span.End()
+// hasStateSummaryInDB returns true if a state summary exists in the log, the archive or, for the
+// finalized summaries which were not migrated, in the state summary bucket.
+func (s *Store) hasStateSummaryInDB(tx *bolt.Tx, blockRoot []byte) bool {
+	if _, ok := s.stateSummaryLog.slot(bytesutil.ToBytes32(blockRoot)); ok {
+		return true
+	}
+	summary, err := s.finalizedStateSummary(context.Background(), tx, bytesutil.ToBytes32(blockRoot))
+	return err == nil && summary != nil
+}
 
-	var enc []byte
-	err := s.db.View(func(tx *bolt.Tx) error {
-		bucket := tx.Bucket(stateSummaryBucket)
-		enc = bucket.Get(blockRoot[:])
-		return nil
-	})
+// finalizedStateSummary returns a summary which is not in the log: a finalized summary which was
+// not migrated out of the state summary bucket, or an archived summary, found from the slot of its
+// block. It returns nil if there is no such summary.
+func (s *Store) finalizedStateSummary(ctx context.Context, tx *bolt.Tx, blockRoot [32]byte) (*pb.StateSummary, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.finalizedStateSummary")
+	defer span.End()
 
-	return enc, err
+	if enc := tx.Bucket(stateSummaryBucket).Get(blockRoot[:]); enc != nil {
+		summary := &pb.StateSummary{}
+		if err := decode(ctx, enc, summary); err != nil {
+			return nil, err
+		}
+		return summary, nil
+	}
+	var block *ethpb.SignedBeaconBlock
+	if v, ok := s.blockCache.Get(string(blockRoot[:])); v != nil && ok {
+		block = v.(*ethpb.SignedBeaconBlock)
+	} else {
+		enc := tx.Bucket(blocksBucket).Get(blockRoot[:])
+		if enc == nil {
+			return nil, nil
+		}
+		block = &ethpb.SignedBeaconBlock{}
+		if err := decode(ctx, enc, block); err != nil {
+			return nil, err
+		}
+	}
+	if block.Block == nil {
+		return nil, nil
+	}
+	ok, err := hasArchivedStateSummary(tx, block.Block.Slot, blockRoot)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return &pb.StateSummary{Slot: block.Block.Slot, Root: blockRoot[:]}, nil
 }
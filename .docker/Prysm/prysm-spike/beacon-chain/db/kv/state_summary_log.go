@@ -0,0 +1,368 @@
+package kv
+
+import (
+	"context"
+	"encoding/binary"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	bolt "go.etcd.io/bbolt"
+)
+
+// State summaries are persisted as an append-only log rather than as one row per block root.
+// Every write appends a segment keyed by a big endian sequence number, which bolt appends to the
+// last page instead of splitting pages across the tree as random root keys do. A segment encodes
+// its summaries as slot deltas followed by the block root:
+//
+//	[kind: 1 byte][count: uvarint]([slot delta: varint][root: 32 bytes])*count
+//
+// The first slot of a delta segment is relative to the last slot of the previous segment, while
+// the first slot of a snapshot segment is absolute. Once stateSummarySnapshotInterval delta
+// segments accumulated, they are compacted into a single snapshot segment, which bounds both the
+// number of keys and the length of the chain of segments decoding depends on.
+//
+// Lookups are served from an in-memory index of the logged summaries, rebuilt from the log at
+// startup. At finalization the summaries of finalized slots are archived: they are merged into
+// the archive, which holds a single snapshot segment per span of stateSummaryArchiveSpan slots
+// keyed by its big endian start slot, and the log is rewritten as a single snapshot of the
+// others. Summaries of finalized slots saved later are merged into the archive directly. An
+// archived summary is found from the slot of its block, so the log and the index are bounded by
+// the number of unfinalized summaries without writing a row per block root.
+const (
+	stateSummarySnapshotSegment byte = 1
+	stateSummaryDeltaSegment    byte = 2
+
+	stateSummarySnapshotInterval = 64
+
+	stateSummaryArchiveSpan = 256
+)
+
+var errInvalidStateSummarySegment = errors.New("invalid state summary log segment")
+
+type stateSummaryEntry struct {
+	slot uint64
+	root [32]byte
+}
+
+// stateSummaryLog is the in-memory index of the state summary log.
+type stateSummaryLog struct {
+	lock          sync.RWMutex
+	slots         map[[32]byte]uint64
+	tail          stateSummaryLogTail
+	finalizedSlot uint64 // Summaries of slots before it are archived.
+}
+
+// stateSummaryLogTail tracks the end of the log, where segments are appended.
+type stateSummaryLogTail struct {
+	lastSlot     uint64   // Last slot of the last segment, the base of the next delta segment.
+	pendingBase  uint64   // Base of the first delta segment since the last snapshot.
+	pendingKeys  [][]byte // Keys of the delta segments since the last snapshot.
+	pendingCount int      // Number of summaries in the delta segments since the last snapshot.
+}
+
+// loadStateSummaryLog builds the index by replaying every segment of the log.
+func loadStateSummaryLog(db *bolt.DB) (*stateSummaryLog, error) {
+	l := &stateSummaryLog{}
+	if err := l.reload(db); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+func (l *stateSummaryLog) reload(db *bolt.DB) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.slots = make(map[[32]byte]uint64)
+	l.tail = stateSummaryLogTail{}
+	return db.View(func(tx *bolt.Tx) error {
+		finalizedSlot, err := finalizedSlotInTx(context.Background(), tx)
+		if err != nil {
+			return err
+		}
+		l.finalizedSlot = finalizedSlot
+		return tx.Bucket(stateSummaryLogBucket).ForEach(func(k, v []byte) error {
+			kind, entries, err := decodeStateSummarySegment(v, l.tail.lastSlot)
+			if err != nil {
+				return errors.Wrapf(err, "could not decode segment %d", bytesutil.BytesToUint64BigEndian(k))
+			}
+			if kind == stateSummarySnapshotSegment {
+				l.tail.pendingKeys = nil
+				l.tail.pendingCount = 0
+			} else {
+				if len(l.tail.pendingKeys) == 0 {
+					l.tail.pendingBase = l.tail.lastSlot
+				}
+				l.tail.pendingKeys = append(l.tail.pendingKeys, bytesutil.SafeCopyBytes(k))
+				l.tail.pendingCount += len(entries)
+			}
+			l.commit(entries, l.tail)
+			return nil
+		})
+	})
+}
+
+// slot returns the slot of the summary with the block root, if it was logged.
+func (l *stateSummaryLog) slot(blockRoot [32]byte) (uint64, bool) {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+	slot, ok := l.slots[blockRoot]
+	return slot, ok
+}
+
+// save appends the summaries not logged yet in a new segment, compacting the delta segments into a
+// snapshot once there are enough of them. Summaries of finalized slots are archived instead. It
+// returns the appended entries and the resulting tail, which the caller passes to commit once the
+// transaction committed. The caller must hold the lock.
+func (l *stateSummaryLog) save(tx *bolt.Tx, entries []stateSummaryEntry) ([]stateSummaryEntry, stateSummaryLogTail, error) {
+	tail := l.tail
+	added := make([]stateSummaryEntry, 0, len(entries))
+	var finalized []stateSummaryEntry
+	seen := make(map[[32]byte]bool, len(entries))
+	for _, e := range entries {
+		if slot, ok := l.slots[e.root]; (ok && slot == e.slot) || seen[e.root] {
+			continue
+		}
+		seen[e.root] = true
+		if e.slot < l.finalizedSlot {
+			finalized = append(finalized, e)
+			continue
+		}
+		added = append(added, e)
+	}
+	if err := mergeArchivedStateSummaries(tx, finalized); err != nil {
+		return nil, tail, err
+	}
+	if len(added) == 0 {
+		return nil, tail, nil
+	}
+	bkt := tx.Bucket(stateSummaryLogBucket)
+	if len(tail.pendingKeys)+1 < stateSummarySnapshotInterval {
+		key, err := appendStateSummarySegment(bkt, encodeStateSummarySegment(stateSummaryDeltaSegment, tail.lastSlot, added))
+		if err != nil {
+			return nil, tail, err
+		}
+		if len(tail.pendingKeys) == 0 {
+			tail.pendingBase = tail.lastSlot
+		}
+		tail.pendingKeys = append(tail.pendingKeys[:len(tail.pendingKeys):len(tail.pendingKeys)], key)
+		tail.pendingCount += len(added)
+		return added, tail, nil
+	}
+
+	// Compact the pending delta segments and the new summaries into a snapshot.
+	snapshot := make([]stateSummaryEntry, 0, tail.pendingCount+len(added))
+	base := tail.pendingBase
+	for _, k := range tail.pendingKeys {
+		_, entries, err := decodeStateSummarySegment(bkt.Get(k), base)
+		if err != nil {
+			return nil, tail, err
+		}
+		if len(entries) > 0 {
+			base = entries[len(entries)-1].slot
+		}
+		snapshot = append(snapshot, entries...)
+		if err := bkt.Delete(k); err != nil {
+			return nil, tail, err
+		}
+	}
+	snapshot = append(snapshot, added...)
+	if _, err := appendStateSummarySegment(bkt, encodeStateSummarySegment(stateSummarySnapshotSegment, 0, snapshot)); err != nil {
+		return nil, tail, err
+	}
+	tail.pendingKeys = nil
+	tail.pendingCount = 0
+	return added, tail, nil
+}
+
+// commit adds logged entries to the index and moves the tail. The caller must hold the lock.
+func (l *stateSummaryLog) commit(entries []stateSummaryEntry, tail stateSummaryLogTail) {
+	for _, e := range entries {
+		l.slots[e.root] = e.slot
+	}
+	if len(entries) > 0 {
+		tail.lastSlot = entries[len(entries)-1].slot
+	}
+	l.tail = tail
+}
+
+// archive moves the summaries of slots before the finalized slot from the log into the archive,
+// and rewrites the log as a snapshot of the remaining summaries. It returns the remaining summaries
+// sorted by slot, and false if there was nothing to archive. The caller must hold the lock, and
+// pass the remaining summaries to reset once the transaction committed.
+func (l *stateSummaryLog) archive(tx *bolt.Tx, finalizedSlot uint64) ([]stateSummaryEntry, bool, error) {
+	var archived, kept []stateSummaryEntry
+	for root, slot := range l.slots {
+		if slot < finalizedSlot {
+			archived = append(archived, stateSummaryEntry{slot: slot, root: root})
+		} else {
+			kept = append(kept, stateSummaryEntry{slot: slot, root: root})
+		}
+	}
+	if len(archived) == 0 {
+		return nil, false, nil
+	}
+	if err := mergeArchivedStateSummaries(tx, archived); err != nil {
+		return nil, false, err
+	}
+
+	logBkt := tx.Bucket(stateSummaryLogBucket)
+	c := logBkt.Cursor()
+	for k, _ := c.First(); k != nil; k, _ = c.First() {
+		if err := c.Delete(); err != nil {
+			return nil, false, err
+		}
+	}
+	sort.Slice(kept, func(i, j int) bool {
+		return kept[i].slot < kept[j].slot
+	})
+	if len(kept) > 0 {
+		if _, err := appendStateSummarySegment(logBkt, encodeStateSummarySegment(stateSummarySnapshotSegment, 0, kept)); err != nil {
+			return nil, false, err
+		}
+	}
+	return kept, true, nil
+}
+
+// reset replaces the index with the summaries of a log rewritten as a single snapshot. The caller
+// must hold the lock.
+func (l *stateSummaryLog) reset(entries []stateSummaryEntry) {
+	l.slots = make(map[[32]byte]uint64, len(entries))
+	l.commit(entries, stateSummaryLogTail{})
+}
+
+// mergeArchivedStateSummaries merges the summaries into the archive snapshots of their spans of
+// slots. A span is rewritten once per finalization touching it, which keeps a single key per span.
+func mergeArchivedStateSummaries(tx *bolt.Tx, entries []stateSummaryEntry) error {
+	spans := make(map[uint64][]stateSummaryEntry)
+	for _, e := range entries {
+		start := e.slot - e.slot%stateSummaryArchiveSpan
+		spans[start] = append(spans[start], e)
+	}
+	bkt := tx.Bucket(stateSummaryArchiveBucket)
+	for start, added := range spans {
+		key := bytesutil.Uint64ToBytesBigEndian(start)
+		var merged []stateSummaryEntry
+		if enc := bkt.Get(key); enc != nil {
+			_, archived, err := decodeStateSummarySegment(enc, 0)
+			if err != nil {
+				return errors.Wrapf(err, "could not decode archived summaries from slot %d", start)
+			}
+			seen := make(map[[32]byte]bool, len(added))
+			for _, e := range added {
+				seen[e.root] = true
+			}
+			for _, e := range archived {
+				if !seen[e.root] {
+					merged = append(merged, e)
+				}
+			}
+		}
+		merged = append(merged, added...)
+		sort.Slice(merged, func(i, j int) bool {
+			return merged[i].slot < merged[j].slot
+		})
+		if err := bkt.Put(key, encodeStateSummarySegment(stateSummarySnapshotSegment, 0, merged)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// hasArchivedStateSummary returns whether the archive holds the summary of the block root at slot.
+func hasArchivedStateSummary(tx *bolt.Tx, slot uint64, blockRoot [32]byte) (bool, error) {
+	start := slot - slot%stateSummaryArchiveSpan
+	enc := tx.Bucket(stateSummaryArchiveBucket).Get(bytesutil.Uint64ToBytesBigEndian(start))
+	if enc == nil {
+		return false, nil
+	}
+	_, entries, err := decodeStateSummarySegment(enc, 0)
+	if err != nil {
+		return false, errors.Wrapf(err, "could not decode archived summaries from slot %d", start)
+	}
+	for _, e := range entries {
+		if e.slot == slot && e.root == blockRoot {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// finalizedSlotInTx returns the start slot of the finalized checkpoint, or 0 before finality.
+func finalizedSlotInTx(ctx context.Context, tx *bolt.Tx) (uint64, error) {
+	enc := tx.Bucket(checkpointBucket).Get(finalizedCheckpointKey)
+	if enc == nil {
+		return 0, nil
+	}
+	checkpoint := &ethpb.Checkpoint{}
+	if err := decode(ctx, enc, checkpoint); err != nil {
+		return 0, err
+	}
+	return helpers.StartSlot(checkpoint.Epoch)
+}
+
+func appendStateSummarySegment(bkt *bolt.Bucket, enc []byte) ([]byte, error) {
+	seq, err := bkt.NextSequence()
+	if err != nil {
+		return nil, err
+	}
+	key := bytesutil.Uint64ToBytesBigEndian(seq)
+	return key, bkt.Put(key, enc)
+}
+
+func encodeStateSummarySegment(kind byte, base uint64, entries []stateSummaryEntry) []byte {
+	enc := make([]byte, 1+binary.MaxVarintLen64, 1+binary.MaxVarintLen64+len(entries)*(binary.MaxVarintLen64+32))
+	enc[0] = kind
+	enc = enc[:1+binary.PutUvarint(enc[1:], uint64(len(entries)))]
+	var buf [binary.MaxVarintLen64]byte
+	prev := base
+	for _, e := range entries {
+		enc = append(enc, buf[:binary.PutVarint(buf[:], int64(e.slot-prev))]...)
+		enc = append(enc, e.root[:]...)
+		prev = e.slot
+	}
+	return enc
+}
+
+// decodeStateSummarySegment decodes a segment, using base as the slot preceding a delta segment.
+func decodeStateSummarySegment(enc []byte, base uint64) (byte, []stateSummaryEntry, error) {
+	if len(enc) == 0 {
+		return 0, nil, errInvalidStateSummarySegment
+	}
+	kind := enc[0]
+	switch kind {
+	case stateSummarySnapshotSegment:
+		base = 0
+	case stateSummaryDeltaSegment:
+	default:
+		return 0, nil, errInvalidStateSummarySegment
+	}
+	count, n := binary.Uvarint(enc[1:])
+	if n <= 0 {
+		return 0, nil, errInvalidStateSummarySegment
+	}
+	enc = enc[1+n:]
+	// Every entry takes at least a byte of delta and the root.
+	if count > uint64(len(enc)/33) {
+		return 0, nil, errInvalidStateSummarySegment
+	}
+	entries := make([]stateSummaryEntry, count)
+	prev := base
+	for i := range entries {
+		delta, n := binary.Varint(enc)
+		if n <= 0 || len(enc) < n+32 {
+			return 0, nil, errInvalidStateSummarySegment
+		}
+		prev += uint64(delta)
+		entries[i].slot = prev
+		copy(entries[i].root[:], enc[n:n+32])
+		enc = enc[n+32:]
+	}
+	if len(enc) != 0 {
+		return 0, nil, errInvalidStateSummarySegment
+	}
+	return kind, entries, nil
+}
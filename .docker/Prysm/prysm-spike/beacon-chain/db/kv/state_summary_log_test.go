@@ -0,0 +1,195 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+func countLogSegments(t *testing.T, db *Store) int {
+	count := 0
+	require.NoError(t, db.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(stateSummaryLogBucket).ForEach(func(k, v []byte) error {
+			count++
+			return nil
+		})
+	}))
+	return count
+}
+
+func TestStateSummarySegment_EncodeDecode(t *testing.T) {
+	entries := []stateSummaryEntry{
+		{slot: 105, root: [32]byte{'a'}},
+		{slot: 104, root: [32]byte{'b'}},
+		{slot: 1 << 40, root: [32]byte{'c'}},
+	}
+	enc := encodeStateSummarySegment(stateSummaryDeltaSegment, 100, entries)
+	kind, decoded, err := decodeStateSummarySegment(enc, 100)
+	require.NoError(t, err)
+	assert.Equal(t, stateSummaryDeltaSegment, kind)
+	assert.DeepEqual(t, entries, decoded)
+
+	// Snapshots do not depend on the previous segment.
+	enc = encodeStateSummarySegment(stateSummarySnapshotSegment, 0, entries)
+	_, decoded, err = decodeStateSummarySegment(enc, 999)
+	require.NoError(t, err)
+	assert.DeepEqual(t, entries, decoded)
+
+	_, _, err = decodeStateSummarySegment(enc[:len(enc)-1], 0)
+	assert.ErrorContains(t, errInvalidStateSummarySegment.Error(), err)
+}
+
+func TestStateSummaryLog_CompactsAndReloads(t *testing.T) {
+	dir := t.TempDir()
+	db, err := NewKVStore(dir, nil, nil)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	total := stateSummarySnapshotInterval + 3
+	for i := 0; i < total; i++ {
+		r := bytesutil.ToBytes32(bytesutil.Bytes8(uint64(i)))
+		require.NoError(t, db.SaveStateSummary(ctx, &pb.StateSummary{Slot: uint64(i), Root: r[:]}))
+	}
+	// Saving a known summary again does not append a segment.
+	r := bytesutil.ToBytes32(bytesutil.Bytes8(0))
+	require.NoError(t, db.SaveStateSummary(ctx, &pb.StateSummary{Slot: 0, Root: r[:]}))
+	assert.Equal(t, 4, countLogSegments(t, db), "Expected a snapshot followed by 3 delta segments")
+	require.NoError(t, db.Close())
+
+	db, err = NewKVStore(dir, nil, nil)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+	for i := 0; i < total; i++ {
+		r := bytesutil.ToBytes32(bytesutil.Bytes8(uint64(i)))
+		summary, err := db.StateSummary(ctx, r)
+		require.NoError(t, err)
+		require.NotNil(t, summary)
+		assert.Equal(t, uint64(i), summary.Slot)
+	}
+	// Appending after a reload keeps deltas relative to the last logged slot.
+	r = bytesutil.ToBytes32([]byte{'z'})
+	require.NoError(t, db.SaveStateSummary(ctx, &pb.StateSummary{Slot: 7, Root: r[:]}))
+	require.NoError(t, db.stateSummaryLog.reload(db.db))
+	summary, err := db.StateSummary(ctx, r)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7), summary.Slot)
+}
+
+func TestStateSummaryLog_MigratesDeprecatedBucket(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	r1 := bytesutil.ToBytes32([]byte{'A'})
+	r2 := bytesutil.ToBytes32([]byte{'B'})
+	finalizedSlot := params.BeaconConfig().SlotsPerEpoch
+	require.NoError(t, db.db.Update(func(tx *bolt.Tx) error {
+		enc, err := encode(ctx, &ethpb.Checkpoint{Epoch: 1})
+		if err != nil {
+			return err
+		}
+		if err := tx.Bucket(checkpointBucket).Put(finalizedCheckpointKey, enc); err != nil {
+			return err
+		}
+		for _, summary := range []*pb.StateSummary{{Slot: finalizedSlot + 1, Root: r2[:]}, {Slot: 1, Root: r1[:]}} {
+			enc, err := encode(ctx, summary)
+			if err != nil {
+				return err
+			}
+			if err := tx.Bucket(stateSummaryBucket).Put(summary.Root, enc); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+
+	// Summaries which were not migrated yet can still be read.
+	require.Equal(t, true, db.HasStateSummary(ctx, r1))
+	summary, err := db.StateSummary(ctx, r2)
+	require.NoError(t, err)
+	assert.Equal(t, finalizedSlot+1, summary.Slot)
+
+	// Only the unfinalized summary moves to the log.
+	require.NoError(t, db.RunMigrations(ctx))
+	assert.Equal(t, 1, countLogSegments(t, db))
+	assert.Equal(t, 1, len(db.stateSummaryLog.slots))
+	require.NoError(t, db.db.View(func(tx *bolt.Tx) error {
+		assert.Equal(t, 1, tx.Bucket(stateSummaryBucket).Stats().KeyN)
+		assert.NotNil(t, tx.Bucket(stateSummaryBucket).Get(r1[:]))
+		return nil
+	}))
+	summary, err = db.StateSummary(ctx, r1)
+	require.NoError(t, err)
+	assert.DeepEqual(t, &pb.StateSummary{Slot: 1, Root: r1[:]}, summary)
+	require.Equal(t, true, db.HasStateSummary(ctx, r2))
+}
+
+func TestStateSummaryLog_ArchivesFinalizedSummaries(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	roots := make([][32]byte, 0, stateSummaryArchiveSpan+1)
+	for i := uint64(0); i <= stateSummaryArchiveSpan; i++ {
+		blk := testutil.NewBeaconBlock()
+		blk.Block.Slot = i
+		r, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, db.SaveBlock(ctx, blk))
+		roots = append(roots, r)
+	}
+	for i := uint64(0); i < 100; i++ {
+		require.NoError(t, db.SaveStateSummary(ctx, &pb.StateSummary{Slot: i, Root: roots[i][:]}))
+	}
+
+	require.NoError(t, db.archiveStateSummaries(ctx, 64))
+	assert.Equal(t, 1, countLogSegments(t, db), "Expected the log to be rewritten as a single snapshot")
+	assert.Equal(t, 36, len(db.stateSummaryLog.slots))
+	// Archived summaries are read from the archive, with a single key for their span of slots.
+	countArchiveKeys := func() int {
+		count := 0
+		require.NoError(t, db.db.View(func(tx *bolt.Tx) error {
+			count = tx.Bucket(stateSummaryArchiveBucket).Stats().KeyN
+			assert.Equal(t, 0, tx.Bucket(stateSummaryBucket).Stats().KeyN)
+			return nil
+		}))
+		return count
+	}
+	assert.Equal(t, 1, countArchiveKeys())
+	for _, i := range []uint64{0, 63, 64, 99} {
+		summary, err := db.StateSummary(ctx, roots[i])
+		require.NoError(t, err)
+		require.NotNil(t, summary)
+		assert.Equal(t, i, summary.Slot)
+		assert.Equal(t, true, db.HasStateSummary(ctx, roots[i]))
+	}
+	// A block without a summary has no archived summary.
+	assert.Equal(t, false, db.HasStateSummary(ctx, roots[150]))
+
+	// Archiving again is a no-op, and appending continues from the snapshot.
+	require.NoError(t, db.archiveStateSummaries(ctx, 64))
+	require.NoError(t, db.SaveStateSummary(ctx, &pb.StateSummary{Slot: 100, Root: roots[100][:]}))
+	assert.Equal(t, 2, countLogSegments(t, db))
+	require.NoError(t, db.stateSummaryLog.reload(db.db))
+	assert.Equal(t, 37, len(db.stateSummaryLog.slots))
+	summary, err := db.StateSummary(ctx, roots[100])
+	require.NoError(t, err)
+	assert.Equal(t, uint64(100), summary.Slot)
+
+	// Summaries of finalized slots are archived directly, merged into the snapshot of their span.
+	require.NoError(t, db.archiveStateSummaries(ctx, stateSummaryArchiveSpan+1))
+	require.NoError(t, db.SaveStateSummary(ctx, &pb.StateSummary{Slot: 150, Root: roots[150][:]}))
+	require.NoError(t, db.SaveStateSummary(ctx, &pb.StateSummary{Slot: stateSummaryArchiveSpan, Root: roots[stateSummaryArchiveSpan][:]}))
+	assert.Equal(t, 0, countLogSegments(t, db))
+	assert.Equal(t, 0, len(db.stateSummaryLog.slots))
+	assert.Equal(t, 2, countArchiveKeys())
+	for _, i := range []uint64{0, 99, 100, 150, stateSummaryArchiveSpan} {
+		assert.Equal(t, true, db.HasStateSummary(ctx, roots[i]), "Missing summary of slot %d", i)
+	}
+}
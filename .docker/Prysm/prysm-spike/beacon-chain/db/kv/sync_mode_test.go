@@ -0,0 +1,65 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+func TestStore_SaveBlocks_FullSyncMode(t *testing.T) {
+	db := setupDB(t)
+	assert.Equal(t, false, db.db.NoSync, "Expected fsync on every commit by default")
+
+	blk := testutil.NewBeaconBlock()
+	require.NoError(t, db.SaveBlock(context.Background(), blk))
+	assert.Equal(t, false, db.db.NoSync)
+	assert.Equal(t, uint64(0), db.unsyncedBlocks)
+}
+
+func TestStore_SaveBlocks_BatchedSyncMode(t *testing.T) {
+	db, err := NewKVStore(t.TempDir(), cache.NewStateSummaryCache(), &Config{BlockSyncBatchSize: 4})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close(), "Failed to close database")
+	})
+	ctx := context.Background()
+	assert.Equal(t, false, db.db.NoSync, "Expected fsync of the writes made when opening the database")
+
+	blks := make([]*ethpb.SignedBeaconBlock, 3)
+	for i := 0; i < len(blks); i++ {
+		b := testutil.NewBeaconBlock()
+		b.Block.Slot = uint64(i + 1)
+		blks[i] = b
+	}
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+	assert.Equal(t, true, db.db.NoSync, "Expected fsync of block saves to be deferred in batched mode")
+	assert.Equal(t, uint64(3), db.unsyncedBlocks)
+
+	// Writes other than block saves are synced to disk when committed.
+	require.NoError(t, db.update(func(tx *bolt.Tx) error {
+		assert.Equal(t, false, tx.DB().NoSync)
+		return nil
+	}))
+
+	// Saving already known blocks does not count towards the batch.
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+	assert.Equal(t, uint64(3), db.unsyncedBlocks)
+
+	b := testutil.NewBeaconBlock()
+	b.Block.Slot = 4
+	require.NoError(t, db.SaveBlock(ctx, b))
+	assert.Equal(t, uint64(0), db.unsyncedBlocks, "Expected durability barrier once batch size was reached")
+
+	b = testutil.NewBeaconBlock()
+	b.Block.Slot = 5
+	require.NoError(t, db.SaveBlock(ctx, b))
+	assert.Equal(t, uint64(1), db.unsyncedBlocks)
+	require.NoError(t, db.syncBarrier())
+	assert.Equal(t, uint64(0), db.unsyncedBlocks)
+}
@@ -13,7 +13,7 @@ import (
 // SetupDB instantiates and returns database backed by key value store.
 func SetupDB(t testing.TB) (db.Database, *cache.StateSummaryCache) {
 	sc := cache.NewStateSummaryCache()
-	s, err := kv.NewKVStore(t.TempDir(), sc)
+	s, err := kv.NewKVStore(t.TempDir(), sc, nil)
 	if err != nil {
 		t.Fatal(err)
 	}
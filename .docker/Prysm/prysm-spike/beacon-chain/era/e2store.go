@@ -0,0 +1,106 @@
+package era
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/pkg/errors"
+)
+
+// headerSize is the size of the header of an e2store record: the type, the length of the data and
+// two reserved bytes.
+const headerSize = 8
+
+// maxRecordSize limits the data read from a record, above the size of a compressed mainnet state.
+const maxRecordSize = 1 << 30
+
+// Types of e2store records.
+var (
+	typeVersion         = [2]byte{0x65, 0x32}
+	typeCompressedBlock = [2]byte{0x01, 0x00}
+	typeCompressedState = [2]byte{0x02, 0x00}
+	typeSlotIndex       = [2]byte{0x69, 0x32}
+)
+
+// writeRecord writes an e2store record of the type and data, and returns its size.
+func writeRecord(w io.Writer, typ [2]byte, data []byte) (int64, error) {
+	if len(data) > math.MaxUint32 {
+		return 0, fmt.Errorf("record of %d bytes exceeds the maximum length", len(data))
+	}
+	var header [headerSize]byte
+	copy(header[:2], typ[:])
+	binary.LittleEndian.PutUint32(header[2:6], uint32(len(data)))
+	if _, err := w.Write(header[:]); err != nil {
+		return 0, err
+	}
+	if _, err := w.Write(data); err != nil {
+		return 0, err
+	}
+	return int64(headerSize + len(data)), nil
+}
+
+// readRecord reads the e2store record at the offset, and checks its type.
+func readRecord(r io.ReaderAt, offset int64, typ [2]byte) ([]byte, error) {
+	var header [headerSize]byte
+	if _, err := r.ReadAt(header[:], offset); err != nil {
+		return nil, errors.Wrapf(err, "could not read record header at offset %d", offset)
+	}
+	if header[0] != typ[0] || header[1] != typ[1] {
+		return nil, fmt.Errorf("record at offset %d has type %#x, expected %#x", offset, header[:2], typ)
+	}
+	length := binary.LittleEndian.Uint32(header[2:6])
+	if length > maxRecordSize {
+		return nil, fmt.Errorf("record at offset %d of %d bytes exceeds the maximum size", offset, length)
+	}
+	data := make([]byte, length)
+	if _, err := r.ReadAt(data, offset+headerSize); err != nil {
+		return nil, errors.Wrapf(err, "could not read record at offset %d", offset)
+	}
+	return data, nil
+}
+
+// encodeSlotIndex encodes a slot index: the starting slot, the offsets of the records of the slots
+// relative to the start of the index record, 0 for empty slots, and the number of slots.
+func encodeSlotIndex(startSlot uint64, offsets []int64) []byte {
+	data := make([]byte, 8*(len(offsets)+2))
+	binary.LittleEndian.PutUint64(data, startSlot)
+	for i, o := range offsets {
+		binary.LittleEndian.PutUint64(data[8*(i+1):], uint64(o))
+	}
+	binary.LittleEndian.PutUint64(data[len(data)-8:], uint64(len(offsets)))
+	return data
+}
+
+// decodeSlotIndex decodes a slot index record ending at the end offset, and returns the offset of
+// the record with the starting slot and absolute offsets of the slots.
+func decodeSlotIndex(r io.ReaderAt, end int64) (int64, uint64, []int64, error) {
+	var count [8]byte
+	if _, err := r.ReadAt(count[:], end-8); err != nil {
+		return 0, 0, nil, errors.Wrap(err, "could not read slot index count")
+	}
+	n := binary.LittleEndian.Uint64(count[:])
+	if n > maxRecordSize/8 {
+		return 0, 0, nil, fmt.Errorf("slot index of %d slots exceeds the maximum size", n)
+	}
+	start := end - headerSize - int64(8*(n+2))
+	if start < 0 {
+		return 0, 0, nil, fmt.Errorf("slot index of %d slots exceeds the file", n)
+	}
+	data, err := readRecord(r, start, typeSlotIndex)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	if uint64(len(data)) != 8*(n+2) {
+		return 0, 0, nil, fmt.Errorf("slot index of %d bytes does not hold %d slots", len(data), n)
+	}
+	startSlot := binary.LittleEndian.Uint64(data)
+	offsets := make([]int64, n)
+	for i := range offsets {
+		if o := int64(binary.LittleEndian.Uint64(data[8*(i+1):])); o != 0 {
+			offsets[i] = start + o
+		}
+	}
+	return start, startSlot, offsets, nil
+}
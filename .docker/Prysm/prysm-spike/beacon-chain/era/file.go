@@ -0,0 +1,205 @@
+// Package era writes the finalized history of the beacon chain to era files, and reads blocks
+// from them for initial sync. An era file holds the blocks of a period of SLOTS_PER_HISTORICAL_ROOT
+// slots and the state at its end, so long-term history can be kept outside the database.
+//
+// Era files are e2store files: a version record, a compressed block record per non-empty slot, the
+// compressed state record, and slot indices of the blocks and of the state at the end.
+package era
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+// Write writes an era file of the blocks of the slots from the starting slot, nil for empty slots,
+// and the state at the end of the slots.
+func Write(w io.Writer, startSlot uint64, blocks []*ethpb.SignedBeaconBlock, st *pb.BeaconState) error {
+	if st.Slot != startSlot+uint64(len(blocks)) {
+		return fmt.Errorf("state at slot %d is not at the end of the blocks of slots %d to %d",
+			st.Slot, startSlot, startSlot+uint64(len(blocks)))
+	}
+	var offset int64
+	write := func(typ [2]byte, data []byte) error {
+		n, err := writeRecord(w, typ, data)
+		offset += n
+		return err
+	}
+	if err := write(typeVersion, nil); err != nil {
+		return err
+	}
+	blockOffsets := make([]int64, len(blocks))
+	for i, b := range blocks {
+		if b == nil {
+			continue
+		}
+		enc, err := b.MarshalSSZ()
+		if err != nil {
+			return errors.Wrapf(err, "could not marshal block at slot %d", b.Block.Slot)
+		}
+		compressed, err := compress(enc)
+		if err != nil {
+			return err
+		}
+		blockOffsets[i] = offset
+		if err := write(typeCompressedBlock, compressed); err != nil {
+			return err
+		}
+	}
+	enc, err := st.MarshalSSZ()
+	if err != nil {
+		return errors.Wrap(err, "could not marshal state")
+	}
+	compressed, err := compress(enc)
+	if err != nil {
+		return err
+	}
+	stateOffset := offset
+	if err := write(typeCompressedState, compressed); err != nil {
+		return err
+	}
+	// Offsets in slot indices are relative to the index record.
+	blockIndexOffset := offset
+	for i, o := range blockOffsets {
+		if o != 0 {
+			blockOffsets[i] = o - blockIndexOffset
+		}
+	}
+	if err := write(typeSlotIndex, encodeSlotIndex(startSlot, blockOffsets)); err != nil {
+		return err
+	}
+	return write(typeSlotIndex, encodeSlotIndex(st.Slot, []int64{stateOffset - offset}))
+}
+
+// File is an era file opened for reading.
+type File struct {
+	f            *os.File
+	startSlot    uint64
+	blockOffsets []int64
+	stateSlot    uint64
+	stateOffset  int64
+}
+
+// Open opens an era file and reads its indices.
+func Open(path string) (*File, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return nil, closeOnError(f, err)
+	}
+	if _, err := readRecord(f, 0, typeVersion); err != nil {
+		return nil, closeOnError(f, errors.Wrap(err, "not an era file"))
+	}
+	stateIndex, stateSlot, stateOffsets, err := decodeSlotIndex(f, info.Size())
+	if err != nil {
+		return nil, closeOnError(f, errors.Wrap(err, "could not read state index"))
+	}
+	if len(stateOffsets) != 1 || stateOffsets[0] == 0 {
+		return nil, closeOnError(f, errors.New("state index does not hold a single state"))
+	}
+	_, startSlot, blockOffsets, err := decodeSlotIndex(f, stateIndex)
+	if err != nil {
+		return nil, closeOnError(f, errors.Wrap(err, "could not read block index"))
+	}
+	if startSlot+uint64(len(blockOffsets)) != stateSlot {
+		return nil, closeOnError(f, fmt.Errorf("blocks of slots %d to %d do not end at the state slot %d",
+			startSlot, startSlot+uint64(len(blockOffsets)), stateSlot))
+	}
+	return &File{
+		f:            f,
+		startSlot:    startSlot,
+		blockOffsets: blockOffsets,
+		stateSlot:    stateSlot,
+		stateOffset:  stateOffsets[0],
+	}, nil
+}
+
+// Close the file.
+func (f *File) Close() error {
+	return f.f.Close()
+}
+
+// StartSlot returns the first slot of the blocks of the file.
+func (f *File) StartSlot() uint64 {
+	return f.startSlot
+}
+
+// StateSlot returns the slot of the state of the file, following the last slot of its blocks.
+func (f *File) StateSlot() uint64 {
+	return f.stateSlot
+}
+
+// Block returns the block at the slot, or nil for an empty slot.
+func (f *File) Block(slot uint64) (*ethpb.SignedBeaconBlock, error) {
+	if slot < f.startSlot || slot >= f.stateSlot {
+		return nil, fmt.Errorf("slot %d is not in the era file of slots %d to %d", slot, f.startSlot, f.stateSlot)
+	}
+	offset := f.blockOffsets[slot-f.startSlot]
+	if offset == 0 {
+		return nil, nil
+	}
+	enc, err := f.read(offset, typeCompressedBlock)
+	if err != nil {
+		return nil, err
+	}
+	b := &ethpb.SignedBeaconBlock{}
+	if err := b.UnmarshalSSZ(enc); err != nil {
+		return nil, errors.Wrapf(err, "could not unmarshal block at slot %d", slot)
+	}
+	if b.Block == nil || b.Block.Slot != slot {
+		return nil, fmt.Errorf("block indexed at slot %d is not of the slot", slot)
+	}
+	return b, nil
+}
+
+// State returns the state at the end of the blocks of the file.
+func (f *File) State() (*state.BeaconState, error) {
+	enc, err := f.read(f.stateOffset, typeCompressedState)
+	if err != nil {
+		return nil, err
+	}
+	st := &pb.BeaconState{}
+	if err := st.UnmarshalSSZ(enc); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal state")
+	}
+	return state.InitializeFromProtoUnsafe(st)
+}
+
+func (f *File) read(offset int64, typ [2]byte) ([]byte, error) {
+	data, err := readRecord(f.f, offset, typ)
+	if err != nil {
+		return nil, err
+	}
+	return ioutil.ReadAll(snappy.NewReader(bytes.NewReader(data)))
+}
+
+// compress compresses the data with the framed snappy format.
+func compress(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w := snappy.NewBufferedWriter(&buf)
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func closeOnError(f *os.File, err error) error {
+	if closeErr := f.Close(); closeErr != nil {
+		return errors.Wrapf(err, "could not close file: %v", closeErr)
+	}
+	return err
+}
@@ -0,0 +1,76 @@
+package era
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// eraBlocks returns the blocks of the era, with blocks at the given slots only.
+func eraBlocks(era uint64, slots ...uint64) []*ethpb.SignedBeaconBlock {
+	sphr := params.BeaconConfig().SlotsPerHistoricalRoot
+	blocks := make([]*ethpb.SignedBeaconBlock, sphr)
+	for _, slot := range slots {
+		b := testutil.NewBeaconBlock()
+		b.Block.Slot = slot
+		blocks[slot-(era-1)*sphr] = b
+	}
+	return blocks
+}
+
+func writeEra(t *testing.T, dir string, era uint64, slots ...uint64) string {
+	st := testutil.NewBeaconState()
+	require.NoError(t, st.SetSlot(era*params.BeaconConfig().SlotsPerHistoricalRoot))
+	path := filepath.Join(dir, FileName(era, [32]byte{byte(era)}))
+	require.NoError(t, writeFile(path, st.Slot()-params.BeaconConfig().SlotsPerHistoricalRoot, eraBlocks(era, slots...), st))
+	return path
+}
+
+func TestFile_RoundTrip(t *testing.T) {
+	sphr := params.BeaconConfig().SlotsPerHistoricalRoot
+	path := writeEra(t, t.TempDir(), 2, sphr, sphr+3, 2*sphr-1)
+	f, err := Open(path)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, f.Close())
+	}()
+	assert.Equal(t, sphr, f.StartSlot())
+	assert.Equal(t, 2*sphr, f.StateSlot())
+
+	for _, slot := range []uint64{sphr, sphr + 3, 2*sphr - 1} {
+		b, err := f.Block(slot)
+		require.NoError(t, err)
+		require.NotNil(t, b)
+		assert.Equal(t, slot, b.Block.Slot)
+	}
+	b, err := f.Block(sphr + 1)
+	require.NoError(t, err)
+	assert.Equal(t, true, b == nil, "Empty slots should have no block")
+	_, err = f.Block(2 * sphr)
+	assert.ErrorContains(t, "is not in the era file", err)
+
+	st, err := f.State()
+	require.NoError(t, err)
+	assert.Equal(t, 2*sphr, st.Slot())
+}
+
+func TestWrite_StateNotAtEnd(t *testing.T) {
+	st := testutil.NewBeaconState()
+	require.NoError(t, st.SetSlot(1))
+	err := Write(&bytes.Buffer{}, 0, eraBlocks(1), st.InnerStateUnsafe())
+	assert.ErrorContains(t, "is not at the end of the blocks", err)
+}
+
+func TestOpen_NotEraFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "invalid.era")
+	require.NoError(t, ioutil.WriteFile(path, []byte("not an era file"), 0600))
+	_, err := Open(path)
+	assert.ErrorContains(t, "not an era file", err)
+}
@@ -0,0 +1,34 @@
+package era
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	exportedEra = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "era_last_exported",
+			Help: "The number of the last era written to an era file.",
+		},
+	)
+	exportDuration = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "era_export_duration_seconds",
+			Help:    "The time it takes to write an era file.",
+			Buckets: []float64{1, 5, 15, 30, 60, 120, 300},
+		},
+	)
+	exportFailures = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "era_export_failures_total",
+			Help: "The number of era files which could not be written.",
+		},
+	)
+	blocksRead = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "era_blocks_read_total",
+			Help: "The number of blocks read from era files, such as by initial sync instead of from peers.",
+		},
+	)
+)
@@ -0,0 +1,222 @@
+package era
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+// StateGetter returns the canonical state at a slot.
+type StateGetter interface {
+	StateBySlot(ctx context.Context, slot uint64) (*state.BeaconState, error)
+}
+
+// Config of the era export service.
+type Config struct {
+	BeaconDB      db.ReadOnlyDatabase
+	StateGen      StateGetter
+	StateNotifier statefeed.Notifier
+	Store         *Store
+	// Paused returns whether exports are paused, such as when free disk space is low.
+	Paused func() bool
+}
+
+// Service writes an era file of every era once it is finalized. Eras are exported in order from
+// the era following the last era file of the store, in the background as the head advances.
+type Service struct {
+	ctx        context.Context
+	cancel     context.CancelFunc
+	cfg        *Config
+	inProgress chan struct{}
+	wg         sync.WaitGroup
+	lock       sync.RWMutex
+	lastErr    error
+}
+
+// NewService creates a service exporting finalized eras to the era files of the store.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		ctx:        ctx,
+		cancel:     cancel,
+		cfg:        cfg,
+		inProgress: make(chan struct{}, 1),
+	}
+}
+
+// Start exporting finalized eras.
+func (s *Service) Start() {
+	log.WithFields(logrus.Fields{
+		"dir":     s.cfg.Store.Dir(),
+		"lastEra": s.cfg.Store.LastEra(),
+	}).Info("Exporting finalized history to era files")
+	s.exportInBackground()
+	go s.run()
+}
+
+// Stop the service, waiting for the era file being written.
+func (s *Service) Stop() error {
+	s.cancel()
+	s.wg.Wait()
+	return s.cfg.Store.Close()
+}
+
+// Status returns the error of the last export, if it failed.
+func (s *Service) Status() error {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.lastErr
+}
+
+func (s *Service) run() {
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.cfg.StateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	for {
+		select {
+		case event := <-stateChannel:
+			if event.Type == statefeed.NewHead {
+				s.exportInBackground()
+			}
+		case err := <-stateSub.Err():
+			log.WithError(err).Error("Could not subscribe to state notifier")
+			return
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// exportInBackground exports the finalized eras unless an export is in progress.
+func (s *Service) exportInBackground() {
+	select {
+	case s.inProgress <- struct{}{}:
+	default:
+		return
+	}
+	s.wg.Add(1)
+	go func() {
+		defer func() {
+			<-s.inProgress
+			s.wg.Done()
+		}()
+		err := s.exportFinalized(s.ctx)
+		if err != nil && s.ctx.Err() == nil {
+			exportFailures.Inc()
+			log.WithError(err).Error("Could not export era file")
+		}
+		s.lock.Lock()
+		s.lastErr = err
+		s.lock.Unlock()
+	}()
+}
+
+// exportFinalized exports the eras following the last era file which ended before the finalized
+// checkpoint.
+func (s *Service) exportFinalized(ctx context.Context) error {
+	checkpoint, err := s.cfg.BeaconDB.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized checkpoint")
+	}
+	finalizedSlot, err := helpers.StartSlot(checkpoint.Epoch)
+	if err != nil {
+		return err
+	}
+	sphr := params.BeaconConfig().SlotsPerHistoricalRoot
+	for era := s.cfg.Store.LastEra() + 1; era*sphr <= finalizedSlot; era++ {
+		if s.cfg.Paused != nil && s.cfg.Paused() {
+			log.WithField("era", era).Debug("Era exports paused")
+			return nil
+		}
+		if err := s.exportEra(ctx, era); err != nil {
+			return errors.Wrapf(err, "could not export era %d", era)
+		}
+	}
+	return nil
+}
+
+// exportEra writes the era file of the era. The canonical blocks of the era are the block roots of
+// the state at its end.
+func (s *Service) exportEra(ctx context.Context, era uint64) error {
+	start := time.Now()
+	sphr := params.BeaconConfig().SlotsPerHistoricalRoot
+	endSlot := era * sphr
+	startSlot := endSlot - sphr
+	st, err := s.cfg.StateGen.StateBySlot(ctx, endSlot)
+	if err != nil {
+		return errors.Wrapf(err, "could not get state at slot %d", endSlot)
+	}
+	roots := st.BlockRoots()
+	blocks := make([]*ethpb.SignedBeaconBlock, sphr)
+	var previousRoot [32]byte
+	for slot := startSlot; slot < endSlot; slot++ {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		root := bytesutil.ToBytes32(roots[slot%sphr])
+		if root == previousRoot {
+			continue
+		}
+		previousRoot = root
+		b, err := s.cfg.BeaconDB.Block(ctx, root)
+		if err != nil {
+			return errors.Wrapf(err, "could not get block of slot %d", slot)
+		}
+		if b == nil || b.Block == nil {
+			return errors.Errorf("block %#x of slot %d is not in the database", root, slot)
+		}
+		// The first slot of the era is empty when it holds the block of a previous slot.
+		if b.Block.Slot == slot {
+			blocks[slot-startSlot] = b
+		}
+	}
+
+	path := filepath.Join(s.cfg.Store.Dir(), FileName(era, previousRoot))
+	if err := writeFile(path, startSlot, blocks, st); err != nil {
+		return err
+	}
+	if err := s.cfg.Store.Add(path); err != nil {
+		return errors.Wrap(err, "could not open written era file")
+	}
+	exportedEra.Set(float64(era))
+	exportDuration.Observe(time.Since(start).Seconds())
+	log.WithFields(logrus.Fields{
+		"era":      era,
+		"file":     filepath.Base(path),
+		"duration": time.Since(start),
+	}).Info("Exported era file")
+	return nil
+}
+
+// writeFile writes the era file to a temporary file first, so a partially written file is never
+// opened.
+func writeFile(path string, startSlot uint64, blocks []*ethpb.SignedBeaconBlock, st *state.BeaconState) error {
+	tmp := path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, params.BeaconIoConfig().ReadWritePermissions)
+	if err != nil {
+		return err
+	}
+	if err := Write(f, startSlot, blocks, st.InnerStateUnsafe()); err != nil {
+		return closeOnError(f, err)
+	}
+	if err := f.Sync(); err != nil {
+		return closeOnError(f, err)
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
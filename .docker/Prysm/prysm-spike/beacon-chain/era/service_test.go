@@ -0,0 +1,81 @@
+package era
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+type mockStateGetter struct {
+	states map[uint64]*state.BeaconState
+}
+
+func (m *mockStateGetter) StateBySlot(_ context.Context, slot uint64) (*state.BeaconState, error) {
+	return m.states[slot], nil
+}
+
+func TestService_ExportFinalized(t *testing.T) {
+	ctx := context.Background()
+	beaconDB, _ := testDB.SetupDB(t)
+	sphr := params.BeaconConfig().SlotsPerHistoricalRoot
+
+	// Blocks at slots 0 and 2 of the first era, the roots of empty slots being of the previous block.
+	st := testutil.NewBeaconState()
+	require.NoError(t, st.SetSlot(sphr))
+	var lastRoot [32]byte
+	for _, slot := range []uint64{0, 2} {
+		b := testutil.NewBeaconBlock()
+		b.Block.Slot = slot
+		b.Block.ParentRoot = lastRoot[:]
+		require.NoError(t, beaconDB.SaveBlock(ctx, b))
+		root, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		if slot == 0 {
+			require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, root))
+		}
+		for s := slot; s < sphr; s++ {
+			require.NoError(t, st.UpdateBlockRootAtIndex(s, root))
+		}
+		lastRoot = root
+	}
+	require.NoError(t, beaconDB.SaveStateSummary(ctx, &pb.StateSummary{Slot: sphr, Root: lastRoot[:]}))
+
+	store, err := OpenStore(t.TempDir())
+	require.NoError(t, err)
+	paused := true
+	s := NewService(ctx, &Config{
+		BeaconDB: beaconDB,
+		StateGen: &mockStateGetter{states: map[uint64]*state.BeaconState{sphr: st}},
+		Store:    store,
+		Paused:   func() bool { return paused },
+	})
+
+	// Eras are not exported before they are finalized.
+	require.NoError(t, s.exportFinalized(ctx))
+	assert.Equal(t, uint64(0), store.LastEra())
+
+	require.NoError(t, beaconDB.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{
+		Epoch: sphr / params.BeaconConfig().SlotsPerEpoch,
+		Root:  lastRoot[:],
+	}))
+	require.NoError(t, s.exportFinalized(ctx))
+	assert.Equal(t, uint64(0), store.LastEra(), "Exports should be paused")
+
+	paused = false
+	require.NoError(t, s.exportFinalized(ctx))
+	require.Equal(t, uint64(1), store.LastEra())
+	blocks, covered, err := store.BlocksByRange(0, sphr)
+	require.NoError(t, err)
+	require.Equal(t, true, covered)
+	require.Equal(t, 2, len(blocks))
+	assert.Equal(t, uint64(2), blocks[1].Block.Slot)
+	require.NoError(t, s.Stop())
+}
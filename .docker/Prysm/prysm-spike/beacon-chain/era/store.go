@@ -0,0 +1,144 @@
+package era
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// fileExtension of era files.
+const fileExtension = ".era"
+
+// FileName returns the name of the era file of the era: the network, the era number, and the first
+// bytes of the root of the last block of the era.
+func FileName(era uint64, lastBlockRoot [32]byte) string {
+	network := strings.ToLower(params.BeaconConfig().NetworkName)
+	if network == "" {
+		network = "unknown"
+	}
+	return fmt.Sprintf("%s-%05d-%x%s", network, era, lastBlockRoot[:4], fileExtension)
+}
+
+// Store is the directory of the era files of a beacon node. Era n holds the blocks of the slots
+// from (n-1)*SLOTS_PER_HISTORICAL_ROOT, and the state at slot n*SLOTS_PER_HISTORICAL_ROOT.
+type Store struct {
+	dir   string
+	lock  sync.RWMutex
+	files map[uint64]*File
+	last  uint64
+}
+
+// OpenStore opens the era files of the directory, creating it if needed.
+func OpenStore(dir string) (*Store, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, errors.Wrap(err, "could not create era directory")
+	}
+	s := &Store{
+		dir:   dir,
+		files: make(map[uint64]*File),
+	}
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read era directory")
+	}
+	for _, e := range entries {
+		if e.IsDir() || filepath.Ext(e.Name()) != fileExtension {
+			continue
+		}
+		if err := s.Add(filepath.Join(dir, e.Name())); err != nil {
+			log.WithError(err).WithField("file", e.Name()).Warn("Could not open era file, ignoring it")
+		}
+	}
+	return s, nil
+}
+
+// Dir returns the directory of the store.
+func (s *Store) Dir() string {
+	return s.dir
+}
+
+// Add opens the era file and serves its blocks.
+func (s *Store) Add(path string) error {
+	f, err := Open(path)
+	if err != nil {
+		return err
+	}
+	sphr := params.BeaconConfig().SlotsPerHistoricalRoot
+	if f.StateSlot()%sphr != 0 || f.StateSlot()-f.StartSlot() != sphr {
+		return closeOnError(f.f, fmt.Errorf("blocks of slots %d to %d are not an era", f.StartSlot(), f.StateSlot()))
+	}
+	era := f.StateSlot() / sphr
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if previous, ok := s.files[era]; ok {
+		if err := previous.Close(); err != nil {
+			log.WithError(err).Debug("Could not close replaced era file")
+		}
+	}
+	s.files[era] = f
+	if era > s.last {
+		s.last = era
+	}
+	return nil
+}
+
+// LastEra returns the highest era of the store, 0 without era files.
+func (s *Store) LastEra() uint64 {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.last
+}
+
+// HasEra returns whether the store has the file of the era.
+func (s *Store) HasEra(era uint64) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	_, ok := s.files[era]
+	return ok
+}
+
+// BlocksByRange returns the blocks of the count slots from the starting slot, and whether the era
+// files of the store hold all of the slots.
+func (s *Store) BlocksByRange(start, count uint64) ([]*ethpb.SignedBeaconBlock, bool, error) {
+	sphr := params.BeaconConfig().SlotsPerHistoricalRoot
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	for slot := start; slot < start+count; slot += sphr - slot%sphr {
+		if _, ok := s.files[slot/sphr+1]; !ok {
+			return nil, false, nil
+		}
+	}
+	blocks := make([]*ethpb.SignedBeaconBlock, 0, count)
+	for slot := start; slot < start+count; slot++ {
+		b, err := s.files[slot/sphr+1].Block(slot)
+		if err != nil {
+			return nil, false, err
+		}
+		if b != nil {
+			blocks = append(blocks, b)
+		}
+	}
+	blocksRead.Add(float64(len(blocks)))
+	return blocks, true, nil
+}
+
+// Close the era files.
+func (s *Store) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	var err error
+	for era, f := range s.files {
+		if closeErr := f.Close(); closeErr != nil {
+			err = closeErr
+		}
+		delete(s.files, era)
+	}
+	return err
+}
@@ -0,0 +1,50 @@
+package era
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_BlocksByRange(t *testing.T) {
+	sphr := params.BeaconConfig().SlotsPerHistoricalRoot
+	dir := t.TempDir()
+	writeEra(t, dir, 1, 0, sphr-1)
+	writeEra(t, dir, 2, sphr, sphr+1)
+	s, err := OpenStore(dir)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, s.Close())
+	}()
+	assert.Equal(t, uint64(2), s.LastEra())
+	assert.Equal(t, true, s.HasEra(1))
+
+	// Ranges may span eras.
+	blocks, covered, err := s.BlocksByRange(sphr-1, 3)
+	require.NoError(t, err)
+	require.Equal(t, true, covered)
+	require.Equal(t, 3, len(blocks))
+	assert.Equal(t, sphr-1, blocks[0].Block.Slot)
+	assert.Equal(t, sphr+1, blocks[2].Block.Slot)
+
+	// Ranges past the last era file are not covered.
+	_, covered, err = s.BlocksByRange(2*sphr-1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, false, covered)
+}
+
+func TestStore_Add_NotAnEra(t *testing.T) {
+	dir := t.TempDir()
+	path := writeEra(t, dir, 1)
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig()
+	cfg.SlotsPerHistoricalRoot *= 2
+	params.OverrideBeaconConfig(cfg)
+
+	s, err := OpenStore(t.TempDir())
+	require.NoError(t, err)
+	assert.ErrorContains(t, "are not an era", s.Add(path))
+	assert.Equal(t, uint64(0), s.LastEra())
+}
@@ -3,6 +3,8 @@
 package flags
 
 import (
+	"time"
+
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/urfave/cli/v2"
 )
@@ -48,11 +50,79 @@ var (
 		Name:  "tls-key",
 		Usage: "Key for secure gRPC. Pass this and the tls-cert flag in order to use gRPC securely.",
 	}
+	// TLSAutoFlag enables secure gRPC with an automatically issued certificate.
+	TLSAutoFlag = &cli.BoolFlag{
+		Name: "tls-auto",
+		Usage: "Serve gRPC over TLS with a certificate issued by the certificate authority in tls-auto-ca-dir, " +
+			"only accepting clients presenting a certificate issued by the same authority. Ignored when tls-cert " +
+			"and tls-key are set.",
+	}
+	// TLSAutoCADirFlag defines the directory of the certificate authority issuing automatic certificates.
+	TLSAutoCADirFlag = &cli.StringFlag{
+		Name: "tls-auto-ca-dir",
+		Usage: "Directory of the certificate authority generated with tls-auto, shared with the validator clients " +
+			"connecting to the beacon node, e.g. through a docker compose volume. Defaults to tls/ca in the data directory.",
+	}
+	// TLSAutoHostsFlag defines additional names covered by the automatically generated certificate.
+	TLSAutoHostsFlag = &cli.StringSliceFlag{
+		Name: "tls-auto-hosts",
+		Usage: "DNS names and IPs the beacon node is reached at in addition to its hostname and addresses, " +
+			"such as its docker compose service name, to include in the certificate generated with tls-auto.",
+	}
 	// DisableGRPCGateway for JSON-HTTP requests to the beacon node.
 	DisableGRPCGateway = &cli.BoolFlag{
 		Name:  "disable-grpc-gateway",
 		Usage: "Disable the gRPC gateway for JSON-HTTP requests",
 	}
+	// RPCReadOnly disables the RPC endpoints which do more than query the beacon node.
+	RPCReadOnly = &cli.BoolFlag{
+		Name: "rpc-read-only",
+		Usage: "Only serves the gRPC and gateway endpoints which query the node, disabling those which submit blocks, " +
+			"attestations, exits and slashings or change the node configuration, such as for a public API replica",
+	}
+	// DBReplicaSnapshotDir is where the database snapshots for API replicas are written and read.
+	DBReplicaSnapshotDir = &cli.StringFlag{
+		Name: "db-replica-snapshot-dir",
+		Usage: "Directory the beacon node writes snapshots of its database to as its head advances, for API " +
+			"replicas started with the api-replica command to serve from. Also the directory the replica reads them from",
+	}
+	// DBReplicaSnapshotEpochs is the minimum number of epochs between the database snapshots for API replicas.
+	DBReplicaSnapshotEpochs = &cli.Uint64Flag{
+		Name: "db-replica-snapshot-epochs",
+		Usage: "Minimum number of epochs of heads between the database snapshots written to db-replica-snapshot-dir. " +
+			"Each snapshot copies the whole database, and is only written when an API replica served requests since the last one",
+		Value: 1,
+	}
+	// EraDir is where the finalized history is exported to era files, and read from by initial sync.
+	EraDir = &cli.StringFlag{
+		Name: "era-dir",
+		Usage: "Directory the beacon node exports finalized blocks and states to, as era files of " +
+			"SLOTS_PER_HISTORICAL_ROOT slots. Initial sync reads the blocks of the era files found there instead of " +
+			"requesting them from peers",
+	}
+	// BackfillBlocksPerSecond is the rate at which the block history missing from the database is requested from peers.
+	BackfillBlocksPerSecond = &cli.Uint64Flag{
+		Name: "backfill-blocks-per-second",
+		Usage: "Requests the block history missing below the lowest block of the database, such as after starting " +
+			"from a finalized checkpoint, from peers at this rate of slots per second, down to genesis. Disabled when 0",
+	}
+	// ReplicaPrimaryRPCProvider is the gRPC endpoint of the beacon node an API replica follows.
+	ReplicaPrimaryRPCProvider = &cli.StringFlag{
+		Name: "primary-rpc-provider",
+		Usage: "gRPC endpoint of the primary beacon node writing the snapshots, whose head events the API replica " +
+			"follows to report how many slots it is behind. Without it, the lag is reported against the current slot",
+	}
+	// ReplicaPrimaryCertFlag is the certificate of the primary beacon node an API replica follows.
+	ReplicaPrimaryCertFlag = &cli.StringFlag{
+		Name:  "primary-tls-cert",
+		Usage: "Certificate for secure gRPC to the primary beacon node. Pass this and primary-rpc-provider to connect over TLS",
+	}
+	// DisableProposalValidation skips the local validation of blocks proposed through the RPC.
+	DisableProposalValidation = &cli.BoolFlag{
+		Name: "disable-proposal-validation",
+		Usage: "Broadcast blocks proposed through the RPC without first running the gossip checks and a dry run " +
+			"of their state transition locally. Saves the time of the dry run for latency sensitive setups",
+	}
 	// GRPCGatewayHost specifies a gRPC gateway host for Prysm.
 	GRPCGatewayHost = &cli.StringFlag{
 		Name:  "grpc-gateway-host",
@@ -65,6 +135,26 @@ var (
 		Usage: "Enable gRPC gateway for JSON requests",
 		Value: 3500,
 	}
+	// GRPCGatewayAdminHost specifies the host of the admin gateway listener.
+	GRPCGatewayAdminHost = &cli.StringFlag{
+		Name:  "grpc-gateway-admin-host",
+		Usage: "The host on which the admin gateway listener, serving the debug endpoints, runs on",
+		Value: "127.0.0.1",
+	}
+	// GRPCGatewayAdminPort enables a separate gateway listener for the admin endpoints.
+	GRPCGatewayAdminPort = &cli.IntFlag{
+		Name: "grpc-gateway-admin-port",
+		Usage: "Serve the admin endpoints of the gateway, such as the debug endpoints, on their own port instead of " +
+			"the public gateway port, so the public API can be exposed while the admin endpoints stay internal. " +
+			"0 serves them on the public gateway port",
+		Value: 0,
+	}
+	// GRPCGatewayAdminTokenFile specifies the token required by the admin gateway listener.
+	GRPCGatewayAdminTokenFile = &cli.StringFlag{
+		Name: "grpc-gateway-admin-token-file",
+		Usage: "Path to a file holding the token requests to the admin gateway port must carry as an " +
+			"Authorization: Bearer header. Requires --grpc-gateway-admin-port",
+	}
 	// GPRCGatewayCorsDomain serves preflight requests when serving gRPC JSON gateway.
 	GPRCGatewayCorsDomain = &cli.StringFlag{
 		Name: "grpc-gateway-corsdomain",
@@ -79,6 +169,45 @@ var (
 		Usage: "The required number of valid peers to connect with before syncing.",
 		Value: 3,
 	}
+	// InitialSyncPeers restricts the peers initial sync requests blocks from.
+	InitialSyncPeers = &cli.StringSliceFlag{
+		Name:  "initial-sync-peers",
+		Usage: "Peer IDs initial sync requests blocks from, ignoring the other peers. Syncs from all peers when unset.",
+	}
+	// InitialSyncStaticPeersOnly restricts initial sync to the static peers.
+	InitialSyncStaticPeersOnly = &cli.BoolFlag{
+		Name:  "initial-sync-static-peers-only",
+		Usage: "Only request blocks from the peers given with --peer during initial sync, in addition to --initial-sync-peers.",
+	}
+	// SyncPeerSelection defines the order in which initial sync tries peers for block requests.
+	SyncPeerSelection = &cli.StringFlag{
+		Name: "sync-peer-selection",
+		Usage: "How initial sync picks the peers to request blocks from. 'scored' orders them by block provider score " +
+			"and remaining capacity, 'fastest' by the response time of their previous requests, 'most-finalized' by " +
+			"their finalized epoch and 'random' at random",
+		Value: SyncPeerSelectionScored,
+	}
+	// BlockProviderBatchWeight defines the score a peer earns for every processed batch of blocks it provided.
+	BlockProviderBatchWeight = &cli.Float64Flag{
+		Name:  "block-provider-batch-weight",
+		Usage: "Score of a peer for every processed batch of blocks it provided, used with --enable-peer-scorer. Defaults to 0.1",
+	}
+	// BlockProviderBlocksCap defines the number of processed blocks counted towards the score of a peer.
+	BlockProviderBlocksCap = &cli.Uint64Flag{
+		Name:  "block-provider-blocks-cap",
+		Usage: "Highest number of processed blocks counted towards the score of a peer, used with --enable-peer-scorer. Defaults to 640",
+	}
+	// BlockProviderDecay defines the number of processed blocks of a peer forgotten every decay interval.
+	BlockProviderDecay = &cli.Uint64Flag{
+		Name: "block-provider-decay",
+		Usage: "Number of processed blocks of a peer forgotten every --block-provider-decay-interval, the minimum " +
+			"performance for a peer to keep its score. Used with --enable-peer-scorer. Defaults to 64",
+	}
+	// BlockProviderDecayInterval defines how often the processed blocks of peers decay.
+	BlockProviderDecayInterval = &cli.DurationFlag{
+		Name:  "block-provider-decay-interval",
+		Usage: "How often the processed blocks of peers decay, used with --enable-peer-scorer. Defaults to 30s",
+	}
 	// ContractDeploymentBlock is the block in which the eth1 deposit contract was deployed.
 	ContractDeploymentBlock = &cli.IntFlag{
 		Name:  "contract-deployment-block",
@@ -173,4 +302,125 @@ var (
 		Usage: "Sets the maximum number of headers that a deposit log query can fetch.",
 		Value: uint64(1000),
 	}
+	// DepositSnapshot defines a deposit snapshot to initialize the deposits of a new node from.
+	DepositSnapshot = &cli.StringFlag{
+		Name: "deposit-snapshot",
+		Usage: "Path or URL of a finalized deposit tree snapshot, such as the /eth/v1alpha1/debug/deposit_snapshot " +
+			"gateway endpoint of another beacon node with --enable-debug-rpc-endpoints, to initialize the deposits of " +
+			"a node without eth1 data from instead of processing every deposit log of the deposit contract. Requires " +
+			"a genesis state",
+	}
+	// GenesisStateURL defines a URL polled for the genesis state of a new devnet.
+	GenesisStateURL = &cli.StringFlag{
+		Name: "genesis-state-url",
+		Usage: "URL of the SSZ genesis state of a new devnet, polled until it is published before the beacon node " +
+			"starts, then used as the genesis state. Without it, the node waits for the eth1 deposit threshold as usual",
+	}
+	// GenesisBarrierTime defines the genesis time the beacon node waits for before starting.
+	GenesisBarrierTime = &cli.Uint64Flag{
+		Name: "genesis-barrier-time",
+		Usage: "Unix genesis time of a new devnet. The beacon node waits to start until --genesis-barrier-delta before it. " +
+			"Defaults to the genesis time of the state fetched from --genesis-state-url",
+	}
+	// GenesisBarrierDelta defines how long before genesis the beacon node of a new devnet starts.
+	GenesisBarrierDelta = &cli.DurationFlag{
+		Name:  "genesis-barrier-delta",
+		Usage: "How long before genesis the beacon node starts when waiting for the genesis of a new devnet",
+		Value: 30 * time.Second,
+	}
+	// DBSyncMode defines the durability policy used by the beacon node database when persisting blocks.
+	DBSyncMode = &cli.StringFlag{
+		Name: "db-sync-mode",
+		Usage: "Durability policy for block writes to the beacon node database. 'full' fsyncs every write transaction. " +
+			"'batched' commits block saves without fsync and only issues a durability barrier every --db-sync-batch-size blocks, " +
+			"on finalization and with any other write, which greatly improves initial sync throughput on slow volumes. " +
+			"A crash of the node process loses nothing, but a power loss or operating system crash loses the blocks saved since " +
+			"the last barrier and can leave the database corrupted, requiring a resync or a restored backup.",
+		Value: DBSyncModeFull,
+	}
+	// DBSyncBatchSize defines the number of blocks written between durability barriers in batched sync mode.
+	DBSyncBatchSize = &cli.IntFlag{
+		Name:  "db-sync-batch-size",
+		Usage: "The number of blocks saved between fsync barriers when running with --db-sync-mode=batched.",
+		Value: 256,
+	}
+	// TraceBlockPropagation enables recording how gossiped blocks propagate to the node.
+	TraceBlockPropagation = &cli.BoolFlag{
+		Name: "trace-block-propagation",
+		Usage: "Records the time every peer first delivers each gossiped block, served for the most recent blocks " +
+			"by the ListBlockPropagation debug RPC. Adds message ID hashing to every received gossip message",
+	}
+	// PublishMeshWait defines how long publications to a newly joined gossip topic wait for a mesh to form.
+	PublishMeshWait = &cli.DurationFlag{
+		Name: "p2p-publish-mesh-wait",
+		Usage: "How long after joining an attestation subnet topic, such as after a restart, attestations published " +
+			"to it in the background are retried until enough peers to form a mesh are available. Blocks and other " +
+			"messages are always published as soon as any peer is available. 0 disables the wait",
+		Value: 4 * time.Second,
+	}
+	// GossipSeenMessageTTL defines how long gossip message IDs are remembered to discard duplicates.
+	GossipSeenMessageTTL = &cli.DurationFlag{
+		Name: "p2p-seen-message-ttl",
+		Usage: "How long the IDs of received gossip messages are remembered, during which the same messages are " +
+			"dropped as duplicates instead of being validated again. 0 uses the default of 550 gossip heartbeats",
+		Value: 0,
+	}
+	// TraceGossipDuplicates enables counting and penalizing duplicate gossip deliveries.
+	TraceGossipDuplicates = &cli.BoolFlag{
+		Name: "trace-gossip-duplicates",
+		Usage: "Counts the gossip messages received again within the seen message TTL by topic, and lowers the " +
+			"gossip score of peers repeatedly delivering the same messages. Adds a lookup to every received gossip message",
+	}
+	// GossipDuplicatePenaltyThreshold defines how often a peer may repeat gossip messages before it is down-scored.
+	GossipDuplicatePenaltyThreshold = &cli.IntFlag{
+		Name: "p2p-duplicate-penalty-threshold",
+		Usage: "How many times within an epoch a peer may deliver gossip messages it already delivered before " +
+			"its gossip score is lowered, for every such number of repeats, with --trace-gossip-duplicates. " +
+			"0 disables the penalty",
+		Value: 64,
+	}
+	// AttestationValidationWorkers defines the number of workers validating gossiped unaggregated attestations.
+	AttestationValidationWorkers = &cli.IntFlag{
+		Name: "attestation-validation-workers",
+		Usage: "The number of workers validating gossiped attestations in parallel. Attestations of the same " +
+			"committee are always validated in order by the same worker. Defaults to the number of CPUs when set to 0.",
+		Value: 0,
+	}
+	// SyncCatchUpThreshold defines how far behind its peers a node in regular sync may fall before catching up
+	// with initial sync.
+	SyncCatchUpThreshold = &cli.Uint64Flag{
+		Name: "sync-catch-up-threshold",
+		Usage: "The number of epochs the finalized epoch of peers may be ahead of the head of a synced node, such as " +
+			"after the node was paused, before it reverts to initial sync to catch up. 0 disables the check",
+		Value: 4,
+	}
+	// PeerStatusRefreshSlots defines how old the status of a peer may get before it is requested again.
+	PeerStatusRefreshSlots = &cli.Uint64Flag{
+		Name: "peer-status-refresh-slots",
+		Usage: "The number of slots after which the status (fork digest, finalized epoch and head) of a connected " +
+			"peer is requested again",
+		Value: 16,
+	}
+	// PeerStatusStaleSlots defines how long a peer may fail to refresh its status before it is disconnected.
+	PeerStatusStaleSlots = &cli.Uint64Flag{
+		Name: "peer-status-stale-slots",
+		Usage: "The number of slots a connected peer may go without answering a status request before it is " +
+			"disconnected. 0 keeps peers connected regardless of the age of their status",
+		Value: 64,
+	}
+	// AttestationSubnetLookaheadSlots defines how early attestation subnets are subscribed to before a duty.
+	AttestationSubnetLookaheadSlots = &cli.Uint64Flag{
+		Name: "attestation-subnet-lookahead-slots",
+		Usage: "The number of slots before an aggregation duty the node subscribes to its attestation subnet. " +
+			"The subscription is dropped one slot after the duty unless a later duty needs the same subnet",
+		Value: 4,
+	}
+	// AttestationPoolSpillThreshold defines the heap size above which unaggregated attestations are spilled to disk.
+	AttestationPoolSpillThreshold = &cli.Uint64Flag{
+		Name: "attestation-pool-spill-threshold",
+		Usage: "The heap size in megabytes above which the unaggregated attestations of the oldest slots are " +
+			"spilled from the attestation pool to a temporary file in the data directory, and reloaded when " +
+			"needed. Prevents running out of memory during attestation floods. Disabled if 0",
+		Value: 0,
+	}
 )
@@ -1,6 +1,9 @@
 package flags
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	log "github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
@@ -9,15 +12,43 @@ import (
 // GlobalFlags specifies all the global flags for the
 // beacon node.
 type GlobalFlags struct {
-	HeadSync                   bool
-	DisableSync                bool
-	DisableDiscv5              bool
-	SubscribeToAllSubnets      bool
-	MinimumSyncPeers           int
-	BlockBatchLimit            int
-	BlockBatchLimitBurstFactor int
+	HeadSync                     bool
+	DisableSync                  bool
+	DisableDiscv5                bool
+	SubscribeToAllSubnets        bool
+	MinimumSyncPeers             int
+	BlockBatchLimit              int
+	BlockBatchLimitBurstFactor   int
+	DBSyncMode                   string
+	DBSyncBatchSize              uint64
+	AttestationValidationWorkers int
+	SubnetLookaheadSlots         uint64
+	SyncCatchUpThreshold         uint64
+	PeerStatusRefreshSlots       uint64
+	PeerStatusStaleSlots         uint64
+	SyncPeerSelection            string
+	BlockProviderBatchWeight     float64
+	BlockProviderBlocksCap       uint64
+	BlockProviderDecay           uint64
+	BlockProviderDecayInterval   time.Duration
 }
 
+const (
+	// DBSyncModeFull fsyncs the database on every write transaction.
+	DBSyncModeFull = "full"
+	// DBSyncModeBatched groups block writes and only fsyncs at periodic durability barriers.
+	DBSyncModeBatched = "batched"
+
+	// SyncPeerSelectionScored orders sync peers by block provider score and remaining capacity.
+	SyncPeerSelectionScored = "scored"
+	// SyncPeerSelectionFastest orders sync peers by the response time of their previous requests.
+	SyncPeerSelectionFastest = "fastest"
+	// SyncPeerSelectionMostFinalized orders sync peers by their finalized epoch.
+	SyncPeerSelectionMostFinalized = "most-finalized"
+	// SyncPeerSelectionRandom orders sync peers at random.
+	SyncPeerSelectionRandom = "random"
+)
+
 var globalConfig *GlobalFlags
 
 // Get retrieves the global config.
@@ -52,11 +83,62 @@ func ConfigureGlobalFlags(ctx *cli.Context) {
 	cfg.DisableDiscv5 = ctx.Bool(DisableDiscv5.Name)
 	cfg.BlockBatchLimit = ctx.Int(BlockBatchLimit.Name)
 	cfg.BlockBatchLimitBurstFactor = ctx.Int(BlockBatchLimitBurstFactor.Name)
+	cfg.AttestationValidationWorkers = ctx.Int(AttestationValidationWorkers.Name)
+	cfg.SubnetLookaheadSlots = ctx.Uint64(AttestationSubnetLookaheadSlots.Name)
+	cfg.SyncCatchUpThreshold = ctx.Uint64(SyncCatchUpThreshold.Name)
+	cfg.PeerStatusRefreshSlots = ctx.Uint64(PeerStatusRefreshSlots.Name)
+	cfg.PeerStatusStaleSlots = ctx.Uint64(PeerStatusStaleSlots.Name)
+	cfg.BlockProviderBatchWeight = ctx.Float64(BlockProviderBatchWeight.Name)
+	cfg.BlockProviderBlocksCap = ctx.Uint64(BlockProviderBlocksCap.Name)
+	cfg.BlockProviderDecay = ctx.Uint64(BlockProviderDecay.Name)
+	cfg.BlockProviderDecayInterval = ctx.Duration(BlockProviderDecayInterval.Name)
 	configureMinimumPeers(ctx, cfg)
+	if err := configureDBSyncMode(ctx, cfg); err != nil {
+		log.Fatal(err)
+	}
+	if err := configureSyncPeerSelection(ctx, cfg); err != nil {
+		log.Fatal(err)
+	}
 
 	Init(cfg)
 }
 
+func configureDBSyncMode(ctx *cli.Context, cfg *GlobalFlags) error {
+	mode := ctx.String(DBSyncMode.Name)
+	switch mode {
+	case "", DBSyncModeFull:
+		cfg.DBSyncMode = DBSyncModeFull
+	case DBSyncModeBatched:
+		batchSize := ctx.Int(DBSyncBatchSize.Name)
+		if batchSize <= 0 {
+			return fmt.Errorf("--%s must be greater than 0, received %d", DBSyncBatchSize.Name, batchSize)
+		}
+		log.WithField("batchSize", batchSize).Warn("Using batched database sync mode, recent blocks may be lost on power failure")
+		cfg.DBSyncMode = DBSyncModeBatched
+		cfg.DBSyncBatchSize = uint64(batchSize)
+	default:
+		return fmt.Errorf("unknown --%s value %q, expected %q or %q", DBSyncMode.Name, mode, DBSyncModeFull, DBSyncModeBatched)
+	}
+	return nil
+}
+
+func configureSyncPeerSelection(ctx *cli.Context, cfg *GlobalFlags) error {
+	strategy := ctx.String(SyncPeerSelection.Name)
+	switch strategy {
+	case "":
+		cfg.SyncPeerSelection = SyncPeerSelectionScored
+	case SyncPeerSelectionScored, SyncPeerSelectionFastest, SyncPeerSelectionMostFinalized, SyncPeerSelectionRandom:
+		cfg.SyncPeerSelection = strategy
+	default:
+		return fmt.Errorf("unknown --%s value %q, expected %q, %q, %q or %q", SyncPeerSelection.Name, strategy,
+			SyncPeerSelectionScored, SyncPeerSelectionFastest, SyncPeerSelectionMostFinalized, SyncPeerSelectionRandom)
+	}
+	if cfg.BlockProviderBatchWeight < 0 {
+		return fmt.Errorf("--%s must not be negative, received %v", BlockProviderBatchWeight.Name, cfg.BlockProviderBatchWeight)
+	}
+	return nil
+}
+
 func configureMinimumPeers(ctx *cli.Context, cfg *GlobalFlags) {
 	cfg.MinimumSyncPeers = ctx.Int(MinSyncPeers.Name)
 	maxPeers := ctx.Int(cmd.P2PMaxPeers.Name)
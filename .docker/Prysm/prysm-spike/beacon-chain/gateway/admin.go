@@ -0,0 +1,34 @@
+package gateway
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+)
+
+// WithAdminListener serves the admin routes, the debug endpoints, on their own listener at the
+// address instead of the public one, so the public API can be exposed while the admin surface
+// stays internal. Requests to the admin listener must carry the token as a bearer token, unless
+// the token is empty.
+func (g *Gateway) WithAdminListener(address, token string) *Gateway {
+	g.adminAddr = address
+	g.adminToken = token
+	return g
+}
+
+// adminAuthHandler rejects requests without the bearer token. An empty token allows every request.
+func adminAuthHandler(h http.Handler, token string) http.Handler {
+	if token == "" {
+		return h
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+		if !strings.HasPrefix(auth, "Bearer ") ||
+			subtle.ConstantTimeCompare([]byte(strings.TrimPrefix(auth, "Bearer ")), []byte(token)) != 1 {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="admin"`)
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
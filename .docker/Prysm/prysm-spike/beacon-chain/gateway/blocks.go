@@ -0,0 +1,128 @@
+package gateway
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	ethpbv1 "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// blocksHandler serves the standard /eth/v1/beacon/blocks/{block_id} and /eth/v1/beacon/blocks/{block_id}/root
+// routes. Responses about finalized blocks carry an ETag header and requests whose If-None-Match header
+// matches it are answered with 304 Not Modified.
+func blocksHandler(client ethpbv1.BeaconChainClient, marshaler gwruntime.Marshaler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		path := strings.TrimPrefix(r.URL.Path, "/eth/v1/beacon/blocks/")
+		rootOnly := strings.HasSuffix(path, "/root")
+		path = strings.TrimSuffix(path, "/root")
+		if path == "" || strings.Contains(path, "/") {
+			http.NotFound(w, r)
+			return
+		}
+		blockID, err := decodeBlockID(path)
+		if err != nil {
+			http.Error(w, "Invalid block ID: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		var md metadata.MD
+		var resp interface{}
+		req := &ethpbv1.BlockRequest{BlockId: blockID}
+		if rootOnly {
+			resp, err = client.GetBlockRoot(r.Context(), req, grpc.Header(&md))
+		} else {
+			resp, err = client.GetBlock(r.Context(), req, grpc.Header(&md))
+		}
+		writeResponse(w, r, marshaler, md, resp, err)
+	}
+}
+
+// blockHeaderHandler serves the standard /eth/v1/beacon/headers/{block_id} route, with the same ETag
+// support as blocksHandler.
+func blockHeaderHandler(client ethpbv1.BeaconChainClient, marshaler gwruntime.Marshaler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		path := strings.TrimPrefix(r.URL.Path, "/eth/v1/beacon/headers/")
+		if path == "" || strings.Contains(path, "/") {
+			http.NotFound(w, r)
+			return
+		}
+		blockID, err := decodeBlockID(path)
+		if err != nil {
+			http.Error(w, "Invalid block ID: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		var md metadata.MD
+		resp, err := client.GetBlockHeader(r.Context(), &ethpbv1.BlockRequest{BlockId: blockID}, grpc.Header(&md))
+		writeResponse(w, r, marshaler, md, resp, err)
+	}
+}
+
+// decodeBlockID converts the block ID of a route into the one of a request, which holds roots as bytes
+// rather than hex strings.
+func decodeBlockID(id string) ([]byte, error) {
+	if strings.HasPrefix(id, "0x") {
+		return hexutil.Decode(id)
+	}
+	return []byte(id), nil
+}
+
+// writeResponse writes the response of a standard API route, along with its entity tag if the beacon
+// node returned one. The body is left out when the entity tag matches the If-None-Match request header.
+func writeResponse(
+	w http.ResponseWriter,
+	r *http.Request,
+	marshaler gwruntime.Marshaler,
+	md metadata.MD,
+	resp interface{},
+	err error,
+) {
+	if err != nil {
+		s, _ := status.FromError(err)
+		http.Error(w, s.Message(), gwruntime.HTTPStatusFromCode(s.Code()))
+		return
+	}
+	if v := md.Get(grpcutils.ETagHeader); len(v) > 0 {
+		w.Header().Set("ETag", v[0])
+		if etagMatches(r.Header.Get("If-None-Match"), v[0]) {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+	}
+	enc, err := marshaler.Marshal(resp)
+	if err != nil {
+		log.WithError(err).Error("Could not marshal response")
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", marshaler.ContentType())
+	if _, err := w.Write(enc); err != nil {
+		log.WithError(err).Error("Could not write response")
+	}
+}
+
+// etagMatches returns whether the value of an If-None-Match header matches the entity tag, using the weak
+// comparison of RFC 7232.
+func etagMatches(ifNoneMatch, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,21 @@
+package gateway
+
+import (
+	"net/http"
+
+	ptypes "github.com/gogo/protobuf/types"
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	ethpbv1 "github.com/prysmaticlabs/ethereumapis/eth/v1"
+)
+
+// forkScheduleHandler serves the standard /eth/v1/config/fork_schedule route.
+func forkScheduleHandler(client ethpbv1.BeaconChainClient, marshaler gwruntime.Marshaler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		resp, err := client.GetForkSchedule(r.Context(), &ptypes.Empty{})
+		writeResponse(w, r, marshaler, nil /* md */, resp, err)
+	}
+}
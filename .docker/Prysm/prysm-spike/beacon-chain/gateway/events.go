@@ -0,0 +1,103 @@
+package gateway
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	pbrpcv1 "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+)
+
+// eventsHandler serves the standard /eth/v1/events route, streaming the events of the topics of the topics query
+// parameter as server-sent events. The parameter may be repeated or hold a comma separated list of topics. The
+// event data is always the standard JSON.
+func eventsHandler(client pbrpcv1.EventsClient, marshaler gwruntime.Marshaler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			http.Error(w, "Streaming is not supported", http.StatusInternalServerError)
+			return
+		}
+		var topics []string
+		for _, v := range r.URL.Query()["topics"] {
+			topics = append(topics, splitTopics(v)...)
+		}
+
+		stream, err := client.StreamEvents(r.Context(), &pbrpcv1.StreamEventsRequest{Topics: topics})
+		if err == nil {
+			// The server sends the headers once it accepted the topics, or fails the stream.
+			_, err = stream.Header()
+		}
+		if err != nil {
+			writeResponse(w, r, marshaler, nil /* md */, nil, err)
+			return
+		}
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+		w.WriteHeader(http.StatusOK)
+		flusher.Flush()
+
+		for {
+			event, err := stream.Recv()
+			if err != nil {
+				if r.Context().Err() == nil {
+					log.WithError(err).Debug("Event stream ended")
+				}
+				return
+			}
+			topic, data, err := eventData(event, marshaler)
+			if err != nil {
+				log.WithError(err).Error("Could not marshal event")
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", topic, data); err != nil {
+				log.WithError(err).Debug("Could not write event")
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+// eventData returns the topic of an event and its data in the JSON of the marshaler.
+func eventData(event *pbrpcv1.Event, marshaler gwruntime.Marshaler) (string, []byte, error) {
+	var topic string
+	var msg proto.Message
+	switch e := event.Event.(type) {
+	case *pbrpcv1.Event_Head:
+		topic, msg = "head", e.Head
+	case *pbrpcv1.Event_Block:
+		topic, msg = "block", e.Block
+	case *pbrpcv1.Event_Attestation:
+		topic, msg = "attestation", e.Attestation
+	case *pbrpcv1.Event_FinalizedCheckpoint:
+		topic, msg = "finalized_checkpoint", e.FinalizedCheckpoint
+	case *pbrpcv1.Event_ChainReorg:
+		topic, msg = "chain_reorg", e.ChainReorg
+	default:
+		return "", nil, fmt.Errorf("unknown event %T", event.Event)
+	}
+	data, err := marshaler.Marshal(msg)
+	if err != nil {
+		return "", nil, err
+	}
+	return topic, data, nil
+}
+
+// splitTopics splits a comma separated list of topics, dropping empty entries.
+func splitTopics(v string) []string {
+	var topics []string
+	for _, topic := range strings.Split(v, ",") {
+		if topic = strings.TrimSpace(topic); topic != "" {
+			topics = append(topics, topic)
+		}
+	}
+	return topics
+}
@@ -0,0 +1,83 @@
+package gateway
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pbrpcv1 "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// eventsClient streams its events, or fails the stream with its error once the topics were sent.
+type eventsClient struct {
+	events []*pbrpcv1.Event
+	err    error
+	topics []string
+}
+
+func (c *eventsClient) StreamEvents(
+	_ context.Context, req *pbrpcv1.StreamEventsRequest, _ ...grpc.CallOption,
+) (pbrpcv1.Events_StreamEventsClient, error) {
+	c.topics = req.Topics
+	return &eventsStream{client: c}, nil
+}
+
+type eventsStream struct {
+	grpc.ClientStream
+	client *eventsClient
+}
+
+func (s *eventsStream) Header() (metadata.MD, error) {
+	return metadata.MD{}, s.client.err
+}
+
+func (s *eventsStream) Recv() (*pbrpcv1.Event, error) {
+	if len(s.client.events) == 0 {
+		return nil, io.EOF
+	}
+	event := s.client.events[0]
+	s.client.events = s.client.events[1:]
+	return event, nil
+}
+
+func TestEventsHandler(t *testing.T) {
+	client := &eventsClient{events: []*pbrpcv1.Event{
+		{Event: &pbrpcv1.Event_Block{Block: &pbrpcv1.EventBlock{Slot: 4, Block: []byte{0xab}}}},
+		{Event: &pbrpcv1.Event_Attestation{Attestation: &ethpb.Attestation{
+			AggregationBits: []byte{0x03},
+			Data: &ethpb.AttestationData{
+				CommitteeIndex: 2,
+				Source:         &ethpb.Checkpoint{},
+				Target:         &ethpb.Checkpoint{},
+			},
+		}}},
+	}}
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/eth/v1/events?topics=block,attestation&topics=head", nil)
+	eventsHandler(client, newStandardMarshaler())(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/event-stream", rec.Header().Get("Content-Type"))
+	assert.DeepEqual(t, []string{"block", "attestation", "head"}, client.topics)
+	assert.Equal(t, "event: block\n"+
+		`data: {"block":"0xab","slot":"4"}`+"\n\n"+
+		"event: attestation\n"+
+		`data: {"aggregation_bits":"0x03","data":{"beacon_block_root":"0x","committee_index":"2","slot":"0",`+
+		`"source":{"epoch":"0","root":"0x"},"target":{"epoch":"0","root":"0x"}},"signature":"0x"}`+"\n\n",
+		rec.Body.String())
+}
+
+func TestEventsHandler_InvalidTopics(t *testing.T) {
+	client := &eventsClient{err: status.Error(codes.InvalidArgument, "Topic foo not allowed for event subscriptions")}
+	rec := httptest.NewRecorder()
+	eventsHandler(client, newStandardMarshaler())(rec, httptest.NewRequest(http.MethodGet, "/eth/v1/events?topics=foo", nil))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
@@ -4,17 +4,21 @@ package gateway
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net"
 	"net/http"
 	"time"
 
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	ethpbv1 "github.com/prysmaticlabs/ethereumapis/eth/v1"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1_gateway"
+	pbrpcv1 "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1_gateway"
 	"github.com/prysmaticlabs/prysm/shared"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials"
 )
 
 var _ shared.Service = (*Gateway)(nil)
@@ -29,10 +33,14 @@ type Gateway struct {
 	remoteAddr              string
 	server                  *http.Server
 	mux                     *http.ServeMux
+	adminAddr               string
+	adminToken              string
+	adminServer             *http.Server
 	allowedOrigins          []string
 	startFailure            error
 	enableDebugRPCEndpoints bool
 	maxCallRecvMsgSize      uint64
+	tlsConfig               *tls.Config
 }
 
 // Start the gateway service. This serves the HTTP JSON traffic on the specified
@@ -52,30 +60,63 @@ func (g *Gateway) Start() {
 
 	g.conn = conn
 
-	gwmux := gwruntime.NewServeMux(
-		gwruntime.WithMarshalerOption(
-			gwruntime.MIMEWildcard,
-			&gwruntime.JSONPb{OrigName: false, EmitDefaults: true},
-		),
-	)
-	handlers := []func(context.Context, *gwruntime.ServeMux, *grpc.ClientConn) error{
+	publicHandlers := []func(context.Context, *gwruntime.ServeMux, *grpc.ClientConn) error{
 		ethpb.RegisterNodeHandler,
 		ethpb.RegisterBeaconChainHandler,
 		ethpb.RegisterBeaconNodeValidatorHandler,
 		pbrpc.RegisterHealthHandler,
 	}
+	var adminHandlers []func(context.Context, *gwruntime.ServeMux, *grpc.ClientConn) error
 	if g.enableDebugRPCEndpoints {
-		handlers = append(handlers, pbrpc.RegisterDebugHandler)
+		adminHandlers = append(adminHandlers, pbrpc.RegisterDebugHandler)
 	}
-	for _, f := range handlers {
-		if err := f(ctx, gwmux, conn); err != nil {
-			log.WithError(err).Error("Failed to start gateway")
-			g.startFailure = err
-			return
-		}
+	if g.adminAddr == "" {
+		publicHandlers = append(publicHandlers, adminHandlers...)
+	}
+
+	// Every route is served in both JSON formats, and each request picks one of them.
+	standardMarshaler := newStandardMarshaler()
+	prysmMarshaler := &gwruntime.JSONPb{OrigName: false, EmitDefaults: true}
+	publicMux, err := newJSONFormatMux(ctx, conn, publicHandlers, standardMarshaler, prysmMarshaler)
+	if err != nil {
+		log.WithError(err).Error("Failed to start gateway")
+		g.startFailure = err
+		return
 	}
 
-	g.mux.Handle("/", gwmux)
+	// The standard API routes have no generated gateway handlers.
+	beaconClientV1 := ethpbv1.NewBeaconChainClient(conn)
+	routes := map[string]func(ethpbv1.BeaconChainClient, gwruntime.Marshaler) http.HandlerFunc{
+		"/eth/v1/beacon/headers":       blockHeadersHandler,
+		"/eth/v1/beacon/headers/":      blockHeaderHandler,
+		"/eth/v1/beacon/blocks/":       blocksHandler,
+		"/eth/v1/config/fork_schedule": forkScheduleHandler,
+	}
+	for pattern, handler := range routes {
+		g.mux.Handle(pattern, jsonFormatHandler(
+			handler(beaconClientV1, standardMarshaler),
+			handler(beaconClientV1, prysmMarshaler),
+		))
+	}
+	nodeClientV1 := ethpbv1.NewBeaconNodeClient(conn)
+	nodeRoutes := map[string]func(ethpbv1.BeaconNodeClient, gwruntime.Marshaler) http.HandlerFunc{
+		"/eth/v1/node/identity":   identityHandler,
+		"/eth/v1/node/peers":      peersHandler,
+		"/eth/v1/node/peers/":     peerHandler,
+		"/eth/v1/node/peer_count": peerCountHandler,
+		"/eth/v1/node/version":    versionHandler,
+		"/eth/v1/node/syncing":    syncingHandler,
+		"/eth/v1/node/health":     healthHandler,
+	}
+	for pattern, handler := range nodeRoutes {
+		g.mux.Handle(pattern, jsonFormatHandler(
+			handler(nodeClientV1, standardMarshaler),
+			handler(nodeClientV1, prysmMarshaler),
+		))
+	}
+	// Events are server-sent events whose data is the standard JSON only.
+	g.mux.Handle("/eth/v1/events", eventsHandler(pbrpcv1.NewEventsClient(conn), standardMarshaler))
+	g.mux.Handle("/", publicMux)
 
 	g.server = &http.Server{
 		Addr:    g.gatewayAddr,
@@ -88,6 +129,63 @@ func (g *Gateway) Start() {
 			return
 		}
 	}()
+
+	if g.adminAddr != "" {
+		adminMux, err := newJSONFormatMux(ctx, conn, adminHandlers, standardMarshaler, prysmMarshaler)
+		if err != nil {
+			log.WithError(err).Error("Failed to start admin gateway")
+			g.startFailure = err
+			return
+		}
+		log.WithField("address", g.adminAddr).Info("Starting admin JSON-HTTP API")
+		g.adminServer = &http.Server{
+			Addr:    g.adminAddr,
+			Handler: adminAuthHandler(adminMux, g.adminToken),
+		}
+		go func() {
+			if err := g.adminServer.ListenAndServe(); err != http.ErrServerClosed {
+				log.WithError(err).Error("Failed to listen and serve admin API")
+				g.startFailure = err
+				return
+			}
+		}()
+	}
+}
+
+// newJSONFormatMux returns a mux of the generated gateway handlers serving both JSON formats.
+func newJSONFormatMux(
+	ctx context.Context,
+	conn *grpc.ClientConn,
+	handlers []func(context.Context, *gwruntime.ServeMux, *grpc.ClientConn) error,
+	standardMarshaler, prysmMarshaler gwruntime.Marshaler,
+) (http.Handler, error) {
+	standardMux, err := newGatewayMux(ctx, conn, handlers, standardMarshaler)
+	if err != nil {
+		return nil, err
+	}
+	prysmMux, err := newGatewayMux(ctx, conn, handlers, prysmMarshaler)
+	if err != nil {
+		return nil, err
+	}
+	return jsonFormatHandler(standardMux, prysmMux), nil
+}
+
+// newGatewayMux returns a mux of the generated gateway handlers writing JSON with the marshaler.
+func newGatewayMux(
+	ctx context.Context,
+	conn *grpc.ClientConn,
+	handlers []func(context.Context, *gwruntime.ServeMux, *grpc.ClientConn) error,
+	marshaler gwruntime.Marshaler,
+) (*gwruntime.ServeMux, error) {
+	gwmux := gwruntime.NewServeMux(
+		gwruntime.WithMarshalerOption(gwruntime.MIMEWildcard, marshaler),
+	)
+	for _, f := range handlers {
+		if err := f(ctx, gwmux, conn); err != nil {
+			return nil, err
+		}
+	}
+	return gwmux, nil
 }
 
 // Status of grpc gateway. Returns an error if this service is unhealthy.
@@ -110,6 +208,11 @@ func (g *Gateway) Stop() error {
 			log.WithError(err).Error("Failed to shut down server")
 		}
 	}
+	if g.adminServer != nil {
+		if err := g.adminServer.Shutdown(g.ctx); err != nil {
+			log.WithError(err).Error("Failed to shut down admin server")
+		}
+	}
 
 	if g.cancel != nil {
 		g.cancel()
@@ -118,6 +221,12 @@ func (g *Gateway) Stop() error {
 	return nil
 }
 
+// WithTLSConfig makes the gateway connect to the gRPC server over TLS with the config.
+func (g *Gateway) WithTLSConfig(cfg *tls.Config) *Gateway {
+	g.tlsConfig = cfg
+	return g
+}
+
 // New returns a new gateway server which translates HTTP into gRPC.
 // Accepts a context and optional http.ServeMux.
 func New(
@@ -159,8 +268,12 @@ func (g *Gateway) dial(ctx context.Context, network, addr string) (*grpc.ClientC
 // dialTCP creates a client connection via TCP.
 // "addr" must be a valid TCP address with a port number.
 func (g *Gateway) dialTCP(ctx context.Context, addr string) (*grpc.ClientConn, error) {
+	transportSecurity := grpc.WithInsecure()
+	if g.tlsConfig != nil {
+		transportSecurity = grpc.WithTransportCredentials(credentials.NewTLS(g.tlsConfig))
+	}
 	opts := []grpc.DialOption{
-		grpc.WithInsecure(),
+		transportSecurity,
 		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(int(g.maxCallRecvMsgSize))),
 	}
 
@@ -0,0 +1,53 @@
+package gateway
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	ethpbv1 "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// blockHeadersHandler serves the standard /eth/v1/beacon/headers route by forwarding its slot and parent_root
+// query parameters to ListBlockHeaders. The slot of the head block is looked up when neither is given, as the
+// request cannot tell an omitted slot from slot 0.
+func blockHeadersHandler(client ethpbv1.BeaconChainClient, marshaler gwruntime.Marshaler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		query := r.URL.Query()
+		req := &ethpbv1.BlockHeadersRequest{}
+		if v := query.Get("parent_root"); v != "" {
+			parentRoot, err := hexutil.Decode(v)
+			if err != nil {
+				http.Error(w, "Invalid parent root: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+			req.ParentRoot = parentRoot
+		}
+		if v := query.Get("slot"); v != "" {
+			slot, err := strconv.ParseUint(v, 10, 64)
+			if err != nil {
+				http.Error(w, "Invalid slot: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+			req.Slot = slot
+		} else if req.ParentRoot == nil {
+			head, err := client.GetBlockHeader(r.Context(), &ethpbv1.BlockRequest{BlockId: []byte("head")})
+			if err != nil {
+				writeResponse(w, r, marshaler, nil, nil, err)
+				return
+			}
+			req.Slot = head.Data.Header.Message.Slot
+		}
+
+		var md metadata.MD
+		resp, err := client.ListBlockHeaders(r.Context(), req, grpc.Header(&md))
+		writeResponse(w, r, marshaler, md, resp, err)
+	}
+}
@@ -0,0 +1,77 @@
+package gateway
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	ethpbv1 "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// headersClient answers the block header methods used by the block headers route.
+type headersClient struct {
+	ethpbv1.BeaconChainClient
+	headSlot uint64
+	requests []*ethpbv1.BlockHeadersRequest
+}
+
+func (c *headersClient) GetBlockHeader(_ context.Context, req *ethpbv1.BlockRequest, _ ...grpc.CallOption) (*ethpbv1.BlockHeaderResponse, error) {
+	if string(req.BlockId) != "head" {
+		return nil, status.Error(codes.InvalidArgument, "unexpected block ID")
+	}
+	return &ethpbv1.BlockHeaderResponse{Data: &ethpbv1.BlockHeaderContainer{
+		Header: &ethpbv1.BeaconBlockHeaderContainer{Message: &ethpbv1.BeaconBlockHeader{Slot: c.headSlot}},
+	}}, nil
+}
+
+func (c *headersClient) ListBlockHeaders(_ context.Context, req *ethpbv1.BlockHeadersRequest, _ ...grpc.CallOption) (*ethpbv1.BlockHeadersResponse, error) {
+	c.requests = append(c.requests, req)
+	if req.Slot > c.headSlot {
+		return nil, status.Error(codes.NotFound, "Could not find requested blocks")
+	}
+	return &ethpbv1.BlockHeadersResponse{Data: []*ethpbv1.BlockHeaderContainer{{Root: []byte{1}}}}, nil
+}
+
+func TestBlockHeadersHandler(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		code  int
+		want  *ethpbv1.BlockHeadersRequest
+	}{
+		{name: "head slot by default", code: http.StatusOK, want: &ethpbv1.BlockHeadersRequest{Slot: 30}},
+		{name: "genesis slot", query: "?slot=0", code: http.StatusOK, want: &ethpbv1.BlockHeadersRequest{Slot: 0}},
+		{
+			name:  "parent root",
+			query: "?parent_root=0x0102",
+			code:  http.StatusOK,
+			want:  &ethpbv1.BlockHeadersRequest{ParentRoot: []byte{1, 2}},
+		},
+		{name: "not found", query: "?slot=31", code: http.StatusNotFound, want: &ethpbv1.BlockHeadersRequest{Slot: 31}},
+		{name: "invalid slot", query: "?slot=foo", code: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &headersClient{headSlot: 30}
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/eth/v1/beacon/headers"+tt.query, nil)
+			blockHeadersHandler(client, newStandardMarshaler())(rec, req)
+			assert.Equal(t, tt.code, rec.Code)
+			if tt.want == nil {
+				assert.Equal(t, 0, len(client.requests))
+				return
+			}
+			require.Equal(t, 1, len(client.requests))
+			assert.DeepEqual(t, tt.want, client.requests[0])
+			if tt.code == http.StatusOK {
+				assert.Equal(t, `{"data":[{"canonical":false,"header":null,"root":"0x01"}]}`, rec.Body.String())
+			}
+		})
+	}
+}
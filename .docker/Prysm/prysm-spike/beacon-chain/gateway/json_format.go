@@ -0,0 +1,375 @@
+package gateway
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// JSONFormatHeader is the request header selecting the JSON format of a response, either
+// StandardJSONFormat or PrysmJSONFormat. The format of a response is echoed in the same header.
+const JSONFormatHeader = "X-Json-Format"
+
+const (
+	// StandardJSONFormat is the JSON of the standard Beacon API: snake_case field names, hex
+	// encoded bytes and integers as decimal strings. It is the default of the /eth/v1/ routes.
+	StandardJSONFormat = "standard"
+	// PrysmJSONFormat is the protobuf JSON of Prysm: camelCase field names and base64 encoded
+	// bytes. It is the default of the other routes, such as /eth/v1alpha1/.
+	PrysmJSONFormat = "prysm"
+)
+
+// standardRoutesPrefix is the path prefix of the routes defaulting to the standard format.
+const standardRoutesPrefix = "/eth/v1/"
+
+// jsonFormatHandler dispatches each request to the handler of the JSON format it negotiated with
+// the JSONFormatHeader, so standard tooling and tools written against the Prysm JSON both work
+// against the same gateway.
+func jsonFormatHandler(standard, prysm http.Handler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		format := strings.ToLower(strings.TrimSpace(r.Header.Get(JSONFormatHeader)))
+		if format == "" {
+			format = PrysmJSONFormat
+			if strings.HasPrefix(r.URL.Path, standardRoutesPrefix) {
+				format = StandardJSONFormat
+			}
+		}
+		switch format {
+		case StandardJSONFormat:
+			w.Header().Set(JSONFormatHeader, StandardJSONFormat)
+			standard.ServeHTTP(w, r)
+		case PrysmJSONFormat:
+			w.Header().Set(JSONFormatHeader, PrysmJSONFormat)
+			prysm.ServeHTTP(w, r)
+		default:
+			http.Error(
+				w,
+				fmt.Sprintf("Unsupported JSON format %q, expected %s or %s", format, StandardJSONFormat, PrysmJSONFormat),
+				http.StatusNotAcceptable,
+			)
+		}
+	}
+}
+
+// standardMarshaler translates protobuf messages to and from the JSON of the standard Beacon API.
+// Messages are walked through the protobuf tags of their fields, which works alike for messages of
+// the gogo and the golang protobuf generators. Well known types, such as timestamps, keep their
+// protobuf JSON.
+type standardMarshaler struct {
+	// inner marshals the translated values and the well known types.
+	inner *gwruntime.JSONPb
+}
+
+var _ gwruntime.Marshaler = (*standardMarshaler)(nil)
+
+func newStandardMarshaler() *standardMarshaler {
+	return &standardMarshaler{inner: &gwruntime.JSONPb{OrigName: true, EmitDefaults: true}}
+}
+
+// ContentType of the standard JSON.
+func (m *standardMarshaler) ContentType() string {
+	return "application/json"
+}
+
+// Marshal a value into the standard JSON.
+func (m *standardMarshaler) Marshal(v interface{}) ([]byte, error) {
+	translated, err := m.toStandard(reflect.ValueOf(v))
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(translated)
+}
+
+// Unmarshal standard JSON into a value. Hex encoded bytes are converted to the base64 the
+// protobuf JSON expects, field names and quoted integers are accepted by it as is.
+func (m *standardMarshaler) Unmarshal(data []byte, v interface{}) error {
+	var generic interface{}
+	if err := json.Unmarshal(data, &generic); err != nil {
+		return err
+	}
+	converted, err := fromStandard(generic, reflect.TypeOf(v))
+	if err != nil {
+		return err
+	}
+	enc, err := json.Marshal(converted)
+	if err != nil {
+		return err
+	}
+	return m.inner.Unmarshal(enc, v)
+}
+
+// NewDecoder returns a decoder of the standard JSON.
+func (m *standardMarshaler) NewDecoder(r io.Reader) gwruntime.Decoder {
+	return gwruntime.DecoderFunc(func(v interface{}) error {
+		var raw json.RawMessage
+		if err := json.NewDecoder(r).Decode(&raw); err != nil {
+			return err
+		}
+		return m.Unmarshal(raw, v)
+	})
+}
+
+// NewEncoder returns an encoder of the standard JSON.
+func (m *standardMarshaler) NewEncoder(w io.Writer) gwruntime.Encoder {
+	return gwruntime.EncoderFunc(func(v interface{}) error {
+		enc, err := m.Marshal(v)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(enc)
+		return err
+	})
+}
+
+// Delimiter of streamed messages.
+func (m *standardMarshaler) Delimiter() []byte {
+	return []byte("\n")
+}
+
+func (m *standardMarshaler) toStandard(v reflect.Value) (interface{}, error) {
+	if !v.IsValid() {
+		return nil, nil
+	}
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil, nil
+		}
+		if v.Kind() == reflect.Ptr && isWellKnownType(v.Type().Elem()) {
+			enc, err := m.inner.Marshal(v.Interface())
+			if err != nil {
+				return nil, err
+			}
+			return json.RawMessage(enc), nil
+		}
+		return m.toStandard(v.Elem())
+	case reflect.Struct:
+		fields := make(map[string]interface{})
+		if err := m.structToStandard(v, fields); err != nil {
+			return nil, err
+		}
+		return fields, nil
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return hexutil.Encode(v.Bytes()), nil
+		}
+		items := make([]interface{}, v.Len())
+		for i := range items {
+			item, err := m.toStandard(v.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			items[i] = item
+		}
+		return items, nil
+	case reflect.Map:
+		entries := make(map[string]interface{}, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			entry, err := m.toStandard(iter.Value())
+			if err != nil {
+				return nil, err
+			}
+			entries[fmt.Sprint(iter.Key().Interface())] = entry
+		}
+		return entries, nil
+	case reflect.Int32:
+		// Enums are named like in the protobuf JSON.
+		if s, ok := v.Interface().(fmt.Stringer); ok {
+			return s.String(), nil
+		}
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	default:
+		return v.Interface(), nil
+	}
+}
+
+// structToStandard adds the protobuf fields of a message to fields, by their original names.
+// The field set in a oneof is added as if it was a field of the message.
+func (m *standardMarshaler) structToStandard(v reflect.Value, fields map[string]interface{}) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if _, ok := field.Tag.Lookup("protobuf_oneof"); ok {
+			oneof := v.Field(i)
+			if oneof.IsNil() || oneof.Elem().Kind() != reflect.Ptr || oneof.Elem().IsNil() {
+				continue
+			}
+			if err := m.structToStandard(oneof.Elem().Elem(), fields); err != nil {
+				return err
+			}
+			continue
+		}
+		name := protobufName(field)
+		if name == "" {
+			continue
+		}
+		translated, err := m.toStandard(v.Field(i))
+		if err != nil {
+			return errors.Wrapf(err, "could not translate field %s", name)
+		}
+		fields[name] = translated
+	}
+	return nil
+}
+
+// fromStandard converts the hex encoded bytes of a generic JSON value into base64, guided by the
+// type the value is unmarshaled into.
+func fromStandard(v interface{}, t reflect.Type) (interface{}, error) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if v == nil || isWellKnownType(t) {
+		return v, nil
+	}
+	switch t.Kind() {
+	case reflect.Struct:
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			return v, nil
+		}
+		types := make(map[string]reflect.Type)
+		addProtobufFieldTypes(t, types)
+		for name, value := range obj {
+			ft, ok := types[name]
+			if !ok {
+				continue
+			}
+			converted, err := fromStandard(value, ft)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid field %s", name)
+			}
+			obj[name] = converted
+		}
+		return obj, nil
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			s, ok := v.(string)
+			if !ok {
+				return v, nil
+			}
+			b, err := hexutil.Decode(s)
+			if err != nil {
+				return nil, err
+			}
+			return base64.StdEncoding.EncodeToString(b), nil
+		}
+		items, ok := v.([]interface{})
+		if !ok {
+			return v, nil
+		}
+		for i, item := range items {
+			converted, err := fromStandard(item, t.Elem())
+			if err != nil {
+				return nil, err
+			}
+			items[i] = converted
+		}
+		return items, nil
+	case reflect.Map:
+		entries, ok := v.(map[string]interface{})
+		if !ok {
+			return v, nil
+		}
+		for k, entry := range entries {
+			converted, err := fromStandard(entry, t.Elem())
+			if err != nil {
+				return nil, err
+			}
+			entries[k] = converted
+		}
+		return entries, nil
+	default:
+		return v, nil
+	}
+}
+
+// addProtobufFieldTypes adds the types of the protobuf fields of a message to types, by both their
+// original and their JSON names, which the protobuf JSON accepts alike. The fields of oneofs are
+// found through the oneof wrappers of gogo messages, and through the descriptors of other messages.
+func addProtobufFieldTypes(t reflect.Type, types map[string]reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if name := protobufName(field); name != "" {
+			types[name] = field.Type
+			if jsonName := protobufJSONName(field); jsonName != "" {
+				types[jsonName] = field.Type
+			}
+		}
+	}
+	switch msg := reflect.New(t).Interface().(type) {
+	case interface{ XXX_OneofWrappers() []interface{} }:
+		for _, wrapper := range msg.XXX_OneofWrappers() {
+			wt := reflect.TypeOf(wrapper)
+			if wt.Kind() == reflect.Ptr && wt.Elem().Kind() == reflect.Struct {
+				addProtobufFieldTypes(wt.Elem(), types)
+			}
+		}
+	case protoreflect.ProtoMessage:
+		m := msg.ProtoReflect()
+		fields := m.Descriptor().Fields()
+		for i := 0; i < fields.Len(); i++ {
+			fd := fields.Get(i)
+			if fd.ContainingOneof() == nil {
+				continue
+			}
+			var ft reflect.Type
+			switch fd.Kind() {
+			case protoreflect.BytesKind:
+				ft = reflect.TypeOf([]byte(nil))
+			case protoreflect.MessageKind:
+				ft = reflect.TypeOf(m.NewField(fd).Message().Interface())
+			default:
+				continue
+			}
+			types[string(fd.Name())] = ft
+			types[fd.JSONName()] = ft
+		}
+	}
+}
+
+// protobufName returns the original name of a field in its protobuf tag, or an empty string for
+// fields which are not protobuf fields.
+func protobufName(field reflect.StructField) string {
+	return protobufTagValue(field, "name=")
+}
+
+func protobufJSONName(field reflect.StructField) string {
+	return protobufTagValue(field, "json=")
+}
+
+func protobufTagValue(field reflect.StructField, key string) string {
+	tag, ok := field.Tag.Lookup("protobuf")
+	if !ok {
+		return ""
+	}
+	for _, part := range strings.Split(tag, ",") {
+		if strings.HasPrefix(part, key) {
+			return strings.TrimPrefix(part, key)
+		}
+	}
+	return ""
+}
+
+// isWellKnownType returns whether a message type is one of the well known protobuf types, whose
+// JSON is defined by the protobuf specification rather than by their fields.
+func isWellKnownType(t reflect.Type) bool {
+	pkg := t.PkgPath()
+	return pkg == "github.com/gogo/protobuf/types" ||
+		strings.HasPrefix(pkg, "github.com/golang/protobuf/ptypes") ||
+		strings.HasPrefix(pkg, "google.golang.org/protobuf/types/known")
+}
@@ -0,0 +1,168 @@
+package gateway
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/timestamp"
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	ethpbv1 "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1_gateway"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// TestStandardMarshaler_Translation runs messages of both protobuf generators used by the gateway
+// through the standard marshaler, and compares the result to the JSON of the standard API.
+func TestStandardMarshaler_Translation(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  interface{}
+		want string
+	}{
+		{
+			name: "gogo message",
+			msg: &ethpbv1.BlockHeaderResponse{Data: &ethpbv1.BlockHeaderContainer{
+				Root:      []byte{0xab, 0xcd},
+				Canonical: true,
+				Header: &ethpbv1.BeaconBlockHeaderContainer{
+					Message: &ethpbv1.BeaconBlockHeader{
+						Slot:          18446744073709551615,
+						ProposerIndex: 7,
+						ParentRoot:    []byte{0x01},
+					},
+				},
+			}},
+			want: `{"data":{"root":"0xabcd","canonical":true,"header":{"message":{"slot":"18446744073709551615",` +
+				`"proposer_index":"7","parent_root":"0x01","state_root":"0x","body_root":"0x"},"signature":"0x"}}}`,
+		},
+		{
+			name: "repeated messages and int32",
+			msg: &ethpb.ValidatorBalances{
+				Epoch:         3,
+				Balances:      []*ethpb.ValidatorBalances_Balance{{PublicKey: []byte{0x02}, Index: 1, Balance: 32}},
+				NextPageToken: "2",
+				TotalSize:     5,
+			},
+			want: `{"epoch":"3","balances":[{"public_key":"0x02","index":"1","balance":"32","status":""}],` +
+				`"next_page_token":"2","total_size":"5"}`,
+		},
+		{
+			name: "enum",
+			msg:  &ethpb.ValidatorStatusResponse{Status: ethpb.ValidatorStatus_ACTIVE, ActivationEpoch: 4},
+			want: `{"status":"ACTIVE","eth1_deposit_block_number":"0","deposit_inclusion_slot":"0",` +
+				`"activation_epoch":"4","position_in_activation_queue":"0"}`,
+		},
+		{
+			name: "oneof",
+			msg:  &ethpb.ListBlocksRequest{QueryFilter: &ethpb.ListBlocksRequest_Root{Root: []byte{0x03}}, PageSize: 10},
+			want: `{"root":"0x03","page_size":"10","page_token":""}`,
+		},
+		{
+			name: "well known type",
+			msg: &ethpb.Genesis{
+				GenesisTime:            &timestamp.Timestamp{Seconds: 1606824023},
+				DepositContractAddress: []byte{0x04},
+			},
+			want: `{"genesis_time":"2020-12-01T12:00:23Z","deposit_contract_address":"0x04","genesis_validators_root":"0x"}`,
+		},
+		{
+			name: "nil message",
+			msg:  &ethpbv1.BlockHeaderResponse{},
+			want: `{"data":null}`,
+		},
+	}
+	m := newStandardMarshaler()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			enc, err := m.Marshal(tt.msg)
+			require.NoError(t, err)
+			assert.Equal(t, normalizeJSON(t, tt.want), normalizeJSON(t, string(enc)))
+		})
+	}
+}
+
+func TestStandardMarshaler_Unmarshal(t *testing.T) {
+	m := newStandardMarshaler()
+
+	req := &ethpb.ListBlocksRequest{}
+	require.NoError(t, m.Unmarshal([]byte(`{"root":"0x0304","page_size":"10"}`), req))
+	assert.DeepEqual(t, []byte{0x03, 0x04}, req.GetRoot())
+	assert.Equal(t, int32(10), req.PageSize)
+
+	balances := &ethpb.ValidatorBalances{
+		Epoch:    3,
+		Balances: []*ethpb.ValidatorBalances_Balance{{PublicKey: []byte{0x02}, Index: 1, Balance: 32}},
+	}
+	enc, err := m.Marshal(balances)
+	require.NoError(t, err)
+	decoded := &ethpb.ValidatorBalances{}
+	require.NoError(t, m.Unmarshal(enc, decoded))
+	assert.Equal(t, true, proto.Equal(balances, decoded), "Round trip changed the message")
+
+	// The Prysm field names are accepted as well.
+	decoded = &ethpb.ValidatorBalances{}
+	require.NoError(t, m.Unmarshal([]byte(`{"balances":[{"publicKey":"0x02"}]}`), decoded))
+	assert.DeepEqual(t, []byte{0x02}, decoded.Balances[0].PublicKey)
+
+	assert.ErrorContains(t, "invalid field root", m.Unmarshal([]byte(`{"root":"0xzz"}`), &ethpb.ListBlocksRequest{}))
+}
+
+func TestJSONFormatHandler(t *testing.T) {
+	msg := &ethpb.Genesis{DepositContractAddress: []byte{0x04}}
+	serve := func(marshaler gwruntime.Marshaler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			enc, err := marshaler.Marshal(msg)
+			require.NoError(t, err)
+			_, err = w.Write(enc)
+			require.NoError(t, err)
+		})
+	}
+	handler := jsonFormatHandler(serve(newStandardMarshaler()), serve(&gwruntime.JSONPb{OrigName: false}))
+
+	tests := []struct {
+		name       string
+		path       string
+		header     string
+		wantFormat string
+		wantField  string
+	}{
+		{name: "standard route default", path: "/eth/v1/node/genesis", wantFormat: StandardJSONFormat, wantField: "deposit_contract_address"},
+		{name: "prysm route default", path: "/eth/v1alpha1/node/genesis", wantFormat: PrysmJSONFormat, wantField: "depositContractAddress"},
+		{name: "standard requested", path: "/eth/v1alpha1/node/genesis", header: "Standard", wantFormat: StandardJSONFormat, wantField: "deposit_contract_address"},
+		{name: "prysm requested", path: "/eth/v1/node/genesis", header: "prysm", wantFormat: PrysmJSONFormat, wantField: "depositContractAddress"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.header != "" {
+				req.Header.Set(JSONFormatHeader, tt.header)
+			}
+			rec := httptest.NewRecorder()
+			handler(rec, req)
+			require.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, tt.wantFormat, rec.Header().Get(JSONFormatHeader))
+			var fields map[string]interface{}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &fields))
+			_, ok := fields[tt.wantField]
+			assert.Equal(t, true, ok, "Missing field %s in %s", tt.wantField, rec.Body.String())
+		})
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/eth/v1/node/genesis", nil)
+	req.Header.Set(JSONFormatHeader, "xml")
+	rec := httptest.NewRecorder()
+	handler(rec, req)
+	assert.Equal(t, http.StatusNotAcceptable, rec.Code)
+}
+
+func normalizeJSON(t *testing.T, s string) string {
+	var v interface{}
+	require.NoError(t, json.Unmarshal([]byte(s), &v))
+	enc, err := json.Marshal(v)
+	require.NoError(t, err)
+	return string(enc)
+}
@@ -0,0 +1,160 @@
+package gateway
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	ptypes "github.com/gogo/protobuf/types"
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	ethpbv1 "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// peersQueryHeaders maps the query parameters filtering the peers of the standard node routes to the
+// request headers they are forwarded in.
+var peersQueryHeaders = map[string]string{
+	"state":     grpcutils.PeerStateHeader,
+	"direction": grpcutils.PeerDirectionHeader,
+}
+
+// identityHandler serves the standard /eth/v1/node/identity route.
+func identityHandler(client ethpbv1.BeaconNodeClient, marshaler gwruntime.Marshaler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		resp, err := client.GetIdentity(r.Context(), &ptypes.Empty{})
+		writeResponse(w, r, marshaler, nil /* md */, resp, err)
+	}
+}
+
+// peersHandler serves the standard /eth/v1/node/peers route, whose state and direction query parameters
+// may be repeated to list the peers of any of the states or directions.
+func peersHandler(client ethpbv1.BeaconNodeClient, marshaler gwruntime.Marshaler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		resp, err := client.ListPeers(peersContext(r), &ptypes.Empty{})
+		writeResponse(w, r, marshaler, nil /* md */, resp, err)
+	}
+}
+
+// peerHandler serves the standard /eth/v1/node/peers/{peer_id} route.
+func peerHandler(client ethpbv1.BeaconNodeClient, marshaler gwruntime.Marshaler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		peerID := strings.TrimPrefix(r.URL.Path, "/eth/v1/node/peers/")
+		if peerID == "" || strings.Contains(peerID, "/") {
+			http.NotFound(w, r)
+			return
+		}
+		resp, err := client.GetPeer(r.Context(), &ethpbv1.PeerRequest{PeerId: peerID})
+		writeResponse(w, r, marshaler, nil /* md */, resp, err)
+	}
+}
+
+// peerCountHandler serves the standard /eth/v1/node/peer_count route, which has no gRPC method, by counting the
+// peers listed by ListPeers in each connection state.
+func peerCountHandler(client ethpbv1.BeaconNodeClient, marshaler gwruntime.Marshaler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		resp, err := client.ListPeers(r.Context(), &ptypes.Empty{})
+		if err != nil {
+			writeResponse(w, r, marshaler, nil /* md */, nil, err)
+			return
+		}
+		counts := make(map[string]uint64, len(ethpbv1.ConnectionState_name))
+		for _, name := range ethpbv1.ConnectionState_name {
+			counts[strings.ToLower(name)] = 0
+		}
+		for _, p := range resp.Data {
+			counts[strings.ToLower(p.State.String())]++
+		}
+		writeResponse(w, r, marshaler, nil /* md */, map[string]interface{}{"data": counts}, nil)
+	}
+}
+
+// versionHandler serves the standard /eth/v1/node/version route.
+func versionHandler(client ethpbv1.BeaconNodeClient, marshaler gwruntime.Marshaler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		resp, err := client.GetVersion(r.Context(), &ptypes.Empty{})
+		writeResponse(w, r, marshaler, nil /* md */, resp, err)
+	}
+}
+
+// syncingHandler serves the standard /eth/v1/node/syncing route.
+func syncingHandler(client ethpbv1.BeaconNodeClient, marshaler gwruntime.Marshaler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		resp, err := client.GetSyncStatus(r.Context(), &ptypes.Empty{})
+		writeResponse(w, r, marshaler, nil /* md */, resp, err)
+	}
+}
+
+// healthHandler serves the standard /eth/v1/node/health route for load balancers. It answers with an empty body
+// and 200 OK when the node is ready, 206 Partial Content while it is syncing and 503 Service Unavailable when it
+// is not initialized or having issues. The syncing_status query parameter replaces the status code of a syncing
+// node, for load balancers only accepting some codes.
+func healthHandler(client ethpbv1.BeaconNodeClient, _ gwruntime.Marshaler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		syncingStatus := http.StatusPartialContent
+		if v := r.URL.Query().Get("syncing_status"); v != "" {
+			code, err := strconv.Atoi(v)
+			if err != nil || code < 100 || code > 599 {
+				http.Error(w, "Invalid syncing status: "+v, http.StatusBadRequest)
+				return
+			}
+			syncingStatus = code
+		}
+
+		var md metadata.MD
+		if _, err := client.GetHealth(r.Context(), &ptypes.Empty{}, grpc.Header(&md)); err != nil {
+			s, _ := status.FromError(err)
+			log.WithError(err).Debug("Beacon node is not healthy")
+			http.Error(w, s.Message(), http.StatusServiceUnavailable)
+			return
+		}
+		if v := md.Get(grpcutils.SyncingHeader); len(v) > 0 && v[0] == "true" {
+			w.WriteHeader(syncingStatus)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+// peersContext returns the context of a request listing peers, with its filters forwarded as request headers.
+func peersContext(r *http.Request) context.Context {
+	ctx := r.Context()
+	query := r.URL.Query()
+	for param, header := range peersQueryHeaders {
+		for _, v := range query[param] {
+			ctx = metadata.AppendToOutgoingContext(ctx, header, v)
+		}
+	}
+	return ctx
+}
@@ -0,0 +1,91 @@
+package gateway
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	ethpbv1 "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// nodeClient answers the node methods used by the health and peer count routes.
+type nodeClient struct {
+	ethpbv1.BeaconNodeClient
+	healthErr error
+	syncing   string
+	peers     []*ethpbv1.Peer
+	filters   metadata.MD
+}
+
+func (c *nodeClient) GetHealth(_ context.Context, _ *ptypes.Empty, opts ...grpc.CallOption) (*ptypes.Empty, error) {
+	if c.healthErr != nil {
+		return nil, c.healthErr
+	}
+	for _, opt := range opts {
+		if h, ok := opt.(grpc.HeaderCallOption); ok {
+			*h.HeaderAddr = metadata.Pairs(grpcutils.SyncingHeader, c.syncing)
+		}
+	}
+	return &ptypes.Empty{}, nil
+}
+
+func (c *nodeClient) ListPeers(ctx context.Context, _ *ptypes.Empty, _ ...grpc.CallOption) (*ethpbv1.PeersResponse, error) {
+	c.filters, _ = metadata.FromOutgoingContext(ctx)
+	return &ethpbv1.PeersResponse{Data: c.peers}, nil
+}
+
+func TestHealthHandler(t *testing.T) {
+	tests := []struct {
+		name   string
+		client *nodeClient
+		query  string
+		code   int
+	}{
+		{name: "ready", client: &nodeClient{syncing: "false"}, code: http.StatusOK},
+		{name: "syncing", client: &nodeClient{syncing: "true"}, code: http.StatusPartialContent},
+		{name: "syncing status", client: &nodeClient{syncing: "true"}, query: "?syncing_status=200", code: http.StatusOK},
+		{name: "invalid syncing status", client: &nodeClient{}, query: "?syncing_status=foo", code: http.StatusBadRequest},
+		{
+			name:   "not initialized",
+			client: &nodeClient{healthErr: status.Error(codes.Unavailable, "not initialized")},
+			code:   http.StatusServiceUnavailable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			healthHandler(tt.client, newStandardMarshaler())(rec, httptest.NewRequest(http.MethodGet, "/eth/v1/node/health"+tt.query, nil))
+			assert.Equal(t, tt.code, rec.Code)
+		})
+	}
+}
+
+func TestPeerCountHandler(t *testing.T) {
+	client := &nodeClient{peers: []*ethpbv1.Peer{
+		{State: ethpbv1.ConnectionState_CONNECTED},
+		{State: ethpbv1.ConnectionState_CONNECTED},
+		{State: ethpbv1.ConnectionState_DISCONNECTING},
+	}}
+	rec := httptest.NewRecorder()
+	peerCountHandler(client, newStandardMarshaler())(rec, httptest.NewRequest(http.MethodGet, "/eth/v1/node/peer_count", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, `{"data":{"connected":"2","connecting":"0","disconnected":"0","disconnecting":"1"}}`, rec.Body.String())
+}
+
+func TestPeersHandler_ForwardsFilters(t *testing.T) {
+	client := &nodeClient{}
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/eth/v1/node/peers?state=connected&state=connecting&direction=inbound", nil)
+	peersHandler(client, newStandardMarshaler())(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.DeepEqual(t, []string{"connected", "connecting"}, client.filters.Get(grpcutils.PeerStateHeader))
+	assert.DeepEqual(t, []string{"inbound"}, client.filters.Get(grpcutils.PeerDirectionHeader))
+}
@@ -6,6 +6,7 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"strings"
 
@@ -23,6 +24,8 @@ var (
 	allowedOrigins          = flag.String("corsdomain", "localhost:4242", "A comma separated list of CORS domains to allow")
 	enableDebugRPCEndpoints = flag.Bool("enable-debug-rpc-endpoints", false, "Enable debug rpc endpoints such as /eth/v1alpha1/beacon/state")
 	grpcMaxMsgSize          = flag.Int("grpc-max-msg-size", 1<<22, "Integer to define max recieve message call size")
+	adminPort               = flag.Int("admin-port", 0, "Port to serve the debug endpoints on instead of --port, 0 serves them on --port")
+	adminTokenFile          = flag.String("admin-token-file", "", "Path to a file holding the bearer token required on --admin-port")
 )
 
 func init() {
@@ -47,6 +50,17 @@ func main() {
 		*enableDebugRPCEndpoints,
 		uint64(*grpcMaxMsgSize),
 	)
+	if *adminPort != 0 {
+		token := ""
+		if *adminTokenFile != "" {
+			enc, err := ioutil.ReadFile(*adminTokenFile)
+			if err != nil {
+				log.Fatalf("Could not read admin token: %v", err)
+			}
+			token = strings.TrimSpace(string(enc))
+		}
+		gw.WithAdminListener(fmt.Sprintf("%s:%d", *host, *adminPort), token)
+	}
 	mux.HandleFunc("/swagger/", gateway.SwaggerServer())
 	mux.HandleFunc("/healthz", healthzServer(gw))
 	gw.Start()
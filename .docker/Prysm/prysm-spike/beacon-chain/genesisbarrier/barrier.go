@@ -0,0 +1,147 @@
+// Package genesisbarrier holds back the start of a beacon node of a new devnet until its genesis
+// assets are published and genesis is near, so every node of the devnet starts together instead
+// of failing until the genesis state exists.
+package genesisbarrier
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "genesis-barrier")
+
+const (
+	// DefaultPollInterval is how often the genesis state URL is polled until the state is published.
+	DefaultPollInterval = 5 * time.Second
+	// countdownInterval is how often the time left until the start is logged.
+	countdownInterval = 30 * time.Second
+	// requestTimeout bounds a single request of the genesis state.
+	requestTimeout = 30 * time.Second
+)
+
+// Config of the genesis barrier.
+type Config struct {
+	// GenesisStateURL is polled until it serves the SSZ genesis state, which is written to
+	// GenesisStatePath. Without it, only the genesis time is waited for.
+	GenesisStateURL  string
+	GenesisStatePath string
+	// GenesisTime is the unix time of genesis. It defaults to the genesis time of the fetched state.
+	GenesisTime uint64
+	// StartDelta is how long before genesis the barrier is released.
+	StartDelta   time.Duration
+	PollInterval time.Duration
+}
+
+// Wait blocks until the genesis state is fetched, if a genesis state URL is configured, and
+// until the genesis time minus the start delta, logging a countdown meanwhile.
+func Wait(ctx context.Context, cfg *Config) error {
+	if cfg.PollInterval == 0 {
+		cfg.PollInterval = DefaultPollInterval
+	}
+	genesisTime := cfg.GenesisTime
+	if cfg.GenesisStateURL != "" {
+		st, err := pollGenesisState(ctx, cfg)
+		if err != nil {
+			return err
+		}
+		if genesisTime == 0 {
+			genesisTime = st.GenesisTime
+		} else if st.GenesisTime != genesisTime {
+			return fmt.Errorf(
+				"genesis time %d of the fetched genesis state does not match the configured genesis time %d",
+				st.GenesisTime, genesisTime,
+			)
+		}
+	}
+	if genesisTime == 0 {
+		return nil
+	}
+	return waitUntil(ctx, time.Unix(int64(genesisTime), 0), cfg.StartDelta)
+}
+
+// pollGenesisState fetches the genesis state from the configured URL until it is published, and
+// writes it to the configured path.
+func pollGenesisState(ctx context.Context, cfg *Config) (*pb.BeaconState, error) {
+	ticker := time.NewTicker(cfg.PollInterval)
+	defer ticker.Stop()
+	for {
+		enc, err := fetchGenesisState(ctx, cfg.GenesisStateURL)
+		if err == nil {
+			st := &pb.BeaconState{}
+			if err := st.UnmarshalSSZ(enc); err != nil {
+				return nil, errors.Wrap(err, "could not unmarshal genesis state")
+			}
+			if err := fileutil.WriteFile(cfg.GenesisStatePath, enc); err != nil {
+				return nil, errors.Wrap(err, "could not write genesis state")
+			}
+			log.WithFields(logrus.Fields{
+				"path":        cfg.GenesisStatePath,
+				"genesisTime": time.Unix(int64(st.GenesisTime), 0),
+				"validators":  len(st.Validators),
+			}).Info("Fetched genesis state")
+			return st, nil
+		}
+		log.WithError(err).WithField("url", cfg.GenesisStateURL).Info("Waiting for the genesis state to be published")
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func fetchGenesisState(ctx context.Context, url string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Debug("Could not close response body")
+		}
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("genesis state request returned status %d", resp.StatusCode)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
+
+// waitUntil blocks until delta before genesis, logging the time left every countdown interval.
+func waitUntil(ctx context.Context, genesis time.Time, delta time.Duration) error {
+	start := genesis.Add(-delta)
+	for {
+		left := start.Sub(timeutils.Now())
+		if left <= 0 {
+			log.WithField("genesisTime", genesis).Info("Genesis barrier released")
+			return nil
+		}
+		log.WithFields(logrus.Fields{
+			"genesisTime": genesis,
+			"startsIn":    left.Round(time.Second),
+		}).Info("Waiting for genesis before starting the beacon node")
+		wait := left
+		if wait > countdownInterval {
+			wait = countdownInterval
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package genesisbarrier
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+)
+
+func genesisStateServer(t *testing.T, genesisTime uint64, publishAfter int32) (*httptest.Server, []byte) {
+	st, _ := testutil.DeterministicGenesisState(t, 4)
+	require.NoError(t, st.SetGenesisTime(genesisTime))
+	enc, err := st.InnerStateUnsafe().MarshalSSZ()
+	require.NoError(t, err)
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) <= publishAfter {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, err := w.Write(enc)
+		require.NoError(t, err)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, enc
+}
+
+func TestWait_PollsGenesisState(t *testing.T) {
+	genesisTime := uint64(timeutils.Now().Add(-time.Minute).Unix())
+	srv, enc := genesisStateServer(t, genesisTime, 2)
+	path := filepath.Join(t.TempDir(), "genesis.ssz")
+
+	require.NoError(t, Wait(context.Background(), &Config{
+		GenesisStateURL:  srv.URL,
+		GenesisStatePath: path,
+		PollInterval:     10 * time.Millisecond,
+	}))
+	written, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	assert.DeepEqual(t, enc, written)
+	st := &pb.BeaconState{}
+	require.NoError(t, st.UnmarshalSSZ(written))
+	assert.Equal(t, genesisTime, st.GenesisTime)
+}
+
+func TestWait_GenesisTimeMismatch(t *testing.T) {
+	srv, _ := genesisStateServer(t, 1000, 0)
+	err := Wait(context.Background(), &Config{
+		GenesisStateURL:  srv.URL,
+		GenesisStatePath: filepath.Join(t.TempDir(), "genesis.ssz"),
+		GenesisTime:      2000,
+	})
+	assert.ErrorContains(t, "does not match the configured genesis time", err)
+}
+
+func TestWait_UntilGenesisMinusDelta(t *testing.T) {
+	// Released right away when genesis is within the start delta.
+	start := time.Now()
+	require.NoError(t, Wait(context.Background(), &Config{
+		GenesisTime: uint64(timeutils.Now().Add(time.Minute).Unix()),
+		StartDelta:  time.Hour,
+	}))
+	assert.Equal(t, true, time.Since(start) < time.Second)
+
+	genesis := timeutils.Now().Add(2 * time.Second).Truncate(time.Second)
+	require.NoError(t, Wait(context.Background(), &Config{GenesisTime: uint64(genesis.Unix())}))
+	assert.Equal(t, false, timeutils.Now().Before(genesis))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := Wait(ctx, &Config{GenesisTime: uint64(timeutils.Now().Add(time.Hour).Unix())})
+	assert.ErrorContains(t, context.Canceled.Error(), err)
+}
@@ -51,7 +51,7 @@ type Config struct {
 // NewService is an interoperability testing service to inject a deterministically generated genesis state
 // into the beacon chain database and running services at start up. This service should not be used in production
 // as it does not have any value other than ease of use for testing purposes.
-func NewService(ctx context.Context, cfg *Config) *Service {
+func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 	log.Warn("Saving generated genesis state in database for interop testing")
 	ctx, cancel := context.WithCancel(ctx)
 
@@ -68,30 +68,30 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 	if s.genesisPath != "" {
 		data, err := ioutil.ReadFile(s.genesisPath)
 		if err != nil {
-			log.Fatalf("Could not read pre-loaded state: %v", err)
+			return nil, errors.Wrap(err, "could not read pre-loaded state")
 		}
 		genesisState := &pb.BeaconState{}
 		if err := genesisState.UnmarshalSSZ(data); err != nil {
-			log.Fatalf("Could not unmarshal pre-loaded state: %v", err)
+			return nil, errors.Wrap(err, "could not unmarshal pre-loaded state")
 		}
 		genesisTrie, err := stateTrie.InitializeFromProto(genesisState)
 		if err != nil {
-			log.Fatalf("Could not get state trie: %v", err)
+			return nil, errors.Wrap(err, "could not get state trie")
 		}
 		if err := s.saveGenesisState(ctx, genesisTrie); err != nil {
-			log.Fatalf("Could not save interop genesis state %v", err)
+			return nil, errors.Wrap(err, "could not save interop genesis state")
 		}
-		return s
+		return s, nil
 	}
 
 	// Save genesis state in db
 	genesisState, _, err := interop.GenerateGenesisState(s.genesisTime, s.numValidators)
 	if err != nil {
-		log.Fatalf("Could not generate interop genesis state: %v", err)
+		return nil, errors.Wrap(err, "could not generate interop genesis state")
 	}
 	genesisTrie, err := stateTrie.InitializeFromProto(genesisState)
 	if err != nil {
-		log.Fatalf("Could not get state trie: %v", err)
+		return nil, errors.Wrap(err, "could not get state trie")
 	}
 	if s.genesisTime == 0 {
 		// Generated genesis time; fetch it
@@ -99,15 +99,15 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 	}
 	gRoot, err := genesisTrie.HashTreeRoot(s.ctx)
 	if err != nil {
-		log.Fatalf("Could not hash tree root genesis state: %v", err)
+		return nil, errors.Wrap(err, "could not hash tree root genesis state")
 	}
 	go slotutil.CountdownToGenesis(ctx, time.Unix(int64(s.genesisTime), 0), s.numValidators, gRoot)
 
 	if err := s.saveGenesisState(ctx, genesisTrie); err != nil {
-		log.Fatalf("Could not save interop genesis state %v", err)
+		return nil, errors.Wrap(err, "could not save interop genesis state")
 	}
 
-	return s
+	return s, nil
 }
 
 // Start initializes the genesis state from configured flags.
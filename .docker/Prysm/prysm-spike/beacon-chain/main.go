@@ -12,8 +12,11 @@ import (
 	joonix "github.com/joonix/log"
 	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/beacon-chain/node"
+	"github.com/prysmaticlabs/prysm/beacon-chain/replica"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/configdump"
 	"github.com/prysmaticlabs/prysm/shared/debug"
+	"github.com/prysmaticlabs/prysm/shared/exitcode"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/journald"
 	"github.com/prysmaticlabs/prysm/shared/logutil"
@@ -32,11 +35,30 @@ var appFlags = []cli.Flag{
 	flags.RPCPort,
 	flags.CertFlag,
 	flags.KeyFlag,
+	flags.TLSAutoFlag,
+	flags.TLSAutoHostsFlag,
+	flags.TLSAutoCADirFlag,
 	flags.DisableGRPCGateway,
+	flags.RPCReadOnly,
+	flags.DisableProposalValidation,
+	flags.DBReplicaSnapshotDir,
+	flags.DBReplicaSnapshotEpochs,
+	flags.EraDir,
+	flags.BackfillBlocksPerSecond,
 	flags.GRPCGatewayHost,
 	flags.GRPCGatewayPort,
 	flags.GPRCGatewayCorsDomain,
+	flags.GRPCGatewayAdminHost,
+	flags.GRPCGatewayAdminPort,
+	flags.GRPCGatewayAdminTokenFile,
 	flags.MinSyncPeers,
+	flags.InitialSyncPeers,
+	flags.InitialSyncStaticPeersOnly,
+	flags.SyncPeerSelection,
+	flags.BlockProviderBatchWeight,
+	flags.BlockProviderBlocksCap,
+	flags.BlockProviderDecay,
+	flags.BlockProviderDecayInterval,
 	flags.ContractDeploymentBlock,
 	flags.SetGCPercent,
 	flags.HeadSync,
@@ -44,6 +66,9 @@ var appFlags = []cli.Flag{
 	flags.DisableDiscv5,
 	flags.BlockBatchLimit,
 	flags.BlockBatchLimitBurstFactor,
+	flags.SyncCatchUpThreshold,
+	flags.PeerStatusRefreshSlots,
+	flags.PeerStatusStaleSlots,
 	flags.InteropMockEth1DataVotesFlag,
 	flags.InteropGenesisStateFlag,
 	flags.InteropNumValidatorsFlag,
@@ -58,12 +83,30 @@ var appFlags = []cli.Flag{
 	flags.NetworkID,
 	flags.WeakSubjectivityCheckpt,
 	flags.Eth1HeaderReqLimit,
+	flags.DepositSnapshot,
+	flags.GenesisStateURL,
+	flags.GenesisBarrierTime,
+	flags.GenesisBarrierDelta,
+	flags.DBSyncMode,
+	flags.DBSyncBatchSize,
+	flags.AttestationValidationWorkers,
+	flags.AttestationSubnetLookaheadSlots,
+	flags.AttestationPoolSpillThreshold,
+	flags.TraceBlockPropagation,
+	flags.PublishMeshWait,
+	flags.GossipSeenMessageTTL,
+	flags.TraceGossipDuplicates,
+	flags.GossipDuplicatePenaltyThreshold,
 	cmd.MinimalConfigFlag,
 	cmd.E2EConfigFlag,
 	cmd.RPCMaxPageSizeFlag,
 	cmd.BootstrapNode,
+	cmd.BootstrapDNS,
 	cmd.NoDiscovery,
 	cmd.StaticPeers,
+	cmd.PeerListURL,
+	cmd.P2PLocalDiscovery,
+	cmd.P2PLocalDiscoveryInterface,
 	cmd.RelayNode,
 	cmd.P2PUDPPort,
 	cmd.P2PTCPPort,
@@ -75,6 +118,10 @@ var appFlags = []cli.Flag{
 	cmd.P2PMetadata,
 	cmd.P2PAllowList,
 	cmd.P2PDenyList,
+	cmd.P2PMaxInboundPerIP,
+	cmd.P2PMaxInboundPerSubnet,
+	cmd.P2PInboundSubnetPrefix,
+	cmd.P2PMaxInboundRatio,
 	cmd.DataDirFlag,
 	cmd.VerbosityFlag,
 	cmd.EnableTracingFlag,
@@ -88,6 +135,10 @@ var appFlags = []cli.Flag{
 	cmd.ForceClearDB,
 	cmd.LogFormat,
 	cmd.MaxGoroutines,
+	cmd.DiskWatchIntervalFlag,
+	cmd.DiskWarningThresholdFlag,
+	cmd.DiskCriticalThresholdFlag,
+	cmd.DiskEmergencyPruneFlag,
 	debug.PProfFlag,
 	debug.PProfAddrFlag,
 	debug.PProfPortFlag,
@@ -95,9 +146,11 @@ var appFlags = []cli.Flag{
 	debug.CPUProfileFlag,
 	debug.TraceFlag,
 	cmd.LogFileName,
+	cmd.LogDedup,
 	cmd.EnableUPnPFlag,
 	cmd.ConfigFileFlag,
 	cmd.ChainConfigFileFlag,
+	cmd.PrintConfigFlag,
 	cmd.GrpcMaxCallRecvMsgSizeFlag,
 	cmd.AcceptTosFlag,
 }
@@ -113,10 +166,19 @@ func main() {
 	app.Usage = "this is a beacon chain implementation for Ethereum 2.0"
 	app.Action = startNode
 	app.Version = version.GetVersion()
+	app.Commands = []*cli.Command{
+		configdump.SupportBundleCommand(node.Configure, flags.MonitoringPortFlag),
+		replica.Command(node.Configure),
+	}
 
 	app.Flags = appFlags
 
-	app.Before = func(ctx *cli.Context) error {
+	app.OnUsageError = func(_ *cli.Context, err error, _ bool) error {
+		return exitcode.Wrap(err, exitcode.ConfigError)
+	}
+
+	// Failures to set up the process from its flags are config errors.
+	setup := func(ctx *cli.Context) error {
 		// Load flags from config file, if specified.
 		if err := cmd.LoadFlagsFromConfig(ctx, app.Flags); err != nil {
 			return err
@@ -154,6 +216,9 @@ func main() {
 				log.WithError(err).Error("Failed to configuring logging to disk.")
 			}
 		}
+		if err := logutil.ConfigureDeduplication(ctx.StringSlice(cmd.LogDedup.Name)); err != nil {
+			return err
+		}
 
 		if err := cmd.ExpandWeb3EndpointIfFile(ctx, flags.HTTPWeb3ProviderFlag); err != nil {
 			return err
@@ -165,6 +230,9 @@ func main() {
 		runtime.GOMAXPROCS(runtime.NumCPU())
 		return debug.Setup(ctx)
 	}
+	app.Before = func(ctx *cli.Context) error {
+		return exitcode.Wrap(setup(ctx), exitcode.ConfigError)
+	}
 
 	defer func() {
 		if x := recover(); x != nil {
@@ -174,11 +242,18 @@ func main() {
 	}()
 
 	if err := app.Run(os.Args); err != nil {
-		log.Error(err.Error())
+		code := exitcode.FromError(err)
+		log.WithField("exitCode", int(code)).Error(err.Error())
+		os.Exit(int(code))
 	}
 }
 
 func startNode(ctx *cli.Context) error {
+	if ctx.Bool(cmd.PrintConfigFlag.Name) {
+		node.Configure(ctx)
+		return configdump.Print(ctx, os.Stdout)
+	}
+
 	// verify if ToS accepted
 	if err := tos.VerifyTosAcceptedOrPrompt(ctx); err != nil {
 		return err
@@ -6,8 +6,10 @@ package node
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"fmt"
 	"io/ioutil"
+	"net"
 	"os"
 	"os/signal"
 	"path/filepath"
@@ -16,30 +18,42 @@ import (
 	"syscall"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/era"
 	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
 	"github.com/prysmaticlabs/prysm/beacon-chain/gateway"
+	"github.com/prysmaticlabs/prysm/beacon-chain/genesisbarrier"
 	interopcoldstart "github.com/prysmaticlabs/prysm/beacon-chain/interop-cold-start"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/localops"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/replica"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	regularsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync/backfill"
 	initialsync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync"
+	sharedpb "github.com/prysmaticlabs/prysm/proto/shared/v1"
 	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/autotls"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/configdump"
 	"github.com/prysmaticlabs/prysm/shared/debug"
+	"github.com/prysmaticlabs/prysm/shared/diskwatch"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/inventory"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/prereq"
 	"github.com/prysmaticlabs/prysm/shared/prometheus"
@@ -67,6 +81,8 @@ type BeaconNode struct {
 	stop              chan struct{} // Channel to wait for termination notifications.
 	db                db.Database
 	stateSummaryCache *cache.StateSummaryCache
+	htrCache          *cache.HashTreeRootCache
+	blockPropagation  *p2p.BlockPropagationTracer
 	attestationPool   attestations.Pool
 	exitPool          *voluntaryexits.Pool
 	slashingsPool     *slashings.Pool
@@ -76,6 +92,10 @@ type BeaconNode struct {
 	opFeed            *event.Feed
 	forkChoiceStore   forkchoice.ForkChoicer
 	stateGen          *stategen.State
+	diskWatch         *diskwatch.Service
+	eraStore          *era.Store
+	tlsCert           *tls.Certificate
+	tlsCA             *autotls.CA
 }
 
 // NewBeaconNode creates a new node instance, sets up configuration options, and registers
@@ -94,63 +114,7 @@ func NewBeaconNode(cliCtx *cli.Context) (*BeaconNode, error) {
 	// Warn if user's platform is not supported
 	prereq.WarnIfNotSupported(cliCtx.Context)
 
-	featureconfig.ConfigureBeaconChain(cliCtx)
-	cmd.ConfigureBeaconChain(cliCtx)
-	flags.ConfigureGlobalFlags(cliCtx)
-
-	if cliCtx.IsSet(cmd.ChainConfigFileFlag.Name) {
-		chainConfigFileName := cliCtx.String(cmd.ChainConfigFileFlag.Name)
-		params.LoadChainConfigFile(chainConfigFileName)
-	}
-
-	if cliCtx.Bool(flags.HistoricalSlasherNode.Name) {
-		c := params.BeaconConfig()
-		// Save a state every 4 epochs.
-		c.SlotsPerArchivedPoint = params.BeaconConfig().SlotsPerEpoch * 4
-		params.OverrideBeaconConfig(c)
-		cmdConfig := cmd.Get()
-		// Allow up to 4096 attestations at a time to be requested from the beacon nde.
-		cmdConfig.MaxRPCPageSize = int(params.BeaconConfig().SlotsPerEpoch * params.BeaconConfig().MaxAttestations)
-		cmd.Init(cmdConfig)
-		log.Warnf(
-			"Setting %d slots per archive point and %d max RPC page size for historical slasher usage. This requires additional storage",
-			c.SlotsPerArchivedPoint,
-			cmdConfig.MaxRPCPageSize,
-		)
-	}
-
-	if cliCtx.IsSet(flags.SlotsPerArchivedPoint.Name) {
-		c := params.BeaconConfig()
-		c.SlotsPerArchivedPoint = uint64(cliCtx.Int(flags.SlotsPerArchivedPoint.Name))
-		params.OverrideBeaconConfig(c)
-	}
-
-	// Setting chain network specific flags.
-	if cliCtx.IsSet(flags.DepositContractFlag.Name) {
-		c := params.BeaconNetworkConfig()
-		c.DepositContractAddress = cliCtx.String(flags.DepositContractFlag.Name)
-		params.OverrideBeaconNetworkConfig(c)
-	}
-	if cliCtx.IsSet(cmd.BootstrapNode.Name) {
-		c := params.BeaconNetworkConfig()
-		c.BootstrapNodes = cliCtx.StringSlice(cmd.BootstrapNode.Name)
-		params.OverrideBeaconNetworkConfig(c)
-	}
-	if cliCtx.IsSet(flags.ContractDeploymentBlock.Name) {
-		networkCfg := params.BeaconNetworkConfig()
-		networkCfg.ContractDeploymentBlock = uint64(cliCtx.Int(flags.ContractDeploymentBlock.Name))
-		params.OverrideBeaconNetworkConfig(networkCfg)
-	}
-	if cliCtx.IsSet(flags.ChainID.Name) {
-		c := params.BeaconNetworkConfig()
-		c.ChainID = cliCtx.Uint64(flags.ChainID.Name)
-		params.OverrideBeaconNetworkConfig(c)
-	}
-	if cliCtx.IsSet(flags.NetworkID.Name) {
-		c := params.BeaconNetworkConfig()
-		c.NetworkID = cliCtx.Uint64(flags.NetworkID.Name)
-		params.OverrideBeaconNetworkConfig(c)
-	}
+	Configure(cliCtx)
 
 	registry := shared.NewServiceRegistry()
 
@@ -166,14 +130,28 @@ func NewBeaconNode(cliCtx *cli.Context) (*BeaconNode, error) {
 		exitPool:          voluntaryexits.NewPool(),
 		slashingsPool:     slashings.NewPool(),
 		stateSummaryCache: cache.NewStateSummaryCache(),
+		htrCache:          cache.NewHashTreeRootCache(),
+	}
+	if cliCtx.Bool(flags.TraceBlockPropagation.Name) {
+		beacon.blockPropagation = p2p.NewBlockPropagationTracer()
 	}
 
 	if err := beacon.startDB(cliCtx); err != nil {
 		return nil, err
 	}
 
+	if err := beacon.awaitGenesisBarrier(cliCtx); err != nil {
+		return nil, err
+	}
+
 	beacon.startStateGen()
 
+	if cliCtx.Duration(cmd.DiskWatchIntervalFlag.Name) > 0 {
+		if err := beacon.registerDiskWatchService(); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := beacon.registerP2P(cliCtx); err != nil {
 		return nil, err
 	}
@@ -196,6 +174,16 @@ func NewBeaconNode(cliCtx *cli.Context) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	if err := beacon.registerLocalOperationsService(); err != nil {
+		return nil, err
+	}
+
+	if cliCtx.String(flags.EraDir.Name) != "" {
+		if err := beacon.registerEraService(); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := beacon.registerInitialSyncService(); err != nil {
 		return nil, err
 	}
@@ -204,10 +192,22 @@ func NewBeaconNode(cliCtx *cli.Context) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	if cliCtx.Uint64(flags.BackfillBlocksPerSecond.Name) > 0 {
+		if err := beacon.registerBackfillService(); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := beacon.registerRPCService(); err != nil {
 		return nil, err
 	}
 
+	if cliCtx.String(flags.DBReplicaSnapshotDir.Name) != "" {
+		if err := beacon.registerReplicaSnapshotService(); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := beacon.registerGRPCGateway(); err != nil {
 		return nil, err
 	}
@@ -221,6 +221,68 @@ func NewBeaconNode(cliCtx *cli.Context) (*BeaconNode, error) {
 	return beacon, nil
 }
 
+// Configure applies the global feature flags, chain config and network config of the beacon node
+// from the flags.
+func Configure(cliCtx *cli.Context) {
+	featureconfig.ConfigureBeaconChain(cliCtx)
+	cmd.ConfigureBeaconChain(cliCtx)
+	flags.ConfigureGlobalFlags(cliCtx)
+
+	if cliCtx.IsSet(cmd.ChainConfigFileFlag.Name) {
+		chainConfigFileName := cliCtx.String(cmd.ChainConfigFileFlag.Name)
+		params.LoadChainConfigFile(chainConfigFileName)
+	}
+
+	if cliCtx.Bool(flags.HistoricalSlasherNode.Name) {
+		c := params.BeaconConfig()
+		// Save a state every 4 epochs.
+		c.SlotsPerArchivedPoint = params.BeaconConfig().SlotsPerEpoch * 4
+		params.OverrideBeaconConfig(c)
+		cmdConfig := cmd.Get()
+		// Allow up to 4096 attestations at a time to be requested from the beacon nde.
+		cmdConfig.MaxRPCPageSize = int(params.BeaconConfig().SlotsPerEpoch * params.BeaconConfig().MaxAttestations)
+		cmd.Init(cmdConfig)
+		log.Warnf(
+			"Setting %d slots per archive point and %d max RPC page size for historical slasher usage. This requires additional storage",
+			c.SlotsPerArchivedPoint,
+			cmdConfig.MaxRPCPageSize,
+		)
+	}
+
+	if cliCtx.IsSet(flags.SlotsPerArchivedPoint.Name) {
+		c := params.BeaconConfig()
+		c.SlotsPerArchivedPoint = uint64(cliCtx.Int(flags.SlotsPerArchivedPoint.Name))
+		params.OverrideBeaconConfig(c)
+	}
+
+	// Setting chain network specific flags.
+	if cliCtx.IsSet(flags.DepositContractFlag.Name) {
+		c := params.BeaconNetworkConfig()
+		c.DepositContractAddress = cliCtx.String(flags.DepositContractFlag.Name)
+		params.OverrideBeaconNetworkConfig(c)
+	}
+	if cliCtx.IsSet(cmd.BootstrapNode.Name) {
+		c := params.BeaconNetworkConfig()
+		c.BootstrapNodes = cliCtx.StringSlice(cmd.BootstrapNode.Name)
+		params.OverrideBeaconNetworkConfig(c)
+	}
+	if cliCtx.IsSet(flags.ContractDeploymentBlock.Name) {
+		networkCfg := params.BeaconNetworkConfig()
+		networkCfg.ContractDeploymentBlock = uint64(cliCtx.Int(flags.ContractDeploymentBlock.Name))
+		params.OverrideBeaconNetworkConfig(networkCfg)
+	}
+	if cliCtx.IsSet(flags.ChainID.Name) {
+		c := params.BeaconNetworkConfig()
+		c.ChainID = cliCtx.Uint64(flags.ChainID.Name)
+		params.OverrideBeaconNetworkConfig(c)
+	}
+	if cliCtx.IsSet(flags.NetworkID.Name) {
+		c := params.BeaconNetworkConfig()
+		c.NetworkID = cliCtx.Uint64(flags.NetworkID.Name)
+		params.OverrideBeaconNetworkConfig(c)
+	}
+}
+
 // StateFeed implements statefeed.Notifier.
 func (b *BeaconNode) StateFeed() *event.Feed {
 	return b.stateFeed
@@ -296,7 +358,11 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context) error {
 
 	log.WithField("database-path", dbPath).Info("Checking DB")
 
-	d, err := db.NewDB(dbPath, b.stateSummaryCache)
+	dbConfig := &kv.Config{}
+	if flags.Get().DBSyncMode == flags.DBSyncModeBatched {
+		dbConfig.BlockSyncBatchSize = flags.Get().DBSyncBatchSize
+	}
+	d, err := db.NewDB(dbPath, b.stateSummaryCache, dbConfig)
 	if err != nil {
 		return err
 	}
@@ -318,7 +384,7 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context) error {
 		if err := d.ClearDB(); err != nil {
 			return errors.Wrap(err, "could not clear database")
 		}
-		d, err = db.NewDB(dbPath, b.stateSummaryCache)
+		d, err = db.NewDB(dbPath, b.stateSummaryCache, dbConfig)
 		if err != nil {
 			return errors.Wrap(err, "could not create new database")
 		}
@@ -339,10 +405,64 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context) error {
 	return nil
 }
 
+// awaitGenesisBarrier holds back the start of the node of a new devnet until its genesis state is
+// published and genesis is near. A node restarted after genesis starts right away.
+func (b *BeaconNode) awaitGenesisBarrier(cliCtx *cli.Context) error {
+	stateURL := cliCtx.String(flags.GenesisStateURL.Name)
+	genesisTime := cliCtx.Uint64(flags.GenesisBarrierTime.Name)
+	if stateURL == "" && genesisTime == 0 {
+		return nil
+	}
+	cfg := &genesisbarrier.Config{
+		GenesisStateURL: stateURL,
+		GenesisTime:     genesisTime,
+		StartDelta:      cliCtx.Duration(flags.GenesisBarrierDelta.Name),
+	}
+	if stateURL != "" && cliCtx.IsSet(flags.InteropGenesisStateFlag.Name) {
+		return fmt.Errorf("--%s and --%s cannot be used together", flags.GenesisStateURL.Name, flags.InteropGenesisStateFlag.Name)
+	}
+	genesisState, err := b.db.GenesisState(b.ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve genesis state")
+	}
+	// A restarted node already saved the genesis state, which must not be loaded again.
+	if genesisState != nil {
+		return nil
+	}
+	if stateURL != "" {
+		// The fetched state is loaded as a pre-loaded genesis state.
+		cfg.GenesisStatePath = filepath.Join(cliCtx.String(cmd.DataDirFlag.Name), "genesis.ssz")
+		if err := cliCtx.Set(flags.InteropGenesisStateFlag.Name, cfg.GenesisStatePath); err != nil {
+			return err
+		}
+	}
+	return genesisbarrier.Wait(b.ctx, cfg)
+}
+
 func (b *BeaconNode) startStateGen() {
 	b.stateGen = stategen.New(b.db, b.stateSummaryCache)
 }
 
+// registerDiskWatchService watches the free space of the database volume. Under pressure,
+// archived states are not saved, and at the critical level the hot states saved to the database
+// during long periods without finality can be deleted.
+func (b *BeaconNode) registerDiskWatchService() error {
+	s, err := diskwatch.NewService(b.ctx, &diskwatch.Config{
+		Dir:            b.db.DatabasePath(),
+		WarningBytes:   b.cliCtx.Uint64(cmd.DiskWarningThresholdFlag.Name) * 1024 * 1024,
+		CriticalBytes:  b.cliCtx.Uint64(cmd.DiskCriticalThresholdFlag.Name) * 1024 * 1024,
+		Interval:       b.cliCtx.Duration(cmd.DiskWatchIntervalFlag.Name),
+		EmergencyPrune: b.cliCtx.Bool(cmd.DiskEmergencyPruneFlag.Name),
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not register disk watch service")
+	}
+	s.RegisterPruner("hot-states", b.stateGen.DeleteHotStatesInDB)
+	b.stateGen.PauseArchivalWhen(s.UnderPressure)
+	b.diskWatch = s
+	return b.services.RegisterService(s)
+}
+
 func readbootNodes(fileName string) ([]string, error) {
 	fileContent, err := ioutil.ReadFile(fileName)
 	if err != nil {
@@ -383,25 +503,42 @@ func (b *BeaconNode) registerP2P(cliCtx *cli.Context) error {
 		}
 	}
 
+	inboundLimits := p2p.InboundLimits{
+		MaxPerIP:     cliCtx.Uint(cmd.P2PMaxInboundPerIP.Name),
+		MaxPerSubnet: cliCtx.Uint(cmd.P2PMaxInboundPerSubnet.Name),
+		SubnetPrefix: cliCtx.Uint(cmd.P2PInboundSubnetPrefix.Name),
+		InboundRatio: cliCtx.Float64(cmd.P2PMaxInboundRatio.Name),
+	}
+
 	svc, err := p2p.NewService(b.ctx, &p2p.Config{
-		NoDiscovery:       cliCtx.Bool(cmd.NoDiscovery.Name),
-		StaticPeers:       sliceutil.SplitCommaSeparated(cliCtx.StringSlice(cmd.StaticPeers.Name)),
-		BootstrapNodeAddr: bootnodeAddrs,
-		RelayNodeAddr:     cliCtx.String(cmd.RelayNode.Name),
-		DataDir:           datadir,
-		LocalIP:           cliCtx.String(cmd.P2PIP.Name),
-		HostAddress:       cliCtx.String(cmd.P2PHost.Name),
-		HostDNS:           cliCtx.String(cmd.P2PHostDNS.Name),
-		PrivateKey:        cliCtx.String(cmd.P2PPrivKey.Name),
-		MetaDataDir:       cliCtx.String(cmd.P2PMetadata.Name),
-		TCPPort:           cliCtx.Uint(cmd.P2PTCPPort.Name),
-		UDPPort:           cliCtx.Uint(cmd.P2PUDPPort.Name),
-		MaxPeers:          cliCtx.Uint(cmd.P2PMaxPeers.Name),
-		AllowListCIDR:     cliCtx.String(cmd.P2PAllowList.Name),
-		DenyListCIDR:      sliceutil.SplitCommaSeparated(cliCtx.StringSlice(cmd.P2PDenyList.Name)),
-		EnableUPnP:        cliCtx.Bool(cmd.EnableUPnPFlag.Name),
-		DisableDiscv5:     cliCtx.Bool(flags.DisableDiscv5.Name),
-		StateNotifier:     b,
+		NoDiscovery:               cliCtx.Bool(cmd.NoDiscovery.Name),
+		StaticPeers:               sliceutil.SplitCommaSeparated(cliCtx.StringSlice(cmd.StaticPeers.Name)),
+		PeerListURLs:              sliceutil.SplitCommaSeparated(cliCtx.StringSlice(cmd.PeerListURL.Name)),
+		LocalDiscovery:            cliCtx.Bool(cmd.P2PLocalDiscovery.Name),
+		LocalDiscoveryInterface:   cliCtx.String(cmd.P2PLocalDiscoveryInterface.Name),
+		BootstrapNodeAddr:         bootnodeAddrs,
+		BootstrapDNS:              sliceutil.SplitCommaSeparated(cliCtx.StringSlice(cmd.BootstrapDNS.Name)),
+		RelayNodeAddr:             cliCtx.String(cmd.RelayNode.Name),
+		DataDir:                   datadir,
+		LocalIP:                   cliCtx.String(cmd.P2PIP.Name),
+		HostAddress:               cliCtx.String(cmd.P2PHost.Name),
+		HostDNS:                   cliCtx.String(cmd.P2PHostDNS.Name),
+		PrivateKey:                cliCtx.String(cmd.P2PPrivKey.Name),
+		MetaDataDir:               cliCtx.String(cmd.P2PMetadata.Name),
+		TCPPort:                   cliCtx.Uint(cmd.P2PTCPPort.Name),
+		UDPPort:                   cliCtx.Uint(cmd.P2PUDPPort.Name),
+		MaxPeers:                  cliCtx.Uint(cmd.P2PMaxPeers.Name),
+		AllowListCIDR:             cliCtx.String(cmd.P2PAllowList.Name),
+		DenyListCIDR:              sliceutil.SplitCommaSeparated(cliCtx.StringSlice(cmd.P2PDenyList.Name)),
+		InboundLimits:             inboundLimits,
+		EnableUPnP:                cliCtx.Bool(cmd.EnableUPnPFlag.Name),
+		DisableDiscv5:             cliCtx.Bool(flags.DisableDiscv5.Name),
+		StateNotifier:             b,
+		BlockPropagation:          b.blockPropagation,
+		PublishMeshWait:           cliCtx.Duration(flags.PublishMeshWait.Name),
+		SeenMessageTTL:            cliCtx.Duration(flags.GossipSeenMessageTTL.Name),
+		TraceDuplicates:           cliCtx.Bool(flags.TraceGossipDuplicates.Name),
+		DuplicatePenaltyThreshold: cliCtx.Int(flags.GossipDuplicatePenaltyThreshold.Name),
 	})
 	if err != nil {
 		return err
@@ -419,7 +556,9 @@ func (b *BeaconNode) fetchP2P() p2p.P2P {
 
 func (b *BeaconNode) registerAttestationPool() error {
 	s, err := attestations.NewService(b.ctx, &attestations.Config{
-		Pool: b.attestationPool,
+		Pool:           b.attestationPool,
+		SpillDir:       filepath.Join(b.cliCtx.String(cmd.DataDirFlag.Name), "attestation-spill"),
+		SpillThreshold: b.cliCtx.Uint64(flags.AttestationPoolSpillThreshold.Name) * 1024 * 1024,
 	})
 	if err != nil {
 		return errors.Wrap(err, "could not register atts pool service")
@@ -467,6 +606,22 @@ func (b *BeaconNode) registerBlockchainService() error {
 	return b.services.RegisterService(blockchainService)
 }
 
+func (b *BeaconNode) registerLocalOperationsService() error {
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+
+	s := localops.NewService(b.ctx, &localops.Config{
+		BeaconDB:      b.db,
+		HeadFetcher:   chainService,
+		Broadcaster:   b.fetchP2P(),
+		ExitPool:      b.exitPool,
+		SlashingsPool: b.slashingsPool,
+	})
+	return b.services.RegisterService(s)
+}
+
 func (b *BeaconNode) registerPOWChainService() error {
 	if b.cliCtx.Bool(testSkipPowFlag) {
 		return b.services.RegisterService(&powchain.Service{})
@@ -493,6 +648,7 @@ func (b *BeaconNode) registerPOWChainService() error {
 		StateNotifier:      b,
 		StateGen:           b.stateGen,
 		Eth1HeaderReqLimit: b.cliCtx.Uint64(flags.Eth1HeaderReqLimit.Name),
+		DepositSnapshot:    b.cliCtx.String(flags.DepositSnapshot.Name),
 	}
 	web3Service, err := powchain.NewService(b.ctx, cfg)
 	if err != nil {
@@ -518,6 +674,35 @@ func (b *BeaconNode) registerPOWChainService() error {
 	return b.services.RegisterService(web3Service)
 }
 
+func (b *BeaconNode) registerReplicaSnapshotService() error {
+	s := replica.NewSnapshotService(b.ctx, &replica.SnapshotConfig{
+		BeaconDB:      b.db,
+		StateNotifier: b,
+		Dir:           b.cliCtx.String(flags.DBReplicaSnapshotDir.Name),
+		Epochs:        b.cliCtx.Uint64(flags.DBReplicaSnapshotEpochs.Name),
+		Paused:        b.diskWatch.UnderPressure,
+	})
+	return b.services.RegisterService(s)
+}
+
+// registerEraService exports the finalized history to era files, whose blocks initial sync reads.
+// It is registered before initial sync, so the era files stay open until initial sync is stopped.
+func (b *BeaconNode) registerEraService() error {
+	store, err := era.OpenStore(b.cliCtx.String(flags.EraDir.Name))
+	if err != nil {
+		return errors.Wrap(err, "could not open era files")
+	}
+	b.eraStore = store
+	s := era.NewService(b.ctx, &era.Config{
+		BeaconDB:      b.db,
+		StateGen:      b.stateGen,
+		StateNotifier: b,
+		Store:         store,
+		Paused:        b.diskWatch.UnderPressure,
+	})
+	return b.services.RegisterService(s)
+}
+
 func (b *BeaconNode) registerSyncService() error {
 	var web3Service *powchain.Service
 	if err := b.services.FetchService(&web3Service); err != nil {
@@ -547,27 +732,75 @@ func (b *BeaconNode) registerSyncService() error {
 		SlashingPool:        b.slashingsPool,
 		StateSummaryCache:   b.stateSummaryCache,
 		StateGen:            b.stateGen,
+		BlockPropagation:    b.blockPropagation,
+		HashTreeRootCache:   b.htrCache,
 	})
 
 	return b.services.RegisterService(rs)
 }
 
+// registerBackfillService requests the block history missing below the lowest block of the database
+// from peers, at the rate of --backfill-blocks-per-second, pausing while free disk space is low.
+func (b *BeaconNode) registerBackfillService() error {
+	s := backfill.NewService(b.ctx, &backfill.Config{
+		P2P:             b.fetchP2P(),
+		DB:              b.db,
+		BlocksPerSecond: b.cliCtx.Uint64(flags.BackfillBlocksPerSecond.Name),
+		Paused:          b.diskWatch.UnderPressure,
+	})
+	return b.services.RegisterService(s)
+}
+
 func (b *BeaconNode) registerInitialSyncService() error {
 	var chainService *blockchain.Service
 	if err := b.services.FetchService(&chainService); err != nil {
 		return err
 	}
 
+	allowedPeers, err := b.initialSyncPeers()
+	if err != nil {
+		return err
+	}
+
 	is := initialsync.NewService(b.ctx, &initialsync.Config{
 		DB:            b.db,
 		Chain:         chainService,
 		P2P:           b.fetchP2P(),
 		StateNotifier: b,
 		BlockNotifier: b,
+		EraStore:      b.eraStore,
+		AllowedPeers:  allowedPeers,
 	})
 	return b.services.RegisterService(is)
 }
 
+// initialSyncPeers returns the peers initial sync is restricted to with --initial-sync-peers and
+// --initial-sync-static-peers-only, or nil when it syncs from all peers.
+func (b *BeaconNode) initialSyncPeers() ([]peer.ID, error) {
+	var pids []peer.ID
+	for _, id := range sliceutil.SplitCommaSeparated(b.cliCtx.StringSlice(flags.InitialSyncPeers.Name)) {
+		pid, err := peer.Decode(id)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid peer id %s in --%s", id, flags.InitialSyncPeers.Name)
+		}
+		pids = append(pids, pid)
+	}
+	if b.cliCtx.Bool(flags.InitialSyncStaticPeersOnly.Name) {
+		staticPeers, err := p2p.PeerIDsFromStringAddrs(sliceutil.SplitCommaSeparated(b.cliCtx.StringSlice(cmd.StaticPeers.Name)))
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get static peer ids")
+		}
+		if len(staticPeers) == 0 {
+			return nil, fmt.Errorf("--%s requires static peers given with --%s", flags.InitialSyncStaticPeersOnly.Name, cmd.StaticPeers.Name)
+		}
+		pids = append(pids, staticPeers...)
+	}
+	if len(pids) > 0 {
+		log.WithField("peers", len(pids)).Info("Initial sync only requests blocks from the allowed peers")
+	}
+	return pids, nil
+}
+
 func (b *BeaconNode) registerRPCService() error {
 	var chainService *blockchain.Service
 	if err := b.services.FetchService(&chainService); err != nil {
@@ -584,6 +817,16 @@ func (b *BeaconNode) registerRPCService() error {
 		return err
 	}
 
+	var localOpsService *localops.Service
+	if err := b.services.FetchService(&localOpsService); err != nil {
+		return err
+	}
+
+	var regularSyncService *regularsync.Service
+	if err := b.services.FetchService(&regularSyncService); err != nil {
+		return err
+	}
+
 	genesisValidators := b.cliCtx.Uint64(flags.InteropNumValidatorsFlag.Name)
 	genesisStatePath := b.cliCtx.String(flags.InteropGenesisStateFlag.Name)
 	var depositFetcher depositcache.DepositFetcher
@@ -609,7 +852,31 @@ func (b *BeaconNode) registerRPCService() error {
 	mockEth1DataVotes := b.cliCtx.Bool(flags.InteropMockEth1DataVotesFlag.Name)
 	enableDebugRPCEndpoints := b.cliCtx.Bool(flags.EnableDebugRPCEndpoints.Name)
 	maxMsgSize := b.cliCtx.Int(cmd.GrpcMaxCallRecvMsgSizeFlag.Name)
+	var tlsConfig *tls.Config
+	tlsCert, err := b.autoTLSCert()
+	if err != nil {
+		return err
+	}
+	if tlsCert != nil {
+		tlsConfig = autotls.ServerConfig(*tlsCert, b.tlsCA)
+	}
 	p2pService := b.fetchP2P()
+	runningConfig := func() (*configdump.Config, error) {
+		return configdump.Resolve(b.cliCtx)
+	}
+	nodeInventory := func() (*sharedpb.Inventory, error) {
+		return inventory.Build(b.services, &inventory.Config{
+			Binary:  "beacon-chain",
+			DataDir: b.cliCtx.String(cmd.DataDirFlag.Name),
+			GenesisValidatorsRoot: func() ([]byte, error) {
+				root := chainService.GenesisValidatorRoot()
+				if root == [32]byte{} {
+					return nil, nil
+				}
+				return root[:], nil
+			},
+		})
+	}
 	rpcService := rpc.NewService(b.ctx, &rpc.Config{
 		Host:                    host,
 		Port:                    port,
@@ -617,14 +884,17 @@ func (b *BeaconNode) registerRPCService() error {
 		BeaconMonitoringPort:    beaconMonitoringPort,
 		CertFlag:                cert,
 		KeyFlag:                 key,
+		TLSConfig:               tlsConfig,
 		BeaconDB:                b.db,
 		Broadcaster:             p2pService,
 		PeersFetcher:            p2pService,
 		PeerManager:             p2pService,
+		MetadataProvider:        p2pService,
 		ChainInfoFetcher:        chainService,
 		HeadFetcher:             chainService,
 		ForkFetcher:             chainService,
 		FinalizationFetcher:     chainService,
+		TargetStateFetcher:      chainService,
 		BlockReceiver:           chainService,
 		AttestationReceiver:     chainService,
 		GenesisTimeFetcher:      chainService,
@@ -632,17 +902,29 @@ func (b *BeaconNode) registerRPCService() error {
 		AttestationsPool:        b.attestationPool,
 		ExitPool:                b.exitPool,
 		SlashingsPool:           b.slashingsPool,
+		LocalOperations:         localOpsService,
 		POWChainService:         web3Service,
 		ChainStartFetcher:       chainStartFetcher,
 		MockEth1Votes:           mockEth1DataVotes,
 		SyncService:             syncService,
+		HeadRecomputer:          chainService,
+		SeenCacheFlusher:        regularSyncService,
+		BlockPropagation:        b.blockPropagation,
+		InboundLimiter:          p2pService.(p2p.InboundLimiter),
+		PeerListProvider:        p2pService.(p2p.PeerListProvider),
+		DepositSnapshotter:      web3Service,
+		RunningConfig:           runningConfig,
+		Inventory:               nodeInventory,
 		DepositFetcher:          depositFetcher,
 		PendingDepositFetcher:   b.depositCache,
 		BlockNotifier:           b,
 		StateNotifier:           b,
 		OperationNotifier:       b,
 		StateGen:                b.stateGen,
+		HashTreeRootCache:       b.htrCache,
 		EnableDebugRPCEndpoints: enableDebugRPCEndpoints,
+		ReadOnly:                b.cliCtx.Bool(flags.RPCReadOnly.Name),
+		ValidateProposals:       !b.cliCtx.Bool(flags.DisableProposalValidation.Name),
 		MaxMsgSize:              maxMsgSize,
 	})
 
@@ -695,17 +977,79 @@ func (b *BeaconNode) registerGRPCGateway() error {
 	gatewayAddress := fmt.Sprintf("%s:%d", gatewayHost, gatewayPort)
 	allowedOrigins := strings.Split(b.cliCtx.String(flags.GPRCGatewayCorsDomain.Name), ",")
 	enableDebugRPCEndpoints := b.cliCtx.Bool(flags.EnableDebugRPCEndpoints.Name)
-	return b.services.RegisterService(
-		gateway.New(
-			b.ctx,
-			selfAddress,
-			gatewayAddress,
-			nil, /*optional mux*/
-			allowedOrigins,
-			enableDebugRPCEndpoints,
-			b.cliCtx.Uint64(cmd.GrpcMaxCallRecvMsgSizeFlag.Name),
-		),
+	gw := gateway.New(
+		b.ctx,
+		selfAddress,
+		gatewayAddress,
+		nil, /*optional mux*/
+		allowedOrigins,
+		enableDebugRPCEndpoints,
+		b.cliCtx.Uint64(cmd.GrpcMaxCallRecvMsgSizeFlag.Name),
 	)
+	tlsCert, err := b.autoTLSCert()
+	if err != nil {
+		return err
+	}
+	if tlsCert != nil {
+		gw.WithTLSConfig(autotls.SelfConfig(*tlsCert))
+	}
+	if adminPort := b.cliCtx.Int(flags.GRPCGatewayAdminPort.Name); adminPort != 0 {
+		adminHost := b.cliCtx.String(flags.GRPCGatewayAdminHost.Name)
+		adminToken := ""
+		if tokenFile := b.cliCtx.String(flags.GRPCGatewayAdminTokenFile.Name); tokenFile != "" {
+			enc, err := ioutil.ReadFile(tokenFile)
+			if err != nil {
+				return errors.Wrap(err, "could not read admin gateway token")
+			}
+			adminToken = strings.TrimSpace(string(enc))
+			if adminToken == "" {
+				return fmt.Errorf("admin gateway token file %s is empty", tokenFile)
+			}
+		} else if ip := net.ParseIP(adminHost); ip == nil || !ip.IsLoopback() {
+			log.WithField("host", adminHost).Warn(
+				"Serving the admin gateway endpoints on a non-loopback host without --" +
+					flags.GRPCGatewayAdminTokenFile.Name + ", anyone reaching the host can use them",
+			)
+		}
+		gw.WithAdminListener(fmt.Sprintf("%s:%d", adminHost, adminPort), adminToken)
+	} else if b.cliCtx.IsSet(flags.GRPCGatewayAdminTokenFile.Name) {
+		return fmt.Errorf("--%s requires --%s", flags.GRPCGatewayAdminTokenFile.Name, flags.GRPCGatewayAdminPort.Name)
+	}
+	return b.services.RegisterService(gw)
+}
+
+// autoTLSCert loads or issues the certificate of the gRPC server, and loads or generates the
+// certificate authority issuing it, if the tls-auto flag is set and no certificate files are
+// configured, returning nil otherwise.
+func (b *BeaconNode) autoTLSCert() (*tls.Certificate, error) {
+	if !b.cliCtx.Bool(flags.TLSAutoFlag.Name) {
+		return nil, nil
+	}
+	if b.cliCtx.String(flags.CertFlag.Name) != "" && b.cliCtx.String(flags.KeyFlag.Name) != "" {
+		return nil, nil
+	}
+	if b.tlsCert == nil {
+		caDir := b.cliCtx.String(flags.TLSAutoCADirFlag.Name)
+		if caDir == "" {
+			caDir = filepath.Join(b.autoTLSDir(), "ca")
+		}
+		ca, err := autotls.LoadOrCreateCA(caDir)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not load TLS certificate authority")
+		}
+		hosts := append(autotls.DefaultHosts(), sliceutil.SplitCommaSeparated(b.cliCtx.StringSlice(flags.TLSAutoHostsFlag.Name))...)
+		cert, err := autotls.LoadOrCreate(b.autoTLSDir(), hosts, ca)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not load TLS certificate")
+		}
+		b.tlsCA = ca
+		b.tlsCert = &cert
+	}
+	return b.tlsCert, nil
+}
+
+func (b *BeaconNode) autoTLSDir() string {
+	return filepath.Join(b.cliCtx.String(cmd.DataDirFlag.Name), "tls")
 }
 
 func (b *BeaconNode) registerInteropServices() error {
@@ -714,13 +1058,16 @@ func (b *BeaconNode) registerInteropServices() error {
 	genesisStatePath := b.cliCtx.String(flags.InteropGenesisStateFlag.Name)
 
 	if genesisValidators > 0 || genesisStatePath != "" {
-		svc := interopcoldstart.NewService(b.ctx, &interopcoldstart.Config{
+		svc, err := interopcoldstart.NewService(b.ctx, &interopcoldstart.Config{
 			GenesisTime:   genesisTime,
 			NumValidators: genesisValidators,
 			BeaconDB:      b.db,
 			DepositCache:  b.depositCache,
 			GenesisPath:   genesisStatePath,
 		})
+		if err != nil {
+			return errors.Wrap(err, "could not start interop cold start service")
+		}
 
 		return b.services.RegisterService(svc)
 	}
@@ -11,6 +11,7 @@ import (
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	bolt "go.etcd.io/bbolt"
 )
 
 var hashFn = hashutil.HashProto
@@ -28,6 +29,12 @@ type AttCaches struct {
 	blockAttLock       sync.RWMutex
 	blockAtt           map[[32]byte][]*ethpb.Attestation
 	seenAtt            *cache.Cache
+	// spillDB holds the unaggregated attestations spilled under memory pressure, if enabled.
+	spillDB *bolt.DB
+	// spillIndex and spilledRoots index the attestations of spillDB by slot and committee, and by
+	// root.
+	spillIndex   map[spillIndexKey]map[[32]byte]bool
+	spilledRoots map[[32]byte]spillIndexKey
 }
 
 // NewAttCaches initializes a new attestation pool consists of multiple KV store in cache for
@@ -41,6 +48,8 @@ func NewAttCaches() *AttCaches {
 		forkchoiceAtt:   make(map[[32]byte]*ethpb.Attestation),
 		blockAtt:        make(map[[32]byte][]*ethpb.Attestation),
 		seenAtt:         c,
+		spillIndex:      make(map[spillIndexKey]map[[32]byte]bool),
+		spilledRoots:    make(map[[32]byte]spillIndexKey),
 	}
 
 	return pool
@@ -0,0 +1,250 @@
+package kv
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	bolt "go.etcd.io/bbolt"
+)
+
+// spillFileName is the name of the file unaggregated attestations are spilled to.
+const spillFileName = "attestation-spill.db"
+
+var spilledAttsBucket = []byte("spilled-unaggregated-attestations")
+
+// EnableSpillover makes room for spilling unaggregated attestations to a file in dir, under memory
+// pressure. The file only holds the attestations of the running node, it is recreated empty here
+// and removed by DisableSpillover.
+func (p *AttCaches) EnableSpillover(dir string) error {
+	if err := fileutil.MkdirAll(dir); err != nil {
+		return err
+	}
+	path := filepath.Join(dir, spillFileName)
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "could not remove previous spill file")
+	}
+	// The spill file does not outlive the node, it needs no durability.
+	db, err := bolt.Open(path, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{
+		Timeout: time.Second,
+		NoSync:  true,
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not open spill file")
+	}
+	if err := db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(spilledAttsBucket)
+		return err
+	}); err != nil {
+		return err
+	}
+	p.unAggregateAttLock.Lock()
+	defer p.unAggregateAttLock.Unlock()
+	p.spillDB = db
+	return nil
+}
+
+// DisableSpillover closes and removes the spill file. Spilled attestations are dropped.
+func (p *AttCaches) DisableSpillover() error {
+	p.unAggregateAttLock.Lock()
+	defer p.unAggregateAttLock.Unlock()
+	if p.spillDB == nil {
+		return nil
+	}
+	path := p.spillDB.Path()
+	if err := p.spillDB.Close(); err != nil {
+		return err
+	}
+	p.spillDB = nil
+	p.spillIndex = make(map[spillIndexKey]map[[32]byte]bool)
+	p.spilledRoots = make(map[[32]byte]spillIndexKey)
+	return os.Remove(path)
+}
+
+// SpillUnaggregatedAttestations moves up to count unaggregated attestations of the oldest slots to
+// the spill file, which are the least likely to be aggregated or included in a block. Spilled
+// attestations are still returned by the pool, and reloaded into memory when their slot and
+// committee are requested again. Returns the number of spilled attestations.
+func (p *AttCaches) SpillUnaggregatedAttestations(count int) (int, error) {
+	p.unAggregateAttLock.Lock()
+	defer p.unAggregateAttLock.Unlock()
+	if p.spillDB == nil || count <= 0 {
+		return 0, nil
+	}
+
+	roots := make([][32]byte, 0, len(p.unAggregatedAtt))
+	for r := range p.unAggregatedAtt {
+		roots = append(roots, r)
+	}
+	sort.Slice(roots, func(i, j int) bool {
+		return p.unAggregatedAtt[roots[i]].Data.Slot < p.unAggregatedAtt[roots[j]].Data.Slot
+	})
+	if len(roots) > count {
+		roots = roots[:count]
+	}
+	if err := p.spillDB.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(spilledAttsBucket)
+		for _, r := range roots {
+			enc, err := p.unAggregatedAtt[r].MarshalSSZ()
+			if err != nil {
+				return err
+			}
+			if err := bkt.Put(spillKey(spillIndexKeyOf(p.unAggregatedAtt[r]), r), enc); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return 0, errors.Wrap(err, "could not spill attestations")
+	}
+	for _, r := range roots {
+		p.indexSpilled(spillIndexKeyOf(p.unAggregatedAtt[r]), r)
+		delete(p.unAggregatedAtt, r)
+	}
+	return len(roots), nil
+}
+
+// SpilledAttestationCount returns the number of unaggregated attestations in the spill file.
+func (p *AttCaches) SpilledAttestationCount() int {
+	p.unAggregateAttLock.RLock()
+	defer p.unAggregateAttLock.RUnlock()
+	return len(p.spilledRoots)
+}
+
+// spillIndexKey is the slot and committee of spilled attestations, which are aggregated together.
+type spillIndexKey struct {
+	slot           uint64
+	committeeIndex uint64
+}
+
+func spillIndexKeyOf(att *ethpb.Attestation) spillIndexKey {
+	return spillIndexKey{slot: att.Data.Slot, committeeIndex: att.Data.CommitteeIndex}
+}
+
+// spillKey orders spilled attestations by slot and committee, so the attestations of a committee
+// are read by prefix.
+func spillKey(k spillIndexKey, root [32]byte) []byte {
+	return append(spillPrefix(k), root[:]...)
+}
+
+func spillPrefix(k spillIndexKey) []byte {
+	return append(bytesutil.Uint64ToBytesBigEndian(k.slot), bytesutil.Uint64ToBytesBigEndian(k.committeeIndex)...)
+}
+
+// The following helpers must be called with the lock of the unaggregated attestations held, for
+// writing if they modify the spill file or its index.
+
+// indexSpilled records a spilled attestation in the in-memory index of the spill file, so lookups
+// do not read the file.
+func (p *AttCaches) indexSpilled(k spillIndexKey, root [32]byte) {
+	if p.spillIndex[k] == nil {
+		p.spillIndex[k] = make(map[[32]byte]bool)
+	}
+	p.spillIndex[k][root] = true
+	p.spilledRoots[root] = k
+}
+
+// unindexSpilled removes an attestation from the in-memory index of the spill file.
+func (p *AttCaches) unindexSpilled(root [32]byte) {
+	k, ok := p.spilledRoots[root]
+	if !ok {
+		return
+	}
+	delete(p.spilledRoots, root)
+	delete(p.spillIndex[k], root)
+	if len(p.spillIndex[k]) == 0 {
+		delete(p.spillIndex, k)
+	}
+}
+
+// spilledAttestations returns the spilled attestations accepted by the filter with their roots, of
+// the slot and committee if k is set, and removes them from the spill file when remove is set. The
+// attestations are read in a single transaction.
+func (p *AttCaches) spilledAttestations(
+	k *spillIndexKey, filter func(*ethpb.Attestation) bool, remove bool,
+) ([]*ethpb.Attestation, [][32]byte, error) {
+	if p.spillDB == nil || len(p.spilledRoots) == 0 || (k != nil && !p.hasSpilled(*k)) {
+		return nil, nil, nil
+	}
+	var atts []*ethpb.Attestation
+	var roots [][32]byte
+	walk := func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(spilledAttsBucket)
+		c := bkt.Cursor()
+		key, v := c.First()
+		var prefix []byte
+		if k != nil {
+			prefix = spillPrefix(*k)
+			key, v = c.Seek(prefix)
+		}
+		var matched [][]byte
+		for ; key != nil && (prefix == nil || bytes.HasPrefix(key, prefix)); key, v = c.Next() {
+			att := &ethpb.Attestation{}
+			if err := att.UnmarshalSSZ(v); err != nil {
+				return err
+			}
+			if filter(att) {
+				atts = append(atts, att)
+				roots = append(roots, bytesutil.ToBytes32(key[len(key)-32:]))
+				matched = append(matched, append([]byte{}, key...))
+			}
+		}
+		if !remove {
+			return nil
+		}
+		for _, key := range matched {
+			if err := bkt.Delete(key); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	var err error
+	if remove {
+		err = p.spillDB.Update(walk)
+	} else {
+		err = p.spillDB.View(walk)
+	}
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not read spilled attestations")
+	}
+	if remove {
+		for _, r := range roots {
+			p.unindexSpilled(r)
+		}
+	}
+	return atts, roots, nil
+}
+
+// deleteSpilledAttestation removes an attestation from the spill file, if it was spilled.
+func (p *AttCaches) deleteSpilledAttestation(root [32]byte) error {
+	k, ok := p.spilledRoots[root]
+	if p.spillDB == nil || !ok {
+		return nil
+	}
+	if err := p.spillDB.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(spilledAttsBucket).Delete(spillKey(k, root))
+	}); err != nil {
+		return err
+	}
+	p.unindexSpilled(root)
+	return nil
+}
+
+// hasSpilled returns whether attestations of the slot and committee are in the spill file.
+func (p *AttCaches) hasSpilled(k spillIndexKey) bool {
+	return len(p.spillIndex[k]) > 0
+}
+
+// isSpilled returns whether an attestation is in the spill file.
+func (p *AttCaches) isSpilled(root [32]byte) bool {
+	_, ok := p.spilledRoots[root]
+	return ok
+}
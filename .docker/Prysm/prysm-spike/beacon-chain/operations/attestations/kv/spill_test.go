@@ -0,0 +1,122 @@
+package kv
+
+import (
+	"path/filepath"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func spillTestAtt(slot, committeeIndex uint64, bit uint64) *ethpb.Attestation {
+	bits := bitfield.NewBitlist(8)
+	bits.SetBitAt(bit, true)
+	return &ethpb.Attestation{
+		AggregationBits: bits,
+		Data: &ethpb.AttestationData{
+			Slot:            slot,
+			CommitteeIndex:  committeeIndex,
+			BeaconBlockRoot: make([]byte, 32),
+			Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+			Target:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+		},
+		Signature: make([]byte, 96),
+	}
+}
+
+func TestKV_Spill_SpillsOldestAndReloads(t *testing.T) {
+	cache := NewAttCaches()
+	require.NoError(t, cache.EnableSpillover(filepath.Join(t.TempDir(), "spill")))
+	defer func() {
+		require.NoError(t, cache.DisableSpillover())
+	}()
+
+	old1 := spillTestAtt(1, 0, 0)
+	old2 := spillTestAtt(1, 1, 1)
+	recent := spillTestAtt(5, 0, 2)
+	require.NoError(t, cache.SaveUnaggregatedAttestations([]*ethpb.Attestation{recent, old1, old2}))
+
+	spilled, err := cache.SpillUnaggregatedAttestations(2)
+	require.NoError(t, err)
+	assert.Equal(t, 2, spilled)
+	assert.Equal(t, 2, cache.SpilledAttestationCount())
+	assert.Equal(t, 1, len(cache.unAggregatedAtt))
+	assert.Equal(t, 3, cache.UnaggregatedAttestationCount())
+
+	// Spilled attestations are still returned, and saving them again does not duplicate them.
+	atts, err := cache.UnaggregatedAttestations()
+	require.NoError(t, err)
+	assert.Equal(t, 3, len(atts))
+	require.NoError(t, cache.SaveUnaggregatedAttestation(old1))
+	assert.Equal(t, 3, cache.UnaggregatedAttestationCount())
+
+	// Requesting a slot and committee reloads its attestations into memory.
+	atts = cache.UnaggregatedAttestationsBySlotIndex(1, 1)
+	require.Equal(t, 1, len(atts))
+	assert.DeepEqual(t, old2, atts[0])
+	assert.Equal(t, 1, cache.SpilledAttestationCount())
+	assert.Equal(t, 2, len(cache.unAggregatedAtt))
+
+	// Deleting a spilled attestation removes it from disk.
+	require.NoError(t, cache.DeleteUnaggregatedAttestation(old1))
+	assert.Equal(t, 0, cache.SpilledAttestationCount())
+	assert.Equal(t, 2, cache.UnaggregatedAttestationCount())
+}
+
+func TestKV_Spill_DeleteSeen(t *testing.T) {
+	cache := NewAttCaches()
+	require.NoError(t, cache.EnableSpillover(filepath.Join(t.TempDir(), "spill")))
+	defer func() {
+		require.NoError(t, cache.DisableSpillover())
+	}()
+
+	att := spillTestAtt(1, 0, 0)
+	require.NoError(t, cache.SaveUnaggregatedAttestation(att))
+	spilled, err := cache.SpillUnaggregatedAttestations(10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, spilled)
+
+	require.NoError(t, cache.insertSeenBit(att))
+	count, err := cache.DeleteSeenUnaggregatedAttestations()
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, 0, cache.UnaggregatedAttestationCount())
+}
+
+func TestKV_Spill_Disabled(t *testing.T) {
+	cache := NewAttCaches()
+	require.NoError(t, cache.SaveUnaggregatedAttestation(spillTestAtt(1, 0, 0)))
+	spilled, err := cache.SpillUnaggregatedAttestations(10)
+	require.NoError(t, err)
+	assert.Equal(t, 0, spilled)
+	assert.Equal(t, 1, cache.UnaggregatedAttestationCount())
+}
+
+func TestKV_Spill_IndexesBySlotAndCommittee(t *testing.T) {
+	cache := NewAttCaches()
+	require.NoError(t, cache.EnableSpillover(filepath.Join(t.TempDir(), "spill")))
+
+	atts := []*ethpb.Attestation{spillTestAtt(1, 0, 0), spillTestAtt(1, 0, 1), spillTestAtt(1, 1, 2), spillTestAtt(2, 0, 3)}
+	require.NoError(t, cache.SaveUnaggregatedAttestations(atts))
+	spilled, err := cache.SpillUnaggregatedAttestations(len(atts))
+	require.NoError(t, err)
+	assert.Equal(t, 4, spilled)
+	assert.Equal(t, true, cache.hasSpilled(spillIndexKey{slot: 1, committeeIndex: 0}))
+	assert.Equal(t, false, cache.hasSpilled(spillIndexKey{slot: 1, committeeIndex: 2}))
+	r, err := hashFn(atts[2])
+	require.NoError(t, err)
+	assert.Equal(t, true, cache.isSpilled(r))
+
+	// Only the attestations of the committee are reloaded.
+	assert.Equal(t, 2, len(cache.UnaggregatedAttestationsBySlotIndex(1, 0)))
+	assert.Equal(t, 2, cache.SpilledAttestationCount())
+	assert.Equal(t, false, cache.hasSpilled(spillIndexKey{slot: 1, committeeIndex: 0}))
+	assert.Equal(t, true, cache.hasSpilled(spillIndexKey{slot: 1, committeeIndex: 1}))
+	assert.Equal(t, 0, len(cache.UnaggregatedAttestationsBySlotIndex(1, 2)))
+
+	require.NoError(t, cache.DisableSpillover())
+	assert.Equal(t, 0, cache.SpilledAttestationCount())
+	assert.Equal(t, false, cache.isSpilled(r))
+}
@@ -31,6 +31,9 @@ func (p *AttCaches) SaveUnaggregatedAttestation(att *ethpb.Attestation) error {
 	att = stateTrie.CopyAttestation(att) // Copied.
 	p.unAggregateAttLock.Lock()
 	defer p.unAggregateAttLock.Unlock()
+	if p.isSpilled(r) {
+		return nil
+	}
 	p.unAggregatedAtt[r] = att
 
 	return nil
@@ -47,12 +50,13 @@ func (p *AttCaches) SaveUnaggregatedAttestations(atts []*ethpb.Attestation) erro
 	return nil
 }
 
-// UnaggregatedAttestations returns all the unaggregated attestations in cache.
+// UnaggregatedAttestations returns all the unaggregated attestations in cache, including the
+// spilled ones.
 func (p *AttCaches) UnaggregatedAttestations() ([]*ethpb.Attestation, error) {
-	p.unAggregateAttLock.Lock()
-	defer p.unAggregateAttLock.Unlock()
+	p.unAggregateAttLock.RLock()
+	defer p.unAggregateAttLock.RUnlock()
 	unAggregatedAtts := p.unAggregatedAtt
-	atts := make([]*ethpb.Attestation, 0, len(unAggregatedAtts))
+	atts := make([]*ethpb.Attestation, 0, len(unAggregatedAtts)+len(p.spilledRoots))
 	for _, att := range unAggregatedAtts {
 		seen, err := p.hasSeenBit(att)
 		if err != nil {
@@ -62,17 +66,33 @@ func (p *AttCaches) UnaggregatedAttestations() ([]*ethpb.Attestation, error) {
 			atts = append(atts, stateTrie.CopyAttestation(att) /* Copied */)
 		}
 	}
-	return atts, nil
+	spilled, _, err := p.spilledAttestations(nil /* all committees */, func(att *ethpb.Attestation) bool {
+		seen, err := p.hasSeenBit(att)
+		return err == nil && !seen
+	}, false /* remove */)
+	if err != nil {
+		return nil, err
+	}
+	return append(atts, spilled...), nil
 }
 
 // UnaggregatedAttestationsBySlotIndex returns the unaggregated attestations in cache,
-// filtered by committee index and slot.
+// filtered by committee index and slot. Spilled attestations of the slot and committee are
+// reloaded into memory, as they are about to be aggregated.
 func (p *AttCaches) UnaggregatedAttestationsBySlotIndex(slot, committeeIndex uint64) []*ethpb.Attestation {
-	atts := make([]*ethpb.Attestation, 0)
-
+	k := spillIndexKey{slot: slot, committeeIndex: committeeIndex}
 	p.unAggregateAttLock.RLock()
-	defer p.unAggregateAttLock.RUnlock()
+	if p.hasSpilled(k) {
+		// Reloading modifies the pool, upgrade to the write lock.
+		p.unAggregateAttLock.RUnlock()
+		p.unAggregateAttLock.Lock()
+		p.reloadSpilledAttestations(k)
+		defer p.unAggregateAttLock.Unlock()
+	} else {
+		defer p.unAggregateAttLock.RUnlock()
+	}
 
+	atts := make([]*ethpb.Attestation, 0)
 	unAggregatedAtts := p.unAggregatedAtt
 	for _, a := range unAggregatedAtts {
 		if slot == a.Data.Slot && committeeIndex == a.Data.CommitteeIndex {
@@ -105,7 +125,7 @@ func (p *AttCaches) DeleteUnaggregatedAttestation(att *ethpb.Attestation) error
 	defer p.unAggregateAttLock.Unlock()
 	delete(p.unAggregatedAtt, r)
 
-	return nil
+	return p.deleteSpilledAttestation(r)
 }
 
 // DeleteSeenUnaggregatedAttestations deletes the unaggregated attestations in cache
@@ -128,12 +148,34 @@ func (p *AttCaches) DeleteSeenUnaggregatedAttestations() (int, error) {
 			count++
 		}
 	}
-	return count, nil
+	spilled, _, err := p.spilledAttestations(nil /* all committees */, func(att *ethpb.Attestation) bool {
+		seen, err := p.hasSeenBit(att)
+		return err == nil && seen
+	}, true /* remove */)
+	if err != nil {
+		return count, err
+	}
+	return count + len(spilled), nil
 }
 
 // UnaggregatedAttestationCount returns the number of unaggregated attestations key in the pool.
 func (p *AttCaches) UnaggregatedAttestationCount() int {
 	p.unAggregateAttLock.RLock()
 	defer p.unAggregateAttLock.RUnlock()
-	return len(p.unAggregatedAtt)
+	return len(p.unAggregatedAtt) + len(p.spilledRoots)
+}
+
+// reloadSpilledAttestations moves the spilled attestations of a slot and committee back into
+// memory. Errors are logged, as the attestations stay in the spill file.
+func (p *AttCaches) reloadSpilledAttestations(k spillIndexKey) {
+	atts, roots, err := p.spilledAttestations(&k, func(*ethpb.Attestation) bool {
+		return true
+	}, true /* remove */)
+	if err != nil {
+		log.WithError(err).Error("Could not reload spilled attestations")
+		return
+	}
+	for i, att := range atts {
+		p.unAggregatedAtt[roots[i]] = att
+	}
 }
@@ -18,6 +18,12 @@ var (
 			Help: "The number of unaggregated attestations in the pool.",
 		},
 	)
+	spilledUnaggregatedAttsCount = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "spilled_unaggregated_attestations_total",
+			Help: "The number of unaggregated attestations of the pool spilled to disk.",
+		},
+	)
 	expiredAggregatedAtts = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "expired_aggregated_atts_total",
 		Help: "The number of expired and deleted aggregated attestations in the pool.",
@@ -35,4 +41,5 @@ var (
 func (s *Service) updateMetrics() {
 	aggregatedAttsCount.Set(float64(s.pool.AggregatedAttestationCount()))
 	unaggregatedAttsCount.Set(float64(s.pool.UnaggregatedAttestationCount()))
+	spilledUnaggregatedAttsCount.Set(float64(s.pool.SpilledAttestationCount()))
 }
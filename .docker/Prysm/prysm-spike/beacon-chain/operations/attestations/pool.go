@@ -38,6 +38,11 @@ type Pool interface {
 	SaveForkchoiceAttestations(atts []*ethpb.Attestation) error
 	ForkchoiceAttestations() []*ethpb.Attestation
 	DeleteForkchoiceAttestation(att *ethpb.Attestation) error
+	// For spilling unaggregated attestations to disk under memory pressure.
+	EnableSpillover(dir string) error
+	DisableSpillover() error
+	SpillUnaggregatedAttestations(count int) (int, error)
+	SpilledAttestationCount() int
 }
 
 // NewPool initializes a new attestation pool.
@@ -15,6 +15,7 @@ func (s *Service) pruneAttsPool() {
 		select {
 		case <-ticker.C:
 			s.pruneExpiredAtts()
+			s.spillUnderMemoryPressure()
 			s.updateMetrics()
 		case <-s.ctx.Done():
 			log.Debug("Context closed, exiting routine")
@@ -22,12 +22,19 @@ type Service struct {
 	forkChoiceProcessedRoots *lru.Cache
 	genesisTime              uint64
 	pruneInterval            time.Duration
+	spillDir                 string
+	spillThreshold           uint64
+	heapInUse                func() uint64
 }
 
 // Config options for the service.
 type Config struct {
 	Pool          Pool
 	pruneInterval time.Duration
+	// SpillDir is the directory unaggregated attestations are spilled to once the heap of the node
+	// exceeds SpillThreshold bytes. Spilling is disabled when SpillThreshold is zero.
+	SpillDir       string
+	SpillThreshold uint64
 }
 
 // NewService instantiates a new attestation pool service instance that will
@@ -51,11 +58,20 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		pool:                     cfg.Pool,
 		forkChoiceProcessedRoots: cache,
 		pruneInterval:            pruneInterval,
+		spillDir:                 cfg.SpillDir,
+		spillThreshold:           cfg.SpillThreshold,
+		heapInUse:                heapInUse,
 	}, nil
 }
 
 // Start an attestation pool service's main event loop.
 func (s *Service) Start() {
+	if s.spillThreshold > 0 {
+		if err := s.pool.EnableSpillover(s.spillDir); err != nil {
+			log.WithError(err).Error("Could not enable spilling attestations to disk")
+			s.spillThreshold = 0
+		}
+	}
 	go s.prepareForkChoiceAtts()
 	go s.pruneAttsPool()
 }
@@ -64,6 +80,9 @@ func (s *Service) Start() {
 // and associated goroutines.
 func (s *Service) Stop() error {
 	defer s.cancel()
+	if s.spillThreshold > 0 {
+		return s.pool.DisableSpillover()
+	}
 	return nil
 }
 
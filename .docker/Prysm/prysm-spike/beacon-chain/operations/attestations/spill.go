@@ -0,0 +1,38 @@
+package attestations
+
+import (
+	"runtime"
+
+	"github.com/sirupsen/logrus"
+)
+
+// spillUnderMemoryPressure spills half of the unaggregated attestations held in memory to disk
+// while the heap of the node exceeds the spill threshold, such as during attestation floods.
+func (s *Service) spillUnderMemoryPressure() {
+	if s.spillThreshold == 0 {
+		return
+	}
+	heap := s.heapInUse()
+	if heap <= s.spillThreshold {
+		return
+	}
+	inMemory := s.pool.UnaggregatedAttestationCount() - s.pool.SpilledAttestationCount()
+	spilled, err := s.pool.SpillUnaggregatedAttestations(inMemory / 2)
+	if err != nil {
+		log.WithError(err).Error("Could not spill attestations to disk")
+		return
+	}
+	log.WithFields(logrus.Fields{
+		"heapBytes":      heap,
+		"thresholdBytes": s.spillThreshold,
+		"spilled":        spilled,
+		"totalSpilled":   s.pool.SpilledAttestationCount(),
+	}).Warn("Memory threshold exceeded, spilled unaggregated attestations to disk")
+}
+
+// heapInUse returns the bytes of allocated heap objects.
+func heapInUse() uint64 {
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+	return stats.HeapAlloc
+}
@@ -0,0 +1,51 @@
+package attestations
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestSpillUnderMemoryPressure(t *testing.T) {
+	s, err := NewService(context.Background(), &Config{
+		Pool:           NewPool(),
+		SpillDir:       filepath.Join(t.TempDir(), "spill"),
+		SpillThreshold: 1000,
+	})
+	require.NoError(t, err)
+	require.NoError(t, s.pool.EnableSpillover(s.spillDir))
+	defer func() {
+		require.NoError(t, s.Stop())
+	}()
+
+	for i := uint64(0); i < 4; i++ {
+		bits := bitfield.NewBitlist(8)
+		bits.SetBitAt(i, true)
+		require.NoError(t, s.pool.SaveUnaggregatedAttestation(&ethpb.Attestation{
+			AggregationBits: bits,
+			Data: &ethpb.AttestationData{
+				Slot:            i,
+				BeaconBlockRoot: make([]byte, 32),
+				Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+				Target:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+			},
+			Signature: make([]byte, 96),
+		}))
+	}
+
+	s.heapInUse = func() uint64 { return 500 }
+	s.spillUnderMemoryPressure()
+	assert.Equal(t, 0, s.pool.SpilledAttestationCount())
+
+	s.heapInUse = func() uint64 { return 2000 }
+	s.spillUnderMemoryPressure()
+	assert.Equal(t, 2, s.pool.SpilledAttestationCount())
+	assert.Equal(t, 4, s.pool.UnaggregatedAttestationCount())
+	s.spillUnderMemoryPressure()
+	assert.Equal(t, 3, s.pool.SpilledAttestationCount())
+}
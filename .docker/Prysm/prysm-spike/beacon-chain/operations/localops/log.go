@@ -0,0 +1,7 @@
+package localops
+
+import (
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "pool/localops")
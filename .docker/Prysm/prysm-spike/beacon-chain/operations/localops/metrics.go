@@ -0,0 +1,33 @@
+package localops
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	numPendingOperations = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "num_pending_local_operations",
+			Help: "Number of locally submitted exits and slashings awaiting inclusion",
+		},
+	)
+	localOperationRebroadcasts = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "local_operations_rebroadcast_total",
+			Help: "Number of times locally submitted exits and slashings were rebroadcast",
+		},
+	)
+	localOperationsIncluded = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "local_operations_included_total",
+			Help: "Number of locally submitted exits and slashings which were included",
+		},
+	)
+	localOperationsExpired = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "local_operations_expired_total",
+			Help: "Number of locally submitted exits and slashings dropped before they were included",
+		},
+	)
+)
@@ -0,0 +1,349 @@
+// Package localops keeps the voluntary exits and slashings submitted to this beacon node
+// until they are included in a block or expire. The operations are persisted in the
+// database and rebroadcast every epoch, so they survive restarts of the node.
+package localops
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	beaconstate "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/runutil"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
+	"github.com/sirupsen/logrus"
+)
+
+// DefaultExpiryEpochs is the number of epochs after its submission a local operation is
+// rebroadcast for before it is dropped, if it was not included by then.
+const DefaultExpiryEpochs = 256
+
+// Tracker records the operations submitted to this node and lists the ones which are
+// still awaiting inclusion.
+type Tracker interface {
+	TrackVoluntaryExit(ctx context.Context, exit *ethpb.SignedVoluntaryExit) error
+	TrackProposerSlashing(ctx context.Context, slashing *ethpb.ProposerSlashing) error
+	TrackAttesterSlashing(ctx context.Context, slashing *ethpb.AttesterSlashing) error
+	PendingOperations() []*dbpb.LocalOperation
+}
+
+// Service rebroadcasts the locally submitted operations until they are included.
+type Service struct {
+	ctx               context.Context
+	cancel            context.CancelFunc
+	db                db.NoHeadAccessDatabase
+	headFetcher       blockchain.HeadFetcher
+	broadcaster       p2p.Broadcaster
+	exitPool          *voluntaryexits.Pool
+	slashingsPool     *slashings.Pool
+	expiryEpochs      uint64
+	rebroadcastPeriod time.Duration
+	lock              sync.RWMutex
+	pending           map[[32]byte]*dbpb.LocalOperation
+}
+
+// Config options for the service.
+type Config struct {
+	BeaconDB      db.NoHeadAccessDatabase
+	HeadFetcher   blockchain.HeadFetcher
+	Broadcaster   p2p.Broadcaster
+	ExitPool      *voluntaryexits.Pool
+	SlashingsPool *slashings.Pool
+	// ExpiryEpochs defaults to DefaultExpiryEpochs when zero.
+	ExpiryEpochs uint64
+}
+
+var _ = Tracker(&Service{})
+
+// NewService instantiates the local operations service.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	expiryEpochs := cfg.ExpiryEpochs
+	if expiryEpochs == 0 {
+		expiryEpochs = DefaultExpiryEpochs
+	}
+	return &Service{
+		ctx:               ctx,
+		cancel:            cancel,
+		db:                cfg.BeaconDB,
+		headFetcher:       cfg.HeadFetcher,
+		broadcaster:       cfg.Broadcaster,
+		exitPool:          cfg.ExitPool,
+		slashingsPool:     cfg.SlashingsPool,
+		expiryEpochs:      expiryEpochs,
+		rebroadcastPeriod: time.Duration(params.BeaconConfig().SlotsPerEpoch*params.BeaconConfig().SecondsPerSlot) * time.Second,
+		pending:           make(map[[32]byte]*dbpb.LocalOperation),
+	}
+}
+
+// Start loads the pending operations saved before the last shutdown and rebroadcasts
+// the pending operations once per epoch.
+func (s *Service) Start() {
+	if err := s.loadPending(s.ctx); err != nil {
+		log.WithError(err).Error("Could not load pending local operations")
+	}
+	// Operations restored from the database are rebroadcast right away, as the peers of the
+	// node may have missed them while it was down.
+	go s.rebroadcast(s.ctx, true /* force */)
+	runutil.RunEvery(s.ctx, s.rebroadcastPeriod, func() {
+		s.rebroadcast(s.ctx, false /* force */)
+	})
+}
+
+// Stop the service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the service.
+func (s *Service) Status() error {
+	return nil
+}
+
+// TrackVoluntaryExit records a voluntary exit broadcast by this node.
+func (s *Service) TrackVoluntaryExit(ctx context.Context, exit *ethpb.SignedVoluntaryExit) error {
+	return s.track(ctx, &dbpb.LocalOperation{
+		Operation: &dbpb.LocalOperation_VoluntaryExit{VoluntaryExit: exit},
+	})
+}
+
+// TrackProposerSlashing records a proposer slashing broadcast by this node.
+func (s *Service) TrackProposerSlashing(ctx context.Context, slashing *ethpb.ProposerSlashing) error {
+	return s.track(ctx, &dbpb.LocalOperation{
+		Operation: &dbpb.LocalOperation_ProposerSlashing{ProposerSlashing: slashing},
+	})
+}
+
+// TrackAttesterSlashing records an attester slashing broadcast by this node.
+func (s *Service) TrackAttesterSlashing(ctx context.Context, slashing *ethpb.AttesterSlashing) error {
+	return s.track(ctx, &dbpb.LocalOperation{
+		Operation: &dbpb.LocalOperation_AttesterSlashing{AttesterSlashing: slashing},
+	})
+}
+
+// PendingOperations returns the operations awaiting inclusion, oldest first.
+func (s *Service) PendingOperations() []*dbpb.LocalOperation {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	ops := make([]*dbpb.LocalOperation, 0, len(s.pending))
+	for _, op := range s.pending {
+		ops = append(ops, proto.Clone(op).(*dbpb.LocalOperation))
+	}
+	sort.SliceStable(ops, func(i, j int) bool {
+		return ops[i].SubmittedEpoch < ops[j].SubmittedEpoch
+	})
+	return ops
+}
+
+// loadPending restores the pending operations from the database.
+func (s *Service) loadPending(ctx context.Context) error {
+	ops, err := s.db.LocalOperations(ctx)
+	if err != nil {
+		return err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for _, op := range ops {
+		root, err := OperationRoot(op)
+		if err != nil {
+			return err
+		}
+		s.pending[root] = op
+	}
+	numPendingOperations.Set(float64(len(s.pending)))
+	if len(ops) > 0 {
+		log.WithField("count", len(ops)).Info("Loaded pending local operations")
+	}
+	return nil
+}
+
+func (s *Service) track(ctx context.Context, op *dbpb.LocalOperation) error {
+	root, err := OperationRoot(op)
+	if err != nil {
+		return err
+	}
+	epoch := helpers.SlotToEpoch(s.headFetcher.HeadSlot())
+	op.SubmittedEpoch = epoch
+	op.LastBroadcastEpoch = epoch
+	op.BroadcastAttempts = 1
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if _, ok := s.pending[root]; ok {
+		return nil
+	}
+	if err := s.db.SaveLocalOperation(ctx, root, op); err != nil {
+		return err
+	}
+	s.pending[root] = op
+	numPendingOperations.Set(float64(len(s.pending)))
+	return nil
+}
+
+// rebroadcast drops the pending operations which were included or expired, and inserts the
+// others into the operation pools and broadcasts them again. Unless forced, operations are
+// broadcast at most once per epoch.
+func (s *Service) rebroadcast(ctx context.Context, force bool) {
+	headState, err := s.headFetcher.HeadState(ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not get head state")
+		return
+	}
+	if headState == nil {
+		return
+	}
+	epoch := helpers.SlotToEpoch(headState.Slot())
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for root, op := range s.pending {
+		fields := logrus.Fields{"type": OperationType(op), "root": fmt.Sprintf("%#x", root[:8])}
+		expired := epoch >= op.SubmittedEpoch+s.expiryEpochs
+		included, err := isIncluded(headState, op)
+		if err != nil {
+			log.WithError(err).WithFields(fields).Error("Could not check inclusion of local operation")
+			// An operation which cannot be checked, such as one of an unknown validator, still
+			// expires instead of being kept forever.
+			if !expired {
+				continue
+			}
+			included = false
+		}
+		if included || expired {
+			if err := s.db.DeleteLocalOperation(ctx, root); err != nil {
+				log.WithError(err).WithFields(fields).Error("Could not delete local operation")
+				continue
+			}
+			delete(s.pending, root)
+			if included {
+				localOperationsIncluded.Inc()
+				log.WithFields(fields).Info("Local operation was included")
+			} else {
+				localOperationsExpired.Inc()
+				log.WithFields(fields).Warn("Local operation expired before it was included")
+			}
+			continue
+		}
+		if !force && op.LastBroadcastEpoch == epoch {
+			continue
+		}
+		if err := s.broadcast(ctx, headState, op); err != nil {
+			log.WithError(err).WithFields(fields).Error("Could not rebroadcast local operation")
+			continue
+		}
+		op.BroadcastAttempts++
+		op.LastBroadcastEpoch = epoch
+		localOperationRebroadcasts.Inc()
+		if err := s.db.SaveLocalOperation(ctx, root, op); err != nil {
+			log.WithError(err).WithFields(fields).Error("Could not save local operation")
+		}
+	}
+	numPendingOperations.Set(float64(len(s.pending)))
+}
+
+// broadcast inserts the operation into its pool, which may not hold it anymore after a
+// restart, and broadcasts it to the network.
+func (s *Service) broadcast(ctx context.Context, headState *beaconstate.BeaconState, op *dbpb.LocalOperation) error {
+	var msg proto.Message
+	switch o := op.Operation.(type) {
+	case *dbpb.LocalOperation_VoluntaryExit:
+		s.exitPool.InsertVoluntaryExit(ctx, headState, o.VoluntaryExit)
+		msg = o.VoluntaryExit
+	case *dbpb.LocalOperation_ProposerSlashing:
+		if err := s.slashingsPool.InsertProposerSlashing(ctx, headState, o.ProposerSlashing); err != nil {
+			log.WithError(err).Debug("Could not insert proposer slashing into pool")
+		}
+		msg = o.ProposerSlashing
+	case *dbpb.LocalOperation_AttesterSlashing:
+		if err := s.slashingsPool.InsertAttesterSlashing(ctx, headState, o.AttesterSlashing); err != nil {
+			log.WithError(err).Debug("Could not insert attester slashing into pool")
+		}
+		msg = o.AttesterSlashing
+	default:
+		return fmt.Errorf("unknown local operation %T", op.Operation)
+	}
+	return s.broadcaster.Broadcast(ctx, msg)
+}
+
+// isIncluded checks whether the effect of the operation is reflected by the state.
+func isIncluded(st *beaconstate.BeaconState, op *dbpb.LocalOperation) (bool, error) {
+	indices := ValidatorIndices(op)
+	if len(indices) == 0 {
+		return false, fmt.Errorf("local operation %T has no validators", op.Operation)
+	}
+	for _, idx := range indices {
+		v, err := st.ValidatorAtIndexReadOnly(idx)
+		if err != nil {
+			return false, err
+		}
+		switch op.Operation.(type) {
+		case *dbpb.LocalOperation_VoluntaryExit:
+			if v.ExitEpoch() == params.BeaconConfig().FarFutureEpoch {
+				return false, nil
+			}
+		default:
+			if !v.Slashed() {
+				return false, nil
+			}
+		}
+	}
+	return true, nil
+}
+
+// OperationRoot returns the hash tree root identifying the operation.
+func OperationRoot(op *dbpb.LocalOperation) ([32]byte, error) {
+	switch o := op.Operation.(type) {
+	case *dbpb.LocalOperation_VoluntaryExit:
+		return o.VoluntaryExit.HashTreeRoot()
+	case *dbpb.LocalOperation_ProposerSlashing:
+		return o.ProposerSlashing.HashTreeRoot()
+	case *dbpb.LocalOperation_AttesterSlashing:
+		return o.AttesterSlashing.HashTreeRoot()
+	default:
+		return [32]byte{}, fmt.Errorf("unknown local operation %T", op.Operation)
+	}
+}
+
+// OperationType returns a short name of the kind of the operation.
+func OperationType(op *dbpb.LocalOperation) string {
+	switch op.Operation.(type) {
+	case *dbpb.LocalOperation_VoluntaryExit:
+		return "voluntary_exit"
+	case *dbpb.LocalOperation_ProposerSlashing:
+		return "proposer_slashing"
+	case *dbpb.LocalOperation_AttesterSlashing:
+		return "attester_slashing"
+	default:
+		return "unknown"
+	}
+}
+
+// ValidatorIndices returns the indices of the validators exited or slashed by the operation.
+func ValidatorIndices(op *dbpb.LocalOperation) []uint64 {
+	switch o := op.Operation.(type) {
+	case *dbpb.LocalOperation_VoluntaryExit:
+		return []uint64{o.VoluntaryExit.Exit.ValidatorIndex}
+	case *dbpb.LocalOperation_ProposerSlashing:
+		return []uint64{o.ProposerSlashing.Header_1.Header.ProposerIndex}
+	case *dbpb.LocalOperation_AttesterSlashing:
+		return sliceutil.IntersectionUint64(
+			o.AttesterSlashing.Attestation_1.AttestingIndices,
+			o.AttesterSlashing.Attestation_2.AttestingIndices,
+		)
+	default:
+		return nil
+	}
+}
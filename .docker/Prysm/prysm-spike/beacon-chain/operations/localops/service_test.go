@@ -0,0 +1,144 @@
+package localops
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_RebroadcastUntilIncluded(t *testing.T) {
+	db, _ := dbTest.SetupDB(t)
+	ctx := context.Background()
+	st, _ := testutil.DeterministicGenesisState(t, 8)
+	require.NoError(t, st.SetSlot(params.BeaconConfig().SlotsPerEpoch))
+	broadcaster := &p2ptest.MockBroadcaster{}
+	cfg := &Config{
+		BeaconDB:      db,
+		HeadFetcher:   &mock.ChainService{State: st},
+		Broadcaster:   broadcaster,
+		ExitPool:      voluntaryexits.NewPool(),
+		SlashingsPool: slashings.NewPool(),
+	}
+	s := NewService(ctx, cfg)
+	exit := &ethpb.SignedVoluntaryExit{
+		Exit:      &ethpb.VoluntaryExit{Epoch: 1, ValidatorIndex: 3},
+		Signature: make([]byte, 96),
+	}
+	require.NoError(t, s.TrackVoluntaryExit(ctx, exit))
+	require.NoError(t, s.TrackVoluntaryExit(ctx, exit))
+	pending := s.PendingOperations()
+	require.Equal(t, 1, len(pending))
+	assert.Equal(t, uint64(1), pending[0].SubmittedEpoch)
+	assert.Equal(t, uint64(1), pending[0].BroadcastAttempts)
+	assert.DeepEqual(t, []uint64{3}, ValidatorIndices(pending[0]))
+
+	// Operations are not rebroadcast within the epoch of their last broadcast.
+	s.rebroadcast(ctx, false)
+	assert.Equal(t, false, broadcaster.BroadcastCalled)
+	assert.Equal(t, uint64(1), s.PendingOperations()[0].BroadcastAttempts)
+
+	require.NoError(t, st.SetSlot(2*params.BeaconConfig().SlotsPerEpoch))
+	s.rebroadcast(ctx, false)
+	assert.Equal(t, true, broadcaster.BroadcastCalled)
+	pending = s.PendingOperations()
+	require.Equal(t, 1, len(pending))
+	assert.Equal(t, uint64(2), pending[0].BroadcastAttempts)
+	assert.Equal(t, uint64(2), pending[0].LastBroadcastEpoch)
+
+	// A restarted service restores the pending operations from the database.
+	restarted := NewService(ctx, cfg)
+	require.NoError(t, restarted.loadPending(ctx))
+	pending = restarted.PendingOperations()
+	require.Equal(t, 1, len(pending))
+	assert.Equal(t, uint64(2), pending[0].BroadcastAttempts)
+
+	// The exit is dropped once the validator is exiting.
+	val, err := st.ValidatorAtIndex(3)
+	require.NoError(t, err)
+	val.ExitEpoch = 10
+	require.NoError(t, st.UpdateValidatorAtIndex(3, val))
+	restarted.rebroadcast(ctx, false)
+	assert.Equal(t, 0, len(restarted.PendingOperations()))
+	ops, err := db.LocalOperations(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(ops))
+}
+
+func TestService_DropsExpiredOperations(t *testing.T) {
+	db, _ := dbTest.SetupDB(t)
+	ctx := context.Background()
+	st, _ := testutil.DeterministicGenesisState(t, 8)
+	s := NewService(ctx, &Config{
+		BeaconDB:      db,
+		HeadFetcher:   &mock.ChainService{State: st},
+		Broadcaster:   &p2ptest.MockBroadcaster{},
+		ExitPool:      voluntaryexits.NewPool(),
+		SlashingsPool: slashings.NewPool(),
+		ExpiryEpochs:  4,
+	})
+	slashing := &ethpb.ProposerSlashing{
+		Header_1: signedHeader(2, 0),
+		Header_2: signedHeader(2, 1),
+	}
+	require.NoError(t, s.TrackProposerSlashing(ctx, slashing))
+
+	require.NoError(t, st.SetSlot(3*params.BeaconConfig().SlotsPerEpoch))
+	s.rebroadcast(ctx, false)
+	require.Equal(t, 1, len(s.PendingOperations()))
+	assert.Equal(t, "proposer_slashing", OperationType(s.PendingOperations()[0]))
+
+	require.NoError(t, st.SetSlot(4*params.BeaconConfig().SlotsPerEpoch))
+	s.rebroadcast(ctx, false)
+	assert.Equal(t, 0, len(s.PendingOperations()))
+}
+
+func signedHeader(proposerIndex, slot uint64) *ethpb.SignedBeaconBlockHeader {
+	return &ethpb.SignedBeaconBlockHeader{
+		Header: &ethpb.BeaconBlockHeader{
+			Slot:          slot,
+			ProposerIndex: proposerIndex,
+			ParentRoot:    make([]byte, 32),
+			StateRoot:     make([]byte, 32),
+			BodyRoot:      make([]byte, 32),
+		},
+		Signature: make([]byte, 96),
+	}
+}
+
+func TestService_DropsExpiredOperationsOfUnknownValidators(t *testing.T) {
+	db, _ := dbTest.SetupDB(t)
+	ctx := context.Background()
+	st, _ := testutil.DeterministicGenesisState(t, 8)
+	s := NewService(ctx, &Config{
+		BeaconDB:      db,
+		HeadFetcher:   &mock.ChainService{State: st},
+		Broadcaster:   &p2ptest.MockBroadcaster{},
+		ExitPool:      voluntaryexits.NewPool(),
+		SlashingsPool: slashings.NewPool(),
+		ExpiryEpochs:  4,
+	})
+	exit := &ethpb.SignedVoluntaryExit{
+		Exit:      &ethpb.VoluntaryExit{Epoch: 0, ValidatorIndex: 100},
+		Signature: make([]byte, 96),
+	}
+	require.NoError(t, s.TrackVoluntaryExit(ctx, exit))
+
+	// The inclusion of an exit of an unknown validator cannot be checked, so it is kept until it expires.
+	require.NoError(t, st.SetSlot(3*params.BeaconConfig().SlotsPerEpoch))
+	s.rebroadcast(ctx, false)
+	require.Equal(t, 1, len(s.PendingOperations()))
+
+	require.NoError(t, st.SetSlot(4*params.BeaconConfig().SlotsPerEpoch))
+	s.rebroadcast(ctx, false)
+	assert.Equal(t, 0, len(s.PendingOperations()))
+}
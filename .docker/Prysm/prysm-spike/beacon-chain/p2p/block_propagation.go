@@ -0,0 +1,151 @@
+package p2p
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+)
+
+const (
+	// blockPropagationTraceCapacity is the number of recent blocks whose propagation is kept.
+	blockPropagationTraceCapacity = 256
+	// maxArrivalsPerBlock bounds the number of peers recorded for a single block.
+	maxArrivalsPerBlock = 128
+)
+
+// BlockArrival is the first time a peer delivered a block to this node.
+type BlockArrival struct {
+	Peer string
+	Time time.Time
+	// SinceFirstSeenMs estimates how many milliseconds the path through this peer lags the
+	// fastest path the block took to this node.
+	SinceFirstSeenMs int64
+}
+
+// BlockPropagationTrace describes how a gossiped block reached this node.
+type BlockPropagationTrace struct {
+	MessageID []byte
+	// BlockRoot and Slot are unset until the block is decoded.
+	BlockRoot []byte
+	Slot      uint64
+	FirstSeen time.Time
+	// SinceSlotStartMs is how many milliseconds after the start of its slot the block was first
+	// seen, an estimate of the total propagation latency from the proposer.
+	SinceSlotStartMs int64
+	Arrivals         []BlockArrival
+}
+
+// BlockPropagationTracer records the time every peer first delivered each gossiped block to
+// this node, keeping the traces of the most recent blocks in a ring buffer. It is registered
+// as a pubsub event tracer, which sees every message received before it is validated.
+type BlockPropagationTracer struct {
+	lock   sync.RWMutex
+	traces map[string]*BlockPropagationTrace
+	ring   []string // Message IDs in insertion order, wrapping at the capacity.
+	next   int
+}
+
+// NewBlockPropagationTracer creates an empty tracer.
+func NewBlockPropagationTracer() *BlockPropagationTracer {
+	return &BlockPropagationTracer{
+		traces: make(map[string]*BlockPropagationTrace, blockPropagationTraceCapacity),
+		ring:   make([]string, 0, blockPropagationTraceCapacity),
+	}
+}
+
+// Trace records the block messages of received RPCs. It is called by pubsub for every traced event.
+func (t *BlockPropagationTracer) Trace(evt *pubsubpb.TraceEvent) {
+	if evt.GetType() != pubsubpb.TraceEvent_RECV_RPC {
+		return
+	}
+	rpc := evt.GetRecvRPC()
+	for _, m := range rpc.GetMeta().GetMessages() {
+		if !isBlockTopic(m.GetTopic()) {
+			continue
+		}
+		from := peer.ID(rpc.GetReceivedFrom()).String()
+		t.recordArrival(string(m.GetMessageID()), from, time.Unix(0, evt.GetTimestamp()))
+	}
+}
+
+// AnnotateBlock attaches the root and slot of a decoded block to the trace of its message.
+func (t *BlockPropagationTracer) AnnotateBlock(msg *pubsub.Message, root [32]byte, slot uint64, slotStart time.Time) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	trace := t.traceFor(msgIDFunction(msg.Message), timeutils.Now())
+	trace.BlockRoot = root[:]
+	trace.Slot = slot
+	trace.SinceSlotStartMs = trace.FirstSeen.Sub(slotStart).Milliseconds()
+}
+
+// Traces returns up to limit of the most recent block traces, newest first. A limit of 0
+// returns every trace.
+func (t *BlockPropagationTracer) Traces(limit int) []*BlockPropagationTrace {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	n := len(t.ring)
+	if limit <= 0 || limit > n {
+		limit = n
+	}
+	traces := make([]*BlockPropagationTrace, 0, limit)
+	for i := 1; i <= limit; i++ {
+		trace := *t.traces[t.ring[(t.next-i+n)%n]]
+		trace.Arrivals = append([]BlockArrival(nil), trace.Arrivals...)
+		traces = append(traces, &trace)
+	}
+	return traces
+}
+
+func (t *BlockPropagationTracer) recordArrival(msgID, from string, at time.Time) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	trace := t.traceFor(msgID, at)
+	if len(trace.Arrivals) >= maxArrivalsPerBlock {
+		return
+	}
+	for _, a := range trace.Arrivals {
+		if a.Peer == from {
+			return
+		}
+	}
+	trace.Arrivals = append(trace.Arrivals, BlockArrival{
+		Peer:             from,
+		Time:             at,
+		SinceFirstSeenMs: at.Sub(trace.FirstSeen).Milliseconds(),
+	})
+}
+
+// traceFor returns the trace of the message, creating it and evicting the oldest trace if
+// the message was not seen before. The caller must hold the lock.
+func (t *BlockPropagationTracer) traceFor(msgID string, firstSeen time.Time) *BlockPropagationTrace {
+	if trace, ok := t.traces[msgID]; ok {
+		return trace
+	}
+	trace := &BlockPropagationTrace{
+		MessageID: []byte(msgID),
+		FirstSeen: firstSeen,
+		Arrivals:  make([]BlockArrival, 0),
+	}
+	if len(t.ring) < blockPropagationTraceCapacity {
+		t.ring = append(t.ring, msgID)
+	} else {
+		delete(t.traces, t.ring[t.next])
+		t.ring[t.next] = msgID
+	}
+	t.next = (t.next + 1) % blockPropagationTraceCapacity
+	t.traces[msgID] = trace
+	return trace
+}
+
+func isBlockTopic(topic string) bool {
+	// Block topics have the form /eth2/<fork digest>/beacon_block/<encoding>.
+	name := strings.TrimPrefix(GossipTypeMapping[reflect.TypeOf(&ethpb.SignedBeaconBlock{})], "/eth2/%x")
+	return strings.Contains(topic, name+"/")
+}
@@ -0,0 +1,76 @@
+package p2p
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/snappy"
+	"github.com/libp2p/go-libp2p-core/peer"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+const testBlockTopic = "/eth2/01020304/beacon_block/ssz_snappy"
+
+func recvRPCEvent(from peer.ID, at time.Time, topic string, msgIDs ...string) *pubsubpb.TraceEvent {
+	ts := at.UnixNano()
+	msgs := make([]*pubsubpb.TraceEvent_MessageMeta, len(msgIDs))
+	for i, id := range msgIDs {
+		msgs[i] = &pubsubpb.TraceEvent_MessageMeta{MessageID: []byte(id), Topic: &topic}
+	}
+	return &pubsubpb.TraceEvent{
+		Type:      pubsubpb.TraceEvent_RECV_RPC.Enum(),
+		Timestamp: &ts,
+		RecvRPC: &pubsubpb.TraceEvent_RecvRPC{
+			ReceivedFrom: []byte(from),
+			Meta:         &pubsubpb.TraceEvent_RPCMeta{Messages: msgs},
+		},
+	}
+}
+
+func TestBlockPropagationTracer_RecordsFirstArrivalPerPeer(t *testing.T) {
+	tracer := NewBlockPropagationTracer()
+	msg := &pubsub.Message{Message: &pubsubpb.Message{Data: snappy.Encode(nil, []byte("block"))}}
+	msgID := msgIDFunction(msg.Message)
+	start := time.Unix(1600000000, 0)
+	peerA, peerB := peer.ID("a"), peer.ID("b")
+
+	tracer.Trace(recvRPCEvent(peerA, start, testBlockTopic, msgID))
+	tracer.Trace(recvRPCEvent(peerB, start.Add(150*time.Millisecond), testBlockTopic, msgID))
+	// Later deliveries from a peer and other topics are not recorded.
+	tracer.Trace(recvRPCEvent(peerA, start.Add(time.Second), testBlockTopic, msgID))
+	tracer.Trace(recvRPCEvent(peerA, start, "/eth2/01020304/beacon_attestation_1/ssz_snappy", "att"))
+	tracer.AnnotateBlock(msg, [32]byte{'r'}, 10, start.Add(-400*time.Millisecond))
+
+	traces := tracer.Traces(0)
+	require.Equal(t, 1, len(traces))
+	trace := traces[0]
+	assert.DeepEqual(t, []byte(msgID), trace.MessageID)
+	root := [32]byte{'r'}
+	assert.DeepEqual(t, root[:], trace.BlockRoot)
+	assert.Equal(t, uint64(10), trace.Slot)
+	assert.Equal(t, int64(400), trace.SinceSlotStartMs)
+	require.Equal(t, 2, len(trace.Arrivals))
+	assert.Equal(t, peerA.String(), trace.Arrivals[0].Peer)
+	assert.Equal(t, int64(0), trace.Arrivals[0].SinceFirstSeenMs)
+	assert.Equal(t, peerB.String(), trace.Arrivals[1].Peer)
+	assert.Equal(t, int64(150), trace.Arrivals[1].SinceFirstSeenMs)
+}
+
+func TestBlockPropagationTracer_EvictsOldestTraces(t *testing.T) {
+	tracer := NewBlockPropagationTracer()
+	start := time.Unix(1600000000, 0)
+	total := blockPropagationTraceCapacity + 10
+	for i := 0; i < total; i++ {
+		tracer.Trace(recvRPCEvent("a", start.Add(time.Duration(i)*time.Second), testBlockTopic, fmt.Sprintf("%d", i)))
+	}
+
+	traces := tracer.Traces(0)
+	require.Equal(t, blockPropagationTraceCapacity, len(traces))
+	assert.Equal(t, start.Add(time.Duration(total-1)*time.Second), traces[0].FirstSeen)
+	assert.Equal(t, start.Add(10*time.Second), traces[len(traces)-1].FirstSeen)
+	assert.Equal(t, blockPropagationTraceCapacity, len(tracer.traces))
+}
@@ -44,7 +44,7 @@ func (s *Service) Broadcast(ctx context.Context, msg proto.Message) error {
 		traceutil.AnnotateError(span, ErrMessageNotMapped)
 		return ErrMessageNotMapped
 	}
-	return s.broadcastObject(ctx, msg, fmt.Sprintf(topic, forkDigest))
+	return s.broadcastObject(ctx, msg, fmt.Sprintf(topic, forkDigest), false /* awaitMesh */)
 }
 
 // BroadcastAttestation broadcasts an attestation to the p2p network.
@@ -109,14 +109,16 @@ func (s *Service) broadcastAttestation(ctx context.Context, subnet uint64, att *
 		}
 	}
 
-	if err := s.broadcastObject(ctx, att, attestationToTopic(subnet, forkDigest)); err != nil {
+	// The broadcast runs in the background, so it can wait for the mesh of a newly joined subnet.
+	if err := s.broadcastObject(ctx, att, attestationToTopic(subnet, forkDigest), true /* awaitMesh */); err != nil {
 		log.WithError(err).Error("Failed to broadcast attestation")
 		traceutil.AnnotateError(span, err)
 	}
 }
 
-// method to broadcast messages to other peers in our gossip mesh.
-func (s *Service) broadcastObject(ctx context.Context, obj interface{}, topic string) error {
+// method to broadcast messages to other peers in our gossip mesh, optionally waiting for the mesh
+// of a newly joined topic to form.
+func (s *Service) broadcastObject(ctx context.Context, obj interface{}, topic string, awaitMesh bool) error {
 	_, span := trace.StartSpan(ctx, "p2p.broadcastObject")
 	defer span.End()
 
@@ -135,7 +137,11 @@ func (s *Service) broadcastObject(ctx context.Context, obj interface{}, topic st
 		span.AddMessageSendEvent(int64(id), messageLen /*uncompressed*/, messageLen /*compressed*/)
 	}
 
-	if err := s.PublishToTopic(ctx, topic+s.Encoding().ProtocolSuffix(), buf.Bytes()); err != nil {
+	publish := s.PublishToTopic
+	if awaitMesh {
+		publish = s.publishToTopicAwaitingMesh
+	}
+	if err := publish(ctx, topic+s.Encoding().ProtocolSuffix(), buf.Bytes()); err != nil {
 		err := errors.Wrap(err, "could not publish message")
 		traceutil.AnnotateError(span, err)
 		return err
@@ -48,11 +48,19 @@ func (s *Service) InterceptAccept(n network.ConnMultiaddrs) (allow bool) {
 		runtime.Gosched()
 		log.WithFields(logrus.Fields{"peer": n.RemoteMultiaddr(),
 			"reason": "exceeded dial limit"}).Trace("Not accepting inbound dial from ip address")
+		inboundRejections.WithLabelValues("dial limit").Inc()
 		return false
 	}
 	if s.isPeerAtLimit(true /* inbound */) {
 		log.WithFields(logrus.Fields{"peer": n.RemoteMultiaddr(),
 			"reason": "at peer limit"}).Trace("Not accepting inbound dial")
+		inboundRejections.WithLabelValues("peer limit").Inc()
+		return false
+	}
+	if reason := s.exceedsInboundLimits(n.RemoteMultiaddr()); reason != "" {
+		log.WithFields(logrus.Fields{"peer": n.RemoteMultiaddr(),
+			"reason": reason}).Trace("Not accepting inbound dial")
+		inboundRejections.WithLabelValues(reason).Inc()
 		return false
 	}
 	return filterConnections(s.addrFilter, n.RemoteMultiaddr())
@@ -82,6 +90,20 @@ func (s *Service) validateDial(addr multiaddr.Multiaddr) bool {
 	return true
 }
 
+// exceedsInboundLimits returns the reason why an inbound dial from the address exceeds the
+// configured inbound limits, or an empty string if it may be accepted.
+func (s *Service) exceedsInboundLimits(addr multiaddr.Multiaddr) string {
+	limits := s.InboundLimits()
+	if limits == (InboundLimits{}) {
+		return ""
+	}
+	ip, err := manet.ToIP(addr)
+	if err != nil {
+		return ""
+	}
+	return limits.rejectReason(ip, s.inboundIPs(), s.cfg.MaxPeers)
+}
+
 // configureFilter looks at the provided allow lists and
 // deny lists to appropriately create a filter.
 func configureFilter(cfg *Config) (*filter.Filters, error) {
@@ -60,7 +60,10 @@ func (s *Service) RefreshENR() {
 
 // listen for new nodes watches for new nodes in the network and adds them to the peerstore.
 func (s *Service) listenForNewNodes() {
-	iterator := s.dv5Listener.RandomNodes()
+	iterator := s.nodeIterator()
+	if iterator == nil {
+		return
+	}
 	iterator = enode.Filter(iterator, s.filterPeer)
 	defer iterator.Close()
 	for {
@@ -93,6 +96,24 @@ func (s *Service) listenForNewNodes() {
 	}
 }
 
+// nodeIterator returns the nodes found by discovery and the nodes of the ENR trees given with
+// --bootstrap-dns, taken from each source in turn, or nil when there is neither.
+func (s *Service) nodeIterator() enode.Iterator {
+	dns := s.dnsIterator()
+	switch {
+	case dns == nil && s.dv5Listener == nil:
+		return nil
+	case dns == nil:
+		return s.dv5Listener.RandomNodes()
+	case s.dv5Listener == nil:
+		return dns
+	}
+	mix := enode.NewFairMix(dnsFairMixTimeout)
+	mix.AddSource(s.dv5Listener.RandomNodes())
+	mix.AddSource(dns)
+	return mix
+}
+
 func (s *Service) createListener(
 	ipAddr net.IP,
 	privKey *ecdsa.PrivateKey,
@@ -381,6 +402,24 @@ func peersFromStringAddrs(addrs []string) ([]ma.Multiaddr, error) {
 	return allAddrs, nil
 }
 
+// PeerIDsFromStringAddrs returns the peer IDs of the multiaddrs and ENRs, such as the static
+// peers. Invalid addresses are logged and skipped, as they are when dialing the static peers.
+func PeerIDsFromStringAddrs(addrs []string) ([]peer.ID, error) {
+	multiAddrs, err := peersFromStringAddrs(addrs)
+	if err != nil {
+		return nil, err
+	}
+	pids := make([]peer.ID, 0, len(multiAddrs))
+	for _, addr := range multiAddrs {
+		info, err := peer.AddrInfoFromP2pAddr(addr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get peer id of %s", addr)
+		}
+		pids = append(pids, info.ID)
+	}
+	return pids, nil
+}
+
 func multiAddrFromString(address string) (ma.Multiaddr, error) {
 	addr, err := iaddr.ParseString(address)
 	if err != nil {
@@ -164,6 +164,22 @@ func TestMultiAddrConversion_OK(t *testing.T) {
 	require.LogsDoNotContain(t, hook, "Could not get multiaddr")
 }
 
+func TestPeerIDsFromStringAddrs(t *testing.T) {
+	pids, err := PeerIDsFromStringAddrs([]string{
+		"/ip4/127.0.0.1/tcp/6660/p2p/QmQ7zhY7nGY66yK1n8hLGevfVyjbtvHSgtZuXkCH9oTrgi",
+		"/ip4/127.0.0.1/tcp/33201/p2p/QmaXZhW44pwQxBSeLkE5FNeLz8tGTTEsRciFg1DNWXXrWG",
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(pids))
+	assert.Equal(t, "QmQ7zhY7nGY66yK1n8hLGevfVyjbtvHSgtZuXkCH9oTrgi", pids[0].String())
+	assert.Equal(t, "QmaXZhW44pwQxBSeLkE5FNeLz8tGTTEsRciFg1DNWXXrWG", pids[1].String())
+
+	// Addresses without peer id are skipped.
+	pids, err = PeerIDsFromStringAddrs([]string{"/ip4/127.0.0.1/tcp/6660"})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(pids))
+}
+
 func TestStaticPeering_PeersAreAdded(t *testing.T) {
 	cfg := &Config{
 		MaxPeers: 30,
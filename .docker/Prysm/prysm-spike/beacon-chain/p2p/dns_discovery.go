@@ -0,0 +1,56 @@
+package p2p
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/dnsdisc"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+)
+
+// dnsTreeRefreshInterval defines how often the ENR trees given with --bootstrap-dns are resolved.
+var dnsTreeRefreshInterval = 30 * time.Minute
+
+// dnsResolver resolves the TXT records of the ENR trees, the system resolver when nil.
+var dnsResolver dnsdisc.Resolver
+
+const dnsLookupTimeout = 10 * time.Second
+
+// dnsFairMixTimeout is how long the discovery loop waits for a node of discovery or of the
+// ENR trees before taking one of the other, so slow DNS lookups do not hold back discovery.
+const dnsFairMixTimeout = 100 * time.Millisecond
+
+// newDNSClient returns a client resolving EIP-1459 ENR trees. The client verifies the
+// signature of the root of a tree against the public key of its enrtree:// URL, and the
+// hashes of its entries, so a DNS server cannot forge the published nodes.
+func newDNSClient() *dnsdisc.Client {
+	return dnsdisc.NewClient(dnsdisc.Config{
+		Timeout:         dnsLookupTimeout,
+		RecheckInterval: dnsTreeRefreshInterval,
+		Resolver:        dnsResolver,
+	})
+}
+
+// dnsIterator returns an iterator over the nodes of the ENR trees given with --bootstrap-dns,
+// and of the trees they link to, or nil when there are none. The trees are resolved as the
+// iterator is consumed by the discovery loop, and again every dnsTreeRefreshInterval, so
+// nodes published after startup are found without restarting. URLs which cannot be parsed
+// are skipped.
+func (s *Service) dnsIterator() enode.Iterator {
+	urls := make([]string, 0, len(s.cfg.BootstrapDNS))
+	for _, url := range s.cfg.BootstrapDNS {
+		if _, _, err := dnsdisc.ParseURL(url); err != nil {
+			log.WithError(err).WithField("url", url).Error("Could not parse ENR tree URL")
+			continue
+		}
+		urls = append(urls, url)
+	}
+	if len(urls) == 0 {
+		return nil
+	}
+	iterator, err := newDNSClient().NewIterator(urls...)
+	if err != nil {
+		log.WithError(err).Error("Could not resolve ENR trees")
+		return nil
+	}
+	return iterator
+}
@@ -0,0 +1,106 @@
+package p2p
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/p2p/dnsdisc"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// txtResolver serves TXT records from a map instead of DNS.
+type txtResolver map[string]string
+
+func (r txtResolver) LookupTXT(_ context.Context, name string) ([]string, error) {
+	if record, ok := r[name]; ok {
+		return []string{record}, nil
+	}
+	return nil, fmt.Errorf("no TXT record for %s", name)
+}
+
+func testENRNode(t *testing.T, tcpPort int) *enode.Node {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	var r enr.Record
+	r.Set(enr.IPv4(net.IPv4(10, 0, 0, 1)))
+	r.Set(enr.UDP(12000))
+	if tcpPort > 0 {
+		r.Set(enr.TCP(tcpPort))
+	}
+	require.NoError(t, enode.SignV4(&r, key))
+	node, err := enode.New(enode.ValidSchemes, &r)
+	require.NoError(t, err)
+	return node
+}
+
+// publishTree signs a tree of the nodes and links for the domain, and adds its records to the resolver.
+func publishTree(t *testing.T, resolver txtResolver, domain string, nodes []*enode.Node, links []string) string {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	tree, err := dnsdisc.MakeTree(1, nodes, links)
+	require.NoError(t, err)
+	url, err := tree.Sign(key, domain)
+	require.NoError(t, err)
+	for name, record := range tree.ToTXT(domain) {
+		resolver[name] = record
+	}
+	return url
+}
+
+// iterate returns the distinct nodes of the iterator, until it found count of them or the timeout expired.
+func iterate(t *testing.T, iterator enode.Iterator, count int, timeout time.Duration) map[enode.ID]bool {
+	timer := time.AfterFunc(timeout, iterator.Close)
+	defer timer.Stop()
+	ids := make(map[enode.ID]bool)
+	for len(ids) < count && iterator.Next() {
+		ids[iterator.Node().ID()] = true
+	}
+	iterator.Close()
+	return ids
+}
+
+func TestService_DNSIterator(t *testing.T) {
+	resolver := txtResolver{}
+	linked := []*enode.Node{testENRNode(t, 13000)}
+	linkedURL := publishTree(t, resolver, "linked.example.org", linked, nil)
+	nodes := []*enode.Node{testENRNode(t, 13000), testENRNode(t, 0)}
+	url := publishTree(t, resolver, "nodes.example.org", nodes, []string{linkedURL})
+	defer func(r dnsdisc.Resolver) { dnsResolver = r }(dnsResolver)
+	dnsResolver = resolver
+
+	s := &Service{cfg: &Config{BootstrapDNS: []string{url, "enrtree://invalid"}}}
+	iterator := s.dnsIterator()
+	require.NotNil(t, iterator)
+	ids := iterate(t, iterator, 3, 10*time.Second)
+	for _, node := range append(nodes, linked...) {
+		assert.Equal(t, true, ids[node.ID()], "Node %s was not resolved", node.ID())
+	}
+}
+
+func TestService_DNSIterator_BadSignature(t *testing.T) {
+	resolver := txtResolver{}
+	url := publishTree(t, resolver, "nodes.example.org", []*enode.Node{testENRNode(t, 13000)}, nil)
+	// Publish the tree again, signed by another key.
+	publishTree(t, resolver, "nodes.example.org", []*enode.Node{testENRNode(t, 13000)}, nil)
+	defer func(r dnsdisc.Resolver) { dnsResolver = r }(dnsResolver)
+	dnsResolver = resolver
+
+	s := &Service{cfg: &Config{BootstrapDNS: []string{url}}}
+	iterator := s.dnsIterator()
+	require.NotNil(t, iterator)
+	assert.Equal(t, 0, len(iterate(t, iterator, 1, time.Second)))
+}
+
+func TestService_DNSIterator_NoTrees(t *testing.T) {
+	s := &Service{cfg: &Config{BootstrapDNS: []string{"enrtree://invalid"}}}
+	assert.Equal(t, nil, s.dnsIterator())
+	s = &Service{cfg: &Config{}}
+	assert.Equal(t, nil, s.nodeIterator())
+}
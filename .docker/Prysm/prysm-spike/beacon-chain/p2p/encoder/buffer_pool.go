@@ -0,0 +1,61 @@
+package encoder
+
+import (
+	"math/bits"
+	"sync"
+)
+
+const (
+	// Buffers smaller than this are cheap enough to allocate.
+	minPooledBufferBits = 9
+	// Buffers up to 2 MiB are pooled, which covers the max encoded length of
+	// the max chunk and gossip sizes.
+	maxPooledBufferBits = 21
+)
+
+// These pools hold the byte buffers messages are encoded into and decoded
+// from, by size class in powers of two, so that encoding and decoding
+// blocks and attestations during sync does not allocate a buffer for every
+// message. Decoded messages copy the bytes they hold, only the buffers are
+// reused.
+var bufferPools [maxPooledBufferBits - minPooledBufferBits + 1]sync.Pool
+
+// Returns the index of the pool of the smallest size class which fits the
+// size, or -1 if the size is too large to be pooled.
+func bufferClass(size int) int {
+	b := bits.Len(uint(size - 1))
+	if size <= 1 || b < minPooledBufferBits {
+		return 0
+	}
+	if b > maxPooledBufferBits {
+		return -1
+	}
+	return b - minPooledBufferBits
+}
+
+// Retrieves a byte slice of the given length from the pool of its size
+// class, allocating one if the pool is empty.
+func getBuffer(size int) []byte {
+	class := bufferClass(size)
+	if class < 0 {
+		return make([]byte, size)
+	}
+	if raw := bufferPools[class].Get(); raw != nil {
+		if buf, ok := raw.(*[]byte); ok && cap(*buf) >= size {
+			return (*buf)[:size]
+		}
+	}
+	return make([]byte, size, 1<<(class+minPooledBufferBits))
+}
+
+// Returns a byte slice to the pool of its size class. Slices which do not
+// have the capacity of a size class, such as ones grown by appending, are
+// left to the garbage collector.
+func putBuffer(buf []byte) {
+	class := bufferClass(cap(buf))
+	if class < 0 || cap(buf) != 1<<(class+minPooledBufferBits) {
+		return
+	}
+	buf = buf[:0]
+	bufferPools[class].Put(&buf)
+}
@@ -0,0 +1,29 @@
+package encoder
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+)
+
+func TestBufferPool_SizeClasses(t *testing.T) {
+	assert.Equal(t, 0, bufferClass(1))
+	assert.Equal(t, 0, bufferClass(512))
+	assert.Equal(t, 1, bufferClass(513))
+	assert.Equal(t, maxPooledBufferBits-minPooledBufferBits, bufferClass(1<<maxPooledBufferBits))
+	assert.Equal(t, -1, bufferClass(1<<maxPooledBufferBits+1))
+
+	buf := getBuffer(1000)
+	assert.Equal(t, 1000, len(buf))
+	assert.Equal(t, 1024, cap(buf))
+	putBuffer(buf)
+
+	// Buffers without the capacity of a size class are not pooled.
+	putBuffer(make([]byte, 0, 700))
+	buf = getBuffer(600)
+	assert.Equal(t, 600, len(buf))
+	assert.Equal(t, 1024, cap(buf))
+
+	large := getBuffer(1<<maxPooledBufferBits + 1)
+	assert.Equal(t, 1<<maxPooledBufferBits+1, len(large))
+}
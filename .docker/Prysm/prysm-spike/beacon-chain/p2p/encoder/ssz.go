@@ -33,9 +33,11 @@ type SszNetworkEncoder struct{}
 // ProtocolSuffixSSZSnappy is the last part of the topic string to identify the encoding protocol.
 const ProtocolSuffixSSZSnappy = "ssz_snappy"
 
+// Encodes the message into a pooled buffer, which the caller returns to the
+// pool with putBuffer once written.
 func (e SszNetworkEncoder) doEncode(msg interface{}) ([]byte, error) {
 	if v, ok := msg.(fastssz.Marshaler); ok {
-		return v.MarshalSSZ()
+		return v.MarshalSSZTo(getBuffer(v.SizeSSZ())[:0])
 	}
 	return nil, errors.Errorf("non-supported type: %T", msg)
 }
@@ -49,11 +51,13 @@ func (e SszNetworkEncoder) EncodeGossip(w io.Writer, msg interface{}) (int, erro
 	if err != nil {
 		return 0, err
 	}
+	defer putBuffer(b)
 	if uint64(len(b)) > MaxGossipSize {
 		return 0, errors.Errorf("gossip message exceeds max gossip size: %d bytes > %d bytes", len(b), MaxGossipSize)
 	}
-	b = snappy.Encode(nil /*dst*/, b)
-	return w.Write(b)
+	compressed := snappy.Encode(getBuffer(snappy.MaxEncodedLen(len(b))), b)
+	defer putBuffer(compressed)
+	return w.Write(compressed)
 }
 
 // EncodeWithMaxLength the proto message to the io.Writer. This encoding prefixes the byte slice with a protobuf varint
@@ -66,6 +70,7 @@ func (e SszNetworkEncoder) EncodeWithMaxLength(w io.Writer, msg interface{}) (in
 	if err != nil {
 		return 0, err
 	}
+	defer putBuffer(b)
 	if uint64(len(b)) > params.BeaconNetworkConfig().MaxChunkSize {
 		return 0, fmt.Errorf(
 			"size of encoded message is %d which is larger than the provided max limit of %d",
@@ -97,7 +102,9 @@ func (e SszNetworkEncoder) DecodeGossip(b []byte, to interface{}) error {
 	if uint64(size) > MaxGossipSize {
 		return errors.Errorf("gossip message exceeds max gossip size: %d bytes > %d bytes", size, MaxGossipSize)
 	}
-	b, err = snappy.Decode(nil /*dst*/, b)
+	buf := getBuffer(size)
+	defer putBuffer(buf)
+	b, err = snappy.Decode(buf, b)
 	if err != nil {
 		return err
 	}
@@ -126,7 +133,8 @@ func (e SszNetworkEncoder) DecodeWithMaxLength(r io.Reader, to interface{}) erro
 	r = newBufferedReader(limitedRdr)
 	defer bufReaderPool.Put(r)
 
-	buf := make([]byte, msgLen)
+	buf := getBuffer(int(msgLen))
+	defer putBuffer(buf)
 	// Returns an error if less than msgLen bytes
 	// are read. This ensures we read exactly the
 	// required amount.
@@ -5,10 +5,13 @@ import (
 	"encoding/binary"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"math"
 	"testing"
 
 	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -219,3 +222,61 @@ func (b *badSnappyStream) Read(p []byte) (n int, err error) {
 	}
 	return
 }
+
+func benchmarkBlock() *ethpb.SignedBeaconBlock {
+	blk := testutil.NewBeaconBlock()
+	for i := uint64(0); i < params.BeaconConfig().MaxAttestations; i++ {
+		att := testutil.NewAttestation()
+		att.AggregationBits = bitfield.NewBitlist(128)
+		blk.Block.Body.Attestations = append(blk.Block.Body.Attestations, att)
+	}
+	return blk
+}
+
+func BenchmarkSszNetworkEncoder_EncodeWithMaxLength(b *testing.B) {
+	e := &encoder.SszNetworkEncoder{}
+	blk := benchmarkBlock()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := e.EncodeWithMaxLength(ioutil.Discard, blk)
+		require.NoError(b, err)
+	}
+}
+
+func BenchmarkSszNetworkEncoder_DecodeWithMaxLength(b *testing.B) {
+	e := &encoder.SszNetworkEncoder{}
+	buf := new(bytes.Buffer)
+	_, err := e.EncodeWithMaxLength(buf, benchmarkBlock())
+	require.NoError(b, err)
+	encoded := buf.Bytes()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		require.NoError(b, e.DecodeWithMaxLength(bytes.NewReader(encoded), &ethpb.SignedBeaconBlock{}))
+	}
+}
+
+func BenchmarkSszNetworkEncoder_EncodeGossip(b *testing.B) {
+	e := &encoder.SszNetworkEncoder{}
+	blk := benchmarkBlock()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := e.EncodeGossip(ioutil.Discard, blk)
+		require.NoError(b, err)
+	}
+}
+
+func BenchmarkSszNetworkEncoder_DecodeGossip(b *testing.B) {
+	e := &encoder.SszNetworkEncoder{}
+	buf := new(bytes.Buffer)
+	_, err := e.EncodeGossip(buf, benchmarkBlock())
+	require.NoError(b, err)
+	encoded := buf.Bytes()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		require.NoError(b, e.DecodeGossip(encoded, &ethpb.SignedBeaconBlock{}))
+	}
+}
@@ -0,0 +1,123 @@
+package p2p
+
+import (
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/libp2p/go-libp2p-core/peer"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+	"github.com/sirupsen/logrus"
+)
+
+// recentDeliveriesSize is the number of recent (message, peer) deliveries kept to detect a peer
+// delivering the same message more than once.
+const recentDeliveriesSize = 1 << 16
+
+var (
+	gossipDuplicates = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "p2p_gossip_duplicate_messages_total",
+		Help: "The number of gossip messages received again within the seen message TTL, by topic.",
+	},
+		[]string{"topic"})
+	gossipDuplicatePenalties = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "p2p_gossip_duplicate_penalties_total",
+		Help: "The number of times a peer was down-scored for repeatedly delivering the same gossip messages.",
+	})
+)
+
+// duplicateGossipTracer counts the gossip messages delivered again after they were first seen,
+// by topic. Mesh peers all forward the messages they see, duplicates from different peers are
+// expected, but a peer delivering the same message more than once only wastes the work of
+// checking it. Such a peer is down-scored by the gossip scorer every time it repeats messages
+// threshold times within an epoch.
+type duplicateGossipTracer struct {
+	threshold  int
+	penalize   func(peer.ID)
+	deliveries *lru.Cache // Keyed by message ID and peer of recent deliveries.
+
+	lock        sync.Mutex
+	windowStart time.Time
+	repeats     map[peer.ID]int
+}
+
+// newDuplicateGossipTracer creates a tracer calling penalize for peers repeating messages
+// threshold times within an epoch. A threshold of 0 disables the penalty.
+func newDuplicateGossipTracer(threshold int, penalize func(peer.ID)) (*duplicateGossipTracer, error) {
+	deliveries, err := lru.New(recentDeliveriesSize)
+	if err != nil {
+		return nil, err
+	}
+	return &duplicateGossipTracer{
+		threshold:   threshold,
+		penalize:    penalize,
+		deliveries:  deliveries,
+		windowStart: timeutils.Now(),
+		repeats:     make(map[peer.ID]int),
+	}, nil
+}
+
+// Trace records received messages and their duplicates. It is called by pubsub for every traced event.
+func (t *duplicateGossipTracer) Trace(evt *pubsubpb.TraceEvent) {
+	switch evt.GetType() {
+	case pubsubpb.TraceEvent_RECV_RPC:
+		rpc := evt.GetRecvRPC()
+		from := peer.ID(rpc.GetReceivedFrom())
+		for _, m := range rpc.GetMeta().GetMessages() {
+			if ok, _ := t.deliveries.ContainsOrAdd(string(m.GetMessageID())+string(from), struct{}{}); ok {
+				t.recordRepeat(from)
+			}
+		}
+	case pubsubpb.TraceEvent_DUPLICATE_MESSAGE:
+		dup := evt.GetDuplicateMessage()
+		gossipDuplicates.WithLabelValues(dup.GetTopic()).Inc()
+	}
+}
+
+func (t *duplicateGossipTracer) recordRepeat(pid peer.ID) {
+	if t.threshold <= 0 {
+		return
+	}
+	t.lock.Lock()
+	t.rotateWindow()
+	t.repeats[pid]++
+	penalize := t.repeats[pid]%t.threshold == 0
+	repeats := t.repeats[pid]
+	t.lock.Unlock()
+
+	if penalize {
+		log.WithFields(logrus.Fields{
+			"peer":    pid.String(),
+			"repeats": repeats,
+		}).Debug("Down-scoring peer repeatedly delivering the same gossip messages")
+		gossipDuplicatePenalties.Inc()
+		t.penalize(pid)
+	}
+}
+
+// rotateWindow starts a new window once an epoch has passed since the current one started. The
+// caller must hold the lock.
+func (t *duplicateGossipTracer) rotateWindow() {
+	epochDuration := time.Duration(params.BeaconConfig().SlotsPerEpoch*params.BeaconConfig().SecondsPerSlot) * time.Second
+	now := timeutils.Now()
+	if now.Sub(t.windowStart) < epochDuration {
+		return
+	}
+	t.windowStart = now
+	t.repeats = make(map[peer.ID]int)
+}
+
+// eventTracers forwards the traced events of pubsub, which takes a single tracer, to each tracer.
+type eventTracers []pubsub.EventTracer
+
+// Trace forwards the event to each tracer.
+func (e eventTracers) Trace(evt *pubsubpb.TraceEvent) {
+	for _, t := range e {
+		t.Trace(evt)
+	}
+}
@@ -0,0 +1,82 @@
+package p2p
+
+import (
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func duplicateEvent(from peer.ID, topic string, msgID string) *pubsubpb.TraceEvent {
+	return &pubsubpb.TraceEvent{
+		Type: pubsubpb.TraceEvent_DUPLICATE_MESSAGE.Enum(),
+		DuplicateMessage: &pubsubpb.TraceEvent_DuplicateMessage{
+			MessageID:    []byte(msgID),
+			ReceivedFrom: []byte(from),
+			Topic:        &topic,
+		},
+	}
+}
+
+func TestDuplicateGossipTracer_PenaltyDisabled(t *testing.T) {
+	tracer, err := newDuplicateGossipTracer(0, func(peer.ID) {
+		t.Fatal("Peer penalized with the penalty disabled")
+	})
+	require.NoError(t, err)
+	peerA, peerB := peer.ID("a"), peer.ID("b")
+
+	tracer.Trace(duplicateEvent(peerA, testBlockTopic, "m1"))
+	tracer.Trace(duplicateEvent(peerB, testBlockTopic, "m1"))
+	// Repeats are only counted with the penalty enabled.
+	tracer.Trace(recvRPCEvent(peerA, time.Now(), testBlockTopic, "m1"))
+	tracer.Trace(recvRPCEvent(peerA, time.Now(), testBlockTopic, "m1"))
+
+	assert.Equal(t, 0, len(tracer.repeats))
+}
+
+func TestDuplicateGossipTracer_PenalizesRepeatingPeers(t *testing.T) {
+	var penalized []peer.ID
+	tracer, err := newDuplicateGossipTracer(2, func(pid peer.ID) {
+		penalized = append(penalized, pid)
+	})
+	require.NoError(t, err)
+	peerA, peerB := peer.ID("a"), peer.ID("b")
+	now := time.Now()
+
+	// Deliveries of a message by different peers are not repeats.
+	tracer.Trace(recvRPCEvent(peerA, now, testBlockTopic, "m1", "m2"))
+	tracer.Trace(recvRPCEvent(peerB, now, testBlockTopic, "m1", "m2"))
+	assert.Equal(t, 0, len(penalized))
+
+	tracer.Trace(recvRPCEvent(peerA, now, testBlockTopic, "m1"))
+	assert.Equal(t, 0, len(penalized))
+	tracer.Trace(recvRPCEvent(peerA, now, testBlockTopic, "m2"))
+	assert.DeepEqual(t, []peer.ID{peerA}, penalized)
+
+	// The peer is penalized again for every threshold repeats.
+	tracer.Trace(recvRPCEvent(peerA, now, testBlockTopic, "m1", "m2"))
+	assert.DeepEqual(t, []peer.ID{peerA, peerA}, penalized)
+
+	// Counts start over in a new window.
+	tracer.windowStart = now.Add(-time.Hour)
+	tracer.Trace(recvRPCEvent(peerB, now, testBlockTopic, "m1"))
+	assert.Equal(t, 0, tracer.repeats[peerA])
+	assert.Equal(t, 1, tracer.repeats[peerB])
+	assert.Equal(t, 2, len(penalized))
+}
+
+func TestEventTracers_ForwardsToEachTracer(t *testing.T) {
+	blocks := NewBlockPropagationTracer()
+	duplicates, err := newDuplicateGossipTracer(2, func(peer.ID) {})
+	require.NoError(t, err)
+	tracers := eventTracers{duplicates, blocks}
+
+	tracers.Trace(recvRPCEvent(peer.ID("a"), time.Now(), testBlockTopic, "m1"))
+	tracers.Trace(recvRPCEvent(peer.ID("a"), time.Now(), testBlockTopic, "m1"))
+
+	assert.Equal(t, 1, len(blocks.Traces(0)))
+	assert.Equal(t, 1, duplicates.repeats[peer.ID("a")])
+}
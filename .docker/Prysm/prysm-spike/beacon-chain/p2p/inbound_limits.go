@@ -0,0 +1,121 @@
+package p2p
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	manet "github.com/multiformats/go-multiaddr-net"
+)
+
+// ipv6SubnetPrefix is the prefix length used to group IPv6 addresses into subnets, as a
+// single IPv6 host is usually assigned a whole /64.
+const ipv6SubnetPrefix = 64
+
+// Reasons reported when an inbound connection is rejected by the inbound limits.
+const (
+	inboundReasonPerIP     = "ip limit"
+	inboundReasonPerSubnet = "subnet limit"
+	inboundReasonRatio     = "inbound ratio"
+)
+
+// InboundLimits caps the inbound connections accepted by the connection gater, so that a
+// flood of dials from a few hosts cannot take every peer slot of a small node. A zero
+// value disables the corresponding limit.
+type InboundLimits struct {
+	// MaxPerIP is the maximum number of inbound connections from a single IP address.
+	MaxPerIP uint
+	// MaxPerSubnet is the maximum number of inbound connections from a single subnet.
+	MaxPerSubnet uint
+	// SubnetPrefix is the prefix length of the IPv4 subnets counted by MaxPerSubnet.
+	// IPv6 addresses are always grouped by /64.
+	SubnetPrefix uint
+	// InboundRatio is the maximum fraction of the peer limit which inbound connections
+	// may take, leaving the remaining slots to the peers we dial ourselves.
+	InboundRatio float64
+}
+
+// validate checks that the limits describe a usable configuration.
+func (l InboundLimits) validate() error {
+	if l.MaxPerSubnet > 0 && (l.SubnetPrefix == 0 || l.SubnetPrefix > 32) {
+		return fmt.Errorf("subnet prefix must be between 1 and 32, got %d", l.SubnetPrefix)
+	}
+	if l.InboundRatio < 0 || l.InboundRatio > 1 {
+		return fmt.Errorf("inbound ratio must be between 0 and 1, got %f", l.InboundRatio)
+	}
+	return nil
+}
+
+// subnet returns the subnet the IP address is counted in by the per subnet limit.
+func (l InboundLimits) subnet(ip net.IP) string {
+	if ip4 := ip.To4(); ip4 != nil {
+		return ip4.Mask(net.CIDRMask(int(l.SubnetPrefix), 32)).String()
+	}
+	return ip.Mask(net.CIDRMask(ipv6SubnetPrefix, 128)).String()
+}
+
+// rejectReason returns why an inbound connection from the remote IP address exceeds the
+// limits, given the remote IP addresses of the current inbound connections and the peer
+// limit of the node. An empty reason means the connection is allowed.
+func (l InboundLimits) rejectReason(remote net.IP, inbound []net.IP, maxPeers uint) string {
+	if l.InboundRatio > 0 && float64(len(inbound)) >= l.InboundRatio*float64(maxPeers) {
+		return inboundReasonRatio
+	}
+	sameIP, sameSubnet := uint(0), uint(0)
+	remoteSubnet := l.subnet(remote)
+	for _, ip := range inbound {
+		if ip.Equal(remote) {
+			sameIP++
+		}
+		if l.MaxPerSubnet > 0 && l.subnet(ip) == remoteSubnet {
+			sameSubnet++
+		}
+	}
+	if l.MaxPerIP > 0 && sameIP >= l.MaxPerIP {
+		return inboundReasonPerIP
+	}
+	if l.MaxPerSubnet > 0 && sameSubnet >= l.MaxPerSubnet {
+		return inboundReasonPerSubnet
+	}
+	return ""
+}
+
+// InboundLimits returns the inbound connection limits currently enforced.
+func (s *Service) InboundLimits() InboundLimits {
+	s.inboundLimitsLock.RLock()
+	defer s.inboundLimitsLock.RUnlock()
+	return s.inboundLimits
+}
+
+// SetInboundLimits replaces the enforced inbound connection limits. Existing connections
+// are kept, the new limits only apply to the following inbound dials.
+func (s *Service) SetInboundLimits(limits InboundLimits) error {
+	if err := limits.validate(); err != nil {
+		return err
+	}
+	s.inboundLimitsLock.Lock()
+	defer s.inboundLimitsLock.Unlock()
+	s.inboundLimits = limits
+	log.WithField("limits", fmt.Sprintf("%+v", limits)).Info("Updated inbound connection limits")
+	return nil
+}
+
+// inboundIPs returns the remote IP addresses of the current inbound connections.
+func (s *Service) inboundIPs() []net.IP {
+	if s.host == nil {
+		return nil
+	}
+	conns := s.host.Network().Conns()
+	ips := make([]net.IP, 0, len(conns))
+	for _, c := range conns {
+		if c.Stat().Direction != network.DirInbound {
+			continue
+		}
+		ip, err := manet.ToIP(c.RemoteMultiaddr())
+		if err != nil {
+			continue
+		}
+		ips = append(ips, ip)
+	}
+	return ips
+}
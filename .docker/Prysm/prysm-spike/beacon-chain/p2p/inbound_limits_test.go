@@ -0,0 +1,82 @@
+package p2p
+
+import (
+	"net"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestInboundLimits_RejectReason(t *testing.T) {
+	inbound := []net.IP{
+		net.ParseIP("10.0.0.1"),
+		net.ParseIP("10.0.0.1"),
+		net.ParseIP("10.0.0.2"),
+		net.ParseIP("2001:db8::1"),
+	}
+	tests := []struct {
+		name   string
+		limits InboundLimits
+		remote string
+		want   string
+	}{
+		{
+			name:   "no limits",
+			limits: InboundLimits{},
+			remote: "10.0.0.1",
+			want:   "",
+		},
+		{
+			name:   "ip limit reached",
+			limits: InboundLimits{MaxPerIP: 2},
+			remote: "10.0.0.1",
+			want:   inboundReasonPerIP,
+		},
+		{
+			name:   "ip limit not reached",
+			limits: InboundLimits{MaxPerIP: 2},
+			remote: "10.0.0.2",
+			want:   "",
+		},
+		{
+			name:   "subnet limit reached",
+			limits: InboundLimits{MaxPerSubnet: 3, SubnetPrefix: 24},
+			remote: "10.0.0.9",
+			want:   inboundReasonPerSubnet,
+		},
+		{
+			name:   "other subnet",
+			limits: InboundLimits{MaxPerSubnet: 3, SubnetPrefix: 24},
+			remote: "10.0.1.9",
+			want:   "",
+		},
+		{
+			name:   "ipv6 subnet",
+			limits: InboundLimits{MaxPerSubnet: 1, SubnetPrefix: 24},
+			remote: "2001:db8::2",
+			want:   inboundReasonPerSubnet,
+		},
+		{
+			name:   "inbound ratio reached",
+			limits: InboundLimits{InboundRatio: 0.5},
+			remote: "10.0.2.1",
+			want:   inboundReasonRatio,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.limits.rejectReason(net.ParseIP(tt.remote), inbound, 8))
+		})
+	}
+}
+
+func TestService_SetInboundLimits(t *testing.T) {
+	s := &Service{}
+	assert.ErrorContains(t, "inbound ratio must be between 0 and 1", s.SetInboundLimits(InboundLimits{InboundRatio: 1.5}))
+	assert.ErrorContains(t, "subnet prefix must be between 1 and 32", s.SetInboundLimits(InboundLimits{MaxPerSubnet: 2}))
+
+	limits := InboundLimits{MaxPerIP: 2, MaxPerSubnet: 4, SubnetPrefix: 16, InboundRatio: 0.6}
+	require.NoError(t, s.SetInboundLimits(limits))
+	assert.Equal(t, limits, s.InboundLimits())
+}
@@ -78,6 +78,17 @@ type PeerManager interface {
 	AddPingMethod(reqFunc func(ctx context.Context, id peer.ID) error)
 }
 
+// InboundLimiter reads and replaces the inbound connection limits of the connection gater.
+type InboundLimiter interface {
+	InboundLimits() InboundLimits
+	SetInboundLimits(limits InboundLimits) error
+}
+
+// PeerListProvider returns the peer list other nodes connect to with --peer-list-url.
+type PeerListProvider interface {
+	PeerList() *PeerList
+}
+
 // Sender abstracts the sending functionality from libp2p.
 type Sender interface {
 	Send(context.Context, interface{}, string, peer.ID) (network.Stream, error)
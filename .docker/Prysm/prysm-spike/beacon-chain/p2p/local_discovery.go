@@ -0,0 +1,188 @@
+package p2p
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/runutil"
+	"github.com/sirupsen/logrus"
+	"github.com/whyrusleeping/mdns"
+)
+
+// localDiscoveryInterval defines how often the local network is queried for beacon nodes
+// when --p2p-local-discovery is set.
+var localDiscoveryInterval = 10 * time.Second
+
+const localDiscoveryQueryTimeout = 5 * time.Second
+
+// localDiscoveryTCPField prefixes the TXT record field holding the TCP port of the node, as the
+// port of the _udp service is its discovery UDP port.
+const localDiscoveryTCPField = "tcp="
+
+// startLocalDiscovery advertises the node with mDNS on the local network, such as a docker
+// network, and connects with the beacon nodes of the same fork advertising themselves there.
+// This lets nodes find each other without bootnodes or static peers.
+func (s *Service) startLocalDiscovery() error {
+	iface, err := localDiscoveryInterface(s.cfg.LocalDiscoveryInterface)
+	if err != nil {
+		return err
+	}
+	digest, err := s.forkDigest()
+	if err != nil {
+		return errors.Wrap(err, "could not compute fork digest")
+	}
+	ips, err := localDiscoveryIPs(iface)
+	if err != nil {
+		return err
+	}
+	if len(ips) == 0 {
+		return errors.New("no IPv4 address to advertise for local discovery")
+	}
+	serviceTag := localDiscoveryServiceTag(digest)
+	id := s.host.ID().Pretty()
+	txt := []string{id, fmt.Sprintf("%s%d", localDiscoveryTCPField, s.cfg.TCPPort)}
+	zone, err := mdns.NewMDNSService(id, serviceTag, "", "", int(s.cfg.UDPPort), ips, txt)
+	if err != nil {
+		return errors.Wrap(err, "could not create mDNS service")
+	}
+	server, err := mdns.NewServer(&mdns.Config{Zone: zone, Iface: iface})
+	if err != nil {
+		return errors.Wrap(err, "could not start mDNS server")
+	}
+	s.localDiscovery = server
+	log.WithFields(logrus.Fields{
+		"service": serviceTag,
+		"ips":     ips,
+	}).Info("Started local peer discovery")
+
+	query := func() {
+		s.queryLocalPeers(serviceTag, iface)
+	}
+	go query()
+	runutil.RunEvery(s.ctx, localDiscoveryInterval, query)
+	return nil
+}
+
+// queryLocalPeers looks up the beacon nodes advertising the service on the local network and
+// connects with the ones not connected yet.
+func (s *Service) queryLocalPeers(serviceTag string, iface *net.Interface) {
+	entries := make(chan *mdns.ServiceEntry, 16)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for entry := range entries {
+			info, err := localPeerAddrInfo(entry)
+			if err != nil {
+				log.WithError(err).Debug("Could not parse local discovery entry")
+				continue
+			}
+			if info.ID == s.host.ID() || s.host.Network().Connectedness(info.ID) == network.Connected {
+				continue
+			}
+			if s.isPeerAtLimit(false /* inbound */) {
+				continue
+			}
+			if err := s.connectWithPeer(s.ctx, *info); err != nil {
+				log.WithError(err).Tracef("Could not connect with local peer %s", info.String())
+			}
+		}
+	}()
+	err := mdns.Query(&mdns.QueryParam{
+		Service:   serviceTag,
+		Domain:    "local",
+		Timeout:   localDiscoveryQueryTimeout,
+		Interface: iface,
+		Entries:   entries,
+	})
+	close(entries)
+	<-done
+	if err != nil {
+		log.WithError(err).Debug("Could not query local network for peers")
+	}
+}
+
+// localDiscoveryServiceTag returns the mDNS service name of the beacon nodes of the fork, so
+// nodes of other networks sharing the local network are not dialed.
+func localDiscoveryServiceTag(forkDigest [4]byte) string {
+	return fmt.Sprintf("_eth2-%x._udp", forkDigest)
+}
+
+// localDiscoveryInterface returns the network interface with the name, or nil to use the
+// default multicast interface if the name is empty.
+func localDiscoveryInterface(name string) (*net.Interface, error) {
+	if name == "" {
+		return nil, nil
+	}
+	iface, err := net.InterfaceByName(name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not find network interface %s", name)
+	}
+	return iface, nil
+}
+
+// localDiscoveryIPs returns the IPv4 addresses of the interface, or of all non-loopback
+// interfaces if none is given.
+func localDiscoveryIPs(iface *net.Interface) ([]net.IP, error) {
+	var addrs []net.Addr
+	var err error
+	if iface != nil {
+		addrs, err = iface.Addrs()
+	} else {
+		addrs, err = net.InterfaceAddrs()
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get interface addresses")
+	}
+	var ips []net.IP
+	for _, addr := range addrs {
+		ipNet, ok := addr.(*net.IPNet)
+		if !ok {
+			continue
+		}
+		ip := ipNet.IP.To4()
+		if ip == nil || (iface == nil && ip.IsLoopback()) {
+			continue
+		}
+		ips = append(ips, ip)
+	}
+	return ips, nil
+}
+
+// localPeerAddrInfo returns the address of the beacon node advertised by the entry. Nodes put
+// their peer ID and TCP port in the TXT record of their service.
+func localPeerAddrInfo(entry *mdns.ServiceEntry) (*peer.AddrInfo, error) {
+	if entry.AddrV4 == nil {
+		return nil, errors.New("entry has no IPv4 address")
+	}
+	if len(entry.InfoFields) == 0 {
+		return nil, errors.New("entry has no TXT record")
+	}
+	id, err := peer.Decode(entry.InfoFields[0])
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid peer ID")
+	}
+	var tcpPort uint64
+	for _, field := range entry.InfoFields[1:] {
+		if strings.HasPrefix(field, localDiscoveryTCPField) {
+			tcpPort, err = strconv.ParseUint(strings.TrimPrefix(field, localDiscoveryTCPField), 10, 16)
+			if err != nil {
+				return nil, errors.Wrap(err, "invalid TCP port")
+			}
+		}
+	}
+	if tcpPort == 0 {
+		return nil, errors.New("entry has no TCP port")
+	}
+	addr, err := ma.NewMultiaddr(fmt.Sprintf("/ip4/%s/tcp/%d", entry.AddrV4, tcpPort))
+	if err != nil {
+		return nil, err
+	}
+	return &peer.AddrInfo{ID: id, Addrs: []ma.Multiaddr{addr}}, nil
+}
@@ -0,0 +1,49 @@
+package p2p
+
+import (
+	"net"
+	"testing"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/whyrusleeping/mdns"
+)
+
+func TestLocalDiscoveryServiceTag(t *testing.T) {
+	assert.Equal(t, "_eth2-01020304._udp", localDiscoveryServiceTag([4]byte{1, 2, 3, 4}))
+}
+
+func TestLocalDiscoveryInterface(t *testing.T) {
+	iface, err := localDiscoveryInterface("")
+	require.NoError(t, err)
+	assert.Equal(t, true, iface == nil)
+
+	_, err = localDiscoveryInterface("does-not-exist0")
+	assert.ErrorContains(t, "could not find network interface does-not-exist0", err)
+}
+
+func TestLocalPeerAddrInfo(t *testing.T) {
+	_, pkey := createAddrAndPrivKey(t)
+	id, err := peer.IDFromPrivateKey(convertToInterfacePrivkey(pkey))
+	require.NoError(t, err)
+
+	info, err := localPeerAddrInfo(&mdns.ServiceEntry{
+		AddrV4:     net.IPv4(172, 18, 0, 3),
+		Port:       12000,
+		InfoFields: []string{id.Pretty(), "tcp=13000"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, id, info.ID)
+	require.Equal(t, 1, len(info.Addrs))
+	assert.Equal(t, "/ip4/172.18.0.3/tcp/13000", info.Addrs[0].String())
+
+	_, err = localPeerAddrInfo(&mdns.ServiceEntry{AddrV4: net.IPv4(172, 18, 0, 3), Port: 12000, InfoFields: []string{"foo", "tcp=13000"}})
+	assert.ErrorContains(t, "invalid peer ID", err)
+	_, err = localPeerAddrInfo(&mdns.ServiceEntry{AddrV4: net.IPv4(172, 18, 0, 3), Port: 12000, InfoFields: []string{id.Pretty()}})
+	assert.ErrorContains(t, "no TCP port", err)
+	_, err = localPeerAddrInfo(&mdns.ServiceEntry{AddrV4: net.IPv4(172, 18, 0, 3), Port: 12000, InfoFields: []string{id.Pretty(), "tcp=foo"}})
+	assert.ErrorContains(t, "invalid TCP port", err)
+	_, err = localPeerAddrInfo(&mdns.ServiceEntry{Port: 12000, InfoFields: []string{id.Pretty(), "tcp=13000"}})
+	assert.ErrorContains(t, "no IPv4 address", err)
+}
@@ -1,6 +1,7 @@
 package p2p
 
 import (
+	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 )
@@ -25,6 +26,26 @@ var (
 		Name: "p2p_attestation_subnet_attempted_broadcasts",
 		Help: "The number of attestations that were attempted to be broadcast.",
 	})
+	publishMeshRetries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "p2p_publish_mesh_retries_total",
+		Help: "The number of messages whose publication was retried until peers were available on the topic.",
+	},
+		[]string{"topic"})
+	publishBelowMeshThreshold = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "p2p_publish_below_mesh_threshold_total",
+		Help: "The number of messages published while the topic had fewer peers than the mesh low watermark.",
+	},
+		[]string{"topic"})
+	inboundRejections = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "p2p_inbound_rejections_total",
+		Help: "The number of inbound dials rejected by the connection gater, by reason.",
+	},
+		[]string{"reason"})
+	connectionCount = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "p2p_connection_count",
+		Help: "The number of open connections in a given direction.",
+	},
+		[]string{"direction"})
 )
 
 func (s *Service) updateMetrics() {
@@ -33,4 +54,15 @@ func (s *Service) updateMetrics() {
 	p2pPeerCount.WithLabelValues("Connecting").Set(float64(len(s.peers.Connecting())))
 	p2pPeerCount.WithLabelValues("Disconnecting").Set(float64(len(s.peers.Disconnecting())))
 	p2pPeerCount.WithLabelValues("Bad").Set(float64(len(s.peers.Bad())))
+
+	inbound, outbound := 0, 0
+	for _, c := range s.host.Network().Conns() {
+		if c.Stat().Direction == network.DirInbound {
+			inbound++
+		} else {
+			outbound++
+		}
+	}
+	connectionCount.WithLabelValues("Inbound").Set(float64(inbound))
+	connectionCount.WithLabelValues("Outbound").Set(float64(outbound))
 }
@@ -30,34 +30,40 @@ const (
 )
 
 func TestOverlayParameters(t *testing.T) {
-	setPubSubParameters()
+	setPubSubParameters(0)
 	assert.Equal(t, gossipSubD, pubsub.GossipSubD, "gossipSubD")
 	assert.Equal(t, gossipSubDlo, pubsub.GossipSubDlo, "gossipSubDlo")
 	assert.Equal(t, gossipSubDhi, pubsub.GossipSubDhi, "gossipSubDhi")
 }
 
 func TestGossipParameters(t *testing.T) {
-	setPubSubParameters()
+	setPubSubParameters(0)
 	assert.Equal(t, gossipSubMcacheLen, pubsub.GossipSubHistoryLength, "gossipSubMcacheLen")
 	assert.Equal(t, gossipSubMcacheGossip, pubsub.GossipSubHistoryGossip, "gossipSubMcacheGossip")
 	assert.Equal(t, gossipSubSeenTTL, int(pubsub.TimeCacheDuration.Milliseconds()/pubsub.GossipSubHeartbeatInterval.Milliseconds()), "gossipSubSeenTtl")
 }
 
+func TestSeenMessageTTLParameter(t *testing.T) {
+	setPubSubParameters(time.Minute)
+	assert.Equal(t, time.Minute, pubsub.TimeCacheDuration, "seenMessageTTL")
+	setPubSubParameters(0)
+}
+
 func TestFanoutParameters(t *testing.T) {
-	setPubSubParameters()
+	setPubSubParameters(0)
 	if pubsub.GossipSubFanoutTTL != gossipSubFanoutTTL {
 		t.Errorf("gossipSubFanoutTTL, wanted: %d, got: %d", gossipSubFanoutTTL, pubsub.GossipSubFanoutTTL)
 	}
 }
 
 func TestHeartbeatParameters(t *testing.T) {
-	setPubSubParameters()
+	setPubSubParameters(0)
 	if pubsub.GossipSubHeartbeatInterval != gossipSubHeartbeatInterval {
 		t.Errorf("gossipSubHeartbeatInterval, wanted: %d, got: %d", gossipSubHeartbeatInterval, pubsub.GossipSubHeartbeatInterval)
 	}
 }
 
 func TestMiscParameters(t *testing.T) {
-	setPubSubParameters()
+	setPubSubParameters(0)
 	assert.Equal(t, randomSubD, pubsub.RandomSubD, "randomSubD")
 }
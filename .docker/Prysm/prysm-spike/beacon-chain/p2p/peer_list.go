@@ -0,0 +1,134 @@
+package p2p
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/pkg/errors"
+)
+
+// peerListRefreshInterval defines how often the peer lists given with --peer-list-url are fetched.
+var peerListRefreshInterval = time.Minute
+
+const peerListRequestTimeout = 10 * time.Second
+
+// PeerList is the JSON document of a peer list, as served by the debug gateway of a beacon node at
+// /eth/v1alpha1/debug/p2p/peer_list. It lets other nodes, such as the beacon nodes of a compose
+// deployment, find and stay connected to this node and its curated static peers.
+type PeerList struct {
+	ENR       string   `json:"enr,omitempty"`
+	Addresses []string `json:"addresses"`
+	Peers     []string `json:"peers"`
+}
+
+// PeerList returns the node's ENR, its dialable addresses and its static peers.
+func (s *Service) PeerList() *PeerList {
+	list := &PeerList{
+		Addresses: make([]string, 0),
+		Peers:     make([]string, 0, len(s.cfg.StaticPeers)),
+	}
+	if s.dv5Listener != nil {
+		list.ENR = s.dv5Listener.Self().String()
+	}
+	if s.host != nil {
+		for _, addr := range s.host.Addrs() {
+			list.Addresses = append(list.Addresses, addr.String()+"/p2p/"+s.host.ID().Pretty())
+		}
+	}
+	list.Peers = append(list.Peers, s.cfg.StaticPeers...)
+	return list
+}
+
+// connectToPeerLists fetches every peer list URL and connects with the peers found. The peers
+// of the lists fetched are watched, and reconnected with like the relay node, until a later
+// fetch no longer lists them.
+func (s *Service) connectToPeerLists() {
+	var addrs []ma.Multiaddr
+	fetched := false
+	for _, url := range s.cfg.PeerListURLs {
+		list, err := fetchPeerList(s.ctx, url)
+		if err != nil {
+			log.WithError(err).WithField("url", url).Error("Could not fetch peer list")
+			continue
+		}
+		fetched = true
+		addrs = append(addrs, list.multiAddrs()...)
+	}
+	// Keep watching the previous peers when no list could be fetched.
+	if !fetched {
+		return
+	}
+	watched := make([]string, 0, len(addrs))
+	for _, addr := range addrs {
+		watched = append(watched, addr.String())
+	}
+	s.peerListAddrsLock.Lock()
+	s.peerListAddrs = watched
+	s.peerListAddrsLock.Unlock()
+	if len(addrs) == 0 {
+		return
+	}
+	s.connectWithAllPeers(addrs)
+}
+
+// watchedPeerListAddrs returns the addresses of the peers found in the last fetched peer lists.
+func (s *Service) watchedPeerListAddrs() []string {
+	s.peerListAddrsLock.RLock()
+	defer s.peerListAddrsLock.RUnlock()
+	addrs := make([]string, len(s.peerListAddrs))
+	copy(addrs, s.peerListAddrs)
+	return addrs
+}
+
+// fetchPeerList requests the peer list served at the given URL.
+func fetchPeerList(ctx context.Context, url string) (*PeerList, error) {
+	ctx, cancel := context.WithTimeout(ctx, peerListRequestTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Debug("Could not close response body")
+		}
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("peer list request returned status %d", resp.StatusCode)
+	}
+	list := &PeerList{}
+	if err := json.NewDecoder(resp.Body).Decode(list); err != nil {
+		return nil, errors.Wrap(err, "could not decode peer list")
+	}
+	return list, nil
+}
+
+// multiAddrs converts the ENR, addresses and peers of the list into dialable multiaddresses,
+// skipping the entries which cannot be dialed.
+func (l *PeerList) multiAddrs() []ma.Multiaddr {
+	entries := make([]string, 0, 1+len(l.Addresses)+len(l.Peers))
+	if l.ENR != "" {
+		entries = append(entries, l.ENR)
+	}
+	entries = append(entries, l.Addresses...)
+	entries = append(entries, l.Peers...)
+
+	addrs := make([]ma.Multiaddr, 0, len(entries))
+	for _, entry := range entries {
+		entryAddrs, err := peersFromStringAddrs([]string{entry})
+		if err != nil {
+			log.WithError(err).WithField("peer", entry).Debug("Skipping invalid peer list entry")
+			continue
+		}
+		addrs = append(addrs, entryAddrs...)
+	}
+	return addrs
+}
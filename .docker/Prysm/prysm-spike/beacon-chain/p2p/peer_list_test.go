@@ -0,0 +1,81 @@
+package p2p
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// servePeerList serves the peer list of the service as the debug gateway does.
+func servePeerList(s *Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(s.PeerList()); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	}
+}
+
+func TestPeerList_ServeAndFetch(t *testing.T) {
+	staticPeers := []string{
+		"/ip4/127.0.0.1/tcp/6660/p2p/QmQ7zhY7nGY66yK1n8hLGevfVyjbtvHSgtZuXkCH9oTrgi",
+		"/ip4/127.0.0.1/tcp/33201/p2p/QmaXZhW44pwQxBSeLkE5FNeLz8tGTTEsRciFg1DNWXXrWG",
+	}
+	s := &Service{cfg: &Config{StaticPeers: staticPeers}}
+	srv := httptest.NewServer(servePeerList(s))
+	defer srv.Close()
+
+	list, err := fetchPeerList(context.Background(), srv.URL)
+	require.NoError(t, err)
+	assert.Equal(t, "", list.ENR)
+	assert.DeepEqual(t, staticPeers, list.Peers)
+
+	addrs := list.multiAddrs()
+	require.Equal(t, 2, len(addrs))
+	assert.Equal(t, staticPeers[0], addrs[0].String())
+	assert.Equal(t, staticPeers[1], addrs[1].String())
+}
+
+func TestPeerList_MultiAddrs_SkipsInvalidEntries(t *testing.T) {
+	list := &PeerList{
+		Addresses: []string{"/ip4/127.0.0.1/tcp/6660/p2p/QmQ7zhY7nGY66yK1n8hLGevfVyjbtvHSgtZuXkCH9oTrgi"},
+		Peers:     []string{"not a peer", ""},
+	}
+	addrs := list.multiAddrs()
+	require.Equal(t, 1, len(addrs))
+	assert.Equal(t, list.Addresses[0], addrs[0].String())
+}
+
+func TestFetchPeerList_BadStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	_, err := fetchPeerList(context.Background(), srv.URL)
+	assert.ErrorContains(t, "returned status 404", err)
+}
+
+func TestConnectToPeerLists_WatchesLastFetchedPeers(t *testing.T) {
+	watched := []string{"/ip4/127.0.0.1/tcp/6660/p2p/QmQ7zhY7nGY66yK1n8hLGevfVyjbtvHSgtZuXkCH9oTrgi"}
+	badSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer badSrv.Close()
+	s := &Service{ctx: context.Background(), cfg: &Config{PeerListURLs: []string{badSrv.URL}}, peerListAddrs: watched}
+
+	// The peers stay watched while no list can be fetched.
+	s.connectToPeerLists()
+	assert.DeepEqual(t, watched, s.watchedPeerListAddrs())
+
+	emptySrv := httptest.NewServer(servePeerList(&Service{cfg: &Config{}}))
+	defer emptySrv.Close()
+	s.cfg.PeerListURLs = []string{badSrv.URL, emptySrv.URL}
+	s.connectToPeerLists()
+	assert.Equal(t, 0, len(s.watchedPeerListAddrs()))
+}
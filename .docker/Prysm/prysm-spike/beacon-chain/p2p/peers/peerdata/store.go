@@ -54,6 +54,7 @@ type PeerData struct {
 	ChainStateValidationError error
 	// Scorers internal data.
 	BadResponses         int
+	GossipPenalties      int
 	ProcessedBlocks      uint64
 	BlockProviderUpdated time.Time
 }
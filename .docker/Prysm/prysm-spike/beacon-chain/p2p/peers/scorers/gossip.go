@@ -0,0 +1,150 @@
+package scorers
+
+import (
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/peerdata"
+)
+
+var _ Scorer = (*GossipScorer)(nil)
+
+const (
+	// DefaultGossipPenaltyThreshold defines how many gossip penalties to tolerate before peer is deemed bad.
+	DefaultGossipPenaltyThreshold = 6
+	// DefaultGossipDecayInterval defines how often to decay previous statistics.
+	// Every interval gossip penalties counter will be decremented by 1.
+	DefaultGossipDecayInterval = time.Hour
+)
+
+// GossipScorer represents gossip behaviour scoring service. Unlike bad responses, which are about
+// the req/resp protocols, it penalizes peers misbehaving on gossip, such as by repeatedly delivering
+// the same messages.
+type GossipScorer struct {
+	config *GossipScorerConfig
+	store  *peerdata.Store
+}
+
+// GossipScorerConfig holds configuration parameters for gossip scoring service.
+type GossipScorerConfig struct {
+	// Threshold specifies number of gossip penalties tolerated, before peer is banned.
+	Threshold int
+	// DecayInterval specifies how often gossip penalty stats should be decayed.
+	DecayInterval time.Duration
+}
+
+// newGossipScorer creates new gossip scoring service.
+func newGossipScorer(store *peerdata.Store, config *GossipScorerConfig) *GossipScorer {
+	if config == nil {
+		config = &GossipScorerConfig{}
+	}
+	scorer := &GossipScorer{
+		config: config,
+		store:  store,
+	}
+	if scorer.config.Threshold == 0 {
+		scorer.config.Threshold = DefaultGossipPenaltyThreshold
+	}
+	if scorer.config.DecayInterval == 0 {
+		scorer.config.DecayInterval = DefaultGossipDecayInterval
+	}
+	return scorer
+}
+
+// Score returns score (penalty) of gossip misbehaviour of a peer.
+func (s *GossipScorer) Score(pid peer.ID) float64 {
+	s.store.RLock()
+	defer s.store.RUnlock()
+	return s.score(pid)
+}
+
+// score is a lock-free version of Score.
+func (s *GossipScorer) score(pid peer.ID) float64 {
+	if s.isBadPeer(pid) {
+		return BadPeerScore
+	}
+	score := float64(0)
+	peerData, ok := s.store.PeerData(pid)
+	if !ok {
+		return score
+	}
+	if peerData.GossipPenalties > 0 {
+		score = float64(peerData.GossipPenalties) / float64(s.config.Threshold)
+		// Since score represents a penalty, negate it.
+		score *= -1
+	}
+	return score
+}
+
+// Params exposes scorer's parameters.
+func (s *GossipScorer) Params() *GossipScorerConfig {
+	return s.config
+}
+
+// Count obtains the number of gossip penalties of the given remote peer.
+func (s *GossipScorer) Count(pid peer.ID) (int, error) {
+	s.store.RLock()
+	defer s.store.RUnlock()
+	if peerData, ok := s.store.PeerData(pid); ok {
+		return peerData.GossipPenalties, nil
+	}
+	return -1, peerdata.ErrPeerUnknown
+}
+
+// Increment increments the number of gossip penalties of the given remote peer.
+// If peer doesn't exist this method is no-op.
+func (s *GossipScorer) Increment(pid peer.ID) {
+	s.store.Lock()
+	defer s.store.Unlock()
+
+	peerData, ok := s.store.PeerData(pid)
+	if !ok {
+		s.store.SetPeerData(pid, &peerdata.PeerData{
+			GossipPenalties: 1,
+		})
+		return
+	}
+	peerData.GossipPenalties++
+}
+
+// IsBadPeer states if the peer is to be considered bad.
+// If the peer is unknown this will return `false`, which makes using this function easier than returning an error.
+func (s *GossipScorer) IsBadPeer(pid peer.ID) bool {
+	s.store.RLock()
+	defer s.store.RUnlock()
+	return s.isBadPeer(pid)
+}
+
+// isBadPeer is lock-free version of IsBadPeer.
+func (s *GossipScorer) isBadPeer(pid peer.ID) bool {
+	if peerData, ok := s.store.PeerData(pid); ok {
+		return peerData.GossipPenalties >= s.config.Threshold
+	}
+	return false
+}
+
+// BadPeers returns the peers that are considered bad.
+func (s *GossipScorer) BadPeers() []peer.ID {
+	s.store.RLock()
+	defer s.store.RUnlock()
+
+	badPeers := make([]peer.ID, 0)
+	for pid := range s.store.Peers() {
+		if s.isBadPeer(pid) {
+			badPeers = append(badPeers, pid)
+		}
+	}
+	return badPeers
+}
+
+// Decay reduces the gossip penalties of all peers, giving reformed peers a chance to join the network.
+func (s *GossipScorer) Decay() {
+	s.store.Lock()
+	defer s.store.Unlock()
+
+	for _, peerData := range s.store.Peers() {
+		if peerData.GossipPenalties > 0 {
+			peerData.GossipPenalties--
+		}
+	}
+}
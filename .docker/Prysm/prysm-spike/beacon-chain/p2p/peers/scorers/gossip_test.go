@@ -0,0 +1,89 @@
+package scorers_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/peerdata"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+)
+
+func TestScorers_Gossip_Score(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	peerStatuses := peers.NewStatus(ctx, &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &scorers.Config{
+			GossipScorerConfig: &scorers.GossipScorerConfig{
+				Threshold: 4,
+			},
+		},
+	})
+	scorer := peerStatuses.Scorers().GossipScorer()
+
+	assert.Equal(t, 0.0, scorer.Score("peer1"), "Unexpected score for unregistered peer")
+	scorer.Increment("peer1")
+	assert.Equal(t, -0.25, scorer.Score("peer1"))
+	scorer.Increment("peer1")
+	assert.Equal(t, -0.5, scorer.Score("peer1"))
+	scorer.Increment("peer1")
+	scorer.Increment("peer1")
+	assert.Equal(t, -1.0, scorer.Score("peer1"))
+	assert.Equal(t, true, scorer.IsBadPeer("peer1"))
+	assert.Equal(t, true, peerStatuses.Scorers().IsBadPeer("peer1"))
+
+	// Gossip penalties are independent of bad responses.
+	assert.Equal(t, 0.0, peerStatuses.Scorers().BadResponsesScorer().Score("peer1"))
+}
+
+func TestScorers_Gossip_Count(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	peerStatuses := peers.NewStatus(ctx, &peers.StatusConfig{
+		PeerLimit:    30,
+		ScorerParams: &scorers.Config{},
+	})
+	scorer := peerStatuses.Scorers().GossipScorer()
+	assert.Equal(t, scorers.DefaultGossipPenaltyThreshold, scorer.Params().Threshold)
+
+	pid := peer.ID("peer1")
+	_, err := scorer.Count(pid)
+	assert.ErrorContains(t, peerdata.ErrPeerUnknown.Error(), err)
+
+	peerStatuses.Add(nil, pid, nil, network.DirUnknown)
+	count, err := scorer.Count(pid)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, count)
+}
+
+func TestScorers_Gossip_Decay(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	peerStatuses := peers.NewStatus(ctx, &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &scorers.Config{
+			GossipScorerConfig: &scorers.GossipScorerConfig{
+				Threshold: 2,
+			},
+		},
+	})
+	scorer := peerStatuses.Scorers().GossipScorer()
+
+	scorer.Increment("peer1")
+	scorer.Increment("peer1")
+	assert.Equal(t, true, scorer.IsBadPeer("peer1"))
+	assert.DeepEqual(t, []peer.ID{"peer1"}, scorer.BadPeers())
+
+	scorer.Decay()
+	count, err := scorer.Count("peer1")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, false, scorer.IsBadPeer("peer1"))
+}
@@ -475,7 +475,11 @@ func (p *Status) Prune() {
 // own latency, or because of their finalized epoch at the time we queried them.
 // Returns epoch number and list of peers that are at or beyond that epoch.
 func (p *Status) BestFinalized(maxPeers int, ourFinalizedEpoch uint64) (uint64, []peer.ID) {
-	connected := p.Connected()
+	return p.BestFinalizedAmong(p.Connected(), maxPeers, ourFinalizedEpoch)
+}
+
+// BestFinalizedAmong is BestFinalized, considering only the given peers.
+func (p *Status) BestFinalizedAmong(connected []peer.ID, maxPeers int, ourFinalizedEpoch uint64) (uint64, []peer.ID) {
 	finalizedEpochVotes := make(map[uint64]uint64)
 	pidEpoch := make(map[peer.ID]uint64, len(connected))
 	pidHead := make(map[peer.ID]uint64, len(connected))
@@ -527,7 +531,11 @@ func (p *Status) BestFinalized(maxPeers int, ourFinalizedEpoch uint64) (uint64,
 // BestNonFinalized returns the highest known epoch, higher than ours,
 // and is shared by at least minPeers.
 func (p *Status) BestNonFinalized(minPeers int, ourHeadEpoch uint64) (uint64, []peer.ID) {
-	connected := p.Connected()
+	return p.BestNonFinalizedAmong(p.Connected(), minPeers, ourHeadEpoch)
+}
+
+// BestNonFinalizedAmong is BestNonFinalized, considering only the given peers.
+func (p *Status) BestNonFinalizedAmong(connected []peer.ID, minPeers int, ourHeadEpoch uint64) (uint64, []peer.ID) {
 	epochVotes := make(map[uint64]uint64)
 	pidEpoch := make(map[peer.ID]uint64, len(connected))
 	pidHead := make(map[peer.ID]uint64, len(connected))
@@ -826,6 +826,34 @@ func TestStatus_BestNonFinalized(t *testing.T) {
 	assert.Equal(t, 3, len(pids), "Unexpected number of peers")
 }
 
+func TestStatus_BestFinalizedAmong(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &scorers.Config{
+			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+				Threshold: 2,
+			},
+		},
+	})
+
+	// Most peers are at epoch 10, the given ones at epoch 5.
+	finalizedEpochs := []uint64{10, 10, 10, 5, 5}
+	for i, epoch := range finalizedEpochs {
+		p.Add(new(enr.Record), peer.ID(rune(i)), nil, network.DirOutbound)
+		p.SetConnectionState(peer.ID(rune(i)), peers.PeerConnected)
+		p.SetChainState(peer.ID(rune(i)), &pb.Status{
+			FinalizedEpoch: epoch,
+		})
+	}
+
+	epoch, pids := p.BestFinalized(10, 0)
+	assert.Equal(t, uint64(10), epoch)
+	assert.Equal(t, 3, len(pids))
+	epoch, pids = p.BestFinalizedAmong([]peer.ID{peer.ID(rune(3)), peer.ID(rune(4))}, 10, 0)
+	assert.Equal(t, uint64(5), epoch)
+	assert.Equal(t, 2, len(pids))
+}
+
 func TestStatus_CurrentEpoch(t *testing.T) {
 	maxBadResponses := 2
 	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
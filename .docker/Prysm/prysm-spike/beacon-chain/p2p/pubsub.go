@@ -11,6 +11,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
 )
 
 // JoinTopic will join PubSub topic, if not already joined.
@@ -24,6 +25,10 @@ func (s *Service) JoinTopic(topic string, opts ...pubsub.TopicOpt) (*pubsub.Topi
 			return nil, err
 		}
 		s.joinedTopics[topic] = topicHandle
+		if s.topicJoinTimes == nil {
+			s.topicJoinTimes = make(map[string]time.Time)
+		}
+		s.topicJoinTimes[topic] = timeutils.Now()
 	}
 
 	return s.joinedTopics[topic], nil
@@ -40,22 +45,50 @@ func (s *Service) LeaveTopic(topic string) error {
 			return err
 		}
 		delete(s.joinedTopics, topic)
+		delete(s.topicJoinTimes, topic)
 	}
 	return nil
 }
 
 // PublishToTopic joins (if necessary) and publishes a message to a PubSub topic.
 func (s *Service) PublishToTopic(ctx context.Context, topic string, data []byte, opts ...pubsub.PubOpt) error {
+	return s.publishToTopic(ctx, topic, data, false /* awaitMesh */, opts...)
+}
+
+// publishToTopicAwaitingMesh publishes a message like PublishToTopic, but right after the topic is
+// joined, such as after a restart, the publication is retried until enough peers are available to
+// form a mesh or the configured mesh wait has passed, rather than publishing to the first peer
+// found. It blocks for up to the mesh wait, so callers should not be latency sensitive.
+func (s *Service) publishToTopicAwaitingMesh(ctx context.Context, topic string, data []byte, opts ...pubsub.PubOpt) error {
+	return s.publishToTopic(ctx, topic, data, true /* awaitMesh */, opts...)
+}
+
+func (s *Service) publishToTopic(ctx context.Context, topic string, data []byte, awaitMesh bool, opts ...pubsub.PubOpt) error {
 	topicHandle, err := s.JoinTopic(topic)
 	if err != nil {
 		return err
 	}
 
-	// Wait for at least 1 peer to be available to receive the published message.
+	// Wait for at least 1 peer to be available to receive the published message, and for the mesh
+	// to form if requested.
+	var meshFormingUntil time.Time
+	if awaitMesh {
+		meshFormingUntil = s.topicJoinTime(topic).Add(s.cfg.PublishMeshWait)
+	}
+	retried := false
 	for {
-		if len(topicHandle.ListPeers()) > 0 {
+		numPeers := len(topicHandle.ListPeers())
+		meshForming := numPeers < pubsub.GossipSubDlo && timeutils.Now().Before(meshFormingUntil)
+		if numPeers > 0 && !meshForming {
+			if retried {
+				publishMeshRetries.WithLabelValues(topic).Inc()
+			}
+			if numPeers < pubsub.GossipSubDlo {
+				publishBelowMeshThreshold.WithLabelValues(topic).Inc()
+			}
 			return topicHandle.Publish(ctx, data, opts...)
 		}
+		retried = true
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
@@ -65,6 +98,13 @@ func (s *Service) PublishToTopic(ctx context.Context, topic string, data []byte,
 	}
 }
 
+// topicJoinTime returns when the topic was joined.
+func (s *Service) topicJoinTime(topic string) time.Time {
+	s.joinedTopicsLock.Lock()
+	defer s.joinedTopicsLock.Unlock()
+	return s.topicJoinTimes[topic]
+}
+
 // SubscribeToTopic joins (if necessary) and subscribes to PubSub topic.
 func (s *Service) SubscribeToTopic(topic string, opts ...pubsub.SubOpt) (*pubsub.Subscription, error) {
 	s.awaitStateInitialized() // Genesis time and genesis validator root are required to subscribe.
@@ -114,7 +154,10 @@ func msgIDFunction(pmsg *pubsub_pb.Message) string {
 	return string(h[:20])
 }
 
-func setPubSubParameters() {
+// setPubSubParameters sets the gossipsub parameters, which the pubsub version in use only takes as
+// package globals rather than router options. A seenMessageTTL of 0 keeps the TTL of the seen message
+// cache at 550 heartbeats.
+func setPubSubParameters(seenMessageTTL time.Duration) {
 	heartBeatInterval := 700 * time.Millisecond
 	pubsub.GossipSubDlo = 6
 	pubsub.GossipSubD = 8
@@ -122,6 +165,9 @@ func setPubSubParameters() {
 	pubsub.GossipSubHistoryLength = 6
 	pubsub.GossipSubHistoryGossip = 3
 	pubsub.TimeCacheDuration = 550 * heartBeatInterval
+	if seenMessageTTL > 0 {
+		pubsub.TimeCacheDuration = seenMessageTTL
+	}
 
 	// Set a larger gossip history to ensure that slower
 	// messages have a longer time to be propagated. This
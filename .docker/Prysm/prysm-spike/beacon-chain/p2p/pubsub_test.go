@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/golang/snappy"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
@@ -57,6 +58,38 @@ func TestService_PublishToTopicConcurrentMapWrite(t *testing.T) {
 	wg.Wait()
 }
 
+func TestService_PublishToTopic_WaitsForMesh(t *testing.T) {
+	p0 := testp2p.NewTestP2P(t)
+	p1 := testp2p.NewTestP2P(t)
+	p0.Connect(p1)
+	meshWait := 500 * time.Millisecond
+	s := &Service{
+		host:         p0.BHost,
+		pubsub:       p0.PubSub(),
+		joinedTopics: map[string]*pubsub.Topic{},
+		cfg:          &Config{PublishMeshWait: meshWait},
+	}
+	topic := "/eth2/testing/mesh" + encoder.ProtocolSuffixSSZSnappy
+	_, err := p1.SubscribeToTopic(topic)
+	require.NoError(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Publications not awaiting the mesh go to the single peer on the newly joined topic.
+	start := time.Now()
+	require.NoError(t, s.PublishToTopic(ctx, topic, []byte{'a'}))
+	assert.Equal(t, true, time.Since(start) < meshWait, "Waited for the mesh without opting in")
+
+	// Those awaiting it wait for the mesh to form, until the mesh wait passed since joining.
+	require.NoError(t, s.publishToTopicAwaitingMesh(ctx, topic, []byte{'b'}))
+	assert.Equal(t, true, time.Since(start) >= meshWait, "Published before the mesh wait passed")
+
+	// Once the mesh wait passed, messages are published to the peers available.
+	start = time.Now()
+	require.NoError(t, s.publishToTopicAwaitingMesh(ctx, topic, []byte{'c'}))
+	assert.Equal(t, true, time.Since(start) < meshWait, "Waited for the mesh again")
+}
+
 func TestMessageIDFunction_HashesCorrectly(t *testing.T) {
 	invalidSnappy := [32]byte{'J', 'U', 'N', 'K'}
 	pMsg := &pubsubpb.Message{Data: invalidSnappy[:]}
@@ -24,16 +24,19 @@ const (
 	RPCPingTopic = "/eth2/beacon_chain/req/ping" + schemaVersionV1
 	// RPCMetaDataTopic defines the topic for the metadata rpc method.
 	RPCMetaDataTopic = "/eth2/beacon_chain/req/metadata" + schemaVersionV1
+	// RPCBeaconStateByRootTopic defines the topic for the beacon state by root rpc method.
+	RPCBeaconStateByRootTopic = "/eth2/beacon_chain/req/beacon_state_by_root" + schemaVersionV1
 )
 
 // RPCTopicMappings map the base message type to the rpc request.
 var RPCTopicMappings = map[string]interface{}{
-	RPCStatusTopic:        new(pb.Status),
-	RPCGoodByeTopic:       new(types.SSZUint64),
-	RPCBlocksByRangeTopic: new(pb.BeaconBlocksByRangeRequest),
-	RPCBlocksByRootTopic:  new(types.BeaconBlockByRootsReq),
-	RPCPingTopic:          new(types.SSZUint64),
-	RPCMetaDataTopic:      new(interface{}),
+	RPCStatusTopic:            new(pb.Status),
+	RPCGoodByeTopic:           new(types.SSZUint64),
+	RPCBlocksByRangeTopic:     new(pb.BeaconBlocksByRangeRequest),
+	RPCBlocksByRootTopic:      new(types.BeaconBlockByRootsReq),
+	RPCPingTopic:              new(types.SSZUint64),
+	RPCMetaDataTopic:          new(interface{}),
+	RPCBeaconStateByRootTopic: new(types.BeaconStateByRootReq),
 }
 
 // VerifyTopicMapping verifies that the topic and its accompanying
@@ -16,4 +16,5 @@ func TestVerifyRPCMappings(t *testing.T) {
 	assert.NotNil(t, VerifyTopicMapping(RPCStatusTopic, new([]byte)), "Incorrect message type verified for metadata rpc topic")
 
 	assert.NoError(t, VerifyTopicMapping(RPCBlocksByRootTopic, new(types.BeaconBlockByRootsReq)), "Failed to verify blocks by root rpc topic")
+	assert.NoError(t, VerifyTopicMapping(RPCBeaconStateByRootTopic, new(types.BeaconStateByRootReq)), "Failed to verify beacon state by root rpc topic")
 }
@@ -24,10 +24,12 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/whyrusleeping/mdns"
 	"go.opencensus.io/trace"
 
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
@@ -68,11 +70,14 @@ type Service struct {
 	peers                 *peers.Status
 	addrFilter            *filter.Filters
 	ipLimiter             *leakybucket.Collector
+	inboundLimits         InboundLimits
+	inboundLimitsLock     sync.RWMutex
 	privKey               *ecdsa.PrivateKey
 	metaData              *pb.MetaData
 	pubsub                *pubsub.PubSub
 	joinedTopics          map[string]*pubsub.Topic
 	joinedTopicsLock      sync.Mutex
+	topicJoinTimes        map[string]time.Time
 	subnetsLock           map[uint64]*sync.RWMutex
 	subnetsLockLock       sync.Mutex // Lock access to subnetsLock
 	initializationLock    sync.Mutex
@@ -83,6 +88,9 @@ type Service struct {
 	host                  host.Host
 	genesisTime           time.Time
 	genesisValidatorsRoot []byte
+	localDiscovery        *mdns.Server
+	peerListAddrs         []string
+	peerListAddrsLock     sync.RWMutex
 }
 
 // NewService initializes a new p2p service compatible with shared.Service interface. No
@@ -123,6 +131,11 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		return nil, err
 	}
 	s.ipLimiter = leakybucket.NewCollector(ipLimit, ipBurst, true /* deleteEmptyBuckets */)
+	if err := cfg.InboundLimits.validate(); err != nil {
+		log.WithError(err).Error("Invalid inbound connection limits")
+		return nil, err
+	}
+	s.inboundLimits = cfg.InboundLimits
 
 	opts := s.buildOptions(ipAddr, s.privKey)
 	h, err := libp2p.New(s.ctx, opts...)
@@ -144,6 +157,23 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		pubsub.WithSubscriptionFilter(s),
 		pubsub.WithPeerOutboundQueueSize(256),
 	}
+	var tracers eventTracers
+	if cfg.TraceDuplicates {
+		duplicates, err := newDuplicateGossipTracer(cfg.DuplicatePenaltyThreshold, func(pid peer.ID) {
+			s.peers.Scorers().GossipScorer().Increment(pid)
+		})
+		if err != nil {
+			log.WithError(err).Error("Failed to create duplicate gossip tracer")
+			return nil, err
+		}
+		tracers = append(tracers, duplicates)
+	}
+	if cfg.BlockPropagation != nil {
+		tracers = append(tracers, cfg.BlockPropagation)
+	}
+	if len(tracers) > 0 {
+		psOpts = append(psOpts, pubsub.WithEventTracer(tracers))
+	}
 	// Add gossip scoring options.
 	if featureconfig.Get().EnablePeerScorer {
 		psOpts = append(
@@ -151,8 +181,13 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 			pubsub.WithPeerScore(peerScoringParams()),
 			pubsub.WithPeerScoreInspect(s.peerInspector, time.Minute))
 	}
+	// Peers found through pruned mesh peers complement local discovery, which only
+	// reaches the nodes of the local network.
+	if cfg.LocalDiscovery {
+		psOpts = append(psOpts, pubsub.WithPeerExchange(true))
+	}
 	// Set the pubsub global parameters that we require.
-	setPubSubParameters()
+	setPubSubParameters(cfg.SeenMessageTTL)
 
 	gs, err := pubsub.NewGossipSub(s.ctx, s.host, psOpts...)
 	if err != nil {
@@ -168,6 +203,13 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 				Threshold:     maxBadResponses,
 				DecayInterval: time.Hour,
 			},
+			// Unset weights fall back to the defaults of the scorer.
+			BlockProviderScorerConfig: &scorers.BlockProviderScorerConfig{
+				ProcessedBatchWeight: flags.Get().BlockProviderBatchWeight,
+				ProcessedBlocksCap:   flags.Get().BlockProviderBlocksCap,
+				Decay:                flags.Get().BlockProviderDecay,
+				DecayInterval:        flags.Get().BlockProviderDecayInterval,
+			},
 		},
 	})
 
@@ -194,7 +236,8 @@ func (s *Service) Start() {
 		}
 	}
 
-	if !s.cfg.NoDiscovery && !s.cfg.DisableDiscv5 {
+	discoveryEnabled := !s.cfg.NoDiscovery && !s.cfg.DisableDiscv5
+	if discoveryEnabled {
 		ipAddr := ipAddr()
 		listener, err := s.startDiscoveryV5(
 			ipAddr,
@@ -225,9 +268,25 @@ func (s *Service) Start() {
 		s.connectWithAllPeers(addrs)
 	}
 
+	if len(s.cfg.PeerListURLs) > 0 {
+		go s.connectToPeerLists()
+		runutil.RunEvery(s.ctx, peerListRefreshInterval, s.connectToPeerLists)
+	}
+
+	// Without discovery, the nodes of the ENR trees are still found in the background.
+	if !discoveryEnabled && len(s.cfg.BootstrapDNS) > 0 {
+		go s.listenForNewNodes()
+	}
+
+	if s.cfg.LocalDiscovery {
+		if err := s.startLocalDiscovery(); err != nil {
+			log.WithError(err).Error("Could not start local peer discovery")
+		}
+	}
+
 	// Periodic functions.
 	runutil.RunEvery(s.ctx, params.BeaconNetworkConfig().TtfbTimeout, func() {
-		ensurePeerConnections(s.ctx, s.host, peersToWatch...)
+		ensurePeerConnections(s.ctx, s.host, append(peersToWatch, s.watchedPeerListAddrs()...)...)
 	})
 	runutil.RunEvery(s.ctx, 30*time.Minute, s.Peers().Prune)
 	runutil.RunEvery(s.ctx, params.BeaconNetworkConfig().RespTimeout, s.updateMetrics)
@@ -259,6 +318,11 @@ func (s *Service) Stop() error {
 	if s.dv5Listener != nil {
 		s.dv5Listener.Close()
 	}
+	if s.localDiscovery != nil {
+		if err := s.localDiscovery.Shutdown(); err != nil {
+			log.WithError(err).Error("Could not stop local peer discovery")
+		}
+	}
 	return nil
 }
 
@@ -137,3 +137,80 @@ func (s *ErrorMessage) UnmarshalSSZ(buf []byte) error {
 	*s = errMsg
 	return nil
 }
+
+// BeaconStateByRootReq specifies the beacon state by root request type, holding the root of the block
+// whose post state is requested.
+type BeaconStateByRootReq [rootLength]byte
+
+// MarshalSSZTo marshals the beacon state by root request with the provided byte slice.
+func (s *BeaconStateByRootReq) MarshalSSZTo(dst []byte) ([]byte, error) {
+	marshalledObj, err := s.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	return append(dst, marshalledObj...), nil
+}
+
+// MarshalSSZ Marshals the beacon state by root request type into the serialized object.
+func (s *BeaconStateByRootReq) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, s.SizeSSZ())
+	copy(buf, s[:])
+	return buf, nil
+}
+
+// SizeSSZ returns the size of the serialized representation.
+func (s *BeaconStateByRootReq) SizeSSZ() int {
+	return rootLength
+}
+
+// UnmarshalSSZ unmarshals the provided bytes buffer into the
+// beacon state by root request object.
+func (s *BeaconStateByRootReq) UnmarshalSSZ(buf []byte) error {
+	if len(buf) != s.SizeSSZ() {
+		return errors.Errorf("expected buffer with length of %d but received length %d", s.SizeSSZ(), len(buf))
+	}
+	copy(s[:], buf)
+	return nil
+}
+
+// BeaconStateChunk is a piece of an SSZ encoded beacon state, as sent in the response chunks of
+// a beacon state by root request.
+type BeaconStateChunk []byte
+
+// MarshalSSZTo marshals the beacon state chunk with the provided byte slice.
+func (s *BeaconStateChunk) MarshalSSZTo(dst []byte) ([]byte, error) {
+	marshalledObj, err := s.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	return append(dst, marshalledObj...), nil
+}
+
+// MarshalSSZ Marshals the beacon state chunk into the serialized object.
+func (s *BeaconStateChunk) MarshalSSZ() ([]byte, error) {
+	if uint64(len(*s)) > params.BeaconNetworkConfig().MaxChunkSize {
+		return nil, errors.Errorf("beacon state chunk exceeds max size: %d > %d", len(*s), params.BeaconNetworkConfig().MaxChunkSize)
+	}
+	buf := make([]byte, s.SizeSSZ())
+	copy(buf, *s)
+	return buf, nil
+}
+
+// SizeSSZ returns the size of the serialized representation.
+func (s *BeaconStateChunk) SizeSSZ() int {
+	return len(*s)
+}
+
+// UnmarshalSSZ unmarshals the provided bytes buffer into the
+// beacon state chunk object.
+func (s *BeaconStateChunk) UnmarshalSSZ(buf []byte) error {
+	bufLen := len(buf)
+	maxLength := params.BeaconNetworkConfig().MaxChunkSize
+	if uint64(bufLen) > maxLength {
+		return errors.Errorf("expected buffer with length of upto %d but received length %d", maxLength, bufLen)
+	}
+	chunk := make([]byte, bufLen)
+	copy(chunk, buf)
+	*s = chunk
+	return nil
+}
@@ -43,10 +43,26 @@ func TestErrorResponse_Limit(t *testing.T) {
 	require.ErrorContains(t, "expected buffer with length of upto", errMsg.UnmarshalSSZ(errorMessage))
 }
 
+func TestBeaconStateChunk_Limit(t *testing.T) {
+	chunk := BeaconStateChunk(make([]byte, params.BeaconNetworkConfig().MaxChunkSize+1))
+	_, err := chunk.MarshalSSZ()
+	require.ErrorContains(t, "beacon state chunk exceeds max size", err)
+
+	chunk2 := BeaconStateChunk(nil)
+	require.ErrorContains(t, "expected buffer with length of upto", chunk2.UnmarshalSSZ(chunk))
+}
+
+func TestBeaconStateByRootReq_Limit(t *testing.T) {
+	req := BeaconStateByRootReq{}
+	require.ErrorContains(t, "expected buffer with length", req.UnmarshalSSZ(make([]byte, 33)))
+}
+
 func TestRoundTripSerialization(t *testing.T) {
 	roundTripTestSSZUint64(t)
 	roundTripTestBlocksByRootReq(t)
 	roundTripTestErrorMessage(t)
+	roundTripTestStateByRootReq(t)
+	roundTripTestBeaconStateChunk(t)
 }
 
 func roundTripTestSSZUint64(t *testing.T) {
@@ -89,3 +105,27 @@ func roundTripTestErrorMessage(t *testing.T) {
 	require.NoError(t, newVal.UnmarshalSSZ(marshalledObj))
 	assert.DeepEqual(t, []byte(newVal), errMsg)
 }
+
+func roundTripTestStateByRootReq(t *testing.T) {
+	req := BeaconStateByRootReq{'r', 'o', 'o', 't'}
+
+	marshalledObj, err := req.MarshalSSZ()
+	require.NoError(t, err)
+	newVal := BeaconStateByRootReq{}
+
+	require.NoError(t, newVal.UnmarshalSSZ(marshalledObj))
+	assert.DeepEqual(t, req, newVal)
+}
+
+func roundTripTestBeaconStateChunk(t *testing.T) {
+	data := []byte{'s', 't', 'a', 't', 'e'}
+	chunk := make(BeaconStateChunk, len(data))
+	copy(chunk, data)
+
+	marshalledObj, err := chunk.MarshalSSZ()
+	require.NoError(t, err)
+	newVal := BeaconStateChunk(nil)
+
+	require.NoError(t, newVal.UnmarshalSSZ(marshalledObj))
+	assert.DeepEqual(t, []byte(newVal), data)
+}
@@ -0,0 +1,209 @@
+package powchain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/gateway"
+	protodb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+	"github.com/sirupsen/logrus"
+)
+
+const depositSnapshotRequestTimeout = 30 * time.Second
+
+// depositSnapshotJSON is the JSON encoding of a deposit tree snapshot, as served by the standard
+// deposit snapshot API of EIP-4881 and by the debug gateway of a beacon node in the standard JSON format.
+type depositSnapshotJSON struct {
+	Finalized            []string `json:"finalized"`
+	DepositRoot          string   `json:"deposit_root"`
+	DepositCount         string   `json:"deposit_count"`
+	ExecutionBlockHash   string   `json:"execution_block_hash"`
+	ExecutionBlockHeight string   `json:"execution_block_height"`
+}
+
+type depositSnapshotResponse struct {
+	Data *depositSnapshotJSON `json:"data"`
+}
+
+// DepositSnapshot returns a snapshot of the finalized deposits, which other nodes can be started
+// from with --deposit-snapshot instead of processing every deposit log of the deposit contract.
+// No snapshot is returned until deposits are finalized.
+func (s *Service) DepositSnapshot(ctx context.Context) (*trieutil.DepositTreeSnapshot, error) {
+	snapshot, err := s.depositCache.FinalizedDepositsSnapshot(ctx)
+	if err != nil || snapshot == nil {
+		return nil, err
+	}
+	// The block hash only describes the snapshot, nodes importing it resume from the block height.
+	hash, err := s.BlockHashByHeight(ctx, new(big.Int).SetUint64(snapshot.ExecutionBlockHeight))
+	if err != nil {
+		log.WithError(err).Debug("Could not get hash of the deposit snapshot block")
+	} else {
+		snapshot.ExecutionBlockHash = hash
+	}
+	return snapshot, nil
+}
+
+// importDepositSnapshot initializes the deposit trie and caches of a node without eth1 data from the
+// deposit snapshot at the given path or URL, so deposit logs are only processed from the block of the
+// snapshot on. The chain must already be initialized from a genesis state.
+func (s *Service) importDepositSnapshot(ctx context.Context, source string) error {
+	if s.chainStartData.Chainstarted || s.lastReceivedMerkleIndex >= 0 {
+		log.Info("Deposit data already exists in DB, ignoring deposit snapshot")
+		return nil
+	}
+	genState, err := s.beaconDB.GenesisState(ctx)
+	if err != nil {
+		return err
+	}
+	if genState == nil {
+		return errors.New("a genesis state is required to import a deposit snapshot")
+	}
+	snapshot, err := readDepositSnapshot(ctx, source)
+	if err != nil {
+		return errors.Wrapf(err, "could not read deposit snapshot from %s", source)
+	}
+	trie, err := trieutil.TrieFromSnapshot(snapshot, params.BeaconConfig().DepositContractTreeDepth)
+	if err != nil {
+		return errors.Wrap(err, "invalid deposit snapshot")
+	}
+
+	s.depositCache.InsertSnapshotDeposits(ctx, trie.Copy(), snapshot.ExecutionBlockHeight)
+	s.depositTrie = trie
+	s.lastReceivedMerkleIndex = int64(snapshot.DepositCount) - 1
+	// Deposits made after the snapshot in the same block are not part of it, so the block is read again.
+	s.latestEth1Data.LastRequestedBlock = snapshot.ExecutionBlockHeight
+	s.chainStartData = &protodb.ChainStartData{
+		Chainstarted:       true,
+		GenesisTime:        genState.GenesisTime(),
+		Eth1Data:           genState.Eth1Data(),
+		ChainstartDeposits: make([]*ethpb.Deposit, 0),
+	}
+	log.WithFields(logrus.Fields{
+		"depositCount": snapshot.DepositCount,
+		"depositRoot":  fmt.Sprintf("%#x", snapshot.DepositRoot),
+		"blockHeight":  snapshot.ExecutionBlockHeight,
+	}).Info("Imported deposit snapshot")
+	return s.savePowchainData(ctx)
+}
+
+// restoreSnapshotDeposits initializes the finalized deposits of a node started from a deposit snapshot,
+// which deposits preceding the first deposit container are only stored in the deposit trie.
+func (s *Service) restoreSnapshotDeposits(ctx context.Context, ctrs []*protodb.DepositContainer) error {
+	count := s.depositTrie.NumOfItems()
+	height := s.latestEth1Data.GetLastRequestedBlock()
+	for _, c := range ctrs {
+		if uint64(c.Index) < count {
+			count = uint64(c.Index)
+			height = c.Eth1BlockHeight
+		}
+	}
+	// Nodes which processed every deposit log have a deposit container from the first deposit on.
+	if count == 0 {
+		return nil
+	}
+	snapshot, err := s.depositTrie.Snapshot(count)
+	if err != nil {
+		return err
+	}
+	trie, err := trieutil.TrieFromSnapshot(snapshot, params.BeaconConfig().DepositContractTreeDepth)
+	if err != nil {
+		return err
+	}
+	s.depositCache.InsertSnapshotDeposits(ctx, trie, height)
+	return nil
+}
+
+// readDepositSnapshot reads a JSON deposit snapshot from a file, or from a URL such as the deposit
+// snapshot endpoint of the debug gateway of another beacon node.
+func readDepositSnapshot(ctx context.Context, source string) (*trieutil.DepositTreeSnapshot, error) {
+	var enc []byte
+	var err error
+	if strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://") {
+		enc, err = fetchDepositSnapshot(ctx, source)
+	} else {
+		enc, err = ioutil.ReadFile(source)
+	}
+	if err != nil {
+		return nil, err
+	}
+	resp := &depositSnapshotResponse{}
+	if err := json.Unmarshal(enc, resp); err != nil {
+		return nil, errors.Wrap(err, "could not decode deposit snapshot")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("no deposit snapshot data")
+	}
+	return decodeDepositSnapshot(resp.Data)
+}
+
+func fetchDepositSnapshot(ctx context.Context, url string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, depositSnapshotRequestTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	// Selects the standard JSON format of the gateway of a beacon node, which other servers ignore.
+	req.Header.Set(gateway.JSONFormatHeader, gateway.StandardJSONFormat)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Debug("Could not close response body")
+		}
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("deposit snapshot request returned status %d", resp.StatusCode)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
+
+func decodeDepositSnapshot(enc *depositSnapshotJSON) (*trieutil.DepositTreeSnapshot, error) {
+	decodeRoot := func(field, value string) ([32]byte, error) {
+		b, err := hexutil.Decode(value)
+		if err != nil {
+			return [32]byte{}, errors.Wrapf(err, "invalid %s", field)
+		}
+		if len(b) != 32 {
+			return [32]byte{}, fmt.Errorf("invalid %s: expected 32 bytes, got %d", field, len(b))
+		}
+		return bytesutil.ToBytes32(b), nil
+	}
+	snapshot := &trieutil.DepositTreeSnapshot{
+		Finalized: make([][32]byte, len(enc.Finalized)),
+	}
+	var err error
+	for i, root := range enc.Finalized {
+		if snapshot.Finalized[i], err = decodeRoot("finalized root", root); err != nil {
+			return nil, err
+		}
+	}
+	if snapshot.DepositRoot, err = decodeRoot("deposit root", enc.DepositRoot); err != nil {
+		return nil, err
+	}
+	if snapshot.ExecutionBlockHash, err = decodeRoot("execution block hash", enc.ExecutionBlockHash); err != nil {
+		return nil, err
+	}
+	if snapshot.DepositCount, err = strconv.ParseUint(enc.DepositCount, 10, 64); err != nil {
+		return nil, errors.Wrap(err, "invalid deposit count")
+	}
+	if snapshot.ExecutionBlockHeight, err = strconv.ParseUint(enc.ExecutionBlockHeight, 10, 64); err != nil {
+		return nil, errors.Wrap(err, "invalid execution block height")
+	}
+	return snapshot, nil
+}
@@ -0,0 +1,121 @@
+package powchain
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
+	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/gateway"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+)
+
+func TestDepositSnapshot_ExportAndImport(t *testing.T) {
+	ctx := context.Background()
+	deposits, _, err := testutil.DeterministicDepositsAndKeys(5)
+	require.NoError(t, err)
+	exportCache, err := depositcache.New()
+	require.NoError(t, err)
+	var leaves [][]byte
+	for i, d := range deposits {
+		leaf, err := d.Data.HashTreeRoot()
+		require.NoError(t, err)
+		leaves = append(leaves, leaf[:])
+		trie, err := trieutil.GenerateTrieFromItems(leaves, params.BeaconConfig().DepositContractTreeDepth)
+		require.NoError(t, err)
+		exportCache.InsertDeposit(ctx, d, uint64(10+i), int64(i), trie.HashTreeRoot())
+	}
+	exportCache.InsertFinalizedDeposits(ctx, 2)
+	exporter := &Service{depositCache: exportCache, headerCache: newHeaderCache()}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The snapshot is requested in the standard JSON format of the debug gateway.
+		if r.Header.Get(gateway.JSONFormatHeader) != gateway.StandardJSONFormat {
+			http.Error(w, "unexpected JSON format", http.StatusNotAcceptable)
+			return
+		}
+		snapshot, err := exporter.DepositSnapshot(r.Context())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(&depositSnapshotResponse{Data: encodeDepositSnapshot(snapshot)}); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	}))
+	defer srv.Close()
+
+	beaconDB, _ := dbutil.SetupDB(t)
+	st, _ := testutil.DeterministicGenesisState(t, 10)
+	genRoot, err := testutil.NewBeaconBlock().HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveState(ctx, st, genRoot))
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, genRoot))
+	importCache, err := depositcache.New()
+	require.NoError(t, err)
+	s, err := NewService(ctx, &Web3ServiceConfig{
+		BeaconDB:     beaconDB,
+		DepositCache: importCache,
+	})
+	require.NoError(t, err)
+	require.NoError(t, s.importDepositSnapshot(ctx, srv.URL))
+
+	full, err := trieutil.GenerateTrieFromItems(leaves[:3], params.BeaconConfig().DepositContractTreeDepth)
+	require.NoError(t, err)
+	assert.Equal(t, full.HashTreeRoot(), s.depositTrie.HashTreeRoot())
+	assert.Equal(t, int64(2), s.lastReceivedMerkleIndex)
+	assert.Equal(t, uint64(12), s.latestEth1Data.LastRequestedBlock)
+	assert.Equal(t, true, s.chainStartData.Chainstarted)
+	assert.Equal(t, full.HashTreeRoot(), importCache.FinalizedDeposits(ctx).Deposits.HashTreeRoot())
+
+	// Deposits following the snapshot are inserted on top of it.
+	full.Insert(leaves[3], 3)
+	s.depositTrie.Insert(leaves[3], 3)
+	assert.Equal(t, full.HashTreeRoot(), s.depositTrie.HashTreeRoot())
+
+	// Restarted nodes restore the finalized deposits of the snapshot.
+	restartCache, err := depositcache.New()
+	require.NoError(t, err)
+	_, err = NewService(ctx, &Web3ServiceConfig{
+		BeaconDB:     beaconDB,
+		DepositCache: restartCache,
+	})
+	require.NoError(t, err)
+	finalized := restartCache.FinalizedDeposits(ctx)
+	assert.Equal(t, int64(2), finalized.MerkleTrieIndex)
+	assert.Equal(t, importCache.FinalizedDeposits(ctx).Deposits.HashTreeRoot(), finalized.Deposits.HashTreeRoot())
+}
+
+func TestDepositSnapshot_NoFinalizedDeposits(t *testing.T) {
+	depositCache, err := depositcache.New()
+	require.NoError(t, err)
+	s := &Service{depositCache: depositCache, headerCache: newHeaderCache()}
+
+	snapshot, err := s.DepositSnapshot(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, (*trieutil.DepositTreeSnapshot)(nil), snapshot)
+}
+
+// encodeDepositSnapshot encodes a snapshot as the debug gateway does in the standard JSON format.
+func encodeDepositSnapshot(snapshot *trieutil.DepositTreeSnapshot) *depositSnapshotJSON {
+	finalized := make([]string, len(snapshot.Finalized))
+	for i, root := range snapshot.Finalized {
+		finalized[i] = hexutil.Encode(root[:])
+	}
+	return &depositSnapshotJSON{
+		Finalized:            finalized,
+		DepositRoot:          hexutil.Encode(snapshot.DepositRoot[:]),
+		DepositCount:         strconv.FormatUint(snapshot.DepositCount, 10),
+		ExecutionBlockHash:   hexutil.Encode(snapshot.ExecutionBlockHash[:]),
+		ExecutionBlockHeight: strconv.FormatUint(snapshot.ExecutionBlockHeight, 10),
+	}
+}
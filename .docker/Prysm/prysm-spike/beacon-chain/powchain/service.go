@@ -89,6 +89,11 @@ type POWBlockFetcher interface {
 	BlockExistsWithCache(ctx context.Context, hash common.Hash) (bool, *big.Int, error)
 }
 
+// DepositSnapshotter creates snapshots of the finalized deposits to start other nodes from.
+type DepositSnapshotter interface {
+	DepositSnapshot(ctx context.Context) (*trieutil.DepositTreeSnapshot, error)
+}
+
 // Chain defines a standard interface for the powchain service in Prysm.
 type Chain interface {
 	ChainStartFetcher
@@ -150,6 +155,7 @@ type Service struct {
 	preGenesisState         *stateTrie.BeaconState
 	stateGen                *stategen.State
 	eth1HeaderReqLimit      uint64
+	depositSnapshot         string
 }
 
 // Web3ServiceConfig defines a config struct for web3 service to use through its life cycle.
@@ -161,6 +167,7 @@ type Web3ServiceConfig struct {
 	StateNotifier      statefeed.Notifier
 	StateGen           *stategen.State
 	Eth1HeaderReqLimit uint64
+	DepositSnapshot    string // Path or URL of a deposit snapshot to start a node without eth1 data from.
 }
 
 // NewService sets up a new instance with an ethclient when
@@ -209,6 +216,7 @@ func NewService(ctx context.Context, config *Web3ServiceConfig) (*Service, error
 		headTicker:              time.NewTicker(time.Duration(params.BeaconConfig().SecondsPerETH1Block) * time.Second),
 		stateGen:                config.StateGen,
 		eth1HeaderReqLimit:      eth1HeaderReqLimit,
+		depositSnapshot:         config.DepositSnapshot,
 	}
 
 	eth1Data, err := config.BeaconDB.PowchainData(ctx)
@@ -229,12 +237,21 @@ func NewService(ctx context.Context, config *Web3ServiceConfig) (*Service, error
 		if err := s.initDepositCaches(ctx, eth1Data.DepositContainers); err != nil {
 			return nil, errors.Wrap(err, "could not initialize caches")
 		}
+		if err := s.restoreSnapshotDeposits(ctx, eth1Data.DepositContainers); err != nil {
+			return nil, errors.Wrap(err, "could not restore deposit snapshot")
+		}
 	}
 	return s, nil
 }
 
 // Start a web3 service's main event loop.
 func (s *Service) Start() {
+	// The genesis state a deposit snapshot is imported with is only saved once every service is registered.
+	if s.depositSnapshot != "" {
+		if err := s.importDepositSnapshot(s.ctx, s.depositSnapshot); err != nil {
+			log.WithError(err).Fatal("Could not import deposit snapshot")
+		}
+	}
 	// If the chain has not started already and we don't have access to eth1 nodes, we will not be
 	// able to generate the genesis state.
 	if !s.chainStartData.Chainstarted && s.httpEndpoint == "" {
@@ -550,10 +567,10 @@ func (s *Service) initDepositCaches(ctx context.Context, ctrs []*protodb.Deposit
 		currIndex = fState.Eth1DepositIndex()
 	}
 	validDepositsCount.Add(float64(currIndex + 1))
-	// Only add pending deposits if the container slice length
-	// is more than the current index in state.
-	if uint64(len(ctrs)) > currIndex {
-		for _, c := range ctrs[currIndex:] {
+	// Only add pending deposits which are not yet processed in state. Containers
+	// may start after the deposits of a deposit snapshot.
+	for _, c := range ctrs {
+		if uint64(c.Index) >= currIndex {
 			s.depositCache.InsertPendingDeposit(ctx, c.Deposit, c.Eth1BlockHeight, c.Index, bytesutil.ToBytes32(c.DepositRoot))
 		}
 	}
@@ -0,0 +1,201 @@
+package replica
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+var (
+	_ = blockchain.HeadFetcher(&chainInfo{})
+	_ = blockchain.FinalizationFetcher(&chainInfo{})
+	_ = blockchain.TimeFetcher(&chainInfo{})
+)
+
+// chainInfo serves the head of the chain saved in the open snapshot, which is the head of the
+// primary when it wrote the snapshot. The replica does not run fork choice, so the head only moves
+// when a newer snapshot is opened.
+type chainInfo struct {
+	db          *snapshotDB
+	stateGen    *stategen.State
+	genesisTime time.Time
+	lock        sync.RWMutex
+	headRoot    [32]byte
+	headBlock   *ethpb.SignedBeaconBlock
+	headState   *state.BeaconState
+}
+
+// update loads the head and the finalized state of the open snapshot.
+func (c *chainInfo) update(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "replica.updateChainInfo")
+	defer span.End()
+
+	cp, err := c.db.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized checkpoint")
+	}
+	fRoot := bytesutil.ToBytes32(cp.Root)
+	if fRoot != params.BeaconConfig().ZeroHash {
+		fState, err := c.stateGen.StateByRoot(ctx, fRoot)
+		if err != nil {
+			return errors.Wrap(err, "could not get finalized state")
+		}
+		if fState == nil {
+			return errors.New("finalized state not found in snapshot")
+		}
+		c.stateGen.SaveFinalizedState(fState.Slot(), fRoot, fState)
+	}
+
+	headBlock, err := c.db.HeadBlock(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get head block")
+	}
+	if headBlock == nil || headBlock.Block == nil {
+		return errors.New("no head block in snapshot")
+	}
+	headRoot, err := headBlock.Block.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "could not hash head block")
+	}
+	headState, err := c.stateGen.StateByRoot(ctx, headRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not get head state")
+	}
+	if headState == nil {
+		return errors.New("head state not found in snapshot")
+	}
+	if c.genesisTime.IsZero() {
+		c.genesisTime = time.Unix(int64(headState.GenesisTime()), 0)
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.headRoot = headRoot
+	c.headBlock = headBlock
+	c.headState = headState
+	return nil
+}
+
+// HeadSlot returns the slot of the head of the snapshot.
+func (c *chainInfo) HeadSlot() uint64 {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	if c.headBlock == nil {
+		return 0
+	}
+	return c.headBlock.Block.Slot
+}
+
+// HeadRoot returns the root of the head of the snapshot.
+func (c *chainInfo) HeadRoot(_ context.Context) ([]byte, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return bytesutil.SafeCopyBytes(c.headRoot[:]), nil
+}
+
+// HeadBlock returns the head block of the snapshot.
+func (c *chainInfo) HeadBlock(_ context.Context) (*ethpb.SignedBeaconBlock, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.headBlock, nil
+}
+
+// HeadState returns a copy of the head state of the snapshot.
+func (c *chainInfo) HeadState(_ context.Context) (*state.BeaconState, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	if c.headState == nil {
+		return nil, nil
+	}
+	return c.headState.Copy(), nil
+}
+
+// HeadValidatorsIndices returns the active validator indices of the epoch in the head state.
+func (c *chainInfo) HeadValidatorsIndices(_ context.Context, epoch uint64) ([]uint64, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	if c.headState == nil {
+		return []uint64{}, nil
+	}
+	return helpers.ActiveValidatorIndices(c.headState, epoch)
+}
+
+// HeadSeed returns the attester seed of the epoch in the head state.
+func (c *chainInfo) HeadSeed(_ context.Context, epoch uint64) ([32]byte, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	if c.headState == nil {
+		return [32]byte{}, nil
+	}
+	return helpers.Seed(c.headState, epoch, params.BeaconConfig().DomainBeaconAttester)
+}
+
+// HeadGenesisValidatorRoot returns the genesis validator root of the head state.
+func (c *chainInfo) HeadGenesisValidatorRoot() [32]byte {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	if c.headState == nil {
+		return [32]byte{}
+	}
+	return bytesutil.ToBytes32(c.headState.GenesisValidatorRoot())
+}
+
+// HeadETH1Data returns the eth1 data of the head state.
+func (c *chainInfo) HeadETH1Data() *ethpb.Eth1Data {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	if c.headState == nil {
+		return &ethpb.Eth1Data{}
+	}
+	return c.headState.Eth1Data()
+}
+
+// ProtoArrayStore returns nil, the replica does not run fork choice.
+func (c *chainInfo) ProtoArrayStore() *protoarray.Store {
+	return nil
+}
+
+// FinalizedCheckpt returns the finalized checkpoint of the head state.
+func (c *chainInfo) FinalizedCheckpt() *ethpb.Checkpoint {
+	return c.checkpoint((*state.BeaconState).FinalizedCheckpoint)
+}
+
+// CurrentJustifiedCheckpt returns the current justified checkpoint of the head state.
+func (c *chainInfo) CurrentJustifiedCheckpt() *ethpb.Checkpoint {
+	return c.checkpoint((*state.BeaconState).CurrentJustifiedCheckpoint)
+}
+
+// PreviousJustifiedCheckpt returns the previous justified checkpoint of the head state.
+func (c *chainInfo) PreviousJustifiedCheckpt() *ethpb.Checkpoint {
+	return c.checkpoint((*state.BeaconState).PreviousJustifiedCheckpoint)
+}
+
+func (c *chainInfo) checkpoint(get func(*state.BeaconState) *ethpb.Checkpoint) *ethpb.Checkpoint {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	if c.headState == nil {
+		return &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]}
+	}
+	return get(c.headState)
+}
+
+// GenesisTime returns the genesis time of the chain.
+func (c *chainInfo) GenesisTime() time.Time {
+	return c.genesisTime
+}
+
+// CurrentSlot returns the current slot based on time.
+func (c *chainInfo) CurrentSlot() uint64 {
+	return helpers.CurrentSlot(uint64(c.genesisTime.Unix()))
+}
@@ -0,0 +1,56 @@
+package replica
+
+import (
+	"errors"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/urfave/cli/v2"
+)
+
+// Command runs an API replica serving the snapshots the beacon node writes to the directory of the
+// db-replica-snapshot-dir flag, with the RPC host, port and TLS flags of the beacon node.
+func Command(configure func(*cli.Context)) *cli.Command {
+	return &cli.Command{
+		Name:     "api-replica",
+		Category: "replica",
+		Usage: "serves the read-only beacon chain API from the database snapshots of a beacon node, " +
+			"for explorers and analytics not to load the node running validators",
+		Flags: []cli.Flag{
+			flags.ReplicaPrimaryRPCProvider,
+			flags.ReplicaPrimaryCertFlag,
+		},
+		Action: func(cliCtx *cli.Context) error {
+			configure(cliCtx)
+			snapshotDir := cliCtx.String(flags.DBReplicaSnapshotDir.Name)
+			if snapshotDir == "" {
+				return errors.New("the --db-replica-snapshot-dir flag is required")
+			}
+			srv, err := New(cliCtx.Context, &Config{
+				SnapshotDir:        snapshotDir,
+				PrimaryRPCProvider: cliCtx.String(flags.ReplicaPrimaryRPCProvider.Name),
+				PrimaryCert:        cliCtx.String(flags.ReplicaPrimaryCertFlag.Name),
+				Host:               cliCtx.String(flags.RPCHost.Name),
+				Port:               cliCtx.String(flags.RPCPort.Name),
+				CertFlag:           cliCtx.String(flags.CertFlag.Name),
+				KeyFlag:            cliCtx.String(flags.KeyFlag.Name),
+				MaxMsgSize:         cliCtx.Int(cmd.GrpcMaxCallRecvMsgSizeFlag.Name),
+			})
+			if err != nil {
+				return err
+			}
+			if err := srv.Start(); err != nil {
+				return err
+			}
+			sigc := make(chan os.Signal, 1)
+			signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+			defer signal.Stop(sigc)
+			<-sigc
+			log.Info("Got interrupt, shutting down API replica")
+			return srv.Stop()
+		},
+	}
+}
@@ -0,0 +1,99 @@
+package replica
+
+import (
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+)
+
+// errReadOnly is returned by the methods of the replica database which write to it.
+var errReadOnly = errors.New("the database of an API replica is read-only")
+
+var _ = iface.HeadAccessDatabase(&snapshotDB{})
+
+// snapshotDB serves the latest snapshot of the database of a primary beacon node, opened
+// read-only. A newer snapshot written by the primary replaces the open one on reload, and reads
+// in progress on the replaced snapshot complete before it is closed.
+type snapshotDB struct {
+	dir               string
+	stateSummaryCache *cache.StateSummaryCache
+	lock              sync.RWMutex
+	store             *kv.Store
+	modTime           time.Time
+}
+
+// openSnapshotDB opens the snapshot in the directory, which must exist.
+func openSnapshotDB(dir string, stateSummaryCache *cache.StateSummaryCache) (*snapshotDB, error) {
+	d := &snapshotDB{
+		dir:               dir,
+		stateSummaryCache: stateSummaryCache,
+	}
+	reloaded, err := d.reload()
+	if err != nil {
+		return nil, err
+	}
+	if !reloaded {
+		return nil, errors.Errorf("no database snapshot in %s", dir)
+	}
+	return d, nil
+}
+
+// current returns the open snapshot.
+func (d *snapshotDB) current() *kv.Store {
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+	return d.store
+}
+
+// snapshotTime returns the time the open snapshot was written at.
+func (d *snapshotDB) snapshotTime() time.Time {
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+	return d.modTime
+}
+
+// reload opens the snapshot in the directory when it is newer than the open one, and returns
+// whether it did.
+func (d *snapshotDB) reload() (bool, error) {
+	info, err := os.Stat(kv.SnapshotPath(d.dir))
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if !info.ModTime().After(d.snapshotTime()) {
+		return false, nil
+	}
+	store, err := kv.NewKVStoreReadOnly(d.dir, d.stateSummaryCache)
+	if err != nil {
+		return false, errors.Wrap(err, "could not open database snapshot")
+	}
+	d.lock.Lock()
+	prev := d.store
+	d.store = store
+	d.modTime = info.ModTime()
+	d.lock.Unlock()
+	if prev != nil {
+		// Closing waits for the read transactions open on the previous snapshot.
+		if err := prev.Close(); err != nil {
+			log.WithError(err).Error("Could not close previous database snapshot")
+		}
+	}
+	return true, nil
+}
+
+// Close closes the open snapshot.
+func (d *snapshotDB) Close() error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if d.store == nil {
+		return nil
+	}
+	return d.store.Close()
+}
@@ -0,0 +1,322 @@
+package replica
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/proto/beacon/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+// Block -- passthrough.
+func (d *snapshotDB) Block(ctx context.Context, blockRoot [32]byte) (*eth.SignedBeaconBlock, error) {
+	return d.current().Block(ctx, blockRoot)
+}
+
+// Blocks -- passthrough.
+func (d *snapshotDB) Blocks(ctx context.Context, f *filters.QueryFilter) ([]*eth.SignedBeaconBlock, [][32]byte, error) {
+	return d.current().Blocks(ctx, f)
+}
+
+// BlockRoots -- passthrough.
+func (d *snapshotDB) BlockRoots(ctx context.Context, f *filters.QueryFilter) ([][32]byte, error) {
+	return d.current().BlockRoots(ctx, f)
+}
+
+// HasBlock -- passthrough.
+func (d *snapshotDB) HasBlock(ctx context.Context, blockRoot [32]byte) bool {
+	return d.current().HasBlock(ctx, blockRoot)
+}
+
+// GenesisBlock -- passthrough.
+func (d *snapshotDB) GenesisBlock(ctx context.Context) (*eth.SignedBeaconBlock, error) {
+	return d.current().GenesisBlock(ctx)
+}
+
+// IsFinalizedBlock -- passthrough.
+func (d *snapshotDB) IsFinalizedBlock(ctx context.Context, blockRoot [32]byte) bool {
+	return d.current().IsFinalizedBlock(ctx, blockRoot)
+}
+
+// FinalizedChildBlock -- passthrough.
+func (d *snapshotDB) FinalizedChildBlock(ctx context.Context, blockRoot [32]byte) (*eth.SignedBeaconBlock, error) {
+	return d.current().FinalizedChildBlock(ctx, blockRoot)
+}
+
+// HighestSlotBlocksBelow -- passthrough.
+func (d *snapshotDB) HighestSlotBlocksBelow(ctx context.Context, slot uint64) ([]*eth.SignedBeaconBlock, error) {
+	return d.current().HighestSlotBlocksBelow(ctx, slot)
+}
+
+// State -- passthrough.
+func (d *snapshotDB) State(ctx context.Context, blockRoot [32]byte) (*state.BeaconState, error) {
+	return d.current().State(ctx, blockRoot)
+}
+
+// GenesisState -- passthrough.
+func (d *snapshotDB) GenesisState(ctx context.Context) (*state.BeaconState, error) {
+	return d.current().GenesisState(ctx)
+}
+
+// HasState -- passthrough.
+func (d *snapshotDB) HasState(ctx context.Context, blockRoot [32]byte) bool {
+	return d.current().HasState(ctx, blockRoot)
+}
+
+// StateSummary -- passthrough.
+func (d *snapshotDB) StateSummary(ctx context.Context, blockRoot [32]byte) (*pb.StateSummary, error) {
+	return d.current().StateSummary(ctx, blockRoot)
+}
+
+// HasStateSummary -- passthrough.
+func (d *snapshotDB) HasStateSummary(ctx context.Context, blockRoot [32]byte) bool {
+	return d.current().HasStateSummary(ctx, blockRoot)
+}
+
+// HighestSlotStatesBelow -- passthrough.
+func (d *snapshotDB) HighestSlotStatesBelow(ctx context.Context, slot uint64) ([]*state.BeaconState, error) {
+	return d.current().HighestSlotStatesBelow(ctx, slot)
+}
+
+// ProposerSlashing -- passthrough.
+func (d *snapshotDB) ProposerSlashing(ctx context.Context, slashingRoot [32]byte) (*eth.ProposerSlashing, error) {
+	return d.current().ProposerSlashing(ctx, slashingRoot)
+}
+
+// AttesterSlashing -- passthrough.
+func (d *snapshotDB) AttesterSlashing(ctx context.Context, slashingRoot [32]byte) (*eth.AttesterSlashing, error) {
+	return d.current().AttesterSlashing(ctx, slashingRoot)
+}
+
+// HasProposerSlashing -- passthrough.
+func (d *snapshotDB) HasProposerSlashing(ctx context.Context, slashingRoot [32]byte) bool {
+	return d.current().HasProposerSlashing(ctx, slashingRoot)
+}
+
+// HasAttesterSlashing -- passthrough.
+func (d *snapshotDB) HasAttesterSlashing(ctx context.Context, slashingRoot [32]byte) bool {
+	return d.current().HasAttesterSlashing(ctx, slashingRoot)
+}
+
+// VoluntaryExit -- passthrough.
+func (d *snapshotDB) VoluntaryExit(ctx context.Context, exitRoot [32]byte) (*eth.VoluntaryExit, error) {
+	return d.current().VoluntaryExit(ctx, exitRoot)
+}
+
+// HasVoluntaryExit -- passthrough.
+func (d *snapshotDB) HasVoluntaryExit(ctx context.Context, exitRoot [32]byte) bool {
+	return d.current().HasVoluntaryExit(ctx, exitRoot)
+}
+
+// DepositBlockRoots -- passthrough.
+func (d *snapshotDB) DepositBlockRoots(ctx context.Context, pubKey []byte) ([][32]byte, error) {
+	return d.current().DepositBlockRoots(ctx, pubKey)
+}
+
+// VoluntaryExitBlockRoots -- passthrough.
+func (d *snapshotDB) VoluntaryExitBlockRoots(ctx context.Context, validatorIndex uint64) ([][32]byte, error) {
+	return d.current().VoluntaryExitBlockRoots(ctx, validatorIndex)
+}
+
+// ProposerSlashingBlockRoots -- passthrough.
+func (d *snapshotDB) ProposerSlashingBlockRoots(ctx context.Context, validatorIndex uint64) ([][32]byte, error) {
+	return d.current().ProposerSlashingBlockRoots(ctx, validatorIndex)
+}
+
+// AttesterSlashingBlockRoots -- passthrough.
+func (d *snapshotDB) AttesterSlashingBlockRoots(ctx context.Context, validatorIndex uint64) ([][32]byte, error) {
+	return d.current().AttesterSlashingBlockRoots(ctx, validatorIndex)
+}
+
+// LocalOperations -- passthrough.
+func (d *snapshotDB) LocalOperations(ctx context.Context) ([]*db.LocalOperation, error) {
+	return d.current().LocalOperations(ctx)
+}
+
+// JustifiedCheckpoint -- passthrough.
+func (d *snapshotDB) JustifiedCheckpoint(ctx context.Context) (*eth.Checkpoint, error) {
+	return d.current().JustifiedCheckpoint(ctx)
+}
+
+// FinalizedCheckpoint -- passthrough.
+func (d *snapshotDB) FinalizedCheckpoint(ctx context.Context) (*eth.Checkpoint, error) {
+	return d.current().FinalizedCheckpoint(ctx)
+}
+
+// ArchivedPointRoot -- passthrough.
+func (d *snapshotDB) ArchivedPointRoot(ctx context.Context, slot uint64) [32]byte {
+	return d.current().ArchivedPointRoot(ctx, slot)
+}
+
+// HasArchivedPoint -- passthrough.
+func (d *snapshotDB) HasArchivedPoint(ctx context.Context, slot uint64) bool {
+	return d.current().HasArchivedPoint(ctx, slot)
+}
+
+// LastArchivedRoot -- passthrough.
+func (d *snapshotDB) LastArchivedRoot(ctx context.Context) [32]byte {
+	return d.current().LastArchivedRoot(ctx)
+}
+
+// LastArchivedSlot -- passthrough.
+func (d *snapshotDB) LastArchivedSlot(ctx context.Context) (uint64, error) {
+	return d.current().LastArchivedSlot(ctx)
+}
+
+// DepositContractAddress -- passthrough.
+func (d *snapshotDB) DepositContractAddress(ctx context.Context) ([]byte, error) {
+	return d.current().DepositContractAddress(ctx)
+}
+
+// PowchainData -- passthrough.
+func (d *snapshotDB) PowchainData(ctx context.Context) (*db.ETH1ChainData, error) {
+	return d.current().PowchainData(ctx)
+}
+
+// InitialSyncProgress -- passthrough.
+func (d *snapshotDB) InitialSyncProgress(ctx context.Context) (*db.InitialSyncProgress, error) {
+	return d.current().InitialSyncProgress(ctx)
+}
+
+// SeenAttestations -- passthrough.
+func (d *snapshotDB) SeenAttestations(ctx context.Context, startEpoch uint64) ([][]byte, error) {
+	return d.current().SeenAttestations(ctx, startEpoch)
+}
+
+// SaveBlock -- not allowed, the database of a replica is read-only.
+func (d *snapshotDB) SaveBlock(_ context.Context, _ *eth.SignedBeaconBlock) error {
+	return errReadOnly
+}
+
+// SaveBlocks -- not allowed, the database of a replica is read-only.
+func (d *snapshotDB) SaveBlocks(_ context.Context, _ []*eth.SignedBeaconBlock) error {
+	return errReadOnly
+}
+
+// SaveBackfilledBlocks -- not allowed, the database of a replica is read-only.
+func (d *snapshotDB) SaveBackfilledBlocks(_ context.Context, _ []*eth.SignedBeaconBlock, _ [32]byte) error {
+	return errReadOnly
+}
+
+// SaveGenesisBlockRoot -- not allowed, the database of a replica is read-only.
+func (d *snapshotDB) SaveGenesisBlockRoot(_ context.Context, _ [32]byte) error {
+	return errReadOnly
+}
+
+// SaveState -- not allowed, the database of a replica is read-only.
+func (d *snapshotDB) SaveState(_ context.Context, _ *state.BeaconState, _ [32]byte) error {
+	return errReadOnly
+}
+
+// SaveStates -- not allowed, the database of a replica is read-only.
+func (d *snapshotDB) SaveStates(_ context.Context, _ []*state.BeaconState, _ [][32]byte) error {
+	return errReadOnly
+}
+
+// DeleteState -- not allowed, the database of a replica is read-only.
+func (d *snapshotDB) DeleteState(_ context.Context, _ [32]byte) error {
+	return errReadOnly
+}
+
+// DeleteStates -- not allowed, the database of a replica is read-only.
+func (d *snapshotDB) DeleteStates(_ context.Context, _ [][32]byte) error {
+	return errReadOnly
+}
+
+// SaveStateSummary -- not allowed, the database of a replica is read-only.
+func (d *snapshotDB) SaveStateSummary(_ context.Context, _ *pb.StateSummary) error {
+	return errReadOnly
+}
+
+// SaveStateSummaries -- not allowed, the database of a replica is read-only.
+func (d *snapshotDB) SaveStateSummaries(_ context.Context, _ []*pb.StateSummary) error {
+	return errReadOnly
+}
+
+// SaveProposerSlashing -- not allowed, the database of a replica is read-only.
+func (d *snapshotDB) SaveProposerSlashing(_ context.Context, _ *eth.ProposerSlashing) error {
+	return errReadOnly
+}
+
+// SaveAttesterSlashing -- not allowed, the database of a replica is read-only.
+func (d *snapshotDB) SaveAttesterSlashing(_ context.Context, _ *eth.AttesterSlashing) error {
+	return errReadOnly
+}
+
+// SaveVoluntaryExit -- not allowed, the database of a replica is read-only.
+func (d *snapshotDB) SaveVoluntaryExit(_ context.Context, _ *eth.VoluntaryExit) error {
+	return errReadOnly
+}
+
+// SaveLocalOperation -- not allowed, the database of a replica is read-only.
+func (d *snapshotDB) SaveLocalOperation(_ context.Context, _ [32]byte, _ *db.LocalOperation) error {
+	return errReadOnly
+}
+
+// DeleteLocalOperation -- not allowed, the database of a replica is read-only.
+func (d *snapshotDB) DeleteLocalOperation(_ context.Context, _ [32]byte) error {
+	return errReadOnly
+}
+
+// SaveJustifiedCheckpoint -- not allowed, the database of a replica is read-only.
+func (d *snapshotDB) SaveJustifiedCheckpoint(_ context.Context, _ *eth.Checkpoint) error {
+	return errReadOnly
+}
+
+// SaveFinalizedCheckpoint -- not allowed, the database of a replica is read-only.
+func (d *snapshotDB) SaveFinalizedCheckpoint(_ context.Context, _ *eth.Checkpoint) error {
+	return errReadOnly
+}
+
+// SaveDepositContractAddress -- not allowed, the database of a replica is read-only.
+func (d *snapshotDB) SaveDepositContractAddress(_ context.Context, _ common.Address) error {
+	return errReadOnly
+}
+
+// SavePowchainData -- not allowed, the database of a replica is read-only.
+func (d *snapshotDB) SavePowchainData(_ context.Context, _ *db.ETH1ChainData) error {
+	return errReadOnly
+}
+
+// SaveInitialSyncProgress -- not allowed, the database of a replica is read-only.
+func (d *snapshotDB) SaveInitialSyncProgress(_ context.Context, _ *db.InitialSyncProgress) error {
+	return errReadOnly
+}
+
+// DeleteInitialSyncProgress -- not allowed, the database of a replica is read-only.
+func (d *snapshotDB) DeleteInitialSyncProgress(_ context.Context) error {
+	return errReadOnly
+}
+
+// SaveSeenAttestations -- not allowed, the database of a replica is read-only.
+func (d *snapshotDB) SaveSeenAttestations(_ context.Context, _ uint64, _ [][]byte) error {
+	return errReadOnly
+}
+
+// DeleteSeenAttestationsBefore -- not allowed, the database of a replica is read-only.
+func (d *snapshotDB) DeleteSeenAttestationsBefore(_ context.Context, _ uint64) error {
+	return errReadOnly
+}
+
+// RunMigrations -- not allowed, the database of a replica is read-only.
+func (d *snapshotDB) RunMigrations(_ context.Context) error {
+	return errReadOnly
+}
+
+// CleanUpDirtyStates -- a no-op, a replica has no states of its own to clean up.
+func (d *snapshotDB) CleanUpDirtyStates(_ context.Context, _ uint64) error {
+	return nil
+}
+
+// HeadBlock -- passthrough.
+func (d *snapshotDB) HeadBlock(ctx context.Context) (*eth.SignedBeaconBlock, error) {
+	return d.current().HeadBlock(ctx)
+}
+
+// SaveHeadBlockRoot -- not allowed, the database of a replica is read-only.
+func (d *snapshotDB) SaveHeadBlockRoot(_ context.Context, _ [32]byte) error {
+	return errReadOnly
+}
@@ -0,0 +1,75 @@
+package replica
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// saveHead saves a head block at the slot along with its state.
+func saveHead(t *testing.T, beaconDB db.Database, slot uint64) [32]byte {
+	ctx := context.Background()
+	b := testutil.NewBeaconBlock()
+	b.Block.Slot = slot
+	require.NoError(t, beaconDB.SaveBlock(ctx, b))
+	root, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	st := testutil.NewBeaconState()
+	require.NoError(t, st.SetSlot(slot))
+	require.NoError(t, beaconDB.SaveState(ctx, st, root))
+	require.NoError(t, beaconDB.SaveHeadBlockRoot(ctx, root))
+	return root
+}
+
+// writeSnapshot writes a snapshot of the database to the directory, dated after the previous one.
+func writeSnapshot(t *testing.T, beaconDB db.Database, dir string, modTime time.Time) {
+	require.NoError(t, beaconDB.Snapshot(context.Background(), dir))
+	require.NoError(t, os.Chtimes(kv.SnapshotPath(dir), modTime, modTime))
+}
+
+func TestSnapshotDB_Reload(t *testing.T) {
+	ctx := context.Background()
+	beaconDB, _ := testDB.SetupDB(t)
+	dir := filepath.Join(t.TempDir(), "snapshots")
+
+	_, err := openSnapshotDB(dir, cache.NewStateSummaryCache())
+	assert.ErrorContains(t, "no database snapshot", err)
+
+	firstRoot := saveHead(t, beaconDB, 10)
+	modTime := time.Now().Add(-time.Minute)
+	writeSnapshot(t, beaconDB, dir, modTime)
+	d, err := openSnapshotDB(dir, cache.NewStateSummaryCache())
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, d.Close())
+	}()
+	head, err := d.HeadBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10), head.Block.Slot)
+	assert.Equal(t, errReadOnly, d.SaveHeadBlockRoot(ctx, firstRoot))
+
+	// The same snapshot is not opened again.
+	reloaded, err := d.reload()
+	require.NoError(t, err)
+	assert.Equal(t, false, reloaded)
+
+	saveHead(t, beaconDB, 20)
+	writeSnapshot(t, beaconDB, dir, modTime.Add(time.Second))
+	reloaded, err = d.reload()
+	require.NoError(t, err)
+	assert.Equal(t, true, reloaded)
+	head, err = d.HeadBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(20), head.Block.Slot)
+	assert.Equal(t, true, d.HasBlock(ctx, firstRoot))
+}
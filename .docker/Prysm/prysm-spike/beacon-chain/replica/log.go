@@ -0,0 +1,5 @@
+package replica
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "replica")
@@ -0,0 +1,34 @@
+package replica
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	snapshotSlot = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "replica_snapshot_head_slot",
+			Help: "The head slot of the last database snapshot written for API replicas.",
+		},
+	)
+	snapshotDuration = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "replica_snapshot_duration_seconds",
+			Help:    "The time it takes to write a database snapshot for API replicas.",
+			Buckets: []float64{1, 5, 15, 30, 60, 120, 300},
+		},
+	)
+	snapshotFailures = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "replica_snapshot_failures_total",
+			Help: "The number of database snapshots for API replicas which could not be written.",
+		},
+	)
+	replicaLagSlots = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "replica_lag_slots",
+			Help: "The number of slots the head of the API replica is behind the head of the primary.",
+		},
+	)
+)
@@ -0,0 +1,342 @@
+// Package replica runs a beacon node API replica, which serves the read-only beacon chain gRPC
+// endpoints from snapshots of the database of a primary beacon node, so explorers and analytics
+// do not load the node running the validators. The primary writes the snapshots with the
+// SnapshotService when replicas request them, and the replica opens each newer snapshot as it is
+// written.
+package replica
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
+	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
+	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/beacon"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/plugin/ocgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// servedMethods are the gRPC methods the replica serves. They only read the database and the head
+// state, and the replica holds no operation pools or peers for the others.
+var servedMethods = map[string]bool{
+	"/ethereum.eth.v1alpha1.BeaconChain/ListBlocks":                    true,
+	"/ethereum.eth.v1alpha1.BeaconChain/GetChainHead":                  true,
+	"/ethereum.eth.v1alpha1.BeaconChain/ListAttestations":              true,
+	"/ethereum.eth.v1alpha1.BeaconChain/ListIndexedAttestations":       true,
+	"/ethereum.eth.v1alpha1.BeaconChain/ListBeaconCommittees":          true,
+	"/ethereum.eth.v1alpha1.BeaconChain/ListValidatorBalances":         true,
+	"/ethereum.eth.v1alpha1.BeaconChain/ListValidators":                true,
+	"/ethereum.eth.v1alpha1.BeaconChain/GetValidator":                  true,
+	"/ethereum.eth.v1alpha1.BeaconChain/GetValidatorActiveSetChanges":  true,
+	"/ethereum.eth.v1alpha1.BeaconChain/GetValidatorParticipation":     true,
+	"/ethereum.eth.v1alpha1.BeaconChain/GetValidatorQueue":             true,
+	"/ethereum.eth.v1alpha1.BeaconChain/ListValidatorAssignments":      true,
+	"/ethereum.eth.v1alpha1.BeaconChain/GetIndividualVotes":            true,
+	"/ethereum.eth.v1alpha1.BeaconChain/GetBeaconConfig":               true,
+	"/ethereum.eth.v1alpha1.BeaconChain/GetWeakSubjectivityCheckpoint": true,
+}
+
+// Config of the API replica.
+type Config struct {
+	// SnapshotDir is the directory the primary writes its database snapshots to.
+	SnapshotDir string
+	// PrimaryRPCProvider is the gRPC endpoint of the primary, whose head the lag is measured
+	// against. Without it, the lag is measured against the current slot.
+	PrimaryRPCProvider string
+	PrimaryCert        string
+	Host               string
+	Port               string
+	CertFlag           string
+	KeyFlag            string
+	MaxMsgSize         int
+}
+
+// Server of the API replica.
+type Server struct {
+	ctx             context.Context
+	cancel          context.CancelFunc
+	cfg             *Config
+	db              *snapshotDB
+	chainInfo       *chainInfo
+	grpcServer      *grpc.Server
+	listener        net.Listener
+	primaryHeadSlot uint64 // Accessed atomically, zero until a head event of the primary is received.
+	served          uint32 // Accessed atomically, 1 when requests were served since a snapshot was last requested.
+}
+
+// New opens the latest snapshot in the snapshot directory and loads its head.
+func New(ctx context.Context, cfg *Config) (*Server, error) {
+	stateSummaryCache := cache.NewStateSummaryCache()
+	d, err := openSnapshotDB(cfg.SnapshotDir, stateSummaryCache)
+	if err != nil {
+		return nil, err
+	}
+	ci := &chainInfo{
+		db:       d,
+		stateGen: stategen.New(d, stateSummaryCache),
+	}
+	if err := ci.update(ctx); err != nil {
+		if closeErr := d.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Could not close database snapshot")
+		}
+		return nil, errors.Wrap(err, "could not load head of database snapshot")
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	return &Server{
+		ctx:       ctx,
+		cancel:    cancel,
+		cfg:       cfg,
+		db:        d,
+		chainInfo: ci,
+	}, nil
+}
+
+// Start serving the gRPC endpoints and following the snapshots and the head of the primary.
+func (s *Server) Start() error {
+	address := fmt.Sprintf("%s:%s", s.cfg.Host, s.cfg.Port)
+	lis, err := net.Listen("tcp", address)
+	if err != nil {
+		return errors.Wrapf(err, "could not listen to %s", address)
+	}
+	s.listener = lis
+
+	opts := []grpc.ServerOption{
+		grpc.StatsHandler(&ocgrpc.ServerHandler{}),
+		grpc.StreamInterceptor(middleware.ChainStreamServer(
+			recovery.StreamServerInterceptor(
+				recovery.WithRecoveryHandlerContext(traceutil.RecoveryHandlerFunc),
+			),
+			grpc_prometheus.StreamServerInterceptor,
+			grpc_opentracing.StreamServerInterceptor(),
+			s.servedMethodsStreamInterceptor,
+		)),
+		grpc.UnaryInterceptor(middleware.ChainUnaryServer(
+			recovery.UnaryServerInterceptor(
+				recovery.WithRecoveryHandlerContext(traceutil.RecoveryHandlerFunc),
+			),
+			grpc_prometheus.UnaryServerInterceptor,
+			grpc_opentracing.UnaryServerInterceptor(),
+			s.servedMethodsUnaryInterceptor,
+			s.freshnessUnaryInterceptor,
+		)),
+		grpc.MaxRecvMsgSize(s.cfg.MaxMsgSize),
+	}
+	grpc_prometheus.EnableHandlingTimeHistogram()
+	if s.cfg.CertFlag != "" && s.cfg.KeyFlag != "" {
+		creds, err := credentials.NewServerTLSFromFile(s.cfg.CertFlag, s.cfg.KeyFlag)
+		if err != nil {
+			return errors.Wrap(err, "could not load TLS keys")
+		}
+		opts = append(opts, grpc.Creds(creds))
+	}
+	s.grpcServer = grpc.NewServer(opts...)
+	beaconChainServer := &beacon.Server{
+		Ctx:                 s.ctx,
+		BeaconDB:            s.db,
+		HeadFetcher:         s.chainInfo,
+		FinalizationFetcher: s.chainInfo,
+		GenesisTimeFetcher:  s.chainInfo,
+		StateGen:            s.chainInfo.stateGen,
+		HashTreeRootCache:   cache.NewHashTreeRootCache(),
+	}
+	ethpb.RegisterBeaconChainServer(s.grpcServer, beaconChainServer)
+
+	go func() {
+		if err := s.grpcServer.Serve(s.listener); err != nil {
+			log.Errorf("Could not serve gRPC: %v", err)
+		}
+	}()
+	go s.followSnapshots()
+	if s.cfg.PrimaryRPCProvider != "" {
+		go s.followPrimary()
+	}
+	log.WithFields(logrus.Fields{
+		"address":  address,
+		"headSlot": s.chainInfo.HeadSlot(),
+	}).Info("API replica serving database snapshot")
+	return nil
+}
+
+// Stop serving and close the open snapshot.
+func (s *Server) Stop() error {
+	s.cancel()
+	if s.grpcServer != nil {
+		s.grpcServer.GracefulStop()
+	}
+	return s.db.Close()
+}
+
+// followSnapshots opens the snapshot written by the primary every slot it changed, and requests a
+// newer snapshot when requests were served from the open one.
+func (s *Server) followSnapshots() {
+	ticker := time.NewTicker(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			s.requestSnapshotIfServed()
+			reloaded, err := s.db.reload()
+			if err != nil {
+				log.WithError(err).Error("Could not open newer database snapshot")
+				continue
+			}
+			if !reloaded {
+				continue
+			}
+			if err := s.chainInfo.update(s.ctx); err != nil {
+				log.WithError(err).Error("Could not load head of database snapshot")
+				continue
+			}
+			log.WithField("headSlot", s.chainInfo.HeadSlot()).Debug("Opened newer database snapshot")
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// requestSnapshotIfServed requests a newer snapshot from the primary when requests were served
+// since the last request, so the primary does not copy its database for idle replicas.
+func (s *Server) requestSnapshotIfServed() {
+	if !atomic.CompareAndSwapUint32(&s.served, 1, 0) {
+		return
+	}
+	if err := requestSnapshot(s.cfg.SnapshotDir); err != nil {
+		log.WithError(err).Error("Could not request a newer database snapshot")
+	}
+}
+
+// followPrimary streams the head events of the primary to measure the lag of the replica, opening
+// the stream again a slot after it ends.
+func (s *Server) followPrimary() {
+	dialOpt := grpc.WithInsecure()
+	if s.cfg.PrimaryCert != "" {
+		creds, err := credentials.NewClientTLSFromFile(s.cfg.PrimaryCert, "")
+		if err != nil {
+			log.WithError(err).Error("Could not get valid credentials for the primary beacon node")
+			return
+		}
+		dialOpt = grpc.WithTransportCredentials(creds)
+	}
+	conn, err := grpc.DialContext(s.ctx, s.cfg.PrimaryRPCProvider, dialOpt, grpc.WithStatsHandler(&ocgrpc.ClientHandler{}))
+	if err != nil {
+		log.WithError(err).Error("Could not dial the primary beacon node")
+		return
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			log.WithError(err).Error("Could not close connection to the primary beacon node")
+		}
+	}()
+	client := pbrpc.NewHealthClient(conn)
+	retryInterval := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	for {
+		if err := s.streamPrimaryHead(client); err != nil && s.ctx.Err() == nil {
+			log.WithError(err).Debug("Head event stream of the primary beacon node ended, opening it again")
+		}
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-time.After(retryInterval):
+		}
+	}
+}
+
+func (s *Server) streamPrimaryHead(client pbrpc.HealthClient) error {
+	stream, err := client.StreamHeadEvents(s.ctx, &ptypes.Empty{})
+	if err != nil {
+		return err
+	}
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		atomic.StoreUint64(&s.primaryHeadSlot, event.Slot)
+	}
+}
+
+// lagSlots returns how many slots the head of the replica is behind the head of the primary, or
+// behind the current slot when no head of the primary was received.
+func (s *Server) lagSlots() (headSlot, primaryHeadSlot, lag uint64) {
+	headSlot = s.chainInfo.HeadSlot()
+	primaryHeadSlot = atomic.LoadUint64(&s.primaryHeadSlot)
+	target := primaryHeadSlot
+	if target == 0 {
+		target = s.chainInfo.CurrentSlot()
+	}
+	if target > headSlot {
+		lag = target - headSlot
+	}
+	replicaLagSlots.Set(float64(lag))
+	return headSlot, primaryHeadSlot, lag
+}
+
+// Unary interceptor returning the freshness of the response in its headers.
+func (s *Server) freshnessUnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	atomic.StoreUint32(&s.served, 1)
+	headSlot, primaryHeadSlot, lag := s.lagSlots()
+	md := metadata.Pairs(
+		grpcutils.ReplicaHeadSlotHeader, strconv.FormatUint(headSlot, 10),
+		grpcutils.ReplicaLagSlotsHeader, strconv.FormatUint(lag, 10),
+		grpcutils.ReplicaSnapshotTimeHeader, s.db.snapshotTime().UTC().Format(time.RFC3339),
+	)
+	if primaryHeadSlot != 0 {
+		md.Set(grpcutils.ReplicaPrimaryHeadSlotHeader, strconv.FormatUint(primaryHeadSlot, 10))
+	}
+	if err := grpc.SetHeader(ctx, md); err != nil {
+		log.WithError(err).Debug("Could not set freshness headers")
+	}
+	return handler(ctx, req)
+}
+
+// Unary interceptor rejecting the methods the replica does not serve.
+func (s *Server) servedMethodsUnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if !servedMethods[info.FullMethod] {
+		return nil, status.Errorf(codes.Unimplemented, "%s is not served by the API replica, use the primary beacon node", info.FullMethod)
+	}
+	return handler(ctx, req)
+}
+
+// Stream interceptor rejecting the streams, which follow the head of the primary the replica does
+// not process.
+func (s *Server) servedMethodsStreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	if !servedMethods[info.FullMethod] {
+		return status.Errorf(codes.Unimplemented, "%s is not served by the API replica, use the primary beacon node", info.FullMethod)
+	}
+	return handler(srv, ss)
+}
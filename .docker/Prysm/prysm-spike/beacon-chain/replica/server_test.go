@@ -0,0 +1,127 @@
+package replica
+
+import (
+	"context"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// headerStream records the headers set by unary interceptors.
+type headerStream struct {
+	grpc.ServerTransportStream
+	header metadata.MD
+}
+
+func (s *headerStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func TestServer_FollowsSnapshots(t *testing.T) {
+	ctx := context.Background()
+	beaconDB, _ := testDB.SetupDB(t)
+	dir := filepath.Join(t.TempDir(), "snapshots")
+	headRoot := saveHead(t, beaconDB, 10)
+	modTime := time.Now().Add(-time.Minute)
+	writeSnapshot(t, beaconDB, dir, modTime)
+
+	s, err := New(ctx, &Config{SnapshotDir: dir})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, s.Stop())
+	}()
+	assert.Equal(t, uint64(10), s.chainInfo.HeadSlot())
+	root, err := s.chainInfo.HeadRoot(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, headRoot[:], root)
+	headState, err := s.chainInfo.HeadState(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10), headState.Slot())
+
+	saveHead(t, beaconDB, 12)
+	writeSnapshot(t, beaconDB, dir, modTime.Add(time.Second))
+	reloaded, err := s.db.reload()
+	require.NoError(t, err)
+	require.Equal(t, true, reloaded)
+	require.NoError(t, s.chainInfo.update(ctx))
+	assert.Equal(t, uint64(12), s.chainInfo.HeadSlot())
+}
+
+func TestServer_FreshnessUnaryInterceptor(t *testing.T) {
+	ctx := context.Background()
+	beaconDB, _ := testDB.SetupDB(t)
+	dir := filepath.Join(t.TempDir(), "snapshots")
+	saveHead(t, beaconDB, 10)
+	modTime := time.Date(2020, 11, 1, 12, 0, 0, 0, time.UTC)
+	writeSnapshot(t, beaconDB, dir, modTime)
+	s, err := New(ctx, &Config{SnapshotDir: dir})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, s.Stop())
+	}()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+
+	// Without the head of the primary, the lag is measured against the current slot.
+	stream := &headerStream{}
+	resp, err := s.freshnessUnaryInterceptor(grpc.NewContextWithServerTransportStream(ctx, stream), nil, &grpc.UnaryServerInfo{}, handler)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+	assert.DeepEqual(t, []string{"10"}, stream.header.Get(grpcutils.ReplicaHeadSlotHeader))
+	assert.DeepEqual(t, []string{"2020-11-01T12:00:00Z"}, stream.header.Get(grpcutils.ReplicaSnapshotTimeHeader))
+	assert.Equal(t, 0, len(stream.header.Get(grpcutils.ReplicaPrimaryHeadSlotHeader)))
+	assert.Equal(t, 1, len(stream.header.Get(grpcutils.ReplicaLagSlotsHeader)))
+
+	s.primaryHeadSlot = 10 + params.BeaconConfig().SlotsPerEpoch
+	stream = &headerStream{}
+	_, err = s.freshnessUnaryInterceptor(grpc.NewContextWithServerTransportStream(ctx, stream), nil, &grpc.UnaryServerInfo{}, handler)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []string{"42"}, stream.header.Get(grpcutils.ReplicaPrimaryHeadSlotHeader))
+	assert.DeepEqual(t, []string{"32"}, stream.header.Get(grpcutils.ReplicaLagSlotsHeader))
+}
+
+func TestServer_ServedMethodsUnaryInterceptor(t *testing.T) {
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	s := &Server{}
+	resp, err := s.servedMethodsUnaryInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{
+		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/ListValidators",
+	}, handler)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+
+	_, err = s.servedMethodsUnaryInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{
+		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/SubmitAttesterSlashing",
+	}, handler)
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+func TestServer_RequestsSnapshotWhenServed(t *testing.T) {
+	dir := t.TempDir()
+	s := &Server{cfg: &Config{SnapshotDir: dir}}
+	requestPath := filepath.Join(dir, requestFileName)
+
+	// No snapshot is requested by an idle replica.
+	s.requestSnapshotIfServed()
+	assert.Equal(t, false, fileutil.FileExists(requestPath))
+
+	atomic.StoreUint32(&s.served, 1)
+	s.requestSnapshotIfServed()
+	assert.Equal(t, true, fileutil.FileExists(requestPath))
+	assert.Equal(t, uint32(0), atomic.LoadUint32(&s.served))
+}
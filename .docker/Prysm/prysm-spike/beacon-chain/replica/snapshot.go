@@ -0,0 +1,197 @@
+package replica
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+// requestFileName is the file API replicas touch in the snapshot directory to request a snapshot
+// newer than the one they serve.
+const requestFileName = "snapshot.request"
+
+// SnapshotConfig of the snapshot service.
+type SnapshotConfig struct {
+	BeaconDB      db.Database
+	StateNotifier statefeed.Notifier
+	// Dir is where the snapshot is written for replicas to open.
+	Dir string
+	// Epochs is the minimum number of epochs of heads between snapshots.
+	Epochs uint64
+	// Paused returns whether snapshots are paused, such as when free disk space is low.
+	Paused func() bool
+}
+
+// SnapshotService runs on the primary beacon node, and writes snapshots of its database for API
+// replicas as the head of the chain advances. A snapshot copies the whole database, so one is only
+// written when an API replica requested a newer one or no snapshot exists yet, at most once every
+// configured number of epochs. A snapshot is written in the background, and heads received while
+// one is written do not start another.
+type SnapshotService struct {
+	ctx        context.Context
+	cancel     context.CancelFunc
+	cfg        *SnapshotConfig
+	inProgress chan struct{}
+	wg         sync.WaitGroup
+	lock       sync.RWMutex
+	written    bool
+	lastEpoch  uint64
+	lastErr    error
+}
+
+// NewSnapshotService creates a service writing database snapshots for API replicas.
+func NewSnapshotService(ctx context.Context, cfg *SnapshotConfig) *SnapshotService {
+	ctx, cancel := context.WithCancel(ctx)
+	if cfg.Epochs == 0 {
+		cfg.Epochs = 1
+	}
+	return &SnapshotService{
+		ctx:        ctx,
+		cancel:     cancel,
+		cfg:        cfg,
+		inProgress: make(chan struct{}, 1),
+	}
+}
+
+// Start writing snapshots on new heads.
+func (s *SnapshotService) Start() {
+	log.WithFields(logrus.Fields{
+		"dir":    s.cfg.Dir,
+		"epochs": s.cfg.Epochs,
+	}).Info("Writing database snapshots for API replicas")
+	go s.run()
+}
+
+// Stop the service, waiting for the snapshot being written.
+func (s *SnapshotService) Stop() error {
+	s.cancel()
+	s.wg.Wait()
+	return nil
+}
+
+// Status returns the error of the last snapshot written, if it failed.
+func (s *SnapshotService) Status() error {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.lastErr
+}
+
+func (s *SnapshotService) run() {
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.cfg.StateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	for {
+		select {
+		case event := <-stateChannel:
+			if event.Type != statefeed.NewHead {
+				continue
+			}
+			data, ok := event.Data.(*statefeed.NewHeadData)
+			if !ok {
+				continue
+			}
+			s.onNewHead(data.Slot)
+		case err := <-stateSub.Err():
+			log.WithError(err).Error("Could not subscribe to state notifier")
+			return
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// onNewHead starts writing a snapshot when one was requested, the head reached the epoch of the
+// next snapshot and no snapshot is being written.
+func (s *SnapshotService) onNewHead(slot uint64) {
+	epoch := helpers.SlotToEpoch(slot)
+	if s.written && epoch < s.lastEpoch+s.cfg.Epochs {
+		return
+	}
+	if !s.requested() {
+		return
+	}
+	if s.cfg.Paused != nil && s.cfg.Paused() {
+		log.WithField("headSlot", slot).Debug("Database snapshots paused")
+		return
+	}
+	select {
+	case s.inProgress <- struct{}{}:
+	default:
+		return
+	}
+	s.written = true
+	s.lastEpoch = epoch
+	s.wg.Add(1)
+	go func() {
+		defer func() {
+			<-s.inProgress
+			s.wg.Done()
+		}()
+		s.writeSnapshot(slot)
+	}()
+}
+
+// requested returns whether an API replica requested a snapshot after the last one was written, or
+// no snapshot was written yet.
+func (s *SnapshotService) requested() bool {
+	snapshot, err := os.Stat(kv.SnapshotPath(s.cfg.Dir))
+	if os.IsNotExist(err) {
+		return true
+	}
+	if err != nil {
+		log.WithError(err).Error("Could not read database snapshot")
+		return false
+	}
+	request, err := os.Stat(filepath.Join(s.cfg.Dir, requestFileName))
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.WithError(err).Error("Could not read database snapshot request")
+		}
+		return false
+	}
+	return request.ModTime().After(snapshot.ModTime())
+}
+
+// requestSnapshot asks the primary writing snapshots to the directory for one newer than the
+// snapshot served.
+func requestSnapshot(dir string) error {
+	path := filepath.Join(dir, requestFileName)
+	now := time.Now()
+	err := os.Chtimes(path, now, now)
+	if os.IsNotExist(err) {
+		return ioutil.WriteFile(path, nil, params.BeaconIoConfig().ReadWritePermissions)
+	}
+	return err
+}
+
+func (s *SnapshotService) writeSnapshot(slot uint64) {
+	start := time.Now()
+	err := s.cfg.BeaconDB.Snapshot(s.ctx, s.cfg.Dir)
+	s.lock.Lock()
+	s.lastErr = err
+	s.lock.Unlock()
+	if err != nil {
+		if s.ctx.Err() == nil {
+			snapshotFailures.Inc()
+			log.WithError(err).Error("Could not write database snapshot")
+		}
+		return
+	}
+	snapshotDuration.Observe(time.Since(start).Seconds())
+	snapshotSlot.Set(float64(slot))
+	log.WithFields(logrus.Fields{
+		"headSlot": slot,
+		"duration": time.Since(start),
+	}).Debug("Wrote database snapshot")
+}
@@ -0,0 +1,89 @@
+package replica
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestSnapshotService_OnNewHead(t *testing.T) {
+	beaconDB, _ := testDB.SetupDB(t)
+	dir := filepath.Join(t.TempDir(), "snapshots")
+	s := NewSnapshotService(context.Background(), &SnapshotConfig{
+		BeaconDB: beaconDB,
+		Dir:      dir,
+		Epochs:   2,
+	})
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+
+	// The first head writes a snapshot, as none exists yet.
+	s.onNewHead(slotsPerEpoch + 1)
+	s.wg.Wait()
+	require.NoError(t, s.Status())
+	assert.Equal(t, true, fileutil.FileExists(kv.SnapshotPath(dir)))
+	assert.Equal(t, uint64(1), s.lastEpoch)
+	written := time.Now().Add(-time.Minute)
+	require.NoError(t, os.Chtimes(kv.SnapshotPath(dir), written, written))
+
+	// Heads are skipped until a replica requests a snapshot.
+	s.onNewHead(4 * slotsPerEpoch)
+	s.wg.Wait()
+	assert.Equal(t, uint64(1), s.lastEpoch)
+	require.NoError(t, requestSnapshot(dir))
+
+	// Heads of the next epoch are skipped.
+	s.onNewHead(2*slotsPerEpoch + 1)
+	s.wg.Wait()
+	assert.Equal(t, uint64(1), s.lastEpoch)
+
+	// Heads are skipped while a snapshot is written.
+	s.inProgress <- struct{}{}
+	s.onNewHead(3 * slotsPerEpoch)
+	s.wg.Wait()
+	assert.Equal(t, uint64(1), s.lastEpoch)
+	<-s.inProgress
+
+	s.onNewHead(3*slotsPerEpoch + 1)
+	s.wg.Wait()
+	require.NoError(t, s.Status())
+	assert.Equal(t, uint64(3), s.lastEpoch)
+	info, err := os.Stat(kv.SnapshotPath(dir))
+	require.NoError(t, err)
+	assert.Equal(t, true, info.ModTime().After(written), "Expected a newer snapshot")
+
+	// The request was answered by the snapshot.
+	s.onNewHead(6 * slotsPerEpoch)
+	s.wg.Wait()
+	assert.Equal(t, uint64(3), s.lastEpoch)
+	require.NoError(t, s.Stop())
+}
+
+func TestSnapshotService_OnNewHead_Paused(t *testing.T) {
+	beaconDB, _ := testDB.SetupDB(t)
+	dir := filepath.Join(t.TempDir(), "snapshots")
+	paused := true
+	s := NewSnapshotService(context.Background(), &SnapshotConfig{
+		BeaconDB: beaconDB,
+		Dir:      dir,
+		Paused:   func() bool { return paused },
+	})
+
+	s.onNewHead(1)
+	s.wg.Wait()
+	assert.Equal(t, false, fileutil.FileExists(kv.SnapshotPath(dir)))
+
+	paused = false
+	s.onNewHead(2)
+	s.wg.Wait()
+	assert.Equal(t, true, fileutil.FileExists(kv.SnapshotPath(dir)))
+	require.NoError(t, s.Stop())
+}
@@ -58,7 +58,7 @@ func (bs *Server) ListBlocks(
 		returnedBlks := blks[start:end]
 		containers := make([]*ethpb.BeaconBlockContainer, len(returnedBlks))
 		for i, b := range returnedBlks {
-			root, err := b.Block.HashTreeRoot()
+			root, err := bs.HashTreeRootCache.HashTreeRoot(b.Block)
 			if err != nil {
 				return nil, err
 			}
@@ -85,7 +85,7 @@ func (bs *Server) ListBlocks(
 				NextPageToken:   strconv.Itoa(0),
 			}, nil
 		}
-		root, err := blk.Block.HashTreeRoot()
+		root, err := bs.HashTreeRootCache.HashTreeRoot(blk.Block)
 		if err != nil {
 			return nil, err
 		}
@@ -121,7 +121,7 @@ func (bs *Server) ListBlocks(
 		returnedBlks := blks[start:end]
 		containers := make([]*ethpb.BeaconBlockContainer, len(returnedBlks))
 		for i, b := range returnedBlks {
-			root, err := b.Block.HashTreeRoot()
+			root, err := bs.HashTreeRootCache.HashTreeRoot(b.Block)
 			if err != nil {
 				return nil, err
 			}
@@ -144,7 +144,7 @@ func (bs *Server) ListBlocks(
 		if genBlk == nil {
 			return nil, status.Error(codes.Internal, "Could not find genesis block")
 		}
-		root, err := genBlk.Block.HashTreeRoot()
+		root, err := bs.HashTreeRootCache.HashTreeRoot(genBlk.Block)
 		if err != nil {
 			return nil, err
 		}
@@ -252,7 +252,7 @@ func (bs *Server) chainHeadRetrieval(ctx context.Context) (*ethpb.ChainHead, err
 	if headBlock == nil || headBlock.Block == nil {
 		return nil, status.Error(codes.Internal, "Head block of chain was nil")
 	}
-	headBlockRoot, err := headBlock.Block.HashTreeRoot()
+	headBlockRoot, err := bs.HashTreeRootCache.HashTreeRoot(headBlock.Block)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not get head block root: %v", err)
 	}
@@ -342,7 +342,7 @@ func (bs *Server) GetWeakSubjectivityCheckpoint(ctx context.Context, _ *ptypes.E
 	if err != nil {
 		return nil, status.Error(codes.Internal, "Could not get weak subjectivity state root")
 	}
-	blkRoot, err := wsState.LatestBlockHeader().HashTreeRoot()
+	blkRoot, err := bs.HashTreeRootCache.HashTreeRoot(wsState.LatestBlockHeader())
 	if err != nil {
 		return nil, status.Error(codes.Internal, "Could not get weak subjectivity block root")
 	}
@@ -0,0 +1,123 @@
+package beacon
+
+import (
+	"bytes"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// blockFilter decides which blocks are sent to a subscriber of the filtered block stream.
+type blockFilter struct {
+	proposers      map[uint64]bool
+	slotRanges     []*pbrpc.SlotRange
+	graffitiPrefix []byte
+}
+
+// newBlockFilter validates the filters of a request and builds a block filter out of them.
+func newBlockFilter(req *pbrpc.BlockFilterRequest) (*blockFilter, error) {
+	if len(req.GraffitiPrefix) > 32 {
+		return nil, status.Errorf(codes.InvalidArgument, "Graffiti prefix of %d bytes is longer than the 32 bytes of a graffiti", len(req.GraffitiPrefix))
+	}
+	for _, r := range req.SlotRanges {
+		if r == nil {
+			return nil, status.Error(codes.InvalidArgument, "Slot range cannot be nil")
+		}
+		if r.EndSlot != 0 && r.EndSlot < r.StartSlot {
+			return nil, status.Errorf(codes.InvalidArgument, "End slot %d of slot range is before its start slot %d", r.EndSlot, r.StartSlot)
+		}
+	}
+	var proposers map[uint64]bool
+	if len(req.ProposerIndices) > 0 {
+		proposers = make(map[uint64]bool, len(req.ProposerIndices))
+		for _, idx := range req.ProposerIndices {
+			proposers[idx] = true
+		}
+	}
+	return &blockFilter{
+		proposers:      proposers,
+		slotRanges:     req.SlotRanges,
+		graffitiPrefix: req.GraffitiPrefix,
+	}, nil
+}
+
+// matches returns true if the block satisfies every filter which is set.
+func (f *blockFilter) matches(blk *ethpb.BeaconBlock) bool {
+	if f.proposers != nil && !f.proposers[blk.ProposerIndex] {
+		return false
+	}
+	if len(f.slotRanges) > 0 {
+		inRange := false
+		for _, r := range f.slotRanges {
+			if blk.Slot >= r.StartSlot && (r.EndSlot == 0 || blk.Slot <= r.EndSlot) {
+				inRange = true
+				break
+			}
+		}
+		if !inRange {
+			return false
+		}
+	}
+	if len(f.graffitiPrefix) > 0 {
+		if blk.Body == nil || !bytes.HasPrefix(blk.Body.Graffiti, f.graffitiPrefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// StreamFilteredBlocks to clients every time a block matching the filters of the request
+// is received by the beacon node. Filters are evaluated before signatures are verified so
+// blocks the subscriber is not interested in cost as little as possible.
+func (bs *Server) StreamFilteredBlocks(req *pbrpc.BlockFilterRequest, stream pbrpc.BlockFeed_StreamFilteredBlocksServer) error {
+	filter, err := newBlockFilter(req)
+	if err != nil {
+		return err
+	}
+	blocksChannel := make(chan *feed.Event, 1)
+	blockSub := bs.BlockNotifier.BlockFeed().Subscribe(blocksChannel)
+	defer blockSub.Unsubscribe()
+	for {
+		select {
+		case event := <-blocksChannel:
+			if event.Type != blockfeed.ReceivedBlock {
+				continue
+			}
+			data, ok := event.Data.(*blockfeed.ReceivedBlockData)
+			if !ok {
+				// Got bad data over the stream.
+				continue
+			}
+			if data.SignedBlock == nil || data.SignedBlock.Block == nil {
+				// One nil block shouldn't stop the stream.
+				continue
+			}
+			if !filter.matches(data.SignedBlock.Block) {
+				continue
+			}
+			headState, err := bs.HeadFetcher.HeadState(bs.Ctx)
+			if err != nil {
+				log.WithError(err).WithField("blockSlot", data.SignedBlock.Block.Slot).Error("Could not get head state")
+				continue
+			}
+			if err := blocks.VerifyBlockSignature(headState, data.SignedBlock); err != nil {
+				log.WithError(err).WithField("blockSlot", data.SignedBlock.Block.Slot).Error("Could not verify block signature")
+				continue
+			}
+			if err := stream.Send(data.SignedBlock); err != nil {
+				return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+			}
+		case <-blockSub.Err():
+			return status.Error(codes.Aborted, "Subscriber closed, exiting goroutine")
+		case <-bs.Ctx.Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	chainMock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+)
+
+type filteredBlocksStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *ethpb.SignedBeaconBlock
+}
+
+func (s *filteredBlocksStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *filteredBlocksStream) Send(b *ethpb.SignedBeaconBlock) error {
+	s.sent <- b
+	return nil
+}
+
+func TestBlockFilter_Matches(t *testing.T) {
+	blk := testutil.NewBeaconBlock().Block
+	blk.Slot = 10
+	blk.ProposerIndex = 3
+	copy(blk.Body.Graffiti, "devnet-operator-a")
+
+	tests := []struct {
+		name string
+		req  *pbrpc.BlockFilterRequest
+		want bool
+	}{
+		{name: "empty request", req: &pbrpc.BlockFilterRequest{}, want: true},
+		{name: "proposer in set", req: &pbrpc.BlockFilterRequest{ProposerIndices: []uint64{1, 3}}, want: true},
+		{name: "proposer not in set", req: &pbrpc.BlockFilterRequest{ProposerIndices: []uint64{1, 2}}, want: false},
+		{
+			name: "slot in one of the ranges",
+			req:  &pbrpc.BlockFilterRequest{SlotRanges: []*pbrpc.SlotRange{{StartSlot: 0, EndSlot: 5}, {StartSlot: 8, EndSlot: 10}}},
+			want: true,
+		},
+		{name: "slot in open ended range", req: &pbrpc.BlockFilterRequest{SlotRanges: []*pbrpc.SlotRange{{StartSlot: 10}}}, want: true},
+		{name: "slot outside ranges", req: &pbrpc.BlockFilterRequest{SlotRanges: []*pbrpc.SlotRange{{StartSlot: 11}}}, want: false},
+		{name: "graffiti prefix", req: &pbrpc.BlockFilterRequest{GraffitiPrefix: []byte("devnet-operator")}, want: true},
+		{name: "other graffiti prefix", req: &pbrpc.BlockFilterRequest{GraffitiPrefix: []byte("devnet-operator-b")}, want: false},
+		{
+			name: "one filter not matching",
+			req:  &pbrpc.BlockFilterRequest{ProposerIndices: []uint64{3}, GraffitiPrefix: []byte("mainnet")},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := newBlockFilter(tt.req)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, f.matches(blk))
+		})
+	}
+}
+
+func TestBlockFilter_InvalidRequest(t *testing.T) {
+	_, err := newBlockFilter(&pbrpc.BlockFilterRequest{SlotRanges: []*pbrpc.SlotRange{{StartSlot: 5, EndSlot: 4}}})
+	assert.ErrorContains(t, "is before its start slot", err)
+	_, err = newBlockFilter(&pbrpc.BlockFilterRequest{GraffitiPrefix: make([]byte, 33)})
+	assert.ErrorContains(t, "longer than the 32 bytes", err)
+}
+
+func TestServer_StreamFilteredBlocks_ContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	chainService := &chainMock.ChainService{}
+	server := &Server{
+		Ctx:           ctx,
+		BlockNotifier: chainService.BlockNotifier(),
+		HeadFetcher:   chainService,
+	}
+	stream := &filteredBlocksStream{ctx: ctx, sent: make(chan *ethpb.SignedBeaconBlock, 1)}
+	exitRoutine := make(chan bool)
+	go func(tt *testing.T) {
+		assert.ErrorContains(tt, "Context canceled", server.StreamFilteredBlocks(&pbrpc.BlockFilterRequest{}, stream))
+		<-exitRoutine
+	}(t)
+	cancel()
+	exitRoutine <- true
+}
+
+func TestServer_StreamFilteredBlocks_SendsMatchingBlocks(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	beaconState, privs := testutil.DeterministicGenesisState(t, 32)
+	b, err := testutil.GenerateFullBlock(beaconState, privs, testutil.DefaultBlockGenConfig(), 1)
+	require.NoError(t, err)
+	chainService := &chainMock.ChainService{State: beaconState}
+	server := &Server{
+		Ctx:           ctx,
+		BlockNotifier: chainService.BlockNotifier(),
+		HeadFetcher:   chainService,
+	}
+	stream := &filteredBlocksStream{ctx: ctx, sent: make(chan *ethpb.SignedBeaconBlock, 2)}
+	req := &pbrpc.BlockFilterRequest{ProposerIndices: []uint64{b.Block.ProposerIndex}}
+	go func(tt *testing.T) {
+		assert.ErrorContains(tt, "Context canceled", server.StreamFilteredBlocks(req, stream))
+	}(t)
+
+	other := testutil.NewBeaconBlock()
+	other.Block.ProposerIndex = b.Block.ProposerIndex + 1
+	// Send in a loop to ensure it is delivered (busy wait for the service to subscribe to the block feed).
+	for sent := 0; sent == 0; {
+		sent = server.BlockNotifier.BlockFeed().Send(&feed.Event{
+			Type: blockfeed.ReceivedBlock,
+			Data: &blockfeed.ReceivedBlockData{SignedBlock: other},
+		})
+	}
+	server.BlockNotifier.BlockFeed().Send(&feed.Event{
+		Type: blockfeed.ReceivedBlock,
+		Data: &blockfeed.ReceivedBlockData{SignedBlock: b},
+	})
+	assert.DeepEqual(t, b, <-stream.sent)
+}
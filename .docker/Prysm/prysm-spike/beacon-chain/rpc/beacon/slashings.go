@@ -23,6 +23,11 @@ func (bs *Server) SubmitProposerSlashing(
 	if err := bs.SlashingsPool.InsertProposerSlashing(ctx, beaconState, req); err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not insert proposer slashing into pool: %v", err)
 	}
+	if bs.LocalOperations != nil {
+		if err := bs.LocalOperations.TrackProposerSlashing(ctx, req); err != nil {
+			log.WithError(err).Error("Could not persist proposer slashing for rebroadcast")
+		}
+	}
 	if err := bs.Broadcaster.Broadcast(ctx, req); err != nil {
 		return nil, err
 	}
@@ -46,6 +51,11 @@ func (bs *Server) SubmitAttesterSlashing(
 	if err := bs.SlashingsPool.InsertAttesterSlashing(ctx, beaconState, req); err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not insert attester slashing into pool: %v", err)
 	}
+	if bs.LocalOperations != nil {
+		if err := bs.LocalOperations.TrackAttesterSlashing(ctx, req); err != nil {
+			log.WithError(err).Error("Could not persist attester slashing for rebroadcast")
+		}
+	}
 	if err := bs.Broadcaster.Broadcast(ctx, req); err != nil {
 		return nil, err
 	}
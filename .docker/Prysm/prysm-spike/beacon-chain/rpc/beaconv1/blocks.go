@@ -21,6 +21,9 @@ import (
 
 // GetBlockHeader retrieves block header for given block id.
 func (bs *Server) GetBlockHeader(ctx context.Context, req *ethpb.BlockRequest) (*ethpb.BlockHeaderResponse, error) {
+	if resp, ok := bs.cachedResponse(ctx, "GetBlockHeader", req.BlockId); ok {
+		return resp.(*ethpb.BlockHeaderResponse), nil
+	}
 	blk, err := bs.blockFromBlockID(ctx, req.BlockId)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not get block from block ID: %v", err)
@@ -47,7 +50,7 @@ func (bs *Server) GetBlockHeader(ctx context.Context, req *ethpb.BlockRequest) (
 		return nil, status.Errorf(codes.Internal, "Could not hash block header: %v", err)
 	}
 
-	return &ethpb.BlockHeaderResponse{
+	resp := &ethpb.BlockHeaderResponse{
 		Data: &ethpb.BlockHeaderContainer{
 			Root:      root[:],
 			Canonical: canonical,
@@ -56,26 +59,29 @@ func (bs *Server) GetBlockHeader(ctx context.Context, req *ethpb.BlockRequest) (
 				Signature: v1BlockHdr.Signature,
 			},
 		},
-	}, nil
+	}
+	bs.cacheFinalizedResponse(ctx, "GetBlockHeader", req.BlockId, blkRoot, resp)
+	return resp, nil
 }
 
-// ListBlockHeaders retrieves block headers matching given query. By default it will fetch current head slot blocks.
+// ListBlockHeaders retrieves block headers matching given query. Blocks are filtered by parent root when one is
+// given, and by slot otherwise, where slot 0 is the genesis slot. Clients querying the blocks of the head slot
+// look it up first, as the gateway does when the slot query parameter is omitted.
 func (bs *Server) ListBlockHeaders(ctx context.Context, req *ethpb.BlockHeadersRequest) (*ethpb.BlockHeadersResponse, error) {
-	var err error
-	var blks []*ethpb_alpha.SignedBeaconBlock
-	var blkRoots [][32]byte
-	if len(req.ParentRoot) == 32 {
-		blks, blkRoots, err = bs.BeaconDB.Blocks(ctx, filters.NewFilter().SetParentRoot(req.ParentRoot))
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "Could not retrieve blocks: %v", err)
-		}
-	} else {
-		blks, blkRoots, err = bs.BeaconDB.Blocks(ctx, filters.NewFilter().SetStartSlot(req.Slot).SetEndSlot(req.Slot))
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "Could not retrieve blocks for slot %d: %v", req.Slot, err)
-		}
+	var filter *filters.QueryFilter
+	switch {
+	case len(req.ParentRoot) == 32:
+		filter = filters.NewFilter().SetParentRoot(req.ParentRoot)
+	case len(req.ParentRoot) != 0:
+		return nil, status.Errorf(codes.InvalidArgument, "Parent root must be 32 bytes, received %d", len(req.ParentRoot))
+	default:
+		filter = filters.NewFilter().SetStartSlot(req.Slot).SetEndSlot(req.Slot)
+	}
+	blks, blkRoots, err := bs.BeaconDB.Blocks(ctx, filter)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve blocks: %v", err)
 	}
-	if blks == nil {
+	if len(blks) == 0 {
 		return nil, status.Error(codes.NotFound, "Could not find requested blocks")
 	}
 
@@ -148,6 +154,9 @@ func (bs *Server) SubmitBlock(ctx context.Context, req *ethpb.BeaconBlockContain
 
 // GetBlock retrieves block details for given block id.
 func (bs *Server) GetBlock(ctx context.Context, req *ethpb.BlockRequest) (*ethpb.BlockResponse, error) {
+	if resp, ok := bs.cachedResponse(ctx, "GetBlock", req.BlockId); ok {
+		return resp.(*ethpb.BlockResponse), nil
+	}
 	blk, err := bs.blockFromBlockID(ctx, req.BlockId)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not get block from block ID: %v", err)
@@ -161,16 +170,27 @@ func (bs *Server) GetBlock(ctx context.Context, req *ethpb.BlockRequest) (*ethpb
 		return nil, status.Errorf(codes.Internal, "Could not convert block to v1")
 	}
 
-	return &ethpb.BlockResponse{
+	resp := &ethpb.BlockResponse{
 		Data: &ethpb.BeaconBlockContainer{
 			Message:   v1Block.Block,
 			Signature: blk.Signature,
 		},
-	}, nil
+	}
+	if isImmutableBlockID(req.BlockId) {
+		blkRoot, err := blk.Block.HashTreeRoot()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not hash block: %v", err)
+		}
+		bs.cacheFinalizedResponse(ctx, "GetBlock", req.BlockId, blkRoot, resp)
+	}
+	return resp, nil
 }
 
 // GetBlockRoot retrieves hashTreeRoot of BeaconBlock/BeaconBlockHeader.
 func (bs *Server) GetBlockRoot(ctx context.Context, req *ethpb.BlockRequest) (*ethpb.BlockRootResponse, error) {
+	if resp, ok := bs.cachedResponse(ctx, "GetBlockRoot", req.BlockId); ok {
+		return resp.(*ethpb.BlockRootResponse), nil
+	}
 	var root []byte
 	var err error
 	switch string(req.BlockId) {
@@ -240,11 +260,13 @@ func (bs *Server) GetBlockRoot(ctx context.Context, req *ethpb.BlockRequest) (*e
 		}
 	}
 
-	return &ethpb.BlockRootResponse{
+	resp := &ethpb.BlockRootResponse{
 		Data: &ethpb.BlockRootContainer{
 			Root: root,
 		},
-	}, nil
+	}
+	bs.cacheFinalizedResponse(ctx, "GetBlockRoot", req.BlockId, bytesutil.ToBytes32(root), resp)
+	return resp, nil
 }
 
 // ListBlockAttestations retrieves attestation included in requested block.
@@ -240,6 +240,40 @@ func TestServer_ListBlockHeaders(t *testing.T) {
 	}
 }
 
+func TestServer_ListBlockHeaders_Filters(t *testing.T) {
+	db, _ := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	fillDBTestBlocks(ctx, t, db)
+	headState := testutil.NewBeaconState()
+	require.NoError(t, headState.SetSlot(30))
+	bs := &Server{
+		BeaconDB: db,
+		ChainInfoFetcher: &mock.ChainService{
+			DB:    db,
+			State: headState,
+		},
+	}
+
+	t.Run("slot 0 is the genesis slot", func(t *testing.T) {
+		headers, err := bs.ListBlockHeaders(ctx, &ethpb.BlockHeadersRequest{})
+		require.NoError(t, err)
+		// The genesis block and the test block of slot 0, rather than the blocks of the head slot.
+		require.Equal(t, 2, len(headers.Data))
+		for _, hdr := range headers.Data {
+			assert.Equal(t, uint64(0), hdr.Header.Message.Slot)
+		}
+	})
+	t.Run("no blocks", func(t *testing.T) {
+		_, err := bs.ListBlockHeaders(ctx, &ethpb.BlockHeadersRequest{Slot: 1000})
+		assert.ErrorContains(t, "Could not find requested blocks", err)
+	})
+	t.Run("invalid parent root", func(t *testing.T) {
+		_, err := bs.ListBlockHeaders(ctx, &ethpb.BlockHeadersRequest{ParentRoot: []byte{1, 2}})
+		assert.ErrorContains(t, "Parent root must be 32 bytes", err)
+	})
+}
+
 func TestServer_ProposeBlock_OK(t *testing.T) {
 	db, _ := dbTest.SetupDB(t)
 	ctx := context.Background()
@@ -6,11 +6,32 @@ import (
 
 	ptypes "github.com/gogo/protobuf/types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/p2putils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // GetForkSchedule retrieve all scheduled upcoming forks this node is aware of.
+// The response of this API version holds a single fork, the next scheduled one, or the
+// current fork when no fork is upcoming.
 func (bs *Server) GetForkSchedule(ctx context.Context, req *ptypes.Empty) (*ethpb.ForkScheduleResponse, error) {
-	return nil, errors.New("unimplemented")
+	currentEpoch := helpers.SlotToEpoch(bs.GenesisTimeFetcher.CurrentSlot())
+	fork := p2putils.NextFork(currentEpoch)
+	if fork == nil {
+		var err error
+		fork, err = p2putils.Fork(currentEpoch)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get current fork: %v", err)
+		}
+	}
+	return &ethpb.ForkScheduleResponse{
+		Data: &ethpb.Fork{
+			PreviousVersion: fork.PreviousVersion,
+			CurrentVersion:  fork.CurrentVersion,
+			Epoch:           fork.Epoch,
+		},
+	}, nil
 }
 
 // GetSpec retrieves specification configuration (without Phase 1 params) used on this node. Specification params list
@@ -0,0 +1,38 @@
+package beaconv1
+
+import (
+	"context"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+)
+
+func TestServer_GetForkSchedule(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	ctx := context.Background()
+	bs := &Server{
+		GenesisTimeFetcher: &mock.ChainService{Genesis: timeutils.Now()},
+	}
+
+	// The current fork is returned when no fork is upcoming.
+	resp, err := bs.GetForkSchedule(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, params.BeaconConfig().GenesisForkVersion, resp.Data.CurrentVersion)
+	assert.Equal(t, uint64(0), resp.Data.Epoch)
+
+	cfg := params.BeaconConfig().Copy()
+	cfg.ForkVersionSchedule = map[uint64][]byte{
+		10: {1, 0, 0, 0},
+	}
+	params.OverrideBeaconConfig(cfg)
+	resp, err = bs.GetForkSchedule(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, params.BeaconConfig().GenesisForkVersion, resp.Data.PreviousVersion)
+	assert.DeepEqual(t, []byte{1, 0, 0, 0}, resp.Data.CurrentVersion)
+	assert.Equal(t, uint64(10), resp.Data.Epoch)
+}
@@ -0,0 +1,63 @@
+package beaconv1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// finalizedResponse is a cached response about a finalized block along with its entity tag.
+type finalizedResponse struct {
+	etag string
+	resp interface{}
+}
+
+// cachedResponse returns the cached response of the method for the block ID, if the block was finalized
+// when first requested, and returns its entity tag in the response headers.
+func (bs *Server) cachedResponse(ctx context.Context, method string, blockId []byte) (interface{}, bool) {
+	if !isImmutableBlockID(blockId) {
+		return nil, false
+	}
+	cached, ok := bs.FinalizedCache.Get(finalizedResponseKey(method, blockId))
+	if !ok {
+		return nil, false
+	}
+	res, ok := cached.(*finalizedResponse)
+	if !ok {
+		return nil, false
+	}
+	setETagHeader(ctx, res.etag)
+	return res.resp, true
+}
+
+// cacheFinalizedResponse caches the response of the method for the block ID if the block with the root
+// is finalized, and returns its entity tag in the response headers.
+func (bs *Server) cacheFinalizedResponse(ctx context.Context, method string, blockId []byte, blkRoot [32]byte, resp interface{}) {
+	if !isImmutableBlockID(blockId) || !bs.BeaconDB.IsFinalizedBlock(ctx, blkRoot) {
+		return
+	}
+	etag := fmt.Sprintf("\"%#x\"", blkRoot)
+	bs.FinalizedCache.Put(finalizedResponseKey(method, blockId), &finalizedResponse{etag: etag, resp: resp})
+	setETagHeader(ctx, etag)
+}
+
+// isImmutableBlockID returns whether the block ID always designates the same block once finalized,
+// which is true of every ID but the head and finalized ones.
+func isImmutableBlockID(blockId []byte) bool {
+	id := string(blockId)
+	return id != "head" && id != "finalized"
+}
+
+func finalizedResponseKey(method string, blockId []byte) string {
+	return fmt.Sprintf("%s/%#x", method, blockId)
+}
+
+func setETagHeader(ctx context.Context, etag string) {
+	if err := grpc.SetHeader(ctx, metadata.Pairs(grpcutils.ETagHeader, etag)); err != nil {
+		log.WithError(err).Debug("Could not set entity tag header")
+	}
+}
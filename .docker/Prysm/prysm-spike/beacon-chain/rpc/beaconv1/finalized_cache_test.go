@@ -0,0 +1,54 @@
+package beaconv1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_GetBlockRoot_FinalizedCache(t *testing.T) {
+	db, _ := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	genBlk, blkContainers := fillDBTestBlocks(ctx, t, db)
+	genRoot, err := genBlk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	bs := &Server{
+		BeaconDB:       db,
+		FinalizedCache: cache.NewFinalizedResponseCache(),
+	}
+
+	// The genesis block is finalized, so its root is cached.
+	resp, err := bs.GetBlockRoot(ctx, &ethpb.BlockRequest{BlockId: []byte("genesis")})
+	require.NoError(t, err)
+	assert.DeepEqual(t, genRoot[:], resp.Data.Root)
+	cached, ok := bs.FinalizedCache.Get(finalizedResponseKey("GetBlockRoot", []byte("genesis")))
+	require.Equal(t, true, ok)
+	assert.Equal(t, fmt.Sprintf("\"%#x\"", genRoot), cached.(*finalizedResponse).etag)
+	cachedResp, ok := bs.cachedResponse(ctx, "GetBlockRoot", []byte("genesis"))
+	require.Equal(t, true, ok)
+	assert.Equal(t, resp, cachedResp)
+	_, ok = bs.cachedResponse(ctx, "GetBlock", []byte("genesis"))
+	assert.Equal(t, false, ok)
+
+	// Blocks which are not finalized yet are not cached.
+	resp, err = bs.GetBlockRoot(ctx, &ethpb.BlockRequest{BlockId: blkContainers[20].BlockRoot})
+	require.NoError(t, err)
+	assert.DeepEqual(t, blkContainers[20].BlockRoot, resp.Data.Root)
+	_, ok = bs.cachedResponse(ctx, "GetBlockRoot", blkContainers[20].BlockRoot)
+	assert.Equal(t, false, ok)
+}
+
+func TestIsImmutableBlockID(t *testing.T) {
+	assert.Equal(t, false, isImmutableBlockID([]byte("head")))
+	assert.Equal(t, false, isImmutableBlockID([]byte("finalized")))
+	assert.Equal(t, true, isImmutableBlockID([]byte("genesis")))
+	assert.Equal(t, true, isImmutableBlockID([]byte("30")))
+	assert.Equal(t, true, isImmutableBlockID(make([]byte, 32)))
+}
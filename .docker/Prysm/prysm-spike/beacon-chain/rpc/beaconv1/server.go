@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
 	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
@@ -44,4 +45,5 @@ type Server struct {
 	ChainStartChan      chan time.Time
 	StateGen            *stategen.State
 	SyncChecker         sync.Checker
+	FinalizedCache      *cache.FinalizedResponseCache
 }
@@ -0,0 +1,99 @@
+package debug
+
+import (
+	"context"
+	"sort"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetBalanceChanges returns the balance changes of validators between the start of two epochs.
+// The balances are read from the states regenerated at both epoch boundaries, so reward analysis
+// tools do not need to download the full balance sets of both epochs.
+func (ds *Server) GetBalanceChanges(
+	ctx context.Context,
+	req *pbrpc.BalanceChangesRequest,
+) (*pbrpc.BalanceChangesResponse, error) {
+	if req.FromEpoch > req.ToEpoch {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"From epoch %d cannot be after to epoch %d",
+			req.FromEpoch,
+			req.ToEpoch,
+		)
+	}
+	currentEpoch := helpers.SlotToEpoch(ds.GenesisTimeFetcher.CurrentSlot())
+	if req.ToEpoch > currentEpoch {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Cannot retrieve information about an epoch in the future, current epoch %d, requesting %d",
+			currentEpoch,
+			req.ToEpoch,
+		)
+	}
+
+	fromBalances, err := ds.balancesAtEpoch(ctx, req.FromEpoch)
+	if err != nil {
+		return nil, err
+	}
+	toBalances, err := ds.balancesAtEpoch(ctx, req.ToEpoch)
+	if err != nil {
+		return nil, err
+	}
+
+	var indices []uint64
+	if len(req.Indices) == 0 {
+		indices = make([]uint64, len(toBalances))
+		for i := range indices {
+			indices[i] = uint64(i)
+		}
+	} else {
+		indices = sliceutil.SetUint64(req.Indices)
+		sort.Slice(indices, func(i, j int) bool {
+			return indices[i] < indices[j]
+		})
+	}
+
+	changes := make([]*pbrpc.ValidatorBalanceChange, 0, len(indices))
+	for _, idx := range indices {
+		if idx >= uint64(len(toBalances)) {
+			return nil, status.Errorf(codes.OutOfRange, "Validator index %d >= balance list %d", idx, len(toBalances))
+		}
+		// Validators which joined the registry after the from epoch start with a zero balance.
+		var fromBalance uint64
+		if idx < uint64(len(fromBalances)) {
+			fromBalance = fromBalances[idx]
+		}
+		changes = append(changes, &pbrpc.ValidatorBalanceChange{
+			Index:       idx,
+			FromBalance: fromBalance,
+			ToBalance:   toBalances[idx],
+			Delta:       int64(toBalances[idx]) - int64(fromBalance),
+		})
+	}
+	return &pbrpc.BalanceChangesResponse{
+		FromEpoch: req.FromEpoch,
+		ToEpoch:   req.ToEpoch,
+		Changes:   changes,
+	}, nil
+}
+
+// balancesAtEpoch regenerates the state at the start slot of the epoch and returns its balances.
+func (ds *Server) balancesAtEpoch(ctx context.Context, epoch uint64) ([]uint64, error) {
+	startSlot, err := helpers.StartSlot(epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not get start slot of epoch %d: %v", epoch, err)
+	}
+	st, err := ds.StateGen.StateBySlot(ctx, startSlot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute state by slot: %v", err)
+	}
+	if st == nil {
+		return nil, status.Errorf(codes.NotFound, "Could not find state of epoch %d", epoch)
+	}
+	return st.Balances(), nil
+}
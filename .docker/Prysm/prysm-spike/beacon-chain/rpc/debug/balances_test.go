@@ -0,0 +1,72 @@
+package debug
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_GetBalanceChanges(t *testing.T) {
+	db, sc := dbTest.SetupDB(t)
+	ctx := context.Background()
+	gen := stategen.New(db, sc)
+	st, _ := testutil.DeterministicGenesisState(t, 4)
+	for _, epoch := range []uint64{1, 2} {
+		slot := epoch * params.BeaconConfig().SlotsPerEpoch
+		require.NoError(t, st.SetSlot(slot))
+		if epoch == 2 {
+			require.NoError(t, st.UpdateBalancesAtIndex(1, st.Balances()[1]+100))
+			require.NoError(t, st.UpdateBalancesAtIndex(3, st.Balances()[3]-50))
+		}
+		b := testutil.NewBeaconBlock()
+		b.Block.Slot = slot
+		require.NoError(t, db.SaveBlock(ctx, b))
+		root, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, gen.SaveState(ctx, root, st))
+		require.NoError(t, db.SaveState(ctx, st, root))
+	}
+
+	ds := &Server{
+		StateGen: gen,
+		GenesisTimeFetcher: &mock.ChainService{Genesis: time.Now().Add(time.Duration(-1*int64(
+			3*params.BeaconConfig().SlotsPerEpoch*params.BeaconConfig().SecondsPerSlot)) * time.Second)},
+	}
+	res, err := ds.GetBalanceChanges(ctx, &pbrpc.BalanceChangesRequest{FromEpoch: 1, ToEpoch: 2})
+	require.NoError(t, err)
+	require.Equal(t, 4, len(res.Changes))
+	wanted := []int64{0, 100, 0, -50}
+	for i, change := range res.Changes {
+		assert.Equal(t, uint64(i), change.Index)
+		assert.Equal(t, wanted[i], change.Delta)
+		assert.Equal(t, change.ToBalance, uint64(int64(change.FromBalance)+change.Delta))
+	}
+
+	res, err = ds.GetBalanceChanges(ctx, &pbrpc.BalanceChangesRequest{FromEpoch: 1, ToEpoch: 2, Indices: []uint64{3, 1, 3}})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Changes))
+	assert.Equal(t, uint64(1), res.Changes[0].Index)
+	assert.Equal(t, int64(100), res.Changes[0].Delta)
+	assert.Equal(t, uint64(3), res.Changes[1].Index)
+	assert.Equal(t, int64(-50), res.Changes[1].Delta)
+
+	_, err = ds.GetBalanceChanges(ctx, &pbrpc.BalanceChangesRequest{FromEpoch: 1, ToEpoch: 2, Indices: []uint64{4}})
+	assert.ErrorContains(t, "Validator index 4 >= balance list 4", err)
+}
+
+func TestServer_GetBalanceChanges_InvalidEpochs(t *testing.T) {
+	ds := &Server{GenesisTimeFetcher: &mock.ChainService{Genesis: time.Now()}}
+	_, err := ds.GetBalanceChanges(context.Background(), &pbrpc.BalanceChangesRequest{FromEpoch: 2, ToEpoch: 1})
+	assert.ErrorContains(t, "From epoch 2 cannot be after to epoch 1", err)
+	_, err = ds.GetBalanceChanges(context.Background(), &pbrpc.BalanceChangesRequest{ToEpoch: 1})
+	assert.ErrorContains(t, "Cannot retrieve information about an epoch in the future", err)
+}
@@ -0,0 +1,65 @@
+package debug
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// FlushCaches flushes the requested caches and recomputes the fork choice head with
+// freshly computed justified balances, for recovering from suspected cache corruption
+// without restarting the node.
+func (ds *Server) FlushCaches(ctx context.Context, req *pbrpc.FlushCachesRequest) (*pbrpc.FlushCachesResponse, error) {
+	if ds.HeadRecomputer == nil {
+		return nil, status.Error(codes.Unavailable, "Head recomputation is not available")
+	}
+	for _, c := range req.Caches {
+		switch c {
+		case pbrpc.FlushCachesRequest_CHECKPOINT_STATES, pbrpc.FlushCachesRequest_COMMITTEES:
+		case pbrpc.FlushCachesRequest_SEEN_OBJECTS:
+			if ds.SeenCacheFlusher == nil {
+				return nil, status.Error(codes.Unavailable, "Seen object caches are not available")
+			}
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "Unknown cache %v", c)
+		}
+	}
+	previousHeadRoot, err := ds.HeadFetcher.HeadRoot(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head root: %v", err)
+	}
+
+	flushed := make([]string, 0, len(req.Caches))
+	for _, c := range req.Caches {
+		switch c {
+		case pbrpc.FlushCachesRequest_CHECKPOINT_STATES:
+			ds.HeadRecomputer.ClearCheckpointStateCache()
+		case pbrpc.FlushCachesRequest_COMMITTEES:
+			helpers.ClearCache()
+		case pbrpc.FlushCachesRequest_SEEN_OBJECTS:
+			ds.SeenCacheFlusher.ClearSeenCaches()
+		}
+		flushed = append(flushed, c.String())
+	}
+	headRoot, err := ds.HeadRecomputer.RecomputeHead(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not recompute head: %v", err)
+	}
+	log.WithFields(logrus.Fields{
+		"caches":           flushed,
+		"previousHeadRoot": fmt.Sprintf("%#x", bytesutil.Trunc(previousHeadRoot)),
+		"headRoot":         fmt.Sprintf("%#x", bytesutil.Trunc(headRoot[:])),
+	}).Warn("Flushed caches and recomputed head on request")
+
+	return &pbrpc.FlushCachesResponse{
+		PreviousHeadRoot: previousHeadRoot,
+		HeadRoot:         headRoot[:],
+		HeadSlot:         ds.HeadFetcher.HeadSlot(),
+	}, nil
+}
@@ -0,0 +1,86 @@
+package debug
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+type mockHeadRecomputer struct {
+	headRoot               [32]byte
+	err                    error
+	recomputed             bool
+	checkpointStateCleared bool
+}
+
+func (m *mockHeadRecomputer) ClearCheckpointStateCache() {
+	m.checkpointStateCleared = true
+}
+
+func (m *mockHeadRecomputer) RecomputeHead(_ context.Context) ([32]byte, error) {
+	m.recomputed = true
+	return m.headRoot, m.err
+}
+
+type mockSeenCacheFlusher struct {
+	cleared bool
+}
+
+func (m *mockSeenCacheFlusher) ClearSeenCaches() {
+	m.cleared = true
+}
+
+func TestServer_FlushCaches(t *testing.T) {
+	previousRoot, headRoot := [32]byte{'a'}, [32]byte{'b'}
+	recomputer := &mockHeadRecomputer{headRoot: headRoot}
+	flusher := &mockSeenCacheFlusher{}
+	ds := &Server{
+		HeadFetcher:      &mock.ChainService{Root: previousRoot[:]},
+		HeadRecomputer:   recomputer,
+		SeenCacheFlusher: flusher,
+	}
+
+	resp, err := ds.FlushCaches(context.Background(), &pbrpc.FlushCachesRequest{
+		Caches: []pbrpc.FlushCachesRequest_Cache{pbrpc.FlushCachesRequest_SEEN_OBJECTS},
+	})
+	require.NoError(t, err)
+	assert.DeepEqual(t, previousRoot[:], resp.PreviousHeadRoot)
+	assert.DeepEqual(t, headRoot[:], resp.HeadRoot)
+	assert.Equal(t, true, recomputer.recomputed)
+	assert.Equal(t, false, recomputer.checkpointStateCleared)
+	assert.Equal(t, true, flusher.cleared)
+
+	_, err = ds.FlushCaches(context.Background(), &pbrpc.FlushCachesRequest{
+		Caches: []pbrpc.FlushCachesRequest_Cache{pbrpc.FlushCachesRequest_CHECKPOINT_STATES, pbrpc.FlushCachesRequest_COMMITTEES},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, true, recomputer.checkpointStateCleared)
+}
+
+func TestServer_FlushCaches_Errors(t *testing.T) {
+	ds := &Server{HeadFetcher: &mock.ChainService{}}
+	_, err := ds.FlushCaches(context.Background(), &pbrpc.FlushCachesRequest{})
+	assert.ErrorContains(t, "Head recomputation is not available", err)
+
+	recomputer := &mockHeadRecomputer{}
+	ds.HeadRecomputer = recomputer
+	_, err = ds.FlushCaches(context.Background(), &pbrpc.FlushCachesRequest{
+		Caches: []pbrpc.FlushCachesRequest_Cache{pbrpc.FlushCachesRequest_SEEN_OBJECTS},
+	})
+	assert.ErrorContains(t, "Seen object caches are not available", err)
+	assert.Equal(t, false, recomputer.recomputed)
+
+	_, err = ds.FlushCaches(context.Background(), &pbrpc.FlushCachesRequest{
+		Caches: []pbrpc.FlushCachesRequest_Cache{5},
+	})
+	assert.ErrorContains(t, "Unknown cache", err)
+
+	recomputer.err = errors.New("no justified state")
+	_, err = ds.FlushCaches(context.Background(), &pbrpc.FlushCachesRequest{})
+	assert.ErrorContains(t, "Could not recompute head: no justified state", err)
+}
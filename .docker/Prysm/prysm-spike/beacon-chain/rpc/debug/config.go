@@ -0,0 +1,24 @@
+package debug
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/types"
+	sharedpb "github.com/prysmaticlabs/prysm/proto/shared/v1"
+	"github.com/prysmaticlabs/prysm/shared/configdump"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetRunningConfig returns the configuration the beacon node runs with, resolved from its
+// flags, config file, feature flags and chain config, with secrets redacted.
+func (ds *Server) GetRunningConfig(_ context.Context, _ *types.Empty) (*sharedpb.RunningConfig, error) {
+	if ds.RunningConfig == nil {
+		return nil, status.Error(codes.Unavailable, "Running configuration is not available")
+	}
+	c, err := ds.RunningConfig()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not resolve configuration: %v", err)
+	}
+	return configdump.RunningConfigProto(c), nil
+}
@@ -0,0 +1,43 @@
+package debug
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/prysmaticlabs/prysm/shared/configdump"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_GetRunningConfig(t *testing.T) {
+	c := &configdump.Config{
+		Binary:  "beacon-chain",
+		Version: "v1.0.0",
+		Flags: []*configdump.Flag{
+			{Name: "datadir", Value: "/data", Set: true},
+		},
+	}
+	ds := &Server{
+		RunningConfig: func() (*configdump.Config, error) {
+			return c, nil
+		},
+	}
+	res, err := ds.GetRunningConfig(context.Background(), &types.Empty{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, configdump.RunningConfigProto(c), res)
+}
+
+func TestServer_GetRunningConfig_Error(t *testing.T) {
+	ds := &Server{
+		RunningConfig: func() (*configdump.Config, error) {
+			return nil, errors.New("bad config file")
+		},
+	}
+	_, err := ds.GetRunningConfig(context.Background(), &types.Empty{})
+	assert.ErrorContains(t, "Could not resolve configuration: bad config file", err)
+
+	_, err = (&Server{}).GetRunningConfig(context.Background(), &types.Empty{})
+	assert.ErrorContains(t, "Running configuration is not available", err)
+}
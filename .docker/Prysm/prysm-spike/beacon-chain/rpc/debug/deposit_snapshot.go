@@ -0,0 +1,38 @@
+package debug
+
+import (
+	"context"
+
+	ptypes "github.com/gogo/protobuf/types"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetDepositSnapshot returns a snapshot of the finalized deposits, which other nodes can be started
+// from with --deposit-snapshot instead of processing every deposit log of the deposit contract.
+func (ds *Server) GetDepositSnapshot(ctx context.Context, _ *ptypes.Empty) (*pbrpc.DepositSnapshotResponse, error) {
+	if ds.DepositSnapshotter == nil {
+		return nil, status.Error(codes.Unavailable, "Deposit snapshots are not available")
+	}
+	snapshot, err := ds.DepositSnapshotter.DepositSnapshot(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not create deposit snapshot: %v", err)
+	}
+	if snapshot == nil {
+		return nil, status.Error(codes.NotFound, "No finalized deposits")
+	}
+	finalized := make([][]byte, len(snapshot.Finalized))
+	for i := range snapshot.Finalized {
+		finalized[i] = snapshot.Finalized[i][:]
+	}
+	return &pbrpc.DepositSnapshotResponse{
+		Data: &pbrpc.DepositSnapshot{
+			Finalized:            finalized,
+			DepositRoot:          snapshot.DepositRoot[:],
+			DepositCount:         snapshot.DepositCount,
+			ExecutionBlockHash:   snapshot.ExecutionBlockHash[:],
+			ExecutionBlockHeight: snapshot.ExecutionBlockHeight,
+		},
+	}, nil
+}
@@ -0,0 +1,50 @@
+package debug
+
+import (
+	"context"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+)
+
+type mockDepositSnapshotter struct {
+	snapshot *trieutil.DepositTreeSnapshot
+}
+
+func (m *mockDepositSnapshotter) DepositSnapshot(_ context.Context) (*trieutil.DepositTreeSnapshot, error) {
+	return m.snapshot, nil
+}
+
+func TestServer_GetDepositSnapshot(t *testing.T) {
+	ctx := context.Background()
+	ds := &Server{}
+	_, err := ds.GetDepositSnapshot(ctx, &ptypes.Empty{})
+	assert.ErrorContains(t, "not available", err)
+
+	snapshotter := &mockDepositSnapshotter{}
+	ds.DepositSnapshotter = snapshotter
+	_, err = ds.GetDepositSnapshot(ctx, &ptypes.Empty{})
+	assert.ErrorContains(t, "No finalized deposits", err)
+
+	snapshotter.snapshot = &trieutil.DepositTreeSnapshot{
+		Finalized:            [][32]byte{{1}, {2}},
+		DepositRoot:          [32]byte{3},
+		DepositCount:         5,
+		ExecutionBlockHash:   [32]byte{4},
+		ExecutionBlockHeight: 12,
+	}
+	res, err := ds.GetDepositSnapshot(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, &pbrpc.DepositSnapshot{
+		Finalized:            [][]byte{bytesutil.PadTo([]byte{1}, 32), bytesutil.PadTo([]byte{2}, 32)},
+		DepositRoot:          bytesutil.PadTo([]byte{3}, 32),
+		DepositCount:         5,
+		ExecutionBlockHash:   bytesutil.PadTo([]byte{4}, 32),
+		ExecutionBlockHeight: 12,
+	}, res.Data)
+}
@@ -0,0 +1,71 @@
+package debug
+
+import (
+	"sort"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// blockExportBatchSlots is the number of slots whose blocks are read from the database at once.
+const blockExportBatchSlots = 64
+
+// StreamBlockExport streams the canonical blocks from the start slot to the end slot, both
+// inclusive, in slot order. Every block carries the cursor to resume an interrupted export from.
+func (ds *Server) StreamBlockExport(req *pbrpc.BlockExportRequest, stream pbrpc.Debug_StreamBlockExportServer) error {
+	ctx := stream.Context()
+	headSlot := ds.HeadFetcher.HeadSlot()
+	endSlot := req.EndSlot
+	if endSlot == 0 || endSlot > headSlot {
+		endSlot = headSlot
+	}
+	for next := req.StartSlot; next <= endSlot; {
+		if ctx.Err() != nil {
+			return status.Error(codes.Canceled, "Block export canceled")
+		}
+		batchEnd := next + blockExportBatchSlots - 1
+		if batchEnd > endSlot || batchEnd < next {
+			batchEnd = endSlot
+		}
+		blks, roots, err := ds.BeaconDB.Blocks(ctx, filters.NewFilter().SetStartSlot(next).SetEndSlot(batchEnd))
+		if err != nil {
+			return status.Errorf(codes.Internal, "Could not retrieve blocks: %v", err)
+		}
+		order := make([]int, len(blks))
+		for i := range order {
+			order[i] = i
+		}
+		sort.Slice(order, func(i, j int) bool {
+			return blks[order[i]].Block.Slot < blks[order[j]].Block.Slot
+		})
+		for _, i := range order {
+			canonical, err := ds.CanonicalFetcher.IsCanonical(ctx, roots[i])
+			if err != nil {
+				return status.Errorf(codes.Internal, "Could not check if block is canonical: %v", err)
+			}
+			if !canonical {
+				continue
+			}
+			encoded, err := blks[i].MarshalSSZ()
+			if err != nil {
+				return status.Errorf(codes.Internal, "Could not marshal block: %v", err)
+			}
+			root := roots[i]
+			if err := stream.Send(&pbrpc.ExportedBlock{
+				Slot:      blks[i].Block.Slot,
+				BlockRoot: root[:],
+				Encoded:   encoded,
+				Cursor:    blks[i].Block.Slot + 1,
+			}); err != nil {
+				return status.Errorf(codes.Unavailable, "Could not send block over stream: %v", err)
+			}
+		}
+		if batchEnd == endSlot {
+			return nil
+		}
+		next = batchEnd + 1
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package debug
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type exportHead struct {
+	blockchain.HeadFetcher
+	slot uint64
+}
+
+func (h *exportHead) HeadSlot() uint64 {
+	return h.slot
+}
+
+type exportCanonical struct {
+	blockchain.CanonicalFetcher
+	roots map[[32]byte]bool
+}
+
+func (c *exportCanonical) IsCanonical(_ context.Context, root [32]byte) (bool, error) {
+	return c.roots[root], nil
+}
+
+type exportStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	blocks []*pbrpc.ExportedBlock
+}
+
+func (s *exportStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *exportStream) Send(b *pbrpc.ExportedBlock) error {
+	s.blocks = append(s.blocks, b)
+	return nil
+}
+
+// setupBlockExport saves a canonical chain of blocks at slots 0, 1, 2 and 70, and a block at
+// slot 3 outside of it.
+func setupBlockExport(t *testing.T) (*Server, []*ethpb.SignedBeaconBlock) {
+	ctx := context.Background()
+	db, _ := dbTest.SetupDB(t)
+	canonicalRoots := &exportCanonical{roots: make(map[[32]byte]bool)}
+	var canonical []*ethpb.SignedBeaconBlock
+	parentRoot := [32]byte{}
+	for _, slot := range []uint64{0, 1, 2, 70} {
+		blk := testutil.NewBeaconBlock()
+		blk.Block.Slot = slot
+		blk.Block.ParentRoot = bytesutil.SafeCopyBytes(parentRoot[:])
+		require.NoError(t, db.SaveBlock(ctx, blk))
+		root, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		canonicalRoots.roots[root] = true
+		canonical = append(canonical, blk)
+		parentRoot = root
+	}
+	orphan := testutil.NewBeaconBlock()
+	orphan.Block.Slot = 3
+	orphan.Block.ParentRoot = bytesutil.PadTo([]byte{'o'}, 32)
+	require.NoError(t, db.SaveBlock(ctx, orphan))
+
+	return &Server{
+		BeaconDB:         db,
+		HeadFetcher:      &exportHead{slot: 70},
+		CanonicalFetcher: canonicalRoots,
+	}, canonical
+}
+
+func TestServer_StreamBlockExport(t *testing.T) {
+	ds, canonical := setupBlockExport(t)
+	stream := &exportStream{ctx: context.Background()}
+	require.NoError(t, ds.StreamBlockExport(&pbrpc.BlockExportRequest{StartSlot: 1, EndSlot: 100}, stream))
+
+	require.Equal(t, 3, len(stream.blocks))
+	for i, b := range stream.blocks {
+		assert.Equal(t, canonical[i+1].Block.Slot, b.Slot)
+		blk := &ethpb.SignedBeaconBlock{}
+		require.NoError(t, blk.UnmarshalSSZ(b.Encoded))
+		assert.DeepEqual(t, canonical[i+1], blk)
+	}
+	assert.Equal(t, uint64(71), stream.blocks[2].Cursor)
+}
+
+func TestServer_StreamBlockExport_ResumesFromCursor(t *testing.T) {
+	ds, _ := setupBlockExport(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := ds.StreamBlockExport(&pbrpc.BlockExportRequest{StartSlot: 2}, &exportStream{ctx: ctx})
+	assert.Equal(t, codes.Canceled, status.Code(err))
+
+	// The end slot defaults to the head slot.
+	stream := &exportStream{ctx: context.Background()}
+	require.NoError(t, ds.StreamBlockExport(&pbrpc.BlockExportRequest{StartSlot: 2}, stream))
+	require.Equal(t, 2, len(stream.blocks))
+	assert.Equal(t, uint64(2), stream.blocks[0].Slot)
+	assert.Equal(t, uint64(71), stream.blocks[1].Cursor)
+}
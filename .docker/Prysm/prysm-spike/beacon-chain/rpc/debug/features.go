@@ -0,0 +1,15 @@
+package debug
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/types"
+	sharedpb "github.com/prysmaticlabs/prysm/proto/shared/v1"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+)
+
+// ListFeatures returns the feature flags of the beacon node with their values and
+// where they were set.
+func (ds *Server) ListFeatures(_ context.Context, _ *types.Empty) (*sharedpb.FeaturesResponse, error) {
+	return &sharedpb.FeaturesResponse{Features: featureconfig.FeaturesProto(featureconfig.ActiveFeatures())}, nil
+}
@@ -0,0 +1,101 @@
+package debug
+
+import (
+	"context"
+	"sort"
+
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListOperationInclusions returns the blocks including a deposit, exit or slashing of a validator,
+// looked up by its index or public key. Blocks saved before the operation indices were added to
+// the database are not indexed, and are not returned.
+func (ds *Server) ListOperationInclusions(ctx context.Context, req *pbrpc.OperationInclusionsRequest) (*pbrpc.OperationInclusionsResponse, error) {
+	st, err := ds.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	if st == nil {
+		return nil, status.Error(codes.Unavailable, "Head state is not available")
+	}
+
+	resp := &pbrpc.OperationInclusionsResponse{}
+	inRegistry := false
+	switch q := req.QueryFilter.(type) {
+	case *pbrpc.OperationInclusionsRequest_ValidatorIndex:
+		if q.ValidatorIndex >= uint64(st.NumValidators()) {
+			return nil, status.Errorf(codes.NotFound, "Validator index %d is not in the registry", q.ValidatorIndex)
+		}
+		pubKey := st.PubkeyAtIndex(q.ValidatorIndex)
+		resp.ValidatorIndex = q.ValidatorIndex
+		resp.PublicKey = pubKey[:]
+		inRegistry = true
+	case *pbrpc.OperationInclusionsRequest_PublicKey:
+		if len(q.PublicKey) != params.BeaconConfig().BLSPubkeyLength {
+			return nil, status.Errorf(codes.InvalidArgument, "Public key must be %d bytes", params.BeaconConfig().BLSPubkeyLength)
+		}
+		resp.PublicKey = q.PublicKey
+		resp.ValidatorIndex, inRegistry = st.ValidatorIndexByPubkey(bytesutil.ToBytes48(q.PublicKey))
+	default:
+		return nil, status.Error(codes.InvalidArgument, "Expected a validator index or public key")
+	}
+
+	roots, err := ds.BeaconDB.DepositBlockRoots(ctx, resp.PublicKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get deposit block roots: %v", err)
+	}
+	if err := ds.appendInclusions(ctx, resp, "deposit", roots); err != nil {
+		return nil, err
+	}
+	if inRegistry {
+		lookups := []struct {
+			typ    string
+			lookup func(context.Context, uint64) ([][32]byte, error)
+		}{
+			{"voluntary_exit", ds.BeaconDB.VoluntaryExitBlockRoots},
+			{"proposer_slashing", ds.BeaconDB.ProposerSlashingBlockRoots},
+			{"attester_slashing", ds.BeaconDB.AttesterSlashingBlockRoots},
+		}
+		for _, l := range lookups {
+			roots, err := l.lookup(ctx, resp.ValidatorIndex)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "Could not get %s block roots: %v", l.typ, err)
+			}
+			if err := ds.appendInclusions(ctx, resp, l.typ, roots); err != nil {
+				return nil, err
+			}
+		}
+	}
+	sort.SliceStable(resp.Inclusions, func(i, j int) bool {
+		return resp.Inclusions[i].Slot < resp.Inclusions[j].Slot
+	})
+	return resp, nil
+}
+
+// appendInclusions adds the blocks of the roots to the response as inclusions of the operation type.
+func (ds *Server) appendInclusions(ctx context.Context, resp *pbrpc.OperationInclusionsResponse, typ string, roots [][32]byte) error {
+	for _, root := range roots {
+		blk, err := ds.BeaconDB.Block(ctx, root)
+		if err != nil {
+			return status.Errorf(codes.Internal, "Could not get block %#x: %v", root, err)
+		}
+		if blk == nil || blk.Block == nil {
+			continue
+		}
+		canonical, err := ds.CanonicalFetcher.IsCanonical(ctx, root)
+		if err != nil {
+			return status.Errorf(codes.Internal, "Could not determine if block %#x is canonical: %v", root, err)
+		}
+		resp.Inclusions = append(resp.Inclusions, &pbrpc.OperationInclusion{
+			Type:      typ,
+			BlockRoot: bytesutil.SafeCopyBytes(root[:]),
+			Slot:      blk.Block.Slot,
+			Canonical: canonical,
+		})
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package debug
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_ListOperationInclusions(t *testing.T) {
+	db, _ := dbTest.SetupDB(t)
+	ctx := context.Background()
+	st, _ := testutil.DeterministicGenesisState(t, 16)
+	pubKey := st.PubkeyAtIndex(3)
+	// The genesis state has the keys of the first 16 deposits, the last is not in the registry.
+	deposits, _, err := testutil.DeterministicDepositsAndKeys(17)
+	require.NoError(t, err)
+
+	depositBlock := testutil.NewBeaconBlock()
+	depositBlock.Block.Slot = 2
+	depositBlock.Block.Body.Deposits = []*ethpb.Deposit{deposits[3]}
+	exitBlock := testutil.NewBeaconBlock()
+	exitBlock.Block.Slot = 1
+	exitBlock.Block.Body.VoluntaryExits = []*ethpb.SignedVoluntaryExit{{
+		Exit:      &ethpb.VoluntaryExit{ValidatorIndex: 3},
+		Signature: make([]byte, 96),
+	}}
+	newDepositBlock := testutil.NewBeaconBlock()
+	newDepositBlock.Block.Slot = 3
+	newDepositBlock.Block.Body.Deposits = []*ethpb.Deposit{deposits[16]}
+	require.NoError(t, db.SaveBlocks(ctx, []*ethpb.SignedBeaconBlock{depositBlock, exitBlock, newDepositBlock}))
+	depositRoot, err := depositBlock.Block.HashTreeRoot()
+	require.NoError(t, err)
+	exitRoot, err := exitBlock.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	ds := &Server{
+		BeaconDB:         db,
+		HeadFetcher:      &mock.ChainService{State: st},
+		CanonicalFetcher: &mock.ChainService{CanonicalRoots: map[[32]byte]bool{exitRoot: true}},
+	}
+	req := &pbrpc.OperationInclusionsRequest{QueryFilter: &pbrpc.OperationInclusionsRequest_ValidatorIndex{ValidatorIndex: 3}}
+	resp, err := ds.ListOperationInclusions(ctx, req)
+	require.NoError(t, err)
+	assert.DeepEqual(t, pubKey[:], resp.PublicKey)
+	require.Equal(t, 2, len(resp.Inclusions))
+	assert.DeepEqual(t, &pbrpc.OperationInclusion{Type: "voluntary_exit", BlockRoot: exitRoot[:], Slot: 1, Canonical: true}, resp.Inclusions[0])
+	assert.DeepEqual(t, &pbrpc.OperationInclusion{Type: "deposit", BlockRoot: depositRoot[:], Slot: 2}, resp.Inclusions[1])
+
+	req = &pbrpc.OperationInclusionsRequest{QueryFilter: &pbrpc.OperationInclusionsRequest_PublicKey{PublicKey: pubKey[:]}}
+	resp, err = ds.ListOperationInclusions(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), resp.ValidatorIndex)
+	assert.Equal(t, 2, len(resp.Inclusions))
+
+	// The deposits of a key not in the registry are found by public key.
+	req = &pbrpc.OperationInclusionsRequest{QueryFilter: &pbrpc.OperationInclusionsRequest_PublicKey{PublicKey: deposits[16].Data.PublicKey}}
+	resp, err = ds.ListOperationInclusions(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Inclusions))
+	assert.Equal(t, "deposit", resp.Inclusions[0].Type)
+	assert.Equal(t, uint64(3), resp.Inclusions[0].Slot)
+
+	req = &pbrpc.OperationInclusionsRequest{QueryFilter: &pbrpc.OperationInclusionsRequest_ValidatorIndex{ValidatorIndex: 16}}
+	_, err = ds.ListOperationInclusions(ctx, req)
+	assert.ErrorContains(t, "not in the registry", err)
+	_, err = ds.ListOperationInclusions(ctx, &pbrpc.OperationInclusionsRequest{})
+	assert.ErrorContains(t, "Expected a validator index or public key", err)
+}
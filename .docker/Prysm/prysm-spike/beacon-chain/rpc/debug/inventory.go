@@ -0,0 +1,23 @@
+package debug
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/types"
+	sharedpb "github.com/prysmaticlabs/prysm/proto/shared/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetInventory returns the version, chain config hash, genesis validators root, data directory
+// disk usage, feature flags and service statuses of the beacon node.
+func (ds *Server) GetInventory(_ context.Context, _ *types.Empty) (*sharedpb.Inventory, error) {
+	if ds.Inventory == nil {
+		return nil, status.Error(codes.Unavailable, "Node inventory is not available")
+	}
+	inv, err := ds.Inventory()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not build node inventory: %v", err)
+	}
+	return inv, nil
+}
@@ -0,0 +1,40 @@
+package debug
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	sharedpb "github.com/prysmaticlabs/prysm/proto/shared/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_GetInventory(t *testing.T) {
+	inv := &sharedpb.Inventory{
+		Binary:   "beacon-chain",
+		Services: []*sharedpb.ServiceStatus{{Service: "*p2p.Service", Status: true}},
+	}
+	ds := &Server{
+		Inventory: func() (*sharedpb.Inventory, error) {
+			return inv, nil
+		},
+	}
+	res, err := ds.GetInventory(context.Background(), &types.Empty{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, inv, res)
+}
+
+func TestServer_GetInventory_Error(t *testing.T) {
+	ds := &Server{
+		Inventory: func() (*sharedpb.Inventory, error) {
+			return nil, errors.New("no chain config")
+		},
+	}
+	_, err := ds.GetInventory(context.Background(), &types.Empty{})
+	assert.ErrorContains(t, "Could not build node inventory: no chain config", err)
+
+	_, err = (&Server{}).GetInventory(context.Background(), &types.Empty{})
+	assert.ErrorContains(t, "Node inventory is not available", err)
+}
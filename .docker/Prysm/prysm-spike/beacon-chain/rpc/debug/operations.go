@@ -0,0 +1,36 @@
+package debug
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/localops"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListPendingLocalOperations returns the exits and slashings submitted to this node
+// which are still being rebroadcast until they are included in a block.
+func (ds *Server) ListPendingLocalOperations(_ context.Context, _ *types.Empty) (*pbrpc.PendingLocalOperationsResponse, error) {
+	if ds.LocalOperations == nil {
+		return nil, status.Error(codes.Unavailable, "Local operations tracking is not enabled")
+	}
+	pending := ds.LocalOperations.PendingOperations()
+	operations := make([]*pbrpc.PendingLocalOperation, 0, len(pending))
+	for _, op := range pending {
+		root, err := localops.OperationRoot(op)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not compute operation root: %v", err)
+		}
+		operations = append(operations, &pbrpc.PendingLocalOperation{
+			Type:               localops.OperationType(op),
+			Root:               root[:],
+			ValidatorIndices:   localops.ValidatorIndices(op),
+			SubmittedEpoch:     op.SubmittedEpoch,
+			BroadcastAttempts:  op.BroadcastAttempts,
+			LastBroadcastEpoch: op.LastBroadcastEpoch,
+		})
+	}
+	return &pbrpc.PendingLocalOperationsResponse{Operations: operations}, nil
+}
@@ -0,0 +1,67 @@
+package debug
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+type mockTracker struct {
+	ops []*dbpb.LocalOperation
+}
+
+func (m *mockTracker) TrackVoluntaryExit(_ context.Context, _ *ethpb.SignedVoluntaryExit) error {
+	return nil
+}
+
+func (m *mockTracker) TrackProposerSlashing(_ context.Context, _ *ethpb.ProposerSlashing) error {
+	return nil
+}
+
+func (m *mockTracker) TrackAttesterSlashing(_ context.Context, _ *ethpb.AttesterSlashing) error {
+	return nil
+}
+
+func (m *mockTracker) PendingOperations() []*dbpb.LocalOperation {
+	return m.ops
+}
+
+func TestServer_ListPendingLocalOperations(t *testing.T) {
+	exit := &ethpb.SignedVoluntaryExit{
+		Exit:      &ethpb.VoluntaryExit{Epoch: 1, ValidatorIndex: 5},
+		Signature: make([]byte, 96),
+	}
+	ds := &Server{
+		LocalOperations: &mockTracker{ops: []*dbpb.LocalOperation{
+			{
+				Operation:          &dbpb.LocalOperation_VoluntaryExit{VoluntaryExit: exit},
+				SubmittedEpoch:     2,
+				BroadcastAttempts:  3,
+				LastBroadcastEpoch: 4,
+			},
+		}},
+	}
+	res, err := ds.ListPendingLocalOperations(context.Background(), &types.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Operations))
+	op := res.Operations[0]
+	root, err := exit.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, "voluntary_exit", op.Type)
+	assert.DeepEqual(t, root[:], op.Root)
+	assert.DeepEqual(t, []uint64{5}, op.ValidatorIndices)
+	assert.Equal(t, uint64(2), op.SubmittedEpoch)
+	assert.Equal(t, uint64(3), op.BroadcastAttempts)
+	assert.Equal(t, uint64(4), op.LastBroadcastEpoch)
+}
+
+func TestServer_ListPendingLocalOperations_Disabled(t *testing.T) {
+	ds := &Server{}
+	_, err := ds.ListPendingLocalOperations(context.Background(), &types.Empty{})
+	assert.ErrorContains(t, "not enabled", err)
+}
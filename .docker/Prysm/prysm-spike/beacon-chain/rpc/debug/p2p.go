@@ -135,3 +135,51 @@ func (ds *Server) getPeer(pid peer.ID) (*pbrpc.DebugPeerResponse, error) {
 		LastUpdated:        unixTime,
 	}, nil
 }
+
+// GetInboundLimits returns the inbound connection limits enforced by the connection gater.
+func (ds *Server) GetInboundLimits(_ context.Context, _ *types.Empty) (*pbrpc.InboundLimits, error) {
+	if ds.InboundLimiter == nil {
+		return nil, status.Error(codes.Unavailable, "Inbound connection limits are not available")
+	}
+	return inboundLimitsToProto(ds.InboundLimiter.InboundLimits()), nil
+}
+
+// SetInboundLimits replaces the inbound connection limits enforced by the connection gater. The
+// new limits only apply to the following inbound dials.
+func (ds *Server) SetInboundLimits(_ context.Context, req *pbrpc.InboundLimits) (*pbrpc.InboundLimits, error) {
+	if ds.InboundLimiter == nil {
+		return nil, status.Error(codes.Unavailable, "Inbound connection limits are not available")
+	}
+	if err := ds.InboundLimiter.SetInboundLimits(p2p.InboundLimits{
+		MaxPerIP:     uint(req.MaxPerIp),
+		MaxPerSubnet: uint(req.MaxPerSubnet),
+		SubnetPrefix: uint(req.SubnetPrefix),
+		InboundRatio: req.InboundRatio,
+	}); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid inbound limits: %v", err)
+	}
+	return inboundLimitsToProto(ds.InboundLimiter.InboundLimits()), nil
+}
+
+// GetPeerList returns the ENR, dialable addresses and static peers of the node, which other nodes
+// connect to with --peer-list-url.
+func (ds *Server) GetPeerList(_ context.Context, _ *types.Empty) (*pbrpc.PeerList, error) {
+	if ds.PeerListProvider == nil {
+		return nil, status.Error(codes.Unavailable, "Peer list is not available")
+	}
+	list := ds.PeerListProvider.PeerList()
+	return &pbrpc.PeerList{
+		Enr:       list.ENR,
+		Addresses: list.Addresses,
+		Peers:     list.Peers,
+	}, nil
+}
+
+func inboundLimitsToProto(limits p2p.InboundLimits) *pbrpc.InboundLimits {
+	return &pbrpc.InboundLimits{
+		MaxPerIp:     uint64(limits.MaxPerIP),
+		MaxPerSubnet: uint64(limits.MaxPerSubnet),
+		SubnetPrefix: uint64(limits.SubnetPrefix),
+		InboundRatio: limits.InboundRatio,
+	}
+}
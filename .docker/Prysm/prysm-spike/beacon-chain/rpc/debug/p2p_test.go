@@ -2,11 +2,14 @@ package debug
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	ptypes "github.com/gogo/protobuf/types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	mockP2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 )
@@ -49,3 +52,70 @@ func TestDebugServer_ListPeers(t *testing.T) {
 		t.Errorf("Expected 2nd peer to have a multiaddress, instead they have no addresses")
 	}
 }
+
+type mockInboundLimiter struct {
+	limits p2p.InboundLimits
+}
+
+func (m *mockInboundLimiter) InboundLimits() p2p.InboundLimits {
+	return m.limits
+}
+
+func (m *mockInboundLimiter) SetInboundLimits(limits p2p.InboundLimits) error {
+	if limits.InboundRatio > 1 {
+		return errors.New("inbound ratio must be between 0 and 1")
+	}
+	m.limits = limits
+	return nil
+}
+
+func TestDebugServer_InboundLimits(t *testing.T) {
+	ctx := context.Background()
+	ds := &Server{}
+	_, err := ds.GetInboundLimits(ctx, &ptypes.Empty{})
+	assert.ErrorContains(t, "not available", err)
+
+	limiter := &mockInboundLimiter{limits: p2p.InboundLimits{MaxPerIP: 2}}
+	ds.InboundLimiter = limiter
+	res, err := ds.GetInboundLimits(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, &pbrpc.InboundLimits{MaxPerIp: 2}, res)
+
+	want := &pbrpc.InboundLimits{MaxPerIp: 3, MaxPerSubnet: 4, SubnetPrefix: 16, InboundRatio: 0.5}
+	res, err = ds.SetInboundLimits(ctx, want)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, res)
+	assert.Equal(t, p2p.InboundLimits{MaxPerIP: 3, MaxPerSubnet: 4, SubnetPrefix: 16, InboundRatio: 0.5}, limiter.limits)
+
+	_, err = ds.SetInboundLimits(ctx, &pbrpc.InboundLimits{InboundRatio: 2})
+	assert.ErrorContains(t, "Invalid inbound limits", err)
+	assert.Equal(t, uint(3), limiter.limits.MaxPerIP)
+}
+
+type mockPeerListProvider struct {
+	list *p2p.PeerList
+}
+
+func (m *mockPeerListProvider) PeerList() *p2p.PeerList {
+	return m.list
+}
+
+func TestDebugServer_GetPeerList(t *testing.T) {
+	ctx := context.Background()
+	ds := &Server{}
+	_, err := ds.GetPeerList(ctx, &ptypes.Empty{})
+	assert.ErrorContains(t, "not available", err)
+
+	ds.PeerListProvider = &mockPeerListProvider{list: &p2p.PeerList{
+		ENR:       "enr:-test",
+		Addresses: []string{"/ip4/127.0.0.1/tcp/13000/p2p/QmQ7zhY7nGY66yK1n8hLGevfVyjbtvHSgtZuXkCH9oTrgi"},
+		Peers:     []string{"/ip4/127.0.0.1/tcp/6660/p2p/QmaXZhW44pwQxBSeLkE5FNeLz8tGTTEsRciFg1DNWXXrWG"},
+	}}
+	res, err := ds.GetPeerList(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, &pbrpc.PeerList{
+		Enr:       "enr:-test",
+		Addresses: []string{"/ip4/127.0.0.1/tcp/13000/p2p/QmQ7zhY7nGY66yK1n8hLGevfVyjbtvHSgtZuXkCH9oTrgi"},
+		Peers:     []string{"/ip4/127.0.0.1/tcp/6660/p2p/QmaXZhW44pwQxBSeLkE5FNeLz8tGTTEsRciFg1DNWXXrWG"},
+	}, res)
+}
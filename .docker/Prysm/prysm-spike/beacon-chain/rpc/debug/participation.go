@@ -0,0 +1,118 @@
+package debug
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxParticipationEpochs is the largest range of epochs ListEpochParticipation regenerates states for.
+const maxParticipationEpochs = 32
+
+// ListEpochParticipation returns the participation of the epochs of the range, with the justification
+// and the finality delay they lead to. The attestations of an epoch can be included until the end of
+// the next epoch, so each epoch is computed from the state at the last slot of the next epoch, or the
+// state at the current slot while the next epoch has not ended.
+func (ds *Server) ListEpochParticipation(
+	ctx context.Context,
+	req *pbrpc.EpochParticipationRequest,
+) (*pbrpc.EpochParticipationResponse, error) {
+	if req.StartEpoch > req.EndEpoch {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Start epoch %d cannot be after end epoch %d",
+			req.StartEpoch,
+			req.EndEpoch,
+		)
+	}
+	if req.EndEpoch-req.StartEpoch >= maxParticipationEpochs {
+		return nil, status.Errorf(codes.InvalidArgument, "Cannot retrieve more than %d epochs", maxParticipationEpochs)
+	}
+	currentSlot := ds.GenesisTimeFetcher.CurrentSlot()
+	currentEpoch := helpers.SlotToEpoch(currentSlot)
+	if req.EndEpoch >= currentEpoch {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Cannot retrieve participation of an epoch which is not over, current epoch %d, requesting %d",
+			currentEpoch,
+			req.EndEpoch,
+		)
+	}
+
+	epochs := make([]*pbrpc.EpochParticipation, 0, req.EndEpoch-req.StartEpoch+1)
+	for epoch := req.StartEpoch; epoch <= req.EndEpoch; epoch++ {
+		participation, err := ds.epochParticipation(ctx, epoch, currentSlot)
+		if err != nil {
+			return nil, err
+		}
+		epochs = append(epochs, participation)
+	}
+	return &pbrpc.EpochParticipationResponse{Epochs: epochs}, nil
+}
+
+// epochParticipation computes the participation of the epoch from the state at the last slot of the
+// next epoch, and runs the justification and finalization of the epoch transition following it.
+func (ds *Server) epochParticipation(ctx context.Context, epoch, currentSlot uint64) (*pbrpc.EpochParticipation, error) {
+	nextEpochEnd, err := helpers.EndSlot(epoch + 1)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not get end slot of epoch %d: %v", epoch+1, err)
+	}
+	complete := nextEpochEnd < currentSlot
+	slot := nextEpochEnd
+	if !complete {
+		slot = currentSlot
+	}
+	st, err := ds.StateGen.StateBySlot(ctx, slot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute state by slot: %v", err)
+	}
+	if st == nil {
+		return nil, status.Errorf(codes.NotFound, "Could not find state of epoch %d", epoch+1)
+	}
+	st = st.Copy()
+
+	v, b, err := precompute.New(ctx, st)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not set up pre compute instance: %v", err)
+	}
+	v, b, err = precompute.ProcessAttestations(ctx, st, v, b)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not pre compute attestations: %v", err)
+	}
+	var targetMissed uint64
+	for _, val := range v {
+		if val.IsActivePrevEpoch && !val.IsSlashed && !val.IsPrevEpochTargetAttester {
+			targetMissed++
+		}
+	}
+	var rate float64
+	if b.ActivePrevEpoch > 0 {
+		rate = float64(b.PrevEpochTargetAttested) / float64(b.ActivePrevEpoch)
+	}
+
+	st, err = precompute.ProcessJustificationAndFinalizationPreCompute(st, b)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not process justification: %v", err)
+	}
+	finalizedEpoch := st.FinalizedCheckpointEpoch()
+	return &pbrpc.EpochParticipation{
+		Epoch:                  epoch,
+		Complete:               complete,
+		ActiveGwei:             b.ActivePrevEpoch,
+		SourceAttestingGwei:    b.PrevEpochAttested,
+		TargetAttestingGwei:    b.PrevEpochTargetAttested,
+		HeadAttestingGwei:      b.PrevEpochHeadAttested,
+		ParticipationRate:      rate,
+		TargetMissedValidators: targetMissed,
+		JustificationBits:      bytesutil.SafeCopyBytes(st.JustificationBits()),
+		JustifiedEpoch:         st.CurrentJustifiedCheckpoint().Epoch,
+		FinalizedEpoch:         finalizedEpoch,
+		FinalityDelay:          precompute.FinalityDelay(epoch, finalizedEpoch),
+		InactivityLeak:         precompute.IsInInactivityLeak(epoch, finalizedEpoch),
+	}, nil
+}
@@ -0,0 +1,118 @@
+package debug
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// participationServer returns a server regenerating the state at the last slot of the epoch after
+// the epoch, with the attestations of the epoch, at the start of the epoch after that.
+func participationServer(t *testing.T, epoch uint64, atts []*pb.PendingAttestation) *Server {
+	db, sc := dbTest.SetupDB(t)
+	ctx := context.Background()
+	validators := make([]*ethpb.Validator, 64)
+	balances := make([]uint64, len(validators))
+	for i := range validators {
+		validators[i] = &ethpb.Validator{
+			PublicKey:             bytesutil.ToBytes(uint64(i), 48),
+			WithdrawalCredentials: make([]byte, 32),
+			ExitEpoch:             params.BeaconConfig().FarFutureEpoch,
+			EffectiveBalance:      params.BeaconConfig().MaxEffectiveBalance,
+		}
+		balances[i] = params.BeaconConfig().MaxEffectiveBalance
+	}
+	st := testutil.NewBeaconState()
+	require.NoError(t, st.SetSlot((epoch+2)*params.BeaconConfig().SlotsPerEpoch-1))
+	require.NoError(t, st.SetValidators(validators))
+	require.NoError(t, st.SetBalances(balances))
+	require.NoError(t, st.SetPreviousEpochAttestations(atts))
+
+	b := testutil.NewBeaconBlock()
+	require.NoError(t, db.SaveBlock(ctx, b))
+	bRoot, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveStateSummary(ctx, &pb.StateSummary{Root: bRoot[:]}))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, bRoot))
+	require.NoError(t, db.SaveState(ctx, st, bRoot))
+
+	return &Server{
+		StateGen: stategen.New(db, sc),
+		GenesisTimeFetcher: &mock.ChainService{Genesis: time.Now().Add(time.Duration(-1*int64(
+			(epoch+3)*params.BeaconConfig().SlotsPerEpoch*params.BeaconConfig().SecondsPerSlot)) * time.Second)},
+	}
+}
+
+func TestServer_ListEpochParticipation(t *testing.T) {
+	// Every validator of epoch 1 attests to the target, justifying it.
+	var atts []*pb.PendingAttestation
+	for i := uint64(0); i < params.BeaconConfig().SlotsPerEpoch; i++ {
+		bits := bitfield.NewBitlist(2)
+		bits.SetBitAt(0, true)
+		bits.SetBitAt(1, true)
+		atts = append(atts, &pb.PendingAttestation{
+			Data: &ethpb.AttestationData{
+				Slot:            params.BeaconConfig().SlotsPerEpoch + i,
+				BeaconBlockRoot: make([]byte, 32),
+				Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+				Target:          &ethpb.Checkpoint{Epoch: 1, Root: make([]byte, 32)},
+			},
+			InclusionDelay:  1,
+			AggregationBits: bits,
+		})
+	}
+	ds := participationServer(t, 1, atts)
+	res, err := ds.ListEpochParticipation(context.Background(), &pbrpc.EpochParticipationRequest{StartEpoch: 1, EndEpoch: 1})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Epochs))
+	p := res.Epochs[0]
+	activeGwei := 64 * params.BeaconConfig().MaxEffectiveBalance
+	assert.Equal(t, uint64(1), p.Epoch)
+	assert.Equal(t, true, p.Complete)
+	assert.Equal(t, activeGwei, p.ActiveGwei)
+	assert.Equal(t, activeGwei, p.TargetAttestingGwei)
+	assert.Equal(t, float64(1), p.ParticipationRate)
+	assert.Equal(t, uint64(0), p.TargetMissedValidators)
+	assert.DeepEqual(t, []byte{0b10}, p.JustificationBits)
+	assert.Equal(t, uint64(1), p.JustifiedEpoch)
+	assert.Equal(t, uint64(0), p.FinalizedEpoch)
+	assert.Equal(t, uint64(1), p.FinalityDelay)
+	assert.Equal(t, false, p.InactivityLeak)
+}
+
+func TestServer_ListEpochParticipation_InactivityLeak(t *testing.T) {
+	ds := participationServer(t, 6, nil)
+	res, err := ds.ListEpochParticipation(context.Background(), &pbrpc.EpochParticipationRequest{StartEpoch: 6, EndEpoch: 6})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Epochs))
+	p := res.Epochs[0]
+	assert.Equal(t, uint64(64), p.TargetMissedValidators)
+	assert.Equal(t, uint64(0), p.JustifiedEpoch)
+	assert.Equal(t, uint64(6), p.FinalityDelay)
+	assert.Equal(t, true, p.InactivityLeak)
+}
+
+func TestServer_ListEpochParticipation_InvalidEpochs(t *testing.T) {
+	genesis := time.Now().Add(time.Duration(-1*int64(
+		2*params.BeaconConfig().SlotsPerEpoch*params.BeaconConfig().SecondsPerSlot)) * time.Second)
+	ds := &Server{GenesisTimeFetcher: &mock.ChainService{Genesis: genesis}}
+	_, err := ds.ListEpochParticipation(context.Background(), &pbrpc.EpochParticipationRequest{StartEpoch: 1, EndEpoch: 0})
+	assert.ErrorContains(t, "Start epoch 1 cannot be after end epoch 0", err)
+	_, err = ds.ListEpochParticipation(context.Background(), &pbrpc.EpochParticipationRequest{EndEpoch: maxParticipationEpochs})
+	assert.ErrorContains(t, "Cannot retrieve more than", err)
+	_, err = ds.ListEpochParticipation(context.Background(), &pbrpc.EpochParticipationRequest{StartEpoch: 2, EndEpoch: 2})
+	assert.ErrorContains(t, "Cannot retrieve participation of an epoch which is not over", err)
+}
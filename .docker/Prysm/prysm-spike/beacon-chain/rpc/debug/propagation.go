@@ -0,0 +1,38 @@
+package debug
+
+import (
+	"context"
+
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListBlockPropagation returns how the recent gossiped blocks reached the beacon node, the
+// time every peer first delivered them, most recently first seen first.
+func (ds *Server) ListBlockPropagation(_ context.Context, req *pbrpc.BlockPropagationRequest) (*pbrpc.BlockPropagationResponse, error) {
+	if ds.BlockPropagation == nil {
+		return nil, status.Error(codes.Unavailable, "Block propagation tracing is not enabled")
+	}
+	traces := ds.BlockPropagation.Traces(int(req.Limit))
+	blocks := make([]*pbrpc.BlockPropagation, len(traces))
+	for i, trace := range traces {
+		arrivals := make([]*pbrpc.BlockArrival, len(trace.Arrivals))
+		for j, arrival := range trace.Arrivals {
+			arrivals[j] = &pbrpc.BlockArrival{
+				PeerId:           arrival.Peer,
+				UnixMs:           arrival.Time.UnixNano() / 1e6,
+				SinceFirstSeenMs: arrival.SinceFirstSeenMs,
+			}
+		}
+		blocks[i] = &pbrpc.BlockPropagation{
+			MessageId:        trace.MessageID,
+			BlockRoot:        trace.BlockRoot,
+			Slot:             trace.Slot,
+			FirstSeenUnixMs:  trace.FirstSeen.UnixNano() / 1e6,
+			SinceSlotStartMs: trace.SinceSlotStartMs,
+			Arrivals:         arrivals,
+		}
+	}
+	return &pbrpc.BlockPropagationResponse{Blocks: blocks}, nil
+}
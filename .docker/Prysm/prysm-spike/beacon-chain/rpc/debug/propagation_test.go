@@ -0,0 +1,50 @@
+package debug
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_ListBlockPropagation(t *testing.T) {
+	ds := &Server{}
+	_, err := ds.ListBlockPropagation(context.Background(), &pbrpc.BlockPropagationRequest{})
+	assert.ErrorContains(t, "not enabled", err)
+
+	ds.BlockPropagation = p2p.NewBlockPropagationTracer()
+	topic := "/eth2/01020304/beacon_block/ssz_snappy"
+	start := time.Unix(1600000000, 0)
+	for i, id := range []string{"a", "b", "c"} {
+		ts := start.Add(time.Duration(i) * time.Second).UnixNano()
+		ds.BlockPropagation.Trace(&pubsubpb.TraceEvent{
+			Type:      pubsubpb.TraceEvent_RECV_RPC.Enum(),
+			Timestamp: &ts,
+			RecvRPC: &pubsubpb.TraceEvent_RecvRPC{
+				ReceivedFrom: []byte("peer"),
+				Meta: &pubsubpb.TraceEvent_RPCMeta{
+					Messages: []*pubsubpb.TraceEvent_MessageMeta{{MessageID: []byte(id), Topic: &topic}},
+				},
+			},
+		})
+	}
+
+	res, err := ds.ListBlockPropagation(context.Background(), &pbrpc.BlockPropagationRequest{Limit: 2})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Blocks))
+	assert.DeepEqual(t, []byte("c"), res.Blocks[0].MessageId)
+	assert.Equal(t, start.Add(2*time.Second).UnixNano()/1e6, res.Blocks[0].FirstSeenUnixMs)
+	require.Equal(t, 1, len(res.Blocks[0].Arrivals))
+	assert.Equal(t, peer.ID("peer").String(), res.Blocks[0].Arrivals[0].PeerId)
+	assert.DeepEqual(t, []byte("b"), res.Blocks[1].MessageId)
+
+	res, err = ds.ListBlockPropagation(context.Background(), &pbrpc.BlockPropagationRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, 3, len(res.Blocks))
+}
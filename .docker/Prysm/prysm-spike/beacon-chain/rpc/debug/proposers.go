@@ -0,0 +1,136 @@
+package debug
+
+import (
+	"context"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetProposerLookahead returns the proposers of the current epoch computed from the head state,
+// as the validator duties are. The proposers of the next epoch are returned once the head is at
+// the last slot of the current epoch, since until then a new block changes the dependent root
+// and the effective balances they are derived from.
+func (ds *Server) GetProposerLookahead(ctx context.Context, _ *ptypes.Empty) (*pbrpc.ProposerLookaheadResponse, error) {
+	headRoot, err := ds.HeadFetcher.HeadRoot(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head root: %v", err)
+	}
+	st, err := ds.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	if st == nil {
+		return nil, status.Error(codes.Unavailable, "Head state is not available")
+	}
+	headSlot := st.Slot()
+	epoch := helpers.SlotToEpoch(ds.GenesisTimeFetcher.CurrentSlot())
+	if headEpoch := helpers.SlotToEpoch(headSlot); headEpoch > epoch {
+		epoch = headEpoch
+	}
+	resp := &pbrpc.ProposerLookaheadResponse{
+		HeadRoot: headRoot,
+		HeadSlot: headSlot,
+	}
+
+	schedule, st, err := ds.proposerSchedule(ctx, st, epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute proposers of epoch %d: %v", epoch, err)
+	}
+	resp.Epochs = append(resp.Epochs, schedule)
+
+	nextStartSlot, err := helpers.StartSlot(epoch + 1)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get start slot of epoch %d: %v", epoch+1, err)
+	}
+	if headSlot+1 == nextStartSlot {
+		schedule, _, err = ds.proposerSchedule(ctx, st, epoch+1)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not compute proposers of epoch %d: %v", epoch+1, err)
+		}
+		resp.Epochs = append(resp.Epochs, schedule)
+	}
+	return resp, nil
+}
+
+// proposerSchedule advances the state to the start of the epoch with empty slots if it is behind,
+// and computes the proposers of the epoch. It returns the advanced state.
+func (ds *Server) proposerSchedule(
+	ctx context.Context,
+	st *stateTrie.BeaconState,
+	epoch uint64,
+) (*pbrpc.EpochProposerSchedule, *stateTrie.BeaconState, error) {
+	startSlot, err := helpers.StartSlot(epoch)
+	if err != nil {
+		return nil, nil, err
+	}
+	if st.Slot() < startSlot {
+		st, err = state.ProcessSlots(ctx, st, startSlot)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "could not process slots up to %d", startSlot)
+		}
+	}
+
+	schedule := &pbrpc.EpochProposerSchedule{Epoch: epoch}
+	if epoch == 0 {
+		genesisBlock, err := ds.BeaconDB.GenesisBlock(ctx)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "could not get genesis block")
+		}
+		if genesisBlock == nil || genesisBlock.Block == nil {
+			return nil, nil, errors.New("genesis block does not exist")
+		}
+		root, err := genesisBlock.Block.HashTreeRoot()
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "could not hash genesis block")
+		}
+		schedule.DependentRoot = root[:]
+	} else {
+		// The proposer shuffling of an epoch depends on the latest block before the epoch starts.
+		schedule.DependentRoot, err = helpers.BlockRootAtSlot(st, startSlot-1)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "could not get proposer dependent root")
+		}
+	}
+	// The mix the proposer seed is derived from, as in helpers.Seed.
+	schedule.RandaoMix, err = helpers.RandaoMix(st, epoch+params.BeaconConfig().EpochsPerHistoricalVector-params.BeaconConfig().MinSeedLookahead-1)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not get randao mix")
+	}
+	seed, err := helpers.Seed(st, epoch, params.BeaconConfig().DomainBeaconProposer)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not get proposer seed")
+	}
+	schedule.Seed = seed[:]
+
+	// Proposer indices are computed from the slot of the state, which is moved on a copy so the
+	// returned state stays at the start of the epoch.
+	slotState := st.Copy()
+	for slot := startSlot; slot < startSlot+params.BeaconConfig().SlotsPerEpoch; slot++ {
+		// Skip proposer assignment for genesis slot.
+		if slot == 0 {
+			continue
+		}
+		if err := slotState.SetSlot(slot); err != nil {
+			return nil, nil, err
+		}
+		idx, err := helpers.BeaconProposerIndex(slotState)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "could not get proposer at slot %d", slot)
+		}
+		pubKey := slotState.PubkeyAtIndex(idx)
+		schedule.Proposers = append(schedule.Proposers, &pbrpc.ProposerAssignment{
+			Slot:           slot,
+			ValidatorIndex: idx,
+			PublicKey:      pubKey[:],
+		})
+	}
+	return schedule, st, nil
+}
@@ -0,0 +1,98 @@
+package debug
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func assertProposerSchedule(t *testing.T, st *stateTrie.BeaconState, schedule *pbrpc.EpochProposerSchedule) {
+	startSlot, err := helpers.StartSlot(schedule.Epoch)
+	require.NoError(t, err)
+	// The genesis slot has no proposer.
+	if startSlot == 0 {
+		startSlot = 1
+	}
+	require.Equal(t, params.BeaconConfig().SlotsPerEpoch-startSlot%params.BeaconConfig().SlotsPerEpoch, uint64(len(schedule.Proposers)))
+	seed, err := helpers.Seed(st, schedule.Epoch, params.BeaconConfig().DomainBeaconProposer)
+	require.NoError(t, err)
+	assert.DeepEqual(t, seed[:], schedule.Seed)
+	for i, p := range schedule.Proposers {
+		assert.Equal(t, startSlot+uint64(i), p.Slot)
+		require.NoError(t, st.SetSlot(p.Slot))
+		idx, err := helpers.BeaconProposerIndex(st)
+		require.NoError(t, err)
+		assert.Equal(t, idx, p.ValidatorIndex)
+		pubKey := st.PubkeyAtIndex(idx)
+		assert.DeepEqual(t, pubKey[:], p.PublicKey)
+	}
+}
+
+func TestServer_GetProposerLookahead(t *testing.T) {
+	db, _ := dbTest.SetupDB(t)
+	ctx := context.Background()
+	genesis, _ := testutil.DeterministicGenesisState(t, 64)
+	genesisBlock := testutil.NewBeaconBlock()
+	require.NoError(t, db.SaveBlock(ctx, genesisBlock))
+	genesisRoot, err := genesisBlock.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisRoot))
+
+	// Only the current epoch is returned until the head is at its last slot.
+	ds := &Server{
+		BeaconDB:           db,
+		HeadFetcher:        &mock.ChainService{State: genesis.Copy(), Root: genesisRoot[:]},
+		GenesisTimeFetcher: &mock.ChainService{Genesis: time.Now()},
+	}
+	resp, err := ds.GetProposerLookahead(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, genesisRoot[:], resp.HeadRoot)
+	require.Equal(t, 1, len(resp.Epochs))
+	assert.Equal(t, uint64(0), resp.Epochs[0].Epoch)
+	assert.DeepEqual(t, genesisRoot[:], resp.Epochs[0].DependentRoot)
+	assertProposerSchedule(t, genesis.Copy(), resp.Epochs[0])
+
+	// The head at the last slot of the epoch also returns the next epoch.
+	lastSlot := params.BeaconConfig().SlotsPerEpoch - 1
+	head, err := state.ProcessSlots(ctx, genesis.Copy(), lastSlot)
+	require.NoError(t, err)
+	ds.HeadFetcher = &mock.ChainService{State: head.Copy(), Root: genesisRoot[:]}
+	resp, err = ds.GetProposerLookahead(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, lastSlot, resp.HeadSlot)
+	require.Equal(t, 2, len(resp.Epochs))
+	next, err := state.ProcessSlots(ctx, head.Copy(), lastSlot+1)
+	require.NoError(t, err)
+	dependentRoot, err := helpers.BlockRootAtSlot(next, lastSlot)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), resp.Epochs[1].Epoch)
+	assert.DeepEqual(t, dependentRoot, resp.Epochs[1].DependentRoot)
+	assertProposerSchedule(t, next, resp.Epochs[1])
+
+	// A head behind the current epoch is advanced with empty slots.
+	slotDuration := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	ds.HeadFetcher = &mock.ChainService{State: genesis.Copy(), Root: genesisRoot[:]}
+	ds.GenesisTimeFetcher = &mock.ChainService{Genesis: time.Now().Add(-time.Duration(2*params.BeaconConfig().SlotsPerEpoch) * slotDuration)}
+	resp, err = ds.GetProposerLookahead(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Epochs))
+	assert.Equal(t, uint64(2), resp.Epochs[0].Epoch)
+	advanced, err := state.ProcessSlots(ctx, genesis.Copy(), 2*params.BeaconConfig().SlotsPerEpoch)
+	require.NoError(t, err)
+	mix, err := helpers.RandaoMix(advanced, 2+params.BeaconConfig().EpochsPerHistoricalVector-params.BeaconConfig().MinSeedLookahead-1)
+	require.NoError(t, err)
+	assert.DeepEqual(t, mix, resp.Epochs[0].RandaoMix)
+	assertProposerSchedule(t, advanced, resp.Epochs[0])
+}
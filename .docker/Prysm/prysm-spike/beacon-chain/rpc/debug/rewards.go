@@ -0,0 +1,199 @@
+package debug
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/attestationutil"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/mathutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetBlockRewards returns the breakdown of the proposer rewards earned by a block, computed by
+// replaying the block on top of its parent state.
+func (ds *Server) GetBlockRewards(
+	ctx context.Context,
+	req *pbrpc.BlockRewardsRequest,
+) (*pbrpc.BlockRewardsResponse, error) {
+	root := bytesutil.ToBytes32(req.BlockRoot)
+	signedBlock, err := ds.BeaconDB.Block(ctx, root)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve block by root: %v", err)
+	}
+	if signedBlock == nil || signedBlock.Block == nil {
+		return nil, status.Errorf(codes.NotFound, "Could not find block with root %#x", req.BlockRoot)
+	}
+	blk := signedBlock.Block
+	resp := &pbrpc.BlockRewardsResponse{
+		BlockRoot:     root[:],
+		Slot:          blk.Slot,
+		ProposerIndex: blk.ProposerIndex,
+	}
+	// The genesis block is not proposed and earns no rewards.
+	if blk.Slot == 0 {
+		return resp, nil
+	}
+
+	preState, err := ds.StateGen.StateByRoot(ctx, bytesutil.ToBytes32(blk.ParentRoot))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve parent state: %v", err)
+	}
+	if preState == nil {
+		return nil, status.Errorf(codes.NotFound, "Could not find parent state of block %#x", req.BlockRoot)
+	}
+	preState, err = state.ProcessSlots(ctx, preState, blk.Slot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not process slots: %v", err)
+	}
+	if err := blockRewards(ctx, preState, signedBlock, resp); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute block rewards: %v", err)
+	}
+	return resp, nil
+}
+
+// blockRewards applies the operations of a block which reward its proposer to the state at the
+// slot of the block and fills in the rewards they yield.
+func blockRewards(
+	ctx context.Context,
+	st *stateTrie.BeaconState,
+	signed *ethpb.SignedBeaconBlock,
+	resp *pbrpc.BlockRewardsResponse,
+) error {
+	body := signed.Block.Body
+
+	// In phase 0 the proposer is the whistleblower of every slashing it includes, so it earns the
+	// whole whistleblower reward of each validator the block slashes.
+	var candidates []uint64
+	for _, s := range body.ProposerSlashings {
+		candidates = append(candidates, s.Header_1.Header.ProposerIndex)
+	}
+	reward, err := slashingRewards(st, candidates, func() error {
+		var err error
+		st, err = blocks.ProcessProposerSlashings(ctx, st, signed)
+		return err
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not process proposer slashings")
+	}
+	resp.ProposerSlashings = reward
+
+	candidates = nil
+	for _, s := range body.AttesterSlashings {
+		candidates = append(candidates, sliceutil.IntersectionUint64(s.Attestation_1.AttestingIndices, s.Attestation_2.AttestingIndices)...)
+	}
+	reward, err = slashingRewards(st, candidates, func() error {
+		var err error
+		st, err = blocks.ProcessAttesterSlashings(ctx, st, signed)
+		return err
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not process attester slashings")
+	}
+	resp.AttesterSlashings = reward
+
+	st, err = blocks.ProcessAttestationsNoVerifySignature(ctx, st, signed)
+	if err != nil {
+		return errors.Wrap(err, "could not process attestations")
+	}
+	resp.AttestationInclusion, err = inclusionRewards(st, signed.Block)
+	if err != nil {
+		return err
+	}
+
+	resp.Total = resp.AttestationInclusion + resp.ProposerSlashings + resp.AttesterSlashings + resp.SyncAggregate
+	return nil
+}
+
+// slashingRewards runs process and sums the whistleblower rewards of the candidates it slashed.
+func slashingRewards(st *stateTrie.BeaconState, candidates []uint64, process func() error) (uint64, error) {
+	wasSlashed := make(map[uint64]bool, len(candidates))
+	for _, idx := range candidates {
+		val, err := st.ValidatorAtIndexReadOnly(idx)
+		if err != nil {
+			return 0, err
+		}
+		wasSlashed[idx] = val.Slashed()
+	}
+	if err := process(); err != nil {
+		return 0, err
+	}
+	var reward uint64
+	for idx, slashed := range wasSlashed {
+		if slashed {
+			continue
+		}
+		val, err := st.ValidatorAtIndexReadOnly(idx)
+		if err != nil {
+			return 0, err
+		}
+		if val.Slashed() {
+			reward += val.EffectiveBalance() / params.BeaconConfig().WhistleBlowerRewardQuotient
+		}
+	}
+	return reward, nil
+}
+
+// inclusionRewards sums the proposer rewards for the attesters whose earliest included attestation
+// is in the block. The rewards are paid at the end of the epoch following the attestation epoch,
+// and are estimated with the balances of the state after the block was applied.
+//
+// Spec pseudocode definition:
+//    attestation = min([
+//        a for a in matching_source_attestations
+//        if index in get_attesting_indices(state, a.data, a.aggregation_bits)
+//    ], key=lambda a: a.inclusion_delay)
+//    rewards[attestation.proposer_index] += get_proposer_reward(state, index)
+func inclusionRewards(st *stateTrie.BeaconState, blk *ethpb.BeaconBlock) (uint64, error) {
+	totalBalance, err := helpers.TotalActiveBalance(st)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not calculate active balance")
+	}
+	balanceSqrt := mathutil.IntegerSquareRoot(totalBalance)
+	// Balance square root cannot be 0, this prevents division by 0.
+	if balanceSqrt == 0 {
+		balanceSqrt = 1
+	}
+
+	var reward uint64
+	for _, atts := range [][]*pbp2p.PendingAttestation{st.PreviousEpochAttestations(), st.CurrentEpochAttestations()} {
+		earliest := make(map[uint64]*pbp2p.PendingAttestation)
+		for _, a := range atts {
+			committee, err := helpers.BeaconCommitteeFromState(st, a.Data.Slot, a.Data.CommitteeIndex)
+			if err != nil {
+				return 0, errors.Wrap(err, "could not get committee")
+			}
+			for _, idx := range attestationutil.AttestingIndices(a.AggregationBits, committee) {
+				if e, ok := earliest[idx]; !ok || a.InclusionDelay < e.InclusionDelay {
+					earliest[idx] = a
+				}
+			}
+		}
+		for idx, a := range earliest {
+			if a.Data.Slot+a.InclusionDelay != blk.Slot || a.ProposerIndex != blk.ProposerIndex {
+				continue
+			}
+			val, err := st.ValidatorAtIndexReadOnly(idx)
+			if err != nil {
+				return 0, err
+			}
+			// Proposers are not rewarded for attestations of slashed validators.
+			if val.Slashed() {
+				continue
+			}
+			baseReward := val.EffectiveBalance() * params.BeaconConfig().BaseRewardFactor / balanceSqrt / params.BeaconConfig().BaseRewardsPerEpoch
+			reward += baseReward / params.BeaconConfig().ProposerRewardQuotient
+		}
+	}
+	return reward, nil
+}
@@ -0,0 +1,62 @@
+package debug
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/attestationutil"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_GetBlockRewards(t *testing.T) {
+	db, sc := dbTest.SetupDB(t)
+	ctx := context.Background()
+	genesis, privKeys := testutil.DeterministicGenesisState(t, 64)
+	b, err := testutil.GenerateFullBlock(genesis, privKeys, &testutil.BlockGenConfig{
+		NumProposerSlashings: 1,
+		NumAttestations:      1,
+	}, 1)
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, b))
+	root, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	gen := stategen.New(db, sc)
+	parentRoot := bytesutil.ToBytes32(b.Block.ParentRoot)
+	require.NoError(t, gen.SaveState(ctx, parentRoot, genesis))
+	require.NoError(t, db.SaveState(ctx, genesis, parentRoot))
+
+	// Every attester of the single attestation is included for the first time.
+	var wantInclusion uint64
+	att := b.Block.Body.Attestations[0]
+	committee, err := helpers.BeaconCommitteeFromState(genesis, att.Data.Slot, att.Data.CommitteeIndex)
+	require.NoError(t, err)
+	for _, idx := range attestationutil.AttestingIndices(att.AggregationBits, committee) {
+		baseReward, err := epoch.BaseReward(genesis, idx)
+		require.NoError(t, err)
+		wantInclusion += baseReward / params.BeaconConfig().ProposerRewardQuotient
+	}
+	wantSlashing := params.BeaconConfig().MaxEffectiveBalance / params.BeaconConfig().WhistleBlowerRewardQuotient
+
+	ds := &Server{BeaconDB: db, StateGen: gen}
+	res, err := ds.GetBlockRewards(ctx, &pbrpc.BlockRewardsRequest{BlockRoot: root[:]})
+	require.NoError(t, err)
+	assert.Equal(t, b.Block.ProposerIndex, res.ProposerIndex)
+	assert.NotEqual(t, uint64(0), wantInclusion)
+	assert.Equal(t, wantInclusion, res.AttestationInclusion)
+	assert.Equal(t, wantSlashing, res.ProposerSlashings)
+	assert.Equal(t, uint64(0), res.AttesterSlashings)
+	assert.Equal(t, uint64(0), res.SyncAggregate)
+	assert.Equal(t, wantInclusion+wantSlashing, res.Total)
+
+	_, err = ds.GetBlockRewards(ctx, &pbrpc.BlockRewardsRequest{BlockRoot: []byte{'a'}})
+	assert.ErrorContains(t, "Could not find block", err)
+}
@@ -12,14 +12,21 @@ import (
 	golog "github.com/ipfs/go-log/v2"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/localops"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	sharedpb "github.com/prysmaticlabs/prysm/proto/shared/v1"
+	"github.com/prysmaticlabs/prysm/shared/configdump"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+var log = logrus.WithField("prefix", "rpc/debug")
+
 // Server defines a server implementation of the gRPC Debug service,
 // providing RPC endpoints for runtime debugging of a node, this server is
 // gated behind the feature flag --enable-debug-rpc-endpoints.
@@ -30,6 +37,16 @@ type Server struct {
 	HeadFetcher        blockchain.HeadFetcher
 	PeerManager        p2p.PeerManager
 	PeersFetcher       p2p.PeersProvider
+	LocalOperations    localops.Tracker
+	HeadRecomputer     blockchain.HeadRecomputer
+	SeenCacheFlusher   sync.SeenCacheFlusher
+	CanonicalFetcher   blockchain.CanonicalFetcher
+	BlockPropagation   *p2p.BlockPropagationTracer
+	InboundLimiter     p2p.InboundLimiter
+	PeerListProvider   p2p.PeerListProvider
+	DepositSnapshotter powchain.DepositSnapshotter
+	RunningConfig      func() (*configdump.Config, error)
+	Inventory          func() (*sharedpb.Inventory, error)
 }
 
 // SetLoggingLevel of a beacon node according to a request type,
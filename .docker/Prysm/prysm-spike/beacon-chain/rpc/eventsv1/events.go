@@ -0,0 +1,150 @@
+package eventsv1
+
+import (
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	headTopic                = "head"
+	blockTopic               = "block"
+	attestationTopic         = "attestation"
+	finalizedCheckpointTopic = "finalized_checkpoint"
+	chainReorgTopic          = "chain_reorg"
+)
+
+// topics are the event topics of the standard API which may be requested.
+var topics = map[string]bool{
+	headTopic:                true,
+	blockTopic:               true,
+	attestationTopic:         true,
+	finalizedCheckpointTopic: true,
+	chainReorgTopic:          true,
+}
+
+// StreamEvents streams the events of the requested topics as they are sent over the state and operation feeds
+// of the beacon node. The response headers are sent as soon as the topics were accepted.
+func (s *Server) StreamEvents(req *pbrpc.StreamEventsRequest, stream pbrpc.Events_StreamEventsServer) error {
+	if len(req.Topics) == 0 {
+		return status.Error(codes.InvalidArgument, "No topics requested")
+	}
+	requested := make(map[string]bool, len(req.Topics))
+	for _, topic := range req.Topics {
+		if !topics[topic] {
+			return status.Errorf(codes.InvalidArgument, "Topic %s not allowed for event subscriptions", topic)
+		}
+		requested[topic] = true
+	}
+
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.StateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	opsChannel := make(chan *feed.Event, 1)
+	opsSub := s.OperationNotifier.OperationFeed().Subscribe(opsChannel)
+	defer opsSub.Unsubscribe()
+	// Sending the headers tells clients the topics were accepted before the first event arrives.
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+	}
+
+	for {
+		var event *pbrpc.Event
+		select {
+		case stateEvent := <-stateChannel:
+			event = stateEventToV1(stateEvent, requested)
+		case opsEvent := <-opsChannel:
+			event = operationEventToV1(opsEvent, requested)
+		case <-stateSub.Err():
+			return status.Error(codes.Aborted, "Subscriber closed, exiting goroutine")
+		case <-opsSub.Err():
+			return status.Error(codes.Aborted, "Subscriber closed, exiting goroutine")
+		case <-s.Ctx.Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		}
+		if event == nil {
+			continue
+		}
+		if err := stream.Send(event); err != nil {
+			return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+		}
+	}
+}
+
+// stateEventToV1 returns the event of the standard API for an event of the state feed, or nil when its topic was
+// not requested.
+func stateEventToV1(event *feed.Event, requested map[string]bool) *pbrpc.Event {
+	switch data := event.Data.(type) {
+	case *statefeed.NewHeadData:
+		if event.Type != statefeed.NewHead || !requested[headTopic] {
+			return nil
+		}
+		return &pbrpc.Event{Event: &pbrpc.Event_Head{Head: &pbrpc.EventHead{
+			Slot:                      data.Slot,
+			Block:                     data.BlockRoot[:],
+			State:                     data.StateRoot[:],
+			EpochTransition:           helpers.IsEpochStart(data.Slot),
+			PreviousDutyDependentRoot: data.PreviousDutyDependentRoot[:],
+			CurrentDutyDependentRoot:  data.CurrentDutyDependentRoot[:],
+		}}}
+	case *statefeed.BlockProcessedData:
+		if event.Type != statefeed.BlockProcessed || !requested[blockTopic] {
+			return nil
+		}
+		return &pbrpc.Event{Event: &pbrpc.Event_Block{Block: &pbrpc.EventBlock{
+			Slot:  data.Slot,
+			Block: data.BlockRoot[:],
+		}}}
+	case *statefeed.FinalizedCheckpointData:
+		if event.Type != statefeed.FinalizedCheckpoint || !requested[finalizedCheckpointTopic] {
+			return nil
+		}
+		return &pbrpc.Event{Event: &pbrpc.Event_FinalizedCheckpoint{FinalizedCheckpoint: &pbrpc.EventFinalizedCheckpoint{
+			Block: data.Block[:],
+			State: data.State[:],
+			Epoch: data.Epoch,
+		}}}
+	case *statefeed.ReorgData:
+		if event.Type != statefeed.Reorg || !requested[chainReorgTopic] {
+			return nil
+		}
+		return &pbrpc.Event{Event: &pbrpc.Event_ChainReorg{ChainReorg: &pbrpc.EventChainReorg{
+			Slot:         data.NewSlot,
+			Depth:        data.Depth,
+			OldHeadBlock: data.OldHeadBlock[:],
+			NewHeadBlock: data.NewHeadBlock[:],
+			OldHeadState: data.OldHeadState[:],
+			NewHeadState: data.NewHeadState[:],
+			Epoch:        helpers.SlotToEpoch(data.NewSlot),
+		}}}
+	}
+	return nil
+}
+
+// operationEventToV1 returns the event of the standard API for an event of the operation feed, or nil when its
+// topic was not requested. Aggregates are sent as the attestation they aggregate.
+func operationEventToV1(event *feed.Event, requested map[string]bool) *pbrpc.Event {
+	if !requested[attestationTopic] {
+		return nil
+	}
+	switch data := event.Data.(type) {
+	case *opfeed.UnAggregatedAttReceivedData:
+		if data.Attestation == nil {
+			return nil
+		}
+		return &pbrpc.Event{Event: &pbrpc.Event_Attestation{Attestation: data.Attestation}}
+	case *opfeed.AggregatedAttReceivedData:
+		if data.Attestation == nil || data.Attestation.Aggregate == nil {
+			return nil
+		}
+		return &pbrpc.Event{Event: &pbrpc.Event_Attestation{Attestation: data.Attestation.Aggregate}}
+	}
+	return nil
+}
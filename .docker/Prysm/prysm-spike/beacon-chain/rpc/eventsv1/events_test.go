@@ -0,0 +1,140 @@
+package eventsv1
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type notifier struct {
+	stateFeed     event.Feed
+	operationFeed event.Feed
+}
+
+func (n *notifier) StateFeed() *event.Feed {
+	return &n.stateFeed
+}
+
+func (n *notifier) OperationFeed() *event.Feed {
+	return &n.operationFeed
+}
+
+// eventStream passes the events sent by the server to the test.
+type eventStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *pbrpc.Event
+}
+
+func (s *eventStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *eventStream) SendHeader(metadata.MD) error {
+	return nil
+}
+
+func (s *eventStream) Send(event *pbrpc.Event) error {
+	s.events <- event
+	return nil
+}
+
+// streamEvents streams the events of the topics until the test ends.
+func streamEvents(t *testing.T, n *notifier, topics ...string) *eventStream {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	stream := &eventStream{ctx: ctx, events: make(chan *pbrpc.Event, 1)}
+	server := &Server{Ctx: context.Background(), StateNotifier: n, OperationNotifier: n}
+	go func() {
+		err := server.StreamEvents(&pbrpc.StreamEventsRequest{Topics: topics}, stream)
+		assert.Equal(t, codes.Canceled, status.Code(err))
+	}()
+	return stream
+}
+
+// send sends the event over the feed once the server subscribed to it.
+func send(f *event.Feed, e *feed.Event) {
+	for sent := 0; sent == 0; {
+		sent = f.Send(e)
+	}
+}
+
+func TestStreamEvents_InvalidTopics(t *testing.T) {
+	server := &Server{Ctx: context.Background(), StateNotifier: &notifier{}, OperationNotifier: &notifier{}}
+	err := server.StreamEvents(&pbrpc.StreamEventsRequest{}, &eventStream{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	err = server.StreamEvents(&pbrpc.StreamEventsRequest{Topics: []string{headTopic, "foo"}}, &eventStream{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestStreamEvents_StateEvents(t *testing.T) {
+	n := &notifier{}
+	stream := streamEvents(t, n, headTopic, chainReorgTopic)
+	blockRoot, stateRoot := [32]byte{'a'}, [32]byte{'b'}
+	previousRoot, currentRoot := [32]byte{'c'}, [32]byte{'d'}
+
+	// Events of topics which were not requested are skipped.
+	send(n.StateFeed(), &feed.Event{
+		Type: statefeed.BlockProcessed,
+		Data: &statefeed.BlockProcessedData{Slot: 32, BlockRoot: blockRoot},
+	})
+	send(n.StateFeed(), &feed.Event{
+		Type: statefeed.NewHead,
+		Data: &statefeed.NewHeadData{
+			Slot:                      32,
+			BlockRoot:                 blockRoot,
+			StateRoot:                 stateRoot,
+			PreviousDutyDependentRoot: previousRoot,
+			CurrentDutyDependentRoot:  currentRoot,
+		},
+	})
+	head := (<-stream.events).GetHead()
+	require.NotNil(t, head)
+	assert.Equal(t, uint64(32), head.Slot)
+	assert.Equal(t, true, head.EpochTransition)
+	assert.DeepEqual(t, blockRoot[:], head.Block)
+	assert.DeepEqual(t, stateRoot[:], head.State)
+	assert.DeepEqual(t, previousRoot[:], head.PreviousDutyDependentRoot)
+	assert.DeepEqual(t, currentRoot[:], head.CurrentDutyDependentRoot)
+
+	send(n.StateFeed(), &feed.Event{
+		Type: statefeed.Reorg,
+		Data: &statefeed.ReorgData{NewSlot: 70, OldSlot: 69, Depth: 2},
+	})
+	reorg := (<-stream.events).GetChainReorg()
+	require.NotNil(t, reorg)
+	assert.Equal(t, uint64(70), reorg.Slot)
+	assert.Equal(t, uint64(2), reorg.Depth)
+	assert.Equal(t, uint64(2), reorg.Epoch)
+}
+
+func TestStreamEvents_Attestations(t *testing.T) {
+	n := &notifier{}
+	stream := streamEvents(t, n, attestationTopic)
+
+	att := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 1}}
+	send(n.OperationFeed(), &feed.Event{
+		Type: opfeed.UnaggregatedAttReceived,
+		Data: &opfeed.UnAggregatedAttReceivedData{Attestation: att},
+	})
+	assert.DeepEqual(t, att, (<-stream.events).GetAttestation())
+
+	aggregate := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 2}}
+	send(n.OperationFeed(), &feed.Event{
+		Type: opfeed.AggregatedAttReceived,
+		Data: &opfeed.AggregatedAttReceivedData{Attestation: &ethpb.AggregateAttestationAndProof{Aggregate: aggregate}},
+	})
+	assert.DeepEqual(t, aggregate, (<-stream.events).GetAttestation())
+}
@@ -0,0 +1,19 @@
+// Package eventsv1 defines a gRPC events service implementation, streaming the
+// head, block, attestation, finalized checkpoint and chain reorg events of the
+// standard API.
+package eventsv1
+
+import (
+	"context"
+
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+)
+
+// Server defines a server implementation of the gRPC Events service,
+// streaming the events of the beacon node from its state and operation feeds.
+type Server struct {
+	Ctx               context.Context
+	StateNotifier     statefeed.Notifier
+	OperationNotifier opfeed.Notifier
+}
@@ -0,0 +1,7 @@
+package eventsv1
+
+import (
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+)
+
+var _ pbrpc.EventsServer = (*Server)(nil)
@@ -0,0 +1,86 @@
+package node
+
+import (
+	"context"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StreamHeadEvents streams the head of the chain every time it changes, starting with the current
+// head, along with the roots of the blocks the duties of the head epoch depend on. Clients compare
+// the dependent roots between events to find the duties invalidated by a reorg.
+func (ns *Server) StreamHeadEvents(_ *ptypes.Empty, stream pbrpc.Health_StreamHeadEventsServer) error {
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := ns.StateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+
+	if err := ns.sendHeadEvent(stream); err != nil {
+		return err
+	}
+	for {
+		select {
+		case event := <-stateChannel:
+			if event.Type == statefeed.NewHead {
+				if err := ns.sendHeadEvent(stream); err != nil {
+					return err
+				}
+			}
+		case <-stateSub.Err():
+			return status.Error(codes.Aborted, "Subscriber closed, exiting goroutine")
+		case <-ns.Ctx.Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		}
+	}
+}
+
+func (ns *Server) sendHeadEvent(stream pbrpc.Health_StreamHeadEventsServer) error {
+	event, err := ns.headEvent(stream.Context())
+	if err != nil {
+		return err
+	}
+	if err := stream.Send(event); err != nil {
+		return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+	}
+	return nil
+}
+
+// headEvent returns the head of the chain along with its duty dependent roots.
+func (ns *Server) headEvent(ctx context.Context) (*pbrpc.HeadEvent, error) {
+	headRoot, err := ns.HeadFetcher.HeadRoot(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head root: %v", err)
+	}
+	headState, err := ns.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	if headState == nil {
+		return nil, status.Error(codes.Internal, "Head state of chain was nil")
+	}
+	epoch := helpers.SlotToEpoch(headState.Slot())
+	currentRoot, err := helpers.DutyDependentRoot(headState, headRoot, epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get current duty dependent root: %v", err)
+	}
+	previousRoot := currentRoot
+	if epoch > 0 {
+		previousRoot, err = helpers.DutyDependentRoot(headState, headRoot, epoch-1)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get previous duty dependent root: %v", err)
+		}
+	}
+	return &pbrpc.HeadEvent{
+		Slot:                      headState.Slot(),
+		BlockRoot:                 headRoot,
+		PreviousDutyDependentRoot: previousRoot,
+		CurrentDutyDependentRoot:  currentRoot,
+	}, nil
+}
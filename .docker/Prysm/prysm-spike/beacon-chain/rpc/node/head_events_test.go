@@ -0,0 +1,52 @@
+package node
+
+import (
+	"context"
+	"testing"
+
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_headEvent(t *testing.T) {
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	s := testutil.NewBeaconState()
+	require.NoError(t, s.SetSlot(2*slotsPerEpoch+3))
+	// Every slot has a block whose root is its slot.
+	for slot := uint64(0); slot < s.Slot(); slot++ {
+		require.NoError(t, s.UpdateBlockRootAtIndex(slot, bytesutil.ToBytes32(bytesutil.Bytes8(slot))))
+	}
+	headRoot := bytesutil.PadTo([]byte("head"), 32)
+	ns := &Server{HeadFetcher: &mock.ChainService{State: s, Root: headRoot}}
+
+	event, err := ns.headEvent(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, s.Slot(), event.Slot)
+	assert.DeepEqual(t, headRoot, event.BlockRoot)
+	assert.DeepEqual(t, bytesutil.PadTo(bytesutil.Bytes8(slotsPerEpoch-1), 32), event.PreviousDutyDependentRoot)
+	assert.DeepEqual(t, bytesutil.PadTo(bytesutil.Bytes8(2*slotsPerEpoch-1), 32), event.CurrentDutyDependentRoot)
+}
+
+func TestServer_headEvent_GenesisEpoch(t *testing.T) {
+	s := testutil.NewBeaconState()
+	headRoot := bytesutil.PadTo([]byte("genesis"), 32)
+	ns := &Server{HeadFetcher: &mock.ChainService{State: s, Root: headRoot}}
+
+	// At genesis the duties depend on the genesis block, which is the head.
+	event, err := ns.headEvent(context.Background())
+	require.NoError(t, err)
+	assert.DeepEqual(t, headRoot, event.PreviousDutyDependentRoot)
+	assert.DeepEqual(t, headRoot, event.CurrentDutyDependentRoot)
+
+	genesisRoot := bytesutil.PadTo([]byte("block 0"), 32)
+	require.NoError(t, s.UpdateBlockRootAtIndex(0, bytesutil.ToBytes32(genesisRoot)))
+	require.NoError(t, s.SetSlot(5))
+	event, err = ns.headEvent(context.Background())
+	require.NoError(t, err)
+	assert.DeepEqual(t, genesisRoot, event.PreviousDutyDependentRoot)
+	assert.DeepEqual(t, genesisRoot, event.CurrentDutyDependentRoot)
+}
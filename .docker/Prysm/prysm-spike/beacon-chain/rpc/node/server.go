@@ -14,10 +14,12 @@ import (
 	"github.com/libp2p/go-libp2p-core/peer"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
 	"github.com/prysmaticlabs/prysm/shared/version"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -28,6 +30,7 @@ import (
 // providing RPC endpoints for verifying a beacon node's sync status, genesis and
 // version information, and services the node implements and runs.
 type Server struct {
+	Ctx                  context.Context
 	SyncChecker          sync.Checker
 	Server               *grpc.Server
 	BeaconDB             db.ReadOnlyDatabase
@@ -35,6 +38,8 @@ type Server struct {
 	PeerManager          p2p.PeerManager
 	GenesisTimeFetcher   blockchain.TimeFetcher
 	GenesisFetcher       blockchain.GenesisFetcher
+	HeadFetcher          blockchain.HeadFetcher
+	StateNotifier        statefeed.Notifier
 	BeaconMonitoringHost string
 	BeaconMonitoringPort int
 }
@@ -127,6 +132,15 @@ func (ns *Server) GetLogsEndpoint(_ context.Context, _ *ptypes.Empty) (*pbrpc.Lo
 	}, nil
 }
 
+// GetCapabilities returns the RPC API version and the optional capabilities of the beacon node.
+func (ns *Server) GetCapabilities(_ context.Context, _ *ptypes.Empty) (*pbrpc.CapabilitiesResponse, error) {
+	return &pbrpc.CapabilitiesResponse{
+		ApiVersion:   grpcutils.APIVersion,
+		Capabilities: grpcutils.BeaconCapabilities,
+		Version:      version.GetVersion(),
+	}, nil
+}
+
 // GetPeer returns the data known about the peer defined by the provided peer id.
 func (ns *Server) GetPeer(_ context.Context, peerReq *ethpb.PeerRequest) (*ethpb.Peer, error) {
 	pid, err := peer.Decode(peerReq.PeerId)
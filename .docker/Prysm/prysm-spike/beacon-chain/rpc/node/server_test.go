@@ -17,6 +17,7 @@ import (
 	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
 	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
@@ -91,6 +92,15 @@ func TestNodeServer_GetLogsEndpoint(t *testing.T) {
 	assert.DeepEqual(t, want, res)
 }
 
+func TestNodeServer_GetCapabilities(t *testing.T) {
+	ns := &Server{}
+	res, err := ns.GetCapabilities(context.Background(), &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(grpcutils.APIVersion), res.ApiVersion)
+	assert.DeepEqual(t, grpcutils.BeaconCapabilities, res.Capabilities)
+	assert.Equal(t, version.GetVersion(), res.Version)
+}
+
 func TestNodeServer_GetImplementedServices(t *testing.T) {
 	server := grpc.NewServer()
 	ns := &Server{
@@ -2,37 +2,130 @@ package nodev1
 
 import (
 	"context"
+	"fmt"
+	"net"
+	"runtime"
+	"strings"
 
+	"github.com/ethereum/go-ethereum/p2p/enode"
 	ptypes "github.com/gogo/protobuf/types"
-	"github.com/pkg/errors"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
+	"github.com/prysmaticlabs/prysm/shared/version"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 // GetIdentity retrieves data about the node's network presence.
 func (ns *Server) GetIdentity(ctx context.Context, _ *ptypes.Empty) (*ethpb.IdentityResponse, error) {
-	return nil, errors.New("unimplemented")
+	peerID := ns.PeerManager.PeerID().String()
+	var p2pAddresses []string
+	for _, addr := range ns.PeerManager.Host().Addrs() {
+		p2pAddresses = append(p2pAddresses, fmt.Sprintf("%s/p2p/%s", addr.String(), peerID))
+	}
+	var enr string
+	var discoveryAddresses []string
+	if record := ns.PeerManager.ENR(); record != nil {
+		var err error
+		enr, err = p2p.SerializeENR(record)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not serialize ENR: %v", err)
+		}
+		node, err := enode.New(enode.ValidSchemes, record)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not read ENR: %v", err)
+		}
+		if node.IP() != nil && node.UDP() != 0 {
+			discoveryAddresses = append(discoveryAddresses, discoveryAddress(node.IP(), node.UDP(), peerID))
+		}
+	}
+	localMetadata := &ethpb.Metadata{}
+	if ns.MetadataProvider != nil {
+		if md := ns.MetadataProvider.Metadata(); md != nil {
+			localMetadata.SeqNumber = md.SeqNumber
+			localMetadata.Attnets = md.Attnets
+		}
+	}
+	return &ethpb.IdentityResponse{
+		Data: &ethpb.Identity{
+			PeerId:             peerID,
+			Enr:                enr,
+			P2PAddresses:       p2pAddresses,
+			DiscoveryAddresses: discoveryAddresses,
+			Metadata:           localMetadata,
+		},
+	}, nil
 }
 
 // GetPeer retrieves data about the given peer.
 func (ns *Server) GetPeer(ctx context.Context, req *ethpb.PeerRequest) (*ethpb.PeerResponse, error) {
-	return nil, errors.New("unimplemented")
+	pid, err := peer.Decode(req.PeerId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid peer ID: %v", err)
+	}
+	p, err := ns.peerInfo(pid)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Peer %s not found: %v", req.PeerId, err)
+	}
+	return &ethpb.PeerResponse{Data: p}, nil
 }
 
-// ListPeers retrieves data about the node's network peers.
+// ListPeers retrieves data about the node's network peers. Every peer the node knows of is listed, unless the
+// PeerStateHeader or PeerDirectionHeader request headers restrict the response to some states or directions.
 func (ns *Server) ListPeers(ctx context.Context, _ *ptypes.Empty) (*ethpb.PeersResponse, error) {
-	return nil, errors.New("unimplemented")
+	filters, err := peerFiltersFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid peer filters: %v", err)
+	}
+	all := ns.PeersFetcher.Peers().All()
+	res := make([]*ethpb.Peer, 0, len(all))
+	for _, pid := range all {
+		if ctx.Err() != nil {
+			return nil, status.Errorf(codes.Canceled, "Could not list peers: %v", ctx.Err())
+		}
+		p, err := ns.peerInfo(pid)
+		if err != nil {
+			// The peer was pruned while the list was read.
+			continue
+		}
+		if filters.matches(p) {
+			res = append(res, p)
+		}
+	}
+	return &ethpb.PeersResponse{Data: res}, nil
 }
 
 // GetVersion requests that the beacon node identify information about its implementation in a
 // format similar to a HTTP User-Agent field.
 func (ns *Server) GetVersion(ctx context.Context, _ *ptypes.Empty) (*ethpb.VersionResponse, error) {
-	return nil, errors.New("unimplemented")
+	return &ethpb.VersionResponse{
+		Data: &ethpb.Version{
+			Version: fmt.Sprintf("%s (%s %s)", version.GetBuildData(), runtime.GOOS, runtime.GOARCH),
+		},
+	}, nil
 }
 
 // GetSyncStatus requests the beacon node to describe if it's currently syncing or not, and
 // if it is, what block it is up to.
 func (ns *Server) GetSyncStatus(ctx context.Context, _ *ptypes.Empty) (*ethpb.SyncingResponse, error) {
-	return nil, errors.New("unimplemented")
+	headSlot := ns.HeadFetcher.HeadSlot()
+	var syncDistance uint64
+	if currentSlot := ns.GenesisTimeFetcher.CurrentSlot(); currentSlot > headSlot {
+		syncDistance = currentSlot - headSlot
+	}
+	return &ethpb.SyncingResponse{
+		Data: &ethpb.SyncInfo{
+			HeadSlot:     headSlot,
+			SyncDistance: syncDistance,
+		},
+	}, nil
 }
 
 // GetHealth returns node health status in http status codes. Useful for load balancers.
@@ -43,6 +136,130 @@ func (ns *Server) GetSyncStatus(ctx context.Context, _ *ptypes.Empty) (*ethpb.Sy
 //      description: Node is syncing but can serve incomplete data
 //    "503":
 //      description: Node not initialized or having issues
+// A node which is syncing succeeds with the SyncingHeader response header set to true, and one which is not
+// initialized or having issues fails with codes.Unavailable.
 func (ns *Server) GetHealth(ctx context.Context, _ *ptypes.Empty) (*ptypes.Empty, error) {
-	return nil, errors.New("unimplemented")
+	if ns.GenesisTimeFetcher.GenesisTime().IsZero() {
+		return nil, status.Error(codes.Unavailable, "Beacon node has not reached genesis")
+	}
+	if err := ns.SyncChecker.Status(); err != nil {
+		return nil, status.Errorf(codes.Unavailable, "Sync service is not healthy: %v", err)
+	}
+	syncing := ns.SyncChecker.Syncing()
+	if err := grpc.SetHeader(ctx, metadata.Pairs(grpcutils.SyncingHeader, fmt.Sprintf("%t", syncing))); err != nil {
+		log.WithError(err).Debug("Could not set syncing header")
+	}
+	return &ptypes.Empty{}, nil
+}
+
+// peerInfo returns what the node knows of a peer.
+func (ns *Server) peerInfo(pid peer.ID) (*ethpb.Peer, error) {
+	peersStatus := ns.PeersFetcher.Peers()
+	addr, err := peersStatus.Address(pid)
+	if err != nil {
+		return nil, err
+	}
+	dir, err := peersStatus.Direction(pid)
+	if err != nil {
+		return nil, err
+	}
+	connState, err := peersStatus.ConnectionState(pid)
+	if err != nil {
+		return nil, err
+	}
+	record, err := peersStatus.ENR(pid)
+	if err != nil {
+		return nil, err
+	}
+	var enr string
+	if record != nil {
+		enr, err = p2p.SerializeENR(record)
+		if err != nil {
+			return nil, err
+		}
+	}
+	address := "unknown"
+	if addr != nil {
+		address = fmt.Sprintf("%s/p2p/%s", addr.String(), pid.String())
+	}
+	direction := ethpb.PeerDirection_UNKNOWN
+	switch dir {
+	case network.DirInbound:
+		direction = ethpb.PeerDirection_INBOUND
+	case network.DirOutbound:
+		direction = ethpb.PeerDirection_OUTBOUND
+	}
+	state := ethpb.ConnectionState_DISCONNECTED
+	switch connState {
+	case peers.PeerConnecting:
+		state = ethpb.ConnectionState_CONNECTING
+	case peers.PeerConnected:
+		state = ethpb.ConnectionState_CONNECTED
+	case peers.PeerDisconnecting:
+		state = ethpb.ConnectionState_DISCONNECTING
+	}
+	return &ethpb.Peer{
+		PeerId:    pid.String(),
+		Enr:       enr,
+		Address:   address,
+		State:     state,
+		Direction: direction,
+	}, nil
+}
+
+func discoveryAddress(ip net.IP, port int, peerID string) string {
+	protocol := "ip4"
+	if ip.To4() == nil {
+		protocol = "ip6"
+	}
+	return fmt.Sprintf("/%s/%s/udp/%d/p2p/%s", protocol, ip.String(), port, peerID)
+}
+
+// peerFilters are the states and directions peers are listed with. Empty sets match any peer.
+type peerFilters struct {
+	states     map[ethpb.ConnectionState]bool
+	directions map[ethpb.PeerDirection]bool
+}
+
+func (f *peerFilters) matches(p *ethpb.Peer) bool {
+	return (len(f.states) == 0 || f.states[p.State]) && (len(f.directions) == 0 || f.directions[p.Direction])
+}
+
+func peerFiltersFromContext(ctx context.Context) (*peerFilters, error) {
+	filters := &peerFilters{
+		states:     make(map[ethpb.ConnectionState]bool),
+		directions: make(map[ethpb.PeerDirection]bool),
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return filters, nil
+	}
+	for _, name := range splitHeaderValues(md.Get(grpcutils.PeerStateHeader)) {
+		state, ok := ethpb.ConnectionState_value[strings.ToUpper(name)]
+		if !ok {
+			return nil, fmt.Errorf("unknown peer state %q", name)
+		}
+		filters.states[ethpb.ConnectionState(state)] = true
+	}
+	for _, name := range splitHeaderValues(md.Get(grpcutils.PeerDirectionHeader)) {
+		direction, ok := ethpb.PeerDirection_value[strings.ToUpper(name)]
+		if !ok || ethpb.PeerDirection(direction) == ethpb.PeerDirection_UNKNOWN {
+			return nil, fmt.Errorf("unknown peer direction %q", name)
+		}
+		filters.directions[ethpb.PeerDirection(direction)] = true
+	}
+	return filters, nil
+}
+
+// splitHeaderValues splits the comma separated lists of the values of a header.
+func splitHeaderValues(values []string) []string {
+	var res []string
+	for _, v := range values {
+		for _, s := range strings.Split(v, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				res = append(res, s)
+			}
+		}
+	}
+	return res
 }
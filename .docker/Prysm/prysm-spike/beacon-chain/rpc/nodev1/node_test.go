@@ -0,0 +1,171 @@
+package nodev1
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	mockp2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type timeFetcher struct {
+	genesis time.Time
+	slot    uint64
+}
+
+func (f *timeFetcher) GenesisTime() time.Time {
+	return f.genesis
+}
+
+func (f *timeFetcher) CurrentSlot() uint64 {
+	return f.slot
+}
+
+// headerStream records the headers set by the server.
+type headerStream struct {
+	grpc.ServerTransportStream
+	header metadata.MD
+}
+
+func (s *headerStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func TestGetIdentity(t *testing.T) {
+	mP2P := mockp2p.NewTestP2P(t)
+	mP2P.LocalMetadata = &pb.MetaData{SeqNumber: 3, Attnets: bitfield.Bitvector64{1, 0, 0, 0, 0, 0, 0, 0}}
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	db, err := enode.OpenDB("")
+	require.NoError(t, err)
+	record := enode.NewLocalNode(db, key).Node().Record()
+	stringENR, err := p2p.SerializeENR(record)
+	require.NoError(t, err)
+	ns := &Server{
+		PeerManager:      &mockp2p.MockPeerManager{BHost: mP2P.BHost, Enr: record, PID: mP2P.BHost.ID()},
+		MetadataProvider: mP2P,
+	}
+
+	resp, err := ns.GetIdentity(context.Background(), &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, mP2P.PeerID().String(), resp.Data.PeerId)
+	assert.Equal(t, stringENR, resp.Data.Enr)
+	require.NotEqual(t, 0, len(resp.Data.P2PAddresses))
+	for _, addr := range resp.Data.P2PAddresses {
+		assert.Equal(t, true, strings.HasSuffix(addr, "/p2p/"+mP2P.PeerID().String()), "Unexpected address %s", addr)
+	}
+	assert.Equal(t, uint64(3), resp.Data.Metadata.SeqNumber)
+	assert.DeepEqual(t, mP2P.LocalMetadata.Attnets, resp.Data.Metadata.Attnets)
+}
+
+func TestGetPeer(t *testing.T) {
+	peersProvider := &mockp2p.MockPeersProvider{}
+	ns := &Server{PeersFetcher: peersProvider}
+	firstPeer := peersProvider.Peers().All()[0]
+
+	resp, err := ns.GetPeer(context.Background(), &ethpb.PeerRequest{PeerId: firstPeer.String()})
+	require.NoError(t, err)
+	assert.Equal(t, firstPeer.String(), resp.Data.PeerId)
+	assert.Equal(t, ethpb.PeerDirection_INBOUND, resp.Data.Direction)
+	assert.Equal(t, ethpb.ConnectionState_CONNECTED, resp.Data.State)
+	assert.Equal(t, "/ip4/213.202.254.180/tcp/13000/p2p/"+firstPeer.String(), resp.Data.Address)
+
+	_, err = ns.GetPeer(context.Background(), &ethpb.PeerRequest{PeerId: "foo"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = ns.GetPeer(context.Background(), &ethpb.PeerRequest{PeerId: "16Uiu2HAmQqFdEcHbSmQTQuLoAhnMUrgoWoraKK4cUJT6FuuqHqTU"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestListPeers_Filters(t *testing.T) {
+	peersProvider := &mockp2p.MockPeersProvider{}
+	disconnected, err := peer.Decode("16Uiu2HAmQqFdEcHbSmQTQuLoAhnMUrgoWoraKK4cUJT6FuuqHqTU")
+	require.NoError(t, err)
+	addr, err := ma.NewMultiaddr("/ip4/10.0.0.1/tcp/13000")
+	require.NoError(t, err)
+	peersProvider.Peers().Add(nil, disconnected, addr, network.DirOutbound)
+	peersProvider.Peers().SetConnectionState(disconnected, peers.PeerDisconnected)
+	ns := &Server{PeersFetcher: peersProvider}
+
+	tests := []struct {
+		name    string
+		headers []string
+		want    int
+	}{
+		{name: "no filters", want: 3},
+		{name: "connected", headers: []string{grpcutils.PeerStateHeader, "connected"}, want: 2},
+		{name: "disconnected", headers: []string{grpcutils.PeerStateHeader, "disconnected"}, want: 1},
+		{name: "several states", headers: []string{grpcutils.PeerStateHeader, "connected,disconnected"}, want: 3},
+		{name: "outbound", headers: []string{grpcutils.PeerDirectionHeader, "outbound"}, want: 2},
+		{
+			name:    "connected and outbound",
+			headers: []string{grpcutils.PeerStateHeader, "connected", grpcutils.PeerDirectionHeader, "outbound"},
+			want:    1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(tt.headers...))
+			resp, err := ns.ListPeers(ctx, &ptypes.Empty{})
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, len(resp.Data))
+		})
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(grpcutils.PeerStateHeader, "foo"))
+	_, err = ns.ListPeers(ctx, &ptypes.Empty{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetVersion(t *testing.T) {
+	resp, err := (&Server{}).GetVersion(context.Background(), &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, true, strings.HasPrefix(resp.Data.Version, "Prysm/"), "Unexpected version %s", resp.Data.Version)
+}
+
+func TestGetHealth(t *testing.T) {
+	genesis := &timeFetcher{genesis: time.Unix(1, 0)}
+	tests := []struct {
+		name    string
+		genesis *timeFetcher
+		syncing bool
+		code    codes.Code
+		header  []string
+	}{
+		{name: "ready", genesis: genesis, header: []string{"false"}},
+		{name: "syncing", genesis: genesis, syncing: true, header: []string{"true"}},
+		{name: "before genesis", genesis: &timeFetcher{}, code: codes.Unavailable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ns := &Server{
+				GenesisTimeFetcher: tt.genesis,
+				SyncChecker:        &mockSync.Sync{IsSyncing: tt.syncing},
+			}
+			stream := &headerStream{}
+			_, err := ns.GetHealth(grpc.NewContextWithServerTransportStream(context.Background(), stream), &ptypes.Empty{})
+			assert.Equal(t, tt.code, status.Code(err))
+			assert.DeepEqual(t, tt.header, stream.header.Get(grpcutils.SyncingHeader))
+		})
+	}
+}
@@ -20,6 +20,8 @@ type Server struct {
 	BeaconDB           db.ReadOnlyDatabase
 	PeersFetcher       p2p.PeersProvider
 	PeerManager        p2p.PeerManager
+	MetadataProvider   p2p.MetadataProvider
+	HeadFetcher        blockchain.HeadFetcher
 	GenesisTimeFetcher blockchain.TimeFetcher
 	GenesisFetcher     blockchain.GenesisFetcher
 }
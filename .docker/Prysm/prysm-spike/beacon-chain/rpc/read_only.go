@@ -0,0 +1,138 @@
+package rpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// readOnlyMethods are the gRPC methods which only query the node. Every other method, such as those
+// submitting operations, changing the node configuration or subscribing it to subnets, is rejected when
+// the RPC is read-only, so methods added later are disabled until they are listed here.
+var readOnlyMethods = map[string]bool{
+	"/ethereum.beacon.rpc.v1.BlockFeed/StreamFilteredBlocks":             true,
+	"/ethereum.beacon.rpc.v1.Debug/GetBalanceChanges":                    true,
+	"/ethereum.beacon.rpc.v1.Debug/GetBeaconState":                       true,
+	"/ethereum.beacon.rpc.v1.Debug/GetBlock":                             true,
+	"/ethereum.beacon.rpc.v1.Debug/GetBlockRewards":                      true,
+	"/ethereum.beacon.rpc.v1.Debug/GetDepositSnapshot":                   true,
+	"/ethereum.beacon.rpc.v1.Debug/GetInboundLimits":                     true,
+	"/ethereum.beacon.rpc.v1.Debug/GetInclusionSlot":                     true,
+	"/ethereum.beacon.rpc.v1.Debug/GetInventory":                         true,
+	"/ethereum.beacon.rpc.v1.Debug/GetPeer":                              true,
+	"/ethereum.beacon.rpc.v1.Debug/GetPeerList":                          true,
+	"/ethereum.beacon.rpc.v1.Debug/GetProposerLookahead":                 true,
+	"/ethereum.beacon.rpc.v1.Debug/GetProtoArrayForkChoice":              true,
+	"/ethereum.beacon.rpc.v1.Debug/GetRunningConfig":                     true,
+	"/ethereum.beacon.rpc.v1.Debug/ListBlockPropagation":                 true,
+	"/ethereum.beacon.rpc.v1.Debug/ListEpochParticipation":               true,
+	"/ethereum.beacon.rpc.v1.Debug/ListFeatures":                         true,
+	"/ethereum.beacon.rpc.v1.Debug/ListOperationInclusions":              true,
+	"/ethereum.beacon.rpc.v1.Debug/ListPeers":                            true,
+	"/ethereum.beacon.rpc.v1.Debug/ListPendingLocalOperations":           true,
+	"/ethereum.beacon.rpc.v1.Debug/StreamBlockExport":                    true,
+	"/ethereum.beacon.rpc.v1.Events/StreamEvents":                        true,
+	"/ethereum.beacon.rpc.v1.Health/GetCapabilities":                     true,
+	"/ethereum.beacon.rpc.v1.Health/GetLogsEndpoint":                     true,
+	"/ethereum.beacon.rpc.v1.Health/StreamHeadEvents":                    true,
+	"/ethereum.eth.v1.BeaconChain/GetBlock":                              true,
+	"/ethereum.eth.v1.BeaconChain/GetBlockHeader":                        true,
+	"/ethereum.eth.v1.BeaconChain/GetBlockRoot":                          true,
+	"/ethereum.eth.v1.BeaconChain/GetDepositContract":                    true,
+	"/ethereum.eth.v1.BeaconChain/GetFinalityCheckpoints":                true,
+	"/ethereum.eth.v1.BeaconChain/GetForkSchedule":                       true,
+	"/ethereum.eth.v1.BeaconChain/GetGenesis":                            true,
+	"/ethereum.eth.v1.BeaconChain/GetSpec":                               true,
+	"/ethereum.eth.v1.BeaconChain/GetStateFork":                          true,
+	"/ethereum.eth.v1.BeaconChain/GetStateRoot":                          true,
+	"/ethereum.eth.v1.BeaconChain/GetValidator":                          true,
+	"/ethereum.eth.v1.BeaconChain/ListBlockAttestations":                 true,
+	"/ethereum.eth.v1.BeaconChain/ListBlockHeaders":                      true,
+	"/ethereum.eth.v1.BeaconChain/ListCommittees":                        true,
+	"/ethereum.eth.v1.BeaconChain/ListPoolAttestations":                  true,
+	"/ethereum.eth.v1.BeaconChain/ListPoolAttesterSlashings":             true,
+	"/ethereum.eth.v1.BeaconChain/ListPoolProposerSlashings":             true,
+	"/ethereum.eth.v1.BeaconChain/ListPoolVoluntaryExits":                true,
+	"/ethereum.eth.v1.BeaconChain/ListValidatorBalances":                 true,
+	"/ethereum.eth.v1.BeaconChain/ListValidators":                        true,
+	"/ethereum.eth.v1.BeaconNode/GetHealth":                              true,
+	"/ethereum.eth.v1.BeaconNode/GetIdentity":                            true,
+	"/ethereum.eth.v1.BeaconNode/GetPeer":                                true,
+	"/ethereum.eth.v1.BeaconNode/GetSyncStatus":                          true,
+	"/ethereum.eth.v1.BeaconNode/GetVersion":                             true,
+	"/ethereum.eth.v1.BeaconNode/ListPeers":                              true,
+	"/ethereum.eth.v1alpha1.BeaconChain/AttestationPool":                 true,
+	"/ethereum.eth.v1alpha1.BeaconChain/GetBeaconConfig":                 true,
+	"/ethereum.eth.v1alpha1.BeaconChain/GetChainHead":                    true,
+	"/ethereum.eth.v1alpha1.BeaconChain/GetIndividualVotes":              true,
+	"/ethereum.eth.v1alpha1.BeaconChain/GetValidator":                    true,
+	"/ethereum.eth.v1alpha1.BeaconChain/GetValidatorActiveSetChanges":    true,
+	"/ethereum.eth.v1alpha1.BeaconChain/GetValidatorParticipation":       true,
+	"/ethereum.eth.v1alpha1.BeaconChain/GetValidatorPerformance":         true,
+	"/ethereum.eth.v1alpha1.BeaconChain/GetValidatorQueue":               true,
+	"/ethereum.eth.v1alpha1.BeaconChain/GetWeakSubjectivityCheckpoint":   true,
+	"/ethereum.eth.v1alpha1.BeaconChain/ListAttestations":                true,
+	"/ethereum.eth.v1alpha1.BeaconChain/ListBeaconCommittees":            true,
+	"/ethereum.eth.v1alpha1.BeaconChain/ListBlocks":                      true,
+	"/ethereum.eth.v1alpha1.BeaconChain/ListIndexedAttestations":         true,
+	"/ethereum.eth.v1alpha1.BeaconChain/ListValidatorAssignments":        true,
+	"/ethereum.eth.v1alpha1.BeaconChain/ListValidatorBalances":           true,
+	"/ethereum.eth.v1alpha1.BeaconChain/ListValidators":                  true,
+	"/ethereum.eth.v1alpha1.BeaconChain/StreamAttestations":              true,
+	"/ethereum.eth.v1alpha1.BeaconChain/StreamBlocks":                    true,
+	"/ethereum.eth.v1alpha1.BeaconChain/StreamChainHead":                 true,
+	"/ethereum.eth.v1alpha1.BeaconChain/StreamIndexedAttestations":       true,
+	"/ethereum.eth.v1alpha1.BeaconChain/StreamValidatorsInfo":            true,
+	"/ethereum.eth.v1alpha1.BeaconNodeValidator/DomainData":              true,
+	"/ethereum.eth.v1alpha1.BeaconNodeValidator/GetAttestationData":      true,
+	"/ethereum.eth.v1alpha1.BeaconNodeValidator/GetBlock":                true,
+	"/ethereum.eth.v1alpha1.BeaconNodeValidator/GetDuties":               true,
+	"/ethereum.eth.v1alpha1.BeaconNodeValidator/MultipleValidatorStatus": true,
+	"/ethereum.eth.v1alpha1.BeaconNodeValidator/StreamDuties":            true,
+	"/ethereum.eth.v1alpha1.BeaconNodeValidator/ValidatorIndex":          true,
+	"/ethereum.eth.v1alpha1.BeaconNodeValidator/ValidatorStatus":         true,
+	"/ethereum.eth.v1alpha1.BeaconNodeValidator/WaitForActivation":       true,
+	"/ethereum.eth.v1alpha1.BeaconNodeValidator/WaitForChainStart":       true,
+	"/ethereum.eth.v1alpha1.Node/GetGenesis":                             true,
+	"/ethereum.eth.v1alpha1.Node/GetHost":                                true,
+	"/ethereum.eth.v1alpha1.Node/GetPeer":                                true,
+	"/ethereum.eth.v1alpha1.Node/GetSyncStatus":                          true,
+	"/ethereum.eth.v1alpha1.Node/GetVersion":                             true,
+	"/ethereum.eth.v1alpha1.Node/ListImplementedServices":                true,
+	"/ethereum.eth.v1alpha1.Node/ListPeers":                              true,
+}
+
+// Unary interceptor rejecting the methods of a read-only RPC which do not only query the node.
+func (s *Service) readOnlyUnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if err := s.checkReadOnly(info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// Stream interceptor rejecting the methods of a read-only RPC which do not only query the node.
+func (s *Service) readOnlyStreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	if err := s.checkReadOnly(info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+func (s *Service) checkReadOnly(method string) error {
+	if s.readOnly && !readOnlyMethods[method] {
+		return status.Errorf(codes.PermissionDenied, "%s is disabled, the beacon node RPC is read-only", method)
+	}
+	return nil
+}
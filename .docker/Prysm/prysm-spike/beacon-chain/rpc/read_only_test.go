@@ -0,0 +1,64 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestReadOnlyUnaryInterceptor(t *testing.T) {
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	submit := &grpc.UnaryServerInfo{FullMethod: "/ethereum.eth.v1.BeaconChain/SubmitBlock"}
+	query := &grpc.UnaryServerInfo{FullMethod: "/ethereum.eth.v1.BeaconChain/GetBlock"}
+
+	s := &Service{}
+	resp, err := s.readOnlyUnaryInterceptor(context.Background(), nil, submit, handler)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+
+	s.readOnly = true
+	_, err = s.readOnlyUnaryInterceptor(context.Background(), nil, submit, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	resp, err = s.readOnlyUnaryInterceptor(context.Background(), nil, query, handler)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+
+	// Methods which are not known to only query the node are rejected.
+	unknown := &grpc.UnaryServerInfo{FullMethod: "/ethereum.eth.v1.BeaconChain/SubmitSomethingNew"}
+	_, err = s.readOnlyUnaryInterceptor(context.Background(), nil, unknown, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestReadOnlyStreamInterceptor(t *testing.T) {
+	handler := func(srv interface{}, stream grpc.ServerStream) error {
+		return nil
+	}
+	subscribe := &grpc.StreamServerInfo{FullMethod: "/ethereum.eth.v1alpha1.BeaconNodeValidator/SubscribeToEverything"}
+	query := &grpc.StreamServerInfo{FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/StreamChainHead"}
+
+	s := &Service{}
+	require.NoError(t, s.readOnlyStreamInterceptor(nil, nil, subscribe, handler))
+
+	s.readOnly = true
+	assert.Equal(t, codes.PermissionDenied, status.Code(s.readOnlyStreamInterceptor(nil, nil, subscribe, handler)))
+	require.NoError(t, s.readOnlyStreamInterceptor(nil, nil, query, handler))
+}
+
+func TestReadOnlyUnaryInterceptor_RejectsCacheFlushes(t *testing.T) {
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	// Flushing the caches recomputes the fork choice head, which changes the node state.
+	flush := &grpc.UnaryServerInfo{FullMethod: "/ethereum.beacon.rpc.v1.Debug/FlushCaches"}
+
+	s := &Service{readOnly: true}
+	_, err := s.readOnlyUnaryInterceptor(context.Background(), nil, flush, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
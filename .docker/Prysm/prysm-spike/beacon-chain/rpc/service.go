@@ -4,6 +4,7 @@ package rpc
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"net"
@@ -23,6 +24,7 @@ import (
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/localops"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
@@ -30,12 +32,17 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/beacon"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/beaconv1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/debug"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/eventsv1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/node"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/nodev1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/validator"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	chainSync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	sharedpb "github.com/prysmaticlabs/prysm/proto/shared/v1"
+	"github.com/prysmaticlabs/prysm/shared/configdump"
+	"github.com/prysmaticlabs/prysm/shared/exitcode"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
@@ -67,15 +74,27 @@ type Service struct {
 	genesisTimeFetcher      blockchain.TimeFetcher
 	genesisFetcher          blockchain.GenesisFetcher
 	attestationReceiver     blockchain.AttestationReceiver
+	targetStateFetcher      blockchain.TargetStateFetcher
 	blockReceiver           blockchain.BlockReceiver
 	powChainService         powchain.Chain
 	chainStartFetcher       powchain.ChainStartFetcher
 	mockEth1Votes           bool
 	enableDebugRPCEndpoints bool
+	readOnly                bool
+	validateProposals       bool
 	attestationsPool        attestations.Pool
 	exitPool                *voluntaryexits.Pool
 	slashingsPool           *slashings.Pool
+	localOperations         localops.Tracker
 	syncService             chainSync.Checker
+	headRecomputer          blockchain.HeadRecomputer
+	seenCacheFlusher        chainSync.SeenCacheFlusher
+	blockPropagation        *p2p.BlockPropagationTracer
+	inboundLimiter          p2p.InboundLimiter
+	peerListProvider        p2p.PeerListProvider
+	depositSnapshotter      powchain.DepositSnapshotter
+	runningConfig           func() (*configdump.Config, error)
+	inventory               func() (*sharedpb.Inventory, error)
 	host                    string
 	port                    string
 	beaconMonitoringHost    string
@@ -83,6 +102,7 @@ type Service struct {
 	listener                net.Listener
 	withCert                string
 	withKey                 string
+	tlsConfig               *tls.Config
 	grpcServer              *grpc.Server
 	canonicalStateChan      chan *pbp2p.BeaconState
 	incomingAttestation     chan *ethpb.Attestation
@@ -90,12 +110,14 @@ type Service struct {
 	p2p                     p2p.Broadcaster
 	peersFetcher            p2p.PeersProvider
 	peerManager             p2p.PeerManager
+	metadataProvider        p2p.MetadataProvider
 	depositFetcher          depositcache.DepositFetcher
 	pendingDepositFetcher   depositcache.PendingDepositsFetcher
 	stateNotifier           statefeed.Notifier
 	blockNotifier           blockfeed.Notifier
 	operationNotifier       opfeed.Notifier
 	stateGen                *stategen.State
+	htrCache                *cache.HashTreeRootCache
 	connectedRPCClients     map[net.Addr]bool
 	clientConnectionLock    sync.Mutex
 	maxMsgSize              int
@@ -107,6 +129,7 @@ type Config struct {
 	Port                    string
 	CertFlag                string
 	KeyFlag                 string
+	TLSConfig               *tls.Config // Used when no certificate and key files are configured.
 	BeaconMonitoringHost    string
 	BeaconMonitoringPort    int
 	BeaconDB                db.HeadAccessDatabase
@@ -115,26 +138,40 @@ type Config struct {
 	ForkFetcher             blockchain.ForkFetcher
 	FinalizationFetcher     blockchain.FinalizationFetcher
 	AttestationReceiver     blockchain.AttestationReceiver
+	TargetStateFetcher      blockchain.TargetStateFetcher
 	BlockReceiver           blockchain.BlockReceiver
 	POWChainService         powchain.Chain
 	ChainStartFetcher       powchain.ChainStartFetcher
 	GenesisTimeFetcher      blockchain.TimeFetcher
 	GenesisFetcher          blockchain.GenesisFetcher
 	EnableDebugRPCEndpoints bool
+	ReadOnly                bool
+	ValidateProposals       bool
 	MockEth1Votes           bool
 	AttestationsPool        attestations.Pool
 	ExitPool                *voluntaryexits.Pool
 	SlashingsPool           *slashings.Pool
+	LocalOperations         localops.Tracker
 	SyncService             chainSync.Checker
+	HeadRecomputer          blockchain.HeadRecomputer
+	SeenCacheFlusher        chainSync.SeenCacheFlusher
+	BlockPropagation        *p2p.BlockPropagationTracer
+	InboundLimiter          p2p.InboundLimiter
+	PeerListProvider        p2p.PeerListProvider
+	DepositSnapshotter      powchain.DepositSnapshotter
+	RunningConfig           func() (*configdump.Config, error)
+	Inventory               func() (*sharedpb.Inventory, error)
 	Broadcaster             p2p.Broadcaster
 	PeersFetcher            p2p.PeersProvider
 	PeerManager             p2p.PeerManager
+	MetadataProvider        p2p.MetadataProvider
 	DepositFetcher          depositcache.DepositFetcher
 	PendingDepositFetcher   depositcache.PendingDepositsFetcher
 	StateNotifier           statefeed.Notifier
 	BlockNotifier           blockfeed.Notifier
 	OperationNotifier       opfeed.Notifier
 	StateGen                *stategen.State
+	HashTreeRootCache       *cache.HashTreeRootCache
 	MaxMsgSize              int
 }
 
@@ -150,6 +187,7 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 		headFetcher:             cfg.HeadFetcher,
 		forkFetcher:             cfg.ForkFetcher,
 		finalizationFetcher:     cfg.FinalizationFetcher,
+		targetStateFetcher:      cfg.TargetStateFetcher,
 		genesisTimeFetcher:      cfg.GenesisTimeFetcher,
 		genesisFetcher:          cfg.GenesisFetcher,
 		attestationReceiver:     cfg.AttestationReceiver,
@@ -157,19 +195,30 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 		p2p:                     cfg.Broadcaster,
 		peersFetcher:            cfg.PeersFetcher,
 		peerManager:             cfg.PeerManager,
+		metadataProvider:        cfg.MetadataProvider,
 		powChainService:         cfg.POWChainService,
 		chainStartFetcher:       cfg.ChainStartFetcher,
 		mockEth1Votes:           cfg.MockEth1Votes,
 		attestationsPool:        cfg.AttestationsPool,
 		exitPool:                cfg.ExitPool,
 		slashingsPool:           cfg.SlashingsPool,
+		localOperations:         cfg.LocalOperations,
 		syncService:             cfg.SyncService,
+		headRecomputer:          cfg.HeadRecomputer,
+		seenCacheFlusher:        cfg.SeenCacheFlusher,
+		blockPropagation:        cfg.BlockPropagation,
+		inboundLimiter:          cfg.InboundLimiter,
+		peerListProvider:        cfg.PeerListProvider,
+		depositSnapshotter:      cfg.DepositSnapshotter,
+		runningConfig:           cfg.RunningConfig,
+		inventory:               cfg.Inventory,
 		host:                    cfg.Host,
 		port:                    cfg.Port,
 		beaconMonitoringHost:    cfg.BeaconMonitoringHost,
 		beaconMonitoringPort:    cfg.BeaconMonitoringPort,
 		withCert:                cfg.CertFlag,
 		withKey:                 cfg.KeyFlag,
+		tlsConfig:               cfg.TLSConfig,
 		depositFetcher:          cfg.DepositFetcher,
 		pendingDepositFetcher:   cfg.PendingDepositFetcher,
 		canonicalStateChan:      make(chan *pbp2p.BeaconState, params.BeaconConfig().DefaultBufferSize),
@@ -178,7 +227,10 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 		blockNotifier:           cfg.BlockNotifier,
 		operationNotifier:       cfg.OperationNotifier,
 		stateGen:                cfg.StateGen,
+		htrCache:                cfg.HashTreeRootCache,
 		enableDebugRPCEndpoints: cfg.EnableDebugRPCEndpoints,
+		readOnly:                cfg.ReadOnly,
+		validateProposals:       cfg.ValidateProposals,
 		connectedRPCClients:     make(map[net.Addr]bool),
 		maxMsgSize:              cfg.MaxMsgSize,
 	}
@@ -189,7 +241,7 @@ func (s *Service) Start() {
 	address := fmt.Sprintf("%s:%s", s.host, s.port)
 	lis, err := net.Listen("tcp", address)
 	if err != nil {
-		log.Errorf("Could not listen to port in Start() %s: %v", address, err)
+		exitcode.Fatal(log.WithField("address", address), err, "Could not listen to port in Start()")
 	}
 	s.listener = lis
 	log.WithField("address", address).Info("gRPC server listening on port")
@@ -203,6 +255,7 @@ func (s *Service) Start() {
 			grpc_prometheus.StreamServerInterceptor,
 			grpc_opentracing.StreamServerInterceptor(),
 			s.validatorStreamConnectionInterceptor,
+			s.readOnlyStreamInterceptor,
 		)),
 		grpc.UnaryInterceptor(middleware.ChainUnaryServer(
 			recovery.UnaryServerInterceptor(
@@ -211,6 +264,7 @@ func (s *Service) Start() {
 			grpc_prometheus.UnaryServerInterceptor,
 			grpc_opentracing.UnaryServerInterceptor(),
 			s.validatorUnaryConnectionInterceptor,
+			s.readOnlyUnaryInterceptor,
 		)),
 		grpc.MaxRecvMsgSize(s.maxMsgSize),
 	}
@@ -221,6 +275,8 @@ func (s *Service) Start() {
 			log.WithError(err).Fatal("Could not load TLS keys")
 		}
 		opts = append(opts, grpc.Creds(creds))
+	} else if s.tlsConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(s.tlsConfig)))
 	} else {
 		log.Warn("You are using an insecure gRPC server. If you are running your beacon node and " +
 			"validator on the same machines, you can ignore this message. If you want to know " +
@@ -234,10 +290,12 @@ func (s *Service) Start() {
 		AttestationCache:       cache.NewAttestationCache(),
 		AttPool:                s.attestationsPool,
 		ExitPool:               s.exitPool,
+		LocalOperations:        s.localOperations,
 		HeadFetcher:            s.headFetcher,
 		ForkFetcher:            s.forkFetcher,
 		FinalizationFetcher:    s.finalizationFetcher,
 		GenesisTimeFetcher:     s.genesisTimeFetcher,
+		TargetStateFetcher:     s.targetStateFetcher,
 		CanonicalStateChan:     s.canonicalStateChan,
 		BlockFetcher:           s.powChainService,
 		DepositFetcher:         s.depositFetcher,
@@ -254,8 +312,10 @@ func (s *Service) Start() {
 		PendingDepositsFetcher: s.pendingDepositFetcher,
 		SlashingsPool:          s.slashingsPool,
 		StateGen:               s.stateGen,
+		ValidateProposals:      s.validateProposals,
 	}
 	nodeServer := &node.Server{
+		Ctx:                  s.ctx,
 		BeaconDB:             s.beaconDB,
 		Server:               s.grpcServer,
 		SyncChecker:          s.syncService,
@@ -263,6 +323,8 @@ func (s *Service) Start() {
 		PeersFetcher:         s.peersFetcher,
 		PeerManager:          s.peerManager,
 		GenesisFetcher:       s.genesisFetcher,
+		HeadFetcher:          s.headFetcher,
+		StateNotifier:        s.stateNotifier,
 		BeaconMonitoringHost: s.beaconMonitoringHost,
 		BeaconMonitoringPort: s.beaconMonitoringPort,
 	}
@@ -271,6 +333,7 @@ func (s *Service) Start() {
 		BeaconDB:                    s.beaconDB,
 		AttestationsPool:            s.attestationsPool,
 		SlashingsPool:               s.slashingsPool,
+		LocalOperations:             s.localOperations,
 		HeadFetcher:                 s.headFetcher,
 		FinalizationFetcher:         s.finalizationFetcher,
 		ChainStartFetcher:           s.chainStartFetcher,
@@ -284,6 +347,7 @@ func (s *Service) Start() {
 		Broadcaster:                 s.p2p,
 		StateGen:                    s.stateGen,
 		SyncChecker:                 s.syncService,
+		HashTreeRootCache:           s.htrCache,
 		ReceivedAttestationsBuffer:  make(chan *ethpb.Attestation, attestationBufferSize),
 		CollectedAttestationsBuffer: make(chan []*ethpb.Attestation, attestationBufferSize),
 	}
@@ -304,11 +368,34 @@ func (s *Service) Start() {
 		Broadcaster:         s.p2p,
 		StateGen:            s.stateGen,
 		SyncChecker:         s.syncService,
+		FinalizedCache:      cache.NewFinalizedResponseCache(),
+	}
+	nodeServerV1 := &nodev1.Server{
+		SyncChecker:        s.syncService,
+		Server:             s.grpcServer,
+		BeaconDB:           s.beaconDB,
+		PeersFetcher:       s.peersFetcher,
+		PeerManager:        s.peerManager,
+		MetadataProvider:   s.metadataProvider,
+		HeadFetcher:        s.headFetcher,
+		GenesisTimeFetcher: s.genesisTimeFetcher,
+		GenesisFetcher:     s.genesisFetcher,
+	}
+	eventsServerV1 := &eventsv1.Server{
+		Ctx:               s.ctx,
+		StateNotifier:     s.stateNotifier,
+		OperationNotifier: s.operationNotifier,
 	}
 	ethpb.RegisterNodeServer(s.grpcServer, nodeServer)
 	pbrpc.RegisterHealthServer(s.grpcServer, nodeServer)
 	ethpb.RegisterBeaconChainServer(s.grpcServer, beaconChainServer)
+	pbrpc.RegisterBlockFeedServer(s.grpcServer, beaconChainServer)
 	ethpbv1.RegisterBeaconChainServer(s.grpcServer, beaconChainServerV1)
+	ethpbv1.RegisterBeaconNodeServer(s.grpcServer, nodeServerV1)
+	pbrpc.RegisterEventsServer(s.grpcServer, eventsServerV1)
+	if s.readOnly {
+		log.Info("Beacon node RPC is read-only, endpoints submitting operations are disabled")
+	}
 	if s.enableDebugRPCEndpoints {
 		log.Info("Enabled debug gRPC endpoints")
 		debugServer := &debug.Server{
@@ -318,6 +405,16 @@ func (s *Service) Start() {
 			HeadFetcher:        s.headFetcher,
 			PeerManager:        s.peerManager,
 			PeersFetcher:       s.peersFetcher,
+			LocalOperations:    s.localOperations,
+			HeadRecomputer:     s.headRecomputer,
+			SeenCacheFlusher:   s.seenCacheFlusher,
+			CanonicalFetcher:   s.chainInfoFetcher,
+			BlockPropagation:   s.blockPropagation,
+			InboundLimiter:     s.inboundLimiter,
+			PeerListProvider:   s.peerListProvider,
+			DepositSnapshotter: s.depositSnapshotter,
+			RunningConfig:      s.runningConfig,
+			Inventory:          s.inventory,
 		}
 		pbrpc.RegisterDebugServer(s.grpcServer, debugServer)
 	}
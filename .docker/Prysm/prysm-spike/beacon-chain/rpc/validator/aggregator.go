@@ -7,6 +7,7 @@ import (
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
@@ -116,7 +117,7 @@ func (vs *Server) SubmitSignedAggregateSelectionProof(ctx context.Context, req *
 	emptySig := make([]byte, params.BeaconConfig().BLSSignatureLength)
 	if bytes.Equal(req.SignedAggregateAndProof.Signature, emptySig) ||
 		bytes.Equal(req.SignedAggregateAndProof.Message.SelectionProof, emptySig) {
-		return nil, status.Error(codes.InvalidArgument, "Signed signatures can't be zero hashes")
+		return nil, grpcutils.InvalidSignatureError("Signed signatures can't be zero hashes")
 	}
 
 	// As a preventive measure, a beacon node shouldn't broadcast an attestation whose slot is out of range.
@@ -2,6 +2,7 @@ package validator
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
@@ -10,22 +11,40 @@ import (
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/rand"
 	"github.com/prysmaticlabs/prysm/shared/slotutil"
 	"github.com/prysmaticlabs/prysm/shared/timeutils"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
 // GetDuties returns the duties assigned to a list of validators specified
 // in the request object.
 func (vs *Server) GetDuties(ctx context.Context, req *ethpb.DutiesRequest) (*ethpb.DutiesResponse, error) {
+	timer := newPhaseTimer("GetDuties")
+	defer timer.observe()
+
 	if vs.SyncChecker.Syncing() {
 		return nil, status.Error(codes.Unavailable, "Syncing to latest head, not ready to respond")
 	}
-	return vs.duties(ctx, req)
+	res, dependentRoot, err := vs.duties(ctx, req, timer)
+	if err != nil {
+		return nil, err
+	}
+	// Let the validator client tell whether proposer duties it fetched ahead of time were reorged.
+	if dependentRoot != nil {
+		md := metadata.Pairs(grpcutils.ProposerDependentRootHeader, fmt.Sprintf("%#x", dependentRoot))
+		if err := grpc.SetHeader(ctx, md); err != nil {
+			log.WithError(err).Debug("Could not set proposer dependent root header")
+		}
+	}
+	return res, nil
 }
 
 // StreamDuties returns the duties assigned to a list of validators specified
@@ -47,7 +66,7 @@ func (vs *Server) StreamDuties(req *ethpb.DutiesRequest, stream ethpb.BeaconNode
 		currentEpoch = slotutil.EpochsSinceGenesis(vs.GenesisTimeFetcher.GenesisTime())
 	}
 	req.Epoch = currentEpoch
-	res, err := vs.duties(stream.Context(), req)
+	res, _, err := vs.duties(stream.Context(), req, nil)
 	if err != nil {
 		return status.Errorf(codes.Internal, "Could not compute validator duties: %v", err)
 	}
@@ -67,7 +86,7 @@ func (vs *Server) StreamDuties(req *ethpb.DutiesRequest, stream ethpb.BeaconNode
 		// Ticks every epoch to submit assignments to connected validator clients.
 		case epoch := <-epochTicker.C():
 			req.Epoch = epoch
-			res, err := vs.duties(stream.Context(), req)
+			res, _, err := vs.duties(stream.Context(), req, nil)
 			if err != nil {
 				return status.Errorf(codes.Internal, "Could not compute validator duties: %v", err)
 			}
@@ -91,7 +110,7 @@ func (vs *Server) StreamDuties(req *ethpb.DutiesRequest, stream ethpb.BeaconNode
 					continue
 				}
 				req.Epoch = currentEpoch
-				res, err := vs.duties(stream.Context(), req)
+				res, _, err := vs.duties(stream.Context(), req, nil)
 				if err != nil {
 					return status.Errorf(codes.Internal, "Could not compute validator duties: %v", err)
 				}
@@ -108,39 +127,40 @@ func (vs *Server) StreamDuties(req *ethpb.DutiesRequest, stream ethpb.BeaconNode
 }
 
 // Compute the validator duties from the head state's corresponding epoch
-// for validators public key / indices requested.
-func (vs *Server) duties(ctx context.Context, req *ethpb.DutiesRequest) (*ethpb.DutiesResponse, error) {
-	s, err := vs.HeadFetcher.HeadState(ctx)
+// for validators public key / indices requested. The timer, which may be nil, times the phases.
+func (vs *Server) duties(ctx context.Context, req *ethpb.DutiesRequest, timer *phaseTimer) (*ethpb.DutiesResponse, []byte, error) {
+	s, err := vs.dutiesState(ctx, req, timer)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+		return nil, nil, err
 	}
-
-	// Advance state with empty transitions up to the requested epoch start slot.
 	epochStartSlot, err := helpers.StartSlot(req.Epoch)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	if s.Slot() < epochStartSlot {
-		s, err = state.ProcessSlots(ctx, s, epochStartSlot)
+
+	var dependentRoot []byte
+	if req.Epoch > 0 {
+		// The proposer shuffling of an epoch depends on the latest block before the epoch starts.
+		dependentRoot, err = helpers.BlockRootAtSlot(s, epochStartSlot-1)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "Could not process slots up to %d: %v", epochStartSlot, err)
+			return nil, nil, status.Errorf(codes.Internal, "Could not get proposer dependent root: %v", err)
 		}
 	}
 	committeeAssignments, proposerIndexToSlots, err := helpers.CommitteeAssignments(s, req.Epoch)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Could not compute committee assignments: %v", err)
+		return nil, nil, status.Errorf(codes.Internal, "Could not compute committee assignments: %v", err)
 	}
 	// Query the next epoch assignments for committee subnet subscriptions.
 	nextCommitteeAssignments, _, err := helpers.CommitteeAssignments(s, req.Epoch+1)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Could not compute next committee assignments: %v", err)
+		return nil, nil, status.Errorf(codes.Internal, "Could not compute next committee assignments: %v", err)
 	}
 
 	validatorAssignments := make([]*ethpb.DutiesResponse_Duty, 0, len(req.PublicKeys))
 	nextValidatorAssignments := make([]*ethpb.DutiesResponse_Duty, 0, len(req.PublicKeys))
 	for _, pubKey := range req.PublicKeys {
 		if ctx.Err() != nil {
-			return nil, status.Errorf(codes.Aborted, "Could not continue fetching assignments: %v", ctx.Err())
+			return nil, nil, status.Errorf(codes.Aborted, "Could not continue fetching assignments: %v", ctx.Err())
 		}
 		assignment := &ethpb.DutiesResponse_Duty{
 			PublicKey: pubKey,
@@ -173,7 +193,9 @@ func (vs *Server) duties(ctx context.Context, req *ethpb.DutiesRequest) (*ethpb.
 			}
 		} else {
 			// If the validator isn't in the beacon state, try finding their deposit to determine their status.
+			endCache := timer.begin(cachePhase)
 			vStatus, _ := vs.validatorStatus(ctx, s, pubKey)
+			endCache()
 			assignment.Status = vStatus.Status
 		}
 		validatorAssignments = append(validatorAssignments, assignment)
@@ -187,7 +209,40 @@ func (vs *Server) duties(ctx context.Context, req *ethpb.DutiesRequest) (*ethpb.
 		Duties:             validatorAssignments,
 		CurrentEpochDuties: validatorAssignments,
 		NextEpochDuties:    nextValidatorAssignments,
-	}, nil
+	}, dependentRoot, nil
+}
+
+// dutiesState returns the head state advanced with empty transitions up to the start slot of the
+// requested epoch.
+func (vs *Server) dutiesState(ctx context.Context, req *ethpb.DutiesRequest, timer *phaseTimer) (*stateTrie.BeaconState, error) {
+	defer timer.begin(statePhase)()
+
+	s, err := vs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	epochStartSlot, err := helpers.StartSlot(req.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	if s.Slot() < epochStartSlot && vs.TargetStateFetcher != nil {
+		// The advanced head state is the target state of the epoch, shared with attestation and
+		// block validation. It is only read here.
+		headRoot, err := vs.HeadFetcher.HeadRoot(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get head root: %v", err)
+		}
+		s, err = vs.TargetStateFetcher.TargetState(ctx, &ethpb.Checkpoint{Epoch: req.Epoch, Root: headRoot})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get target state of epoch %d: %v", req.Epoch, err)
+		}
+	} else if s.Slot() < epochStartSlot {
+		s, err = state.ProcessSlots(ctx, s, epochStartSlot)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not process slots up to %d: %v", epochStartSlot, err)
+		}
+	}
+	return s, nil
 }
 
 // assignValidatorToSubnet checks the status and pubkey of a particular validator
@@ -101,6 +101,33 @@ func TestGetDuties_OK(t *testing.T) {
 	}
 }
 
+func TestDuties_ProposerDependentRoot(t *testing.T) {
+	deposits, _, err := testutil.DeterministicDepositsAndKeys(params.BeaconConfig().MinGenesisActiveValidatorCount)
+	require.NoError(t, err)
+	eth1Data, err := testutil.DeterministicEth1Data(len(deposits))
+	require.NoError(t, err)
+	bs, err := state.GenesisBeaconState(deposits, 0, eth1Data)
+	require.NoError(t, err, "Could not setup genesis bs")
+
+	chain := &mockChain.ChainService{State: bs, Genesis: time.Now()}
+	vs := &Server{
+		HeadFetcher:        chain,
+		GenesisTimeFetcher: chain,
+		SyncChecker:        &mockSync.Sync{IsSyncing: false},
+	}
+	req := &ethpb.DutiesRequest{PublicKeys: [][]byte{deposits[0].Data.PublicKey}}
+
+	// Genesis proposers do not depend on any block.
+	_, dependentRoot, err := vs.duties(context.Background(), req, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(dependentRoot))
+
+	req.Epoch = 1
+	_, dependentRoot, err = vs.duties(context.Background(), req, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 32, len(dependentRoot))
+}
+
 func TestGetDuties_CurrentEpoch_ShouldNotFail(t *testing.T) {
 	db, _ := dbutil.SetupDB(t)
 
@@ -250,7 +277,7 @@ func TestStreamDuties_OK(t *testing.T) {
 	req := &ethpb.DutiesRequest{
 		PublicKeys: [][]byte{deposits[0].Data.PublicKey},
 	}
-	wantedRes, err := vs.duties(ctx, req)
+	wantedRes, _, err := vs.duties(ctx, req, nil)
 	require.NoError(t, err)
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -309,7 +336,7 @@ func TestStreamDuties_OK_ChainReorg(t *testing.T) {
 	req := &ethpb.DutiesRequest{
 		PublicKeys: [][]byte{deposits[0].Data.PublicKey},
 	}
-	wantedRes, err := vs.duties(ctx, req)
+	wantedRes, _, err := vs.duties(ctx, req, nil)
 	require.NoError(t, err)
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -15,6 +15,7 @@ import (
 	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"go.opencensus.io/trace"
 	"google.golang.org/grpc/codes"
@@ -30,6 +31,8 @@ func (vs *Server) GetAttestationData(ctx context.Context, req *ethpb.Attestation
 		trace.Int64Attribute("slot", int64(req.Slot)),
 		trace.Int64Attribute("committeeIndex", int64(req.CommitteeIndex)),
 	)
+	timer := newPhaseTimer("GetAttestationData")
+	defer timer.observe()
 
 	if vs.SyncChecker.Syncing() {
 		return nil, status.Errorf(codes.Unavailable, "Syncing to latest head, not ready to respond")
@@ -39,7 +42,9 @@ func (vs *Server) GetAttestationData(ctx context.Context, req *ethpb.Attestation
 		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid request: %v", err))
 	}
 
+	endCache := timer.begin(cachePhase)
 	res, err := vs.AttestationCache.Get(ctx, req)
+	endCache()
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not retrieve data from attestation cache: %v", err)
 	}
@@ -50,7 +55,10 @@ func (vs *Server) GetAttestationData(ctx context.Context, req *ethpb.Attestation
 
 	if err := vs.AttestationCache.MarkInProgress(req); err != nil {
 		if errors.Is(err, cache.ErrAlreadyInProgress) {
+			// Wait for the request in progress to fill the cache.
+			endCache := timer.begin(cachePhase)
 			res, err := vs.AttestationCache.Get(ctx, req)
+			endCache()
 			if err != nil {
 				return nil, status.Errorf(codes.Internal, "Could not retrieve data from attestation cache: %v", err)
 			}
@@ -68,7 +76,9 @@ func (vs *Server) GetAttestationData(ctx context.Context, req *ethpb.Attestation
 		}
 	}()
 
+	endState := timer.begin(statePhase)
 	headState, err := vs.HeadFetcher.HeadState(ctx)
+	endState()
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not retrieve head state: %v", err)
 	}
@@ -83,7 +93,10 @@ func (vs *Server) GetAttestationData(ctx context.Context, req *ethpb.Attestation
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "Could not get historical head root: %v", err)
 		}
+		// A historical state is loaded from the database and replayed.
+		endDB := timer.begin(dbPhase)
 		headState, err = vs.StateGen.StateByRoot(ctx, bytesutil.ToBytes32(headRoot))
+		endDB()
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "Could not get historical head state: %v", err)
 		}
@@ -93,7 +106,9 @@ func (vs *Server) GetAttestationData(ctx context.Context, req *ethpb.Attestation
 	}
 
 	if helpers.CurrentEpoch(headState) < helpers.SlotToEpoch(req.Slot) {
+		endState := timer.begin(statePhase)
 		headState, err = state.ProcessSlots(ctx, headState, req.Slot)
+		endState()
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "Could not process slots up to %d: %v", req.Slot, err)
 		}
@@ -128,7 +143,10 @@ func (vs *Server) GetAttestationData(ctx context.Context, req *ethpb.Attestation
 		},
 	}
 
-	if err := vs.AttestationCache.Put(ctx, req, res); err != nil {
+	endCache = timer.begin(cachePhase)
+	err = vs.AttestationCache.Put(ctx, req, res)
+	endCache()
+	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not store attestation data in cache: %v", err)
 	}
 	return res, nil
@@ -139,9 +157,11 @@ func (vs *Server) GetAttestationData(ctx context.Context, req *ethpb.Attestation
 func (vs *Server) ProposeAttestation(ctx context.Context, att *ethpb.Attestation) (*ethpb.AttestResponse, error) {
 	ctx, span := trace.StartSpan(ctx, "AttesterServer.ProposeAttestation")
 	defer span.End()
+	timer := newPhaseTimer("ProposeAttestation")
+	defer timer.observe()
 
 	if _, err := bls.SignatureFromBytes(att.Signature); err != nil {
-		return nil, status.Error(codes.InvalidArgument, "Incorrect attestation signature")
+		return nil, grpcutils.InvalidSignatureError("Incorrect attestation signature")
 	}
 
 	root, err := att.Data.HashTreeRoot()
@@ -160,7 +180,9 @@ func (vs *Server) ProposeAttestation(ctx context.Context, att *ethpb.Attestation
 
 	// Determine subnet to broadcast attestation to
 	wantedEpoch := helpers.SlotToEpoch(att.Data.Slot)
+	endState := timer.begin(statePhase)
 	vals, err := vs.HeadFetcher.HeadValidatorsIndices(ctx, wantedEpoch)
+	endState()
 	if err != nil {
 		return nil, err
 	}
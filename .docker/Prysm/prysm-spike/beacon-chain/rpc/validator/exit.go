@@ -47,6 +47,11 @@ func (vs *Server) ProposeExit(ctx context.Context, req *ethpb.SignedVoluntaryExi
 	})
 
 	vs.ExitPool.InsertVoluntaryExit(ctx, s, req)
+	if vs.LocalOperations != nil {
+		if err := vs.LocalOperations.TrackVoluntaryExit(ctx, req); err != nil {
+			log.WithError(err).Error("Could not persist voluntary exit for rebroadcast")
+		}
+	}
 
 	r, err := req.Exit.HashTreeRoot()
 	if err != nil {
@@ -0,0 +1,68 @@
+package validator
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+)
+
+// Phases of the duty handlers a request can wait on.
+const (
+	statePhase = "state" // Fetching, regenerating or advancing a state, or running a state transition.
+	cachePhase = "cache" // Reading the attestation cache, the operation pools and the eth1 caches.
+	dbPhase    = "db"    // Reading or writing the beacon database.
+	totalPhase = "total" // The whole handler, including the time outside of the other phases.
+)
+
+var rpcPhaseDuration = promauto.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Name:    "validator_rpc_phase_duration_seconds",
+		Help:    "Time the duty handlers of the validator RPC spent in each phase of a request, by method.",
+		Buckets: []float64{0.001, 0.005, 0.01, 0.05, 0.1, 0.25, 0.5, 1, 2, 4, 8},
+	},
+	[]string{
+		"method",
+		"phase",
+	},
+)
+
+// phaseTimer sums the time a request spends in each phase, and observes the sums and the total
+// time of the request once it is done, so the phases of a late duty can be told apart from the
+// time the request took on the validator side. A nil timer records nothing.
+type phaseTimer struct {
+	method string
+	start  time.Time
+	phases map[string]time.Duration
+}
+
+func newPhaseTimer(method string) *phaseTimer {
+	return &phaseTimer{
+		method: method,
+		start:  timeutils.Now(),
+		phases: make(map[string]time.Duration),
+	}
+}
+
+// begin starts timing the phase, and returns the function ending it.
+func (t *phaseTimer) begin(phase string) func() {
+	if t == nil {
+		return func() {}
+	}
+	start := timeutils.Now()
+	return func() {
+		t.phases[phase] += timeutils.Since(start)
+	}
+}
+
+// observe records the time spent in each phase the request entered, and the total time.
+func (t *phaseTimer) observe() {
+	if t == nil {
+		return
+	}
+	for phase, d := range t.phases {
+		rpcPhaseDuration.WithLabelValues(t.method, phase).Observe(d.Seconds())
+	}
+	rpcPhaseDuration.WithLabelValues(t.method, totalPhase).Observe(timeutils.Since(t.start).Seconds())
+}
@@ -0,0 +1,27 @@
+package validator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+)
+
+func TestPhaseTimer_SumsPhases(t *testing.T) {
+	timer := newPhaseTimer("GetDuties")
+	for i := 0; i < 2; i++ {
+		end := timer.begin(statePhase)
+		time.Sleep(5 * time.Millisecond)
+		end()
+	}
+	assert.Equal(t, true, timer.phases[statePhase] >= 10*time.Millisecond, "Phases are not summed")
+	_, ok := timer.phases[dbPhase]
+	assert.Equal(t, false, ok, "Phases which were not entered are not recorded")
+	timer.observe()
+}
+
+func TestPhaseTimer_Nil(t *testing.T) {
+	var timer *phaseTimer
+	timer.begin(cachePhase)()
+	timer.observe()
+}
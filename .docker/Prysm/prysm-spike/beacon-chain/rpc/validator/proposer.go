@@ -22,6 +22,7 @@ import (
 	attaggregation "github.com/prysmaticlabs/prysm/shared/aggregation/attestations"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/rand"
@@ -53,6 +54,8 @@ func (vs *Server) GetBlock(ctx context.Context, req *ethpb.BlockRequest) (*ethpb
 	ctx, span := trace.StartSpan(ctx, "ProposerServer.GetBlock")
 	defer span.End()
 	span.AddAttributes(trace.Int64Attribute("slot", int64(req.Slot)))
+	timer := newPhaseTimer("GetBlock")
+	defer timer.observe()
 
 	if vs.SyncChecker.Syncing() {
 		return nil, status.Errorf(codes.Unavailable, "Syncing to latest head, not ready to respond")
@@ -64,15 +67,19 @@ func (vs *Server) GetBlock(ctx context.Context, req *ethpb.BlockRequest) (*ethpb
 		return nil, status.Errorf(codes.Internal, "Could not retrieve head root: %v", err)
 	}
 
+	endState := timer.begin(statePhase)
 	head, err := vs.HeadFetcher.HeadState(ctx)
 	if err != nil {
+		endState()
 		return nil, status.Errorf(codes.Internal, "Could not get head state %v", err)
 	}
 	head, err = state.ProcessSlots(ctx, head, req.Slot)
+	endState()
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not advance slot to calculate proposer index: %v", err)
 	}
 
+	endCache := timer.begin(cachePhase)
 	var eth1Data *ethpb.Eth1Data
 	if featureconfig.Get().EnableEth1DataMajorityVote {
 		eth1Data, err = vs.eth1DataMajorityVote(ctx, head)
@@ -80,17 +87,20 @@ func (vs *Server) GetBlock(ctx context.Context, req *ethpb.BlockRequest) (*ethpb
 		eth1Data, err = vs.eth1Data(ctx, req.Slot)
 	}
 	if err != nil {
+		endCache()
 		return nil, status.Errorf(codes.Internal, "Could not get ETH1 data: %v", err)
 	}
 
 	// Pack ETH1 deposits which have not been included in the beacon chain.
 	deposits, err := vs.deposits(ctx, head, eth1Data)
 	if err != nil {
+		endCache()
 		return nil, status.Errorf(codes.Internal, "Could not get ETH1 deposits: %v", err)
 	}
 
 	// Pack aggregated attestations which have not been included in the beacon chain.
 	atts, err := vs.packAttestations(ctx, head)
+	endCache()
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not get attestations to pack into block: %v", err)
 	}
@@ -124,7 +134,9 @@ func (vs *Server) GetBlock(ctx context.Context, req *ethpb.BlockRequest) (*ethpb
 	}
 
 	// Compute state root with the newly constructed block.
+	endState = timer.begin(statePhase)
 	stateRoot, err = vs.computeStateRoot(ctx, &ethpb.SignedBeaconBlock{Block: blk, Signature: make([]byte, 96)})
+	endState()
 	if err != nil {
 		interop.WriteBlockToDisk(&ethpb.SignedBeaconBlock{Block: blk}, true /*failed*/)
 		return nil, status.Errorf(codes.Internal, "Could not compute state root: %v", err)
@@ -137,6 +149,9 @@ func (vs *Server) GetBlock(ctx context.Context, req *ethpb.BlockRequest) (*ethpb
 // ProposeBlock is called by a proposer during its assigned slot to create a block in an attempt
 // to get it processed by the beacon node as the canonical head.
 func (vs *Server) ProposeBlock(ctx context.Context, blk *ethpb.SignedBeaconBlock) (*ethpb.ProposeResponse, error) {
+	timer := newPhaseTimer("ProposeBlock")
+	defer timer.observe()
+
 	root, err := blk.Block.HashTreeRoot()
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not tree hash block: %v", err)
@@ -152,6 +167,15 @@ func (vs *Server) ProposeBlock(ctx context.Context, blk *ethpb.SignedBeaconBlock
 		})
 	}()
 
+	if vs.ValidateProposals {
+		if err := vs.validateProposal(ctx, blk, timer); err != nil {
+			if errors.Is(err, helpers.ErrSigFailedToVerify) {
+				return nil, grpcutils.InvalidSignatureError("Invalid block proposal, not broadcast: %v", err)
+			}
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid block proposal, not broadcast: %v", err)
+		}
+	}
+
 	// Broadcast the new block to the network.
 	if err := vs.P2P.Broadcast(ctx, blk); err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not broadcast block: %v", err)
@@ -160,7 +184,11 @@ func (vs *Server) ProposeBlock(ctx context.Context, blk *ethpb.SignedBeaconBlock
 		"blockRoot": hex.EncodeToString(root[:]),
 	}).Debug("Broadcasting block")
 
-	if err := vs.BlockReceiver.ReceiveBlock(ctx, blk, root); err != nil {
+	// Receiving the block runs its state transition and saves it with its state.
+	endState := timer.begin(statePhase)
+	err = vs.BlockReceiver.ReceiveBlock(ctx, blk, root)
+	endState()
+	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not process beacon block: %v", err)
 	}
 
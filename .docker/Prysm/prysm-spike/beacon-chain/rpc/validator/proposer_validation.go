@@ -0,0 +1,76 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+// validateProposal applies the checks peers run on gossiped blocks to a block proposed through the
+// RPC, followed by a dry run of its state transition, which verifies the proposer index, the
+// signatures and the state root. A block peers would reject is returned to the validator with the
+// reason instead of being broadcast. The timer, which may be nil, times the phases.
+func (vs *Server) validateProposal(ctx context.Context, blk *ethpb.SignedBeaconBlock, timer *phaseTimer) error {
+	ctx, span := trace.StartSpan(ctx, "ProposerServer.validateProposal")
+	defer span.End()
+
+	if blk == nil || blk.Block == nil {
+		return errors.New("nil block")
+	}
+	genesisTime := uint64(vs.GenesisTimeFetcher.GenesisTime().Unix())
+	if err := helpers.VerifySlotTime(genesisTime, blk.Block.Slot, params.BeaconNetworkConfig().MaximumGossipClockDisparity); err != nil {
+		return errors.Wrap(err, "block slot is not current")
+	}
+	finalizedSlot, err := helpers.StartSlot(vs.FinalizationFetcher.FinalizedCheckpt().Epoch)
+	if err != nil {
+		return err
+	}
+	if blk.Block.Slot <= finalizedSlot {
+		return fmt.Errorf("block slot %d is not after the finalized slot %d", blk.Block.Slot, finalizedSlot)
+	}
+
+	root, err := blk.Block.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	// Peers ignore a second block of a proposer for a slot, and it would get the proposer slashed.
+	endDB := timer.begin(dbPhase)
+	blks, roots, err := vs.BeaconDB.Blocks(ctx, filters.NewFilter().SetStartSlot(blk.Block.Slot).SetEndSlot(blk.Block.Slot))
+	endDB()
+	if err != nil {
+		return errors.Wrap(err, "could not get blocks of the slot")
+	}
+	for i, other := range blks {
+		if other.Block.ProposerIndex == blk.Block.ProposerIndex && roots[i] != root {
+			return fmt.Errorf(
+				"proposer %d already proposed block %#x at slot %d",
+				blk.Block.ProposerIndex, bytesutil.Trunc(roots[i][:]), blk.Block.Slot,
+			)
+		}
+	}
+
+	parentRoot := bytesutil.ToBytes32(blk.Block.ParentRoot)
+	endDB = timer.begin(dbPhase)
+	hasParent := vs.BeaconDB.HasBlock(ctx, parentRoot)
+	endDB()
+	if !hasParent {
+		return fmt.Errorf("parent block %#x is unknown", bytesutil.Trunc(parentRoot[:]))
+	}
+	defer timer.begin(statePhase)()
+	parentState, err := vs.StateGen.StateByRoot(ctx, parentRoot)
+	if err != nil {
+		return errors.Wrapf(err, "could not get state of parent block %#x", bytesutil.Trunc(parentRoot[:]))
+	}
+	if _, err := state.ExecuteStateTransition(ctx, parentState, blk); err != nil {
+		return errors.Wrap(err, "state transition failed")
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package validator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestProposer_ValidateProposal(t *testing.T) {
+	db, sc := dbutil.SetupDB(t)
+	ctx := context.Background()
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	stateRoot, err := beaconState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	genesis := b.NewGenesisBlock(stateRoot[:])
+	require.NoError(t, db.SaveBlock(ctx, genesis))
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, beaconState, genesisRoot))
+
+	slot := uint64(1)
+	chain := &mock.ChainService{
+		Genesis:             time.Now().Add(-time.Duration(slot*params.BeaconConfig().SecondsPerSlot) * time.Second),
+		FinalizedCheckPoint: &ethpb.Checkpoint{Root: genesisRoot[:]},
+	}
+	proposerServer := &Server{
+		BeaconDB:            db,
+		GenesisTimeFetcher:  chain,
+		FinalizationFetcher: chain,
+		StateGen:            stategen.New(db, sc),
+	}
+	blk, err := testutil.GenerateFullBlock(beaconState, privKeys, testutil.DefaultBlockGenConfig(), slot)
+	require.NoError(t, err)
+	require.NoError(t, proposerServer.validateProposal(ctx, blk, nil))
+
+	badSignature := proto.Clone(blk).(*ethpb.SignedBeaconBlock)
+	badSignature.Signature = make([]byte, 96)
+	assert.ErrorContains(t, "state transition failed", proposerServer.validateProposal(ctx, badSignature, nil))
+
+	unknownParent := proto.Clone(blk).(*ethpb.SignedBeaconBlock)
+	unknownParent.Block.ParentRoot = make([]byte, 32)
+	assert.ErrorContains(t, "is unknown", proposerServer.validateProposal(ctx, unknownParent, nil))
+
+	future := proto.Clone(blk).(*ethpb.SignedBeaconBlock)
+	future.Block.Slot = 100
+	assert.ErrorContains(t, "block slot is not current", proposerServer.validateProposal(ctx, future, nil))
+
+	// Another block of the same proposer at the slot is a double proposal.
+	other := proto.Clone(blk).(*ethpb.SignedBeaconBlock)
+	other.Block.Body.Graffiti = bytesutil.PadTo([]byte("other"), 32)
+	require.NoError(t, db.SaveBlock(ctx, other))
+	assert.ErrorContains(t, "already proposed block", proposerServer.validateProposal(ctx, blk, nil))
+}
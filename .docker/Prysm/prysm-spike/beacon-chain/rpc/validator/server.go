@@ -20,6 +20,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/localops"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
@@ -28,6 +29,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/p2putils"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
@@ -52,6 +54,7 @@ type Server struct {
 	ForkFetcher            blockchain.ForkFetcher
 	FinalizationFetcher    blockchain.FinalizationFetcher
 	TimeFetcher            blockchain.TimeFetcher
+	TargetStateFetcher     blockchain.TargetStateFetcher
 	CanonicalStateChan     chan *pbp2p.BeaconState
 	BlockFetcher           powchain.POWBlockFetcher
 	DepositFetcher         depositcache.DepositFetcher
@@ -65,12 +68,14 @@ type Server struct {
 	AttPool                attestations.Pool
 	SlashingsPool          *slashings.Pool
 	ExitPool               *voluntaryexits.Pool
+	LocalOperations        localops.Tracker
 	BlockReceiver          blockchain.BlockReceiver
 	MockEth1Votes          bool
 	Eth1BlockFetcher       powchain.POWBlockFetcher
 	PendingDepositsFetcher depositcache.PendingDepositsFetcher
 	OperationNotifier      opfeed.Notifier
 	StateGen               *stategen.State
+	ValidateProposals      bool
 }
 
 // WaitForActivation checks if a validator public key exists in the active validator registry of the current
@@ -130,9 +135,13 @@ func (vs *Server) ValidatorIndex(ctx context.Context, req *ethpb.ValidatorIndexR
 	return &ethpb.ValidatorIndexResponse{Index: index}, nil
 }
 
-// DomainData fetches the current domain version information from the beacon state.
+// DomainData fetches the domain version information of the requested epoch from the beacon state, or from
+// the fork version schedule when a fork is scheduled between the fork of the beacon state and the epoch.
 func (vs *Server) DomainData(_ context.Context, request *ethpb.DomainRequest) (*ethpb.DomainResponse, error) {
-	fork := vs.ForkFetcher.CurrentFork()
+	fork, err := vs.forkAtEpoch(request.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get fork at epoch %d: %v", request.Epoch, err)
+	}
 	headGenesisValidatorRoot := vs.HeadFetcher.HeadGenesisValidatorRoot()
 	dv, err := helpers.Domain(fork, request.Epoch, bytesutil.ToBytes4(request.Domain), headGenesisValidatorRoot[:])
 	if err != nil {
@@ -143,6 +152,20 @@ func (vs *Server) DomainData(_ context.Context, request *ethpb.DomainRequest) (*
 	}, nil
 }
 
+// forkAtEpoch returns the fork active at the epoch. The beacon state only moves to a scheduled fork once it
+// reaches the fork epoch, so the fork schedule takes over for epochs past a fork the state has not reached.
+func (vs *Server) forkAtEpoch(epoch uint64) (*pbp2p.Fork, error) {
+	headFork := vs.ForkFetcher.CurrentFork()
+	scheduledFork, err := p2putils.Fork(epoch)
+	if err != nil {
+		return nil, err
+	}
+	if headFork == nil || scheduledFork.Epoch > headFork.Epoch {
+		return scheduledFork, nil
+	}
+	return headFork, nil
+}
+
 // CanonicalHead of the current beacon chain. This method is requested on-demand
 // by a validator when it is their time to propose or attest.
 func (vs *Server) CanonicalHead(ctx context.Context, _ *ptypes.Empty) (*ethpb.SignedBeaconBlock, error) {
@@ -432,3 +432,34 @@ func TestWaitForChainStart_NotStartedThenLogFired(t *testing.T) {
 	exitRoutine <- true
 	require.LogsContain(t, hook, "Sending genesis time")
 }
+
+func TestServer_forkAtEpoch(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.ForkVersionSchedule = map[uint64][]byte{
+		10: {1, 0, 0, 0},
+	}
+	params.OverrideBeaconConfig(cfg)
+	headFork := &pbp2p.Fork{
+		PreviousVersion: params.BeaconConfig().GenesisForkVersion,
+		CurrentVersion:  params.BeaconConfig().GenesisForkVersion,
+	}
+	vs := &Server{ForkFetcher: &mockChain.ChainService{Fork: headFork}}
+
+	// The fork of the head state applies before the scheduled fork.
+	fork, err := vs.forkAtEpoch(9)
+	require.NoError(t, err)
+	assert.DeepEqual(t, headFork, fork)
+
+	// The scheduled fork applies from its epoch on, before the head state reaches it.
+	fork, err = vs.forkAtEpoch(10)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte{1, 0, 0, 0}, fork.CurrentVersion)
+	assert.Equal(t, uint64(10), fork.Epoch)
+
+	// The fork of the head state applies once the head state reached the scheduled fork.
+	vs.ForkFetcher = &mockChain.ChainService{Fork: fork}
+	fork, err = vs.forkAtEpoch(11)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10), fork.Epoch)
+}
@@ -39,6 +39,10 @@ func (s *State) MigrateToCold(ctx context.Context, fRoot [32]byte) error {
 		}
 
 		if slot%s.slotsPerArchivedPoint == 0 && slot != 0 {
+			if s.archivalPaused != nil && s.archivalPaused() {
+				log.WithField("slot", slot).Warn("Archival paused, not saving state of archived point in DB")
+				continue
+			}
 			cached, exists, err := s.epochBoundaryStateCache.getBySlot(slot)
 			if err != nil {
 				return fmt.Errorf("could not get epoch boundary state for slot %d", slot)
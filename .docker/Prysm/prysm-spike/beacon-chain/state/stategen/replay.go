@@ -6,6 +6,7 @@ import (
 
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	transition "github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
 	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
@@ -178,6 +179,12 @@ func processSlotsStateGen(ctx context.Context, state *stateTrie.BeaconState, slo
 		if err := state.SetSlot(state.Slot() + 1); err != nil {
 			return nil, err
 		}
+		if helpers.IsEpochStart(state.Slot()) {
+			state, err = transition.ProcessForkUpgrade(state)
+			if err != nil {
+				return nil, errors.Wrap(err, "could not process fork upgrade")
+			}
+		}
 	}
 
 	return state, nil
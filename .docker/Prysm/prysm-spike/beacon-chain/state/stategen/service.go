@@ -28,6 +28,7 @@ type State struct {
 	stateSummaryCache       *cache.StateSummaryCache
 	epochBoundaryStateCache *epochBoundaryState
 	saveHotStateDB          *saveHotStateDbConfig
+	archivalPaused          func() bool
 }
 
 // This tracks the config in the event of long non-finality,
@@ -64,6 +65,13 @@ func New(db db.NoHeadAccessDatabase, stateSummaryCache *cache.StateSummaryCache)
 	}
 }
 
+// PauseArchivalWhen sets the condition under which states on archived points are not saved to the
+// DB, such as low free disk space. Skipped archived points make regenerating cold states replay
+// more blocks from an earlier archived point.
+func (s *State) PauseArchivalWhen(paused func() bool) {
+	s.archivalPaused = paused
+}
+
 // Resume resumes a new state management object from previously saved finalized check point in DB.
 func (s *State) Resume(ctx context.Context) (*state.BeaconState, error) {
 	ctx, span := trace.StartSpan(ctx, "stateGen.Resume")
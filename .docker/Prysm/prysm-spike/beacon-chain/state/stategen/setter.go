@@ -153,3 +153,20 @@ func (s *State) DisableSaveHotStateToDB(ctx context.Context) error {
 
 	return nil
 }
+
+// DeleteHotStatesInDB deletes the hot states saved to the DB in the mode that saves hot states,
+// without leaving the mode. It frees space in the DB when the disk is critically low, at the cost
+// of replaying more blocks to regenerate hot states.
+func (s *State) DeleteHotStatesInDB(ctx context.Context) error {
+	s.saveHotStateDB.lock.Lock()
+	defer s.saveHotStateDB.lock.Unlock()
+	if len(s.saveHotStateDB.savedStateRoots) == 0 {
+		return nil
+	}
+	if err := s.beaconDB.DeleteStates(ctx, s.saveHotStateDB.savedStateRoots); err != nil {
+		return err
+	}
+	log.WithField("deletedHotStates", len(s.saveHotStateDB.savedStateRoots)).Warn("Deleted hot states saved in DB")
+	s.saveHotStateDB.savedStateRoots = nil
+	return nil
+}
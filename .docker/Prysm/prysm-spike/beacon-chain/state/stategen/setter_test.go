@@ -204,6 +204,22 @@ func TestEnableSaveHotStateToDB_AlreadyDisabled(t *testing.T) {
 	require.Equal(t, false, service.saveHotStateDB.enabled)
 }
 
+func TestDeleteHotStatesInDB(t *testing.T) {
+	ctx := context.Background()
+	db, _ := testDB.SetupDB(t)
+	service := New(db, cache.NewStateSummaryCache())
+	service.saveHotStateDB.enabled = true
+	beaconState, _ := testutil.DeterministicGenesisState(t, 32)
+	r := [32]byte{'A'}
+	require.NoError(t, db.SaveState(ctx, beaconState, r))
+	service.saveHotStateDB.savedStateRoots = [][32]byte{r}
+
+	require.NoError(t, service.DeleteHotStatesInDB(ctx))
+	assert.Equal(t, false, db.HasState(ctx, r), "Did not delete hot state")
+	require.Equal(t, 0, len(service.saveHotStateDB.savedStateRoots))
+	require.Equal(t, true, service.saveHotStateDB.enabled)
+}
+
 func TestState_SaveStateSummariesToDB(t *testing.T) {
 	ctx := context.Background()
 	db, _ := testDB.SetupDB(t)
@@ -0,0 +1,21 @@
+package backfill
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	remainingSlots = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "backfill_remaining_slots",
+			Help: "The slot of the lowest block of the database, below which the block history is backfilled.",
+		},
+	)
+	backfilledBlocks = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "backfill_blocks_total",
+			Help: "The number of blocks backfilled from peers below the lowest block of the database.",
+		},
+	)
+)
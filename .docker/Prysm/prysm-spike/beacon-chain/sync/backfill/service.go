@@ -0,0 +1,331 @@
+// Package backfill requests the block history missing below the lowest block of the database from
+// peers, such as after a node started from a finalized checkpoint instead of genesis, so the node
+// can serve the full history to archival users and other peers.
+package backfill
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	prysmsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	p2ppb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/rand"
+	"github.com/sirupsen/logrus"
+)
+
+var _ shared.Service = (*Service)(nil)
+
+// blocksPerRequest is the number of slots of history requested from a peer at once.
+const blocksPerRequest = 64
+
+// retryInterval is how long the service waits before retrying a failed request, or before looking
+// for peers again when none can serve the history.
+var retryInterval = 12 * time.Second
+
+// errUnlinkedBlocks is returned for a response whose blocks are not the chain of the expected root.
+var errUnlinkedBlocks = errors.New("blocks are not the ancestors of the backfilled history")
+
+// Config of the backfill service.
+type Config struct {
+	P2P p2p.P2P
+	DB  db.NoHeadAccessDatabase
+	// BlocksPerSecond is the rate at which history is requested from peers, unlimited when 0.
+	BlocksPerSecond uint64
+	// Paused returns whether backfilling is paused, such as when free disk space is low.
+	Paused func() bool
+}
+
+// Service backfills the block history below the lowest block of the database, its anchor, down to
+// genesis. Peers serve the history backwards in batches, and a batch is only saved once its blocks
+// are verified to be the ancestors of the anchor, each block being the parent of the next one.
+type Service struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	cfg    *Config
+	rand   *rand.Rand
+	wg     sync.WaitGroup
+	lock   sync.RWMutex
+	err    error
+}
+
+// progress is the lowest block of the backfilled history, whose parent is requested next.
+type progress struct {
+	slot       uint64   // Slot of the lowest block, the history is backfilled below it.
+	root       [32]byte // Root of the lowest block.
+	parentRoot [32]byte // Root of the next block to backfill.
+}
+
+// NewService creates a service backfilling the block history of the database.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		ctx:    ctx,
+		cancel: cancel,
+		cfg:    cfg,
+		rand:   rand.NewGenerator(),
+	}
+}
+
+// Start backfilling the block history in the background.
+func (s *Service) Start() {
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		s.run()
+	}()
+}
+
+// Stop the service, waiting for the batch being saved.
+func (s *Service) Stop() error {
+	s.cancel()
+	s.wg.Wait()
+	return nil
+}
+
+// Status returns the error of the last batch, if it failed.
+func (s *Service) Status() error {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.err
+}
+
+func (s *Service) setStatus(err error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.err = err
+}
+
+func (s *Service) run() {
+	p, err := s.anchor(s.ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not find the lowest block of the database to backfill history below")
+		s.setStatus(err)
+		return
+	}
+	if p == nil {
+		log.Debug("Block history is complete, nothing to backfill")
+		return
+	}
+	log.WithFields(logrus.Fields{
+		"slot":      p.slot,
+		"blockRoot": fmt.Sprintf("%#x", bytesutil.Trunc(p.root[:])),
+	}).Info("Backfilling block history below the lowest block of the database")
+
+	// The slot history is requested below. Batches may be empty when all their slots were skipped, so
+	// a batch not holding the expected block may be the fault of a peer which served an empty one before.
+	slot := p.slot
+	for !s.cfg.DB.HasBlock(s.ctx, p.parentRoot) {
+		if slot == 0 {
+			// No peer served the expected block down to genesis, request it again.
+			slot = p.slot
+		}
+		remainingSlots.Set(float64(p.slot))
+		if s.paused() {
+			log.Debug("Backfill paused")
+			if !s.wait(retryInterval) {
+				return
+			}
+			continue
+		}
+		count := uint64(blocksPerRequest)
+		if slot < count {
+			count = slot
+		}
+		start := slot - count
+		pid, err := s.pickPeer(p)
+		if err != nil {
+			log.WithError(err).Debug("Waiting for peers to backfill history from")
+			if !s.wait(retryInterval) {
+				return
+			}
+			continue
+		}
+		blocks, err := prysmsync.SendBeaconBlocksByRangeRequest(s.ctx, s.cfg.P2P, pid, &p2ppb.BeaconBlocksByRangeRequest{
+			StartSlot: start,
+			Count:     count,
+			Step:      1,
+		}, nil)
+		if err == nil && s.paused() {
+			// Backfilling was paused while the batch was requested, request it again once resumed.
+			log.Debug("Backfill paused")
+			if !s.wait(retryInterval) {
+				return
+			}
+			continue
+		}
+		if err == nil {
+			var saved bool
+			saved, err = s.saveBatch(p, start, blocks)
+			if saved {
+				slot = p.slot
+			} else if err == nil {
+				slot = start
+			}
+		}
+		if errors.Is(err, errUnlinkedBlocks) && slot == p.slot {
+			s.cfg.P2P.Peers().Scorers().BadResponsesScorer().Increment(pid)
+		}
+		s.setStatus(err)
+		if err != nil {
+			log.WithError(err).WithFields(logrus.Fields{
+				"peer":  pid,
+				"start": start,
+				"count": count,
+			}).Debug("Could not backfill blocks")
+			// Request the slots below the lowest backfilled block again, from another peer.
+			slot = p.slot
+			if !s.wait(retryInterval) {
+				return
+			}
+			continue
+		}
+		if !s.wait(s.batchInterval(count)) {
+			return
+		}
+	}
+	remainingSlots.Set(0)
+	log.Info("Backfilled block history")
+}
+
+// anchor returns the lowest block of the database above the genesis slot, and up to the finalized
+// checkpoint, when its parent is missing. It returns nil when the block history is complete.
+func (s *Service) anchor(ctx context.Context) (*progress, error) {
+	finalized, err := s.cfg.DB.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get finalized checkpoint")
+	}
+	if finalized == nil {
+		return nil, nil
+	}
+	endSlot, err := helpers.StartSlot(finalized.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	for start := uint64(1); start <= endSlot; start += slotsPerEpoch {
+		blocks, roots, err := s.cfg.DB.Blocks(ctx, filters.NewFilter().SetStartSlot(start).SetEndSlot(start+slotsPerEpoch-1))
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get blocks from slot %d", start)
+		}
+		if len(blocks) == 0 {
+			continue
+		}
+		lowest := 0
+		for i, blk := range blocks {
+			if blk.Block.Slot < blocks[lowest].Block.Slot {
+				lowest = i
+			}
+		}
+		parentRoot := bytesutil.ToBytes32(blocks[lowest].Block.ParentRoot)
+		if s.cfg.DB.HasBlock(ctx, parentRoot) {
+			return nil, nil
+		}
+		return &progress{
+			slot:       blocks[lowest].Block.Slot,
+			root:       roots[lowest],
+			parentRoot: parentRoot,
+		}, nil
+	}
+	return nil, nil
+}
+
+// pickPeer returns a random peer whose finalized checkpoint is at least the epoch of the lowest
+// backfilled block, so it can serve the finalized history below it.
+func (s *Service) pickPeer(p *progress) (peer.ID, error) {
+	_, pids := s.cfg.P2P.Peers().BestFinalized(params.BeaconConfig().MaxPeersToSync, helpers.SlotToEpoch(p.slot))
+	if len(pids) == 0 {
+		return "", errors.New("no peers finalized the backfilled history")
+	}
+	return pids[s.rand.Intn(len(pids))], nil
+}
+
+// saveBatch saves the blocks of the batch of slots from start, once verified to be the ancestors of
+// the lowest backfilled block, and moves the progress to the lowest of them. It returns whether
+// blocks were saved. An empty batch is valid and saves no blocks.
+func (s *Service) saveBatch(p *progress, start uint64, blocks []*ethpb.SignedBeaconBlock) (bool, error) {
+	if len(blocks) == 0 {
+		return false, nil
+	}
+	chain, roots, err := linkBlocks(blocks, p.parentRoot, start, p.slot)
+	if err != nil {
+		return false, err
+	}
+	if err := s.cfg.DB.SaveBackfilledBlocks(s.ctx, chain, p.root); err != nil {
+		return false, errors.Wrap(err, "could not save backfilled blocks")
+	}
+	backfilledBlocks.Add(float64(len(chain)))
+	p.slot = chain[0].Block.Slot
+	p.root = roots[0]
+	p.parentRoot = bytesutil.ToBytes32(chain[0].Block.ParentRoot)
+	if p.slot == 0 {
+		// The genesis block has no parent to backfill.
+		p.parentRoot = p.root
+	}
+	return true, nil
+}
+
+// linkBlocks orders the blocks by slot, and verifies that they are in the slots from start to end,
+// that the highest one has the expected root and that each one is the parent of the next one. It
+// returns the blocks and their roots.
+func linkBlocks(blocks []*ethpb.SignedBeaconBlock, expectedRoot [32]byte, start, end uint64) ([]*ethpb.SignedBeaconBlock, [][32]byte, error) {
+	sort.Slice(blocks, func(i, j int) bool {
+		return blocks[i].Block.Slot < blocks[j].Block.Slot
+	})
+	roots := make([][32]byte, len(blocks))
+	for i := len(blocks) - 1; i >= 0; i-- {
+		blk := blocks[i].Block
+		if blk.Slot < start || blk.Slot >= end {
+			return nil, nil, fmt.Errorf("block slot %d is not in the requested slots %d to %d", blk.Slot, start, end)
+		}
+		root, err := blk.HashTreeRoot()
+		if err != nil {
+			return nil, nil, err
+		}
+		if root != expectedRoot {
+			return nil, nil, errors.Wrapf(errUnlinkedBlocks, "block %#x at slot %d is not the expected block %#x",
+				bytesutil.Trunc(root[:]), blk.Slot, bytesutil.Trunc(expectedRoot[:]))
+		}
+		roots[i] = root
+		expectedRoot = bytesutil.ToBytes32(blk.ParentRoot)
+	}
+	return blocks, roots, nil
+}
+
+// paused returns whether backfilling is paused.
+func (s *Service) paused() bool {
+	return s.cfg.Paused != nil && s.cfg.Paused()
+}
+
+// batchInterval is how long the service waits after requesting a batch of count slots, to request
+// history at the configured rate.
+func (s *Service) batchInterval(count uint64) time.Duration {
+	if s.cfg.BlocksPerSecond == 0 {
+		return 0
+	}
+	return time.Duration(count) * time.Second / time.Duration(s.cfg.BlocksPerSecond)
+}
+
+// wait waits for the duration, and returns false when the service stops first.
+func (s *Service) wait(d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-s.ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
@@ -0,0 +1,181 @@
+package backfill
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/libp2p/go-libp2p-core/network"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	prysmsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	p2ppb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// makeChain returns a chain of blocks from genesis to the end slot, with no block in the skipped
+// slots, indexed by slot.
+func makeChain(t *testing.T, end uint64, skipped func(slot uint64) bool) map[uint64]*ethpb.SignedBeaconBlock {
+	chain := make(map[uint64]*ethpb.SignedBeaconBlock)
+	parentRoot := make([]byte, 32)
+	for slot := uint64(0); slot <= end; slot++ {
+		if slot > 0 && skipped(slot) {
+			continue
+		}
+		blk := testutil.NewBeaconBlock()
+		blk.Block.Slot = slot
+		blk.Block.ParentRoot = parentRoot
+		root, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		parentRoot = root[:]
+		chain[slot] = blk
+	}
+	return chain
+}
+
+func TestLinkBlocks(t *testing.T) {
+	chain := makeChain(t, 10, func(slot uint64) bool { return slot == 5 })
+	root := func(slot uint64) [32]byte {
+		r, err := chain[slot].Block.HashTreeRoot()
+		require.NoError(t, err)
+		return r
+	}
+	tests := []struct {
+		name         string
+		blocks       []*ethpb.SignedBeaconBlock
+		expectedRoot [32]byte
+		start, end   uint64
+		wantSlots    []uint64
+		wantErr      string
+	}{
+		{
+			name:         "linked blocks out of order",
+			blocks:       []*ethpb.SignedBeaconBlock{chain[7], chain[4], chain[6], chain[3]},
+			expectedRoot: root(7),
+			start:        2,
+			end:          8,
+			wantSlots:    []uint64{3, 4, 6, 7},
+		},
+		{
+			name:         "highest block is not the expected block",
+			blocks:       []*ethpb.SignedBeaconBlock{chain[3], chain[4]},
+			expectedRoot: root(7),
+			start:        2,
+			end:          8,
+			wantErr:      errUnlinkedBlocks.Error(),
+		},
+		{
+			name:         "missing block between the blocks",
+			blocks:       []*ethpb.SignedBeaconBlock{chain[3], chain[6], chain[7]},
+			expectedRoot: root(7),
+			start:        2,
+			end:          8,
+			wantErr:      errUnlinkedBlocks.Error(),
+		},
+		{
+			name:         "block out of the requested slots",
+			blocks:       []*ethpb.SignedBeaconBlock{chain[8], chain[7]},
+			expectedRoot: root(8),
+			start:        2,
+			end:          8,
+			wantErr:      "is not in the requested slots",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			blocks, roots, err := linkBlocks(tt.blocks, tt.expectedRoot, tt.start, tt.end)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, len(tt.wantSlots), len(blocks))
+			for i, slot := range tt.wantSlots {
+				assert.Equal(t, slot, blocks[i].Block.Slot)
+				assert.Equal(t, root(slot), roots[i])
+			}
+		})
+	}
+}
+
+func TestService_Backfill(t *testing.T) {
+	retryInterval = 10 * time.Millisecond
+	defer func() {
+		retryInterval = 12 * time.Second
+	}()
+	ctx := context.Background()
+
+	// Slots 20 to 100 are skipped, so a whole batch of the history is empty.
+	const anchorSlot = 150
+	chain := makeChain(t, anchorSlot, func(slot uint64) bool { return slot >= 20 && slot <= 100 })
+
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	p1.Peers().Add(new(enr.Record), p2.PeerID(), nil, network.DirOutbound)
+	p1.Peers().SetConnectionState(p2.PeerID(), peers.PeerConnected)
+	p1.Peers().SetChainState(p2.PeerID(), &p2ppb.Status{FinalizedEpoch: 10, HeadSlot: 400})
+	p2.SetStreamHandler(fmt.Sprintf("%s/ssz_snappy", p2p.RPCBlocksByRangeTopic), func(stream network.Stream) {
+		defer func() {
+			assert.NoError(t, stream.Close())
+		}()
+		req := &p2ppb.BeaconBlocksByRangeRequest{}
+		assert.NoError(t, p2.Encoding().DecodeWithMaxLength(stream, req))
+		for slot := req.StartSlot; slot < req.StartSlot+req.Count*req.Step; slot += req.Step {
+			if blk, ok := chain[slot]; ok {
+				assert.NoError(t, prysmsync.WriteChunk(stream, p2.Encoding(), blk))
+			}
+		}
+	})
+
+	// The database starts from the anchor block, as if synced from a finalized checkpoint.
+	db, _ := dbtest.SetupDB(t)
+	anchorRoot, err := chain[anchorSlot].Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, chain[anchorSlot]))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, anchorRoot))
+	require.NoError(t, db.SaveState(ctx, testutil.NewBeaconState(), anchorRoot))
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 5, Root: anchorRoot[:]}))
+
+	// Nothing is backfilled while paused.
+	var paused int32 = 1
+	s := NewService(ctx, &Config{P2P: p1, DB: db, Paused: func() bool {
+		return atomic.LoadInt32(&paused) == 1
+	}})
+	s.Start()
+	time.Sleep(5 * retryInterval)
+	parentRoot, err := chain[anchorSlot-1].Block.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, false, db.HasBlock(ctx, parentRoot), "Block history was backfilled while paused")
+	atomic.StoreInt32(&paused, 0)
+
+	genesisRoot, err := chain[0].Block.HashTreeRoot()
+	require.NoError(t, err)
+	for i := 0; !db.HasBlock(ctx, genesisRoot); i++ {
+		require.Equal(t, true, i < 100, "Block history was not backfilled")
+		time.Sleep(50 * time.Millisecond)
+	}
+	require.NoError(t, s.Stop())
+	require.NoError(t, s.Status())
+
+	for slot, blk := range chain {
+		root, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, true, db.HasBlock(ctx, root), "Block at slot %d was not backfilled", slot)
+		if slot == anchorSlot {
+			continue
+		}
+		assert.Equal(t, true, db.IsFinalizedBlock(ctx, root), "Block at slot %d is not finalized", slot)
+	}
+	genesis, err := db.GenesisBlock(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, chain[0], genesis)
+}
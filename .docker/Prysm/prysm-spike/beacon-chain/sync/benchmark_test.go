@@ -0,0 +1,129 @@
+package sync
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	gcache "github.com/patrickmn/go-cache"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// discardStream is a stream which drops everything written to it, so benchmarks of response
+// handlers do not measure the network.
+type discardStream struct {
+	network.Stream
+}
+
+func (s *discardStream) Write(p []byte) (int, error) {
+	return len(p), nil
+}
+
+func (s *discardStream) SetWriteDeadline(_ time.Time) error {
+	return nil
+}
+
+func BenchmarkValidateBeaconBlockPubSub(b *testing.B) {
+	db, stateSummaryCache := dbtest.SetupDB(b)
+	p := p2ptest.NewFuzzTestP2P()
+	ctx := context.Background()
+	beaconState, privKeys := testutil.DeterministicGenesisState(b, 1024)
+	parentBlock := testutil.NewBeaconBlock()
+	require.NoError(b, db.SaveBlock(ctx, parentBlock))
+	bRoot, err := parentBlock.Block.HashTreeRoot()
+	require.NoError(b, err)
+	require.NoError(b, db.SaveState(ctx, beaconState, bRoot))
+	require.NoError(b, db.SaveStateSummary(ctx, &pb.StateSummary{Root: bRoot[:]}))
+	copied := beaconState.Copy()
+	require.NoError(b, copied.SetSlot(1))
+	proposerIdx, err := helpers.BeaconProposerIndex(copied)
+	require.NoError(b, err)
+	msg := testutil.NewBeaconBlock()
+	msg.Block.ParentRoot = bRoot[:]
+	msg.Block.Slot = 1
+	msg.Block.ProposerIndex = proposerIdx
+	msg.Signature, err = helpers.ComputeDomainAndSign(beaconState, 0, msg.Block, params.BeaconConfig().DomainBeaconProposer, privKeys[proposerIdx])
+	require.NoError(b, err)
+
+	chainService := &mock.ChainService{Genesis: time.Unix(time.Now().Unix()-int64(params.BeaconConfig().SecondsPerSlot), 0),
+		State: beaconState,
+		FinalizedCheckPoint: &ethpb.Checkpoint{
+			Epoch: 0,
+			Root:  make([]byte, 32),
+		},
+	}
+	r := &Service{
+		db:                  db,
+		p2p:                 p,
+		initialSync:         &mockSync.Sync{IsSyncing: false},
+		chain:               chainService,
+		blockNotifier:       chainService.BlockNotifier(),
+		seenBlockCache:      newSeenCache("block", seenBlockRetention, 10),
+		badBlockCache:       newSeenCache("bad_block", seenBlockRetention, 10),
+		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
+		seenPendingBlocks:   newSeenCache("pending_block", params.BeaconConfig().SlotsPerEpoch, seenPendingBlockSize),
+		stateSummaryCache:   stateSummaryCache,
+		stateGen:            stategen.New(db, stateSummaryCache),
+	}
+	buf := new(bytes.Buffer)
+	_, err = p.Encoding().EncodeGossip(buf, msg)
+	require.NoError(b, err)
+	topic := p2p.GossipTypeMapping[reflect.TypeOf(msg)]
+	data := buf.Bytes()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m := &pubsub.Message{
+			Message: &pubsubpb.Message{
+				Data:  data,
+				Topic: &topic,
+			},
+		}
+		if r.validateBeaconBlockPubSub(ctx, "", m) != pubsub.ValidationAccept {
+			b.Fatal("Block was not accepted")
+		}
+	}
+}
+
+func BenchmarkWriteBlockRangeToStream(b *testing.B) {
+	d, _ := dbtest.SetupDB(b)
+	ctx := context.Background()
+
+	// Populate the database with a chain of blocks, as served to a syncing peer.
+	count := params.BeaconNetworkConfig().MaxRequestBlocks
+	var parentRoot [32]byte
+	for i := uint64(1); i <= count; i++ {
+		blk := testutil.NewBeaconBlock()
+		blk.Block.Slot = i
+		blk.Block.ParentRoot = parentRoot[:]
+		require.NoError(b, d.SaveBlock(ctx, blk))
+		root, err := blk.Block.HashTreeRoot()
+		require.NoError(b, err)
+		parentRoot = root
+	}
+
+	r := &Service{p2p: p2ptest.NewFuzzTestP2P(), db: d, chain: &mock.ChainService{}}
+	stream := &discardStream{}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		prevRoot := [32]byte{}
+		require.NoError(b, r.writeBlockRangeToStream(ctx, 1, count, 1, &prevRoot, stream))
+	}
+}
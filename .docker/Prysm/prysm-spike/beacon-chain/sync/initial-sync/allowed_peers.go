@@ -0,0 +1,46 @@
+package initialsync
+
+import (
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+)
+
+// allowedPeers restricts the peers initial sync requests blocks from, and whose chain status
+// it syncs towards. A nil set allows all connected peers.
+type allowedPeers map[peer.ID]bool
+
+func newAllowedPeers(pids []peer.ID) allowedPeers {
+	if len(pids) == 0 {
+		return nil
+	}
+	allowed := make(allowedPeers, len(pids))
+	for _, pid := range pids {
+		allowed[pid] = true
+	}
+	return allowed
+}
+
+// connected returns the connected peers which are allowed.
+func (a allowedPeers) connected(status *peers.Status) []peer.ID {
+	connected := status.Connected()
+	if a == nil {
+		return connected
+	}
+	allowed := make([]peer.ID, 0, len(a))
+	for _, pid := range connected {
+		if a[pid] {
+			allowed = append(allowed, pid)
+		}
+	}
+	return allowed
+}
+
+// bestFinalized returns the best finalized epoch of the allowed peers, and the peers at or beyond it.
+func (a allowedPeers) bestFinalized(status *peers.Status, maxPeers int, ourFinalizedEpoch uint64) (uint64, []peer.ID) {
+	return status.BestFinalizedAmong(a.connected(status), maxPeers, ourFinalizedEpoch)
+}
+
+// bestNonFinalized returns the best head epoch of the allowed peers, and the peers at or beyond it.
+func (a allowedPeers) bestNonFinalized(status *peers.Status, minPeers int, ourHeadEpoch uint64) (uint64, []peer.ID) {
+	return status.BestNonFinalizedAmong(a.connected(status), minPeers, ourHeadEpoch)
+}
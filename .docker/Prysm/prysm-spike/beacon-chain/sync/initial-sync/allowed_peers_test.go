@@ -0,0 +1,52 @@
+package initialsync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
+	p2ppb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+)
+
+func TestAllowedPeers(t *testing.T) {
+	status := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit:    30,
+		ScorerParams: &scorers.Config{},
+	})
+	// The allowed peers are behind the other peers.
+	chainStates := map[peer.ID]*p2ppb.Status{
+		"a": {FinalizedEpoch: 10, HeadSlot: 400},
+		"b": {FinalizedEpoch: 10, HeadSlot: 400},
+		"c": {FinalizedEpoch: 8, HeadSlot: 300},
+		"d": {FinalizedEpoch: 8, HeadSlot: 300},
+	}
+	for pid, chainState := range chainStates {
+		status.Add(new(enr.Record), pid, nil, network.DirOutbound)
+		status.SetConnectionState(pid, peers.PeerConnected)
+		status.SetChainState(pid, chainState)
+	}
+	status.Add(new(enr.Record), "e", nil, network.DirOutbound)
+
+	var all allowedPeers
+	assert.Equal(t, 4, len(all.connected(status)))
+	epoch, pids := all.bestFinalized(status, 10, 0)
+	assert.Equal(t, uint64(10), epoch)
+	assert.Equal(t, 2, len(pids))
+
+	allowed := newAllowedPeers([]peer.ID{"c", "d", "e"})
+	assert.Equal(t, 2, len(allowed.connected(status)), "Disconnected peers are not returned")
+	epoch, pids = allowed.bestFinalized(status, 10, 0)
+	assert.Equal(t, uint64(8), epoch)
+	assert.Equal(t, 2, len(pids))
+	epoch, pids = allowed.bestNonFinalized(status, 1, 0)
+	assert.Equal(t, uint64(9), epoch)
+	for _, pid := range pids {
+		assert.Equal(t, true, allowed[pid], "Peer %s is not allowed", pid)
+	}
+	assert.Equal(t, true, newAllowedPeers(nil) == nil)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/pkg/errors"
 	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/era"
 	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	p2pTypes "github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
@@ -19,6 +20,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/rand"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
@@ -43,6 +45,9 @@ const (
 	// backtrackingMaxHops how many hops (during search for common ancestor in backtracking) to do
 	// before giving up.
 	backtrackingMaxHops = 128
+	// peerLatencyWeight defines how much the latest response time of a peer affects its tracked
+	// response time, which the fastest peer selection orders peers by.
+	peerLatencyWeight = 0.3
 )
 
 var (
@@ -61,6 +66,10 @@ type blocksFetcherConfig struct {
 	db                       db.ReadOnlyDatabase
 	peerFilterCapacityWeight float64
 	mode                     syncMode
+	preferredPeers           []peer.ID
+	allowedPeers             allowedPeers
+	eraStore                 *era.Store
+	peerSelection            string
 }
 
 // blocksFetcher is a service to fetch chain data from peers.
@@ -79,9 +88,14 @@ type blocksFetcher struct {
 	peerLocks       map[peer.ID]*peerLock
 	fetchRequests   chan *fetchRequestParams
 	fetchResponses  chan *fetchRequestResponse
-	capacityWeight  float64       // how remaining capacity affects peer selection
-	mode            syncMode      // allows to use fetcher in different sync scenarios
-	quit            chan struct{} // termination notifier
+	capacityWeight  float64                   // how remaining capacity affects peer selection
+	mode            syncMode                  // allows to use fetcher in different sync scenarios
+	preferredPeers  map[peer.ID]bool          // peers which served the batches before the sync was resumed
+	allowedPeers    allowedPeers              // peers blocks may be requested from, all when nil
+	eraStore        *era.Store                // era files of finalized blocks, read before requesting peers
+	peerSelection   string                    // strategy ordering the peers requests are sent to
+	peerLatencies   map[peer.ID]time.Duration // tracked response times of peers, by peer
+	quit            chan struct{}             // termination notifier
 }
 
 // peerLock restricts fetcher actions on per peer basis. Currently, used for rate limiting.
@@ -120,6 +134,11 @@ func newBlocksFetcher(ctx context.Context, cfg *blocksFetcherConfig) *blocksFetc
 		capacityWeight = peerFilterCapacityWeight
 	}
 
+	preferredPeers := make(map[peer.ID]bool, len(cfg.preferredPeers))
+	for _, pid := range cfg.preferredPeers {
+		preferredPeers[pid] = true
+	}
+
 	ctx, cancel := context.WithCancel(ctx)
 	return &blocksFetcher{
 		ctx:             ctx,
@@ -135,6 +154,11 @@ func newBlocksFetcher(ctx context.Context, cfg *blocksFetcherConfig) *blocksFetc
 		fetchResponses:  make(chan *fetchRequestResponse, maxPendingRequests),
 		capacityWeight:  capacityWeight,
 		mode:            cfg.mode,
+		preferredPeers:  preferredPeers,
+		allowedPeers:    cfg.allowedPeers,
+		eraStore:        cfg.eraStore,
+		peerSelection:   cfg.peerSelection,
+		peerLatencies:   make(map[peer.ID]time.Duration),
 		quit:            make(chan struct{}),
 	}
 }
@@ -252,6 +276,17 @@ func (f *blocksFetcher) handleRequest(ctx context.Context, start, count uint64)
 		return response
 	}
 
+	// Finalized blocks of era files are read without requesting peers.
+	if f.eraStore != nil {
+		blocks, covered, err := f.eraStore.BlocksByRange(start, count)
+		if err != nil {
+			log.WithError(err).Debug("Could not read blocks from era files")
+		} else if covered {
+			response.blocks = blocks
+			return response
+		}
+	}
+
 	_, targetEpoch, peers := f.calculateHeadAndTargetEpochs()
 	if len(peers) == 0 {
 		response.err = errNoPeersAvailable
@@ -294,6 +329,7 @@ func (f *blocksFetcher) fetchBlocksFromPeer(
 			}
 			return blocks, peers[i], err
 		}
+		f.unpreferPeer(peers[i])
 	}
 	return nil, "", errNoPeersAvailable
 }
@@ -325,7 +361,12 @@ func (f *blocksFetcher) requestBlocks(
 	f.rateLimiter.Add(pid.String(), int64(req.Count))
 	l.Unlock()
 
-	return prysmsync.SendBeaconBlocksByRangeRequest(ctx, f.p2p, pid, req, nil)
+	start := timeutils.Now()
+	blocks, err := prysmsync.SendBeaconBlocksByRangeRequest(ctx, f.p2p, pid, req, nil)
+	if err == nil {
+		f.trackLatency(pid, timeutils.Since(start))
+	}
+	return blocks, err
 }
 
 // requestBlocksByRoot is a wrapper for handling BeaconBlockByRootsReq requests/streams.
@@ -76,10 +76,10 @@ func (f *blocksFetcher) waitForMinimumPeers(ctx context.Context) ([]peer.ID, err
 		var peers []peer.ID
 		if f.mode == modeStopOnFinalizedEpoch {
 			headEpoch := f.chain.FinalizedCheckpt().Epoch
-			_, peers = f.p2p.Peers().BestFinalized(params.BeaconConfig().MaxPeersToSync, headEpoch)
+			_, peers = f.allowedPeers.bestFinalized(f.p2p.Peers(), params.BeaconConfig().MaxPeersToSync, headEpoch)
 		} else {
 			headEpoch := helpers.SlotToEpoch(f.chain.HeadSlot())
-			_, peers = f.p2p.Peers().BestNonFinalized(flags.Get().MinimumSyncPeers, headEpoch)
+			_, peers = f.allowedPeers.bestNonFinalized(f.p2p.Peers(), flags.Get().MinimumSyncPeers, headEpoch)
 		}
 		if len(peers) >= required {
 			return peers, nil
@@ -93,8 +93,17 @@ func (f *blocksFetcher) waitForMinimumPeers(ctx context.Context) ([]peer.ID, err
 
 // filterPeers returns transformed list of peers, weight ordered or randomized, constrained
 // if necessary (when only percentage of peers returned).
-// When peer scorer is enabled, fallbacks filterScoredPeers.
+// Peers are ordered by the peer selection strategy of the fetcher. With the default, scored,
+// strategy and the peer scorer enabled, fallbacks filterScoredPeers.
 func (f *blocksFetcher) filterPeers(ctx context.Context, peers []peer.ID, peersPercentage float64) []peer.ID {
+	switch f.peerSelection {
+	case flags.SyncPeerSelectionFastest:
+		return f.filterFastestPeers(peers, peersPercentage)
+	case flags.SyncPeerSelectionMostFinalized:
+		return f.filterMostFinalizedPeers(peers, peersPercentage)
+	case flags.SyncPeerSelectionRandom:
+		return f.filterRandomPeers(peers, peersPercentage)
+	}
 	if featureconfig.Get().EnablePeerScorer {
 		return f.filterScoredPeers(ctx, peers, peersPercentagePerRequest)
 	}
@@ -110,7 +119,7 @@ func (f *blocksFetcher) filterPeers(ctx context.Context, peers []peer.ID, peersP
 	})
 
 	// Select sub-sample from peers (honoring min-max invariants).
-	peers = trimPeers(peers, peersPercentage)
+	peers = trimPeers(f.prioritizePreferredPeers(peers), peersPercentage)
 
 	// Order peers by remaining capacity, effectively turning in-order
 	// round robin peer processing into a weighted one (peers with higher
@@ -154,7 +163,28 @@ func (f *blocksFetcher) filterScoredPeers(ctx context.Context, peers []peer.ID,
 		return math.Round(overallScore*scorers.ScoreRoundingFactor) / scorers.ScoreRoundingFactor
 	})
 
-	return trimPeers(peers, peersPercentage)
+	return trimPeers(f.prioritizePreferredPeers(peers), peersPercentage)
+}
+
+// prioritizePreferredPeers moves the preferred peers, which served the batches processed before
+// the sync was resumed, to the front of the list, keeping the order of the other peers.
+func (f *blocksFetcher) prioritizePreferredPeers(peers []peer.ID) []peer.ID {
+	f.Lock()
+	defer f.Unlock()
+	if len(f.preferredPeers) == 0 {
+		return peers
+	}
+	sort.SliceStable(peers, func(i, j int) bool {
+		return f.preferredPeers[peers[i]] && !f.preferredPeers[peers[j]]
+	})
+	return peers
+}
+
+// unpreferPeer stops preferring a peer which failed to serve a request.
+func (f *blocksFetcher) unpreferPeer(pid peer.ID) {
+	f.Lock()
+	defer f.Unlock()
+	delete(f.preferredPeers, pid)
 }
 
 // trimPeers limits peer list, returning only specified percentage of peers.
@@ -172,3 +202,52 @@ func trimPeers(peers []peer.ID, peersPercentage float64) []peer.ID {
 	limit = mathutil.Min(limit, uint64(len(peers)))
 	return peers[:limit]
 }
+
+// trackLatency folds the response time of a successful request into the tracked response time of a peer.
+func (f *blocksFetcher) trackLatency(pid peer.ID, latency time.Duration) {
+	f.Lock()
+	defer f.Unlock()
+	if tracked, ok := f.peerLatencies[pid]; ok {
+		latency = time.Duration(float64(tracked)*(1-peerLatencyWeight) + float64(latency)*peerLatencyWeight)
+	}
+	f.peerLatencies[pid] = latency
+}
+
+// filterFastestPeers returns the peers ordered by their tracked response time, fastest first. Peers not
+// requested yet come first, so that their response time gets known.
+func (f *blocksFetcher) filterFastestPeers(peers []peer.ID, peersPercentage float64) []peer.ID {
+	f.rand.Shuffle(len(peers), func(i, j int) {
+		peers[i], peers[j] = peers[j], peers[i]
+	})
+	f.Lock()
+	sort.SliceStable(peers, func(i, j int) bool {
+		return f.peerLatencies[peers[i]] < f.peerLatencies[peers[j]]
+	})
+	f.Unlock()
+	return trimPeers(f.prioritizePreferredPeers(peers), peersPercentage)
+}
+
+// filterMostFinalizedPeers returns the peers ordered by the finalized epoch of their last status, highest first.
+func (f *blocksFetcher) filterMostFinalizedPeers(peers []peer.ID, peersPercentage float64) []peer.ID {
+	f.rand.Shuffle(len(peers), func(i, j int) {
+		peers[i], peers[j] = peers[j], peers[i]
+	})
+	finalizedEpochs := make(map[peer.ID]uint64, len(peers))
+	for _, pid := range peers {
+		if status, err := f.p2p.Peers().ChainState(pid); err == nil && status != nil {
+			finalizedEpochs[pid] = status.FinalizedEpoch
+		}
+	}
+	sort.SliceStable(peers, func(i, j int) bool {
+		return finalizedEpochs[peers[i]] > finalizedEpochs[peers[j]]
+	})
+	return trimPeers(f.prioritizePreferredPeers(peers), peersPercentage)
+}
+
+// filterRandomPeers returns the peers in random order.
+func (f *blocksFetcher) filterRandomPeers(peers []peer.ID, peersPercentage float64) []peer.ID {
+	f.rand.Shuffle(len(peers), func(i, j int) {
+		peers[i], peers[j] = peers[j], peers[i]
+	})
+	return trimPeers(f.prioritizePreferredPeers(peers), peersPercentage)
+}
@@ -8,10 +8,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/p2p/enr"
 	"github.com/kevinms/leakybucket-go"
+	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
+	p2pt "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	p2ppb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
@@ -523,3 +527,72 @@ func TestBlocksFetcher_removeStalePeerLocks(t *testing.T) {
 		})
 	}
 }
+
+func TestBlocksFetcher_prioritizePreferredPeers(t *testing.T) {
+	peers := []peer.ID{"a", "b", "c", "d"}
+	fetcher := newBlocksFetcher(context.Background(), &blocksFetcherConfig{
+		preferredPeers: []peer.ID{"c", "e"},
+	})
+	assert.DeepEqual(t, []peer.ID{"c", "a", "b", "d"}, fetcher.prioritizePreferredPeers(peers))
+
+	// Peers failing a request are no longer preferred.
+	fetcher.unpreferPeer("c")
+	assert.DeepEqual(t, []peer.ID{"c", "a", "b", "d"}, fetcher.prioritizePreferredPeers(peers))
+	peers = []peer.ID{"a", "b", "c", "d"}
+	assert.DeepEqual(t, []peer.ID{"a", "b", "c", "d"}, fetcher.prioritizePreferredPeers(peers))
+}
+
+func TestBlocksFetcher_trackLatency(t *testing.T) {
+	fetcher := newBlocksFetcher(context.Background(), &blocksFetcherConfig{})
+	fetcher.trackLatency("a", 100*time.Millisecond)
+	assert.Equal(t, 100*time.Millisecond, fetcher.peerLatencies["a"])
+	fetcher.trackLatency("a", 200*time.Millisecond)
+	assert.Equal(t, 130*time.Millisecond, fetcher.peerLatencies["a"])
+}
+
+func TestBlocksFetcher_filterPeers_Strategies(t *testing.T) {
+	p := p2pt.NewTestP2P(t)
+	chainStates := map[peer.ID]*p2ppb.Status{
+		"a": {FinalizedEpoch: 8},
+		"b": {FinalizedEpoch: 12},
+		"c": {FinalizedEpoch: 10},
+		"d": {FinalizedEpoch: 4},
+	}
+	for pid, chainState := range chainStates {
+		p.Peers().Add(new(enr.Record), pid, nil, network.DirOutbound)
+		p.Peers().SetChainState(pid, chainState)
+	}
+
+	t.Run("fastest", func(t *testing.T) {
+		fetcher := newBlocksFetcher(context.Background(), &blocksFetcherConfig{
+			p2p:           p,
+			peerSelection: flags.SyncPeerSelectionFastest,
+		})
+		fetcher.trackLatency("a", 300*time.Millisecond)
+		fetcher.trackLatency("b", 100*time.Millisecond)
+		fetcher.trackLatency("c", 200*time.Millisecond)
+		// Peer "d" has not been requested yet, so it is tried first.
+		got := fetcher.filterPeers(context.Background(), []peer.ID{"a", "b", "c", "d"}, 1.0)
+		assert.DeepEqual(t, []peer.ID{"d", "b", "c", "a"}, got)
+	})
+
+	t.Run("most finalized", func(t *testing.T) {
+		fetcher := newBlocksFetcher(context.Background(), &blocksFetcherConfig{
+			p2p:           p,
+			peerSelection: flags.SyncPeerSelectionMostFinalized,
+		})
+		got := fetcher.filterPeers(context.Background(), []peer.ID{"a", "b", "c", "d"}, 1.0)
+		assert.DeepEqual(t, []peer.ID{"b", "c", "a", "d"}, got)
+	})
+
+	t.Run("random", func(t *testing.T) {
+		fetcher := newBlocksFetcher(context.Background(), &blocksFetcherConfig{
+			p2p:            p,
+			peerSelection:  flags.SyncPeerSelectionRandom,
+			preferredPeers: []peer.ID{"c"},
+		})
+		got := fetcher.filterPeers(context.Background(), []peer.ID{"a", "b", "c", "d"}, 1.0)
+		assert.Equal(t, 4, len(got))
+		assert.Equal(t, peer.ID("c"), got[0])
+	})
+}
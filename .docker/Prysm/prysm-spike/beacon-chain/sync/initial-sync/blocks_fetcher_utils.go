@@ -169,7 +169,7 @@ func (f *blocksFetcher) findFork(ctx context.Context, slot uint64) (*forkData, e
 
 	// Select peers that have higher head slot, and potentially blocks from more favourable fork.
 	// Exit early if no peers are ready.
-	_, peers := f.p2p.Peers().BestNonFinalized(1, epoch+1)
+	_, peers := f.allowedPeers.bestNonFinalized(f.p2p.Peers(), 1, epoch+1)
 	if len(peers) == 0 {
 		return nil, errNoPeersAvailable
 	}
@@ -286,7 +286,7 @@ func (f *blocksFetcher) findAncestor(ctx context.Context, pid peer.ID, block *et
 
 // bestFinalizedSlot returns the highest finalized slot of the majority of connected peers.
 func (f *blocksFetcher) bestFinalizedSlot() uint64 {
-	finalizedEpoch, _ := f.p2p.Peers().BestFinalized(
+	finalizedEpoch, _ := f.allowedPeers.bestFinalized(f.p2p.Peers(),
 		params.BeaconConfig().MaxPeersToSync, f.chain.FinalizedCheckpt().Epoch)
 	return finalizedEpoch * params.BeaconConfig().SlotsPerEpoch
 }
@@ -294,7 +294,7 @@ func (f *blocksFetcher) bestFinalizedSlot() uint64 {
 // bestNonFinalizedSlot returns the highest non-finalized slot of enough number of connected peers.
 func (f *blocksFetcher) bestNonFinalizedSlot() uint64 {
 	headEpoch := helpers.SlotToEpoch(f.chain.HeadSlot())
-	targetEpoch, _ := f.p2p.Peers().BestNonFinalized(flags.Get().MinimumSyncPeers*2, headEpoch)
+	targetEpoch, _ := f.allowedPeers.bestNonFinalized(f.p2p.Peers(), flags.Get().MinimumSyncPeers*2, headEpoch)
 	return targetEpoch * params.BeaconConfig().SlotsPerEpoch
 }
 
@@ -305,10 +305,10 @@ func (f *blocksFetcher) calculateHeadAndTargetEpochs() (uint64, uint64, []peer.I
 	var peers []peer.ID
 	if f.mode == modeStopOnFinalizedEpoch {
 		headEpoch = f.chain.FinalizedCheckpt().Epoch
-		targetEpoch, peers = f.p2p.Peers().BestFinalized(params.BeaconConfig().MaxPeersToSync, headEpoch)
+		targetEpoch, peers = f.allowedPeers.bestFinalized(f.p2p.Peers(), params.BeaconConfig().MaxPeersToSync, headEpoch)
 	} else {
 		headEpoch = helpers.SlotToEpoch(f.chain.HeadSlot())
-		targetEpoch, peers = f.p2p.Peers().BestNonFinalized(flags.Get().MinimumSyncPeers, headEpoch)
+		targetEpoch, peers = f.allowedPeers.bestNonFinalized(f.p2p.Peers(), flags.Get().MinimumSyncPeers, headEpoch)
 	}
 	return headEpoch, targetEpoch, peers
 }
@@ -9,6 +9,7 @@ import (
 	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/era"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	beaconsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
@@ -63,10 +64,15 @@ type blocksQueueConfig struct {
 	blocksFetcher       *blocksFetcher
 	chain               blockchainService
 	startSlot           uint64
+	resumeSlot          uint64
 	highestExpectedSlot uint64
 	p2p                 p2p.P2P
 	db                  db.ReadOnlyDatabase
 	mode                syncMode
+	preferredPeers      []peer.ID
+	allowedPeers        allowedPeers
+	eraStore            *era.Store
+	peerSelection       string
 }
 
 // blocksQueue is a priority queue that serves as a intermediary between block fetchers (producers)
@@ -77,6 +83,7 @@ type blocksQueue struct {
 	smm                 *stateMachineManager
 	blocksFetcher       *blocksFetcher
 	chain               blockchainService
+	resumeSlot          uint64
 	highestExpectedSlot uint64
 	mode                syncMode
 	exitConditions      struct {
@@ -100,9 +107,13 @@ func newBlocksQueue(ctx context.Context, cfg *blocksQueueConfig) *blocksQueue {
 	blocksFetcher := cfg.blocksFetcher
 	if blocksFetcher == nil {
 		blocksFetcher = newBlocksFetcher(ctx, &blocksFetcherConfig{
-			chain: cfg.chain,
-			p2p:   cfg.p2p,
-			db:    cfg.db,
+			chain:          cfg.chain,
+			p2p:            cfg.p2p,
+			db:             cfg.db,
+			preferredPeers: cfg.preferredPeers,
+			allowedPeers:   cfg.allowedPeers,
+			eraStore:       cfg.eraStore,
+			peerSelection:  cfg.peerSelection,
 		})
 	}
 	highestExpectedSlot := cfg.highestExpectedSlot
@@ -120,6 +131,7 @@ func newBlocksQueue(ctx context.Context, cfg *blocksQueueConfig) *blocksQueue {
 	queue := &blocksQueue{
 		ctx:                 ctx,
 		cancel:              cancel,
+		resumeSlot:          cfg.resumeSlot,
 		highestExpectedSlot: highestExpectedSlot,
 		blocksFetcher:       blocksFetcher,
 		chain:               cfg.chain,
@@ -175,9 +187,12 @@ func (q *blocksQueue) loop() {
 		log.WithError(err).Debug("Can not start blocks provider")
 	}
 
-	// Define initial state machines.
+	// Define initial state machines. A sync resumed from the saved progress starts right after the
+	// last processed batch, as its head is known to be on the synced chain.
 	startSlot := q.chain.HeadSlot()
-	if startSlot > startBackSlots {
+	if q.resumeSlot > 0 {
+		startSlot = q.resumeSlot
+	} else if startSlot > startBackSlots {
 		startSlot -= startBackSlots
 	}
 	blocksPerRequest := q.blocksFetcher.blocksPerSecond
@@ -319,9 +334,11 @@ func (q *blocksQueue) onDataReceivedEvent(ctx context.Context) eventHandlerFn {
 					}
 				}
 			case beaconsync.ErrInvalidFetchedData:
-				// Peer returned invalid data, penalize.
-				q.blocksFetcher.p2p.Peers().Scorers().BadResponsesScorer().Increment(m.pid)
-				log.WithField("pid", response.pid).Debug("Peer is penalized for invalid blocks")
+				// Peer returned invalid data, penalize. Blocks read from era files have no peer.
+				if response.pid != "" {
+					q.blocksFetcher.p2p.Peers().Scorers().BadResponsesScorer().Increment(response.pid)
+					log.WithField("pid", response.pid).Debug("Peer is penalized for invalid blocks")
+				}
 			}
 			return m.state, response.err
 		}
@@ -544,6 +544,27 @@ func TestBlocksQueue_onDataReceivedEvent(t *testing.T) {
 		assert.LogsContain(t, hook, "msg=\"Peer is penalized for invalid blocks\" pid=ZiCa")
 	})
 
+	t.Run("invalid data read from era files", func(t *testing.T) {
+		queue := newBlocksQueue(ctx, &blocksQueueConfig{
+			blocksFetcher:       fetcher,
+			chain:               mc,
+			highestExpectedSlot: blockBatchLimit,
+		})
+
+		hook := logTest.NewGlobal()
+		defer hook.Reset()
+		handlerFn := queue.onDataReceivedEvent(ctx)
+		updatedState, err := handlerFn(&stateMachine{
+			state: stateScheduled,
+			pid:   "def",
+		}, &fetchRequestResponse{
+			err: beaconsync.ErrInvalidFetchedData,
+		})
+		assert.ErrorContains(t, beaconsync.ErrInvalidFetchedData.Error(), err)
+		assert.Equal(t, stateScheduled, updatedState)
+		assert.LogsDoNotContain(t, hook, "Peer is penalized for invalid blocks")
+	})
+
 	t.Run("transition ok", func(t *testing.T) {
 		queue := newBlocksQueue(ctx, &blocksQueueConfig{
 			blocksFetcher:       fetcher,
@@ -0,0 +1,85 @@
+package initialsync
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/runutil"
+	"github.com/sirupsen/logrus"
+)
+
+// monitorCatchUp periodically checks whether the node, while in regular sync, has fallen so far behind
+// the finalized epoch of its peers (for instance after the process was paused) that blocks are better
+// fetched with the queue based initial sync, and switches to it until the head is caught up again.
+func (s *Service) monitorCatchUp(genesis time.Time) {
+	threshold := flags.Get().SyncCatchUpThreshold
+	if threshold == 0 {
+		return
+	}
+	interval := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	runutil.RunEvery(s.ctx, interval, func() {
+		if s.synced.IsNotSet() {
+			return
+		}
+		headEpoch := helpers.SlotToEpoch(s.chain.HeadSlot())
+		finalizedEpoch := s.highestFinalizedEpoch()
+		if !shouldCatchUp(headEpoch, finalizedEpoch, threshold) {
+			return
+		}
+		log.WithFields(logrus.Fields{
+			"headEpoch":           headEpoch,
+			"peersFinalizedEpoch": finalizedEpoch,
+			"threshold":           threshold,
+		}).Info("Fallen behind finalized epoch of peers; switching to initial sync to catch up")
+		if err := s.catchUp(genesis); err != nil {
+			log.WithError(err).Error("Could not catch up with peers")
+		}
+	})
+}
+
+// catchUp runs initial sync rounds until the head is close enough to the current epoch to resume
+// regular sync, or until a round makes no progress, in which case regular sync takes over and the
+// monitor retries once peers advertise a later finalized epoch.
+func (s *Service) catchUp(genesis time.Time) error {
+	s.catchUpLock.Lock()
+	defer s.catchUpLock.Unlock()
+	s.synced.UnSet()
+	defer s.synced.Set()
+
+	for {
+		s.waitForMinimumPeers()
+		startSlot := s.chain.HeadSlot()
+		if err := s.roundRobinSync(genesis); err != nil {
+			if errors.Is(s.ctx.Err(), context.Canceled) {
+				return nil
+			}
+			return err
+		}
+		headSlot := s.chain.HeadSlot()
+		if caughtUp(helpers.SlotToEpoch(headSlot), helpers.SlotToEpoch(helpers.SlotsSince(genesis))) {
+			log.WithField("slot", headSlot).Info("Caught up with peers; resuming regular sync")
+			return nil
+		}
+		if headSlot <= startSlot {
+			log.WithField("slot", headSlot).Warn("Initial sync round made no progress; resuming regular sync")
+			return nil
+		}
+	}
+}
+
+// shouldCatchUp returns true when the finalized epoch of peers is more than threshold epochs ahead of
+// the head epoch of the node.
+func shouldCatchUp(headEpoch, peersFinalizedEpoch, threshold uint64) bool {
+	return peersFinalizedEpoch > headEpoch+threshold
+}
+
+// caughtUp returns true when the head epoch of the node is within an epoch of the current epoch. This
+// is stricter than the threshold to start catching up, so the node does not flap between sync modes
+// while its distance to peers hovers around the threshold.
+func caughtUp(headEpoch, currentEpoch uint64) bool {
+	return headEpoch+1 >= currentEpoch
+}
@@ -0,0 +1,37 @@
+package initialsync
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+)
+
+func TestService_ShouldCatchUp(t *testing.T) {
+	tests := []struct {
+		name                string
+		headEpoch           uint64
+		peersFinalizedEpoch uint64
+		threshold           uint64
+		want                bool
+	}{
+		{name: "peers behind", headEpoch: 10, peersFinalizedEpoch: 8, threshold: 4, want: false},
+		{name: "within threshold", headEpoch: 10, peersFinalizedEpoch: 14, threshold: 4, want: false},
+		{name: "beyond threshold", headEpoch: 10, peersFinalizedEpoch: 15, threshold: 4, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, shouldCatchUp(tt.headEpoch, tt.peersFinalizedEpoch, tt.threshold))
+		})
+	}
+}
+
+func TestService_CaughtUp(t *testing.T) {
+	assert.Equal(t, true, caughtUp(10, 10))
+	assert.Equal(t, true, caughtUp(10, 11))
+	assert.Equal(t, false, caughtUp(10, 12))
+	// A node which started catching up at a distance of several epochs keeps catching up
+	// until it is within an epoch of the current epoch.
+	assert.Equal(t, true, shouldCatchUp(10, 15, 4))
+	assert.Equal(t, false, shouldCatchUp(13, 15, 4))
+	assert.Equal(t, false, caughtUp(13, 16))
+}
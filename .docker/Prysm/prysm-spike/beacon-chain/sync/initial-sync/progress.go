@@ -0,0 +1,93 @@
+package initialsync
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/pkg/errors"
+	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/sirupsen/logrus"
+)
+
+// resumePoint is the position an interrupted initial sync is resumed from.
+type resumePoint struct {
+	slot  uint64
+	peers []peer.ID
+}
+
+// loadResumePoint returns the position saved by an interrupted initial sync. The progress is only
+// resumed while its head is still the head of the chain, otherwise it is stale and removed.
+func (s *Service) loadResumePoint(ctx context.Context) (*resumePoint, error) {
+	progress, err := s.db.InitialSyncProgress(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get initial sync progress")
+	}
+	if progress == nil {
+		return nil, nil
+	}
+	headRoot, err := s.chain.HeadRoot(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get head root")
+	}
+	if !bytes.Equal(headRoot, progress.ProcessedRoot) || s.chain.HeadSlot() != progress.ProcessedSlot {
+		log.WithField("processedSlot", progress.ProcessedSlot).Debug("Removing stale initial sync progress")
+		return nil, s.db.DeleteInitialSyncProgress(ctx)
+	}
+	point := &resumePoint{slot: progress.ProcessedSlot + 1}
+	for _, p := range progress.Peers {
+		pid, err := peer.Decode(p)
+		if err != nil {
+			continue
+		}
+		point.peers = append(point.peers, pid)
+	}
+	log.WithFields(logrus.Fields{
+		"batchStartSlot": progress.BatchStartSlot,
+		"processedSlot":  progress.ProcessedSlot,
+		"peers":          len(point.peers),
+	}).Info("Resuming initial sync from saved progress")
+	return point, nil
+}
+
+// saveProgress saves the position of the sync once a batch of blocks is processed, together with
+// the peers which served the most recent batches.
+func (s *Service) saveProgress(ctx context.Context, blks []*eth.SignedBeaconBlock, pid peer.ID) {
+	if len(blks) == 0 {
+		return
+	}
+	headRoot, err := s.chain.HeadRoot(ctx)
+	if err != nil {
+		log.WithError(err).Debug("Could not get head root to save initial sync progress")
+		return
+	}
+	progress := &dbpb.InitialSyncProgress{
+		BatchStartSlot: blks[0].Block.Slot,
+		ProcessedSlot:  s.chain.HeadSlot(),
+		ProcessedRoot:  headRoot,
+	}
+	if pid != "" {
+		progress.Peers = append(progress.Peers, pid.String())
+	}
+	for _, p := range s.lastProgressPeers {
+		if len(progress.Peers) == lookaheadSteps {
+			break
+		}
+		if p != pid.String() {
+			progress.Peers = append(progress.Peers, p)
+		}
+	}
+	s.lastProgressPeers = progress.Peers
+	if err := s.db.SaveInitialSyncProgress(ctx, progress); err != nil {
+		log.WithError(err).Debug("Could not save initial sync progress")
+	}
+}
+
+// clearProgress removes the saved position once the node is synced.
+func (s *Service) clearProgress(ctx context.Context) {
+	s.lastProgressPeers = nil
+	if err := s.db.DeleteInitialSyncProgress(ctx); err != nil {
+		log.WithError(err).Debug("Could not remove initial sync progress")
+	}
+}
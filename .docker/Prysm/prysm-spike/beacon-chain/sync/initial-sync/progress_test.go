@@ -0,0 +1,69 @@
+package initialsync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	p2pt "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_saveAndResumeProgress(t *testing.T) {
+	beaconDB, _ := dbtest.SetupDB(t)
+	ctx := context.Background()
+	st := testutil.NewBeaconState()
+	require.NoError(t, st.SetSlot(40))
+	headRoot := bytesutil.PadTo([]byte("head"), 32)
+	s := NewService(ctx, &Config{
+		P2P:           p2pt.NewTestP2P(t),
+		DB:            beaconDB,
+		Chain:         &mock.ChainService{State: st, Root: headRoot},
+		StateNotifier: &mock.MockStateNotifier{},
+	})
+	pid, err := peer.Decode("16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR")
+	require.NoError(t, err)
+
+	point, err := s.loadResumePoint(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, (*resumePoint)(nil), point)
+
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = 33
+	s.saveProgress(ctx, []*eth.SignedBeaconBlock{blk}, pid)
+	progress, err := beaconDB.InitialSyncProgress(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, progress)
+	assert.Equal(t, uint64(33), progress.BatchStartSlot)
+	assert.Equal(t, uint64(40), progress.ProcessedSlot)
+	assert.DeepEqual(t, headRoot, progress.ProcessedRoot)
+	assert.DeepEqual(t, []string{pid.String()}, progress.Peers)
+
+	// The sync resumes right after the head the progress was saved with.
+	point, err = s.loadResumePoint(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, point)
+	assert.Equal(t, uint64(41), point.slot)
+	assert.DeepEqual(t, []peer.ID{pid}, point.peers)
+
+	// Progress saved with another head is stale and removed.
+	s.chain = &mock.ChainService{State: st, Root: bytesutil.PadTo([]byte("other"), 32)}
+	point, err = s.loadResumePoint(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, (*resumePoint)(nil), point)
+	progress, err = beaconDB.InitialSyncProgress(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, progress == nil, "Expected stale progress to be removed")
+
+	s.saveProgress(ctx, []*eth.SignedBeaconBlock{blk}, pid)
+	s.clearProgress(ctx)
+	progress, err = beaconDB.InitialSyncProgress(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, progress == nil, "Expected progress to be removed once synced")
+}
@@ -13,6 +13,7 @@ import (
 	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/sirupsen/logrus"
@@ -48,12 +49,24 @@ func (s *Service) roundRobinSync(genesis time.Time) error {
 	if err != nil {
 		return err
 	}
+	resume, err := s.loadResumePoint(ctx)
+	if err != nil {
+		return err
+	}
+	if resume == nil {
+		resume = &resumePoint{}
+	}
 	queue := newBlocksQueue(ctx, &blocksQueueConfig{
 		p2p:                 s.p2p,
 		db:                  s.db,
 		chain:               s.chain,
+		resumeSlot:          resume.slot,
 		highestExpectedSlot: highestFinalizedSlot,
 		mode:                modeStopOnFinalizedEpoch,
+		preferredPeers:      resume.peers,
+		allowedPeers:        s.allowedPeers,
+		eraStore:            s.eraStore,
+		peerSelection:       flags.Get().SyncPeerSelection,
 	})
 	if err := queue.start(); err != nil {
 		return err
@@ -63,6 +76,12 @@ func (s *Service) roundRobinSync(genesis time.Time) error {
 	for data := range queue.fetchedData {
 		s.processFetchedData(ctx, genesis, s.chain.HeadSlot(), data)
 	}
+	// The queue is drained when the sync is stopped, the progress saved so far is kept for the
+	// sync to resume from on restart.
+	if err := ctx.Err(); err != nil {
+		log.WithField("syncedSlot", s.chain.HeadSlot()).Info("Initial sync stopped, progress saved")
+		return err
+	}
 
 	log.WithFields(logrus.Fields{
 		"syncedSlot": s.chain.HeadSlot(),
@@ -74,6 +93,7 @@ func (s *Service) roundRobinSync(genesis time.Time) error {
 
 	// Already at head, no need for 2nd phase.
 	if s.chain.HeadSlot() == helpers.SlotsSince(genesis) {
+		s.clearProgress(ctx)
 		return nil
 	}
 
@@ -85,6 +105,8 @@ func (s *Service) roundRobinSync(genesis time.Time) error {
 		chain:               s.chain,
 		highestExpectedSlot: helpers.SlotsSince(genesis),
 		mode:                modeNonConstrained,
+		allowedPeers:        s.allowedPeers,
+		peerSelection:       flags.Get().SyncPeerSelection,
 	})
 	if err := queue.start(); err != nil {
 		return err
@@ -92,6 +114,10 @@ func (s *Service) roundRobinSync(genesis time.Time) error {
 	for data := range queue.fetchedData {
 		s.processFetchedDataRegSync(ctx, genesis, s.chain.HeadSlot(), data)
 	}
+	if err := ctx.Err(); err != nil {
+		log.WithField("syncedSlot", s.chain.HeadSlot()).Info("Initial sync stopped, progress saved")
+		return err
+	}
 	log.WithFields(logrus.Fields{
 		"syncedSlot": s.chain.HeadSlot(),
 		"headSlot":   helpers.SlotsSince(genesis),
@@ -99,6 +125,7 @@ func (s *Service) roundRobinSync(genesis time.Time) error {
 	if err := queue.stop(); err != nil {
 		log.WithError(err).Debug("Error stopping queue")
 	}
+	s.clearProgress(ctx)
 
 	return nil
 }
@@ -110,8 +137,10 @@ func (s *Service) processFetchedData(
 
 	// Use Batch Block Verify to process and verify batches directly.
 	if err := s.processBatchedBlocks(ctx, genesis, data.blocks, s.chain.ReceiveBlockBatch); err != nil {
-		log.WithError(err).Warn("Batch is not processed")
+		log.WithError(err).WithField("source", batchSource(data.pid)).Warn("Batch is not processed")
+		return
 	}
+	s.saveProgress(ctx, data.blocks, data.pid)
 }
 
 // processFetchedData processes data received from queue.
@@ -138,15 +167,20 @@ func (s *Service) processFetchedDataRegSync(
 	}
 	// Add more visible logging if all blocks cannot be processed.
 	if len(data.blocks) == invalidBlocks {
-		log.WithField("error", "Range had no valid blocks to process").Warn("Range is not processed")
+		log.WithFields(logrus.Fields{
+			"error":  "Range had no valid blocks to process",
+			"source": batchSource(data.pid),
+		}).Warn("Range is not processed")
+		return
 	}
+	s.saveProgress(ctx, data.blocks, data.pid)
 }
 
 // highestFinalizedEpoch returns the absolute highest finalized epoch of all connected peers.
 // Note this can be lower than our finalized epoch if we have no peers or peers that are all behind us.
 func (s *Service) highestFinalizedEpoch() uint64 {
 	highest := uint64(0)
-	for _, pid := range s.p2p.Peers().Connected() {
+	for _, pid := range s.allowedPeers.connected(s.p2p.Peers()) {
 		peerChainState, err := s.p2p.Peers().ChainState(pid)
 		if err == nil && peerChainState != nil && peerChainState.FinalizedEpoch > highest {
 			highest = peerChainState.FinalizedEpoch
@@ -277,6 +311,14 @@ func (s *Service) updatePeerScorerStats(pid peer.ID, startSlot uint64) {
 	}
 }
 
+// batchSource returns the peer which served a batch, or era for batches read from era files.
+func batchSource(pid peer.ID) string {
+	if pid == "" {
+		return "era"
+	}
+	return pid.String()
+}
+
 // isProcessedBlock checks DB and local cache for presence of a given block, to avoid duplicates.
 func (s *Service) isProcessedBlock(ctx context.Context, blk *eth.SignedBeaconBlock, blkRoot [32]byte) bool {
 	finalizedSlot, err := helpers.StartSlot(s.chain.FinalizedCheckpt().Epoch)
@@ -5,8 +5,10 @@ package initialsync
 
 import (
 	"context"
+	"sync"
 	"time"
 
+	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/paulbellamy/ratecounter"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
@@ -15,6 +17,7 @@ import (
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/era"
 	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/shared"
@@ -36,10 +39,14 @@ type blockchainService interface {
 // Config to set up the initial sync service.
 type Config struct {
 	P2P           p2p.P2P
-	DB            db.ReadOnlyDatabase
+	DB            db.NoHeadAccessDatabase
 	Chain         blockchainService
 	StateNotifier statefeed.Notifier
 	BlockNotifier blockfeed.Notifier
+	// EraStore serves finalized blocks from era files, when set.
+	EraStore *era.Store
+	// AllowedPeers restricts the peers blocks are requested from, all peers are used when empty.
+	AllowedPeers []peer.ID
 }
 
 // Service service.
@@ -48,12 +55,17 @@ type Service struct {
 	cancel        context.CancelFunc
 	chain         blockchainService
 	p2p           p2p.P2P
-	db            db.ReadOnlyDatabase
+	db            db.NoHeadAccessDatabase
 	synced        *abool.AtomicBool
 	chainStarted  *abool.AtomicBool
 	stateNotifier statefeed.Notifier
+	eraStore      *era.Store
+	allowedPeers  allowedPeers
 	counter       *ratecounter.RateCounter
 	genesisChan   chan time.Time
+	catchUpLock   sync.Mutex
+	// lastProgressPeers are the peers saved with the latest initial sync progress.
+	lastProgressPeers []string
 }
 
 // NewService configures the initial sync service responsible for bringing the node up to the
@@ -69,6 +81,8 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 		synced:        abool.New(),
 		chainStarted:  abool.New(),
 		stateNotifier: cfg.StateNotifier,
+		eraStore:      cfg.EraStore,
+		allowedPeers:  newAllowedPeers(cfg.AllowedPeers),
 		counter:       ratecounter.NewRateCounter(counterSeconds * time.Second),
 		genesisChan:   make(chan time.Time),
 	}
@@ -92,12 +106,14 @@ func (s *Service) Start() {
 	if genesis.After(timeutils.Now()) {
 		s.markSynced(genesis)
 		log.WithField("genesisTime", genesis).Info("Genesis time has not arrived - not syncing")
+		s.monitorCatchUp(genesis)
 		return
 	}
 	currentSlot := helpers.SlotsSince(genesis)
 	if helpers.SlotToEpoch(currentSlot) == 0 {
 		log.WithField("genesisTime", genesis).Info("Chain started within the last epoch - not syncing")
 		s.markSynced(genesis)
+		s.monitorCatchUp(genesis)
 		return
 	}
 	s.chainStarted.Set()
@@ -106,6 +122,7 @@ func (s *Service) Start() {
 	if helpers.SlotToEpoch(s.chain.HeadSlot()) == helpers.SlotToEpoch(currentSlot) {
 		log.Info("Already synced to the current chain head")
 		s.markSynced(genesis)
+		s.monitorCatchUp(genesis)
 		return
 	}
 	s.waitForMinimumPeers()
@@ -117,6 +134,7 @@ func (s *Service) Start() {
 	}
 	log.Infof("Synced up to slot %d", s.chain.HeadSlot())
 	s.markSynced(genesis)
+	s.monitorCatchUp(genesis)
 }
 
 // Stop initial sync.
@@ -146,6 +164,8 @@ func (s *Service) Resync() error {
 		return errors.Errorf("could not retrieve head state: %v", err)
 	}
 
+	s.catchUpLock.Lock()
+	defer s.catchUpLock.Unlock()
 	// Set it to false since we are syncing again.
 	s.synced.UnSet()
 	defer func() { s.synced.Set() }() // Reset it at the end of the method.
@@ -165,7 +185,7 @@ func (s *Service) waitForMinimumPeers() {
 		required = flags.Get().MinimumSyncPeers
 	}
 	for {
-		_, peers := s.p2p.Peers().BestNonFinalized(flags.Get().MinimumSyncPeers, s.chain.FinalizedCheckpt().Epoch)
+		_, peers := s.allowedPeers.bestNonFinalized(s.p2p.Peers(), flags.Get().MinimumSyncPeers, s.chain.FinalizedCheckpt().Epoch)
 		if len(peers) >= required {
 			break
 		}
@@ -41,12 +41,58 @@ var (
 		},
 		[]string{"topic"},
 	)
+	peerStatusDisconnectCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "p2p_peer_status_disconnects_total",
+			Help: "Count of peers disconnected because their status was incompatible or could not be refreshed.",
+		},
+		[]string{"reason"},
+	)
 	numberOfTimesResyncedCounter = promauto.NewCounter(
 		prometheus.CounterOpts{
 			Name: "number_of_times_resynced",
 			Help: "Count the number of times a node resyncs.",
 		},
 	)
+	validationQueueDepth = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "p2p_attestation_validation_queue_depth",
+			Help: "The number of gossiped attestations waiting for a validation worker.",
+		},
+	)
+	validationQueueTimeoutCounter = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "p2p_attestation_validation_timeout_total",
+			Help: "Count of gossiped attestations ignored because they timed out waiting for validation.",
+		},
+	)
+	lateSubnetSubscriptionCounter = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "p2p_attestation_subnet_late_subscriptions_total",
+			Help: "Count of attestation subnets subscribed to less than the configured lookahead slots before an aggregation duty.",
+		},
+	)
+	seenCacheHits = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "p2p_seen_cache_hits_total",
+			Help: "Count of lookups of keys found in a seen cache.",
+		},
+		[]string{"cache"},
+	)
+	seenCacheMisses = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "p2p_seen_cache_misses_total",
+			Help: "Count of lookups of keys not found in a seen cache.",
+		},
+		[]string{"cache"},
+	)
+	seenCacheEvictions = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "p2p_seen_cache_evictions_total",
+			Help: "Count of keys evicted from a seen cache, either expired or to bound its size.",
+		},
+		[]string{"cache", "reason"},
+	)
 	arrivalBlockPropagationHistogram = promauto.NewHistogram(
 		prometheus.HistogramOpts{
 			Name:    "block_arrival_latency_milliseconds",
@@ -82,10 +82,10 @@ func (s *Service) processPendingBlocks(ctx context.Context) error {
 			}
 
 			s.pendingQueueLock.RLock()
-			inPendingQueue := s.seenPendingBlocks[bytesutil.ToBytes32(b.Block.ParentRoot)]
+			inPendingQueue := s.seenPendingBlocks.Has(string(b.Block.ParentRoot))
 			s.pendingQueueLock.RUnlock()
 
-			blkRoot, err := b.Block.HashTreeRoot()
+			blkRoot, err := s.htrCache.HashTreeRoot(b.Block)
 			if err != nil {
 				traceutil.AnnotateError(span, err)
 				span.End()
@@ -204,7 +204,7 @@ func (s *Service) sendBatchRootRequest(ctx context.Context, roots [][32]byte, ra
 		newRoots := make([][32]byte, 0, len(roots))
 		s.pendingQueueLock.RLock()
 		for _, rt := range roots {
-			if !s.seenPendingBlocks[rt] {
+			if !s.seenPendingBlocks.Has(string(rt[:])) {
 				newRoots = append(newRoots, rt)
 			}
 		}
@@ -257,7 +257,7 @@ func (s *Service) validatePendingSlots() error {
 			epoch := helpers.SlotToEpoch(slot)
 			// remove all descendant blocks of old blocks
 			if oldBlockRoots[bytesutil.ToBytes32(b.Block.ParentRoot)] {
-				root, err := b.Block.HashTreeRoot()
+				root, err := s.htrCache.HashTreeRoot(b.Block)
 				if err != nil {
 					return err
 				}
@@ -269,7 +269,7 @@ func (s *Service) validatePendingSlots() error {
 			}
 			// don't process old blocks
 			if finalizedEpoch > 0 && epoch <= finalizedEpoch {
-				blkRoot, err := b.Block.HashTreeRoot()
+				blkRoot, err := s.htrCache.HashTreeRoot(b.Block)
 				if err != nil {
 					return err
 				}
@@ -287,7 +287,7 @@ func (s *Service) clearPendingSlots() {
 	s.pendingQueueLock.Lock()
 	defer s.pendingQueueLock.Unlock()
 	s.slotToPendingBlocks.Flush()
-	s.seenPendingBlocks = make(map[[32]byte]bool)
+	s.seenPendingBlocks.Purge()
 }
 
 // Delete block from the list from the pending queue using the slot as key.
@@ -317,7 +317,7 @@ func (s *Service) deleteBlockFromPendingQueue(slot uint64, b *ethpb.SignedBeacon
 	if err := s.slotToPendingBlocks.Replace(slotToCacheKey(slot), newBlks, d); err != nil {
 		return err
 	}
-	delete(s.seenPendingBlocks, r)
+	s.seenPendingBlocks.Remove(string(r[:]))
 	return nil
 }
 
@@ -326,7 +326,7 @@ func (s *Service) deleteBlockFromPendingQueue(slot uint64, b *ethpb.SignedBeacon
 func (s *Service) insertBlockToPendingQueue(slot uint64, b *ethpb.SignedBeaconBlock, r [32]byte) error {
 	mutexasserts.AssertRWMutexLocked(&s.pendingQueueLock)
 
-	if s.seenPendingBlocks[r] {
+	if s.seenPendingBlocks.Has(string(r[:])) {
 		return nil
 	}
 
@@ -334,7 +334,7 @@ func (s *Service) insertBlockToPendingQueue(slot uint64, b *ethpb.SignedBeaconBl
 		return err
 	}
 
-	s.seenPendingBlocks[r] = true
+	s.seenPendingBlocks.Add(string(r[:]))
 	return nil
 }
 
@@ -20,6 +20,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/rand"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
@@ -43,7 +44,7 @@ func TestRegularSyncBeaconBlockSubscriber_ProcessPendingBlocks1(t *testing.T) {
 			},
 		},
 		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
-		seenPendingBlocks:   make(map[[32]byte]bool),
+		seenPendingBlocks:   newSeenCache("pending_block", params.BeaconConfig().SlotsPerEpoch, seenPendingBlockSize),
 		stateSummaryCache:   stateSummaryCache,
 		stateGen:            stategen.New(db, stateSummaryCache),
 	}
@@ -75,7 +76,7 @@ func TestRegularSyncBeaconBlockSubscriber_ProcessPendingBlocks1(t *testing.T) {
 
 	require.NoError(t, r.processPendingBlocks(context.Background()))
 	assert.Equal(t, 1, len(r.slotToPendingBlocks.Items()), "Incorrect size for slot to pending blocks cache")
-	assert.Equal(t, 1, len(r.seenPendingBlocks), "Incorrect size for seen pending block")
+	assert.Equal(t, 1, r.seenPendingBlocks.Len(), "Incorrect size for seen pending block")
 
 	// Add b1 to the cache
 	require.NoError(t, r.insertBlockToPendingQueue(b1.Block.Slot, b1, b1Root))
@@ -88,7 +89,7 @@ func TestRegularSyncBeaconBlockSubscriber_ProcessPendingBlocks1(t *testing.T) {
 	require.NoError(t, r.processPendingBlocks(context.Background())) // Bad block removed on second run
 
 	assert.Equal(t, 1, len(r.slotToPendingBlocks.Items()), "Incorrect size for slot to pending blocks cache")
-	assert.Equal(t, 2, len(r.seenPendingBlocks), "Incorrect size for seen pending block")
+	assert.Equal(t, 2, r.seenPendingBlocks.Len(), "Incorrect size for seen pending block")
 }
 
 func TestRegularSync_InsertDuplicateBlocks(t *testing.T) {
@@ -105,7 +106,7 @@ func TestRegularSync_InsertDuplicateBlocks(t *testing.T) {
 			},
 		},
 		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
-		seenPendingBlocks:   make(map[[32]byte]bool),
+		seenPendingBlocks:   newSeenCache("pending_block", params.BeaconConfig().SlotsPerEpoch, seenPendingBlockSize),
 	}
 	err := r.initCaches()
 	require.NoError(t, err)
@@ -172,7 +173,7 @@ func TestRegularSyncBeaconBlockSubscriber_ProcessPendingBlocks_2Chains(t *testin
 			},
 		},
 		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
-		seenPendingBlocks:   make(map[[32]byte]bool),
+		seenPendingBlocks:   newSeenCache("pending_block", params.BeaconConfig().SlotsPerEpoch, seenPendingBlockSize),
 		stateSummaryCache:   stateSummaryCache,
 		stateGen:            stategen.New(db, stateSummaryCache),
 	}
@@ -222,7 +223,7 @@ func TestRegularSyncBeaconBlockSubscriber_ProcessPendingBlocks_2Chains(t *testin
 	require.NoError(t, r.processPendingBlocks(context.Background())) // Bad block removed on second run
 
 	assert.Equal(t, 2, len(r.slotToPendingBlocks.Items()), "Incorrect size for slot to pending blocks cache")
-	assert.Equal(t, 2, len(r.seenPendingBlocks), "Incorrect size for seen pending block")
+	assert.Equal(t, 2, r.seenPendingBlocks.Len(), "Incorrect size for seen pending block")
 
 	// Add b3 to the cache
 	require.NoError(t, r.insertBlockToPendingQueue(b3.Block.Slot, b3, b3Root))
@@ -232,7 +233,7 @@ func TestRegularSyncBeaconBlockSubscriber_ProcessPendingBlocks_2Chains(t *testin
 	require.NoError(t, r.processPendingBlocks(context.Background())) // Bad block removed on second run
 
 	assert.Equal(t, 1, len(r.slotToPendingBlocks.Items()), "Incorrect size for slot to pending blocks cache")
-	assert.Equal(t, 3, len(r.seenPendingBlocks), "Incorrect size for seen pending block")
+	assert.Equal(t, 3, r.seenPendingBlocks.Len(), "Incorrect size for seen pending block")
 
 	// Add b2 to the cache
 	require.NoError(t, r.insertBlockToPendingQueue(b2.Block.Slot, b2, b2Root))
@@ -243,7 +244,7 @@ func TestRegularSyncBeaconBlockSubscriber_ProcessPendingBlocks_2Chains(t *testin
 	require.NoError(t, r.processPendingBlocks(context.Background())) // Bad block removed on second run
 
 	assert.Equal(t, 0, len(r.slotToPendingBlocks.Items()), "Incorrect size for slot to pending blocks cache")
-	assert.Equal(t, 4, len(r.seenPendingBlocks), "Incorrect size for seen pending block")
+	assert.Equal(t, 4, r.seenPendingBlocks.Len(), "Incorrect size for seen pending block")
 }
 
 func TestRegularSyncBeaconBlockSubscriber_PruneOldPendingBlocks(t *testing.T) {
@@ -263,7 +264,7 @@ func TestRegularSyncBeaconBlockSubscriber_PruneOldPendingBlocks(t *testing.T) {
 			},
 		},
 		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
-		seenPendingBlocks:   make(map[[32]byte]bool),
+		seenPendingBlocks:   newSeenCache("pending_block", params.BeaconConfig().SlotsPerEpoch, seenPendingBlockSize),
 	}
 	err := r.initCaches()
 	require.NoError(t, err)
@@ -311,13 +312,13 @@ func TestRegularSyncBeaconBlockSubscriber_PruneOldPendingBlocks(t *testing.T) {
 
 	require.NoError(t, r.processPendingBlocks(context.Background()))
 	assert.Equal(t, 0, len(r.slotToPendingBlocks.Items()), "Incorrect size for slot to pending blocks cache")
-	assert.Equal(t, 4, len(r.seenPendingBlocks), "Incorrect size for seen pending block")
+	assert.Equal(t, 4, r.seenPendingBlocks.Len(), "Incorrect size for seen pending block")
 }
 
 func TestService_sortedPendingSlots(t *testing.T) {
 	r := &Service{
 		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
-		seenPendingBlocks:   make(map[[32]byte]bool),
+		seenPendingBlocks:   newSeenCache("pending_block", params.BeaconConfig().SlotsPerEpoch, seenPendingBlockSize),
 	}
 
 	var lastSlot uint64 = math.MaxUint64
@@ -347,7 +348,7 @@ func TestService_BatchRootRequest(t *testing.T) {
 			},
 		},
 		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
-		seenPendingBlocks:   make(map[[32]byte]bool),
+		seenPendingBlocks:   newSeenCache("pending_block", params.BeaconConfig().SlotsPerEpoch, seenPendingBlockSize),
 	}
 
 	err := r.initCaches()
@@ -416,13 +417,13 @@ func TestService_BatchRootRequest(t *testing.T) {
 		t.Fatal("Did not receive stream within 1 sec")
 	}
 	assert.Equal(t, 4, len(r.slotToPendingBlocks.Items()), "Incorrect size for slot to pending blocks cache")
-	assert.Equal(t, 4, len(r.seenPendingBlocks), "Incorrect size for seen pending block")
+	assert.Equal(t, 4, r.seenPendingBlocks.Len(), "Incorrect size for seen pending block")
 }
 
 func TestService_AddPeningBlockToQueueOverMax(t *testing.T) {
 	r := &Service{
 		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
-		seenPendingBlocks:   make(map[[32]byte]bool),
+		seenPendingBlocks:   newSeenCache("pending_block", params.BeaconConfig().SlotsPerEpoch, seenPendingBlockSize),
 	}
 
 	b := testutil.NewBeaconBlock()
@@ -10,6 +10,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	p2ptypes "github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/sirupsen/logrus"
 	"github.com/trailofbits/go-mutexasserts"
 )
@@ -53,6 +54,10 @@ func newRateLimiter(p2pProvider p2p.P2P) *limiter {
 	// BlockByRange requests
 	topicMap[addEncoding(p2p.RPCBlocksByRangeTopic)] = blockCollector
 
+	// BeaconStateByRoot requests, allowing a state per slot as each is a large transfer.
+	stateRequestsPerSecond := 1 / float64(params.BeaconConfig().SecondsPerSlot)
+	topicMap[addEncoding(p2p.RPCBeaconStateByRootTopic)] = leakybucket.NewCollector(stateRequestsPerSecond, 1, false /* deleteEmptyBuckets */)
+
 	return &limiter{limiterMap: topicMap, p2p: p2pProvider}
 }
 
@@ -54,6 +54,10 @@ func (s *Service) registerRPCHandlers() {
 		p2p.RPCMetaDataTopic,
 		s.metaDataHandler,
 	)
+	s.registerRPC(
+		p2p.RPCBeaconStateByRootTopic,
+		s.beaconStateByRootRPCHandler,
+	)
 }
 
 // registerRPC for a given topic with an expected protobuf message type.
@@ -18,7 +18,7 @@ func (s *Service) sendRecentBeaconBlocksRequest(ctx context.Context, blockRoots
 	defer cancel()
 
 	_, err := SendBeaconBlocksByRootRequest(ctx, s.p2p, id, blockRoots, func(blk *ethpb.SignedBeaconBlock) error {
-		blkRoot, err := blk.Block.HashTreeRoot()
+		blkRoot, err := s.htrCache.HashTreeRoot(blk.Block)
 		if err != nil {
 			return err
 		}
@@ -107,7 +107,7 @@ func TestRecentBeaconBlocks_RPCRequestSent(t *testing.T) {
 			Root:                blockARoot[:],
 		},
 		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
-		seenPendingBlocks:   make(map[[32]byte]bool),
+		seenPendingBlocks:   newSeenCache("pending_block", params.BeaconConfig().SlotsPerEpoch, seenPendingBlockSize),
 		ctx:                 context.Background(),
 		rateLimiter:         newRateLimiter(p1),
 	}
@@ -0,0 +1,79 @@
+package sync
+
+import (
+	"context"
+
+	libp2pcore "github.com/libp2p/go-libp2p-core"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// beaconStateByRootRPCHandler sends the SSZ encoded post state of the requested block, split in chunks of at
+// most the max chunk size. Only the state of the latest finalized checkpoint is served, which is kept in the
+// state cache and needs no regeneration.
+func (s *Service) beaconStateByRootRPCHandler(ctx context.Context, msg interface{}, stream libp2pcore.Stream) error {
+	defer func() {
+		if err := stream.Close(); err != nil {
+			log.WithError(err).Debug("Could not close stream")
+		}
+	}()
+	ctx, cancel := context.WithTimeout(ctx, respTimeout)
+	defer cancel()
+	SetRPCStreamDeadlines(stream)
+	log := log.WithField("handler", "beacon_state_by_root")
+
+	rawMsg, ok := msg.(*types.BeaconStateByRootReq)
+	if !ok {
+		return errors.New("message is not type BeaconStateByRootReq")
+	}
+	if err := s.rateLimiter.validateRequest(stream, 1); err != nil {
+		return err
+	}
+	s.rateLimiter.add(stream, 1)
+
+	root := [32]byte(*rawMsg)
+	finalized := s.chain.FinalizedCheckpt()
+	if finalized == nil || root == params.BeaconConfig().ZeroHash || root != bytesutil.ToBytes32(finalized.Root) {
+		resp, err := s.generateErrorResponse(responseCodeInvalidRequest, "requested state is not of the finalized checkpoint")
+		if err != nil {
+			log.WithError(err).Debug("Could not generate a response error")
+		} else if _, err := stream.Write(resp); err != nil {
+			log.WithError(err).Debugf("Could not write to stream")
+		}
+		return errors.New("requested state is not of the finalized checkpoint")
+	}
+
+	st, err := s.stateGen.StateByRoot(ctx, root)
+	if err == nil && st == nil {
+		err = errors.New("finalized state not found")
+	}
+	var enc []byte
+	if err == nil {
+		enc, err = st.CloneInnerState().MarshalSSZ()
+	}
+	if err != nil {
+		log.WithError(err).Debug("Could not fetch finalized state")
+		resp, err := s.generateErrorResponse(responseCodeServerError, types.ErrGeneric.Error())
+		if err != nil {
+			log.WithError(err).Debug("Could not generate a response error")
+		} else if _, err := stream.Write(resp); err != nil {
+			log.WithError(err).Debugf("Could not write to stream")
+		}
+		return err
+	}
+
+	chunkSize := int(params.BeaconNetworkConfig().MaxChunkSize)
+	for start := 0; start < len(enc); start += chunkSize {
+		end := start + chunkSize
+		if end > len(enc) {
+			end = len(enc)
+		}
+		chunk := types.BeaconStateChunk(enc[start:end])
+		if err := s.chunkWriter(stream, &chunk); err != nil {
+			return err
+		}
+	}
+	return nil
+}
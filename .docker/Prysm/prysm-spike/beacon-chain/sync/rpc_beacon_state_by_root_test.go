@@ -0,0 +1,123 @@
+package sync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	p2pTypes "github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestBeaconStateByRootRPCHandler_ServesFinalizedState(t *testing.T) {
+	ctx := context.Background()
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	d, _ := dbtest.SetupDB(t)
+
+	// The state is larger than a chunk, so it is sent in several of them.
+	st, _ := testutil.DeterministicGenesisState(t, 64)
+	blk := testutil.NewBeaconBlock()
+	bodyRoot, err := blk.Block.Body.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, st.SetLatestBlockHeader(&ethpb.BeaconBlockHeader{
+		ParentRoot: blk.Block.ParentRoot,
+		StateRoot:  params.BeaconConfig().ZeroHash[:],
+		BodyRoot:   bodyRoot[:],
+	}))
+	stateRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	blk.Block.StateRoot = stateRoot[:]
+	blkRoot, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, d.SaveBlock(ctx, blk))
+	require.NoError(t, d.SaveStateSummary(ctx, &pb.StateSummary{Root: blkRoot[:]}))
+	require.NoError(t, d.SaveState(ctx, st, blkRoot))
+
+	r := &Service{
+		p2p:         p2,
+		db:          d,
+		chain:       &mock.ChainService{FinalizedCheckPoint: &ethpb.Checkpoint{Root: blkRoot[:]}},
+		stateGen:    stategen.New(d, cache.NewStateSummaryCache()),
+		rateLimiter: newRateLimiter(p2),
+	}
+	topic := p2p.RPCBeaconStateByRootTopic + p2.Encoding().ProtocolSuffix()
+	p2.SetStreamHandler(topic, func(stream network.Stream) {
+		req := new(p2pTypes.BeaconStateByRootReq)
+		assert.NoError(t, p2.Encoding().DecodeWithMaxLength(stream, req))
+		assert.NoError(t, r.beaconStateByRootRPCHandler(ctx, req, stream))
+	})
+
+	received, err := SendBeaconStateByRootRequest(ctx, p1, p2.PeerID(), blkRoot)
+	require.NoError(t, err)
+	receivedRoot, err := received.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, stateRoot, receivedRoot)
+}
+
+func TestBeaconStateByRootRPCHandler_RejectsNonFinalizedRoot(t *testing.T) {
+	ctx := context.Background()
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	d, _ := dbtest.SetupDB(t)
+
+	r := &Service{
+		p2p:         p2,
+		db:          d,
+		chain:       &mock.ChainService{FinalizedCheckPoint: &ethpb.Checkpoint{Root: []byte{'a'}}},
+		stateGen:    stategen.New(d, cache.NewStateSummaryCache()),
+		rateLimiter: newRateLimiter(p2),
+	}
+	topic := p2p.RPCBeaconStateByRootTopic + p2.Encoding().ProtocolSuffix()
+	p2.SetStreamHandler(topic, func(stream network.Stream) {
+		req := new(p2pTypes.BeaconStateByRootReq)
+		assert.NoError(t, p2.Encoding().DecodeWithMaxLength(stream, req))
+		assert.ErrorContains(t, "not of the finalized checkpoint", r.beaconStateByRootRPCHandler(ctx, req, stream))
+	})
+
+	_, err := SendBeaconStateByRootRequest(ctx, p1, p2.PeerID(), [32]byte{'b'})
+	assert.ErrorContains(t, "not of the finalized checkpoint", err)
+}
+
+func TestVerifyStateOfBlock(t *testing.T) {
+	ctx := context.Background()
+	st, _ := testutil.DeterministicGenesisState(t, 16)
+	require.NoError(t, st.SetLatestBlockHeader(&ethpb.BeaconBlockHeader{
+		ParentRoot: params.BeaconConfig().ZeroHash[:],
+		StateRoot:  params.BeaconConfig().ZeroHash[:],
+		BodyRoot:   bytesutil.PadTo([]byte{'b'}, 32),
+	}))
+	stateRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	blkRoot, err := (&ethpb.BeaconBlockHeader{
+		ParentRoot: params.BeaconConfig().ZeroHash[:],
+		StateRoot:  stateRoot[:],
+		BodyRoot:   bytesutil.PadTo([]byte{'b'}, 32),
+	}).HashTreeRoot()
+	require.NoError(t, err)
+
+	assert.NoError(t, verifyStateOfBlock(ctx, st, blkRoot))
+	assert.ErrorContains(t, ErrInvalidFetchedData.Error(), verifyStateOfBlock(ctx, st, [32]byte{'o'}))
+
+	// A state advanced past the slot of the block no longer proves itself.
+	require.NoError(t, st.SetLatestBlockHeader(&ethpb.BeaconBlockHeader{
+		ParentRoot: params.BeaconConfig().ZeroHash[:],
+		StateRoot:  stateRoot[:],
+		BodyRoot:   bytesutil.PadTo([]byte{'b'}, 32),
+	}))
+	assert.ErrorContains(t, ErrInvalidFetchedData.Error(), verifyStateOfBlock(ctx, st, blkRoot))
+}
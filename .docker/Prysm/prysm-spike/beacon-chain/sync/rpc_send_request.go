@@ -11,7 +11,9 @@ import (
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 )
 
@@ -122,3 +124,89 @@ func SendBeaconBlocksByRootRequest(
 	}
 	return blocks, nil
 }
+
+// maxBeaconStateChunks bounds the number of chunks read for a beacon state, 1 GiB with the default chunk size.
+const maxBeaconStateChunks = 1024
+
+// SendBeaconStateByRootRequest sends BeaconStateByRoot for the root of a finalized block and returns the post
+// state of the block. The state is only returned when its latest block header, completed with the root of the
+// state, hashes to the block root, so the peer need not be trusted beyond the root.
+func SendBeaconStateByRootRequest(
+	ctx context.Context, p2pProvider p2p.P2P, pid peer.ID, blockRoot [32]byte,
+) (*stateTrie.BeaconState, error) {
+	req := types.BeaconStateByRootReq(blockRoot)
+	stream, err := p2pProvider.Send(ctx, &req, p2p.RPCBeaconStateByRootTopic, pid)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := streamhelpers.FullClose(stream); err != nil && err.Error() != mux.ErrReset.Error() {
+			log.WithError(err).Debugf("Could not close stream with protocol %s", stream.Protocol())
+		}
+	}()
+
+	var enc []byte
+	for i := 0; ; i++ {
+		chunk := types.BeaconStateChunk{}
+		if i == 0 {
+			code, errMsg, err := ReadStatusCode(stream, p2pProvider.Encoding())
+			if err != nil {
+				return nil, err
+			}
+			if code != 0 {
+				return nil, errors.New(errMsg)
+			}
+			err = p2pProvider.Encoding().DecodeWithMaxLength(stream, &chunk)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			err := readResponseChunk(stream, p2pProvider, &chunk)
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+		if i >= maxBeaconStateChunks {
+			return nil, ErrInvalidFetchedData
+		}
+		enc = append(enc, chunk...)
+	}
+
+	pbState := &pb.BeaconState{}
+	if err := pbState.UnmarshalSSZ(enc); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal beacon state")
+	}
+	st, err := stateTrie.InitializeFromProtoUnsafe(pbState)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyStateOfBlock(ctx, st, blockRoot); err != nil {
+		return nil, err
+	}
+	return st, nil
+}
+
+// verifyStateOfBlock checks the state is the post state of the block with the root, before any slot was processed
+// after the block. The state root of the latest block header is only filled in by the next slot processed.
+func verifyStateOfBlock(ctx context.Context, st *stateTrie.BeaconState, blockRoot [32]byte) error {
+	header := st.LatestBlockHeader()
+	if header == nil || bytesutil.ToBytes32(header.StateRoot) != params.BeaconConfig().ZeroHash {
+		return ErrInvalidFetchedData
+	}
+	stateRoot, err := st.HashTreeRoot(ctx)
+	if err != nil {
+		return err
+	}
+	header.StateRoot = stateRoot[:]
+	headerRoot, err := header.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	if headerRoot != blockRoot {
+		return ErrInvalidFetchedData
+	}
+	return nil
+}
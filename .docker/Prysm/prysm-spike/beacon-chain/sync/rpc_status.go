@@ -25,10 +25,10 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-// maintainPeerStatuses by infrequently polling peers for their latest status.
+// maintainPeerStatuses by infrequently polling peers for their latest status. Peers which report
+// an incompatible chain, or which do not answer status requests for too long, are disconnected.
 func (s *Service) maintainPeerStatuses() {
-	// Run twice per epoch.
-	interval := time.Duration(params.BeaconConfig().SecondsPerSlot*params.BeaconConfig().SlotsPerEpoch/2) * time.Second
+	interval, staleAfter := peerStatusIntervals()
 	runutil.RunEvery(s.ctx, interval, func() {
 		for _, pid := range s.p2p.Peers().Connected() {
 			go func(id peer.ID) {
@@ -54,9 +54,20 @@ func (s *Service) maintainPeerStatuses() {
 					return
 				}
 				if timeutils.Now().After(lastUpdated.Add(interval)) {
-					if err := s.reValidatePeer(s.ctx, id); err != nil {
-						log.WithField("peer", id).WithError(err).Debug("Could not revalidate peer")
-						s.p2p.Peers().Scorers().BadResponsesScorer().Increment(id)
+					err := s.reValidatePeer(s.ctx, id)
+					if err == nil {
+						return
+					}
+					log.WithField("peer", id).WithError(err).Debug("Could not revalidate peer")
+					s.p2p.Peers().Scorers().BadResponsesScorer().Increment(id)
+					switch {
+					case errors.Is(err, p2ptypes.ErrWrongForkDigestVersion):
+						// Already disconnected as a bad peer by the status request.
+					case errors.Is(err, p2ptypes.ErrInvalidFinalizedRoot):
+						// The peer finalized a different chain, such as one of a devnet restarted with a new genesis.
+						s.disconnectStatusPeer(id, p2ptypes.GoodbyeCodeWrongNetwork, "incompatible")
+					case staleAfter > 0 && timeutils.Now().After(lastUpdated.Add(staleAfter)):
+						s.disconnectStatusPeer(id, p2ptypes.GoodbyeCodeGenericError, "stale")
 					}
 				}
 			}(pid)
@@ -64,6 +75,30 @@ func (s *Service) maintainPeerStatuses() {
 	})
 }
 
+// peerStatusIntervals returns how old the status of a peer may get before it is requested again,
+// and how long a peer may fail to refresh its status before it is disconnected.
+func peerStatusIntervals() (time.Duration, time.Duration) {
+	slotDuration := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	refreshSlots := flags.Get().PeerStatusRefreshSlots
+	if refreshSlots == 0 {
+		// Run twice per epoch.
+		refreshSlots = params.BeaconConfig().SlotsPerEpoch / 2
+	}
+	return time.Duration(refreshSlots) * slotDuration, time.Duration(flags.Get().PeerStatusStaleSlots) * slotDuration
+}
+
+// disconnectStatusPeer says goodbye to a peer whose status could not be refreshed and disconnects it.
+func (s *Service) disconnectStatusPeer(id peer.ID, code p2ptypes.RPCGoodbyeCode, reason string) {
+	log.WithFields(logrus.Fields{
+		"peer":   id,
+		"reason": reason,
+	}).Debug("Disconnecting peer after failed status refresh")
+	peerStatusDisconnectCounter.WithLabelValues(reason).Inc()
+	if err := s.sendGoodByeAndDisconnect(s.ctx, code, id); err != nil {
+		log.WithError(err).Debug("Could not disconnect peer")
+	}
+}
+
 // resyncIfBehind checks periodically to see if we are in normal sync but have fallen behind our peers
 // by more than an epoch, in which case we attempt a resync using the initial sync method to catch up.
 func (s *Service) resyncIfBehind() {
@@ -16,6 +16,7 @@ import (
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	testingDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
 	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
 	p2ptypes "github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
@@ -909,3 +910,19 @@ func makeBlocks(t *testing.T, i, n uint64, previousRoot [32]byte) []*ethpb.Signe
 	}
 	return blocks
 }
+
+func TestPeerStatusIntervals(t *testing.T) {
+	resetFlags := flags.Get()
+	defer flags.Init(resetFlags)
+	slot := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+
+	flags.Init(&flags.GlobalFlags{})
+	refresh, stale := peerStatusIntervals()
+	assert.Equal(t, time.Duration(params.BeaconConfig().SlotsPerEpoch/2)*slot, refresh, "Unexpected default refresh interval")
+	assert.Equal(t, time.Duration(0), stale, "Stale peers should not be disconnected when unset")
+
+	flags.Init(&flags.GlobalFlags{PeerStatusRefreshSlots: 4, PeerStatusStaleSlots: 32})
+	refresh, stale = peerStatusIntervals()
+	assert.Equal(t, 4*slot, refresh)
+	assert.Equal(t, 32*slot, stale)
+}
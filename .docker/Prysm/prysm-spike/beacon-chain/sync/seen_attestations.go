@@ -0,0 +1,123 @@
+package sync
+
+import (
+	"math"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+// seenAttestationDigestSize is the size of the keys of the seen attestation cache. Keys are
+// digests of the fields identifying attestations, compact enough to be saved to the database.
+const seenAttestationDigestSize = 16
+
+// seenAttestationKey returns the key of the seen attestation cache for the identifying fields.
+func seenAttestationKey(fields ...[]byte) string {
+	var b []byte
+	for _, f := range fields {
+		b = append(b, f...)
+	}
+	h := hashutil.Hash(b)
+	return string(h[:seenAttestationDigestSize])
+}
+
+// addSeenAttestation marks the key as seen in the cache, and queues it to be saved to the database
+// with the epoch it is relevant in.
+func (s *Service) addSeenAttestation(epoch uint64, key string) {
+	s.seenAttestationCache.Add(key, true)
+	s.unsavedSeenAttsLock.Lock()
+	defer s.unsavedSeenAttsLock.Unlock()
+	if s.unsavedSeenAtts == nil {
+		s.unsavedSeenAtts = make(map[uint64][][]byte)
+	}
+	s.unsavedSeenAtts[epoch] = append(s.unsavedSeenAtts[epoch], []byte(key))
+}
+
+// loadSeenAttestations fills the seen attestation cache with the attestations saved to the database
+// during the previous and current epochs, the ones still propagated on the wire, so a restarted
+// node keeps ignoring their duplicates.
+func (s *Service) loadSeenAttestations() {
+	if s.db == nil {
+		return
+	}
+	start := time.Now()
+	epoch := helpers.SlotToEpoch(s.chain.CurrentSlot())
+	if epoch > 0 {
+		epoch--
+	}
+	digests, err := s.db.SeenAttestations(s.ctx, epoch)
+	if err != nil {
+		log.WithError(err).Warn("Could not load seen attestations")
+		return
+	}
+	s.seenAttestationLock.Lock()
+	defer s.seenAttestationLock.Unlock()
+	for _, d := range digests {
+		s.seenAttestationCache.Add(string(d), true)
+	}
+	log.WithFields(logrus.Fields{
+		"count":    len(digests),
+		"duration": time.Since(start),
+	}).Debug("Loaded seen attestations")
+}
+
+// seenAttestationsRetainedEpochs is the number of epochs of seen attestations kept in the database,
+// the previous and current epochs loaded by loadSeenAttestations.
+const seenAttestationsRetainedEpochs = 2
+
+// saveSeenAttestations saves the attestations seen since the last call to the database, and deletes
+// the ones of epochs before the finalized checkpoint or the retained epochs, whichever is later, so
+// the saved attestations stay bounded while the chain does not finalize.
+func (s *Service) saveSeenAttestations() {
+	if s.db == nil {
+		return
+	}
+	s.seenAttsSaveLock.Lock()
+	defer s.seenAttsSaveLock.Unlock()
+	s.unsavedSeenAttsLock.Lock()
+	unsaved := s.unsavedSeenAtts
+	s.unsavedSeenAtts = nil
+	s.unsavedSeenAttsLock.Unlock()
+	for epoch, digests := range unsaved {
+		if err := s.db.SaveSeenAttestations(s.ctx, epoch, digests); err != nil {
+			log.WithError(err).Debug("Could not save seen attestations")
+		}
+	}
+
+	pruneEpoch := uint64(0)
+	if epoch := helpers.SlotToEpoch(s.chain.CurrentSlot()); epoch > seenAttestationsRetainedEpochs {
+		pruneEpoch = epoch - seenAttestationsRetainedEpochs
+	}
+	if finalized := s.chain.FinalizedCheckpt(); finalized != nil && finalized.Epoch > pruneEpoch {
+		pruneEpoch = finalized.Epoch
+	}
+	if pruneEpoch <= s.seenAttsPrunedEpoch {
+		return
+	}
+	if err := s.db.DeleteSeenAttestationsBefore(s.ctx, pruneEpoch); err != nil {
+		log.WithError(err).Debug("Could not prune seen attestations")
+		return
+	}
+	s.seenAttsPrunedEpoch = pruneEpoch
+}
+
+// clearSeenAttestations forgets the seen attestations saved to the database.
+func (s *Service) clearSeenAttestations() {
+	s.unsavedSeenAttsLock.Lock()
+	s.unsavedSeenAtts = nil
+	s.unsavedSeenAttsLock.Unlock()
+	if s.db == nil {
+		return
+	}
+	if err := s.db.DeleteSeenAttestationsBefore(s.ctx, math.MaxUint64); err != nil {
+		log.WithError(err).Error("Could not delete seen attestations")
+	}
+}
+
+// seenAttestationsSaveInterval is how often seen attestations are saved to the database.
+func seenAttestationsSaveInterval() time.Duration {
+	return time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+}
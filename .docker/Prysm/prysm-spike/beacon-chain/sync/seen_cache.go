@@ -0,0 +1,115 @@
+package sync
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+)
+
+// seenCache is a set of keys which are forgotten once they are older than the retention window
+// of the cache. Unlike a fixed size LRU, entries are not evicted early when many keys are added
+// in a short period such as a long reorg. The size bound only protects memory, once it is
+// reached the oldest keys are evicted first.
+type seenCache struct {
+	lock      sync.Mutex
+	name      string
+	retention time.Duration
+	maxSize   int
+	entries   map[string]*list.Element
+	order     *list.List // Entries from oldest to newest.
+	now       func() time.Time
+}
+
+type seenCacheEntry struct {
+	key     string
+	addedAt time.Time
+}
+
+// newSeenCache creates a cache retaining keys for the given number of slots, holding at most
+// maxSize keys.
+func newSeenCache(name string, retentionSlots uint64, maxSize int) *seenCache {
+	retention := time.Duration(retentionSlots*params.BeaconConfig().SecondsPerSlot) * time.Second
+	return &seenCache{
+		name:      name,
+		retention: retention,
+		maxSize:   maxSize,
+		entries:   make(map[string]*list.Element),
+		order:     list.New(),
+		now:       timeutils.Now,
+	}
+}
+
+// Has returns true if the key was added within the retention window.
+func (c *seenCache) Has(key string) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.evictExpired()
+	_, ok := c.entries[key]
+	if ok {
+		seenCacheHits.WithLabelValues(c.name).Inc()
+	} else {
+		seenCacheMisses.WithLabelValues(c.name).Inc()
+	}
+	return ok
+}
+
+// Add marks the key as seen, restarting its retention window if it was already seen.
+func (c *seenCache) Add(key string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.evictExpired()
+	if elem, ok := c.entries[key]; ok {
+		c.order.Remove(elem)
+	}
+	c.entries[key] = c.order.PushBack(&seenCacheEntry{key: key, addedAt: c.now()})
+	for c.order.Len() > c.maxSize {
+		c.removeElement(c.order.Front())
+		seenCacheEvictions.WithLabelValues(c.name, "size").Inc()
+	}
+}
+
+// Remove forgets the key.
+func (c *seenCache) Remove(key string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if elem, ok := c.entries[key]; ok {
+		c.removeElement(elem)
+	}
+}
+
+// Purge forgets all keys.
+func (c *seenCache) Purge() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.entries = make(map[string]*list.Element)
+	c.order.Init()
+}
+
+// Len returns the number of keys within the retention window.
+func (c *seenCache) Len() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.evictExpired()
+	return c.order.Len()
+}
+
+// Note: this helper is not thread safe.
+func (c *seenCache) evictExpired() {
+	cutoff := c.now().Add(-c.retention)
+	for elem := c.order.Front(); elem != nil; elem = c.order.Front() {
+		if elem.Value.(*seenCacheEntry).addedAt.After(cutoff) {
+			return
+		}
+		c.removeElement(elem)
+		seenCacheEvictions.WithLabelValues(c.name, "expired").Inc()
+	}
+}
+
+// Note: this helper is not thread safe.
+func (c *seenCache) removeElement(elem *list.Element) {
+	c.order.Remove(elem)
+	delete(c.entries, elem.Value.(*seenCacheEntry).key)
+}
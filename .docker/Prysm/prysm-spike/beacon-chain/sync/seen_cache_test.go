@@ -0,0 +1,57 @@
+package sync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+)
+
+func TestSeenCache_ExpiresAfterRetention(t *testing.T) {
+	now := time.Now()
+	c := newSeenCache("test", 2, 10)
+	c.now = func() time.Time { return now }
+	slot := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+
+	c.Add("a")
+	now = now.Add(slot)
+	c.Add("b")
+	assert.Equal(t, true, c.Has("a"))
+	assert.Equal(t, true, c.Has("b"))
+
+	now = now.Add(slot)
+	assert.Equal(t, false, c.Has("a"), "Expected key to expire after the retention window")
+	assert.Equal(t, true, c.Has("b"))
+	assert.Equal(t, 1, c.Len())
+
+	// Adding a key again restarts its retention window.
+	c.Add("b")
+	now = now.Add(slot)
+	assert.Equal(t, true, c.Has("b"))
+}
+
+func TestSeenCache_EvictsOldestWhenFull(t *testing.T) {
+	c := newSeenCache("test", 64, 2)
+	c.Add("a")
+	c.Add("b")
+	c.Add("a")
+	c.Add("c")
+	assert.Equal(t, 2, c.Len())
+	assert.Equal(t, false, c.Has("b"))
+	assert.Equal(t, true, c.Has("a"))
+	assert.Equal(t, true, c.Has("c"))
+}
+
+func TestSeenCache_RemoveAndPurge(t *testing.T) {
+	c := newSeenCache("test", 64, 10)
+	c.Add("a")
+	c.Add("b")
+	c.Remove("a")
+	assert.Equal(t, false, c.Has("a"))
+	assert.Equal(t, true, c.Has("b"))
+	c.Purge()
+	assert.Equal(t, 0, c.Len())
+	c.Add("a")
+	assert.Equal(t, true, c.Has("a"))
+}
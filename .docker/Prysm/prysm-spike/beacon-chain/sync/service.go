@@ -6,6 +6,7 @@ package sync
 
 import (
 	"context"
+	"runtime"
 	"sync"
 	"time"
 
@@ -39,12 +40,17 @@ import (
 var _ shared.Service = (*Service)(nil)
 
 const rangeLimit = 1024
-const seenBlockSize = 1000
+const seenBlockSize = 10000
 const seenAttSize = 10000
 const seenExitSize = 100
 const seenAttesterSlashingSize = 100
 const seenProposerSlashingSize = 100
-const badBlockSize = 1000
+const badBlockSize = 10000
+const seenPendingBlockSize = 10000
+
+// Seen and bad blocks are remembered for two epochs, long enough to not readmit duplicates
+// during long reorgs.
+var seenBlockRetention = 2 * params.BeaconConfig().SlotsPerEpoch
 
 const syncMetricsInterval = 10 * time.Second
 
@@ -64,6 +70,8 @@ type Config struct {
 	AttestationNotifier operation.Notifier
 	StateSummaryCache   *cache.StateSummaryCache
 	StateGen            *stategen.State
+	BlockPropagation    *p2p.BlockPropagationTracer
+	HashTreeRootCache   *cache.HashTreeRootCache
 }
 
 // This defines the interface for interacting with block chain service
@@ -76,6 +84,7 @@ type blockchainService interface {
 	blockchain.TimeFetcher
 	blockchain.GenesisFetcher
 	blockchain.CanonicalFetcher
+	blockchain.TargetStateFetcher
 }
 
 // Service is responsible for handling all run time p2p related operations as the
@@ -90,7 +99,7 @@ type Service struct {
 	slashingPool              *slashings.Pool
 	chain                     blockchainService
 	slotToPendingBlocks       *gcache.Cache
-	seenPendingBlocks         map[[32]byte]bool
+	seenPendingBlocks         *seenCache
 	blkRootToPendingAtts      map[[32]byte][]*ethpb.SignedAggregateAttestationAndProof
 	pendingAttsLock           sync.RWMutex
 	pendingQueueLock          sync.RWMutex
@@ -102,19 +111,26 @@ type Service struct {
 	rateLimiter               *limiter
 	attestationNotifier       operation.Notifier
 	seenBlockLock             sync.RWMutex
-	seenBlockCache            *lru.Cache
+	seenBlockCache            *seenCache
 	seenAttestationLock       sync.RWMutex
 	seenAttestationCache      *lru.Cache
+	unsavedSeenAttsLock       sync.Mutex
+	unsavedSeenAtts           map[uint64][][]byte // keys of the seen attestation cache to save, by epoch
+	seenAttsSaveLock          sync.Mutex
+	seenAttsPrunedEpoch       uint64
 	seenExitLock              sync.RWMutex
 	seenExitCache             *lru.Cache
 	seenProposerSlashingLock  sync.RWMutex
 	seenProposerSlashingCache *lru.Cache
 	seenAttesterSlashingLock  sync.RWMutex
 	seenAttesterSlashingCache *lru.Cache
-	badBlockCache             *lru.Cache
+	badBlockCache             *seenCache
 	badBlockLock              sync.RWMutex
 	stateSummaryCache         *cache.StateSummaryCache
 	stateGen                  *stategen.State
+	attValidationPool         *shardedValidationPool
+	blockPropagation          *p2p.BlockPropagationTracer
+	htrCache                  *cache.HashTreeRootCache
 }
 
 // NewService initializes new regular sync service.
@@ -136,14 +152,21 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 		initialSync:          cfg.InitialSync,
 		attestationNotifier:  cfg.AttestationNotifier,
 		slotToPendingBlocks:  c,
-		seenPendingBlocks:    make(map[[32]byte]bool),
+		seenPendingBlocks:    newSeenCache("pending_block", params.BeaconConfig().SlotsPerEpoch, seenPendingBlockSize),
 		blkRootToPendingAtts: make(map[[32]byte][]*ethpb.SignedAggregateAttestationAndProof),
 		stateNotifier:        cfg.StateNotifier,
 		blockNotifier:        cfg.BlockNotifier,
 		stateSummaryCache:    cfg.StateSummaryCache,
 		stateGen:             cfg.StateGen,
 		rateLimiter:          rLimiter,
+		blockPropagation:     cfg.BlockPropagation,
+		htrCache:             cfg.HashTreeRootCache,
+	}
+	workers := flags.Get().AttestationValidationWorkers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
 	}
+	r.attValidationPool = newShardedValidationPool(ctx, workers, attValidationQueueSize)
 
 	go r.registerHandlers()
 
@@ -155,6 +178,7 @@ func (s *Service) Start() {
 	if err := s.initCaches(); err != nil {
 		panic(err)
 	}
+	s.loadSeenAttestations()
 
 	s.p2p.AddConnectionHandler(s.reValidatePeer, s.sendGoodbye)
 	s.p2p.AddDisconnectionHandler(func(_ context.Context, _ peer.ID) error {
@@ -171,6 +195,7 @@ func (s *Service) Start() {
 
 	// Update sync metrics.
 	runutil.RunEvery(s.ctx, syncMetricsInterval, s.updateMetrics)
+	runutil.RunEvery(s.ctx, seenAttestationsSaveInterval(), s.saveSeenAttestations)
 }
 
 // Stop the regular sync service.
@@ -190,6 +215,7 @@ func (s *Service) Stop() error {
 			log.Errorf("Could not successfully unregister for topic %s: %v", t, err)
 		}
 	}
+	s.saveSeenAttestations()
 	defer s.cancel()
 	return nil
 }
@@ -208,10 +234,6 @@ func (s *Service) Status() error {
 // This initializes the caches to update seen beacon objects coming in from the wire
 // and prevent DoS.
 func (s *Service) initCaches() error {
-	blkCache, err := lru.New(seenBlockSize)
-	if err != nil {
-		return err
-	}
 	attCache, err := lru.New(seenAttSize)
 	if err != nil {
 		return err
@@ -228,20 +250,29 @@ func (s *Service) initCaches() error {
 	if err != nil {
 		return err
 	}
-	badBlockCache, err := lru.New(badBlockSize)
-	if err != nil {
-		return err
-	}
-	s.seenBlockCache = blkCache
+	s.seenBlockCache = newSeenCache("block", seenBlockRetention, seenBlockSize)
 	s.seenAttestationCache = attCache
 	s.seenExitCache = exitCache
 	s.seenAttesterSlashingCache = attesterSlashingCache
 	s.seenProposerSlashingCache = proposerSlashingCache
-	s.badBlockCache = badBlockCache
+	s.badBlockCache = newSeenCache("bad_block", seenBlockRetention, badBlockSize)
 
 	return nil
 }
 
+// ClearSeenCaches forgets all blocks, attestations, exits and slashings seen on the wire, including
+// the blocks marked as bad, so they are validated again when received.
+func (s *Service) ClearSeenCaches() {
+	s.seenPendingBlocks.Purge()
+	s.seenBlockCache.Purge()
+	s.badBlockCache.Purge()
+	s.seenAttestationCache.Purge()
+	s.clearSeenAttestations()
+	s.seenExitCache.Purge()
+	s.seenProposerSlashingCache.Purge()
+	s.seenAttesterSlashingCache.Purge()
+}
+
 func (s *Service) registerHandlers() {
 	// Wait until chain start.
 	stateChannel := make(chan *feed.Event, 1)
@@ -302,3 +333,8 @@ type Checker interface {
 	Status() error
 	Resync() error
 }
+
+// SeenCacheFlusher defines a struct which can clear the caches of objects already seen on the wire.
+type SeenCacheFlusher interface {
+	ClearSeenCaches()
+}
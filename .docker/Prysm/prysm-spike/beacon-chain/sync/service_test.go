@@ -248,3 +248,26 @@ func TestSyncService_StopCleanly(t *testing.T) {
 	require.Equal(t, 0, len(r.p2p.PubSub().GetTopics()))
 	require.Equal(t, 0, len(r.p2p.Host().Mux().Protocols()))
 }
+
+func TestService_ClearSeenCaches(t *testing.T) {
+	r := &Service{
+		seenPendingBlocks: newSeenCache("pending_block", seenBlockRetention, seenPendingBlockSize),
+	}
+	require.NoError(t, r.initCaches())
+	r.seenPendingBlocks.Add("pending")
+	r.seenBlockCache.Add("block")
+	r.badBlockCache.Add("bad")
+	r.seenAttestationCache.Add("att", true)
+	r.seenExitCache.Add(uint64(1), true)
+	r.seenProposerSlashingCache.Add(uint64(2), true)
+	r.seenAttesterSlashingCache.Add(uint64(3), true)
+
+	r.ClearSeenCaches()
+	assert.Equal(t, 0, r.seenPendingBlocks.Len())
+	assert.Equal(t, 0, r.seenBlockCache.Len())
+	assert.Equal(t, 0, r.badBlockCache.Len())
+	assert.Equal(t, 0, r.seenAttestationCache.Len())
+	assert.Equal(t, 0, r.seenExitCache.Len())
+	assert.Equal(t, 0, r.seenProposerSlashingCache.Len())
+	assert.Equal(t, 0, r.seenAttesterSlashingCache.Len())
+}
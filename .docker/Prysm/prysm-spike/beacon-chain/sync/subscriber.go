@@ -20,6 +20,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/sliceutil"
 	"github.com/prysmaticlabs/prysm/shared/slotutil"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
 
@@ -271,7 +272,19 @@ func (s *Service) subscribeDynamicWithSubnets(
 				// Persistent subscriptions from validators
 				persistentSubs := s.persistentSubnetIndices()
 				// Update desired topic indices for aggregator
-				wantedSubs := s.aggregatorSubnetIndices(currentSlot)
+				duties := s.aggregatorSubnetDuties(currentSlot)
+				wantedSubs := make([]uint64, 0, len(duties))
+				for idx, dutySlot := range duties {
+					if _, exists := subscriptions[idx]; !exists && dutySlot < currentSlot+flags.Get().SubnetLookaheadSlots {
+						lateSubnetSubscriptionCounter.Inc()
+						log.WithFields(logrus.Fields{
+							"subnet":      idx,
+							"dutySlot":    dutySlot,
+							"currentSlot": currentSlot,
+						}).Debug("Subscribing to attestation subnet later than the configured lookahead")
+					}
+					wantedSubs = append(wantedSubs, idx)
+				}
 
 				// Combine subscriptions to get all requested subscriptions
 				wantedSubs = sliceutil.SetUint64(append(persistentSubs, wantedSubs...))
@@ -11,10 +11,15 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/sliceutil"
 )
 
+// subnetLingerSlots is the number of slots an aggregator subnet stays subscribed after its duty, so
+// attestations of the duty slot arriving late are still received.
+const subnetLingerSlots = 1
+
 func (s *Service) committeeIndexBeaconAttestationSubscriber(_ context.Context, msg proto.Message) error {
 	a, ok := msg.(*eth.Attestation)
 	if !ok {
@@ -51,13 +56,33 @@ func (s *Service) persistentSubnetIndices() []uint64 {
 }
 
 func (s *Service) aggregatorSubnetIndices(currentSlot uint64) []uint64 {
-	endEpoch := helpers.SlotToEpoch(currentSlot) + 1
-	endSlot := endEpoch * params.BeaconConfig().SlotsPerEpoch
-	var commIds []uint64
-	for i := currentSlot; i <= endSlot; i++ {
-		commIds = append(commIds, cache.SubnetIDs.GetAggregatorSubnetIDs(i)...)
+	duties := s.aggregatorSubnetDuties(currentSlot)
+	commIds := make([]uint64, 0, len(duties))
+	for idx := range duties {
+		commIds = append(commIds, idx)
 	}
-	return sliceutil.SetUint64(commIds)
+	return commIds
+}
+
+// aggregatorSubnetDuties returns the subnets of aggregator duties whose subscription window covers
+// the current slot, mapped to the earliest duty slot needing the subnet. The window of a duty opens
+// the configured number of lookahead slots before the duty and closes subnetLingerSlots after it,
+// so consecutive duties on the same subnet keep the subscription alive in between.
+func (s *Service) aggregatorSubnetDuties(currentSlot uint64) map[uint64]uint64 {
+	startSlot := uint64(0)
+	if currentSlot > subnetLingerSlots {
+		startSlot = currentSlot - subnetLingerSlots
+	}
+	endSlot := currentSlot + flags.Get().SubnetLookaheadSlots
+	duties := make(map[uint64]uint64)
+	for i := startSlot; i <= endSlot; i++ {
+		for _, idx := range cache.SubnetIDs.GetAggregatorSubnetIDs(i) {
+			if _, ok := duties[idx]; !ok {
+				duties[idx] = i
+			}
+		}
+	}
+	return duties
 }
 
 func (s *Service) attesterSubnetIndices(currentSlot uint64) []uint64 {
@@ -24,7 +24,7 @@ func (s *Service) beaconBlockSubscriber(ctx context.Context, msg proto.Message)
 
 	block := signed.Block
 
-	root, err := block.HashTreeRoot()
+	root, err := s.htrCache.HashTreeRoot(block)
 	if err != nil {
 		return err
 	}
@@ -15,7 +15,9 @@ import (
 	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
 	pb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	mockChain "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	db "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
@@ -233,6 +235,28 @@ func TestRevalidateSubscription_CorrectlyFormatsTopic(t *testing.T) {
 	require.LogsDoNotContain(t, hook, "Could not unregister topic validator")
 }
 
+func TestAggregatorSubnetDuties_SubscriptionWindow(t *testing.T) {
+	resetFlags := flags.Get()
+	cfg := *resetFlags
+	cfg.SubnetLookaheadSlots = 2
+	flags.Init(&cfg)
+	defer flags.Init(resetFlags)
+
+	r := Service{}
+	dutySlot := uint64(100000)
+	cache.SubnetIDs.AddAggregatorSubnetID(dutySlot, 7)
+	cache.SubnetIDs.AddAggregatorSubnetID(dutySlot+3, 7)
+	cache.SubnetIDs.AddAggregatorSubnetID(dutySlot+1, 9)
+
+	assert.Equal(t, 0, len(r.aggregatorSubnetDuties(dutySlot-3)), "Subscribed before the lookahead")
+	assert.DeepEqual(t, map[uint64]uint64{7: dutySlot}, r.aggregatorSubnetDuties(dutySlot-2))
+	assert.DeepEqual(t, map[uint64]uint64{7: dutySlot, 9: dutySlot + 1}, r.aggregatorSubnetDuties(dutySlot))
+	// The consecutive duty keeps subnet 7 subscribed after the first duty lingered.
+	assert.DeepEqual(t, map[uint64]uint64{7: dutySlot + 3, 9: dutySlot + 1}, r.aggregatorSubnetDuties(dutySlot+2))
+	assert.DeepEqual(t, map[uint64]uint64{7: dutySlot + 3}, r.aggregatorSubnetDuties(dutySlot+4))
+	assert.Equal(t, 0, len(r.aggregatorSubnetDuties(dutySlot+5)), "Subscribed after the duty")
+}
+
 func TestStaticSubnets(t *testing.T) {
 	p := p2ptest.NewTestP2P(t)
 	ctx, cancel := context.WithCancel(context.Background())
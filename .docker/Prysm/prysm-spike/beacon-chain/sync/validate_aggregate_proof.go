@@ -187,8 +187,7 @@ func (s *Service) validateBlockInAttestation(ctx context.Context, satt *ethpb.Si
 func (s *Service) hasSeenAggregatorIndexEpoch(epoch, aggregatorIndex uint64) bool {
 	s.seenAttestationLock.RLock()
 	defer s.seenAttestationLock.RUnlock()
-	b := append(bytesutil.Bytes32(epoch), bytesutil.Bytes32(aggregatorIndex)...)
-	_, seen := s.seenAttestationCache.Get(string(b))
+	_, seen := s.seenAttestationCache.Get(seenAttestationKey(bytesutil.Bytes32(epoch), bytesutil.Bytes32(aggregatorIndex)))
 	return seen
 }
 
@@ -196,8 +195,7 @@ func (s *Service) hasSeenAggregatorIndexEpoch(epoch, aggregatorIndex uint64) boo
 func (s *Service) setAggregatorIndexEpochSeen(epoch, aggregatorIndex uint64) {
 	s.seenAttestationLock.Lock()
 	defer s.seenAttestationLock.Unlock()
-	b := append(bytesutil.Bytes32(epoch), bytesutil.Bytes32(aggregatorIndex)...)
-	s.seenAttestationCache.Add(string(b), true)
+	s.addSeenAttestation(epoch, seenAttestationKey(bytesutil.Bytes32(epoch), bytesutil.Bytes32(aggregatorIndex)))
 }
 
 // This validates the aggregator's index in state is within the beacon committee.
@@ -76,6 +76,21 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 		return pubsub.ValidationReject
 	}
 
+	// The remaining validation runs on the worker of the attestation's committee, so attestations of
+	// the same committee are checked against the seen cache in the order they were received.
+	if s.attValidationPool == nil {
+		return s.validateCommitteeAttestation(ctx, msg, att, *originalTopic)
+	}
+	return s.attValidationPool.submit(ctx, att.Data.CommitteeIndex, func(ctx context.Context) pubsub.ValidationResult {
+		return s.validateCommitteeAttestation(ctx, msg, att, *originalTopic)
+	})
+}
+
+// This validates a decoded unaggregated attestation against the chain and its committee.
+func (s *Service) validateCommitteeAttestation(ctx context.Context, msg *pubsub.Message, att *eth.Attestation, topic string) pubsub.ValidationResult {
+	ctx, span := trace.StartSpan(ctx, "sync.validateCommitteeAttestation")
+	defer span.End()
+
 	// Verify this the first attestation received for the participating validator for the slot.
 	if s.hasSeenCommitteeIndicesSlot(att.Data.Slot, att.Data.CommitteeIndex, att.AggregationBits) {
 		return pubsub.ValidationIgnore
@@ -112,7 +127,7 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 		return pubsub.ValidationIgnore
 	}
 
-	validationRes := s.validateUnaggregatedAttTopic(ctx, att, preState, *originalTopic)
+	validationRes := s.validateUnaggregatedAttTopic(ctx, att, preState, topic)
 	if validationRes != pubsub.ValidationAccept {
 		return validationRes
 	}
@@ -196,9 +211,7 @@ func (s *Service) validateUnaggregatedAttWithState(ctx context.Context, a *eth.A
 func (s *Service) hasSeenCommitteeIndicesSlot(slot, committeeID uint64, aggregateBits []byte) bool {
 	s.seenAttestationLock.RLock()
 	defer s.seenAttestationLock.RUnlock()
-	b := append(bytesutil.Bytes32(slot), bytesutil.Bytes32(committeeID)...)
-	b = append(b, aggregateBits...)
-	_, seen := s.seenAttestationCache.Get(string(b))
+	_, seen := s.seenAttestationCache.Get(seenAttestationKey(bytesutil.Bytes32(slot), bytesutil.Bytes32(committeeID), aggregateBits))
 	return seen
 }
 
@@ -206,9 +219,8 @@ func (s *Service) hasSeenCommitteeIndicesSlot(slot, committeeID uint64, aggregat
 func (s *Service) setSeenCommitteeIndicesSlot(slot, committeeID uint64, aggregateBits []byte) {
 	s.seenAttestationLock.Lock()
 	defer s.seenAttestationLock.Unlock()
-	b := append(bytesutil.Bytes32(slot), bytesutil.Bytes32(committeeID)...)
-	b = append(b, aggregateBits...)
-	s.seenAttestationCache.Add(string(b), true)
+	key := seenAttestationKey(bytesutil.Bytes32(slot), bytesutil.Bytes32(committeeID), aggregateBits)
+	s.addSeenAttestation(helpers.SlotToEpoch(slot), key)
 }
 
 // hasBlockAndState returns true if the beacon node knows about a block and associated state in the
@@ -74,11 +74,15 @@ func (s *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, ms
 		return pubsub.ValidationIgnore
 	}
 
-	blockRoot, err := blk.Block.HashTreeRoot()
+	blockRoot, err := s.htrCache.HashTreeRoot(blk.Block)
 	if err != nil {
 		log.WithError(err).WithField("blockSlot", blk.Block.Slot).Debug("Ignored block")
 		return pubsub.ValidationIgnore
 	}
+	if s.blockPropagation != nil {
+		slotStart := s.chain.GenesisTime().Add(time.Duration(blk.Block.Slot*params.BeaconConfig().SecondsPerSlot) * time.Second)
+		s.blockPropagation.AnnotateBlock(msg, blockRoot, blk.Block.Slot, slotStart)
+	}
 	if s.db.HasBlock(ctx, blockRoot) {
 		return pubsub.ValidationIgnore
 	}
@@ -91,7 +95,7 @@ func (s *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, ms
 	}
 
 	s.pendingQueueLock.RLock()
-	if s.seenPendingBlocks[blockRoot] {
+	if s.seenPendingBlocks.Has(string(blockRoot[:])) {
 		s.pendingQueueLock.RUnlock()
 		return pubsub.ValidationIgnore
 	}
@@ -168,6 +172,16 @@ func (s *Service) validateBeaconBlock(ctx context.Context, blk *ethpb.SignedBeac
 		return err
 	}
 
+	// Blocks of the same epoch built on the same parent share the epoch transition of their
+	// parent state, which is the costly part of advancing it.
+	blkEpoch := helpers.SlotToEpoch(blk.Block.Slot)
+	if blkEpoch > helpers.CurrentEpoch(parentState) {
+		parentState, err = s.chain.TargetState(ctx, &ethpb.Checkpoint{Epoch: blkEpoch, Root: blk.Block.ParentRoot})
+		if err != nil {
+			return err
+		}
+		parentState = parentState.Copy()
+	}
 	parentState, err = state.ProcessSlots(ctx, parentState, blk.Block.Slot)
 	if err != nil {
 		return err
@@ -189,8 +203,7 @@ func (s *Service) hasSeenBlockIndexSlot(slot, proposerIdx uint64) bool {
 	s.seenBlockLock.RLock()
 	defer s.seenBlockLock.RUnlock()
 	b := append(bytesutil.Bytes32(slot), bytesutil.Bytes32(proposerIdx)...)
-	_, seen := s.seenBlockCache.Get(string(b))
-	return seen
+	return s.seenBlockCache.Has(string(b))
 }
 
 // Set block proposer index and slot as seen for incoming blocks.
@@ -198,15 +211,14 @@ func (s *Service) setSeenBlockIndexSlot(slot, proposerIdx uint64) {
 	s.seenBlockLock.Lock()
 	defer s.seenBlockLock.Unlock()
 	b := append(bytesutil.Bytes32(slot), bytesutil.Bytes32(proposerIdx)...)
-	s.seenBlockCache.Add(string(b), true)
+	s.seenBlockCache.Add(string(b))
 }
 
 // Returns true if the block is marked as a bad block.
 func (s *Service) hasBadBlock(root [32]byte) bool {
 	s.badBlockLock.RLock()
 	defer s.badBlockLock.RUnlock()
-	_, seen := s.badBlockCache.Get(string(root[:]))
-	return seen
+	return s.badBlockCache.Has(string(root[:]))
 }
 
 // Set bad block in the cache.
@@ -216,7 +228,7 @@ func (s *Service) setBadBlock(ctx context.Context, root [32]byte) {
 	if ctx.Err() != nil { // Do not mark block as bad if it was due to context error.
 		return
 	}
-	s.badBlockCache.Add(string(root[:]), true)
+	s.badBlockCache.Add(string(root[:]))
 }
 
 // This captures metrics for block arrival time by subtracts slot start time.
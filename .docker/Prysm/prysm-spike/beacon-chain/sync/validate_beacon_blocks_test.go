@@ -8,7 +8,6 @@ import (
 	"testing"
 	"time"
 
-	lru "github.com/hashicorp/golang-lru"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
 	gcache "github.com/patrickmn/go-cache"
@@ -48,10 +47,8 @@ func TestValidateBeaconBlockPubSub_InvalidSignature(t *testing.T) {
 
 	p := p2ptest.NewTestP2P(t)
 
-	c, err := lru.New(10)
-	require.NoError(t, err)
-	c2, err := lru.New(10)
-	require.NoError(t, err)
+	c := newSeenCache("block", seenBlockRetention, 10)
+	c2 := newSeenCache("bad_block", seenBlockRetention, 10)
 	chainService := &mock.ChainService{Genesis: time.Now(),
 		FinalizedCheckPoint: &ethpb.Checkpoint{
 			Epoch: 0,
@@ -67,7 +64,7 @@ func TestValidateBeaconBlockPubSub_InvalidSignature(t *testing.T) {
 	}
 
 	buf := new(bytes.Buffer)
-	_, err = p.Encoding().EncodeGossip(buf, msg)
+	_, err := p.Encoding().EncodeGossip(buf, msg)
 	require.NoError(t, err)
 	topic := p2p.GossipTypeMapping[reflect.TypeOf(msg)]
 	m := &pubsub.Message{
@@ -90,10 +87,8 @@ func TestValidateBeaconBlockPubSub_BlockAlreadyPresentInDB(t *testing.T) {
 	msg.Block.ParentRoot = testutil.Random32Bytes(t)
 	require.NoError(t, db.SaveBlock(context.Background(), msg))
 
-	c, err := lru.New(10)
-	require.NoError(t, err)
-	c2, err := lru.New(10)
-	require.NoError(t, err)
+	c := newSeenCache("block", seenBlockRetention, 10)
+	c2 := newSeenCache("bad_block", seenBlockRetention, 10)
 	chainService := &mock.ChainService{Genesis: time.Now()}
 	r := &Service{
 		db:                db,
@@ -107,7 +102,7 @@ func TestValidateBeaconBlockPubSub_BlockAlreadyPresentInDB(t *testing.T) {
 	}
 
 	buf := new(bytes.Buffer)
-	_, err = p.Encoding().EncodeGossip(buf, msg)
+	_, err := p.Encoding().EncodeGossip(buf, msg)
 	require.NoError(t, err)
 
 	topic := p2p.GossipTypeMapping[reflect.TypeOf(msg)]
@@ -142,10 +137,8 @@ func TestValidateBeaconBlockPubSub_CanRecoverStateSummary(t *testing.T) {
 	msg.Signature, err = helpers.ComputeDomainAndSign(beaconState, 0, msg.Block, params.BeaconConfig().DomainBeaconProposer, privKeys[proposerIdx])
 	require.NoError(t, err)
 
-	c, err := lru.New(10)
-	require.NoError(t, err)
-	c2, err := lru.New(10)
-	require.NoError(t, err)
+	c := newSeenCache("block", seenBlockRetention, 10)
+	c2 := newSeenCache("bad_block", seenBlockRetention, 10)
 	stateGen := stategen.New(db, stateSummaryCache)
 	chainService := &mock.ChainService{Genesis: time.Unix(time.Now().Unix()-int64(params.BeaconConfig().SecondsPerSlot), 0),
 		State: beaconState,
@@ -163,7 +156,7 @@ func TestValidateBeaconBlockPubSub_CanRecoverStateSummary(t *testing.T) {
 		seenBlockCache:      c,
 		badBlockCache:       c2,
 		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
-		seenPendingBlocks:   make(map[[32]byte]bool),
+		seenPendingBlocks:   newSeenCache("pending_block", params.BeaconConfig().SlotsPerEpoch, seenPendingBlockSize),
 		stateSummaryCache:   stateSummaryCache,
 		stateGen:            stateGen,
 	}
@@ -204,10 +197,8 @@ func TestValidateBeaconBlockPubSub_ValidProposerSignature(t *testing.T) {
 	msg.Signature, err = helpers.ComputeDomainAndSign(beaconState, 0, msg.Block, params.BeaconConfig().DomainBeaconProposer, privKeys[proposerIdx])
 	require.NoError(t, err)
 
-	c, err := lru.New(10)
-	require.NoError(t, err)
-	c2, err := lru.New(10)
-	require.NoError(t, err)
+	c := newSeenCache("block", seenBlockRetention, 10)
+	c2 := newSeenCache("bad_block", seenBlockRetention, 10)
 	stateGen := stategen.New(db, stateSummaryCache)
 	chainService := &mock.ChainService{Genesis: time.Unix(time.Now().Unix()-int64(params.BeaconConfig().SecondsPerSlot), 0),
 		State: beaconState,
@@ -225,7 +216,7 @@ func TestValidateBeaconBlockPubSub_ValidProposerSignature(t *testing.T) {
 		seenBlockCache:      c,
 		badBlockCache:       c2,
 		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
-		seenPendingBlocks:   make(map[[32]byte]bool),
+		seenPendingBlocks:   newSeenCache("pending_block", params.BeaconConfig().SlotsPerEpoch, seenPendingBlockSize),
 		stateSummaryCache:   stateSummaryCache,
 		stateGen:            stateGen,
 	}
@@ -269,10 +260,8 @@ func TestValidateBeaconBlockPubSub_AdvanceEpochsForState(t *testing.T) {
 	msg.Signature, err = helpers.ComputeDomainAndSign(beaconState, 0, msg.Block, params.BeaconConfig().DomainBeaconProposer, privKeys[proposerIdx])
 	require.NoError(t, err)
 
-	c, err := lru.New(10)
-	require.NoError(t, err)
-	c2, err := lru.New(10)
-	require.NoError(t, err)
+	c := newSeenCache("block", seenBlockRetention, 10)
+	c2 := newSeenCache("bad_block", seenBlockRetention, 10)
 	stateGen := stategen.New(db, stateSummaryCache)
 	chainService := &mock.ChainService{Genesis: time.Unix(time.Now().Unix()-int64(blkSlot*params.BeaconConfig().SecondsPerSlot), 0),
 		State: beaconState,
@@ -288,7 +277,7 @@ func TestValidateBeaconBlockPubSub_AdvanceEpochsForState(t *testing.T) {
 		seenBlockCache:      c,
 		badBlockCache:       c2,
 		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
-		seenPendingBlocks:   make(map[[32]byte]bool),
+		seenPendingBlocks:   newSeenCache("pending_block", params.BeaconConfig().SlotsPerEpoch, seenPendingBlockSize),
 		stateSummaryCache:   stateSummaryCache,
 		stateGen:            stateGen,
 	}
@@ -358,10 +347,8 @@ func TestValidateBeaconBlockPubSub_RejectBlocksFromFuture(t *testing.T) {
 	msg.Block.ParentRoot = testutil.Random32Bytes(t)
 	msg.Signature = sk.Sign([]byte("data")).Marshal()
 
-	c, err := lru.New(10)
-	require.NoError(t, err)
-	c2, err := lru.New(10)
-	require.NoError(t, err)
+	c := newSeenCache("block", seenBlockRetention, 10)
+	c2 := newSeenCache("bad_block", seenBlockRetention, 10)
 	chainService := &mock.ChainService{Genesis: time.Now()}
 	r := &Service{
 		p2p:                 p,
@@ -373,7 +360,7 @@ func TestValidateBeaconBlockPubSub_RejectBlocksFromFuture(t *testing.T) {
 		seenBlockCache:      c,
 		badBlockCache:       c2,
 		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
-		seenPendingBlocks:   make(map[[32]byte]bool),
+		seenPendingBlocks:   newSeenCache("pending_block", params.BeaconConfig().SlotsPerEpoch, seenPendingBlockSize),
 	}
 
 	buf := new(bytes.Buffer)
@@ -404,10 +391,8 @@ func TestValidateBeaconBlockPubSub_RejectBlocksFromThePast(t *testing.T) {
 	msg.Signature = sk.Sign([]byte("data")).Marshal()
 
 	genesisTime := time.Now()
-	c, err := lru.New(10)
-	require.NoError(t, err)
-	c2, err := lru.New(10)
-	require.NoError(t, err)
+	c := newSeenCache("block", seenBlockRetention, 10)
+	c2 := newSeenCache("bad_block", seenBlockRetention, 10)
 	chainService := &mock.ChainService{
 		Genesis: time.Unix(genesisTime.Unix()-1000, 0),
 		FinalizedCheckPoint: &ethpb.Checkpoint{
@@ -458,10 +443,8 @@ func TestValidateBeaconBlockPubSub_SeenProposerSlot(t *testing.T) {
 	msg.Signature, err = helpers.ComputeDomainAndSign(beaconState, 0, msg.Block, params.BeaconConfig().DomainBeaconProposer, privKeys[proposerIdx])
 	require.NoError(t, err)
 
-	c, err := lru.New(10)
-	require.NoError(t, err)
-	c2, err := lru.New(10)
-	require.NoError(t, err)
+	c := newSeenCache("block", seenBlockRetention, 10)
+	c2 := newSeenCache("bad_block", seenBlockRetention, 10)
 	chainService := &mock.ChainService{Genesis: time.Unix(time.Now().Unix()-int64(params.BeaconConfig().SecondsPerSlot), 0),
 		State: beaconState,
 		FinalizedCheckPoint: &ethpb.Checkpoint{
@@ -478,7 +461,7 @@ func TestValidateBeaconBlockPubSub_SeenProposerSlot(t *testing.T) {
 		seenBlockCache:      c,
 		badBlockCache:       c2,
 		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
-		seenPendingBlocks:   make(map[[32]byte]bool),
+		seenPendingBlocks:   newSeenCache("pending_block", params.BeaconConfig().SlotsPerEpoch, seenPendingBlockSize),
 		stateSummaryCache:   cache.NewStateSummaryCache(),
 	}
 
@@ -511,10 +494,8 @@ func TestValidateBeaconBlockPubSub_FilterByFinalizedEpoch(t *testing.T) {
 		FinalizedCheckPoint: &ethpb.Checkpoint{
 			Epoch: 1,
 		}}
-	c, err := lru.New(10)
-	require.NoError(t, err)
-	c2, err := lru.New(10)
-	require.NoError(t, err)
+	c := newSeenCache("block", seenBlockRetention, 10)
+	c2 := newSeenCache("bad_block", seenBlockRetention, 10)
 	r := &Service{
 		db:             db,
 		p2p:            p,
@@ -580,10 +561,8 @@ func TestValidateBeaconBlockPubSub_ParentNotFinalizedDescendant(t *testing.T) {
 	msg.Signature, err = helpers.ComputeDomainAndSign(beaconState, 0, msg.Block, params.BeaconConfig().DomainBeaconProposer, privKeys[proposerIdx])
 	require.NoError(t, err)
 
-	c, err := lru.New(10)
-	require.NoError(t, err)
-	c2, err := lru.New(10)
-	require.NoError(t, err)
+	c := newSeenCache("block", seenBlockRetention, 10)
+	c2 := newSeenCache("bad_block", seenBlockRetention, 10)
 	stateGen := stategen.New(db, stateSummaryCache)
 	chainService := &mock.ChainService{Genesis: time.Unix(time.Now().Unix()-int64(params.BeaconConfig().SecondsPerSlot), 0),
 		State: beaconState,
@@ -602,7 +581,7 @@ func TestValidateBeaconBlockPubSub_ParentNotFinalizedDescendant(t *testing.T) {
 		seenBlockCache:      c,
 		badBlockCache:       c2,
 		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
-		seenPendingBlocks:   make(map[[32]byte]bool),
+		seenPendingBlocks:   newSeenCache("pending_block", params.BeaconConfig().SlotsPerEpoch, seenPendingBlockSize),
 		stateSummaryCache:   stateSummaryCache,
 		stateGen:            stateGen,
 	}
@@ -647,10 +626,8 @@ func TestValidateBeaconBlockPubSub_InvalidParentBlock(t *testing.T) {
 	currBlockRoot, err := msg.Block.HashTreeRoot()
 	require.NoError(t, err)
 
-	c, err := lru.New(10)
-	require.NoError(t, err)
-	c2, err := lru.New(10)
-	require.NoError(t, err)
+	c := newSeenCache("block", seenBlockRetention, 10)
+	c2 := newSeenCache("bad_block", seenBlockRetention, 10)
 	stateGen := stategen.New(db, stateSummaryCache)
 	chainService := &mock.ChainService{Genesis: time.Unix(time.Now().Unix()-int64(params.BeaconConfig().SecondsPerSlot), 0),
 		State: beaconState,
@@ -666,7 +643,7 @@ func TestValidateBeaconBlockPubSub_InvalidParentBlock(t *testing.T) {
 		seenBlockCache:      c,
 		badBlockCache:       c2,
 		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
-		seenPendingBlocks:   make(map[[32]byte]bool),
+		seenPendingBlocks:   newSeenCache("pending_block", params.BeaconConfig().SlotsPerEpoch, seenPendingBlockSize),
 		stateSummaryCache:   stateSummaryCache,
 		stateGen:            stateGen,
 	}
@@ -745,10 +722,8 @@ func TestValidateBeaconBlockPubSub_RejectEvilBlocksFromFuture(t *testing.T) {
 	require.NoError(t, err)
 
 	genesisTime := time.Now()
-	c, err := lru.New(10)
-	require.NoError(t, err)
-	c2, err := lru.New(10)
-	require.NoError(t, err)
+	c := newSeenCache("block", seenBlockRetention, 10)
+	c2 := newSeenCache("bad_block", seenBlockRetention, 10)
 
 	stateGen := stategen.New(db, stateSummaryCache)
 	chainService := &mock.ChainService{
@@ -766,7 +741,7 @@ func TestValidateBeaconBlockPubSub_RejectEvilBlocksFromFuture(t *testing.T) {
 		seenBlockCache:      c,
 		badBlockCache:       c2,
 		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
-		seenPendingBlocks:   make(map[[32]byte]bool),
+		seenPendingBlocks:   newSeenCache("pending_block", params.BeaconConfig().SlotsPerEpoch, seenPendingBlockSize),
 		stateSummaryCache:   stateSummaryCache,
 		stateGen:            stateGen,
 	}
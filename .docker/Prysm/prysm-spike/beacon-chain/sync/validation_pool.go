@@ -0,0 +1,101 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+)
+
+// attValidationQueueSize is the number of attestations which may wait for validation in each shard.
+// Once a shard is full, pubsub validation blocks until the shard drains or the message times out,
+// which pushes back on pubsub and lets its validation throttle drop excess messages.
+const attValidationQueueSize = 256
+
+type validationTask struct {
+	ctx      context.Context
+	validate func(context.Context) pubsub.ValidationResult
+	result   chan pubsub.ValidationResult
+}
+
+// shardedValidationPool runs validation tasks on a fixed number of workers. Tasks with the same
+// key are always run by the same worker in the order they were submitted, while tasks with
+// different keys may run in parallel.
+type shardedValidationPool struct {
+	shards []chan *validationTask
+}
+
+// newShardedValidationPool starts a pool of the given number of workers, which run until the
+// context is canceled.
+func newShardedValidationPool(ctx context.Context, workers, queueSize int) *shardedValidationPool {
+	if workers < 1 {
+		workers = 1
+	}
+	p := &shardedValidationPool{
+		shards: make([]chan *validationTask, workers),
+	}
+	for i := range p.shards {
+		p.shards[i] = make(chan *validationTask, queueSize)
+		go p.work(ctx, p.shards[i])
+	}
+	return p
+}
+
+// submit queues the validation on the shard of the key and waits for its result. The
+// message is ignored if the context expires before the validation could complete.
+func (p *shardedValidationPool) submit(
+	ctx context.Context,
+	key uint64,
+	validate func(context.Context) pubsub.ValidationResult,
+) pubsub.ValidationResult {
+	task := &validationTask{
+		ctx:      ctx,
+		validate: validate,
+		result:   make(chan pubsub.ValidationResult, 1),
+	}
+	shard := p.shards[key%uint64(len(p.shards))]
+	validationQueueDepth.Inc()
+	select {
+	case shard <- task:
+	case <-ctx.Done():
+		validationQueueDepth.Dec()
+		validationQueueTimeoutCounter.Inc()
+		return pubsub.ValidationIgnore
+	}
+	select {
+	case res := <-task.result:
+		return res
+	case <-ctx.Done():
+		validationQueueTimeoutCounter.Inc()
+		return pubsub.ValidationIgnore
+	}
+}
+
+func (p *shardedValidationPool) work(ctx context.Context, shard chan *validationTask) {
+	for {
+		select {
+		case task := <-shard:
+			validationQueueDepth.Dec()
+			task.result <- runValidationTask(task)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// runValidationTask runs the task unless its message already timed out, ignoring any
+// message which causes a panic.
+func runValidationTask(task *validationTask) (res pubsub.ValidationResult) {
+	if task.ctx.Err() != nil {
+		return pubsub.ValidationIgnore
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			log.WithError(fmt.Errorf("%v", r)).Error("Panic occurred while validating message")
+			debug.PrintStack()
+			res = pubsub.ValidationIgnore
+		}
+	}()
+	return task.validate(task.ctx)
+}
@@ -0,0 +1,114 @@
+package sync
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestShardedValidationPool_PreservesOrderPerKey(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	p := newShardedValidationPool(ctx, 4, 16)
+
+	var lock sync.Mutex
+	order := make(map[uint64][]int)
+	var wg sync.WaitGroup
+	for key := uint64(0); key < 4; key++ {
+		wg.Add(1)
+		go func(key uint64) {
+			defer wg.Done()
+			for i := 0; i < 50; i++ {
+				i := i
+				res := p.submit(ctx, key, func(_ context.Context) pubsub.ValidationResult {
+					lock.Lock()
+					defer lock.Unlock()
+					order[key] = append(order[key], i)
+					return pubsub.ValidationAccept
+				})
+				assert.Equal(t, pubsub.ValidationAccept, res)
+			}
+		}(key)
+	}
+	wg.Wait()
+
+	for key := uint64(0); key < 4; key++ {
+		require.Equal(t, 50, len(order[key]))
+		for i, v := range order[key] {
+			assert.Equal(t, i, v, "Unexpected validation order for key %d", key)
+		}
+	}
+}
+
+func TestShardedValidationPool_ValidatesKeysInParallel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	p := newShardedValidationPool(ctx, 2, 1)
+
+	blocked := make(chan struct{})
+	release := make(chan struct{})
+	go p.submit(ctx, 0, func(_ context.Context) pubsub.ValidationResult {
+		close(blocked)
+		<-release
+		return pubsub.ValidationAccept
+	})
+	<-blocked
+	defer close(release)
+
+	// A different shard must not wait on the blocked one.
+	submitCtx, submitCancel := context.WithTimeout(ctx, time.Second)
+	defer submitCancel()
+	res := p.submit(submitCtx, 1, func(_ context.Context) pubsub.ValidationResult {
+		return pubsub.ValidationReject
+	})
+	assert.Equal(t, pubsub.ValidationReject, res)
+}
+
+func TestShardedValidationPool_IgnoresOnTimeout(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	p := newShardedValidationPool(ctx, 1, 0)
+
+	blocked := make(chan struct{})
+	release := make(chan struct{})
+	go p.submit(ctx, 0, func(_ context.Context) pubsub.ValidationResult {
+		close(blocked)
+		<-release
+		return pubsub.ValidationAccept
+	})
+	<-blocked
+	defer close(release)
+
+	// The only worker is busy and the shard has no queue, so the message times out.
+	submitCtx, submitCancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer submitCancel()
+	called := false
+	res := p.submit(submitCtx, 0, func(_ context.Context) pubsub.ValidationResult {
+		called = true
+		return pubsub.ValidationAccept
+	})
+	assert.Equal(t, pubsub.ValidationIgnore, res)
+	assert.Equal(t, false, called)
+}
+
+func TestShardedValidationPool_RecoversPanic(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	p := newShardedValidationPool(ctx, 1, 1)
+
+	res := p.submit(ctx, 0, func(_ context.Context) pubsub.ValidationResult {
+		panic("bad message")
+	})
+	assert.Equal(t, pubsub.ValidationIgnore, res)
+
+	// The worker keeps validating after a panic.
+	res = p.submit(ctx, 0, func(_ context.Context) pubsub.ValidationResult {
+		return pubsub.ValidationAccept
+	})
+	assert.Equal(t, pubsub.ValidationAccept, res)
+}
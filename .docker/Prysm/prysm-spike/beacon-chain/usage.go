@@ -49,6 +49,7 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.RPCMaxPageSizeFlag,
 			cmd.NoDiscovery,
 			cmd.BootstrapNode,
+			cmd.BootstrapDNS,
 			cmd.RelayNode,
 			cmd.P2PUDPPort,
 			cmd.P2PTCPPort,
@@ -66,7 +67,12 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.ClearDB,
 			cmd.ConfigFileFlag,
 			cmd.ChainConfigFileFlag,
+			cmd.PrintConfigFlag,
 			cmd.GrpcMaxCallRecvMsgSizeFlag,
+			cmd.DiskWatchIntervalFlag,
+			cmd.DiskWarningThresholdFlag,
+			cmd.DiskCriticalThresholdFlag,
+			cmd.DiskEmergencyPruneFlag,
 			cmd.AcceptTosFlag,
 		},
 	},
@@ -92,10 +98,22 @@ var appHelpFlagGroups = []flagGroup{
 			flags.RPCPort,
 			flags.CertFlag,
 			flags.KeyFlag,
+			flags.TLSAutoFlag,
+			flags.TLSAutoHostsFlag,
+			flags.TLSAutoCADirFlag,
 			flags.DisableGRPCGateway,
+			flags.RPCReadOnly,
+			flags.DisableProposalValidation,
+			flags.DBReplicaSnapshotDir,
+			flags.DBReplicaSnapshotEpochs,
+			flags.EraDir,
+			flags.BackfillBlocksPerSecond,
 			flags.GRPCGatewayHost,
 			flags.GRPCGatewayPort,
 			flags.GPRCGatewayCorsDomain,
+			flags.GRPCGatewayAdminHost,
+			flags.GRPCGatewayAdminPort,
+			flags.GRPCGatewayAdminTokenFile,
 			flags.HTTPWeb3ProviderFlag,
 			flags.SetGCPercent,
 			flags.HeadSync,
@@ -104,6 +122,9 @@ var appHelpFlagGroups = []flagGroup{
 			flags.DisableDiscv5,
 			flags.BlockBatchLimit,
 			flags.BlockBatchLimitBurstFactor,
+			flags.SyncCatchUpThreshold,
+			flags.PeerStatusRefreshSlots,
+			flags.PeerStatusStaleSlots,
 			flags.EnableDebugRPCEndpoints,
 			flags.SubscribeToAllSubnets,
 			flags.HistoricalSlasherNode,
@@ -113,6 +134,20 @@ var appHelpFlagGroups = []flagGroup{
 			flags.EnableBackupWebhookFlag,
 			flags.BackupWebhookOutputDir,
 			flags.Eth1HeaderReqLimit,
+			flags.DepositSnapshot,
+			flags.GenesisStateURL,
+			flags.GenesisBarrierTime,
+			flags.GenesisBarrierDelta,
+			flags.DBSyncMode,
+			flags.DBSyncBatchSize,
+			flags.AttestationValidationWorkers,
+			flags.AttestationSubnetLookaheadSlots,
+			flags.AttestationPoolSpillThreshold,
+			flags.TraceBlockPropagation,
+			flags.PublishMeshWait,
+			flags.GossipSeenMessageTTL,
+			flags.TraceGossipDuplicates,
+			flags.GossipDuplicatePenaltyThreshold,
 		},
 	},
 	{
@@ -126,9 +161,23 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.P2PMetadata,
 			cmd.P2PAllowList,
 			cmd.P2PDenyList,
+			cmd.P2PMaxInboundPerIP,
+			cmd.P2PMaxInboundPerSubnet,
+			cmd.P2PInboundSubnetPrefix,
+			cmd.P2PMaxInboundRatio,
 			cmd.StaticPeers,
+			cmd.PeerListURL,
+			cmd.P2PLocalDiscovery,
+			cmd.P2PLocalDiscoveryInterface,
 			cmd.EnableUPnPFlag,
 			flags.MinSyncPeers,
+			flags.InitialSyncPeers,
+			flags.InitialSyncStaticPeersOnly,
+			flags.SyncPeerSelection,
+			flags.BlockProviderBatchWeight,
+			flags.BlockProviderBlocksCap,
+			flags.BlockProviderDecay,
+			flags.BlockProviderDecayInterval,
 		},
 	},
 	{
@@ -136,6 +185,7 @@ var appHelpFlagGroups = []flagGroup{
 		Flags: []cli.Flag{
 			cmd.LogFormat,
 			cmd.LogFileName,
+			cmd.LogDedup,
 		},
 	},
 	{
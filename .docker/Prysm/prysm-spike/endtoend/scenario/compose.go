@@ -0,0 +1,99 @@
+package scenario
+
+import (
+	"bytes"
+	"context"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Network controls the services of the network under test.
+type Network interface {
+	Restart(ctx context.Context, service string) error
+	Disconnect(ctx context.Context, service string) error
+	Connect(ctx context.Context, service string) error
+}
+
+var nonProjectChars = regexp.MustCompile("[^a-z0-9]")
+
+// ComposeNetwork controls the services of a docker-compose project with the docker CLIs.
+type ComposeNetwork struct {
+	dir     string
+	project string
+	network string
+}
+
+// NewComposeNetwork controls the project defined in the compose directory. The project and network
+// names default to the names docker-compose derives from the directory.
+func NewComposeNetwork(dir, project, network string) (*ComposeNetwork, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	if project == "" {
+		project = nonProjectChars.ReplaceAllString(strings.ToLower(filepath.Base(dir)), "")
+	}
+	if network == "" {
+		network = project + "_default"
+	}
+	return &ComposeNetwork{dir: dir, project: project, network: network}, nil
+}
+
+// Restart restarts the containers of the service.
+func (c *ComposeNetwork) Restart(ctx context.Context, service string) error {
+	_, err := c.compose(ctx, "restart", service)
+	return err
+}
+
+// Disconnect disconnects the container of the service from the compose network.
+func (c *ComposeNetwork) Disconnect(ctx context.Context, service string) error {
+	id, err := c.container(ctx, service)
+	if err != nil {
+		return err
+	}
+	_, err = run(ctx, "", "docker", "network", "disconnect", c.network, id)
+	return err
+}
+
+// Connect connects the container of the service to the compose network again, restoring the
+// service name other containers resolve it by.
+func (c *ComposeNetwork) Connect(ctx context.Context, service string) error {
+	id, err := c.container(ctx, service)
+	if err != nil {
+		return err
+	}
+	_, err = run(ctx, "", "docker", "network", "connect", "--alias", service, c.network, id)
+	return err
+}
+
+func (c *ComposeNetwork) container(ctx context.Context, service string) (string, error) {
+	out, err := c.compose(ctx, "ps", "-q", service)
+	if err != nil {
+		return "", err
+	}
+	ids := strings.Fields(out)
+	if len(ids) != 1 {
+		return "", errors.Errorf("expected a single container for service %s, found %d", service, len(ids))
+	}
+	return ids[0], nil
+}
+
+func (c *ComposeNetwork) compose(ctx context.Context, args ...string) (string, error) {
+	return run(ctx, c.dir, "docker-compose", append([]string{"-p", c.project}, args...)...)
+}
+
+func run(ctx context.Context, dir, name string, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Dir = dir
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", errors.Wrapf(err, "%s %s failed: %s", name, strings.Join(args, " "), strings.TrimSpace(stderr.String()))
+	}
+	return stdout.String(), nil
+}
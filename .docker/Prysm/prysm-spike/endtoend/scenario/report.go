@@ -0,0 +1,34 @@
+package scenario
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+)
+
+// Report is the machine-readable outcome of a scenario run.
+type Report struct {
+	Scenario string        `json:"scenario"`
+	Passed   bool          `json:"passed"`
+	Started  time.Time     `json:"started"`
+	Finished time.Time     `json:"finished"`
+	Steps    []*StepResult `json:"steps"`
+}
+
+// StepResult is the outcome of a single step.
+type StepResult struct {
+	Index      int                    `json:"index"`
+	Action     string                 `json:"action"`
+	Passed     bool                   `json:"passed"`
+	Skipped    bool                   `json:"skipped,omitempty"`
+	Error      string                 `json:"error,omitempty"`
+	DurationMs int64                  `json:"durationMs"`
+	Details    map[string]interface{} `json:"details,omitempty"`
+}
+
+// Write encodes the report as indented JSON.
+func (r *Report) Write(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
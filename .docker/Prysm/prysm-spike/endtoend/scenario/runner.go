@@ -0,0 +1,295 @@
+package scenario
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+)
+
+var log = logrus.WithField("prefix", "scenario")
+
+// BeaconClient is the subset of the beacon chain API scenarios assert with.
+type BeaconClient interface {
+	GetChainHead(ctx context.Context, in *ptypes.Empty, opts ...grpc.CallOption) (*ethpb.ChainHead, error)
+	GetValidatorParticipation(ctx context.Context, in *ethpb.GetValidatorParticipationRequest, opts ...grpc.CallOption) (*ethpb.ValidatorParticipationResponse, error)
+}
+
+// Config for a scenario runner.
+type Config struct {
+	Network      Network
+	Beacons      map[string]BeaconClient // Beacon nodes by name.
+	PollInterval time.Duration           // Defaults to the slot duration.
+}
+
+// Runner runs scenarios against a network.
+type Runner struct {
+	network      Network
+	beacons      map[string]BeaconClient
+	beaconNames  []string
+	pollInterval time.Duration
+}
+
+// NewRunner creates a runner from the config.
+func NewRunner(cfg *Config) (*Runner, error) {
+	if len(cfg.Beacons) == 0 {
+		return nil, errors.New("no beacon nodes configured")
+	}
+	names := make([]string, 0, len(cfg.Beacons))
+	for name := range cfg.Beacons {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	pollInterval := cfg.PollInterval
+	if pollInterval == 0 {
+		pollInterval = time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	}
+	return &Runner{
+		network:      cfg.Network,
+		beacons:      cfg.Beacons,
+		beaconNames:  names,
+		pollInterval: pollInterval,
+	}, nil
+}
+
+// Run runs the steps of the scenario in order. Once a step failed, the following steps are
+// skipped unless they are marked to always run.
+func (r *Runner) Run(ctx context.Context, s *Scenario) *Report {
+	report := &Report{Scenario: s.Name, Passed: true, Started: timeutils.Now()}
+	for i, step := range s.Steps {
+		res := &StepResult{Index: i, Action: step.Action, Details: make(map[string]interface{})}
+		report.Steps = append(report.Steps, res)
+		if !report.Passed && !step.Always {
+			res.Skipped = true
+			continue
+		}
+
+		log.WithFields(logrus.Fields{"step": i, "action": step.Action}).Info("Running step")
+		start := timeutils.Now()
+		stepCtx, cancel := context.WithTimeout(ctx, step.timeout)
+		err := r.runStep(stepCtx, step, res.Details)
+		cancel()
+		res.DurationMs = timeutils.Since(start).Milliseconds()
+		if err != nil {
+			log.WithError(err).WithFields(logrus.Fields{"step": i, "action": step.Action}).Error("Step failed")
+			res.Error = err.Error()
+			report.Passed = false
+			continue
+		}
+		res.Passed = true
+	}
+	report.Finished = timeutils.Now()
+	return report
+}
+
+func (r *Runner) runStep(ctx context.Context, step *Step, details map[string]interface{}) error {
+	beacons, err := r.stepBeacons(step)
+	if err != nil {
+		return err
+	}
+	switch step.Action {
+	case WaitForGenesis:
+		return r.waitForGenesis(ctx, beacons, details)
+	case WaitEpochs:
+		return r.waitEpochs(ctx, beacons[0], step.Epochs, details)
+	case AssertParticipation:
+		return r.assertParticipation(ctx, beacons[0], step.Epochs, step.MinRate, details)
+	case Restart:
+		return r.forServices(ctx, step.Services, r.network.Restart)
+	case Partition:
+		return r.forServices(ctx, step.Services, r.network.Disconnect)
+	case Heal:
+		return r.forServices(ctx, step.Services, r.network.Connect)
+	case AssertFork:
+		return r.assertFork(ctx, beacons, details)
+	case AssertRecovered:
+		return r.assertRecovered(ctx, beacons, step.RequireFinality, details)
+	}
+	return fmt.Errorf("unknown action %q", step.Action)
+}
+
+// stepBeacons returns the names of the beacon nodes a step queries.
+func (r *Runner) stepBeacons(step *Step) ([]string, error) {
+	if len(step.Beacons) == 0 {
+		return r.beaconNames, nil
+	}
+	for _, name := range step.Beacons {
+		if _, ok := r.beacons[name]; !ok {
+			return nil, fmt.Errorf("unknown beacon node %q", name)
+		}
+	}
+	return step.Beacons, nil
+}
+
+func (r *Runner) forServices(ctx context.Context, services []string, action func(context.Context, string) error) error {
+	if r.network == nil {
+		return errors.New("no network configured")
+	}
+	for _, service := range services {
+		if err := action(ctx, service); err != nil {
+			return errors.Wrapf(err, "service %s", service)
+		}
+	}
+	return nil
+}
+
+func (r *Runner) waitForGenesis(ctx context.Context, beacons []string, details map[string]interface{}) error {
+	return r.poll(ctx, func(ctx context.Context) error {
+		for _, name := range beacons {
+			head, err := r.beacons[name].GetChainHead(ctx, &ptypes.Empty{})
+			if err != nil {
+				return errors.Wrapf(err, "could not get chain head of %s", name)
+			}
+			if head.HeadSlot == 0 {
+				return fmt.Errorf("%s has no block past genesis", name)
+			}
+			details[name+"_head_slot"] = head.HeadSlot
+		}
+		return nil
+	})
+}
+
+func (r *Runner) waitEpochs(ctx context.Context, beacon string, epochs uint64, details map[string]interface{}) error {
+	head, err := r.beacons[beacon].GetChainHead(ctx, &ptypes.Empty{})
+	if err != nil {
+		return errors.Wrap(err, "could not get chain head")
+	}
+	target := head.HeadEpoch + epochs
+	details["target_epoch"] = target
+	return r.poll(ctx, func(ctx context.Context) error {
+		head, err := r.beacons[beacon].GetChainHead(ctx, &ptypes.Empty{})
+		if err != nil {
+			return errors.Wrap(err, "could not get chain head")
+		}
+		if head.HeadEpoch < target {
+			return fmt.Errorf("head epoch %d did not reach epoch %d", head.HeadEpoch, target)
+		}
+		return nil
+	})
+}
+
+// assertParticipation checks the target participation of the epochs before the previous epoch,
+// whose attestations are all included by the head.
+func (r *Runner) assertParticipation(ctx context.Context, beacon string, epochs uint64, minRate float64, details map[string]interface{}) error {
+	client := r.beacons[beacon]
+	head, err := client.GetChainHead(ctx, &ptypes.Empty{})
+	if err != nil {
+		return errors.Wrap(err, "could not get chain head")
+	}
+	if head.HeadEpoch <= epochs {
+		return fmt.Errorf("head epoch %d is too early to assert the participation of %d epochs", head.HeadEpoch, epochs)
+	}
+	// The participation response of an epoch reports the participation of the epoch before.
+	for epoch := head.HeadEpoch - epochs; epoch < head.HeadEpoch; epoch++ {
+		resp, err := client.GetValidatorParticipation(ctx, &ethpb.GetValidatorParticipationRequest{
+			QueryFilter: &ethpb.GetValidatorParticipationRequest_Epoch{Epoch: epoch},
+		})
+		if err != nil {
+			return errors.Wrapf(err, "could not get participation of epoch %d", epoch-1)
+		}
+		p := resp.Participation
+		if p == nil || p.PreviousEpochActiveGwei == 0 {
+			return fmt.Errorf("no active balance in epoch %d", epoch-1)
+		}
+		rate := float64(p.PreviousEpochTargetAttestingGwei) / float64(p.PreviousEpochActiveGwei)
+		details[fmt.Sprintf("epoch_%d_participation", epoch-1)] = rate
+		if rate < minRate {
+			return fmt.Errorf("participation of epoch %d is %.2f, below %.2f", epoch-1, rate, minRate)
+		}
+	}
+	return nil
+}
+
+func (r *Runner) assertFork(ctx context.Context, beacons []string, details map[string]interface{}) error {
+	if len(beacons) < 2 {
+		return errors.New("asserting a fork requires at least 2 beacon nodes")
+	}
+	heads, err := r.heads(ctx, beacons, details)
+	if err != nil {
+		return err
+	}
+	if sameHeads(heads) {
+		return fmt.Errorf("beacon nodes agree on head %#x", heads[0].HeadBlockRoot)
+	}
+	return nil
+}
+
+// assertRecovered waits for the beacon nodes to agree on the head, and with requireFinality for
+// every node to finalize an epoch past the one it had finalized when the step started.
+func (r *Runner) assertRecovered(ctx context.Context, beacons []string, requireFinality bool, details map[string]interface{}) error {
+	initial, err := r.heads(ctx, beacons, nil)
+	if err != nil {
+		return err
+	}
+	return r.poll(ctx, func(ctx context.Context) error {
+		heads, err := r.heads(ctx, beacons, details)
+		if err != nil {
+			return err
+		}
+		if !sameHeads(heads) {
+			return errors.New("beacon nodes disagree on head")
+		}
+		if !requireFinality {
+			return nil
+		}
+		for i, head := range heads {
+			if head.FinalizedEpoch <= initial[i].FinalizedEpoch {
+				return fmt.Errorf("%s did not finalize past epoch %d", beacons[i], initial[i].FinalizedEpoch)
+			}
+		}
+		return nil
+	})
+}
+
+// heads returns the chain heads of the beacon nodes, recording them in the details if any.
+func (r *Runner) heads(ctx context.Context, beacons []string, details map[string]interface{}) ([]*ethpb.ChainHead, error) {
+	heads := make([]*ethpb.ChainHead, len(beacons))
+	for i, name := range beacons {
+		head, err := r.beacons[name].GetChainHead(ctx, &ptypes.Empty{})
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get chain head of %s", name)
+		}
+		heads[i] = head
+		if details != nil {
+			details[name+"_head"] = fmt.Sprintf("%#x", head.HeadBlockRoot)
+			details[name+"_head_slot"] = head.HeadSlot
+			details[name+"_finalized_epoch"] = head.FinalizedEpoch
+		}
+	}
+	return heads, nil
+}
+
+func sameHeads(heads []*ethpb.ChainHead) bool {
+	for _, head := range heads[1:] {
+		if string(head.HeadBlockRoot) != string(heads[0].HeadBlockRoot) {
+			return false
+		}
+	}
+	return true
+}
+
+// poll runs the check every poll interval until it succeeds, returning the last failure of the
+// check once the context is done.
+func (r *Runner) poll(ctx context.Context, check func(context.Context) error) error {
+	ticker := time.NewTicker(r.pollInterval)
+	defer ticker.Stop()
+	for {
+		err := check(ctx)
+		if err == nil {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return errors.Wrap(err, "timed out")
+		case <-ticker.C:
+		}
+	}
+}
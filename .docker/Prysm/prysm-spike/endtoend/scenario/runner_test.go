@@ -0,0 +1,192 @@
+package scenario
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+)
+
+// fakeBeacon serves the chain head set by the test, advancing the head epoch on every call if
+// advance is set.
+type fakeBeacon struct {
+	lock          sync.Mutex
+	head          *ethpb.ChainHead
+	advance       bool
+	participation map[uint64]float64
+	err           error
+}
+
+func (f *fakeBeacon) GetChainHead(_ context.Context, _ *ptypes.Empty, _ ...grpc.CallOption) (*ethpb.ChainHead, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if f.err != nil {
+		return nil, f.err
+	}
+	head := *f.head
+	if f.advance {
+		f.head.HeadEpoch++
+		f.head.FinalizedEpoch++
+	}
+	return &head, nil
+}
+
+func (f *fakeBeacon) GetValidatorParticipation(_ context.Context, req *ethpb.GetValidatorParticipationRequest, _ ...grpc.CallOption) (*ethpb.ValidatorParticipationResponse, error) {
+	epoch := req.QueryFilter.(*ethpb.GetValidatorParticipationRequest_Epoch).Epoch
+	return &ethpb.ValidatorParticipationResponse{
+		Epoch: epoch,
+		Participation: &ethpb.ValidatorParticipation{
+			PreviousEpochActiveGwei:          100,
+			PreviousEpochTargetAttestingGwei: uint64(f.participation[epoch-1] * 100),
+		},
+	}, nil
+}
+
+func (f *fakeBeacon) setHead(head *ethpb.ChainHead, advance bool) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.head = head
+	f.advance = advance
+}
+
+type fakeNetwork struct {
+	calls     []string
+	onConnect func()
+}
+
+func (f *fakeNetwork) Restart(_ context.Context, service string) error {
+	f.calls = append(f.calls, "restart "+service)
+	return nil
+}
+
+func (f *fakeNetwork) Disconnect(_ context.Context, service string) error {
+	f.calls = append(f.calls, "disconnect "+service)
+	return nil
+}
+
+func (f *fakeNetwork) Connect(_ context.Context, service string) error {
+	f.calls = append(f.calls, "connect "+service)
+	if f.onConnect != nil {
+		f.onConnect()
+	}
+	return nil
+}
+
+func newTestRunner(t *testing.T, network Network, beacons map[string]BeaconClient) *Runner {
+	r, err := NewRunner(&Config{Network: network, Beacons: beacons, PollInterval: time.Millisecond})
+	require.NoError(t, err)
+	return r
+}
+
+func TestRunner_PartitionScenario(t *testing.T) {
+	s, err := Parse([]byte(`
+name: partition
+steps:
+  - action: wait_for_genesis
+  - action: partition
+    services: [beacon-2]
+  - action: assert_fork
+  - action: heal
+    services: [beacon-2]
+  - action: assert_recovered
+    require_finality: true
+    timeout: 1s
+`))
+	require.NoError(t, err)
+	b1 := &fakeBeacon{head: &ethpb.ChainHead{HeadSlot: 40, HeadBlockRoot: []byte{1}}}
+	b2 := &fakeBeacon{head: &ethpb.ChainHead{HeadSlot: 40, HeadBlockRoot: []byte{2}}}
+	// The beacon nodes converge and finalize once healed.
+	network := &fakeNetwork{onConnect: func() {
+		b1.setHead(&ethpb.ChainHead{HeadSlot: 40, HeadBlockRoot: []byte{1}}, true)
+		b2.setHead(&ethpb.ChainHead{HeadSlot: 40, HeadBlockRoot: []byte{1}}, true)
+	}}
+	r := newTestRunner(t, network, map[string]BeaconClient{"beacon-1": b1, "beacon-2": b2})
+	report := r.Run(context.Background(), s)
+
+	for _, step := range report.Steps {
+		assert.Equal(t, true, step.Passed, "Step %d failed: %s", step.Index, step.Error)
+	}
+	assert.Equal(t, true, report.Passed)
+	assert.DeepEqual(t, []string{"disconnect beacon-2", "connect beacon-2"}, network.calls)
+}
+
+func TestRunner_SkipsStepsAfterFailure(t *testing.T) {
+	s, err := Parse([]byte(`
+name: participation
+steps:
+  - action: assert_participation
+    min_rate: 0.9
+    epochs: 2
+  - action: restart
+    services: [validator]
+  - action: heal
+    services: [beacon]
+    always: true
+`))
+	require.NoError(t, err)
+	beacon := &fakeBeacon{
+		head:          &ethpb.ChainHead{HeadEpoch: 5},
+		participation: map[uint64]float64{2: 0.95, 3: 0.5},
+	}
+	network := &fakeNetwork{}
+	report := newTestRunner(t, network, map[string]BeaconClient{"beacon": beacon}).Run(context.Background(), s)
+
+	assert.Equal(t, false, report.Passed)
+	assert.Equal(t, "participation of epoch 3 is 0.50, below 0.90", report.Steps[0].Error)
+	assert.Equal(t, 0.95, report.Steps[0].Details["epoch_2_participation"])
+	assert.Equal(t, true, report.Steps[1].Skipped)
+	assert.Equal(t, true, report.Steps[2].Passed)
+	assert.DeepEqual(t, []string{"connect beacon"}, network.calls)
+
+	var buf bytes.Buffer
+	require.NoError(t, report.Write(&buf))
+	decoded := &Report{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), decoded))
+	assert.Equal(t, "participation", decoded.Scenario)
+	assert.Equal(t, 3, len(decoded.Steps))
+}
+
+func TestRunner_StepTimesOut(t *testing.T) {
+	s, err := Parse([]byte(`
+name: genesis
+steps:
+  - action: wait_for_genesis
+    timeout: 20ms
+`))
+	require.NoError(t, err)
+	beacon := &fakeBeacon{err: errors.New("connection refused")}
+	report := newTestRunner(t, nil, map[string]BeaconClient{"beacon": beacon}).Run(context.Background(), s)
+
+	assert.Equal(t, false, report.Passed)
+	assert.ErrorContains(t, "connection refused", errors.New(report.Steps[0].Error))
+}
+
+func TestParse_Invalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		scenario string
+		err      string
+	}{
+		{name: "no steps", scenario: "name: x", err: "no steps"},
+		{name: "unknown action", scenario: "name: x\nsteps:\n  - action: explode", err: "unknown action"},
+		{name: "unknown field", scenario: "name: x\nsteps:\n  - action: restart\n    service: [a]", err: "could not parse"},
+		{name: "missing services", scenario: "name: x\nsteps:\n  - action: partition", err: "requires services"},
+		{name: "bad rate", scenario: "name: x\nsteps:\n  - action: assert_participation\n    min_rate: 2", err: "min_rate"},
+		{name: "bad timeout", scenario: "name: x\nsteps:\n  - action: assert_fork\n    timeout: soon", err: "invalid timeout"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse([]byte(tt.scenario))
+			assert.ErrorContains(t, tt.err, err)
+		})
+	}
+}
@@ -0,0 +1,127 @@
+// Package scenario drives cross-service end-to-end scenarios against a running docker-compose
+// network, such as restarting validators or partitioning beacon nodes, and asserts the network
+// behaves as expected using the beacon node API. Each run produces a machine-readable report.
+package scenario
+
+import (
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// Actions supported in scenario steps.
+const (
+	// WaitForGenesis waits until the beacon nodes serve a chain head past genesis.
+	WaitForGenesis = "wait_for_genesis"
+	// WaitEpochs waits for a number of epochs to pass.
+	WaitEpochs = "wait_epochs"
+	// AssertParticipation asserts the participation rate of the last completed epochs.
+	AssertParticipation = "assert_participation"
+	// Restart restarts the services.
+	Restart = "restart"
+	// Partition disconnects the services from the network.
+	Partition = "partition"
+	// Heal reconnects partitioned services to the network.
+	Heal = "heal"
+	// AssertFork asserts the beacon nodes have diverging heads.
+	AssertFork = "assert_fork"
+	// AssertRecovered waits for the beacon nodes to agree on the head again.
+	AssertRecovered = "assert_recovered"
+)
+
+// defaultStepTimeout bounds the steps which wait for the network without a timeout.
+const defaultStepTimeout = 10 * time.Minute
+
+// Scenario is a named sequence of steps run against the network.
+type Scenario struct {
+	Name  string  `yaml:"name"`
+	Steps []*Step `yaml:"steps"`
+}
+
+// Step is a single action of a scenario. The fields used depend on the action.
+type Step struct {
+	Action   string   `yaml:"action"`
+	Services []string `yaml:"services"` // Compose services acted upon by restart, partition and heal.
+	Beacons  []string `yaml:"beacons"`  // Beacon nodes queried by assertions, all of them if empty.
+	Epochs   uint64   `yaml:"epochs"`
+	MinRate  float64  `yaml:"min_rate"`
+	// RequireFinality makes assert_recovered also wait for the finalized epoch to advance.
+	RequireFinality bool `yaml:"require_finality"`
+	// Timeout of the step as a duration string, such as 5m.
+	Timeout string `yaml:"timeout"`
+	// Always runs the step even if a previous step failed, to restore the network.
+	Always bool `yaml:"always"`
+
+	timeout time.Duration
+}
+
+// Load reads a scenario from a YAML file and validates it.
+func Load(path string) (*Scenario, error) {
+	enc, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read scenario")
+	}
+	return Parse(enc)
+}
+
+// Parse decodes a YAML scenario and validates it.
+func Parse(enc []byte) (*Scenario, error) {
+	s := &Scenario{}
+	if err := yaml.UnmarshalStrict(enc, s); err != nil {
+		return nil, errors.Wrap(err, "could not parse scenario")
+	}
+	if err := s.validate(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *Scenario) validate() error {
+	if s.Name == "" {
+		return errors.New("scenario has no name")
+	}
+	if len(s.Steps) == 0 {
+		return errors.New("scenario has no steps")
+	}
+	for i, step := range s.Steps {
+		if err := step.validate(); err != nil {
+			return errors.Wrapf(err, "invalid step %d", i)
+		}
+	}
+	return nil
+}
+
+func (s *Step) validate() error {
+	switch s.Action {
+	case WaitForGenesis, AssertFork, AssertRecovered:
+	case WaitEpochs:
+		if s.Epochs == 0 {
+			return errors.New("wait_epochs requires epochs")
+		}
+	case AssertParticipation:
+		if s.MinRate <= 0 || s.MinRate > 1 {
+			return errors.New("assert_participation requires a min_rate in (0, 1]")
+		}
+		if s.Epochs == 0 {
+			s.Epochs = 1
+		}
+	case Restart, Partition, Heal:
+		if len(s.Services) == 0 {
+			return fmt.Errorf("%s requires services", s.Action)
+		}
+	default:
+		return fmt.Errorf("unknown action %q", s.Action)
+	}
+	s.timeout = defaultStepTimeout
+	if s.Timeout != "" {
+		timeout, err := time.ParseDuration(s.Timeout)
+		if err != nil {
+			return errors.Wrap(err, "invalid timeout")
+		}
+		s.timeout = timeout
+	}
+	return nil
+}
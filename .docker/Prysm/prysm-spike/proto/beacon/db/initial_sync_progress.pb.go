@@ -0,0 +1,470 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proto/beacon/db/initial_sync_progress.proto
+
+package db
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// InitialSyncProgress is the position of the initial sync, saved as batches of blocks are
+// processed so a restarted node resumes the sync where it stopped.
+type InitialSyncProgress struct {
+	// Slot of the first block of the last processed batch.
+	BatchStartSlot uint64 `protobuf:"varint,1,opt,name=batch_start_slot,json=batchStartSlot,proto3" json:"batch_start_slot,omitempty"`
+	// Slot of the head block once the last batch was processed.
+	ProcessedSlot uint64 `protobuf:"varint,2,opt,name=processed_slot,json=processedSlot,proto3" json:"processed_slot,omitempty"`
+	// Root of the head block once the last batch was processed. The progress is only
+	// resumed while it is still the head.
+	ProcessedRoot []byte `protobuf:"bytes,3,opt,name=processed_root,json=processedRoot,proto3" json:"processed_root,omitempty"`
+	// Peers which served the most recent batches, most recent first.
+	Peers                []string `protobuf:"bytes,4,rep,name=peers,proto3" json:"peers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InitialSyncProgress) Reset()         { *m = InitialSyncProgress{} }
+func (m *InitialSyncProgress) String() string { return proto.CompactTextString(m) }
+func (*InitialSyncProgress) ProtoMessage()    {}
+func (*InitialSyncProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c66491fe6c6c358d, []int{0}
+}
+func (m *InitialSyncProgress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InitialSyncProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InitialSyncProgress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InitialSyncProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InitialSyncProgress.Merge(m, src)
+}
+func (m *InitialSyncProgress) XXX_Size() int {
+	return m.Size()
+}
+func (m *InitialSyncProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_InitialSyncProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InitialSyncProgress proto.InternalMessageInfo
+
+func (m *InitialSyncProgress) GetBatchStartSlot() uint64 {
+	if m != nil {
+		return m.BatchStartSlot
+	}
+	return 0
+}
+
+func (m *InitialSyncProgress) GetProcessedSlot() uint64 {
+	if m != nil {
+		return m.ProcessedSlot
+	}
+	return 0
+}
+
+func (m *InitialSyncProgress) GetProcessedRoot() []byte {
+	if m != nil {
+		return m.ProcessedRoot
+	}
+	return nil
+}
+
+func (m *InitialSyncProgress) GetPeers() []string {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*InitialSyncProgress)(nil), "prysm.beacon.db.InitialSyncProgress")
+}
+
+func init() {
+	proto.RegisterFile("proto/beacon/db/initial_sync_progress.proto", fileDescriptor_c66491fe6c6c358d)
+}
+
+var fileDescriptor_c66491fe6c6c358d = []byte{
+	// 228 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0xd0, 0xb1, 0x4a, 0xc5, 0x30,
+	0x14, 0xc6, 0x71, 0xe2, 0xbd, 0x0a, 0x06, 0xbd, 0x4a, 0x75, 0xe8, 0x54, 0x8a, 0x20, 0x14, 0x84,
+	0x64, 0x70, 0x75, 0x72, 0x73, 0x93, 0x76, 0x73, 0x29, 0x49, 0x1a, 0xee, 0x0d, 0xf4, 0xf6, 0x84,
+	0x73, 0x8e, 0x43, 0x9f, 0xc7, 0x97, 0x71, 0xf4, 0x11, 0xa4, 0x4f, 0x22, 0x26, 0x22, 0xe2, 0x1d,
+	0xbf, 0x3f, 0xbf, 0x0c, 0x39, 0xf2, 0x2e, 0x22, 0x30, 0x68, 0xeb, 0x8d, 0x83, 0x49, 0x0f, 0x56,
+	0x87, 0x29, 0x70, 0x30, 0x63, 0x4f, 0xf3, 0xe4, 0xfa, 0x88, 0xb0, 0x45, 0x4f, 0xa4, 0x92, 0x2a,
+	0x2e, 0x22, 0xce, 0xb4, 0x57, 0x19, 0xab, 0xc1, 0xde, 0xbc, 0x09, 0x79, 0xf5, 0x94, 0x1f, 0x74,
+	0xf3, 0xe4, 0x9e, 0x7f, 0x78, 0xd1, 0xc8, 0x4b, 0x6b, 0xd8, 0xed, 0x7a, 0x62, 0x83, 0xdc, 0xd3,
+	0x08, 0x5c, 0x8a, 0x5a, 0x34, 0xeb, 0x76, 0x93, 0x7a, 0xf7, 0x9d, 0xbb, 0x11, 0xb8, 0xb8, 0x95,
+	0x9b, 0x88, 0xe0, 0x3c, 0x91, 0x1f, 0xb2, 0x3b, 0x4a, 0xee, 0xfc, 0xb7, 0x1e, 0x32, 0x04, 0xe0,
+	0x72, 0x55, 0x8b, 0xe6, 0xec, 0x0f, 0x6b, 0x01, 0xb8, 0xb8, 0x96, 0xc7, 0xd1, 0x7b, 0xa4, 0x72,
+	0x5d, 0xaf, 0x9a, 0xd3, 0x36, 0x8f, 0xc7, 0x87, 0xf7, 0xa5, 0x12, 0x1f, 0x4b, 0x25, 0x3e, 0x97,
+	0x4a, 0xbc, 0xa8, 0x6d, 0xe0, 0xdd, 0xab, 0x55, 0x0e, 0xf6, 0x3a, 0xfd, 0xc7, 0x70, 0x70, 0xa3,
+	0xb1, 0x94, 0x97, 0xfe, 0x77, 0x10, 0x7b, 0x92, 0xc2, 0xfd, 0xd7, 0x00, 0x65, 0xed, 0x54, 0x64,
+	0x2a, 0x01, 0x00, 0x00,
+}
+
+func (m *InitialSyncProgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InitialSyncProgress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InitialSyncProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Peers) > 0 {
+		for iNdEx := len(m.Peers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Peers[iNdEx])
+			copy(dAtA[i:], m.Peers[iNdEx])
+			i = encodeVarintInitialSyncProgress(dAtA, i, uint64(len(m.Peers[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ProcessedRoot) > 0 {
+		i -= len(m.ProcessedRoot)
+		copy(dAtA[i:], m.ProcessedRoot)
+		i = encodeVarintInitialSyncProgress(dAtA, i, uint64(len(m.ProcessedRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ProcessedSlot != 0 {
+		i = encodeVarintInitialSyncProgress(dAtA, i, uint64(m.ProcessedSlot))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BatchStartSlot != 0 {
+		i = encodeVarintInitialSyncProgress(dAtA, i, uint64(m.BatchStartSlot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintInitialSyncProgress(dAtA []byte, offset int, v uint64) int {
+	offset -= sovInitialSyncProgress(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InitialSyncProgress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BatchStartSlot != 0 {
+		n += 1 + sovInitialSyncProgress(uint64(m.BatchStartSlot))
+	}
+	if m.ProcessedSlot != 0 {
+		n += 1 + sovInitialSyncProgress(uint64(m.ProcessedSlot))
+	}
+	l = len(m.ProcessedRoot)
+	if l > 0 {
+		n += 1 + l + sovInitialSyncProgress(uint64(l))
+	}
+	if len(m.Peers) > 0 {
+		for _, s := range m.Peers {
+			l = len(s)
+			n += 1 + l + sovInitialSyncProgress(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovInitialSyncProgress(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozInitialSyncProgress(x uint64) (n int) {
+	return sovInitialSyncProgress(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InitialSyncProgress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInitialSyncProgress
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InitialSyncProgress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InitialSyncProgress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchStartSlot", wireType)
+			}
+			m.BatchStartSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInitialSyncProgress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchStartSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessedSlot", wireType)
+			}
+			m.ProcessedSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInitialSyncProgress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProcessedSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessedRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInitialSyncProgress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthInitialSyncProgress
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInitialSyncProgress
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessedRoot = append(m.ProcessedRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProcessedRoot == nil {
+				m.ProcessedRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Peers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInitialSyncProgress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInitialSyncProgress
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInitialSyncProgress
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Peers = append(m.Peers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInitialSyncProgress(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInitialSyncProgress
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthInitialSyncProgress
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipInitialSyncProgress(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowInitialSyncProgress
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInitialSyncProgress
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInitialSyncProgress
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthInitialSyncProgress
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupInitialSyncProgress
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthInitialSyncProgress
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthInitialSyncProgress        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowInitialSyncProgress          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupInitialSyncProgress = fmt.Errorf("proto: unexpected end of group")
+)
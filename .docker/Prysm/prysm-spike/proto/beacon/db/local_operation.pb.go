@@ -0,0 +1,682 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proto/beacon/db/local_operation.proto
+
+package db
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	proto "github.com/gogo/protobuf/proto"
+	v1alpha1 "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// LocalOperation is a voluntary exit or slashing submitted to this beacon node, which is
+// kept until it is included in a block or expires so it can be rebroadcast after restarts.
+type LocalOperation struct {
+	// Types that are valid to be assigned to Operation:
+	//	*LocalOperation_VoluntaryExit
+	//	*LocalOperation_ProposerSlashing
+	//	*LocalOperation_AttesterSlashing
+	Operation isLocalOperation_Operation `protobuf_oneof:"operation"`
+	// Epoch of the head state when the operation was submitted.
+	SubmittedEpoch uint64 `protobuf:"varint,4,opt,name=submitted_epoch,json=submittedEpoch,proto3" json:"submitted_epoch,omitempty"`
+	// Number of times the operation was broadcast, including the initial broadcast.
+	BroadcastAttempts uint64 `protobuf:"varint,5,opt,name=broadcast_attempts,json=broadcastAttempts,proto3" json:"broadcast_attempts,omitempty"`
+	// Epoch of the head state when the operation was last broadcast.
+	LastBroadcastEpoch   uint64   `protobuf:"varint,6,opt,name=last_broadcast_epoch,json=lastBroadcastEpoch,proto3" json:"last_broadcast_epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LocalOperation) Reset()         { *m = LocalOperation{} }
+func (m *LocalOperation) String() string { return proto.CompactTextString(m) }
+func (*LocalOperation) ProtoMessage()    {}
+func (*LocalOperation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16814e321525e02c, []int{0}
+}
+func (m *LocalOperation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LocalOperation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LocalOperation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LocalOperation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LocalOperation.Merge(m, src)
+}
+func (m *LocalOperation) XXX_Size() int {
+	return m.Size()
+}
+func (m *LocalOperation) XXX_DiscardUnknown() {
+	xxx_messageInfo_LocalOperation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LocalOperation proto.InternalMessageInfo
+
+type isLocalOperation_Operation interface {
+	isLocalOperation_Operation()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type LocalOperation_VoluntaryExit struct {
+	VoluntaryExit *v1alpha1.SignedVoluntaryExit `protobuf:"bytes,1,opt,name=voluntary_exit,json=voluntaryExit,proto3,oneof" json:"voluntary_exit,omitempty"`
+}
+type LocalOperation_ProposerSlashing struct {
+	ProposerSlashing *v1alpha1.ProposerSlashing `protobuf:"bytes,2,opt,name=proposer_slashing,json=proposerSlashing,proto3,oneof" json:"proposer_slashing,omitempty"`
+}
+type LocalOperation_AttesterSlashing struct {
+	AttesterSlashing *v1alpha1.AttesterSlashing `protobuf:"bytes,3,opt,name=attester_slashing,json=attesterSlashing,proto3,oneof" json:"attester_slashing,omitempty"`
+}
+
+func (*LocalOperation_VoluntaryExit) isLocalOperation_Operation()    {}
+func (*LocalOperation_ProposerSlashing) isLocalOperation_Operation() {}
+func (*LocalOperation_AttesterSlashing) isLocalOperation_Operation() {}
+
+func (m *LocalOperation) GetOperation() isLocalOperation_Operation {
+	if m != nil {
+		return m.Operation
+	}
+	return nil
+}
+
+func (m *LocalOperation) GetVoluntaryExit() *v1alpha1.SignedVoluntaryExit {
+	if x, ok := m.GetOperation().(*LocalOperation_VoluntaryExit); ok {
+		return x.VoluntaryExit
+	}
+	return nil
+}
+
+func (m *LocalOperation) GetProposerSlashing() *v1alpha1.ProposerSlashing {
+	if x, ok := m.GetOperation().(*LocalOperation_ProposerSlashing); ok {
+		return x.ProposerSlashing
+	}
+	return nil
+}
+
+func (m *LocalOperation) GetAttesterSlashing() *v1alpha1.AttesterSlashing {
+	if x, ok := m.GetOperation().(*LocalOperation_AttesterSlashing); ok {
+		return x.AttesterSlashing
+	}
+	return nil
+}
+
+func (m *LocalOperation) GetSubmittedEpoch() uint64 {
+	if m != nil {
+		return m.SubmittedEpoch
+	}
+	return 0
+}
+
+func (m *LocalOperation) GetBroadcastAttempts() uint64 {
+	if m != nil {
+		return m.BroadcastAttempts
+	}
+	return 0
+}
+
+func (m *LocalOperation) GetLastBroadcastEpoch() uint64 {
+	if m != nil {
+		return m.LastBroadcastEpoch
+	}
+	return 0
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*LocalOperation) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*LocalOperation_VoluntaryExit)(nil),
+		(*LocalOperation_ProposerSlashing)(nil),
+		(*LocalOperation_AttesterSlashing)(nil),
+	}
+}
+
+func init() {
+	proto.RegisterType((*LocalOperation)(nil), "prysm.beacon.db.LocalOperation")
+}
+
+func init() {
+	proto.RegisterFile("proto/beacon/db/local_operation.proto", fileDescriptor_16814e321525e02c)
+}
+
+var fileDescriptor_16814e321525e02c = []byte{
+	// 350 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcd, 0x6a, 0xf2, 0x40,
+	0x18, 0x85, 0xcd, 0xa7, 0x9f, 0xd0, 0x91, 0x6a, 0x1d, 0xba, 0x08, 0x5d, 0x58, 0x29, 0x14, 0xa5,
+	0xd0, 0x49, 0x6d, 0xb7, 0xdd, 0x28, 0x08, 0x2e, 0x0a, 0x2d, 0x0a, 0x2e, 0xba, 0x09, 0x33, 0xc9,
+	0x60, 0x86, 0x4e, 0x32, 0xc3, 0xcc, 0x1b, 0xd1, 0x7b, 0xea, 0x85, 0x74, 0xd9, 0x4b, 0x28, 0x5e,
+	0x49, 0xc9, 0x9f, 0x15, 0xa1, 0xdd, 0x25, 0xe7, 0x3c, 0xe7, 0xc9, 0xe2, 0x0d, 0xba, 0xd6, 0x46,
+	0x81, 0xf2, 0x18, 0xa7, 0x81, 0x4a, 0xbc, 0x90, 0x79, 0x52, 0x05, 0x54, 0xfa, 0x4a, 0x73, 0x43,
+	0x41, 0xa8, 0x84, 0xe4, 0x3d, 0xee, 0x68, 0xb3, 0xb5, 0x31, 0x29, 0x30, 0x12, 0xb2, 0x8b, 0x4b,
+	0x0e, 0x91, 0xb7, 0x1e, 0x51, 0xa9, 0x23, 0x3a, 0x2a, 0xe7, 0x3e, 0x93, 0x2a, 0x78, 0x2b, 0x16,
+	0x57, 0xef, 0x75, 0xd4, 0x7e, 0xca, 0x5c, 0xcf, 0x95, 0x0a, 0x2f, 0x50, 0x7b, 0xad, 0x64, 0x9a,
+	0x00, 0x35, 0x5b, 0x9f, 0x6f, 0x04, 0xb8, 0x4e, 0xdf, 0x19, 0xb6, 0xee, 0x6f, 0x08, 0x87, 0x88,
+	0x1b, 0x9e, 0xc6, 0xd9, 0x03, 0xa9, 0xac, 0x64, 0x21, 0x56, 0x09, 0x0f, 0x97, 0xd5, 0x64, 0xba,
+	0x11, 0x30, 0xab, 0xcd, 0x4f, 0xd7, 0x87, 0x01, 0x5e, 0xa2, 0xae, 0x36, 0x4a, 0x2b, 0xcb, 0x8d,
+	0x6f, 0x25, 0xb5, 0x91, 0x48, 0x56, 0xee, 0xbf, 0xdc, 0x3b, 0xf8, 0xc5, 0xfb, 0x52, 0xf2, 0x8b,
+	0x12, 0x9f, 0xd5, 0xe6, 0x67, 0xfa, 0x28, 0xcb, 0xbc, 0x14, 0x80, 0x5b, 0x38, 0xf4, 0xd6, 0xff,
+	0xf4, 0x8e, 0x4b, 0xfe, 0xd0, 0x4b, 0x8f, 0x32, 0x3c, 0x40, 0x1d, 0x9b, 0xb2, 0x58, 0x00, 0xf0,
+	0xd0, 0xe7, 0x5a, 0x05, 0x91, 0xdb, 0xe8, 0x3b, 0xc3, 0xc6, 0xbc, 0xbd, 0x8f, 0xa7, 0x59, 0x8a,
+	0x6f, 0x11, 0x66, 0x46, 0xd1, 0x30, 0xa0, 0x16, 0xfc, 0x4c, 0x13, 0x6b, 0xb0, 0xee, 0xff, 0x9c,
+	0xed, 0xee, 0x9b, 0x71, 0x59, 0xe0, 0x3b, 0x74, 0x2e, 0x33, 0xf2, 0x67, 0x53, 0xc8, 0x9b, 0xf9,
+	0x00, 0x67, 0xdd, 0xa4, 0xaa, 0xf2, 0x0f, 0x4c, 0x5a, 0xe8, 0x64, 0x7f, 0xe6, 0xc9, 0xe3, 0xc7,
+	0xae, 0xe7, 0x7c, 0xee, 0x7a, 0xce, 0xd7, 0xae, 0xe7, 0xbc, 0x92, 0x95, 0x80, 0x28, 0x65, 0x24,
+	0x50, 0xb1, 0x97, 0x5f, 0x9e, 0x82, 0x08, 0x24, 0x65, 0xb6, 0x78, 0xf3, 0x8e, 0x7e, 0x1a, 0xd6,
+	0xcc, 0x83, 0x87, 0xef, 0x01, 0x00, 0x0f, 0xb4, 0x98, 0xbc, 0x4e, 0x02, 0x00, 0x00,
+}
+
+func (m *LocalOperation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LocalOperation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LocalOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.LastBroadcastEpoch != 0 {
+		i = encodeVarintLocalOperation(dAtA, i, uint64(m.LastBroadcastEpoch))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BroadcastAttempts != 0 {
+		i = encodeVarintLocalOperation(dAtA, i, uint64(m.BroadcastAttempts))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SubmittedEpoch != 0 {
+		i = encodeVarintLocalOperation(dAtA, i, uint64(m.SubmittedEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Operation != nil {
+		{
+			size := m.Operation.Size()
+			i -= size
+			if _, err := m.Operation.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LocalOperation_VoluntaryExit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LocalOperation_VoluntaryExit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.VoluntaryExit != nil {
+		{
+			size, err := m.VoluntaryExit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLocalOperation(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *LocalOperation_ProposerSlashing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LocalOperation_ProposerSlashing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ProposerSlashing != nil {
+		{
+			size, err := m.ProposerSlashing.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLocalOperation(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *LocalOperation_AttesterSlashing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LocalOperation_AttesterSlashing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.AttesterSlashing != nil {
+		{
+			size, err := m.AttesterSlashing.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLocalOperation(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func encodeVarintLocalOperation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLocalOperation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LocalOperation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Operation != nil {
+		n += m.Operation.Size()
+	}
+	if m.SubmittedEpoch != 0 {
+		n += 1 + sovLocalOperation(uint64(m.SubmittedEpoch))
+	}
+	if m.BroadcastAttempts != 0 {
+		n += 1 + sovLocalOperation(uint64(m.BroadcastAttempts))
+	}
+	if m.LastBroadcastEpoch != 0 {
+		n += 1 + sovLocalOperation(uint64(m.LastBroadcastEpoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LocalOperation_VoluntaryExit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VoluntaryExit != nil {
+		l = m.VoluntaryExit.Size()
+		n += 1 + l + sovLocalOperation(uint64(l))
+	}
+	return n
+}
+func (m *LocalOperation_ProposerSlashing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposerSlashing != nil {
+		l = m.ProposerSlashing.Size()
+		n += 1 + l + sovLocalOperation(uint64(l))
+	}
+	return n
+}
+func (m *LocalOperation_AttesterSlashing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AttesterSlashing != nil {
+		l = m.AttesterSlashing.Size()
+		n += 1 + l + sovLocalOperation(uint64(l))
+	}
+	return n
+}
+
+func sovLocalOperation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLocalOperation(x uint64) (n int) {
+	return sovLocalOperation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LocalOperation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLocalOperation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LocalOperation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LocalOperation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoluntaryExit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocalOperation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocalOperation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocalOperation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &v1alpha1.SignedVoluntaryExit{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Operation = &LocalOperation_VoluntaryExit{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerSlashing", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocalOperation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocalOperation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocalOperation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &v1alpha1.ProposerSlashing{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Operation = &LocalOperation_ProposerSlashing{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttesterSlashing", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocalOperation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocalOperation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocalOperation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &v1alpha1.AttesterSlashing{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Operation = &LocalOperation_AttesterSlashing{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmittedEpoch", wireType)
+			}
+			m.SubmittedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocalOperation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmittedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BroadcastAttempts", wireType)
+			}
+			m.BroadcastAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocalOperation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BroadcastAttempts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBroadcastEpoch", wireType)
+			}
+			m.LastBroadcastEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocalOperation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastBroadcastEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLocalOperation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLocalOperation
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthLocalOperation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipLocalOperation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowLocalOperation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLocalOperation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLocalOperation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthLocalOperation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupLocalOperation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthLocalOperation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthLocalOperation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowLocalOperation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupLocalOperation = fmt.Errorf("proto: unexpected end of group")
+)
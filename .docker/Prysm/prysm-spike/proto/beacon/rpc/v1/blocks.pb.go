@@ -0,0 +1,827 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proto/beacon/rpc/v1/blocks.proto
+
+package ethereum_beacon_rpc_v1
+
+import (
+	context "context"
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	proto "github.com/gogo/protobuf/proto"
+	v1alpha1 "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type BlockFilterRequest struct {
+	ProposerIndices      []uint64     `protobuf:"varint,1,rep,packed,name=proposer_indices,json=proposerIndices,proto3" json:"proposer_indices,omitempty"`
+	SlotRanges           []*SlotRange `protobuf:"bytes,2,rep,name=slot_ranges,json=slotRanges,proto3" json:"slot_ranges,omitempty"`
+	GraffitiPrefix       []byte       `protobuf:"bytes,3,opt,name=graffiti_prefix,json=graffitiPrefix,proto3" json:"graffiti_prefix,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *BlockFilterRequest) Reset()         { *m = BlockFilterRequest{} }
+func (m *BlockFilterRequest) String() string { return proto.CompactTextString(m) }
+func (*BlockFilterRequest) ProtoMessage()    {}
+func (*BlockFilterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7f826600694a5980, []int{0}
+}
+func (m *BlockFilterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockFilterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockFilterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockFilterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockFilterRequest.Merge(m, src)
+}
+func (m *BlockFilterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockFilterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockFilterRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockFilterRequest proto.InternalMessageInfo
+
+func (m *BlockFilterRequest) GetProposerIndices() []uint64 {
+	if m != nil {
+		return m.ProposerIndices
+	}
+	return nil
+}
+
+func (m *BlockFilterRequest) GetSlotRanges() []*SlotRange {
+	if m != nil {
+		return m.SlotRanges
+	}
+	return nil
+}
+
+func (m *BlockFilterRequest) GetGraffitiPrefix() []byte {
+	if m != nil {
+		return m.GraffitiPrefix
+	}
+	return nil
+}
+
+type SlotRange struct {
+	StartSlot            uint64   `protobuf:"varint,1,opt,name=start_slot,json=startSlot,proto3" json:"start_slot,omitempty"`
+	EndSlot              uint64   `protobuf:"varint,2,opt,name=end_slot,json=endSlot,proto3" json:"end_slot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SlotRange) Reset()         { *m = SlotRange{} }
+func (m *SlotRange) String() string { return proto.CompactTextString(m) }
+func (*SlotRange) ProtoMessage()    {}
+func (*SlotRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7f826600694a5980, []int{1}
+}
+func (m *SlotRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlotRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlotRange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlotRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlotRange.Merge(m, src)
+}
+func (m *SlotRange) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlotRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlotRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlotRange proto.InternalMessageInfo
+
+func (m *SlotRange) GetStartSlot() uint64 {
+	if m != nil {
+		return m.StartSlot
+	}
+	return 0
+}
+
+func (m *SlotRange) GetEndSlot() uint64 {
+	if m != nil {
+		return m.EndSlot
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*BlockFilterRequest)(nil), "ethereum.beacon.rpc.v1.BlockFilterRequest")
+	proto.RegisterType((*SlotRange)(nil), "ethereum.beacon.rpc.v1.SlotRange")
+}
+
+func init() { proto.RegisterFile("proto/beacon/rpc/v1/blocks.proto", fileDescriptor_7f826600694a5980) }
+
+var fileDescriptor_7f826600694a5980 = []byte{
+	// 307 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0x41, 0x4e, 0xc3, 0x30,
+	0x14, 0x44, 0x49, 0x5b, 0x01, 0x75, 0x11, 0x45, 0x16, 0x42, 0xa1, 0x12, 0x22, 0x74, 0x43, 0x60,
+	0xe1, 0x90, 0x72, 0x83, 0x4a, 0x20, 0xb1, 0x43, 0xe9, 0x01, 0x22, 0x37, 0xf9, 0x6d, 0x2c, 0xd2,
+	0xd8, 0x7c, 0xff, 0x56, 0x6c, 0xb8, 0x11, 0x87, 0x44, 0xb1, 0x09, 0x2c, 0x80, 0x5d, 0x32, 0x6f,
+	0x66, 0xe4, 0x3f, 0x2c, 0x32, 0xa8, 0x49, 0x27, 0x4b, 0x90, 0x85, 0x6e, 0x12, 0x34, 0x45, 0xb2,
+	0x4b, 0x93, 0x65, 0xad, 0x8b, 0x17, 0x2b, 0x1c, 0xe2, 0x67, 0x40, 0x15, 0x20, 0x6c, 0x37, 0xc2,
+	0x9b, 0x04, 0x9a, 0x42, 0xec, 0xd2, 0xc9, 0x25, 0x50, 0x95, 0xec, 0x52, 0x59, 0x9b, 0x4a, 0xa6,
+	0x5f, 0x05, 0xb9, 0x4b, 0xfa, 0xe0, 0xf4, 0x23, 0x60, 0x7c, 0xde, 0xfe, 0x3f, 0xaa, 0x9a, 0x00,
+	0x33, 0x78, 0xdd, 0x82, 0x25, 0x7e, 0xc3, 0x4e, 0x0c, 0x6a, 0xa3, 0x2d, 0x60, 0xae, 0x9a, 0x52,
+	0x15, 0x60, 0xc3, 0x20, 0xea, 0xc7, 0x83, 0x6c, 0xdc, 0xe9, 0x4f, 0x5e, 0xe6, 0x73, 0x36, 0xb2,
+	0xb5, 0xa6, 0x1c, 0x65, 0xb3, 0x06, 0x1b, 0xf6, 0xa2, 0x7e, 0x3c, 0x9a, 0x5d, 0x89, 0xbf, 0x1f,
+	0x24, 0x16, 0xb5, 0xa6, 0xac, 0x75, 0x66, 0xcc, 0x76, 0x9f, 0x96, 0x5f, 0xb3, 0xf1, 0x1a, 0xe5,
+	0x6a, 0xa5, 0x48, 0xe5, 0x06, 0x61, 0xa5, 0xde, 0xc2, 0x7e, 0x14, 0xc4, 0x47, 0xd9, 0x71, 0x27,
+	0x3f, 0x3b, 0x75, 0xfa, 0xc0, 0x86, 0xdf, 0x0d, 0xfc, 0x82, 0x31, 0x4b, 0x12, 0x29, 0x6f, 0x9b,
+	0xc2, 0x20, 0x0a, 0xe2, 0x41, 0x36, 0x74, 0x4a, 0xeb, 0xe1, 0xe7, 0xec, 0x10, 0x9a, 0xd2, 0xc3,
+	0x9e, 0x83, 0x07, 0xd0, 0x94, 0x2d, 0x9a, 0xbd, 0xb3, 0xa1, 0x3f, 0x1a, 0xa0, 0xe4, 0x86, 0x9d,
+	0x2e, 0x08, 0x41, 0x6e, 0xfc, 0x04, 0x50, 0x3a, 0x64, 0xf9, 0xed, 0x7f, 0x37, 0xfc, 0xde, 0x6b,
+	0x12, 0xff, 0x78, 0x81, 0x2a, 0xd1, 0x2d, 0x2e, 0x16, 0x6a, 0xdd, 0x40, 0x39, 0x77, 0x71, 0x17,
+	0x9b, 0xee, 0xdd, 0x05, 0xcb, 0x7d, 0xb7, 0xfd, 0xfd, 0xe7, 0x00, 0xf2, 0xd5, 0xc0, 0xdf, 0xd8,
+	0x01, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// BlockFeedClient is the client API for BlockFeed service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BlockFeedClient interface {
+	StreamFilteredBlocks(ctx context.Context, in *BlockFilterRequest, opts ...grpc.CallOption) (BlockFeed_StreamFilteredBlocksClient, error)
+}
+
+type blockFeedClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewBlockFeedClient(cc *grpc.ClientConn) BlockFeedClient {
+	return &blockFeedClient{cc}
+}
+
+func (c *blockFeedClient) StreamFilteredBlocks(ctx context.Context, in *BlockFilterRequest, opts ...grpc.CallOption) (BlockFeed_StreamFilteredBlocksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BlockFeed_serviceDesc.Streams[0], "/ethereum.beacon.rpc.v1.BlockFeed/StreamFilteredBlocks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &blockFeedStreamFilteredBlocksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BlockFeed_StreamFilteredBlocksClient interface {
+	Recv() (*v1alpha1.SignedBeaconBlock, error)
+	grpc.ClientStream
+}
+
+type blockFeedStreamFilteredBlocksClient struct {
+	grpc.ClientStream
+}
+
+func (x *blockFeedStreamFilteredBlocksClient) Recv() (*v1alpha1.SignedBeaconBlock, error) {
+	m := new(v1alpha1.SignedBeaconBlock)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// BlockFeedServer is the server API for BlockFeed service.
+type BlockFeedServer interface {
+	StreamFilteredBlocks(*BlockFilterRequest, BlockFeed_StreamFilteredBlocksServer) error
+}
+
+// UnimplementedBlockFeedServer can be embedded to have forward compatible implementations.
+type UnimplementedBlockFeedServer struct {
+}
+
+func (*UnimplementedBlockFeedServer) StreamFilteredBlocks(req *BlockFilterRequest, srv BlockFeed_StreamFilteredBlocksServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamFilteredBlocks not implemented")
+}
+
+func RegisterBlockFeedServer(s *grpc.Server, srv BlockFeedServer) {
+	s.RegisterService(&_BlockFeed_serviceDesc, srv)
+}
+
+func _BlockFeed_StreamFilteredBlocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BlockFilterRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BlockFeedServer).StreamFilteredBlocks(m, &blockFeedStreamFilteredBlocksServer{stream})
+}
+
+type BlockFeed_StreamFilteredBlocksServer interface {
+	Send(*v1alpha1.SignedBeaconBlock) error
+	grpc.ServerStream
+}
+
+type blockFeedStreamFilteredBlocksServer struct {
+	grpc.ServerStream
+}
+
+func (x *blockFeedStreamFilteredBlocksServer) Send(m *v1alpha1.SignedBeaconBlock) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _BlockFeed_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.BlockFeed",
+	HandlerType: (*BlockFeedServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamFilteredBlocks",
+			Handler:       _BlockFeed_StreamFilteredBlocks_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/beacon/rpc/v1/blocks.proto",
+}
+
+func (m *BlockFilterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockFilterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockFilterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.GraffitiPrefix) > 0 {
+		i -= len(m.GraffitiPrefix)
+		copy(dAtA[i:], m.GraffitiPrefix)
+		i = encodeVarintBlocks(dAtA, i, uint64(len(m.GraffitiPrefix)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SlotRanges) > 0 {
+		for iNdEx := len(m.SlotRanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlotRanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBlocks(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ProposerIndices) > 0 {
+		dAtA2 := make([]byte, len(m.ProposerIndices)*10)
+		var j1 int
+		for _, num := range m.ProposerIndices {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintBlocks(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SlotRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlotRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlotRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.EndSlot != 0 {
+		i = encodeVarintBlocks(dAtA, i, uint64(m.EndSlot))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartSlot != 0 {
+		i = encodeVarintBlocks(dAtA, i, uint64(m.StartSlot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBlocks(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBlocks(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BlockFilterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ProposerIndices) > 0 {
+		l = 0
+		for _, e := range m.ProposerIndices {
+			l += sovBlocks(uint64(e))
+		}
+		n += 1 + sovBlocks(uint64(l)) + l
+	}
+	if len(m.SlotRanges) > 0 {
+		for _, e := range m.SlotRanges {
+			l = e.Size()
+			n += 1 + l + sovBlocks(uint64(l))
+		}
+	}
+	l = len(m.GraffitiPrefix)
+	if l > 0 {
+		n += 1 + l + sovBlocks(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SlotRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartSlot != 0 {
+		n += 1 + sovBlocks(uint64(m.StartSlot))
+	}
+	if m.EndSlot != 0 {
+		n += 1 + sovBlocks(uint64(m.EndSlot))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovBlocks(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBlocks(x uint64) (n int) {
+	return sovBlocks(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BlockFilterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlocks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockFilterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockFilterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBlocks
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ProposerIndices = append(m.ProposerIndices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBlocks
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBlocks
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthBlocks
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ProposerIndices) == 0 {
+					m.ProposerIndices = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBlocks
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ProposerIndices = append(m.ProposerIndices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerIndices", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlotRanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlotRanges = append(m.SlotRanges, &SlotRange{})
+			if err := m.SlotRanges[len(m.SlotRanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GraffitiPrefix", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GraffitiPrefix = append(m.GraffitiPrefix[:0], dAtA[iNdEx:postIndex]...)
+			if m.GraffitiPrefix == nil {
+				m.GraffitiPrefix = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlocks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlotRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlocks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlotRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlotRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartSlot", wireType)
+			}
+			m.StartSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndSlot", wireType)
+			}
+			m.EndSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlocks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBlocks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBlocks(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBlocks
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBlocks
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBlocks
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBlocks
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBlocks
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBlocks
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBlocks        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBlocks          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBlocks = fmt.Errorf("proto: unexpected end of group")
+)
@@ -76,31 +76,179 @@ func (m *LogsEndpointResponse) GetBeaconLogsEndpoint() string {
 	return ""
 }
 
+type CapabilitiesResponse struct {
+	ApiVersion           uint64   `protobuf:"varint,1,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	Capabilities         []string `protobuf:"bytes,2,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
+	Version              string   `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CapabilitiesResponse) Reset()         { *m = CapabilitiesResponse{} }
+func (m *CapabilitiesResponse) String() string { return proto.CompactTextString(m) }
+func (*CapabilitiesResponse) ProtoMessage()    {}
+func (*CapabilitiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e4b7e98e3e10444, []int{1}
+}
+func (m *CapabilitiesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CapabilitiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CapabilitiesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CapabilitiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CapabilitiesResponse.Merge(m, src)
+}
+func (m *CapabilitiesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CapabilitiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CapabilitiesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CapabilitiesResponse proto.InternalMessageInfo
+
+func (m *CapabilitiesResponse) GetApiVersion() uint64 {
+	if m != nil {
+		return m.ApiVersion
+	}
+	return 0
+}
+
+func (m *CapabilitiesResponse) GetCapabilities() []string {
+	if m != nil {
+		return m.Capabilities
+	}
+	return nil
+}
+
+func (m *CapabilitiesResponse) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+type HeadEvent struct {
+	Slot                      uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	BlockRoot                 []byte   `protobuf:"bytes,2,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	PreviousDutyDependentRoot []byte   `protobuf:"bytes,3,opt,name=previous_duty_dependent_root,json=previousDutyDependentRoot,proto3" json:"previous_duty_dependent_root,omitempty"`
+	CurrentDutyDependentRoot  []byte   `protobuf:"bytes,4,opt,name=current_duty_dependent_root,json=currentDutyDependentRoot,proto3" json:"current_duty_dependent_root,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{} `json:"-"`
+	XXX_unrecognized          []byte   `json:"-"`
+	XXX_sizecache             int32    `json:"-"`
+}
+
+func (m *HeadEvent) Reset()         { *m = HeadEvent{} }
+func (m *HeadEvent) String() string { return proto.CompactTextString(m) }
+func (*HeadEvent) ProtoMessage()    {}
+func (*HeadEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e4b7e98e3e10444, []int{2}
+}
+func (m *HeadEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HeadEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HeadEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HeadEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeadEvent.Merge(m, src)
+}
+func (m *HeadEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *HeadEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeadEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeadEvent proto.InternalMessageInfo
+
+func (m *HeadEvent) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *HeadEvent) GetBlockRoot() []byte {
+	if m != nil {
+		return m.BlockRoot
+	}
+	return nil
+}
+
+func (m *HeadEvent) GetPreviousDutyDependentRoot() []byte {
+	if m != nil {
+		return m.PreviousDutyDependentRoot
+	}
+	return nil
+}
+
+func (m *HeadEvent) GetCurrentDutyDependentRoot() []byte {
+	if m != nil {
+		return m.CurrentDutyDependentRoot
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*LogsEndpointResponse)(nil), "ethereum.beacon.rpc.v1.LogsEndpointResponse")
+	proto.RegisterType((*CapabilitiesResponse)(nil), "ethereum.beacon.rpc.v1.CapabilitiesResponse")
+	proto.RegisterType((*HeadEvent)(nil), "ethereum.beacon.rpc.v1.HeadEvent")
 }
 
 func init() { proto.RegisterFile("proto/beacon/rpc/v1/health.proto", fileDescriptor_2e4b7e98e3e10444) }
 
 var fileDescriptor_2e4b7e98e3e10444 = []byte{
-	// 258 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xc1, 0x4a, 0xc3, 0x40,
-	0x10, 0x86, 0x59, 0x0f, 0x05, 0x17, 0x41, 0x58, 0x4a, 0x91, 0x2a, 0xa1, 0x04, 0x0f, 0x22, 0xb2,
-	0x63, 0xf4, 0x0d, 0x84, 0x62, 0x0f, 0x9e, 0xfa, 0x02, 0x65, 0x13, 0xc7, 0x6c, 0x20, 0xdd, 0x59,
-	0xb2, 0xd3, 0x80, 0x57, 0xbd, 0x7b, 0xf1, 0xa5, 0x3c, 0x0a, 0xbe, 0x80, 0x04, 0x1f, 0x44, 0x9a,
-	0x6d, 0xa0, 0x87, 0x1e, 0x87, 0x6f, 0x7e, 0xfe, 0x6f, 0x46, 0xce, 0x7c, 0x43, 0x4c, 0x90, 0xa3,
-	0x29, 0xc8, 0x41, 0xe3, 0x0b, 0x68, 0x33, 0xb0, 0x68, 0x6a, 0xb6, 0xba, 0x47, 0x6a, 0x82, 0x6c,
-	0xb1, 0xc1, 0xcd, 0x5a, 0xc7, 0x25, 0xdd, 0xf8, 0x42, 0xb7, 0xd9, 0xf4, 0xa2, 0x24, 0x2a, 0x6b,
-	0x04, 0xe3, 0x2b, 0x30, 0xce, 0x11, 0x1b, 0xae, 0xc8, 0x85, 0x98, 0x9a, 0x9e, 0xef, 0x68, 0x3f,
-	0xe5, 0x9b, 0x17, 0xc0, 0xb5, 0xe7, 0xd7, 0x08, 0xd3, 0x85, 0x1c, 0x3f, 0x51, 0x19, 0xe6, 0xee,
-	0xd9, 0x53, 0xe5, 0x78, 0x89, 0xc1, 0x93, 0x0b, 0xa8, 0x6e, 0xe5, 0x38, 0x76, 0xac, 0x6a, 0x2a,
-	0xc3, 0x0a, 0x77, 0xfc, 0x4c, 0xcc, 0xc4, 0xd5, 0xf1, 0x52, 0x45, 0xb6, 0x9f, 0xbc, 0xfb, 0x10,
-	0x72, 0xb4, 0xe8, 0x6d, 0xd5, 0xbb, 0x90, 0xa7, 0x8f, 0xc8, 0xfb, 0x58, 0x4d, 0x74, 0xd4, 0xd0,
-	0x83, 0x86, 0x9e, 0x6f, 0x35, 0xa6, 0x37, 0xfa, 0xf0, 0x51, 0xfa, 0x90, 0x56, 0x7a, 0xfd, 0xf6,
-	0xf3, 0xf7, 0x79, 0x74, 0xa9, 0x52, 0x40, 0xb6, 0xd0, 0x66, 0xa6, 0xf6, 0xd6, 0x0c, 0x5f, 0x82,
-	0xad, 0x2a, 0x0c, 0xaa, 0x0f, 0x27, 0x5f, 0x5d, 0x22, 0xbe, 0xbb, 0x44, 0xfc, 0x76, 0x89, 0xc8,
-	0x47, 0x7d, 0xef, 0xfd, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x73, 0x6a, 0x3d, 0x50, 0x66, 0x01,
-	0x00, 0x00,
+	// 457 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x49, 0x5b, 0x56, 0x3a, 0x16, 0x94, 0xa1, 0x2c, 0xb1, 0xbb, 0xd6, 0x6e, 0xf4, 0x50,
+	0x45, 0x32, 0x5b, 0x3d, 0x8b, 0xa0, 0x5b, 0xec, 0x41, 0x10, 0x22, 0x78, 0x0d, 0x93, 0xe4, 0xd9,
+	0x0c, 0xa6, 0xf3, 0x86, 0xc9, 0x24, 0xd0, 0xeb, 0xfa, 0x11, 0xfc, 0x3e, 0x9e, 0x3d, 0x0a, 0x7e,
+	0x01, 0x29, 0xfb, 0x41, 0xa4, 0x33, 0x4d, 0xe9, 0x62, 0x7a, 0xd8, 0x5b, 0x66, 0xde, 0xfb, 0xbd,
+	0xf9, 0x93, 0xdf, 0x23, 0x13, 0xa5, 0xd1, 0x20, 0x4b, 0x80, 0xa7, 0x28, 0x99, 0x56, 0x29, 0xab,
+	0x67, 0x2c, 0x07, 0x5e, 0x98, 0x3c, 0xb4, 0x25, 0x7a, 0x0a, 0x26, 0x07, 0x0d, 0xd5, 0x2a, 0x74,
+	0x4d, 0xa1, 0x56, 0x69, 0x58, 0xcf, 0x46, 0xe7, 0x4b, 0xc4, 0x65, 0x01, 0x8c, 0x2b, 0xc1, 0xb8,
+	0x94, 0x68, 0xb8, 0x11, 0x28, 0x4b, 0x47, 0x8d, 0xce, 0x76, 0x55, 0x7b, 0x4a, 0xaa, 0xaf, 0x0c,
+	0x56, 0xca, 0xac, 0x5d, 0x31, 0x58, 0x90, 0xe1, 0x47, 0x5c, 0x96, 0x73, 0x99, 0x29, 0x14, 0xd2,
+	0x44, 0x50, 0x2a, 0x94, 0x25, 0xd0, 0x4b, 0x32, 0x74, 0x6f, 0xc4, 0x05, 0x2e, 0xcb, 0x18, 0x76,
+	0x75, 0xdf, 0x9b, 0x78, 0xd3, 0x7e, 0x44, 0x5d, 0xed, 0x90, 0x0c, 0x2a, 0x32, 0x7c, 0xcf, 0x15,
+	0x4f, 0x44, 0x21, 0x8c, 0x80, 0x72, 0x3f, 0xe9, 0x09, 0xb9, 0xcf, 0x95, 0x88, 0x6b, 0xd0, 0xa5,
+	0x40, 0x69, 0x07, 0xf4, 0x22, 0xc2, 0x95, 0xf8, 0xe2, 0x6e, 0x68, 0x40, 0x06, 0xe9, 0x01, 0xe8,
+	0x77, 0x26, 0xdd, 0x69, 0x3f, 0xba, 0x75, 0x47, 0x7d, 0x72, 0xaf, 0x19, 0xd0, 0xb5, 0x09, 0x9a,
+	0x63, 0xf0, 0xd3, 0x23, 0xfd, 0x05, 0xf0, 0x6c, 0x5e, 0x83, 0x34, 0x94, 0x92, 0x5e, 0x59, 0xa0,
+	0xd9, 0xbd, 0x62, 0xbf, 0xe9, 0x63, 0x42, 0x92, 0x02, 0xd3, 0x6f, 0xb1, 0x46, 0x34, 0x7e, 0x67,
+	0xe2, 0x4d, 0x07, 0x51, 0xdf, 0xde, 0x44, 0x88, 0x86, 0xbe, 0x25, 0xe7, 0x4a, 0x43, 0x2d, 0xb0,
+	0x2a, 0xe3, 0xac, 0x32, 0xeb, 0x38, 0x03, 0x05, 0x32, 0x03, 0x69, 0x1c, 0xd0, 0xb5, 0xc0, 0xa3,
+	0xa6, 0xe7, 0xaa, 0x32, 0xeb, 0xab, 0xa6, 0xc3, 0x0e, 0x78, 0x43, 0xce, 0xd2, 0x4a, 0xeb, 0x2d,
+	0xd0, 0xc6, 0xf7, 0x2c, 0xef, 0xef, 0x5a, 0xfe, 0xc3, 0x5f, 0xdd, 0x74, 0xc8, 0xc9, 0xc2, 0x5a,
+	0xa6, 0xdf, 0x3d, 0xf2, 0xe0, 0x03, 0x98, 0xc3, 0xdf, 0x4a, 0x4f, 0x43, 0xa7, 0x2f, 0x6c, 0xf4,
+	0x85, 0xf3, 0xad, 0xbe, 0xd1, 0xcb, 0xb0, 0x7d, 0x19, 0xc2, 0x36, 0x9d, 0xc1, 0x8b, 0xeb, 0x3f,
+	0x37, 0x3f, 0x3a, 0xcf, 0x68, 0xc0, 0xc0, 0xe4, 0xac, 0x9e, 0xf1, 0x42, 0xe5, 0xbc, 0xd9, 0x2e,
+	0xb6, 0x55, 0xcc, 0x1a, 0xc5, 0xf4, 0xda, 0xa5, 0x38, 0x94, 0x79, 0xf7, 0x14, 0x6d, 0xab, 0x10,
+	0x3c, 0xb7, 0x29, 0x9e, 0xd2, 0x8b, 0xd6, 0x14, 0xb7, 0x84, 0x7f, 0x22, 0x0f, 0x3f, 0x1b, 0x0d,
+	0x7c, 0xb5, 0x77, 0x7b, 0x3c, 0xc4, 0xc5, 0xb1, 0x10, 0x7b, 0xf6, 0xd2, 0x7b, 0x37, 0xf8, 0xb5,
+	0x19, 0x7b, 0xbf, 0x37, 0x63, 0xef, 0xef, 0x66, 0xec, 0x25, 0x27, 0x76, 0xc4, 0xeb, 0x7f, 0x03,
+	0x00, 0x84, 0x1b, 0x60, 0xdc, 0x74, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -116,6 +264,8 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type HealthClient interface {
 	GetLogsEndpoint(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*LogsEndpointResponse, error)
+	GetCapabilities(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*CapabilitiesResponse, error)
+	StreamHeadEvents(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (Health_StreamHeadEventsClient, error)
 }
 
 type healthClient struct {
@@ -135,9 +285,52 @@ func (c *healthClient) GetLogsEndpoint(ctx context.Context, in *types.Empty, opt
 	return out, nil
 }
 
+func (c *healthClient) GetCapabilities(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*CapabilitiesResponse, error) {
+	out := new(CapabilitiesResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Health/GetCapabilities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthClient) StreamHeadEvents(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (Health_StreamHeadEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Health_serviceDesc.Streams[0], "/ethereum.beacon.rpc.v1.Health/StreamHeadEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &healthStreamHeadEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Health_StreamHeadEventsClient interface {
+	Recv() (*HeadEvent, error)
+	grpc.ClientStream
+}
+
+type healthStreamHeadEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *healthStreamHeadEventsClient) Recv() (*HeadEvent, error) {
+	m := new(HeadEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // HealthServer is the server API for Health service.
 type HealthServer interface {
 	GetLogsEndpoint(context.Context, *types.Empty) (*LogsEndpointResponse, error)
+	GetCapabilities(context.Context, *types.Empty) (*CapabilitiesResponse, error)
+	StreamHeadEvents(*types.Empty, Health_StreamHeadEventsServer) error
 }
 
 // UnimplementedHealthServer can be embedded to have forward compatible implementations.
@@ -147,6 +340,12 @@ type UnimplementedHealthServer struct {
 func (*UnimplementedHealthServer) GetLogsEndpoint(ctx context.Context, req *types.Empty) (*LogsEndpointResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLogsEndpoint not implemented")
 }
+func (*UnimplementedHealthServer) GetCapabilities(ctx context.Context, req *types.Empty) (*CapabilitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCapabilities not implemented")
+}
+func (*UnimplementedHealthServer) StreamHeadEvents(req *types.Empty, srv Health_StreamHeadEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamHeadEvents not implemented")
+}
 
 func RegisterHealthServer(s *grpc.Server, srv HealthServer) {
 	s.RegisterService(&_Health_serviceDesc, srv)
@@ -170,6 +369,45 @@ func _Health_GetLogsEndpoint_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Health_GetCapabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServer).GetCapabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Health/GetCapabilities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServer).GetCapabilities(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Health_StreamHeadEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(types.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(HealthServer).StreamHeadEvents(m, &healthStreamHeadEventsServer{stream})
+}
+
+type Health_StreamHeadEventsServer interface {
+	Send(*HeadEvent) error
+	grpc.ServerStream
+}
+
+type healthStreamHeadEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *healthStreamHeadEventsServer) Send(m *HeadEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _Health_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethereum.beacon.rpc.v1.Health",
 	HandlerType: (*HealthServer)(nil),
@@ -178,8 +416,18 @@ var _Health_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetLogsEndpoint",
 			Handler:    _Health_GetLogsEndpoint_Handler,
 		},
+		{
+			MethodName: "GetCapabilities",
+			Handler:    _Health_GetCapabilities_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamHeadEvents",
+			Handler:       _Health_StreamHeadEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/beacon/rpc/v1/health.proto",
 }
 
@@ -217,6 +465,107 @@ func (m *LogsEndpointResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CapabilitiesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CapabilitiesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CapabilitiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintHealth(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Capabilities) > 0 {
+		for iNdEx := len(m.Capabilities) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Capabilities[iNdEx])
+			copy(dAtA[i:], m.Capabilities[iNdEx])
+			i = encodeVarintHealth(dAtA, i, uint64(len(m.Capabilities[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.ApiVersion != 0 {
+		i = encodeVarintHealth(dAtA, i, uint64(m.ApiVersion))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HeadEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeadEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeadEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CurrentDutyDependentRoot) > 0 {
+		i -= len(m.CurrentDutyDependentRoot)
+		copy(dAtA[i:], m.CurrentDutyDependentRoot)
+		i = encodeVarintHealth(dAtA, i, uint64(len(m.CurrentDutyDependentRoot)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PreviousDutyDependentRoot) > 0 {
+		i -= len(m.PreviousDutyDependentRoot)
+		copy(dAtA[i:], m.PreviousDutyDependentRoot)
+		i = encodeVarintHealth(dAtA, i, uint64(len(m.PreviousDutyDependentRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BlockRoot) > 0 {
+		i -= len(m.BlockRoot)
+		copy(dAtA[i:], m.BlockRoot)
+		i = encodeVarintHealth(dAtA, i, uint64(len(m.BlockRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Slot != 0 {
+		i = encodeVarintHealth(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintHealth(dAtA []byte, offset int, v uint64) int {
 	offset -= sovHealth(v)
 	base := offset
@@ -244,6 +593,58 @@ func (m *LogsEndpointResponse) Size() (n int) {
 	return n
 }
 
+func (m *CapabilitiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ApiVersion != 0 {
+		n += 1 + sovHealth(uint64(m.ApiVersion))
+	}
+	if len(m.Capabilities) > 0 {
+		for _, s := range m.Capabilities {
+			l = len(s)
+			n += 1 + l + sovHealth(uint64(l))
+		}
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovHealth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HeadEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovHealth(uint64(m.Slot))
+	}
+	l = len(m.BlockRoot)
+	if l > 0 {
+		n += 1 + l + sovHealth(uint64(l))
+	}
+	l = len(m.PreviousDutyDependentRoot)
+	if l > 0 {
+		n += 1 + l + sovHealth(uint64(l))
+	}
+	l = len(m.CurrentDutyDependentRoot)
+	if l > 0 {
+		n += 1 + l + sovHealth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovHealth(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -336,6 +737,318 @@ func (m *LogsEndpointResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CapabilitiesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHealth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CapabilitiesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CapabilitiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiVersion", wireType)
+			}
+			m.ApiVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capabilities", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHealth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHealth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Capabilities = append(m.Capabilities, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHealth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHealth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHealth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHealth
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthHealth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HeadEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHealth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeadEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeadEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHealth
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHealth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockRoot == nil {
+				m.BlockRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousDutyDependentRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHealth
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHealth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousDutyDependentRoot = append(m.PreviousDutyDependentRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.PreviousDutyDependentRoot == nil {
+				m.PreviousDutyDependentRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentDutyDependentRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHealth
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHealth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentDutyDependentRoot = append(m.CurrentDutyDependentRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.CurrentDutyDependentRoot == nil {
+				m.CurrentDutyDependentRoot = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHealth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHealth
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthHealth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipHealth(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,2904 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proto/slashing/status.proto
+
+package ethereum_slashing
+
+import (
+	context "context"
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
+	v1alpha1 "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	v1 "github.com/prysmaticlabs/prysm/proto/shared/v1"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type SlashingKind int32
+
+const (
+	SlashingKind_ANY_KIND SlashingKind = 0
+	SlashingKind_ATTESTER SlashingKind = 1
+	SlashingKind_PROPOSER SlashingKind = 2
+)
+
+var SlashingKind_name = map[int32]string{
+	0: "ANY_KIND",
+	1: "ATTESTER",
+	2: "PROPOSER",
+}
+
+var SlashingKind_value = map[string]int32{
+	"ANY_KIND": 0,
+	"ATTESTER": 1,
+	"PROPOSER": 2,
+}
+
+func (x SlashingKind) String() string {
+	return proto.EnumName(SlashingKind_name, int32(x))
+}
+
+func (SlashingKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_46ae1626c68df322, []int{0}
+}
+
+type DetectedSlashingStatus int32
+
+const (
+	DetectedSlashingStatus_ANY_STATUS DetectedSlashingStatus = 0
+	DetectedSlashingStatus_ACTIVE     DetectedSlashingStatus = 1
+	DetectedSlashingStatus_INCLUDED   DetectedSlashingStatus = 2
+	DetectedSlashingStatus_REVERTED   DetectedSlashingStatus = 3
+)
+
+var DetectedSlashingStatus_name = map[int32]string{
+	0: "ANY_STATUS",
+	1: "ACTIVE",
+	2: "INCLUDED",
+	3: "REVERTED",
+}
+
+var DetectedSlashingStatus_value = map[string]int32{
+	"ANY_STATUS": 0,
+	"ACTIVE":     1,
+	"INCLUDED":   2,
+	"REVERTED":   3,
+}
+
+func (x DetectedSlashingStatus) String() string {
+	return proto.EnumName(DetectedSlashingStatus_name, int32(x))
+}
+
+func (DetectedSlashingStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_46ae1626c68df322, []int{1}
+}
+
+type SlasherStatusResponse struct {
+	ChainHeadEpoch               uint64   `protobuf:"varint,1,opt,name=chain_head_epoch,json=chainHeadEpoch,proto3" json:"chain_head_epoch,omitempty"`
+	LatestEpochDetected          uint64   `protobuf:"varint,2,opt,name=latest_epoch_detected,json=latestEpochDetected,proto3" json:"latest_epoch_detected,omitempty"`
+	LatestAttestationTargetEpoch uint64   `protobuf:"varint,3,opt,name=latest_attestation_target_epoch,json=latestAttestationTargetEpoch,proto3" json:"latest_attestation_target_epoch,omitempty"`
+	Ready                        bool     `protobuf:"varint,4,opt,name=ready,proto3" json:"ready,omitempty"`
+	StatusError                  string   `protobuf:"bytes,5,opt,name=status_error,json=statusError,proto3" json:"status_error,omitempty"`
+	AttesterSlashings            uint64   `protobuf:"varint,6,opt,name=attester_slashings,json=attesterSlashings,proto3" json:"attester_slashings,omitempty"`
+	ProposerSlashings            uint64   `protobuf:"varint,7,opt,name=proposer_slashings,json=proposerSlashings,proto3" json:"proposer_slashings,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{} `json:"-"`
+	XXX_unrecognized             []byte   `json:"-"`
+	XXX_sizecache                int32    `json:"-"`
+}
+
+func (m *SlasherStatusResponse) Reset()         { *m = SlasherStatusResponse{} }
+func (m *SlasherStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*SlasherStatusResponse) ProtoMessage()    {}
+func (*SlasherStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46ae1626c68df322, []int{0}
+}
+func (m *SlasherStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlasherStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlasherStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlasherStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlasherStatusResponse.Merge(m, src)
+}
+func (m *SlasherStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlasherStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlasherStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlasherStatusResponse proto.InternalMessageInfo
+
+func (m *SlasherStatusResponse) GetChainHeadEpoch() uint64 {
+	if m != nil {
+		return m.ChainHeadEpoch
+	}
+	return 0
+}
+
+func (m *SlasherStatusResponse) GetLatestEpochDetected() uint64 {
+	if m != nil {
+		return m.LatestEpochDetected
+	}
+	return 0
+}
+
+func (m *SlasherStatusResponse) GetLatestAttestationTargetEpoch() uint64 {
+	if m != nil {
+		return m.LatestAttestationTargetEpoch
+	}
+	return 0
+}
+
+func (m *SlasherStatusResponse) GetReady() bool {
+	if m != nil {
+		return m.Ready
+	}
+	return false
+}
+
+func (m *SlasherStatusResponse) GetStatusError() string {
+	if m != nil {
+		return m.StatusError
+	}
+	return ""
+}
+
+func (m *SlasherStatusResponse) GetAttesterSlashings() uint64 {
+	if m != nil {
+		return m.AttesterSlashings
+	}
+	return 0
+}
+
+func (m *SlasherStatusResponse) GetProposerSlashings() uint64 {
+	if m != nil {
+		return m.ProposerSlashings
+	}
+	return 0
+}
+
+type DetectedSlashingsRequest struct {
+	Kind                 SlashingKind           `protobuf:"varint,1,opt,name=kind,proto3,enum=ethereum.slashing.SlashingKind" json:"kind,omitempty"`
+	Status               DetectedSlashingStatus `protobuf:"varint,2,opt,name=status,proto3,enum=ethereum.slashing.DetectedSlashingStatus" json:"status,omitempty"`
+	PageSize             int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken            string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *DetectedSlashingsRequest) Reset()         { *m = DetectedSlashingsRequest{} }
+func (m *DetectedSlashingsRequest) String() string { return proto.CompactTextString(m) }
+func (*DetectedSlashingsRequest) ProtoMessage()    {}
+func (*DetectedSlashingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46ae1626c68df322, []int{1}
+}
+func (m *DetectedSlashingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DetectedSlashingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DetectedSlashingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DetectedSlashingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DetectedSlashingsRequest.Merge(m, src)
+}
+func (m *DetectedSlashingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DetectedSlashingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DetectedSlashingsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DetectedSlashingsRequest proto.InternalMessageInfo
+
+func (m *DetectedSlashingsRequest) GetKind() SlashingKind {
+	if m != nil {
+		return m.Kind
+	}
+	return SlashingKind_ANY_KIND
+}
+
+func (m *DetectedSlashingsRequest) GetStatus() DetectedSlashingStatus {
+	if m != nil {
+		return m.Status
+	}
+	return DetectedSlashingStatus_ANY_STATUS
+}
+
+func (m *DetectedSlashingsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *DetectedSlashingsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type DetectedSlashing struct {
+	Kind                 SlashingKind               `protobuf:"varint,1,opt,name=kind,proto3,enum=ethereum.slashing.SlashingKind" json:"kind,omitempty"`
+	Status               DetectedSlashingStatus     `protobuf:"varint,2,opt,name=status,proto3,enum=ethereum.slashing.DetectedSlashingStatus" json:"status,omitempty"`
+	Epoch                uint64                     `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	ValidatorIndices     []uint64                   `protobuf:"varint,4,rep,packed,name=validator_indices,json=validatorIndices,proto3" json:"validator_indices,omitempty"`
+	AttesterSlashing     *v1alpha1.AttesterSlashing `protobuf:"bytes,5,opt,name=attester_slashing,json=attesterSlashing,proto3" json:"attester_slashing,omitempty"`
+	ProposerSlashing     *v1alpha1.ProposerSlashing `protobuf:"bytes,6,opt,name=proposer_slashing,json=proposerSlashing,proto3" json:"proposer_slashing,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *DetectedSlashing) Reset()         { *m = DetectedSlashing{} }
+func (m *DetectedSlashing) String() string { return proto.CompactTextString(m) }
+func (*DetectedSlashing) ProtoMessage()    {}
+func (*DetectedSlashing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46ae1626c68df322, []int{2}
+}
+func (m *DetectedSlashing) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DetectedSlashing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DetectedSlashing.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DetectedSlashing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DetectedSlashing.Merge(m, src)
+}
+func (m *DetectedSlashing) XXX_Size() int {
+	return m.Size()
+}
+func (m *DetectedSlashing) XXX_DiscardUnknown() {
+	xxx_messageInfo_DetectedSlashing.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DetectedSlashing proto.InternalMessageInfo
+
+func (m *DetectedSlashing) GetKind() SlashingKind {
+	if m != nil {
+		return m.Kind
+	}
+	return SlashingKind_ANY_KIND
+}
+
+func (m *DetectedSlashing) GetStatus() DetectedSlashingStatus {
+	if m != nil {
+		return m.Status
+	}
+	return DetectedSlashingStatus_ANY_STATUS
+}
+
+func (m *DetectedSlashing) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *DetectedSlashing) GetValidatorIndices() []uint64 {
+	if m != nil {
+		return m.ValidatorIndices
+	}
+	return nil
+}
+
+func (m *DetectedSlashing) GetAttesterSlashing() *v1alpha1.AttesterSlashing {
+	if m != nil {
+		return m.AttesterSlashing
+	}
+	return nil
+}
+
+func (m *DetectedSlashing) GetProposerSlashing() *v1alpha1.ProposerSlashing {
+	if m != nil {
+		return m.ProposerSlashing
+	}
+	return nil
+}
+
+type DetectedSlashingsResponse struct {
+	Slashings            []*DetectedSlashing `protobuf:"bytes,1,rep,name=slashings,proto3" json:"slashings,omitempty"`
+	NextPageToken        string              `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize            int32               `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *DetectedSlashingsResponse) Reset()         { *m = DetectedSlashingsResponse{} }
+func (m *DetectedSlashingsResponse) String() string { return proto.CompactTextString(m) }
+func (*DetectedSlashingsResponse) ProtoMessage()    {}
+func (*DetectedSlashingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46ae1626c68df322, []int{3}
+}
+func (m *DetectedSlashingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DetectedSlashingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DetectedSlashingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DetectedSlashingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DetectedSlashingsResponse.Merge(m, src)
+}
+func (m *DetectedSlashingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DetectedSlashingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DetectedSlashingsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DetectedSlashingsResponse proto.InternalMessageInfo
+
+func (m *DetectedSlashingsResponse) GetSlashings() []*DetectedSlashing {
+	if m != nil {
+		return m.Slashings
+	}
+	return nil
+}
+
+func (m *DetectedSlashingsResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func (m *DetectedSlashingsResponse) GetTotalSize() int32 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+type WatchedValidatorsRequest struct {
+	Indices              []uint64 `protobuf:"varint,1,rep,packed,name=indices,proto3" json:"indices,omitempty"`
+	PageSize             int32    `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken            string   `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchedValidatorsRequest) Reset()         { *m = WatchedValidatorsRequest{} }
+func (m *WatchedValidatorsRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchedValidatorsRequest) ProtoMessage()    {}
+func (*WatchedValidatorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46ae1626c68df322, []int{4}
+}
+func (m *WatchedValidatorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchedValidatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchedValidatorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WatchedValidatorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchedValidatorsRequest.Merge(m, src)
+}
+func (m *WatchedValidatorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchedValidatorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchedValidatorsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchedValidatorsRequest proto.InternalMessageInfo
+
+func (m *WatchedValidatorsRequest) GetIndices() []uint64 {
+	if m != nil {
+		return m.Indices
+	}
+	return nil
+}
+
+func (m *WatchedValidatorsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *WatchedValidatorsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type WatchedValidator struct {
+	Index                uint64   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	PublicKey            []byte   `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Attested             bool     `protobuf:"varint,3,opt,name=attested,proto3" json:"attested,omitempty"`
+	HighestSourceEpoch   uint64   `protobuf:"varint,4,opt,name=highest_source_epoch,json=highestSourceEpoch,proto3" json:"highest_source_epoch,omitempty"`
+	HighestTargetEpoch   uint64   `protobuf:"varint,5,opt,name=highest_target_epoch,json=highestTargetEpoch,proto3" json:"highest_target_epoch,omitempty"`
+	AttesterSlashings    uint64   `protobuf:"varint,6,opt,name=attester_slashings,json=attesterSlashings,proto3" json:"attester_slashings,omitempty"`
+	ProposerSlashings    uint64   `protobuf:"varint,7,opt,name=proposer_slashings,json=proposerSlashings,proto3" json:"proposer_slashings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchedValidator) Reset()         { *m = WatchedValidator{} }
+func (m *WatchedValidator) String() string { return proto.CompactTextString(m) }
+func (*WatchedValidator) ProtoMessage()    {}
+func (*WatchedValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46ae1626c68df322, []int{5}
+}
+func (m *WatchedValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchedValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchedValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WatchedValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchedValidator.Merge(m, src)
+}
+func (m *WatchedValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchedValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchedValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchedValidator proto.InternalMessageInfo
+
+func (m *WatchedValidator) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *WatchedValidator) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *WatchedValidator) GetAttested() bool {
+	if m != nil {
+		return m.Attested
+	}
+	return false
+}
+
+func (m *WatchedValidator) GetHighestSourceEpoch() uint64 {
+	if m != nil {
+		return m.HighestSourceEpoch
+	}
+	return 0
+}
+
+func (m *WatchedValidator) GetHighestTargetEpoch() uint64 {
+	if m != nil {
+		return m.HighestTargetEpoch
+	}
+	return 0
+}
+
+func (m *WatchedValidator) GetAttesterSlashings() uint64 {
+	if m != nil {
+		return m.AttesterSlashings
+	}
+	return 0
+}
+
+func (m *WatchedValidator) GetProposerSlashings() uint64 {
+	if m != nil {
+		return m.ProposerSlashings
+	}
+	return 0
+}
+
+type WatchedValidatorsResponse struct {
+	Validators           []*WatchedValidator `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
+	NextPageToken        string              `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize            int32               `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *WatchedValidatorsResponse) Reset()         { *m = WatchedValidatorsResponse{} }
+func (m *WatchedValidatorsResponse) String() string { return proto.CompactTextString(m) }
+func (*WatchedValidatorsResponse) ProtoMessage()    {}
+func (*WatchedValidatorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46ae1626c68df322, []int{6}
+}
+func (m *WatchedValidatorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchedValidatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchedValidatorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WatchedValidatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchedValidatorsResponse.Merge(m, src)
+}
+func (m *WatchedValidatorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchedValidatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchedValidatorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchedValidatorsResponse proto.InternalMessageInfo
+
+func (m *WatchedValidatorsResponse) GetValidators() []*WatchedValidator {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *WatchedValidatorsResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func (m *WatchedValidatorsResponse) GetTotalSize() int32 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("ethereum.slashing.SlashingKind", SlashingKind_name, SlashingKind_value)
+	proto.RegisterEnum("ethereum.slashing.DetectedSlashingStatus", DetectedSlashingStatus_name, DetectedSlashingStatus_value)
+	proto.RegisterType((*SlasherStatusResponse)(nil), "ethereum.slashing.SlasherStatusResponse")
+	proto.RegisterType((*DetectedSlashingsRequest)(nil), "ethereum.slashing.DetectedSlashingsRequest")
+	proto.RegisterType((*DetectedSlashing)(nil), "ethereum.slashing.DetectedSlashing")
+	proto.RegisterType((*DetectedSlashingsResponse)(nil), "ethereum.slashing.DetectedSlashingsResponse")
+	proto.RegisterType((*WatchedValidatorsRequest)(nil), "ethereum.slashing.WatchedValidatorsRequest")
+	proto.RegisterType((*WatchedValidator)(nil), "ethereum.slashing.WatchedValidator")
+	proto.RegisterType((*WatchedValidatorsResponse)(nil), "ethereum.slashing.WatchedValidatorsResponse")
+}
+
+func init() { proto.RegisterFile("proto/slashing/status.proto", fileDescriptor_46ae1626c68df322) }
+
+var fileDescriptor_46ae1626c68df322 = []byte{
+	// 984 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x0e, 0xf5, 0x17, 0x69, 0xac, 0xb8, 0xf4, 0xd6, 0x0e, 0x18, 0xd9, 0x95, 0x15, 0x35, 0x48,
+	0xd4, 0x24, 0x25, 0x6b, 0xe5, 0xd2, 0xab, 0x6a, 0xb1, 0xad, 0xe1, 0xc0, 0x11, 0x56, 0x8c, 0x8b,
+	0x9e, 0x88, 0x15, 0xb9, 0x16, 0x09, 0x2b, 0x24, 0x4b, 0xae, 0x8c, 0x38, 0xc7, 0xbe, 0x42, 0x8f,
+	0xed, 0x35, 0xe8, 0x23, 0xf4, 0x15, 0x7a, 0x2a, 0x0a, 0xf8, 0x05, 0x0a, 0xa3, 0x2f, 0xd1, 0x5b,
+	0xc1, 0xdd, 0xa5, 0x2c, 0x51, 0xb2, 0xe1, 0x43, 0x8b, 0x9c, 0x84, 0x99, 0xf9, 0x66, 0xe6, 0xe3,
+	0xce, 0xb7, 0xb3, 0x82, 0xed, 0x28, 0x0e, 0x59, 0x68, 0x24, 0x13, 0x92, 0x78, 0x7e, 0x30, 0x36,
+	0x12, 0x46, 0xd8, 0x34, 0xd1, 0xb9, 0x17, 0x6d, 0x50, 0xe6, 0xd1, 0x98, 0x4e, 0xdf, 0xe8, 0x59,
+	0xbc, 0xb1, 0x4b, 0x99, 0x67, 0x9c, 0xed, 0x91, 0x49, 0xe4, 0x91, 0x3d, 0x63, 0x44, 0x89, 0x13,
+	0x06, 0xf6, 0x68, 0x12, 0x3a, 0xa7, 0x22, 0xa7, 0xb1, 0x33, 0x0e, 0xc3, 0xf1, 0x84, 0x1a, 0x24,
+	0xf2, 0x0d, 0x12, 0x04, 0x21, 0x23, 0xcc, 0x0f, 0x03, 0x59, 0xb1, 0xb1, 0x2d, 0xa3, 0xdc, 0x1a,
+	0x4d, 0x4f, 0x0c, 0xfa, 0x26, 0x62, 0xe7, 0x59, 0xaa, 0xe4, 0xe2, 0x91, 0x98, 0xba, 0xc6, 0xd9,
+	0x9e, 0xe1, 0x84, 0xc1, 0x89, 0x3f, 0x16, 0xd1, 0xf6, 0x45, 0x01, 0xb6, 0x86, 0x29, 0x0d, 0x1a,
+	0x0f, 0x39, 0x49, 0x4c, 0x93, 0x28, 0x0c, 0x12, 0x8a, 0x3a, 0xa0, 0x3a, 0x1e, 0xf1, 0x03, 0xdb,
+	0xa3, 0xc4, 0xb5, 0x69, 0x14, 0x3a, 0x9e, 0xa6, 0xb4, 0x94, 0x4e, 0x09, 0xaf, 0x73, 0xff, 0xb7,
+	0x94, 0xb8, 0x66, 0xea, 0x45, 0x5d, 0xd8, 0x9a, 0x10, 0x46, 0x13, 0x26, 0x50, 0xb6, 0x4b, 0x19,
+	0x75, 0x18, 0x75, 0xb5, 0x02, 0x87, 0x7f, 0x2c, 0x82, 0x1c, 0xdb, 0x97, 0x21, 0x64, 0xc2, 0xae,
+	0xcc, 0x21, 0x2c, 0xfd, 0xe1, 0xdf, 0x63, 0x33, 0x12, 0x8f, 0xa9, 0x2c, 0xa3, 0x15, 0x79, 0xf6,
+	0x8e, 0x80, 0xf5, 0xae, 0x50, 0x16, 0x07, 0x89, 0xd6, 0x9b, 0x50, 0x8e, 0x29, 0x71, 0xcf, 0xb5,
+	0x52, 0x4b, 0xe9, 0x54, 0xb1, 0x30, 0xd0, 0x43, 0xa8, 0x8b, 0x13, 0xb7, 0x69, 0x1c, 0x87, 0xb1,
+	0x56, 0x6e, 0x29, 0x9d, 0x1a, 0x5e, 0x13, 0x3e, 0x33, 0x75, 0xa1, 0xcf, 0x01, 0x89, 0xc6, 0x34,
+	0xb6, 0xb3, 0x31, 0x24, 0x5a, 0x85, 0xb7, 0xdc, 0xc8, 0x22, 0xc3, 0x2c, 0x90, 0xc2, 0xa3, 0x38,
+	0x8c, 0xc2, 0x64, 0x01, 0x7e, 0x57, 0xc0, 0xb3, 0xc8, 0x0c, 0xde, 0xfe, 0x43, 0x01, 0x2d, 0xfb,
+	0xd4, 0x99, 0x17, 0xd3, 0x1f, 0xa6, 0x34, 0x61, 0xe8, 0x05, 0x94, 0x4e, 0xfd, 0xc0, 0xe5, 0x87,
+	0xb9, 0xde, 0xdd, 0xd5, 0x97, 0xe4, 0xa0, 0x67, 0x29, 0x87, 0x7e, 0xe0, 0x62, 0x0e, 0x46, 0x3d,
+	0xa8, 0x08, 0xfa, 0xfc, 0x50, 0xd7, 0xbb, 0x9f, 0xad, 0x48, 0xcb, 0x77, 0x94, 0x03, 0x95, 0x89,
+	0x68, 0x1b, 0x6a, 0x11, 0x19, 0x53, 0x3b, 0xf1, 0xdf, 0x51, 0x7e, 0xb8, 0x65, 0x5c, 0x4d, 0x1d,
+	0x43, 0xff, 0x1d, 0x45, 0x9f, 0x00, 0xf0, 0x20, 0x0b, 0x4f, 0x69, 0xc0, 0x4f, 0xb3, 0x86, 0x39,
+	0xdc, 0x4a, 0x1d, 0xed, 0x7f, 0x0a, 0xa0, 0xe6, 0xcb, 0x7f, 0xb0, 0x0f, 0xd9, 0x84, 0xf2, 0xbc,
+	0x42, 0x84, 0x81, 0x9e, 0xc1, 0xc6, 0x19, 0x99, 0xf8, 0x2e, 0x61, 0x61, 0x6c, 0xfb, 0x81, 0xeb,
+	0x3b, 0x34, 0xd1, 0x4a, 0xad, 0x62, 0xa7, 0x84, 0xd5, 0x59, 0xe0, 0x40, 0xf8, 0x91, 0x05, 0x1b,
+	0x4b, 0xe3, 0xe7, 0x32, 0x59, 0xeb, 0x3e, 0xb9, 0x22, 0x44, 0x99, 0xa7, 0x67, 0xb7, 0x52, 0xef,
+	0xe5, 0x44, 0x81, 0xd5, 0xbc, 0x4c, 0xd2, 0xaa, 0x4b, 0x2a, 0xd1, 0x2a, 0x37, 0x56, 0x1d, 0xe4,
+	0xb4, 0x83, 0xd5, 0xbc, 0x9a, 0xda, 0xef, 0x15, 0x78, 0xb0, 0x42, 0x4c, 0xf2, 0x9a, 0xf6, 0xa0,
+	0x76, 0x25, 0x48, 0xa5, 0x55, 0xec, 0xac, 0x75, 0x3f, 0xbd, 0xc5, 0x91, 0xe2, 0xab, 0x2c, 0xf4,
+	0x18, 0x3e, 0x0a, 0xe8, 0x5b, 0x66, 0xcf, 0x09, 0xa0, 0xc0, 0x05, 0x70, 0x2f, 0x75, 0x0f, 0x32,
+	0x11, 0xa4, 0x1a, 0x61, 0x21, 0x23, 0x93, 0x79, 0x05, 0xd5, 0xb8, 0x27, 0x95, 0x50, 0x3b, 0x02,
+	0xed, 0x3b, 0xc2, 0x1c, 0x8f, 0xba, 0xc7, 0xd9, 0x71, 0xcf, 0x34, 0xaf, 0xc1, 0xdd, 0x6c, 0x24,
+	0x0a, 0x1f, 0x49, 0x66, 0x2e, 0xaa, 0xb2, 0x70, 0xa3, 0x2a, 0x8b, 0x79, 0x55, 0xbe, 0x2f, 0x80,
+	0x9a, 0x6f, 0x99, 0xaa, 0xc3, 0x0f, 0x5c, 0xfa, 0x56, 0x2e, 0x2b, 0x61, 0xf0, 0x4a, 0xd3, 0xd1,
+	0xc4, 0x77, 0xec, 0x53, 0x7a, 0xce, 0xfb, 0xd4, 0x71, 0x4d, 0x78, 0x0e, 0xe9, 0x39, 0x6a, 0x40,
+	0x55, 0x4e, 0xd3, 0xe5, 0x6d, 0xaa, 0x78, 0x66, 0xa3, 0x2f, 0x60, 0xd3, 0xf3, 0xc7, 0x5e, 0xba,
+	0xab, 0x92, 0x70, 0x1a, 0x3b, 0x54, 0xee, 0xa7, 0x12, 0xaf, 0x8f, 0x64, 0x6c, 0xc8, 0x43, 0x62,
+	0x2b, 0xcd, 0x65, 0x2c, 0x6c, 0xb4, 0xf2, 0x42, 0xc6, 0xfc, 0x1e, 0xfb, 0x7f, 0xd7, 0xd1, 0xaf,
+	0x0a, 0x3c, 0x58, 0x31, 0x1a, 0xa9, 0xa0, 0x7d, 0x80, 0xd9, 0xfd, 0xb8, 0x49, 0x42, 0xf9, 0x0a,
+	0x78, 0x2e, 0xed, 0x3f, 0xd2, 0xd0, 0xd3, 0x2f, 0xa1, 0x3e, 0xbf, 0x33, 0x50, 0x1d, 0xaa, 0xbd,
+	0xa3, 0xef, 0xed, 0xc3, 0x83, 0xa3, 0xbe, 0x7a, 0x87, 0x5b, 0x96, 0x65, 0x0e, 0x2d, 0x13, 0xab,
+	0x4a, 0x6a, 0x0d, 0xf0, 0xab, 0xc1, 0xab, 0xa1, 0x89, 0xd5, 0xc2, 0xd3, 0x01, 0xdc, 0x5f, 0xbd,
+	0x36, 0xd0, 0x3a, 0x40, 0x5a, 0x63, 0x68, 0xf5, 0xac, 0xd7, 0x43, 0xf5, 0x0e, 0x02, 0xa8, 0xf4,
+	0xf6, 0xad, 0x83, 0x63, 0x53, 0xd4, 0x38, 0x38, 0xda, 0x7f, 0xf9, 0xba, 0x6f, 0xf6, 0xd5, 0x42,
+	0x6a, 0x61, 0xf3, 0xd8, 0xc4, 0x96, 0xd9, 0x57, 0x8b, 0xdd, 0xdf, 0x4a, 0x70, 0x6f, 0xe1, 0x69,
+	0x44, 0x09, 0xd4, 0xbe, 0xa1, 0x4c, 0x1a, 0xf7, 0x75, 0xf1, 0xea, 0xea, 0xd9, 0xab, 0xab, 0x9b,
+	0xe9, 0xab, 0xdb, 0xe8, 0x5c, 0xb7, 0x07, 0xf3, 0x2f, 0x6c, 0xfb, 0xd1, 0x8f, 0x17, 0x7f, 0xff,
+	0x54, 0x68, 0xa2, 0x1d, 0x63, 0xe1, 0xf9, 0x4f, 0x04, 0x58, 0xfe, 0x69, 0x40, 0x3f, 0x2b, 0xb0,
+	0xf5, 0xd2, 0x4f, 0xd8, 0xd2, 0x0a, 0x40, 0xcf, 0x6e, 0x71, 0xcf, 0xb3, 0x1b, 0xd8, 0x78, 0x7e,
+	0x3b, 0xb0, 0xa4, 0xf6, 0x84, 0x53, 0x7b, 0x88, 0x76, 0xaf, 0xa1, 0x36, 0xe3, 0xf0, 0x8b, 0x64,
+	0xb7, 0x24, 0xaf, 0x95, 0xec, 0xae, 0xdb, 0x0f, 0x8d, 0xe7, 0xb7, 0x03, 0x4b, 0x76, 0x1d, 0xce,
+	0xae, 0x8d, 0x5a, 0xab, 0xd9, 0xcd, 0xc9, 0x92, 0x41, 0x3d, 0x65, 0xf7, 0x35, 0x25, 0x6c, 0x1a,
+	0xd3, 0xeb, 0x87, 0xf6, 0x68, 0xae, 0x3f, 0xff, 0xa3, 0xa4, 0x9f, 0xed, 0xe9, 0x59, 0xd6, 0xac,
+	0xef, 0x63, 0xde, 0xb7, 0x85, 0x9a, 0xab, 0xfb, 0x9e, 0x48, 0xfc, 0x57, 0xf5, 0xdf, 0x2f, 0x9b,
+	0xca, 0x9f, 0x97, 0x4d, 0xe5, 0xaf, 0xcb, 0xa6, 0x32, 0xaa, 0xf0, 0x5e, 0x2f, 0xfe, 0x1d, 0x00,
+	0x9a, 0x4a, 0xbe, 0xc0, 0x15, 0x0a, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// SlasherStatusClient is the client API for SlasherStatus service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SlasherStatusClient interface {
+	GetStatus(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*SlasherStatusResponse, error)
+	ListDetectedSlashings(ctx context.Context, in *DetectedSlashingsRequest, opts ...grpc.CallOption) (*DetectedSlashingsResponse, error)
+	ListWatchedValidators(ctx context.Context, in *WatchedValidatorsRequest, opts ...grpc.CallOption) (*WatchedValidatorsResponse, error)
+	// Returns the feature flags of the slasher with their values and where they were set.
+	ListFeatures(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*v1.FeaturesResponse, error)
+}
+
+type slasherStatusClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSlasherStatusClient(cc *grpc.ClientConn) SlasherStatusClient {
+	return &slasherStatusClient{cc}
+}
+
+func (c *slasherStatusClient) GetStatus(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*SlasherStatusResponse, error) {
+	out := new(SlasherStatusResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.slashing.SlasherStatus/GetStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *slasherStatusClient) ListDetectedSlashings(ctx context.Context, in *DetectedSlashingsRequest, opts ...grpc.CallOption) (*DetectedSlashingsResponse, error) {
+	out := new(DetectedSlashingsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.slashing.SlasherStatus/ListDetectedSlashings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *slasherStatusClient) ListWatchedValidators(ctx context.Context, in *WatchedValidatorsRequest, opts ...grpc.CallOption) (*WatchedValidatorsResponse, error) {
+	out := new(WatchedValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.slashing.SlasherStatus/ListWatchedValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *slasherStatusClient) ListFeatures(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*v1.FeaturesResponse, error) {
+	out := new(v1.FeaturesResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.slashing.SlasherStatus/ListFeatures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SlasherStatusServer is the server API for SlasherStatus service.
+type SlasherStatusServer interface {
+	GetStatus(context.Context, *types.Empty) (*SlasherStatusResponse, error)
+	ListDetectedSlashings(context.Context, *DetectedSlashingsRequest) (*DetectedSlashingsResponse, error)
+	ListWatchedValidators(context.Context, *WatchedValidatorsRequest) (*WatchedValidatorsResponse, error)
+	// Returns the feature flags of the slasher with their values and where they were set.
+	ListFeatures(context.Context, *types.Empty) (*v1.FeaturesResponse, error)
+}
+
+// UnimplementedSlasherStatusServer can be embedded to have forward compatible implementations.
+type UnimplementedSlasherStatusServer struct {
+}
+
+func (*UnimplementedSlasherStatusServer) GetStatus(ctx context.Context, req *types.Empty) (*SlasherStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatus not implemented")
+}
+func (*UnimplementedSlasherStatusServer) ListDetectedSlashings(ctx context.Context, req *DetectedSlashingsRequest) (*DetectedSlashingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDetectedSlashings not implemented")
+}
+func (*UnimplementedSlasherStatusServer) ListWatchedValidators(ctx context.Context, req *WatchedValidatorsRequest) (*WatchedValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWatchedValidators not implemented")
+}
+func (*UnimplementedSlasherStatusServer) ListFeatures(ctx context.Context, req *types.Empty) (*v1.FeaturesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFeatures not implemented")
+}
+
+func RegisterSlasherStatusServer(s *grpc.Server, srv SlasherStatusServer) {
+	s.RegisterService(&_SlasherStatus_serviceDesc, srv)
+}
+
+func _SlasherStatus_GetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlasherStatusServer).GetStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.slashing.SlasherStatus/GetStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlasherStatusServer).GetStatus(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SlasherStatus_ListDetectedSlashings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetectedSlashingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlasherStatusServer).ListDetectedSlashings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.slashing.SlasherStatus/ListDetectedSlashings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlasherStatusServer).ListDetectedSlashings(ctx, req.(*DetectedSlashingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SlasherStatus_ListWatchedValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WatchedValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlasherStatusServer).ListWatchedValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.slashing.SlasherStatus/ListWatchedValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlasherStatusServer).ListWatchedValidators(ctx, req.(*WatchedValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SlasherStatus_ListFeatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlasherStatusServer).ListFeatures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.slashing.SlasherStatus/ListFeatures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlasherStatusServer).ListFeatures(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _SlasherStatus_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.slashing.SlasherStatus",
+	HandlerType: (*SlasherStatusServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetStatus",
+			Handler:    _SlasherStatus_GetStatus_Handler,
+		},
+		{
+			MethodName: "ListDetectedSlashings",
+			Handler:    _SlasherStatus_ListDetectedSlashings_Handler,
+		},
+		{
+			MethodName: "ListWatchedValidators",
+			Handler:    _SlasherStatus_ListWatchedValidators_Handler,
+		},
+		{
+			MethodName: "ListFeatures",
+			Handler:    _SlasherStatus_ListFeatures_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/slashing/status.proto",
+}
+
+func (m *SlasherStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlasherStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlasherStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ProposerSlashings != 0 {
+		i = encodeVarintStatus(dAtA, i, uint64(m.ProposerSlashings))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.AttesterSlashings != 0 {
+		i = encodeVarintStatus(dAtA, i, uint64(m.AttesterSlashings))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.StatusError) > 0 {
+		i -= len(m.StatusError)
+		copy(dAtA[i:], m.StatusError)
+		i = encodeVarintStatus(dAtA, i, uint64(len(m.StatusError)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Ready {
+		i--
+		if m.Ready {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LatestAttestationTargetEpoch != 0 {
+		i = encodeVarintStatus(dAtA, i, uint64(m.LatestAttestationTargetEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LatestEpochDetected != 0 {
+		i = encodeVarintStatus(dAtA, i, uint64(m.LatestEpochDetected))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainHeadEpoch != 0 {
+		i = encodeVarintStatus(dAtA, i, uint64(m.ChainHeadEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DetectedSlashingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DetectedSlashingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DetectedSlashingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PageToken) > 0 {
+		i -= len(m.PageToken)
+		copy(dAtA[i:], m.PageToken)
+		i = encodeVarintStatus(dAtA, i, uint64(len(m.PageToken)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintStatus(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Status != 0 {
+		i = encodeVarintStatus(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Kind != 0 {
+		i = encodeVarintStatus(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DetectedSlashing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DetectedSlashing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DetectedSlashing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ProposerSlashing != nil {
+		{
+			size, err := m.ProposerSlashing.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStatus(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.AttesterSlashing != nil {
+		{
+			size, err := m.AttesterSlashing.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStatus(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ValidatorIndices) > 0 {
+		dAtA4 := make([]byte, len(m.ValidatorIndices)*10)
+		var j3 int
+		for _, num := range m.ValidatorIndices {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintStatus(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintStatus(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Status != 0 {
+		i = encodeVarintStatus(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Kind != 0 {
+		i = encodeVarintStatus(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DetectedSlashingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DetectedSlashingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DetectedSlashingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TotalSize != 0 {
+		i = encodeVarintStatus(dAtA, i, uint64(m.TotalSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintStatus(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Slashings) > 0 {
+		for iNdEx := len(m.Slashings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Slashings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStatus(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchedValidatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchedValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchedValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PageToken) > 0 {
+		i -= len(m.PageToken)
+		copy(dAtA[i:], m.PageToken)
+		i = encodeVarintStatus(dAtA, i, uint64(len(m.PageToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintStatus(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Indices) > 0 {
+		dAtA6 := make([]byte, len(m.Indices)*10)
+		var j5 int
+		for _, num := range m.Indices {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintStatus(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchedValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchedValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchedValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ProposerSlashings != 0 {
+		i = encodeVarintStatus(dAtA, i, uint64(m.ProposerSlashings))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.AttesterSlashings != 0 {
+		i = encodeVarintStatus(dAtA, i, uint64(m.AttesterSlashings))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.HighestTargetEpoch != 0 {
+		i = encodeVarintStatus(dAtA, i, uint64(m.HighestTargetEpoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.HighestSourceEpoch != 0 {
+		i = encodeVarintStatus(dAtA, i, uint64(m.HighestSourceEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Attested {
+		i--
+		if m.Attested {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintStatus(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintStatus(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchedValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchedValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchedValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TotalSize != 0 {
+		i = encodeVarintStatus(dAtA, i, uint64(m.TotalSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintStatus(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStatus(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStatus(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStatus(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SlasherStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainHeadEpoch != 0 {
+		n += 1 + sovStatus(uint64(m.ChainHeadEpoch))
+	}
+	if m.LatestEpochDetected != 0 {
+		n += 1 + sovStatus(uint64(m.LatestEpochDetected))
+	}
+	if m.LatestAttestationTargetEpoch != 0 {
+		n += 1 + sovStatus(uint64(m.LatestAttestationTargetEpoch))
+	}
+	if m.Ready {
+		n += 2
+	}
+	l = len(m.StatusError)
+	if l > 0 {
+		n += 1 + l + sovStatus(uint64(l))
+	}
+	if m.AttesterSlashings != 0 {
+		n += 1 + sovStatus(uint64(m.AttesterSlashings))
+	}
+	if m.ProposerSlashings != 0 {
+		n += 1 + sovStatus(uint64(m.ProposerSlashings))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DetectedSlashingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Kind != 0 {
+		n += 1 + sovStatus(uint64(m.Kind))
+	}
+	if m.Status != 0 {
+		n += 1 + sovStatus(uint64(m.Status))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovStatus(uint64(m.PageSize))
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovStatus(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DetectedSlashing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Kind != 0 {
+		n += 1 + sovStatus(uint64(m.Kind))
+	}
+	if m.Status != 0 {
+		n += 1 + sovStatus(uint64(m.Status))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovStatus(uint64(m.Epoch))
+	}
+	if len(m.ValidatorIndices) > 0 {
+		l = 0
+		for _, e := range m.ValidatorIndices {
+			l += sovStatus(uint64(e))
+		}
+		n += 1 + sovStatus(uint64(l)) + l
+	}
+	if m.AttesterSlashing != nil {
+		l = m.AttesterSlashing.Size()
+		n += 1 + l + sovStatus(uint64(l))
+	}
+	if m.ProposerSlashing != nil {
+		l = m.ProposerSlashing.Size()
+		n += 1 + l + sovStatus(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DetectedSlashingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Slashings) > 0 {
+		for _, e := range m.Slashings {
+			l = e.Size()
+			n += 1 + l + sovStatus(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovStatus(uint64(l))
+	}
+	if m.TotalSize != 0 {
+		n += 1 + sovStatus(uint64(m.TotalSize))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WatchedValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Indices) > 0 {
+		l = 0
+		for _, e := range m.Indices {
+			l += sovStatus(uint64(e))
+		}
+		n += 1 + sovStatus(uint64(l)) + l
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovStatus(uint64(m.PageSize))
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovStatus(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WatchedValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovStatus(uint64(m.Index))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovStatus(uint64(l))
+	}
+	if m.Attested {
+		n += 2
+	}
+	if m.HighestSourceEpoch != 0 {
+		n += 1 + sovStatus(uint64(m.HighestSourceEpoch))
+	}
+	if m.HighestTargetEpoch != 0 {
+		n += 1 + sovStatus(uint64(m.HighestTargetEpoch))
+	}
+	if m.AttesterSlashings != 0 {
+		n += 1 + sovStatus(uint64(m.AttesterSlashings))
+	}
+	if m.ProposerSlashings != 0 {
+		n += 1 + sovStatus(uint64(m.ProposerSlashings))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WatchedValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovStatus(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovStatus(uint64(l))
+	}
+	if m.TotalSize != 0 {
+		n += 1 + sovStatus(uint64(m.TotalSize))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovStatus(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStatus(x uint64) (n int) {
+	return sovStatus(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SlasherStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStatus
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlasherStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlasherStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainHeadEpoch", wireType)
+			}
+			m.ChainHeadEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainHeadEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestEpochDetected", wireType)
+			}
+			m.LatestEpochDetected = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestEpochDetected |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestAttestationTargetEpoch", wireType)
+			}
+			m.LatestAttestationTargetEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestAttestationTargetEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ready", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ready = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStatus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStatus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StatusError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttesterSlashings", wireType)
+			}
+			m.AttesterSlashings = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttesterSlashings |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerSlashings", wireType)
+			}
+			m.ProposerSlashings = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposerSlashings |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStatus(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStatus
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStatus
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DetectedSlashingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStatus
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DetectedSlashingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DetectedSlashingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= SlashingKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= DetectedSlashingStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStatus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStatus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStatus(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStatus
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStatus
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DetectedSlashing) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStatus
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DetectedSlashing: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DetectedSlashing: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= SlashingKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= DetectedSlashingStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStatus
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ValidatorIndices = append(m.ValidatorIndices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStatus
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStatus
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStatus
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ValidatorIndices) == 0 {
+					m.ValidatorIndices = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStatus
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ValidatorIndices = append(m.ValidatorIndices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorIndices", wireType)
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttesterSlashing", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStatus
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStatus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AttesterSlashing == nil {
+				m.AttesterSlashing = &v1alpha1.AttesterSlashing{}
+			}
+			if err := m.AttesterSlashing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerSlashing", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStatus
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStatus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProposerSlashing == nil {
+				m.ProposerSlashing = &v1alpha1.ProposerSlashing{}
+			}
+			if err := m.ProposerSlashing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStatus(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStatus
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStatus
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DetectedSlashingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStatus
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DetectedSlashingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DetectedSlashingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStatus
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStatus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Slashings = append(m.Slashings, &DetectedSlashing{})
+			if err := m.Slashings[len(m.Slashings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStatus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStatus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
+			}
+			m.TotalSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStatus(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStatus
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStatus
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WatchedValidatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStatus
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchedValidatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchedValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStatus
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Indices = append(m.Indices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStatus
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStatus
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStatus
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Indices) == 0 {
+					m.Indices = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStatus
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Indices = append(m.Indices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indices", wireType)
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStatus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStatus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStatus(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStatus
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStatus
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WatchedValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStatus
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchedValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchedValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStatus
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStatus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attested", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Attested = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighestSourceEpoch", wireType)
+			}
+			m.HighestSourceEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HighestSourceEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighestTargetEpoch", wireType)
+			}
+			m.HighestTargetEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HighestTargetEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttesterSlashings", wireType)
+			}
+			m.AttesterSlashings = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttesterSlashings |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerSlashings", wireType)
+			}
+			m.ProposerSlashings = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposerSlashings |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStatus(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStatus
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStatus
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WatchedValidatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStatus
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchedValidatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchedValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStatus
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStatus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, &WatchedValidator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStatus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStatus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
+			}
+			m.TotalSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStatus(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStatus
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStatus
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStatus(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStatus
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStatus
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStatus
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStatus
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStatus        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStatus          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStatus = fmt.Errorf("proto: unexpected end of group")
+)
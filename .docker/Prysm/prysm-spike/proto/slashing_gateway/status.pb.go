@@ -0,0 +1,1194 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.13.0
+// source: proto/slashing/status.proto
+
+package ethereum_slashing
+
+import (
+	context "context"
+	reflect "reflect"
+	sync "sync"
+
+	proto "github.com/golang/protobuf/proto"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	v1alpha1 "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	v1 "github.com/prysmaticlabs/prysm/proto/shared/v1"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type SlashingKind int32
+
+const (
+	SlashingKind_ANY_KIND SlashingKind = 0
+	SlashingKind_ATTESTER SlashingKind = 1
+	SlashingKind_PROPOSER SlashingKind = 2
+)
+
+// Enum value maps for SlashingKind.
+var (
+	SlashingKind_name = map[int32]string{
+		0: "ANY_KIND",
+		1: "ATTESTER",
+		2: "PROPOSER",
+	}
+	SlashingKind_value = map[string]int32{
+		"ANY_KIND": 0,
+		"ATTESTER": 1,
+		"PROPOSER": 2,
+	}
+)
+
+func (x SlashingKind) Enum() *SlashingKind {
+	p := new(SlashingKind)
+	*p = x
+	return p
+}
+
+func (x SlashingKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SlashingKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_slashing_status_proto_enumTypes[0].Descriptor()
+}
+
+func (SlashingKind) Type() protoreflect.EnumType {
+	return &file_proto_slashing_status_proto_enumTypes[0]
+}
+
+func (x SlashingKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SlashingKind.Descriptor instead.
+func (SlashingKind) EnumDescriptor() ([]byte, []int) {
+	return file_proto_slashing_status_proto_rawDescGZIP(), []int{0}
+}
+
+type DetectedSlashingStatus int32
+
+const (
+	DetectedSlashingStatus_ANY_STATUS DetectedSlashingStatus = 0
+	DetectedSlashingStatus_ACTIVE     DetectedSlashingStatus = 1
+	DetectedSlashingStatus_INCLUDED   DetectedSlashingStatus = 2
+	DetectedSlashingStatus_REVERTED   DetectedSlashingStatus = 3
+)
+
+// Enum value maps for DetectedSlashingStatus.
+var (
+	DetectedSlashingStatus_name = map[int32]string{
+		0: "ANY_STATUS",
+		1: "ACTIVE",
+		2: "INCLUDED",
+		3: "REVERTED",
+	}
+	DetectedSlashingStatus_value = map[string]int32{
+		"ANY_STATUS": 0,
+		"ACTIVE":     1,
+		"INCLUDED":   2,
+		"REVERTED":   3,
+	}
+)
+
+func (x DetectedSlashingStatus) Enum() *DetectedSlashingStatus {
+	p := new(DetectedSlashingStatus)
+	*p = x
+	return p
+}
+
+func (x DetectedSlashingStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DetectedSlashingStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_slashing_status_proto_enumTypes[1].Descriptor()
+}
+
+func (DetectedSlashingStatus) Type() protoreflect.EnumType {
+	return &file_proto_slashing_status_proto_enumTypes[1]
+}
+
+func (x DetectedSlashingStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DetectedSlashingStatus.Descriptor instead.
+func (DetectedSlashingStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_slashing_status_proto_rawDescGZIP(), []int{1}
+}
+
+type SlasherStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainHeadEpoch               uint64 `protobuf:"varint,1,opt,name=chain_head_epoch,json=chainHeadEpoch,proto3" json:"chain_head_epoch,omitempty"`
+	LatestEpochDetected          uint64 `protobuf:"varint,2,opt,name=latest_epoch_detected,json=latestEpochDetected,proto3" json:"latest_epoch_detected,omitempty"`
+	LatestAttestationTargetEpoch uint64 `protobuf:"varint,3,opt,name=latest_attestation_target_epoch,json=latestAttestationTargetEpoch,proto3" json:"latest_attestation_target_epoch,omitempty"`
+	Ready                        bool   `protobuf:"varint,4,opt,name=ready,proto3" json:"ready,omitempty"`
+	StatusError                  string `protobuf:"bytes,5,opt,name=status_error,json=statusError,proto3" json:"status_error,omitempty"`
+	AttesterSlashings            uint64 `protobuf:"varint,6,opt,name=attester_slashings,json=attesterSlashings,proto3" json:"attester_slashings,omitempty"`
+	ProposerSlashings            uint64 `protobuf:"varint,7,opt,name=proposer_slashings,json=proposerSlashings,proto3" json:"proposer_slashings,omitempty"`
+}
+
+func (x *SlasherStatusResponse) Reset() {
+	*x = SlasherStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_slashing_status_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SlasherStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlasherStatusResponse) ProtoMessage() {}
+
+func (x *SlasherStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_slashing_status_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlasherStatusResponse.ProtoReflect.Descriptor instead.
+func (*SlasherStatusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_slashing_status_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SlasherStatusResponse) GetChainHeadEpoch() uint64 {
+	if x != nil {
+		return x.ChainHeadEpoch
+	}
+	return 0
+}
+
+func (x *SlasherStatusResponse) GetLatestEpochDetected() uint64 {
+	if x != nil {
+		return x.LatestEpochDetected
+	}
+	return 0
+}
+
+func (x *SlasherStatusResponse) GetLatestAttestationTargetEpoch() uint64 {
+	if x != nil {
+		return x.LatestAttestationTargetEpoch
+	}
+	return 0
+}
+
+func (x *SlasherStatusResponse) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+func (x *SlasherStatusResponse) GetStatusError() string {
+	if x != nil {
+		return x.StatusError
+	}
+	return ""
+}
+
+func (x *SlasherStatusResponse) GetAttesterSlashings() uint64 {
+	if x != nil {
+		return x.AttesterSlashings
+	}
+	return 0
+}
+
+func (x *SlasherStatusResponse) GetProposerSlashings() uint64 {
+	if x != nil {
+		return x.ProposerSlashings
+	}
+	return 0
+}
+
+type DetectedSlashingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind      SlashingKind           `protobuf:"varint,1,opt,name=kind,proto3,enum=ethereum.slashing.SlashingKind" json:"kind,omitempty"`
+	Status    DetectedSlashingStatus `protobuf:"varint,2,opt,name=status,proto3,enum=ethereum.slashing.DetectedSlashingStatus" json:"status,omitempty"`
+	PageSize  int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *DetectedSlashingsRequest) Reset() {
+	*x = DetectedSlashingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_slashing_status_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DetectedSlashingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectedSlashingsRequest) ProtoMessage() {}
+
+func (x *DetectedSlashingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_slashing_status_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectedSlashingsRequest.ProtoReflect.Descriptor instead.
+func (*DetectedSlashingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_slashing_status_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DetectedSlashingsRequest) GetKind() SlashingKind {
+	if x != nil {
+		return x.Kind
+	}
+	return SlashingKind_ANY_KIND
+}
+
+func (x *DetectedSlashingsRequest) GetStatus() DetectedSlashingStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DetectedSlashingStatus_ANY_STATUS
+}
+
+func (x *DetectedSlashingsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *DetectedSlashingsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type DetectedSlashing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind             SlashingKind               `protobuf:"varint,1,opt,name=kind,proto3,enum=ethereum.slashing.SlashingKind" json:"kind,omitempty"`
+	Status           DetectedSlashingStatus     `protobuf:"varint,2,opt,name=status,proto3,enum=ethereum.slashing.DetectedSlashingStatus" json:"status,omitempty"`
+	Epoch            uint64                     `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	ValidatorIndices []uint64                   `protobuf:"varint,4,rep,packed,name=validator_indices,json=validatorIndices,proto3" json:"validator_indices,omitempty"`
+	AttesterSlashing *v1alpha1.AttesterSlashing `protobuf:"bytes,5,opt,name=attester_slashing,json=attesterSlashing,proto3" json:"attester_slashing,omitempty"`
+	ProposerSlashing *v1alpha1.ProposerSlashing `protobuf:"bytes,6,opt,name=proposer_slashing,json=proposerSlashing,proto3" json:"proposer_slashing,omitempty"`
+}
+
+func (x *DetectedSlashing) Reset() {
+	*x = DetectedSlashing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_slashing_status_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DetectedSlashing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectedSlashing) ProtoMessage() {}
+
+func (x *DetectedSlashing) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_slashing_status_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectedSlashing.ProtoReflect.Descriptor instead.
+func (*DetectedSlashing) Descriptor() ([]byte, []int) {
+	return file_proto_slashing_status_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DetectedSlashing) GetKind() SlashingKind {
+	if x != nil {
+		return x.Kind
+	}
+	return SlashingKind_ANY_KIND
+}
+
+func (x *DetectedSlashing) GetStatus() DetectedSlashingStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DetectedSlashingStatus_ANY_STATUS
+}
+
+func (x *DetectedSlashing) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *DetectedSlashing) GetValidatorIndices() []uint64 {
+	if x != nil {
+		return x.ValidatorIndices
+	}
+	return nil
+}
+
+func (x *DetectedSlashing) GetAttesterSlashing() *v1alpha1.AttesterSlashing {
+	if x != nil {
+		return x.AttesterSlashing
+	}
+	return nil
+}
+
+func (x *DetectedSlashing) GetProposerSlashing() *v1alpha1.ProposerSlashing {
+	if x != nil {
+		return x.ProposerSlashing
+	}
+	return nil
+}
+
+type DetectedSlashingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slashings     []*DetectedSlashing `protobuf:"bytes,1,rep,name=slashings,proto3" json:"slashings,omitempty"`
+	NextPageToken string              `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize     int32               `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+}
+
+func (x *DetectedSlashingsResponse) Reset() {
+	*x = DetectedSlashingsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_slashing_status_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DetectedSlashingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectedSlashingsResponse) ProtoMessage() {}
+
+func (x *DetectedSlashingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_slashing_status_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectedSlashingsResponse.ProtoReflect.Descriptor instead.
+func (*DetectedSlashingsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_slashing_status_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DetectedSlashingsResponse) GetSlashings() []*DetectedSlashing {
+	if x != nil {
+		return x.Slashings
+	}
+	return nil
+}
+
+func (x *DetectedSlashingsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *DetectedSlashingsResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type WatchedValidatorsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Indices   []uint64 `protobuf:"varint,1,rep,packed,name=indices,proto3" json:"indices,omitempty"`
+	PageSize  int32    `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string   `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *WatchedValidatorsRequest) Reset() {
+	*x = WatchedValidatorsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_slashing_status_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchedValidatorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchedValidatorsRequest) ProtoMessage() {}
+
+func (x *WatchedValidatorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_slashing_status_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchedValidatorsRequest.ProtoReflect.Descriptor instead.
+func (*WatchedValidatorsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_slashing_status_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *WatchedValidatorsRequest) GetIndices() []uint64 {
+	if x != nil {
+		return x.Indices
+	}
+	return nil
+}
+
+func (x *WatchedValidatorsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *WatchedValidatorsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type WatchedValidator struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index              uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	PublicKey          []byte `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Attested           bool   `protobuf:"varint,3,opt,name=attested,proto3" json:"attested,omitempty"`
+	HighestSourceEpoch uint64 `protobuf:"varint,4,opt,name=highest_source_epoch,json=highestSourceEpoch,proto3" json:"highest_source_epoch,omitempty"`
+	HighestTargetEpoch uint64 `protobuf:"varint,5,opt,name=highest_target_epoch,json=highestTargetEpoch,proto3" json:"highest_target_epoch,omitempty"`
+	AttesterSlashings  uint64 `protobuf:"varint,6,opt,name=attester_slashings,json=attesterSlashings,proto3" json:"attester_slashings,omitempty"`
+	ProposerSlashings  uint64 `protobuf:"varint,7,opt,name=proposer_slashings,json=proposerSlashings,proto3" json:"proposer_slashings,omitempty"`
+}
+
+func (x *WatchedValidator) Reset() {
+	*x = WatchedValidator{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_slashing_status_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchedValidator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchedValidator) ProtoMessage() {}
+
+func (x *WatchedValidator) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_slashing_status_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchedValidator.ProtoReflect.Descriptor instead.
+func (*WatchedValidator) Descriptor() ([]byte, []int) {
+	return file_proto_slashing_status_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *WatchedValidator) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *WatchedValidator) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *WatchedValidator) GetAttested() bool {
+	if x != nil {
+		return x.Attested
+	}
+	return false
+}
+
+func (x *WatchedValidator) GetHighestSourceEpoch() uint64 {
+	if x != nil {
+		return x.HighestSourceEpoch
+	}
+	return 0
+}
+
+func (x *WatchedValidator) GetHighestTargetEpoch() uint64 {
+	if x != nil {
+		return x.HighestTargetEpoch
+	}
+	return 0
+}
+
+func (x *WatchedValidator) GetAttesterSlashings() uint64 {
+	if x != nil {
+		return x.AttesterSlashings
+	}
+	return 0
+}
+
+func (x *WatchedValidator) GetProposerSlashings() uint64 {
+	if x != nil {
+		return x.ProposerSlashings
+	}
+	return 0
+}
+
+type WatchedValidatorsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Validators    []*WatchedValidator `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
+	NextPageToken string              `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize     int32               `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+}
+
+func (x *WatchedValidatorsResponse) Reset() {
+	*x = WatchedValidatorsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_slashing_status_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchedValidatorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchedValidatorsResponse) ProtoMessage() {}
+
+func (x *WatchedValidatorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_slashing_status_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchedValidatorsResponse.ProtoReflect.Descriptor instead.
+func (*WatchedValidatorsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_slashing_status_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *WatchedValidatorsResponse) GetValidators() []*WatchedValidator {
+	if x != nil {
+		return x.Validators
+	}
+	return nil
+}
+
+func (x *WatchedValidatorsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *WatchedValidatorsResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+var File_proto_slashing_status_proto protoreflect.FileDescriptor
+
+var file_proto_slashing_status_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67,
+	0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x11, 0x65,
+	0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67,
+	0x1a, 0x1f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x62,
+	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e,
+	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd3, 0x02, 0x0a, 0x15, 0x53,
+	0x6c, 0x61, 0x73, 0x68, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x68, 0x65,
+	0x61, 0x64, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x48, 0x65, 0x61, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x32,
+	0x0a, 0x15, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x64,
+	0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x6c,
+	0x61, 0x74, 0x65, 0x73, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x12, 0x45, 0x0a, 0x1f, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x74, 0x74,
+	0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x1c, 0x6c, 0x61, 0x74,
+	0x65, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61,
+	0x64, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x12,
+	0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x2d, 0x0a, 0x12, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x73,
+	0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11,
+	0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67,
+	0x73, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x6c,
+	0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x73,
+	0x22, 0xce, 0x01, 0x0a, 0x18, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x6c, 0x61,
+	0x73, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x65, 0x74,
+	0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e,
+	0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x12, 0x41, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x29, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x73, 0x6c,
+	0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53,
+	0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0xf9, 0x02, 0x0a, 0x10, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x6c,
+	0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x33, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e,
+	0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e,
+	0x67, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x41, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x65, 0x74,
+	0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e,
+	0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x12, 0x2b, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x5f, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x04, 0x52,
+	0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x65,
+	0x73, 0x12, 0x54, 0x0a, 0x11, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x6c,
+	0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x65,
+	0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x53, 0x6c, 0x61,
+	0x73, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x53,
+	0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x54, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x65, 0x72, 0x5f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74,
+	0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x65, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x70, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x65, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x22, 0xa5, 0x01,
+	0x0a, 0x19, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69,
+	0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x09, 0x73,
+	0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69,
+	0x6e, 0x67, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x6c, 0x61, 0x73, 0x68,
+	0x69, 0x6e, 0x67, 0x52, 0x09, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x26,
+	0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x70, 0x0a, 0x18, 0x57, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x04, 0x52, 0x07, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xa5, 0x02, 0x0a, 0x10, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65,
+	0x79, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x64, 0x12, 0x30, 0x0a,
+	0x14, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x68, 0x69, 0x67,
+	0x68, 0x65, 0x73, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12,
+	0x30, 0x0a, 0x14, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x68,
+	0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x45, 0x70, 0x6f, 0x63,
+	0x68, 0x12, 0x2d, 0x0a, 0x12, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x6c,
+	0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x61,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x73,
+	0x12, 0x2d, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x6c, 0x61,
+	0x73, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x70, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x22,
+	0xa7, 0x01, 0x0a, 0x19, 0x57, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a,
+	0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x73, 0x6c, 0x61,
+	0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x2a, 0x38, 0x0a, 0x0c, 0x53, 0x6c, 0x61,
+	0x73, 0x68, 0x69, 0x6e, 0x67, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x4e, 0x59,
+	0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x54, 0x54, 0x45, 0x53,
+	0x54, 0x45, 0x52, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x45,
+	0x52, 0x10, 0x02, 0x2a, 0x50, 0x0a, 0x16, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53,
+	0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0e, 0x0a,
+	0x0a, 0x41, 0x4e, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x10, 0x00, 0x12, 0x0a, 0x0a,
+	0x06, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x4e, 0x43,
+	0x4c, 0x55, 0x44, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x56, 0x45, 0x52,
+	0x54, 0x45, 0x44, 0x10, 0x03, 0x32, 0xb7, 0x04, 0x0a, 0x0d, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x73, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x28, 0x2e, 0x65,
+	0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67,
+	0x2e, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c,
+	0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x6c,
+	0x61, 0x73, 0x68, 0x65, 0x72, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x9b, 0x01, 0x0a,
+	0x15, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x6c, 0x61,
+	0x73, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2b, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75,
+	0x6d, 0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x73,
+	0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x65, 0x74, 0x68, 0x2f,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x72,
+	0x2f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x9c, 0x01, 0x0a, 0x15, 0x4c,
+	0x69, 0x73, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x73, 0x12, 0x2b, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e,
+	0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2c, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x73, 0x6c, 0x61,
+	0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x72, 0x2f, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x74, 0x0a, 0x0c, 0x4c, 0x69, 0x73,
+	0x74, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x1a, 0x24, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12,
+	0x1e, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73,
+	0x6c, 0x61, 0x73, 0x68, 0x65, 0x72, 0x2f, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_slashing_status_proto_rawDescOnce sync.Once
+	file_proto_slashing_status_proto_rawDescData = file_proto_slashing_status_proto_rawDesc
+)
+
+func file_proto_slashing_status_proto_rawDescGZIP() []byte {
+	file_proto_slashing_status_proto_rawDescOnce.Do(func() {
+		file_proto_slashing_status_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_slashing_status_proto_rawDescData)
+	})
+	return file_proto_slashing_status_proto_rawDescData
+}
+
+var file_proto_slashing_status_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_proto_slashing_status_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_proto_slashing_status_proto_goTypes = []interface{}{
+	(SlashingKind)(0),                 // 0: ethereum.slashing.SlashingKind
+	(DetectedSlashingStatus)(0),       // 1: ethereum.slashing.DetectedSlashingStatus
+	(*SlasherStatusResponse)(nil),     // 2: ethereum.slashing.SlasherStatusResponse
+	(*DetectedSlashingsRequest)(nil),  // 3: ethereum.slashing.DetectedSlashingsRequest
+	(*DetectedSlashing)(nil),          // 4: ethereum.slashing.DetectedSlashing
+	(*DetectedSlashingsResponse)(nil), // 5: ethereum.slashing.DetectedSlashingsResponse
+	(*WatchedValidatorsRequest)(nil),  // 6: ethereum.slashing.WatchedValidatorsRequest
+	(*WatchedValidator)(nil),          // 7: ethereum.slashing.WatchedValidator
+	(*WatchedValidatorsResponse)(nil), // 8: ethereum.slashing.WatchedValidatorsResponse
+	(*v1alpha1.AttesterSlashing)(nil), // 9: ethereum.eth.v1alpha1.AttesterSlashing
+	(*v1alpha1.ProposerSlashing)(nil), // 10: ethereum.eth.v1alpha1.ProposerSlashing
+	(*empty.Empty)(nil),               // 11: google.protobuf.Empty
+	(*v1.FeaturesResponse)(nil),       // 12: ethereum.shared.v1.FeaturesResponse
+}
+var file_proto_slashing_status_proto_depIdxs = []int32{
+	0,  // 0: ethereum.slashing.DetectedSlashingsRequest.kind:type_name -> ethereum.slashing.SlashingKind
+	1,  // 1: ethereum.slashing.DetectedSlashingsRequest.status:type_name -> ethereum.slashing.DetectedSlashingStatus
+	0,  // 2: ethereum.slashing.DetectedSlashing.kind:type_name -> ethereum.slashing.SlashingKind
+	1,  // 3: ethereum.slashing.DetectedSlashing.status:type_name -> ethereum.slashing.DetectedSlashingStatus
+	9,  // 4: ethereum.slashing.DetectedSlashing.attester_slashing:type_name -> ethereum.eth.v1alpha1.AttesterSlashing
+	10, // 5: ethereum.slashing.DetectedSlashing.proposer_slashing:type_name -> ethereum.eth.v1alpha1.ProposerSlashing
+	4,  // 6: ethereum.slashing.DetectedSlashingsResponse.slashings:type_name -> ethereum.slashing.DetectedSlashing
+	7,  // 7: ethereum.slashing.WatchedValidatorsResponse.validators:type_name -> ethereum.slashing.WatchedValidator
+	11, // 8: ethereum.slashing.SlasherStatus.GetStatus:input_type -> google.protobuf.Empty
+	3,  // 9: ethereum.slashing.SlasherStatus.ListDetectedSlashings:input_type -> ethereum.slashing.DetectedSlashingsRequest
+	6,  // 10: ethereum.slashing.SlasherStatus.ListWatchedValidators:input_type -> ethereum.slashing.WatchedValidatorsRequest
+	11, // 11: ethereum.slashing.SlasherStatus.ListFeatures:input_type -> google.protobuf.Empty
+	2,  // 12: ethereum.slashing.SlasherStatus.GetStatus:output_type -> ethereum.slashing.SlasherStatusResponse
+	5,  // 13: ethereum.slashing.SlasherStatus.ListDetectedSlashings:output_type -> ethereum.slashing.DetectedSlashingsResponse
+	8,  // 14: ethereum.slashing.SlasherStatus.ListWatchedValidators:output_type -> ethereum.slashing.WatchedValidatorsResponse
+	12, // 15: ethereum.slashing.SlasherStatus.ListFeatures:output_type -> ethereum.shared.v1.FeaturesResponse
+	12, // [12:16] is the sub-list for method output_type
+	8,  // [8:12] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_proto_slashing_status_proto_init() }
+func file_proto_slashing_status_proto_init() {
+	if File_proto_slashing_status_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_slashing_status_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SlasherStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_slashing_status_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DetectedSlashingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_slashing_status_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DetectedSlashing); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_slashing_status_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DetectedSlashingsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_slashing_status_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchedValidatorsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_slashing_status_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchedValidator); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_slashing_status_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchedValidatorsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_slashing_status_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_slashing_status_proto_goTypes,
+		DependencyIndexes: file_proto_slashing_status_proto_depIdxs,
+		EnumInfos:         file_proto_slashing_status_proto_enumTypes,
+		MessageInfos:      file_proto_slashing_status_proto_msgTypes,
+	}.Build()
+	File_proto_slashing_status_proto = out.File
+	file_proto_slashing_status_proto_rawDesc = nil
+	file_proto_slashing_status_proto_goTypes = nil
+	file_proto_slashing_status_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// SlasherStatusClient is the client API for SlasherStatus service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SlasherStatusClient interface {
+	GetStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*SlasherStatusResponse, error)
+	ListDetectedSlashings(ctx context.Context, in *DetectedSlashingsRequest, opts ...grpc.CallOption) (*DetectedSlashingsResponse, error)
+	ListWatchedValidators(ctx context.Context, in *WatchedValidatorsRequest, opts ...grpc.CallOption) (*WatchedValidatorsResponse, error)
+	// Returns the feature flags of the slasher with their values and where they were set.
+	ListFeatures(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*v1.FeaturesResponse, error)
+}
+
+type slasherStatusClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSlasherStatusClient(cc grpc.ClientConnInterface) SlasherStatusClient {
+	return &slasherStatusClient{cc}
+}
+
+func (c *slasherStatusClient) GetStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*SlasherStatusResponse, error) {
+	out := new(SlasherStatusResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.slashing.SlasherStatus/GetStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *slasherStatusClient) ListDetectedSlashings(ctx context.Context, in *DetectedSlashingsRequest, opts ...grpc.CallOption) (*DetectedSlashingsResponse, error) {
+	out := new(DetectedSlashingsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.slashing.SlasherStatus/ListDetectedSlashings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *slasherStatusClient) ListWatchedValidators(ctx context.Context, in *WatchedValidatorsRequest, opts ...grpc.CallOption) (*WatchedValidatorsResponse, error) {
+	out := new(WatchedValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.slashing.SlasherStatus/ListWatchedValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *slasherStatusClient) ListFeatures(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*v1.FeaturesResponse, error) {
+	out := new(v1.FeaturesResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.slashing.SlasherStatus/ListFeatures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SlasherStatusServer is the server API for SlasherStatus service.
+type SlasherStatusServer interface {
+	GetStatus(context.Context, *empty.Empty) (*SlasherStatusResponse, error)
+	ListDetectedSlashings(context.Context, *DetectedSlashingsRequest) (*DetectedSlashingsResponse, error)
+	ListWatchedValidators(context.Context, *WatchedValidatorsRequest) (*WatchedValidatorsResponse, error)
+	// Returns the feature flags of the slasher with their values and where they were set.
+	ListFeatures(context.Context, *empty.Empty) (*v1.FeaturesResponse, error)
+}
+
+// UnimplementedSlasherStatusServer can be embedded to have forward compatible implementations.
+type UnimplementedSlasherStatusServer struct {
+}
+
+func (*UnimplementedSlasherStatusServer) GetStatus(context.Context, *empty.Empty) (*SlasherStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatus not implemented")
+}
+func (*UnimplementedSlasherStatusServer) ListDetectedSlashings(context.Context, *DetectedSlashingsRequest) (*DetectedSlashingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDetectedSlashings not implemented")
+}
+func (*UnimplementedSlasherStatusServer) ListWatchedValidators(context.Context, *WatchedValidatorsRequest) (*WatchedValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWatchedValidators not implemented")
+}
+func (*UnimplementedSlasherStatusServer) ListFeatures(context.Context, *empty.Empty) (*v1.FeaturesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFeatures not implemented")
+}
+
+func RegisterSlasherStatusServer(s *grpc.Server, srv SlasherStatusServer) {
+	s.RegisterService(&_SlasherStatus_serviceDesc, srv)
+}
+
+func _SlasherStatus_GetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlasherStatusServer).GetStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.slashing.SlasherStatus/GetStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlasherStatusServer).GetStatus(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SlasherStatus_ListDetectedSlashings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetectedSlashingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlasherStatusServer).ListDetectedSlashings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.slashing.SlasherStatus/ListDetectedSlashings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlasherStatusServer).ListDetectedSlashings(ctx, req.(*DetectedSlashingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SlasherStatus_ListWatchedValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WatchedValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlasherStatusServer).ListWatchedValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.slashing.SlasherStatus/ListWatchedValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlasherStatusServer).ListWatchedValidators(ctx, req.(*WatchedValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SlasherStatus_ListFeatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlasherStatusServer).ListFeatures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.slashing.SlasherStatus/ListFeatures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlasherStatusServer).ListFeatures(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _SlasherStatus_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.slashing.SlasherStatus",
+	HandlerType: (*SlasherStatusServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetStatus",
+			Handler:    _SlasherStatus_GetStatus_Handler,
+		},
+		{
+			MethodName: "ListDetectedSlashings",
+			Handler:    _SlasherStatus_ListDetectedSlashings_Handler,
+		},
+		{
+			MethodName: "ListWatchedValidators",
+			Handler:    _SlasherStatus_ListWatchedValidators_Handler,
+		},
+		{
+			MethodName: "ListFeatures",
+			Handler:    _SlasherStatus_ListFeatures_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/slashing/status.proto",
+}
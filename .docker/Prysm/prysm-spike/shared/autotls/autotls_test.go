@@ -0,0 +1,182 @@
+package autotls
+
+import (
+	"crypto/tls"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestLoadOrCreate(t *testing.T) {
+	dir := t.TempDir()
+	ca, err := LoadOrCreateCA(filepath.Join(dir, "ca"))
+	require.NoError(t, err)
+	certDir := filepath.Join(dir, "tls")
+	cert, err := LoadOrCreate(certDir, []string{"localhost", "beacon", "172.18.0.2"}, ca)
+	require.NoError(t, err)
+	require.NoError(t, cert.Leaf.VerifyHostname("beacon"))
+	require.NoError(t, cert.Leaf.VerifyHostname("172.18.0.2"))
+	require.NoError(t, cert.Leaf.CheckSignatureFrom(ca.Cert))
+
+	// The persisted certificate is loaded as long as it covers the hosts.
+	loaded, err := LoadOrCreate(certDir, []string{"beacon"}, ca)
+	require.NoError(t, err)
+	assert.DeepEqual(t, cert.Certificate, loaded.Certificate)
+
+	// A new address reissues the certificate.
+	reissued, err := LoadOrCreate(certDir, []string{"beacon", "172.18.0.3"}, ca)
+	require.NoError(t, err)
+	require.NoError(t, reissued.Leaf.VerifyHostname("172.18.0.3"))
+	assert.NotEqual(t, string(cert.Leaf.Raw), string(reissued.Leaf.Raw))
+
+	// So does a new certificate authority.
+	otherCA, err := LoadOrCreateCA(filepath.Join(dir, "other_ca"))
+	require.NoError(t, err)
+	reissued, err = LoadOrCreate(certDir, []string{"beacon"}, otherCA)
+	require.NoError(t, err)
+	require.NoError(t, reissued.Leaf.CheckSignatureFrom(otherCA.Cert))
+
+	// The persisted certificate authority is loaded.
+	loadedCA, err := LoadOrCreateCA(filepath.Join(dir, "ca"))
+	require.NoError(t, err)
+	assert.DeepEqual(t, ca.Cert.Raw, loadedCA.Cert.Raw)
+}
+
+func TestLoadOrCreateCA_Concurrent(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "ca")
+	const processes = 8
+	cas := make([]*CA, processes)
+	errs := make([]error, processes)
+	var wg sync.WaitGroup
+	for i := 0; i < processes; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			cas[i], errs[i] = LoadOrCreateCA(dir)
+		}(i)
+	}
+	wg.Wait()
+	for i := 0; i < processes; i++ {
+		require.NoError(t, errs[i])
+		assert.DeepEqual(t, cas[0].Cert.Raw, cas[i].Cert.Raw)
+	}
+	assert.Equal(t, false, fileutil.FileExists(filepath.Join(dir, caLockFile)), "Lock file was not removed")
+
+	// A process waiting for the lock loads the CA written by the one holding it.
+	other := filepath.Join(t.TempDir(), "ca")
+	require.NoError(t, os.MkdirAll(other, 0700))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(other, caLockFile), nil, 0600))
+	go func() {
+		time.Sleep(5 * caLockPollInterval)
+		for _, f := range []string{caKeyFile, caCertFile} {
+			enc, err := ioutil.ReadFile(filepath.Join(dir, f))
+			assert.NoError(t, err)
+			assert.NoError(t, ioutil.WriteFile(filepath.Join(other, f), enc, 0600))
+		}
+		assert.NoError(t, os.Remove(filepath.Join(other, caLockFile)))
+	}()
+	loaded, err := LoadOrCreateCA(other)
+	require.NoError(t, err)
+	assert.DeepEqual(t, cas[0].Cert.Raw, loaded.Cert.Raw)
+}
+
+func TestLoadOrCreateCA_MismatchedKey(t *testing.T) {
+	dir := t.TempDir()
+	_, err := LoadOrCreateCA(filepath.Join(dir, "ca"))
+	require.NoError(t, err)
+	_, err = LoadOrCreateCA(filepath.Join(dir, "other_ca"))
+	require.NoError(t, err)
+
+	// The key of another CA does not match the certificate.
+	enc, err := ioutil.ReadFile(filepath.Join(dir, "other_ca", caKeyFile))
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "ca", caKeyFile), enc, 0600))
+	_, err = LoadOrCreateCA(filepath.Join(dir, "ca"))
+	assert.ErrorContains(t, "does not match its key", err)
+}
+
+func TestHandshake(t *testing.T) {
+	dir := t.TempDir()
+	ca, err := LoadOrCreateCA(filepath.Join(dir, "ca"))
+	require.NoError(t, err)
+	otherCA, err := LoadOrCreateCA(filepath.Join(dir, "other_ca"))
+	require.NoError(t, err)
+	serverCert, err := LoadOrCreate(filepath.Join(dir, "server"), []string{"beacon"}, ca)
+	require.NoError(t, err)
+	clientCert, err := LoadOrCreate(filepath.Join(dir, "client"), []string{"validator"}, ca)
+	require.NoError(t, err)
+	otherCert, err := LoadOrCreate(filepath.Join(dir, "other"), []string{"beacon"}, otherCA)
+	require.NoError(t, err)
+
+	handshake := func(serverCfg, clientCfg *tls.Config) (error, error) {
+		// Loopback connections are buffered, unlike net.Pipe, so the alert of a server rejecting
+		// the client after it finished its side of a TLS 1.3 handshake does not block.
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, listener.Close())
+		}()
+		errs := make(chan error, 1)
+		go func() {
+			conn, err := listener.Accept()
+			if err != nil {
+				errs <- err
+				return
+			}
+			defer func() {
+				_ = conn.Close()
+			}()
+			errs <- tls.Server(conn, serverCfg).Handshake()
+		}()
+		conn, err := net.Dial("tcp", listener.Addr().String())
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, conn.Close())
+		}()
+		clientErr := tls.Client(conn, clientCfg).Handshake()
+		return <-errs, clientErr
+	}
+	withName := func(cfg *tls.Config) *tls.Config {
+		cfg.ServerName = "beacon"
+		return cfg
+	}
+
+	serverErr, clientErr := handshake(ServerConfig(serverCert, ca), withName(ClientConfig(clientCert, ca)))
+	require.NoError(t, serverErr)
+	require.NoError(t, clientErr)
+
+	// A server with a certificate issued by another authority is rejected.
+	_, clientErr = handshake(ServerConfig(otherCert, otherCA), withName(ClientConfig(clientCert, ca)))
+	assert.ErrorContains(t, "certificate signed by unknown authority", clientErr)
+
+	// So is a client with a certificate issued by another authority.
+	serverErr, _ = handshake(ServerConfig(serverCert, ca), withName(ClientConfig(otherCert, ca)))
+	assert.ErrorContains(t, "certificate signed by unknown authority", serverErr)
+
+	// And a client without a certificate.
+	serverErr, _ = handshake(ServerConfig(serverCert, ca), withName(&tls.Config{RootCAs: ca.Pool()}))
+	assert.ErrorContains(t, "didn't provide a certificate", serverErr)
+
+	// Processes connecting to their own server trust only its certificate.
+	serverErr, clientErr = handshake(ServerConfig(serverCert, ca), SelfConfig(serverCert))
+	require.NoError(t, serverErr)
+	require.NoError(t, clientErr)
+	_, clientErr = handshake(ServerConfig(otherCert, ca), SelfConfig(serverCert))
+	assert.ErrorContains(t, "unexpected certificate", clientErr)
+}
+
+func TestDefaultHosts(t *testing.T) {
+	hostname, err := os.Hostname()
+	require.NoError(t, err)
+	hosts := DefaultHosts()
+	assert.Equal(t, "localhost", hosts[0])
+	assert.Equal(t, hostname, hosts[1])
+}
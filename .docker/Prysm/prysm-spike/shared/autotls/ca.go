@@ -0,0 +1,184 @@
+package autotls
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	caCertFile   = "ca.crt"
+	caKeyFile    = "ca.key"
+	caLockFile   = "ca.lock"
+	caValidity   = 10 * 365 * 24 * time.Hour
+	caCommonName = "prysm auto TLS CA"
+)
+
+var (
+	// caLockTimeout is how long a process waits for another one to create the CA.
+	caLockTimeout = time.Minute
+	// caLockPollInterval is how often a waiting process checks whether the CA was created.
+	caLockPollInterval = 100 * time.Millisecond
+)
+
+// CA is the certificate authority issuing the certificates of all the processes sharing its
+// directory. Servers only accept clients presenting a certificate issued by it.
+type CA struct {
+	Cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+// LoadOrCreateCA loads the certificate authority persisted in the directory, generating it if
+// there is none. Processes connecting to each other must share the directory, e.g. through a
+// volume mounted in each container. Processes starting together on an empty directory create the
+// CA once: the first one to create the lock file generates it, while the others wait for it and
+// load what it wrote.
+func LoadOrCreateCA(dir string) (*CA, error) {
+	certPath, keyPath := filepath.Join(dir, caCertFile), filepath.Join(dir, caKeyFile)
+	lockPath := filepath.Join(dir, caLockFile)
+	if err := fileutil.MkdirAll(dir); err != nil {
+		return nil, err
+	}
+	deadline := timeutils.Now().Add(caLockTimeout)
+	for {
+		// The certificate is written last, once written the CA is complete.
+		if fileutil.FileExists(certPath) {
+			return loadCA(certPath, keyPath)
+		}
+		lock, err := os.OpenFile(lockPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, params.BeaconIoConfig().ReadWritePermissions)
+		if err == nil {
+			if err := lock.Close(); err != nil {
+				return nil, err
+			}
+			ca, err := createCA(dir, certPath, keyPath)
+			if rmErr := os.Remove(lockPath); rmErr != nil && err == nil {
+				err = errors.Wrap(rmErr, "could not remove CA lock file")
+			}
+			return ca, err
+		}
+		if !os.IsExist(err) {
+			return nil, errors.Wrap(err, "could not create CA lock file")
+		}
+		if timeutils.Now().After(deadline) {
+			return nil, fmt.Errorf("timed out waiting for another process to create the CA, remove %s if none is running", lockPath)
+		}
+		time.Sleep(caLockPollInterval)
+	}
+}
+
+// loadCA loads the persisted certificate authority, verifying that the certificate is the one of
+// the key.
+func loadCA(certPath, keyPath string) (*CA, error) {
+	certPEM, err := ioutil.ReadFile(certPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read CA certificate")
+	}
+	keyPEM, err := ioutil.ReadFile(keyPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read CA key")
+	}
+	pair, err := parse(certPEM, keyPEM)
+	if err != nil {
+		return nil, errors.Wrapf(err, "CA certificate %s does not match its key %s", certPath, keyPath)
+	}
+	key, ok := pair.PrivateKey.(*ecdsa.PrivateKey)
+	if !ok || !pair.Leaf.IsCA {
+		return nil, fmt.Errorf("%s is not an auto TLS certificate authority", certPath)
+	}
+	return &CA{Cert: pair.Leaf, key: key}, nil
+}
+
+// createCA generates the certificate authority. The caller must hold the lock file.
+func createCA(dir, certPath, keyPath string) (*CA, error) {
+	// Another process may have created the CA between the check and taking the lock.
+	if fileutil.FileExists(certPath) {
+		return loadCA(certPath, keyPath)
+	}
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not generate CA key")
+	}
+	serial, err := rand.Int(rand.Reader, serialLimit)
+	if err != nil {
+		return nil, err
+	}
+	now := timeutils.Now()
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: caCommonName},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(caValidity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create CA certificate")
+	}
+	keyPEM, err := encodeKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if err := writeFileAtomic(dir, keyPath, keyPEM); err != nil {
+		return nil, errors.Wrap(err, "could not write CA key")
+	}
+	if err := writeFileAtomic(dir, certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})); err != nil {
+		return nil, errors.Wrap(err, "could not write CA certificate")
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse CA certificate")
+	}
+	log.WithFields(logrus.Fields{
+		"path":        certPath,
+		"fingerprint": Fingerprint(cert),
+	}).Info("Generated TLS certificate authority")
+	return &CA{Cert: cert, key: key}, nil
+}
+
+// writeFileAtomic writes the file through a temporary file renamed over it, so other processes
+// never read a partially written file.
+func writeFileAtomic(dir, path string, data []byte) error {
+	tmp, err := ioutil.TempFile(dir, "."+filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// The temporary file is gone once renamed.
+		_ = os.Remove(tmp.Name())
+	}()
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// Pool returns a certificate pool trusting only the certificate authority.
+func (ca *CA) Pool() *x509.CertPool {
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.Cert)
+	return pool
+}
@@ -0,0 +1,216 @@
+// Package autotls provides mutually authenticated gRPC connections without managing certificates
+// by hand. A certificate authority is generated in a directory shared by the processes, and each
+// process issues itself a certificate signed by it covering the DNS names and IPs it is reached at.
+package autotls
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "autotls")
+
+const (
+	certFile = "tls.crt"
+	keyFile  = "tls.key"
+	// Certificates are regenerated once they expire within renewBefore.
+	certValidity = 365 * 24 * time.Hour
+	renewBefore  = 30 * 24 * time.Hour
+)
+
+var serialLimit = new(big.Int).Lsh(big.NewInt(1), 128)
+
+// DefaultHosts returns the hostname, the addresses of the network interfaces and the loopback
+// name of the machine, which are the names a container is reached at in a compose network.
+func DefaultHosts() []string {
+	hosts := []string{"localhost"}
+	if hostname, err := os.Hostname(); err == nil {
+		hosts = append(hosts, hostname)
+	}
+	addrs, err := net.InterfaceAddrs()
+	if err != nil {
+		log.WithError(err).Debug("Could not list interface addresses")
+		return hosts
+	}
+	for _, addr := range addrs {
+		if ipNet, ok := addr.(*net.IPNet); ok {
+			hosts = append(hosts, ipNet.IP.String())
+		}
+	}
+	return hosts
+}
+
+// LoadOrCreate loads the certificate persisted in the directory. The certificate is issued by the
+// certificate authority if there is none, and reissued if it expires soon, does not cover all the
+// hosts, e.g. after the container got a new address, or was not issued by the authority.
+func LoadOrCreate(dir string, hosts []string, ca *CA) (tls.Certificate, error) {
+	certPath, keyPath := filepath.Join(dir, certFile), filepath.Join(dir, keyFile)
+	key, err := loadOrCreateKey(dir, keyPath)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	keyPEM, err := encodeKey(key)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	if fileutil.FileExists(certPath) {
+		certPEM, err := ioutil.ReadFile(certPath)
+		if err != nil {
+			return tls.Certificate{}, errors.Wrap(err, "could not read TLS certificate")
+		}
+		cert, err := parse(certPEM, keyPEM)
+		if err != nil {
+			return tls.Certificate{}, err
+		}
+		reason := staleReason(cert.Leaf, hosts, ca)
+		if reason == "" {
+			return cert, nil
+		}
+		log.WithField("reason", reason).Info("Regenerating TLS certificate")
+	}
+
+	certPEM, err := createCert(key, hosts, ca)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	if err := fileutil.WriteFile(certPath, certPEM); err != nil {
+		return tls.Certificate{}, errors.Wrap(err, "could not write TLS certificate")
+	}
+	cert, err := parse(certPEM, keyPEM)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	log.WithFields(logrus.Fields{
+		"path":        certPath,
+		"fingerprint": Fingerprint(cert.Leaf),
+	}).Info("Issued TLS certificate")
+	return cert, nil
+}
+
+func loadOrCreateKey(dir, keyPath string) (*ecdsa.PrivateKey, error) {
+	if fileutil.FileExists(keyPath) {
+		enc, err := ioutil.ReadFile(keyPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read TLS key")
+		}
+		block, _ := pem.Decode(enc)
+		if block == nil {
+			return nil, errors.New("could not decode TLS key")
+		}
+		key, err := x509.ParseECPrivateKey(block.Bytes)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not parse TLS key")
+		}
+		return key, nil
+	}
+	if err := fileutil.MkdirAll(dir); err != nil {
+		return nil, err
+	}
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not generate TLS key")
+	}
+	enc, err := encodeKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if err := fileutil.WriteFile(keyPath, enc); err != nil {
+		return nil, errors.Wrap(err, "could not write TLS key")
+	}
+	return key, nil
+}
+
+func encodeKey(key *ecdsa.PrivateKey) ([]byte, error) {
+	der, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), nil
+}
+
+func parse(certPEM, keyPEM []byte) (tls.Certificate, error) {
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return tls.Certificate{}, errors.Wrap(err, "could not load TLS certificate")
+	}
+	cert.Leaf, err = x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		return tls.Certificate{}, errors.Wrap(err, "could not parse TLS certificate")
+	}
+	return cert, nil
+}
+
+// staleReason returns why the certificate has to be regenerated, if it has to.
+func staleReason(cert *x509.Certificate, hosts []string, ca *CA) string {
+	if timeutils.Now().Add(renewBefore).After(cert.NotAfter) {
+		return "certificate expires soon"
+	}
+	if err := cert.CheckSignatureFrom(ca.Cert); err != nil {
+		return "certificate was not issued by the certificate authority"
+	}
+	for _, host := range hosts {
+		if err := cert.VerifyHostname(host); err != nil {
+			return "certificate does not cover " + host
+		}
+	}
+	return ""
+}
+
+func createCert(key *ecdsa.PrivateKey, hosts []string, ca *CA) ([]byte, error) {
+	serial, err := rand.Int(rand.Reader, serialLimit)
+	if err != nil {
+		return nil, err
+	}
+	commonName, err := os.Hostname()
+	if err != nil {
+		commonName = "prysm"
+	}
+	now := timeutils.Now()
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(certValidity),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+	}
+	for _, host := range hosts {
+		if ip := net.ParseIP(host); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else {
+			template.DNSNames = append(template.DNSNames, host)
+		}
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.Cert, &key.PublicKey, ca.key)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create TLS certificate")
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), nil
+}
+
+// Fingerprint returns the hex encoded SHA-256 hash of the public key of the certificate, logged so
+// operators can tell certificates apart.
+func Fingerprint(cert *x509.Certificate) string {
+	h := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+	return hex.EncodeToString(h[:])
+}
@@ -0,0 +1,55 @@
+package autotls
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+
+	"github.com/pkg/errors"
+)
+
+// ClientConfig returns the TLS config to connect to servers with the certificate, verifying the
+// certificate of the server against the certificate authority and the name it is dialed by.
+func ClientConfig(cert tls.Certificate, ca *CA) *tls.Config {
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      ca.Pool(),
+		MinVersion:   tls.VersionTLS12,
+	}
+}
+
+// ServerConfig returns the TLS config to serve with the certificate. Clients must present a
+// certificate issued by the certificate authority.
+func ServerConfig(cert tls.Certificate, ca *CA) *tls.Config {
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    ca.Pool(),
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	}
+}
+
+// SelfConfig returns the TLS config for a process to connect to its own server, presenting the
+// certificate and trusting only the same certificate on the server side. The server is dialed at
+// addresses such as 0.0.0.0 the certificate does not cover, so its name is not verified.
+func SelfConfig(cert tls.Certificate) *tls.Config {
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+		// The certificate is compared with the expected one instead of verifying the chain.
+		// #nosec G402
+		InsecureSkipVerify: true,
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			if len(rawCerts) == 0 {
+				return errors.New("server presented no certificate")
+			}
+			leaf, err := x509.ParseCertificate(rawCerts[0])
+			if err != nil {
+				return err
+			}
+			if Fingerprint(leaf) != Fingerprint(cert.Leaf) {
+				return errors.New("server presented an unexpected certificate")
+			}
+			return nil
+		},
+	}
+}
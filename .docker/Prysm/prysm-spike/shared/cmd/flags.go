@@ -2,6 +2,8 @@
 package cmd
 
 import (
+	"time"
+
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/urfave/cli/v2"
 	"github.com/urfave/cli/v2/altsrc"
@@ -81,12 +83,37 @@ var (
 		Name:  "peer",
 		Usage: "Connect with this peer. This flag may be used multiple times.",
 	}
+	// PeerListURL specifies URLs serving peer lists to connect to and periodically refresh.
+	PeerListURL = &cli.StringSliceFlag{
+		Name: "peer-list-url",
+		Usage: "URL of a peer list, such as the /eth/v1alpha1/debug/p2p/peer_list gateway endpoint of another " +
+			"beacon node with --enable-debug-rpc-endpoints. The listed peers are connected to and the list is " +
+			"refreshed every minute. This flag may be used multiple times.",
+	}
+	// P2PLocalDiscovery enables mDNS discovery of the beacon nodes on the local network.
+	P2PLocalDiscovery = &cli.BoolFlag{
+		Name: "p2p-local-discovery",
+		Usage: "Advertise the node with mDNS and connect with the beacon nodes of the same network found on " +
+			"the local network, such as a docker network, without bootnodes. Also enables gossipsub peer exchange.",
+	}
+	// P2PLocalDiscoveryInterface selects the network interface used by local discovery.
+	P2PLocalDiscoveryInterface = &cli.StringFlag{
+		Name:  "p2p-local-discovery-interface",
+		Usage: "The network interface, such as eth0, to use for --p2p-local-discovery. Defaults to all interfaces.",
+	}
 	// BootstrapNode tells the beacon node which bootstrap node to connect to
 	BootstrapNode = &cli.StringSliceFlag{
 		Name:  "bootstrap-node",
 		Usage: "The address of bootstrap node. Beacon node will connect for peer discovery via DHT.  Multiple nodes can be passed by using the flag multiple times but not comma-separated. You can also pass YAML files containing multiple nodes.",
 		Value: cli.NewStringSlice(params.BeaconNetworkConfig().BootstrapNodes...),
 	}
+	// BootstrapDNS tells the beacon node which EIP-1459 ENR trees to find bootstrap nodes in.
+	BootstrapDNS = &cli.StringSliceFlag{
+		Name: "bootstrap-dns",
+		Usage: "URL of an ENR tree published in DNS, such as enrtree://<public key>@nodes.example.org. The " +
+			"signed nodes of the tree are resolved in the background alongside discovery, and again every 30 " +
+			"minutes to connect with new nodes. This flag may be used multiple times.",
+	}
 	// RelayNode tells the beacon node which relay node to connect to.
 	RelayNode = &cli.StringFlag{
 		Name: "relay-node",
@@ -156,6 +183,29 @@ var (
 			"192.168.0.0/16 would deny connections from peers on your local network only. The " +
 			"default is to accept all connections.",
 	}
+	// P2PMaxInboundPerIP defines a flag to cap the inbound connections from a single ip address.
+	P2PMaxInboundPerIP = &cli.UintFlag{
+		Name:  "p2p-max-inbound-per-ip",
+		Usage: "The max number of inbound p2p connections accepted from a single ip address. 0 disables the limit.",
+	}
+	// P2PMaxInboundPerSubnet defines a flag to cap the inbound connections from a single subnet.
+	P2PMaxInboundPerSubnet = &cli.UintFlag{
+		Name: "p2p-max-inbound-per-subnet",
+		Usage: "The max number of inbound p2p connections accepted from a single subnet, see " +
+			"--p2p-inbound-subnet-prefix. 0 disables the limit.",
+	}
+	// P2PInboundSubnetPrefix defines the prefix length of the subnets counted by P2PMaxInboundPerSubnet.
+	P2PInboundSubnetPrefix = &cli.UintFlag{
+		Name:  "p2p-inbound-subnet-prefix",
+		Usage: "The prefix length of the IPv4 subnets counted by --p2p-max-inbound-per-subnet. IPv6 subnets are always /64.",
+		Value: 24,
+	}
+	// P2PMaxInboundRatio defines a flag to cap the share of the peer limit taken by inbound connections.
+	P2PMaxInboundRatio = &cli.Float64Flag{
+		Name: "p2p-max-inbound-ratio",
+		Usage: "The max fraction of --p2p-max-peers which inbound connections may take, keeping the " +
+			"remaining slots for outbound peers. 0 disables the limit.",
+	}
 	// ForceClearDB removes any previously stored data at the data directory.
 	ForceClearDB = &cli.BoolFlag{
 		Name:  "force-clear-db",
@@ -183,6 +233,13 @@ var (
 		Name:  "log-file",
 		Usage: "Specify log file name, relative or absolute",
 	}
+	// LogDedup collapses repeated identical log messages starting with a prefix.
+	LogDedup = &cli.StringSliceFlag{
+		Name: "log-dedup",
+		Usage: "Collapse repeated identical log messages starting with a prefix into one message per interval, " +
+			"followed by a summary with the number of repeats. Given as prefix=interval, such as " +
+			"'Block is not processed=1m'. Errors are never collapsed. This flag may be used multiple times.",
+	}
 	// EnableUPnPFlag specifies if UPnP should be enabled or not. The default value is false.
 	EnableUPnPFlag = &cli.BoolFlag{
 		Name:  "enable-upnp",
@@ -198,12 +255,49 @@ var (
 		Name:  "chain-config-file",
 		Usage: "The path to a YAML file with chain config values",
 	}
+	// PrintConfigFlag prints the resolved configuration instead of starting the binary.
+	PrintConfigFlag = &cli.BoolFlag{
+		Name: "print-config",
+		Usage: "Print the fully resolved configuration, from the flags, config file, feature flags and " +
+			"chain config, as JSON with secrets redacted, and exit",
+	}
+	// SupportBundleOutputFlag specifies the path of the archive written by the support-bundle command.
+	SupportBundleOutputFlag = &cli.StringFlag{
+		Name:  "output",
+		Usage: "Path of the support bundle archive (default: <binary>-support-<time>.tar.gz in the working directory)",
+	}
 	// GrpcMaxCallRecvMsgSizeFlag defines the max call message size for GRPC
 	GrpcMaxCallRecvMsgSizeFlag = &cli.IntFlag{
 		Name:  "grpc-max-msg-size",
 		Usage: "Integer to define max recieve message call size (default: 4194304 (for 4MB))",
 		Value: 1 << 22,
 	}
+	// DiskWatchIntervalFlag defines how often the free space of the data directory is checked.
+	DiskWatchIntervalFlag = &cli.DurationFlag{
+		Name: "disk-watch-interval",
+		Usage: "How often the free space of the volume holding the data directory is checked. Below the warning " +
+			"threshold, non-essential writes such as archival and backups pause. Disabled if 0",
+		Value: time.Minute,
+	}
+	// DiskWarningThresholdFlag defines the free disk space below which non-essential writes pause.
+	DiskWarningThresholdFlag = &cli.Uint64Flag{
+		Name:  "disk-warning-threshold",
+		Usage: "The free disk space in megabytes below which warnings are logged and non-essential writes pause",
+		Value: 2048,
+	}
+	// DiskCriticalThresholdFlag defines the free disk space below which emergency pruning runs.
+	DiskCriticalThresholdFlag = &cli.Uint64Flag{
+		Name: "disk-critical-threshold",
+		Usage: "The free disk space in megabytes below which errors are logged, the health status fails, and " +
+			"emergency pruning runs if enabled",
+		Value: 512,
+	}
+	// DiskEmergencyPruneFlag enables deleting non-essential data when the free disk space is critically low.
+	DiskEmergencyPruneFlag = &cli.BoolFlag{
+		Name: "disk-emergency-prune",
+		Usage: "Delete non-essential data below the critical disk threshold: hot states saved to the beacon " +
+			"database during long periods without finality, and all but the latest validator database backup",
+	}
 	// AcceptTosFlag specifies user acceptance of ToS for non-interactive environments.
 	AcceptTosFlag = &cli.BoolFlag{
 		Name:  "accept-terms-of-use",
@@ -0,0 +1,199 @@
+package configdump
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	// maxLogBytes is the size of the end of the log file included in a support bundle.
+	maxLogBytes = 32 << 20
+	// snapshotTimeout is the time allowed to fetch a snapshot from the monitoring server.
+	snapshotTimeout = 10 * time.Second
+)
+
+// snapshots are the paths fetched from the monitoring server of the running binary, and the
+// names of the files they are saved as in the bundle.
+var snapshots = []struct {
+	path, name string
+}{
+	{path: "/metrics", name: "metrics.txt"},
+}
+
+// SupportBundleCommand returns the command writing a support bundle to attach to issue reports: a
+// gzipped tar archive of the resolved configuration, the end of the log file, and a snapshot of the
+// metrics served by the monitoring server of the running binary. The configure function applies the
+// feature flags and chain config of the binary from the flags.
+// Global flags, such as --config-file, --log-file and the monitoring flags, are given before the
+// command so they match the running binary.
+func SupportBundleCommand(configure func(*cli.Context), monitoringPortFlag *cli.IntFlag) *cli.Command {
+	return &cli.Command{
+		Name:     "support-bundle",
+		Category: "support",
+		Usage:    "writes the configuration, recent logs and metrics of the node to an archive for issue reports",
+		Flags: []cli.Flag{
+			cmd.SupportBundleOutputFlag,
+		},
+		Action: func(cliCtx *cli.Context) error {
+			configure(cliCtx)
+			output := cliCtx.String(cmd.SupportBundleOutputFlag.Name)
+			if output == "" {
+				output = fmt.Sprintf("%s-support-%s.tar.gz", cliCtx.App.Name, time.Now().UTC().Format("20060102-150405"))
+			}
+			monitoringURL := ""
+			if !cliCtx.Bool(cmd.DisableMonitoringFlag.Name) {
+				monitoringURL = fmt.Sprintf("http://%s:%d",
+					cliCtx.String(cmd.MonitoringHostFlag.Name), cliCtx.Int(monitoringPortFlag.Name))
+			}
+			if err := writeBundleFile(cliCtx, output, cliCtx.String(cmd.LogFileName.Name), monitoringURL); err != nil {
+				return errors.Wrap(err, "could not write support bundle")
+			}
+			log.WithField("bundle", output).Info("Wrote support bundle")
+			return nil
+		},
+	}
+}
+
+// writeBundleFile writes the bundle to a temporary file first, so a partially written bundle is
+// never mistaken for a complete one.
+func writeBundleFile(cliCtx *cli.Context, output, logFile, monitoringURL string) error {
+	output, err := fileutil.ExpandPath(output)
+	if err != nil {
+		return err
+	}
+	if err := fileutil.MkdirAll(filepath.Dir(output)); err != nil {
+		return err
+	}
+	tmpFile, err := ioutil.TempFile(filepath.Dir(output), filepath.Base(output)+".tmp")
+	if err != nil {
+		return errors.Wrap(err, "could not create bundle file")
+	}
+	defer func() {
+		if err := os.Remove(tmpFile.Name()); err != nil && !os.IsNotExist(err) {
+			log.WithError(err).Error("Could not remove temporary bundle file")
+		}
+	}()
+	if err := writeBundle(cliCtx, tmpFile, logFile, monitoringURL); err != nil {
+		if closeErr := tmpFile.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Could not close temporary bundle file")
+		}
+		return err
+	}
+	if err := tmpFile.Close(); err != nil {
+		return errors.Wrap(err, "could not close bundle")
+	}
+	return os.Rename(tmpFile.Name(), output)
+}
+
+// writeBundle writes the bundle archive to the writer. The log file and monitoring snapshots are
+// left out of the bundle with a warning when they cannot be read, as the node may not be running.
+func writeBundle(cliCtx *cli.Context, w io.Writer, logFile, monitoringURL string) error {
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+
+	var config bytes.Buffer
+	if err := Print(cliCtx, &config); err != nil {
+		return errors.Wrap(err, "could not resolve configuration")
+	}
+	if err := writeBundleEntry(tw, "config.json", config.Bytes()); err != nil {
+		return err
+	}
+	if logFile != "" {
+		logs, err := tailFile(logFile, maxLogBytes)
+		if err != nil {
+			log.WithError(err).Warn("Could not read log file, leaving it out of the bundle")
+		} else if err := writeBundleEntry(tw, filepath.Join("logs", filepath.Base(logFile)), logs); err != nil {
+			return err
+		}
+	}
+	if monitoringURL != "" {
+		client := &http.Client{Timeout: snapshotTimeout}
+		for _, s := range snapshots {
+			snapshot, err := fetchSnapshot(cliCtx.Context, client, monitoringURL+s.path)
+			if err != nil {
+				log.WithError(err).WithFields(logrus.Fields{
+					"url": monitoringURL + s.path,
+				}).Warn("Could not fetch snapshot, leaving it out of the bundle")
+				continue
+			}
+			if err := writeBundleEntry(tw, s.name, snapshot); err != nil {
+				return err
+			}
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gw.Close()
+}
+
+func writeBundleEntry(tw *tar.Writer, name string, content []byte) error {
+	if err := tw.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0600,
+		Size:    int64(len(content)),
+		ModTime: time.Now(),
+	}); err != nil {
+		return errors.Wrapf(err, "could not write bundle entry %s", name)
+	}
+	_, err := tw.Write(content)
+	return err
+}
+
+// tailFile returns at most the last max bytes of the file.
+func tailFile(path string, max int64) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).Error("Could not close log file")
+		}
+	}()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if info.Size() > max {
+		if _, err := f.Seek(info.Size()-max, io.SeekStart); err != nil {
+			return nil, err
+		}
+	}
+	return ioutil.ReadAll(f)
+}
+
+func fetchSnapshot(ctx context.Context, client *http.Client, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Error("Could not close response body")
+		}
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
@@ -0,0 +1,68 @@
+package configdump
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func readBundle(t *testing.T, r io.Reader) map[string][]byte {
+	gr, err := gzip.NewReader(r)
+	require.NoError(t, err)
+	tr := tar.NewReader(gr)
+	entries := make(map[string][]byte)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return entries
+		}
+		require.NoError(t, err)
+		content, err := ioutil.ReadAll(tr)
+		require.NoError(t, err)
+		entries[hdr.Name] = content
+	}
+}
+
+func TestWriteBundle(t *testing.T) {
+	cliCtx := newConfigContext(t, "--"+passwordFlag.Name, "hunter2")
+	logFile := filepath.Join(t.TempDir(), "beacon.log")
+	require.NoError(t, ioutil.WriteFile(logFile, []byte("some log line\n"), 0600))
+	mux := http.NewServeMux()
+	mux.HandleFunc("/metrics", func(w http.ResponseWriter, _ *http.Request) {
+		_, err := w.Write([]byte("beacon_head_slot 42\n"))
+		require.NoError(t, err)
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	var bundle bytes.Buffer
+	require.NoError(t, writeBundle(cliCtx, &bundle, logFile, srv.URL))
+	entries := readBundle(t, &bundle)
+	require.Equal(t, 3, len(entries))
+	c := &Config{}
+	require.NoError(t, json.Unmarshal(entries["config.json"], c))
+	assert.Equal(t, "beacon-chain", c.Binary)
+	assert.Equal(t, "some log line\n", string(entries["logs/beacon.log"]))
+	assert.Equal(t, "beacon_head_slot 42\n", string(entries["metrics.txt"]))
+}
+
+func TestTailFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "beacon.log")
+	require.NoError(t, ioutil.WriteFile(path, []byte("0123456789"), 0600))
+	tail, err := tailFile(path, 4)
+	require.NoError(t, err)
+	assert.Equal(t, "6789", string(tail))
+	tail, err = tailFile(path, 20)
+	require.NoError(t, err)
+	assert.Equal(t, "0123456789", string(tail))
+}
@@ -0,0 +1,224 @@
+// Package configdump resolves the configuration a Prysm binary runs with, from its flags, config
+// file, feature flags and chain config, with secrets redacted so it can be shared in issue reports.
+package configdump
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	sharedpb "github.com/prysmaticlabs/prysm/proto/shared/v1"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/version"
+	"github.com/urfave/cli/v2"
+	"github.com/urfave/cli/v2/altsrc"
+	"gopkg.in/yaml.v2"
+)
+
+// redacted replaces the values which may hold secrets.
+const redacted = "<redacted>"
+
+// secretNames are the parts of flag names whose values are secrets, unless the flag only holds
+// the path to a file.
+var secretNames = []string{"password", "secret", "token", "mnemonic"}
+
+// pathSuffixes are the suffixes of flag names holding paths rather than values.
+var pathSuffixes = []string{"-file", "-files", "-path", "-dir"}
+
+// Config is the resolved configuration of a Prysm binary.
+type Config struct {
+	Binary           string                         `json:"binary"`
+	Version          string                         `json:"version"`
+	ChainConfigHash  string                         `json:"chainConfigHash"`
+	ConfigFile       string                         `json:"configFile,omitempty"`
+	ConfigFileValues map[string]string              `json:"configFileValues,omitempty"`
+	Flags            []*Flag                        `json:"flags"`
+	Features         []*featureconfig.ActiveFeature `json:"features"`
+}
+
+// Flag is the resolved value of a flag, set when it was given on the command line or in the
+// config file.
+type Flag struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+	Set   bool   `json:"set"`
+}
+
+// Resolve returns the configuration of the binary from the flags of the cli context. The feature
+// flags and chain config are the ones configured globally, so the binary must have applied them.
+func Resolve(cliCtx *cli.Context) (*Config, error) {
+	configHash, err := params.BeaconConfigHash()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not hash chain config")
+	}
+	c := &Config{
+		Binary:          cliCtx.App.Name,
+		Version:         version.GetVersion(),
+		ChainConfigHash: configHash,
+		Flags:           make([]*Flag, 0, len(cliCtx.App.Flags)),
+		Features:        featureconfig.ActiveFeatures(),
+	}
+	if cliCtx.IsSet(cmd.ConfigFileFlag.Name) {
+		c.ConfigFile = cliCtx.String(cmd.ConfigFileFlag.Name)
+		c.ConfigFileValues, err = configFileValues(c.ConfigFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, f := range cliCtx.App.Flags {
+		name := f.Names()[0]
+		c.Flags = append(c.Flags, &Flag{
+			Name:  name,
+			Value: redact(name, flagValue(cliCtx, f)),
+			Set:   cliCtx.IsSet(name),
+		})
+	}
+	sort.Slice(c.Flags, func(i, j int) bool {
+		return c.Flags[i].Name < c.Flags[j].Name
+	})
+	return c, nil
+}
+
+// RunningConfigProto converts the configuration to the message returned by the APIs of every
+// binary, with the config file values sorted by name.
+func RunningConfigProto(c *Config) *sharedpb.RunningConfig {
+	values := make([]*sharedpb.ConfigValue, 0, len(c.ConfigFileValues))
+	for name, value := range c.ConfigFileValues {
+		values = append(values, &sharedpb.ConfigValue{Name: name, Value: value})
+	}
+	sort.Slice(values, func(i, j int) bool {
+		return values[i].Name < values[j].Name
+	})
+	flags := make([]*sharedpb.ConfigFlag, len(c.Flags))
+	for i, f := range c.Flags {
+		flags[i] = &sharedpb.ConfigFlag{Name: f.Name, Value: f.Value, Set: f.Set}
+	}
+	return &sharedpb.RunningConfig{
+		Binary:           c.Binary,
+		Version:          c.Version,
+		ChainConfigHash:  c.ChainConfigHash,
+		ConfigFile:       c.ConfigFile,
+		ConfigFileValues: values,
+		Flags:            flags,
+		Features:         featureconfig.FeaturesProto(c.Features),
+	}
+}
+
+// Print writes the configuration of the binary to the writer as indented JSON.
+func Print(cliCtx *cli.Context, w io.Writer) error {
+	c, err := Resolve(cliCtx)
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	return enc.Encode(c)
+}
+
+// configFileValues returns the values of the YAML config file, with secrets redacted.
+func configFileValues(path string) (map[string]string, error) {
+	enc, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read config file")
+	}
+	raw := make(map[string]interface{})
+	if err := yaml.Unmarshal(enc, &raw); err != nil {
+		return nil, errors.Wrap(err, "could not parse config file")
+	}
+	values := make(map[string]string, len(raw))
+	for name, v := range raw {
+		var value string
+		if list, ok := v.([]interface{}); ok {
+			items := make([]string, len(list))
+			for i, item := range list {
+				items[i] = fmt.Sprint(item)
+			}
+			value = strings.Join(items, ",")
+		} else {
+			value = fmt.Sprint(v)
+		}
+		values[name] = redact(name, value)
+	}
+	return values, nil
+}
+
+// flagValue formats the value of a flag, looked up through the lineage of the context so the
+// flags of the binary are resolved from its subcommands too.
+func flagValue(cliCtx *cli.Context, f cli.Flag) string {
+	name := f.Names()[0]
+	switch f.(type) {
+	case *cli.BoolFlag, *altsrc.BoolFlag:
+		return strconv.FormatBool(cliCtx.Bool(name))
+	case *cli.DurationFlag, *altsrc.DurationFlag:
+		return cliCtx.Duration(name).String()
+	case *cli.Float64Flag, *altsrc.Float64Flag:
+		return strconv.FormatFloat(cliCtx.Float64(name), 'g', -1, 64)
+	case *cli.IntFlag, *altsrc.IntFlag:
+		return strconv.Itoa(cliCtx.Int(name))
+	case *cli.Int64Flag, *altsrc.Int64Flag:
+		return strconv.FormatInt(cliCtx.Int64(name), 10)
+	case *cli.UintFlag, *altsrc.UintFlag:
+		return strconv.FormatUint(uint64(cliCtx.Uint(name)), 10)
+	case *cli.Uint64Flag, *altsrc.Uint64Flag:
+		return strconv.FormatUint(cliCtx.Uint64(name), 10)
+	case *cli.StringSliceFlag, *altsrc.StringSliceFlag:
+		return strings.Join(cliCtx.StringSlice(name), ",")
+	case *cli.GenericFlag, *altsrc.GenericFlag:
+		if g := cliCtx.Generic(name); g != nil {
+			return fmt.Sprint(g)
+		}
+		return ""
+	default:
+		return cliCtx.String(name)
+	}
+}
+
+// redact hides the value of a flag holding a secret, and the credentials, paths and queries of
+// the URLs in the value of any other flag, which often embed API keys.
+func redact(name, value string) string {
+	if value == "" {
+		return value
+	}
+	if isSecret(name) {
+		return redacted
+	}
+	items := strings.Split(value, ",")
+	for i, item := range items {
+		items[i] = redactURL(item)
+	}
+	return strings.Join(items, ",")
+}
+
+func isSecret(name string) bool {
+	for _, suffix := range pathSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return false
+		}
+	}
+	for _, secret := range secretNames {
+		if strings.Contains(name, secret) {
+			return true
+		}
+	}
+	return false
+}
+
+func redactURL(value string) string {
+	u, err := url.Parse(value)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return value
+	}
+	if u.User == nil && (u.Path == "" || u.Path == "/") && u.RawQuery == "" {
+		return value
+	}
+	return u.Scheme + "://" + u.Host + "/" + redacted
+}
@@ -0,0 +1,5 @@
+package configdump
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "configdump")
@@ -0,0 +1,250 @@
+// Package diskwatch watches the free space of the volume holding a data directory, so a full
+// volume does not abort database writes halfway. Under pressure, non-essential writers such as
+// archival and backups pause, and at the critical level registered pruners free space.
+package diskwatch
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "diskwatch")
+
+// reminderChecks is the number of checks between the repeated logs of an unchanged pressure level.
+const reminderChecks = 10
+
+var (
+	diskFreeBytes = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "disk_free_bytes",
+		Help: "Free bytes of the volume holding the data directory, available to the process.",
+	})
+	diskPressureLevel = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "disk_pressure_level",
+		Help: "Disk pressure level of the data directory: 0 normal, 1 warning, 2 critical.",
+	})
+	diskEmergencyPrunes = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "disk_emergency_prunes_total",
+		Help: "Count the emergency prunes run at the critical disk pressure level, by pruner and result.",
+	}, []string{"pruner", "result"})
+)
+
+// Level of disk pressure.
+type Level int
+
+// Levels of disk pressure.
+const (
+	Normal Level = iota
+	Warning
+	Critical
+)
+
+// String returns the name of the level.
+func (l Level) String() string {
+	switch l {
+	case Normal:
+		return "normal"
+	case Warning:
+		return "warning"
+	case Critical:
+		return "critical"
+	default:
+		return fmt.Sprintf("level %d", int(l))
+	}
+}
+
+// Config of the disk watch service.
+type Config struct {
+	Dir string
+	// WarningBytes and CriticalBytes are the free bytes below which the levels start. The free
+	// bytes are those of the volume, the space the database already takes is not counted.
+	WarningBytes  uint64
+	CriticalBytes uint64
+	Interval      time.Duration
+	// EmergencyPrune runs the registered pruners at the critical level.
+	EmergencyPrune bool
+}
+
+type pruner struct {
+	name  string
+	prune func(ctx context.Context) error
+}
+
+// Service checks the free space of the data directory every interval.
+type Service struct {
+	ctx       context.Context
+	cancel    context.CancelFunc
+	cfg       *Config
+	freeBytes func(dir string) (uint64, error)
+	lock      sync.RWMutex
+	level     Level
+	lastErr   error
+	pruners   []pruner
+	unchanged int
+}
+
+// NewService creates a disk watch service.
+func NewService(ctx context.Context, cfg *Config) (*Service, error) {
+	if cfg.Interval <= 0 {
+		return nil, errors.New("disk watch interval must be greater than 0")
+	}
+	if cfg.CriticalBytes > cfg.WarningBytes {
+		return nil, errors.New("critical disk threshold cannot exceed the warning threshold")
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		ctx:       ctx,
+		cancel:    cancel,
+		cfg:       cfg,
+		freeBytes: freeBytes,
+	}, nil
+}
+
+// RegisterPruner adds a function freeing disk space, run at the critical level when emergency
+// pruning is enabled. Pruners run in the order of registration.
+func (s *Service) RegisterPruner(name string, prune func(ctx context.Context) error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.pruners = append(s.pruners, pruner{name: name, prune: prune})
+}
+
+// Start the disk watch loop.
+func (s *Service) Start() {
+	log.WithFields(logrus.Fields{
+		"dir":            s.cfg.Dir,
+		"interval":       s.cfg.Interval,
+		"warningBytes":   s.cfg.WarningBytes,
+		"criticalBytes":  s.cfg.CriticalBytes,
+		"emergencyPrune": s.cfg.EmergencyPrune,
+	}).Info("Watching free disk space")
+	s.check()
+	go s.run()
+}
+
+// Stop the disk watch loop.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status returns an error at the critical level, or when the free space could not be read.
+func (s *Service) Status() error {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if s.lastErr != nil {
+		return s.lastErr
+	}
+	if s.level == Critical {
+		return errors.New("free disk space is critically low")
+	}
+	return nil
+}
+
+// Level returns the disk pressure level of the latest check.
+func (s *Service) Level() Level {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.level
+}
+
+// UnderPressure returns whether non-essential writes should be paused. A nil service is never
+// under pressure.
+func (s *Service) UnderPressure() bool {
+	if s == nil {
+		return false
+	}
+	return s.Level() >= Warning
+}
+
+func (s *Service) run() {
+	ticker := time.NewTicker(s.cfg.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			s.check()
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// check reads the free space, updates the level, and logs level changes. Unchanged warning and
+// critical levels are logged again every few checks, and the critical level runs the pruners.
+func (s *Service) check() {
+	free, err := s.freeBytes(s.cfg.Dir)
+	if err != nil {
+		log.WithError(err).Error("Could not read free disk space")
+		s.lock.Lock()
+		s.lastErr = err
+		s.lock.Unlock()
+		return
+	}
+	level := s.levelOf(free)
+	diskFreeBytes.Set(float64(free))
+	diskPressureLevel.Set(float64(level))
+
+	s.lock.Lock()
+	previous := s.level
+	s.level = level
+	s.lastErr = nil
+	if level == previous {
+		s.unchanged++
+	} else {
+		s.unchanged = 0
+	}
+	remind := level != Normal && s.unchanged%reminderChecks == 0
+	s.lock.Unlock()
+
+	fields := logrus.Fields{
+		"dir":       s.cfg.Dir,
+		"freeBytes": free,
+		"level":     level,
+	}
+	switch {
+	case level == Critical && (level != previous || remind):
+		log.WithFields(fields).Error("Free disk space is critically low, pausing non-essential writes")
+	case level == Warning && (level != previous || remind):
+		log.WithFields(fields).Warn("Free disk space is low, pausing non-essential writes")
+	case level == Normal && level != previous:
+		log.WithFields(fields).Info("Free disk space recovered, resuming non-essential writes")
+	}
+	if level == Critical && s.cfg.EmergencyPrune {
+		s.prune()
+	}
+}
+
+// levelOf returns the level of the free bytes.
+func (s *Service) levelOf(free uint64) Level {
+	switch {
+	case free < s.cfg.CriticalBytes:
+		return Critical
+	case free < s.cfg.WarningBytes:
+		return Warning
+	default:
+		return Normal
+	}
+}
+
+// prune runs the pruners. Space freed inside a database is only reused by its later writes, it is
+// not returned to the volume, but it stops the database from growing.
+func (s *Service) prune() {
+	s.lock.RLock()
+	pruners := s.pruners
+	s.lock.RUnlock()
+	for _, p := range pruners {
+		if err := p.prune(s.ctx); err != nil {
+			diskEmergencyPrunes.WithLabelValues(p.name, "error").Inc()
+			log.WithError(err).WithField("pruner", p.name).Error("Could not run emergency prune")
+			continue
+		}
+		diskEmergencyPrunes.WithLabelValues(p.name, "success").Inc()
+		log.WithField("pruner", p.name).Warn("Ran emergency prune to free disk space")
+	}
+}
@@ -0,0 +1,116 @@
+package diskwatch
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func testService(t *testing.T, cfg *Config, free *uint64) *Service {
+	cfg.Interval = time.Minute
+	s, err := NewService(context.Background(), cfg)
+	require.NoError(t, err)
+	s.freeBytes = func(string) (uint64, error) {
+		return *free, nil
+	}
+	return s
+}
+
+func TestNewService_InvalidConfig(t *testing.T) {
+	_, err := NewService(context.Background(), &Config{})
+	require.ErrorContains(t, "interval must be greater than 0", err)
+	_, err = NewService(context.Background(), &Config{Interval: time.Minute, WarningBytes: 1, CriticalBytes: 2})
+	require.ErrorContains(t, "cannot exceed the warning threshold", err)
+}
+
+func TestService_Levels(t *testing.T) {
+	hook := logTest.NewGlobal()
+	free := uint64(1000)
+	s := testService(t, &Config{WarningBytes: 100, CriticalBytes: 10}, &free)
+
+	s.check()
+	assert.Equal(t, Normal, s.Level())
+	assert.Equal(t, false, s.UnderPressure())
+	require.NoError(t, s.Status())
+
+	free = 50
+	s.check()
+	assert.Equal(t, Warning, s.Level())
+	assert.Equal(t, true, s.UnderPressure())
+	require.NoError(t, s.Status())
+	require.LogsContain(t, hook, "Free disk space is low")
+
+	free = 5
+	s.check()
+	assert.Equal(t, Critical, s.Level())
+	require.ErrorContains(t, "critically low", s.Status())
+	require.LogsContain(t, hook, "Free disk space is critically low")
+
+	free = 1000
+	s.check()
+	assert.Equal(t, Normal, s.Level())
+	require.LogsContain(t, hook, "Free disk space recovered")
+}
+
+func TestService_IgnoresDatabaseSize(t *testing.T) {
+	dir := t.TempDir()
+	// A database larger than the free space does not raise the level.
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "beaconchain.db"), make([]byte, 500), 0600))
+	free := uint64(400)
+	s := testService(t, &Config{Dir: dir, WarningBytes: 100, CriticalBytes: 10}, &free)
+
+	s.check()
+	assert.Equal(t, Normal, s.Level())
+	free = 50
+	s.check()
+	assert.Equal(t, Warning, s.Level())
+}
+
+func TestService_EmergencyPrune(t *testing.T) {
+	free := uint64(5)
+	s := testService(t, &Config{WarningBytes: 100, CriticalBytes: 10, EmergencyPrune: true}, &free)
+	var pruned []string
+	s.RegisterPruner("failing", func(context.Context) error {
+		pruned = append(pruned, "failing")
+		return errors.New("failed")
+	})
+	s.RegisterPruner("states", func(context.Context) error {
+		pruned = append(pruned, "states")
+		return nil
+	})
+
+	s.check()
+	assert.DeepEqual(t, []string{"failing", "states"}, pruned)
+
+	free = 50
+	s.check()
+	assert.Equal(t, 2, len(pruned), "Pruners should only run at the critical level")
+}
+
+func TestService_FreeBytesError(t *testing.T) {
+	s, err := NewService(context.Background(), &Config{Interval: time.Minute})
+	require.NoError(t, err)
+	s.freeBytes = func(string) (uint64, error) {
+		return 0, errors.New("no such file or directory")
+	}
+	s.check()
+	require.ErrorContains(t, "no such file or directory", s.Status())
+}
+
+func TestService_NilUnderPressure(t *testing.T) {
+	var s *Service
+	assert.Equal(t, false, s.UnderPressure())
+}
+
+func TestFreeBytes(t *testing.T) {
+	free, err := freeBytes(t.TempDir())
+	require.NoError(t, err)
+	assert.NotEqual(t, uint64(0), free)
+}
@@ -0,0 +1,16 @@
+// +build !windows
+
+package diskwatch
+
+import (
+	"syscall"
+)
+
+// freeBytes returns the bytes of the volume holding the directory available to unprivileged users.
+func freeBytes(dir string) (uint64, error) {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(dir, &stat); err != nil {
+		return 0, err
+	}
+	return uint64(stat.Bavail) * uint64(stat.Bsize), nil
+}
@@ -0,0 +1,12 @@
+// +build windows
+
+package diskwatch
+
+import (
+	"errors"
+)
+
+// freeBytes is not supported on windows.
+func freeBytes(_ string) (uint64, error) {
+	return 0, errors.New("reading free disk space is not supported on windows")
+}
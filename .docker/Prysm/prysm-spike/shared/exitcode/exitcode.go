@@ -0,0 +1,106 @@
+// Package exitcode defines the exit codes of the beacon node and validator client processes, so
+// that restart policies and orchestration scripts can tell the classes of failures apart:
+//
+//  1 - Failure: any failure without a more specific code.
+//  2 - ConfigError: invalid flags, config file or log settings. Restarting does not help.
+//  3 - TermsNotAccepted: the terms of use were not accepted. Restarting does not help.
+//  4 - DatabaseCorrupted: the database file is not a valid database and needs to be restored.
+//  5 - PortInUse: a port to listen on is used by another process. Restarting later may help.
+//  6 - GenesisMismatch: the database belongs to another network than the one connected to.
+//  7 - SlashingDetected: a message signed by the validator would be slashable. Restarting may
+//      get the validator slashed, the other process signing with its keys has to be found first.
+package exitcode
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"syscall"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Code is the exit code of a process.
+type Code int
+
+// Exit codes of the processes.
+const (
+	Success           Code = 0
+	Failure           Code = 1
+	ConfigError       Code = 2
+	TermsNotAccepted  Code = 3
+	DatabaseCorrupted Code = 4
+	PortInUse         Code = 5
+	GenesisMismatch   Code = 6
+	SlashingDetected  Code = 7
+)
+
+var codeNames = map[Code]string{
+	Success:           "success",
+	Failure:           "failure",
+	ConfigError:       "config error",
+	TermsNotAccepted:  "terms not accepted",
+	DatabaseCorrupted: "database corrupted",
+	PortInUse:         "port in use",
+	GenesisMismatch:   "genesis mismatch",
+	SlashingDetected:  "slashing detected",
+}
+
+// exit is replaced in tests to not exit the test process.
+var exit = os.Exit
+
+// String returns the name of the failure class of the code.
+func (c Code) String() string {
+	if name, ok := codeNames[c]; ok {
+		return name
+	}
+	return fmt.Sprintf("exit code %d", int(c))
+}
+
+// codedError is an error with the exit code of its failure class.
+type codedError struct {
+	err  error
+	code Code
+}
+
+func (e *codedError) Error() string {
+	return e.err.Error()
+}
+
+func (e *codedError) Unwrap() error {
+	return e.err
+}
+
+// Wrap returns the error with the exit code of its failure class, or nil for a nil error. The
+// error message is not changed.
+func Wrap(err error, code Code) error {
+	if err == nil {
+		return nil
+	}
+	return &codedError{err: err, code: code}
+}
+
+// FromError returns the exit code of the error: the code of the outermost error wrapped with
+// Wrap, PortInUse for errors of addresses already in use, Failure for other errors, and Success
+// for a nil error.
+func FromError(err error) Code {
+	if err == nil {
+		return Success
+	}
+	var coded *codedError
+	if errors.As(err, &coded) {
+		return coded.code
+	}
+	if errors.Is(err, syscall.EADDRINUSE) {
+		return PortInUse
+	}
+	return Failure
+}
+
+// Fatal logs the message with the error at fatal level and exits the process with the exit code
+// of the error. Like logrus fatal logs, deferred functions are not run.
+func Fatal(entry *logrus.Entry, err error, msg string) {
+	code := FromError(err)
+	entry.WithError(err).WithField("exitCode", int(code)).Log(logrus.FatalLevel, msg)
+	exit(int(code))
+}
@@ -0,0 +1,58 @@
+package exitcode
+
+import (
+	"net"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/sirupsen/logrus"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestFromError(t *testing.T) {
+	assert.Equal(t, Success, FromError(nil))
+	assert.Equal(t, Failure, FromError(errors.New("failed")))
+
+	err := Wrap(errors.New("invalid database"), DatabaseCorrupted)
+	assert.Equal(t, "invalid database", err.Error())
+	assert.Equal(t, DatabaseCorrupted, FromError(err))
+	assert.Equal(t, DatabaseCorrupted, FromError(errors.Wrap(err, "could not start node")))
+	assert.Equal(t, GenesisMismatch, FromError(Wrap(err, GenesisMismatch)), "Expected the code of the outermost error")
+	assert.Equal(t, nil, Wrap(nil, ConfigError))
+}
+
+func TestFromError_PortInUse(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, lis.Close())
+	}()
+	_, err = net.Listen("tcp", lis.Addr().String())
+	require.NotNil(t, err)
+	assert.Equal(t, PortInUse, FromError(errors.Wrap(err, "could not listen")))
+}
+
+func TestFatal(t *testing.T) {
+	var exited int
+	osExit := exit
+	exit = func(code int) {
+		exited = code
+	}
+	defer func() {
+		exit = osExit
+	}()
+	logger, hook := logTest.NewNullLogger()
+
+	Fatal(logrus.NewEntry(logger), Wrap(errors.New("slashable"), SlashingDetected), "Stopping")
+	assert.Equal(t, int(SlashingDetected), exited)
+	require.NotNil(t, hook.LastEntry())
+	assert.Equal(t, logrus.FatalLevel, hook.LastEntry().Level)
+	assert.Equal(t, int(SlashingDetected), hook.LastEntry().Data["exitCode"])
+}
+
+func TestCode_String(t *testing.T) {
+	assert.Equal(t, "port in use", PortInUse.String())
+	assert.Equal(t, "exit code 42", Code(42).String())
+}
@@ -145,9 +145,13 @@ func configureTestnet(ctx *cli.Context, cfg *Flags) {
 // on what flags are enabled for the beacon-chain client.
 func ConfigureBeaconChain(ctx *cli.Context) {
 	complainOnDeprecatedFlags(ctx)
+	sources, err := applyFeaturesFile(ctx, BeaconChainFlags)
+	if err != nil {
+		log.WithError(err).Fatal("Could not load features file")
+	}
 	cfg := &Flags{}
 	if ctx.Bool(devModeFlag.Name) {
-		enableDevModeFlags(ctx)
+		enableDevModeFlags(ctx, sources)
 	}
 	configureTestnet(ctx, cfg)
 
@@ -200,12 +204,17 @@ func ConfigureBeaconChain(ctx *cli.Context) {
 		cfg.EnableLargerGossipHistory = true
 	}
 	Init(cfg)
+	recordActiveFeatures(ctx, BeaconChainFlags, sources)
 }
 
 // ConfigureSlasher sets the global config based
 // on what flags are enabled for the slasher client.
 func ConfigureSlasher(ctx *cli.Context) {
 	complainOnDeprecatedFlags(ctx)
+	sources, err := applyFeaturesFile(ctx, SlasherFlags)
+	if err != nil {
+		log.WithError(err).Fatal("Could not load features file")
+	}
 	cfg := &Flags{}
 	configureTestnet(ctx, cfg)
 
@@ -214,12 +223,17 @@ func ConfigureSlasher(ctx *cli.Context) {
 		cfg.DisableLookback = true
 	}
 	Init(cfg)
+	recordActiveFeatures(ctx, SlasherFlags, sources)
 }
 
 // ConfigureValidator sets the global config based
 // on what flags are enabled for the validator client.
 func ConfigureValidator(ctx *cli.Context) {
 	complainOnDeprecatedFlags(ctx)
+	sources, err := applyFeaturesFile(ctx, ValidatorFlags)
+	if err != nil {
+		log.WithError(err).Fatal("Could not load features file")
+	}
 	cfg := &Flags{}
 	configureTestnet(ctx, cfg)
 	if ctx.Bool(enableExternalSlasherProtectionFlag.Name) {
@@ -237,17 +251,21 @@ func ConfigureValidator(ctx *cli.Context) {
 		cfg.EnableBlst = false
 	}
 	Init(cfg)
+	recordActiveFeatures(ctx, ValidatorFlags, sources)
 }
 
-// enableDevModeFlags switches development mode features on.
-func enableDevModeFlags(ctx *cli.Context) {
+// enableDevModeFlags switches development mode features on. The enabled features
+// inherit the source of the dev flag itself.
+func enableDevModeFlags(ctx *cli.Context, sources map[string]FeatureSource) {
 	log.Warn("Enabling development mode flags")
 	for _, f := range devModeFlags {
 		log.WithField("flag", f.Names()[0]).Debug("Enabling development mode flag")
 		if !ctx.IsSet(f.Names()[0]) {
 			if err := ctx.Set(f.Names()[0], "true"); err != nil {
 				log.WithError(err).Debug("Error enabling development mode flag")
+				continue
 			}
+			sources[f.Names()[0]] = sources[devModeFlag.Name]
 		}
 	}
 }
@@ -0,0 +1,187 @@
+package featureconfig
+
+import (
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	sharedpb "github.com/prysmaticlabs/prysm/proto/shared/v1"
+	"github.com/urfave/cli/v2"
+	"gopkg.in/yaml.v2"
+)
+
+// FeatureSource describes where the value of a feature flag came from.
+type FeatureSource string
+
+const (
+	// SourceDefault is used for features left at their default value.
+	SourceDefault FeatureSource = "default"
+	// SourceFlag is used for features set on the command line.
+	SourceFlag FeatureSource = "flag"
+	// SourceFile is used for features set in the features file.
+	SourceFile FeatureSource = "file"
+)
+
+// ActiveFeature is a feature flag along with its runtime value and where it was set.
+type ActiveFeature struct {
+	Name   string        `json:"name"`
+	Value  string        `json:"value"`
+	Source FeatureSource `json:"source"`
+}
+
+var activeFeatures []*ActiveFeature
+
+// ActiveFeatures returns the feature flags of the running binary with their values and sources.
+func ActiveFeatures() []*ActiveFeature {
+	featureConfigLock.RLock()
+	defer featureConfigLock.RUnlock()
+
+	features := make([]*ActiveFeature, len(activeFeatures))
+	copy(features, activeFeatures)
+	return features
+}
+
+// FeaturesProto converts feature flags to the messages returned by the APIs of every binary.
+func FeaturesProto(features []*ActiveFeature) []*sharedpb.Feature {
+	res := make([]*sharedpb.Feature, len(features))
+	for i, f := range features {
+		res[i] = &sharedpb.Feature{
+			Name:   f.Name,
+			Value:  f.Value,
+			Source: string(f.Source),
+		}
+	}
+	return res
+}
+
+// loadFeaturesFile reads a YAML file mapping feature flag names to their values.
+func loadFeaturesFile(path string) (map[string]string, error) {
+	enc, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read features file")
+	}
+	raw := make(map[string]interface{})
+	if err := yaml.Unmarshal(enc, &raw); err != nil {
+		return nil, errors.Wrap(err, "could not parse features file")
+	}
+	values := make(map[string]string, len(raw))
+	for name, v := range raw {
+		switch v.(type) {
+		case bool, int, int64, uint64, float64, string:
+			values[name] = fmt.Sprint(v)
+		default:
+			return nil, fmt.Errorf("feature %q must be a scalar value", name)
+		}
+	}
+	return values, nil
+}
+
+// applyFeaturesFile sets the flags found in the features file on the cli context, unless they were
+// already set on the command line. Flags meant for another Prysm binary are skipped with a warning so
+// the same file can be shared, while unknown flags are rejected. It returns the source of every flag
+// that was not left at its default value.
+func applyFeaturesFile(ctx *cli.Context, flags []cli.Flag) (map[string]FeatureSource, error) {
+	sources := make(map[string]FeatureSource)
+	for _, f := range flags {
+		if ctx.IsSet(f.Names()[0]) {
+			sources[f.Names()[0]] = SourceFlag
+		}
+	}
+	path := ctx.String(FeaturesFileFlag.Name)
+	if path == "" {
+		return sources, nil
+	}
+	values, err := loadFeaturesFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	known := flagNames(flags)
+	deprecated := flagNames(deprecatedFlags)
+	all := flagNames(append(append(append([]cli.Flag{}, BeaconChainFlags...), ValidatorFlags...), SlasherFlags...))
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var unknown []string
+	for _, name := range names {
+		switch {
+		case name == FeaturesFileFlag.Name:
+			return nil, errors.New("features file cannot reference another features file")
+		case deprecated[name]:
+			log.Errorf("%s is deprecated and has no effect. Remove it from the features file, it will be deleted soon.", name)
+		case !known[name] && all[name]:
+			log.WithField("flag", name).Warn("Feature flag in features file does not apply to this binary, ignoring")
+		case !known[name]:
+			unknown = append(unknown, name)
+		case sources[name] == SourceFlag:
+			log.WithField("flag", name).Warn("Feature flag set on the command line overrides the features file")
+		default:
+			if err := ctx.Set(name, values[name]); err != nil {
+				return nil, errors.Wrapf(err, "invalid value for feature %q", name)
+			}
+			sources[name] = SourceFile
+		}
+	}
+	if len(unknown) > 0 {
+		return nil, fmt.Errorf("unknown feature flags in %s: %s", path, strings.Join(unknown, ", "))
+	}
+	log.WithField("path", path).Info("Loaded feature flags from file")
+	return sources, nil
+}
+
+// recordActiveFeatures stores the visible feature flags of the binary for ActiveFeatures.
+func recordActiveFeatures(ctx *cli.Context, flags []cli.Flag, sources map[string]FeatureSource) {
+	features := make([]*ActiveFeature, 0, len(flags))
+	for _, f := range ActiveFlags(flags) {
+		name := f.Names()[0]
+		if name == FeaturesFileFlag.Name {
+			continue
+		}
+		source, ok := sources[name]
+		if !ok {
+			source = SourceDefault
+		}
+		features = append(features, &ActiveFeature{
+			Name:   name,
+			Value:  featureValue(ctx, f),
+			Source: source,
+		})
+	}
+	sort.Slice(features, func(i, j int) bool {
+		return features[i].Name < features[j].Name
+	})
+
+	featureConfigLock.Lock()
+	defer featureConfigLock.Unlock()
+	activeFeatures = features
+}
+
+// featureValue formats the value of a feature flag, falling back to the flag's default
+// when the flag was not registered on the context.
+func featureValue(ctx *cli.Context, f cli.Flag) string {
+	name := f.Names()[0]
+	switch flag := f.(type) {
+	case *cli.BoolFlag:
+		return fmt.Sprint(ctx.Bool(name))
+	case *cli.StringFlag:
+		if !ctx.IsSet(name) && ctx.String(name) == "" {
+			return flag.Value
+		}
+		return ctx.String(name)
+	default:
+		return fmt.Sprint(flagValue(f).FieldByName("Value"))
+	}
+}
+
+func flagNames(flags []cli.Flag) map[string]bool {
+	names := make(map[string]bool, len(flags))
+	for _, f := range flags {
+		names[f.Names()[0]] = true
+	}
+	return names
+}
@@ -0,0 +1,117 @@
+package featureconfig
+
+import (
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	sharedpb "github.com/prysmaticlabs/prysm/proto/shared/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/urfave/cli/v2"
+)
+
+func writeFeaturesFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "features.yaml")
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func newFeaturesContext(t *testing.T, flags []cli.Flag, args ...string) *cli.Context {
+	set := flag.NewFlagSet("test", 0)
+	for _, f := range flags {
+		require.NoError(t, f.Apply(set))
+	}
+	require.NoError(t, set.Parse(args))
+	return cli.NewContext(&cli.App{}, set, nil)
+}
+
+func TestLoadFeaturesFile(t *testing.T) {
+	path := writeFeaturesFile(t, "enable-peer-scorer: true\nattestation-aggregation-strategy: naive\n")
+	values, err := loadFeaturesFile(path)
+	require.NoError(t, err)
+	assert.DeepEqual(t, map[string]string{
+		"enable-peer-scorer":               "true",
+		"attestation-aggregation-strategy": "naive",
+	}, values)
+
+	path = writeFeaturesFile(t, "enable-peer-scorer:\n  - true\n")
+	_, err = loadFeaturesFile(path)
+	assert.ErrorContains(t, "must be a scalar value", err)
+}
+
+func TestApplyFeaturesFile(t *testing.T) {
+	path := writeFeaturesFile(t, "enable-peer-scorer: true\nattestation-aggregation-strategy: naive\n")
+	ctx := newFeaturesContext(t, BeaconChainFlags,
+		"--"+FeaturesFileFlag.Name, path,
+		"--"+attestationAggregationStrategy.Name, "max_cover",
+	)
+	sources, err := applyFeaturesFile(ctx, BeaconChainFlags)
+	require.NoError(t, err)
+	assert.Equal(t, true, ctx.Bool(enablePeerScorer.Name))
+	assert.Equal(t, SourceFile, sources[enablePeerScorer.Name])
+	// The command line takes precedence over the file.
+	assert.Equal(t, "max_cover", ctx.String(attestationAggregationStrategy.Name))
+	assert.Equal(t, SourceFlag, sources[attestationAggregationStrategy.Name])
+}
+
+func TestApplyFeaturesFile_UnknownFlag(t *testing.T) {
+	path := writeFeaturesFile(t, "enable-peer-scorer: true\nenable-something-new: true\n")
+	ctx := newFeaturesContext(t, BeaconChainFlags, "--"+FeaturesFileFlag.Name, path)
+	_, err := applyFeaturesFile(ctx, BeaconChainFlags)
+	assert.ErrorContains(t, "unknown feature flags", err)
+	assert.ErrorContains(t, "enable-something-new", err)
+}
+
+func TestApplyFeaturesFile_OtherBinaryFlagIgnored(t *testing.T) {
+	path := writeFeaturesFile(t, "enable-peer-scorer: true\ndisable-blst: true\n")
+	ctx := newFeaturesContext(t, ValidatorFlags, "--"+FeaturesFileFlag.Name, path)
+	sources, err := applyFeaturesFile(ctx, ValidatorFlags)
+	require.NoError(t, err)
+	assert.Equal(t, true, ctx.Bool(disableBlst.Name))
+	assert.Equal(t, SourceFile, sources[disableBlst.Name])
+	_, ok := sources[enablePeerScorer.Name]
+	assert.Equal(t, false, ok)
+}
+
+func TestApplyFeaturesFile_InvalidValue(t *testing.T) {
+	path := writeFeaturesFile(t, "enable-peer-scorer: maybe\n")
+	ctx := newFeaturesContext(t, BeaconChainFlags, "--"+FeaturesFileFlag.Name, path)
+	_, err := applyFeaturesFile(ctx, BeaconChainFlags)
+	assert.ErrorContains(t, "invalid value for feature", err)
+}
+
+func TestConfigureBeaconChain_RecordsActiveFeatures(t *testing.T) {
+	defer Init(&Flags{})
+	path := writeFeaturesFile(t, "enable-peer-scorer: true\n")
+	ctx := newFeaturesContext(t, BeaconChainFlags,
+		"--"+FeaturesFileFlag.Name, path,
+		"--"+devModeFlag.Name,
+	)
+	ConfigureBeaconChain(ctx)
+	assert.Equal(t, true, Get().EnablePeerScorer)
+	assert.Equal(t, true, Get().EnableSyncBacktracking)
+
+	features := make(map[string]*ActiveFeature)
+	for _, f := range ActiveFeatures() {
+		features[f.Name] = f
+	}
+	_, ok := features[FeaturesFileFlag.Name]
+	assert.Equal(t, false, ok)
+	assert.DeepEqual(t, &ActiveFeature{Name: enablePeerScorer.Name, Value: "true", Source: SourceFile}, features[enablePeerScorer.Name])
+	assert.DeepEqual(t, &ActiveFeature{Name: enableSyncBacktracking.Name, Value: "true", Source: SourceFlag}, features[enableSyncBacktracking.Name])
+	assert.DeepEqual(t, &ActiveFeature{Name: disableBlst.Name, Value: "false", Source: SourceDefault}, features[disableBlst.Name])
+}
+
+func TestFeaturesProto(t *testing.T) {
+	features := []*ActiveFeature{
+		{Name: enablePeerScorer.Name, Value: "true", Source: SourceFile},
+		{Name: disableBlst.Name, Value: "false", Source: SourceDefault},
+	}
+	want := []*sharedpb.Feature{
+		{Name: enablePeerScorer.Name, Value: "true", Source: "file"},
+		{Name: disableBlst.Name, Value: "false", Source: "default"},
+	}
+	assert.DeepEqual(t, want, FeaturesProto(features))
+}
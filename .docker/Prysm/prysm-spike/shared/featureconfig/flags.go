@@ -25,6 +25,12 @@ var (
 		Name:  "mainnet",
 		Usage: "Run on Ethereum 2.0 Main Net. This is the default and can be omitted.",
 	}
+	// FeaturesFileFlag specifies a YAML file from which to load feature flags.
+	FeaturesFileFlag = &cli.StringFlag{
+		Name: "features-file",
+		Usage: "Path to a YAML file mapping feature flag names to their values, e.g. `enable-peer-scorer: true`. " +
+			"Flags set on the command line take precedence over the file",
+	}
 	devModeFlag = &cli.BoolFlag{
 		Name:  "dev",
 		Usage: "Enable experimental features still in development. These features may not be stable.",
@@ -103,6 +109,7 @@ var devModeFlags = []cli.Flag{
 
 // ValidatorFlags contains a list of all the feature flags that apply to the validator client.
 var ValidatorFlags = append(deprecatedFlags, []cli.Flag{
+	FeaturesFileFlag,
 	writeWalletPasswordOnWebOnboarding,
 	enableExternalSlasherProtectionFlag,
 	ToledoTestnet,
@@ -115,6 +122,7 @@ var ValidatorFlags = append(deprecatedFlags, []cli.Flag{
 
 // SlasherFlags contains a list of all the feature flags that apply to the slasher client.
 var SlasherFlags = append(deprecatedFlags, []cli.Flag{
+	FeaturesFileFlag,
 	disableLookbackFlag,
 	ToledoTestnet,
 	PyrmontTestnet,
@@ -127,6 +135,7 @@ var E2EValidatorFlags = []string{}
 
 // BeaconChainFlags contains a list of all the feature flags that apply to the beacon-chain client.
 var BeaconChainFlags = append(deprecatedFlags, []cli.Flag{
+	FeaturesFileFlag,
 	devModeFlag,
 	writeSSZStateTransitionsFlag,
 	kafkaBootstrapServersFlag,
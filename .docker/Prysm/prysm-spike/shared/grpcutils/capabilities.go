@@ -0,0 +1,24 @@
+package grpcutils
+
+// APIVersion is the version of the RPC API beacon nodes serve to validator clients. It is
+// incremented on changes clients built against an older version cannot handle.
+const APIVersion = 1
+
+// Optional capabilities beacon nodes advertise to their clients.
+const (
+	// CapabilityProposerDependentRoot means duties responses carry the ProposerDependentRootHeader.
+	CapabilityProposerDependentRoot = "proposer_dependent_root"
+	// CapabilityFilteredBlockStream means blocks can be streamed with server side filters
+	// using the StreamFilteredBlocks endpoint.
+	CapabilityFilteredBlockStream = "filtered_block_stream"
+	// CapabilityHeadEvents means head changes and their duty dependent roots can be streamed with
+	// the StreamHeadEvents endpoint.
+	CapabilityHeadEvents = "head_events"
+)
+
+// BeaconCapabilities lists the capabilities of beacon nodes of this build.
+var BeaconCapabilities = []string{
+	CapabilityProposerDependentRoot,
+	CapabilityFilteredBlockStream,
+	CapabilityHeadEvents,
+}
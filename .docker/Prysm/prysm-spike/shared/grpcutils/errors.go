@@ -0,0 +1,45 @@
+package grpcutils
+
+import (
+	"errors"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrorDomain is the domain of the error info details of the errors returned by the RPC servers.
+const ErrorDomain = "prysm"
+
+// InvalidSignatureReason is the reason of the error info detail of the errors rejecting a message for
+// its signature, so clients can tell them apart from other rejections without parsing their messages.
+const InvalidSignatureReason = "INVALID_SIGNATURE"
+
+// InvalidSignatureError returns an InvalidArgument error with the formatted message, carrying an error
+// info detail with the InvalidSignatureReason.
+func InvalidSignatureError(format string, args ...interface{}) error {
+	st := status.Newf(codes.InvalidArgument, format, args...)
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: InvalidSignatureReason,
+		Domain: ErrorDomain,
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// IsInvalidSignatureError returns whether the error, or an error it wraps, is an RPC error carrying
+// an error info detail with the InvalidSignatureReason.
+func IsInvalidSignatureError(err error) bool {
+	var grpcErr interface{ GRPCStatus() *status.Status }
+	if !errors.As(err, &grpcErr) {
+		return false
+	}
+	for _, detail := range grpcErr.GRPCStatus().Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok && info.Domain == ErrorDomain && info.Reason == InvalidSignatureReason {
+			return true
+		}
+	}
+	return false
+}
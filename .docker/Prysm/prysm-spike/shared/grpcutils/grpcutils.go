@@ -9,6 +9,36 @@ import (
 	"google.golang.org/grpc/metadata"
 )
 
+// ProposerDependentRootHeader is the response header in which the beacon node returns the root of the
+// block the proposer duties of the requested epoch depend on. The duties change if this block is reorged.
+const ProposerDependentRootHeader = "x-proposer-dependent-root"
+
+// ETagHeader is the response header in which the beacon node returns the entity tag of responses about
+// finalized data, which never change, so HTTP clients can revalidate them with If-None-Match.
+const ETagHeader = "x-etag"
+
+// Request headers filtering the responses of standard API methods, whose requests have no fields for them.
+const (
+	// PeerStateHeader and PeerDirectionHeader hold comma separated lists of the states and directions, such as
+	// connected or inbound, the peers are listed with.
+	PeerStateHeader     = "x-peer-state"
+	PeerDirectionHeader = "x-peer-direction"
+)
+
+// SyncingHeader is the response header in which the beacon node tells health checks whether it is syncing, so the
+// standard health route answers 206 Partial Content rather than 200 OK while the node catches up.
+const SyncingHeader = "x-syncing"
+
+// Response headers in which an API replica returns how fresh its responses are. The head slot is the head of the
+// database snapshot the replica serves, and the lag is how many slots it is behind the head of the primary beacon
+// node, or behind the current slot when the replica does not follow the primary.
+const (
+	ReplicaHeadSlotHeader        = "x-replica-head-slot"
+	ReplicaPrimaryHeadSlotHeader = "x-replica-primary-head-slot"
+	ReplicaLagSlotsHeader        = "x-replica-lag-slots"
+	ReplicaSnapshotTimeHeader    = "x-replica-snapshot-time"
+)
+
 // LogGRPCRequests this method logs the gRPC backend as well as request duration when the log level is set to debug
 // or higher.
 func LogGRPCRequests(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
@@ -0,0 +1,88 @@
+// Package inventory reports the version, chain config, data directory disk usage, feature flags
+// and service statuses of a running binary, so orchestration tooling can inspect a node with a
+// single request to its API.
+package inventory
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/pkg/errors"
+	sharedpb "github.com/prysmaticlabs/prysm/proto/shared/v1"
+	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/version"
+)
+
+// Config describes the node reported by the inventory.
+type Config struct {
+	// Binary is the name of the Prysm binary, such as beacon-chain or validator.
+	Binary string
+	// DataDir is the data directory whose disk usage is reported.
+	DataDir string
+	// GenesisValidatorsRoot returns the genesis validators root known to the node, nil if not yet known.
+	GenesisValidatorsRoot func() ([]byte, error)
+}
+
+// Build returns the binary version, chain config hash, genesis validators root, data directory
+// disk usage, active features and service statuses of the node.
+func Build(svcRegistry *shared.ServiceRegistry, cfg *Config) (*sharedpb.Inventory, error) {
+	configHash, err := params.BeaconConfigHash()
+	if err != nil {
+		return nil, err
+	}
+	inv := &sharedpb.Inventory{
+		Binary:          cfg.Binary,
+		Version:         version.GetVersion(),
+		ChainConfigHash: configHash,
+		DataDir:         cfg.DataDir,
+		Features:        featureconfig.FeaturesProto(featureconfig.ActiveFeatures()),
+		Services:        make([]*sharedpb.ServiceStatus, 0),
+	}
+	if cfg.GenesisValidatorsRoot != nil {
+		root, err := cfg.GenesisValidatorsRoot()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get genesis validators root")
+		}
+		inv.GenesisValidatorsRoot = root
+	}
+	if cfg.DataDir != "" {
+		size, err := dirSize(cfg.DataDir)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get data directory disk usage")
+		}
+		inv.DataDirBytes = uint64(size)
+	}
+	for kind, err := range svcRegistry.Statuses() {
+		status := &sharedpb.ServiceStatus{Service: kind.String(), Status: err == nil}
+		if err != nil {
+			status.Error = err.Error()
+		}
+		inv.Services = append(inv.Services, status)
+	}
+	sort.Slice(inv.Services, func(i, j int) bool {
+		return inv.Services[i].Service < inv.Services[j].Service
+	})
+	return inv, nil
+}
+
+// dirSize returns the total size in bytes of the regular files under the directory, 0 if the
+// directory does not exist.
+func dirSize(dir string) (int64, error) {
+	var size int64
+	err := filepath.Walk(dir, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if info.Mode().IsRegular() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size, err
+}
@@ -0,0 +1,71 @@
+package inventory
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+type mockService struct {
+	status error
+}
+
+func (m *mockService) Start() {
+}
+
+func (m *mockService) Stop() error {
+	return nil
+}
+
+func (m *mockService) Status() error {
+	return m.status
+}
+
+func TestBuild(t *testing.T) {
+	registry := shared.NewServiceRegistry()
+	require.NoError(t, registry.RegisterService(&mockService{status: errors.New("not synced")}))
+	dataDir := t.TempDir()
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dataDir, "a"), make([]byte, 100), 0600))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dataDir, "b"), make([]byte, 20), 0600))
+
+	inv, err := Build(registry, &Config{
+		Binary:  "validator",
+		DataDir: dataDir,
+		GenesisValidatorsRoot: func() ([]byte, error) {
+			return []byte{0x01, 0x02}, nil
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "validator", inv.Binary)
+	assert.DeepEqual(t, []byte{0x01, 0x02}, inv.GenesisValidatorsRoot)
+	assert.Equal(t, uint64(120), inv.DataDirBytes)
+	require.Equal(t, 1, len(inv.Services))
+	assert.Equal(t, "*inventory.mockService", inv.Services[0].Service)
+	assert.Equal(t, false, inv.Services[0].Status)
+	assert.Equal(t, "not synced", inv.Services[0].Error)
+
+	// The config hash follows the active chain config.
+	hash := inv.ChainConfigHash
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.SlotsPerEpoch++
+	params.OverrideBeaconConfig(cfg)
+	changed, err := params.BeaconConfigHash()
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, changed)
+}
+
+func TestBuild_MissingDataDir(t *testing.T) {
+	inv, err := Build(shared.NewServiceRegistry(), &Config{
+		DataDir: filepath.Join(t.TempDir(), "missing"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), inv.DataDirBytes)
+	assert.Equal(t, 0, len(inv.GenesisValidatorsRoot))
+}
@@ -0,0 +1,170 @@
+package logutil
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// DedupRule collapses the repeated messages starting with a prefix into one message per interval.
+type DedupRule struct {
+	Prefix   string
+	Interval time.Duration
+}
+
+type dedupState struct {
+	rule       *DedupRule
+	level      logrus.Level
+	message    string
+	firstSeen  time.Time
+	suppressed int
+}
+
+// DedupFormatter wraps a formatter to collapse repeated identical messages matching a rule, such as
+// the warnings logged for every block or peer during an incident. The first message of an interval
+// is written, the repeats are counted, and the count is written along with the next message after
+// the interval or in a summary by Flush. Messages are identical when their text and level are, their
+// fields are not compared. Errors and more severe messages are never collapsed.
+//
+// Logrus hooks cannot drop entries, which is why deduplication happens when entries are formatted.
+type DedupFormatter struct {
+	inner  logrus.Formatter
+	rules  []*DedupRule
+	now    func() time.Time
+	lock   sync.Mutex
+	states map[string]*dedupState
+}
+
+// NewDedupFormatter returns a formatter collapsing the messages matching the rules, and writing
+// entries with the inner formatter.
+func NewDedupFormatter(inner logrus.Formatter, rules []*DedupRule) *DedupFormatter {
+	return &DedupFormatter{
+		inner:  inner,
+		rules:  rules,
+		now:    time.Now,
+		states: make(map[string]*dedupState),
+	}
+}
+
+// Format writes the entry with the inner formatter, unless it repeats a message of the current
+// interval of its rule.
+func (f *DedupFormatter) Format(entry *logrus.Entry) ([]byte, error) {
+	if entry.Level <= logrus.ErrorLevel {
+		return f.inner.Format(entry)
+	}
+	rule := f.ruleFor(entry.Message)
+	if rule == nil {
+		return f.inner.Format(entry)
+	}
+	key := entry.Level.String() + "|" + entry.Message
+	now := f.now()
+	f.lock.Lock()
+	state, ok := f.states[key]
+	if ok && now.Sub(state.firstSeen) < rule.Interval {
+		state.suppressed++
+		f.lock.Unlock()
+		return nil, nil
+	}
+	suppressed := 0
+	if ok {
+		suppressed = state.suppressed
+	}
+	f.states[key] = &dedupState{rule: rule, level: entry.Level, message: entry.Message, firstSeen: now}
+	f.lock.Unlock()
+
+	if suppressed > 0 {
+		repeated := entry.WithField("suppressedRepeats", suppressed)
+		repeated.Level = entry.Level
+		repeated.Message = entry.Message
+		repeated.Caller = entry.Caller
+		entry = repeated
+	}
+	return f.inner.Format(entry)
+}
+
+// Flush returns summary entries for the messages repeated during intervals which are over, and
+// forgets those messages so their next occurrence is written again.
+func (f *DedupFormatter) Flush() []*logrus.Entry {
+	now := f.now()
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	var summaries []*logrus.Entry
+	for key, state := range f.states {
+		if now.Sub(state.firstSeen) < state.rule.Interval {
+			continue
+		}
+		delete(f.states, key)
+		if state.suppressed == 0 {
+			continue
+		}
+		entry := logrus.WithFields(logrus.Fields{
+			"message":  state.message,
+			"count":    state.suppressed,
+			"interval": state.rule.Interval,
+		})
+		entry.Level = state.level
+		entry.Message = "Suppressed repeated log messages"
+		summaries = append(summaries, entry)
+	}
+	return summaries
+}
+
+func (f *DedupFormatter) ruleFor(message string) *DedupRule {
+	for _, rule := range f.rules {
+		if strings.HasPrefix(message, rule.Prefix) {
+			return rule
+		}
+	}
+	return nil
+}
+
+// ParseDedupRules parses rules given as prefix=interval, such as "Block is not processed=1m".
+func ParseDedupRules(values []string) ([]*DedupRule, error) {
+	rules := make([]*DedupRule, 0, len(values))
+	for _, v := range values {
+		i := strings.LastIndex(v, "=")
+		if i <= 0 {
+			return nil, fmt.Errorf("invalid log deduplication rule %q, expected prefix=interval", v)
+		}
+		interval, err := time.ParseDuration(v[i+1:])
+		if err != nil || interval <= 0 {
+			return nil, fmt.Errorf("invalid interval in log deduplication rule %q", v)
+		}
+		rules = append(rules, &DedupRule{Prefix: v[:i], Interval: interval})
+	}
+	return rules, nil
+}
+
+// ConfigureDeduplication wraps the formatter of the standard logger to collapse the repeated
+// messages matching the rules, and logs summaries of the collapsed messages as often as the
+// shortest interval of the rules.
+func ConfigureDeduplication(values []string) error {
+	rules, err := ParseDedupRules(values)
+	if err != nil {
+		return err
+	}
+	if len(rules) == 0 {
+		return nil
+	}
+	period := rules[0].Interval
+	for _, rule := range rules {
+		if rule.Interval < period {
+			period = rule.Interval
+		}
+	}
+	f := NewDedupFormatter(logrus.StandardLogger().Formatter, rules)
+	logrus.SetFormatter(f)
+	go func() {
+		ticker := time.NewTicker(period)
+		defer ticker.Stop()
+		for range ticker.C {
+			for _, summary := range f.Flush() {
+				summary.Log(summary.Level, summary.Message)
+			}
+		}
+	}()
+	return nil
+}
@@ -0,0 +1,64 @@
+package logutil
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/sirupsen/logrus"
+)
+
+func TestDedupFormatter(t *testing.T) {
+	rules, err := ParseDedupRules([]string{"Block is not processed=1m"})
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	logger := logrus.New()
+	logger.Out = &buf
+	f := NewDedupFormatter(&logrus.TextFormatter{DisableTimestamp: true}, rules)
+	now := time.Unix(1000, 0)
+	f.now = func() time.Time { return now }
+	logger.SetFormatter(f)
+
+	for i := 0; i < 3; i++ {
+		logger.WithField("slot", i).Warn("Block is not processed")
+	}
+	logger.Warn("Other message")
+	logger.Error("Block is not processed")
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Equal(t, 3, len(lines))
+	assert.Equal(t, `level=warning msg="Block is not processed" slot=0`, lines[0])
+	assert.Equal(t, `level=warning msg="Other message"`, lines[1])
+	assert.Equal(t, `level=error msg="Block is not processed"`, lines[2])
+
+	// The first message after the interval carries the number of repeats.
+	now = now.Add(time.Minute)
+	buf.Reset()
+	logger.WithField("slot", 3).Warn("Block is not processed")
+	assert.Equal(t, `level=warning msg="Block is not processed" slot=3 suppressedRepeats=2`, strings.TrimSpace(buf.String()))
+
+	// Repeats of an interval which is over without another message are summarized by Flush.
+	logger.Warn("Block is not processed")
+	assert.Equal(t, 0, len(f.Flush()))
+	now = now.Add(time.Minute)
+	summaries := f.Flush()
+	require.Equal(t, 1, len(summaries))
+	assert.Equal(t, logrus.WarnLevel, summaries[0].Level)
+	assert.Equal(t, 1, summaries[0].Data["count"])
+	assert.Equal(t, "Block is not processed", summaries[0].Data["message"])
+	assert.Equal(t, 0, len(f.Flush()))
+}
+
+func TestParseDedupRules(t *testing.T) {
+	rules, err := ParseDedupRules([]string{"a=b=5s"})
+	require.NoError(t, err)
+	assert.Equal(t, "a=b", rules[0].Prefix)
+	assert.Equal(t, 5*time.Second, rules[0].Interval)
+
+	_, err = ParseDedupRules([]string{"prefix"})
+	assert.ErrorContains(t, "expected prefix=interval", err)
+	_, err = ParseDedupRules([]string{"prefix=soon"})
+	assert.ErrorContains(t, "invalid interval", err)
+}
@@ -2,6 +2,7 @@
 package p2putils
 
 import (
+	"sort"
 	"time"
 
 	"github.com/pkg/errors"
@@ -43,23 +44,54 @@ func CreateForkDigest(
 func Fork(
 	targetEpoch uint64,
 ) (*pb.Fork, error) {
-	// We retrieve a list of scheduled forks by epoch.
-	// We loop through the keys in this map to determine the current
-	// fork version based on the requested epoch.
-	retrievedForkVersion := params.BeaconConfig().GenesisForkVersion
-	previousForkVersion := params.BeaconConfig().GenesisForkVersion
-	scheduledForks := params.BeaconConfig().ForkVersionSchedule
-	forkEpoch := uint64(0)
-	for epoch, forkVersion := range scheduledForks {
-		if epoch <= targetEpoch {
-			previousForkVersion = retrievedForkVersion
-			retrievedForkVersion = forkVersion
-			forkEpoch = epoch
+	// We retrieve the list of scheduled forks in epoch order
+	// to determine the current fork version based on the
+	// requested epoch.
+	fork := &pb.Fork{
+		PreviousVersion: params.BeaconConfig().GenesisForkVersion,
+		CurrentVersion:  params.BeaconConfig().GenesisForkVersion,
+		Epoch:           0,
+	}
+	for _, scheduled := range ScheduledForks() {
+		if scheduled.Epoch > targetEpoch {
+			break
+		}
+		fork = scheduled
+	}
+	return fork, nil
+}
+
+// ScheduledForks returns the forks of the fork version schedule
+// in epoch order, each with the version of the fork before it.
+func ScheduledForks() []*pb.Fork {
+	schedule := params.BeaconConfig().ForkVersionSchedule
+	epochs := make([]uint64, 0, len(schedule))
+	for epoch := range schedule {
+		epochs = append(epochs, epoch)
+	}
+	sort.Slice(epochs, func(i, j int) bool {
+		return epochs[i] < epochs[j]
+	})
+	forks := make([]*pb.Fork, 0, len(epochs))
+	previousVersion := params.BeaconConfig().GenesisForkVersion
+	for _, epoch := range epochs {
+		forks = append(forks, &pb.Fork{
+			PreviousVersion: previousVersion,
+			CurrentVersion:  schedule[epoch],
+			Epoch:           epoch,
+		})
+		previousVersion = schedule[epoch]
+	}
+	return forks
+}
+
+// NextFork returns the first fork of the fork version schedule
+// after the current epoch, nil if no fork is scheduled.
+func NextFork(currentEpoch uint64) *pb.Fork {
+	for _, scheduled := range ScheduledForks() {
+		if scheduled.Epoch > currentEpoch {
+			return scheduled
 		}
 	}
-	return &pb.Fork{
-		PreviousVersion: previousForkVersion,
-		CurrentVersion:  retrievedForkVersion,
-		Epoch:           forkEpoch,
-	}, nil
+	return nil
 }
@@ -0,0 +1,51 @@
+package p2putils
+
+import (
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestFork(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.GenesisForkVersion = []byte{0, 0, 0, 0}
+	cfg.ForkVersionSchedule = map[uint64][]byte{
+		20: {2, 0, 0, 0},
+		10: {1, 0, 0, 0},
+	}
+	params.OverrideBeaconConfig(cfg)
+
+	tests := []struct {
+		epoch uint64
+		want  *pb.Fork
+	}{
+		{epoch: 0, want: &pb.Fork{PreviousVersion: []byte{0, 0, 0, 0}, CurrentVersion: []byte{0, 0, 0, 0}, Epoch: 0}},
+		{epoch: 9, want: &pb.Fork{PreviousVersion: []byte{0, 0, 0, 0}, CurrentVersion: []byte{0, 0, 0, 0}, Epoch: 0}},
+		{epoch: 10, want: &pb.Fork{PreviousVersion: []byte{0, 0, 0, 0}, CurrentVersion: []byte{1, 0, 0, 0}, Epoch: 10}},
+		{epoch: 19, want: &pb.Fork{PreviousVersion: []byte{0, 0, 0, 0}, CurrentVersion: []byte{1, 0, 0, 0}, Epoch: 10}},
+		{epoch: 25, want: &pb.Fork{PreviousVersion: []byte{1, 0, 0, 0}, CurrentVersion: []byte{2, 0, 0, 0}, Epoch: 20}},
+	}
+	for _, tt := range tests {
+		fork, err := Fork(tt.epoch)
+		require.NoError(t, err)
+		assert.DeepEqual(t, tt.want, fork)
+	}
+}
+
+func TestNextFork(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.ForkVersionSchedule = map[uint64][]byte{
+		20: {2, 0, 0, 0},
+		10: {1, 0, 0, 0},
+	}
+	params.OverrideBeaconConfig(cfg)
+
+	assert.Equal(t, uint64(10), NextFork(0).Epoch)
+	assert.Equal(t, uint64(20), NextFork(10).Epoch)
+	assert.Equal(t, (*pb.Fork)(nil), NextFork(20))
+}
@@ -1,6 +1,7 @@
 package params
 
 import (
+	"crypto/sha256"
 	"encoding/hex"
 	"io/ioutil"
 	"strings"
@@ -29,10 +30,25 @@ func LoadChainConfigFile(chainConfigFileName string) {
 	if err := yaml.Unmarshal(yamlFile, conf); err != nil {
 		log.WithError(err).Fatal("Failed to parse chain config yaml file.")
 	}
+	scheduleNextFork(conf)
 	log.Debugf("Config file values: %+v", conf)
 	OverrideBeaconConfig(conf)
 }
 
+// scheduleNextFork adds the fork set with the next fork values of a chain config file to the fork version
+// schedule, so beacon states move to it and validators sign with its version from its epoch on.
+func scheduleNextFork(conf *BeaconChainConfig) {
+	if conf.NextForkEpoch == conf.FarFutureEpoch || len(conf.NextForkVersion) == 0 {
+		return
+	}
+	schedule := make(map[uint64][]byte, len(conf.ForkVersionSchedule)+1)
+	for epoch, version := range conf.ForkVersionSchedule {
+		schedule[epoch] = version
+	}
+	schedule[conf.NextForkEpoch] = conf.NextForkVersion
+	conf.ForkVersionSchedule = schedule
+}
+
 func replaceHexStringWithYAMLFormat(line string) []string {
 	parts := strings.Split(line, "0x")
 	decoded, err := hex.DecodeString(parts[1])
@@ -116,3 +132,14 @@ func replaceHexStringWithYAMLFormat(line string) []string {
 	}
 	return parts
 }
+
+// BeaconConfigHash returns the hex encoded sha256 hash of the YAML encoding of the active beacon
+// chain config, which differs between nodes running with different chain configs.
+func BeaconConfigHash() (string, error) {
+	enc, err := yaml.Marshal(BeaconConfig())
+	if err != nil {
+		return "", err
+	}
+	h := sha256.Sum256(enc)
+	return hex.EncodeToString(h[:]), nil
+}
@@ -57,6 +57,19 @@ func TestLoadConfigFile_OverwriteCorrectly(t *testing.T) {
 	}
 }
 
+func TestScheduleNextFork(t *testing.T) {
+	conf := MainnetConfig().Copy()
+	scheduleNextFork(conf)
+	require.Equal(t, 0, len(conf.ForkVersionSchedule))
+
+	conf.NextForkVersion = []byte{1, 0, 0, 0}
+	conf.NextForkEpoch = 10
+	scheduleNextFork(conf)
+	require.Equal(t, 1, len(conf.ForkVersionSchedule))
+	require.DeepEqual(t, []byte{1, 0, 0, 0}, conf.ForkVersionSchedule[10])
+	require.Equal(t, 0, len(MainnetConfig().ForkVersionSchedule))
+}
+
 func Test_replaceHexStringWithYAMLFormat(t *testing.T) {
 
 	testLines := []struct {
@@ -0,0 +1,80 @@
+package retryutil
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+)
+
+const (
+	// DefaultBreakerThreshold is the number of consecutive failed calls which opens the default breaker.
+	DefaultBreakerThreshold = 5
+	// DefaultBreakerCooldown is how long the default breaker stays open before letting a trial call through.
+	DefaultBreakerCooldown = 10 * time.Second
+)
+
+// ErrBreakerOpen is returned for calls rejected while the circuit breaker is open.
+var ErrBreakerOpen = errors.New("circuit breaker is open")
+
+// Breaker is a circuit breaker which fails calls fast after a number of consecutive failures,
+// giving a recovering remote some time before it is called again. Once the cooldown has elapsed
+// a single trial call is let through, closing the breaker if it succeeds.
+type Breaker struct {
+	lock      sync.Mutex
+	threshold uint
+	cooldown  time.Duration
+	failures  uint
+	openUntil time.Time
+	trialing  bool
+}
+
+// NewBreaker returns a closed breaker opening after threshold consecutive failures.
+func NewBreaker(threshold uint, cooldown time.Duration) *Breaker {
+	return &Breaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+	}
+}
+
+// Allow returns ErrBreakerOpen if a call should not be attempted.
+func (b *Breaker) Allow() error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if b.threshold == 0 || b.failures < b.threshold {
+		return nil
+	}
+	if timeutils.Now().Before(b.openUntil) || b.trialing {
+		return ErrBreakerOpen
+	}
+	// Half open, let a single trial call through.
+	b.trialing = true
+	return nil
+}
+
+// Open reports whether the breaker is currently rejecting calls.
+func (b *Breaker) Open() bool {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.threshold > 0 && b.failures >= b.threshold && timeutils.Now().Before(b.openUntil)
+}
+
+// Success records a successful call and closes the breaker.
+func (b *Breaker) Success() {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.failures = 0
+	b.trialing = false
+}
+
+// Failure records a failed call, opening the breaker once the threshold is reached.
+func (b *Breaker) Failure() {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.failures++
+	b.trialing = false
+	if b.threshold > 0 && b.failures >= b.threshold {
+		b.openUntil = timeutils.Now().Add(b.cooldown)
+	}
+}
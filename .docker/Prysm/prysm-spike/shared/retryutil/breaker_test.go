@@ -0,0 +1,50 @@
+package retryutil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestBreaker_OpensAfterThreshold(t *testing.T) {
+	b := NewBreaker(2, time.Hour)
+	require.NoError(t, b.Allow())
+	b.Failure()
+	require.NoError(t, b.Allow())
+	assert.Equal(t, false, b.Open())
+	b.Failure()
+	assert.Equal(t, true, b.Open())
+	assert.ErrorContains(t, ErrBreakerOpen.Error(), b.Allow())
+}
+
+func TestBreaker_HalfOpen(t *testing.T) {
+	b := NewBreaker(1, 10*time.Millisecond)
+	b.Failure()
+	assert.ErrorContains(t, ErrBreakerOpen.Error(), b.Allow())
+	time.Sleep(20 * time.Millisecond)
+
+	// A single trial call is let through once the cooldown elapsed.
+	require.NoError(t, b.Allow())
+	assert.ErrorContains(t, ErrBreakerOpen.Error(), b.Allow())
+
+	// A failed trial opens the breaker again.
+	b.Failure()
+	assert.ErrorContains(t, ErrBreakerOpen.Error(), b.Allow())
+	time.Sleep(20 * time.Millisecond)
+
+	// A successful trial closes it.
+	require.NoError(t, b.Allow())
+	b.Success()
+	require.NoError(t, b.Allow())
+	require.NoError(t, b.Allow())
+}
+
+func TestBreaker_Disabled(t *testing.T) {
+	b := NewBreaker(0, time.Hour)
+	for i := 0; i < 10; i++ {
+		b.Failure()
+	}
+	require.NoError(t, b.Allow())
+}
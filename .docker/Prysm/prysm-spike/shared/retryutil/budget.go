@@ -0,0 +1,57 @@
+package retryutil
+
+import "sync"
+
+const (
+	// DefaultBudgetTokens is the size of the default retry budget.
+	DefaultBudgetTokens = 10
+	// DefaultBudgetTokenRatio is the number of tokens a successful call gives back to the default budget.
+	DefaultBudgetTokenRatio = 0.1
+)
+
+// Budget limits the number of retries made against a remote which is failing most calls,
+// following the gRPC retry throttling design: every failed attempt spends a token, every
+// successful call earns back a fraction of a token, and retries are only allowed while
+// more than half of the tokens are left.
+type Budget struct {
+	lock       sync.Mutex
+	maxTokens  float64
+	tokens     float64
+	tokenRatio float64
+}
+
+// NewBudget returns a full budget of maxTokens tokens, refilled by tokenRatio per success.
+func NewBudget(maxTokens, tokenRatio float64) *Budget {
+	return &Budget{
+		maxTokens:  maxTokens,
+		tokens:     maxTokens,
+		tokenRatio: tokenRatio,
+	}
+}
+
+// AllowRetry reports whether the budget allows retrying a failed call.
+func (b *Budget) AllowRetry() bool {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.tokens > b.maxTokens/2
+}
+
+// Success records a successful call.
+func (b *Budget) Success() {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.tokens += b.tokenRatio
+	if b.tokens > b.maxTokens {
+		b.tokens = b.maxTokens
+	}
+}
+
+// Failure records a failed attempt.
+func (b *Budget) Failure() {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.tokens--
+	if b.tokens < 0 {
+		b.tokens = 0
+	}
+}
@@ -0,0 +1,29 @@
+package retryutil
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+)
+
+func TestBudget(t *testing.T) {
+	b := NewBudget(4, 0.5)
+	assert.Equal(t, true, b.AllowRetry())
+	b.Failure()
+	assert.Equal(t, true, b.AllowRetry())
+	b.Failure()
+	assert.Equal(t, false, b.AllowRetry(), "Retries allowed with half of the budget spent")
+
+	// Two successes earn back a token.
+	b.Success()
+	b.Success()
+	assert.Equal(t, true, b.AllowRetry())
+
+	// The budget never grows past its size.
+	for i := 0; i < 100; i++ {
+		b.Success()
+	}
+	b.Failure()
+	b.Failure()
+	assert.Equal(t, false, b.AllowRetry())
+}
@@ -0,0 +1,119 @@
+package retryutil
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var log = logrus.WithField("prefix", "retry")
+
+// retryableCodes are the gRPC codes for which a call is retried. Both indicate the
+// remote could not serve the request, rather than the request being invalid.
+var retryableCodes = map[codes.Code]bool{
+	codes.Unavailable:       true,
+	codes.ResourceExhausted: true,
+}
+
+// UnaryClientInterceptor retries failed unary calls according to the policy.
+func UnaryClientInterceptor(p *Policy) grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		return p.do(ctx, method, func() error {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		})
+	}
+}
+
+// StreamClientInterceptor retries failing to establish a stream according to the policy.
+// Errors returned once the stream is established are left to the caller.
+func StreamClientInterceptor(p *Policy) grpc.StreamClientInterceptor {
+	return func(
+		ctx context.Context,
+		desc *grpc.StreamDesc,
+		cc *grpc.ClientConn,
+		method string,
+		streamer grpc.Streamer,
+		opts ...grpc.CallOption,
+	) (grpc.ClientStream, error) {
+		var stream grpc.ClientStream
+		err := p.do(ctx, method, func() error {
+			var err error
+			stream, err = streamer(ctx, desc, cc, method, opts...)
+			return err
+		})
+		return stream, err
+	}
+}
+
+// do runs call until it succeeds, fails with a non retryable error, or the policy gives up.
+func (p *Policy) do(ctx context.Context, method string, call func() error) error {
+	if p.Breaker != nil {
+		if err := p.Breaker.Allow(); err != nil {
+			return status.Errorf(codes.Unavailable, "%s: %v", method, err)
+		}
+	}
+	for attempt := uint(1); ; attempt++ {
+		err := call()
+		if !isRetryable(err) {
+			p.success()
+			return err
+		}
+		if p.Budget != nil {
+			p.Budget.Failure()
+		}
+		if attempt >= p.MaxAttempts || (p.Budget != nil && !p.Budget.AllowRetry()) {
+			p.failure()
+			return err
+		}
+		delay := p.Backoff(attempt - 1)
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+			p.failure()
+			return err
+		}
+		log.WithFields(logrus.Fields{
+			"method":  method,
+			"attempt": attempt,
+			"backoff": delay,
+		}).WithError(err).Debug("Retrying gRPC request")
+		select {
+		case <-ctx.Done():
+			p.failure()
+			return err
+		case <-time.After(delay):
+		}
+	}
+}
+
+func (p *Policy) success() {
+	if p.Budget != nil {
+		p.Budget.Success()
+	}
+	if p.Breaker != nil {
+		p.Breaker.Success()
+	}
+}
+
+func (p *Policy) failure() {
+	if p.Breaker != nil {
+		p.Breaker.Failure()
+	}
+}
+
+func isRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	s, ok := status.FromError(err)
+	return ok && retryableCodes[s.Code()]
+}
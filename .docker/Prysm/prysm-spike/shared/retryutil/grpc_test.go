@@ -0,0 +1,96 @@
+package retryutil
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func invokerFailing(calls *int, failures int, code codes.Code) grpc.UnaryInvoker {
+	return func(_ context.Context, _ string, _, _ interface{}, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		*calls++
+		if *calls <= failures {
+			return status.Error(code, "failed")
+		}
+		return nil
+	}
+}
+
+func TestUnaryClientInterceptor_RetriesUntilSuccess(t *testing.T) {
+	p := NewPolicy(3, time.Millisecond)
+	calls := 0
+	err := UnaryClientInterceptor(p)(context.Background(), "method", nil, nil, nil, invokerFailing(&calls, 2, codes.Unavailable))
+	require.NoError(t, err)
+	assert.Equal(t, 3, calls)
+}
+
+func TestUnaryClientInterceptor_MaxAttempts(t *testing.T) {
+	p := NewPolicy(3, time.Millisecond)
+	calls := 0
+	err := UnaryClientInterceptor(p)(context.Background(), "method", nil, nil, nil, invokerFailing(&calls, 10, codes.Unavailable))
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, 3, calls)
+}
+
+func TestUnaryClientInterceptor_NonRetryable(t *testing.T) {
+	p := NewPolicy(3, time.Millisecond)
+	calls := 0
+	err := UnaryClientInterceptor(p)(context.Background(), "method", nil, nil, nil, invokerFailing(&calls, 10, codes.InvalidArgument))
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, 1, calls)
+}
+
+func TestUnaryClientInterceptor_RespectsDeadline(t *testing.T) {
+	p := NewPolicy(3, time.Hour)
+	p.Jitter = 0
+	calls := 0
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	err := UnaryClientInterceptor(p)(ctx, "method", nil, nil, nil, invokerFailing(&calls, 10, codes.Unavailable))
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, 1, calls, "Retried although the backoff exceeds the deadline")
+}
+
+func TestUnaryClientInterceptor_Budget(t *testing.T) {
+	p := NewPolicy(10, time.Millisecond)
+	p.Budget = NewBudget(4, 0.1)
+	calls := 0
+	err := UnaryClientInterceptor(p)(context.Background(), "method", nil, nil, nil, invokerFailing(&calls, 10, codes.Unavailable))
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, 2, calls, "Retries should stop once half of the budget is spent")
+}
+
+func TestUnaryClientInterceptor_BreakerOpen(t *testing.T) {
+	p := NewPolicy(1, time.Millisecond)
+	p.Breaker = NewBreaker(2, time.Hour)
+	calls := 0
+	interceptor := UnaryClientInterceptor(p)
+	invoker := invokerFailing(&calls, 10, codes.Unavailable)
+	for i := 0; i < 2; i++ {
+		assert.Equal(t, codes.Unavailable, status.Code(interceptor(context.Background(), "method", nil, nil, nil, invoker)))
+	}
+	err := interceptor(context.Background(), "method", nil, nil, nil, invoker)
+	assert.ErrorContains(t, ErrBreakerOpen.Error(), err)
+	assert.Equal(t, 2, calls, "Call made while the breaker is open")
+}
+
+func TestStreamClientInterceptor_RetriesStreamCreation(t *testing.T) {
+	p := NewPolicy(3, time.Millisecond)
+	calls := 0
+	streamer := func(_ context.Context, _ *grpc.StreamDesc, _ *grpc.ClientConn, _ string, _ ...grpc.CallOption) (grpc.ClientStream, error) {
+		calls++
+		if calls < 2 {
+			return nil, status.Error(codes.Unavailable, "failed")
+		}
+		return nil, nil
+	}
+	_, err := StreamClientInterceptor(p)(context.Background(), &grpc.StreamDesc{}, nil, "method", streamer)
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls)
+}
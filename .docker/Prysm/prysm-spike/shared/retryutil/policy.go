@@ -0,0 +1,78 @@
+// Package retryutil defines retry policies with jittered exponential backoff, retry budgets
+// and circuit breaking, along with gRPC client interceptors applying them.
+package retryutil
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/rand"
+)
+
+const (
+	// DefaultMaxDelay caps the delay between two attempts.
+	DefaultMaxDelay = 30 * time.Second
+	// DefaultMultiplier is the factor applied to the delay after every failed attempt.
+	DefaultMultiplier = 2.0
+	// DefaultJitter is the fraction of the delay that is randomized to spread out retries from many clients.
+	DefaultJitter = 0.2
+)
+
+// Policy describes how failed calls are retried. A policy is safe for concurrent use
+// and is meant to be shared by every call made to the same remote.
+type Policy struct {
+	MaxAttempts uint          // MaxAttempts is the total number of attempts, including the first call.
+	BaseDelay   time.Duration // BaseDelay is the delay before the first retry.
+	MaxDelay    time.Duration // MaxDelay caps the delay between attempts.
+	Multiplier  float64       // Multiplier grows the delay after each failed attempt.
+	Jitter      float64       // Jitter is the fraction of the delay which is randomized.
+	Budget      *Budget       // Budget limits retries when most calls are failing, may be nil.
+	Breaker     *Breaker      // Breaker fails calls fast while the remote is down, may be nil.
+
+	randLock sync.Mutex
+	rand     *rand.Rand
+}
+
+// NewPolicy returns a policy with the default backoff parameters, retry budget and circuit
+// breaker, making at most maxAttempts attempts starting with a baseDelay backoff.
+func NewPolicy(maxAttempts uint, baseDelay time.Duration) *Policy {
+	return &Policy{
+		MaxAttempts: maxAttempts,
+		BaseDelay:   baseDelay,
+		MaxDelay:    DefaultMaxDelay,
+		Multiplier:  DefaultMultiplier,
+		Jitter:      DefaultJitter,
+		Budget:      NewBudget(DefaultBudgetTokens, DefaultBudgetTokenRatio),
+		Breaker:     NewBreaker(DefaultBreakerThreshold, DefaultBreakerCooldown),
+	}
+}
+
+// Backoff returns the delay to wait before the given retry, where retry 0 is the first retry.
+func (p *Policy) Backoff(retry uint) time.Duration {
+	if p.BaseDelay <= 0 {
+		return 0
+	}
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+	delay := float64(p.BaseDelay) * math.Pow(multiplier, float64(retry))
+	if p.MaxDelay > 0 && delay > float64(p.MaxDelay) {
+		delay = float64(p.MaxDelay)
+	}
+	if p.Jitter > 0 {
+		// Spread the delay uniformly over [delay*(1-jitter), delay*(1+jitter)].
+		delay *= 1 + p.Jitter*(2*p.randFloat()-1)
+	}
+	return time.Duration(delay)
+}
+
+func (p *Policy) randFloat() float64 {
+	p.randLock.Lock()
+	defer p.randLock.Unlock()
+	if p.rand == nil {
+		p.rand = rand.NewGenerator()
+	}
+	return p.rand.Float64()
+}
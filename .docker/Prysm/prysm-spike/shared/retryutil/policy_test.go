@@ -0,0 +1,44 @@
+package retryutil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+)
+
+func TestPolicy_Backoff_Exponential(t *testing.T) {
+	p := &Policy{
+		BaseDelay:  100 * time.Millisecond,
+		MaxDelay:   time.Second,
+		Multiplier: 2,
+	}
+	assert.Equal(t, 100*time.Millisecond, p.Backoff(0))
+	assert.Equal(t, 200*time.Millisecond, p.Backoff(1))
+	assert.Equal(t, 400*time.Millisecond, p.Backoff(2))
+	assert.Equal(t, 800*time.Millisecond, p.Backoff(3))
+	assert.Equal(t, time.Second, p.Backoff(4), "Backoff is not capped")
+	assert.Equal(t, time.Second, p.Backoff(100), "Backoff is not capped")
+}
+
+func TestPolicy_Backoff_Jitter(t *testing.T) {
+	p := &Policy{
+		BaseDelay:  time.Second,
+		Multiplier: 1,
+		Jitter:     0.5,
+	}
+	different := false
+	for i := 0; i < 100; i++ {
+		delay := p.Backoff(0)
+		assert.Equal(t, true, delay >= 500*time.Millisecond && delay <= 1500*time.Millisecond, "Unexpected delay %v", delay)
+		if delay != time.Second {
+			different = true
+		}
+	}
+	assert.Equal(t, true, different, "Backoff was never jittered")
+}
+
+func TestPolicy_Backoff_NoDelay(t *testing.T) {
+	p := NewPolicy(3, 0)
+	assert.Equal(t, time.Duration(0), p.Backoff(2))
+}
@@ -0,0 +1,58 @@
+package slip39
+
+import (
+	"crypto/sha256"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const (
+	// Number of iterations of PBKDF2 for an iteration exponent of 0.
+	baseIterationCount = 10000
+	// Number of rounds of the Feistel network.
+	roundCount = 4
+)
+
+// encrypt the master secret with the passphrase using the four round Feistel network of SLIP-39.
+func encrypt(masterSecret []byte, passphrase string, iterationExponent int, identifier uint16) []byte {
+	l, r := halves(masterSecret)
+	salt := cipherSalt(identifier)
+	for i := 0; i < roundCount; i++ {
+		l, r = r, xor(l, roundFunction(i, passphrase, iterationExponent, salt, r))
+	}
+	return append(r, l...)
+}
+
+// decrypt the encrypted master secret with the passphrase, applying the rounds of encrypt in
+// reverse order.
+func decrypt(encryptedSecret []byte, passphrase string, iterationExponent int, identifier uint16) []byte {
+	l, r := halves(encryptedSecret)
+	salt := cipherSalt(identifier)
+	for i := roundCount - 1; i >= 0; i-- {
+		l, r = r, xor(l, roundFunction(i, passphrase, iterationExponent, salt, r))
+	}
+	return append(r, l...)
+}
+
+func roundFunction(round int, passphrase string, iterationExponent int, salt, r []byte) []byte {
+	password := append([]byte{byte(round)}, passphrase...)
+	iterations := (baseIterationCount << uint(iterationExponent)) / roundCount
+	return pbkdf2.Key(password, append(append([]byte{}, salt...), r...), iterations, len(r), sha256.New)
+}
+
+func cipherSalt(identifier uint16) []byte {
+	return append([]byte(customizationString), byte(identifier>>8), byte(identifier))
+}
+
+func halves(secret []byte) ([]byte, []byte) {
+	half := len(secret) / 2
+	return append([]byte{}, secret[:half]...), append([]byte{}, secret[half:]...)
+}
+
+func xor(a, b []byte) []byte {
+	out := make([]byte, len(a))
+	for i := range a {
+		out[i] = a[i] ^ b[i]
+	}
+	return out
+}
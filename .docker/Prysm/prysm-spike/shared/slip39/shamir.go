@@ -0,0 +1,158 @@
+package slip39
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/rand"
+)
+
+const (
+	// Index of the share holding the digest of the secret.
+	digestIndex = 254
+	// Index of the share holding the secret.
+	secretIndex = 255
+	// Length of the digest of the secret in bytes.
+	digestLength = 4
+	// Maximum number of shares a secret can be split into.
+	maxShareCount = 16
+)
+
+var expTable, logTable [256]byte
+
+func init() {
+	// Powers of the generator 3 of GF(256) with the Rijndael polynomial x^8 + x^4 + x^3 + x + 1.
+	x := byte(1)
+	for i := 0; i < 255; i++ {
+		expTable[i] = x
+		logTable[x] = byte(i)
+		doubled := x << 1
+		if x&0x80 != 0 {
+			doubled ^= 0x1b
+		}
+		x ^= doubled
+	}
+}
+
+type share struct {
+	x     byte
+	value []byte
+}
+
+// interpolate evaluates at x the polynomial through the given shares, byte by byte over GF(256).
+func interpolate(shares []share, x byte) ([]byte, error) {
+	if len(shares) == 0 {
+		return nil, errors.New("no shares to interpolate")
+	}
+	length := len(shares[0].value)
+	seen := make(map[byte]bool, len(shares))
+	for _, s := range shares {
+		if seen[s.x] {
+			return nil, errors.New("share indices must be unique")
+		}
+		seen[s.x] = true
+		if len(s.value) != length {
+			return nil, errors.New("all share values must have the same length")
+		}
+	}
+	for _, s := range shares {
+		if s.x == x {
+			return append([]byte{}, s.value...), nil
+		}
+	}
+
+	// The Lagrange basis polynomials are evaluated in the log domain, the product of all
+	// (x - x_i) is shared by every basis polynomial.
+	logProd := 0
+	for _, s := range shares {
+		logProd += int(logTable[s.x^x])
+	}
+	result := make([]byte, length)
+	for _, si := range shares {
+		logBasis := logProd - int(logTable[si.x^x])
+		for _, sj := range shares {
+			if sj.x != si.x {
+				logBasis -= int(logTable[sj.x^si.x])
+			}
+		}
+		logBasis = ((logBasis % 255) + 255) % 255
+		for i, b := range si.value {
+			if b != 0 {
+				result[i] ^= expTable[(int(logTable[b])+logBasis)%255]
+			}
+		}
+	}
+	return result, nil
+}
+
+// splitSecret splits the secret into count shares, any threshold of which recover it.
+func splitSecret(threshold, count int, secret []byte) ([]share, error) {
+	if threshold < 1 {
+		return nil, errors.New("threshold must be positive")
+	}
+	if threshold > count {
+		return nil, errors.Errorf("threshold %d must not exceed the share count %d", threshold, count)
+	}
+	if count > maxShareCount {
+		return nil, errors.Errorf("share count must not exceed %d", maxShareCount)
+	}
+	shares := make([]share, 0, count)
+	if threshold == 1 {
+		for i := 0; i < count; i++ {
+			shares = append(shares, share{x: byte(i), value: append([]byte{}, secret...)})
+		}
+		return shares, nil
+	}
+
+	gen := rand.NewGenerator()
+	randomShareCount := threshold - 2
+	for i := 0; i < randomShareCount; i++ {
+		value := make([]byte, len(secret))
+		if _, err := gen.Read(value); err != nil {
+			return nil, err
+		}
+		shares = append(shares, share{x: byte(i), value: value})
+	}
+	randomPart := make([]byte, len(secret)-digestLength)
+	if _, err := gen.Read(randomPart); err != nil {
+		return nil, err
+	}
+	base := append(shares[:randomShareCount:randomShareCount],
+		share{x: digestIndex, value: append(digest(randomPart, secret), randomPart...)},
+		share{x: secretIndex, value: secret},
+	)
+	for i := randomShareCount; i < count; i++ {
+		value, err := interpolate(base, byte(i))
+		if err != nil {
+			return nil, err
+		}
+		shares = append(shares, share{x: byte(i), value: value})
+	}
+	return shares, nil
+}
+
+// recoverSecret recovers the secret from threshold shares and checks it against its digest.
+func recoverSecret(threshold int, shares []share) ([]byte, error) {
+	if threshold == 1 {
+		return shares[0].value, nil
+	}
+	secret, err := interpolate(shares, secretIndex)
+	if err != nil {
+		return nil, err
+	}
+	digestShare, err := interpolate(shares, digestIndex)
+	if err != nil {
+		return nil, err
+	}
+	if !hmac.Equal(digestShare[:digestLength], digest(digestShare[digestLength:], secret)) {
+		return nil, errors.New("invalid digest of the shared secret")
+	}
+	return secret, nil
+}
+
+func digest(randomPart, secret []byte) []byte {
+	mac := hmac.New(sha256.New, randomPart)
+	mac.Write(secret)
+	return mac.Sum(nil)[:digestLength]
+}
@@ -0,0 +1,307 @@
+// Package slip39 implements SLIP-0039, Shamir's secret-sharing for mnemonic codes. A master
+// secret is split into mnemonic shares of which a threshold is needed to recover the secret.
+//
+// See https://github.com/satoshilabs/slips/blob/master/slip-0039.md.
+package slip39
+
+import (
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/rand"
+)
+
+const (
+	// Number of bits encoded by a word of the wordlist.
+	radixBits = 10
+	// Number of words in the wordlist.
+	radixSize = 1 << radixBits
+	// Number of bits of the random identifier of a set of shares.
+	idLengthBits = 15
+	// Number of bits of the iteration exponent.
+	iterationExpLengthBits = 5
+	// Number of words holding the identifier and the iteration exponent.
+	idExpLengthWords = 2
+	// Number of words of the checksum.
+	checksumLengthWords = 3
+	// Number of words of a share which do not encode the share value.
+	metadataLengthWords = idExpLengthWords + 2 + checksumLengthWords
+	// Minimum length of the master secret in bits.
+	minStrengthBits = 128
+	// Minimum number of words of a share.
+	minMnemonicLengthWords = metadataLengthWords + (minStrengthBits+radixBits-1)/radixBits
+	// Iteration exponent of the shares created by Split.
+	defaultIterationExponent = 1
+	// Customization string of the checksum and the encryption salt.
+	customizationString = "shamir"
+)
+
+var wordIndex = make(map[string]int, radixSize)
+
+func init() {
+	for i, w := range wordlist {
+		wordIndex[w] = i
+	}
+}
+
+// mnemonicShare is a decoded mnemonic share.
+type mnemonicShare struct {
+	identifier        uint16
+	iterationExponent int
+	groupIndex        int
+	groupThreshold    int
+	groupCount        int
+	memberIndex       int
+	memberThreshold   int
+	value             []byte
+}
+
+// Split the master secret into count mnemonic shares, any threshold of which recover it. The
+// master secret is encrypted with the passphrase before it is split, the same passphrase has
+// to be given to Combine.
+func Split(masterSecret []byte, passphrase string, threshold, count int) ([]string, error) {
+	if len(masterSecret)*8 < minStrengthBits {
+		return nil, errors.Errorf("master secret must be at least %d bits", minStrengthBits)
+	}
+	if len(masterSecret)%2 != 0 {
+		return nil, errors.New("master secret must have an even number of bytes")
+	}
+	if err := validatePassphrase(passphrase); err != nil {
+		return nil, err
+	}
+	if threshold == 1 && count > 1 {
+		return nil, errors.New("creating multiple shares with a threshold of 1 is not allowed, use a single share instead")
+	}
+	idBytes := make([]byte, 2)
+	if _, err := rand.NewGenerator().Read(idBytes); err != nil {
+		return nil, err
+	}
+	identifier := (uint16(idBytes[0])<<8 | uint16(idBytes[1])) & (1<<idLengthBits - 1)
+
+	// Shares are created in a single group, so the encrypted master secret is the group secret.
+	encrypted := encrypt(masterSecret, passphrase, defaultIterationExponent, identifier)
+	shares, err := splitSecret(threshold, count, encrypted)
+	if err != nil {
+		return nil, err
+	}
+	mnemonics := make([]string, len(shares))
+	for i, s := range shares {
+		mnemonics[i] = (&mnemonicShare{
+			identifier:        identifier,
+			iterationExponent: defaultIterationExponent,
+			groupIndex:        0,
+			groupThreshold:    1,
+			groupCount:        1,
+			memberIndex:       int(s.x),
+			memberThreshold:   threshold,
+			value:             s.value,
+		}).encode()
+	}
+	return mnemonics, nil
+}
+
+// Combine recovers the master secret from mnemonic shares, decrypting it with the passphrase.
+func Combine(mnemonics []string, passphrase string) ([]byte, error) {
+	if len(mnemonics) == 0 {
+		return nil, errors.New("no mnemonic shares provided")
+	}
+	if err := validatePassphrase(passphrase); err != nil {
+		return nil, err
+	}
+	shares := make([]*mnemonicShare, len(mnemonics))
+	for i, m := range mnemonics {
+		s, err := decode(m)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid mnemonic share %d", i)
+		}
+		shares[i] = s
+	}
+	first := shares[0]
+	groups := make(map[int][]*mnemonicShare)
+	for _, s := range shares {
+		if s.identifier != first.identifier || s.iterationExponent != first.iterationExponent {
+			return nil, errors.New("mnemonic shares belong to different sets")
+		}
+		if s.groupThreshold != first.groupThreshold || s.groupCount != first.groupCount || len(s.value) != len(first.value) {
+			return nil, errors.New("mnemonic shares have mismatching parameters")
+		}
+		for _, other := range groups[s.groupIndex] {
+			if other.memberThreshold != s.memberThreshold {
+				return nil, errors.Errorf("mnemonic shares of group %d have mismatching thresholds", s.groupIndex+1)
+			}
+			if other.memberIndex == s.memberIndex {
+				return nil, errors.Errorf("mnemonic share %d of group %d was provided twice", s.memberIndex+1, s.groupIndex+1)
+			}
+		}
+		groups[s.groupIndex] = append(groups[s.groupIndex], s)
+	}
+
+	indices := make([]int, 0, len(groups))
+	for idx, members := range groups {
+		if len(members) >= members[0].memberThreshold {
+			indices = append(indices, idx)
+		}
+	}
+	if len(indices) < first.groupThreshold {
+		return nil, errors.Errorf("insufficient mnemonic shares, %d complete groups are needed but %d were provided", first.groupThreshold, len(indices))
+	}
+	sort.Ints(indices)
+	groupShares := make([]share, 0, first.groupThreshold)
+	for _, idx := range indices[:first.groupThreshold] {
+		members := groups[idx]
+		memberShares := make([]share, members[0].memberThreshold)
+		for i := range memberShares {
+			memberShares[i] = share{x: byte(members[i].memberIndex), value: members[i].value}
+		}
+		secret, err := recoverSecret(members[0].memberThreshold, memberShares)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not recover secret of group %d", idx+1)
+		}
+		groupShares = append(groupShares, share{x: byte(idx), value: secret})
+	}
+	encrypted, err := recoverSecret(first.groupThreshold, groupShares)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not recover master secret")
+	}
+	return decrypt(encrypted, passphrase, first.iterationExponent, first.identifier), nil
+}
+
+// encode the share as a mnemonic.
+func (s *mnemonicShare) encode() string {
+	idExp := int(s.identifier)<<iterationExpLengthBits | s.iterationExponent
+	indices := intToIndices(big.NewInt(int64(idExp)), idExpLengthWords)
+	indices = append(indices,
+		s.groupIndex<<6|(s.groupThreshold-1)<<2|(s.groupCount-1)>>2,
+		((s.groupCount-1)&3)<<8|s.memberIndex<<4|(s.memberThreshold-1),
+	)
+	valueWords := (len(s.value)*8 + radixBits - 1) / radixBits
+	indices = append(indices, intToIndices(new(big.Int).SetBytes(s.value), valueWords)...)
+	indices = append(indices, createChecksum(indices)...)
+
+	words := make([]string, len(indices))
+	for i, idx := range indices {
+		words[i] = wordlist[idx]
+	}
+	return strings.Join(words, " ")
+}
+
+// decode a mnemonic share, verifying its checksum.
+func decode(mnemonic string) (*mnemonicShare, error) {
+	words := strings.Fields(strings.ToLower(mnemonic))
+	indices := make([]int, len(words))
+	for i, w := range words {
+		idx, ok := wordIndex[w]
+		if !ok {
+			return nil, errors.Errorf("invalid word %q", w)
+		}
+		indices[i] = idx
+	}
+	if len(indices) < minMnemonicLengthWords {
+		return nil, errors.Errorf("mnemonic share must be at least %d words", minMnemonicLengthWords)
+	}
+	paddingLen := (radixBits * (len(indices) - metadataLengthWords)) % 16
+	if paddingLen > 8 {
+		return nil, errors.New("invalid mnemonic share length")
+	}
+	if !verifyChecksum(indices) {
+		return nil, errors.New("invalid mnemonic share checksum")
+	}
+
+	idExp := indices[0]<<radixBits | indices[1]
+	params := indices[2]<<radixBits | indices[3]
+	s := &mnemonicShare{
+		identifier:        uint16(idExp >> iterationExpLengthBits),
+		iterationExponent: idExp & (1<<iterationExpLengthBits - 1),
+		groupIndex:        params >> 16 & 0xf,
+		groupThreshold:    params>>12&0xf + 1,
+		groupCount:        params>>8&0xf + 1,
+		memberIndex:       params >> 4 & 0xf,
+		memberThreshold:   params&0xf + 1,
+	}
+	if s.groupCount < s.groupThreshold {
+		return nil, errors.New("group threshold of the mnemonic share exceeds its group count")
+	}
+
+	valueData := indices[idExpLengthWords+2 : len(indices)-checksumLengthWords]
+	valueLen := (radixBits*len(valueData) - paddingLen) / 8
+	value := indicesToInt(valueData)
+	if value.BitLen() > valueLen*8 {
+		return nil, errors.New("invalid padding of the mnemonic share")
+	}
+	s.value = value.FillBytes(make([]byte, valueLen))
+	return s, nil
+}
+
+func intToIndices(value *big.Int, length int) []int {
+	indices := make([]int, length)
+	mask := big.NewInt(radixSize - 1)
+	v := new(big.Int).Set(value)
+	for i := length - 1; i >= 0; i-- {
+		indices[i] = int(new(big.Int).And(v, mask).Int64())
+		v.Rsh(v, radixBits)
+	}
+	return indices
+}
+
+func indicesToInt(indices []int) *big.Int {
+	v := new(big.Int)
+	for _, idx := range indices {
+		v.Lsh(v, radixBits)
+		v.Or(v, big.NewInt(int64(idx)))
+	}
+	return v
+}
+
+// rs1024Polymod computes the Reed-Solomon checksum polynomial of SLIP-39 over GF(1024).
+func rs1024Polymod(values []int) int {
+	gen := [10]int{
+		0xe0e040, 0x1c1c080, 0x3838100, 0x7070200, 0xe0e0009,
+		0x1c0c2412, 0x38086c24, 0x3090fc48, 0x21b1f890, 0x3f3f120,
+	}
+	chk := 1
+	for _, v := range values {
+		b := chk >> 20
+		chk = (chk&0xfffff)<<10 ^ v
+		for i := 0; i < 10; i++ {
+			if (b>>i)&1 == 1 {
+				chk ^= gen[i]
+			}
+		}
+	}
+	return chk
+}
+
+func customizedValues(data []int) []int {
+	values := make([]int, 0, len(customizationString)+len(data)+checksumLengthWords)
+	for _, c := range []byte(customizationString) {
+		values = append(values, int(c))
+	}
+	return append(values, data...)
+}
+
+func createChecksum(data []int) []int {
+	values := append(customizedValues(data), make([]int, checksumLengthWords)...)
+	polymod := rs1024Polymod(values) ^ 1
+	checksum := make([]int, checksumLengthWords)
+	for i := range checksum {
+		checksum[i] = (polymod >> (radixBits * (checksumLengthWords - 1 - i))) & (radixSize - 1)
+	}
+	return checksum
+}
+
+func verifyChecksum(data []int) bool {
+	return rs1024Polymod(customizedValues(data)) == 1
+}
+
+// validatePassphrase checks the passphrase only contains printable ASCII characters, as required
+// by SLIP-39.
+func validatePassphrase(passphrase string) error {
+	for _, c := range passphrase {
+		if c < 32 || c > 126 {
+			return errors.New("passphrase must only contain printable ASCII characters")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package slip39
+
+import (
+	"encoding/hex"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestWordlist(t *testing.T) {
+	words := wordlist[:]
+	assert.Equal(t, true, sort.StringsAreSorted(words), "Wordlist is not sorted")
+	assert.Equal(t, radixSize, len(wordIndex), "Wordlist has duplicate words")
+	prefixes := make(map[string]bool)
+	for _, w := range words {
+		prefixes[w[:4]] = true
+	}
+	assert.Equal(t, radixSize, len(prefixes), "Words are not unique by their first four letters")
+}
+
+func TestCombine_Vectors(t *testing.T) {
+	// Test vectors of SLIP-39, using the passphrase TREZOR.
+	tests := []struct {
+		name      string
+		mnemonics []string
+		secret    string
+		err       string
+	}{
+		{
+			name:      "without sharing",
+			mnemonics: []string{"duckling enlarge academic academic agency result length solution fridge kidney coal piece deal husband erode duke ajar critical decision keyboard"},
+			secret:    "bb54aac4b89dc868ba37d9cc21b2cece",
+		},
+		{
+			name:      "invalid checksum",
+			mnemonics: []string{"duckling enlarge academic academic agency result length solution fridge kidney coal piece deal husband erode duke ajar critical decision kidney"},
+			err:       "checksum",
+		},
+		{
+			name: "2 of 3 shares",
+			mnemonics: []string{
+				"shadow pistol academic always adequate wildlife fancy gross oasis cylinder mustang wrist rescue view short owner flip making coding armed",
+				"shadow pistol academic acid actress prayer class unknown daughter sweater depict flip twice unkind craft early superior advocate guest smoking",
+			},
+			secret: "b43ceb7e57a0ea8766221624d01b0864",
+		},
+		{
+			name:      "1 of 2 required shares",
+			mnemonics: []string{"shadow pistol academic always adequate wildlife fancy gross oasis cylinder mustang wrist rescue view short owner flip making coding armed"},
+			err:       "insufficient mnemonic shares",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			secret, err := Combine(tt.mnemonics, "TREZOR")
+			if tt.err != "" {
+				assert.ErrorContains(t, tt.err, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.secret, hex.EncodeToString(secret))
+		})
+	}
+}
+
+func TestSplitCombine(t *testing.T) {
+	for _, size := range []int{16, 32} {
+		secret := make([]byte, size)
+		for i := range secret {
+			secret[i] = byte(i * 7)
+		}
+		mnemonics, err := Split(secret, "passphrase", 3, 5)
+		require.NoError(t, err)
+		require.Equal(t, 5, len(mnemonics))
+		// 16 bytes are encoded in 20 words, 32 bytes in 33 words.
+		assert.Equal(t, 7+(size*8+9)/10, len(strings.Fields(mnemonics[0])))
+
+		// Every combination of three shares recovers the secret.
+		for i := 0; i < 5; i++ {
+			for j := i + 1; j < 5; j++ {
+				for k := j + 1; k < 5; k++ {
+					got, err := Combine([]string{mnemonics[i], mnemonics[j], mnemonics[k]}, "passphrase")
+					require.NoError(t, err)
+					assert.DeepEqual(t, secret, got)
+				}
+			}
+		}
+		_, err = Combine(mnemonics[:2], "passphrase")
+		assert.ErrorContains(t, "insufficient mnemonic shares", err)
+		_, err = Combine([]string{mnemonics[0], mnemonics[0], mnemonics[1]}, "passphrase")
+		assert.ErrorContains(t, "provided twice", err)
+
+		// A different passphrase decrypts to a different secret without error.
+		got, err := Combine(mnemonics[:3], "other")
+		require.NoError(t, err)
+		assert.NotEqual(t, hex.EncodeToString(secret), hex.EncodeToString(got))
+	}
+}
+
+func TestSplit_Invalid(t *testing.T) {
+	_, err := Split(make([]byte, 8), "", 2, 3)
+	assert.ErrorContains(t, "at least 128 bits", err)
+	_, err = Split(make([]byte, 17), "", 2, 3)
+	assert.ErrorContains(t, "even number of bytes", err)
+	_, err = Split(make([]byte, 16), "", 4, 3)
+	assert.ErrorContains(t, "must not exceed the share count", err)
+	_, err = Split(make([]byte, 16), "", 1, 3)
+	assert.ErrorContains(t, "threshold of 1", err)
+	_, err = Split(make([]byte, 16), "pässword", 2, 3)
+	assert.ErrorContains(t, "printable ASCII", err)
+}
+
+func TestCombine_MixedSets(t *testing.T) {
+	a, err := Split(make([]byte, 16), "", 2, 2)
+	require.NoError(t, err)
+	b, err := Split(make([]byte, 16), "", 2, 2)
+	require.NoError(t, err)
+	// The sets are told apart by their random identifiers, which rarely collide.
+	_, err = Combine([]string{a[0], b[1]}, "")
+	assert.NotNil(t, err)
+}
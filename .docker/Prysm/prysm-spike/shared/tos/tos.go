@@ -7,6 +7,7 @@ import (
 
 	"github.com/logrusorgru/aurora"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/exitcode"
 	"github.com/prysmaticlabs/prysm/shared/fileutil"
 	"github.com/prysmaticlabs/prysm/shared/promptutil"
 	"github.com/sirupsen/logrus"
@@ -51,10 +52,13 @@ func VerifyTosAcceptedOrPrompt(ctx *cli.Context) error {
 
 	input, err := promptutil.DefaultPrompt(au.Bold(acceptTosPromptText).String(), "decline")
 	if err != nil {
-		return errors.New(acceptTosPromptErrText)
+		return exitcode.Wrap(errors.New(acceptTosPromptErrText), exitcode.TermsNotAccepted)
 	}
 	if strings.ToLower(input) != "accept" {
-		return errors.New("you have to accept Terms and Conditions in order to continue")
+		return exitcode.Wrap(
+			errors.New("you have to accept Terms and Conditions in order to continue"),
+			exitcode.TermsNotAccepted,
+		)
 	}
 
 	saveTosAccepted(ctx)
@@ -25,7 +25,7 @@ func Setup(serviceName, processName, endpoint string, sampleFraction float64, en
 	}
 
 	trace.ApplyConfig(trace.Config{
-		DefaultSampler:          trace.ProbabilitySampler(sampleFraction),
+		DefaultSampler:          remoteParentSampler(trace.ProbabilitySampler(sampleFraction)),
 		MaxMessageEventsPerSpan: 500,
 	})
 
@@ -51,3 +51,15 @@ func Setup(serviceName, processName, endpoint string, sampleFraction float64, en
 
 	return nil
 }
+
+// remoteParentSampler keeps the sampling decision of a span started by another process, such as
+// the validator span of a duty around its RPC calls to the beacon node, so the spans of both
+// processes are recorded in a single trace or not at all. Other spans are sampled by the fallback.
+func remoteParentSampler(fallback trace.Sampler) trace.Sampler {
+	return func(p trace.SamplingParameters) trace.SamplingDecision {
+		if p.HasRemoteParent {
+			return trace.SamplingDecision{Sample: p.ParentContext.IsSampled()}
+		}
+		return fallback(p)
+	}
+}
@@ -0,0 +1,27 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"go.opencensus.io/trace"
+)
+
+func TestRemoteParentSampler(t *testing.T) {
+	sampler := remoteParentSampler(trace.NeverSample())
+	ctx := context.Background()
+
+	sampled := trace.SpanContext{TraceID: trace.TraceID{1}, SpanID: trace.SpanID{1}, TraceOptions: 1}
+	_, span := trace.StartSpanWithRemoteParent(ctx, "sampled", sampled, trace.WithSampler(sampler))
+	assert.Equal(t, true, span.SpanContext().IsSampled(), "Span of a sampled remote parent not sampled")
+	assert.Equal(t, sampled.TraceID, span.SpanContext().TraceID, "Span not in the trace of its remote parent")
+
+	sampler = remoteParentSampler(trace.AlwaysSample())
+	notSampled := trace.SpanContext{TraceID: trace.TraceID{2}, SpanID: trace.SpanID{2}}
+	_, span = trace.StartSpanWithRemoteParent(ctx, "not sampled", notSampled, trace.WithSampler(sampler))
+	assert.Equal(t, false, span.SpanContext().IsSampled(), "Span of a remote parent not sampled was sampled")
+
+	_, span = trace.StartSpan(ctx, "root", trace.WithSampler(sampler))
+	assert.Equal(t, true, span.SpanContext().IsSampled(), "Root span not sampled by the fallback")
+}
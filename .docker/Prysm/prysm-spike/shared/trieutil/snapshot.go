@@ -0,0 +1,123 @@
+package trieutil
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+)
+
+// DepositTreeSnapshot is a compact representation of the first deposits of a deposit trie, following
+// EIP-4881. Instead of every deposit, it only holds the roots of the largest complete subtrees covering
+// them, which is enough to compute the root of the trie and to keep inserting deposits into it.
+type DepositTreeSnapshot struct {
+	Finalized            [][32]byte
+	DepositRoot          [32]byte
+	DepositCount         uint64
+	ExecutionBlockHash   [32]byte
+	ExecutionBlockHeight uint64
+}
+
+// Snapshot returns a snapshot of the first count items of the trie. The execution block of the snapshot
+// is left for the caller to fill in.
+func (m *SparseMerkleTrie) Snapshot(count uint64) (*DepositTreeSnapshot, error) {
+	if count > m.NumOfItems() {
+		return nil, fmt.Errorf("cannot snapshot %d items of a trie with %d items", count, m.NumOfItems())
+	}
+	finalized := make([][32]byte, 0, m.depth)
+	var index uint64
+	for i := int(m.depth); i >= 0; i-- {
+		if count&(1<<uint(i)) == 0 {
+			continue
+		}
+		finalized = append(finalized, bytesutil.ToBytes32(m.branches[i][index>>uint(i)]))
+		index += 1 << uint(i)
+	}
+	trie, err := trieFromFinalized(finalized, count, uint64(m.depth))
+	if err != nil {
+		return nil, err
+	}
+	return &DepositTreeSnapshot{
+		Finalized:    finalized,
+		DepositRoot:  trie.HashTreeRoot(),
+		DepositCount: count,
+	}, nil
+}
+
+// TrieFromSnapshot creates a trie from a deposit snapshot, which items can be inserted into from the
+// deposit count of the snapshot on. The trie can't produce proofs for the items of the snapshot.
+func TrieFromSnapshot(snapshot *DepositTreeSnapshot, depth uint64) (*SparseMerkleTrie, error) {
+	if snapshot == nil {
+		return nil, errors.New("nil deposit snapshot")
+	}
+	trie, err := trieFromFinalized(snapshot.Finalized, snapshot.DepositCount, depth)
+	if err != nil {
+		return nil, err
+	}
+	if root := trie.HashTreeRoot(); root != snapshot.DepositRoot {
+		return nil, fmt.Errorf("deposit snapshot root %#x does not match the root of its trie %#x", snapshot.DepositRoot, root)
+	}
+	return trie, nil
+}
+
+// trieFromFinalized builds a trie of count items out of the roots of the largest complete subtrees
+// covering them. Nodes within those subtrees are unknown and left as zero hashes, while the nodes on
+// the path to the last item are computed so new items can be inserted.
+func trieFromFinalized(finalized [][32]byte, count, depth uint64) (*SparseMerkleTrie, error) {
+	if depth >= 64 || count > 1<<depth {
+		return nil, fmt.Errorf("%d items do not fit in a trie of depth %d", count, depth)
+	}
+	if count == 0 {
+		if len(finalized) != 0 {
+			return nil, errors.New("empty deposit snapshot has finalized roots")
+		}
+		return NewTrie(depth)
+	}
+	layers := make([][][]byte, depth+1)
+	for i := uint64(0); i <= depth; i++ {
+		layers[i] = make([][]byte, ((count-1)>>i)+1)
+		for j := range layers[i] {
+			layers[i][j] = ZeroHashes[i][:]
+		}
+	}
+	var index uint64
+	used := 0
+	for i := int(depth); i >= 0; i-- {
+		if count&(1<<uint(i)) == 0 {
+			continue
+		}
+		if used >= len(finalized) {
+			return nil, fmt.Errorf("deposit snapshot of %d items has too few finalized roots", count)
+		}
+		root := finalized[used]
+		layers[i][index>>uint(i)] = root[:]
+		used++
+		index += 1 << uint(i)
+	}
+	if used != len(finalized) {
+		return nil, fmt.Errorf("deposit snapshot of %d items has too many finalized roots", count)
+	}
+	// Parents which are only partially filled are not covered by the finalized roots.
+	for i := uint64(0); i < depth; i++ {
+		parentIdx := (count - 1) >> (i + 1)
+		if (parentIdx+1)<<(i+1) <= count {
+			continue
+		}
+		right := ZeroHashes[i][:]
+		if 2*parentIdx+1 < uint64(len(layers[i])) {
+			right = layers[i][2*parentIdx+1]
+		}
+		parent := hashutil.Hash(append(append([]byte{}, layers[i][2*parentIdx]...), right...))
+		layers[i+1][parentIdx] = parent[:]
+	}
+	items := make([][]byte, count)
+	for i := range items {
+		items[i] = layers[0][i]
+	}
+	return &SparseMerkleTrie{
+		branches:      layers,
+		originalItems: items,
+		depth:         uint(depth),
+	}, nil
+}
@@ -0,0 +1,103 @@
+package trieutil
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func snapshotTestItems(n int) [][]byte {
+	items := make([][]byte, n)
+	for i := range items {
+		items[i] = bytesutil.Bytes32(uint64(i + 1))
+	}
+	return items
+}
+
+func TestTrieFromSnapshot_InsertAfterSnapshot(t *testing.T) {
+	depth := params.BeaconConfig().DepositContractTreeDepth
+	items := snapshotTestItems(20)
+	for count := 0; count <= len(items); count++ {
+		full, err := NewTrie(depth)
+		require.NoError(t, err)
+		for i, item := range items[:count] {
+			full.Insert(item, i)
+		}
+		snapshot, err := full.Snapshot(uint64(count))
+		require.NoError(t, err)
+		assert.Equal(t, uint64(count), snapshot.DepositCount)
+		assert.Equal(t, full.HashTreeRoot(), snapshot.DepositRoot)
+
+		trie, err := TrieFromSnapshot(snapshot, depth)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(count), trie.NumOfItems())
+		for i := count; i < len(items); i++ {
+			full.Insert(items[i], i)
+			trie.Insert(items[i], i)
+			require.Equal(t, full.HashTreeRoot(), trie.HashTreeRoot(), "Roots differ after inserting item %d into a snapshot of %d items", i, count)
+
+			proof, err := trie.MerkleProof(i)
+			require.NoError(t, err)
+			root := full.Root()
+			assert.Equal(t, true, VerifyMerkleBranch(root[:], items[i], i, proof, depth))
+		}
+	}
+}
+
+func TestSparseMerkleTrie_Snapshot_Prefix(t *testing.T) {
+	depth := params.BeaconConfig().DepositContractTreeDepth
+	items := snapshotTestItems(13)
+	full, err := GenerateTrieFromItems(items, depth)
+	require.NoError(t, err)
+	prefix, err := GenerateTrieFromItems(items[:6], depth)
+	require.NoError(t, err)
+
+	want, err := prefix.Snapshot(6)
+	require.NoError(t, err)
+	got, err := full.Snapshot(6)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, got)
+
+	// Tries built from a snapshot can be snapshotted again past the snapshot.
+	trie, err := TrieFromSnapshot(got, depth)
+	require.NoError(t, err)
+	for i := 6; i < len(items); i++ {
+		trie.Insert(items[i], i)
+	}
+	want, err = full.Snapshot(11)
+	require.NoError(t, err)
+	got, err = trie.Snapshot(11)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, got)
+
+	_, err = full.Snapshot(14)
+	assert.ErrorContains(t, "cannot snapshot 14 items", err)
+}
+
+func TestTrieFromSnapshot_Invalid(t *testing.T) {
+	depth := params.BeaconConfig().DepositContractTreeDepth
+	full, err := GenerateTrieFromItems(snapshotTestItems(5), depth)
+	require.NoError(t, err)
+	snapshot, err := full.Snapshot(5)
+	require.NoError(t, err)
+
+	_, err = TrieFromSnapshot(nil, depth)
+	assert.ErrorContains(t, "nil deposit snapshot", err)
+
+	wrongRoot := *snapshot
+	wrongRoot.DepositRoot = [32]byte{'a'}
+	_, err = TrieFromSnapshot(&wrongRoot, depth)
+	assert.ErrorContains(t, "does not match the root of its trie", err)
+
+	wrongCount := *snapshot
+	wrongCount.DepositCount = 7
+	_, err = TrieFromSnapshot(&wrongCount, depth)
+	assert.ErrorContains(t, "too few finalized roots", err)
+
+	wrongCount.DepositCount = 4
+	_, err = TrieFromSnapshot(&wrongCount, depth)
+	assert.ErrorContains(t, "too many finalized roots", err)
+}
@@ -78,6 +78,16 @@ func (m *SparseMerkleTrie) Items() [][]byte {
 	return m.originalItems
 }
 
+// NumOfItems returns the number of items inserted in the trie, accounting for empty tries which
+// hold a single zero item.
+func (m *SparseMerkleTrie) NumOfItems() uint64 {
+	var zeroBytes [32]byte
+	if len(m.originalItems) == 1 && bytes.Equal(m.originalItems[0], zeroBytes[:]) {
+		return 0
+	}
+	return uint64(len(m.originalItems))
+}
+
 // Root returns the top-most, Merkle root of the trie.
 func (m *SparseMerkleTrie) Root() [32]byte {
 	enc := [32]byte{}
@@ -155,11 +165,7 @@ func (m *SparseMerkleTrie) MerkleProof(index int) ([][]byte, error) {
 //   sha256(concat(node, self.to_little_endian_64(self.deposit_count), slice(zero_bytes32, start=0, len=24)))
 func (m *SparseMerkleTrie) HashTreeRoot() [32]byte {
 	var zeroBytes [32]byte
-	depositCount := uint64(len(m.originalItems))
-	if len(m.originalItems) == 1 && bytes.Equal(m.originalItems[0], zeroBytes[:]) {
-		// Accounting for empty tries
-		depositCount = 0
-	}
+	depositCount := m.NumOfItems()
 	newNode := append(m.branches[len(m.branches)-1][0], bytesutil.Bytes8(depositCount)...)
 	newNode = append(newNode, zeroBytes[:24]...)
 	return hashutil.Hash(newNode)
@@ -7,15 +7,16 @@ package beaconclient
 
 import (
 	"context"
+	"time"
 
 	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
-	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
 	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/grpcutils"
+	"github.com/prysmaticlabs/prysm/shared/retryutil"
 	"github.com/prysmaticlabs/prysm/slasher/cache"
 	"github.com/prysmaticlabs/prysm/slasher/db"
 	"github.com/sirupsen/logrus"
@@ -26,6 +27,13 @@ import (
 
 var log = logrus.WithField("prefix", "beaconclient")
 
+const (
+	// beaconRetries is the number of attempts made for a beacon node request.
+	beaconRetries = 5
+	// beaconRetryDelay is the base delay between attempts, which grows exponentially.
+	beaconRetryDelay = time.Second
+)
+
 // Notifier defines a struct which exposes event feeds regarding beacon blocks,
 // attestations, and more information received from a beacon node.
 type Notifier interface {
@@ -160,19 +168,20 @@ func (bs *Service) Start() {
 			"You are using an insecure gRPC connection to beacon chain! Please provide a certificate and key to use a secure connection",
 		)
 	}
+	retryPolicy := retryutil.NewPolicy(beaconRetries, beaconRetryDelay)
 	beaconOpts := []grpc.DialOption{
 		dialOpt,
 		grpc.WithStatsHandler(&ocgrpc.ClientHandler{}),
 		grpc.WithStreamInterceptor(middleware.ChainStreamClient(
 			grpc_opentracing.StreamClientInterceptor(),
 			grpc_prometheus.StreamClientInterceptor,
-			grpc_retry.StreamClientInterceptor(),
+			retryutil.StreamClientInterceptor(retryPolicy),
 			grpcutils.LogGRPCStream,
 		)),
 		grpc.WithUnaryInterceptor(middleware.ChainUnaryClient(
 			grpc_opentracing.UnaryClientInterceptor(),
 			grpc_prometheus.UnaryClientInterceptor,
-			grpc_retry.UnaryClientInterceptor(),
+			retryutil.UnaryClientInterceptor(retryPolicy),
 			grpcutils.LogGRPCRequests,
 		)),
 	}
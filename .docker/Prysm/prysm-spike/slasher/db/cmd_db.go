@@ -0,0 +1,229 @@
+package db
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/slasher/db/kv"
+	"github.com/prysmaticlabs/prysm/slasher/flags"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var log = logrus.WithField("prefix", "db")
+
+// DatabaseCommands for the slasher database. The slasher must be stopped while they run, as it
+// holds a lock on the database.
+var DatabaseCommands = &cli.Command{
+	Name:     "db",
+	Category: "db",
+	Usage:    "defines commands for moving and trimming the slasher database",
+	Subcommands: []*cli.Command{
+		{
+			Name: "export",
+			Description: "exports the detection history of the slasher, its spans, attestations, block headers " +
+				"and slashings, to an archive which can be imported by a slasher on another host",
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+				flags.ArchiveFileFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				return cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				if err := exportArchiveCli(cliCtx); err != nil {
+					log.Fatalf("Could not export slasher archive: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name: "import",
+			Description: "imports an archive written by the export command into the slasher database, " +
+				"overwriting the existing records of the same attestations, blocks and epochs",
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+				flags.ArchiveFileFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				return cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				if err := importArchiveCli(cliCtx); err != nil {
+					log.Fatalf("Could not import slasher archive: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name: "trim",
+			Description: "removes the spans, attestations and block headers older than the retention period, " +
+				"by default the weak subjectivity period, from the slasher database",
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+				flags.TrimCurrentEpochFlag,
+				flags.TrimRetentionEpochsFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				return cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				if err := trimCli(cliCtx); err != nil {
+					log.Fatalf("Could not trim slasher database: %v", err)
+				}
+				return nil
+			},
+		},
+	},
+}
+
+func openDB(cliCtx *cli.Context) (*kv.Store, error) {
+	dataDir, err := fileutil.ExpandPath(cliCtx.String(cmd.DataDirFlag.Name))
+	if err != nil {
+		return nil, err
+	}
+	return NewDB(path.Join(dataDir, DatabaseDirName), &kv.Config{})
+}
+
+func closeDB(d *kv.Store) {
+	if err := d.Close(); err != nil {
+		log.WithError(err).Error("Could not close slasher database")
+	}
+}
+
+// exportArchiveCli writes the archive to a temporary file first, so a partially written archive
+// is never mistaken for a complete one.
+func exportArchiveCli(cliCtx *cli.Context) error {
+	archivePath, err := fileutil.ExpandPath(cliCtx.String(flags.ArchiveFileFlag.Name))
+	if err != nil {
+		return err
+	}
+	if archivePath == "" {
+		return errors.New("no archive file specified, use --" + flags.ArchiveFileFlag.Name)
+	}
+	d, err := openDB(cliCtx)
+	if err != nil {
+		return err
+	}
+	defer closeDB(d)
+
+	if err := fileutil.MkdirAll(filepath.Dir(archivePath)); err != nil {
+		return err
+	}
+	tmpFile, err := ioutil.TempFile(filepath.Dir(archivePath), filepath.Base(archivePath)+".tmp")
+	if err != nil {
+		return errors.Wrap(err, "could not create archive file")
+	}
+	defer func() {
+		if err := os.Remove(tmpFile.Name()); err != nil && !os.IsNotExist(err) {
+			log.WithError(err).Error("Could not remove temporary archive file")
+		}
+	}()
+	records, err := d.ExportArchive(cliCtx.Context, tmpFile)
+	if err != nil {
+		if closeErr := tmpFile.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Could not close temporary archive file")
+		}
+		return err
+	}
+	if err := tmpFile.Sync(); err != nil {
+		return errors.Wrap(err, "could not sync archive")
+	}
+	if err := tmpFile.Close(); err != nil {
+		return errors.Wrap(err, "could not close archive")
+	}
+	if err := os.Rename(tmpFile.Name(), archivePath); err != nil {
+		return errors.Wrap(err, "could not move archive into place")
+	}
+	log.WithFields(logrus.Fields{
+		"archive": archivePath,
+		"records": records,
+	}).Info("Exported slasher archive")
+	return nil
+}
+
+func importArchiveCli(cliCtx *cli.Context) error {
+	archivePath, err := fileutil.ExpandPath(cliCtx.String(flags.ArchiveFileFlag.Name))
+	if err != nil {
+		return err
+	}
+	if archivePath == "" {
+		return errors.New("no archive file specified, use --" + flags.ArchiveFileFlag.Name)
+	}
+	f, err := os.Open(archivePath)
+	if err != nil {
+		return errors.Wrap(err, "could not open archive")
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).Error("Could not close archive file")
+		}
+	}()
+	d, err := openDB(cliCtx)
+	if err != nil {
+		return err
+	}
+	defer closeDB(d)
+
+	records, err := d.ImportArchive(cliCtx.Context, f)
+	if err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"archive": archivePath,
+		"records": records,
+	}).Info("Imported slasher archive")
+	return nil
+}
+
+func trimCli(cliCtx *cli.Context) error {
+	d, err := openDB(cliCtx)
+	if err != nil {
+		return err
+	}
+	defer closeDB(d)
+
+	currentEpoch := cliCtx.Uint64(flags.TrimCurrentEpochFlag.Name)
+	if currentEpoch == 0 {
+		head, err := d.ChainHead(cliCtx.Context)
+		if err != nil {
+			return errors.Wrap(err, "could not get chain head")
+		}
+		if head == nil {
+			return errors.New("no chain head stored by the slasher, use --" + flags.TrimCurrentEpochFlag.Name)
+		}
+		currentEpoch = head.HeadEpoch
+	}
+	retention := cliCtx.Uint64(flags.TrimRetentionEpochsFlag.Name)
+	if retention == 0 {
+		retention = params.BeaconConfig().WeakSubjectivityPeriod
+	}
+	if err := trim(cliCtx.Context, d, currentEpoch, retention); err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"currentEpoch":    currentEpoch,
+		"retentionEpochs": retention,
+	}).Info("Trimmed slasher database")
+	return nil
+}
+
+func trim(ctx context.Context, d *kv.Store, currentEpoch, retention uint64) error {
+	if err := d.PruneAttHistory(ctx, currentEpoch, retention); err != nil {
+		return errors.Wrap(err, "could not prune attestations")
+	}
+	if err := d.PruneBlockHistory(ctx, currentEpoch, retention); err != nil {
+		return errors.Wrap(err, "could not prune block headers")
+	}
+	if err := d.PruneEpochSpans(ctx, currentEpoch, retention); err != nil {
+		return errors.Wrap(err, "could not prune spans")
+	}
+	return nil
+}
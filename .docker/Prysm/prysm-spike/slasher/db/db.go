@@ -5,6 +5,9 @@ import (
 	"github.com/prysmaticlabs/prysm/slasher/db/kv"
 )
 
+// DatabaseDirName is the directory of the slasher database in the data directory.
+const DatabaseDirName = "slasherdata"
+
 // NewDB initializes a new DB.
 func NewDB(dirPath string, cfg *kv.Config) (*kv.Store, error) {
 	return kv.NewKVStore(dirPath, cfg)
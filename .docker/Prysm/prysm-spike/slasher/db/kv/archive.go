@@ -0,0 +1,191 @@
+package kv
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// archiveMagic starts every slasher archive, followed by records of a bucket name, a key and a
+// value, each prefixed with its uvarint encoded length. A record with an empty bucket name ends
+// the archive, so a truncated archive is never imported as a complete one.
+const archiveMagic = "prysm-slasher-archive-v1\n"
+
+// importBatchSize is the number of records imported per database transaction.
+const importBatchSize = 10000
+
+// archivedBuckets hold the detection history of the slasher: the min-max spans, the attestations
+// and block headers the slashings are detected against, the slashings found, the validator public
+// keys, and the latest detected epoch and chain head.
+var archivedBuckets = [][]byte{
+	validatorsMinMaxSpanBucketNew,
+	historicIndexedAttestationsBucket,
+	highestAttestationBucket,
+	historicBlockHeadersBucket,
+	slashingBucket,
+	validatorsPublicKeysBucket,
+	chainDataBucket,
+}
+
+// ExportArchive writes the detection history of the slasher to the writer, and returns the number
+// of records written.
+func (db *Store) ExportArchive(ctx context.Context, w io.Writer) (uint64, error) {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.ExportArchive")
+	defer span.End()
+	// Purging the caches persists the spans and highest attestations held in memory.
+	db.flatSpanCache.Purge()
+	db.highestAttestationCache.Purge()
+
+	bw := bufio.NewWriter(w)
+	if _, err := bw.WriteString(archiveMagic); err != nil {
+		return 0, err
+	}
+	var records uint64
+	if err := db.view(func(tx *bolt.Tx) error {
+		for _, name := range archivedBuckets {
+			if err := tx.Bucket(name).ForEach(func(k, v []byte) error {
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+				for _, field := range [][]byte{name, k, v} {
+					if err := writeArchiveField(bw, field); err != nil {
+						return err
+					}
+				}
+				records++
+				return nil
+			}); err != nil {
+				return errors.Wrapf(err, "could not export bucket %s", name)
+			}
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	if err := writeArchiveField(bw, nil); err != nil {
+		return 0, err
+	}
+	return records, bw.Flush()
+}
+
+// ImportArchive writes the records of an archive written by ExportArchive to the database,
+// overwriting the existing records with the same keys, and returns the number of records imported.
+func (db *Store) ImportArchive(ctx context.Context, r io.Reader) (uint64, error) {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.ImportArchive")
+	defer span.End()
+	// The caches are persisted first so they cannot overwrite the imported records on eviction.
+	db.flatSpanCache.Purge()
+	db.highestAttestationCache.Purge()
+
+	br := bufio.NewReader(r)
+	magic := make([]byte, len(archiveMagic))
+	if _, err := io.ReadFull(br, magic); err != nil || string(magic) != archiveMagic {
+		return 0, errors.New("not a slasher archive")
+	}
+	known := make(map[string]bool, len(archivedBuckets))
+	for _, name := range archivedBuckets {
+		known[string(name)] = true
+	}
+
+	var records uint64
+	for done := false; !done; {
+		if err := ctx.Err(); err != nil {
+			return records, err
+		}
+		var batch uint64
+		if err := db.update(func(tx *bolt.Tx) error {
+			for ; batch < importBatchSize; batch++ {
+				name, err := readArchiveField(br)
+				if err != nil {
+					return err
+				}
+				if len(name) == 0 {
+					done = true
+					return nil
+				}
+				if !known[string(name)] {
+					return fmt.Errorf("unknown bucket %q", name)
+				}
+				k, err := readArchiveField(br)
+				if err != nil {
+					return err
+				}
+				v, err := readArchiveField(br)
+				if err != nil {
+					return err
+				}
+				if err := tx.Bucket(name).Put(k, v); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return records, errors.Wrap(err, "could not import archive")
+		}
+		records += batch
+	}
+	return records, nil
+}
+
+// PruneEpochSpans removes the min-max spans of the epochs older than the pruning epoch age.
+func (db *Store) PruneEpochSpans(ctx context.Context, currentEpoch, pruningEpochAge uint64) error {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.PruneEpochSpans")
+	defer span.End()
+	pruneFromEpoch := int64(currentEpoch) - int64(pruningEpochAge)
+	if pruneFromEpoch <= 0 {
+		return nil
+	}
+	// Purging the cache persists the spans held in memory, which are then pruned with the others.
+	db.flatSpanCache.Purge()
+
+	return db.update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(validatorsMinMaxSpanBucketNew)
+		c := bucket.Cursor()
+		// The epochs are little-endian encoded, so the keys are not in epoch order and every key
+		// is decoded. Keys are collected first, as deleting under a cursor skips the following key.
+		var keys [][]byte
+		for k, _ := c.First(); k != nil; k, _ = c.Next() {
+			if bytesutil.FromBytes8(k) <= uint64(pruneFromEpoch) {
+				keys = append(keys, k)
+			}
+		}
+		for _, k := range keys {
+			if err := bucket.Delete(k); err != nil {
+				return errors.Wrap(err, "failed to delete epoch spans")
+			}
+		}
+		return nil
+	})
+}
+
+func writeArchiveField(w io.Writer, field []byte) error {
+	prefix := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(prefix, uint64(len(field)))
+	if _, err := w.Write(prefix[:n]); err != nil {
+		return err
+	}
+	_, err := w.Write(field)
+	return err
+}
+
+func readArchiveField(r *bufio.Reader) ([]byte, error) {
+	size, err := binary.ReadUvarint(r)
+	if err == io.EOF {
+		return nil, errors.New("archive is truncated")
+	}
+	if err != nil {
+		return nil, err
+	}
+	field := make([]byte, size)
+	if _, err := io.ReadFull(r, field); err != nil {
+		return nil, errors.New("archive is truncated")
+	}
+	return field, nil
+}
@@ -0,0 +1,102 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/slasher/detection/attestations/types"
+)
+
+func TestStore_ExportImportArchive(t *testing.T) {
+	ctx := context.Background()
+	source := setupDB(t)
+	es, err := types.NewEpochStore([]byte{0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0})
+	require.NoError(t, err)
+	// The spans of epoch 4 are only cached, and must be persisted by the export.
+	require.NoError(t, source.SaveEpochSpans(ctx, 3, es, false))
+	require.NoError(t, source.SaveEpochSpans(ctx, 4, es, true))
+	for _, tt := range tests {
+		require.NoError(t, source.SaveIndexedAttestation(ctx, tt.idxAtt))
+	}
+	header := &ethpb.SignedBeaconBlockHeader{
+		Signature: bytesutil.PadTo([]byte("header"), 96),
+		Header:    &ethpb.BeaconBlockHeader{Slot: 5, ProposerIndex: 1},
+	}
+	require.NoError(t, source.SaveBlockHeader(ctx, header))
+	require.NoError(t, source.SetLatestEpochDetected(ctx, 4))
+
+	var archive bytes.Buffer
+	exported, err := source.ExportArchive(ctx, &archive)
+	require.NoError(t, err)
+	// Two spans, the attestations, the header and the latest detected epoch.
+	assert.Equal(t, uint64(len(tests)+4), exported)
+
+	target := setupDB(t)
+	imported, err := target.ImportArchive(ctx, bytes.NewReader(archive.Bytes()))
+	require.NoError(t, err)
+	assert.Equal(t, exported, imported)
+	for _, epoch := range []uint64{3, 4} {
+		spans, err := target.EpochSpans(ctx, epoch, false)
+		require.NoError(t, err)
+		assert.DeepEqual(t, es.Bytes(), spans.Bytes())
+	}
+	for _, tt := range tests {
+		has, err := target.HasIndexedAttestation(ctx, tt.idxAtt)
+		require.NoError(t, err)
+		assert.Equal(t, true, has)
+	}
+	headers, err := target.BlockHeaders(ctx, 5, 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(headers))
+	assert.DeepEqual(t, header, headers[0])
+	latest, err := target.GetLatestEpochDetected(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(4), latest)
+
+	// Archives which are truncated or not slasher archives are rejected.
+	_, err = setupDB(t).ImportArchive(ctx, bytes.NewReader(archive.Bytes()[:archive.Len()-1]))
+	assert.ErrorContains(t, "archive is truncated", err)
+	_, err = setupDB(t).ImportArchive(ctx, bytes.NewReader([]byte("not an archive")))
+	assert.ErrorContains(t, "not a slasher archive", err)
+}
+
+func TestStore_PruneEpochSpans(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	es, err := types.NewEpochStore([]byte{0, 0, 0, 0, 0, 0, 1})
+	require.NoError(t, err)
+	for epoch := uint64(1); epoch <= 5; epoch++ {
+		require.NoError(t, db.SaveEpochSpans(ctx, epoch, es, epoch == 2))
+	}
+
+	require.NoError(t, db.PruneEpochSpans(ctx, 5, 2))
+	for epoch := uint64(1); epoch <= 5; epoch++ {
+		spans, err := db.EpochSpans(ctx, epoch, false)
+		require.NoError(t, err)
+		assert.Equal(t, epoch > 3, len(spans.Bytes()) > 0, "Unexpected spans for epoch %d", epoch)
+	}
+}
+
+func TestStore_PruneEpochSpans_AcrossByteBoundary(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	es, err := types.NewEpochStore([]byte{0, 0, 0, 0, 0, 0, 1})
+	require.NoError(t, err)
+	// Little-endian keys of epochs past 255 sort before those of lower epochs.
+	epochs := []uint64{1, 200, 255, 256, 257, 300, 511, 512}
+	for _, epoch := range epochs {
+		require.NoError(t, db.SaveEpochSpans(ctx, epoch, es, false))
+	}
+
+	require.NoError(t, db.PruneEpochSpans(ctx, 512, 256))
+	for _, epoch := range epochs {
+		spans, err := db.EpochSpans(ctx, epoch, false)
+		require.NoError(t, err)
+		assert.Equal(t, epoch > 256, len(spans.Bytes()) > 0, "Unexpected spans for epoch %d", epoch)
+	}
+}
@@ -46,6 +46,24 @@ var (
 		Usage: "RPC port exposed by the slasher",
 		Value: 4002,
 	}
+	// GRPCGatewayHost specifies the host of the gRPC gateway serving the slasher status API.
+	GRPCGatewayHost = &cli.StringFlag{
+		Name:  "grpc-gateway-host",
+		Usage: "The host on which the gateway server of the slasher status API runs on",
+		Value: "127.0.0.1",
+	}
+	// GRPCGatewayPort enables a gRPC gateway serving the slasher status API as JSON.
+	GRPCGatewayPort = &cli.IntFlag{
+		Name:  "grpc-gateway-port",
+		Usage: "Enable a gRPC gateway serving the slasher status and detected slashings as JSON on the port, 0 disables it",
+	}
+	// GRPCGatewayCorsDomain serves preflight requests when serving gRPC JSON gateway.
+	GRPCGatewayCorsDomain = &cli.StringFlag{
+		Name: "grpc-gateway-corsdomain",
+		Usage: "Comma separated list of domains from which to accept cross origin requests " +
+			"(browser enforced). This flag has no effect if not used with --grpc-gateway-port.",
+		Value: "http://localhost:4242,http://127.0.0.1:4242,http://localhost:4200",
+	}
 	// EnableHistoricalDetectionFlag is a flag to enable historical detection for the slasher. Requires --historical-slasher-node on the beacon node.
 	EnableHistoricalDetectionFlag = &cli.BoolFlag{
 		Name:  "enable-historical-detection",
@@ -63,4 +81,19 @@ var (
 		Usage: "Sets the highest attestation cache size.",
 		Value: 3000,
 	}
+	// ArchiveFileFlag defines the path of a slasher archive.
+	ArchiveFileFlag = &cli.StringFlag{
+		Name:  "archive-file",
+		Usage: "Path of the archive the slasher detection history is exported to or imported from.",
+	}
+	// TrimCurrentEpochFlag defines the epoch the slasher data is trimmed relative to.
+	TrimCurrentEpochFlag = &cli.Uint64Flag{
+		Name:  "current-epoch",
+		Usage: "Epoch the slasher data is trimmed relative to. Defaults to the epoch of the chain head stored by the slasher.",
+	}
+	// TrimRetentionEpochsFlag defines the number of epochs of slasher data kept when trimming.
+	TrimRetentionEpochsFlag = &cli.Uint64Flag{
+		Name:  "retention-epochs",
+		Usage: "Number of epochs of slasher data kept when trimming. Defaults to the weak subjectivity period.",
+	}
 )
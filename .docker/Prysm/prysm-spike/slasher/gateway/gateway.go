@@ -0,0 +1,122 @@
+// Package gateway defines a gRPC gateway to serve the slasher status API as
+// HTTP-JSON traffic, proxying it to the slasher's gRPC service.
+package gateway
+
+import (
+	"context"
+	"net/http"
+
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	pb "github.com/prysmaticlabs/prysm/proto/slashing_gateway"
+	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/rs/cors"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+var _ shared.Service = (*Gateway)(nil)
+
+var log = logrus.WithField("prefix", "gateway")
+
+// Gateway is the gRPC gateway to serve HTTP JSON traffic as a
+// proxy and forward it to the slasher gRPC server.
+type Gateway struct {
+	ctx            context.Context
+	cancel         context.CancelFunc
+	gatewayAddr    string
+	remoteAddr     string
+	remoteCert     string
+	server         *http.Server
+	allowedOrigins []string
+	startFailure   error
+}
+
+// New returns a new gateway server which translates HTTP into gRPC. The remote certificate is
+// the TLS certificate of the slasher gRPC server, empty when it does not serve TLS.
+func New(
+	ctx context.Context,
+	remoteAddress,
+	remoteCert,
+	gatewayAddress string,
+	allowedOrigins []string,
+) *Gateway {
+	return &Gateway{
+		remoteAddr:     remoteAddress,
+		remoteCert:     remoteCert,
+		gatewayAddr:    gatewayAddress,
+		ctx:            ctx,
+		allowedOrigins: allowedOrigins,
+	}
+}
+
+// Start the gateway service. This serves the HTTP JSON traffic.
+func (g *Gateway) Start() {
+	ctx, cancel := context.WithCancel(g.ctx)
+	g.cancel = cancel
+
+	gwmux := gwruntime.NewServeMux(
+		gwruntime.WithMarshalerOption(
+			gwruntime.MIMEWildcard,
+			&gwruntime.JSONPb{OrigName: false, EmitDefaults: true},
+		),
+	)
+	dialOpt := grpc.WithInsecure()
+	if g.remoteCert != "" {
+		creds, err := credentials.NewClientTLSFromFile(g.remoteCert, "")
+		if err != nil {
+			log.WithError(err).Error("Could not get valid credentials for the slasher gRPC server")
+			g.startFailure = err
+			return
+		}
+		dialOpt = grpc.WithTransportCredentials(creds)
+	}
+	opts := []grpc.DialOption{dialOpt}
+	if err := pb.RegisterSlasherStatusHandlerFromEndpoint(ctx, gwmux, g.remoteAddr, opts); err != nil {
+		log.WithError(err).Error("Could not register slasher status handler with grpc endpoint")
+		g.startFailure = err
+		return
+	}
+	g.server = &http.Server{
+		Addr:    g.gatewayAddr,
+		Handler: g.corsMiddleware(gwmux),
+	}
+
+	go func() {
+		log.WithField("address", g.gatewayAddr).Info("Starting gRPC gateway")
+		if err := g.server.ListenAndServe(); err != http.ErrServerClosed {
+			log.WithError(err).Error("Failed to listen and serve")
+			g.startFailure = err
+			return
+		}
+	}()
+}
+
+// Status of grpc gateway. Returns an error if this service is unhealthy.
+func (g *Gateway) Status() error {
+	return g.startFailure
+}
+
+// Stop the gateway with a graceful shutdown.
+func (g *Gateway) Stop() error {
+	if g.server != nil {
+		if err := g.server.Shutdown(g.ctx); err != nil {
+			log.WithError(err).Error("Failed to shut down server")
+		}
+	}
+	if g.cancel != nil {
+		g.cancel()
+	}
+	return nil
+}
+
+func (g *Gateway) corsMiddleware(h http.Handler) http.Handler {
+	c := cors.New(cors.Options{
+		AllowedOrigins:   g.allowedOrigins,
+		AllowedMethods:   []string{http.MethodGet, http.MethodOptions},
+		AllowCredentials: true,
+		MaxAge:           600,
+		AllowedHeaders:   []string{"*"},
+	})
+	return c.Handler(h)
+}
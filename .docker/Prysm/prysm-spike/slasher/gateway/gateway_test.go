@@ -0,0 +1,18 @@
+package gateway
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestGateway_StartWithoutRemoteCertificate(t *testing.T) {
+	g := New(context.Background(), "localhost:4002", filepath.Join(t.TempDir(), "missing.crt"), "localhost:0", nil)
+	g.Start()
+	require.ErrorContains(t, "missing.crt", g.Status())
+	assert.Equal(t, true, g.server == nil, "Expected the gateway not to serve without the TLS credentials")
+	require.NoError(t, g.Stop())
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/logutil"
 	"github.com/prysmaticlabs/prysm/shared/tos"
 	"github.com/prysmaticlabs/prysm/shared/version"
+	"github.com/prysmaticlabs/prysm/slasher/db"
 	"github.com/prysmaticlabs/prysm/slasher/flags"
 	"github.com/prysmaticlabs/prysm/slasher/node"
 	"github.com/sirupsen/logrus"
@@ -59,6 +60,7 @@ var appFlags = []cli.Flag{
 	flags.MonitoringPortFlag,
 	cmd.DisableMonitoringFlag,
 	cmd.LogFileName,
+	cmd.LogDedup,
 	cmd.LogFormat,
 	cmd.ClearDB,
 	cmd.ForceClearDB,
@@ -73,6 +75,9 @@ var appFlags = []cli.Flag{
 	flags.RPCHost,
 	flags.CertFlag,
 	flags.KeyFlag,
+	flags.GRPCGatewayHost,
+	flags.GRPCGatewayPort,
+	flags.GRPCGatewayCorsDomain,
 	flags.BeaconCertFlag,
 	flags.BeaconRPCProviderFlag,
 	flags.EnableHistoricalDetectionFlag,
@@ -92,6 +97,9 @@ func main() {
 	app.Version = version.GetVersion()
 	app.Flags = appFlags
 	app.Action = startSlasher
+	app.Commands = []*cli.Command{
+		db.DatabaseCommands,
+	}
 	app.Before = func(ctx *cli.Context) error {
 		// Load flags from config file, if specified.
 		if err := cmd.LoadFlagsFromConfig(ctx, app.Flags); err != nil {
@@ -126,6 +134,9 @@ func main() {
 				log.WithError(err).Error("Failed to configuring logging to disk.")
 			}
 		}
+		if err := logutil.ConfigureDeduplication(ctx.StringSlice(cmd.LogDedup.Name)); err != nil {
+			return err
+		}
 
 		runtime.GOMAXPROCS(runtime.NumCPU())
 		return debug.Setup(ctx)
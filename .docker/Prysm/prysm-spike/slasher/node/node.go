@@ -9,6 +9,7 @@ import (
 	"os"
 	"os/signal"
 	"path"
+	"strings"
 	"sync"
 	"syscall"
 
@@ -28,6 +29,7 @@ import (
 	"github.com/prysmaticlabs/prysm/slasher/db/kv"
 	"github.com/prysmaticlabs/prysm/slasher/detection"
 	"github.com/prysmaticlabs/prysm/slasher/flags"
+	"github.com/prysmaticlabs/prysm/slasher/gateway"
 	"github.com/prysmaticlabs/prysm/slasher/rpc"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
@@ -35,8 +37,6 @@ import (
 
 var log = logrus.WithField("prefix", "node")
 
-const slasherDBName = "slasherdata"
-
 // SlasherNode defines a struct that handles the services running a slashing detector
 // for eth2. It handles the lifecycle of the entire system and registers
 // services to a service registry.
@@ -112,6 +112,12 @@ func NewSlasherNode(cliCtx *cli.Context) (*SlasherNode, error) {
 		return nil, err
 	}
 
+	if cliCtx.Int(flags.GRPCGatewayPort.Name) > 0 {
+		if err := slasher.registerGRPCGateway(); err != nil {
+			return nil, err
+		}
+	}
+
 	return slasher, nil
 }
 
@@ -174,7 +180,7 @@ func (s *SlasherNode) startDB() error {
 	baseDir := s.cliCtx.String(cmd.DataDirFlag.Name)
 	clearDB := s.cliCtx.Bool(cmd.ClearDB.Name)
 	forceClearDB := s.cliCtx.Bool(cmd.ForceClearDB.Name)
-	dbPath := path.Join(baseDir, slasherDBName)
+	dbPath := path.Join(baseDir, db.DatabaseDirName)
 	spanCacheSize := s.cliCtx.Int(flags.SpanCacheSize.Name)
 	highestAttCacheSize := s.cliCtx.Int(flags.HighestAttCacheSize.Name)
 	cfg := &kv.Config{SpanCacheSize: spanCacheSize, HighestAttestationCacheSize: highestAttCacheSize}
@@ -273,3 +279,15 @@ func (s *SlasherNode) registerRPCService() error {
 
 	return s.services.RegisterService(rpcService)
 }
+
+func (s *SlasherNode) registerGRPCGateway() error {
+	rpcAddr := fmt.Sprintf("%s:%d", s.cliCtx.String(flags.RPCHost.Name), s.cliCtx.Int(flags.RPCPort.Name))
+	gatewayAddr := fmt.Sprintf("%s:%d", s.cliCtx.String(flags.GRPCGatewayHost.Name), s.cliCtx.Int(flags.GRPCGatewayPort.Name))
+	allowedOrigins := strings.Split(s.cliCtx.String(flags.GRPCGatewayCorsDomain.Name), ",")
+	// The gateway dials the gRPC server with its own certificate when it serves TLS.
+	var rpcCert string
+	if s.cliCtx.String(flags.CertFlag.Name) != "" && s.cliCtx.String(flags.KeyFlag.Name) != "" {
+		rpcCert = s.cliCtx.String(flags.CertFlag.Name)
+	}
+	return s.services.RegisterService(gateway.New(s.ctx, rpcAddr, rpcCert, gatewayAddr, allowedOrigins))
+}
@@ -122,6 +122,10 @@ func (s *Service) Start() {
 		beaconClient: s.beaconclient,
 	}
 	slashpb.RegisterSlasherServer(s.grpcServer, slasherServer)
+	slashpb.RegisterSlasherStatusServer(s.grpcServer, &StatusServer{
+		slasherDB: s.slasherDB,
+		status:    s.Status,
+	})
 
 	// Register reflection service on gRPC server.
 	reflection.Register(s.grpcServer)
@@ -0,0 +1,260 @@
+package rpc
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	ptypes "github.com/gogo/protobuf/types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	sharedpb "github.com/prysmaticlabs/prysm/proto/shared/v1"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/pagination"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
+	"github.com/prysmaticlabs/prysm/slasher/db"
+	"github.com/prysmaticlabs/prysm/slasher/db/types"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// detectedStatuses are the statuses slashings are stored with, in the order they are listed.
+var detectedStatuses = []types.SlashingStatus{types.Active, types.Included, types.Reverted}
+
+// StatusServer defines a server implementation of the gRPC SlasherStatus service, providing a
+// read-only view of the slasher detection for dashboards.
+type StatusServer struct {
+	slasherDB db.Database
+	status    func() error
+}
+
+// GetStatus returns the detection progress of the slasher and the number of slashings detected.
+func (ss *StatusServer) GetStatus(ctx context.Context, _ *ptypes.Empty) (*slashpb.SlasherStatusResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "status.GetStatus")
+	defer span.End()
+
+	res := &slashpb.SlasherStatusResponse{Ready: true}
+	if ss.status != nil {
+		if err := ss.status(); err != nil {
+			res.Ready = false
+			res.StatusError = err.Error()
+		}
+	}
+	head, err := ss.slasherDB.ChainHead(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve chain head: %v", err)
+	}
+	if head != nil {
+		res.ChainHeadEpoch = head.HeadEpoch
+	}
+	if res.LatestEpochDetected, err = ss.slasherDB.GetLatestEpochDetected(ctx); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve latest epoch detected: %v", err)
+	}
+	if res.LatestAttestationTargetEpoch, err = ss.slasherDB.LatestIndexedAttestationsTargetEpoch(ctx); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve latest attestation target epoch: %v", err)
+	}
+	slashings, err := ss.detectedSlashings(ctx, slashpb.SlashingKind_ANY_KIND, slashpb.DetectedSlashingStatus_ANY_STATUS)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range slashings {
+		switch s.Kind {
+		case slashpb.SlashingKind_ATTESTER:
+			res.AttesterSlashings++
+		case slashpb.SlashingKind_PROPOSER:
+			res.ProposerSlashings++
+		}
+	}
+	return res, nil
+}
+
+// ListDetectedSlashings returns the slashings detected by the slasher matching the request
+// filter, most recent offence first.
+func (ss *StatusServer) ListDetectedSlashings(
+	ctx context.Context,
+	req *slashpb.DetectedSlashingsRequest,
+) (*slashpb.DetectedSlashingsResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "status.ListDetectedSlashings")
+	defer span.End()
+
+	if int(req.PageSize) > cmd.Get().MaxRPCPageSize {
+		return nil, status.Errorf(codes.InvalidArgument, "Requested page size %d can not be greater than max size %d",
+			req.PageSize, cmd.Get().MaxRPCPageSize)
+	}
+	slashings, err := ss.detectedSlashings(ctx, req.Kind, req.Status)
+	if err != nil {
+		return nil, err
+	}
+	if len(slashings) == 0 {
+		return &slashpb.DetectedSlashingsResponse{
+			Slashings:     make([]*slashpb.DetectedSlashing, 0),
+			TotalSize:     int32(0),
+			NextPageToken: strconv.Itoa(0),
+		}, nil
+	}
+	start, end, nextPageToken, err := pagination.StartAndEndPage(req.PageToken, int(req.PageSize), len(slashings))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not paginate results: %v", err)
+	}
+	return &slashpb.DetectedSlashingsResponse{
+		Slashings:     slashings[start:end],
+		TotalSize:     int32(len(slashings)),
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// ListWatchedValidators returns what the slasher observed of the requested validators, in the
+// order of the request.
+func (ss *StatusServer) ListWatchedValidators(
+	ctx context.Context,
+	req *slashpb.WatchedValidatorsRequest,
+) (*slashpb.WatchedValidatorsResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "status.ListWatchedValidators")
+	defer span.End()
+
+	if int(req.PageSize) > cmd.Get().MaxRPCPageSize {
+		return nil, status.Errorf(codes.InvalidArgument, "Requested page size %d can not be greater than max size %d",
+			req.PageSize, cmd.Get().MaxRPCPageSize)
+	}
+	if len(req.Indices) == 0 {
+		return &slashpb.WatchedValidatorsResponse{
+			Validators:    make([]*slashpb.WatchedValidator, 0),
+			TotalSize:     int32(0),
+			NextPageToken: strconv.Itoa(0),
+		}, nil
+	}
+	start, end, nextPageToken, err := pagination.StartAndEndPage(req.PageToken, int(req.PageSize), len(req.Indices))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not paginate results: %v", err)
+	}
+	slashings, err := ss.detectedSlashings(ctx, slashpb.SlashingKind_ANY_KIND, slashpb.DetectedSlashingStatus_ANY_STATUS)
+	if err != nil {
+		return nil, err
+	}
+	attesterSlashings := make(map[uint64]uint64)
+	proposerSlashings := make(map[uint64]uint64)
+	for _, s := range slashings {
+		for _, idx := range s.ValidatorIndices {
+			if s.Kind == slashpb.SlashingKind_ATTESTER {
+				attesterSlashings[idx]++
+			} else {
+				proposerSlashings[idx]++
+			}
+		}
+	}
+
+	validators := make([]*slashpb.WatchedValidator, 0, end-start)
+	for _, idx := range req.Indices[start:end] {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		pubKey, err := ss.slasherDB.ValidatorPubKey(ctx, idx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not retrieve public key of validator %d: %v", idx, err)
+		}
+		highest, err := ss.slasherDB.HighestAttestation(ctx, idx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not retrieve highest attestation of validator %d: %v", idx, err)
+		}
+		v := &slashpb.WatchedValidator{
+			Index:             idx,
+			PublicKey:         bytesutil.SafeCopyBytes(pubKey),
+			AttesterSlashings: attesterSlashings[idx],
+			ProposerSlashings: proposerSlashings[idx],
+		}
+		if highest != nil {
+			v.Attested = true
+			v.HighestSourceEpoch = highest.HighestSourceEpoch
+			v.HighestTargetEpoch = highest.HighestTargetEpoch
+		}
+		validators = append(validators, v)
+	}
+	return &slashpb.WatchedValidatorsResponse{
+		Validators:    validators,
+		TotalSize:     int32(len(req.Indices)),
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// detectedSlashings returns the slashings of the kind and status stored by the slasher, sorted
+// by descending epoch of the offence.
+func (ss *StatusServer) detectedSlashings(
+	ctx context.Context,
+	kind slashpb.SlashingKind,
+	st slashpb.DetectedSlashingStatus,
+) ([]*slashpb.DetectedSlashing, error) {
+	statuses := detectedStatuses
+	if st != slashpb.DetectedSlashingStatus_ANY_STATUS {
+		statuses = []types.SlashingStatus{types.SlashingStatus(st)}
+	}
+	res := make([]*slashpb.DetectedSlashing, 0)
+	for _, s := range statuses {
+		if kind == slashpb.SlashingKind_ANY_KIND || kind == slashpb.SlashingKind_ATTESTER {
+			attesterSlashings, err := ss.slasherDB.AttesterSlashings(ctx, s)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "Could not retrieve attester slashings: %v", err)
+			}
+			for _, as := range attesterSlashings {
+				res = append(res, detectedAttesterSlashing(as, s))
+			}
+		}
+		if kind == slashpb.SlashingKind_ANY_KIND || kind == slashpb.SlashingKind_PROPOSER {
+			proposerSlashings, err := ss.slasherDB.ProposalSlashingsByStatus(ctx, s)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "Could not retrieve proposer slashings: %v", err)
+			}
+			for _, ps := range proposerSlashings {
+				res = append(res, detectedProposerSlashing(ps, s))
+			}
+		}
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].Epoch > res[j].Epoch
+	})
+	return res, nil
+}
+
+// detectedAttesterSlashing returns the slashing with the validators attesting to both
+// attestations, and the target epoch of the later attestation as epoch.
+func detectedAttesterSlashing(as *ethpb.AttesterSlashing, st types.SlashingStatus) *slashpb.DetectedSlashing {
+	d := &slashpb.DetectedSlashing{
+		Kind:             slashpb.SlashingKind_ATTESTER,
+		Status:           slashpb.DetectedSlashingStatus(st),
+		AttesterSlashing: as,
+	}
+	att1, att2 := as.Attestation_1, as.Attestation_2
+	if att1 == nil || att2 == nil || att1.Data == nil || att2.Data == nil ||
+		att1.Data.Target == nil || att2.Data.Target == nil {
+		return d
+	}
+	d.ValidatorIndices = sliceutil.IntersectionUint64(att1.AttestingIndices, att2.AttestingIndices)
+	d.Epoch = att1.Data.Target.Epoch
+	if att2.Data.Target.Epoch > d.Epoch {
+		d.Epoch = att2.Data.Target.Epoch
+	}
+	return d
+}
+
+// detectedProposerSlashing returns the slashing with the proposer and the epoch of the proposals.
+func detectedProposerSlashing(ps *ethpb.ProposerSlashing, st types.SlashingStatus) *slashpb.DetectedSlashing {
+	d := &slashpb.DetectedSlashing{
+		Kind:             slashpb.SlashingKind_PROPOSER,
+		Status:           slashpb.DetectedSlashingStatus(st),
+		ProposerSlashing: ps,
+	}
+	if ps.Header_1 == nil || ps.Header_1.Header == nil {
+		return d
+	}
+	d.ValidatorIndices = []uint64{ps.Header_1.Header.ProposerIndex}
+	d.Epoch = helpers.SlotToEpoch(ps.Header_1.Header.Slot)
+	return d
+}
+
+// ListFeatures returns the feature flags of the slasher with their values and where they were set.
+func (ss *StatusServer) ListFeatures(_ context.Context, _ *ptypes.Empty) (*sharedpb.FeaturesResponse, error) {
+	return &sharedpb.FeaturesResponse{Features: featureconfig.FeaturesProto(featureconfig.ActiveFeatures())}, nil
+}
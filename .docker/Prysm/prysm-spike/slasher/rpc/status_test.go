@@ -0,0 +1,167 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/slasher/db"
+	testDB "github.com/prysmaticlabs/prysm/slasher/db/testing"
+	"github.com/prysmaticlabs/prysm/slasher/db/types"
+)
+
+func testAttesterSlashing(indices []uint64, target1, target2 uint64) *ethpb.AttesterSlashing {
+	att := func(target uint64, root byte) *ethpb.IndexedAttestation {
+		return &ethpb.IndexedAttestation{
+			AttestingIndices: indices,
+			Data: &ethpb.AttestationData{
+				BeaconBlockRoot: bytesutil.PadTo([]byte{root}, 32),
+				Source:          &ethpb.Checkpoint{Epoch: 0, Root: make([]byte, 32)},
+				Target:          &ethpb.Checkpoint{Epoch: target, Root: make([]byte, 32)},
+			},
+			Signature: make([]byte, 96),
+		}
+	}
+	return &ethpb.AttesterSlashing{Attestation_1: att(target1, 1), Attestation_2: att(target2, 2)}
+}
+
+func testProposerSlashing(index, slot uint64) *ethpb.ProposerSlashing {
+	header := func(root byte) *ethpb.SignedBeaconBlockHeader {
+		return &ethpb.SignedBeaconBlockHeader{
+			Header: &ethpb.BeaconBlockHeader{
+				Slot:          slot,
+				ProposerIndex: index,
+				ParentRoot:    bytesutil.PadTo([]byte{root}, 32),
+				StateRoot:     make([]byte, 32),
+				BodyRoot:      make([]byte, 32),
+			},
+			Signature: make([]byte, 96),
+		}
+	}
+	return &ethpb.ProposerSlashing{Header_1: header(1), Header_2: header(2)}
+}
+
+func setupStatusServer(t *testing.T) (*StatusServer, db.Database) {
+	ctx := context.Background()
+	slasherDB := testDB.SetupSlasherDB(t, false)
+	require.NoError(t, slasherDB.SaveAttesterSlashing(ctx, types.Active, testAttesterSlashing([]uint64{1, 2}, 3, 3)))
+	require.NoError(t, slasherDB.SaveAttesterSlashing(ctx, types.Included, testAttesterSlashing([]uint64{2}, 1, 5)))
+	slot := 4 * params.BeaconConfig().SlotsPerEpoch
+	require.NoError(t, slasherDB.SaveProposerSlashing(ctx, types.Active, testProposerSlashing(2, slot)))
+	return &StatusServer{slasherDB: slasherDB}, slasherDB
+}
+
+func TestStatusServer_GetStatus(t *testing.T) {
+	ctx := context.Background()
+	ss, slasherDB := setupStatusServer(t)
+	require.NoError(t, slasherDB.SaveChainHead(ctx, &ethpb.ChainHead{HeadEpoch: 7}))
+	require.NoError(t, slasherDB.SetLatestEpochDetected(ctx, 6))
+
+	res, err := ss.GetStatus(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, true, res.Ready)
+	assert.Equal(t, uint64(7), res.ChainHeadEpoch)
+	assert.Equal(t, uint64(6), res.LatestEpochDetected)
+	assert.Equal(t, uint64(2), res.AttesterSlashings)
+	assert.Equal(t, uint64(1), res.ProposerSlashings)
+
+	ss.status = func() error {
+		return errors.New("beacon node not synced")
+	}
+	res, err = ss.GetStatus(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, false, res.Ready)
+	assert.Equal(t, "beacon node not synced", res.StatusError)
+}
+
+func TestStatusServer_ListDetectedSlashings(t *testing.T) {
+	ctx := context.Background()
+	ss, _ := setupStatusServer(t)
+
+	res, err := ss.ListDetectedSlashings(ctx, &slashpb.DetectedSlashingsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(res.Slashings))
+	assert.Equal(t, int32(3), res.TotalSize)
+	assert.Equal(t, uint64(5), res.Slashings[0].Epoch)
+	assert.Equal(t, slashpb.DetectedSlashingStatus_INCLUDED, res.Slashings[0].Status)
+	assert.DeepEqual(t, []uint64{2}, res.Slashings[0].ValidatorIndices)
+	assert.Equal(t, slashpb.SlashingKind_PROPOSER, res.Slashings[1].Kind)
+	assert.Equal(t, uint64(4), res.Slashings[1].Epoch)
+	assert.Equal(t, uint64(3), res.Slashings[2].Epoch)
+	assert.DeepEqual(t, []uint64{1, 2}, res.Slashings[2].ValidatorIndices)
+
+	res, err = ss.ListDetectedSlashings(ctx, &slashpb.DetectedSlashingsRequest{
+		Kind:   slashpb.SlashingKind_ATTESTER,
+		Status: slashpb.DetectedSlashingStatus_ACTIVE,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Slashings))
+	assert.Equal(t, uint64(3), res.Slashings[0].Epoch)
+
+	res, err = ss.ListDetectedSlashings(ctx, &slashpb.DetectedSlashingsRequest{
+		Status: slashpb.DetectedSlashingStatus_REVERTED,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(res.Slashings))
+	assert.Equal(t, int32(0), res.TotalSize)
+}
+
+func TestStatusServer_ListDetectedSlashings_Pagination(t *testing.T) {
+	ctx := context.Background()
+	ss, _ := setupStatusServer(t)
+
+	res, err := ss.ListDetectedSlashings(ctx, &slashpb.DetectedSlashingsRequest{PageSize: 2})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Slashings))
+	assert.Equal(t, "1", res.NextPageToken)
+	res, err = ss.ListDetectedSlashings(ctx, &slashpb.DetectedSlashingsRequest{PageSize: 2, PageToken: res.NextPageToken})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Slashings))
+	assert.Equal(t, uint64(3), res.Slashings[0].Epoch)
+	assert.Equal(t, "", res.NextPageToken)
+
+	_, err = ss.ListDetectedSlashings(ctx, &slashpb.DetectedSlashingsRequest{
+		PageSize: int32(cmd.Get().MaxRPCPageSize + 1),
+	})
+	assert.ErrorContains(t, "can not be greater than max size", err)
+}
+
+func TestStatusServer_ListWatchedValidators(t *testing.T) {
+	ctx := context.Background()
+	ss, slasherDB := setupStatusServer(t)
+	require.NoError(t, slasherDB.SavePubKey(ctx, 2, []byte{'a'}))
+	require.NoError(t, slasherDB.SaveHighestAttestation(ctx, &slashpb.HighestAttestation{
+		ValidatorId:        2,
+		HighestSourceEpoch: 1,
+		HighestTargetEpoch: 5,
+	}))
+
+	res, err := ss.ListWatchedValidators(ctx, &slashpb.WatchedValidatorsRequest{Indices: []uint64{2, 1, 9}})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(res.Validators))
+	assert.Equal(t, int32(3), res.TotalSize)
+	assert.DeepEqual(t, &slashpb.WatchedValidator{
+		Index:              2,
+		PublicKey:          []byte{'a'},
+		Attested:           true,
+		HighestSourceEpoch: 1,
+		HighestTargetEpoch: 5,
+		AttesterSlashings:  2,
+		ProposerSlashings:  1,
+	}, res.Validators[0])
+	assert.Equal(t, uint64(1), res.Validators[1].AttesterSlashings)
+	assert.Equal(t, false, res.Validators[1].Attested)
+	assert.DeepEqual(t, &slashpb.WatchedValidator{Index: 9}, res.Validators[2])
+
+	res, err = ss.ListWatchedValidators(ctx, &slashpb.WatchedValidatorsRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(res.Validators))
+}
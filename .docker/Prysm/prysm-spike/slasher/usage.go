@@ -58,6 +58,7 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.DisableMonitoringFlag,
 			cmd.LogFormat,
 			cmd.LogFileName,
+			cmd.LogDedup,
 			cmd.ForceClearDB,
 			cmd.ClearDB,
 			cmd.ConfigFileFlag,
@@ -81,6 +82,9 @@ var appHelpFlagGroups = []flagGroup{
 			flags.BeaconCertFlag,
 			flags.CertFlag,
 			flags.KeyFlag,
+			flags.GRPCGatewayHost,
+			flags.GRPCGatewayPort,
+			flags.GRPCGatewayCorsDomain,
 			flags.RPCPort,
 			flags.RPCHost,
 			flags.BeaconRPCProviderFlag,
@@ -0,0 +1,175 @@
+// Command benchmark-diff runs the benchmarks of two test binaries, such as the ones of a package
+// built before and after a change, and compares their results. It exits with a non-zero code if
+// any benchmark regressed by more than the threshold.
+//
+// Example:
+//
+//	go test -c -o /tmp/old.test ./beacon-chain/sync && git checkout my-change
+//	go test -c -o /tmp/new.test ./beacon-chain/sync
+//	benchmark-diff --old /tmp/old.test --new /tmp/new.test --bench ValidateBeaconBlock --threshold 10
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+	prefixed "github.com/x-cray/logrus-prefixed-formatter"
+)
+
+var (
+	oldFlag = &cli.StringFlag{
+		Name:     "old",
+		Usage:    "Path to the test binary to compare against, such as one built from the base revision",
+		Required: true,
+	}
+	newFlag = &cli.StringFlag{
+		Name:     "new",
+		Usage:    "Path to the test binary of the change",
+		Required: true,
+	}
+	benchFlag = &cli.StringFlag{
+		Name:  "bench",
+		Usage: "Regular expression of the benchmarks to run, as with -test.bench",
+		Value: ".",
+	}
+	countFlag = &cli.IntFlag{
+		Name:  "count",
+		Usage: "Number of times the benchmarks of each binary are run. Runs of both binaries are interleaved to spread out machine noise",
+		Value: 5,
+	}
+	benchtimeFlag = &cli.StringFlag{
+		Name:  "benchtime",
+		Usage: "Run time of each benchmark, as with -test.benchtime",
+		Value: "1s",
+	}
+	thresholdFlag = &cli.Float64Flag{
+		Name:  "threshold",
+		Usage: "Maximum increase in percent of the median of a metric before the benchmark is considered regressed",
+		Value: 10,
+	}
+	metricFlag = &cli.StringSliceFlag{
+		Name:  "metric",
+		Usage: "Units of the metrics to compare, can be repeated",
+		Value: cli.NewStringSlice("ns/op", "allocs/op"),
+	}
+	testArgFlag = &cli.StringSliceFlag{
+		Name:  "test-arg",
+		Usage: "Extra argument passed to both test binaries, such as -test.cpu=1, can be repeated",
+	}
+)
+
+func main() {
+	customFormatter := new(prefixed.TextFormatter)
+	customFormatter.TimestampFormat = "2006-01-02 15:04:05"
+	customFormatter.FullTimestamp = true
+	logrus.SetFormatter(customFormatter)
+
+	app := &cli.App{
+		Name:  "benchmark-diff",
+		Usage: "Compares the benchmarks of two test binaries and fails on regressions",
+		Flags: []cli.Flag{
+			oldFlag,
+			newFlag,
+			benchFlag,
+			countFlag,
+			benchtimeFlag,
+			thresholdFlag,
+			metricFlag,
+			testArgFlag,
+		},
+		Action: run,
+	}
+	if err := app.Run(os.Args); err != nil {
+		logrus.Fatal(err)
+	}
+}
+
+func run(cliCtx *cli.Context) error {
+	args := []string{
+		"-test.run=^$",
+		"-test.bench=" + cliCtx.String(benchFlag.Name),
+		"-test.benchtime=" + cliCtx.String(benchtimeFlag.Name),
+		"-test.benchmem",
+		"-test.count=1",
+	}
+	args = append(args, cliCtx.StringSlice(testArgFlag.Name)...)
+
+	oldRes, newRes := make(results), make(results)
+	count := cliCtx.Int(countFlag.Name)
+	for i := 0; i < count; i++ {
+		logrus.Infof("Running benchmarks %d/%d", i+1, count)
+		if err := runBenchmarks(cliCtx.String(oldFlag.Name), args, oldRes); err != nil {
+			return err
+		}
+		if err := runBenchmarks(cliCtx.String(newFlag.Name), args, newRes); err != nil {
+			return err
+		}
+	}
+	if len(oldRes) == 0 && len(newRes) == 0 {
+		return fmt.Errorf("no benchmark matches %q", cliCtx.String(benchFlag.Name))
+	}
+
+	threshold := cliCtx.Float64(thresholdFlag.Name)
+	comparisons := compare(oldRes, newRes, cliCtx.StringSlice(metricFlag.Name))
+	regressions := writeComparisons(os.Stdout, comparisons, threshold)
+	for _, name := range missing(oldRes, newRes) {
+		logrus.WithField("benchmark", name).Warn("Benchmark only exists in the old binary")
+	}
+	for _, name := range missing(newRes, oldRes) {
+		logrus.WithField("benchmark", name).Info("Benchmark only exists in the new binary")
+	}
+	if regressions > 0 {
+		return fmt.Errorf("%d benchmark metrics regressed by more than %.1f%%", regressions, threshold)
+	}
+	return nil
+}
+
+// runBenchmarks runs the benchmarks of the test binary once and adds their results to res.
+func runBenchmarks(binary string, args []string, res results) error {
+	var out bytes.Buffer
+	cmd := exec.Command(binary, args...)
+	cmd.Stdout = &out
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("could not run benchmarks of %s: %v\n%s", binary, err, lastLines(out.String(), 20))
+	}
+	return parseResults(&out, res)
+}
+
+// writeComparisons writes the comparisons as a table and returns the number of regressions.
+func writeComparisons(w io.Writer, comparisons []comparison, threshold float64) int {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	if _, err := fmt.Fprintln(tw, "benchmark\tunit\told\tnew\tdelta\t"); err != nil {
+		logrus.WithError(err).Error("Could not write comparison")
+	}
+	regressions := 0
+	for _, c := range comparisons {
+		status := ""
+		if c.delta > threshold {
+			status = "REGRESSION"
+			regressions++
+		}
+		if _, err := fmt.Fprintf(tw, "%s\t%s\t%.4g\t%.4g\t%+.2f%%\t%s\n", c.name, c.unit, c.old, c.new, c.delta, status); err != nil {
+			logrus.WithError(err).Error("Could not write comparison")
+		}
+	}
+	if err := tw.Flush(); err != nil {
+		logrus.WithError(err).Error("Could not write comparison")
+	}
+	return regressions
+}
+
+func lastLines(s string, n int) string {
+	lines := strings.Split(strings.TrimRight(s, "\n"), "\n")
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// results holds the samples of every benchmark of a binary, keyed by benchmark name and unit,
+// such as ns/op or allocs/op.
+type results map[string]map[string][]float64
+
+// comparison is the change of the median of a benchmark metric between two binaries.
+type comparison struct {
+	name  string
+	unit  string
+	old   float64
+	new   float64
+	delta float64 // Relative change in percent, positive when the new binary measures more.
+}
+
+// parseResults adds the results of the `go test -bench` output to res. Lines other than
+// benchmark results, such as logs or the PASS line, are skipped.
+func parseResults(r io.Reader, res results) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		// A result line holds the name, the number of iterations and value-unit pairs.
+		if len(fields) < 4 || len(fields)%2 != 0 || !strings.HasPrefix(fields[0], "Benchmark") {
+			continue
+		}
+		if _, err := strconv.ParseUint(fields[1], 10, 64); err != nil {
+			continue
+		}
+		name := fields[0]
+		for i := 2; i < len(fields); i += 2 {
+			value, err := strconv.ParseFloat(fields[i], 64)
+			if err != nil {
+				return fmt.Errorf("invalid value %q of benchmark %s", fields[i], name)
+			}
+			if res[name] == nil {
+				res[name] = make(map[string][]float64)
+			}
+			unit := fields[i+1]
+			res[name][unit] = append(res[name][unit], value)
+		}
+	}
+	return scanner.Err()
+}
+
+// compare compares the medians of the given units for every benchmark the two results have in
+// common, sorted by benchmark name.
+func compare(oldRes, newRes results, units []string) []comparison {
+	names := make([]string, 0, len(oldRes))
+	for name := range oldRes {
+		if _, ok := newRes[name]; ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var comparisons []comparison
+	for _, name := range names {
+		for _, unit := range units {
+			oldSamples, newSamples := oldRes[name][unit], newRes[name][unit]
+			if len(oldSamples) == 0 || len(newSamples) == 0 {
+				continue
+			}
+			c := comparison{
+				name: name,
+				unit: unit,
+				old:  median(oldSamples),
+				new:  median(newSamples),
+			}
+			switch {
+			case c.old != 0:
+				c.delta = (c.new - c.old) / c.old * 100
+			case c.new != 0:
+				// Anything is infinitely more than nothing, such as the first allocation of a benchmark.
+				c.delta = 100
+			}
+			comparisons = append(comparisons, c)
+		}
+	}
+	return comparisons
+}
+
+// missing returns the names of the benchmarks of a which b has no results of.
+func missing(a, b results) []string {
+	var names []string
+	for name := range a {
+		if _, ok := b[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func median(samples []float64) float64 {
+	sorted := append([]float64{}, samples...)
+	sort.Float64s(sorted)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+const oldOutput = `goos: linux
+goarch: amd64
+pkg: github.com/prysmaticlabs/prysm/beacon-chain/sync
+BenchmarkValidateBeaconBlockPubSub-8   	     500	   2000000 ns/op	  40000 B/op	     400 allocs/op
+BenchmarkWriteBlockRangeToStream-8     	    1000	   1000000 ns/op	  20000 B/op	     200 allocs/op
+BenchmarkRemoved-8                     	    1000	      1000 ns/op	      0 B/op	       0 allocs/op
+PASS
+`
+
+const newOutput = `BenchmarkValidateBeaconBlockPubSub-8   	     500	   2100000 ns/op	  40000 B/op	     400 allocs/op
+BenchmarkWriteBlockRangeToStream-8     	    1000	   1500000 ns/op	  20000 B/op	     100 allocs/op
+BenchmarkAdded-8                       	    1000	      1000 ns/op	      0 B/op	       0 allocs/op
+some log line
+PASS
+`
+
+func TestParseResults(t *testing.T) {
+	res := make(results)
+	require.NoError(t, parseResults(strings.NewReader(oldOutput), res))
+	require.NoError(t, parseResults(strings.NewReader(oldOutput), res))
+	assert.Equal(t, 3, len(res))
+	assert.DeepEqual(t, []float64{2000000, 2000000}, res["BenchmarkValidateBeaconBlockPubSub-8"]["ns/op"])
+	assert.DeepEqual(t, []float64{200, 200}, res["BenchmarkWriteBlockRangeToStream-8"]["allocs/op"])
+
+	err := parseResults(strings.NewReader("BenchmarkBroken-8 10 abc ns/op\n"), res)
+	assert.ErrorContains(t, "invalid value", err)
+}
+
+func TestCompare(t *testing.T) {
+	oldRes, newRes := make(results), make(results)
+	require.NoError(t, parseResults(strings.NewReader(oldOutput), oldRes))
+	require.NoError(t, parseResults(strings.NewReader(newOutput), newRes))
+
+	comparisons := compare(oldRes, newRes, []string{"ns/op", "allocs/op"})
+	require.Equal(t, 4, len(comparisons))
+	assert.Equal(t, "BenchmarkValidateBeaconBlockPubSub-8", comparisons[0].name)
+	assert.Equal(t, "ns/op", comparisons[0].unit)
+	assert.Equal(t, float64(5), comparisons[0].delta)
+	assert.Equal(t, float64(50), comparisons[2].delta)
+	assert.Equal(t, float64(-50), comparisons[3].delta)
+
+	var buf bytes.Buffer
+	assert.Equal(t, 1, writeComparisons(&buf, comparisons, 10))
+	assert.Equal(t, 2, writeComparisons(&buf, comparisons, 4))
+	assert.Equal(t, true, strings.Contains(buf.String(), "REGRESSION"))
+
+	assert.DeepEqual(t, []string{"BenchmarkRemoved-8"}, missing(oldRes, newRes))
+	assert.DeepEqual(t, []string{"BenchmarkAdded-8"}, missing(newRes, oldRes))
+}
+
+func TestMedian(t *testing.T) {
+	assert.Equal(t, float64(2), median([]float64{3, 1, 2}))
+	assert.Equal(t, float64(2.5), median([]float64{4, 1, 3, 2}))
+}
@@ -0,0 +1,150 @@
+// Command e2e-scenario runs an end-to-end scenario against a running docker-compose network and
+// writes a JSON report of the outcome. It exits with a non-zero code if the scenario failed.
+//
+// Example:
+//
+//	e2e-scenario --scenario scenarios/validator-restart.yaml --compose-dir ../.. --beacon beacon=127.0.0.1:4000
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/endtoend/scenario"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+	prefixed "github.com/x-cray/logrus-prefixed-formatter"
+	"google.golang.org/grpc"
+)
+
+var (
+	scenarioFlag = &cli.StringFlag{
+		Name:     "scenario",
+		Usage:    "Path to the YAML scenario to run",
+		Required: true,
+	}
+	composeDirFlag = &cli.StringFlag{
+		Name:  "compose-dir",
+		Usage: "Directory of the docker-compose project under test",
+		Value: ".",
+	}
+	composeProjectFlag = &cli.StringFlag{
+		Name:  "compose-project",
+		Usage: "Name of the docker-compose project, defaults to the name derived from the compose directory",
+	}
+	composeNetworkFlag = &cli.StringFlag{
+		Name:  "compose-network",
+		Usage: "Docker network partitions disconnect services from, defaults to the default network of the project",
+	}
+	beaconFlag = &cli.StringSliceFlag{
+		Name:  "beacon",
+		Usage: "Beacon node gRPC endpoint as name=host:port, can be repeated (default: beacon=127.0.0.1:4000)",
+	}
+	reportFlag = &cli.StringFlag{
+		Name:  "report",
+		Usage: "Path to write the JSON report to, - writes it to stdout",
+		Value: "-",
+	}
+	pollIntervalFlag = &cli.DurationFlag{
+		Name:  "poll-interval",
+		Usage: "Interval at which waiting steps poll the beacon nodes, defaults to the slot duration",
+	}
+)
+
+func main() {
+	customFormatter := new(prefixed.TextFormatter)
+	customFormatter.TimestampFormat = "2006-01-02 15:04:05"
+	customFormatter.FullTimestamp = true
+	logrus.SetFormatter(customFormatter)
+	// Logs go to stderr, keeping stdout for the report.
+	logrus.SetOutput(os.Stderr)
+
+	app := &cli.App{
+		Name:  "e2e-scenario",
+		Usage: "Runs an end-to-end scenario against a running docker-compose network",
+		Flags: []cli.Flag{
+			scenarioFlag,
+			composeDirFlag,
+			composeProjectFlag,
+			composeNetworkFlag,
+			beaconFlag,
+			reportFlag,
+			pollIntervalFlag,
+		},
+		Action: run,
+	}
+	if err := app.Run(os.Args); err != nil {
+		logrus.Fatal(err)
+	}
+}
+
+func run(cliCtx *cli.Context) error {
+	s, err := scenario.Load(cliCtx.String(scenarioFlag.Name))
+	if err != nil {
+		return err
+	}
+	network, err := scenario.NewComposeNetwork(
+		cliCtx.String(composeDirFlag.Name),
+		cliCtx.String(composeProjectFlag.Name),
+		cliCtx.String(composeNetworkFlag.Name),
+	)
+	if err != nil {
+		return err
+	}
+	endpoints := cliCtx.StringSlice(beaconFlag.Name)
+	if len(endpoints) == 0 {
+		endpoints = []string{"beacon=127.0.0.1:4000"}
+	}
+	beacons := make(map[string]scenario.BeaconClient)
+	for _, b := range endpoints {
+		parts := strings.SplitN(b, "=", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return fmt.Errorf("invalid beacon %q, expected name=host:port", b)
+		}
+		conn, err := grpc.Dial(parts[1], grpc.WithInsecure())
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := conn.Close(); err != nil {
+				logrus.WithError(err).Error("Could not close connection")
+			}
+		}()
+		beacons[parts[0]] = ethpb.NewBeaconChainClient(conn)
+	}
+	runner, err := scenario.NewRunner(&scenario.Config{
+		Network:      network,
+		Beacons:      beacons,
+		PollInterval: cliCtx.Duration(pollIntervalFlag.Name),
+	})
+	if err != nil {
+		return err
+	}
+
+	report := runner.Run(context.Background(), s)
+
+	var w io.Writer = os.Stdout
+	if path := cliCtx.String(reportFlag.Name); path != "-" {
+		f, err := os.Create(path)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := f.Close(); err != nil {
+				logrus.WithError(err).Error("Could not close report")
+			}
+		}()
+		w = f
+	}
+	if err := report.Write(w); err != nil {
+		return err
+	}
+	if !report.Passed {
+		return fmt.Errorf("scenario %s failed", s.Name)
+	}
+	return nil
+}
@@ -0,0 +1,420 @@
+// This tool splits a set of validator keys into N wallet directories, one per validator
+// container of a dockerized validator fleet. Keys are read from a directory of EIP-2335
+// keystore files or derived from a mnemonic and an index range, and are assigned to the
+// wallets by the hash of their public key, so a key stays in the same wallet when the tool is
+// rerun with keys added or removed.
+// Every wallet directory holds its keystores and the password file to import them with, and
+// a manifest at the root of the output directory records which keys went where.
+package main
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/logrusorgru/aurora"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/promptutil"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/derived"
+	bip39 "github.com/tyler-smith/go-bip39"
+	"github.com/urfave/cli/v2"
+	util "github.com/wealdtech/go-eth2-util"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+const (
+	manifestFileName = "manifest.json"
+	passwordFileName = "password.txt"
+	keystoresDirName = "keystores"
+)
+
+var (
+	keystoresFlag = &cli.StringFlag{
+		Name:  "keystores",
+		Value: "",
+		Usage: "Path to a directory containing the keystore files to split",
+	}
+	passwordFlag = &cli.StringFlag{
+		Name:  "password",
+		Value: "",
+		Usage: "Password of the keystore files, or the password to encrypt derived keys with. A random password is generated for every wallet when deriving keys without it",
+	}
+	mnemonicFileFlag = &cli.StringFlag{
+		Name:  "mnemonic-file",
+		Value: "",
+		Usage: "Path to a file containing the mnemonic to derive the keys to split from",
+	}
+	startIndexFlag = &cli.Uint64Flag{
+		Name:  "start-index",
+		Value: 0,
+		Usage: "Derivation index of the first key derived from the mnemonic",
+	}
+	numKeysFlag = &cli.Uint64Flag{
+		Name:  "num-keys",
+		Value: 0,
+		Usage: "Number of keys to derive from the mnemonic",
+	}
+	numWalletsFlag = &cli.Uint64Flag{
+		Name:     "num-wallets",
+		Value:    0,
+		Usage:    "Number of wallet directories to split the keys into, one per validator container",
+		Required: true,
+	}
+	outputDirFlag = &cli.StringFlag{
+		Name:     "output-dir",
+		Value:    "",
+		Usage:    "Empty or missing directory to write the wallet directories and the manifest to",
+		Required: true,
+	}
+	au = aurora.NewAurora(true /* enable colors */)
+)
+
+// splitKey is a validator key to assign to a wallet. Keys read from files keep their keystore,
+// while derived keys keep their secret until they are encrypted with the password of their wallet.
+type splitKey struct {
+	pubKey         []byte
+	keystore       *keymanager.Keystore
+	secret         []byte
+	derivationPath string
+}
+
+// manifest records which keys were written to which wallet directory.
+type manifest struct {
+	Wallets []*walletManifest `json:"wallets"`
+}
+
+type walletManifest struct {
+	Name         string         `json:"name"`
+	Directory    string         `json:"directory"`
+	PasswordFile string         `json:"password_file"`
+	Keys         []*keyManifest `json:"keys"`
+}
+
+type keyManifest struct {
+	PublicKey      string `json:"public_key"`
+	Keystore       string `json:"keystore"`
+	DerivationPath string `json:"derivation_path,omitempty"`
+}
+
+func main() {
+	app := &cli.App{
+		Name:        "keysplit",
+		Description: "Utility to split validator keys into wallet directories for a fleet of validator containers",
+		Usage:       "",
+		Flags: []cli.Flag{
+			keystoresFlag,
+			passwordFlag,
+			mnemonicFileFlag,
+			startIndexFlag,
+			numKeysFlag,
+			numWalletsFlag,
+			outputDirFlag,
+		},
+		Action: split,
+	}
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func split(cliCtx *cli.Context) error {
+	numWallets := cliCtx.Uint64(numWalletsFlag.Name)
+	if numWallets == 0 {
+		return errors.New("--num-wallets must be greater than 0")
+	}
+	outputDir, err := fileutil.ExpandPath(cliCtx.String(outputDirFlag.Name))
+	if err != nil {
+		return errors.Wrapf(err, "could not expand path: %s", cliCtx.String(outputDirFlag.Name))
+	}
+	if err := ensureEmptyDir(outputDir); err != nil {
+		return err
+	}
+
+	keystoresPath := cliCtx.String(keystoresFlag.Name)
+	mnemonicPath := cliCtx.String(mnemonicFileFlag.Name)
+	if (keystoresPath == "") == (mnemonicPath == "") {
+		return errors.New("exactly one of --keystores or --mnemonic-file must be set")
+	}
+	password := cliCtx.String(passwordFlag.Name)
+	var keys []*splitKey
+	if keystoresPath != "" {
+		if !cliCtx.IsSet(passwordFlag.Name) {
+			password, err = promptutil.PasswordPrompt("Input the keystore(s) password", func(s string) error {
+				// Any password is valid.
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+		// The password is written to the password files as is, an empty one would be replaced
+		// by a random password the keystores are not encrypted with.
+		if password == "" {
+			return errors.New("--password must not be empty when splitting keystore files")
+		}
+		fullPath, err := fileutil.ExpandPath(keystoresPath)
+		if err != nil {
+			return errors.Wrapf(err, "could not expand path: %s", keystoresPath)
+		}
+		keys, err = loadKeystores(fullPath)
+		if err != nil {
+			return err
+		}
+		// The password is written as is to the password files the validator containers
+		// import the keystores with, so it must be the one of every keystore.
+		if err := verifyKeystores(keys, password); err != nil {
+			return err
+		}
+	} else {
+		fullPath, err := fileutil.ExpandPath(mnemonicPath)
+		if err != nil {
+			return errors.Wrapf(err, "could not expand path: %s", mnemonicPath)
+		}
+		mnemonic, err := ioutil.ReadFile(fullPath)
+		if err != nil {
+			return errors.Wrapf(err, "could not read mnemonic file: %s", fullPath)
+		}
+		numKeys := cliCtx.Uint64(numKeysFlag.Name)
+		if numKeys == 0 {
+			return errors.New("--num-keys must be greater than 0 when deriving keys from a mnemonic")
+		}
+		keys, err = deriveKeys(strings.TrimSpace(string(mnemonic)), cliCtx.Uint64(startIndexFlag.Name), numKeys)
+		if err != nil {
+			return err
+		}
+	}
+	if uint64(len(keys)) < numWallets {
+		return fmt.Errorf("cannot split %d keys into %d wallets", len(keys), numWallets)
+	}
+
+	m, err := writeWallets(outputDir, assignKeys(keys, numWallets), password)
+	if err != nil {
+		return err
+	}
+	for _, w := range m.Wallets {
+		fmt.Printf("Wrote %d keys to wallet %s\n", len(w.Keys), au.BrightMagenta(filepath.Join(outputDir, w.Directory)))
+	}
+	fmt.Printf("Wrote manifest at path %s\n", au.BrightGreen(filepath.Join(outputDir, manifestFileName)))
+	return nil
+}
+
+// ensureEmptyDir refuses to write into a directory which already has contents, so an earlier
+// split is never partially overwritten.
+func ensureEmptyDir(dir string) error {
+	files, err := ioutil.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "could not read directory: %s", dir)
+	}
+	if len(files) > 0 {
+		return fmt.Errorf("output directory %s is not empty", dir)
+	}
+	return nil
+}
+
+// loadKeystores reads the keystore files of a directory, sorted by public key.
+func loadKeystores(dir string) ([]*splitKey, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read directory: %s", dir)
+	}
+	seen := make(map[string]bool)
+	keys := make([]*splitKey, 0, len(files))
+	for _, f := range files {
+		if f.IsDir() || !strings.Contains(f.Name(), "keystore") {
+			continue
+		}
+		path := filepath.Join(dir, f.Name())
+		encoded, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not read file at path: %s", path)
+		}
+		keystore := &keymanager.Keystore{}
+		if err := json.Unmarshal(encoded, keystore); err != nil {
+			return nil, errors.Wrapf(err, "could not JSON unmarshal keystore file at path: %s", path)
+		}
+		pubKey, err := hex.DecodeString(strings.TrimPrefix(keystore.Pubkey, "0x"))
+		if err != nil || len(pubKey) != 48 {
+			return nil, fmt.Errorf("keystore file at path %s has no valid public key", path)
+		}
+		// The same key exported twice must not end up in two validator containers.
+		if seen[string(pubKey)] {
+			return nil, fmt.Errorf("keystore file at path %s duplicates public key %#x", path, pubKey)
+		}
+		seen[string(pubKey)] = true
+		keys = append(keys, &splitKey{pubKey: pubKey, keystore: keystore})
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no keystore files found in directory: %s", dir)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return hex.EncodeToString(keys[i].pubKey) < hex.EncodeToString(keys[j].pubKey)
+	})
+	return keys, nil
+}
+
+// deriveKeys derives the validating keys of a mnemonic for a range of indices, sorted by index.
+func deriveKeys(mnemonic string, startIndex, numKeys uint64) ([]*splitKey, error) {
+	if !bip39.IsMnemonicValid(mnemonic) {
+		return nil, bip39.ErrInvalidMnemonic
+	}
+	seed := bip39.NewSeed(mnemonic, "")
+	keys := make([]*splitKey, numKeys)
+	for i := uint64(0); i < numKeys; i++ {
+		path := fmt.Sprintf(derived.ValidatingKeyDerivationPathTemplate, startIndex+i)
+		privKey, err := util.PrivateKeyFromSeedAndPath(seed, path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not derive key at path %s", path)
+		}
+		keys[i] = &splitKey{
+			pubKey:         privKey.PublicKey().Marshal(),
+			secret:         privKey.Marshal(),
+			derivationPath: path,
+		}
+	}
+	return keys, nil
+}
+
+// verifyKeystores decrypts every keystore with the password, and checks it holds the secret key
+// of its public key.
+func verifyKeystores(keys []*splitKey, password string) error {
+	decryptor := keystorev4.New()
+	for _, k := range keys {
+		secret, err := decryptor.Decrypt(k.keystore.Crypto, password)
+		if err != nil {
+			return errors.Wrapf(err, "could not decrypt keystore of %#x with the password", k.pubKey)
+		}
+		secretKey, err := bls.SecretKeyFromBytes(secret)
+		if err != nil {
+			return errors.Wrapf(err, "keystore of %#x holds an invalid secret key", k.pubKey)
+		}
+		if !bytes.Equal(secretKey.PublicKey().Marshal(), k.pubKey) {
+			return fmt.Errorf("keystore of %#x holds the secret key of another public key", k.pubKey)
+		}
+	}
+	return nil
+}
+
+// assignKeys assigns every key to a wallet by the hash of its public key, so the assignment of
+// a key does not depend on the other keys being split. Keys keep their order within a wallet.
+func assignKeys(keys []*splitKey, numWallets uint64) [][]*splitKey {
+	wallets := make([][]*splitKey, numWallets)
+	for _, k := range keys {
+		w := walletIndex(k.pubKey, numWallets)
+		wallets[w] = append(wallets[w], k)
+	}
+	return wallets
+}
+
+// walletIndex returns the wallet of a public key.
+func walletIndex(pubKey []byte, numWallets uint64) uint64 {
+	h := hashutil.Hash(pubKey)
+	return binary.BigEndian.Uint64(h[:8]) % numWallets
+}
+
+// writeWallets writes every wallet directory with its keystores and password file, followed by
+// the manifest of the split.
+func writeWallets(outputDir string, wallets [][]*splitKey, password string) (*manifest, error) {
+	m := &manifest{Wallets: make([]*walletManifest, len(wallets))}
+	for i, keys := range wallets {
+		name := fmt.Sprintf("validator-%d", i)
+		walletPassword := password
+		if walletPassword == "" {
+			var err error
+			walletPassword, err = randomPassword()
+			if err != nil {
+				return nil, err
+			}
+		}
+		keystoresDir := filepath.Join(outputDir, name, keystoresDirName)
+		if err := fileutil.MkdirAll(keystoresDir); err != nil {
+			return nil, errors.Wrapf(err, "could not create directory: %s", keystoresDir)
+		}
+		wm := &walletManifest{
+			Name:         name,
+			Directory:    name,
+			PasswordFile: filepath.Join(name, passwordFileName),
+			Keys:         make([]*keyManifest, len(keys)),
+		}
+		for j, k := range keys {
+			keystore, err := k.encrypt(walletPassword)
+			if err != nil {
+				return nil, err
+			}
+			encoded, err := json.MarshalIndent(keystore, "", "\t")
+			if err != nil {
+				return nil, errors.Wrap(err, "could not json marshal keystore")
+			}
+			fileName := fmt.Sprintf("keystore-%x.json", k.pubKey)
+			if err := fileutil.WriteFile(filepath.Join(keystoresDir, fileName), encoded); err != nil {
+				return nil, errors.Wrapf(err, "could not write keystore of %#x", k.pubKey)
+			}
+			wm.Keys[j] = &keyManifest{
+				PublicKey:      fmt.Sprintf("%#x", k.pubKey),
+				Keystore:       filepath.Join(name, keystoresDirName, fileName),
+				DerivationPath: k.derivationPath,
+			}
+		}
+		if err := fileutil.WriteFile(filepath.Join(outputDir, wm.PasswordFile), []byte(walletPassword)); err != nil {
+			return nil, errors.Wrapf(err, "could not write password file of wallet %s", name)
+		}
+		m.Wallets[i] = wm
+	}
+	encoded, err := json.MarshalIndent(m, "", "\t")
+	if err != nil {
+		return nil, errors.Wrap(err, "could not json marshal manifest")
+	}
+	if err := fileutil.WriteFile(filepath.Join(outputDir, manifestFileName), encoded); err != nil {
+		return nil, errors.Wrap(err, "could not write manifest")
+	}
+	return m, nil
+}
+
+// encrypt returns the keystore of a key read from a file as is, and encrypts a derived key
+// into a new keystore with the password of its wallet.
+func (k *splitKey) encrypt(password string) (*keymanager.Keystore, error) {
+	if k.keystore != nil {
+		return k.keystore, nil
+	}
+	encryptor := keystorev4.New()
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not generate new random uuid")
+	}
+	cryptoFields, err := encryptor.Encrypt(k.secret, password)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encrypt into new keystore")
+	}
+	return &keymanager.Keystore{
+		Crypto:  cryptoFields,
+		ID:      id.String(),
+		Version: encryptor.Version(),
+		Pubkey:  fmt.Sprintf("%x", k.pubKey),
+		Name:    encryptor.Name(),
+	}, nil
+}
+
+func randomPassword() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "could not generate random password")
+	}
+	return hex.EncodeToString(b), nil
+}
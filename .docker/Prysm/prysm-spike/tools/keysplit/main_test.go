@@ -0,0 +1,212 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/urfave/cli/v2"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+const testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+func setupCliContext(tb testing.TB, flags map[string]string) *cli.Context {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(keystoresFlag.Name, "", "")
+	set.String(passwordFlag.Name, "", "")
+	set.String(mnemonicFileFlag.Name, "", "")
+	set.Uint64(startIndexFlag.Name, 0, "")
+	set.Uint64(numKeysFlag.Name, 0, "")
+	set.Uint64(numWalletsFlag.Name, 0, "")
+	set.String(outputDirFlag.Name, "", "")
+	for name, value := range flags {
+		assert.NoError(tb, set.Set(name, value))
+	}
+	return cli.NewContext(&app, set, nil)
+}
+
+func writeRandomKeystore(t testing.TB, dir, password string) []byte {
+	encryptor := keystorev4.New()
+	id, err := uuid.NewRandom()
+	require.NoError(t, err)
+	validatingKey, err := bls.RandKey()
+	require.NoError(t, err)
+	pubKey := validatingKey.PublicKey().Marshal()
+	cryptoFields, err := encryptor.Encrypt(validatingKey.Marshal(), password)
+	require.NoError(t, err)
+	encoded, err := json.Marshal(&keymanager.Keystore{
+		Crypto:  cryptoFields,
+		Pubkey:  fmt.Sprintf("%x", pubKey),
+		ID:      id.String(),
+		Version: encryptor.Version(),
+		Name:    encryptor.Name(),
+	})
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, fmt.Sprintf("keystore-%x.json", pubKey[:4])), encoded, 0600))
+	return pubKey
+}
+
+func readManifest(t testing.TB, dir string) *manifest {
+	encoded, err := ioutil.ReadFile(filepath.Join(dir, manifestFileName))
+	require.NoError(t, err)
+	m := &manifest{}
+	require.NoError(t, json.Unmarshal(encoded, m))
+	return m
+}
+
+func TestAssignKeys_HashOfPublicKey(t *testing.T) {
+	keys := make([]*splitKey, 64)
+	for i := range keys {
+		keys[i] = &splitKey{pubKey: []byte{byte(i)}}
+	}
+	wallets := assignKeys(keys, 3)
+	require.Equal(t, 3, len(wallets))
+	total := 0
+	for w, assigned := range wallets {
+		assert.NotEqual(t, 0, len(assigned))
+		for _, k := range assigned {
+			assert.Equal(t, uint64(w), walletIndex(k.pubKey, 3))
+		}
+		total += len(assigned)
+	}
+	assert.Equal(t, len(keys), total)
+
+	// Removing a key does not move the other keys to other wallets.
+	for w, assigned := range assignKeys(keys[1:], 3) {
+		for _, k := range assigned {
+			assert.Equal(t, uint64(w), walletIndex(k.pubKey, 3))
+		}
+	}
+}
+
+func TestSplit_Keystores(t *testing.T) {
+	keysDir := t.TempDir()
+	outputDir := filepath.Join(t.TempDir(), "split")
+	password := "Passw0rdz2020%"
+	for i := 0; i < 3; i++ {
+		writeRandomKeystore(t, keysDir, password)
+	}
+	cliCtx := setupCliContext(t, map[string]string{
+		keystoresFlag.Name:  keysDir,
+		passwordFlag.Name:   password,
+		numWalletsFlag.Name: "2",
+		outputDirFlag.Name:  outputDir,
+	})
+	require.NoError(t, split(cliCtx))
+
+	m := readManifest(t, outputDir)
+	require.Equal(t, 2, len(m.Wallets))
+	total := 0
+	for i, w := range m.Wallets {
+		written, err := ioutil.ReadFile(filepath.Join(outputDir, w.PasswordFile))
+		require.NoError(t, err)
+		assert.Equal(t, password, string(written))
+		for _, k := range w.Keys {
+			_, err := ioutil.ReadFile(filepath.Join(outputDir, k.Keystore))
+			require.NoError(t, err)
+			pubKey, err := hex.DecodeString(strings.TrimPrefix(k.PublicKey, "0x"))
+			require.NoError(t, err)
+			assert.Equal(t, uint64(i), walletIndex(pubKey, 2))
+		}
+		total += len(w.Keys)
+	}
+	assert.Equal(t, 3, total)
+
+	// An earlier split is never overwritten.
+	assert.ErrorContains(t, "is not empty", split(cliCtx))
+}
+
+func TestSplit_Mnemonic(t *testing.T) {
+	mnemonicFile := filepath.Join(t.TempDir(), "mnemonic.txt")
+	require.NoError(t, ioutil.WriteFile(mnemonicFile, []byte(testMnemonic+"\n"), 0600))
+	outputDir := t.TempDir()
+	cliCtx := setupCliContext(t, map[string]string{
+		mnemonicFileFlag.Name: mnemonicFile,
+		startIndexFlag.Name:   "4",
+		numKeysFlag.Name:      "2",
+		numWalletsFlag.Name:   "2",
+		outputDirFlag.Name:    outputDir,
+	})
+	require.NoError(t, split(cliCtx))
+
+	m := readManifest(t, outputDir)
+	require.Equal(t, 2, len(m.Wallets))
+	keys, err := deriveKeys(testMnemonic, 4, 2)
+	require.NoError(t, err)
+	for i, k := range keys {
+		w := m.Wallets[walletIndex(k.pubKey, 2)]
+		var written *keyManifest
+		for _, km := range w.Keys {
+			if km.PublicKey == fmt.Sprintf("%#x", k.pubKey) {
+				written = km
+			}
+		}
+		require.NotNil(t, written, "Key %d not in its wallet", i)
+		assert.Equal(t, fmt.Sprintf("m/12381/3600/%d/0/0", 4+i), written.DerivationPath)
+
+		// The keystore decrypts with the generated password of its wallet.
+		password, err := ioutil.ReadFile(filepath.Join(outputDir, w.PasswordFile))
+		require.NoError(t, err)
+		encoded, err := ioutil.ReadFile(filepath.Join(outputDir, written.Keystore))
+		require.NoError(t, err)
+		keystore := &keymanager.Keystore{}
+		require.NoError(t, json.Unmarshal(encoded, keystore))
+		secret, err := keystorev4.New().Decrypt(keystore.Crypto, string(password))
+		require.NoError(t, err)
+		assert.DeepEqual(t, k.secret, secret)
+	}
+	first, err := ioutil.ReadFile(filepath.Join(outputDir, m.Wallets[0].PasswordFile))
+	require.NoError(t, err)
+	second, err := ioutil.ReadFile(filepath.Join(outputDir, m.Wallets[1].PasswordFile))
+	require.NoError(t, err)
+	assert.NotEqual(t, string(first), string(second), "Expected every wallet to get its own password")
+}
+
+func TestSplit_WrongKeystorePassword(t *testing.T) {
+	keysDir := t.TempDir()
+	outputDir := filepath.Join(t.TempDir(), "split")
+	writeRandomKeystore(t, keysDir, "Passw0rdz2020%")
+	writeRandomKeystore(t, keysDir, "0therPassw0rd%")
+	cliCtx := setupCliContext(t, map[string]string{
+		keystoresFlag.Name:  keysDir,
+		passwordFlag.Name:   "Passw0rdz2020%",
+		numWalletsFlag.Name: "2",
+		outputDirFlag.Name:  outputDir,
+	})
+	assert.ErrorContains(t, "with the password", split(cliCtx))
+	// Nothing is written when a keystore does not decrypt.
+	_, err := os.Stat(outputDir)
+	assert.Equal(t, true, os.IsNotExist(err))
+}
+
+func TestSplit_InvalidFlags(t *testing.T) {
+	outputDir := t.TempDir()
+	cliCtx := setupCliContext(t, map[string]string{
+		numWalletsFlag.Name: "2",
+		outputDirFlag.Name:  outputDir,
+	})
+	assert.ErrorContains(t, "exactly one of --keystores or --mnemonic-file must be set", split(cliCtx))
+
+	mnemonicFile := filepath.Join(t.TempDir(), "mnemonic.txt")
+	require.NoError(t, ioutil.WriteFile(mnemonicFile, []byte(testMnemonic), 0600))
+	cliCtx = setupCliContext(t, map[string]string{
+		mnemonicFileFlag.Name: mnemonicFile,
+		numKeysFlag.Name:      "1",
+		numWalletsFlag.Name:   "2",
+		outputDirFlag.Name:    outputDir,
+	})
+	assert.ErrorContains(t, "cannot split 1 keys into 2 wallets", split(cliCtx))
+}
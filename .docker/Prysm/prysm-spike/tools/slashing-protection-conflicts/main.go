@@ -0,0 +1,127 @@
+// This tool compares the EIP-3076 slashing protection exports of two validator clients, and reports
+// the keys both signed with and the signing records which are slashable together. Operators run it
+// before consolidating keys onto a single machine, as importing a history over a conflicting one
+// does not undo slashable messages which were already signed.
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+	interchangeformat "github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	firstFlag = &cli.StringFlag{
+		Name:     "first",
+		Usage:    "Path to the slashing protection export of the first validator client",
+		Required: true,
+	}
+	secondFlag = &cli.StringFlag{
+		Name:     "second",
+		Usage:    "Path to the slashing protection export of the second validator client",
+		Required: true,
+	}
+	jsonFlag = &cli.BoolFlag{
+		Name:  "json",
+		Usage: "Write the report as JSON instead of a table",
+	}
+)
+
+func main() {
+	app := &cli.App{
+		Name:  "slashing-protection-conflicts",
+		Usage: "Reports conflicting signing histories of keys shared by two EIP-3076 slashing protection exports",
+		Flags: []cli.Flag{
+			firstFlag,
+			secondFlag,
+			jsonFlag,
+		},
+		Action: run,
+	}
+	if err := app.Run(os.Args); err != nil {
+		logrus.Fatal(err)
+	}
+}
+
+func run(cliCtx *cli.Context) error {
+	first, err := readInterchangeFile(cliCtx.String(firstFlag.Name))
+	if err != nil {
+		return err
+	}
+	second, err := readInterchangeFile(cliCtx.String(secondFlag.Name))
+	if err != nil {
+		return err
+	}
+	report, err := interchangeformat.FindConflicts(first, second)
+	if err != nil {
+		return err
+	}
+	if cliCtx.Bool(jsonFlag.Name) {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(report); err != nil {
+			return err
+		}
+	} else if err := writeReport(report); err != nil {
+		return err
+	}
+	if len(report.Conflicts) > 0 {
+		return fmt.Errorf("found %d conflicts, do not run the overlapping keys with both histories", len(report.Conflicts))
+	}
+	return nil
+}
+
+func readInterchangeFile(path string) (*interchangeformat.EIPSlashingProtectionFormat, error) {
+	enc, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read %s", path)
+	}
+	format := &interchangeformat.EIPSlashingProtectionFormat{}
+	if err := json.Unmarshal(enc, format); err != nil {
+		return nil, errors.Wrapf(err, "could not decode %s", path)
+	}
+	return format, nil
+}
+
+func writeReport(report *interchangeformat.ConflictReport) error {
+	fmt.Printf("%d overlapping public keys\n", len(report.OverlappingPubkeys))
+	for _, pubKey := range report.OverlappingPubkeys {
+		fmt.Println(pubKey)
+	}
+	if len(report.Conflicts) == 0 {
+		fmt.Println("No conflicts")
+		return nil
+	}
+	fmt.Println()
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	if _, err := fmt.Fprintln(w, "pubkey\tkind\tfirst\tsecond\t"); err != nil {
+		return err
+	}
+	for _, c := range report.Conflicts {
+		if _, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\t\n", c.Pubkey, c.Kind, describe(c.FirstBlock, c.FirstAttestation), describe(c.SecondBlock, c.SecondAttestation)); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
+
+func describe(blk *interchangeformat.SignedBlock, att *interchangeformat.SignedAttestation) string {
+	root := ""
+	var desc string
+	if blk != nil {
+		desc, root = fmt.Sprintf("slot %s", blk.Slot), blk.SigningRoot
+	} else if att != nil {
+		desc, root = fmt.Sprintf("epochs %s->%s", att.SourceEpoch, att.TargetEpoch), att.SigningRoot
+	}
+	if root == "" {
+		return desc + " (no signing root)"
+	}
+	return fmt.Sprintf("%s (root %s)", desc, root)
+}
@@ -2,6 +2,7 @@ package accounts
 
 import (
 	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	vdb "github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/sirupsen/logrus"
 )
@@ -15,4 +16,7 @@ type AccountsConfig struct {
 	DisablePublicKeys [][]byte
 	EnablePublicKeys  [][]byte
 	DeletePublicKeys  [][]byte
+	// ValidatorDB, when set, archives the slashing protection history of deleted accounts and
+	// records tombstones preventing their use until they are imported again.
+	ValidatorDB vdb.Database
 }
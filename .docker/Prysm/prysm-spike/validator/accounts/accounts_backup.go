@@ -46,7 +46,7 @@ func BackupAccountsCli(cliCtx *cli.Context) error {
 	if err != nil {
 		return errors.Wrap(err, "could not initialize wallet")
 	}
-	if w.KeymanagerKind() == keymanager.Remote {
+	if w.KeymanagerKind() == keymanager.Remote || w.KeymanagerKind() == keymanager.Web3Signer {
 		return errors.New(
 			"remote wallets cannot backup accounts",
 		)
@@ -59,6 +59,10 @@ func BackupAccountsCli(cliCtx *cli.Context) error {
 	if err != nil {
 		return errors.Wrap(err, "could not fetch validating public keys")
 	}
+	pubKeys, err = filterAccountsCli(cliCtx, pubKeys)
+	if err != nil {
+		return err
+	}
 
 	// Input the directory where they wish to backup their accounts.
 	backupDir, err := prompt.InputDirectory(cliCtx, backupPromptText, flags.BackupDirFlag)
@@ -111,7 +115,7 @@ func BackupAccountsCli(cliCtx *cli.Context) error {
 		if err != nil {
 			return errors.Wrap(err, "could not backup accounts for derived keymanager")
 		}
-	case keymanager.Remote:
+	case keymanager.Remote, keymanager.Web3Signer:
 		return errors.New("backing up keys is not supported for a remote keymanager")
 	default:
 		return errors.New("keymanager kind not supported")
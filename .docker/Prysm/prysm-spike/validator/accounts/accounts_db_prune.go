@@ -0,0 +1,54 @@
+package accounts
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/validator/flags"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// PruneDBCli removes the slashing protection history of the validator database older than
+// --db-prune-retention-epochs, and compacts the database file.
+func PruneDBCli(cliCtx *cli.Context) error {
+	valDB, err := openSlashingProtectionDB(cliCtx)
+	if err != nil {
+		return err
+	}
+	return pruneValidatorDB(cliCtx.Context, valDB, cliCtx.Uint64(flags.DBPruneRetentionEpochsFlag.Name))
+}
+
+// pruneValidatorDB prunes the history of the database and closes it to compact its file. A retention
+// of 0 keeps the weak subjectivity period.
+func pruneValidatorDB(ctx context.Context, valDB *kv.Store, retentionEpochs uint64) error {
+	if retentionEpochs == 0 {
+		retentionEpochs = params.BeaconConfig().WeakSubjectivityPeriod
+	}
+	result, err := valDB.PruneHistory(ctx, retentionEpochs)
+	if closeErr := valDB.Close(); closeErr != nil {
+		log.WithError(closeErr).Error("Could not close validator database")
+	}
+	if err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"newestEpoch":          result.NewestEpoch,
+		"retentionEpochs":      retentionEpochs,
+		"attestationEntries":   result.AttestationEntries,
+		"attestationHistories": result.AttestationHistories,
+		"proposals":            result.Proposals,
+	}).Info("Pruned slashing protection history")
+
+	before, after, err := kv.Compact(valDB.DatabasePath())
+	if err != nil {
+		return errors.Wrap(err, "could not compact validator database")
+	}
+	log.WithFields(logrus.Fields{
+		"sizeBefore": before,
+		"sizeAfter":  after,
+	}).Info("Compacted validator database")
+	return nil
+}
@@ -0,0 +1,37 @@
+package accounts
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+)
+
+func TestPruneValidatorDB(t *testing.T) {
+	ctx := context.Background()
+	active, stopped := [48]byte{1}, [48]byte{2}
+	dir := t.TempDir()
+	valDB, err := kv.NewKVStore(dir, [][48]byte{active, stopped})
+	require.NoError(t, err)
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	require.NoError(t, valDB.SaveProposalHistoryForSlot(ctx, stopped, slotsPerEpoch, []byte{1}))
+	require.NoError(t, valDB.SaveProposalHistoryForSlot(ctx, active, (wsPeriod+2)*slotsPerEpoch, []byte{2}))
+
+	require.NoError(t, pruneValidatorDB(ctx, valDB, 0))
+
+	valDB, err = kv.NewKVStore(dir, nil)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, valDB.Close())
+	}()
+	_, exists, err := valDB.ProposalHistoryForSlot(ctx, stopped, slotsPerEpoch)
+	require.NoError(t, err)
+	assert.Equal(t, false, exists, "Expected the proposal older than the weak subjectivity period to be pruned")
+	_, exists, err = valDB.ProposalHistoryForSlot(ctx, active, (wsPeriod+2)*slotsPerEpoch)
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+}
@@ -2,22 +2,31 @@ package accounts
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/promptutil"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
 	"github.com/prysmaticlabs/prysm/validator/accounts/prompt"
 	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	vdb "github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/prysmaticlabs/prysm/validator/flags"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/derived"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
+	interchangeformat "github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format"
 	"github.com/urfave/cli/v2"
 )
 
+// ProtectionArchiveDirName is the directory of the wallet in which the slashing protection history
+// of deleted accounts is archived.
+const ProtectionArchiveDirName = "protection-archive"
+
 // DeleteAccountCli deletes the accounts that the user requests to be deleted from the wallet.
 // This function uses the CLI to extract necessary values.
 func DeleteAccountCli(cliCtx *cli.Context) error {
@@ -85,10 +94,20 @@ func DeleteAccountCli(cliCtx *cli.Context) error {
 			}
 		}
 	}
+	valDB, err := openValidatorDB(cliCtx, w)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := valDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close validator database")
+		}
+	}()
 	if err := DeleteAccount(cliCtx.Context, &AccountsConfig{
 		Wallet:           w,
 		Keymanager:       keymanager,
 		DeletePublicKeys: rawPublicKeys,
+		ValidatorDB:      valDB,
 	}); err != nil {
 		return err
 	}
@@ -102,7 +121,7 @@ func DeleteAccountCli(cliCtx *cli.Context) error {
 // DeleteAccount deletes the accounts that the user requests to be deleted from the wallet.
 func DeleteAccount(ctx context.Context, cfg *AccountsConfig) error {
 	switch cfg.Wallet.KeymanagerKind() {
-	case keymanager.Remote:
+	case keymanager.Remote, keymanager.Web3Signer:
 		return errors.New("cannot delete accounts for a remote keymanager")
 	case keymanager.Imported:
 		km, ok := cfg.Keymanager.(*imported.Keymanager)
@@ -133,5 +152,54 @@ func DeleteAccount(ctx context.Context, cfg *AccountsConfig) error {
 	default:
 		return fmt.Errorf("keymanager kind %s not supported", cfg.Wallet.KeymanagerKind())
 	}
+	if err := deleteAccountsMetadata(ctx, cfg.Wallet, cfg.DeletePublicKeys); err != nil {
+		return errors.Wrap(err, "could not delete metadata of accounts")
+	}
+	if cfg.ValidatorDB == nil {
+		return nil
+	}
+	pubKeys := make([][48]byte, len(cfg.DeletePublicKeys))
+	for i, pk := range cfg.DeletePublicKeys {
+		pubKeys[i] = bytesutil.ToBytes48(pk)
+	}
+	archivePath, err := archiveProtectionHistory(ctx, cfg.Wallet, cfg.ValidatorDB, pubKeys)
+	if err != nil {
+		return errors.Wrap(err, "could not archive slashing protection history of deleted accounts")
+	}
+	if err := cfg.ValidatorDB.SaveDeletedPublicKeys(ctx, pubKeys, archivePath); err != nil {
+		return errors.Wrap(err, "could not record deleted accounts")
+	}
+	log.WithField("archivePath", archivePath).Info(
+		"Archived slashing protection history of deleted accounts, which must be imported again to be used",
+	)
 	return nil
 }
+
+// archiveProtectionHistory exports the slashing protection history of the public keys as an EIP-3076
+// interchange file into the protection archive directory of the wallet and returns its path.
+func archiveProtectionHistory(ctx context.Context, w *wallet.Wallet, valDB vdb.Database, pubKeys [][48]byte) (string, error) {
+	eipJSON, err := interchangeformat.ExportStandardProtectionJSON(ctx, valDB)
+	if err != nil {
+		return "", err
+	}
+	archived := make(map[string]bool, len(pubKeys))
+	for _, pk := range pubKeys {
+		archived[fmt.Sprintf("%#x", pk)] = true
+	}
+	data := make([]*interchangeformat.ProtectionData, 0, len(pubKeys))
+	for _, item := range eipJSON.Data {
+		if archived[item.Pubkey] {
+			data = append(data, item)
+		}
+	}
+	eipJSON.Data = data
+	encoded, err := json.MarshalIndent(eipJSON, "", "\t")
+	if err != nil {
+		return "", err
+	}
+	fileName := fmt.Sprintf("deleted-accounts-%d.json", timeutils.Now().Unix())
+	if err := w.WriteFileAtPath(ctx, ProtectionArchiveDirName, fileName, encoded); err != nil {
+		return "", err
+	}
+	return filepath.Join(w.AccountsDir(), ProtectionArchiveDirName, fileName), nil
+}
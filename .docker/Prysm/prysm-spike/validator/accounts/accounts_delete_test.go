@@ -9,9 +9,11 @@ import (
 	"time"
 
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
 )
@@ -73,4 +75,28 @@ func TestDeleteAccounts_Noninteractive(t *testing.T) {
 	remainingPublicKey, err := hex.DecodeString(k3.Pubkey)
 	require.NoError(t, err)
 	assert.DeepEqual(t, remainingAccounts[0], bytesutil.ToBytes48(remainingPublicKey))
+
+	// The deleted accounts are tombstoned along with the archive of their protection history.
+	valDB, err := kv.NewKVStore(w.AccountsDir(), nil)
+	require.NoError(t, err)
+	deleted, err := valDB.DeletedPublicKeys(cliCtx.Context)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(deleted))
+	for _, pubKey := range generatedPubKeys[0:2] {
+		enc, err := hex.DecodeString(pubKey)
+		require.NoError(t, err)
+		archivePath, ok := deleted[bytesutil.ToBytes48(enc)]
+		require.Equal(t, true, ok)
+		assert.Equal(t, true, fileutil.FileExists(archivePath))
+	}
+	require.NoError(t, valDB.Close())
+
+	// Importing the accounts again clears their tombstones.
+	require.NoError(t, ImportAccountsCli(cliCtx))
+	valDB, err = kv.NewKVStore(w.AccountsDir(), nil)
+	require.NoError(t, err)
+	deleted, err = valDB.DeletedPublicKeys(cliCtx.Context)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(deleted))
+	require.NoError(t, valDB.Close())
 }
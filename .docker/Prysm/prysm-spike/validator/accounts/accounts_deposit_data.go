@@ -0,0 +1,448 @@
+package accounts
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/depositutil"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/promptutil"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+	"github.com/prysmaticlabs/prysm/validator/accounts/prompt"
+	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/validator/flags"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/derived"
+	"github.com/urfave/cli/v2"
+)
+
+// depositDataCLIVersion is the eth2.0-deposit-cli version recorded in the deposit data. The
+// launchpad refuses deposit data files without one.
+const depositDataCLIVersion = "1.0.0"
+
+// DepositDataEntry is the deposit of an account in the deposit_data JSON format of the
+// eth2.0-deposit-cli, as uploaded to the launchpad. Byte fields are hex encoded without prefix.
+type DepositDataEntry struct {
+	PubKey                string `json:"pubkey"`
+	WithdrawalCredentials string `json:"withdrawal_credentials"`
+	Amount                uint64 `json:"amount"`
+	Signature             string `json:"signature"`
+	DepositMessageRoot    string `json:"deposit_message_root"`
+	DepositDataRoot       string `json:"deposit_data_root"`
+	ForkVersion           string `json:"fork_version"`
+	NetworkName           string `json:"eth2_network_name"`
+	DepositCLIVersion     string `json:"deposit_cli_version"`
+}
+
+// depositMessage is the deposit data without its signature, the object signed by the
+// validating key.
+type depositMessage struct {
+	PublicKey             []byte `ssz-size:"48"`
+	WithdrawalCredentials []byte `ssz-size:"32"`
+	Amount                uint64
+}
+
+type depositAccount struct {
+	pubKey                [48]byte
+	withdrawalCredentials []byte
+}
+
+// depositSigner signs the signing root of the deposit of an account with its validating key.
+type depositSigner func(ctx context.Context, pubKey [48]byte, signingRoot [32]byte, domain []byte) (bls.Signature, error)
+
+// DepositDataCli writes launchpad-compatible deposit data for the selected accounts of the
+// wallet or, given a mnemonic, for a batch of accounts derived from it. Every deposit is
+// verified against its withdrawal credentials before the file is written.
+func DepositDataCli(cliCtx *cli.Context) error {
+	amount := cliCtx.Uint64(flags.DepositAmountGweiFlag.Name)
+	if amount == 0 {
+		amount = params.BeaconConfig().MaxEffectiveBalance
+	}
+	if amount < params.BeaconConfig().MinDepositAmount {
+		return fmt.Errorf(
+			"deposit amount of %d gwei is below the minimum deposit amount of %d gwei",
+			amount, params.BeaconConfig().MinDepositAmount,
+		)
+	}
+	if amount > params.BeaconConfig().MaxEffectiveBalance {
+		log.Warnf(
+			"Deposit amount of %d gwei exceeds the maximum effective balance of %d gwei, the excess does not earn rewards",
+			amount, params.BeaconConfig().MaxEffectiveBalance,
+		)
+	}
+	format := cliCtx.String(flags.DepositDataFormatFlag.Name)
+	if format != "json" && format != "csv" {
+		return fmt.Errorf("unsupported deposit data format %q", format)
+	}
+	withdrawalCredentials, err := inputDepositWithdrawalCredentials(cliCtx)
+	if err != nil {
+		return err
+	}
+
+	var accounts []*depositAccount
+	var sign depositSigner
+	if cliCtx.IsSet(flags.MnemonicFileFlag.Name) {
+		accounts, sign, err = mnemonicDepositAccounts(cliCtx, withdrawalCredentials)
+	} else {
+		accounts, sign, err = walletDepositAccounts(cliCtx, withdrawalCredentials)
+	}
+	if err != nil {
+		return err
+	}
+	entries, err := depositDataEntries(cliCtx.Context, accounts, amount, sign)
+	if err != nil {
+		return err
+	}
+
+	var encoded bytes.Buffer
+	if err := writeDepositData(&encoded, entries, format); err != nil {
+		return err
+	}
+	outputDir := cliCtx.String(flags.DepositDataOutputDirFlag.Name)
+	if err := fileutil.MkdirAll(outputDir); err != nil {
+		return errors.Wrap(err, "could not create deposit data output directory")
+	}
+	outputPath := filepath.Join(outputDir, fmt.Sprintf("deposit_data-%d.%s", timeutils.Now().Unix(), format))
+	if err := fileutil.WriteFile(outputPath, encoded.Bytes()); err != nil {
+		return errors.Wrap(err, "could not write deposit data")
+	}
+	log.WithField("path", outputPath).Infof(
+		"Wrote verified deposit data of %d accounts with %s withdrawal credentials",
+		len(entries), withdrawalCredentialsKind(accounts[0].withdrawalCredentials),
+	)
+	return nil
+}
+
+// inputDepositWithdrawalCredentials returns the withdrawal credentials set by the withdrawal
+// address or BLS withdrawal public key flags, or nil if neither is set.
+func inputDepositWithdrawalCredentials(cliCtx *cli.Context) ([]byte, error) {
+	hasAddress := cliCtx.IsSet(flags.DepositWithdrawalAddressFlag.Name)
+	hasPubKey := cliCtx.IsSet(flags.DepositBLSWithdrawalPublicKeyFlag.Name)
+	switch {
+	case hasAddress && hasPubKey:
+		return nil, fmt.Errorf(
+			"only one of --%s and --%s can be set",
+			flags.DepositWithdrawalAddressFlag.Name, flags.DepositBLSWithdrawalPublicKeyFlag.Name,
+		)
+	case hasAddress:
+		address, err := parseExecutionAddress(cliCtx.String(flags.DepositWithdrawalAddressFlag.Name))
+		if err != nil {
+			return nil, err
+		}
+		return executionAddressWithdrawalCredentials(address), nil
+	case hasPubKey:
+		input := cliCtx.String(flags.DepositBLSWithdrawalPublicKeyFlag.Name)
+		pubKey, err := hex.DecodeString(strings.TrimPrefix(input, "0x"))
+		if err != nil || len(pubKey) != params.BeaconConfig().BLSPubkeyLength {
+			return nil, fmt.Errorf("%s is not a valid BLS public key", input)
+		}
+		creds := blsWithdrawalCredentials(pubKey)
+		return creds[:], nil
+	default:
+		return nil, nil
+	}
+}
+
+// walletDepositAccounts returns the selected accounts of the wallet, signing with the wallet
+// keymanager. Withdrawal credentials are required, as the wallet does not hold withdrawal keys.
+func walletDepositAccounts(cliCtx *cli.Context, withdrawalCredentials []byte) ([]*depositAccount, depositSigner, error) {
+	if withdrawalCredentials == nil {
+		return nil, nil, fmt.Errorf(
+			"--%s or --%s is required to create deposit data for the accounts of a wallet",
+			flags.DepositWithdrawalAddressFlag.Name, flags.DepositBLSWithdrawalPublicKeyFlag.Name,
+		)
+	}
+	w, err := wallet.OpenWalletOrElseCli(cliCtx, func(cliCtx *cli.Context) (*wallet.Wallet, error) {
+		return nil, wallet.ErrNoWalletFound
+	})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not open wallet")
+	}
+	km, err := w.InitializeKeymanager(cliCtx.Context)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not initialize keymanager")
+	}
+	pubKeys, err := km.FetchValidatingPublicKeys(cliCtx.Context)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(pubKeys) == 0 {
+		return nil, nil, errors.New("wallet is empty, no accounts to create deposit data for")
+	}
+	if cliCtx.IsSet(flags.DepositDataPublicKeysFlag.Name) {
+		filteredPubKeys, err := filterPublicKeysFromUserInput(
+			cliCtx,
+			flags.DepositDataPublicKeysFlag,
+			pubKeys,
+			prompt.SelectAccountsDepositDataPromptText,
+		)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "could not filter public keys for deposit data")
+		}
+		pubKeys = make([][48]byte, len(filteredPubKeys))
+		for i, pk := range filteredPubKeys {
+			copy(pubKeys[i][:], pk.Marshal())
+		}
+	}
+	accounts := make([]*depositAccount, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		accounts[i] = &depositAccount{pubKey: pubKey, withdrawalCredentials: withdrawalCredentials}
+	}
+	sign := func(ctx context.Context, pubKey [48]byte, signingRoot [32]byte, domain []byte) (bls.Signature, error) {
+		return km.Sign(ctx, &validatorpb.SignRequest{
+			PublicKey:       pubKey[:],
+			SigningRoot:     signingRoot[:],
+			SignatureDomain: domain,
+		})
+	}
+	return accounts, sign, nil
+}
+
+// mnemonicDepositAccounts derives a batch of accounts from a mnemonic, as a derived wallet
+// recovered from the same mnemonic holds them. Without withdrawal credentials, the BLS
+// withdrawal credentials of the withdrawal key derived for each account are used.
+func mnemonicDepositAccounts(cliCtx *cli.Context, withdrawalCredentials []byte) ([]*depositAccount, depositSigner, error) {
+	mnemonic, err := inputMnemonic(cliCtx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not get mnemonic phrase")
+	}
+	mnemonicPassphrase := ""
+	if cliCtx.IsSet(flags.Mnemonic25thWordFileFlag.Name) {
+		mnemonicPassphrase, err = promptutil.InputPassword(
+			cliCtx,
+			flags.Mnemonic25thWordFileFlag,
+			mnemonicPassphrasePromptText,
+			"Confirm mnemonic passphrase",
+			false, /* Should confirm password */
+			func(input string) error {
+				if strings.TrimSpace(input) == "" {
+					return errors.New("input cannot be empty")
+				}
+				return nil
+			},
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	numAccounts := cliCtx.Int(flags.NumAccountsFlag.Name)
+	if numAccounts < 1 {
+		return nil, nil, errors.New("number of accounts must be at least 1")
+	}
+	startIndex := cliCtx.Int(flags.DepositStartIndexFlag.Name)
+	if startIndex < 0 {
+		return nil, nil, errors.New("start index cannot be negative")
+	}
+	validatingKeys, withdrawalKeys, err := derived.DeriveAccountKeys(mnemonic, mnemonicPassphrase, startIndex, numAccounts)
+	if err != nil {
+		return nil, nil, err
+	}
+	accounts := make([]*depositAccount, numAccounts)
+	secretKeys := make(map[[48]byte]bls.SecretKey, numAccounts)
+	for i, key := range validatingKeys {
+		account := &depositAccount{
+			pubKey:                bytesutil.ToBytes48(key.PublicKey().Marshal()),
+			withdrawalCredentials: withdrawalCredentials,
+		}
+		if account.withdrawalCredentials == nil {
+			account.withdrawalCredentials = depositutil.WithdrawalCredentialsHash(withdrawalKeys[i])
+		}
+		accounts[i] = account
+		secretKeys[account.pubKey] = key
+	}
+	sign := func(_ context.Context, pubKey [48]byte, signingRoot [32]byte, _ []byte) (bls.Signature, error) {
+		key, ok := secretKeys[pubKey]
+		if !ok {
+			return nil, fmt.Errorf("no key derived for account %#x", bytesutil.Trunc(pubKey[:]))
+		}
+		return key.Sign(signingRoot[:]), nil
+	}
+	return accounts, sign, nil
+}
+
+// depositDataEntries signs the deposits of the accounts and verifies each of them, along with
+// the withdrawal credentials the account was requested with.
+func depositDataEntries(
+	ctx context.Context, accounts []*depositAccount, amount uint64, sign depositSigner,
+) ([]*DepositDataEntry, error) {
+	// Deposits are valid regardless of fork version, they are signed with the genesis fork version.
+	domain, err := helpers.ComputeDomain(params.BeaconConfig().DomainDeposit, nil, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute deposit domain")
+	}
+	entries := make([]*DepositDataEntry, len(accounts))
+	for i, account := range accounts {
+		msg := &depositMessage{
+			PublicKey:             account.pubKey[:],
+			WithdrawalCredentials: account.withdrawalCredentials,
+			Amount:                amount,
+		}
+		msgRoot, err := ssz.HashTreeRoot(msg)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not compute deposit message root")
+		}
+		signingRoot, err := helpers.ComputeSigningRoot(msg, domain)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not compute signing root")
+		}
+		sig, err := sign(ctx, account.pubKey, signingRoot, domain)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not sign deposit of account %#x", bytesutil.Trunc(account.pubKey[:]))
+		}
+		data := &ethpb.Deposit_Data{
+			PublicKey:             msg.PublicKey,
+			WithdrawalCredentials: msg.WithdrawalCredentials,
+			Amount:                amount,
+			Signature:             sig.Marshal(),
+		}
+		dataRoot, err := data.HashTreeRoot()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not compute deposit data root")
+		}
+		entries[i] = &DepositDataEntry{
+			PubKey:                hex.EncodeToString(data.PublicKey),
+			WithdrawalCredentials: hex.EncodeToString(data.WithdrawalCredentials),
+			Amount:                amount,
+			Signature:             hex.EncodeToString(data.Signature),
+			DepositMessageRoot:    hex.EncodeToString(msgRoot[:]),
+			DepositDataRoot:       hex.EncodeToString(dataRoot[:]),
+			ForkVersion:           hex.EncodeToString(params.BeaconConfig().GenesisForkVersion),
+			NetworkName:           params.BeaconConfig().NetworkName,
+			DepositCLIVersion:     depositDataCLIVersion,
+		}
+		if err := verifyDepositDataEntry(entries[i], account.withdrawalCredentials); err != nil {
+			return nil, errors.Wrapf(err, "deposit of account %#x did not verify", bytesutil.Trunc(account.pubKey[:]))
+		}
+	}
+	return entries, nil
+}
+
+// verifyDepositDataEntry checks a deposit has the expected withdrawal credentials, of a known
+// kind, that its roots match its fields and that it is signed by its public key.
+func verifyDepositDataEntry(entry *DepositDataEntry, expectedWithdrawalCredentials []byte) error {
+	decode := func(name, value string, length int) ([]byte, error) {
+		b, err := hex.DecodeString(strings.TrimPrefix(value, "0x"))
+		if err != nil || len(b) != length {
+			return nil, fmt.Errorf("invalid %s %q", name, value)
+		}
+		return b, nil
+	}
+	pubKey, err := decode("public key", entry.PubKey, params.BeaconConfig().BLSPubkeyLength)
+	if err != nil {
+		return err
+	}
+	creds, err := decode("withdrawal credentials", entry.WithdrawalCredentials, 32)
+	if err != nil {
+		return err
+	}
+	sig, err := decode("signature", entry.Signature, params.BeaconConfig().BLSSignatureLength)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(creds, expectedWithdrawalCredentials) {
+		return fmt.Errorf("withdrawal credentials %#x do not match the expected %#x", creds, expectedWithdrawalCredentials)
+	}
+	if withdrawalCredentialsKind(creds) == UnknownWithdrawalCredentials {
+		return fmt.Errorf("withdrawal credentials %#x have an unknown prefix", creds)
+	}
+	if entry.ForkVersion != hex.EncodeToString(params.BeaconConfig().GenesisForkVersion) {
+		return fmt.Errorf("fork version %s is not the genesis fork version of the network", entry.ForkVersion)
+	}
+	msgRoot, err := ssz.HashTreeRoot(&depositMessage{PublicKey: pubKey, WithdrawalCredentials: creds, Amount: entry.Amount})
+	if err != nil {
+		return errors.Wrap(err, "could not compute deposit message root")
+	}
+	if entry.DepositMessageRoot != hex.EncodeToString(msgRoot[:]) {
+		return errors.New("deposit message root does not match the deposit")
+	}
+	data := &ethpb.Deposit_Data{
+		PublicKey:             pubKey,
+		WithdrawalCredentials: creds,
+		Amount:                entry.Amount,
+		Signature:             sig,
+	}
+	dataRoot, err := data.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "could not compute deposit data root")
+	}
+	if entry.DepositDataRoot != hex.EncodeToString(dataRoot[:]) {
+		return errors.New("deposit data root does not match the deposit")
+	}
+	domain, err := helpers.ComputeDomain(params.BeaconConfig().DomainDeposit, nil, nil)
+	if err != nil {
+		return errors.Wrap(err, "could not compute deposit domain")
+	}
+	return depositutil.VerifyDepositSignature(data, domain)
+}
+
+// writeDepositData writes the deposit data as a launchpad-compatible JSON array, or as CSV
+// with one column per JSON field.
+func writeDepositData(out io.Writer, entries []*DepositDataEntry, format string) error {
+	switch format {
+	case "json":
+		enc, err := json.Marshal(entries)
+		if err != nil {
+			return err
+		}
+		_, err = out.Write(enc)
+		return err
+	case "csv":
+		writer := csv.NewWriter(out)
+		if err := writer.Write([]string{
+			"pubkey",
+			"withdrawal_credentials",
+			"amount",
+			"signature",
+			"deposit_message_root",
+			"deposit_data_root",
+			"fork_version",
+			"eth2_network_name",
+			"deposit_cli_version",
+		}); err != nil {
+			return err
+		}
+		for _, e := range entries {
+			if err := writer.Write([]string{
+				e.PubKey,
+				e.WithdrawalCredentials,
+				strconv.FormatUint(e.Amount, 10),
+				e.Signature,
+				e.DepositMessageRoot,
+				e.DepositDataRoot,
+				e.ForkVersion,
+				e.NetworkName,
+				e.DepositCLIVersion,
+			}); err != nil {
+				return err
+			}
+		}
+		writer.Flush()
+		return writer.Error()
+	default:
+		return fmt.Errorf("unsupported deposit data format %q", format)
+	}
+}
+
+// executionAddressWithdrawalCredentials returns the withdrawal credentials withdrawing to an
+// execution address.
+func executionAddressWithdrawalCredentials(address []byte) []byte {
+	creds := make([]byte, 32)
+	creds[0] = eth1AddressWithdrawalPrefixByte
+	copy(creds[32-executionAddressLength:], address)
+	return creds
+}
@@ -0,0 +1,128 @@
+package accounts
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/depositutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/derived"
+)
+
+const depositDataTestMnemonic = "tumble turn jewel sudden social great water general cabin jacket bounce dry flip monster advance problem social half flee inform century chicken hard reason"
+
+func depositDataTestAccounts(t *testing.T, withdrawalCredentials []byte) ([]*depositAccount, depositSigner) {
+	validatingKeys, withdrawalKeys, err := derived.DeriveAccountKeys(depositDataTestMnemonic, "", 2, 3)
+	require.NoError(t, err)
+	accounts := make([]*depositAccount, len(validatingKeys))
+	secretKeys := make(map[[48]byte]bls.SecretKey)
+	for i, key := range validatingKeys {
+		accounts[i] = &depositAccount{
+			pubKey:                bytesutil.ToBytes48(key.PublicKey().Marshal()),
+			withdrawalCredentials: withdrawalCredentials,
+		}
+		if withdrawalCredentials == nil {
+			accounts[i].withdrawalCredentials = depositutil.WithdrawalCredentialsHash(withdrawalKeys[i])
+		}
+		secretKeys[accounts[i].pubKey] = key
+	}
+	sign := func(_ context.Context, pubKey [48]byte, signingRoot [32]byte, _ []byte) (bls.Signature, error) {
+		return secretKeys[pubKey].Sign(signingRoot[:]), nil
+	}
+	return accounts, sign
+}
+
+func TestDepositDataEntries(t *testing.T) {
+	ctx := context.Background()
+	address := bytes.Repeat([]byte{0xab}, executionAddressLength)
+	creds := executionAddressWithdrawalCredentials(address)
+	accounts, sign := depositDataTestAccounts(t, creds)
+	amount := params.BeaconConfig().MaxEffectiveBalance
+
+	entries, err := depositDataEntries(ctx, accounts, amount, sign)
+	require.NoError(t, err)
+	require.Equal(t, len(accounts), len(entries))
+	for i, entry := range entries {
+		assert.Equal(t, hex.EncodeToString(accounts[i].pubKey[:]), entry.PubKey)
+		assert.Equal(t, amount, entry.Amount)
+		assert.Equal(t, depositDataCLIVersion, entry.DepositCLIVersion)
+		require.NoError(t, verifyDepositDataEntry(entry, creds))
+	}
+
+	// Accounts without withdrawal credentials withdraw to their derived BLS withdrawal keys.
+	blsAccounts, blsSign := depositDataTestAccounts(t, nil)
+	blsEntries, err := depositDataEntries(ctx, blsAccounts, amount, blsSign)
+	require.NoError(t, err)
+	assert.Equal(t, BLSWithdrawalCredentials, withdrawalCredentialsKind(blsAccounts[0].withdrawalCredentials))
+	require.NoError(t, verifyDepositDataEntry(blsEntries[0], blsAccounts[0].withdrawalCredentials))
+}
+
+func TestVerifyDepositDataEntry_Invalid(t *testing.T) {
+	ctx := context.Background()
+	creds := executionAddressWithdrawalCredentials(bytes.Repeat([]byte{0xab}, executionAddressLength))
+	accounts, sign := depositDataTestAccounts(t, creds)
+	entries, err := depositDataEntries(ctx, accounts, params.BeaconConfig().MaxEffectiveBalance, sign)
+	require.NoError(t, err)
+
+	otherCreds := executionAddressWithdrawalCredentials(bytes.Repeat([]byte{0xcd}, executionAddressLength))
+	err = verifyDepositDataEntry(entries[0], otherCreds)
+	assert.ErrorContains(t, "do not match the expected", err)
+
+	tampered := *entries[0]
+	tampered.Amount = params.BeaconConfig().MinDepositAmount
+	err = verifyDepositDataEntry(&tampered, creds)
+	assert.ErrorContains(t, "deposit message root does not match", err)
+
+	swapped := *entries[0]
+	swapped.Signature = entries[1].Signature
+	err = verifyDepositDataEntry(&swapped, creds)
+	assert.ErrorContains(t, "deposit data root does not match", err)
+
+	unknown := append([]byte{0x05}, make([]byte, 31)...)
+	accounts, sign = depositDataTestAccounts(t, unknown)
+	_, err = depositDataEntries(ctx, accounts, params.BeaconConfig().MaxEffectiveBalance, sign)
+	assert.ErrorContains(t, "unknown prefix", err)
+}
+
+func TestWriteDepositData(t *testing.T) {
+	creds := executionAddressWithdrawalCredentials(bytes.Repeat([]byte{0xab}, executionAddressLength))
+	accounts, sign := depositDataTestAccounts(t, creds)
+	entries, err := depositDataEntries(context.Background(), accounts, params.BeaconConfig().MaxEffectiveBalance, sign)
+	require.NoError(t, err)
+
+	var jsonOut bytes.Buffer
+	require.NoError(t, writeDepositData(&jsonOut, entries, "json"))
+	var decoded []*DepositDataEntry
+	require.NoError(t, json.Unmarshal(jsonOut.Bytes(), &decoded))
+	assert.DeepEqual(t, entries, decoded)
+
+	var csvOut bytes.Buffer
+	require.NoError(t, writeDepositData(&csvOut, entries, "csv"))
+	records, err := csv.NewReader(&csvOut).ReadAll()
+	require.NoError(t, err)
+	require.Equal(t, len(entries)+1, len(records))
+	assert.Equal(t, "pubkey", records[0][0])
+	assert.Equal(t, "deposit_cli_version", records[0][8])
+	assert.Equal(t, entries[0].PubKey, records[1][0])
+	assert.Equal(t, entries[2].DepositDataRoot, records[3][5])
+
+	assert.ErrorContains(t, "unsupported deposit data format", writeDepositData(&csvOut, entries, "yaml"))
+}
+
+func TestExecutionAddressWithdrawalCredentials(t *testing.T) {
+	address := bytes.Repeat([]byte{0xab}, executionAddressLength)
+	creds := executionAddressWithdrawalCredentials(address)
+	require.Equal(t, 32, len(creds))
+	assert.Equal(t, eth1AddressWithdrawalPrefixByte, creds[0])
+	assert.DeepEqual(t, make([]byte, 11), creds[1:12])
+	assert.DeepEqual(t, address, creds[12:])
+	assert.Equal(t, ExecutionAddressWithdrawalCredentials, withdrawalCredentialsKind(creds))
+}
@@ -6,6 +6,7 @@ import (
 	"io"
 	"strings"
 
+	ptypes "github.com/gogo/protobuf/types"
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
@@ -37,6 +38,10 @@ func ExitAccountsCli(cliCtx *cli.Context, r io.Reader) error {
 	if err != nil {
 		return err
 	}
+	validatingPublicKeys, err = filterAccountsCli(cliCtx, validatingPublicKeys)
+	if err != nil {
+		return err
+	}
 
 	rawPubKeys, formattedPubKeys, err := interact(cliCtx, r, validatingPublicKeys)
 	if err != nil {
@@ -170,26 +175,42 @@ func interact(cliCtx *cli.Context, r io.Reader, validatingPublicKeys [][48]byte)
 }
 
 func prepareClients(cliCtx *cli.Context) (*ethpb.BeaconNodeValidatorClient, *ethpb.NodeClient, error) {
+	conn, err := dialBeaconNode(cliCtx)
+	if err != nil {
+		return nil, nil, err
+	}
+	validatorClient := ethpb.NewBeaconNodeValidatorClient(conn)
+	nodeClient := ethpb.NewNodeClient(conn)
+
+	return &validatorClient, &nodeClient, nil
+}
+
+func dialBeaconNode(cliCtx *cli.Context) (*grpc.ClientConn, error) {
 	dialOpts := client.ConstructDialOptions(
 		cliCtx.Int(cmd.GrpcMaxCallRecvMsgSizeFlag.Name),
 		cliCtx.String(flags.CertFlag.Name),
+		nil, /* tlsConfig */
 		cliCtx.Uint(flags.GrpcRetriesFlag.Name),
 		cliCtx.Duration(flags.GrpcRetryDelayFlag.Name),
 	)
 	if dialOpts == nil {
-		return nil, nil, errors.New("failed to construct dial options")
+		return nil, errors.New("failed to construct dial options")
 	}
-	conn, err := grpc.DialContext(cliCtx.Context, cliCtx.String(flags.BeaconRPCProviderFlag.Name), dialOpts...)
+	conn, err := client.DialBeaconNode(cliCtx.Context, cliCtx.String(flags.BeaconRPCProviderFlag.Name), dialOpts)
 	if err != nil {
-		return nil, nil, errors.Wrapf(err, "could not dial endpoint %s", flags.BeaconRPCProviderFlag.Name)
+		return nil, errors.Wrapf(err, "could not dial endpoint %s", flags.BeaconRPCProviderFlag.Name)
 	}
-	validatorClient := ethpb.NewBeaconNodeValidatorClient(conn)
-	nodeClient := ethpb.NewNodeClient(conn)
-
-	return &validatorClient, &nodeClient, nil
+	return conn, nil
 }
 
 func performExit(cliCtx *cli.Context, cfg performExitCfg) ([]string, error) {
+	if km, ok := cfg.keymanager.(keymanager.GenesisValidatorsRootSetter); ok {
+		genesis, err := cfg.nodeClient.GetGenesis(cliCtx.Context, &ptypes.Empty{})
+		if err != nil {
+			return nil, errors.Wrap(err, "gRPC call to get genesis failed")
+		}
+		km.SetGenesisValidatorsRoot(genesis.GenesisValidatorsRoot)
+	}
 	var rawNotExitedKeys [][]byte
 	for i, key := range cfg.rawPubKeys {
 		if err := client.ProposeExit(cliCtx.Context, cfg.validatorClient, cfg.nodeClient, cfg.keymanager.Sign, key); err != nil {
@@ -0,0 +1,170 @@
+package accounts
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/validator/flags"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// Statuses of validators the accounts can be filtered by.
+const (
+	validatorStatusActive  = "active"
+	validatorStatusExited  = "exited"
+	validatorStatusPending = "pending"
+)
+
+// accountFilter selects accounts by the record of their validator in the state of the beacon node.
+// Each set criterion must match, and a criterion with several values matches any of them.
+type accountFilter struct {
+	blsCredentials  bool
+	eth1Credentials bool
+	statuses        map[string]bool
+}
+
+// accountFilterFromCli returns the filter of the account filter flags, or nil when none is set.
+func accountFilterFromCli(cliCtx *cli.Context) (*accountFilter, error) {
+	f := &accountFilter{
+		blsCredentials:  cliCtx.Bool(flags.WithBLSCredentialsFlag.Name),
+		eth1Credentials: cliCtx.Bool(flags.WithEth1CredentialsFlag.Name),
+	}
+	for _, status := range cliCtx.StringSlice(flags.ValidatorStatusFilterFlag.Name) {
+		for _, s := range strings.Split(status, ",") {
+			s = strings.ToLower(strings.TrimSpace(s))
+			switch s {
+			case validatorStatusActive, validatorStatusExited, validatorStatusPending:
+			default:
+				return nil, fmt.Errorf(
+					"unsupported status %q, expected %s, %s or %s",
+					s, validatorStatusActive, validatorStatusExited, validatorStatusPending,
+				)
+			}
+			if f.statuses == nil {
+				f.statuses = make(map[string]bool)
+			}
+			f.statuses[s] = true
+		}
+	}
+	if !f.blsCredentials && !f.eth1Credentials && f.statuses == nil {
+		return nil, nil
+	}
+	return f, nil
+}
+
+// matches returns whether the validator passes the filter at the epoch. A nil validator has no
+// deposit processed by the beacon node, and matches no filter.
+func (f *accountFilter) matches(v *ethpb.Validator, epoch uint64) bool {
+	if v == nil {
+		return false
+	}
+	if f.blsCredentials || f.eth1Credentials {
+		kind := withdrawalCredentialsKind(v.WithdrawalCredentials)
+		bls := f.blsCredentials && kind == BLSWithdrawalCredentials
+		eth1 := f.eth1Credentials && kind == ExecutionAddressWithdrawalCredentials
+		if !bls && !eth1 {
+			return false
+		}
+	}
+	if f.statuses != nil && !f.statuses[validatorStatus(v, epoch)] {
+		return false
+	}
+	return true
+}
+
+// validatorStatus returns whether the validator is pending activation, active or exited at the epoch.
+func validatorStatus(v *ethpb.Validator, epoch uint64) string {
+	switch {
+	case epoch < v.ActivationEpoch:
+		return validatorStatusPending
+	case epoch < v.ExitEpoch:
+		return validatorStatusActive
+	default:
+		return validatorStatusExited
+	}
+}
+
+// filter returns the public keys whose validators pass the filter, in their order.
+func (f *accountFilter) filter(ctx context.Context, client ethpb.BeaconChainClient, pubKeys [][48]byte) ([][48]byte, error) {
+	validators, epoch, err := fetchValidators(ctx, client, pubKeys)
+	if err != nil {
+		return nil, err
+	}
+	filtered := make([][48]byte, 0, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		var v *ethpb.Validator
+		if container, ok := validators[pubKey]; ok {
+			v = container.Validator
+		}
+		if f.matches(v, epoch) {
+			filtered = append(filtered, pubKey)
+		}
+	}
+	return filtered, nil
+}
+
+// filterAccountsCli returns the public keys whose validators pass the account filter flags. The
+// beacon node is only queried when a filter is set, otherwise all the public keys are returned.
+func filterAccountsCli(cliCtx *cli.Context, pubKeys [][48]byte) ([][48]byte, error) {
+	f, err := accountFilterFromCli(cliCtx)
+	if err != nil {
+		return nil, err
+	}
+	if f == nil {
+		return pubKeys, nil
+	}
+	conn, err := dialBeaconNode(cliCtx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			log.WithError(err).Error("Could not close connection to beacon node")
+		}
+	}()
+	filtered, err := f.filter(cliCtx.Context, ethpb.NewBeaconChainClient(conn), pubKeys)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not filter accounts by their validators")
+	}
+	log.WithFields(logrus.Fields{
+		"accounts": len(pubKeys),
+		"matching": len(filtered),
+	}).Info("Filtered accounts by the validator records of the beacon node")
+	if len(filtered) == 0 {
+		return nil, errors.New("no accounts match the filters")
+	}
+	return filtered, nil
+}
+
+// fetchValidators returns the validators of the public keys in the state of the beacon node, and
+// the epoch of the state. Keys without a deposit processed by the beacon node are left out.
+func fetchValidators(
+	ctx context.Context, client ethpb.BeaconChainClient, pubKeys [][48]byte,
+) (map[[48]byte]*ethpb.Validators_ValidatorContainer, uint64, error) {
+	req := &ethpb.ListValidatorsRequest{PublicKeys: make([][]byte, len(pubKeys))}
+	for i := range pubKeys {
+		req.PublicKeys[i] = pubKeys[i][:]
+	}
+	byPubKey := make(map[[48]byte]*ethpb.Validators_ValidatorContainer, len(pubKeys))
+	var epoch uint64
+	for {
+		resp, err := client.ListValidators(ctx, req)
+		if err != nil {
+			return nil, 0, errors.Wrap(err, "could not list validators")
+		}
+		epoch = resp.Epoch
+		for _, v := range resp.ValidatorList {
+			byPubKey[bytesutil.ToBytes48(v.Validator.PublicKey)] = v
+		}
+		if resp.NextPageToken == "" {
+			break
+		}
+		req.PageToken = resp.NextPageToken
+	}
+	return byPubKey, epoch, nil
+}
@@ -0,0 +1,99 @@
+package accounts
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/flags"
+	"github.com/urfave/cli/v2"
+)
+
+func TestAccountFilterFromCli(t *testing.T) {
+	newCliCtx := func(blsCredentials bool, statuses ...string) *cli.Context {
+		set := flag.NewFlagSet("test", 0)
+		set.Bool(flags.WithBLSCredentialsFlag.Name, blsCredentials, "")
+		statusFlag := cli.NewStringSlice(statuses...)
+		set.Var(statusFlag, flags.ValidatorStatusFilterFlag.Name, "")
+		return cli.NewContext(&cli.App{}, set, nil)
+	}
+
+	f, err := accountFilterFromCli(newCliCtx(false))
+	require.NoError(t, err)
+	assert.Equal(t, (*accountFilter)(nil), f, "Expected no filter without filter flags")
+
+	f, err = accountFilterFromCli(newCliCtx(true, "Active", "pending,exited"))
+	require.NoError(t, err)
+	assert.DeepEqual(t, &accountFilter{
+		blsCredentials: true,
+		statuses: map[string]bool{
+			validatorStatusActive:  true,
+			validatorStatusPending: true,
+			validatorStatusExited:  true,
+		},
+	}, f)
+
+	_, err = accountFilterFromCli(newCliCtx(false, "slashed"))
+	assert.ErrorContains(t, "unsupported status", err)
+}
+
+func TestAccountFilter_Filter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconChainClient(ctrl)
+
+	blsCreds := blsWithdrawalCredentials(bytes.Repeat([]byte{'w'}, 48))
+	eth1Creds := append([]byte{eth1AddressWithdrawalPrefixByte}, make([]byte, 31)...)
+	farFuture := params.BeaconConfig().FarFutureEpoch
+	pending, active, exited, unknown := [48]byte{1}, [48]byte{2}, [48]byte{3}, [48]byte{4}
+	pubKeys := [][48]byte{pending, active, exited, unknown}
+	client.EXPECT().ListValidators(gomock.Any(), gomock.Any()).Return(&ethpb.Validators{
+		Epoch: 10,
+		ValidatorList: []*ethpb.Validators_ValidatorContainer{
+			{Validator: &ethpb.Validator{PublicKey: pending[:], WithdrawalCredentials: blsCreds[:], ActivationEpoch: farFuture, ExitEpoch: farFuture}},
+			{Validator: &ethpb.Validator{PublicKey: active[:], WithdrawalCredentials: eth1Creds, ActivationEpoch: 2, ExitEpoch: farFuture}},
+			{Validator: &ethpb.Validator{PublicKey: exited[:], WithdrawalCredentials: blsCreds[:], ActivationEpoch: 2, ExitEpoch: 10}},
+		},
+	}, nil).Times(4)
+
+	tests := []struct {
+		name   string
+		filter *accountFilter
+		want   [][48]byte
+	}{
+		{
+			name:   "BLS credentials",
+			filter: &accountFilter{blsCredentials: true},
+			want:   [][48]byte{pending, exited},
+		},
+		{
+			name:   "either credentials",
+			filter: &accountFilter{blsCredentials: true, eth1Credentials: true},
+			want:   [][48]byte{pending, active, exited},
+		},
+		{
+			name:   "active or pending",
+			filter: &accountFilter{statuses: map[string]bool{validatorStatusActive: true, validatorStatusPending: true}},
+			want:   [][48]byte{pending, active},
+		},
+		{
+			name:   "exited with eth1 credentials",
+			filter: &accountFilter{eth1Credentials: true, statuses: map[string]bool{validatorStatusExited: true}},
+			want:   [][48]byte{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filtered, err := tt.filter.filter(context.Background(), client, pubKeys)
+			require.NoError(t, err)
+			assert.DeepEqual(t, tt.want, filtered)
+		})
+	}
+}
@@ -7,9 +7,47 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	vdb "github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
 	"github.com/urfave/cli/v2"
 )
 
+// openValidatorDB opens the validator database from the same directory as the validator client
+// does, enabling its encryption when configured.
+func openValidatorDB(cliCtx *cli.Context, w *wallet.Wallet) (*kv.Store, error) {
+	dataDir := w.AccountsDir()
+	if cliCtx.IsSet(cmd.DataDirFlag.Name) {
+		dataDir = cliCtx.String(cmd.DataDirFlag.Name)
+	}
+	valDB, err := kv.NewKVStore(dataDir, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open validator database")
+	}
+	if err := enableValidatorDBEncryption(cliCtx, w, valDB); err != nil {
+		if closeErr := valDB.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Could not close validator database")
+		}
+		return nil, err
+	}
+	return valDB, nil
+}
+
+func enableValidatorDBEncryption(cliCtx *cli.Context, w *wallet.Wallet, valDB *kv.Store) error {
+	secret, err := vdb.EncryptionSecret(cliCtx, w.Password())
+	if err != nil {
+		return err
+	}
+	if secret == nil {
+		return nil
+	}
+	if err := valDB.EnableEncryption(secret); err != nil {
+		return errors.Wrap(err, "could not enable database encryption")
+	}
+	return nil
+}
+
 func filterPublicKeysFromUserInput(
 	cliCtx *cli.Context,
 	publicKeysFlag *cli.StringFlag,
@@ -20,6 +20,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/promptutil"
 	"github.com/prysmaticlabs/prysm/validator/accounts/prompt"
 	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	vdb "github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/prysmaticlabs/prysm/validator/flags"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
@@ -71,6 +72,8 @@ type ImportAccountsConfig struct {
 	Keystores       []*keymanager.Keystore
 	Keymanager      *imported.Keymanager
 	AccountPassword string
+	// ValidatorDB, when set, clears the tombstones of imported accounts which were deleted before.
+	ValidatorDB vdb.Database
 }
 
 // ImportAccountsCli can import external, EIP-2335 compliant keystore.json files as
@@ -107,11 +110,20 @@ func ImportAccountsCli(cliCtx *cli.Context) error {
 	if !ok {
 		return errors.New("Only imported wallets can import more keystores")
 	}
+	valDB, err := openValidatorDB(cliCtx, w)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := valDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close validator database")
+		}
+	}()
 
 	// Check if the user wishes to import a one-off, private key directly
 	// as an account into the Prysm validator.
 	if cliCtx.IsSet(flags.ImportPrivateKeyFileFlag.Name) {
-		return importPrivateKeyAsAccount(cliCtx, w, k)
+		return importPrivateKeyAsAccount(cliCtx, w, k, valDB)
 	}
 
 	keysDir, err := prompt.InputDirectory(cliCtx, prompt.ImportKeysDirPromptText, flags.KeysDirFlag)
@@ -124,6 +136,8 @@ func ImportAccountsCli(cliCtx *cli.Context) error {
 		return errors.Wrap(err, "could not determine if path is a directory")
 	}
 	keystoresImported := make([]*keymanager.Keystore, 0)
+	derivationPaths := make(map[*keymanager.Keystore]string)
+	depositData := make(map[[48]byte]json.RawMessage)
 	if isDir {
 		files, err := ioutil.ReadDir(keysDir)
 		if err != nil {
@@ -150,6 +164,13 @@ func ImportAccountsCli(cliCtx *cli.Context) error {
 				return errors.Wrapf(err, "could not import keystore at path: %s", name)
 			}
 			keystoresImported = append(keystoresImported, keystore)
+			if path := derivationPathRegex.FindString(name); path != "" {
+				derivationPaths[keystore] = strings.ReplaceAll(path, "_", "/")
+			}
+		}
+		depositData, err = readDepositData(keysDir)
+		if err != nil {
+			return err
 		}
 	} else {
 		keystore, err := readKeystoreFile(cliCtx.Context, keysDir)
@@ -180,9 +201,27 @@ func ImportAccountsCli(cliCtx *cli.Context) error {
 		Keymanager:      k,
 		Keystores:       keystoresImported,
 		AccountPassword: accountsPassword,
+		ValidatorDB:     valDB,
 	}); err != nil {
 		return err
 	}
+	tags := accountTags(cliCtx)
+	metadata := make(map[[48]byte]*accountMetadata, len(keystoresImported))
+	for _, keystore := range keystoresImported {
+		pubKey, err := hex.DecodeString(strings.TrimPrefix(keystore.Pubkey, "0x"))
+		if err != nil {
+			return errors.Wrap(err, "could not decode public key of keystore")
+		}
+		pk := bytesutil.ToBytes48(pubKey)
+		metadata[pk] = &accountMetadata{
+			DerivationPath: derivationPaths[keystore],
+			Tags:           tags,
+			DepositData:    depositData[pk],
+		}
+	}
+	if err := recordAccountsMetadata(cliCtx.Context, w, metadata); err != nil {
+		return errors.Wrap(err, "could not record metadata of imported accounts")
+	}
 	fmt.Printf(
 		"Successfully imported %s accounts, view all of them by running accounts list\n",
 		au.BrightMagenta(strconv.Itoa(len(keystoresImported))),
@@ -193,16 +232,44 @@ func ImportAccountsCli(cliCtx *cli.Context) error {
 // ImportAccounts can import external, EIP-2335 compliant keystore.json files as
 // new accounts into the Prysm validator wallet.
 func ImportAccounts(ctx context.Context, cfg *ImportAccountsConfig) error {
-	return cfg.Keymanager.ImportKeystores(
+	if err := cfg.Keymanager.ImportKeystores(
 		ctx,
 		cfg.Keystores,
 		cfg.AccountPassword,
-	)
+	); err != nil {
+		return err
+	}
+	if cfg.ValidatorDB == nil {
+		return nil
+	}
+	pubKeys := make([][48]byte, len(cfg.Keystores))
+	for i, keystore := range cfg.Keystores {
+		pubKey, err := hex.DecodeString(strings.TrimPrefix(keystore.Pubkey, "0x"))
+		if err != nil {
+			return errors.Wrap(err, "could not decode public key of keystore")
+		}
+		pubKeys[i] = bytesutil.ToBytes48(pubKey)
+	}
+	if err := cfg.ValidatorDB.ClearDeletedPublicKeys(ctx, pubKeys); err != nil {
+		return errors.Wrap(err, "could not clear deleted accounts")
+	}
+	return nil
+}
+
+// accountTags parses the tags of imported accounts.
+func accountTags(cliCtx *cli.Context) []string {
+	var tags []string
+	for _, tag := range strings.Split(cliCtx.String(flags.AccountTagsFlag.Name), ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
 }
 
 // Imports a one-off file containing a private key as a hex string into
 // the Prysm validator's accounts.
-func importPrivateKeyAsAccount(cliCtx *cli.Context, wallet *wallet.Wallet, km *imported.Keymanager) error {
+func importPrivateKeyAsAccount(cliCtx *cli.Context, wallet *wallet.Wallet, km *imported.Keymanager, valDB vdb.Database) error {
 	privKeyFile := cliCtx.String(flags.ImportPrivateKeyFileFlag.Name)
 	fullPath, err := fileutil.ExpandPath(privKeyFile)
 	if err != nil {
@@ -239,10 +306,16 @@ func importPrivateKeyAsAccount(cliCtx *cli.Context, wallet *wallet.Wallet, km *i
 			Keymanager:      km,
 			AccountPassword: wallet.Password(),
 			Keystores:       []*keymanager.Keystore{keystore},
+			ValidatorDB:     valDB,
 		},
 	); err != nil {
 		return errors.Wrap(err, "could not import keystore into wallet")
 	}
+	if err := recordAccountsMetadata(cliCtx.Context, wallet, map[[48]byte]*accountMetadata{
+		bytesutil.ToBytes48(privKey.PublicKey().Marshal()): {Tags: accountTags(cliCtx)},
+	}); err != nil {
+		return errors.Wrap(err, "could not record metadata of imported account")
+	}
 	fmt.Printf(
 		"Imported account with public key %#x, view all accounts by running accounts list\n",
 		au.BrightMagenta(bytesutil.Trunc(privKey.PublicKey().Marshal())),
@@ -374,7 +374,7 @@ func Test_importPrivateKeyAsAccount(t *testing.T) {
 		},
 	)
 	require.NoError(t, err)
-	assert.NoError(t, importPrivateKeyAsAccount(cliCtx, wallet, keymanager))
+	assert.NoError(t, importPrivateKeyAsAccount(cliCtx, wallet, keymanager, nil /* valDB */))
 
 	// We re-instantiate the keymanager and check we now have 1 public key.
 	keymanager, err = imported.NewKeymanager(
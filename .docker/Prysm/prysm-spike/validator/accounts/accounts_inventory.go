@@ -0,0 +1,204 @@
+package accounts
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/petnames"
+	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/derived"
+)
+
+// Formats of the accounts list.
+const (
+	accountsOutputText = "text"
+	accountsOutputJSON = "json"
+	accountsOutputCSV  = "csv"
+)
+
+// verificationRoot is the message signed to verify the key of an account.
+var verificationRoot = [32]byte{'p', 'r', 'y', 's', 'm'}
+
+// accountsInventory is the machine-readable listing of the accounts of a wallet.
+type accountsInventory struct {
+	KeymanagerKind string                   `json:"keymanager_kind"`
+	Accounts       []*accountInventoryEntry `json:"accounts"`
+}
+
+type accountInventoryEntry struct {
+	Index          int             `json:"index"`
+	Name           string          `json:"name"`
+	PublicKey      string          `json:"public_key"`
+	PrivateKey     string          `json:"private_key,omitempty"`
+	Enabled        bool            `json:"enabled"`
+	CreatedAt      string          `json:"created_at,omitempty"`
+	DerivationPath string          `json:"derivation_path,omitempty"`
+	Tags           []string        `json:"tags"`
+	DepositData    json.RawMessage `json:"deposit_data,omitempty"`
+	// KeystoreValid is only set when keystores are verified.
+	KeystoreValid *bool  `json:"keystore_valid,omitempty"`
+	KeystoreError string `json:"keystore_error,omitempty"`
+}
+
+type inventoryOpts struct {
+	showDepositData bool
+	showPrivateKeys bool
+	verifyKeystores bool
+	// selected accounts are the only ones listed, all accounts are listed when nil.
+	selected map[[48]byte]bool
+}
+
+// accountsInventoryFor lists the accounts of a wallet along with their metadata.
+func accountsInventoryFor(
+	ctx context.Context, w *wallet.Wallet, km keymanager.IKeymanager, opts *inventoryOpts,
+) (*accountsInventory, error) {
+	allPubKeys, err := km.FetchAllValidatingPublicKeys(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not fetch validating public keys")
+	}
+	enabledPubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not fetch validating public keys")
+	}
+	enabled := make(map[[48]byte]bool, len(enabledPubKeys))
+	for _, pk := range enabledPubKeys {
+		enabled[pk] = true
+	}
+	privateKeys := make(map[[48]byte][32]byte)
+	if opts.showPrivateKeys {
+		privateKeysFetcher, ok := km.(interface {
+			FetchValidatingPrivateKeys(ctx context.Context) ([][32]byte, error)
+		})
+		if !ok {
+			return nil, errors.New("private keys are not held by the wallet of a remote keymanager")
+		}
+		keys, err := privateKeysFetcher.FetchValidatingPrivateKeys(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not fetch private keys")
+		}
+		// Private keys are fetched for the enabled accounts.
+		for i := 0; i < len(keys) && i < len(enabledPubKeys); i++ {
+			privateKeys[enabledPubKeys[i]] = keys[i]
+		}
+	}
+	metadata, err := readAccountsMetadata(ctx, w)
+	if err != nil {
+		return nil, err
+	}
+
+	inventory := &accountsInventory{
+		KeymanagerKind: w.KeymanagerKind().String(),
+		Accounts:       make([]*accountInventoryEntry, 0, len(allPubKeys)),
+	}
+	for i, pubKey := range allPubKeys {
+		if opts.selected != nil && !opts.selected[pubKey] {
+			continue
+		}
+		entry := &accountInventoryEntry{
+			Index:     i,
+			Name:      petnames.DeterministicName(pubKey[:], "-"),
+			PublicKey: fmt.Sprintf("%#x", pubKey),
+			Enabled:   enabled[pubKey],
+			Tags:      []string{},
+		}
+		if key, ok := privateKeys[pubKey]; ok {
+			entry.PrivateKey = fmt.Sprintf("%#x", key)
+		}
+		if m, ok := metadata[entry.PublicKey]; ok {
+			entry.CreatedAt = time.Unix(m.CreatedAt, 0).UTC().Format(time.RFC3339)
+			entry.DerivationPath = m.DerivationPath
+			if len(m.Tags) > 0 {
+				entry.Tags = m.Tags
+			}
+			if opts.showDepositData {
+				entry.DepositData = m.DepositData
+			}
+		}
+		if w.KeymanagerKind() == keymanager.Derived {
+			entry.DerivationPath = fmt.Sprintf(derived.ValidatingKeyDerivationPathTemplate, i)
+		}
+		// The keys of remote keymanagers are held by the remote signer.
+		if opts.verifyKeystores && w.KeymanagerKind() != keymanager.Remote && w.KeymanagerKind() != keymanager.Web3Signer {
+			valid := true
+			if err := verifyAccountKey(ctx, km, pubKey); err != nil {
+				valid = false
+				entry.KeystoreError = err.Error()
+			}
+			entry.KeystoreValid = &valid
+		}
+		inventory.Accounts = append(inventory.Accounts, entry)
+	}
+	return inventory, nil
+}
+
+// verifyAccountKey checks the key of an account decrypted from the wallet signs for its public key.
+func verifyAccountKey(ctx context.Context, km keymanager.IKeymanager, pubKey [48]byte) error {
+	sig, err := km.Sign(ctx, &validatorpb.SignRequest{
+		PublicKey:   pubKey[:],
+		SigningRoot: verificationRoot[:],
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not sign with the account key")
+	}
+	pk, err := bls.PublicKeyFromBytes(pubKey[:])
+	if err != nil {
+		return errors.Wrap(err, "invalid public key")
+	}
+	if !sig.Verify(pk, verificationRoot[:]) {
+		return errors.New("the account key does not match its public key")
+	}
+	return nil
+}
+
+// writeAccountsInventory writes an inventory in the json or csv format.
+func writeAccountsInventory(out io.Writer, inventory *accountsInventory, format string) error {
+	switch format {
+	case accountsOutputJSON:
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(inventory)
+	case accountsOutputCSV:
+		writer := csv.NewWriter(out)
+		if err := writer.Write([]string{
+			"index", "name", "public_key", "private_key", "enabled", "created_at", "derivation_path", "tags",
+			"deposit_data", "keystore_valid", "keystore_error",
+		}); err != nil {
+			return err
+		}
+		for _, a := range inventory.Accounts {
+			keystoreValid := ""
+			if a.KeystoreValid != nil {
+				keystoreValid = strconv.FormatBool(*a.KeystoreValid)
+			}
+			if err := writer.Write([]string{
+				strconv.Itoa(a.Index),
+				a.Name,
+				a.PublicKey,
+				a.PrivateKey,
+				strconv.FormatBool(a.Enabled),
+				a.CreatedAt,
+				a.DerivationPath,
+				strings.Join(a.Tags, ";"),
+				string(a.DepositData),
+				keystoreValid,
+				a.KeystoreError,
+			}); err != nil {
+				return err
+			}
+		}
+		writer.Flush()
+		return writer.Error()
+	default:
+		return fmt.Errorf("unsupported output format %q", format)
+	}
+}
@@ -0,0 +1,146 @@
+package accounts
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
+)
+
+func TestAccountsInventory_ImportedKeymanager(t *testing.T) {
+	walletDir, passwordsDir, walletPasswordFile := setupWalletAndPasswordsDir(t)
+	cliCtx := setupWalletCtx(t, &testWalletConfig{
+		walletDir:          walletDir,
+		passwordsDir:       passwordsDir,
+		keymanagerKind:     keymanager.Imported,
+		walletPasswordFile: walletPasswordFile,
+	})
+	w, err := CreateWalletWithKeymanager(cliCtx.Context, &CreateWalletConfig{
+		WalletCfg: &wallet.Config{
+			WalletDir:      walletDir,
+			KeymanagerKind: keymanager.Imported,
+			WalletPassword: "Passwordz0320$",
+		},
+	})
+	require.NoError(t, err)
+	km, err := imported.NewKeymanager(cliCtx.Context, &imported.SetupConfig{Wallet: w})
+	require.NoError(t, err)
+	keystores := []*keymanager.Keystore{createRandomKeystore(t, password), createRandomKeystore(t, password)}
+	require.NoError(t, km.ImportKeystores(cliCtx.Context, keystores, password))
+	pubKeys, err := km.FetchAllValidatingPublicKeys(cliCtx.Context)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(pubKeys))
+
+	depositData := json.RawMessage(fmt.Sprintf(`{"pubkey":"%x","amount":32000000000}`, pubKeys[0]))
+	require.NoError(t, recordAccountsMetadata(cliCtx.Context, w, map[[48]byte]*accountMetadata{
+		pubKeys[0]: {DerivationPath: "m/12381/3600/0/0/0", Tags: []string{"node-a", "mainnet"}, DepositData: depositData},
+	}))
+	// Recording an account again keeps its creation time and metadata.
+	metadata, err := readAccountsMetadata(cliCtx.Context, w)
+	require.NoError(t, err)
+	createdAt := metadata[fmt.Sprintf("%#x", pubKeys[0])].CreatedAt
+	require.NoError(t, recordAccountsMetadata(cliCtx.Context, w, map[[48]byte]*accountMetadata{pubKeys[0]: {}}))
+	metadata, err = readAccountsMetadata(cliCtx.Context, w)
+	require.NoError(t, err)
+	assert.Equal(t, createdAt, metadata[fmt.Sprintf("%#x", pubKeys[0])].CreatedAt)
+	assert.DeepEqual(t, []string{"node-a", "mainnet"}, metadata[fmt.Sprintf("%#x", pubKeys[0])].Tags)
+
+	inventory, err := accountsInventoryFor(cliCtx.Context, w, km, &inventoryOpts{
+		showDepositData: true,
+		verifyKeystores: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, keymanager.Imported.String(), inventory.KeymanagerKind)
+	require.Equal(t, 2, len(inventory.Accounts))
+
+	var buf bytes.Buffer
+	require.NoError(t, writeAccountsInventory(&buf, inventory, accountsOutputJSON))
+	decoded := &accountsInventory{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), decoded))
+	first := decoded.Accounts[0]
+	assert.Equal(t, fmt.Sprintf("%#x", pubKeys[0]), first.PublicKey)
+	assert.Equal(t, true, first.Enabled)
+	assert.Equal(t, "m/12381/3600/0/0/0", first.DerivationPath)
+	assert.DeepEqual(t, []string{"node-a", "mainnet"}, first.Tags)
+	assert.NotEqual(t, "", first.CreatedAt)
+	assert.Equal(t, true, bytes.Contains(first.DepositData, []byte("32000000000")), "Missing deposit data")
+	require.NotNil(t, first.KeystoreValid)
+	assert.Equal(t, true, *first.KeystoreValid)
+	assert.Equal(t, "", first.PrivateKey)
+	second := decoded.Accounts[1]
+	assert.Equal(t, "", second.CreatedAt)
+	assert.DeepEqual(t, []string{}, second.Tags)
+
+	buf.Reset()
+	require.NoError(t, writeAccountsInventory(&buf, inventory, accountsOutputCSV))
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Equal(t, 3, len(records))
+	assert.Equal(t, "public_key", records[0][2])
+	assert.Equal(t, fmt.Sprintf("%#x", pubKeys[1]), records[2][2])
+	assert.Equal(t, "node-a;mainnet", records[1][7])
+	assert.Equal(t, "true", records[1][9])
+
+	// Metadata of deleted accounts is removed.
+	require.NoError(t, deleteAccountsMetadata(cliCtx.Context, w, [][]byte{pubKeys[0][:]}))
+	metadata, err = readAccountsMetadata(cliCtx.Context, w)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(metadata))
+}
+
+// wrongKeySigner signs with a key other than the one of the requested public key.
+type wrongKeySigner struct {
+	mockRemoteKeymanager
+	key bls.SecretKey
+}
+
+func (s *wrongKeySigner) Sign(_ context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+	return s.key.Sign(req.SigningRoot), nil
+}
+
+func TestVerifyAccountKey(t *testing.T) {
+	km, err := imported.NewInteropKeymanager(context.Background(), 0, 1)
+	require.NoError(t, err)
+	pubKeys, err := km.FetchAllValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, verifyAccountKey(context.Background(), km, pubKeys[0]))
+
+	key, err := bls.RandKey()
+	require.NoError(t, err)
+	assert.ErrorContains(
+		t, "does not match its public key", verifyAccountKey(context.Background(), &wrongKeySigner{key: key}, pubKeys[0]),
+	)
+}
+
+func TestReadDepositData(t *testing.T) {
+	dir := t.TempDir()
+	pubKey := bytes.Repeat([]byte{0xab}, 48)
+	require.NoError(t, ioutil.WriteFile(
+		filepath.Join(dir, "deposit_data-1606824023.json"),
+		[]byte(fmt.Sprintf("[\n  {\"pubkey\": \"%x\", \"amount\": 32000000000}\n]", pubKey)),
+		0600,
+	))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "keystore-m_12381_3600_0_0_0.json"), []byte("{}"), 0600))
+	depositData, err := readDepositData(dir)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(depositData))
+	var key [48]byte
+	copy(key[:], pubKey)
+	assert.Equal(t, fmt.Sprintf(`{"pubkey":"%x","amount":32000000000}`, pubKey), string(depositData[key]))
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "deposit_data-2.json"), []byte(`[{"pubkey":"0x01"}]`), 0600))
+	_, err = readDepositData(dir)
+	assert.ErrorContains(t, "invalid public key", err)
+}
@@ -3,6 +3,7 @@ package accounts
 import (
 	"context"
 	"fmt"
+	"os"
 	"path/filepath"
 	"strings"
 
@@ -16,6 +17,7 @@ import (
 	"github.com/prysmaticlabs/prysm/validator/keymanager/derived"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/remote"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/web3signer"
 	"github.com/urfave/cli/v2"
 )
 
@@ -34,15 +36,39 @@ func ListAccountsCli(cliCtx *cli.Context) error {
 	if err != nil {
 		return errors.Wrap(err, "could not initialize keymanager")
 	}
+	selected, err := selectedAccountsCli(cliCtx, km)
+	if err != nil {
+		return err
+	}
 	showDepositData := cliCtx.Bool(flags.ShowDepositDataFlag.Name)
 	showPrivateKeys := cliCtx.Bool(flags.ShowPrivateKeysFlag.Name)
+	verifyKeystores := cliCtx.Bool(flags.VerifyKeystoresFlag.Name)
+	switch output := strings.ToLower(cliCtx.String(flags.AccountsListOutputFlag.Name)); output {
+	case accountsOutputText, "":
+	case accountsOutputJSON, accountsOutputCSV:
+		inventory, err := accountsInventoryFor(cliCtx.Context, w, km, &inventoryOpts{
+			showDepositData: showDepositData,
+			showPrivateKeys: showPrivateKeys,
+			verifyKeystores: verifyKeystores,
+			selected:        selected,
+		})
+		if err != nil {
+			return errors.Wrap(err, "could not list validator accounts")
+		}
+		return writeAccountsInventory(os.Stdout, inventory, output)
+	default:
+		return fmt.Errorf(
+			"unsupported output format %q, expected %s, %s or %s",
+			output, accountsOutputText, accountsOutputJSON, accountsOutputCSV,
+		)
+	}
 	switch w.KeymanagerKind() {
 	case keymanager.Imported:
 		km, ok := km.(*imported.Keymanager)
 		if !ok {
 			return errors.New("could not assert keymanager interface to concrete type")
 		}
-		if err := listImportedKeymanagerAccounts(cliCtx.Context, showDepositData, showPrivateKeys, km); err != nil {
+		if err := listImportedKeymanagerAccounts(cliCtx.Context, showDepositData, showPrivateKeys, km, selected); err != nil {
 			return errors.Wrap(err, "could not list validator accounts with imported keymanager")
 		}
 	case keymanager.Derived:
@@ -50,7 +76,7 @@ func ListAccountsCli(cliCtx *cli.Context) error {
 		if !ok {
 			return errors.New("could not assert keymanager interface to concrete type")
 		}
-		if err := listDerivedKeymanagerAccounts(cliCtx.Context, showPrivateKeys, km); err != nil {
+		if err := listDerivedKeymanagerAccounts(cliCtx.Context, showPrivateKeys, km, selected); err != nil {
 			return errors.Wrap(err, "could not list validator accounts with derived keymanager")
 		}
 	case keymanager.Remote:
@@ -58,12 +84,78 @@ func ListAccountsCli(cliCtx *cli.Context) error {
 		if !ok {
 			return errors.New("could not assert keymanager interface to concrete type")
 		}
-		if err := listRemoteKeymanagerAccounts(cliCtx.Context, w, km, km.KeymanagerOpts()); err != nil {
+		if err := listRemoteKeymanagerAccounts(cliCtx.Context, w, km, km.KeymanagerOpts(), selected); err != nil {
 			return errors.Wrap(err, "could not list validator accounts with remote keymanager")
 		}
+	case keymanager.Web3Signer:
+		km, ok := km.(*web3signer.Keymanager)
+		if !ok {
+			return errors.New("could not assert keymanager interface to concrete type")
+		}
+		if err := listRemoteKeymanagerAccounts(cliCtx.Context, w, km, km.KeymanagerOpts(), selected); err != nil {
+			return errors.Wrap(err, "could not list validator accounts with web3signer keymanager")
+		}
 	default:
 		return fmt.Errorf("keymanager kind %s not yet supported", w.KeymanagerKind().String())
 	}
+	if verifyKeystores {
+		return printKeystoreVerification(cliCtx.Context, w, km, selected)
+	}
+	return nil
+}
+
+// selectedAccountsCli returns the accounts whose validators pass the account filter flags, or nil
+// when all accounts are listed.
+func selectedAccountsCli(cliCtx *cli.Context, km keymanager.IKeymanager) (map[[48]byte]bool, error) {
+	pubKeys, err := km.FetchAllValidatingPublicKeys(cliCtx.Context)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not fetch validating public keys")
+	}
+	filtered, err := filterAccountsCli(cliCtx, pubKeys)
+	if err != nil {
+		return nil, err
+	}
+	if len(filtered) == len(pubKeys) {
+		return nil, nil
+	}
+	selected := make(map[[48]byte]bool, len(filtered))
+	for _, pubKey := range filtered {
+		selected[pubKey] = true
+	}
+	return selected, nil
+}
+
+// listedCount returns how many of the accounts of the wallet are listed.
+func listedCount(numAccounts int, selected map[[48]byte]bool) int {
+	if selected == nil {
+		return numAccounts
+	}
+	return len(selected)
+}
+
+// printKeystoreVerification verifies the key of each account of the wallet, and prints the result.
+func printKeystoreVerification(ctx context.Context, w *wallet.Wallet, km keymanager.IKeymanager, selected map[[48]byte]bool) error {
+	if w.KeymanagerKind() == keymanager.Remote || w.KeymanagerKind() == keymanager.Web3Signer {
+		fmt.Println("Keystores of a remote keymanager are held by the remote signer, skipping their verification")
+		return nil
+	}
+	inventory, err := accountsInventoryFor(ctx, w, km, &inventoryOpts{verifyKeystores: true, selected: selected})
+	if err != nil {
+		return errors.Wrap(err, "could not verify keystores")
+	}
+	fmt.Printf("%s\n", au.BrightGreen("Keystore verification").Bold())
+	failed := 0
+	for _, a := range inventory.Accounts {
+		if a.KeystoreValid != nil && *a.KeystoreValid {
+			fmt.Printf("%s %s\n", a.PublicKey, au.BrightGreen("ok"))
+			continue
+		}
+		failed++
+		fmt.Printf("%s %s: %s\n", a.PublicKey, au.BrightRed("failed"), a.KeystoreError)
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d keystores failed verification", failed, len(inventory.Accounts))
+	}
 	return nil
 }
 
@@ -72,16 +164,17 @@ func listImportedKeymanagerAccounts(
 	showDepositData,
 	showPrivateKeys bool,
 	keymanager *imported.Keymanager,
+	selected map[[48]byte]bool,
 ) error {
 	// We initialize the wallet's keymanager.
 	accountNames, err := keymanager.ValidatingAccountNames()
 	if err != nil {
 		return errors.Wrap(err, "could not fetch account names")
 	}
-	numAccounts := au.BrightYellow(len(accountNames))
+	numAccounts := au.BrightYellow(listedCount(len(accountNames), selected))
 	fmt.Printf("(keymanager kind) %s\n", au.BrightGreen("imported wallet").Bold())
 	fmt.Println("")
-	if len(accountNames) == 1 {
+	if listedCount(len(accountNames), selected) == 1 {
 		fmt.Printf("Showing %d validator account\n", numAccounts)
 	} else {
 		fmt.Printf("Showing %d validator accounts\n", numAccounts)
@@ -108,6 +201,9 @@ func listImportedKeymanagerAccounts(
 		}
 	}
 	for i := 0; i < len(accountNames); i++ {
+		if selected != nil && !selected[pubKeys[i]] {
+			continue
+		}
 		fmt.Println("")
 		if existingDisabledPk[pubKeys[i]] {
 			fmt.Printf("%s | %s (%s)\n", au.BrightBlue(fmt.Sprintf("Account %d", i)).Bold(), au.BrightRed(accountNames[i]).Bold(), au.BrightRed("disabled").Bold())
@@ -136,6 +232,7 @@ func listDerivedKeymanagerAccounts(
 	ctx context.Context,
 	showPrivateKeys bool,
 	keymanager *derived.Keymanager,
+	selected map[[48]byte]bool,
 ) error {
 	au := aurora.NewAurora(true)
 	fmt.Printf("(keymanager kind) %s\n", au.BrightGreen("derived, (HD) hierarchical-deterministic").Bold())
@@ -155,15 +252,19 @@ func listDerivedKeymanagerAccounts(
 	if err != nil {
 		return err
 	}
-	if len(accountNames) == 1 {
+	numAccounts := listedCount(len(accountNames), selected)
+	if numAccounts == 1 {
 		fmt.Print("Showing 1 validator account\n")
-	} else if len(accountNames) == 0 {
+	} else if numAccounts == 0 {
 		fmt.Print("No accounts found\n")
 		return nil
 	} else {
-		fmt.Printf("Showing %d validator accounts\n", len(accountNames))
+		fmt.Printf("Showing %d validator accounts\n", numAccounts)
 	}
 	for i := 0; i < len(accountNames); i++ {
+		if selected != nil && !selected[validatingPubKeys[i]] {
+			continue
+		}
 		fmt.Println("")
 		validatingKeyPath := fmt.Sprintf(derived.ValidatingKeyDerivationPathTemplate, i)
 
@@ -184,10 +285,15 @@ func listRemoteKeymanagerAccounts(
 	ctx context.Context,
 	w *wallet.Wallet,
 	keymanager keymanager.IKeymanager,
-	opts *remote.KeymanagerOpts,
+	opts fmt.Stringer,
+	selected map[[48]byte]bool,
 ) error {
 	au := aurora.NewAurora(true)
-	fmt.Printf("(keymanager kind) %s\n", au.BrightGreen("remote signer").Bold())
+	kind := "remote signer"
+	if _, ok := opts.(*web3signer.KeymanagerOpts); ok {
+		kind = "web3signer"
+	}
+	fmt.Printf("(keymanager kind) %s\n", au.BrightGreen(kind).Bold())
 	fmt.Printf(
 		"(configuration file path) %s\n",
 		au.BrightGreen(filepath.Join(w.AccountsDir(), wallet.KeymanagerConfigFileName)).Bold(),
@@ -199,15 +305,19 @@ func listRemoteKeymanagerAccounts(
 	if err != nil {
 		return errors.Wrap(err, "could not fetch validating public keys")
 	}
-	if len(validatingPubKeys) == 1 {
+	numAccounts := listedCount(len(validatingPubKeys), selected)
+	if numAccounts == 1 {
 		fmt.Print("Showing 1 validator account\n")
-	} else if len(validatingPubKeys) == 0 {
+	} else if numAccounts == 0 {
 		fmt.Print("No accounts found\n")
 		return nil
 	} else {
-		fmt.Printf("Showing %d validator accounts\n", len(validatingPubKeys))
+		fmt.Printf("Showing %d validator accounts\n", numAccounts)
 	}
 	for i := 0; i < len(validatingPubKeys); i++ {
+		if selected != nil && !selected[validatingPubKeys[i]] {
+			continue
+		}
 		fmt.Println("")
 		fmt.Printf(
 			"%s\n", au.BrightGreen(petnames.DeterministicName(validatingPubKeys[i][:], "-")).Bold(),
@@ -103,6 +103,7 @@ func TestListAccounts_ImportedKeymanager(t *testing.T) {
 			true, /* show deposit data */
 			true, /*show private keys */
 			km,
+			nil, /* all accounts selected */
 		),
 	)
 
@@ -246,7 +247,7 @@ func TestListAccounts_DerivedKeymanager(t *testing.T) {
 	os.Stdout = writer
 
 	// We call the list imported keymanager accounts function.
-	require.NoError(t, listDerivedKeymanagerAccounts(cliCtx.Context, true, keymanager))
+	require.NoError(t, listDerivedKeymanagerAccounts(cliCtx.Context, true, keymanager, nil))
 
 	require.NoError(t, writer.Close())
 	out, err := ioutil.ReadAll(r)
@@ -389,7 +390,7 @@ func TestListAccounts_RemoteKeymanager(t *testing.T) {
 		},
 	}
 	// We call the list remote keymanager accounts function.
-	require.NoError(t, listRemoteKeymanagerAccounts(context.Background(), w, km, km.opts))
+	require.NoError(t, listRemoteKeymanagerAccounts(context.Background(), w, km, km.opts, nil))
 
 	require.NoError(t, writer.Close())
 	out, err := ioutil.ReadAll(r)
@@ -0,0 +1,86 @@
+package accounts
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/validator/client"
+	"github.com/prysmaticlabs/prysm/validator/flags"
+	"github.com/urfave/cli/v2"
+)
+
+// MaintenanceWindowCli prints the next window in which none of the accounts of the wallet has a
+// proposer or aggregator duty, optionally blocking until the window starts.
+func MaintenanceWindowCli(cliCtx *cli.Context) error {
+	w, err := wallet.OpenWalletOrElseCli(cliCtx, func(cliCtx *cli.Context) (*wallet.Wallet, error) {
+		return nil, wallet.ErrNoWalletFound
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not open wallet")
+	}
+	km, err := w.InitializeKeymanager(cliCtx.Context)
+	if err != nil {
+		return errors.Wrap(err, "could not initialize keymanager")
+	}
+	pubKeys, err := km.FetchValidatingPublicKeys(cliCtx.Context)
+	if err != nil {
+		return err
+	}
+	if len(pubKeys) == 0 {
+		return errors.New("wallet is empty, no accounts to inspect the duties of")
+	}
+
+	conn, err := dialBeaconNode(cliCtx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			log.WithError(err).Error("Could not close connection to beacon node")
+		}
+	}()
+	genesis, err := ethpb.NewNodeClient(conn).GetGenesis(cliCtx.Context, &ptypes.Empty{})
+	if err != nil {
+		return errors.Wrap(err, "could not get genesis")
+	}
+	if genesis.GenesisTime == nil {
+		return errors.New("beacon node has no genesis time")
+	}
+	genesisTime := uint64(genesis.GenesisTime.Seconds)
+
+	find := client.NextMaintenanceWindow
+	if cliCtx.Bool(flags.MaintenanceWindowWaitFlag.Name) {
+		find = client.WaitForMaintenanceWindow
+	}
+	window, err := find(
+		cliCtx.Context,
+		ethpb.NewBeaconNodeValidatorClient(conn),
+		km,
+		pubKeys,
+		genesisTime,
+		cliCtx.Uint64(flags.MaintenanceWindowSlotsFlag.Name),
+	)
+	if err != nil {
+		return err
+	}
+	return writeMaintenanceWindow(os.Stdout, window)
+}
+
+func writeMaintenanceWindow(out io.Writer, window *client.MaintenanceWindow) error {
+	_, err := fmt.Fprintf(
+		out,
+		"Maintenance window from slot %d to slot %d (%d slots), %s to %s\n",
+		window.StartSlot,
+		window.EndSlot,
+		window.EndSlot-window.StartSlot+1,
+		window.StartTime.Format(time.RFC3339),
+		window.EndTime.Format(time.RFC3339),
+	)
+	return err
+}
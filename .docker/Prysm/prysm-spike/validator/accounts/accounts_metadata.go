@@ -0,0 +1,157 @@
+package accounts
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/derived"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
+)
+
+// AccountMetadataFileName is the file of the wallet accounts directory holding the metadata of
+// accounts which the keystores do not record, such as their creation time and tags.
+const AccountMetadataFileName = "account-metadata.json"
+
+// depositDataFilePattern matches the deposit data files written alongside keystores by the
+// eth2.0-deposit-cli.
+const depositDataFilePattern = "deposit_data-*.json"
+
+// accountMetadata of a validator account, keyed by hex encoded public key in the metadata file.
+type accountMetadata struct {
+	// CreatedAt is the unix time the account was created, imported or recovered in the wallet.
+	CreatedAt      int64           `json:"created_at"`
+	DerivationPath string          `json:"derivation_path,omitempty"`
+	Tags           []string        `json:"tags,omitempty"`
+	DepositData    json.RawMessage `json:"deposit_data,omitempty"`
+}
+
+// readAccountsMetadata of a wallet. Wallets without a metadata file have no metadata.
+func readAccountsMetadata(ctx context.Context, w *wallet.Wallet) (map[string]*accountMetadata, error) {
+	metadata := make(map[string]*accountMetadata)
+	encoded, err := w.ReadFileAtPath(ctx, imported.AccountsPath, AccountMetadataFileName)
+	if errors.Is(err, wallet.ErrNoFilesFound) {
+		return metadata, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read accounts metadata")
+	}
+	if err := json.Unmarshal(encoded, &metadata); err != nil {
+		return nil, errors.Wrap(err, "could not decode accounts metadata")
+	}
+	return metadata, nil
+}
+
+func writeAccountsMetadata(ctx context.Context, w *wallet.Wallet, metadata map[string]*accountMetadata) error {
+	encoded, err := json.MarshalIndent(metadata, "", "\t")
+	if err != nil {
+		return err
+	}
+	return w.WriteFileAtPath(ctx, imported.AccountsPath, AccountMetadataFileName, encoded)
+}
+
+// recordAccountsMetadata saves the metadata of new accounts, by public key. Accounts already known
+// keep their creation time, and their other metadata is only replaced when set.
+func recordAccountsMetadata(ctx context.Context, w *wallet.Wallet, accounts map[[48]byte]*accountMetadata) error {
+	metadata, err := readAccountsMetadata(ctx, w)
+	if err != nil {
+		return err
+	}
+	now := timeutils.Now().Unix()
+	for pubKey, m := range accounts {
+		key := fmt.Sprintf("%#x", pubKey)
+		existing, ok := metadata[key]
+		if !ok {
+			existing = &accountMetadata{CreatedAt: now}
+			metadata[key] = existing
+		}
+		if m.DerivationPath != "" {
+			existing.DerivationPath = m.DerivationPath
+		}
+		if len(m.Tags) > 0 {
+			existing.Tags = m.Tags
+		}
+		if len(m.DepositData) > 0 {
+			existing.DepositData = m.DepositData
+		}
+	}
+	return writeAccountsMetadata(ctx, w, metadata)
+}
+
+// recordDerivedAccountsMetadata saves the metadata of the accounts of a derived wallet, which are
+// ordered by their derivation index.
+func recordDerivedAccountsMetadata(ctx context.Context, w *wallet.Wallet, km *derived.Keymanager) error {
+	pubKeys, err := km.FetchAllValidatingPublicKeys(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not fetch validating public keys")
+	}
+	metadata := make(map[[48]byte]*accountMetadata, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		metadata[pubKey] = &accountMetadata{
+			DerivationPath: fmt.Sprintf(derived.ValidatingKeyDerivationPathTemplate, i),
+		}
+	}
+	return recordAccountsMetadata(ctx, w, metadata)
+}
+
+// deleteAccountsMetadata removes the metadata of deleted accounts.
+func deleteAccountsMetadata(ctx context.Context, w *wallet.Wallet, pubKeys [][]byte) error {
+	metadata, err := readAccountsMetadata(ctx, w)
+	if err != nil {
+		return err
+	}
+	if len(metadata) == 0 {
+		return nil
+	}
+	for _, pubKey := range pubKeys {
+		delete(metadata, fmt.Sprintf("%#x", pubKey))
+	}
+	return writeAccountsMetadata(ctx, w, metadata)
+}
+
+// readDepositData reads the deposit data files of a keys directory, by public key.
+func readDepositData(keysDir string) (map[[48]byte]json.RawMessage, error) {
+	files, err := filepath.Glob(filepath.Join(keysDir, depositDataFilePattern))
+	if err != nil {
+		return nil, err
+	}
+	depositData := make(map[[48]byte]json.RawMessage)
+	for _, file := range files {
+		encoded, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not read deposit data file %s", file)
+		}
+		var entries []json.RawMessage
+		if err := json.Unmarshal(encoded, &entries); err != nil {
+			return nil, errors.Wrapf(err, "could not decode deposit data file %s", file)
+		}
+		for _, entry := range entries {
+			var fields struct {
+				Pubkey string `json:"pubkey"`
+			}
+			if err := json.Unmarshal(entry, &fields); err != nil {
+				return nil, errors.Wrapf(err, "could not decode deposit data file %s", file)
+			}
+			pubKey, err := hex.DecodeString(strings.TrimPrefix(fields.Pubkey, "0x"))
+			if err != nil || len(pubKey) != 48 {
+				return nil, fmt.Errorf("invalid public key %q in deposit data file %s", fields.Pubkey, file)
+			}
+			var compacted bytes.Buffer
+			if err := json.Compact(&compacted, entry); err != nil {
+				return nil, err
+			}
+			var key [48]byte
+			copy(key[:], pubKey)
+			depositData[key] = compacted.Bytes()
+		}
+	}
+	return depositData, nil
+}
@@ -0,0 +1,54 @@
+package accounts
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/validator/client"
+	"github.com/prysmaticlabs/prysm/validator/flags"
+	"github.com/urfave/cli/v2"
+)
+
+// MetricsLabelsCli writes the pubkey label values of the account metrics of every account in the
+// wallet as CSV to stdout, so operators using truncated or hashed labels can relate metrics to accounts.
+func MetricsLabelsCli(cliCtx *cli.Context) error {
+	w, err := wallet.OpenWalletOrElseCli(cliCtx, func(cliCtx *cli.Context) (*wallet.Wallet, error) {
+		return nil, wallet.ErrNoWalletFound
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not open wallet")
+	}
+	km, err := w.InitializeKeymanager(cliCtx.Context)
+	if err != nil {
+		return errors.Wrap(err, "could not initialize keymanager")
+	}
+	pubKeys, err := km.FetchValidatingPublicKeys(cliCtx.Context)
+	if err != nil {
+		return err
+	}
+	// The salt of hashed labels is stored in the data directory of the validator client.
+	labeler, err := client.NewAccountMetricsLabeler(
+		cliCtx.String(flags.AccountMetricsLabelFlag.Name),
+		cliCtx.String(cmd.DataDirFlag.Name),
+	)
+	if err != nil {
+		return err
+	}
+	return writeMetricsLabels(os.Stdout, pubKeys, labeler)
+}
+
+func writeMetricsLabels(w io.Writer, pubKeys [][48]byte, labeler *client.AccountMetricsLabeler) error {
+	if _, err := fmt.Fprintln(w, "public_key,label"); err != nil {
+		return err
+	}
+	for _, pubKey := range pubKeys {
+		if _, err := fmt.Fprintf(w, "%#x,%s\n", pubKey, labeler.Label(pubKey[:])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
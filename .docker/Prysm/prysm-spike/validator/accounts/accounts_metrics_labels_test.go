@@ -0,0 +1,27 @@
+package accounts
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/client"
+)
+
+func TestWriteMetricsLabels(t *testing.T) {
+	labeler, err := client.NewAccountMetricsLabeler(client.AccountMetricsLabelHashed, filepath.Join(t.TempDir(), "data"))
+	require.NoError(t, err)
+	pubKeys := [][48]byte{{1}, {2}}
+
+	var buf bytes.Buffer
+	require.NoError(t, writeMetricsLabels(&buf, pubKeys, labeler))
+	want := fmt.Sprintf(
+		"public_key,label\n%#x,%s\n%#x,%s\n",
+		pubKeys[0], labeler.Label(pubKeys[0][:]),
+		pubKeys[1], labeler.Label(pubKeys[1][:]),
+	)
+	assert.Equal(t, want, buf.String())
+}
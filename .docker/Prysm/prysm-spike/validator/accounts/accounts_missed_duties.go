@@ -0,0 +1,97 @@
+package accounts
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/validator/flags"
+	"github.com/urfave/cli/v2"
+)
+
+// MissedDutiesCli lists the entries of the missed duty journal of the validator database matching
+// the filters given as flags. A running validator client serves the same journal through the
+// ListMissedDuties RPC of its web API.
+func MissedDutiesCli(cliCtx *cli.Context) error {
+	filter, err := missedDutyFilterFromCli(cliCtx)
+	if err != nil {
+		return err
+	}
+	w, err := wallet.OpenWalletOrElseCli(cliCtx, func(cliCtx *cli.Context) (*wallet.Wallet, error) {
+		return nil, wallet.ErrNoWalletFound
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not open wallet")
+	}
+	valDB, err := openValidatorDB(cliCtx, w)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := valDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close validator database")
+		}
+	}()
+	duties, err := valDB.MissedDuties(cliCtx.Context, filter)
+	if err != nil {
+		return errors.Wrap(err, "could not read missed duties")
+	}
+	if cliCtx.Bool(flags.MissedDutiesJSONFlag.Name) {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "\t")
+		return enc.Encode(duties)
+	}
+	return writeMissedDuties(os.Stdout, duties)
+}
+
+func missedDutyFilterFromCli(cliCtx *cli.Context) (*kv.MissedDutyFilter, error) {
+	filter := &kv.MissedDutyFilter{
+		Type:       kv.DutyType(cliCtx.String(flags.MissedDutyTypeFlag.Name)),
+		Failure:    kv.DutyFailure(cliCtx.String(flags.MissedDutyFailureFlag.Name)),
+		StartEpoch: cliCtx.Uint64(flags.MissedDutiesStartEpochFlag.Name),
+		EndEpoch:   cliCtx.Uint64(flags.MissedDutiesEndEpochFlag.Name),
+		Limit:      cliCtx.Int(flags.MissedDutiesLimitFlag.Name),
+	}
+	switch filter.Type {
+	case "", kv.AttestationDuty, kv.ProposalDuty, kv.AggregationDuty:
+	default:
+		return nil, fmt.Errorf("unknown duty type %q", filter.Type)
+	}
+	if filter.EndEpoch != 0 && filter.EndEpoch < filter.StartEpoch {
+		return nil, fmt.Errorf("end epoch %d is before start epoch %d", filter.EndEpoch, filter.StartEpoch)
+	}
+	if s := cliCtx.String(flags.MissedDutiesPublicKeysFlag.Name); s != "" {
+		filter.PubKeys = make(map[string]bool)
+		for _, pubKey := range strings.Split(s, ",") {
+			pubKey = strings.ToLower(strings.TrimSpace(pubKey))
+			if !strings.HasPrefix(pubKey, "0x") {
+				pubKey = "0x" + pubKey
+			}
+			filter.PubKeys[pubKey] = true
+		}
+	}
+	return filter, nil
+}
+
+func writeMissedDuties(w io.Writer, duties []*kv.MissedDuty) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	if _, err := fmt.Fprintln(tw, "TIME\tTYPE\tSLOT\tEPOCH\tPUBLIC KEY\tFAILURE\tERROR"); err != nil {
+		return err
+	}
+	for _, d := range duties {
+		if _, err := fmt.Fprintf(
+			tw, "%s\t%s\t%d\t%d\t%s\t%s\t%s\n",
+			time.Unix(d.Timestamp, 0).UTC().Format(time.RFC3339), d.Type, d.Slot, d.Epoch, d.PubKey, d.Failure, d.Error,
+		); err != nil {
+			return err
+		}
+	}
+	return tw.Flush()
+}
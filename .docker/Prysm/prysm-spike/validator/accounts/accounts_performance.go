@@ -0,0 +1,179 @@
+package accounts
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/validator/accounts/prompt"
+	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/validator/client"
+	"github.com/prysmaticlabs/prysm/validator/flags"
+	"github.com/urfave/cli/v2"
+)
+
+// BackfillPerformanceCli reconstructs the attestation performance of the selected accounts from
+// the finalized chain data of the beacon node into the validator database.
+func BackfillPerformanceCli(cliCtx *cli.Context) error {
+	w, err := wallet.OpenWalletOrElseCli(cliCtx, func(cliCtx *cli.Context) (*wallet.Wallet, error) {
+		return nil, wallet.ErrNoWalletFound
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not open wallet")
+	}
+	km, err := w.InitializeKeymanager(cliCtx.Context)
+	if err != nil {
+		return errors.Wrap(err, "could not initialize keymanager")
+	}
+	validatingPublicKeys, err := km.FetchValidatingPublicKeys(cliCtx.Context)
+	if err != nil {
+		return err
+	}
+	if len(validatingPublicKeys) == 0 {
+		return errors.New("wallet is empty, no accounts to backfill performance for")
+	}
+	filteredPubKeys, err := filterPublicKeysFromUserInput(
+		cliCtx,
+		flags.BackfillPublicKeysFlag,
+		validatingPublicKeys,
+		prompt.SelectAccountsBackfillPromptText,
+	)
+	if err != nil {
+		return errors.Wrap(err, "could not filter public keys for backfill")
+	}
+	pubKeys := make([][48]byte, len(filteredPubKeys))
+	for i, pk := range filteredPubKeys {
+		copy(pubKeys[i][:], pk.Marshal())
+	}
+
+	valDB, err := openValidatorDB(cliCtx, w)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := valDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close validator database")
+		}
+	}()
+
+	conn, err := dialBeaconNode(cliCtx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			log.WithError(err).Error("Could not close connection to beacon node")
+		}
+	}()
+	return client.BackfillAttestationPerformance(
+		cliCtx.Context,
+		ethpb.NewBeaconChainClient(conn),
+		ethpb.NewBeaconNodeValidatorClient(conn),
+		valDB,
+		pubKeys,
+		cliCtx.Uint64(flags.BackfillEpochsFlag.Name),
+	)
+}
+
+// ComparePerformanceCli compares the attestation performance of the selected accounts with every
+// validator of the network over the most recent finalized epochs, and writes the comparison.
+func ComparePerformanceCli(cliCtx *cli.Context) error {
+	w, err := wallet.OpenWalletOrElseCli(cliCtx, func(cliCtx *cli.Context) (*wallet.Wallet, error) {
+		return nil, wallet.ErrNoWalletFound
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not open wallet")
+	}
+	km, err := w.InitializeKeymanager(cliCtx.Context)
+	if err != nil {
+		return errors.Wrap(err, "could not initialize keymanager")
+	}
+	validatingPublicKeys, err := km.FetchValidatingPublicKeys(cliCtx.Context)
+	if err != nil {
+		return err
+	}
+	if len(validatingPublicKeys) == 0 {
+		return errors.New("wallet is empty, no accounts to compare performance for")
+	}
+	filteredPubKeys, err := filterPublicKeysFromUserInput(
+		cliCtx,
+		flags.ComparePublicKeysFlag,
+		validatingPublicKeys,
+		prompt.SelectAccountsComparePromptText,
+	)
+	if err != nil {
+		return errors.Wrap(err, "could not filter public keys for comparison")
+	}
+	pubKeys := make([][48]byte, len(filteredPubKeys))
+	for i, pk := range filteredPubKeys {
+		copy(pubKeys[i][:], pk.Marshal())
+	}
+
+	conn, err := dialBeaconNode(cliCtx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			log.WithError(err).Error("Could not close connection to beacon node")
+		}
+	}()
+	performance, err := client.CompareAttestationPerformance(
+		cliCtx.Context,
+		ethpb.NewBeaconChainClient(conn),
+		ethpb.NewBeaconNodeValidatorClient(conn),
+		pubKeys,
+		cliCtx.Uint64(flags.CompareEpochsFlag.Name),
+	)
+	if err != nil {
+		return err
+	}
+	if cliCtx.Bool(flags.ComparePerformanceJSONFlag.Name) {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "\t")
+		return enc.Encode(performance)
+	}
+	return writePerformanceComparison(os.Stdout, performance)
+}
+
+func writePerformanceComparison(w io.Writer, performance *client.NetworkPerformance) error {
+	if _, err := fmt.Fprintf(
+		w, "Epochs %d to %d, %d validators with duties\n\n", performance.StartEpoch, performance.EndEpoch, performance.Validators,
+	); err != nil {
+		return err
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	if _, err := fmt.Fprintln(tw, "VALIDATOR\tINCLUDED\tINCLUSION DISTANCE\tPERCENTILE\tEFFECTIVENESS\tPERCENTILE"); err != nil {
+		return err
+	}
+	network := performance.Average
+	if _, err := fmt.Fprintf(
+		tw, "network average\t%d/%d\t%.2f\t-\t%.1f%%\t-\n",
+		network.Included, network.Duties, network.InclusionDistance, 100*network.Effectiveness,
+	); err != nil {
+		return err
+	}
+	for _, c := range performance.Comparisons {
+		distance, distancePercentile := "-", "-"
+		if c.Summary.Included > 0 {
+			distance = fmt.Sprintf("%.2f", c.Summary.InclusionDistance)
+			distancePercentile = fmt.Sprintf("%.0f", c.InclusionDistancePercentile)
+		}
+		effectivenessPercentile := "-"
+		if c.Summary.Duties > 0 {
+			effectivenessPercentile = fmt.Sprintf("%.0f", c.EffectivenessPercentile)
+		}
+		if _, err := fmt.Fprintf(
+			tw, "%d %s\t%d/%d\t%s\t%s\t%.1f%%\t%s\n",
+			c.Index, c.PubKey, c.Summary.Included, c.Summary.Duties,
+			distance, distancePercentile, 100*c.Summary.Effectiveness, effectivenessPercentile,
+		); err != nil {
+			return err
+		}
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,107 @@
+package accounts
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/validator/flags"
+	"github.com/urfave/cli/v2"
+)
+
+// QuarantineCli lists the keys the validator client quarantined after repeated signing anomalies,
+// and releases the keys given with --release-public-keys, putting them back on duties the next
+// time the validator client starts.
+func QuarantineCli(cliCtx *cli.Context) error {
+	release, err := quarantineReleasePublicKeys(cliCtx.String(flags.QuarantineReleasePublicKeysFlag.Name))
+	if err != nil {
+		return err
+	}
+	w, err := wallet.OpenWalletOrElseCli(cliCtx, func(cliCtx *cli.Context) (*wallet.Wallet, error) {
+		return nil, wallet.ErrNoWalletFound
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not open wallet")
+	}
+	valDB, err := openValidatorDB(cliCtx, w)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := valDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close validator database")
+		}
+	}()
+	quarantined, err := valDB.QuarantinedPublicKeys(cliCtx.Context)
+	if err != nil {
+		return errors.Wrap(err, "could not read quarantined keys")
+	}
+	if len(release) > 0 {
+		for _, pubKey := range release {
+			if _, ok := quarantined[pubKey]; !ok {
+				return fmt.Errorf("key %#x is not quarantined", pubKey)
+			}
+		}
+		if err := valDB.ClearQuarantinedPublicKeys(cliCtx.Context, release); err != nil {
+			return errors.Wrap(err, "could not release keys from quarantine")
+		}
+		for _, pubKey := range release {
+			delete(quarantined, pubKey)
+			log.WithField("pubKey", fmt.Sprintf("%#x", pubKey)).Info("Released key from quarantine")
+		}
+	}
+	return writeQuarantinedKeys(os.Stdout, quarantined)
+}
+
+func quarantineReleasePublicKeys(s string) ([][48]byte, error) {
+	if s == "" {
+		return nil, nil
+	}
+	var pubKeys [][48]byte
+	for _, str := range strings.Split(s, ",") {
+		str = strings.TrimPrefix(strings.TrimSpace(str), "0x")
+		pubKeyBytes, err := hex.DecodeString(str)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not decode string %s as hex", str)
+		}
+		if len(pubKeyBytes) != 48 {
+			return nil, fmt.Errorf("%#x is not a 48 byte public key", pubKeyBytes)
+		}
+		var pubKey [48]byte
+		copy(pubKey[:], pubKeyBytes)
+		pubKeys = append(pubKeys, pubKey)
+	}
+	return pubKeys, nil
+}
+
+func writeQuarantinedKeys(w io.Writer, quarantined map[[48]byte]*kv.Quarantine) error {
+	pubKeys := make([][48]byte, 0, len(quarantined))
+	for pubKey := range quarantined {
+		pubKeys = append(pubKeys, pubKey)
+	}
+	sort.Slice(pubKeys, func(i, j int) bool {
+		return quarantined[pubKeys[i]].Timestamp < quarantined[pubKeys[j]].Timestamp
+	})
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	if _, err := fmt.Fprintln(tw, "SINCE\tPUBLIC KEY\tANOMALIES\tFAILURE\tERROR"); err != nil {
+		return err
+	}
+	for _, pubKey := range pubKeys {
+		q := quarantined[pubKey]
+		if _, err := fmt.Fprintf(
+			tw, "%s\t%#x\t%d\t%s\t%s\n",
+			time.Unix(q.Timestamp, 0).UTC().Format(time.RFC3339), pubKey, q.Anomalies, q.Failure, q.Error,
+		); err != nil {
+			return err
+		}
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,96 @@
+package accounts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	vdb "github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/validator/flags"
+	interchangeformat "github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format"
+	"github.com/urfave/cli/v2"
+)
+
+// ImportSlashingProtectionCli imports the slashing protection history of the EIP-3076 interchange
+// file given with --from into the validator database.
+func ImportSlashingProtectionCli(cliCtx *cli.Context) error {
+	filePath := cliCtx.String(flags.SlashingProtectionImportFileFlag.Name)
+	if filePath == "" {
+		return fmt.Errorf("no slashing protection file to import, set --%s", flags.SlashingProtectionImportFileFlag.Name)
+	}
+	valDB, err := openSlashingProtectionDB(cliCtx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := valDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close validator database")
+		}
+	}()
+	if err := importSlashingProtectionFile(cliCtx.Context, valDB, filePath); err != nil {
+		return err
+	}
+	log.WithField("file", filePath).Info("Imported slashing protection history")
+	return nil
+}
+
+// ExportSlashingProtectionCli exports the slashing protection history of the validator database
+// as an EIP-3076 interchange file to the path given with --to.
+func ExportSlashingProtectionCli(cliCtx *cli.Context) error {
+	filePath := cliCtx.String(flags.SlashingProtectionExportFileFlag.Name)
+	if filePath == "" {
+		return fmt.Errorf("no file to export slashing protection history to, set --%s", flags.SlashingProtectionExportFileFlag.Name)
+	}
+	valDB, err := openSlashingProtectionDB(cliCtx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := valDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close validator database")
+		}
+	}()
+	if err := exportSlashingProtectionFile(cliCtx.Context, valDB, filePath); err != nil {
+		return err
+	}
+	log.WithField("file", filePath).Info("Exported slashing protection history")
+	return nil
+}
+
+func openSlashingProtectionDB(cliCtx *cli.Context) (*kv.Store, error) {
+	w, err := wallet.OpenWalletOrElseCli(cliCtx, func(cliCtx *cli.Context) (*wallet.Wallet, error) {
+		return nil, wallet.ErrNoWalletFound
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open wallet")
+	}
+	return openValidatorDB(cliCtx, w)
+}
+
+func importSlashingProtectionFile(ctx context.Context, valDB vdb.Database, filePath string) error {
+	enc, err := fileutil.ReadFileAsBytes(filePath)
+	if err != nil {
+		return errors.Wrap(err, "could not read slashing protection file")
+	}
+	return interchangeformat.ImportStandardProtectionJSON(ctx, valDB, bytes.NewReader(enc))
+}
+
+func exportSlashingProtectionFile(ctx context.Context, valDB vdb.Database, filePath string) error {
+	eipJSON, err := interchangeformat.ExportStandardProtectionJSON(ctx, valDB)
+	if err != nil {
+		return errors.Wrap(err, "could not export slashing protection history")
+	}
+	encoded, err := json.MarshalIndent(eipJSON, "", "\t")
+	if err != nil {
+		return err
+	}
+	if err := fileutil.WriteFile(filePath, encoded); err != nil {
+		return errors.Wrap(err, "could not write slashing protection file")
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package accounts
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+)
+
+func TestExportImportSlashingProtectionFile(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [48]byte{1}
+	genesisValidatorsRoot := [32]byte{2}
+	signingRoot := [32]byte{3}
+
+	sourceDB, err := kv.NewKVStore(t.TempDir(), [][48]byte{pubKey})
+	require.NoError(t, err)
+	require.NoError(t, sourceDB.SaveGenesisValidatorsRoot(ctx, genesisValidatorsRoot[:]))
+	require.NoError(t, sourceDB.SaveProposalHistoryForSlot(ctx, pubKey, 10, signingRoot[:]))
+	history := kv.NewAttestationHistoryArray(2)
+	history, err = history.SetTargetData(ctx, 2, &kv.HistoryData{Source: 1, SigningRoot: signingRoot[:]})
+	require.NoError(t, err)
+	history, err = history.SetLatestEpochWritten(ctx, 2)
+	require.NoError(t, err)
+	require.NoError(t, sourceDB.SaveAttestationHistoryForPubKeyV2(ctx, pubKey, history))
+	require.NoError(t, sourceDB.SaveLowestSignedTargetEpoch(ctx, pubKey, 2))
+
+	filePath := filepath.Join(t.TempDir(), "slashing-protection.json")
+	require.NoError(t, exportSlashingProtectionFile(ctx, sourceDB, filePath))
+	// Only one validator database can be open at a time, as they register the same metrics.
+	require.NoError(t, sourceDB.Close())
+
+	targetDB, err := kv.NewKVStore(t.TempDir(), nil)
+	require.NoError(t, err)
+	require.NoError(t, importSlashingProtectionFile(ctx, targetDB, filePath))
+
+	root, err := targetDB.GenesisValidatorsRoot(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, genesisValidatorsRoot[:], root)
+	proposalRoot, exists, err := targetDB.ProposalHistoryForSlot(ctx, pubKey, 10)
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+	assert.Equal(t, signingRoot, proposalRoot)
+	histories, err := targetDB.AttestationHistoryForPubKeysV2(ctx, [][48]byte{pubKey})
+	require.NoError(t, err)
+	attestation, err := histories[pubKey].GetTargetData(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), attestation.Source)
+	assert.DeepEqual(t, signingRoot[:], attestation.SigningRoot)
+	require.NoError(t, targetDB.Close())
+
+	otherDB, err := kv.NewKVStore(t.TempDir(), nil)
+	require.NoError(t, err)
+	otherRoot := [32]byte{4}
+	require.NoError(t, otherDB.SaveGenesisValidatorsRoot(ctx, otherRoot[:]))
+	assert.NotNil(t, importSlashingProtectionFile(ctx, otherDB, filePath), "Histories of another chain are refused")
+	require.NoError(t, otherDB.Close())
+}
@@ -0,0 +1,323 @@
+package accounts
+
+import (
+	"bufio"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/validator/accounts/prompt"
+	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/validator/flags"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	// Withdrawal credentials starting with this byte hold an execution address in their last 20 bytes.
+	eth1AddressWithdrawalPrefixByte = byte(1)
+	executionAddressLength          = 20
+)
+
+// domainBLSToExecutionChange is the signature domain of withdrawal credential changes. Changes are
+// signed with the genesis fork version, so they stay valid for later broadcast across forks.
+var domainBLSToExecutionChange = [4]byte{0x0A, 0, 0, 0}
+
+// WithdrawalCredentialsKind describes how the withdrawal credentials of a validator are set.
+type WithdrawalCredentialsKind string
+
+const (
+	// BLSWithdrawalCredentials commit to the hash of a BLS withdrawal public key, and must be
+	// changed to an execution address before withdrawals.
+	BLSWithdrawalCredentials WithdrawalCredentialsKind = "bls"
+	// ExecutionAddressWithdrawalCredentials hold the execution address receiving withdrawals.
+	ExecutionAddressWithdrawalCredentials WithdrawalCredentialsKind = "execution_address"
+	// UnknownWithdrawalCredentials have a prefix this client does not know about.
+	UnknownWithdrawalCredentials WithdrawalCredentialsKind = "unknown"
+)
+
+// BLSToExecutionChange is a message changing the BLS withdrawal credentials of a validator to an
+// execution address. It is signed by the BLS withdrawal key.
+type BLSToExecutionChange struct {
+	ValidatorIndex     uint64
+	FromBLSPubkey      []byte `ssz-size:"48"`
+	ToExecutionAddress []byte `ssz-size:"20"`
+}
+
+// PreparedCredentialChange is an unsigned BLSToExecutionChange along with the root to sign offline
+// with the withdrawal key, in the JSON format of the standard beacon node API.
+type PreparedCredentialChange struct {
+	Message struct {
+		ValidatorIndex     string `json:"validator_index"`
+		FromBLSPubkey      string `json:"from_bls_pubkey"`
+		ToExecutionAddress string `json:"to_execution_address"`
+	} `json:"message"`
+	SigningRoot string `json:"signing_root"`
+}
+
+type withdrawalCredentials struct {
+	pubKey      [48]byte
+	index       uint64
+	known       bool
+	credentials []byte
+}
+
+// WithdrawalCredentialsCli displays the withdrawal credentials of the selected accounts as known to
+// the beacon node. Given an execution address and the BLS withdrawal public keys of the accounts, it
+// also writes the credential changes of the accounts with BLS credentials to sign offline.
+func WithdrawalCredentialsCli(cliCtx *cli.Context) error {
+	w, err := wallet.OpenWalletOrElseCli(cliCtx, func(cliCtx *cli.Context) (*wallet.Wallet, error) {
+		return nil, wallet.ErrNoWalletFound
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not open wallet")
+	}
+	km, err := w.InitializeKeymanager(cliCtx.Context)
+	if err != nil {
+		return errors.Wrap(err, "could not initialize keymanager")
+	}
+	pubKeys, err := km.FetchValidatingPublicKeys(cliCtx.Context)
+	if err != nil {
+		return err
+	}
+	if len(pubKeys) == 0 {
+		return errors.New("wallet is empty, no accounts to inspect withdrawal credentials for")
+	}
+	if cliCtx.IsSet(flags.WithdrawalCredentialsPublicKeysFlag.Name) {
+		filteredPubKeys, err := filterPublicKeysFromUserInput(
+			cliCtx,
+			flags.WithdrawalCredentialsPublicKeysFlag,
+			pubKeys,
+			prompt.SelectAccountsWithdrawalCredentialsPromptText,
+		)
+		if err != nil {
+			return errors.Wrap(err, "could not filter public keys for withdrawal credentials")
+		}
+		pubKeys = make([][48]byte, len(filteredPubKeys))
+		for i, pk := range filteredPubKeys {
+			copy(pubKeys[i][:], pk.Marshal())
+		}
+	}
+
+	var executionAddress []byte
+	var withdrawalPubKeys [][]byte
+	prepare := cliCtx.IsSet(flags.ExecutionAddressFlag.Name)
+	if prepare {
+		executionAddress, err = parseExecutionAddress(cliCtx.String(flags.ExecutionAddressFlag.Name))
+		if err != nil {
+			return err
+		}
+		withdrawalPubKeys, err = readWithdrawalPublicKeys(cliCtx.String(flags.BLSWithdrawalPublicKeysFileFlag.Name))
+		if err != nil {
+			return err
+		}
+	}
+
+	conn, err := dialBeaconNode(cliCtx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			log.WithError(err).Error("Could not close connection to beacon node")
+		}
+	}()
+	creds, err := fetchWithdrawalCredentials(cliCtx.Context, ethpb.NewBeaconChainClient(conn), pubKeys)
+	if err != nil {
+		return err
+	}
+	if err := displayWithdrawalCredentials(os.Stdout, creds); err != nil {
+		return err
+	}
+	if !prepare {
+		return nil
+	}
+
+	genesis, err := ethpb.NewNodeClient(conn).GetGenesis(cliCtx.Context, &ptypes.Empty{})
+	if err != nil {
+		return errors.Wrap(err, "could not get genesis from beacon node")
+	}
+	changes, err := prepareCredentialChanges(creds, withdrawalPubKeys, executionAddress, genesis.GenesisValidatorsRoot)
+	if err != nil {
+		return err
+	}
+	if len(changes) == 0 {
+		log.Info("No accounts with BLS withdrawal credentials to change")
+		return nil
+	}
+	enc, err := json.MarshalIndent(changes, "", "\t")
+	if err != nil {
+		return errors.Wrap(err, "could not encode credential changes")
+	}
+	outputPath := cliCtx.String(flags.CredentialChangesOutputPathFlag.Name)
+	if err := fileutil.WriteFile(outputPath, enc); err != nil {
+		return errors.Wrap(err, "could not write credential changes")
+	}
+	log.WithField("path", outputPath).Infof(
+		"Wrote %d credential changes, sign their signing roots with the withdrawal keys before broadcast",
+		len(changes),
+	)
+	return nil
+}
+
+// fetchWithdrawalCredentials returns the withdrawal credentials of the public keys as stored in the
+// state of the beacon node. Keys without a deposit processed by the beacon node are not known.
+func fetchWithdrawalCredentials(
+	ctx context.Context, client ethpb.BeaconChainClient, pubKeys [][48]byte,
+) ([]*withdrawalCredentials, error) {
+	byPubKey, _, err := fetchValidators(ctx, client, pubKeys)
+	if err != nil {
+		return nil, err
+	}
+	creds := make([]*withdrawalCredentials, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		creds[i] = &withdrawalCredentials{pubKey: pubKey}
+		if v, ok := byPubKey[pubKey]; ok {
+			creds[i].known = true
+			creds[i].index = v.Index
+			creds[i].credentials = v.Validator.WithdrawalCredentials
+		}
+	}
+	return creds, nil
+}
+
+func displayWithdrawalCredentials(w io.Writer, creds []*withdrawalCredentials) error {
+	for _, c := range creds {
+		if !c.known {
+			if _, err := fmt.Fprintf(w, "%#x\tnot known to the beacon node\n", bytesutil.Trunc(c.pubKey[:])); err != nil {
+				return err
+			}
+			continue
+		}
+		kind := withdrawalCredentialsKind(c.credentials)
+		line := fmt.Sprintf("%#x\tindex %d\t%s\t%#x", bytesutil.Trunc(c.pubKey[:]), c.index, kind, c.credentials)
+		if kind == ExecutionAddressWithdrawalCredentials {
+			line += fmt.Sprintf("\taddress %#x", c.credentials[len(c.credentials)-executionAddressLength:])
+		}
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// withdrawalCredentialsKind returns the kind of withdrawal credentials from their prefix byte.
+func withdrawalCredentialsKind(credentials []byte) WithdrawalCredentialsKind {
+	if len(credentials) != 32 {
+		return UnknownWithdrawalCredentials
+	}
+	switch credentials[0] {
+	case params.BeaconConfig().BLSWithdrawalPrefixByte:
+		return BLSWithdrawalCredentials
+	case eth1AddressWithdrawalPrefixByte:
+		return ExecutionAddressWithdrawalCredentials
+	default:
+		return UnknownWithdrawalCredentials
+	}
+}
+
+// prepareCredentialChanges returns the changes to the execution address of the validators with BLS
+// withdrawal credentials. Each validator is matched with the withdrawal public key its credentials
+// commit to, and validators without a matching key are skipped with a warning.
+func prepareCredentialChanges(
+	creds []*withdrawalCredentials, withdrawalPubKeys [][]byte, executionAddress, genesisValidatorsRoot []byte,
+) ([]*PreparedCredentialChange, error) {
+	byCredentials := make(map[[32]byte][]byte, len(withdrawalPubKeys))
+	for _, pubKey := range withdrawalPubKeys {
+		byCredentials[blsWithdrawalCredentials(pubKey)] = pubKey
+	}
+	domain, err := helpers.ComputeDomain(
+		domainBLSToExecutionChange,
+		params.BeaconConfig().GenesisForkVersion,
+		genesisValidatorsRoot,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute signature domain")
+	}
+	changes := make([]*PreparedCredentialChange, 0)
+	for _, c := range creds {
+		if !c.known || withdrawalCredentialsKind(c.credentials) != BLSWithdrawalCredentials {
+			continue
+		}
+		withdrawalPubKey, ok := byCredentials[bytesutil.ToBytes32(c.credentials)]
+		if !ok {
+			log.Warnf("No BLS withdrawal public key matches the withdrawal credentials of account %#x", bytesutil.Trunc(c.pubKey[:]))
+			continue
+		}
+		msg := &BLSToExecutionChange{
+			ValidatorIndex:     c.index,
+			FromBLSPubkey:      withdrawalPubKey,
+			ToExecutionAddress: executionAddress,
+		}
+		root, err := helpers.ComputeSigningRoot(msg, domain)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not compute signing root")
+		}
+		change := &PreparedCredentialChange{SigningRoot: fmt.Sprintf("%#x", root)}
+		change.Message.ValidatorIndex = strconv.FormatUint(c.index, 10)
+		change.Message.FromBLSPubkey = fmt.Sprintf("%#x", withdrawalPubKey)
+		change.Message.ToExecutionAddress = fmt.Sprintf("%#x", executionAddress)
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+// blsWithdrawalCredentials returns the BLS withdrawal credentials committing to the public key.
+func blsWithdrawalCredentials(pubKey []byte) [32]byte {
+	creds := hashutil.Hash(pubKey)
+	creds[0] = params.BeaconConfig().BLSWithdrawalPrefixByte
+	return creds
+}
+
+func parseExecutionAddress(s string) ([]byte, error) {
+	address, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil || len(address) != executionAddressLength {
+		return nil, fmt.Errorf("%s is not a valid execution address", s)
+	}
+	return address, nil
+}
+
+// readWithdrawalPublicKeys reads hex BLS public keys from the file, one per line.
+func readWithdrawalPublicKeys(path string) ([][]byte, error) {
+	if path == "" {
+		return nil, fmt.Errorf("--%s is required to prepare credential changes", flags.BLSWithdrawalPublicKeysFileFlag.Name)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open BLS withdrawal public keys file")
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).Error("Could not close BLS withdrawal public keys file")
+		}
+	}()
+	var pubKeys [][]byte
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		pubKey, err := hex.DecodeString(strings.TrimPrefix(line, "0x"))
+		if err != nil || len(pubKey) != params.BeaconConfig().BLSPubkeyLength {
+			return nil, fmt.Errorf("%s is not a valid BLS public key", line)
+		}
+		pubKeys = append(pubKeys, pubKey)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "could not read BLS withdrawal public keys file")
+	}
+	return pubKeys, nil
+}
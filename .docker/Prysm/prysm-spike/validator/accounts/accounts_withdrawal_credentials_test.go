@@ -0,0 +1,103 @@
+package accounts
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestFetchWithdrawalCredentials(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconChainClient(ctrl)
+	pubKeys := [][48]byte{{1}, {2}, {3}}
+	blsCreds := blsWithdrawalCredentials(bytes.Repeat([]byte{'w'}, 48))
+	eth1Creds := append([]byte{eth1AddressWithdrawalPrefixByte}, make([]byte, 31)...)
+	client.EXPECT().ListValidators(gomock.Any(), gomock.Any()).Return(&ethpb.Validators{
+		ValidatorList: []*ethpb.Validators_ValidatorContainer{
+			{Index: 4, Validator: &ethpb.Validator{PublicKey: pubKeys[0][:], WithdrawalCredentials: blsCreds[:]}},
+		},
+		NextPageToken: "1",
+	}, nil)
+	client.EXPECT().ListValidators(gomock.Any(), gomock.Any()).Return(&ethpb.Validators{
+		ValidatorList: []*ethpb.Validators_ValidatorContainer{
+			{Index: 7, Validator: &ethpb.Validator{PublicKey: pubKeys[2][:], WithdrawalCredentials: eth1Creds}},
+		},
+	}, nil)
+
+	creds, err := fetchWithdrawalCredentials(context.Background(), client, pubKeys)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(creds))
+	assert.Equal(t, true, creds[0].known)
+	assert.Equal(t, uint64(4), creds[0].index)
+	assert.Equal(t, BLSWithdrawalCredentials, withdrawalCredentialsKind(creds[0].credentials))
+	assert.Equal(t, false, creds[1].known)
+	assert.Equal(t, uint64(7), creds[2].index)
+	assert.Equal(t, ExecutionAddressWithdrawalCredentials, withdrawalCredentialsKind(creds[2].credentials))
+	assert.Equal(t, UnknownWithdrawalCredentials, withdrawalCredentialsKind([]byte{2}))
+}
+
+func TestPrepareCredentialChanges(t *testing.T) {
+	withdrawalPubKey := bytes.Repeat([]byte{'w'}, 48)
+	blsCreds := blsWithdrawalCredentials(withdrawalPubKey)
+	otherCreds := blsWithdrawalCredentials(bytes.Repeat([]byte{'o'}, 48))
+	address, err := parseExecutionAddress("0x" + fmt.Sprintf("%x", bytes.Repeat([]byte{'a'}, 20)))
+	require.NoError(t, err)
+	genesisValidatorsRoot := bytes.Repeat([]byte{'g'}, 32)
+	creds := []*withdrawalCredentials{
+		{pubKey: [48]byte{1}, index: 4, known: true, credentials: blsCreds[:]},
+		// No withdrawal public key matches these credentials.
+		{pubKey: [48]byte{2}, index: 5, known: true, credentials: otherCreds[:]},
+		{pubKey: [48]byte{3}},
+	}
+
+	changes, err := prepareCredentialChanges(creds, [][]byte{withdrawalPubKey}, address, genesisValidatorsRoot)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(changes))
+	assert.Equal(t, "4", changes[0].Message.ValidatorIndex)
+	assert.Equal(t, fmt.Sprintf("%#x", withdrawalPubKey), changes[0].Message.FromBLSPubkey)
+	assert.Equal(t, fmt.Sprintf("%#x", address), changes[0].Message.ToExecutionAddress)
+
+	// The hash tree root of the three fields, padded to four leaves.
+	var index [32]byte
+	binary.LittleEndian.PutUint64(index[:], 4)
+	pubKeyRoot := hashutil.Hash(append(withdrawalPubKey, make([]byte, 16)...))
+	var addressLeaf [32]byte
+	copy(addressLeaf[:], address)
+	left := hashutil.Hash(append(index[:], pubKeyRoot[:]...))
+	right := hashutil.Hash(append(addressLeaf[:], make([]byte, 32)...))
+	objectRoot := hashutil.Hash(append(left[:], right[:]...))
+	domain, err := helpers.ComputeDomain(domainBLSToExecutionChange, params.BeaconConfig().GenesisForkVersion, genesisValidatorsRoot)
+	require.NoError(t, err)
+	signingRoot := hashutil.Hash(append(objectRoot[:], domain...))
+	assert.Equal(t, fmt.Sprintf("%#x", signingRoot), changes[0].SigningRoot)
+}
+
+func TestReadWithdrawalPublicKeys(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys.txt")
+	pubKey := bytes.Repeat([]byte{'w'}, 48)
+	require.NoError(t, ioutil.WriteFile(path, []byte(fmt.Sprintf("%#x\n\n%x\n", pubKey, pubKey)), 0600))
+	pubKeys, err := readWithdrawalPublicKeys(path)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][]byte{pubKey, pubKey}, pubKeys)
+
+	require.NoError(t, ioutil.WriteFile(path, []byte("0x1234\n"), 0600))
+	_, err = readWithdrawalPublicKeys(path)
+	assert.ErrorContains(t, "0x1234 is not a valid BLS public key", err)
+
+	_, err = parseExecutionAddress("0x1234")
+	assert.ErrorContains(t, "not a valid execution address", err)
+}
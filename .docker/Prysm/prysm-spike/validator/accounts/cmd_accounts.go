@@ -17,11 +17,16 @@ var AccountCommands = &cli.Command{
 	Usage:    "defines commands for interacting with eth2 validator accounts",
 	Subcommands: []*cli.Command{
 		{
-			Name:        "delete",
-			Description: `deletes the selected accounts from a users wallet.`,
+			Name: "delete",
+			Description: "deletes the selected accounts from a users wallet, archiving their slashing protection " +
+				"history in the wallet directory. Deleted accounts must be imported again before the validator " +
+				"client uses them. The validator client must be stopped, as it holds a lock on the database",
 			Flags: cmd.WrapFlags([]cli.Flag{
 				flags.WalletDirFlag,
 				flags.WalletPasswordFileFlag,
+				cmd.DataDirFlag,
+				flags.DBEncryptionFlag,
+				flags.DBEncryptionKeyFileFlag,
 				flags.DeletePublicKeysFlag,
 				featureconfig.Mainnet,
 				featureconfig.PyrmontTestnet,
@@ -44,13 +49,25 @@ var AccountCommands = &cli.Command{
 			},
 		},
 		{
-			Name:        "list",
-			Description: "Lists all validator accounts in a user's wallet directory",
+			Name: "list",
+			Description: "Lists all validator accounts in a user's wallet directory. The accounts listed can be " +
+				"filtered by the withdrawal credentials and status of their validators, as known to the beacon node",
 			Flags: cmd.WrapFlags([]cli.Flag{
 				flags.WalletDirFlag,
 				flags.WalletPasswordFileFlag,
 				flags.ShowDepositDataFlag,
 				flags.ShowPrivateKeysFlag,
+				flags.AccountsListOutputFlag,
+				flags.VerifyKeystoresFlag,
+				flags.WithBLSCredentialsFlag,
+				flags.WithEth1CredentialsFlag,
+				flags.ValidatorStatusFilterFlag,
+				flags.BeaconRPCProviderFlag,
+				cmd.GrpcMaxCallRecvMsgSizeFlag,
+				flags.CertFlag,
+				flags.GrpcHeadersFlag,
+				flags.GrpcRetriesFlag,
+				flags.GrpcRetryDelayFlag,
 				featureconfig.Mainnet,
 				featureconfig.PyrmontTestnet,
 				featureconfig.ToledoTestnet,
@@ -76,13 +93,23 @@ var AccountCommands = &cli.Command{
 			Description: "backup accounts into EIP-2335 compliant keystore.json files zipped into a backup.zip file " +
 				"at a desired output directory. Accounts to backup can also " +
 				"be specified programmatically via a --backup-for-public-keys flag which specifies a comma-separated " +
-				"list of hex string public keys",
+				"list of hex string public keys, or filtered by the withdrawal credentials and status of their " +
+				"validators, as known to the beacon node",
 			Flags: cmd.WrapFlags([]cli.Flag{
 				flags.WalletDirFlag,
 				flags.WalletPasswordFileFlag,
 				flags.BackupDirFlag,
 				flags.BackupPublicKeysFlag,
 				flags.BackupPasswordFile,
+				flags.WithBLSCredentialsFlag,
+				flags.WithEth1CredentialsFlag,
+				flags.ValidatorStatusFilterFlag,
+				flags.BeaconRPCProviderFlag,
+				cmd.GrpcMaxCallRecvMsgSizeFlag,
+				flags.CertFlag,
+				flags.GrpcHeadersFlag,
+				flags.GrpcRetriesFlag,
+				flags.GrpcRetryDelayFlag,
 				featureconfig.Mainnet,
 				featureconfig.PyrmontTestnet,
 				featureconfig.ToledoTestnet,
@@ -112,6 +139,10 @@ var AccountCommands = &cli.Command{
 				flags.WalletPasswordFileFlag,
 				flags.AccountPasswordFileFlag,
 				flags.ImportPrivateKeyFileFlag,
+				flags.AccountTagsFlag,
+				cmd.DataDirFlag,
+				flags.DBEncryptionFlag,
+				flags.DBEncryptionKeyFileFlag,
 				featureconfig.Mainnet,
 				featureconfig.PyrmontTestnet,
 				featureconfig.ToledoTestnet,
@@ -133,13 +164,17 @@ var AccountCommands = &cli.Command{
 			},
 		},
 		{
-			Name:        "voluntary-exit",
-			Description: "Performs a voluntary exit on selected accounts",
+			Name: "voluntary-exit",
+			Description: "Performs a voluntary exit on selected accounts. The accounts to select from can be " +
+				"filtered by the withdrawal credentials and status of their validators, as known to the beacon node",
 			Flags: cmd.WrapFlags([]cli.Flag{
 				flags.WalletDirFlag,
 				flags.WalletPasswordFileFlag,
 				flags.AccountPasswordFileFlag,
 				flags.VoluntaryExitPublicKeysFlag,
+				flags.WithBLSCredentialsFlag,
+				flags.WithEth1CredentialsFlag,
+				flags.ValidatorStatusFilterFlag,
 				flags.BeaconRPCProviderFlag,
 				cmd.GrpcMaxCallRecvMsgSizeFlag,
 				flags.CertFlag,
@@ -166,5 +201,299 @@ var AccountCommands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name: "backfill-performance",
+			Description: "Reconstructs the attestation performance of selected accounts over the most recent " +
+				"finalized epochs from the chain data of the beacon node, and saves it to the validator database. " +
+				"The validator client must be stopped, as it holds a lock on the database",
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.WalletDirFlag,
+				flags.WalletPasswordFileFlag,
+				cmd.DataDirFlag,
+				flags.DBEncryptionFlag,
+				flags.DBEncryptionKeyFileFlag,
+				flags.BackfillPublicKeysFlag,
+				flags.BackfillEpochsFlag,
+				flags.BeaconRPCProviderFlag,
+				cmd.GrpcMaxCallRecvMsgSizeFlag,
+				flags.CertFlag,
+				flags.GrpcHeadersFlag,
+				flags.GrpcRetriesFlag,
+				flags.GrpcRetryDelayFlag,
+				featureconfig.Mainnet,
+				featureconfig.PyrmontTestnet,
+				featureconfig.ToledoTestnet,
+				featureconfig.L14TestNet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				return tos.VerifyTosAcceptedOrPrompt(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				featureconfig.ConfigureValidator(cliCtx)
+				if err := BackfillPerformanceCli(cliCtx); err != nil {
+					log.Fatalf("Could not backfill attestation performance: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name: "compare-performance",
+			Description: "Compares the inclusion distance and effectiveness of the attestations of selected accounts " +
+				"over the most recent finalized epochs with every validator of the network, from the chain data of " +
+				"the beacon node. Percentile rankings tell whether underperformance is local or network-wide",
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.WalletDirFlag,
+				flags.WalletPasswordFileFlag,
+				flags.ComparePublicKeysFlag,
+				flags.CompareEpochsFlag,
+				flags.ComparePerformanceJSONFlag,
+				flags.BeaconRPCProviderFlag,
+				cmd.GrpcMaxCallRecvMsgSizeFlag,
+				flags.CertFlag,
+				flags.GrpcHeadersFlag,
+				flags.GrpcRetriesFlag,
+				flags.GrpcRetryDelayFlag,
+				featureconfig.Mainnet,
+				featureconfig.PyrmontTestnet,
+				featureconfig.ToledoTestnet,
+				featureconfig.L14TestNet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				return tos.VerifyTosAcceptedOrPrompt(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				featureconfig.ConfigureValidator(cliCtx)
+				if err := ComparePerformanceCli(cliCtx); err != nil {
+					log.Fatalf("Could not compare attestation performance: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name: "withdrawal-credentials",
+			Description: "Displays the withdrawal credentials of the selected accounts as known to the beacon node. " +
+				"With --to-execution-address, writes the unsigned changes of BLS withdrawal credentials to that " +
+				"address along with their signing roots, to sign offline with the withdrawal keys and broadcast later",
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.WalletDirFlag,
+				flags.WalletPasswordFileFlag,
+				flags.WithdrawalCredentialsPublicKeysFlag,
+				flags.ExecutionAddressFlag,
+				flags.BLSWithdrawalPublicKeysFileFlag,
+				flags.CredentialChangesOutputPathFlag,
+				flags.BeaconRPCProviderFlag,
+				cmd.GrpcMaxCallRecvMsgSizeFlag,
+				flags.CertFlag,
+				flags.GrpcHeadersFlag,
+				flags.GrpcRetriesFlag,
+				flags.GrpcRetryDelayFlag,
+				featureconfig.Mainnet,
+				featureconfig.PyrmontTestnet,
+				featureconfig.ToledoTestnet,
+				featureconfig.L14TestNet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				return tos.VerifyTosAcceptedOrPrompt(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				featureconfig.ConfigureValidator(cliCtx)
+				if err := WithdrawalCredentialsCli(cliCtx); err != nil {
+					log.Fatalf("Could not inspect withdrawal credentials: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name: "deposit-data",
+			Description: "Writes launchpad-compatible deposit data for the selected accounts of the wallet, with the " +
+				"withdrawal credentials of --withdrawal-address or --bls-withdrawal-public-key. Given --mnemonic-file, " +
+				"writes the deposit data of --num-accounts accounts derived from the mnemonic from --start-index on " +
+				"instead, withdrawing to the BLS withdrawal keys derived alongside unless withdrawal credentials are set. " +
+				"Every deposit is verified against its withdrawal credentials before the file is written",
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.WalletDirFlag,
+				flags.WalletPasswordFileFlag,
+				flags.DepositDataPublicKeysFlag,
+				flags.MnemonicFileFlag,
+				flags.MnemonicLanguageFlag,
+				flags.Mnemonic25thWordFileFlag,
+				flags.NumAccountsFlag,
+				flags.DepositStartIndexFlag,
+				flags.DepositAmountGweiFlag,
+				flags.DepositWithdrawalAddressFlag,
+				flags.DepositBLSWithdrawalPublicKeyFlag,
+				flags.DepositDataOutputDirFlag,
+				flags.DepositDataFormatFlag,
+				featureconfig.Mainnet,
+				featureconfig.PyrmontTestnet,
+				featureconfig.ToledoTestnet,
+				featureconfig.L14TestNet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				return tos.VerifyTosAcceptedOrPrompt(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				featureconfig.ConfigureValidator(cliCtx)
+				if err := DepositDataCli(cliCtx); err != nil {
+					log.Fatalf("Could not create deposit data: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name: "missed-duties",
+			Description: "Lists the duties the validator client missed or failed to perform, along with the " +
+				"classification of their failure, from the journal of the validator database. The validator " +
+				"client must be stopped, as it holds a lock on the database. A running client serves the " +
+				"journal through its web API at /v2/validator/performance/missed_duties",
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.WalletDirFlag,
+				flags.WalletPasswordFileFlag,
+				cmd.DataDirFlag,
+				flags.DBEncryptionFlag,
+				flags.DBEncryptionKeyFileFlag,
+				flags.MissedDutiesPublicKeysFlag,
+				flags.MissedDutyTypeFlag,
+				flags.MissedDutyFailureFlag,
+				flags.MissedDutiesStartEpochFlag,
+				flags.MissedDutiesEndEpochFlag,
+				flags.MissedDutiesLimitFlag,
+				flags.MissedDutiesJSONFlag,
+				featureconfig.Mainnet,
+				featureconfig.PyrmontTestnet,
+				featureconfig.ToledoTestnet,
+				featureconfig.L14TestNet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				return tos.VerifyTosAcceptedOrPrompt(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				featureconfig.ConfigureValidator(cliCtx)
+				if err := MissedDutiesCli(cliCtx); err != nil {
+					log.Fatalf("Could not list missed duties: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name: "quarantine",
+			Description: "Lists the keys the validator client quarantined after repeated slashing protection " +
+				"rejections or signatures rejected by the beacon node, which perform no duties, and releases " +
+				"the keys given with --release-public-keys. The validator client must be stopped, as it holds " +
+				"a lock on the database, released keys perform duties again once it is started",
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.WalletDirFlag,
+				flags.WalletPasswordFileFlag,
+				cmd.DataDirFlag,
+				flags.DBEncryptionFlag,
+				flags.DBEncryptionKeyFileFlag,
+				flags.QuarantineReleasePublicKeysFlag,
+				featureconfig.Mainnet,
+				featureconfig.PyrmontTestnet,
+				featureconfig.ToledoTestnet,
+				featureconfig.L14TestNet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				return tos.VerifyTosAcceptedOrPrompt(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				featureconfig.ConfigureValidator(cliCtx)
+				if err := QuarantineCli(cliCtx); err != nil {
+					log.Fatalf("Could not manage quarantined keys: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name: "maintenance-window",
+			Description: "Inspects the upcoming proposer and aggregator duties of all accounts in the wallet and " +
+				"prints the next window of at least --min-slots slots without any, in which the validator client " +
+				"can be restarted while missing at most attestations. Duties are only known up to the end of the " +
+				"next epoch. With --wait, blocks until the window starts",
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.WalletDirFlag,
+				flags.WalletPasswordFileFlag,
+				flags.MaintenanceWindowSlotsFlag,
+				flags.MaintenanceWindowWaitFlag,
+				flags.BeaconRPCProviderFlag,
+				cmd.GrpcMaxCallRecvMsgSizeFlag,
+				flags.CertFlag,
+				flags.GrpcHeadersFlag,
+				flags.GrpcRetriesFlag,
+				flags.GrpcRetryDelayFlag,
+				featureconfig.Mainnet,
+				featureconfig.PyrmontTestnet,
+				featureconfig.ToledoTestnet,
+				featureconfig.L14TestNet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				return tos.VerifyTosAcceptedOrPrompt(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				featureconfig.ConfigureValidator(cliCtx)
+				if err := MaintenanceWindowCli(cliCtx); err != nil {
+					log.Fatalf("Could not find a maintenance window: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name: "metrics-labels",
+			Description: "Writes the pubkey label of the prometheus metrics of every account in the wallet as CSV, " +
+				"relating truncated or hashed labels set with --account-metrics-label to public keys. The data " +
+				"directory must be the one of the validator client, as it holds the salt of hashed labels",
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.WalletDirFlag,
+				flags.WalletPasswordFileFlag,
+				cmd.DataDirFlag,
+				flags.AccountMetricsLabelFlag,
+				featureconfig.Mainnet,
+				featureconfig.PyrmontTestnet,
+				featureconfig.ToledoTestnet,
+				featureconfig.L14TestNet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				return tos.VerifyTosAcceptedOrPrompt(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				featureconfig.ConfigureValidator(cliCtx)
+				if err := MetricsLabelsCli(cliCtx); err != nil {
+					log.Fatalf("Could not export account metrics labels: %v", err)
+				}
+				return nil
+			},
+		},
 	},
 }
@@ -0,0 +1,50 @@
+package accounts
+
+import (
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/tos"
+	"github.com/prysmaticlabs/prysm/validator/flags"
+	"github.com/urfave/cli/v2"
+)
+
+// DBCommands for the maintenance of the validator database.
+var DBCommands = &cli.Command{
+	Name:     "db",
+	Category: "db",
+	Usage:    "defines commands for the maintenance of the validator database",
+	Subcommands: []*cli.Command{
+		{
+			Name: "prune",
+			Description: "Removes the slashing protection history older than --db-prune-retention-epochs before " +
+				"the newest epoch signed, which cannot be less than the weak subjectivity period, and compacts the " +
+				"validator database file. The validator client must be stopped, as it holds a lock on the database",
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.WalletDirFlag,
+				flags.WalletPasswordFileFlag,
+				cmd.DataDirFlag,
+				flags.DBEncryptionFlag,
+				flags.DBEncryptionKeyFileFlag,
+				flags.DBPruneRetentionEpochsFlag,
+				featureconfig.Mainnet,
+				featureconfig.PyrmontTestnet,
+				featureconfig.ToledoTestnet,
+				featureconfig.L14TestNet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				return tos.VerifyTosAcceptedOrPrompt(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				featureconfig.ConfigureValidator(cliCtx)
+				if err := PruneDBCli(cliCtx); err != nil {
+					log.Fatalf("Could not prune validator database: %v", err)
+				}
+				return nil
+			},
+		},
+	},
+}
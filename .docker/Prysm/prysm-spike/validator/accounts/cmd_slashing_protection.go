@@ -0,0 +1,82 @@
+package accounts
+
+import (
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/tos"
+	"github.com/prysmaticlabs/prysm/validator/flags"
+	"github.com/urfave/cli/v2"
+)
+
+// slashingProtectionCommands for importing and exporting the slashing protection history of Prysm
+// validators, a subcommand of the wallet commands.
+var slashingProtectionCommands = &cli.Command{
+	Name:  "slashing-protection",
+	Usage: "defines commands for importing and exporting slashing protection history in the EIP-3076 interchange format",
+	Subcommands: []*cli.Command{
+		{
+			Name: "import",
+			Description: "Imports the slashing protection history of an EIP-3076 interchange JSON file given with " +
+				"--from into the validator database, such as one exported by another client before migrating keys. " +
+				"The validator client must be stopped, as it holds a lock on the database",
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.WalletDirFlag,
+				flags.WalletPasswordFileFlag,
+				cmd.DataDirFlag,
+				flags.DBEncryptionFlag,
+				flags.DBEncryptionKeyFileFlag,
+				flags.SlashingProtectionImportFileFlag,
+				featureconfig.Mainnet,
+				featureconfig.PyrmontTestnet,
+				featureconfig.ToledoTestnet,
+				featureconfig.L14TestNet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				return tos.VerifyTosAcceptedOrPrompt(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				featureconfig.ConfigureValidator(cliCtx)
+				if err := ImportSlashingProtectionCli(cliCtx); err != nil {
+					log.Fatalf("Could not import slashing protection history: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name: "export",
+			Description: "Exports the slashing protection history of the validator database as an EIP-3076 " +
+				"interchange JSON file to the path given with --to, to be imported by another client before " +
+				"migrating keys. The validator client must be stopped, as it holds a lock on the database",
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.WalletDirFlag,
+				flags.WalletPasswordFileFlag,
+				cmd.DataDirFlag,
+				flags.DBEncryptionFlag,
+				flags.DBEncryptionKeyFileFlag,
+				flags.SlashingProtectionExportFileFlag,
+				featureconfig.Mainnet,
+				featureconfig.PyrmontTestnet,
+				featureconfig.ToledoTestnet,
+				featureconfig.L14TestNet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				return tos.VerifyTosAcceptedOrPrompt(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				featureconfig.ConfigureValidator(cliCtx)
+				if err := ExportSlashingProtectionCli(cliCtx); err != nil {
+					log.Fatalf("Could not export slashing protection history: %v", err)
+				}
+				return nil
+			},
+		},
+	},
+}
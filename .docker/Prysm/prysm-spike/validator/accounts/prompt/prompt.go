@@ -12,6 +12,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/promptutil"
 	"github.com/prysmaticlabs/prysm/validator/flags"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/remote"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/web3signer"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
 )
@@ -27,6 +28,14 @@ const (
 	SelectAccountsBackupPromptText = "Select the account(s) you wish to backup"
 	// SelectAccountsVoluntaryExitPromptText --
 	SelectAccountsVoluntaryExitPromptText = "Select the account(s) on which you wish to perform a voluntary exit"
+	// SelectAccountsBackfillPromptText --
+	SelectAccountsBackfillPromptText = "Select the account(s) whose attestation performance you wish to backfill"
+	// SelectAccountsComparePromptText --
+	SelectAccountsComparePromptText = "Select the account(s) whose attestation performance you wish to compare with the network"
+	// SelectAccountsWithdrawalCredentialsPromptText --
+	SelectAccountsWithdrawalCredentialsPromptText = "Select the account(s) whose withdrawal credentials you wish to inspect"
+	// SelectAccountsDepositDataPromptText --
+	SelectAccountsDepositDataPromptText = "Select the account(s) you wish to create deposit data for"
 	// SelectAccountsDisablePromptText --
 	SelectAccountsDisablePromptText = "Select the account(s) you would like to disable"
 	// SelectAccountsEnablePromptText --
@@ -134,6 +143,69 @@ func InputRemoteKeymanagerConfig(cliCtx *cli.Context) (*remote.KeymanagerOpts, e
 	return newCfg, nil
 }
 
+// InputWeb3SignerKeymanagerConfig via the cli. Each url must be https, and the TLS certificates
+// apply to each of them.
+func InputWeb3SignerKeymanagerConfig(cliCtx *cli.Context) (*web3signer.KeymanagerOpts, error) {
+	urls := cliCtx.StringSlice(flags.Web3SignerURLFlag.Name)
+	crt := cliCtx.String(flags.RemoteSignerCertPathFlag.Name)
+	key := cliCtx.String(flags.RemoteSignerKeyPathFlag.Name)
+	ca := cliCtx.String(flags.RemoteSignerCACertPathFlag.Name)
+	log.Info("Input desired configuration")
+	if len(urls) == 0 {
+		u, err := promptutil.ValidatePrompt(
+			os.Stdin,
+			"Web3Signer URL (such as https://web3signer.example.com:9000)",
+			promptutil.NotEmpty)
+		if err != nil {
+			return nil, err
+		}
+		urls = []string{strings.TrimRight(u, "\r\n")}
+	}
+	for _, u := range urls {
+		if !strings.HasPrefix(u, "https://") {
+			return nil, fmt.Errorf("web3signer url %s must be https", u)
+		}
+	}
+	var err error
+	if crt == "" {
+		crt, err = promptutil.ValidatePrompt(
+			os.Stdin,
+			"Path to TLS crt (such as /path/to/client.crt)",
+			validateCertPath)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if key == "" {
+		key, err = promptutil.ValidatePrompt(
+			os.Stdin,
+			"Path to TLS key (such as /path/to/client.key)",
+			validateCertPath)
+		if err != nil {
+			return nil, err
+		}
+	}
+	certs := &remote.CertificateConfig{}
+	if certs.ClientCertPath, err = fileutil.ExpandPath(strings.TrimRight(crt, "\r\n")); err != nil {
+		return nil, errors.Wrapf(err, "could not determine absolute path for %s", crt)
+	}
+	if certs.ClientKeyPath, err = fileutil.ExpandPath(strings.TrimRight(key, "\r\n")); err != nil {
+		return nil, errors.Wrapf(err, "could not determine absolute path for %s", key)
+	}
+	// Without a CA certificate, the server is verified with the certificate authorities of the system.
+	if ca != "" {
+		if certs.CACertPath, err = fileutil.ExpandPath(strings.TrimRight(ca, "\r\n")); err != nil {
+			return nil, errors.Wrapf(err, "could not determine absolute path for %s", ca)
+		}
+	}
+	newCfg := &web3signer.KeymanagerOpts{}
+	for _, u := range urls {
+		newCfg.Signers = append(newCfg.Signers, &web3signer.SignerConfig{URL: u, RemoteCertificate: certs})
+	}
+	fmt.Printf("%s\n", newCfg)
+	return newCfg, nil
+}
+
 func validateCertPath(input string) error {
 	if input == "" {
 		return errors.New("crt path cannot be empty")
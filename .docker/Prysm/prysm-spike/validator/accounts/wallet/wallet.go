@@ -18,6 +18,7 @@ import (
 	"github.com/prysmaticlabs/prysm/validator/keymanager/derived"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/remote"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/web3signer"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
 )
@@ -25,7 +26,7 @@ import (
 var log = logrus.WithField("prefix", "wallet")
 
 const (
-	// KeymanagerConfigFileName for the keymanager used by the wallet: imported, derived, or remote.
+	// KeymanagerConfigFileName for the keymanager used by the wallet: imported, derived, remote, or web3signer.
 	KeymanagerConfigFileName = "keymanageropts.json"
 	// NewWalletPasswordPromptText for wallet creation.
 	NewWalletPasswordPromptText = "New wallet password"
@@ -51,11 +52,14 @@ var (
 			"If you already did, perhaps you created a wallet in a custom directory, which you can specify using " +
 			"--wallet-dir=/path/to/my/wallet",
 	)
+	// ErrNoFilesFound signifies no file of the wallet matched the requested file name.
+	ErrNoFilesFound = errors.New("no files found")
 	// KeymanagerKindSelections as friendly text.
 	KeymanagerKindSelections = map[keymanager.Kind]string{
-		keymanager.Imported: "Imported Wallet (Recommended)",
-		keymanager.Derived:  "HD Wallet",
-		keymanager.Remote:   "Remote Signing Wallet (Advanced)",
+		keymanager.Imported:   "Imported Wallet (Recommended)",
+		keymanager.Derived:    "HD Wallet",
+		keymanager.Remote:     "Remote Signing Wallet (Advanced)",
+		keymanager.Web3Signer: "Web3Signer Remote Signing Wallet (Advanced)",
 	}
 	// ValidateExistingPass checks that an input cannot be empty.
 	ValidateExistingPass = func(input string) error {
@@ -289,6 +293,21 @@ func (w *Wallet) InitializeKeymanager(ctx context.Context) (keymanager.IKeymanag
 		if err != nil {
 			return nil, errors.Wrap(err, "could not initialize remote keymanager")
 		}
+	case keymanager.Web3Signer:
+		configFile, err := w.ReadKeymanagerConfigFromDisk(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read keymanager config")
+		}
+		opts, err := web3signer.UnmarshalOptionsFile(configFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal keymanager config file")
+		}
+		km, err = web3signer.NewKeymanager(ctx, &web3signer.SetupConfig{
+			Opts: opts,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "could not initialize web3signer keymanager")
+		}
 	default:
 		return nil, fmt.Errorf("keymanager kind not supported: %s", w.keymanagerKind)
 	}
@@ -336,7 +355,7 @@ func (w *Wallet) ReadFileAtPath(_ context.Context, filePath, fileName string) ([
 		return []byte{}, errors.Wrap(err, "could not find file")
 	}
 	if len(matches) == 0 {
-		return []byte{}, fmt.Errorf("no files found %s", fullPath)
+		return []byte{}, fmt.Errorf("%w %s", ErrNoFilesFound, fullPath)
 	}
 	rawData, err := ioutil.ReadFile(matches[0])
 	if err != nil {
@@ -358,7 +377,7 @@ func (w *Wallet) FileNameAtPath(_ context.Context, filePath, fileName string) (s
 		return "", errors.Wrap(err, "could not find file")
 	}
 	if len(matches) == 0 {
-		return "", fmt.Errorf("no files found %s", fullPath)
+		return "", fmt.Errorf("%w %s", ErrNoFilesFound, fullPath)
 	}
 	fullFileName := filepath.Base(matches[0])
 	return fullFileName, nil
@@ -1,6 +1,8 @@
 package wallet_test
 
 import (
+	"context"
+	"errors"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -9,6 +11,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/sirupsen/logrus"
 )
 
@@ -49,3 +52,11 @@ func Test_IsValid_RandomFiles(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, true, valid)
 }
+
+func Test_ReadFileAtPath_NoFilesFound(t *testing.T) {
+	w := wallet.New(&wallet.Config{WalletDir: t.TempDir(), KeymanagerKind: keymanager.Imported})
+	_, err := w.ReadFileAtPath(context.Background(), "accounts", "missing.json")
+	require.Equal(t, true, errors.Is(err, wallet.ErrNoFilesFound))
+	_, err = w.FileNameAtPath(context.Background(), "accounts", "missing.json")
+	require.Equal(t, true, errors.Is(err, wallet.ErrNoFilesFound))
+}
@@ -15,16 +15,21 @@ import (
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/derived"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/remote"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/web3signer"
 	"github.com/urfave/cli/v2"
 )
 
 // CreateWalletConfig defines the parameters needed to call the create wallet functions.
 type CreateWalletConfig struct {
-	WalletCfg            *wallet.Config
-	RemoteKeymanagerOpts *remote.KeymanagerOpts
-	SkipMnemonicConfirm  bool
-	Mnemonic25thWord     string
-	NumAccounts          int
+	WalletCfg                *wallet.Config
+	RemoteKeymanagerOpts     *remote.KeymanagerOpts
+	Web3SignerKeymanagerOpts *web3signer.KeymanagerOpts
+	SkipMnemonicConfirm      bool
+	Mnemonic25thWord         string
+	NumAccounts              int
+	MnemonicLanguage         string
+	MnemonicShares           int
+	MnemonicThreshold        int
 }
 
 // CreateAndSaveWalletCli from user input with a desired keymanager. If a
@@ -74,13 +79,7 @@ func CreateWalletWithKeymanager(ctx context.Context, cfg *CreateWalletConfig) (*
 			"Successfully created wallet with ability to import keystores",
 		)
 	case keymanager.Derived:
-		if err = createDerivedKeymanagerWallet(
-			ctx,
-			w,
-			cfg.Mnemonic25thWord,
-			cfg.SkipMnemonicConfirm,
-			cfg.NumAccounts,
-		); err != nil {
+		if err = createDerivedKeymanagerWallet(ctx, w, cfg); err != nil {
 			return nil, errors.Wrap(err, "could not initialize wallet")
 		}
 		log.WithField("--wallet-dir", cfg.WalletCfg.WalletDir).Info(
@@ -93,6 +92,13 @@ func CreateWalletWithKeymanager(ctx context.Context, cfg *CreateWalletConfig) (*
 		log.WithField("--wallet-dir", cfg.WalletCfg.WalletDir).Info(
 			"Successfully created wallet with remote keymanager configuration",
 		)
+	case keymanager.Web3Signer:
+		if err = createWeb3SignerKeymanagerWallet(ctx, w, cfg.Web3SignerKeymanagerOpts); err != nil {
+			return nil, errors.Wrap(err, "could not initialize wallet")
+		}
+		log.WithField("--wallet-dir", cfg.WalletCfg.WalletDir).Info(
+			"Successfully created wallet with web3signer keymanager configuration",
+		)
 	default:
 		return nil, errors.Wrapf(err, "keymanager type %s is not supported", w.KeymanagerKind())
 	}
@@ -126,6 +132,12 @@ func extractWalletCreationConfigFromCli(cliCtx *cli.Context, keymanagerKind keym
 			WalletPassword: walletPassword,
 		},
 		SkipMnemonicConfirm: cliCtx.Bool(flags.SkipDepositConfirmationFlag.Name),
+		MnemonicLanguage:    cliCtx.String(flags.MnemonicLanguageFlag.Name),
+		MnemonicShares:      cliCtx.Int(flags.MnemonicSharesFlag.Name),
+		MnemonicThreshold:   cliCtx.Int(flags.MnemonicThresholdFlag.Name),
+	}
+	if createWalletConfig.MnemonicShares > 0 && createWalletConfig.MnemonicThreshold <= 0 {
+		return nil, fmt.Errorf("--%s is required with --%s", flags.MnemonicThresholdFlag.Name, flags.MnemonicSharesFlag.Name)
 	}
 	skipMnemonic25thWord := cliCtx.IsSet(flags.SkipMnemonic25thWordCheckFlag.Name)
 	has25thWordFile := cliCtx.IsSet(flags.Mnemonic25thWordFileFlag.Name)
@@ -170,6 +182,13 @@ func extractWalletCreationConfigFromCli(cliCtx *cli.Context, keymanagerKind keym
 		}
 		createWalletConfig.RemoteKeymanagerOpts = opts
 	}
+	if keymanagerKind == keymanager.Web3Signer {
+		opts, err := prompt.InputWeb3SignerKeymanagerConfig(cliCtx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not input web3signer keymanager config")
+		}
+		createWalletConfig.Web3SignerKeymanagerOpts = opts
+	}
 	return createWalletConfig, nil
 }
 
@@ -183,16 +202,15 @@ func createImportedKeymanagerWallet(ctx context.Context, wallet *wallet.Wallet)
 	return nil
 }
 
-func createDerivedKeymanagerWallet(
-	ctx context.Context,
-	wallet *wallet.Wallet,
-	mnemonicPassphrase string,
-	skipMnemonicConfirm bool,
-	numAccounts int,
-) error {
+func createDerivedKeymanagerWallet(ctx context.Context, wallet *wallet.Wallet, cfg *CreateWalletConfig) error {
 	if wallet == nil {
 		return errors.New("nil wallet")
 	}
+	if cfg.MnemonicLanguage != "" {
+		if err := derived.SetMnemonicLanguage(cfg.MnemonicLanguage); err != nil {
+			return err
+		}
+	}
 	if err := wallet.SaveWallet(); err != nil {
 		return errors.Wrap(err, "could not save wallet to disk")
 	}
@@ -202,14 +220,21 @@ func createDerivedKeymanagerWallet(
 	if err != nil {
 		return errors.Wrap(err, "could not initialize HD keymanager")
 	}
-	mnemonic, err := derived.GenerateAndConfirmMnemonic(skipMnemonicConfirm)
+	var mnemonic string
+	if cfg.MnemonicShares > 0 {
+		mnemonic, err = derived.GenerateAndConfirmMnemonicShares(
+			cfg.SkipMnemonicConfirm, cfg.MnemonicThreshold, cfg.MnemonicShares,
+		)
+	} else {
+		mnemonic, err = derived.GenerateAndConfirmMnemonic(cfg.SkipMnemonicConfirm)
+	}
 	if err != nil {
 		return errors.Wrap(err, "could not confirm mnemonic")
 	}
-	if err := km.RecoverAccountsFromMnemonic(ctx, mnemonic, mnemonicPassphrase, numAccounts); err != nil {
+	if err := km.RecoverAccountsFromMnemonic(ctx, mnemonic, cfg.Mnemonic25thWord, cfg.NumAccounts); err != nil {
 		return errors.Wrap(err, "could not recover accounts from mnemonic")
 	}
-	return nil
+	return recordDerivedAccountsMetadata(ctx, wallet, km)
 }
 
 func createRemoteKeymanagerWallet(ctx context.Context, wallet *wallet.Wallet, opts *remote.KeymanagerOpts) error {
@@ -226,6 +251,20 @@ func createRemoteKeymanagerWallet(ctx context.Context, wallet *wallet.Wallet, op
 	return nil
 }
 
+func createWeb3SignerKeymanagerWallet(ctx context.Context, wallet *wallet.Wallet, opts *web3signer.KeymanagerOpts) error {
+	keymanagerConfig, err := web3signer.MarshalOptionsFile(ctx, opts)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal config file")
+	}
+	if err := wallet.SaveWallet(); err != nil {
+		return errors.Wrap(err, "could not save wallet to disk")
+	}
+	if err := wallet.WriteKeymanagerConfigToDisk(ctx, keymanagerConfig); err != nil {
+		return errors.Wrap(err, "could not write keymanager config to disk")
+	}
+	return nil
+}
+
 func inputKeymanagerKind(cliCtx *cli.Context) (keymanager.Kind, error) {
 	if cliCtx.IsSet(flags.KeymanagerKindFlag.Name) {
 		return keymanager.ParseKind(cliCtx.String(flags.KeymanagerKindFlag.Name))
@@ -236,6 +275,7 @@ func inputKeymanagerKind(cliCtx *cli.Context) (keymanager.Kind, error) {
 			wallet.KeymanagerKindSelections[keymanager.Imported],
 			wallet.KeymanagerKindSelections[keymanager.Derived],
 			wallet.KeymanagerKindSelections[keymanager.Remote],
+			wallet.KeymanagerKindSelections[keymanager.Web3Signer],
 		},
 	}
 	selection, _, err := promptSelect.Run()
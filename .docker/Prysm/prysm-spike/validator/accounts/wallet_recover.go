@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
-	"sort"
 	"strconv"
 	"strings"
 
@@ -16,8 +15,6 @@ import (
 	"github.com/prysmaticlabs/prysm/validator/flags"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/derived"
-	"github.com/tyler-smith/go-bip39"
-	"github.com/tyler-smith/go-bip39/wordlists"
 	"github.com/urfave/cli/v2"
 )
 
@@ -137,6 +134,9 @@ func RecoverWallet(ctx context.Context, cfg *RecoverWalletConfig) (*wallet.Walle
 	if err := km.RecoverAccountsFromMnemonic(ctx, cfg.Mnemonic, cfg.Mnemonic25thWord, cfg.NumAccounts); err != nil {
 		return nil, err
 	}
+	if err := recordDerivedAccountsMetadata(ctx, w, km); err != nil {
+		return nil, errors.Wrap(err, "could not record metadata of recovered accounts")
+	}
 	log.WithField("wallet-path", w.AccountsDir()).Infof(
 		"Successfully recovered HD wallet with %d accounts. Please use accounts list to view details for your accounts",
 		cfg.NumAccounts,
@@ -145,6 +145,12 @@ func RecoverWallet(ctx context.Context, cfg *RecoverWalletConfig) (*wallet.Walle
 }
 
 func inputMnemonic(cliCtx *cli.Context) (string, error) {
+	selectedLanguage := cliCtx.String(flags.MnemonicLanguageFlag.Name)
+	if selectedLanguage != "" {
+		if err := derived.SetMnemonicLanguage(selectedLanguage); err != nil {
+			return "", err
+		}
+	}
 	if cliCtx.IsSet(flags.MnemonicFileFlag.Name) {
 		mnemonicFilePath := cliCtx.String(flags.MnemonicFileFlag.Name)
 		data, err := ioutil.ReadFile(mnemonicFilePath)
@@ -157,35 +163,29 @@ func inputMnemonic(cliCtx *cli.Context) (string, error) {
 		}
 		return enteredMnemonic, nil
 	}
-	allowedLanguages := map[string][]string{
-		"english":             wordlists.English,
-		"chinese_simplified":  wordlists.ChineseSimplified,
-		"chinese_traditional": wordlists.ChineseTraditional,
-		"french":              wordlists.French,
-		"italian":             wordlists.Italian,
-		"japanese":            wordlists.Japanese,
-		"korean":              wordlists.Korean,
-		"spanish":             wordlists.Spanish,
-	}
-	languages := make([]string, 0)
-	for k := range allowedLanguages {
-		languages = append(languages, k)
-	}
-	sort.Strings(languages)
-	selectedLanguage, err := promptutil.ValidatePrompt(
-		os.Stdin,
-		fmt.Sprintf("Enter the language of your seed phrase: %s", strings.Join(languages, ", ")),
-		func(input string) error {
-			if _, ok := allowedLanguages[input]; !ok {
-				return errors.New("input not in the list of allowed languages")
-			}
-			return nil
-		},
-	)
-	if err != nil {
-		return "", fmt.Errorf("could not get mnemonic language: %w", err)
+	if cliCtx.IsSet(flags.MnemonicShareFilesFlag.Name) {
+		return inputMnemonicShares(cliCtx.StringSlice(flags.MnemonicShareFilesFlag.Name))
+	}
+	if selectedLanguage == "" {
+		languages := derived.MnemonicLanguageNames()
+		var err error
+		selectedLanguage, err = promptutil.ValidatePrompt(
+			os.Stdin,
+			fmt.Sprintf("Enter the language of your seed phrase: %s", strings.Join(languages, ", ")),
+			func(input string) error {
+				if _, ok := derived.MnemonicLanguages[input]; !ok {
+					return errors.New("input not in the list of allowed languages")
+				}
+				return nil
+			},
+		)
+		if err != nil {
+			return "", fmt.Errorf("could not get mnemonic language: %w", err)
+		}
+		if err := derived.SetMnemonicLanguage(selectedLanguage); err != nil {
+			return "", err
+		}
 	}
-	bip39.SetWordList(allowedLanguages[selectedLanguage])
 	mnemonicPhrase, err := promptutil.ValidatePrompt(
 		os.Stdin,
 		"Enter the seed phrase for the wallet you would like to recover",
@@ -196,6 +196,28 @@ func inputMnemonic(cliCtx *cli.Context) (string, error) {
 	return mnemonicPhrase, nil
 }
 
+// inputMnemonicShares recombines the mnemonic from the SLIP-39 shares stored in the given files.
+// The mnemonic is rebuilt in the selected mnemonic language, which has to be the language the
+// wallet was created with.
+func inputMnemonicShares(shareFilePaths []string) (string, error) {
+	shares := make([]string, len(shareFilePaths))
+	for i, path := range shareFilePaths {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return "", errors.Wrapf(err, "could not read mnemonic share file %s", path)
+		}
+		shares[i] = strings.TrimSpace(string(data))
+	}
+	mnemonic, err := derived.MnemonicFromShares(shares)
+	if err != nil {
+		return "", errors.Wrap(err, "could not recombine mnemonic shares")
+	}
+	if err := validateMnemonic(mnemonic); err != nil {
+		return "", errors.Wrap(err, "mnemonic phrase did not pass validation")
+	}
+	return mnemonic, nil
+}
+
 func inputNumAccounts(cliCtx *cli.Context) (int64, error) {
 	if cliCtx.IsSet(flags.NumAccountsFlag.Name) {
 		numAccounts := cliCtx.Int64(flags.NumAccountsFlag.Name)
@@ -5,13 +5,16 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
 	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/slotutil"
 	"github.com/prysmaticlabs/prysm/shared/timeutils"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
 	"go.opencensus.io/trace"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -26,11 +29,12 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot uint64, pu
 	defer span.End()
 
 	span.AddAttributes(trace.StringAttribute("validator", fmt.Sprintf("%#x", pubKey)))
-	fmtKey := fmt.Sprintf("%#x", pubKey[:])
+	fmtKey := v.metricsLabel(pubKey[:])
 
 	duty, err := v.duty(pubKey)
 	if err != nil {
 		log.Errorf("Could not fetch validator assignment: %v", err)
+		v.recordMissedDuty(ctx, kv.AggregationDuty, slot, pubKey, kv.MissingAssignment, err)
 		if v.emitAccountMetrics {
 			ValidatorAggFailVec.WithLabelValues(fmtKey).Inc()
 		}
@@ -47,9 +51,10 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot uint64, pu
 	v.aggregatedSlotCommitteeIDCache.Add(k, true)
 	v.aggregatedSlotCommitteeIDCacheLock.Unlock()
 
-	slotSig, err := v.signSlot(ctx, pubKey, slot)
+	slotSig, err := v.selectionProof(ctx, pubKey, slot)
 	if err != nil {
-		log.Errorf("Could not sign slot: %v", err)
+		log.Errorf("Could not get selection proof: %v", err)
+		v.recordMissedDuty(ctx, kv.AggregationDuty, slot, pubKey, kv.SigningFailure, err)
 		if v.emitAccountMetrics {
 			ValidatorAggFailVec.WithLabelValues(fmtKey).Inc()
 		}
@@ -60,45 +65,66 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot uint64, pu
 	// to broadcast the best aggregate to the global aggregate channel.
 	// https://github.com/ethereum/eth2.0-specs/blob/v0.9.3/specs/validator/0_beacon-chain-validator.md#broadcast-aggregate
 	v.waitToSlotTwoThirds(ctx, slot)
+	v.submissionQueue.wait(ctx, aggregateSubmission)
 
-	res, err := v.validatorClient.SubmitAggregateSelectionProof(ctx, &ethpb.AggregateSelectionRequest{
-		Slot:           slot,
-		CommitteeIndex: duty.CommitteeIndex,
-		PublicKey:      pubKey[:],
-		SlotSignature:  slotSig,
-	})
-	if err != nil {
-		status, ok := status.FromError(err)
-		if ok && status.Code() == codes.NotFound {
+	var aggregateAndProof *ethpb.AggregateAttestationAndProof
+	if v.beaconAPI != nil {
+		aggregateAndProof, err = v.aggregateAndProofFromBeaconAPI(ctx, slot, duty, slotSig)
+		if errors.Is(err, errBeaconAPINotFound) {
 			log.WithField("slot", slot).WithError(err).Warn("No attestations to aggregate")
-		} else {
-			log.WithField("slot", slot).WithError(err).Error("Could not submit slot signature to beacon node")
-			if v.emitAccountMetrics {
-				ValidatorAggFailVec.WithLabelValues(fmtKey).Inc()
-			}
+			return
+		}
+	} else {
+		var res *ethpb.AggregateSelectionResponse
+		res, err = v.validatorClient.SubmitAggregateSelectionProof(ctx, &ethpb.AggregateSelectionRequest{
+			Slot:           slot,
+			CommitteeIndex: duty.CommitteeIndex,
+			PublicKey:      pubKey[:],
+			SlotSignature:  slotSig,
+		})
+		if status, ok := status.FromError(err); err != nil && ok && status.Code() == codes.NotFound {
+			log.WithField("slot", slot).WithError(err).Warn("No attestations to aggregate")
+			return
+		}
+		if err == nil {
+			aggregateAndProof = res.AggregateAndProof
+		}
+	}
+	if err != nil {
+		log.WithField("slot", slot).WithError(err).Error("Could not get aggregate and proof from beacon node")
+		v.recordMissedDuty(ctx, kv.AggregationDuty, slot, pubKey, kv.BeaconNodeError, err)
+		if v.emitAccountMetrics {
+			ValidatorAggFailVec.WithLabelValues(fmtKey).Inc()
 		}
-
 		return
 	}
 
-	sig, err := v.aggregateAndProofSig(ctx, pubKey, res.AggregateAndProof)
+	sig, err := v.aggregateAndProofSig(ctx, pubKey, aggregateAndProof)
 	if err != nil {
 		log.Errorf("Could not sign aggregate and proof: %v", err)
+		v.recordMissedDuty(ctx, kv.AggregationDuty, slot, pubKey, kv.SigningFailure, err)
 		return
 	}
-	_, err = v.validatorClient.SubmitSignedAggregateSelectionProof(ctx, &ethpb.SignedAggregateSubmitRequest{
-		SignedAggregateAndProof: &ethpb.SignedAggregateAttestationAndProof{
-			Message:   res.AggregateAndProof,
-			Signature: sig,
-		},
-	})
+	signedAggregateAndProof := &ethpb.SignedAggregateAttestationAndProof{
+		Message:   aggregateAndProof,
+		Signature: sig,
+	}
+	if v.beaconAPI != nil {
+		err = v.beaconAPI.SubmitAggregateAndProofs(ctx, []*ethpb.SignedAggregateAttestationAndProof{signedAggregateAndProof})
+	} else {
+		_, err = v.validatorClient.SubmitSignedAggregateSelectionProof(ctx, &ethpb.SignedAggregateSubmitRequest{
+			SignedAggregateAndProof: signedAggregateAndProof,
+		})
+	}
 	if err != nil {
 		log.Errorf("Could not submit signed aggregate and proof to beacon node: %v", err)
+		v.recordMissedDuty(ctx, kv.AggregationDuty, slot, pubKey, kv.SubmissionRejected, err)
 		if v.emitAccountMetrics {
 			ValidatorAggFailVec.WithLabelValues(fmtKey).Inc()
 		}
 		return
 	}
+	v.quarantine.clearAnomalies(pubKey)
 
 	if err := v.addIndicesToLog(duty); err != nil {
 		log.Errorf("Could not add aggregator indices to logs: %v", err)
@@ -113,6 +139,78 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot uint64, pu
 
 }
 
+// aggregateAndProofFromBeaconAPI builds the aggregate and proof for the validator's committee
+// using the standard beacon node REST API. Unlike the gRPC path, where the beacon node assembles
+// the aggregate and proof on the validator's behalf, the validator fetches the best aggregate for
+// its committee's attestation data root and constructs the message itself.
+func (v *validator) aggregateAndProofFromBeaconAPI(
+	ctx context.Context,
+	slot uint64,
+	duty *ethpb.DutiesResponse_Duty,
+	slotSig []byte,
+) (*ethpb.AggregateAttestationAndProof, error) {
+	ctx, span := trace.StartSpan(ctx, "validator.aggregateAndProofFromBeaconAPI")
+	defer span.End()
+
+	data, err := v.beaconAPI.AttestationData(ctx, slot, duty.CommitteeIndex)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get attestation data")
+	}
+	dataRoot, err := data.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute attestation data root")
+	}
+	aggregate, err := v.beaconAPI.AggregateAttestation(ctx, slot, dataRoot)
+	if err != nil {
+		return nil, err
+	}
+	return &ethpb.AggregateAttestationAndProof{
+		AggregatorIndex: duty.ValidatorIndex,
+		Aggregate:       aggregate,
+		SelectionProof:  slotSig,
+	}, nil
+}
+
+// selectionProofsPerKey is the number of selection proofs cached for every validating key, the
+// proofs of its attestation duty in the current and in the next epoch.
+const selectionProofsPerKey = 2
+
+// selectionProof returns the validator's slot signature used both to determine aggregator
+// eligibility and as the selection proof of an aggregate. Signatures are cached so the same slot
+// is not signed twice for a key between the duty update and the aggregation duty.
+func (v *validator) selectionProof(ctx context.Context, pubKey [48]byte, slot uint64) ([]byte, error) {
+	if v.selectionProofCache == nil {
+		return v.signSlot(ctx, pubKey, slot)
+	}
+	k := selectionProofKey(slot, pubKey)
+	if sig, ok := v.selectionProofCache.Get(k); ok {
+		return sig.([]byte), nil
+	}
+	sig, err := v.signSlot(ctx, pubKey, slot)
+	if err != nil {
+		return nil, err
+	}
+	v.selectionProofCache.Add(k, sig)
+	return sig, nil
+}
+
+// resizeSelectionProofCache sizes the selection proof cache so the proofs of the current and
+// next epoch duties of every validating key fit.
+func (v *validator) resizeSelectionProofCache(keys int) {
+	if v.selectionProofCache == nil || keys == 0 {
+		return
+	}
+	v.selectionProofCache.Resize(selectionProofsPerKey * keys)
+}
+
+// This constructs the selection proof cache key of a validator public key at a given slot.
+func selectionProofKey(slot uint64, pubKey [48]byte) [56]byte {
+	var k [56]byte
+	copy(k[:8], bytesutil.Bytes8(slot))
+	copy(k[8:], pubKey[:])
+	return k
+}
+
 // This implements selection logic outlined in:
 // https://github.com/ethereum/eth2.0-specs/blob/v0.9.3/specs/validator/0_beacon-chain-validator.md#aggregation-selection
 func (v *validator) signSlot(ctx context.Context, pubKey [48]byte, slot uint64) ([]byte, error) {
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	lru "github.com/hashicorp/golang-lru"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/prysm/shared/bls"
@@ -125,6 +126,141 @@ func TestSubmitAggregateAndProof_Ok(t *testing.T) {
 	validator.SubmitAggregateAndProof(context.Background(), 0, pubKey)
 }
 
+type mockBeaconAPIAggregator struct {
+	data      *ethpb.AttestationData
+	aggregate *ethpb.Attestation
+	aggErr    error
+	submitted []*ethpb.SignedAggregateAttestationAndProof
+}
+
+func (m *mockBeaconAPIAggregator) AttestationData(_ context.Context, _, _ uint64) (*ethpb.AttestationData, error) {
+	return m.data, nil
+}
+
+func (m *mockBeaconAPIAggregator) AggregateAttestation(_ context.Context, _ uint64, _ [32]byte) (*ethpb.Attestation, error) {
+	return m.aggregate, m.aggErr
+}
+
+func (m *mockBeaconAPIAggregator) SubmitAggregateAndProofs(_ context.Context, aggs []*ethpb.SignedAggregateAttestationAndProof) error {
+	m.submitted = append(m.submitted, aggs...)
+	return nil
+}
+
+func TestSubmitAggregateAndProof_BeaconAPI_Ok(t *testing.T) {
+	validator, m, validatorKey, finish := setup(t)
+	defer finish()
+	pubKey := [48]byte{}
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+	validator.duties = &ethpb.DutiesResponse{
+		Duties: []*ethpb.DutiesResponse_Duty{
+			{
+				PublicKey:      validatorKey.PublicKey().Marshal(),
+				ValidatorIndex: 5,
+				CommitteeIndex: 1,
+			},
+		},
+	}
+	data := &ethpb.AttestationData{
+		CommitteeIndex:  1,
+		BeaconBlockRoot: make([]byte, 32),
+		Target:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+		Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+	}
+	api := &mockBeaconAPIAggregator{
+		data: data,
+		aggregate: &ethpb.Attestation{
+			Data:            data,
+			Signature:       make([]byte, 96),
+			AggregationBits: bitfield.NewBitlist(1),
+		},
+	}
+	validator.beaconAPI = api
+
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), // epoch
+	).Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil /*err*/).Times(2)
+
+	validator.SubmitAggregateAndProof(context.Background(), 0, pubKey)
+
+	require.Equal(t, 1, len(api.submitted))
+	assert.Equal(t, uint64(5), api.submitted[0].Message.AggregatorIndex)
+	assert.DeepEqual(t, api.aggregate, api.submitted[0].Message.Aggregate)
+	assert.Equal(t, 96, len(api.submitted[0].Message.SelectionProof))
+	assert.Equal(t, 96, len(api.submitted[0].Signature))
+}
+
+func TestSubmitAggregateAndProof_BeaconAPI_NoAttestations(t *testing.T) {
+	hook := logTest.NewGlobal()
+	validator, m, validatorKey, finish := setup(t)
+	defer finish()
+	pubKey := [48]byte{}
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+	validator.duties = &ethpb.DutiesResponse{
+		Duties: []*ethpb.DutiesResponse_Duty{
+			{
+				PublicKey: validatorKey.PublicKey().Marshal(),
+			},
+		},
+	}
+	api := &mockBeaconAPIAggregator{
+		data: &ethpb.AttestationData{
+			BeaconBlockRoot: make([]byte, 32),
+			Target:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+			Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+		},
+		aggErr: errBeaconAPINotFound,
+	}
+	validator.beaconAPI = api
+
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), // epoch
+	).Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil /*err*/)
+
+	validator.SubmitAggregateAndProof(context.Background(), 0, pubKey)
+
+	require.LogsContain(t, hook, "No attestations to aggregate")
+	assert.Equal(t, 0, len(api.submitted))
+}
+
+func TestSelectionProof_Cached(t *testing.T) {
+	validator, m, validatorKey, finish := setup(t)
+	defer finish()
+	c, err := lru.New(8)
+	require.NoError(t, err)
+	validator.selectionProofCache = c
+	pubKey := [48]byte{}
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		&ethpb.DomainRequest{Epoch: 0, Domain: params.BeaconConfig().DomainSelectionProof[:]},
+	).Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil /*err*/).Times(1)
+
+	first, err := validator.selectionProof(context.Background(), pubKey, 1)
+	require.NoError(t, err)
+	second, err := validator.selectionProof(context.Background(), pubKey, 1)
+	require.NoError(t, err)
+	assert.DeepEqual(t, first, second)
+}
+
+func TestResizeSelectionProofCache(t *testing.T) {
+	c, err := lru.New(selectionProofsPerKey)
+	require.NoError(t, err)
+	v := &validator{selectionProofCache: c}
+	keys := 3
+	v.resizeSelectionProofCache(keys)
+	for i := 0; i < selectionProofsPerKey*keys; i++ {
+		c.Add(selectionProofKey(uint64(i), [48]byte{byte(i)}), []byte{})
+	}
+	assert.Equal(t, selectionProofsPerKey*keys, c.Len())
+
+	// Keys removed from the wallet no longer keep their proofs.
+	v.resizeSelectionProofCache(1)
+	assert.Equal(t, selectionProofsPerKey, c.Len())
+}
+
 func TestWaitForSlotTwoThird_WaitCorrectly(t *testing.T) {
 	validator, _, _, finish := setup(t)
 	defer finish()
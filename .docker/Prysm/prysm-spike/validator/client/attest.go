@@ -16,6 +16,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/slotutil"
 	"github.com/prysmaticlabs/prysm/shared/timeutils"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
@@ -29,11 +30,12 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot uint64, pubKey [
 	defer span.End()
 	span.AddAttributes(trace.StringAttribute("validator", fmt.Sprintf("%#x", pubKey)))
 
-	fmtKey := fmt.Sprintf("%#x", pubKey[:])
+	fmtKey := v.metricsLabel(pubKey[:])
 	log := log.WithField("pubKey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:]))).WithField("slot", slot)
 	duty, err := v.duty(pubKey)
 	if err != nil {
 		log.WithError(err).Error("Could not fetch validator assignment")
+		v.recordMissedDuty(ctx, kv.AttestationDuty, slot, pubKey, kv.MissingAssignment, err)
 		if v.emitAccountMetrics {
 			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
 		}
@@ -44,27 +46,54 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot uint64, pubKey [
 		return
 	}
 
-	v.waitToSlotOneThird(ctx, slot)
+	queueCtx, queued := v.queueAttestation(ctx, slot, pubKey, duty)
+	v.waitToSlotOneThird(queueCtx, slot)
+	if v.dequeueAttestation(pubKey, queued) {
+		// The assignment was replaced while waiting, attest with the new one if it is still at this slot.
+		duty, err = v.duty(pubKey)
+		if err != nil || duty.AttesterSlot != slot || len(duty.Committee) == 0 {
+			log.Warn("Attester assignment was invalidated by a reorg, not attesting")
+			return
+		}
+		log.WithField("committeeIndex", duty.CommitteeIndex).Info("Attester assignment was moved to another committee by a reorg")
+	}
 
 	req := &ethpb.AttestationDataRequest{
 		Slot:           slot,
 		CommitteeIndex: duty.CommitteeIndex,
 	}
-	data, err := v.validatorClient.GetAttestationData(ctx, req)
+	data, err := v.attestationData(ctx, req)
 	if err != nil {
 		log.WithError(err).Error("Could not request attestation to sign at slot")
+		v.recordMissedDuty(ctx, kv.AttestationDuty, slot, pubKey, kv.BeaconNodeError, err)
 		if v.emitAccountMetrics {
 			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
 		}
 		return
 	}
 
+	if v.attDataVerifier != nil {
+		if err := v.attDataVerifier.verify(ctx, v.beaconClient, req, data); err != nil {
+			if errors.Is(err, errAttestationDataMismatch) && !v.attDataVerifier.preferPrimary {
+				log.WithError(err).Error("Attestation data of the beacon node does not match the secondary beacon node, not attesting")
+				v.recordMissedDuty(ctx, kv.AttestationDuty, slot, pubKey, kv.AttestationDataMismatch, err)
+				if v.emitAccountMetrics {
+					ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
+				}
+				return
+			}
+			log.WithError(err).Warn("Could not verify attestation data against the secondary beacon node, " +
+				"attesting with the data of the beacon node")
+		}
+	}
+
 	indexedAtt := &ethpb.IndexedAttestation{
 		AttestingIndices: []uint64{duty.ValidatorIndex},
 		Data:             data,
 	}
 	if err := v.preAttSignValidations(ctx, indexedAtt, pubKey); err != nil {
 		log.WithError(err).Error("Failed attestation slashing protection check")
+		v.recordMissedDuty(ctx, kv.AttestationDuty, slot, pubKey, kv.SlashingProtection, err)
 		log.WithFields(
 			attestationLogFields(pubKey, indexedAtt),
 		).Debug("Attempted slashable attestation details")
@@ -74,6 +103,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot uint64, pubKey [
 	sig, signingRoot, err := v.signAtt(ctx, pubKey, data)
 	if err != nil {
 		log.WithError(err).Error("Could not sign attestation")
+		v.recordMissedDuty(ctx, kv.AttestationDuty, slot, pubKey, kv.SigningFailure, err)
 		if v.emitAccountMetrics {
 			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
 		}
@@ -108,6 +138,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot uint64, pubKey [
 	indexedAtt.Signature = sig
 	if err := v.postAttSignUpdate(ctx, indexedAtt, pubKey, signingRoot); err != nil {
 		log.WithError(err).Error("Failed attestation slashing protection check")
+		v.recordMissedDuty(ctx, kv.AttestationDuty, slot, pubKey, kv.SlashingProtection, err)
 		log.WithFields(
 			attestationLogFields(pubKey, indexedAtt),
 		).Debug("Attempted slashable attestation details")
@@ -116,14 +147,17 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot uint64, pubKey [
 	if err := v.SaveProtection(ctx, pubKey); err != nil {
 		log.WithError(err).Errorf("Could not save validator: %#x protection", pubKey)
 	}
+	v.submissionQueue.wait(ctx, attestationSubmission)
 	attResp, err := v.validatorClient.ProposeAttestation(ctx, attestation)
 	if err != nil {
 		log.WithError(err).Error("Could not submit attestation to beacon node")
+		v.recordMissedDuty(ctx, kv.AttestationDuty, slot, pubKey, kv.SubmissionRejected, err)
 		if v.emitAccountMetrics {
 			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
 		}
 		return
 	}
+	v.quarantine.clearAnomalies(pubKey)
 
 	if err := v.saveAttesterIndexToData(data, duty.ValidatorIndex); err != nil {
 		log.WithError(err).Error("Could not save validator index for logging")
@@ -151,11 +185,12 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot uint64, pubKey [
 
 // Given the validator public key, this gets the validator assignment.
 func (v *validator) duty(pubKey [48]byte) (*ethpb.DutiesResponse_Duty, error) {
-	if v.duties == nil {
+	duties := v.currentDuties()
+	if duties == nil {
 		return nil, errors.New("no duties for validators")
 	}
 
-	for _, duty := range v.duties.Duties {
+	for _, duty := range duties.Duties {
 		if bytes.Equal(pubKey[:], duty.PublicKey) {
 			return duty, nil
 		}
@@ -216,7 +251,7 @@ func (v *validator) saveAttesterIndexToData(data *ethpb.AttestationData, index u
 }
 
 // waitToSlotOneThird waits until one third through the current slot period
-// such that head block for beacon node can get updated.
+// such that head block for beacon node can get updated, or until the context is done.
 func (v *validator) waitToSlotOneThird(ctx context.Context, slot uint64) {
 	_, span := trace.StartSpan(ctx, "validator.waitToSlotOneThird")
 	defer span.End()
@@ -224,7 +259,10 @@ func (v *validator) waitToSlotOneThird(ctx context.Context, slot uint64) {
 	delay := slotutil.DivideSlotBy(3 /* a third of the slot duration */)
 	startTime := slotutil.SlotStartTime(v.genesisTime, slot)
 	finalTime := startTime.Add(delay)
-	time.Sleep(timeutils.Until(finalTime))
+	select {
+	case <-ctx.Done():
+	case <-time.After(timeutils.Until(finalTime)):
+	}
 }
 
 func attestationLogFields(pubKey [48]byte, indexedAtt *ethpb.IndexedAttestation) logrus.Fields {
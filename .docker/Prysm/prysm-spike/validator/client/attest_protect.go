@@ -7,6 +7,7 @@ import (
 
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/exitcode"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/validator/db/kv"
@@ -18,7 +19,7 @@ var failedPreAttSignExternalErr = "attempted to make slashable attestation, reje
 var failedPostAttSignExternalErr = "external slasher service detected a submitted slashable attestation"
 
 func (v *validator) preAttSignValidations(ctx context.Context, indexedAtt *ethpb.IndexedAttestation, pubKey [48]byte) error {
-	fmtKey := fmt.Sprintf("%#x", pubKey[:])
+	fmtKey := v.metricsLabel(pubKey[:])
 	v.attesterHistoryByPubKeyLock.RLock()
 	attesterHistory, ok := v.attesterHistoryByPubKey[pubKey]
 	v.attesterHistoryByPubKeyLock.RUnlock()
@@ -30,7 +31,7 @@ func (v *validator) preAttSignValidations(ctx context.Context, indexedAtt *ethpb
 		}
 		attesterHistory, ok = attesterHistoryMap[pubKey]
 		if !ok {
-			log.WithField("publicKey", fmtKey).Debug("Could not get local slashing protection data for validator in pre validation")
+			log.WithField("publicKey", fmt.Sprintf("%#x", pubKey[:])).Debug("Could not get local slashing protection data for validator in pre validation")
 		}
 	} else {
 		AttestationMapHit.Inc()
@@ -56,19 +57,25 @@ func (v *validator) preAttSignValidations(ctx context.Context, indexedAtt *ethpb
 		}
 		return errors.New(failedAttLocalProtectionErr)
 	}
+	if v.policies != nil {
+		if err := v.policies.CheckAttestation(ctx, pubKey, indexedAtt.Data); err != nil {
+			countPolicyDenial(err)
+			return err
+		}
+	}
 	if featureconfig.Get().SlasherProtection && v.protector != nil {
 		if !v.protector.CheckAttestationSafety(ctx, indexedAtt) {
 			if v.emitAccountMetrics {
 				ValidatorAttestFailVecSlasher.WithLabelValues(fmtKey).Inc()
 			}
-			return errors.New(failedPreAttSignExternalErr)
+			return exitcode.Wrap(errors.New(failedPreAttSignExternalErr), exitcode.SlashingDetected)
 		}
 	}
 	return nil
 }
 
 func (v *validator) postAttSignUpdate(ctx context.Context, indexedAtt *ethpb.IndexedAttestation, pubKey [48]byte, signingRoot [32]byte) error {
-	fmtKey := fmt.Sprintf("%#x", pubKey[:])
+	fmtKey := v.metricsLabel(pubKey[:])
 	v.attesterHistoryByPubKeyLock.Lock()
 	defer v.attesterHistoryByPubKeyLock.Unlock()
 	attesterHistory, ok := v.attesterHistoryByPubKey[pubKey]
@@ -80,7 +87,7 @@ func (v *validator) postAttSignUpdate(ctx context.Context, indexedAtt *ethpb.Ind
 		}
 		attesterHistory, ok = attesterHistoryMap[pubKey]
 		if !ok {
-			log.WithField("publicKey", fmtKey).Debug("Could not get local slashing protection data for validator in post validation")
+			log.WithField("publicKey", fmt.Sprintf("%#x", pubKey[:])).Debug("Could not get local slashing protection data for validator in post validation")
 		}
 	} else {
 		AttestationMapHit.Inc()
@@ -120,7 +127,7 @@ func (v *validator) postAttSignUpdate(ctx context.Context, indexedAtt *ethpb.Ind
 			if v.emitAccountMetrics {
 				ValidatorAttestFailVecSlasher.WithLabelValues(fmtKey).Inc()
 			}
-			return errors.New(failedPostAttSignExternalErr)
+			return exitcode.Wrap(errors.New(failedPostAttSignExternalErr), exitcode.SlashingDetected)
 		}
 	}
 
@@ -0,0 +1,210 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"go.opencensus.io/trace"
+)
+
+// errAttestationDataMismatch is attestation data of the beacon node differing from the
+// attestation data of the secondary beacon node.
+var errAttestationDataMismatch = errors.New("attestation data does not match the secondary beacon node")
+
+// Results of attestation data verifications.
+const (
+	attestationDataMatch    = "match"
+	attestationDataMismatch = "mismatch"
+	attestationDataError    = "error"
+)
+
+var attestationDataVerifications = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: "validator",
+		Name:      "attestation_data_verifications_total",
+		Help:      "Count the checks of attestation data against the secondary beacon node, by result.",
+	},
+	[]string{
+		"result",
+	},
+)
+
+// attestationDataVerifier checks the attestation data of the beacon node against the attestation
+// data of a secondary beacon node before it is signed, to catch a malfunctioning beacon node
+// computing bad votes. The source and target checkpoints have to be equal. The head block may
+// differ when a block reached one beacon node but not yet the other, as long as the head blocks
+// are at most headTolerance slots apart. The validators of a committee attest to the same data, so
+// the requests for a slot are sent once and their responses shared by the duties of the slot.
+type attestationDataVerifier struct {
+	validatorClient ethpb.BeaconNodeValidatorClient // Of the secondary beacon node.
+	beaconClient    ethpb.BeaconChainClient         // Of the secondary beacon node.
+	headTolerance   uint64
+	preferPrimary   bool // Signs mismatching attestation data instead of skipping the attestation.
+	lock            sync.Mutex
+	slot            uint64                           // Slot of the shared responses.
+	responses       map[string]*sharedVerifyResponse // Responses of the slot, by request.
+}
+
+// sharedVerifyResponse is the response to a request of the verification, shared by the duties
+// verifying attestation data of the same slot. It is set when done is closed.
+type sharedVerifyResponse struct {
+	done      chan struct{}
+	data      *ethpb.AttestationData
+	blockSlot uint64
+	err       error
+}
+
+// verify checks the attestation data the beacon node returned for the request. It returns an
+// error wrapping errAttestationDataMismatch for data not matching the secondary beacon node, and
+// other errors when the attestation data could not be checked.
+func (a *attestationDataVerifier) verify(
+	ctx context.Context, primary ethpb.BeaconChainClient, req *ethpb.AttestationDataRequest, data *ethpb.AttestationData,
+) error {
+	ctx, span := trace.StartSpan(ctx, "validator.verifyAttestationData")
+	defer span.End()
+
+	err := a.compare(ctx, primary, req, data)
+	switch {
+	case err == nil:
+		attestationDataVerifications.WithLabelValues(attestationDataMatch).Inc()
+	case errors.Is(err, errAttestationDataMismatch):
+		attestationDataVerifications.WithLabelValues(attestationDataMismatch).Inc()
+	default:
+		attestationDataVerifications.WithLabelValues(attestationDataError).Inc()
+	}
+	return err
+}
+
+func (a *attestationDataVerifier) compare(
+	ctx context.Context, primary ethpb.BeaconChainClient, req *ethpb.AttestationDataRequest, data *ethpb.AttestationData,
+) error {
+	dataKey := fmt.Sprintf("data/%d", req.CommitteeIndex)
+	resp, err := a.shared(ctx, req.Slot, dataKey, func() (*sharedVerifyResponse, error) {
+		data, err := a.validatorClient.GetAttestationData(ctx, req)
+		return &sharedVerifyResponse{data: data}, err
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not request attestation data from the secondary beacon node")
+	}
+	secondary := resp.data
+	if !checkpointsEqual(data.Source, secondary.Source) {
+		return errors.Wrapf(errAttestationDataMismatch, "source checkpoint %s, secondary beacon node %s",
+			checkpointString(data.Source), checkpointString(secondary.Source))
+	}
+	if !checkpointsEqual(data.Target, secondary.Target) {
+		return errors.Wrapf(errAttestationDataMismatch, "target checkpoint %s, secondary beacon node %s",
+			checkpointString(data.Target), checkpointString(secondary.Target))
+	}
+	if bytes.Equal(data.BeaconBlockRoot, secondary.BeaconBlockRoot) {
+		return nil
+	}
+	if a.headTolerance == 0 {
+		return errors.Wrapf(errAttestationDataMismatch, "head block %#x, secondary beacon node %#x",
+			data.BeaconBlockRoot, secondary.BeaconBlockRoot)
+	}
+	headSlot, err := a.sharedBlockSlot(ctx, req.Slot, "primary", primary, data.BeaconBlockRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not get slot of head block from the beacon node")
+	}
+	secondaryHeadSlot, err := a.sharedBlockSlot(ctx, req.Slot, "secondary", a.beaconClient, secondary.BeaconBlockRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not get slot of head block from the secondary beacon node")
+	}
+	distance := headSlot - secondaryHeadSlot
+	if secondaryHeadSlot > headSlot {
+		distance = secondaryHeadSlot - headSlot
+	}
+	if distance > a.headTolerance {
+		return errors.Wrapf(errAttestationDataMismatch, "head block %#x at slot %d, secondary beacon node %#x at slot %d",
+			data.BeaconBlockRoot, headSlot, secondary.BeaconBlockRoot, secondaryHeadSlot)
+	}
+	return nil
+}
+
+// sharedBlockSlot returns the slot of the block with the root in the named beacon node, requested
+// once for the duties of the slot.
+func (a *attestationDataVerifier) sharedBlockSlot(
+	ctx context.Context, slot uint64, node string, client ethpb.BeaconChainClient, root []byte,
+) (uint64, error) {
+	resp, err := a.shared(ctx, slot, fmt.Sprintf("%s/%#x", node, root), func() (*sharedVerifyResponse, error) {
+		blockSlot, err := blockSlot(ctx, client, root)
+		return &sharedVerifyResponse{blockSlot: blockSlot}, err
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.blockSlot, nil
+}
+
+// shared returns the response to the request with the key for the slot, sending the request when
+// no duty of the slot sent it yet, and waiting for the response otherwise. The responses of the
+// previous slots are dropped, as attestation data is only requested for the current slot.
+func (a *attestationDataVerifier) shared(
+	ctx context.Context, slot uint64, key string, request func() (*sharedVerifyResponse, error),
+) (*sharedVerifyResponse, error) {
+	a.lock.Lock()
+	if a.responses == nil || slot > a.slot {
+		a.slot = slot
+		a.responses = make(map[string]*sharedVerifyResponse)
+	}
+	var resp *sharedVerifyResponse
+	if slot == a.slot {
+		resp = a.responses[key]
+	}
+	if resp != nil {
+		a.lock.Unlock()
+		select {
+		case <-resp.done:
+			return resp, resp.err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	resp = &sharedVerifyResponse{done: make(chan struct{})}
+	if slot == a.slot {
+		a.responses[key] = resp
+	}
+	a.lock.Unlock()
+
+	r, err := request()
+	if r != nil {
+		resp.data, resp.blockSlot = r.data, r.blockSlot
+	}
+	resp.err = err
+	close(resp.done)
+	return resp, err
+}
+
+// blockSlot returns the slot of the block with the root in the beacon node.
+func blockSlot(ctx context.Context, client ethpb.BeaconChainClient, root []byte) (uint64, error) {
+	resp, err := client.ListBlocks(ctx, &ethpb.ListBlocksRequest{
+		QueryFilter: &ethpb.ListBlocksRequest_Root{Root: root},
+	})
+	if err != nil {
+		return 0, err
+	}
+	if len(resp.BlockContainers) == 0 || resp.BlockContainers[0].Block == nil || resp.BlockContainers[0].Block.Block == nil {
+		return 0, fmt.Errorf("block %#x not found", root)
+	}
+	return resp.BlockContainers[0].Block.Block.Slot, nil
+}
+
+func checkpointsEqual(a, b *ethpb.Checkpoint) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Epoch == b.Epoch && bytes.Equal(a.Root, b.Root)
+}
+
+func checkpointString(cp *ethpb.Checkpoint) string {
+	if cp == nil {
+		return "nil"
+	}
+	return fmt.Sprintf("%d/%#x", cp.Epoch, cp.Root)
+}
@@ -0,0 +1,190 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func verifiedAttestationData(head byte, targetEpoch uint64) *ethpb.AttestationData {
+	return &ethpb.AttestationData{
+		Slot:            10,
+		BeaconBlockRoot: bytesutil.PadTo([]byte{head}, 32),
+		Source:          &ethpb.Checkpoint{Epoch: 0, Root: bytesutil.PadTo([]byte{'s'}, 32)},
+		Target:          &ethpb.Checkpoint{Epoch: targetEpoch, Root: bytesutil.PadTo([]byte{'t'}, 32)},
+	}
+}
+
+func expectBlockSlot(client *mock.MockBeaconChainClient, root []byte, slot uint64) {
+	client.EXPECT().ListBlocks(
+		gomock.Any(), // ctx
+		&ethpb.ListBlocksRequest{QueryFilter: &ethpb.ListBlocksRequest_Root{Root: root}},
+	).Return(&ethpb.ListBlocksResponse{
+		BlockContainers: []*ethpb.BeaconBlockContainer{
+			{Block: &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: slot}}},
+		},
+	}, nil)
+}
+
+func TestAttestationDataVerifier_Verify(t *testing.T) {
+	req := &ethpb.AttestationDataRequest{Slot: 10}
+	tests := []struct {
+		name          string
+		primary       *ethpb.AttestationData
+		secondary     *ethpb.AttestationData
+		primarySlot   uint64
+		secondarySlot uint64
+		mismatch      bool
+	}{
+		{
+			name:      "equal data",
+			primary:   verifiedAttestationData('a', 1),
+			secondary: verifiedAttestationData('a', 1),
+		},
+		{
+			name:      "different target",
+			primary:   verifiedAttestationData('a', 1),
+			secondary: verifiedAttestationData('a', 2),
+			mismatch:  true,
+		},
+		{
+			name:          "head within tolerance",
+			primary:       verifiedAttestationData('a', 1),
+			secondary:     verifiedAttestationData('b', 1),
+			primarySlot:   10,
+			secondarySlot: 9,
+		},
+		{
+			name:          "head beyond tolerance",
+			primary:       verifiedAttestationData('a', 1),
+			secondary:     verifiedAttestationData('b', 1),
+			primarySlot:   6,
+			secondarySlot: 10,
+			mismatch:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			primaryClient := mock.NewMockBeaconChainClient(ctrl)
+			secondaryValidatorClient := mock.NewMockBeaconNodeValidatorClient(ctrl)
+			secondaryClient := mock.NewMockBeaconChainClient(ctrl)
+			secondaryValidatorClient.EXPECT().GetAttestationData(gomock.Any(), req).Return(tt.secondary, nil)
+			if tt.primarySlot != 0 {
+				expectBlockSlot(primaryClient, tt.primary.BeaconBlockRoot, tt.primarySlot)
+				expectBlockSlot(secondaryClient, tt.secondary.BeaconBlockRoot, tt.secondarySlot)
+			}
+			verifier := &attestationDataVerifier{
+				validatorClient: secondaryValidatorClient,
+				beaconClient:    secondaryClient,
+				headTolerance:   1,
+			}
+
+			err := verifier.verify(context.Background(), primaryClient, req, tt.primary)
+			if tt.mismatch {
+				assert.Equal(t, true, errors.Is(err, errAttestationDataMismatch), "Expected mismatch, got %v", err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAttestationDataVerifier_SharesRequestsOfSlot(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	primaryClient := mock.NewMockBeaconChainClient(ctrl)
+	secondaryValidatorClient := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	secondaryClient := mock.NewMockBeaconChainClient(ctrl)
+	req := &ethpb.AttestationDataRequest{Slot: 10, CommitteeIndex: 1}
+	// Each request is sent once for all the validators of the committee.
+	secondaryValidatorClient.EXPECT().GetAttestationData(gomock.Any(), req).Return(verifiedAttestationData('b', 1), nil)
+	expectBlockSlot(primaryClient, verifiedAttestationData('a', 1).BeaconBlockRoot, 10)
+	expectBlockSlot(secondaryClient, verifiedAttestationData('b', 1).BeaconBlockRoot, 9)
+	verifier := &attestationDataVerifier{
+		validatorClient: secondaryValidatorClient,
+		beaconClient:    secondaryClient,
+		headTolerance:   1,
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, 8)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = verifier.verify(context.Background(), primaryClient, req, verifiedAttestationData('a', 1))
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+
+	// Another committee and the next slot are requested again.
+	other := &ethpb.AttestationDataRequest{Slot: 10, CommitteeIndex: 2}
+	secondaryValidatorClient.EXPECT().GetAttestationData(gomock.Any(), other).Return(verifiedAttestationData('a', 1), nil)
+	require.NoError(t, verifier.verify(context.Background(), primaryClient, other, verifiedAttestationData('a', 1)))
+	next := &ethpb.AttestationDataRequest{Slot: 11, CommitteeIndex: 1}
+	secondaryValidatorClient.EXPECT().GetAttestationData(gomock.Any(), next).Return(verifiedAttestationData('a', 1), nil)
+	require.NoError(t, verifier.verify(context.Background(), primaryClient, next, verifiedAttestationData('a', 1)))
+	assert.Equal(t, 1, len(verifier.responses), "Expected the responses of the previous slot to be dropped")
+}
+
+func TestAttestationDataVerifier_SecondaryError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	secondaryValidatorClient := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	secondaryValidatorClient.EXPECT().GetAttestationData(gomock.Any(), gomock.Any()).Return(nil, errors.New("unavailable"))
+	verifier := &attestationDataVerifier{validatorClient: secondaryValidatorClient}
+
+	err := verifier.verify(context.Background(), nil, &ethpb.AttestationDataRequest{}, verifiedAttestationData('a', 1))
+	require.ErrorContains(t, "unavailable", err)
+	assert.Equal(t, false, errors.Is(err, errAttestationDataMismatch), "Expected error not to be a mismatch")
+}
+
+func TestAttestToBlockHead_SubmitAttestation_AttestationDataMismatch(t *testing.T) {
+	hook := logTest.NewGlobal()
+	validator, m, validatorKey, finish := setup(t)
+	defer finish()
+	pubKey := [48]byte{}
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+	validator.duties = &ethpb.DutiesResponse{Duties: []*ethpb.DutiesResponse_Duty{
+		{
+			PublicKey:      validatorKey.PublicKey().Marshal(),
+			CommitteeIndex: 5,
+			Committee:      make([]uint64, 111),
+			ValidatorIndex: 0,
+		}}}
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	secondaryValidatorClient := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	validator.attDataVerifier = &attestationDataVerifier{validatorClient: secondaryValidatorClient}
+
+	m.validatorClient.EXPECT().GetAttestationData(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&ethpb.AttestationDataRequest{}),
+	).Return(verifiedAttestationData('a', 1), nil)
+	secondaryValidatorClient.EXPECT().GetAttestationData(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&ethpb.AttestationDataRequest{}),
+	).Return(verifiedAttestationData('a', 2), nil)
+
+	validator.SubmitAttestation(context.Background(), 30, pubKey)
+	require.LogsContain(t, hook, "does not match the secondary beacon node, not attesting")
+	duties, err := validator.db.MissedDuties(context.Background(), &kv.MissedDutyFilter{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(duties))
+	assert.Equal(t, kv.AttestationDataMismatch, duties[0].Failure)
+}
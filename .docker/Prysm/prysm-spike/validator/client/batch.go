@@ -0,0 +1,155 @@
+package client
+
+import (
+	"context"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// maxDutiesBatchRetries is how many times the batches of a duties request are requested again when
+// the head changed while they were requested.
+const maxDutiesBatchRetries = 3
+
+// errDutiesDependOnDifferentBlocks is returned when the batches of a duties request were computed on
+// different heads.
+var errDutiesDependOnDifferentBlocks = errors.New("duties batches depend on different blocks, the head changed while they were requested")
+
+// batchRanges splits n items into consecutive [start, end) ranges of at most size items, or a
+// single range when size is 0.
+func batchRanges(n int, size uint64) [][2]int {
+	if size == 0 || uint64(n) <= size {
+		return [][2]int{{0, n}}
+	}
+	ranges := make([][2]int, 0, (uint64(n)+size-1)/size)
+	for start := 0; start < n; start += int(size) {
+		end := start + int(size)
+		if end > n {
+			end = n
+		}
+		ranges = append(ranges, [2]int{start, end})
+	}
+	return ranges
+}
+
+// fetchDuties requests the duties along with the proposer dependent root the beacon node returns in
+// the response header. The keys are split into batches of the duties batch size, which are requested
+// in parallel so the response for thousands of keys stays under the gRPC message size limit. When
+// the head changes while the batches are requested, they are all requested again.
+func (v *validator) fetchDuties(ctx context.Context, req *ethpb.DutiesRequest) (*ethpb.DutiesResponse, string, error) {
+	ranges := batchRanges(len(req.PublicKeys), v.dutiesBatchSize)
+	if len(ranges) == 1 {
+		return v.fetchDutiesBatch(ctx, req)
+	}
+	for attempt := 0; ; attempt++ {
+		resp, root, err := v.fetchDutiesBatches(ctx, req, ranges)
+		if !errors.Is(err, errDutiesDependOnDifferentBlocks) || attempt == maxDutiesBatchRetries {
+			return resp, root, err
+		}
+		log.WithField("epoch", req.Epoch).Debug("Head changed while duties were requested, requesting them again")
+	}
+}
+
+// fetchDutiesBatches requests the batches of the keys in parallel, and merges their duties.
+func (v *validator) fetchDutiesBatches(ctx context.Context, req *ethpb.DutiesRequest, ranges [][2]int) (*ethpb.DutiesResponse, string, error) {
+	resps := make([]*ethpb.DutiesResponse, len(ranges))
+	roots := make([]string, len(ranges))
+	errs := make([]error, len(ranges))
+	var wg sync.WaitGroup
+	for i, r := range ranges {
+		wg.Add(1)
+		go func(i int, keys [][]byte) {
+			defer wg.Done()
+			resps[i], roots[i], errs[i] = v.fetchDutiesBatch(ctx, &ethpb.DutiesRequest{
+				Epoch:      req.Epoch,
+				PublicKeys: keys,
+			})
+		}(i, req.PublicKeys[r[0]:r[1]])
+	}
+	wg.Wait()
+
+	resp := &ethpb.DutiesResponse{}
+	for i := range ranges {
+		if errs[i] != nil {
+			return nil, "", errs[i]
+		}
+		// Duties depending on different blocks would mix proposers from before and after a reorg.
+		if roots[i] != roots[0] {
+			return nil, "", errDutiesDependOnDifferentBlocks
+		}
+		resp.Duties = append(resp.Duties, resps[i].Duties...)
+		resp.CurrentEpochDuties = append(resp.CurrentEpochDuties, resps[i].CurrentEpochDuties...)
+		resp.NextEpochDuties = append(resp.NextEpochDuties, resps[i].NextEpochDuties...)
+	}
+	return resp, roots[0], nil
+}
+
+// fetchDutiesBatch requests the duties of the keys in a single request.
+func (v *validator) fetchDutiesBatch(ctx context.Context, req *ethpb.DutiesRequest) (*ethpb.DutiesResponse, string, error) {
+	var header metadata.MD
+	resp, err := v.validatorClient.GetDuties(ctx, req, grpc.Header(&header))
+	if err != nil {
+		return nil, "", err
+	}
+	var dependentRoot string
+	if values := header.Get(grpcutils.ProposerDependentRootHeader); len(values) > 0 {
+		dependentRoot = values[0]
+	}
+	return resp, dependentRoot, nil
+}
+
+// attestationDataCalls are the attestation data requests in flight by slot and committee index.
+type attestationDataCalls struct {
+	lock  sync.Mutex
+	calls map[[2]uint64]*attestationDataCall
+}
+
+// attestationDataCall is an attestation data request shared by the keys of a committee.
+type attestationDataCall struct {
+	done    chan struct{}
+	waiters int // Keys waiting for the request, besides the one which sent it.
+	data    *ethpb.AttestationData
+	err     error
+}
+
+// attestationData requests the attestation data of the slot and committee of the request. All keys
+// of a committee attest to the same data, so keys requesting it while a request is in flight share
+// its response instead of each sending their own. Responses are not kept once the request is done,
+// so keys attesting later, such as after a reorg, get the data of the current head.
+func (v *validator) attestationData(ctx context.Context, req *ethpb.AttestationDataRequest) (*ethpb.AttestationData, error) {
+	key := [2]uint64{req.Slot, req.CommitteeIndex}
+	c := &v.attestationDataCalls
+	c.lock.Lock()
+	call, ok := c.calls[key]
+	if !ok {
+		call = &attestationDataCall{done: make(chan struct{})}
+		if c.calls == nil {
+			c.calls = make(map[[2]uint64]*attestationDataCall)
+		}
+		c.calls[key] = call
+		c.lock.Unlock()
+
+		call.data, call.err = v.validatorClient.GetAttestationData(ctx, req)
+		c.lock.Lock()
+		delete(c.calls, key)
+		c.lock.Unlock()
+		close(call.done)
+	} else {
+		call.waiters++
+		c.lock.Unlock()
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-call.done:
+		}
+	}
+	if call.err != nil {
+		return nil, call.err
+	}
+	return proto.Clone(call.data).(*ethpb.AttestationData), nil
+}
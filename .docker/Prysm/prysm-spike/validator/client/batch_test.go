@@ -0,0 +1,221 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/golang/mock/gomock"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+)
+
+func TestBatchRanges(t *testing.T) {
+	assert.DeepEqual(t, [][2]int{{0, 5}}, batchRanges(5, 0))
+	assert.DeepEqual(t, [][2]int{{0, 5}}, batchRanges(5, 5))
+	assert.DeepEqual(t, [][2]int{{0, 2}, {2, 4}, {4, 5}}, batchRanges(5, 2))
+	assert.DeepEqual(t, [][2]int{{0, 0}}, batchRanges(0, 2))
+}
+
+func TestFetchDuties_Batches(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	v := validator{validatorClient: client, dutiesBatchSize: 2}
+
+	var lock sync.Mutex
+	var batchSizes []int
+	client.EXPECT().GetDuties(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, req *ethpb.DutiesRequest, opts ...grpc.CallOption) (*ethpb.DutiesResponse, error) {
+			lock.Lock()
+			batchSizes = append(batchSizes, len(req.PublicKeys))
+			lock.Unlock()
+			duties := make([]*ethpb.DutiesResponse_Duty, len(req.PublicKeys))
+			for i, key := range req.PublicKeys {
+				duties[i] = &ethpb.DutiesResponse_Duty{PublicKey: key}
+			}
+			return returnDutiesWithRoot(&ethpb.DutiesResponse{Duties: duties, CurrentEpochDuties: duties}, "0x01")(ctx, req, opts...)
+		},
+	).Times(3)
+
+	keys := [][]byte{{1}, {2}, {3}, {4}, {5}}
+	resp, root, err := v.fetchDuties(context.Background(), &ethpb.DutiesRequest{Epoch: 1, PublicKeys: keys})
+	require.NoError(t, err)
+	assert.Equal(t, "0x01", root)
+	assert.Equal(t, 3, len(batchSizes))
+	require.Equal(t, len(keys), len(resp.Duties))
+	require.Equal(t, len(keys), len(resp.CurrentEpochDuties))
+	for i, key := range keys {
+		assert.DeepEqual(t, key, resp.Duties[i].PublicKey, "Expected duties in the order of the keys")
+	}
+}
+
+func TestFetchDuties_BatchesDependOnDifferentBlocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	v := validator{validatorClient: client, dutiesBatchSize: 1}
+
+	// The head changes while the first batches are requested, and the batches are requested again.
+	var lock sync.Mutex
+	var requests int
+	client.EXPECT().GetDuties(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, req *ethpb.DutiesRequest, opts ...grpc.CallOption) (*ethpb.DutiesResponse, error) {
+			lock.Lock()
+			requests++
+			root := "0x02"
+			if requests == 1 {
+				root = "0x01"
+			}
+			lock.Unlock()
+			return returnDutiesWithRoot(&ethpb.DutiesResponse{}, root)(ctx, req, opts...)
+		},
+	).Times(4)
+
+	_, root, err := v.fetchDuties(context.Background(), &ethpb.DutiesRequest{PublicKeys: [][]byte{{1}, {2}}})
+	require.NoError(t, err)
+	assert.Equal(t, "0x02", root)
+}
+
+func TestFetchDuties_BatchesKeepDependingOnDifferentBlocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	v := validator{validatorClient: client, dutiesBatchSize: 1}
+
+	client.EXPECT().GetDuties(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, req *ethpb.DutiesRequest, opts ...grpc.CallOption) (*ethpb.DutiesResponse, error) {
+			root := "0x01"
+			if req.PublicKeys[0][0] == 2 {
+				root = "0x02"
+			}
+			return returnDutiesWithRoot(&ethpb.DutiesResponse{}, root)(ctx, req, opts...)
+		},
+	).Times(2 * (maxDutiesBatchRetries + 1))
+
+	_, _, err := v.fetchDuties(context.Background(), &ethpb.DutiesRequest{PublicKeys: [][]byte{{1}, {2}}})
+	assert.ErrorContains(t, "depend on different blocks", err)
+}
+
+func TestAttestationData_SharedByCommittee(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	v := validator{validatorClient: client}
+
+	// Requests of the same committee wait for the one in flight.
+	release := make(chan struct{})
+	client.EXPECT().GetAttestationData(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *ethpb.AttestationDataRequest, _ ...grpc.CallOption) (*ethpb.AttestationData, error) {
+			<-release
+			return &ethpb.AttestationData{Slot: req.Slot, CommitteeIndex: req.CommitteeIndex}, nil
+		},
+	).Times(2)
+
+	const keys = 4
+	datas := make([]*ethpb.AttestationData, keys+1)
+	var wg sync.WaitGroup
+	for i := 0; i < keys; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			var err error
+			datas[i], err = v.attestationData(context.Background(), &ethpb.AttestationDataRequest{Slot: 1, CommitteeIndex: 2})
+			assert.NoError(t, err)
+		}(i)
+	}
+	// Wait for all keys to share the request in flight before answering it.
+	for {
+		v.attestationDataCalls.lock.Lock()
+		call := v.attestationDataCalls.calls[[2]uint64{1, 2}]
+		joined := call != nil && call.waiters == keys-1
+		v.attestationDataCalls.lock.Unlock()
+		if joined {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	close(release)
+	wg.Wait()
+	for i := 0; i < keys; i++ {
+		require.NotNil(t, datas[i])
+		assert.Equal(t, uint64(2), datas[i].CommitteeIndex)
+	}
+
+	// Once the request is done, the data is requested again.
+	var err error
+	datas[keys], err = v.attestationData(context.Background(), &ethpb.AttestationDataRequest{Slot: 1, CommitteeIndex: 2})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(v.attestationDataCalls.calls))
+}
+
+func TestSubscribeToCommitteeSubnets_UsesNextEpochDuties(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	v := validator{validatorClient: client}
+
+	// Duties of the next epoch are in the response, so no more duties are requested.
+	res := &ethpb.DutiesResponse{
+		NextEpochDuties: []*ethpb.DutiesResponse_Duty{{PublicKey: []byte{1}, Status: ethpb.ValidatorStatus_PENDING}},
+	}
+	require.NoError(t, v.subscribeToCommitteeSubnets(context.Background(), &ethpb.DutiesRequest{PublicKeys: [][]byte{{1}}}, res))
+}
+
+func TestSendSubnetSubscriptions_Batches(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	v := validator{validatorClient: client, dutiesBatchSize: 2}
+
+	client.EXPECT().SubscribeCommitteeSubnets(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *ethpb.CommitteeSubnetsSubscribeRequest, _ ...grpc.CallOption) (*ptypes.Empty, error) {
+			if req.Slots[0] == 3 {
+				return nil, errors.New("bad")
+			}
+			return &ptypes.Empty{}, nil
+		},
+	).Times(2)
+
+	subs := []*subnetSubscription{{slot: 1}, {slot: 2}, {slot: 3}}
+	assert.ErrorContains(t, "bad", v.sendSubnetSubscriptions(context.Background(), subs))
+	assert.Equal(t, 2, len(v.subnetSubscriptions.sent))
+	pending := v.subnetSubscriptions.takePending(0)
+	require.Equal(t, 1, len(pending), "Expected the subscriptions of the failed batch to be retried")
+	assert.Equal(t, uint64(3), pending[0].slot)
+}
+
+func TestDomainData_RequestedOncePerEpoch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	v := validator{validatorClient: client}
+	domain := params.BeaconConfig().DomainBeaconAttester[:]
+
+	client.EXPECT().DomainData(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *ethpb.DomainRequest) (*ethpb.DomainResponse, error) {
+			return &ethpb.DomainResponse{SignatureDomain: []byte{byte(req.Epoch)}}, nil
+		},
+	).Times(4)
+
+	for i := 0; i < 3; i++ {
+		res, err := v.domainData(context.Background(), 1, domain)
+		require.NoError(t, err)
+		assert.DeepEqual(t, []byte{1}, res.SignatureDomain)
+	}
+	_, err := v.domainData(context.Background(), 2, domain)
+	require.NoError(t, err)
+	_, err = v.domainData(context.Background(), 3, domain)
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(v.domainDataByEpoch), "Expected domains of old epochs to be dropped")
+	// Epoch 1 was dropped and is requested again.
+	_, err = v.domainData(context.Background(), 1, domain)
+	require.NoError(t, err)
+}
@@ -0,0 +1,283 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+)
+
+const (
+	attestationDataPath      = "/eth/v1/validator/attestation_data"
+	aggregateAttestationPath = "/eth/v1/validator/aggregate_attestation"
+	aggregateAndProofsPath   = "/eth/v1/validator/aggregate_and_proofs"
+	beaconAPIRequestTimeout  = 10 * time.Second
+)
+
+// errBeaconAPINotFound is returned when the beacon node has no data for a standard API query,
+// such as when there are no attestations to aggregate for a given attestation data root.
+var errBeaconAPINotFound = errors.New("not found")
+
+// beaconAPIAggregator defines the standard beacon node REST API calls required to
+// perform the aggregation duty without the Prysm specific gRPC endpoints.
+type beaconAPIAggregator interface {
+	AttestationData(ctx context.Context, slot, committeeIndex uint64) (*ethpb.AttestationData, error)
+	AggregateAttestation(ctx context.Context, slot uint64, attDataRoot [32]byte) (*ethpb.Attestation, error)
+	SubmitAggregateAndProofs(ctx context.Context, aggs []*ethpb.SignedAggregateAttestationAndProof) error
+}
+
+// beaconAPIClient talks to a beacon node via the standard eth2 REST API.
+type beaconAPIClient struct {
+	endpoint   string
+	httpClient *http.Client
+}
+
+var _ beaconAPIAggregator = (*beaconAPIClient)(nil)
+
+func newBeaconAPIClient(endpoint string) *beaconAPIClient {
+	if !strings.HasPrefix(endpoint, "http://") && !strings.HasPrefix(endpoint, "https://") {
+		endpoint = "http://" + endpoint
+	}
+	return &beaconAPIClient{
+		endpoint:   strings.TrimSuffix(endpoint, "/"),
+		httpClient: &http.Client{Timeout: beaconAPIRequestTimeout},
+	}
+}
+
+type checkpointJSON struct {
+	Epoch string `json:"epoch"`
+	Root  string `json:"root"`
+}
+
+type attestationDataJSON struct {
+	Slot            string          `json:"slot"`
+	Index           string          `json:"index"`
+	BeaconBlockRoot string          `json:"beacon_block_root"`
+	Source          *checkpointJSON `json:"source"`
+	Target          *checkpointJSON `json:"target"`
+}
+
+type attestationJSON struct {
+	AggregationBits string               `json:"aggregation_bits"`
+	Data            *attestationDataJSON `json:"data"`
+	Signature       string               `json:"signature"`
+}
+
+type aggregateAndProofJSON struct {
+	AggregatorIndex string           `json:"aggregator_index"`
+	Aggregate       *attestationJSON `json:"aggregate"`
+	SelectionProof  string           `json:"selection_proof"`
+}
+
+type signedAggregateAndProofJSON struct {
+	Message   *aggregateAndProofJSON `json:"message"`
+	Signature string                 `json:"signature"`
+}
+
+type apiErrorJSON struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// AttestationData requests the attestation data to be signed by a committee at the given slot.
+func (c *beaconAPIClient) AttestationData(ctx context.Context, slot, committeeIndex uint64) (*ethpb.AttestationData, error) {
+	query := url.Values{}
+	query.Set("slot", strconv.FormatUint(slot, 10))
+	query.Set("committee_index", strconv.FormatUint(committeeIndex, 10))
+	resp := &struct {
+		Data *attestationDataJSON `json:"data"`
+	}{}
+	if err := c.get(ctx, attestationDataPath, query, resp); err != nil {
+		return nil, err
+	}
+	if resp.Data == nil {
+		return nil, errors.New("empty attestation data response")
+	}
+	return resp.Data.toProto()
+}
+
+// AggregateAttestation requests the best aggregate the beacon node knows for the given attestation data root.
+func (c *beaconAPIClient) AggregateAttestation(ctx context.Context, slot uint64, attDataRoot [32]byte) (*ethpb.Attestation, error) {
+	query := url.Values{}
+	query.Set("slot", strconv.FormatUint(slot, 10))
+	query.Set("attestation_data_root", hexutil.Encode(attDataRoot[:]))
+	resp := &struct {
+		Data *attestationJSON `json:"data"`
+	}{}
+	if err := c.get(ctx, aggregateAttestationPath, query, resp); err != nil {
+		return nil, err
+	}
+	if resp.Data == nil {
+		return nil, errors.New("empty aggregate attestation response")
+	}
+	return resp.Data.toProto()
+}
+
+// SubmitAggregateAndProofs publishes signed aggregate and proofs through the beacon node.
+func (c *beaconAPIClient) SubmitAggregateAndProofs(ctx context.Context, aggs []*ethpb.SignedAggregateAttestationAndProof) error {
+	body := make([]*signedAggregateAndProofJSON, len(aggs))
+	for i, agg := range aggs {
+		if agg == nil || agg.Message == nil || agg.Message.Aggregate == nil {
+			return errors.New("nil aggregate and proof")
+		}
+		body[i] = &signedAggregateAndProofJSON{
+			Message: &aggregateAndProofJSON{
+				AggregatorIndex: strconv.FormatUint(agg.Message.AggregatorIndex, 10),
+				Aggregate:       attestationToJSON(agg.Message.Aggregate),
+				SelectionProof:  hexutil.Encode(agg.Message.SelectionProof),
+			},
+			Signature: hexutil.Encode(agg.Signature),
+		}
+	}
+	enc, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint+aggregateAndProofsPath, bytes.NewReader(enc))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return c.do(req, nil)
+}
+
+func (c *beaconAPIClient) get(ctx context.Context, path string, query url.Values, resp interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.endpoint+path+"?"+query.Encode(), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	return c.do(req, resp)
+}
+
+func (c *beaconAPIClient) do(req *http.Request, resp interface{}) error {
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "could not reach beacon node at %s", req.URL.Path)
+	}
+	defer func() {
+		if err := res.Body.Close(); err != nil {
+			log.WithError(err).Debug("Could not close response body")
+		}
+	}()
+	if res.StatusCode == http.StatusNotFound {
+		return errBeaconAPINotFound
+	}
+	if res.StatusCode != http.StatusOK {
+		body, err := ioutil.ReadAll(res.Body)
+		if err != nil {
+			return errors.Wrapf(err, "could not read error response from %s", req.URL.Path)
+		}
+		apiErr := &apiErrorJSON{}
+		if err := json.Unmarshal(body, apiErr); err == nil && apiErr.Message != "" {
+			return fmt.Errorf("beacon node returned %d for %s: %s", res.StatusCode, req.URL.Path, apiErr.Message)
+		}
+		return fmt.Errorf("beacon node returned %d for %s", res.StatusCode, req.URL.Path)
+	}
+	if resp == nil {
+		return nil
+	}
+	return json.NewDecoder(res.Body).Decode(resp)
+}
+
+func (d *attestationDataJSON) toProto() (*ethpb.AttestationData, error) {
+	if d.Source == nil || d.Target == nil {
+		return nil, errors.New("missing checkpoint in attestation data")
+	}
+	slot, err := strconv.ParseUint(d.Slot, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid slot")
+	}
+	index, err := strconv.ParseUint(d.Index, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid committee index")
+	}
+	blockRoot, err := hexutil.Decode(d.BeaconBlockRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid beacon block root")
+	}
+	source, err := d.Source.toProto()
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid source checkpoint")
+	}
+	target, err := d.Target.toProto()
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid target checkpoint")
+	}
+	return &ethpb.AttestationData{
+		Slot:            slot,
+		CommitteeIndex:  index,
+		BeaconBlockRoot: blockRoot,
+		Source:          source,
+		Target:          target,
+	}, nil
+}
+
+func (c *checkpointJSON) toProto() (*ethpb.Checkpoint, error) {
+	epoch, err := strconv.ParseUint(c.Epoch, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	root, err := hexutil.Decode(c.Root)
+	if err != nil {
+		return nil, err
+	}
+	return &ethpb.Checkpoint{Epoch: epoch, Root: root}, nil
+}
+
+func (a *attestationJSON) toProto() (*ethpb.Attestation, error) {
+	if a.Data == nil {
+		return nil, errors.New("missing attestation data")
+	}
+	bits, err := hexutil.Decode(a.AggregationBits)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid aggregation bits")
+	}
+	sig, err := hexutil.Decode(a.Signature)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid signature")
+	}
+	data, err := a.Data.toProto()
+	if err != nil {
+		return nil, err
+	}
+	return &ethpb.Attestation{
+		AggregationBits: bits,
+		Data:            data,
+		Signature:       sig,
+	}, nil
+}
+
+func attestationDataToJSON(data *ethpb.AttestationData) *attestationDataJSON {
+	return &attestationDataJSON{
+		Slot:            strconv.FormatUint(data.Slot, 10),
+		Index:           strconv.FormatUint(data.CommitteeIndex, 10),
+		BeaconBlockRoot: hexutil.Encode(data.BeaconBlockRoot),
+		Source: &checkpointJSON{
+			Epoch: strconv.FormatUint(data.Source.Epoch, 10),
+			Root:  hexutil.Encode(data.Source.Root),
+		},
+		Target: &checkpointJSON{
+			Epoch: strconv.FormatUint(data.Target.Epoch, 10),
+			Root:  hexutil.Encode(data.Target.Root),
+		},
+	}
+}
+
+func attestationToJSON(att *ethpb.Attestation) *attestationJSON {
+	return &attestationJSON{
+		AggregationBits: hexutil.Encode(att.AggregationBits),
+		Data:            attestationDataToJSON(att.Data),
+		Signature:       hexutil.Encode(att.Signature),
+	}
+}
@@ -0,0 +1,115 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func testAttestationData() *ethpb.AttestationData {
+	return &ethpb.AttestationData{
+		Slot:            10,
+		CommitteeIndex:  3,
+		BeaconBlockRoot: bytesutil.PadTo([]byte{'a'}, 32),
+		Source:          &ethpb.Checkpoint{Epoch: 1, Root: bytesutil.PadTo([]byte{'b'}, 32)},
+		Target:          &ethpb.Checkpoint{Epoch: 2, Root: bytesutil.PadTo([]byte{'c'}, 32)},
+	}
+}
+
+func TestBeaconAPIClient_AttestationData(t *testing.T) {
+	data := testAttestationData()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, attestationDataPath, r.URL.Path)
+		assert.Equal(t, "10", r.URL.Query().Get("slot"))
+		assert.Equal(t, "3", r.URL.Query().Get("committee_index"))
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]interface{}{"data": attestationDataToJSON(data)}))
+	}))
+	defer srv.Close()
+
+	c := newBeaconAPIClient(srv.URL)
+	received, err := c.AttestationData(context.Background(), 10, 3)
+	require.NoError(t, err)
+	assert.DeepEqual(t, data, received)
+}
+
+func TestBeaconAPIClient_AggregateAttestation(t *testing.T) {
+	att := &ethpb.Attestation{
+		AggregationBits: bitfield.Bitlist{0b1101},
+		Data:            testAttestationData(),
+		Signature:       bytesutil.PadTo([]byte{'d'}, 96),
+	}
+	root := [32]byte{'r'}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, aggregateAttestationPath, r.URL.Path)
+		assert.Equal(t, "10", r.URL.Query().Get("slot"))
+		assert.Equal(t, "0x7200000000000000000000000000000000000000000000000000000000000000", r.URL.Query().Get("attestation_data_root"))
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]interface{}{"data": attestationToJSON(att)}))
+	}))
+	defer srv.Close()
+
+	c := newBeaconAPIClient(srv.URL)
+	received, err := c.AggregateAttestation(context.Background(), 10, root)
+	require.NoError(t, err)
+	assert.DeepEqual(t, att, received)
+}
+
+func TestBeaconAPIClient_AggregateAttestation_NotFound(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	c := newBeaconAPIClient(srv.URL)
+	_, err := c.AggregateAttestation(context.Background(), 10, [32]byte{})
+	assert.ErrorContains(t, errBeaconAPINotFound.Error(), err)
+}
+
+func TestBeaconAPIClient_SubmitAggregateAndProofs(t *testing.T) {
+	agg := &ethpb.SignedAggregateAttestationAndProof{
+		Message: &ethpb.AggregateAttestationAndProof{
+			AggregatorIndex: 7,
+			Aggregate: &ethpb.Attestation{
+				AggregationBits: bitfield.Bitlist{0b11},
+				Data:            testAttestationData(),
+				Signature:       make([]byte, 96),
+			},
+			SelectionProof: bytesutil.PadTo([]byte{'s'}, 96),
+		},
+		Signature: bytesutil.PadTo([]byte{'t'}, 96),
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, aggregateAndProofsPath, r.URL.Path)
+		var body []*signedAggregateAndProofJSON
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		require.Equal(t, 1, len(body))
+		assert.Equal(t, "7", body[0].Message.AggregatorIndex)
+		assert.Equal(t, "0x03", body[0].Message.Aggregate.AggregationBits)
+		assert.Equal(t, "10", body[0].Message.Aggregate.Data.Slot)
+	}))
+	defer srv.Close()
+
+	c := newBeaconAPIClient(srv.URL)
+	require.NoError(t, c.SubmitAggregateAndProofs(context.Background(), []*ethpb.SignedAggregateAttestationAndProof{agg}))
+}
+
+func TestBeaconAPIClient_ErrorMessage(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, err := w.Write([]byte(`{"code":400,"message":"invalid aggregate"}`))
+		require.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	c := newBeaconAPIClient(srv.URL)
+	err := c.SubmitAggregateAndProofs(context.Background(), []*ethpb.SignedAggregateAttestationAndProof{})
+	assert.ErrorContains(t, "invalid aggregate", err)
+}
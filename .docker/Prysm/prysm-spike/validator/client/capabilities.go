@@ -0,0 +1,62 @@
+package client
+
+import (
+	"context"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// NegotiateCapabilities asks the beacon node for its RPC API version and optional capabilities, so
+// features the beacon node does not support are disabled instead of failing once they are used.
+// Beacon nodes predating the negotiation are assumed to support no optional capabilities.
+func (v *validator) NegotiateCapabilities(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "validator.NegotiateCapabilities")
+	defer span.End()
+
+	resp, err := v.healthClient.GetCapabilities(ctx, &ptypes.Empty{})
+	if status.Code(err) == codes.Unimplemented {
+		log.Warn("Beacon node does not support capability negotiation, disabling optional features. " +
+			"Upgrade the beacon node to the version of the validator client to enable them")
+		v.setCapabilities(nil)
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "could not get beacon node capabilities")
+	}
+	fields := logrus.Fields{
+		"apiVersion":   resp.ApiVersion,
+		"capabilities": resp.Capabilities,
+		"version":      resp.Version,
+	}
+	if resp.ApiVersion != grpcutils.APIVersion {
+		log.WithFields(fields).Warnf("Beacon node API version differs from version %d of the validator client, "+
+			"run matching versions to avoid errors", grpcutils.APIVersion)
+	} else {
+		log.WithFields(fields).Info("Negotiated beacon node capabilities")
+	}
+	v.setCapabilities(resp.Capabilities)
+	return nil
+}
+
+func (v *validator) setCapabilities(capabilities []string) {
+	v.capabilitiesLock.Lock()
+	defer v.capabilitiesLock.Unlock()
+	v.capabilities = make(map[string]bool, len(capabilities))
+	for _, c := range capabilities {
+		v.capabilities[c] = true
+	}
+}
+
+// hasCapability returns whether the beacon node supports the capability. All capabilities are
+// assumed to be supported until they were negotiated.
+func (v *validator) hasCapability(capability string) bool {
+	v.capabilitiesLock.RLock()
+	defer v.capabilitiesLock.RUnlock()
+	return v.capabilities == nil || v.capabilities[capability]
+}
@@ -0,0 +1,67 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeHealthClient struct {
+	pbrpc.HealthClient
+	resp *pbrpc.CapabilitiesResponse
+	err  error
+}
+
+func (f *fakeHealthClient) GetCapabilities(_ context.Context, _ *ptypes.Empty, _ ...grpc.CallOption) (*pbrpc.CapabilitiesResponse, error) {
+	return f.resp, f.err
+}
+
+func TestNegotiateCapabilities(t *testing.T) {
+	hook := logTest.NewGlobal()
+	v := &validator{healthClient: &fakeHealthClient{resp: &pbrpc.CapabilitiesResponse{
+		ApiVersion:   grpcutils.APIVersion,
+		Capabilities: []string{grpcutils.CapabilityHeadEvents},
+	}}}
+	assert.Equal(t, true, v.hasCapability(grpcutils.CapabilityProposerDependentRoot), "Expected capabilities to be assumed before negotiation")
+
+	require.NoError(t, v.NegotiateCapabilities(context.Background()))
+	assert.Equal(t, true, v.hasCapability(grpcutils.CapabilityHeadEvents))
+	assert.Equal(t, false, v.hasCapability(grpcutils.CapabilityProposerDependentRoot))
+	require.LogsContain(t, hook, "Negotiated beacon node capabilities")
+}
+
+func TestNegotiateCapabilities_APIVersionMismatch(t *testing.T) {
+	hook := logTest.NewGlobal()
+	v := &validator{healthClient: &fakeHealthClient{resp: &pbrpc.CapabilitiesResponse{
+		ApiVersion:   grpcutils.APIVersion + 1,
+		Capabilities: grpcutils.BeaconCapabilities,
+	}}}
+	require.NoError(t, v.NegotiateCapabilities(context.Background()))
+	assert.Equal(t, true, v.hasCapability(grpcutils.CapabilityProposerDependentRoot))
+	require.LogsContain(t, hook, "Beacon node API version differs")
+}
+
+func TestNegotiateCapabilities_LegacyBeaconNode(t *testing.T) {
+	hook := logTest.NewGlobal()
+	v := &validator{healthClient: &fakeHealthClient{err: status.Error(codes.Unimplemented, "unknown method GetCapabilities")}}
+	require.NoError(t, v.NegotiateCapabilities(context.Background()))
+	assert.Equal(t, false, v.hasCapability(grpcutils.CapabilityProposerDependentRoot))
+	require.LogsContain(t, hook, "Beacon node does not support capability negotiation")
+}
+
+func TestNegotiateCapabilities_Error(t *testing.T) {
+	v := &validator{healthClient: &fakeHealthClient{err: errors.New("connection refused")}}
+	err := v.NegotiateCapabilities(context.Background())
+	assert.ErrorContains(t, "could not get beacon node capabilities", err)
+	assert.Equal(t, true, v.hasCapability(grpcutils.CapabilityProposerDependentRoot))
+}
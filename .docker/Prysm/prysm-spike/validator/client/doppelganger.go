@@ -0,0 +1,219 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/exitcode"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// doppelganger is a message signed with one of the validator's keys which the validator has no
+// record of signing.
+type doppelganger struct {
+	pubKey [48]byte
+	index  uint64
+	slot   uint64
+	kind   string
+}
+
+// DoppelgangerProtection watches the beacon chain for the configured number of epochs before the
+// validator performs any duty, and fails when attestations or blocks signed with its keys show
+// up which are missing from its slashing protection history. Such messages come from another
+// validator client running with the same keys, and performing duties next to it gets the keys
+// slashed. The epoch before startup is checked as well, to catch a client which was only just
+// stopped or moved.
+func (v *validator) DoppelgangerProtection(ctx context.Context) error {
+	if v.doppelgangerEpochs == 0 {
+		return nil
+	}
+	ctx, span := trace.StartSpan(ctx, "validator.DoppelgangerProtection")
+	defer span.End()
+
+	pubKeysByIndex, err := v.activeValidatorIndices(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get indices of active keys")
+	}
+	if len(pubKeysByIndex) == 0 {
+		log.Info("No active keys, skipping doppelganger protection")
+		return nil
+	}
+	currentEpoch := helpers.SlotToEpoch(slotutil.SlotsSinceGenesis(time.Unix(int64(v.genesisTime), 0)))
+	firstEpoch := currentEpoch
+	if firstEpoch > 0 {
+		firstEpoch--
+	}
+	lastEpoch := currentEpoch + v.doppelgangerEpochs - 1
+	log.WithFields(logrus.Fields{
+		"activeKeys": len(pubKeysByIndex),
+		"startEpoch": firstEpoch,
+		"endEpoch":   lastEpoch,
+	}).Info("Watching the beacon chain for other validators signing with the keys before performing duties")
+
+	for epoch := firstEpoch; epoch <= lastEpoch; epoch++ {
+		ValidatorDoppelgangerEpochsGauge.Set(float64(lastEpoch - epoch + 1))
+		if err := v.waitForEpochEnd(ctx, epoch); err != nil {
+			return err
+		}
+		found, err := v.findDoppelgangers(ctx, epoch, pubKeysByIndex)
+		if err != nil {
+			return errors.Wrapf(err, "could not check epoch %d for doppelgangers", epoch)
+		}
+		if len(found) > 0 {
+			for _, d := range found {
+				log.WithFields(logrus.Fields{
+					"pubKey":         fmt.Sprintf("%#x", bytesutil.Trunc(d.pubKey[:])),
+					"validatorIndex": d.index,
+					"slot":           d.slot,
+					"message":        d.kind,
+				}).Error("Found a message signed with the key by another validator client")
+			}
+			return exitcode.Wrap(fmt.Errorf(
+				"another validator client is signing with %d of the keys, stop it before restarting",
+				len(found),
+			), exitcode.SlashingDetected)
+		}
+		log.WithField("epoch", epoch).Debug("No doppelganger found in epoch")
+	}
+	ValidatorDoppelgangerEpochsGauge.Set(0)
+	log.Info("No other validator client is signing with the keys, starting duties")
+	return nil
+}
+
+// activeValidatorIndices returns the validating keys by validator index, for the keys of
+// validators which are active and could have signed messages included in the chain.
+func (v *validator) activeValidatorIndices(ctx context.Context) (map[uint64][48]byte, error) {
+	validatingKeys, err := v.keyManager.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not fetch validating keys")
+	}
+	if len(validatingKeys) == 0 {
+		return nil, nil
+	}
+	publicKeys := make([][]byte, len(validatingKeys))
+	for i := range validatingKeys {
+		publicKeys[i] = validatingKeys[i][:]
+	}
+	resp, err := v.validatorClient.MultipleValidatorStatus(ctx, &ethpb.MultipleValidatorStatusRequest{
+		PublicKeys: publicKeys,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Statuses) != len(resp.PublicKeys) || len(resp.Indices) != len(resp.PublicKeys) {
+		return nil, errors.New("number of status responses did not match number of public keys")
+	}
+	pubKeysByIndex := make(map[uint64][48]byte)
+	for i, s := range resp.Statuses {
+		if s.Status != ethpb.ValidatorStatus_ACTIVE && s.Status != ethpb.ValidatorStatus_EXITING {
+			continue
+		}
+		pubKeysByIndex[resp.Indices[i]] = bytesutil.ToBytes48(resp.PublicKeys[i])
+	}
+	return pubKeysByIndex, nil
+}
+
+// waitForEpochEnd waits until the first slot after the epoch is over, leaving the blocks of the
+// last slot of the epoch time to be processed by the beacon node.
+func (v *validator) waitForEpochEnd(ctx context.Context, epoch uint64) error {
+	nextEpochSlot, err := helpers.StartSlot(epoch + 1)
+	if err != nil {
+		return err
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(time.Until(v.SlotDeadline(nextEpochSlot))):
+		return nil
+	}
+}
+
+// findDoppelgangers returns the attestations included in the blocks of the epoch and the blocks
+// of the epoch signed by the given validators, which are not in their slashing protection history.
+func (v *validator) findDoppelgangers(
+	ctx context.Context,
+	epoch uint64,
+	pubKeysByIndex map[uint64][48]byte,
+) ([]*doppelganger, error) {
+	pubKeys := make([][48]byte, 0, len(pubKeysByIndex))
+	for _, pubKey := range pubKeysByIndex {
+		pubKeys = append(pubKeys, pubKey)
+	}
+	histories, err := v.db.AttestationHistoryForPubKeysV2(ctx, pubKeys)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get attestation histories")
+	}
+	latestTargets := make(map[[48]byte]uint64, len(histories))
+	for pubKey, history := range histories {
+		latestTargets[pubKey], err = history.GetLatestEpochWritten(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get latest attested epoch")
+		}
+	}
+
+	var found []*doppelganger
+	attReq := &ethpb.ListIndexedAttestationsRequest{
+		QueryFilter: &ethpb.ListIndexedAttestationsRequest_Epoch{Epoch: epoch},
+	}
+	for {
+		resp, err := v.beaconClient.ListIndexedAttestations(ctx, attReq)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not list indexed attestations")
+		}
+		for _, att := range resp.IndexedAttestations {
+			if att.Data == nil || att.Data.Target == nil {
+				continue
+			}
+			for _, index := range att.AttestingIndices {
+				pubKey, ok := pubKeysByIndex[index]
+				// Attestations of the validator's own history were signed before the restart.
+				if !ok || att.Data.Target.Epoch <= latestTargets[pubKey] {
+					continue
+				}
+				found = append(found, &doppelganger{pubKey: pubKey, index: index, slot: att.Data.Slot, kind: "attestation"})
+			}
+		}
+		if resp.NextPageToken == "" || len(resp.IndexedAttestations) == 0 {
+			break
+		}
+		attReq.PageToken = resp.NextPageToken
+	}
+
+	blkReq := &ethpb.ListBlocksRequest{QueryFilter: &ethpb.ListBlocksRequest_Epoch{Epoch: epoch}}
+	for {
+		resp, err := v.beaconClient.ListBlocks(ctx, blkReq)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not list blocks")
+		}
+		for _, ctr := range resp.BlockContainers {
+			if ctr.Block == nil || ctr.Block.Block == nil {
+				continue
+			}
+			blk := ctr.Block.Block
+			pubKey, ok := pubKeysByIndex[blk.ProposerIndex]
+			if !ok {
+				continue
+			}
+			highestProposal, err := v.db.HighestSignedProposal(ctx, pubKey)
+			if err != nil {
+				return nil, errors.Wrap(err, "could not get highest signed proposal")
+			}
+			if blk.Slot <= highestProposal {
+				continue
+			}
+			found = append(found, &doppelganger{pubKey: pubKey, index: blk.ProposerIndex, slot: blk.Slot, kind: "block"})
+		}
+		if resp.NextPageToken == "" || len(resp.BlockContainers) == 0 {
+			break
+		}
+		blkReq.PageToken = resp.NextPageToken
+	}
+	return found, nil
+}
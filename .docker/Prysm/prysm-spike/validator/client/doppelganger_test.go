@@ -0,0 +1,120 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/exitcode"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	dbTest "github.com/prysmaticlabs/prysm/validator/db/testing"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+// setupDoppelgangerValidator returns a validator at the start of the given epoch with two active
+// keys of indices 1 and 2. Attestations up to the target epoch and a proposal at the slot are
+// saved in the slashing protection history of the first key.
+func setupDoppelgangerValidator(
+	t *testing.T,
+	ctrl *gomock.Controller,
+	epoch, attestedTarget, proposedSlot uint64,
+) (*validator, *mock.MockBeaconChainClient, [][48]byte) {
+	km := genMockKeymanger(2)
+	pubKeys, err := km.FetchValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+	valDB := dbTest.SetupDB(t, pubKeys)
+	ctx := context.Background()
+	history, err := kv.NewAttestationHistoryArray(0).SetLatestEpochWritten(ctx, attestedTarget)
+	require.NoError(t, err)
+	require.NoError(t, valDB.SaveAttestationHistoryForPubKeyV2(ctx, pubKeys[0], history))
+	require.NoError(t, valDB.SaveProposalHistoryForSlot(ctx, pubKeys[0], proposedSlot, []byte{1}))
+
+	validatorClient := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	validatorClient.EXPECT().MultipleValidatorStatus(gomock.Any(), gomock.Any()).Return(&ethpb.MultipleValidatorStatusResponse{
+		PublicKeys: [][]byte{pubKeys[0][:], pubKeys[1][:]},
+		Statuses: []*ethpb.ValidatorStatusResponse{
+			{Status: ethpb.ValidatorStatus_ACTIVE},
+			{Status: ethpb.ValidatorStatus_ACTIVE},
+		},
+		Indices: []uint64{1, 2},
+	}, nil)
+	beaconClient := mock.NewMockBeaconChainClient(ctrl)
+	epochDuration := time.Duration(params.BeaconConfig().SlotsPerEpoch*params.BeaconConfig().SecondsPerSlot) * time.Second
+	return &validator{
+		db:                 valDB,
+		keyManager:         km,
+		validatorClient:    validatorClient,
+		beaconClient:       beaconClient,
+		genesisTime:        uint64(time.Now().Add(-time.Duration(epoch) * epochDuration).Unix()),
+		doppelgangerEpochs: 2,
+	}, beaconClient, pubKeys
+}
+
+func TestDoppelgangerProtection_FindsAttestations(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	v, beaconClient, _ := setupDoppelgangerValidator(t, ctrl, 10, 9, 300)
+
+	// The epoch before startup is checked right away.
+	beaconClient.EXPECT().ListIndexedAttestations(gomock.Any(), &ethpb.ListIndexedAttestationsRequest{
+		QueryFilter: &ethpb.ListIndexedAttestationsRequest_Epoch{Epoch: 9},
+	}).Return(&ethpb.ListIndexedAttestationsResponse{
+		IndexedAttestations: []*ethpb.IndexedAttestation{
+			{AttestingIndices: []uint64{1, 3}, Data: &ethpb.AttestationData{Slot: 290, Target: &ethpb.Checkpoint{Epoch: 9}}},
+			{AttestingIndices: []uint64{2}, Data: &ethpb.AttestationData{Slot: 291, Target: &ethpb.Checkpoint{Epoch: 9}}},
+		},
+	}, nil)
+	beaconClient.EXPECT().ListBlocks(gomock.Any(), gomock.Any()).Return(&ethpb.ListBlocksResponse{
+		BlockContainers: []*ethpb.BeaconBlockContainer{
+			{Block: &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 300, ProposerIndex: 1}}},
+		},
+	}, nil)
+
+	err := v.DoppelgangerProtection(context.Background())
+	require.ErrorContains(t, "another validator client is signing with 1 of the keys", err)
+	assert.Equal(t, exitcode.SlashingDetected, exitcode.FromError(err))
+	require.LogsContain(t, hook, "Found a message signed with the key by another validator client")
+	assert.Equal(t, uint64(2), hook.LastEntry().Data["validatorIndex"])
+}
+
+func TestFindDoppelgangers_IgnoresOwnHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	v, beaconClient, pubKeys := setupDoppelgangerValidator(t, ctrl, 10, 9, 300)
+	pubKeysByIndex, err := v.activeValidatorIndices(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(pubKeysByIndex))
+
+	beaconClient.EXPECT().ListIndexedAttestations(gomock.Any(), gomock.Any()).Return(&ethpb.ListIndexedAttestationsResponse{
+		IndexedAttestations: []*ethpb.IndexedAttestation{
+			{AttestingIndices: []uint64{1}, Data: &ethpb.AttestationData{Slot: 290, Target: &ethpb.Checkpoint{Epoch: 9}}},
+		},
+		NextPageToken: "1",
+	}, nil)
+	beaconClient.EXPECT().ListIndexedAttestations(gomock.Any(), gomock.Any()).Return(&ethpb.ListIndexedAttestationsResponse{}, nil)
+	beaconClient.EXPECT().ListBlocks(gomock.Any(), gomock.Any()).Return(&ethpb.ListBlocksResponse{
+		BlockContainers: []*ethpb.BeaconBlockContainer{
+			{Block: &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 300, ProposerIndex: 1}}},
+			{Block: &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 305, ProposerIndex: 2}}},
+		},
+	}, nil)
+
+	found, err := v.findDoppelgangers(context.Background(), 9, pubKeysByIndex)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(found), "Only the block missing from the slashing protection history is found")
+	assert.Equal(t, pubKeys[1], found[0].pubKey)
+	assert.Equal(t, uint64(305), found[0].slot)
+	assert.Equal(t, "block", found[0].kind)
+}
+
+func TestDoppelgangerProtection_Disabled(t *testing.T) {
+	v := &validator{}
+	require.NoError(t, v.DoppelgangerProtection(context.Background()))
+}
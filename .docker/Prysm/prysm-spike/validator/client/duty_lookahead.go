@@ -0,0 +1,132 @@
+package client
+
+import (
+	"context"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// dutiesLookahead holds the duties of an upcoming epoch fetched before the epoch started.
+type dutiesLookahead struct {
+	epoch         uint64
+	dependentRoot string // Root of the block the proposer duties depend on, empty if the beacon node did not return it.
+	duties        *ethpb.DutiesResponse
+}
+
+func (v *validator) currentDuties() *ethpb.DutiesResponse {
+	v.dutiesLock.RLock()
+	defer v.dutiesLock.RUnlock()
+	return v.duties
+}
+
+// currentDutiesDependentRoot returns the dependent root the duties held were fetched with.
+func (v *validator) currentDutiesDependentRoot() *dutiesDependentRoot {
+	v.dutiesLock.RLock()
+	defer v.dutiesLock.RUnlock()
+	return v.dutiesDependentRoot
+}
+
+// setDuties replaces the duties held with the duties of the epoch, and records the root of the
+// block they depend on so head events can tell when a reorg changed them.
+func (v *validator) setDuties(epoch uint64, duties *ethpb.DutiesResponse, dependentRoot string) {
+	v.dutiesLock.Lock()
+	defer v.dutiesLock.Unlock()
+	v.duties = duties
+	v.dutiesDependentRoot = &dutiesDependentRoot{
+		epoch: epoch,
+		root:  dependentRoot,
+	}
+}
+
+// takeDutiesLookahead returns and clears the duties fetched ahead of time if they are for the epoch.
+func (v *validator) takeDutiesLookahead(epoch uint64) *dutiesLookahead {
+	v.dutiesLock.Lock()
+	defer v.dutiesLock.Unlock()
+	lookahead := v.dutiesLookahead
+	v.dutiesLookahead = nil
+	if lookahead == nil || lookahead.epoch != epoch {
+		return nil
+	}
+	return lookahead
+}
+
+// prefetchDuties fetches the duties of the epoch following the given last slot of an epoch. The
+// proposer duties of an epoch only become stable once the block of the last slot of the previous
+// epoch is known, so the request is sent two thirds into the slot, when that block should have been
+// processed. Were the block reorged, refreshDuties replaces the duties once the epoch starts.
+func (v *validator) prefetchDuties(ctx context.Context, slot uint64) {
+	ctx, span := trace.StartSpan(ctx, "validator.prefetchDuties")
+	defer span.End()
+
+	v.waitToSlotTwoThirds(ctx, slot)
+	ctx, cancel := context.WithDeadline(ctx, v.SlotDeadline(slot))
+	defer cancel()
+
+	validatingKeys, err := v.keyManager.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		log.WithError(err).Debug("Could not fetch validating keys to prefetch duties")
+		return
+	}
+	epoch := helpers.SlotToEpoch(slot) + 1
+	resp, dependentRoot, err := v.fetchDuties(ctx, &ethpb.DutiesRequest{
+		Epoch:      epoch,
+		PublicKeys: bytesutil.FromBytes48Array(validatingKeys),
+	})
+	if err != nil {
+		log.WithError(err).WithField("epoch", epoch).Debug("Could not prefetch duties")
+		return
+	}
+	v.dutiesLock.Lock()
+	v.dutiesLookahead = &dutiesLookahead{
+		epoch:         epoch,
+		dependentRoot: dependentRoot,
+		duties:        resp,
+	}
+	v.dutiesLock.Unlock()
+	log.WithFields(logrus.Fields{
+		"epoch":         epoch,
+		"dependentRoot": dependentRoot,
+	}).Debug("Prefetched duties of the next epoch")
+}
+
+// refreshDuties fetches the duties of the epoch started at the slot again to replace the prefetched
+// duties, in case the block their proposer duties depend on was reorged, then subscribes to the
+// committee subnets of the duties.
+func (v *validator) refreshDuties(slot uint64, req *ethpb.DutiesRequest, prefetchedRoot string) {
+	// The caller's context ends with its slot, the refresh has until the end of the epoch.
+	ss, err := helpers.StartSlot(req.Epoch + 1)
+	if err != nil {
+		log.WithError(err).Error("Could not refresh duties")
+		return
+	}
+	ctx, cancel := context.WithDeadline(context.Background(), v.SlotDeadline(ss))
+	defer cancel()
+	ctx, span := trace.StartSpan(ctx, "validator.refreshDuties")
+	defer span.End()
+
+	resp, dependentRoot, err := v.fetchDuties(ctx, req)
+	if err != nil {
+		// Keep the prefetched duties, they are correct unless a reorg happened.
+		log.WithError(err).Error("Could not refresh prefetched duties")
+		return
+	}
+	if dependentRoot != prefetchedRoot {
+		log.WithFields(logrus.Fields{
+			"epoch":          req.Epoch,
+			"prefetchedRoot": prefetchedRoot,
+			"dependentRoot":  dependentRoot,
+		}).Warn("Proposer dependent root changed, replacing prefetched duties")
+		ValidatorDutiesReorgedCounter.Inc()
+		v.setDuties(req.Epoch, resp, dependentRoot)
+		v.logDuties(slot, resp.Duties)
+	} else {
+		v.setDuties(req.Epoch, resp, dependentRoot)
+	}
+	if err := v.subscribeToCommitteeSubnets(ctx, req, resp); err != nil {
+		log.WithError(err).Error("Could not subscribe to committee subnets")
+	}
+}
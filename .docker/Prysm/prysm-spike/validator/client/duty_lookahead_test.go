@@ -0,0 +1,97 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// returnDutiesWithRoot returns the duties from a mocked GetDuties call along with the dependent root header.
+func returnDutiesWithRoot(resp *ethpb.DutiesResponse, root string) func(context.Context, *ethpb.DutiesRequest, ...grpc.CallOption) (*ethpb.DutiesResponse, error) {
+	return func(_ context.Context, _ *ethpb.DutiesRequest, opts ...grpc.CallOption) (*ethpb.DutiesResponse, error) {
+		for _, opt := range opts {
+			if h, ok := opt.(grpc.HeaderCallOption); ok {
+				*h.HeaderAddr = metadata.Pairs(grpcutils.ProposerDependentRootHeader, root)
+			}
+		}
+		return resp, nil
+	}
+}
+
+func TestUpdateDuties_UsesLookahead(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconNodeValidatorClient(ctrl)
+
+	slot := params.BeaconConfig().SlotsPerEpoch
+	prefetched := &ethpb.DutiesResponse{
+		Duties: []*ethpb.DutiesResponse_Duty{{ProposerSlots: []uint64{slot}}},
+	}
+	v := validator{
+		keyManager:      genMockKeymanger(1),
+		validatorClient: client,
+		dutiesLookahead: &dutiesLookahead{epoch: 1, dependentRoot: "0x01", duties: prefetched},
+	}
+	client.EXPECT().GetDuties(
+		gomock.Any(),
+		gomock.Any(),
+		gomock.Any(),
+	).DoAndReturn(returnDutiesWithRoot(prefetched, "0x01"))
+	refreshed := make(chan struct{})
+	client.EXPECT().GetDuties(
+		gomock.Any(),
+		gomock.Any(),
+		gomock.Any(),
+	).DoAndReturn(func(_ context.Context, _ *ethpb.DutiesRequest, _ ...grpc.CallOption) (*ethpb.DutiesResponse, error) {
+		close(refreshed)
+		return prefetched, nil
+	})
+
+	require.NoError(t, v.UpdateDuties(context.Background(), slot))
+	assert.Equal(t, prefetched, v.currentDuties(), "Expected the prefetched duties to be used")
+	<-refreshed
+	assert.Equal(t, (*dutiesLookahead)(nil), v.dutiesLookahead, "Expected the lookahead to be consumed")
+}
+
+func TestRefreshDuties_ReplacesReorgedDuties(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconNodeValidatorClient(ctrl)
+
+	slot := params.BeaconConfig().SlotsPerEpoch
+	prefetched := &ethpb.DutiesResponse{
+		Duties: []*ethpb.DutiesResponse_Duty{{ProposerSlots: []uint64{slot}}},
+	}
+	reorged := &ethpb.DutiesResponse{
+		Duties: []*ethpb.DutiesResponse_Duty{{ProposerSlots: []uint64{slot + 1}}},
+	}
+	v := validator{
+		validatorClient: client,
+		duties:          prefetched,
+	}
+	client.EXPECT().GetDuties(
+		gomock.Any(),
+		gomock.Any(),
+		gomock.Any(),
+	).DoAndReturn(returnDutiesWithRoot(reorged, "0x02"))
+	client.EXPECT().GetDuties(
+		gomock.Any(),
+		gomock.Any(),
+		gomock.Any(),
+	).Return(reorged, nil)
+
+	v.refreshDuties(slot, &ethpb.DutiesRequest{Epoch: 1}, "0x01")
+	assert.Equal(t, reorged, v.currentDuties(), "Expected the reorged duties to replace the prefetched ones")
+	require.LogsContain(t, hook, "Proposer dependent root changed")
+}
@@ -0,0 +1,197 @@
+package client
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/resolver"
+)
+
+// failoverScheme is the resolver scheme of the connection to several beacon nodes with failover.
+const failoverScheme = "failover"
+
+// beaconNodeFailover probes each beacon node of a comma separated endpoint, and resolves the
+// connection of the validator to the first one in the configured order which is reachable and
+// synced. All requests of the validator, from fetching duties to submitting blocks, follow the
+// connection to the node it resolves to. It returns to a preferred node as soon as it is healthy
+// again, and keeps the current node when none is healthy.
+type beaconNodeFailover struct {
+	endpoints    []string
+	probeConns   []*grpc.ClientConn
+	probeClients []ethpb.NodeClient
+	lock         sync.Mutex
+	active       int
+	cc           resolver.ClientConn
+}
+
+// newBeaconNodeFailover returns the failover between the beacon nodes of the comma separated
+// endpoint, starting with the first one.
+func newBeaconNodeFailover(endpoint string) *beaconNodeFailover {
+	var endpoints []string
+	for _, e := range strings.Split(endpoint, ",") {
+		if e = strings.TrimSpace(e); e != "" {
+			endpoints = append(endpoints, e)
+		}
+	}
+	return &beaconNodeFailover{endpoints: endpoints}
+}
+
+// target is the dial target of the connection resolved by the failover.
+func (f *beaconNodeFailover) target() string {
+	return failoverScheme + ":///" + strings.Join(f.endpoints, ",")
+}
+
+// Build implements resolver.Builder, the failover is the resolver of a single connection.
+func (f *beaconNodeFailover) Build(_ resolver.Target, cc resolver.ClientConn, _ resolver.BuildOptions) (resolver.Resolver, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.cc = cc
+	f.updateState()
+	return f, nil
+}
+
+// Scheme implements resolver.Builder.
+func (*beaconNodeFailover) Scheme() string {
+	return failoverScheme
+}
+
+// ResolveNow implements resolver.Resolver. The node is only changed by probes.
+func (*beaconNodeFailover) ResolveNow(_ resolver.ResolveNowOptions) {}
+
+// Close implements resolver.Resolver.
+func (*beaconNodeFailover) Close() {}
+
+// updateState resolves the connection to the active node. The caller holds the lock.
+func (f *beaconNodeFailover) updateState() {
+	if f.cc == nil {
+		return
+	}
+	endpoint := f.endpoints[f.active]
+	// The server name lets TLS credentials verify the node by its own name.
+	f.cc.UpdateState(resolver.State{Addresses: []resolver.Address{{Addr: endpoint, ServerName: endpoint}}})
+}
+
+// dialProbes opens a separate connection to each node, so probes reach the nodes the validator
+// is not connected to.
+func (f *beaconNodeFailover) dialProbes(ctx context.Context, dialOpts []grpc.DialOption) error {
+	for _, endpoint := range f.endpoints {
+		conn, err := grpc.DialContext(ctx, endpoint, dialOpts...)
+		if err != nil {
+			f.close()
+			return errors.Wrapf(err, "could not dial endpoint %s", endpoint)
+		}
+		f.probeConns = append(f.probeConns, conn)
+		f.probeClients = append(f.probeClients, ethpb.NewNodeClient(conn))
+	}
+	return nil
+}
+
+// run probes the nodes right away and then every half slot, until the context is canceled.
+func (f *beaconNodeFailover) run(ctx context.Context) {
+	interval := slotutil.DivideSlotBy(2)
+	f.probe(ctx, interval)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			f.probe(ctx, interval)
+		}
+	}
+}
+
+// probe checks the health of every node at once, and switches the connection to the first
+// healthy one.
+func (f *beaconNodeFailover) probe(ctx context.Context, timeout time.Duration) {
+	errs := make([]error, len(f.probeClients))
+	var wg sync.WaitGroup
+	for i, client := range f.probeClients {
+		wg.Add(1)
+		go func(i int, client ethpb.NodeClient) {
+			defer wg.Done()
+			errs[i] = probeBeaconNode(ctx, client, timeout)
+		}(i, client)
+	}
+	wg.Wait()
+	healthy := -1
+	for i, err := range errs {
+		if err != nil {
+			log.WithError(err).WithField("endpoint", f.endpoints[i]).Debug("Beacon node is not healthy")
+			ValidatorBeaconNodeHealthyGaugeVec.WithLabelValues(f.endpoints[i]).Set(0)
+			continue
+		}
+		ValidatorBeaconNodeHealthyGaugeVec.WithLabelValues(f.endpoints[i]).Set(1)
+		if healthy == -1 {
+			healthy = i
+		}
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if healthy == -1 {
+		log.WithField("endpoint", f.endpoints[f.active]).Warn("No beacon node is healthy, keeping the current one")
+		return
+	}
+	if healthy == f.active {
+		return
+	}
+	log.WithFields(logrus.Fields{
+		"from": f.endpoints[f.active],
+		"to":   f.endpoints[healthy],
+	}).Warn("Switching to another beacon node")
+	ValidatorBeaconNodeFailoversCounter.Inc()
+	f.active = healthy
+	f.updateState()
+}
+
+// DialBeaconNode dials the beacon node of the endpoint. Several comma separated endpoints are
+// probed once, and the connection is resolved to the first one in the configured order which is
+// reachable and synced, like the connection of the validator client. It is meant for commands
+// which do not live long enough to fail over while running.
+func DialBeaconNode(ctx context.Context, endpoint string, dialOpts []grpc.DialOption) (*grpc.ClientConn, error) {
+	if !strings.Contains(endpoint, ",") {
+		return grpc.DialContext(ctx, endpoint, dialOpts...)
+	}
+	f := newBeaconNodeFailover(endpoint)
+	if err := f.dialProbes(ctx, dialOpts); err != nil {
+		return nil, err
+	}
+	defer f.close()
+	f.probe(ctx, slotutil.DivideSlotBy(2))
+	return grpc.DialContext(ctx, f.target(), append(dialOpts, grpc.WithResolvers(f))...)
+}
+
+// probeBeaconNode returns an error when the node is unreachable or syncing.
+func probeBeaconNode(ctx context.Context, client ethpb.NodeClient, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	resp, err := client.GetSyncStatus(ctx, &ptypes.Empty{})
+	if err != nil {
+		return errors.Wrap(err, "could not get sync status")
+	}
+	if resp.Syncing {
+		return errors.New("beacon node is syncing")
+	}
+	return nil
+}
+
+// close closes the probe connections.
+func (f *beaconNodeFailover) close() {
+	for i, conn := range f.probeConns {
+		if err := conn.Close(); err != nil {
+			log.WithError(err).WithField("endpoint", f.endpoints[i]).Error("Could not close probe connection")
+		}
+	}
+	f.probeConns = nil
+	f.probeClients = nil
+}
@@ -0,0 +1,124 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/golang/mock/gomock"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/resolver"
+)
+
+// syncStatusServer is a beacon node answering sync status requests.
+type syncStatusServer struct {
+	ethpb.UnimplementedNodeServer
+	syncing bool
+}
+
+func (s *syncStatusServer) GetSyncStatus(_ context.Context, _ *ptypes.Empty) (*ethpb.SyncStatus, error) {
+	return &ethpb.SyncStatus{Syncing: s.syncing}, nil
+}
+
+// startNodeServer starts a beacon node server and returns its endpoint.
+func startNodeServer(t *testing.T, syncing bool) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := grpc.NewServer()
+	ethpb.RegisterNodeServer(srv, &syncStatusServer{syncing: syncing})
+	go func() {
+		_ = srv.Serve(lis)
+	}()
+	t.Cleanup(srv.Stop)
+	return lis.Addr().String()
+}
+
+// recordingClientConn records the addresses a resolver resolves to.
+type recordingClientConn struct {
+	resolver.ClientConn
+	addrs []resolver.Address
+}
+
+func (cc *recordingClientConn) UpdateState(s resolver.State) {
+	cc.addrs = s.Addresses
+}
+
+func TestNewBeaconNodeFailover(t *testing.T) {
+	f := newBeaconNodeFailover("beacon:4000, beacon2:4000,")
+	assert.DeepEqual(t, []string{"beacon:4000", "beacon2:4000"}, f.endpoints)
+	assert.Equal(t, "failover:///beacon:4000,beacon2:4000", f.target())
+
+	cc := &recordingClientConn{}
+	_, err := f.Build(resolver.Target{}, cc, resolver.BuildOptions{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []resolver.Address{{Addr: "beacon:4000", ServerName: "beacon:4000"}}, cc.addrs)
+}
+
+func TestBeaconNodeFailover_Probe(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	primary := mock.NewMockNodeClient(ctrl)
+	secondary := mock.NewMockNodeClient(ctrl)
+	f := newBeaconNodeFailover("beacon:4000,beacon2:4000")
+	f.probeClients = []ethpb.NodeClient{primary, secondary}
+	cc := &recordingClientConn{}
+	_, err := f.Build(resolver.Target{}, cc, resolver.BuildOptions{})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	// The primary is syncing.
+	primary.EXPECT().GetSyncStatus(gomock.Any(), gomock.Any()).Return(&ethpb.SyncStatus{Syncing: true}, nil)
+	secondary.EXPECT().GetSyncStatus(gomock.Any(), gomock.Any()).Return(&ethpb.SyncStatus{}, nil)
+	f.probe(ctx, time.Second)
+	assert.Equal(t, "beacon2:4000", cc.addrs[0].Addr)
+	require.LogsContain(t, hook, "Switching to another beacon node")
+
+	// No node is healthy.
+	primary.EXPECT().GetSyncStatus(gomock.Any(), gomock.Any()).Return(nil, errors.New("unavailable"))
+	secondary.EXPECT().GetSyncStatus(gomock.Any(), gomock.Any()).Return(nil, errors.New("unavailable"))
+	f.probe(ctx, time.Second)
+	assert.Equal(t, "beacon2:4000", cc.addrs[0].Addr)
+	require.LogsContain(t, hook, "No beacon node is healthy")
+
+	// The primary is preferred once it is synced again.
+	primary.EXPECT().GetSyncStatus(gomock.Any(), gomock.Any()).Return(&ethpb.SyncStatus{}, nil)
+	secondary.EXPECT().GetSyncStatus(gomock.Any(), gomock.Any()).Return(&ethpb.SyncStatus{}, nil)
+	f.probe(ctx, time.Second)
+	assert.Equal(t, "beacon:4000", cc.addrs[0].Addr)
+}
+
+func TestDialBeaconNode_Failover(t *testing.T) {
+	ctx := context.Background()
+	syncing := startNodeServer(t, true)
+	synced := startNodeServer(t, false)
+	dialOpts := []grpc.DialOption{grpc.WithInsecure()}
+
+	// The first node is syncing, so the connection resolves to the second one.
+	conn, err := DialBeaconNode(ctx, syncing+","+synced, dialOpts)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, conn.Close())
+	}()
+	resp, err := ethpb.NewNodeClient(conn).GetSyncStatus(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, false, resp.Syncing)
+
+	// A single endpoint is dialed directly.
+	single, err := DialBeaconNode(ctx, syncing, dialOpts)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, single.Close())
+	}()
+	resp, err = ethpb.NewNodeClient(single).GetSyncStatus(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, true, resp.Syncing)
+}
@@ -0,0 +1,192 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// dutiesDependentRoot is the root of the block the duties held depend on, as returned by the beacon
+// node along with them.
+type dutiesDependentRoot struct {
+	epoch uint64
+	root  string // Root the proposer duties of the epoch depend on, empty if the beacon node did not return it.
+}
+
+// queuedAttestation is an attestation waiting for a third of its slot before being submitted.
+type queuedAttestation struct {
+	slot           uint64
+	committeeIndex uint64
+	cancel         context.CancelFunc
+	invalidated    bool
+}
+
+// WatchHeadEvents follows the head of the beacon node and fetches the duties of the current epoch
+// again when a reorg changes the blocks they depend on, instead of waiting for the next epoch to
+// start. The stream is opened again a slot after it ends, until the context is canceled.
+func (v *validator) WatchHeadEvents(ctx context.Context) {
+	if !v.hasCapability(grpcutils.CapabilityHeadEvents) {
+		log.Debug("Beacon node does not stream head events, duties are only refreshed at epoch starts")
+		return
+	}
+	retryInterval := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	for {
+		if err := v.streamHeadEvents(ctx); err != nil && ctx.Err() == nil {
+			log.WithError(err).Debug("Head event stream ended, opening it again")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retryInterval):
+		}
+	}
+}
+
+func (v *validator) streamHeadEvents(ctx context.Context) error {
+	stream, err := v.healthClient.StreamHeadEvents(ctx, &ptypes.Empty{})
+	if err != nil {
+		return err
+	}
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		v.handleHeadEvent(ctx, event)
+	}
+}
+
+// handleHeadEvent fetches the duties of the current epoch again when the proposer dependent root of
+// the head differs from the one the duties held were fetched with. The attester duties of the epoch
+// depend on an ancestor of that block, so they cannot change while it stays the same.
+func (v *validator) handleHeadEvent(ctx context.Context, event *pbrpc.HeadEvent) {
+	epoch := helpers.SlotToEpoch(event.Slot)
+	fetched := v.currentDutiesDependentRoot()
+	// Duties of another epoch are fetched when it starts, and duties fetched without a dependent
+	// root cannot be compared.
+	if fetched == nil || fetched.epoch != epoch || fetched.root == "" {
+		return
+	}
+	if fetched.root == fmt.Sprintf("%#x", event.CurrentDutyDependentRoot) {
+		return
+	}
+	// The duties held are those of the current epoch, which a head lagging behind does not affect.
+	currentSlot := slotutil.SlotsSinceGenesis(time.Unix(int64(v.genesisTime), 0))
+	if helpers.SlotToEpoch(currentSlot) != epoch || v.currentDuties() == nil {
+		return
+	}
+	log.WithFields(logrus.Fields{
+		"epoch":         epoch,
+		"headSlot":      event.Slot,
+		"headRoot":      fmt.Sprintf("%#x", bytesutil.Trunc(event.BlockRoot)),
+		"fetchedRoot":   fetched.root,
+		"dependentRoot": fmt.Sprintf("%#x", event.CurrentDutyDependentRoot),
+	}).Warn("Duty dependent roots changed by a reorg, fetching duties again")
+	v.revalidateDuties(ctx, currentSlot, epoch)
+}
+
+// revalidateDuties replaces the duties of the epoch with the ones of the new head, and cancels the
+// queued attestations whose assignments changed.
+func (v *validator) revalidateDuties(ctx context.Context, slot, epoch uint64) {
+	ss, err := helpers.StartSlot(epoch + 1)
+	if err != nil {
+		log.WithError(err).Error("Could not revalidate duties")
+		return
+	}
+	ctx, cancel := context.WithDeadline(ctx, v.SlotDeadline(ss))
+	defer cancel()
+	ctx, span := trace.StartSpan(ctx, "validator.revalidateDuties")
+	defer span.End()
+
+	validatingKeys, err := v.keyManager.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not fetch validating keys to revalidate duties")
+		return
+	}
+	req := &ethpb.DutiesRequest{
+		Epoch:      epoch,
+		PublicKeys: bytesutil.FromBytes48Array(validatingKeys),
+	}
+	resp, dependentRoot, err := v.fetchDuties(ctx, req)
+	if err != nil {
+		// Keep the duties held, the next epoch start fetches them again.
+		log.WithError(err).Error("Could not revalidate duties")
+		return
+	}
+	ValidatorDutiesReorgedCounter.Inc()
+	v.setDuties(epoch, resp, dependentRoot)
+	// Duties prefetched for the next epoch may depend on a reorged block too.
+	v.takeDutiesLookahead(epoch + 1)
+	if canceled := v.cancelInvalidatedAttestations(resp); canceled > 0 {
+		log.WithField("attestations", canceled).Warn("Canceled queued attestations of reorged assignments")
+	}
+	v.logDuties(slot, resp.Duties)
+	if err := v.subscribeToCommitteeSubnets(ctx, req, resp); err != nil {
+		log.WithError(err).Error("Could not subscribe to committee subnets")
+	}
+}
+
+// queueAttestation records the attestation of the validator at the slot until it is submitted. The
+// returned context is canceled when a reorg invalidates the assignment the attestation is for.
+func (v *validator) queueAttestation(ctx context.Context, slot uint64, pubKey [48]byte, duty *ethpb.DutiesResponse_Duty) (context.Context, *queuedAttestation) {
+	ctx, cancel := context.WithCancel(ctx)
+	queued := &queuedAttestation{
+		slot:           slot,
+		committeeIndex: duty.CommitteeIndex,
+		cancel:         cancel,
+	}
+	v.queuedAttestationsLock.Lock()
+	defer v.queuedAttestationsLock.Unlock()
+	if v.queuedAttestations == nil {
+		v.queuedAttestations = make(map[[48]byte]*queuedAttestation)
+	}
+	v.queuedAttestations[pubKey] = queued
+	return ctx, queued
+}
+
+// dequeueAttestation removes the attestation from the queue and returns whether its assignment was
+// invalidated while it was queued.
+func (v *validator) dequeueAttestation(pubKey [48]byte, queued *queuedAttestation) bool {
+	v.queuedAttestationsLock.Lock()
+	defer v.queuedAttestationsLock.Unlock()
+	if v.queuedAttestations[pubKey] == queued {
+		delete(v.queuedAttestations, pubKey)
+	}
+	queued.cancel()
+	return queued.invalidated
+}
+
+// cancelInvalidatedAttestations cancels the queued attestations whose validator is no longer
+// assigned to the same slot and committee in the duties, and returns how many were canceled.
+func (v *validator) cancelInvalidatedAttestations(duties *ethpb.DutiesResponse) int {
+	assignments := make(map[[48]byte]*ethpb.DutiesResponse_Duty, len(duties.Duties))
+	for _, duty := range duties.Duties {
+		assignments[bytesutil.ToBytes48(duty.PublicKey)] = duty
+	}
+	v.queuedAttestationsLock.Lock()
+	defer v.queuedAttestationsLock.Unlock()
+	var canceled int
+	for pubKey, queued := range v.queuedAttestations {
+		duty, ok := assignments[pubKey]
+		if ok && duty.AttesterSlot == queued.slot && duty.CommitteeIndex == queued.committeeIndex {
+			continue
+		}
+		queued.invalidated = true
+		queued.cancel()
+		delete(v.queuedAttestations, pubKey)
+		canceledAttestationsCounter.Inc()
+		canceled++
+	}
+	return canceled
+}
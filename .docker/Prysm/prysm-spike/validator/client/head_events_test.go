@@ -0,0 +1,162 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestHandleHeadEvent_RevalidatesReorgedDuties(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconNodeValidatorClient(ctrl)
+
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	secondsPerSlot := params.BeaconConfig().SecondsPerSlot
+	// The wall clock is one slot into epoch 1.
+	genesis := time.Now().Add(-time.Duration((slotsPerEpoch+1)*secondsPerSlot) * time.Second)
+	moved, unchanged := [48]byte{1}, [48]byte{2}
+	duties := &ethpb.DutiesResponse{Duties: []*ethpb.DutiesResponse_Duty{
+		{PublicKey: moved[:], AttesterSlot: slotsPerEpoch + 2, CommitteeIndex: 1},
+		{PublicKey: unchanged[:], AttesterSlot: slotsPerEpoch + 3, CommitteeIndex: 1},
+	}}
+	reorged := &ethpb.DutiesResponse{Duties: []*ethpb.DutiesResponse_Duty{
+		{PublicKey: moved[:], AttesterSlot: slotsPerEpoch + 2, CommitteeIndex: 2},
+		{PublicKey: unchanged[:], AttesterSlot: slotsPerEpoch + 3, CommitteeIndex: 1},
+	}}
+	v := validator{
+		genesisTime:     uint64(genesis.Unix()),
+		keyManager:      genMockKeymanger(1),
+		validatorClient: client,
+		duties:          duties,
+		dutiesDependentRoot: &dutiesDependentRoot{
+			epoch: 1,
+			root:  fmt.Sprintf("%#x", []byte{'b'}),
+		},
+	}
+	client.EXPECT().GetDuties(
+		gomock.Any(),
+		gomock.Any(),
+		gomock.Any(),
+	).Return(reorged, nil)
+	client.EXPECT().GetDuties(
+		gomock.Any(),
+		gomock.Any(),
+		gomock.Any(),
+	).Return(reorged, nil).AnyTimes()
+
+	movedCtx, movedAtt := v.queueAttestation(context.Background(), slotsPerEpoch+2, moved, duties.Duties[0])
+	unchangedCtx, unchangedAtt := v.queueAttestation(context.Background(), slotsPerEpoch+3, unchanged, duties.Duties[1])
+
+	// A head with the dependent root the duties were fetched with does not change them.
+	v.handleHeadEvent(context.Background(), &pbrpc.HeadEvent{
+		Slot:                      slotsPerEpoch,
+		PreviousDutyDependentRoot: []byte{'a'},
+		CurrentDutyDependentRoot:  []byte{'b'},
+	})
+	assert.Equal(t, duties, v.currentDuties())
+
+	v.handleHeadEvent(context.Background(), &pbrpc.HeadEvent{
+		Slot:                      slotsPerEpoch + 1,
+		PreviousDutyDependentRoot: []byte{'a'},
+		CurrentDutyDependentRoot:  []byte{'c'},
+	})
+	assert.Equal(t, reorged, v.currentDuties(), "Expected the reorged duties to replace the held ones")
+	assert.Equal(t, uint64(1), v.currentDutiesDependentRoot().epoch)
+	require.LogsContain(t, hook, "Duty dependent roots changed by a reorg")
+	assert.NotNil(t, movedCtx.Err(), "Expected the attestation of the moved assignment to be canceled")
+	assert.Equal(t, true, v.dequeueAttestation(moved, movedAtt))
+	assert.NoError(t, unchangedCtx.Err())
+	assert.Equal(t, false, v.dequeueAttestation(unchanged, unchangedAtt))
+}
+
+func TestHandleHeadEvent_FirstHeadOfEpochIsCompared(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconNodeValidatorClient(ctrl)
+
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	secondsPerSlot := params.BeaconConfig().SecondsPerSlot
+	// The wall clock is at the start of epoch 1.
+	genesis := time.Now().Add(-time.Duration(slotsPerEpoch*secondsPerSlot) * time.Second)
+	reorged := &ethpb.DutiesResponse{}
+	v := validator{
+		genesisTime:     uint64(genesis.Unix()),
+		keyManager:      genMockKeymanger(1),
+		validatorClient: client,
+		duties:          &ethpb.DutiesResponse{},
+		dutiesDependentRoot: &dutiesDependentRoot{
+			epoch: 1,
+			root:  fmt.Sprintf("%#x", []byte{'b'}),
+		},
+	}
+	client.EXPECT().GetDuties(
+		gomock.Any(),
+		gomock.Any(),
+		gomock.Any(),
+	).Return(reorged, nil).MinTimes(1)
+
+	// The head of the new epoch reorged the block the duties were fetched with.
+	v.handleHeadEvent(context.Background(), &pbrpc.HeadEvent{
+		Slot:                      slotsPerEpoch,
+		PreviousDutyDependentRoot: []byte{'a'},
+		CurrentDutyDependentRoot:  []byte{'c'},
+	})
+	assert.Equal(t, reorged, v.currentDuties(), "Expected the reorged duties to replace the held ones")
+}
+
+func TestHandleHeadEvent_OtherEpochIsNotCompared(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	// No duties are fetched, the epoch start fetches the duties of a new epoch.
+	client := mock.NewMockBeaconNodeValidatorClient(ctrl)
+
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	duties := &ethpb.DutiesResponse{}
+	fetched := &dutiesDependentRoot{
+		epoch: 1,
+		root:  fmt.Sprintf("%#x", []byte{'b'}),
+	}
+	v := validator{
+		validatorClient:     client,
+		duties:              duties,
+		dutiesDependentRoot: fetched,
+	}
+	v.handleHeadEvent(context.Background(), &pbrpc.HeadEvent{
+		Slot:                      2 * slotsPerEpoch,
+		PreviousDutyDependentRoot: []byte{'b'},
+		CurrentDutyDependentRoot:  []byte{'c'},
+	})
+	assert.Equal(t, duties, v.currentDuties())
+	assert.Equal(t, fetched, v.currentDutiesDependentRoot())
+
+	// Duties fetched without a dependent root are not compared either.
+	v.dutiesDependentRoot = &dutiesDependentRoot{epoch: 2}
+	v.handleHeadEvent(context.Background(), &pbrpc.HeadEvent{
+		Slot:                     2 * slotsPerEpoch,
+		CurrentDutyDependentRoot: []byte{'c'},
+	})
+	assert.Equal(t, duties, v.currentDuties())
+}
+
+func TestCancelInvalidatedAttestations_MissingAssignment(t *testing.T) {
+	v := validator{}
+	pubKey := [48]byte{1}
+	ctx, queued := v.queueAttestation(context.Background(), 5, pubKey, &ethpb.DutiesResponse_Duty{CommitteeIndex: 3})
+
+	assert.Equal(t, 1, v.cancelInvalidatedAttestations(&ethpb.DutiesResponse{}))
+	assert.NotNil(t, ctx.Err())
+	assert.Equal(t, true, v.dequeueAttestation(pubKey, queued))
+	assert.Equal(t, 0, len(v.queuedAttestations))
+}
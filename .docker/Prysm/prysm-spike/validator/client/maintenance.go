@@ -0,0 +1,179 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// ErrNoMaintenanceWindow is returned when the known duties leave no window of the requested length.
+var ErrNoMaintenanceWindow = errors.New("no maintenance window within the known duties")
+
+// MaintenanceWindow is a range of upcoming slots in which none of the validator keys has a
+// proposer or aggregator duty. Restarting the validator client within the window misses at
+// most attestations.
+type MaintenanceWindow struct {
+	StartSlot uint64
+	// EndSlot is the last slot of the window known to be free of duties.
+	EndSlot   uint64
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+// NextMaintenanceWindow returns the first window of at least minSlots slots, starting at or
+// after the current slot, in which none of the public keys has a proposer or aggregator duty.
+// The beacon node only knows the duties of the current and the next epoch, the window must
+// fit before the end of the next epoch.
+func NextMaintenanceWindow(
+	ctx context.Context,
+	validatorClient ethpb.BeaconNodeValidatorClient,
+	km keymanager.IKeymanager,
+	pubKeys [][48]byte,
+	genesisTime uint64,
+	minSlots uint64,
+) (*MaintenanceWindow, error) {
+	ctx, span := trace.StartSpan(ctx, "validator.NextMaintenanceWindow")
+	defer span.End()
+
+	v := &validator{
+		validatorClient: validatorClient,
+		keyManager:      km,
+	}
+	currentSlot := slotutil.SlotsSinceGenesis(time.Unix(int64(genesisTime), 0))
+	w, err := v.nextMaintenanceWindow(ctx, pubKeys, currentSlot, minSlots)
+	if err != nil {
+		return nil, err
+	}
+	w.StartTime = slotutil.SlotStartTime(genesisTime, w.StartSlot)
+	w.EndTime = slotutil.SlotStartTime(genesisTime, w.EndSlot+1)
+	return w, nil
+}
+
+// WaitForMaintenanceWindow blocks until the next maintenance window of at least minSlots slots
+// starts. The window is looked up again at every epoch start, as the duties of the next epoch
+// can change until then.
+func WaitForMaintenanceWindow(
+	ctx context.Context,
+	validatorClient ethpb.BeaconNodeValidatorClient,
+	km keymanager.IKeymanager,
+	pubKeys [][48]byte,
+	genesisTime uint64,
+	minSlots uint64,
+) (*MaintenanceWindow, error) {
+	for {
+		w, err := NextMaintenanceWindow(ctx, validatorClient, km, pubKeys, genesisTime, minSlots)
+		if err != nil && !errors.Is(err, ErrNoMaintenanceWindow) {
+			return nil, err
+		}
+		currentSlot := slotutil.SlotsSinceGenesis(time.Unix(int64(genesisTime), 0))
+		if w != nil && w.StartSlot <= currentSlot {
+			return w, nil
+		}
+		wakeSlot, err := helpers.StartSlot(helpers.SlotToEpoch(currentSlot) + 1)
+		if err != nil {
+			return nil, err
+		}
+		if w != nil && w.StartSlot < wakeSlot {
+			wakeSlot = w.StartSlot
+		}
+		wakeTime := slotutil.SlotStartTime(genesisTime, wakeSlot)
+		log.WithFields(logrus.Fields{
+			"currentSlot": currentSlot,
+			"wakeSlot":    wakeSlot,
+		}).Infof("Waiting %s for a maintenance window", wakeTime.Sub(timeutils.Now()).Round(time.Second))
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(time.Until(wakeTime)):
+		}
+	}
+}
+
+func (v *validator) nextMaintenanceWindow(
+	ctx context.Context,
+	pubKeys [][48]byte,
+	currentSlot uint64,
+	minSlots uint64,
+) (*MaintenanceWindow, error) {
+	if minSlots == 0 {
+		return nil, errors.New("maintenance window must be at least one slot long")
+	}
+	epoch := helpers.SlotToEpoch(currentSlot)
+	duties, err := v.validatorClient.GetDuties(ctx, &ethpb.DutiesRequest{
+		Epoch:      epoch,
+		PublicKeys: bytesutil.FromBytes48Array(pubKeys),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get duties")
+	}
+	busy, err := v.highValueDutySlots(ctx, append(duties.CurrentEpochDuties, duties.NextEpochDuties...), currentSlot)
+	if err != nil {
+		return nil, err
+	}
+	horizon, err := helpers.StartSlot(epoch + 2)
+	if err != nil {
+		return nil, err
+	}
+	start, end, ok := freeWindow(busy, currentSlot, horizon-1, minSlots)
+	if !ok {
+		return nil, errors.Wrapf(ErrNoMaintenanceWindow, "no %d slots without duties before slot %d", minSlots, horizon)
+	}
+	return &MaintenanceWindow{StartSlot: start, EndSlot: end}, nil
+}
+
+// highValueDutySlots returns the slots from the given slot on in which one of the duties
+// proposes a block or aggregates attestations.
+func (v *validator) highValueDutySlots(ctx context.Context, duties []*ethpb.DutiesResponse_Duty, fromSlot uint64) (map[uint64]bool, error) {
+	busy := make(map[uint64]bool)
+	for _, duty := range duties {
+		if duty == nil {
+			continue
+		}
+		for _, slot := range duty.ProposerSlots {
+			if slot != 0 && slot >= fromSlot {
+				busy[slot] = true
+			}
+		}
+		if duty.AttesterSlot < fromSlot || busy[duty.AttesterSlot] {
+			continue
+		}
+		aggregator, err := v.isAggregator(ctx, duty.Committee, duty.AttesterSlot, bytesutil.ToBytes48(duty.PublicKey))
+		if err != nil {
+			return nil, errors.Wrap(err, "could not check if a validator is an aggregator")
+		}
+		if aggregator {
+			busy[duty.AttesterSlot] = true
+		}
+	}
+	return busy, nil
+}
+
+// freeWindow returns the first run of at least minSlots slots in [fromSlot, lastSlot] which
+// are not busy, extended up to the next busy slot or the last slot.
+func freeWindow(busy map[uint64]bool, fromSlot, lastSlot, minSlots uint64) (uint64, uint64, bool) {
+	start := fromSlot
+	for slot := fromSlot; slot <= lastSlot; slot++ {
+		if busy[slot] {
+			start = slot + 1
+			continue
+		}
+		if slot+1-start < minSlots {
+			continue
+		}
+		end := slot
+		for end < lastSlot && !busy[end+1] {
+			end++
+		}
+		return start, end, true
+	}
+	return 0, 0, false
+}
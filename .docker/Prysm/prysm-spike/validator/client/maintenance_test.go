@@ -0,0 +1,86 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestFreeWindow(t *testing.T) {
+	busy := map[uint64]bool{3: true, 6: true}
+	tests := []struct {
+		name      string
+		minSlots  uint64
+		wantStart uint64
+		wantEnd   uint64
+		wantOK    bool
+	}{
+		{name: "before first duty", minSlots: 2, wantStart: 1, wantEnd: 2, wantOK: true},
+		{name: "between duties", minSlots: 3, wantStart: 7, wantEnd: 10, wantOK: true},
+		{name: "too long", minSlots: 5, wantOK: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end, ok := freeWindow(busy, 1, 10, tt.minSlots)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantStart, start)
+			assert.Equal(t, tt.wantEnd, end)
+		})
+	}
+}
+
+func TestNextMaintenanceWindow_SkipsProposerAndAggregatorDuties(t *testing.T) {
+	v, m, validatorKey, finish := setup(t)
+	defer finish()
+	ctx := context.Background()
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	currentSlot := slotsPerEpoch + 2
+	pubKey := [48]byte{}
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+
+	m.validatorClient.EXPECT().GetDuties(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&ethpb.DutiesResponse{
+		CurrentEpochDuties: []*ethpb.DutiesResponse_Duty{
+			{
+				PublicKey:     pubKey[:],
+				ProposerSlots: []uint64{slotsPerEpoch + 1, slotsPerEpoch + 8},
+				// Single member committees always select their member as aggregator.
+				AttesterSlot: slotsPerEpoch + 13,
+				Committee:    []uint64{0},
+			},
+		},
+		NextEpochDuties: []*ethpb.DutiesResponse_Duty{
+			{
+				PublicKey:     pubKey[:],
+				ProposerSlots: []uint64{2*slotsPerEpoch + 6},
+				AttesterSlot:  2*slotsPerEpoch + 6,
+				Committee:     []uint64{0},
+			},
+		},
+	}, nil).Times(3)
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil).AnyTimes()
+
+	w, err := v.nextMaintenanceWindow(ctx, [][48]byte{pubKey}, currentSlot, 4)
+	require.NoError(t, err)
+	assert.Equal(t, currentSlot, w.StartSlot)
+	assert.Equal(t, slotsPerEpoch+7, w.EndSlot)
+
+	w, err = v.nextMaintenanceWindow(ctx, [][48]byte{pubKey}, currentSlot, 10)
+	require.NoError(t, err)
+	assert.Equal(t, slotsPerEpoch+14, w.StartSlot)
+	assert.Equal(t, 2*slotsPerEpoch+5, w.EndSlot)
+
+	_, err = v.nextMaintenanceWindow(ctx, [][48]byte{pubKey}, currentSlot, slotsPerEpoch)
+	assert.Equal(t, true, errors.Is(err, ErrNoMaintenanceWindow))
+}
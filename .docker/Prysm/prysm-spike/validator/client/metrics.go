@@ -77,6 +77,51 @@ var (
 			"pubkey",
 		},
 	)
+	// ValidatorProposalsOrphaned used to count proposed blocks that did not become canonical.
+	ValidatorProposalsOrphaned = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "validator",
+		Name:      "orphaned_proposals_total",
+		Help:      "The number of proposed blocks that were orphaned by the beacon node's canonical chain.",
+	})
+	// ValidatorDutiesReorgedCounter used to count duties replaced after a reorg.
+	ValidatorDutiesReorgedCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "validator",
+		Name:      "reorged_duties_total",
+		Help:      "The number of times duties were replaced because their dependent root changed.",
+	})
+	// canceledAttestationsCounter used to count queued attestations canceled after a reorg.
+	canceledAttestationsCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "validator",
+		Name:      "canceled_attestations_total",
+		Help:      "The number of queued attestations canceled because a reorg invalidated their assignment.",
+	})
+	// subnetSubscriptionsCounter used to count committee subnet subscriptions sent to the beacon node by result.
+	subnetSubscriptionsCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "subnet_subscriptions_total",
+			Help:      "The number of committee subnet subscriptions sent to the beacon node, by result.",
+		},
+		[]string{
+			"result",
+		},
+	)
+	// subnetSubscriptionsSkippedCounter used to count committee subnet subscriptions not sent again.
+	subnetSubscriptionsSkippedCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "validator",
+		Name:      "subnet_subscriptions_skipped_total",
+		Help:      "The number of committee subnet subscriptions skipped because they were shared by several validators or already sent.",
+	})
+	// ValidatorProposeOrphanedVec used to count orphaned proposals by public key.
+	ValidatorProposeOrphanedVec = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "orphaned_proposals",
+		},
+		[]string{
+			"pubkey",
+		},
+	)
 	// ValidatorProposeFailVecSlasher used to count failed proposals by slashing protection.
 	ValidatorProposeFailVecSlasher = promauto.NewCounterVec(
 		prometheus.CounterOpts{
@@ -87,6 +132,57 @@ var (
 			"pubkey",
 		},
 	)
+	// ValidatorPolicyDeniedVec used to count messages denied by slashing protection policies.
+	ValidatorPolicyDeniedVec = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "policy_denied_total",
+			Help:      "Count the attestations and blocks denied by slashing protection policies.",
+		},
+		[]string{
+			"policy",
+		},
+	)
+	// ValidatorSigningAnomaliesVec used to count the signing anomalies which can lead to a quarantine.
+	ValidatorSigningAnomaliesVec = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "signing_anomalies_total",
+			Help:      "Count the slashing protection rejections and signatures rejected by the beacon node, by failure.",
+		},
+		[]string{
+			"failure",
+		},
+	)
+	// ValidatorQuarantinedKeysGauge used to keep track of the keys quarantined after repeated signing anomalies.
+	ValidatorQuarantinedKeysGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "validator",
+		Name:      "quarantined_keys",
+		Help:      "The number of keys quarantined after repeated signing anomalies, which do not perform duties.",
+	})
+	// ValidatorBeaconNodeHealthyGaugeVec used to track the health of the beacon nodes failed over between.
+	ValidatorBeaconNodeHealthyGaugeVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "beacon_node_healthy",
+			Help:      "Whether the beacon node was reachable and synced at the last probe, by endpoint.",
+		},
+		[]string{
+			"endpoint",
+		},
+	)
+	// ValidatorBeaconNodeFailoversCounter used to count the switches between beacon nodes.
+	ValidatorBeaconNodeFailoversCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "validator",
+		Name:      "beacon_node_failovers_total",
+		Help:      "The number of times the validator switched to another beacon node.",
+	})
+	// ValidatorDoppelgangerEpochsGauge used to keep track of the epochs left before duties start.
+	ValidatorDoppelgangerEpochsGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "validator",
+		Name:      "doppelganger_protection_remaining_epochs",
+		Help:      "The number of epochs the chain is still watched for other validators signing with the keys before duties start.",
+	})
 	// ValidatorBalancesGaugeVec used to keep track of validator balances by public key.
 	ValidatorBalancesGaugeVec = promauto.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -98,6 +194,39 @@ var (
 			"pubkey",
 		},
 	)
+	// ValidatorExpectedRewardGaugeVec used to keep track of the expected epoch reward by public key.
+	ValidatorExpectedRewardGaugeVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "expected_reward_gwei",
+			Help:      "Reward in gwei expected in the last epoch transition with optimal attestations.",
+		},
+		[]string{
+			"pubkey",
+		},
+	)
+	// ValidatorActualRewardGaugeVec used to keep track of the actual epoch balance change by public key.
+	ValidatorActualRewardGaugeVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "actual_reward_gwei",
+			Help:      "Balance change in gwei in the last epoch transition.",
+		},
+		[]string{
+			"pubkey",
+		},
+	)
+	// ValidatorRewardShortfallGaugeVec used to keep track of the missed part of the expected epoch reward by public key.
+	ValidatorRewardShortfallGaugeVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "reward_shortfall_gwei",
+			Help:      "Expected reward minus balance change in gwei in the last epoch transition.",
+		},
+		[]string{
+			"pubkey",
+		},
+	)
 	// ValidatorInclusionDistancesGaugeVec used to keep track of validator inclusion distances by public key.
 	ValidatorInclusionDistancesGaugeVec = promauto.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -216,7 +345,7 @@ func (v *validator) LogValidatorGainsAndLosses(ctx context.Context, slot uint64)
 
 	if v.emitAccountMetrics {
 		for _, missingPubKey := range resp.MissingValidators {
-			fmtKey := fmt.Sprintf("%#x", missingPubKey)
+			fmtKey := v.metricsLabel(missingPubKey)
 			ValidatorBalancesGaugeVec.WithLabelValues(fmtKey).Set(0)
 		}
 	}
@@ -224,9 +353,11 @@ func (v *validator) LogValidatorGainsAndLosses(ctx context.Context, slot uint64)
 	prevEpoch := uint64(0)
 	if slot >= params.BeaconConfig().SlotsPerEpoch {
 		prevEpoch = (slot / params.BeaconConfig().SlotsPerEpoch) - 1
+		v.voteStatsLock.Lock()
 		if v.voteStats.startEpoch == ^uint64(0) { // Handles unknown first epoch.
 			v.voteStats.startEpoch = prevEpoch
 		}
+		v.voteStatsLock.Unlock()
 	}
 	gweiPerEth := float64(params.BeaconConfig().GweiPerEth)
 	v.prevBalanceLock.Lock()
@@ -239,7 +370,7 @@ func (v *validator) LogValidatorGainsAndLosses(ctx context.Context, slot uint64)
 			v.startBalances[pubKeyBytes] = resp.BalancesBeforeEpochTransition[i]
 		}
 
-		fmtKey := fmt.Sprintf("%#x", pubKey)
+		fmtKey := v.metricsLabel(pubKey)
 		truncatedKey := fmt.Sprintf("%#x", bytesutil.Trunc(pubKey))
 		if v.prevBalance[pubKeyBytes] > 0 {
 			newBalance := float64(resp.BalancesAfterEpochTransition[i]) / gweiPerEth
@@ -287,11 +418,15 @@ func (v *validator) LogValidatorGainsAndLosses(ctx context.Context, slot uint64)
 	v.prevBalanceLock.Unlock()
 
 	v.UpdateLogAggregateStats(resp, slot)
+	v.recordRewardSummaries(ctx, slot, resp)
 	return nil
 }
 
 // UpdateLogAggregateStats updates and logs the voteStats struct of a validator using the RPC response obtained from LogValidatorGainsAndLosses.
 func (v *validator) UpdateLogAggregateStats(resp *ethpb.ValidatorPerformanceResponse, slot uint64) {
+	v.voteStatsLock.Lock()
+	defer v.voteStatsLock.Unlock()
+	v.updateKeyPerformances(resp)
 	summary := &v.voteStats
 	currentEpoch := slot / params.BeaconConfig().SlotsPerEpoch
 	var included uint64
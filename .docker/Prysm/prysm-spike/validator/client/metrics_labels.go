@@ -0,0 +1,106 @@
+package client
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+)
+
+const (
+	// AccountMetricsLabelFull labels account metrics with the full public key.
+	AccountMetricsLabelFull = "full"
+	// AccountMetricsLabelTruncated labels account metrics with the first bytes of the public key,
+	// as shown in the logs.
+	AccountMetricsLabelTruncated = "truncated"
+	// AccountMetricsLabelHashed labels account metrics with a salted hash of the public key, which
+	// can only be related to the key with the salt of the validator client.
+	AccountMetricsLabelHashed = "hashed"
+
+	accountMetricsSaltFileName = "account-metrics-salt"
+	accountMetricsSaltLength   = 32
+	// Eight bytes of hash keep collisions unlikely for any number of keys of a validator client.
+	hashedLabelLength = 8
+)
+
+// AccountMetricsLabeler formats the public keys of validator accounts as values of the pubkey
+// label of account metrics.
+type AccountMetricsLabeler struct {
+	mode string
+	salt []byte
+}
+
+// NewAccountMetricsLabeler returns a labeler for the given mode. Hashed labels are salted with the
+// salt stored in the data directory, which is generated on first use so labels stay the same
+// across restarts.
+func NewAccountMetricsLabeler(mode, dataDir string) (*AccountMetricsLabeler, error) {
+	switch mode {
+	case AccountMetricsLabelFull, AccountMetricsLabelTruncated:
+		return &AccountMetricsLabeler{mode: mode}, nil
+	case AccountMetricsLabelHashed:
+		salt, err := loadOrCreateAccountMetricsSalt(filepath.Join(dataDir, accountMetricsSaltFileName))
+		if err != nil {
+			return nil, err
+		}
+		return &AccountMetricsLabeler{mode: mode, salt: salt}, nil
+	default:
+		return nil, fmt.Errorf(
+			"invalid account metrics label %q, expected one of %s, %s or %s",
+			mode,
+			AccountMetricsLabelFull,
+			AccountMetricsLabelTruncated,
+			AccountMetricsLabelHashed,
+		)
+	}
+}
+
+// Label returns the label value of the public key. A nil labeler uses full public keys.
+func (l *AccountMetricsLabeler) Label(pubKey []byte) string {
+	if l == nil {
+		return fmt.Sprintf("%#x", pubKey)
+	}
+	switch l.mode {
+	case AccountMetricsLabelTruncated:
+		return fmt.Sprintf("%#x", bytesutil.Trunc(pubKey))
+	case AccountMetricsLabelHashed:
+		h := hashutil.Hash(append(append([]byte{}, l.salt...), pubKey...))
+		return fmt.Sprintf("%#x", h[:hashedLabelLength])
+	default:
+		return fmt.Sprintf("%#x", pubKey)
+	}
+}
+
+func loadOrCreateAccountMetricsSalt(path string) ([]byte, error) {
+	if fileutil.FileExists(path) {
+		salt, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read account metrics salt")
+		}
+		if len(salt) != accountMetricsSaltLength {
+			return nil, fmt.Errorf("invalid account metrics salt in %s: expected %d bytes, got %d", path, accountMetricsSaltLength, len(salt))
+		}
+		return salt, nil
+	}
+	if err := fileutil.MkdirAll(filepath.Dir(path)); err != nil {
+		return nil, err
+	}
+	salt := make([]byte, accountMetricsSaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, errors.Wrap(err, "could not generate account metrics salt")
+	}
+	if err := fileutil.WriteFile(path, salt); err != nil {
+		return nil, errors.Wrap(err, "could not write account metrics salt")
+	}
+	log.WithField("path", path).Info("Generated salt of hashed account metrics labels")
+	return salt, nil
+}
+
+// metricsLabel returns the value of the pubkey label of the account metrics of the public key.
+func (v *validator) metricsLabel(pubKey []byte) string {
+	return v.accountMetricsLabeler.Label(pubKey)
+}
@@ -0,0 +1,42 @@
+package client
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestAccountMetricsLabeler_Label(t *testing.T) {
+	pubKey := [48]byte{1, 2, 3, 4, 5, 6, 7}
+	dir := filepath.Join(t.TempDir(), "data")
+
+	full, err := NewAccountMetricsLabeler(AccountMetricsLabelFull, dir)
+	require.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf("%#x", pubKey), full.Label(pubKey[:]))
+	var nilLabeler *AccountMetricsLabeler
+	assert.Equal(t, fmt.Sprintf("%#x", pubKey), nilLabeler.Label(pubKey[:]))
+
+	truncated, err := NewAccountMetricsLabeler(AccountMetricsLabelTruncated, dir)
+	require.NoError(t, err)
+	assert.Equal(t, "0x010203040506", truncated.Label(pubKey[:]))
+
+	hashed, err := NewAccountMetricsLabeler(AccountMetricsLabelHashed, dir)
+	require.NoError(t, err)
+	label := hashed.Label(pubKey[:])
+	assert.Equal(t, 2+2*hashedLabelLength, len(label))
+	assert.NotEqual(t, label, hashed.Label([]byte{1}))
+
+	// Hashed labels are stable across restarts and differ between data directories.
+	restarted, err := NewAccountMetricsLabeler(AccountMetricsLabelHashed, dir)
+	require.NoError(t, err)
+	assert.Equal(t, label, restarted.Label(pubKey[:]))
+	other, err := NewAccountMetricsLabeler(AccountMetricsLabelHashed, filepath.Join(t.TempDir(), "data"))
+	require.NoError(t, err)
+	assert.NotEqual(t, label, other.Label(pubKey[:]))
+
+	_, err = NewAccountMetricsLabeler("unknown", dir)
+	assert.ErrorContains(t, "invalid account metrics label", err)
+}
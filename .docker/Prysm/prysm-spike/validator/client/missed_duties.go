@@ -0,0 +1,55 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// recordMissedDuty saves a failed duty to the missed duty journal of the validator database, so
+// its history outlives the logs. Failures of beacon node requests are classified by their status.
+// Signing anomalies among the failures count towards the quarantine of the key.
+func (v *validator) recordMissedDuty(
+	ctx context.Context, dutyType kv.DutyType, slot uint64, pubKey [48]byte, failure kv.DutyFailure, err error,
+) {
+	v.recordSigningAnomaly(ctx, slot, pubKey, failure, err)
+	if dutyType == kv.ProposalDuty {
+		v.recordProposal(pubKey, false)
+	}
+	if v.db == nil {
+		return
+	}
+	if failure == kv.BeaconNodeError || failure == kv.SubmissionRejected {
+		failure = classifyBeaconNodeError(err, failure)
+	}
+	duty := &kv.MissedDuty{
+		Type:      dutyType,
+		Slot:      slot,
+		Epoch:     slot / params.BeaconConfig().SlotsPerEpoch,
+		PubKey:    fmt.Sprintf("%#x", pubKey),
+		Failure:   failure,
+		Timestamp: timeutils.Now().Unix(),
+	}
+	if err != nil {
+		duty.Error = err.Error()
+	}
+	if err := v.db.SaveMissedDuty(ctx, duty); err != nil {
+		log.WithError(err).Error("Could not save missed duty")
+	}
+}
+
+// classifyBeaconNodeError returns BeaconNodeUnavailable for errors of requests which did not reach
+// the beacon node in time, and the given failure otherwise.
+func classifyBeaconNodeError(err error, failure kv.DutyFailure) kv.DutyFailure {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Canceled:
+		return kv.BeaconNodeUnavailable
+	default:
+		return failure
+	}
+}
@@ -0,0 +1,38 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	dbTest "github.com/prysmaticlabs/prysm/validator/db/testing"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRecordMissedDuty_ClassifiesBeaconNodeErrors(t *testing.T) {
+	pubKey := [48]byte{1}
+	valDB := dbTest.SetupDB(t, [][48]byte{pubKey})
+	v := &validator{db: valDB}
+	ctx := context.Background()
+	slot := params.BeaconConfig().SlotsPerEpoch*3 + 1
+
+	v.recordMissedDuty(ctx, kv.AttestationDuty, slot, pubKey, kv.BeaconNodeError, status.Error(codes.Unavailable, "connection refused"))
+	v.recordMissedDuty(ctx, kv.ProposalDuty, slot, pubKey, kv.SubmissionRejected, status.Error(codes.InvalidArgument, "bad block"))
+	v.recordMissedDuty(ctx, kv.AggregationDuty, slot, pubKey, kv.SigningFailure, errors.New("remote signer down"))
+
+	duties, err := valDB.MissedDuties(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(duties))
+	assert.Equal(t, kv.BeaconNodeUnavailable, duties[0].Failure)
+	assert.Equal(t, kv.SubmissionRejected, duties[1].Failure)
+	assert.Equal(t, kv.SigningFailure, duties[2].Failure)
+	assert.Equal(t, uint64(3), duties[0].Epoch)
+	assert.Equal(t, fmt.Sprintf("%#x", pubKey), duties[0].PubKey)
+	assert.Equal(t, "remote signer down", duties[2].Error)
+}
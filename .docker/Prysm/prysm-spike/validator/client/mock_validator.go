@@ -15,7 +15,9 @@ type FakeValidator struct {
 	DoneCalled                        bool
 	WaitForWalletInitializationCalled bool
 	WaitForActivationCalled           bool
+	DoppelgangerProtectionCalled      bool
 	WaitForChainStartCalled           bool
+	NegotiateCapabilitiesCalled       bool
 	WaitForSyncCalled                 bool
 	SlasherReadyCalled                bool
 	NextSlotCalled                    bool
@@ -64,12 +66,24 @@ func (fv *FakeValidator) WaitForChainStart(_ context.Context) error {
 	return nil
 }
 
+// NegotiateCapabilities for mocking.
+func (fv *FakeValidator) NegotiateCapabilities(_ context.Context) error {
+	fv.NegotiateCapabilitiesCalled = true
+	return nil
+}
+
 // WaitForActivation for mocking.
 func (fv *FakeValidator) WaitForActivation(_ context.Context) error {
 	fv.WaitForActivationCalled = true
 	return nil
 }
 
+// DoppelgangerProtection for mocking.
+func (fv *FakeValidator) DoppelgangerProtection(_ context.Context) error {
+	fv.DoppelgangerProtectionCalled = true
+	return nil
+}
+
 // WaitForSync for mocking.
 func (fv *FakeValidator) WaitForSync(_ context.Context) error {
 	fv.WaitForSyncCalled = true
@@ -148,6 +162,17 @@ func (fv *FakeValidator) ProposeBlock(_ context.Context, slot uint64, _ [48]byte
 // SubmitAggregateAndProof for mocking.
 func (fv *FakeValidator) SubmitAggregateAndProof(_ context.Context, _ uint64, _ [48]byte) {}
 
+// CheckProposedBlocks for mocking.
+func (fv *FakeValidator) CheckProposedBlocks(_ context.Context, _ uint64) {}
+
+// WatchHeadEvents for mocking.
+func (fv *FakeValidator) WatchHeadEvents(_ context.Context) {}
+
+// KeyPerformances for mocking.
+func (fv *FakeValidator) KeyPerformances() (uint64, []*KeyPerformance) {
+	return 0, nil
+}
+
 // LogAttestationsSubmitted for mocking.
 func (fv *FakeValidator) LogAttestationsSubmitted() {}
 
@@ -36,7 +36,8 @@ func (r *multipleEndpointsGrpcResolver) start() {
 	endpoints := strings.Split(r.target.Endpoint, ",")
 	var addrs []resolver.Address
 	for _, endpoint := range endpoints {
-		addrs = append(addrs, resolver.Address{Addr: endpoint})
+		// The server name of each address lets TLS credentials verify every endpoint by its own name.
+		addrs = append(addrs, resolver.Address{Addr: endpoint, ServerName: endpoint})
 	}
 	r.cc.UpdateState(resolver.State{Addresses: addrs})
 }
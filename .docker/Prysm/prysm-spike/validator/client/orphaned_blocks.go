@@ -0,0 +1,196 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// webhookTimeout bounds the time a webhook can take to accept a notification.
+const webhookTimeout = 5 * time.Second
+
+// proposedBlock is a block proposed by one of the validator's keys which has not yet
+// been checked against the beacon node's canonical chain.
+type proposedBlock struct {
+	pubKey [48]byte
+	slot   uint64
+	root   []byte
+}
+
+// orphanedBlockNotification is the JSON payload sent to the orphaned block webhook.
+type orphanedBlockNotification struct {
+	PublicKey          string `json:"pubkey"`
+	Slot               uint64 `json:"slot"`
+	BlockRoot          string `json:"block_root"`
+	CanonicalBlockRoot string `json:"canonical_block_root,omitempty"`
+	HeadBlockRoot      string `json:"head_block_root"`
+	HeadSlot           uint64 `json:"head_slot"`
+}
+
+// trackProposedBlock records a successfully submitted block so it can later be checked
+// for inclusion in the canonical chain.
+func (v *validator) trackProposedBlock(pubKey [48]byte, slot uint64, root []byte) {
+	if v.orphanCheckDepth == 0 || v.proposedBlocks == nil {
+		return
+	}
+	v.proposedBlocksLock.Lock()
+	defer v.proposedBlocksLock.Unlock()
+	v.proposedBlocks[slot] = &proposedBlock{
+		pubKey: pubKey,
+		slot:   slot,
+		root:   bytesutil.SafeCopyBytes(root),
+	}
+}
+
+// CheckProposedBlocks verifies that blocks proposed at least orphanCheckDepth slots ago
+// are part of the beacon node's canonical chain, and reports the ones that were orphaned.
+func (v *validator) CheckProposedBlocks(ctx context.Context, slot uint64) {
+	if v.orphanCheckDepth == 0 || slot < v.orphanCheckDepth {
+		return
+	}
+	ctx, span := trace.StartSpan(ctx, "validator.CheckProposedBlocks")
+	defer span.End()
+
+	for _, blk := range v.proposedBlocksDue(slot) {
+		canonicalRoot, head, err := v.canonicalBlockRootAtSlot(ctx, blk.slot)
+		if err != nil {
+			log.WithError(err).WithField("slot", blk.slot).Debug("Could not check if proposed block is canonical")
+			// Retry on the next slot, unless the proposal is too old to be worth tracking.
+			if slot >= blk.slot+v.orphanCheckDepth+params.BeaconConfig().SlotsPerEpoch {
+				v.untrackProposedBlock(blk.slot)
+			}
+			continue
+		}
+		v.untrackProposedBlock(blk.slot)
+		if bytes.Equal(canonicalRoot, blk.root) {
+			continue
+		}
+		v.reportOrphanedBlock(ctx, blk, canonicalRoot, head)
+	}
+}
+
+// proposedBlocksDue returns the tracked proposals that are old enough to be checked.
+func (v *validator) proposedBlocksDue(slot uint64) []*proposedBlock {
+	v.proposedBlocksLock.Lock()
+	defer v.proposedBlocksLock.Unlock()
+	due := make([]*proposedBlock, 0)
+	for s, blk := range v.proposedBlocks {
+		if s+v.orphanCheckDepth <= slot {
+			due = append(due, blk)
+		}
+	}
+	return due
+}
+
+func (v *validator) untrackProposedBlock(slot uint64) {
+	v.proposedBlocksLock.Lock()
+	defer v.proposedBlocksLock.Unlock()
+	delete(v.proposedBlocks, slot)
+}
+
+// canonicalBlockRootAtSlot walks back from the beacon node's head until it reaches the
+// requested slot. It returns the canonical block root at that slot, or nil if the slot
+// was skipped in the canonical chain, along with the chain head used for the walk.
+func (v *validator) canonicalBlockRootAtSlot(ctx context.Context, slot uint64) ([]byte, *ethpb.ChainHead, error) {
+	head, err := v.beaconClient.GetChainHead(ctx, &ptypes.Empty{})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not get chain head")
+	}
+	if head.HeadSlot < slot {
+		return nil, nil, fmt.Errorf("beacon node head slot %d is behind slot %d", head.HeadSlot, slot)
+	}
+	root := head.HeadBlockRoot
+	// The walk visits at most one block per slot between the head and the requested slot.
+	for i := uint64(0); i <= head.HeadSlot-slot; i++ {
+		resp, err := v.beaconClient.ListBlocks(ctx, &ethpb.ListBlocksRequest{
+			QueryFilter: &ethpb.ListBlocksRequest_Root{Root: root},
+		})
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "could not get block %#x", bytesutil.Trunc(root))
+		}
+		if len(resp.BlockContainers) == 0 || resp.BlockContainers[0].Block == nil {
+			return nil, nil, fmt.Errorf("block %#x not found", bytesutil.Trunc(root))
+		}
+		blk := resp.BlockContainers[0].Block.Block
+		if blk.Slot == slot {
+			return root, head, nil
+		}
+		if blk.Slot < slot {
+			return nil, head, nil
+		}
+		root = blk.ParentRoot
+	}
+	return nil, head, nil
+}
+
+func (v *validator) reportOrphanedBlock(ctx context.Context, blk *proposedBlock, canonicalRoot []byte, head *ethpb.ChainHead) {
+	ValidatorProposalsOrphaned.Inc()
+	if v.emitAccountMetrics {
+		ValidatorProposeOrphanedVec.WithLabelValues(v.metricsLabel(blk.pubKey[:])).Inc()
+	}
+	fields := logrus.Fields{
+		"pubKey":            fmt.Sprintf("%#x", bytesutil.Trunc(blk.pubKey[:])),
+		"slot":              blk.slot,
+		"blockRoot":         fmt.Sprintf("%#x", bytesutil.Trunc(blk.root)),
+		"competingHeadRoot": fmt.Sprintf("%#x", bytesutil.Trunc(head.HeadBlockRoot)),
+		"headSlot":          head.HeadSlot,
+	}
+	if canonicalRoot != nil {
+		fields["canonicalBlockRoot"] = fmt.Sprintf("%#x", bytesutil.Trunc(canonicalRoot))
+	}
+	log.WithFields(fields).Warn("Proposed block was orphaned")
+
+	if v.orphanedBlockWebhook == "" {
+		return
+	}
+	notification := &orphanedBlockNotification{
+		PublicKey:     fmt.Sprintf("%#x", blk.pubKey[:]),
+		Slot:          blk.slot,
+		BlockRoot:     fmt.Sprintf("%#x", blk.root),
+		HeadBlockRoot: fmt.Sprintf("%#x", head.HeadBlockRoot),
+		HeadSlot:      head.HeadSlot,
+	}
+	if canonicalRoot != nil {
+		notification.CanonicalBlockRoot = fmt.Sprintf("%#x", canonicalRoot)
+	}
+	if err := postWebhook(ctx, v.orphanedBlockWebhook, notification); err != nil {
+		log.WithError(err).Error("Could not notify orphaned block webhook")
+	}
+}
+
+// postWebhook posts the notification to the webhook as JSON.
+func postWebhook(ctx context.Context, url string, notification interface{}) error {
+	enc, err := json.Marshal(notification)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, webhookTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(enc))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	if err := resp.Body.Close(); err != nil {
+		log.WithError(err).Debug("Could not close response body")
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
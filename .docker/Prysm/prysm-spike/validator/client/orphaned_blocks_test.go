@@ -0,0 +1,134 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+// expectChain sets up the beacon client mock to serve a chain head and the blocks by root.
+func expectChain(client *mock.MockBeaconChainClient, head *ethpb.ChainHead, blocks map[[32]byte]*ethpb.BeaconBlock) {
+	client.EXPECT().GetChainHead(gomock.Any(), gomock.Any()).Return(head, nil)
+	client.EXPECT().ListBlocks(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *ethpb.ListBlocksRequest, _ ...interface{}) (*ethpb.ListBlocksResponse, error) {
+			root := bytesutil.ToBytes32(req.QueryFilter.(*ethpb.ListBlocksRequest_Root).Root)
+			blk, ok := blocks[root]
+			if !ok {
+				return &ethpb.ListBlocksResponse{}, nil
+			}
+			return &ethpb.ListBlocksResponse{
+				BlockContainers: []*ethpb.BeaconBlockContainer{
+					{Block: &ethpb.SignedBeaconBlock{Block: blk}, BlockRoot: root[:]},
+				},
+			}, nil
+		}).AnyTimes()
+}
+
+func TestCheckProposedBlocks_Canonical(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconChainClient(ctrl)
+	v := &validator{
+		beaconClient:     client,
+		orphanCheckDepth: 2,
+		proposedBlocks:   make(map[uint64]*proposedBlock),
+	}
+	proposed := [32]byte{'a'}
+	v.trackProposedBlock([48]byte{'k'}, 10, proposed[:])
+
+	// Not yet old enough to be checked.
+	v.CheckProposedBlocks(context.Background(), 11)
+	assert.Equal(t, 1, len(v.proposedBlocks))
+
+	head := [32]byte{'c'}
+	expectChain(client, &ethpb.ChainHead{HeadSlot: 12, HeadBlockRoot: head[:]}, map[[32]byte]*ethpb.BeaconBlock{
+		head:     {Slot: 12, ParentRoot: proposed[:]},
+		proposed: {Slot: 10},
+	})
+	v.CheckProposedBlocks(context.Background(), 12)
+	assert.Equal(t, 0, len(v.proposedBlocks))
+	require.LogsDoNotContain(t, hook, "Proposed block was orphaned")
+}
+
+func TestCheckProposedBlocks_Orphaned(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconChainClient(ctrl)
+
+	var received *orphanedBlockNotification
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = &orphanedBlockNotification{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(received))
+	}))
+	defer srv.Close()
+
+	v := &validator{
+		beaconClient:         client,
+		orphanCheckDepth:     2,
+		orphanedBlockWebhook: srv.URL,
+		proposedBlocks:       make(map[uint64]*proposedBlock),
+	}
+	proposed := [32]byte{'a'}
+	v.trackProposedBlock([48]byte{'k'}, 10, proposed[:])
+
+	competing := [32]byte{'b'}
+	head := [32]byte{'c'}
+	expectChain(client, &ethpb.ChainHead{HeadSlot: 12, HeadBlockRoot: head[:]}, map[[32]byte]*ethpb.BeaconBlock{
+		head:      {Slot: 12, ParentRoot: competing[:]},
+		competing: {Slot: 10},
+	})
+	v.CheckProposedBlocks(context.Background(), 12)
+	assert.Equal(t, 0, len(v.proposedBlocks))
+	require.LogsContain(t, hook, "Proposed block was orphaned")
+	require.NotNil(t, received)
+	assert.Equal(t, uint64(10), received.Slot)
+	assert.Equal(t, "0x61"+"00000000000000000000000000000000000000000000000000000000000000", received.BlockRoot)
+	assert.Equal(t, "0x62"+"00000000000000000000000000000000000000000000000000000000000000", received.CanonicalBlockRoot)
+	assert.Equal(t, "0x63"+"00000000000000000000000000000000000000000000000000000000000000", received.HeadBlockRoot)
+}
+
+func TestCheckProposedBlocks_SkippedSlot(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconChainClient(ctrl)
+	v := &validator{
+		beaconClient:     client,
+		orphanCheckDepth: 2,
+		proposedBlocks:   make(map[uint64]*proposedBlock),
+	}
+	proposed := [32]byte{'a'}
+	v.trackProposedBlock([48]byte{'k'}, 10, proposed[:])
+
+	parent := [32]byte{'p'}
+	head := [32]byte{'c'}
+	expectChain(client, &ethpb.ChainHead{HeadSlot: 12, HeadBlockRoot: head[:]}, map[[32]byte]*ethpb.BeaconBlock{
+		head:   {Slot: 12, ParentRoot: parent[:]},
+		parent: {Slot: 9},
+	})
+	v.CheckProposedBlocks(context.Background(), 12)
+	require.LogsContain(t, hook, "Proposed block was orphaned")
+	require.LogsDoNotContain(t, hook, "canonicalBlockRoot")
+}
+
+func TestCheckProposedBlocks_Disabled(t *testing.T) {
+	v := &validator{
+		proposedBlocks: make(map[uint64]*proposedBlock),
+	}
+	v.trackProposedBlock([48]byte{'k'}, 10, []byte{'a'})
+	assert.Equal(t, 0, len(v.proposedBlocks))
+	// No beacon client calls are expected when detection is disabled.
+	v.CheckProposedBlocks(context.Background(), 100)
+}
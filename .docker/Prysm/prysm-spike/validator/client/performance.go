@@ -0,0 +1,113 @@
+package client
+
+import (
+	"bytes"
+	"errors"
+	"sort"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+// PerformanceFetcher can retrieve how the validating keys performed their duties
+// since the validator started.
+type PerformanceFetcher interface {
+	KeyPerformances() (uint64, []*KeyPerformance, error)
+}
+
+// KeyPerformance sums up the duties of a validating key since the validator started.
+type KeyPerformance struct {
+	PublicKey              [48]byte
+	Epochs                 uint64
+	IncludedAttestations   uint64
+	MissedAttestations     uint64
+	TotalInclusionDistance uint64
+	CorrectSources         uint64
+	CorrectTargets         uint64
+	CorrectHeads           uint64
+	BalanceChange          int64 // In gwei, over the epoch transitions of the tracked epochs.
+	ProposedBlocks         uint64
+	MissedProposals        uint64
+}
+
+// KeyPerformances returns the first epoch summed up and the performance of every key that had a duty
+// since, ordered by public key. It errors until the validator runs.
+func (v *ValidatorService) KeyPerformances() (uint64, []*KeyPerformance, error) {
+	if v.validator == nil {
+		return 0, nil, errors.New("validator is not running yet")
+	}
+	startEpoch, performances := v.validator.KeyPerformances()
+	return startEpoch, performances, nil
+}
+
+// KeyPerformances returns copies of the per key stats tracked in the vote stats of the validator.
+func (v *validator) KeyPerformances() (uint64, []*KeyPerformance) {
+	v.voteStatsLock.RLock()
+	defer v.voteStatsLock.RUnlock()
+	startEpoch := v.voteStats.startEpoch
+	if startEpoch == ^uint64(0) {
+		startEpoch = 0
+	}
+	performances := make([]*KeyPerformance, 0, len(v.voteStats.keys))
+	for _, perf := range v.voteStats.keys {
+		p := *perf
+		performances = append(performances, &p)
+	}
+	sort.Slice(performances, func(i, j int) bool {
+		return bytes.Compare(performances[i].PublicKey[:], performances[j].PublicKey[:]) < 0
+	})
+	return startEpoch, performances
+}
+
+// keyPerformance returns the tracked stats of the key, creating them on first use. The caller holds
+// the vote stats lock.
+func (v *validator) keyPerformance(pubKey [48]byte) *KeyPerformance {
+	if v.voteStats.keys == nil {
+		v.voteStats.keys = make(map[[48]byte]*KeyPerformance)
+	}
+	perf, ok := v.voteStats.keys[pubKey]
+	if !ok {
+		perf = &KeyPerformance{PublicKey: pubKey}
+		v.voteStats.keys[pubKey] = perf
+	}
+	return perf
+}
+
+// updateKeyPerformances adds the previous epoch votes and balance changes of the response to the stats
+// of each key. The caller holds the vote stats lock.
+func (v *validator) updateKeyPerformances(resp *ethpb.ValidatorPerformanceResponse) {
+	for i, pubKey := range resp.PublicKeys {
+		perf := v.keyPerformance(bytesutil.ToBytes48(pubKey))
+		perf.Epochs++
+		if resp.InclusionSlots[i] == ^uint64(0) {
+			perf.MissedAttestations++
+		} else {
+			perf.IncludedAttestations++
+			perf.TotalInclusionDistance += resp.InclusionDistances[i]
+		}
+		if resp.CorrectlyVotedSource[i] {
+			perf.CorrectSources++
+		}
+		if resp.CorrectlyVotedTarget[i] {
+			perf.CorrectTargets++
+		}
+		if resp.CorrectlyVotedHead[i] {
+			perf.CorrectHeads++
+		}
+		if i < len(resp.BalancesAfterEpochTransition) && i < len(resp.BalancesBeforeEpochTransition) {
+			perf.BalanceChange += int64(resp.BalancesAfterEpochTransition[i]) - int64(resp.BalancesBeforeEpochTransition[i])
+		}
+	}
+}
+
+// recordProposal counts a proposal of the key, submitted or missed.
+func (v *validator) recordProposal(pubKey [48]byte, submitted bool) {
+	v.voteStatsLock.Lock()
+	defer v.voteStatsLock.Unlock()
+	perf := v.keyPerformance(pubKey)
+	if submitted {
+		perf.ProposedBlocks++
+	} else {
+		perf.MissedProposals++
+	}
+}
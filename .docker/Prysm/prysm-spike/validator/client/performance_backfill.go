@@ -0,0 +1,274 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	vdb "github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// attestationDuty is the position of a validator in a beacon committee.
+type attestationDuty struct {
+	slot           uint64
+	committeeIndex uint64
+	position       uint64
+}
+
+type committeeKey struct {
+	slot           uint64
+	committeeIndex uint64
+}
+
+// includedAttestation is an attestation included in a canonical block.
+type includedAttestation struct {
+	blockSlot   uint64
+	attestation *ethpb.Attestation
+}
+
+// BackfillAttestationPerformance reconstructs the attestation performance of the validator public
+// keys over the most recent finalized epochs from the chain data of the beacon node, and saves it
+// to the validator database. This gives keys migrated to a new validator client the history the
+// client would have recorded itself. Keys unknown to the beacon node are skipped.
+func BackfillAttestationPerformance(
+	ctx context.Context,
+	beaconClient ethpb.BeaconChainClient,
+	validatorClient ethpb.BeaconNodeValidatorClient,
+	valDB vdb.Database,
+	pubKeys [][48]byte,
+	numEpochs uint64,
+) error {
+	ctx, span := trace.StartSpan(ctx, "validator.BackfillAttestationPerformance")
+	defer span.End()
+
+	head, err := beaconClient.GetChainHead(ctx, &ptypes.Empty{})
+	if err != nil {
+		return errors.Wrap(err, "could not get chain head")
+	}
+	startEpoch, endEpoch, ok := finalizedEpochRange(head, numEpochs)
+	if !ok {
+		return errors.New("no finalized epochs to backfill")
+	}
+	keysByIndex := validatorIndices(ctx, validatorClient, pubKeys)
+	if len(keysByIndex) == 0 {
+		return errors.New("none of the public keys are known to the beacon node")
+	}
+	performance, err := finalizedAttestationPerformance(ctx, beaconClient, head, startEpoch, endEpoch, keysByIndex)
+	if err != nil {
+		return err
+	}
+
+	for index, p := range performance {
+		pubKey := keysByIndex[index]
+		if err := valDB.SaveAttestationPerformance(ctx, pubKey, p); err != nil {
+			return errors.Wrap(err, "could not save attestation performance")
+		}
+		included := 0
+		for _, record := range p {
+			if record.Included {
+				included++
+			}
+		}
+		log.WithFields(logrus.Fields{
+			"pubKey":     fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:])),
+			"startEpoch": startEpoch,
+			"endEpoch":   endEpoch,
+			"duties":     len(p),
+			"included":   included,
+		}).Info("Backfilled attestation performance")
+	}
+	return nil
+}
+
+// finalizedEpochRange returns the range of the most recent finalized epochs, at most numEpochs.
+// Attestations can be included until the end of the epoch following their duty, only epochs whose
+// whole inclusion window precedes the finalized checkpoint are in the range.
+func finalizedEpochRange(head *ethpb.ChainHead, numEpochs uint64) (uint64, uint64, bool) {
+	if head.FinalizedEpoch < 2 || numEpochs == 0 {
+		return 0, 0, false
+	}
+	endEpoch := head.FinalizedEpoch - 2
+	startEpoch := uint64(0)
+	if endEpoch+1 > numEpochs {
+		startEpoch = endEpoch + 1 - numEpochs
+	}
+	return startEpoch, endEpoch, true
+}
+
+// validatorIndices returns the public keys by validator index. Keys unknown to the beacon node are
+// skipped.
+func validatorIndices(
+	ctx context.Context,
+	validatorClient ethpb.BeaconNodeValidatorClient,
+	pubKeys [][48]byte,
+) map[uint64][48]byte {
+	keysByIndex := make(map[uint64][48]byte, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		resp, err := validatorClient.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]})
+		if err != nil {
+			log.WithError(err).WithField("pubKey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:]))).Warn(
+				"Could not get validator index, skipping it",
+			)
+			continue
+		}
+		keysByIndex[resp.Index] = pubKey
+	}
+	return keysByIndex
+}
+
+// finalizedAttestationPerformance returns the attestation performance of the validators over the
+// finalized epoch range by validator index, of every validator when keysByIndex is nil.
+func finalizedAttestationPerformance(
+	ctx context.Context,
+	beaconClient ethpb.BeaconChainClient,
+	head *ethpb.ChainHead,
+	startEpoch, endEpoch uint64,
+	keysByIndex map[uint64][48]byte,
+) (map[uint64][]*kv.AttestationPerformance, error) {
+	// Blocks of the epoch before the first epoch are needed for the roots of empty slots.
+	blocksStartEpoch := startEpoch
+	if blocksStartEpoch > 0 {
+		blocksStartEpoch--
+	}
+	canonical, err := canonicalBlocks(ctx, beaconClient, head.FinalizedBlockRoot, blocksStartEpoch, head.FinalizedEpoch)
+	if err != nil {
+		return nil, err
+	}
+	inclusions := make(map[committeeKey][]*includedAttestation)
+	for _, blk := range canonical {
+		for _, att := range blk.Block.Block.Body.Attestations {
+			k := committeeKey{slot: att.Data.Slot, committeeIndex: att.Data.CommitteeIndex}
+			inclusions[k] = append(inclusions[k], &includedAttestation{blockSlot: blk.Block.Block.Slot, attestation: att})
+		}
+	}
+
+	performance := make(map[uint64][]*kv.AttestationPerformance)
+	for epoch := startEpoch; epoch <= endEpoch; epoch++ {
+		committees, err := beaconClient.ListBeaconCommittees(ctx, &ethpb.ListCommitteesRequest{
+			QueryFilter: &ethpb.ListCommitteesRequest_Epoch{Epoch: epoch},
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not list beacon committees of epoch %d", epoch)
+		}
+		epochStartSlot, err := helpers.StartSlot(epoch)
+		if err != nil {
+			return nil, err
+		}
+		targetRoot := rootAtSlot(canonical, epochStartSlot)
+		for index, duty := range attestationDuties(committees, keysByIndex) {
+			p := attestationPerformance(
+				duty,
+				inclusions[committeeKey{slot: duty.slot, committeeIndex: duty.committeeIndex}],
+				targetRoot,
+				rootAtSlot(canonical, duty.slot),
+			)
+			p.Epoch = epoch
+			performance[index] = append(performance[index], p)
+		}
+	}
+	return performance, nil
+}
+
+// canonicalBlocks returns the blocks of the epoch range which are ancestors of the finalized block,
+// in ascending slot order.
+func canonicalBlocks(
+	ctx context.Context,
+	beaconClient ethpb.BeaconChainClient,
+	finalizedRoot []byte,
+	startEpoch, endEpoch uint64,
+) ([]*ethpb.BeaconBlockContainer, error) {
+	blocksByRoot := make(map[[32]byte]*ethpb.BeaconBlockContainer)
+	for epoch := startEpoch; epoch <= endEpoch; epoch++ {
+		req := &ethpb.ListBlocksRequest{QueryFilter: &ethpb.ListBlocksRequest_Epoch{Epoch: epoch}}
+		for {
+			resp, err := beaconClient.ListBlocks(ctx, req)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not list blocks of epoch %d", epoch)
+			}
+			for _, blk := range resp.BlockContainers {
+				blocksByRoot[bytesutil.ToBytes32(blk.BlockRoot)] = blk
+			}
+			if resp.NextPageToken == "" || len(resp.BlockContainers) == 0 {
+				break
+			}
+			req.PageToken = resp.NextPageToken
+		}
+	}
+	// Blocks orphaned before finalization are not ancestors of the finalized block.
+	var canonical []*ethpb.BeaconBlockContainer
+	blk, ok := blocksByRoot[bytesutil.ToBytes32(finalizedRoot)]
+	for ok {
+		canonical = append(canonical, blk)
+		blk, ok = blocksByRoot[bytesutil.ToBytes32(blk.Block.Block.ParentRoot)]
+	}
+	for i, j := 0, len(canonical)-1; i < j; i, j = i+1, j-1 {
+		canonical[i], canonical[j] = canonical[j], canonical[i]
+	}
+	return canonical, nil
+}
+
+// rootAtSlot returns the root of the latest canonical block at or before the slot, nil if the
+// canonical blocks do not reach back to the slot.
+func rootAtSlot(canonical []*ethpb.BeaconBlockContainer, slot uint64) []byte {
+	i := sort.Search(len(canonical), func(i int) bool {
+		return canonical[i].Block.Block.Slot > slot
+	})
+	if i == 0 {
+		return nil
+	}
+	return canonical[i-1].BlockRoot
+}
+
+// attestationDuties returns the duties of the validators in the committees of an epoch, by
+// validator index, of every validator when keysByIndex is nil.
+func attestationDuties(committees *ethpb.BeaconCommittees, keysByIndex map[uint64][48]byte) map[uint64]*attestationDuty {
+	duties := make(map[uint64]*attestationDuty)
+	for slot, list := range committees.Committees {
+		for committeeIndex, committee := range list.Committees {
+			for position, index := range committee.ValidatorIndices {
+				if _, ok := keysByIndex[index]; ok || keysByIndex == nil {
+					duties[index] = &attestationDuty{
+						slot:           slot,
+						committeeIndex: uint64(committeeIndex),
+						position:       uint64(position),
+					}
+				}
+			}
+		}
+	}
+	return duties
+}
+
+// attestationPerformance returns whether and when the attestation of the duty was first included
+// in the canonical attestations of its committee, which are in ascending block slot order, and
+// whether it voted for the canonical target and head roots.
+func attestationPerformance(
+	duty *attestationDuty,
+	inclusions []*includedAttestation,
+	targetRoot, headRoot []byte,
+) *kv.AttestationPerformance {
+	p := &kv.AttestationPerformance{Slot: duty.slot}
+	for _, included := range inclusions {
+		if included.blockSlot > duty.slot+params.BeaconConfig().SlotsPerEpoch {
+			break
+		}
+		if included.attestation.AggregationBits.BitAt(duty.position) {
+			p.Included = true
+			p.InclusionSlot = included.blockSlot
+			p.CorrectTarget = targetRoot != nil && bytes.Equal(included.attestation.Data.Target.Root, targetRoot)
+			p.CorrectHead = headRoot != nil && bytes.Equal(included.attestation.Data.BeaconBlockRoot, headRoot)
+			return p
+		}
+	}
+	return p
+}
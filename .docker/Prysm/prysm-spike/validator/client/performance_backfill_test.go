@@ -0,0 +1,133 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	dbTest "github.com/prysmaticlabs/prysm/validator/db/testing"
+)
+
+func backfillAttestation(slot, position uint64, target, head [32]byte) *ethpb.Attestation {
+	bits := bitfield.NewBitlist(2)
+	bits.SetBitAt(position, true)
+	return &ethpb.Attestation{
+		AggregationBits: bits,
+		Data: &ethpb.AttestationData{
+			Slot:            slot,
+			BeaconBlockRoot: head[:],
+			Target:          &ethpb.Checkpoint{Root: target[:]},
+		},
+	}
+}
+
+func TestBackfillAttestationPerformance(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	beaconClient := mock.NewMockBeaconChainClient(ctrl)
+	validatorClient := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	keys := map[uint64][48]byte{5: {5}, 7: {7}, 9: {9}}
+	unknownKey := [48]byte{1}
+	valDB := dbTest.SetupDB(t, nil)
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+
+	a, b, orphan, c, finalized := [32]byte{'a'}, [32]byte{'b'}, [32]byte{'o'}, [32]byte{'c'}, [32]byte{'f'}
+	blocks := []*ethpb.BeaconBlockContainer{
+		{BlockRoot: a[:], Block: &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{
+			Slot: 0, Body: &ethpb.BeaconBlockBody{},
+		}}},
+		{BlockRoot: b[:], Block: &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{
+			Slot: slotsPerEpoch + 1, ParentRoot: a[:], Body: &ethpb.BeaconBlockBody{
+				Attestations: []*ethpb.Attestation{backfillAttestation(1, 1, a, a)},
+			},
+		}}},
+		{BlockRoot: orphan[:], Block: &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{
+			Slot: slotsPerEpoch + 10, ParentRoot: b[:], Body: &ethpb.BeaconBlockBody{
+				Attestations: []*ethpb.Attestation{backfillAttestation(slotsPerEpoch+8, 1, a, b)},
+			},
+		}}},
+		{BlockRoot: c[:], Block: &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{
+			Slot: 2 * slotsPerEpoch, ParentRoot: b[:], Body: &ethpb.BeaconBlockBody{
+				Attestations: []*ethpb.Attestation{backfillAttestation(slotsPerEpoch+8, 0, b, b)},
+			},
+		}}},
+		{BlockRoot: finalized[:], Block: &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{
+			Slot: 3 * slotsPerEpoch, ParentRoot: c[:], Body: &ethpb.BeaconBlockBody{},
+		}}},
+	}
+
+	beaconClient.EXPECT().GetChainHead(gomock.Any(), gomock.Any()).Return(&ethpb.ChainHead{
+		FinalizedEpoch:     3,
+		FinalizedBlockRoot: finalized[:],
+	}, nil)
+	beaconClient.EXPECT().ListBlocks(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *ethpb.ListBlocksRequest, _ ...interface{}) (*ethpb.ListBlocksResponse, error) {
+			epoch := req.QueryFilter.(*ethpb.ListBlocksRequest_Epoch).Epoch
+			resp := &ethpb.ListBlocksResponse{}
+			for _, blk := range blocks {
+				if blk.Block.Block.Slot/slotsPerEpoch == epoch {
+					resp.BlockContainers = append(resp.BlockContainers, blk)
+				}
+			}
+			return resp, nil
+		}).Times(4)
+	beaconClient.EXPECT().ListBeaconCommittees(gomock.Any(), &ethpb.ListCommitteesRequest{
+		QueryFilter: &ethpb.ListCommitteesRequest_Epoch{Epoch: 0},
+	}).Return(&ethpb.BeaconCommittees{Committees: map[uint64]*ethpb.BeaconCommittees_CommitteesList{
+		1: {Committees: []*ethpb.BeaconCommittees_CommitteeItem{{ValidatorIndices: []uint64{3, 5}}}},
+	}}, nil)
+	beaconClient.EXPECT().ListBeaconCommittees(gomock.Any(), &ethpb.ListCommitteesRequest{
+		QueryFilter: &ethpb.ListCommitteesRequest_Epoch{Epoch: 1},
+	}).Return(&ethpb.BeaconCommittees{Committees: map[uint64]*ethpb.BeaconCommittees_CommitteesList{
+		slotsPerEpoch + 8: {Committees: []*ethpb.BeaconCommittees_CommitteeItem{{ValidatorIndices: []uint64{7, 9}}}},
+	}}, nil)
+	validatorClient.EXPECT().ValidatorIndex(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *ethpb.ValidatorIndexRequest, _ ...interface{}) (*ethpb.ValidatorIndexResponse, error) {
+			for index, key := range keys {
+				if bytesutil.ToBytes48(req.PublicKey) == key {
+					return &ethpb.ValidatorIndexResponse{Index: index}, nil
+				}
+			}
+			return nil, errors.New("not found")
+		}).Times(4)
+
+	ctx := context.Background()
+	pubKeys := [][48]byte{keys[5], keys[7], keys[9], unknownKey}
+	require.NoError(t, BackfillAttestationPerformance(ctx, beaconClient, validatorClient, valDB, pubKeys, 10))
+
+	received, err := valDB.AttestationPerformance(ctx, keys[5], 0, 10)
+	require.NoError(t, err)
+	require.DeepEqual(t, []*kv.AttestationPerformance{
+		{Epoch: 0, Slot: 1, Included: true, InclusionSlot: slotsPerEpoch + 1, CorrectTarget: true, CorrectHead: true},
+	}, received)
+	// The attestation in the orphaned block does not count, the canonical one has a wrong target.
+	received, err = valDB.AttestationPerformance(ctx, keys[7], 0, 10)
+	require.NoError(t, err)
+	require.DeepEqual(t, []*kv.AttestationPerformance{
+		{Epoch: 1, Slot: slotsPerEpoch + 8, Included: true, InclusionSlot: 2 * slotsPerEpoch, CorrectHead: true},
+	}, received)
+	received, err = valDB.AttestationPerformance(ctx, keys[9], 0, 10)
+	require.NoError(t, err)
+	require.DeepEqual(t, []*kv.AttestationPerformance{{Epoch: 1, Slot: slotsPerEpoch + 8}}, received)
+	received, err = valDB.AttestationPerformance(ctx, unknownKey, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(received))
+}
+
+func TestBackfillAttestationPerformance_NothingFinalized(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	beaconClient := mock.NewMockBeaconChainClient(ctrl)
+	beaconClient.EXPECT().GetChainHead(gomock.Any(), gomock.Any()).Return(&ethpb.ChainHead{FinalizedEpoch: 1}, nil)
+	err := BackfillAttestationPerformance(context.Background(), beaconClient, nil, nil, [][48]byte{{1}}, 10)
+	assert.ErrorContains(t, "no finalized epochs to backfill", err)
+}
@@ -0,0 +1,164 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"go.opencensus.io/trace"
+)
+
+// AttestationSummary is the attestation performance of a validator, or the average of the
+// validators of the network, over a range of finalized epochs.
+type AttestationSummary struct {
+	Duties   int `json:"duties"`
+	Included int `json:"included"`
+	// InclusionDistance is the average number of slots between the duties and the blocks including
+	// their attestations, of the included attestations.
+	InclusionDistance float64 `json:"inclusion_distance"`
+	// Effectiveness is the average of the inverse inclusion distance of the duties, 0 for missed
+	// attestations, so 1 is the best achievable.
+	Effectiveness float64 `json:"effectiveness"`
+}
+
+// PerformanceComparison compares the attestation performance of a validator with the validators of
+// the network. Percentiles are the share of the validators of the network performing worse, so 50
+// is the median and 100 is the best.
+type PerformanceComparison struct {
+	PubKey                      string              `json:"pubkey"`
+	Index                       uint64              `json:"index"`
+	Summary                     *AttestationSummary `json:"summary"`
+	InclusionDistancePercentile float64             `json:"inclusion_distance_percentile"`
+	EffectivenessPercentile     float64             `json:"effectiveness_percentile"`
+}
+
+// NetworkPerformance is the attestation performance of the network over a range of finalized epochs,
+// and the comparison of the validators with it.
+type NetworkPerformance struct {
+	StartEpoch  uint64                   `json:"start_epoch"`
+	EndEpoch    uint64                   `json:"end_epoch"`
+	Validators  int                      `json:"validators"`
+	Average     *AttestationSummary      `json:"average"`
+	Comparisons []*PerformanceComparison `json:"comparisons"`
+}
+
+// CompareAttestationPerformance compares the attestation performance of the validator public keys
+// over the most recent finalized epochs with every validator of the network, from the chain data of
+// the beacon node. This tells whether an underperforming validator is affected by a local problem,
+// or by one of the whole network. Keys unknown to the beacon node are skipped.
+func CompareAttestationPerformance(
+	ctx context.Context,
+	beaconClient ethpb.BeaconChainClient,
+	validatorClient ethpb.BeaconNodeValidatorClient,
+	pubKeys [][48]byte,
+	numEpochs uint64,
+) (*NetworkPerformance, error) {
+	ctx, span := trace.StartSpan(ctx, "validator.CompareAttestationPerformance")
+	defer span.End()
+
+	head, err := beaconClient.GetChainHead(ctx, &ptypes.Empty{})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get chain head")
+	}
+	startEpoch, endEpoch, ok := finalizedEpochRange(head, numEpochs)
+	if !ok {
+		return nil, errors.New("no finalized epochs to compare")
+	}
+	keysByIndex := validatorIndices(ctx, validatorClient, pubKeys)
+	if len(keysByIndex) == 0 {
+		return nil, errors.New("none of the public keys are known to the beacon node")
+	}
+	performance, err := finalizedAttestationPerformance(ctx, beaconClient, head, startEpoch, endEpoch, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	summaries := make(map[uint64]*AttestationSummary, len(performance))
+	var distances, effectiveness []float64
+	network := &AttestationSummary{}
+	var distanceSum, effectivenessSum float64
+	for index, p := range performance {
+		s := summarizeAttestations(p)
+		summaries[index] = s
+		network.Duties += s.Duties
+		network.Included += s.Included
+		distanceSum += s.InclusionDistance * float64(s.Included)
+		effectivenessSum += s.Effectiveness * float64(s.Duties)
+		if s.Included > 0 {
+			distances = append(distances, s.InclusionDistance)
+		}
+		effectiveness = append(effectiveness, s.Effectiveness)
+	}
+	if network.Included > 0 {
+		network.InclusionDistance = distanceSum / float64(network.Included)
+	}
+	if network.Duties > 0 {
+		network.Effectiveness = effectivenessSum / float64(network.Duties)
+	}
+	sort.Float64s(distances)
+	sort.Float64s(effectiveness)
+
+	result := &NetworkPerformance{
+		StartEpoch: startEpoch,
+		EndEpoch:   endEpoch,
+		Validators: len(summaries),
+		Average:    network,
+	}
+	for index, pubKey := range keysByIndex {
+		s, ok := summaries[index]
+		if !ok {
+			// Validators without duties in the range, such as pending ones, have nothing to compare.
+			s = &AttestationSummary{}
+		}
+		c := &PerformanceComparison{PubKey: fmt.Sprintf("%#x", pubKey), Index: index, Summary: s}
+		if s.Included > 0 {
+			// A shorter inclusion distance is better.
+			c.InclusionDistancePercentile = 100 - percentileRank(distances, s.InclusionDistance)
+		}
+		if s.Duties > 0 {
+			c.EffectivenessPercentile = percentileRank(effectiveness, s.Effectiveness)
+		}
+		result.Comparisons = append(result.Comparisons, c)
+	}
+	sort.Slice(result.Comparisons, func(i, j int) bool {
+		return result.Comparisons[i].Index < result.Comparisons[j].Index
+	})
+	return result, nil
+}
+
+// summarizeAttestations returns the average attestation performance of the duties of a validator.
+func summarizeAttestations(performance []*kv.AttestationPerformance) *AttestationSummary {
+	s := &AttestationSummary{Duties: len(performance)}
+	var distanceSum, effectivenessSum float64
+	for _, p := range performance {
+		if !p.Included {
+			continue
+		}
+		distance := float64(p.InclusionSlot - p.Slot)
+		s.Included++
+		distanceSum += distance
+		effectivenessSum += 1 / distance
+	}
+	if s.Included > 0 {
+		s.InclusionDistance = distanceSum / float64(s.Included)
+	}
+	if s.Duties > 0 {
+		s.Effectiveness = effectivenessSum / float64(s.Duties)
+	}
+	return s
+}
+
+// percentileRank returns the percentage of the sorted values below the value, counting equal
+// values as half below.
+func percentileRank(sorted []float64, value float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	below := sort.SearchFloat64s(sorted, value)
+	equal := sort.Search(len(sorted), func(i int) bool { return sorted[i] > value }) - below
+	return 100 * (float64(below) + float64(equal)/2) / float64(len(sorted))
+}
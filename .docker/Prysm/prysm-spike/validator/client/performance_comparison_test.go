@@ -0,0 +1,128 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func committeeAttestation(slot uint64, positions ...uint64) *ethpb.Attestation {
+	bits := bitfield.NewBitlist(4)
+	for _, position := range positions {
+		bits.SetBitAt(position, true)
+	}
+	return &ethpb.Attestation{
+		AggregationBits: bits,
+		Data:            &ethpb.AttestationData{Slot: slot, Target: &ethpb.Checkpoint{}},
+	}
+}
+
+func TestCompareAttestationPerformance(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	beaconClient := mock.NewMockBeaconChainClient(ctrl)
+	validatorClient := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	keys := map[uint64][48]byte{9: {9}, 11: {11}}
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	dutySlot := slotsPerEpoch + 8
+
+	// Validators 7 and 9 are included after a slot, 11 after 4 slots, and 13 missed its attestation.
+	a, b, c, finalized := [32]byte{'a'}, [32]byte{'b'}, [32]byte{'c'}, [32]byte{'f'}
+	blocks := []*ethpb.BeaconBlockContainer{
+		{BlockRoot: a[:], Block: &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{
+			Slot: 0, Body: &ethpb.BeaconBlockBody{},
+		}}},
+		{BlockRoot: b[:], Block: &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{
+			Slot: dutySlot + 1, ParentRoot: a[:], Body: &ethpb.BeaconBlockBody{
+				Attestations: []*ethpb.Attestation{committeeAttestation(dutySlot, 0, 1)},
+			},
+		}}},
+		{BlockRoot: c[:], Block: &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{
+			Slot: dutySlot + 4, ParentRoot: b[:], Body: &ethpb.BeaconBlockBody{
+				Attestations: []*ethpb.Attestation{committeeAttestation(dutySlot, 2)},
+			},
+		}}},
+		{BlockRoot: finalized[:], Block: &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{
+			Slot: 3 * slotsPerEpoch, ParentRoot: c[:], Body: &ethpb.BeaconBlockBody{},
+		}}},
+	}
+
+	beaconClient.EXPECT().GetChainHead(gomock.Any(), gomock.Any()).Return(&ethpb.ChainHead{
+		FinalizedEpoch:     3,
+		FinalizedBlockRoot: finalized[:],
+	}, nil)
+	beaconClient.EXPECT().ListBlocks(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *ethpb.ListBlocksRequest, _ ...interface{}) (*ethpb.ListBlocksResponse, error) {
+			epoch := req.QueryFilter.(*ethpb.ListBlocksRequest_Epoch).Epoch
+			resp := &ethpb.ListBlocksResponse{}
+			for _, blk := range blocks {
+				if blk.Block.Block.Slot/slotsPerEpoch == epoch {
+					resp.BlockContainers = append(resp.BlockContainers, blk)
+				}
+			}
+			return resp, nil
+		}).Times(4)
+	beaconClient.EXPECT().ListBeaconCommittees(gomock.Any(), &ethpb.ListCommitteesRequest{
+		QueryFilter: &ethpb.ListCommitteesRequest_Epoch{Epoch: 1},
+	}).Return(&ethpb.BeaconCommittees{Committees: map[uint64]*ethpb.BeaconCommittees_CommitteesList{
+		dutySlot: {Committees: []*ethpb.BeaconCommittees_CommitteeItem{{ValidatorIndices: []uint64{7, 9, 11, 13}}}},
+	}}, nil)
+	validatorClient.EXPECT().ValidatorIndex(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *ethpb.ValidatorIndexRequest, _ ...interface{}) (*ethpb.ValidatorIndexResponse, error) {
+			for index, key := range keys {
+				if bytesutil.ToBytes48(req.PublicKey) == key {
+					return &ethpb.ValidatorIndexResponse{Index: index}, nil
+				}
+			}
+			return nil, errors.New("not found")
+		}).Times(3)
+
+	pubKeys := [][48]byte{keys[11], keys[9], {1}}
+	result, err := CompareAttestationPerformance(context.Background(), beaconClient, validatorClient, pubKeys, 1)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), result.StartEpoch)
+	assert.Equal(t, uint64(1), result.EndEpoch)
+	assert.Equal(t, 4, result.Validators)
+	assert.DeepEqual(t, &AttestationSummary{
+		Duties:            4,
+		Included:          3,
+		InclusionDistance: 2,
+		Effectiveness:     0.5625,
+	}, result.Average)
+
+	require.Equal(t, 2, len(result.Comparisons))
+	first, second := result.Comparisons[0], result.Comparisons[1]
+	assert.Equal(t, uint64(9), first.Index)
+	assert.DeepEqual(t, &AttestationSummary{Duties: 1, Included: 1, InclusionDistance: 1, Effectiveness: 1}, first.Summary)
+	assert.Equal(t, 67, int(first.InclusionDistancePercentile+0.5))
+	assert.Equal(t, 75.0, first.EffectivenessPercentile)
+	assert.Equal(t, uint64(11), second.Index)
+	assert.Equal(t, 17, int(second.InclusionDistancePercentile+0.5))
+	assert.Equal(t, 37.5, second.EffectivenessPercentile)
+}
+
+func TestCompareAttestationPerformance_NothingFinalized(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	beaconClient := mock.NewMockBeaconChainClient(ctrl)
+	beaconClient.EXPECT().GetChainHead(gomock.Any(), gomock.Any()).Return(&ethpb.ChainHead{FinalizedEpoch: 1}, nil)
+	_, err := CompareAttestationPerformance(context.Background(), beaconClient, nil, [][48]byte{{1}}, 10)
+	assert.ErrorContains(t, "no finalized epochs to compare", err)
+}
+
+func TestPercentileRank(t *testing.T) {
+	sorted := []float64{1, 2, 2, 3}
+	assert.Equal(t, 0.0, percentileRank(nil, 1))
+	assert.Equal(t, 12.5, percentileRank(sorted, 1))
+	assert.Equal(t, 50.0, percentileRank(sorted, 2))
+	assert.Equal(t, 100.0, percentileRank(sorted, 4))
+}
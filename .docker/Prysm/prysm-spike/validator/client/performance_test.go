@@ -0,0 +1,78 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+)
+
+func TestKeyPerformances(t *testing.T) {
+	first, second := [48]byte{1}, [48]byte{2}
+	v := &validator{
+		startBalances: make(map[[48]byte]uint64),
+		prevBalance:   make(map[[48]byte]uint64),
+		voteStats:     voteStats{startEpoch: ^uint64(0)},
+	}
+	startEpoch, performances := v.KeyPerformances()
+	assert.Equal(t, uint64(0), startEpoch)
+	assert.Equal(t, 0, len(performances))
+
+	v.voteStats.startEpoch = 2
+	for _, resp := range []*ethpb.ValidatorPerformanceResponse{
+		{
+			PublicKeys:                    [][]byte{second[:], first[:]},
+			InclusionSlots:                []uint64{^uint64(0), 70},
+			InclusionDistances:            []uint64{0, 3},
+			CorrectlyVotedSource:          []bool{false, true},
+			CorrectlyVotedTarget:          []bool{false, true},
+			CorrectlyVotedHead:            []bool{false, false},
+			BalancesBeforeEpochTransition: []uint64{32000000000, 32000000000},
+			BalancesAfterEpochTransition:  []uint64{31999990000, 32000020000},
+		},
+		{
+			PublicKeys:                    [][]byte{second[:], first[:]},
+			InclusionSlots:                []uint64{97, 98},
+			InclusionDistances:            []uint64{1, 2},
+			CorrectlyVotedSource:          []bool{true, true},
+			CorrectlyVotedTarget:          []bool{true, true},
+			CorrectlyVotedHead:            []bool{true, true},
+			BalancesBeforeEpochTransition: []uint64{31999990000, 32000020000},
+			BalancesAfterEpochTransition:  []uint64{32000010000, 32000040000},
+		},
+	} {
+		v.UpdateLogAggregateStats(resp, 96)
+	}
+	v.recordProposal(first, true)
+	v.recordMissedDuty(context.Background(), kv.ProposalDuty, 100, second, kv.BeaconNodeError, nil)
+
+	startEpoch, performances = v.KeyPerformances()
+	assert.Equal(t, uint64(2), startEpoch)
+	require.Equal(t, 2, len(performances))
+	assert.DeepEqual(t, &KeyPerformance{
+		PublicKey:              first,
+		Epochs:                 2,
+		IncludedAttestations:   2,
+		TotalInclusionDistance: 5,
+		CorrectSources:         2,
+		CorrectTargets:         2,
+		CorrectHeads:           1,
+		BalanceChange:          40000,
+		ProposedBlocks:         1,
+	}, performances[0])
+	assert.DeepEqual(t, &KeyPerformance{
+		PublicKey:              second,
+		Epochs:                 2,
+		IncludedAttestations:   1,
+		MissedAttestations:     1,
+		TotalInclusionDistance: 1,
+		CorrectSources:         1,
+		CorrectTargets:         1,
+		CorrectHeads:           1,
+		BalanceChange:          10000,
+		MissedProposals:        1,
+	}, performances[1])
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/timeutils"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
@@ -36,7 +37,7 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, pubKey [48]by
 	}
 	ctx, span := trace.StartSpan(ctx, "validator.ProposeBlock")
 	defer span.End()
-	fmtKey := fmt.Sprintf("%#x", pubKey[:])
+	fmtKey := v.metricsLabel(pubKey[:])
 
 	span.AddAttributes(trace.StringAttribute("validator", fmt.Sprintf("%#x", pubKey)))
 	log := log.WithField("pubKey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:])))
@@ -46,6 +47,7 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, pubKey [48]by
 	randaoReveal, err := v.signRandaoReveal(ctx, pubKey, epoch)
 	if err != nil {
 		log.WithError(err).Error("Failed to sign randao reveal")
+		v.recordMissedDuty(ctx, kv.ProposalDuty, slot, pubKey, kv.SigningFailure, err)
 		if v.emitAccountMetrics {
 			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
 		}
@@ -56,10 +58,11 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, pubKey [48]by
 	b, err := v.validatorClient.GetBlock(ctx, &ethpb.BlockRequest{
 		Slot:         slot,
 		RandaoReveal: randaoReveal,
-		Graffiti:     v.graffiti,
+		Graffiti:     v.graffitiFor(pubKey),
 	})
 	if err != nil {
 		log.WithField("blockSlot", slot).WithError(err).Error("Failed to request block from beacon node")
+		v.recordMissedDuty(ctx, kv.ProposalDuty, slot, pubKey, kv.BeaconNodeError, err)
 		if v.emitAccountMetrics {
 			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
 		}
@@ -70,6 +73,7 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, pubKey [48]by
 		log.WithFields(
 			blockLogFields(pubKey, b, nil),
 		).WithError(err).Error("Failed block slashing protection check")
+		v.recordMissedDuty(ctx, kv.ProposalDuty, slot, pubKey, kv.SlashingProtection, err)
 		return
 	}
 
@@ -77,6 +81,7 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, pubKey [48]by
 	sig, domain, err := v.signBlock(ctx, pubKey, epoch, b)
 	if err != nil {
 		log.WithError(err).Error("Failed to sign block")
+		v.recordMissedDuty(ctx, kv.ProposalDuty, slot, pubKey, kv.SigningFailure, err)
 		if v.emitAccountMetrics {
 			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
 		}
@@ -91,13 +96,16 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, pubKey [48]by
 		log.WithFields(
 			blockLogFields(pubKey, b, sig),
 		).WithError(err).Error("Failed block slashing protection check")
+		v.recordMissedDuty(ctx, kv.ProposalDuty, slot, pubKey, kv.SlashingProtection, err)
 		return
 	}
 
 	// Propose and broadcast block via beacon node
+	v.submissionQueue.wait(ctx, proposalSubmission)
 	blkResp, err := v.validatorClient.ProposeBlock(ctx, blk)
 	if err != nil {
 		log.WithError(err).Error("Failed to propose block")
+		v.recordMissedDuty(ctx, kv.ProposalDuty, slot, pubKey, kv.SubmissionRejected, err)
 		if v.emitAccountMetrics {
 			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
 		}
@@ -119,9 +127,23 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, pubKey [48]by
 		"graffiti":        string(b.Body.Graffiti),
 	}).Info("Submitted new block")
 
+	v.quarantine.clearAnomalies(pubKey)
 	if v.emitAccountMetrics {
 		ValidatorProposeSuccessVec.WithLabelValues(fmtKey).Inc()
 	}
+	v.trackProposedBlock(pubKey, b.Slot, blkResp.BlockRoot)
+	v.recordProposal(pubKey, true)
+}
+
+// graffitiFor returns the graffiti of the key in the graffiti file, or the graffiti flag for keys
+// without one.
+func (v *validator) graffitiFor(pubKey [48]byte) []byte {
+	if v.graffitiFile != nil {
+		if graffiti, ok := v.graffitiFile.Graffiti(pubKey); ok {
+			return graffiti
+		}
+	}
+	return v.graffiti
 }
 
 // ProposeExit performs a voluntary exit on a validator.
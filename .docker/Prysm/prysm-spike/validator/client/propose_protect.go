@@ -8,6 +8,7 @@ import (
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/shared/blockutil"
+	"github.com/prysmaticlabs/prysm/shared/exitcode"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/sirupsen/logrus"
 )
@@ -17,7 +18,7 @@ var failedPreBlockSignExternalErr = "attempted a double proposal, block rejected
 var failedPostBlockSignErr = "made a double proposal, considered slashable by remote slashing protection"
 
 func (v *validator) preBlockSignValidations(ctx context.Context, pubKey [48]byte, block *ethpb.BeaconBlock) error {
-	fmtKey := fmt.Sprintf("%#x", pubKey[:])
+	fmtKey := v.metricsLabel(pubKey[:])
 	_, exists, err := v.db.ProposalHistoryForSlot(ctx, pubKey, block.Slot)
 	if err != nil {
 		if v.emitAccountMetrics {
@@ -34,6 +35,12 @@ func (v *validator) preBlockSignValidations(ctx context.Context, pubKey [48]byte
 		}
 		return errors.New(failedPreBlockSignLocalErr)
 	}
+	if v.policies != nil {
+		if err := v.policies.CheckBlock(ctx, pubKey, block); err != nil {
+			countPolicyDenial(err)
+			return err
+		}
+	}
 
 	if featureconfig.Get().SlasherProtection && v.protector != nil {
 		blockHdr, err := blockutil.BeaconBlockHeaderFromBlock(block)
@@ -44,7 +51,7 @@ func (v *validator) preBlockSignValidations(ctx context.Context, pubKey [48]byte
 			if v.emitAccountMetrics {
 				ValidatorProposeFailVecSlasher.WithLabelValues(fmtKey).Inc()
 			}
-			return errors.New(failedPreBlockSignExternalErr)
+			return exitcode.Wrap(errors.New(failedPreBlockSignExternalErr), exitcode.SlashingDetected)
 		}
 	}
 
@@ -52,7 +59,7 @@ func (v *validator) preBlockSignValidations(ctx context.Context, pubKey [48]byte
 }
 
 func (v *validator) postBlockSignUpdate(ctx context.Context, pubKey [48]byte, block *ethpb.SignedBeaconBlock, domain *ethpb.DomainResponse) error {
-	fmtKey := fmt.Sprintf("%#x", pubKey[:])
+	fmtKey := v.metricsLabel(pubKey[:])
 	if featureconfig.Get().SlasherProtection && v.protector != nil {
 		sbh, err := blockutil.SignedBeaconBlockHeaderFromBlock(block)
 		if err != nil {
@@ -66,7 +73,7 @@ func (v *validator) postBlockSignUpdate(ctx context.Context, pubKey [48]byte, bl
 			if v.emitAccountMetrics {
 				ValidatorProposeFailVecSlasher.WithLabelValues(fmtKey).Inc()
 			}
-			return fmt.Errorf(failedPostBlockSignErr)
+			return exitcode.Wrap(errors.New(failedPostBlockSignErr), exitcode.SlashingDetected)
 		}
 	}
 	signingRoot, err := helpers.ComputeSigningRoot(block.Block, domain.SignatureDomain)
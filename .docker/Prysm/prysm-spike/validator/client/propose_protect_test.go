@@ -6,8 +6,10 @@ import (
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/slashing-protection/policy"
 	mockSlasher "github.com/prysmaticlabs/prysm/validator/testing"
 )
 
@@ -100,3 +102,24 @@ func TestPostBlockSignUpdate(t *testing.T) {
 	err = validator.postBlockSignUpdate(context.Background(), pubKey, emptyBlock, &ethpb.DomainResponse{SignatureDomain: make([]byte, 32)})
 	require.NoError(t, err, "Expected allowed attestation not to throw error")
 }
+
+func TestPreBlockSignValidation_Policies(t *testing.T) {
+	ctx := context.Background()
+	validator, _, validatorKey, finish := setup(t)
+	defer finish()
+	pubKey := [48]byte{}
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+	policies, err := policy.NewSet(&policy.Config{
+		Policies: []*policy.RuleConfig{{Type: policy.MaxProposalsPerEpoch, Max: 1}},
+	}, &policy.Dependencies{Proposals: validator.db})
+	require.NoError(t, err)
+	validator.policies = policies
+
+	require.NoError(t, validator.db.SaveProposalHistoryForSlot(ctx, pubKey, 10, []byte{1}))
+	err = validator.preBlockSignValidations(ctx, pubKey, &ethpb.BeaconBlock{Slot: 12})
+	require.ErrorContains(t, "denied by slashing protection policy max_proposals_per_epoch(1)", err)
+
+	// A block of the next epoch is allowed.
+	err = validator.preBlockSignValidations(ctx, pubKey, &ethpb.BeaconBlock{Slot: params.BeaconConfig().SlotsPerEpoch + 1})
+	require.NoError(t, err)
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"encoding/hex"
 	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -21,6 +24,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 	"github.com/prysmaticlabs/prysm/validator/db/kv"
 	testing2 "github.com/prysmaticlabs/prysm/validator/db/testing"
+	"github.com/prysmaticlabs/prysm/validator/graffiti"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 )
 
@@ -198,11 +202,6 @@ func TestProposeBlock_BlocksDoubleProposal(t *testing.T) {
 		gomock.Any(),
 	).Times(2).Return(testutil.NewBeaconBlock().Block, nil /*err*/)
 
-	m.validatorClient.EXPECT().DomainData(
-		gomock.Any(), // ctx
-		gomock.Any(), //epoch
-	).Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil /*err*/)
-
 	m.validatorClient.EXPECT().ProposeBlock(
 		gomock.Any(), // ctx
 		gomock.AssignableToTypeOf(&ethpb.SignedBeaconBlock{}),
@@ -233,11 +232,6 @@ func TestProposeBlock_BlocksDoubleProposal_After54KEpochs(t *testing.T) {
 		gomock.Any(),
 	).Times(2).Return(testutil.NewBeaconBlock().Block, nil /*err*/)
 
-	m.validatorClient.EXPECT().DomainData(
-		gomock.Any(), // ctx
-		gomock.Any(), //epoch
-	).Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil /*err*/)
-
 	m.validatorClient.EXPECT().ProposeBlock(
 		gomock.Any(), // ctx
 		gomock.AssignableToTypeOf(&ethpb.SignedBeaconBlock{}),
@@ -407,6 +401,35 @@ func TestProposeBlock_BroadcastsBlock_WithGraffiti(t *testing.T) {
 	assert.Equal(t, string(validator.graffiti), string(sentBlock.Block.Body.Graffiti))
 }
 
+func TestProposeBlock_RequestsGraffitiOfKey(t *testing.T) {
+	validator, m, validatorKey, finish := setup(t)
+	defer finish()
+	pubKey := [48]byte{}
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+
+	validator.graffiti = []byte("flag")
+	path := filepath.Join(t.TempDir(), "graffiti.yaml")
+	require.NoError(t, ioutil.WriteFile(path, []byte(fmt.Sprintf("public_keys:\n  %#x: file\n", pubKey)), 0600))
+	graffitiFile, err := graffiti.NewFile(path)
+	require.NoError(t, err)
+	validator.graffitiFile = graffitiFile
+
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), //epoch
+	).Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil /*err*/)
+	m.validatorClient.EXPECT().GetBlock(
+		gomock.Any(), // ctx
+		gomock.Any(),
+	).DoAndReturn(func(_ context.Context, req *ethpb.BlockRequest) (*ethpb.BeaconBlock, error) {
+		assert.Equal(t, "file", string(req.Graffiti))
+		return nil, errors.New("uh oh")
+	})
+
+	validator.ProposeBlock(context.Background(), 1, pubKey)
+	assert.Equal(t, "flag", string(validator.graffitiFor([48]byte{1})), "Keys missing from the file use the flag")
+}
+
 func TestProposeExit_ValidatorIndexFailed(t *testing.T) {
 	_, m, validatorKey, finish := setup(t)
 	defer finish()
@@ -0,0 +1,184 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/exitcode"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/validator/slashing-protection/policy"
+	"github.com/sirupsen/logrus"
+)
+
+// keyQuarantine takes keys off duties once they run into a number of consecutive signing
+// anomalies: messages refused by slashing protection, or signatures rejected by the beacon node.
+// These point at another process signing with the key or at a corrupted slashing protection
+// history, so the key stays quarantined across restarts until an operator releases it. A key the
+// slasher detected a slashable message of is quarantined at once, leaving the other keys on duty.
+type keyQuarantine struct {
+	threshold   uint64 // Consecutive anomalies quarantining a key, 0 quarantines keys on slashings only.
+	webhook     string
+	lock        sync.RWMutex
+	anomalies   map[[48]byte]uint64
+	quarantined map[[48]byte]bool
+}
+
+// keyQuarantineNotification is the JSON payload sent to the key quarantine webhook.
+type keyQuarantineNotification struct {
+	PublicKey string `json:"pubkey"`
+	Slot      uint64 `json:"slot"`
+	Failure   string `json:"failure"`
+	Error     string `json:"error"`
+	Anomalies uint64 `json:"anomalies"`
+}
+
+// newKeyQuarantine returns a quarantine of the keys with the given number of consecutive
+// anomalies, already holding the given quarantined keys. Keys stay quarantined when the threshold
+// is 0.
+func newKeyQuarantine(threshold uint64, webhook string, quarantined map[[48]byte]*kv.Quarantine) *keyQuarantine {
+	q := &keyQuarantine{
+		threshold:   threshold,
+		webhook:     webhook,
+		anomalies:   make(map[[48]byte]uint64),
+		quarantined: make(map[[48]byte]bool, len(quarantined)),
+	}
+	for pubKey := range quarantined {
+		q.quarantined[pubKey] = true
+	}
+	ValidatorQuarantinedKeysGauge.Set(float64(len(q.quarantined)))
+	return q
+}
+
+// isQuarantined returns whether the key is quarantined. A nil quarantine holds no keys.
+func (q *keyQuarantine) isQuarantined(pubKey [48]byte) bool {
+	if q == nil {
+		return false
+	}
+	q.lock.RLock()
+	defer q.lock.RUnlock()
+	return q.quarantined[pubKey]
+}
+
+// addAnomaly counts an anomaly of the key, and returns the number of consecutive anomalies
+// and whether the key got quarantined by it.
+func (q *keyQuarantine) addAnomaly(pubKey [48]byte) (uint64, bool) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	if q.threshold == 0 || q.quarantined[pubKey] {
+		return 0, false
+	}
+	q.anomalies[pubKey]++
+	anomalies := q.anomalies[pubKey]
+	if anomalies < q.threshold {
+		return anomalies, false
+	}
+	delete(q.anomalies, pubKey)
+	q.quarantined[pubKey] = true
+	ValidatorQuarantinedKeysGauge.Set(float64(len(q.quarantined)))
+	return anomalies, true
+}
+
+// quarantineKey quarantines the key regardless of the threshold, and returns the anomalies it had
+// and whether it was not quarantined yet.
+func (q *keyQuarantine) quarantineKey(pubKey [48]byte) (uint64, bool) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	if q.quarantined[pubKey] {
+		return 0, false
+	}
+	anomalies := q.anomalies[pubKey] + 1
+	delete(q.anomalies, pubKey)
+	q.quarantined[pubKey] = true
+	ValidatorQuarantinedKeysGauge.Set(float64(len(q.quarantined)))
+	return anomalies, true
+}
+
+// clearAnomalies resets the anomalies of a key which had a message accepted.
+func (q *keyQuarantine) clearAnomalies(pubKey [48]byte) {
+	if q == nil {
+		return
+	}
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	delete(q.anomalies, pubKey)
+}
+
+// isSigningAnomaly returns whether a failed duty is a signing anomaly. Denials of slashing
+// protection policies are expected, and are not anomalies, and of the messages rejected by the
+// beacon node only the ones rejected for their signature are.
+func isSigningAnomaly(failure kv.DutyFailure, err error) bool {
+	switch failure {
+	case kv.SlashingProtection:
+		var denied *policy.DeniedError
+		return !errors.As(err, &denied)
+	case kv.SubmissionRejected:
+		return grpcutils.IsInvalidSignatureError(err)
+	default:
+		return false
+	}
+}
+
+// recordSigningAnomaly counts the failed duty against the key if it is a signing anomaly, and
+// quarantines the key once it reaches the threshold, or at once when the slasher detected a
+// slashable message of the key: the quarantine is saved to the validator database and reported in
+// the logs, the metrics and the webhook.
+func (v *validator) recordSigningAnomaly(ctx context.Context, slot uint64, pubKey [48]byte, failure kv.DutyFailure, err error) {
+	if v.quarantine == nil || !isSigningAnomaly(failure, err) {
+		return
+	}
+	ValidatorSigningAnomaliesVec.WithLabelValues(string(failure)).Inc()
+	slashing := exitcode.FromError(err) == exitcode.SlashingDetected
+	var anomalies uint64
+	var quarantined bool
+	if slashing {
+		anomalies, quarantined = v.quarantine.quarantineKey(pubKey)
+	} else {
+		anomalies, quarantined = v.quarantine.addAnomaly(pubKey)
+	}
+	if !quarantined {
+		return
+	}
+	quarantine := &kv.Quarantine{
+		Failure:   failure,
+		Anomalies: anomalies,
+		Timestamp: timeutils.Now().Unix(),
+	}
+	if err != nil {
+		quarantine.Error = err.Error()
+	}
+	if v.db != nil {
+		if err := v.db.SaveQuarantinedPublicKey(ctx, pubKey, quarantine); err != nil {
+			log.WithError(err).Error("Could not save key quarantine, the key is only quarantined until a restart")
+		}
+	}
+	reason := "Quarantined key after repeated signing anomalies"
+	if slashing {
+		reason = "Quarantined key after the slasher detected a slashable message, another process may be signing with it"
+	}
+	log.WithFields(logrus.Fields{
+		"pubKey":    fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:])),
+		"slot":      slot,
+		"failure":   failure,
+		"anomalies": anomalies,
+	}).WithError(err).Error(reason + ", it performs no duties until released " +
+		"with `validator accounts quarantine --release-public-keys`")
+
+	if v.quarantine.webhook == "" {
+		return
+	}
+	notification := &keyQuarantineNotification{
+		PublicKey: fmt.Sprintf("%#x", pubKey[:]),
+		Slot:      slot,
+		Failure:   string(failure),
+		Error:     quarantine.Error,
+		Anomalies: anomalies,
+	}
+	if err := postWebhook(ctx, v.quarantine.webhook, notification); err != nil {
+		log.WithError(err).Error("Could not notify key quarantine webhook")
+	}
+}
@@ -0,0 +1,142 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/exitcode"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	dbTest "github.com/prysmaticlabs/prysm/validator/db/testing"
+	"github.com/prysmaticlabs/prysm/validator/slashing-protection/policy"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestIsSigningAnomaly(t *testing.T) {
+	tests := []struct {
+		name    string
+		failure kv.DutyFailure
+		err     error
+		want    bool
+	}{
+		{"slashable", kv.SlashingProtection, errors.New("attempted a double vote"), true},
+		{"policy denial", kv.SlashingProtection, fmt.Errorf("wrapped: %w", &policy.DeniedError{Policy: "finality"}), false},
+		{"bad signature", kv.SubmissionRejected, grpcutils.InvalidSignatureError("Incorrect attestation signature"), true},
+		{"wrapped bad signature", kv.SubmissionRejected, fmt.Errorf("wrapped: %w", grpcutils.InvalidSignatureError("Incorrect attestation signature")), true},
+		{"signature in message", kv.SubmissionRejected, status.Error(codes.InvalidArgument, "Could not verify signature"), false},
+		{"other rejection", kv.SubmissionRejected, status.Error(codes.Internal, "Could not broadcast block"), false},
+		{"beacon node error", kv.BeaconNodeError, status.Error(codes.Internal, "signature"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isSigningAnomaly(tt.failure, tt.err))
+		})
+	}
+}
+
+func TestKeyQuarantine_ConsecutiveAnomalies(t *testing.T) {
+	pubKey := [48]byte{1}
+	q := newKeyQuarantine(3, "", nil)
+	_, quarantined := q.addAnomaly(pubKey)
+	assert.Equal(t, false, quarantined)
+	_, quarantined = q.addAnomaly(pubKey)
+	assert.Equal(t, false, quarantined)
+	q.clearAnomalies(pubKey)
+	_, quarantined = q.addAnomaly(pubKey)
+	assert.Equal(t, false, quarantined, "Accepted messages reset the anomalies")
+	_, quarantined = q.addAnomaly(pubKey)
+	assert.Equal(t, false, quarantined)
+	anomalies, quarantined := q.addAnomaly(pubKey)
+	assert.Equal(t, true, quarantined)
+	assert.Equal(t, uint64(3), anomalies)
+	assert.Equal(t, true, q.isQuarantined(pubKey))
+	assert.Equal(t, false, q.isQuarantined([48]byte{2}))
+
+	var disabled *keyQuarantine
+	assert.Equal(t, false, disabled.isQuarantined(pubKey))
+	kept := newKeyQuarantine(0, "", map[[48]byte]*kv.Quarantine{pubKey: {}})
+	assert.Equal(t, true, kept.isQuarantined(pubKey), "Saved quarantines are kept when no more keys are quarantined")
+	_, quarantined = kept.addAnomaly([48]byte{2})
+	assert.Equal(t, false, quarantined)
+	_, quarantined = kept.quarantineKey([48]byte{2})
+	assert.Equal(t, true, quarantined, "Keys are quarantined on slashings without a threshold")
+	_, quarantined = kept.quarantineKey([48]byte{2})
+	assert.Equal(t, false, quarantined)
+}
+
+func TestRecordSigningAnomaly_Quarantines(t *testing.T) {
+	hook := logTest.NewGlobal()
+	pubKey := [48]byte{1}
+	valDB := dbTest.SetupDB(t, [][48]byte{pubKey})
+	ctx := context.Background()
+
+	var received *keyQuarantineNotification
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = &keyQuarantineNotification{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(received))
+	}))
+	defer srv.Close()
+
+	v := &validator{
+		db:         valDB,
+		quarantine: newKeyQuarantine(2, srv.URL, nil),
+		duties: &ethpb.DutiesResponse{Duties: []*ethpb.DutiesResponse_Duty{
+			{PublicKey: pubKey[:], ProposerSlots: []uint64{5}},
+		}},
+	}
+	roles, err := v.RolesAt(ctx, 5)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(roles))
+
+	rejected := grpcutils.InvalidSignatureError("Incorrect attestation signature")
+	v.recordMissedDuty(ctx, kv.AttestationDuty, 3, pubKey, kv.SubmissionRejected, rejected)
+	assert.Equal(t, true, received == nil)
+	v.recordMissedDuty(ctx, kv.AttestationDuty, 4, pubKey, kv.SubmissionRejected, rejected)
+	require.LogsContain(t, hook, "Quarantined key after repeated signing anomalies")
+
+	quarantined, err := valDB.QuarantinedPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(quarantined))
+	assert.Equal(t, kv.SubmissionRejected, quarantined[pubKey].Failure)
+	assert.Equal(t, uint64(2), quarantined[pubKey].Anomalies)
+
+	require.NotNil(t, received)
+	assert.Equal(t, fmt.Sprintf("%#x", pubKey), received.PublicKey)
+	assert.Equal(t, uint64(4), received.Slot)
+
+	roles, err = v.RolesAt(ctx, 5)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(roles), "Quarantined keys perform no duties")
+}
+
+func TestRecordSigningAnomaly_QuarantinesSlashingAtOnce(t *testing.T) {
+	hook := logTest.NewGlobal()
+	pubKey, other := [48]byte{1}, [48]byte{2}
+	valDB := dbTest.SetupDB(t, [][48]byte{pubKey, other})
+	ctx := context.Background()
+	v := &validator{
+		db:         valDB,
+		quarantine: newKeyQuarantine(0, "", nil),
+	}
+
+	slashable := exitcode.Wrap(errors.New(failedPostAttSignExternalErr), exitcode.SlashingDetected)
+	v.recordMissedDuty(ctx, kv.AttestationDuty, 3, pubKey, kv.SlashingProtection, slashable)
+	require.LogsContain(t, hook, "Quarantined key after the slasher detected a slashable message")
+	assert.Equal(t, true, v.quarantine.isQuarantined(pubKey))
+	assert.Equal(t, false, v.quarantine.isQuarantined(other), "Only the key of the slashable message is quarantined")
+
+	quarantined, err := valDB.QuarantinedPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(quarantined))
+	assert.Equal(t, kv.SlashingProtection, quarantined[pubKey].Failure)
+}
@@ -0,0 +1,91 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/mathutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"github.com/sirupsen/logrus"
+)
+
+// recordRewardSummaries compares the reward each key was expected to earn in the epoch transition
+// at the end of the epoch of the slot with the change of its balance, and saves the comparison to
+// the validator database. Like the voting summary, the comparison is attributed to the previous
+// epoch, whose attestations the transition rewards.
+func (v *validator) recordRewardSummaries(ctx context.Context, slot uint64, resp *ethpb.ValidatorPerformanceResponse) {
+	epoch := helpers.SlotToEpoch(slot)
+	participation, err := v.beaconClient.GetValidatorParticipation(ctx, &ethpb.GetValidatorParticipationRequest{
+		QueryFilter: &ethpb.GetValidatorParticipationRequest_Epoch{Epoch: epoch},
+	})
+	if err != nil {
+		log.WithError(err).Error("Could not get validator participation to compute expected rewards")
+		return
+	}
+	attesting := make(map[[48]byte]bool)
+	if duties := v.currentDuties(); duties != nil {
+		for _, duty := range duties.CurrentEpochDuties {
+			if duty.Status == ethpb.ValidatorStatus_ACTIVE || duty.Status == ethpb.ValidatorStatus_EXITING {
+				attesting[bytesutil.ToBytes48(duty.PublicKey)] = true
+			}
+		}
+	}
+
+	var totalExpected uint64
+	var totalActual int64
+	for i, pubKey := range resp.PublicKeys {
+		summary := &kv.RewardSummary{
+			Epoch:        epoch - 1,
+			ActualChange: int64(resp.BalancesAfterEpochTransition[i]) - int64(resp.BalancesBeforeEpochTransition[i]),
+		}
+		if attesting[bytesutil.ToBytes48(pubKey)] {
+			summary.ExpectedReward = expectedAttestationReward(resp.CurrentEffectiveBalances[i], participation.Participation)
+		}
+		if err := v.db.SaveRewardSummary(ctx, bytesutil.ToBytes48(pubKey), summary); err != nil {
+			log.WithError(err).Error("Could not save reward summary")
+		}
+		totalExpected += summary.ExpectedReward
+		totalActual += summary.ActualChange
+		if v.emitAccountMetrics {
+			fmtKey := v.metricsLabel(pubKey)
+			ValidatorExpectedRewardGaugeVec.WithLabelValues(fmtKey).Set(float64(summary.ExpectedReward))
+			ValidatorActualRewardGaugeVec.WithLabelValues(fmtKey).Set(float64(summary.ActualChange))
+			ValidatorRewardShortfallGaugeVec.WithLabelValues(fmtKey).Set(float64(summary.Shortfall()))
+		}
+	}
+	if totalExpected == 0 {
+		return
+	}
+	log.WithFields(logrus.Fields{
+		"epoch":             epoch - 1,
+		"expectedGwei":      totalExpected,
+		"actualGwei":        totalActual,
+		"percentOfExpected": fmt.Sprintf("%.2f%%", float64(totalActual)/float64(totalExpected)*100),
+	}).Info("Previous epoch reward summary")
+}
+
+// expectedAttestationReward returns the reward in gwei of a validator with the effective balance
+// in an epoch transition, had it attested timely to the correct source, target and head and been
+// included in the next slot, given the participation of the other validators in the previous
+// epoch. Rewards are replaced by penalties during an inactivity leak, which is not accounted for.
+func expectedAttestationReward(effectiveBalance uint64, p *ethpb.ValidatorParticipation) uint64 {
+	cfg := params.BeaconConfig()
+	if p == nil || p.CurrentEpochActiveGwei == 0 {
+		return 0
+	}
+	baseReward := effectiveBalance * cfg.BaseRewardFactor / mathutil.IntegerSquareRoot(p.CurrentEpochActiveGwei) / cfg.BaseRewardsPerEpoch
+	totalIncrements := p.CurrentEpochActiveGwei / cfg.EffectiveBalanceIncrement
+	var reward uint64
+	for _, attestingBalance := range []uint64{
+		p.PreviousEpochAttestingGwei,
+		p.PreviousEpochTargetAttestingGwei,
+		p.PreviousEpochHeadAttestingGwei,
+	} {
+		reward += baseReward * (attestingBalance / cfg.EffectiveBalanceIncrement) / totalIncrements
+	}
+	return reward + baseReward - baseReward/cfg.ProposerRewardQuotient
+}
@@ -0,0 +1,84 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	dbTest "github.com/prysmaticlabs/prysm/validator/db/testing"
+)
+
+// A total active balance of 1e18 gwei gives a base reward of 512 gwei for a 32 ETH validator.
+const rewardsTestTotalBalance = uint64(1e18)
+
+func TestExpectedAttestationReward(t *testing.T) {
+	full := &ethpb.ValidatorParticipation{
+		CurrentEpochActiveGwei:           rewardsTestTotalBalance,
+		PreviousEpochAttestingGwei:       rewardsTestTotalBalance,
+		PreviousEpochTargetAttestingGwei: rewardsTestTotalBalance,
+		PreviousEpochHeadAttestingGwei:   rewardsTestTotalBalance,
+	}
+	maxBalance := params.BeaconConfig().MaxEffectiveBalance
+	assert.Equal(t, uint64(3*512+512-64), expectedAttestationReward(maxBalance, full))
+
+	halfHead := &ethpb.ValidatorParticipation{
+		CurrentEpochActiveGwei:           rewardsTestTotalBalance,
+		PreviousEpochAttestingGwei:       rewardsTestTotalBalance,
+		PreviousEpochTargetAttestingGwei: rewardsTestTotalBalance,
+		PreviousEpochHeadAttestingGwei:   rewardsTestTotalBalance / 2,
+	}
+	assert.Equal(t, uint64(2*512+256+512-64), expectedAttestationReward(maxBalance, halfHead))
+	assert.Equal(t, uint64(0), expectedAttestationReward(maxBalance, &ethpb.ValidatorParticipation{}))
+}
+
+func TestRecordRewardSummaries(t *testing.T) {
+	active, pending := [48]byte{1}, [48]byte{2}
+	valDB := dbTest.SetupDB(t, [][48]byte{active, pending})
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconChainClient(ctrl)
+	v := &validator{
+		db:           valDB,
+		beaconClient: client,
+		duties: &ethpb.DutiesResponse{
+			CurrentEpochDuties: []*ethpb.DutiesResponse_Duty{
+				{PublicKey: active[:], Status: ethpb.ValidatorStatus_ACTIVE},
+				{PublicKey: pending[:], Status: ethpb.ValidatorStatus_PENDING},
+			},
+		},
+	}
+	epoch := uint64(5)
+	client.EXPECT().GetValidatorParticipation(gomock.Any(), &ethpb.GetValidatorParticipationRequest{
+		QueryFilter: &ethpb.GetValidatorParticipationRequest_Epoch{Epoch: epoch},
+	}).Return(&ethpb.ValidatorParticipationResponse{
+		Participation: &ethpb.ValidatorParticipation{
+			CurrentEpochActiveGwei:           rewardsTestTotalBalance,
+			PreviousEpochAttestingGwei:       rewardsTestTotalBalance,
+			PreviousEpochTargetAttestingGwei: rewardsTestTotalBalance,
+			PreviousEpochHeadAttestingGwei:   rewardsTestTotalBalance,
+		},
+	}, nil)
+
+	maxBalance := params.BeaconConfig().MaxEffectiveBalance
+	v.recordRewardSummaries(context.Background(), (epoch+1)*params.BeaconConfig().SlotsPerEpoch-1, &ethpb.ValidatorPerformanceResponse{
+		PublicKeys:                    [][]byte{active[:], pending[:]},
+		CurrentEffectiveBalances:      []uint64{maxBalance, maxBalance},
+		BalancesBeforeEpochTransition: []uint64{maxBalance, maxBalance},
+		BalancesAfterEpochTransition:  []uint64{maxBalance + 1500, maxBalance},
+	})
+
+	summaries, err := valDB.RewardSummaries(context.Background(), active, 0, 10)
+	require.NoError(t, err)
+	require.DeepEqual(t, []*kv.RewardSummary{{Epoch: epoch - 1, ExpectedReward: 1984, ActualChange: 1500}}, summaries)
+	assert.Equal(t, int64(484), summaries[0].Shortfall())
+
+	summaries, err = valDB.RewardSummaries(context.Background(), pending, 0, 10)
+	require.NoError(t, err)
+	require.DeepEqual(t, []*kv.RewardSummary{{Epoch: epoch - 1}}, summaries)
+}
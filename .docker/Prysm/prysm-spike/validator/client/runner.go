@@ -8,6 +8,7 @@ import (
 
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/exitcode"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"go.opencensus.io/trace"
@@ -18,9 +19,11 @@ import (
 // Validator interface defines the primary methods of a validator client.
 type Validator interface {
 	Done()
+	NegotiateCapabilities(ctx context.Context) error
 	WaitForChainStart(ctx context.Context) error
 	WaitForSync(ctx context.Context) error
 	WaitForActivation(ctx context.Context) error
+	DoppelgangerProtection(ctx context.Context) error
 	SlasherReady(ctx context.Context) error
 	CanonicalHeadSlot(ctx context.Context) (uint64, error)
 	NextSlot() <-chan uint64
@@ -37,6 +40,9 @@ type Validator interface {
 	UpdateDomainDataCaches(ctx context.Context, slot uint64)
 	WaitForWalletInitialization(ctx context.Context) error
 	AllValidatorsAreExited(ctx context.Context) (bool, error)
+	CheckProposedBlocks(ctx context.Context, slot uint64)
+	WatchHeadEvents(ctx context.Context)
+	KeyPerformances() (uint64, []*KeyPerformance)
 }
 
 // Run the main validator routine. This routine exits if the context is
@@ -45,10 +51,11 @@ type Validator interface {
 // Order of operations:
 // 1 - Initialize validator data
 // 2 - Wait for validator activation
-// 3 - Wait for the next slot start
-// 4 - Update assignments
-// 5 - Determine role at current slot
-// 6 - Perform assigned role, if any
+// 3 - Watch the chain for other validators signing with the keys
+// 4 - Wait for the next slot start
+// 5 - Update assignments
+// 6 - Determine role at current slot
+// 7 - Perform assigned role, if any
 func run(ctx context.Context, v Validator) {
 	cleanup := v.Done
 	defer cleanup()
@@ -62,8 +69,11 @@ func run(ctx context.Context, v Validator) {
 			log.Fatalf("Slasher is not ready: %v", err)
 		}
 	}
+	if err := v.NegotiateCapabilities(ctx); err != nil {
+		log.Fatalf("Could not negotiate capabilities with beacon node: %v", err)
+	}
 	if err := v.WaitForChainStart(ctx); err != nil {
-		log.Fatalf("Could not determine if beacon chain started: %v", err)
+		exitcode.Fatal(log, err, "Could not determine if beacon chain started")
 	}
 	if err := v.WaitForSync(ctx); err != nil {
 		log.Fatalf("Could not determine if beacon node synced: %v", err)
@@ -71,6 +81,9 @@ func run(ctx context.Context, v Validator) {
 	if err := v.WaitForActivation(ctx); err != nil {
 		log.Fatalf("Could not wait for validator activation: %v", err)
 	}
+	if err := v.DoppelgangerProtection(ctx); err != nil {
+		exitcode.Fatal(log, err, "Doppelganger protection failed")
+	}
 	headSlot, err := v.CanonicalHeadSlot(ctx)
 	if err != nil {
 		log.Fatalf("Could not get current canonical head slot: %v", err)
@@ -78,6 +91,7 @@ func run(ctx context.Context, v Validator) {
 	if err := v.UpdateDuties(ctx, headSlot); err != nil {
 		handleAssignmentError(err, headSlot)
 	}
+	go v.WatchHeadEvents(ctx)
 
 	for {
 		ctx, span := trace.StartSpan(ctx, "validator.processSlot")
@@ -162,6 +176,7 @@ func run(ctx context.Context, v Validator) {
 				if err := v.LogValidatorGainsAndLosses(slotCtx, slot); err != nil {
 					log.WithError(err).Error("Could not report validator's rewards/penalties")
 				}
+				v.CheckProposedBlocks(slotCtx, slot)
 				span.End()
 			}()
 		}
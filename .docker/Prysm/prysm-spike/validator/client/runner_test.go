@@ -36,6 +36,12 @@ func TestCancelledContext_WaitsForActivation(t *testing.T) {
 	assert.Equal(t, true, v.WaitForActivationCalled, "Expected WaitForActivation() to be called")
 }
 
+func TestCancelledContext_ChecksDoppelgangers(t *testing.T) {
+	v := &FakeValidator{}
+	run(cancelledContext(), v)
+	assert.Equal(t, true, v.DoppelgangerProtectionCalled, "Expected DoppelgangerProtection() to be called")
+}
+
 func TestCancelledContext_ChecksSlasherReady(t *testing.T) {
 	v := &FakeValidator{}
 	cfg := &featureconfig.Flags{
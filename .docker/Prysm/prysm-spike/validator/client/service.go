@@ -2,14 +2,13 @@ package client
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"strings"
 	"time"
 
-	"github.com/dgraph-io/ristretto"
 	ptypes "github.com/gogo/protobuf/types"
 	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
-	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
 	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	lru "github.com/hashicorp/golang-lru"
@@ -20,11 +19,14 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/grpcutils"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/retryutil"
 	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
 	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/graffiti"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
 	slashingprotection "github.com/prysmaticlabs/prysm/validator/slashing-protection"
+	"github.com/prysmaticlabs/prysm/validator/slashing-protection/policy"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/plugin/ocgrpc"
 	"google.golang.org/grpc"
@@ -59,6 +61,8 @@ type ValidatorService struct {
 	emitAccountMetrics    bool
 	logValidatorBalances  bool
 	conn                  *grpc.ClientConn
+	verificationConn      *grpc.ClientConn
+	failover              *beaconNodeFailover
 	grpcRetryDelay        time.Duration
 	grpcRetries           uint
 	maxCallRecvMsgSize    int
@@ -67,13 +71,26 @@ type ValidatorService struct {
 	db                    db.Database
 	dataDir               string
 	withCert              string
+	tlsConfig             *tls.Config
 	endpoint              string
+	beaconAPIEndpoint     string
+	attVerification       *AttestationVerificationConfig
+	submissionSpread      time.Duration
+	orphanedBlockWebhook  string
+	orphanCheckDepth      uint64
+	quarantineThreshold   uint64
+	quarantineWebhook     string
+	doppelgangerEpochs    uint64
+	dutiesBatchSize       uint64
 	validator             Validator
+	accountMetricsLabeler *AccountMetricsLabeler
 	protector             slashingprotection.Protector
+	policies              *policy.Config
 	ctx                   context.Context
 	keyManager            keymanager.IKeymanager
 	grpcHeaders           []string
 	graffiti              []byte
+	graffitiFile          *graffiti.File
 }
 
 // Config for the validator service.
@@ -81,21 +98,43 @@ type Config struct {
 	UseWeb                     bool
 	LogValidatorBalances       bool
 	EmitAccountMetrics         bool
+	AccountMetricsLabeler      *AccountMetricsLabeler // Full public keys are used when nil.
 	WalletInitializedFeed      *event.Feed
 	GrpcRetriesFlag            uint
 	GrpcRetryDelay             time.Duration
 	GrpcMaxCallRecvMsgSizeFlag int
 	Protector                  slashingprotection.Protector
-	Endpoint                   string
+	ProtectionPolicies         *policy.Config
+	Endpoint                   string // Comma separated beacon nodes are failed over between.
+	BeaconAPIEndpoint          string
+	AttestationVerification    *AttestationVerificationConfig // Attestation data is not verified when nil.
+	SubmissionSpread           time.Duration                  // Submissions are not queued when 0.
+	OrphanedBlockWebhook       string
+	OrphanedBlockCheckDepth    uint64
+	KeyQuarantineThreshold     uint64 // Keys are not quarantined when 0.
+	KeyQuarantineWebhook       string
+	DoppelgangerEpochs         uint64 // Duties start without watching the chain when 0.
+	DutiesBatchSize            uint64 // Requests are not split when 0.
 	Validator                  Validator
 	ValDB                      db.Database
 	KeyManager                 keymanager.IKeymanager
 	GraffitiFlag               string
+	GraffitiFile               *graffiti.File // Per key graffiti, the flag is used for the other keys.
 	CertFlag                   string
+	TLSConfig                  *tls.Config // Used when no certificate file is configured.
 	DataDir                    string
 	GrpcHeadersFlag            string
 }
 
+// AttestationVerificationConfig is the secondary beacon node attestation data is checked against
+// before signing.
+type AttestationVerificationConfig struct {
+	Endpoint      string
+	CertFlag      string
+	HeadTolerance uint64 // Number of slots the head blocks of the beacon nodes may differ.
+	PreferPrimary bool   // Signs mismatching attestation data instead of skipping the attestation.
+}
+
 // NewValidatorService creates a new validator service for the service
 // registry.
 func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, error) {
@@ -104,17 +143,30 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		ctx:                   ctx,
 		cancel:                cancel,
 		endpoint:              cfg.Endpoint,
+		beaconAPIEndpoint:     cfg.BeaconAPIEndpoint,
+		attVerification:       cfg.AttestationVerification,
+		submissionSpread:      cfg.SubmissionSpread,
+		orphanedBlockWebhook:  cfg.OrphanedBlockWebhook,
+		orphanCheckDepth:      cfg.OrphanedBlockCheckDepth,
+		quarantineThreshold:   cfg.KeyQuarantineThreshold,
+		quarantineWebhook:     cfg.KeyQuarantineWebhook,
+		doppelgangerEpochs:    cfg.DoppelgangerEpochs,
+		dutiesBatchSize:       cfg.DutiesBatchSize,
 		withCert:              cfg.CertFlag,
+		tlsConfig:             cfg.TLSConfig,
 		dataDir:               cfg.DataDir,
 		graffiti:              []byte(cfg.GraffitiFlag),
+		graffitiFile:          cfg.GraffitiFile,
 		keyManager:            cfg.KeyManager,
 		logValidatorBalances:  cfg.LogValidatorBalances,
 		emitAccountMetrics:    cfg.EmitAccountMetrics,
+		accountMetricsLabeler: cfg.AccountMetricsLabeler,
 		maxCallRecvMsgSize:    cfg.GrpcMaxCallRecvMsgSizeFlag,
 		grpcRetries:           cfg.GrpcRetriesFlag,
 		grpcRetryDelay:        cfg.GrpcRetryDelay,
 		grpcHeaders:           strings.Split(cfg.GrpcHeadersFlag, ","),
 		protector:             cfg.Protector,
+		policies:              cfg.ProtectionPolicies,
 		validator:             cfg.Validator,
 		db:                    cfg.ValDB,
 		walletInitializedFeed: cfg.WalletInitializedFeed,
@@ -128,11 +180,13 @@ func (v *ValidatorService) Start() {
 	streamInterceptor := grpc.WithStreamInterceptor(middleware.ChainStreamClient(
 		grpc_opentracing.StreamClientInterceptor(),
 		grpc_prometheus.StreamClientInterceptor,
-		grpc_retry.StreamClientInterceptor(),
 	))
+	// Time the requests to the beacon node, to compare with the phases the beacon node reports.
+	grpc_prometheus.EnableClientHandlingTimeHistogram()
 	dialOpts := ConstructDialOptions(
 		v.maxCallRecvMsgSize,
 		v.withCert,
+		v.tlsConfig,
 		v.grpcRetries,
 		v.grpcRetryDelay,
 		streamInterceptor,
@@ -152,50 +206,150 @@ func (v *ValidatorService) Start() {
 		}
 	}
 
-	conn, err := grpc.DialContext(v.ctx, v.endpoint, dialOpts...)
+	target := v.endpoint
+	if strings.Contains(v.endpoint, ",") {
+		v.failover = newBeaconNodeFailover(v.endpoint)
+		if err := v.failover.dialProbes(v.ctx, dialOpts); err != nil {
+			log.Errorf("Could not dial beacon nodes: %v", err)
+			return
+		}
+		target = v.failover.target()
+		dialOpts = append(dialOpts, grpc.WithResolvers(v.failover))
+		log.WithField("endpoints", v.failover.endpoints).Info("Failing over between beacon nodes")
+	}
+	conn, err := grpc.DialContext(v.ctx, target, dialOpts...)
 	if err != nil {
 		log.Errorf("Could not dial endpoint: %s, %v", v.endpoint, err)
 		return
 	}
-	if v.withCert != "" {
+	if v.failover != nil {
+		go v.failover.run(v.ctx)
+	}
+	if v.withCert != "" || v.tlsConfig != nil {
 		log.Info("Established secure gRPC connection")
 	}
 
 	v.conn = conn
-	cache, err := ristretto.NewCache(&ristretto.Config{
-		NumCounters: 1920, // number of keys to track.
-		MaxCost:     192,  // maximum cost of cache, 1 item = 1 cost.
-		BufferItems: 64,   // number of keys per Get buffer.
-	})
+	aggregatedSlotCommitteeIDCache, err := lru.New(int(params.BeaconConfig().MaxCommitteesPerSlot))
 	if err != nil {
-		panic(err)
+		log.Errorf("Could not initialize cache: %v", err)
+		return
 	}
 
-	aggregatedSlotCommitteeIDCache, err := lru.New(int(params.BeaconConfig().MaxCommitteesPerSlot))
+	// Selection proofs are keyed by slot and public key, the cache is sized to the validating
+	// keys on every duties update.
+	selectionProofCache, err := lru.New(selectionProofsPerKey)
 	if err != nil {
 		log.Errorf("Could not initialize cache: %v", err)
 		return
 	}
 
-	v.validator = &validator{
+	var beaconAPI beaconAPIAggregator
+	if v.beaconAPIEndpoint != "" {
+		log.WithField("endpoint", v.beaconAPIEndpoint).Info("Using standard beacon node REST API for aggregation duties")
+		beaconAPI = newBeaconAPIClient(v.beaconAPIEndpoint)
+	}
+
+	var attDataVerifier *attestationDataVerifier
+	if v.attVerification != nil {
+		verificationDialOpts := ConstructDialOptions(
+			v.maxCallRecvMsgSize,
+			v.attVerification.CertFlag,
+			nil,
+			v.grpcRetries,
+			v.grpcRetryDelay,
+		)
+		if verificationDialOpts == nil {
+			return
+		}
+		v.verificationConn, err = grpc.DialContext(v.ctx, v.attVerification.Endpoint, verificationDialOpts...)
+		if err != nil {
+			log.Errorf("Could not dial endpoint: %s, %v", v.attVerification.Endpoint, err)
+			return
+		}
+		log.WithField("endpoint", v.attVerification.Endpoint).Info("Verifying attestation data against secondary beacon node")
+		attDataVerifier = &attestationDataVerifier{
+			validatorClient: ethpb.NewBeaconNodeValidatorClient(v.verificationConn),
+			beaconClient:    ethpb.NewBeaconChainClient(v.verificationConn),
+			headTolerance:   v.attVerification.HeadTolerance,
+			preferPrimary:   v.attVerification.PreferPrimary,
+		}
+	}
+
+	var queue *submissionQueue
+	if v.submissionSpread > 0 {
+		log.WithField("spread", v.submissionSpread).Info("Spreading bursts of submissions to the beacon node")
+		queue = newSubmissionQueue(v.submissionSpread)
+		go queue.run(v.ctx)
+	}
+
+	var policies *policy.Set
+	if v.policies != nil {
+		policies, err = policy.NewSet(v.policies, &policy.Dependencies{
+			Finality:  &beaconFinalityFetcher{beaconClient: ethpb.NewBeaconChainClient(v.conn)},
+			Proposals: v.db,
+		})
+		if err != nil {
+			log.Errorf("Could not initialize slashing protection policies: %v", err)
+			return
+		}
+		log.WithField("policies", policies.Names()).Info("Enforcing slashing protection policies")
+	}
+
+	var quarantine *keyQuarantine
+	if v.db != nil {
+		quarantined, err := v.db.QuarantinedPublicKeys(v.ctx)
+		if err != nil {
+			log.Errorf("Could not read quarantined keys: %v", err)
+			return
+		}
+		for pubKey, q := range quarantined {
+			log.WithFields(logrus.Fields{
+				"pubKey":  fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:])),
+				"failure": q.Failure,
+				"since":   time.Unix(q.Timestamp, 0),
+			}).Warn("Key is quarantined and performs no duties")
+		}
+		quarantine = newKeyQuarantine(v.quarantineThreshold, v.quarantineWebhook, quarantined)
+	}
+
+	val := &validator{
 		db:                             v.db,
 		validatorClient:                ethpb.NewBeaconNodeValidatorClient(v.conn),
 		beaconClient:                   ethpb.NewBeaconChainClient(v.conn),
+		healthClient:                   pbrpc.NewHealthClient(v.conn),
 		node:                           ethpb.NewNodeClient(v.conn),
 		keyManager:                     v.keyManager,
 		graffiti:                       v.graffiti,
+		graffitiFile:                   v.graffitiFile,
 		logValidatorBalances:           v.logValidatorBalances,
 		emitAccountMetrics:             v.emitAccountMetrics,
+		accountMetricsLabeler:          v.accountMetricsLabeler,
 		startBalances:                  make(map[[48]byte]uint64),
 		prevBalance:                    make(map[[48]byte]uint64),
 		attLogs:                        make(map[[32]byte]*attSubmitted),
-		domainDataCache:                cache,
 		aggregatedSlotCommitteeIDCache: aggregatedSlotCommitteeIDCache,
+		selectionProofCache:            selectionProofCache,
+		beaconAPI:                      beaconAPI,
+		attDataVerifier:                attDataVerifier,
+		submissionQueue:                queue,
+		proposedBlocks:                 make(map[uint64]*proposedBlock),
+		orphanCheckDepth:               v.orphanCheckDepth,
+		orphanedBlockWebhook:           v.orphanedBlockWebhook,
+		quarantine:                     quarantine,
+		doppelgangerEpochs:             v.doppelgangerEpochs,
+		dutiesBatchSize:                v.dutiesBatchSize,
 		protector:                      v.protector,
+		policies:                       policies,
 		voteStats:                      voteStats{startEpoch: ^uint64(0)},
 		useWeb:                         v.useWeb,
 		walletInitializedFeed:          v.walletInitializedFeed,
 	}
+	v.validator = val
+	go val.watchReconnects(v.ctx, v.conn)
+	if v.graffitiFile != nil {
+		go v.graffitiFile.Watch(v.ctx)
+	}
 	go run(v.ctx, v.validator)
 	go v.recheckKeys(v.ctx)
 }
@@ -204,6 +358,14 @@ func (v *ValidatorService) Start() {
 func (v *ValidatorService) Stop() error {
 	v.cancel()
 	log.Info("Stopping service")
+	if v.verificationConn != nil {
+		if err := v.verificationConn.Close(); err != nil {
+			log.WithError(err).Error("Could not close connection to secondary beacon node")
+		}
+	}
+	if v.failover != nil {
+		v.failover.close()
+	}
 	if v.conn != nil {
 		return v.conn.Close()
 	}
@@ -254,6 +416,7 @@ func (v *ValidatorService) recheckKeys(ctx context.Context) {
 func ConstructDialOptions(
 	maxCallRecvMsgSize int,
 	withCert string,
+	tlsConfig *tls.Config,
 	grpcRetries uint,
 	grpcRetryDelay time.Duration,
 	extraOpts ...grpc.DialOption,
@@ -266,6 +429,8 @@ func ConstructDialOptions(
 			return nil
 		}
 		transportSecurity = grpc.WithTransportCredentials(creds)
+	} else if tlsConfig != nil {
+		transportSecurity = grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig))
 	} else {
 		transportSecurity = grpc.WithInsecure()
 		log.Warn("You are using an insecure gRPC connection. If you are running your beacon node and " +
@@ -277,25 +442,24 @@ func ConstructDialOptions(
 		maxCallRecvMsgSize = 10 * 5 << 20 // Default 50Mb
 	}
 
+	retryPolicy := retryutil.NewPolicy(grpcRetries, grpcRetryDelay)
 	dialOpts := []grpc.DialOption{
 		transportSecurity,
 		grpc.WithDefaultCallOptions(
 			grpc.MaxCallRecvMsgSize(maxCallRecvMsgSize),
-			grpc_retry.WithMax(grpcRetries),
-			grpc_retry.WithBackoff(grpc_retry.BackoffLinear(grpcRetryDelay)),
 		),
 		grpc.WithStatsHandler(&ocgrpc.ClientHandler{}),
 		grpc.WithUnaryInterceptor(middleware.ChainUnaryClient(
 			grpc_opentracing.UnaryClientInterceptor(),
 			grpc_prometheus.UnaryClientInterceptor,
-			grpc_retry.UnaryClientInterceptor(),
+			retryutil.UnaryClientInterceptor(retryPolicy),
 			grpcutils.LogGRPCRequests,
 		)),
 		grpc.WithChainStreamInterceptor(
 			grpcutils.LogGRPCStream,
 			grpc_opentracing.StreamClientInterceptor(),
 			grpc_prometheus.StreamClientInterceptor,
-			grpc_retry.StreamClientInterceptor(),
+			retryutil.StreamClientInterceptor(retryPolicy),
 		),
 		grpc.WithResolvers(&multipleEndpointsGrpcResolverBuilder{}),
 	}
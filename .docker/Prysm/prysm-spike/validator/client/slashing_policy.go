@@ -0,0 +1,48 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+	"github.com/prysmaticlabs/prysm/validator/slashing-protection/policy"
+)
+
+// beaconFinalityFetcher provides slashing protection policies with the finalized epoch of the
+// beacon node. The finalized epoch is requested at most once per slot and shared by the signing
+// requests of every key, as it only changes at epoch transitions.
+type beaconFinalityFetcher struct {
+	beaconClient ethpb.BeaconChainClient
+
+	lock      sync.Mutex
+	finalized uint64
+	fetchedAt time.Time
+}
+
+func (f *beaconFinalityFetcher) FinalizedEpoch(ctx context.Context) (uint64, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	slotDuration := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	if !f.fetchedAt.IsZero() && timeutils.Since(f.fetchedAt) < slotDuration {
+		return f.finalized, nil
+	}
+	head, err := f.beaconClient.GetChainHead(ctx, &ptypes.Empty{})
+	if err != nil {
+		return 0, err
+	}
+	f.finalized = head.FinalizedEpoch
+	f.fetchedAt = timeutils.Now()
+	return f.finalized, nil
+}
+
+func countPolicyDenial(err error) {
+	var denied *policy.DeniedError
+	if errors.As(err, &denied) {
+		ValidatorPolicyDeniedVec.WithLabelValues(denied.Policy).Inc()
+	}
+}
@@ -0,0 +1,35 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestBeaconFinalityFetcher_CachedForSlot(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	beaconClient := mock.NewMockBeaconChainClient(ctrl)
+	f := &beaconFinalityFetcher{beaconClient: beaconClient}
+
+	beaconClient.EXPECT().GetChainHead(gomock.Any(), gomock.Any()).Return(&ethpb.ChainHead{FinalizedEpoch: 3}, nil).Times(1)
+	for i := 0; i < 3; i++ {
+		finalized, err := f.FinalizedEpoch(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, uint64(3), finalized)
+	}
+
+	// The finalized epoch is requested again once a slot went by.
+	f.fetchedAt = f.fetchedAt.Add(-time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+	beaconClient.EXPECT().GetChainHead(gomock.Any(), gomock.Any()).Return(&ethpb.ChainHead{FinalizedEpoch: 4}, nil).Times(1)
+	finalized, err := f.FinalizedEpoch(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(4), finalized)
+}
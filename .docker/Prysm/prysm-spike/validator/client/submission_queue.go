@@ -0,0 +1,193 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+	"go.opencensus.io/trace"
+)
+
+// Kinds of submissions, proposals are released ahead of the others.
+const (
+	proposalSubmission    = "proposal"
+	attestationSubmission = "attestation"
+	aggregateSubmission   = "aggregate"
+)
+
+var (
+	submissionQueuePending = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "validator",
+		Name:      "submission_queue_pending",
+		Help:      "Number of submissions waiting in the submission queue.",
+	})
+	submissionQueueDelay = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "validator",
+			Name:      "submission_queue_delay_seconds",
+			Help:      "Time submissions waited in the submission queue, by kind.",
+			Buckets:   []float64{0.001, 0.01, 0.05, 0.1, 0.25, 0.5, 1, 2, 4},
+		},
+		[]string{
+			"kind",
+		},
+	)
+)
+
+type submission struct {
+	kind     string
+	queued   time.Time
+	deadline time.Time
+	release  chan struct{}
+}
+
+// submissionQueue spreads bursts of submissions to the beacon node, so a client running many keys
+// does not send the attestations or aggregates of a slot all at once. A submission arriving at an
+// idle queue is released right away. The submissions following it within spread form a burst, and
+// are released one after the other, spaced by spread divided by the number of submissions of the
+// burst seen so far. No submission waits longer than spread. Proposals skip the spacing and are
+// released ahead of waiting submissions.
+type submissionQueue struct {
+	spread      time.Duration
+	lock        sync.Mutex
+	proposals   []*submission
+	pending     []*submission
+	lastRelease time.Time
+	burst       int // Submissions released since the queue was idle.
+	wake        chan struct{}
+}
+
+// newSubmissionQueue creates a submission queue spreading bursts over the duration. Run releases
+// the submissions.
+func newSubmissionQueue(spread time.Duration) *submissionQueue {
+	return &submissionQueue{
+		spread: spread,
+		wake:   make(chan struct{}, 1),
+	}
+}
+
+// wait blocks until the queue releases the submission of the kind, or the context is done and the
+// submission fails with the context error. A nil queue releases submissions right away.
+func (q *submissionQueue) wait(ctx context.Context, kind string) {
+	if q == nil {
+		return
+	}
+	ctx, span := trace.StartSpan(ctx, "validator.waitForSubmission")
+	defer span.End()
+	span.AddAttributes(trace.StringAttribute("kind", kind))
+
+	now := timeutils.Now()
+	s := &submission{
+		kind:     kind,
+		queued:   now,
+		deadline: now.Add(q.spread),
+		release:  make(chan struct{}),
+	}
+	q.lock.Lock()
+	if kind == proposalSubmission {
+		q.proposals = append(q.proposals, s)
+	} else {
+		q.pending = append(q.pending, s)
+	}
+	submissionQueuePending.Inc()
+	q.lock.Unlock()
+	q.notify()
+
+	select {
+	case <-s.release:
+		submissionQueueDelay.WithLabelValues(kind).Observe(timeutils.Since(s.queued).Seconds())
+	case <-ctx.Done():
+		q.lock.Lock()
+		defer q.lock.Unlock()
+		select {
+		case <-s.release:
+			return
+		default:
+		}
+		q.proposals = removeSubmission(q.proposals, s)
+		q.pending = removeSubmission(q.pending, s)
+		submissionQueuePending.Dec()
+	}
+}
+
+// run releases the queued submissions until the context is done.
+func (q *submissionQueue) run(ctx context.Context) {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		delay, ok := q.releaseNext(timeutils.Now())
+		if ok {
+			continue
+		}
+		if !timer.Stop() {
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+		if delay > 0 {
+			timer.Reset(delay)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-q.wake:
+		case <-timer.C:
+		}
+	}
+}
+
+// releaseNext releases the next submission if it is due, otherwise it returns the time until it is
+// due, or 0 when the queue is empty.
+func (q *submissionQueue) releaseNext(now time.Time) (time.Duration, bool) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	var next *submission
+	switch {
+	case len(q.proposals) > 0:
+		next = q.proposals[0]
+		q.proposals = q.proposals[1:]
+	case len(q.pending) > 0:
+		head := q.pending[0]
+		if now.Sub(q.lastRelease) >= q.spread {
+			q.burst = 0
+		}
+		if q.burst > 0 {
+			due := q.lastRelease.Add(q.spread / time.Duration(q.burst+len(q.pending)))
+			if head.deadline.Before(due) {
+				due = head.deadline
+			}
+			if due.After(now) {
+				return due.Sub(now), false
+			}
+		}
+		next = head
+		q.pending = q.pending[1:]
+		q.burst++
+	default:
+		return 0, false
+	}
+	q.lastRelease = now
+	close(next.release)
+	submissionQueuePending.Dec()
+	return 0, true
+}
+
+func (q *submissionQueue) notify() {
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+}
+
+func removeSubmission(submissions []*submission, s *submission) []*submission {
+	for i, other := range submissions {
+		if other == s {
+			return append(submissions[:i:i], submissions[i+1:]...)
+		}
+	}
+	return submissions
+}
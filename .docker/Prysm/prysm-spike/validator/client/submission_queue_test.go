@@ -0,0 +1,142 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func queueSubmission(q *submissionQueue, kind string, now time.Time) *submission {
+	s := &submission{kind: kind, queued: now, deadline: now.Add(q.spread), release: make(chan struct{})}
+	if kind == proposalSubmission {
+		q.proposals = append(q.proposals, s)
+	} else {
+		q.pending = append(q.pending, s)
+	}
+	return s
+}
+
+func released(s *submission) bool {
+	select {
+	case <-s.release:
+		return true
+	default:
+		return false
+	}
+}
+
+func TestSubmissionQueue_ReleaseNext_SpreadsBurst(t *testing.T) {
+	q := newSubmissionQueue(4 * time.Second)
+	now := time.Unix(1000, 0)
+	first := queueSubmission(q, attestationSubmission, now)
+	second := queueSubmission(q, attestationSubmission, now)
+	queueSubmission(q, attestationSubmission, now)
+	queueSubmission(q, aggregateSubmission, now)
+
+	_, ok := q.releaseNext(now)
+	require.Equal(t, true, ok, "The first submission of a burst should be released right away")
+	assert.Equal(t, true, released(first))
+
+	delay, ok := q.releaseNext(now)
+	require.Equal(t, false, ok)
+	assert.Equal(t, time.Second, delay, "The spread should be divided between the 4 submissions of the burst")
+	assert.Equal(t, false, released(second))
+
+	_, ok = q.releaseNext(now.Add(time.Second))
+	require.Equal(t, true, ok)
+	assert.Equal(t, true, released(second))
+	assert.Equal(t, 2, len(q.pending))
+}
+
+func TestSubmissionQueue_ReleaseNext_ProposalsFirst(t *testing.T) {
+	q := newSubmissionQueue(4 * time.Second)
+	now := time.Unix(1000, 0)
+	queueSubmission(q, attestationSubmission, now)
+	_, ok := q.releaseNext(now)
+	require.Equal(t, true, ok)
+	att := queueSubmission(q, attestationSubmission, now)
+	proposal := queueSubmission(q, proposalSubmission, now)
+
+	_, ok = q.releaseNext(now)
+	require.Equal(t, true, ok)
+	assert.Equal(t, true, released(proposal), "Proposals should skip the spacing")
+	assert.Equal(t, false, released(att))
+}
+
+func TestSubmissionQueue_ReleaseNext_Deadline(t *testing.T) {
+	q := newSubmissionQueue(4 * time.Second)
+	now := time.Unix(1000, 0)
+	queueSubmission(q, attestationSubmission, now)
+	_, ok := q.releaseNext(now)
+	require.Equal(t, true, ok)
+	late := queueSubmission(q, attestationSubmission, now.Add(-3500*time.Millisecond))
+
+	delay, ok := q.releaseNext(now)
+	require.Equal(t, false, ok)
+	assert.Equal(t, 500*time.Millisecond, delay, "Submissions should not wait past their deadline")
+	_, ok = q.releaseNext(now.Add(500 * time.Millisecond))
+	require.Equal(t, true, ok)
+	assert.Equal(t, true, released(late))
+}
+
+func TestSubmissionQueue_ReleaseNext_IdleResetsBurst(t *testing.T) {
+	q := newSubmissionQueue(4 * time.Second)
+	now := time.Unix(1000, 0)
+	queueSubmission(q, attestationSubmission, now)
+	_, ok := q.releaseNext(now)
+	require.Equal(t, true, ok)
+
+	later := now.Add(5 * time.Second)
+	s := queueSubmission(q, attestationSubmission, later)
+	_, ok = q.releaseNext(later)
+	require.Equal(t, true, ok)
+	assert.Equal(t, true, released(s))
+	assert.Equal(t, 1, q.burst)
+
+	delay, ok := q.releaseNext(later)
+	assert.Equal(t, false, ok)
+	assert.Equal(t, time.Duration(0), delay, "An empty queue has nothing due")
+}
+
+func TestSubmissionQueue_Wait(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	spread := 200 * time.Millisecond
+	q := newSubmissionQueue(spread)
+	go q.run(ctx)
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			q.wait(ctx, attestationSubmission)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, true, time.Since(start) < 2*spread, "Submissions should be released within the spread")
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	assert.Equal(t, 0, len(q.pending))
+}
+
+func TestSubmissionQueue_Wait_ContextDone(t *testing.T) {
+	q := newSubmissionQueue(time.Minute)
+	q.lastRelease = time.Now()
+	q.burst = 1
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	q.wait(ctx, aggregateSubmission)
+	assert.Equal(t, 0, len(q.pending), "Abandoned submissions should leave the queue")
+}
+
+func TestSubmissionQueue_Wait_Nil(t *testing.T) {
+	var q *submissionQueue
+	q.wait(context.Background(), proposalSubmission)
+}
@@ -0,0 +1,207 @@
+package client
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+)
+
+// subnetSubscription is a committee subnet subscription requested from the beacon node.
+type subnetSubscription struct {
+	slot         uint64
+	committeeID  uint64
+	isAggregator bool
+}
+
+// subnetSubscriptions remembers the committee subnet subscriptions the beacon node accepted, so
+// duty updates only send the ones it does not know about yet, and keeps the ones which failed so
+// they can be retried before their slot.
+type subnetSubscriptions struct {
+	lock    sync.Mutex
+	sent    map[[64]byte]*subnetSubscription
+	pending map[[64]byte]*subnetSubscription
+}
+
+// unsent filters out the subscriptions the beacon node already accepted. A subscription accepted
+// for an attester is sent again once a validator of the committee turns out to be an aggregator.
+func (s *subnetSubscriptions) unsent(subs []*subnetSubscription) []*subnetSubscription {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	unsent := make([]*subnetSubscription, 0, len(subs))
+	for _, sub := range subs {
+		sent, ok := s.sent[validatorSubscribeKey(sub.slot, sub.committeeID)]
+		if ok && (sent.isAggregator || !sub.isAggregator) {
+			continue
+		}
+		unsent = append(unsent, sub)
+	}
+	return unsent
+}
+
+// markSent records subscriptions accepted by the beacon node.
+func (s *subnetSubscriptions) markSent(subs []*subnetSubscription) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.sent == nil {
+		s.sent = make(map[[64]byte]*subnetSubscription)
+	}
+	for _, sub := range subs {
+		k := validatorSubscribeKey(sub.slot, sub.committeeID)
+		s.sent[k] = sub
+		delete(s.pending, k)
+	}
+}
+
+// markFailed records subscriptions the beacon node could not be notified of.
+func (s *subnetSubscriptions) markFailed(subs []*subnetSubscription) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.pending == nil {
+		s.pending = make(map[[64]byte]*subnetSubscription)
+	}
+	for _, sub := range subs {
+		s.pending[validatorSubscribeKey(sub.slot, sub.committeeID)] = sub
+	}
+}
+
+// takePending returns and clears the failed subscriptions which are still ahead of the slot,
+// dropping the subscriptions of past slots.
+func (s *subnetSubscriptions) takePending(slot uint64) []*subnetSubscription {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for k, sub := range s.sent {
+		if sub.slot < slot {
+			delete(s.sent, k)
+		}
+	}
+	pending := make([]*subnetSubscription, 0, len(s.pending))
+	for k, sub := range s.pending {
+		if sub.slot > slot {
+			pending = append(pending, sub)
+		}
+		delete(s.pending, k)
+	}
+	return pending
+}
+
+// resendAll moves the subscriptions the beacon node accepted to the failed ones, so they are sent
+// again at the next slot. Returns the number of subscriptions to resend.
+func (s *subnetSubscriptions) resendAll() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.pending == nil {
+		s.pending = make(map[[64]byte]*subnetSubscription)
+	}
+	for k, sub := range s.sent {
+		s.pending[k] = sub
+		delete(s.sent, k)
+	}
+	return len(s.pending)
+}
+
+// watchReconnects resends the committee subnet subscriptions whenever the connection to the beacon
+// node becomes ready again, as a restarted node or the node failed over to does not know about
+// them, until the context is canceled.
+func (v *validator) watchReconnects(ctx context.Context, conn *grpc.ClientConn) {
+	state := conn.GetState()
+	for conn.WaitForStateChange(ctx, state) {
+		state = conn.GetState()
+		if state != connectivity.Ready {
+			continue
+		}
+		if n := v.subnetSubscriptions.resendAll(); n > 0 {
+			log.WithField("subscriptions", n).Info("Connected to beacon node, resending committee subnet subscriptions")
+		}
+	}
+}
+
+// committeeSubnetSubscriptions appends the subscriptions needed by the active duties to subs,
+// merging the duties of validators sharing the same slot and committee into one subscription.
+func (v *validator) committeeSubnetSubscriptions(
+	ctx context.Context,
+	duties []*ethpb.DutiesResponse_Duty,
+	subs []*subnetSubscription,
+	seen map[[64]byte]*subnetSubscription,
+) ([]*subnetSubscription, error) {
+	for _, duty := range duties {
+		if duty.Status != ethpb.ValidatorStatus_ACTIVE && duty.Status != ethpb.ValidatorStatus_EXITING {
+			continue
+		}
+		k := validatorSubscribeKey(duty.AttesterSlot, duty.CommitteeIndex)
+		sub, ok := seen[k]
+		if ok && sub.isAggregator {
+			subnetSubscriptionsSkippedCounter.Inc()
+			continue
+		}
+		aggregator, err := v.isAggregator(ctx, duty.Committee, duty.AttesterSlot, bytesutil.ToBytes48(duty.PublicKey))
+		if err != nil {
+			return nil, errors.Wrap(err, "could not check if a validator is an aggregator")
+		}
+		if ok {
+			subnetSubscriptionsSkippedCounter.Inc()
+			sub.isAggregator = aggregator
+			continue
+		}
+		sub = &subnetSubscription{
+			slot:         duty.AttesterSlot,
+			committeeID:  duty.CommitteeIndex,
+			isAggregator: aggregator,
+		}
+		seen[k] = sub
+		subs = append(subs, sub)
+	}
+	return subs, nil
+}
+
+// sendSubnetSubscriptions notifies the beacon node of subscriptions in requests of at most the duties
+// batch size, keeping the subscriptions of failed requests to retry them.
+func (v *validator) sendSubnetSubscriptions(ctx context.Context, subs []*subnetSubscription) error {
+	if len(subs) == 0 {
+		return nil
+	}
+	var err error
+	for _, r := range batchRanges(len(subs), v.dutiesBatchSize) {
+		if batchErr := v.sendSubnetSubscriptionsBatch(ctx, subs[r[0]:r[1]]); batchErr != nil {
+			err = batchErr
+		}
+	}
+	return err
+}
+
+// sendSubnetSubscriptionsBatch notifies the beacon node of subscriptions in a single request.
+func (v *validator) sendSubnetSubscriptionsBatch(ctx context.Context, subs []*subnetSubscription) error {
+	req := &ethpb.CommitteeSubnetsSubscribeRequest{
+		Slots:        make([]uint64, len(subs)),
+		CommitteeIds: make([]uint64, len(subs)),
+		IsAggregator: make([]bool, len(subs)),
+	}
+	for i, sub := range subs {
+		req.Slots[i] = sub.slot
+		req.CommitteeIds[i] = sub.committeeID
+		req.IsAggregator[i] = sub.isAggregator
+	}
+	if _, err := v.validatorClient.SubscribeCommitteeSubnets(ctx, req); err != nil {
+		subnetSubscriptionsCounter.WithLabelValues("failure").Add(float64(len(subs)))
+		v.subnetSubscriptions.markFailed(subs)
+		return err
+	}
+	subnetSubscriptionsCounter.WithLabelValues("success").Add(float64(len(subs)))
+	v.subnetSubscriptions.markSent(subs)
+	return nil
+}
+
+// retrySubnetSubscriptions sends subscriptions which failed earlier again.
+func (v *validator) retrySubnetSubscriptions(ctx context.Context, slot uint64, pending []*subnetSubscription) {
+	if err := v.sendSubnetSubscriptions(ctx, pending); err != nil {
+		log.WithError(err).WithFields(logrus.Fields{
+			"slot":          slot,
+			"subscriptions": len(pending),
+		}).Warn("Could not retry committee subnet subscriptions")
+	}
+}
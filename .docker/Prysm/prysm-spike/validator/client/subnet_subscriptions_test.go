@@ -0,0 +1,140 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	lru "github.com/hashicorp/golang-lru"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+)
+
+func TestSubnetSubscriptions_Unsent(t *testing.T) {
+	s := &subnetSubscriptions{}
+	attester := &subnetSubscription{slot: 10, committeeID: 1}
+	s.markSent([]*subnetSubscription{attester})
+
+	unsent := s.unsent([]*subnetSubscription{
+		{slot: 10, committeeID: 1},
+		{slot: 10, committeeID: 2},
+	})
+	require.Equal(t, 1, len(unsent))
+	assert.Equal(t, uint64(2), unsent[0].committeeID)
+
+	aggregator := &subnetSubscription{slot: 10, committeeID: 1, isAggregator: true}
+	unsent = s.unsent([]*subnetSubscription{aggregator})
+	require.Equal(t, 1, len(unsent), "Expected an attester subscription to be sent again for an aggregator")
+	s.markSent(unsent)
+	assert.Equal(t, 0, len(s.unsent([]*subnetSubscription{attester, aggregator})))
+}
+
+func TestSubnetSubscriptions_TakePending(t *testing.T) {
+	s := &subnetSubscriptions{}
+	s.markSent([]*subnetSubscription{{slot: 5, committeeID: 1}})
+	s.markFailed([]*subnetSubscription{
+		{slot: 5, committeeID: 2},
+		{slot: 20, committeeID: 3},
+	})
+
+	pending := s.takePending(10)
+	require.Equal(t, 1, len(pending), "Expected subscriptions of past slots to be dropped")
+	assert.Equal(t, uint64(20), pending[0].slot)
+	assert.Equal(t, 0, len(s.takePending(10)), "Expected pending subscriptions to be cleared")
+	assert.Equal(t, 0, len(s.sent), "Expected sent subscriptions of past slots to be pruned")
+}
+
+func TestSubnetSubscriptions_ResendAll(t *testing.T) {
+	s := &subnetSubscriptions{}
+	s.markSent([]*subnetSubscription{{slot: 20, committeeID: 1}, {slot: 20, committeeID: 2}})
+	s.markFailed([]*subnetSubscription{{slot: 21, committeeID: 1}})
+
+	assert.Equal(t, 3, s.resendAll())
+	assert.Equal(t, 0, len(s.sent))
+	assert.Equal(t, 2, len(s.unsent([]*subnetSubscription{{slot: 20, committeeID: 1}, {slot: 20, committeeID: 2}})))
+	assert.Equal(t, 3, len(s.takePending(10)))
+}
+
+func TestWatchReconnects_ResendsSubscriptions(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := lis.Addr().String()
+	server := grpc.NewServer()
+	go func() {
+		_ = server.Serve(lis)
+	}()
+	conn, err := grpc.DialContext(ctx, addr, grpc.WithInsecure(), grpc.WithBlock())
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, conn.Close())
+	}()
+
+	v := &validator{}
+	go v.watchReconnects(ctx, conn)
+	v.subnetSubscriptions.markSent([]*subnetSubscription{{slot: 20, committeeID: 1}})
+
+	// The beacon node restarts on the same address.
+	server.Stop()
+	lis, err = net.Listen("tcp", addr)
+	require.NoError(t, err)
+	server = grpc.NewServer()
+	defer server.Stop()
+	go func() {
+		_ = server.Serve(lis)
+	}()
+
+	deadline := time.Now().Add(10 * time.Second)
+	for len(v.subnetSubscriptions.unsent([]*subnetSubscription{{slot: 20, committeeID: 1}})) == 0 {
+		require.Equal(t, true, time.Now().Before(deadline), "Subscriptions were not resent after reconnecting")
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Equal(t, 1, len(v.subnetSubscriptions.takePending(10)))
+}
+
+func TestCommitteeSubnetSubscriptions_MergesSharedCommittees(t *testing.T) {
+	c, err := lru.New(8)
+	require.NoError(t, err)
+	pubKey := [48]byte{1}
+	c.Add(selectionProofKey(10, pubKey), []byte{'A'})
+	v := validator{selectionProofCache: c}
+
+	duties := []*ethpb.DutiesResponse_Duty{
+		{AttesterSlot: 10, CommitteeIndex: 1, Committee: []uint64{0, 1}, PublicKey: pubKey[:], Status: ethpb.ValidatorStatus_ACTIVE},
+		{AttesterSlot: 10, CommitteeIndex: 1, Committee: []uint64{0, 1}, PublicKey: []byte{2}, Status: ethpb.ValidatorStatus_ACTIVE},
+		{AttesterSlot: 11, CommitteeIndex: 1, PublicKey: []byte{3}, Status: ethpb.ValidatorStatus_PENDING},
+	}
+	subs, err := v.committeeSubnetSubscriptions(context.Background(), duties, nil, make(map[[64]byte]*subnetSubscription))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(subs))
+	assert.DeepEqual(t, &subnetSubscription{slot: 10, committeeID: 1, isAggregator: true}, subs[0])
+}
+
+func TestSendSubnetSubscriptions_RetriesFailures(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	v := validator{validatorClient: client}
+	subs := []*subnetSubscription{{slot: 20, committeeID: 3, isAggregator: true}}
+	want := &ethpb.CommitteeSubnetsSubscribeRequest{
+		Slots:        []uint64{20},
+		CommitteeIds: []uint64{3},
+		IsAggregator: []bool{true},
+	}
+
+	client.EXPECT().SubscribeCommitteeSubnets(gomock.Any(), want).Return(nil, errors.New("unavailable"))
+	assert.ErrorContains(t, "unavailable", v.sendSubnetSubscriptions(context.Background(), subs))
+	assert.Equal(t, 1, len(v.subnetSubscriptions.unsent(subs)), "Failed subscriptions should not be marked as sent")
+
+	client.EXPECT().SubscribeCommitteeSubnets(gomock.Any(), want).Return(nil, nil)
+	v.retrySubnetSubscriptions(context.Background(), 19, v.subnetSubscriptions.takePending(19))
+	assert.Equal(t, 0, len(v.subnetSubscriptions.unsent(subs)))
+	assert.Equal(t, 0, len(v.subnetSubscriptions.takePending(19)))
+}
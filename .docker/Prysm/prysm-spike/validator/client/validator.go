@@ -9,29 +9,31 @@ import (
 	"encoding/hex"
 	"fmt"
 	"io"
-	"strconv"
-	"strings"
 	"sync"
 	"time"
 
-	"github.com/dgraph-io/ristretto"
 	"github.com/gogo/protobuf/proto"
 	ptypes "github.com/gogo/protobuf/types"
 	lru "github.com/hashicorp/golang-lru"
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/exitcode"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/slotutil"
 	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
 	vdb "github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/validator/graffiti"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	slashingprotection "github.com/prysmaticlabs/prysm/validator/slashing-protection"
+	"github.com/prysmaticlabs/prysm/validator/slashing-protection/policy"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
@@ -58,25 +60,50 @@ type validator struct {
 	attLogsLock                        sync.Mutex
 	aggregatedSlotCommitteeIDCacheLock sync.Mutex
 	prevBalanceLock                    sync.RWMutex
+	proposedBlocksLock                 sync.Mutex
+	dutiesLock                         sync.RWMutex
+	capabilitiesLock                   sync.RWMutex
 	attesterHistoryByPubKeyLock        sync.RWMutex
+	queuedAttestationsLock             sync.Mutex
+	voteStatsLock                      sync.RWMutex
 	walletInitializedFeed              *event.Feed
 	genesisTime                        uint64
-	domainDataCache                    *ristretto.Cache
+	domainDataByEpoch                  map[uint64]map[string]*ethpb.DomainResponse
 	aggregatedSlotCommitteeIDCache     *lru.Cache
+	selectionProofCache                *lru.Cache
 	ticker                             *slotutil.SlotTicker
 	attesterHistoryByPubKey            map[[48]byte]kv.EncHistoryData
 	prevBalance                        map[[48]byte]uint64
 	duties                             *ethpb.DutiesResponse
+	dutiesLookahead                    *dutiesLookahead
+	dutiesDependentRoot                *dutiesDependentRoot
+	queuedAttestations                 map[[48]byte]*queuedAttestation
+	capabilities                       map[string]bool
 	startBalances                      map[[48]byte]uint64
+	proposedBlocks                     map[uint64]*proposedBlock
 	attLogs                            map[[32]byte]*attSubmitted
 	node                               ethpb.NodeClient
 	keyManager                         keymanager.IKeymanager
+	accountMetricsLabeler              *AccountMetricsLabeler
 	beaconClient                       ethpb.BeaconChainClient
+	healthClient                       pbrpc.HealthClient
 	validatorClient                    ethpb.BeaconNodeValidatorClient
+	beaconAPI                          beaconAPIAggregator
+	attDataVerifier                    *attestationDataVerifier
+	submissionQueue                    *submissionQueue
 	protector                          slashingprotection.Protector
+	policies                           *policy.Set
 	db                                 vdb.Database
 	graffiti                           []byte
+	graffitiFile                       *graffiti.File
+	orphanCheckDepth                   uint64
+	orphanedBlockWebhook               string
+	quarantine                         *keyQuarantine
+	doppelgangerEpochs                 uint64
+	dutiesBatchSize                    uint64
+	attestationDataCalls               attestationDataCalls
 	voteStats                          voteStats
+	subnetSubscriptions                subnetSubscriptions
 }
 
 // Done cleans up the validator.
@@ -149,13 +176,17 @@ func (v *validator) WaitForChainStart(ctx context.Context) error {
 					"your validator database. This could indicate that this is a database meant for another network. If " +
 					"you were previously running this validator database on another network, please run --clear-db to " +
 					"clear the database. If not, please file an issue at https://github.com/prysmaticlabs/prysm/issues")
-				return fmt.Errorf(
+				return exitcode.Wrap(fmt.Errorf(
 					"genesis validators root from beacon node (%#x) does not match root saved in validator db (%#x)",
 					chainStartRes.GenesisValidatorsRoot,
 					curGenValRoot,
-				)
+				), exitcode.GenesisMismatch)
 			}
 		}
+		// Remote signers computing signing roots themselves need the genesis validators root.
+		if km, ok := v.keyManager.(keymanager.GenesisValidatorsRootSetter); ok {
+			km.SetGenesisValidatorsRoot(chainStartRes.GenesisValidatorsRoot)
+		}
 	}
 
 	// Once the ChainStart log is received, we update the genesis time of the validator client
@@ -291,7 +322,7 @@ func (v *validator) checkAndLogValidatorStatus(validatorStatuses []*ethpb.Valida
 		}
 		log := log.WithFields(fields)
 		if v.emitAccountMetrics {
-			fmtKey := fmt.Sprintf("%#x", status.PublicKey)
+			fmtKey := v.metricsLabel(status.PublicKey)
 			ValidatorStatusesGaugeVec.WithLabelValues(fmtKey).Set(float64(status.Status.Status))
 		}
 		switch status.Status.Status {
@@ -360,7 +391,17 @@ func (v *validator) SlotDeadline(slot uint64) time.Time {
 // list of upcoming assignments needs to be updated. For example, at the
 // beginning of a new epoch.
 func (v *validator) UpdateDuties(ctx context.Context, slot uint64) error {
-	if slot%params.BeaconConfig().SlotsPerEpoch != 0 && v.duties != nil {
+	if slot%params.BeaconConfig().SlotsPerEpoch != 0 && v.currentDuties() != nil {
+		// Fetch the duties of the next epoch ahead of time, so proposing at its first slot
+		// does not have to wait for them.
+		// Prefetched duties cannot be checked for reorgs without the proposer dependent root.
+		if helpers.IsEpochEnd(slot) && v.hasCapability(grpcutils.CapabilityProposerDependentRoot) {
+			go v.prefetchDuties(ctx, slot)
+		}
+		// Retry the subnet subscriptions which failed while their slots are still ahead.
+		if pending := v.subnetSubscriptions.takePending(slot); len(pending) > 0 {
+			go v.retrySubnetSubscriptions(ctx, slot, pending)
+		}
 		// Do nothing if not epoch start AND assignments already exist.
 		return nil
 	}
@@ -378,89 +419,67 @@ func (v *validator) UpdateDuties(ctx context.Context, slot uint64) error {
 	if err != nil {
 		return err
 	}
+	v.resizeSelectionProofCache(len(validatingKeys))
 	req := &ethpb.DutiesRequest{
 		Epoch:      slot / params.BeaconConfig().SlotsPerEpoch,
 		PublicKeys: bytesutil.FromBytes48Array(validatingKeys),
 	}
 
+	if lookahead := v.takeDutiesLookahead(req.Epoch); lookahead != nil {
+		v.setDuties(req.Epoch, lookahead.duties, lookahead.dependentRoot)
+		v.logDuties(slot, lookahead.duties.Duties)
+		// Confirm the duties were not reorged and subscribe to subnets without holding up this slot.
+		go v.refreshDuties(slot, req, lookahead.dependentRoot)
+		return nil
+	}
+
 	// If duties is nil it means we have had no prior duties and just started up.
-	resp, err := v.validatorClient.GetDuties(ctx, req)
+	resp, dependentRoot, err := v.fetchDuties(ctx, req)
 	if err != nil {
-		v.duties = nil // Clear assignments so we know to retry the request.
+		v.setDuties(req.Epoch, nil, "") // Clear assignments so we know to retry the request.
 		log.Error(err)
 		return err
 	}
 
-	v.duties = resp
-	v.logDuties(slot, v.duties.Duties)
-	subscribeSlots := make([]uint64, 0, len(validatingKeys))
-	subscribeCommitteeIDs := make([]uint64, 0, len(validatingKeys))
-	subscribeIsAggregator := make([]bool, 0, len(validatingKeys))
-	alreadySubscribed := make(map[[64]byte]bool)
-
-	for _, duty := range v.duties.Duties {
-		pk := bytesutil.ToBytes48(duty.PublicKey)
-		if duty.Status == ethpb.ValidatorStatus_ACTIVE || duty.Status == ethpb.ValidatorStatus_EXITING {
-			attesterSlot := duty.AttesterSlot
-			committeeIndex := duty.CommitteeIndex
-
-			alreadySubscribedKey := validatorSubscribeKey(attesterSlot, committeeIndex)
-			if _, ok := alreadySubscribed[alreadySubscribedKey]; ok {
-				continue
-			}
-
-			aggregator, err := v.isAggregator(ctx, duty.Committee, attesterSlot, pk)
-			if err != nil {
-				return errors.Wrap(err, "could not check if a validator is an aggregator")
-			}
-			if aggregator {
-				alreadySubscribed[alreadySubscribedKey] = true
-			}
-
-			subscribeSlots = append(subscribeSlots, attesterSlot)
-			subscribeCommitteeIDs = append(subscribeCommitteeIDs, committeeIndex)
-			subscribeIsAggregator = append(subscribeIsAggregator, aggregator)
-		}
-	}
+	v.setDuties(req.Epoch, resp, dependentRoot)
+	v.logDuties(slot, resp.Duties)
+	return v.subscribeToCommitteeSubnets(ctx, req, resp)
+}
 
-	// Notify beacon node to subscribe to the attester and aggregator subnets for the next epoch.
-	req.Epoch++
-	dutiesNextEpoch, err := v.validatorClient.GetDuties(ctx, req)
+// subscribeToCommitteeSubnets notifies the beacon node to subscribe to the attester and aggregator
+// subnets of the duties of the requested epoch and of the epoch after. The duties of the epoch after
+// come along with the response, and are only requested from beacon nodes which do not return them.
+// Subscriptions shared by several validators are sent once, and subscriptions the beacon node
+// already accepted are skipped.
+func (v *validator) subscribeToCommitteeSubnets(ctx context.Context, req *ethpb.DutiesRequest, res *ethpb.DutiesResponse) error {
+	seen := make(map[[64]byte]*subnetSubscription)
+	subs, err := v.committeeSubnetSubscriptions(ctx, res.Duties, nil, seen)
 	if err != nil {
-		log.Error(err)
 		return err
 	}
-	for _, duty := range dutiesNextEpoch.Duties {
-		if duty.Status == ethpb.ValidatorStatus_ACTIVE || duty.Status == ethpb.ValidatorStatus_EXITING {
-			attesterSlot := duty.AttesterSlot
-			committeeIndex := duty.CommitteeIndex
-
-			alreadySubscribedKey := validatorSubscribeKey(attesterSlot, committeeIndex)
-			if _, ok := alreadySubscribed[alreadySubscribedKey]; ok {
-				continue
-			}
 
-			aggregator, err := v.isAggregator(ctx, duty.Committee, attesterSlot, bytesutil.ToBytes48(duty.PublicKey))
-			if err != nil {
-				return errors.Wrap(err, "could not check if a validator is an aggregator")
-			}
-			if aggregator {
-				alreadySubscribed[alreadySubscribedKey] = true
-			}
-
-			subscribeSlots = append(subscribeSlots, attesterSlot)
-			subscribeCommitteeIDs = append(subscribeCommitteeIDs, committeeIndex)
-			subscribeIsAggregator = append(subscribeIsAggregator, aggregator)
+	// Notify beacon node to subscribe to the attester and aggregator subnets for the next epoch.
+	dutiesNextEpoch := res.NextEpochDuties
+	if len(dutiesNextEpoch) == 0 {
+		nextReq := &ethpb.DutiesRequest{
+			Epoch:      req.Epoch + 1,
+			PublicKeys: req.PublicKeys,
 		}
+		resNextEpoch, _, err := v.fetchDuties(ctx, nextReq)
+		if err != nil {
+			log.Error(err)
+			return err
+		}
+		dutiesNextEpoch = resNextEpoch.Duties
+	}
+	subs, err = v.committeeSubnetSubscriptions(ctx, dutiesNextEpoch, subs, seen)
+	if err != nil {
+		return err
 	}
 
-	_, err = v.validatorClient.SubscribeCommitteeSubnets(ctx, &ethpb.CommitteeSubnetsSubscribeRequest{
-		Slots:        subscribeSlots,
-		CommitteeIds: subscribeCommitteeIDs,
-		IsAggregator: subscribeIsAggregator,
-	})
-
-	return err
+	unsent := v.subnetSubscriptions.unsent(subs)
+	subnetSubscriptionsSkippedCounter.Add(float64(len(subs) - len(unsent)))
+	return v.sendSubnetSubscriptions(ctx, unsent)
 }
 
 // RolesAt slot returns the validator roles at the given slot. Returns nil if the
@@ -468,7 +487,7 @@ func (v *validator) UpdateDuties(ctx context.Context, slot uint64) error {
 // validator assignments are unknown. Otherwise returns a valid ValidatorRole map.
 func (v *validator) RolesAt(ctx context.Context, slot uint64) (map[[48]byte][]ValidatorRole, error) {
 	rolesAt := make(map[[48]byte][]ValidatorRole)
-	for _, duty := range v.duties.Duties {
+	for _, duty := range v.currentDuties().Duties {
 		var roles []ValidatorRole
 
 		if duty == nil {
@@ -500,6 +519,9 @@ func (v *validator) RolesAt(ctx context.Context, slot uint64) (map[[48]byte][]Va
 
 		var pubKey [48]byte
 		copy(pubKey[:], duty.PublicKey)
+		if v.quarantine.isQuarantined(pubKey) {
+			continue
+		}
 		rolesAt[pubKey] = roles
 	}
 	return rolesAt, nil
@@ -508,8 +530,9 @@ func (v *validator) RolesAt(ctx context.Context, slot uint64) (map[[48]byte][]Va
 // UpdateProtections goes through the duties of the given slot and fetches the required validator history,
 // assigning it in validator.
 func (v *validator) UpdateProtections(ctx context.Context, slot uint64) error {
-	attestingPubKeys := make([][48]byte, 0, len(v.duties.CurrentEpochDuties))
-	for _, duty := range v.duties.CurrentEpochDuties {
+	duties := v.currentDuties()
+	attestingPubKeys := make([][48]byte, 0, len(duties.CurrentEpochDuties))
+	for _, duty := range duties.CurrentEpochDuties {
 		if duty == nil || duty.AttesterSlot != slot {
 			continue
 		}
@@ -551,7 +574,7 @@ func (v *validator) isAggregator(ctx context.Context, committee []uint64, slot u
 		modulo = uint64(len(committee)) / params.BeaconConfig().TargetAggregatorsPerCommittee
 	}
 
-	slotSig, err := v.signSlot(ctx, pubKey, slot)
+	slotSig, err := v.selectionProof(ctx, pubKey, slot)
 	if err != nil {
 		return false, err
 	}
@@ -612,27 +635,37 @@ func (v *validator) AllValidatorsAreExited(ctx context.Context) (bool, error) {
 	return true, nil
 }
 
+// domainData returns the domain of the epoch, which all keys share. Each domain is requested once
+// an epoch, and domains of epochs before the previous one are dropped as new epochs are cached.
 func (v *validator) domainData(ctx context.Context, epoch uint64, domain []byte) (*ethpb.DomainResponse, error) {
 	v.domainDataLock.Lock()
 	defer v.domainDataLock.Unlock()
 
-	req := &ethpb.DomainRequest{
-		Epoch:  epoch,
-		Domain: domain,
-	}
-
-	key := strings.Join([]string{strconv.FormatUint(req.Epoch, 10), hex.EncodeToString(req.Domain)}, ",")
-
-	if val, ok := v.domainDataCache.Get(key); ok {
-		return proto.Clone(val.(proto.Message)).(*ethpb.DomainResponse), nil
+	key := hex.EncodeToString(domain)
+	if res, ok := v.domainDataByEpoch[epoch][key]; ok {
+		return proto.Clone(res).(*ethpb.DomainResponse), nil
 	}
 
-	res, err := v.validatorClient.DomainData(ctx, req)
+	res, err := v.validatorClient.DomainData(ctx, &ethpb.DomainRequest{
+		Epoch:  epoch,
+		Domain: domain,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	v.domainDataCache.Set(key, proto.Clone(res), 1)
+	if v.domainDataByEpoch == nil {
+		v.domainDataByEpoch = make(map[uint64]map[string]*ethpb.DomainResponse)
+	}
+	if v.domainDataByEpoch[epoch] == nil {
+		v.domainDataByEpoch[epoch] = make(map[string]*ethpb.DomainResponse)
+		for e := range v.domainDataByEpoch {
+			if e+1 < epoch {
+				delete(v.domainDataByEpoch, e)
+			}
+		}
+	}
+	v.domainDataByEpoch[epoch][key] = proto.Clone(res).(*ethpb.DomainResponse)
 
 	return res, nil
 }
@@ -647,7 +680,7 @@ func (v *validator) logDuties(slot uint64, duties []*ethpb.DutiesResponse_Duty)
 
 	for _, duty := range duties {
 		if v.emitAccountMetrics {
-			fmtKey := fmt.Sprintf("%#x", duty.PublicKey)
+			fmtKey := v.metricsLabel(duty.PublicKey)
 			ValidatorStatusesGaugeVec.WithLabelValues(fmtKey).Set(float64(duty.Status))
 		}
 
@@ -703,4 +736,5 @@ type voteStats struct {
 	totalTargets          uint64
 	correctHeads          uint64
 	totalHeads            uint64
+	keys                  map[[48]byte]*KeyPerformance
 }
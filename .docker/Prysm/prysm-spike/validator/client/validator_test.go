@@ -669,6 +669,7 @@ func TestUpdateDuties_ReturnsError(t *testing.T) {
 	client.EXPECT().GetDuties(
 		gomock.Any(),
 		gomock.Any(),
+		gomock.Any(),
 	).Return(nil, expected)
 
 	assert.ErrorContains(t, expected.Error(), v.UpdateDuties(context.Background(), params.BeaconConfig().SlotsPerEpoch))
@@ -709,17 +710,14 @@ func TestUpdateDuties_OK(t *testing.T) {
 	client.EXPECT().GetDuties(
 		gomock.Any(),
 		gomock.Any(),
+		gomock.Any(),
 	).Return(resp, nil)
 
 	client.EXPECT().GetDuties(
 		gomock.Any(),
 		gomock.Any(),
-	).Return(resp, nil)
-
-	client.EXPECT().SubscribeCommitteeSubnets(
-		gomock.Any(),
 		gomock.Any(),
-	).Return(nil, nil)
+	).Return(resp, nil)
 
 	require.NoError(t, v.UpdateDuties(context.Background(), slot), "Could not update assignments")
 	assert.Equal(t, params.BeaconConfig().SlotsPerEpoch+1, v.duties.Duties[0].ProposerSlots[0], "Unexpected validator assignments")
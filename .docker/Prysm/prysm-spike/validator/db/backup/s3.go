@@ -0,0 +1,241 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+)
+
+const (
+	s3Service        = "s3"
+	s3Algorithm      = "AWS4-HMAC-SHA256"
+	s3DateFormat     = "20060102"
+	s3TimeFormat     = "20060102T150405Z"
+	s3RequestTimeout = 5 * time.Minute
+)
+
+// s3Client uploads backups to an S3 compatible endpoint, such as AWS S3 or MinIO, using
+// path style requests signed with AWS signature version 4.
+type s3Client struct {
+	endpoint  *url.URL // Scheme and host of the endpoint.
+	bucket    string
+	prefix    string // Key prefix within the bucket, empty or ending with a slash.
+	region    string
+	accessKey string
+	secretKey string
+	client    *http.Client
+}
+
+// newS3Client parses an URL of the form https://host[:port]/bucket[/prefix], reading the
+// credentials from the standard AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY variables.
+func newS3Client(rawURL, region string) (*s3Client, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse S3 URL")
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("unsupported S3 URL scheme %q", u.Scheme)
+	}
+	parts := strings.SplitN(strings.Trim(u.Path, "/"), "/", 2)
+	if parts[0] == "" {
+		return nil, errors.New("S3 URL must include a bucket")
+	}
+	c := &s3Client{
+		endpoint:  &url.URL{Scheme: u.Scheme, Host: u.Host},
+		bucket:    parts[0],
+		region:    region,
+		accessKey: os.Getenv("AWS_ACCESS_KEY_ID"),
+		secretKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		client:    &http.Client{Timeout: s3RequestTimeout},
+	}
+	if len(parts) == 2 && parts[1] != "" {
+		c.prefix = strings.TrimSuffix(parts[1], "/") + "/"
+	}
+	if c.accessKey == "" || c.secretKey == "" {
+		return nil, errors.New("AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY must be set to upload backups to S3")
+	}
+	return c, nil
+}
+
+// putFile uploads the file at the given path under the key prefix.
+func (c *s3Client) putFile(ctx context.Context, path, name string) error {
+	body, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	resp, err := c.do(ctx, http.MethodPut, c.prefix+name, nil, body)
+	if err != nil {
+		return err
+	}
+	return closeResponse(resp)
+}
+
+type listBucketResult struct {
+	Contents []struct {
+		Key string `xml:"Key"`
+	} `xml:"Contents"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+// list returns the names of the objects under the key prefix starting with namePrefix, in
+// lexicographic order.
+func (c *s3Client) list(ctx context.Context, namePrefix string) ([]string, error) {
+	var names []string
+	token := ""
+	for {
+		query := url.Values{}
+		query.Set("list-type", "2")
+		query.Set("prefix", c.prefix+namePrefix)
+		if token != "" {
+			query.Set("continuation-token", token)
+		}
+		resp, err := c.do(ctx, http.MethodGet, "", query, nil)
+		if err != nil {
+			return nil, err
+		}
+		result := &listBucketResult{}
+		err = xml.NewDecoder(resp.Body).Decode(result)
+		if closeErr := closeResponse(resp); closeErr != nil {
+			return nil, closeErr
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "could not decode S3 object list")
+		}
+		for _, obj := range result.Contents {
+			names = append(names, strings.TrimPrefix(obj.Key, c.prefix))
+		}
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			break
+		}
+		token = result.NextContinuationToken
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// delete removes the object with the given name under the key prefix.
+func (c *s3Client) delete(ctx context.Context, name string) error {
+	resp, err := c.do(ctx, http.MethodDelete, c.prefix+name, nil, nil)
+	if err != nil {
+		return err
+	}
+	return closeResponse(resp)
+}
+
+// do sends a signed request for the key of the bucket, returning an error for any
+// unsuccessful response.
+func (c *s3Client) do(ctx context.Context, method, key string, query url.Values, body []byte) (*http.Response, error) {
+	u := *c.endpoint
+	u.Path = "/" + c.bucket + "/" + key
+	u.RawQuery = canonicalQuery(query)
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.ContentLength = int64(len(body))
+	c.sign(req, body, timeutils.Now().UTC())
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, err := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		if err != nil {
+			log.WithError(err).Debug("Could not read S3 error response")
+		}
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Debug("Could not close S3 response body")
+		}
+		return nil, fmt.Errorf("S3 %s %s returned status %d: %s", method, u.Path, resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	return resp, nil
+}
+
+// sign adds an AWS signature version 4 authorization header to the request.
+func (c *s3Client) sign(req *http.Request, body []byte, now time.Time) {
+	payloadHash := sha256Hex(body)
+	amzDate := now.Format(s3TimeFormat)
+	req.Header.Set("Host", req.URL.Host)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	req.Header.Set("X-Amz-Date", amzDate)
+
+	signedHeaders := "host;x-amz-content-sha256;x-amz-date"
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		"host:" + req.URL.Host + "\n" +
+			"x-amz-content-sha256:" + payloadHash + "\n" +
+			"x-amz-date:" + amzDate + "\n",
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	scope := strings.Join([]string{now.Format(s3DateFormat), c.region, s3Service, "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{s3Algorithm, amzDate, scope, sha256Hex([]byte(canonicalRequest))}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+c.secretKey), now.Format(s3DateFormat))
+	key = hmacSHA256(key, c.region)
+	key = hmacSHA256(key, s3Service)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3Algorithm, c.accessKey, scope, signedHeaders, signature,
+	))
+}
+
+// canonicalQuery encodes the query with sorted keys and spaces escaped as %20, as required
+// by signature version 4.
+func canonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		for _, v := range query[k] {
+			pairs = append(pairs, escapeQuery(k)+"="+escapeQuery(v))
+		}
+	}
+	return strings.Join(pairs, "&")
+}
+
+func escapeQuery(s string) string {
+	return strings.ReplaceAll(url.QueryEscape(s), "+", "%20")
+}
+
+func sha256Hex(b []byte) string {
+	h := sha256.Sum256(b)
+	return hex.EncodeToString(h[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+func closeResponse(resp *http.Response) error {
+	if _, err := io.Copy(ioutil.Discard, resp.Body); err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
@@ -0,0 +1,235 @@
+// Package backup periodically snapshots the validator database, keeping a bounded
+// number of backups locally and optionally on an S3 compatible endpoint.
+package backup
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "db-backup")
+
+// Database is the subset of the validator database used for backups.
+type Database interface {
+	DatabasePath() string
+	Backup(ctx context.Context, outputDir string) (string, error)
+}
+
+// Config for the backup service.
+type Config struct {
+	DB        Database
+	Interval  time.Duration
+	OutputDir string // Defaults to the backups directory of the database path.
+	Retention int    // Number of backups kept, 0 keeps all of them.
+	S3URL     string // Optional https://host[:port]/bucket[/prefix] to upload backups to.
+	S3Region  string
+	Paused    func() bool // Optional, skips backups while it returns true, such as when free disk space is low.
+}
+
+// Service takes a backup of the validator database every interval.
+type Service struct {
+	ctx       context.Context
+	cancel    context.CancelFunc
+	db        Database
+	interval  time.Duration
+	outputDir string
+	retention int
+	paused    func() bool
+	s3        *s3Client
+	lock      sync.RWMutex
+	lastErr   error
+}
+
+// NewService creates a backup service, validating the S3 configuration if one is given.
+func NewService(ctx context.Context, cfg *Config) (*Service, error) {
+	if cfg.Interval <= 0 {
+		return nil, errors.New("backup interval must be greater than 0")
+	}
+	if cfg.Retention < 0 {
+		return nil, errors.New("backup retention cannot be negative")
+	}
+	outputDir := cfg.OutputDir
+	if outputDir == "" {
+		outputDir = filepath.Join(cfg.DB.DatabasePath(), "backups")
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	s := &Service{
+		ctx:       ctx,
+		cancel:    cancel,
+		db:        cfg.DB,
+		interval:  cfg.Interval,
+		outputDir: outputDir,
+		retention: cfg.Retention,
+		paused:    cfg.Paused,
+	}
+	if cfg.S3URL != "" {
+		client, err := newS3Client(cfg.S3URL, cfg.S3Region)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		s.s3 = client
+	}
+	return s, nil
+}
+
+// Start the backup loop.
+func (s *Service) Start() {
+	log.WithFields(logrus.Fields{
+		"interval":  s.interval,
+		"outputDir": s.outputDir,
+		"retention": s.retention,
+		"s3":        s.s3 != nil,
+	}).Info("Scheduling validator database backups")
+	go s.run()
+}
+
+// Stop the backup loop.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status returns the error of the latest backup, if it failed.
+func (s *Service) Status() error {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.lastErr
+}
+
+func (s *Service) run() {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if s.paused != nil && s.paused() {
+				log.Warn("Validator database backups paused")
+				continue
+			}
+			err := s.backup(s.ctx)
+			if err != nil {
+				log.WithError(err).Error("Could not back up validator database")
+			}
+			s.lock.Lock()
+			s.lastErr = err
+			s.lock.Unlock()
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// backup takes a backup, uploads it and prunes the backups exceeding the retention.
+func (s *Service) backup(ctx context.Context) error {
+	backupPath, err := s.db.Backup(ctx, s.outputDir)
+	if err != nil {
+		return err
+	}
+	if err := kv.VerifyBackup(backupPath); err != nil {
+		return err
+	}
+	if s.s3 != nil {
+		if err := s.upload(ctx, backupPath); err != nil {
+			return errors.Wrap(err, "could not upload backup")
+		}
+	}
+	if err := s.pruneLocal(s.retention); err != nil {
+		return errors.Wrap(err, "could not prune backups")
+	}
+	if s.s3 != nil {
+		if err := s.pruneS3(ctx); err != nil {
+			return errors.Wrap(err, "could not prune uploaded backups")
+		}
+	}
+	return nil
+}
+
+// upload puts the backup and then its checksum, so an uploaded checksum always has its backup.
+func (s *Service) upload(ctx context.Context, backupPath string) error {
+	name := filepath.Base(backupPath)
+	if err := s.s3.putFile(ctx, backupPath, name); err != nil {
+		return err
+	}
+	if err := s.s3.putFile(ctx, backupPath+kv.BackupHashExtension, name+kv.BackupHashExtension); err != nil {
+		return err
+	}
+	log.WithField("backup", name).Info("Uploaded validator database backup")
+	return nil
+}
+
+// pruneLocal removes the local backups exceeding the retention.
+func (s *Service) pruneLocal(retention int) error {
+	files, err := ioutil.ReadDir(s.outputDir)
+	if err != nil {
+		return err
+	}
+	names := make([]string, 0, len(files))
+	for _, f := range files {
+		names = append(names, f.Name())
+	}
+	for _, name := range expired(names, retention) {
+		for _, file := range []string{name, name + kv.BackupHashExtension} {
+			if err := os.Remove(filepath.Join(s.outputDir, file)); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+		log.WithField("backup", name).Debug("Removed expired validator database backup")
+	}
+	return nil
+}
+
+// PruneToLatest removes all local backups but the latest one, regardless of the retention. Used to
+// free disk space when it is critically low.
+func (s *Service) PruneToLatest(_ context.Context) error {
+	if err := s.pruneLocal(1); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (s *Service) pruneS3(ctx context.Context) error {
+	names, err := s.s3.list(ctx, kv.BackupFilePrefix)
+	if err != nil {
+		return err
+	}
+	for _, name := range expired(names, s.retention) {
+		if err := s.s3.delete(ctx, name); err != nil {
+			return err
+		}
+		if err := s.s3.delete(ctx, name+kv.BackupHashExtension); err != nil {
+			return err
+		}
+		log.WithField("backup", name).Debug("Removed expired uploaded validator database backup")
+	}
+	return nil
+}
+
+// expired returns the backups among the file names which exceed the retention, oldest first.
+// Backup names embed their timestamp, so sorting them by name sorts them by age.
+func expired(names []string, retention int) []string {
+	if retention == 0 {
+		return nil
+	}
+	backups := make([]string, 0, len(names))
+	for _, name := range names {
+		if strings.HasPrefix(name, kv.BackupFilePrefix) && strings.HasSuffix(name, kv.BackupFileExtension) {
+			backups = append(backups, name)
+		}
+	}
+	if len(backups) <= retention {
+		return nil
+	}
+	sort.Strings(backups)
+	return backups[:len(backups)-retention]
+}
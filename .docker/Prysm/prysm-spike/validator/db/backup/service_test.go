@@ -0,0 +1,207 @@
+package backup
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+)
+
+// fakeS3 is an in-memory path style S3 bucket.
+type fakeS3 struct {
+	lock    sync.Mutex
+	bucket  string
+	objects map[string][]byte
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if !strings.HasPrefix(r.Header.Get("Authorization"), s3Algorithm+" Credential=access/") {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	key := strings.TrimPrefix(r.URL.Path, "/"+f.bucket+"/")
+	switch r.Method {
+	case http.MethodPut:
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		f.objects[key] = body
+	case http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodGet:
+		prefix := r.URL.Query().Get("prefix")
+		var b strings.Builder
+		b.WriteString("<ListBucketResult>")
+		for k := range f.objects {
+			if strings.HasPrefix(k, prefix) {
+				b.WriteString(fmt.Sprintf("<Contents><Key>%s</Key></Contents>", k))
+			}
+		}
+		b.WriteString("<IsTruncated>false</IsTruncated></ListBucketResult>")
+		_, err := w.Write([]byte(b.String()))
+		if err != nil {
+			panic(err)
+		}
+	}
+}
+
+func (f *fakeS3) keys() []string {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	keys := make([]string, 0, len(f.objects))
+	for k := range f.objects {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func setupDB(t *testing.T) *kv.Store {
+	db, err := kv.NewKVStore(t.TempDir(), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+	return db
+}
+
+func backupNames(t *testing.T, dir string) []string {
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	names := make([]string, 0, len(files))
+	for _, f := range files {
+		names = append(names, f.Name())
+	}
+	return names
+}
+
+// writeOldBackups creates backups older than any taken by the test.
+func writeOldBackups(t *testing.T, dir string, names ...string) {
+	require.NoError(t, fileutil.MkdirAll(dir))
+	for _, name := range names {
+		require.NoError(t, fileutil.WriteFile(filepath.Join(dir, name), []byte(name)))
+		require.NoError(t, fileutil.WriteFile(filepath.Join(dir, name+kv.BackupHashExtension), []byte(name)))
+	}
+}
+
+func setS3Credentials(t *testing.T, accessKey, secretKey string) {
+	require.NoError(t, os.Setenv("AWS_ACCESS_KEY_ID", accessKey))
+	require.NoError(t, os.Setenv("AWS_SECRET_ACCESS_KEY", secretKey))
+	t.Cleanup(func() {
+		require.NoError(t, os.Unsetenv("AWS_ACCESS_KEY_ID"))
+		require.NoError(t, os.Unsetenv("AWS_SECRET_ACCESS_KEY"))
+	})
+}
+
+func TestService_BackupPrunesExpired(t *testing.T) {
+	db := setupDB(t)
+	s, err := NewService(context.Background(), &Config{DB: db, Interval: time.Minute, Retention: 2})
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(db.DatabasePath(), "backups"), s.outputDir)
+	writeOldBackups(t, s.outputDir,
+		"prysm_validatordb_20201202T120000Z.backup",
+		"prysm_validatordb_20201202T130000Z.backup",
+	)
+
+	require.NoError(t, s.backup(context.Background()))
+
+	names := backupNames(t, s.outputDir)
+	require.Equal(t, 4, len(names))
+	assert.DeepEqual(t, []string{
+		"prysm_validatordb_20201202T130000Z.backup",
+		"prysm_validatordb_20201202T130000Z.backup.sha256",
+	}, names[:2])
+	require.NoError(t, kv.VerifyBackup(filepath.Join(s.outputDir, names[2])))
+}
+
+func TestService_PruneToLatest(t *testing.T) {
+	db := setupDB(t)
+	s, err := NewService(context.Background(), &Config{DB: db, Interval: time.Minute, Retention: 5})
+	require.NoError(t, err)
+	require.NoError(t, s.PruneToLatest(context.Background()), "Missing backup directory should not fail")
+	writeOldBackups(t, s.outputDir,
+		"prysm_validatordb_20201202T120000Z.backup",
+		"prysm_validatordb_20201202T130000Z.backup",
+		"prysm_validatordb_20201202T140000Z.backup",
+	)
+
+	require.NoError(t, s.PruneToLatest(context.Background()))
+	assert.DeepEqual(t, []string{
+		"prysm_validatordb_20201202T140000Z.backup",
+		"prysm_validatordb_20201202T140000Z.backup.sha256",
+	}, backupNames(t, s.outputDir))
+}
+
+func TestService_BackupUploadsToS3(t *testing.T) {
+	bucket := &fakeS3{bucket: "backups", objects: make(map[string][]byte)}
+	srv := httptest.NewServer(bucket)
+	defer srv.Close()
+	setS3Credentials(t, "access", "secret")
+	bucket.objects["validator-1/prysm_validatordb_20201202T120000Z.backup"] = []byte("old")
+	bucket.objects["validator-1/prysm_validatordb_20201202T120000Z.backup.sha256"] = []byte("old")
+	bucket.objects["validator-2/prysm_validatordb_20201202T120000Z.backup"] = []byte("other validator")
+
+	s, err := NewService(context.Background(), &Config{
+		DB:        setupDB(t),
+		Interval:  time.Minute,
+		Retention: 1,
+		S3URL:     srv.URL + "/backups/validator-1/",
+		S3Region:  "us-east-1",
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, s.backup(context.Background()))
+
+	names := backupNames(t, s.outputDir)
+	require.Equal(t, 2, len(names))
+	assert.DeepEqual(t, []string{
+		"validator-1/" + names[0],
+		"validator-1/" + names[1],
+		"validator-2/prysm_validatordb_20201202T120000Z.backup",
+	}, bucket.keys())
+	local, err := ioutil.ReadFile(filepath.Join(s.outputDir, names[0]))
+	require.NoError(t, err)
+	assert.DeepEqual(t, local, bucket.objects["validator-1/"+names[0]])
+}
+
+func TestNewService_S3RequiresCredentials(t *testing.T) {
+	setS3Credentials(t, "", "")
+	_, err := NewService(context.Background(), &Config{
+		DB:       setupDB(t),
+		Interval: time.Minute,
+		S3URL:    "https://minio:9000/backups",
+	})
+	assert.ErrorContains(t, "AWS_ACCESS_KEY_ID", err)
+}
+
+func TestNewS3Client_ParsesURL(t *testing.T) {
+	setS3Credentials(t, "access", "secret")
+	c, err := newS3Client("https://minio:9000/backups/a/b", "eu-west-1")
+	require.NoError(t, err)
+	assert.Equal(t, "minio:9000", c.endpoint.Host)
+	assert.Equal(t, "backups", c.bucket)
+	assert.Equal(t, "a/b/", c.prefix)
+
+	_, err = newS3Client("https://minio:9000/", "eu-west-1")
+	assert.ErrorContains(t, "must include a bucket", err)
+	_, err = newS3Client("ftp://minio/backups", "eu-west-1")
+	assert.ErrorContains(t, "unsupported S3 URL scheme", err)
+}
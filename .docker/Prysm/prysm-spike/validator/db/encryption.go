@@ -0,0 +1,39 @@
+package db
+
+import (
+	"bytes"
+	"io/ioutil"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/validator/flags"
+	"github.com/urfave/cli/v2"
+)
+
+// EncryptionSecret returns the secret the validator database encryption key is derived from, which
+// is the contents of the keyfile when one is set and the wallet password otherwise. It returns nil
+// if database encryption is not enabled.
+func EncryptionSecret(cliCtx *cli.Context, walletPassword string) ([]byte, error) {
+	if cliCtx.IsSet(flags.DBEncryptionKeyFileFlag.Name) {
+		keyFile, err := fileutil.ExpandPath(cliCtx.String(flags.DBEncryptionKeyFileFlag.Name))
+		if err != nil {
+			return nil, err
+		}
+		secret, err := ioutil.ReadFile(keyFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read database encryption keyfile")
+		}
+		secret = bytes.TrimSpace(secret)
+		if len(secret) == 0 {
+			return nil, errors.New("database encryption keyfile is empty")
+		}
+		return secret, nil
+	}
+	if !cliCtx.Bool(flags.DBEncryptionFlag.Name) {
+		return nil, nil
+	}
+	if walletPassword == "" {
+		return nil, errors.New("database encryption requires a wallet password or --db-encryption-keyfile")
+	}
+	return []byte(walletPassword), nil
+}
@@ -14,6 +14,8 @@ type ValidatorDB interface {
 	DatabasePath() string
 	ClearDB() error
 	UpdatePublicKeysBuckets(publicKeys [][48]byte) error
+	Backup(ctx context.Context, outputDir string) (string, error)
+	PruneHistory(ctx context.Context, retentionEpochs uint64) (*kv.PruneResult, error)
 
 	// Genesis information related methods.
 	GenesisValidatorsRoot(ctx context.Context) ([]byte, error)
@@ -35,4 +37,26 @@ type ValidatorDB interface {
 	SaveAttestationHistoryForPubKeysV2(ctx context.Context, historyByPubKeys map[[48]byte]kv.EncHistoryData) error
 	SaveAttestationHistoryForPubKeyV2(ctx context.Context, pubKey [48]byte, history kv.EncHistoryData) error
 	AttestedPublicKeys(ctx context.Context) ([][48]byte, error)
+
+	// Attestation performance related methods.
+	AttestationPerformance(ctx context.Context, pubKey [48]byte, startEpoch, endEpoch uint64) ([]*kv.AttestationPerformance, error)
+	SaveAttestationPerformance(ctx context.Context, pubKey [48]byte, performance []*kv.AttestationPerformance) error
+
+	// Reward summary related methods.
+	RewardSummaries(ctx context.Context, pubKey [48]byte, startEpoch, endEpoch uint64) ([]*kv.RewardSummary, error)
+	SaveRewardSummary(ctx context.Context, pubKey [48]byte, summary *kv.RewardSummary) error
+
+	// Missed duty journal related methods.
+	SaveMissedDuty(ctx context.Context, duty *kv.MissedDuty) error
+	MissedDuties(ctx context.Context, filter *kv.MissedDutyFilter) ([]*kv.MissedDuty, error)
+
+	// Deleted public key related methods.
+	SaveDeletedPublicKeys(ctx context.Context, pubKeys [][48]byte, archivePath string) error
+	DeletedPublicKeys(ctx context.Context) (map[[48]byte]string, error)
+	ClearDeletedPublicKeys(ctx context.Context, pubKeys [][48]byte) error
+
+	// Quarantined public key related methods.
+	SaveQuarantinedPublicKey(ctx context.Context, pubKey [48]byte, quarantine *kv.Quarantine) error
+	QuarantinedPublicKeys(ctx context.Context) (map[[48]byte]*kv.Quarantine, error)
+	ClearQuarantinedPublicKeys(ctx context.Context, pubKeys [][48]byte) error
 }
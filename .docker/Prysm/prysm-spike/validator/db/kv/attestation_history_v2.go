@@ -40,7 +40,10 @@ func (store *Store) AttestationHistoryForPubKeysV2(ctx context.Context, publicKe
 	err = store.view(func(tx *bolt.Tx) error {
 		bucket := tx.Bucket(newHistoricAttestationsBucket)
 		for _, key := range publicKeys {
-			enc := bucket.Get(key[:])
+			enc, err := store.decrypt(bucket.Get(key[:]))
+			if err != nil {
+				return err
+			}
 			var attestationHistory EncHistoryData
 			if len(enc) == 0 {
 				attestationHistory = NewAttestationHistoryArray(0)
@@ -68,7 +71,11 @@ func (store *Store) SaveAttestationHistoryForPubKeysV2(ctx context.Context, hist
 	err := store.update(func(tx *bolt.Tx) error {
 		bucket := tx.Bucket(newHistoricAttestationsBucket)
 		for pubKey, encodedHistory := range historyByPubKeys {
-			if err := bucket.Put(pubKey[:], encodedHistory); err != nil {
+			enc, err := store.encrypt(encodedHistory)
+			if err != nil {
+				return err
+			}
+			if err := bucket.Put(pubKey[:], enc); err != nil {
 				return err
 			}
 		}
@@ -83,7 +90,11 @@ func (store *Store) SaveAttestationHistoryForPubKeyV2(ctx context.Context, pubKe
 	defer span.End()
 	err := store.update(func(tx *bolt.Tx) error {
 		bucket := tx.Bucket(newHistoricAttestationsBucket)
-		return bucket.Put(pubKey[:], history)
+		enc, err := store.encrypt(history)
+		if err != nil {
+			return err
+		}
+		return bucket.Put(pubKey[:], enc)
 	})
 
 	return err
@@ -99,7 +110,10 @@ func (store *Store) LowestSignedSourceEpoch(ctx context.Context, publicKey [48]b
 	var lowestSignedSourceEpoch uint64
 	err = store.view(func(tx *bolt.Tx) error {
 		bucket := tx.Bucket(lowestSignedSourceBucket)
-		lowestSignedSourceBytes := bucket.Get(publicKey[:])
+		lowestSignedSourceBytes, err := store.decrypt(bucket.Get(publicKey[:]))
+		if err != nil {
+			return err
+		}
 		// 8 because bytesutil.BytesToUint64BigEndian will return 0 if input is less than 8 bytes.
 		if len(lowestSignedSourceBytes) < 8 {
 			return nil
@@ -120,7 +134,10 @@ func (store *Store) LowestSignedTargetEpoch(ctx context.Context, publicKey [48]b
 	var lowestSignedTargetEpoch uint64
 	err = store.view(func(tx *bolt.Tx) error {
 		bucket := tx.Bucket(lowestSignedTargetBucket)
-		lowestSignedTargetBytes := bucket.Get(publicKey[:])
+		lowestSignedTargetBytes, err := store.decrypt(bucket.Get(publicKey[:]))
+		if err != nil {
+			return err
+		}
 		// 8 because bytesutil.BytesToUint64BigEndian will return 0 if input is less than 8 bytes.
 		if len(lowestSignedTargetBytes) < 8 {
 			return nil
@@ -140,13 +157,20 @@ func (store *Store) SaveLowestSignedSourceEpoch(ctx context.Context, publicKey [
 		bucket := tx.Bucket(lowestSignedSourceBucket)
 
 		// If the incoming epoch is lower than the lowest signed epoch, override.
-		lowestSignedSourceBytes := bucket.Get(publicKey[:])
+		lowestSignedSourceBytes, err := store.decrypt(bucket.Get(publicKey[:]))
+		if err != nil {
+			return err
+		}
 		var lowestSignedSourceEpoch uint64
 		if len(lowestSignedSourceBytes) >= 8 {
 			lowestSignedSourceEpoch = bytesutil.BytesToUint64BigEndian(lowestSignedSourceBytes)
 		}
 		if len(lowestSignedSourceBytes) == 0 || epoch < lowestSignedSourceEpoch {
-			if err := bucket.Put(publicKey[:], bytesutil.Uint64ToBytesBigEndian(epoch)); err != nil {
+			enc, err := store.encrypt(bytesutil.Uint64ToBytesBigEndian(epoch))
+			if err != nil {
+				return err
+			}
+			if err := bucket.Put(publicKey[:], enc); err != nil {
 				return err
 			}
 		}
@@ -163,13 +187,20 @@ func (store *Store) SaveLowestSignedTargetEpoch(ctx context.Context, publicKey [
 		bucket := tx.Bucket(lowestSignedTargetBucket)
 
 		// If the incoming epoch is lower than the lowest signed epoch, override.
-		lowestSignedTargetBytes := bucket.Get(publicKey[:])
+		lowestSignedTargetBytes, err := store.decrypt(bucket.Get(publicKey[:]))
+		if err != nil {
+			return err
+		}
 		var lowestSignedTargetEpoch uint64
 		if len(lowestSignedTargetBytes) >= 8 {
 			lowestSignedTargetEpoch = bytesutil.BytesToUint64BigEndian(lowestSignedTargetBytes)
 		}
 		if len(lowestSignedTargetBytes) == 0 || epoch < lowestSignedTargetEpoch {
-			if err := bucket.Put(publicKey[:], bytesutil.Uint64ToBytesBigEndian(epoch)); err != nil {
+			enc, err := store.encrypt(bytesutil.Uint64ToBytesBigEndian(epoch))
+			if err != nil {
+				return err
+			}
+			if err := bucket.Put(publicKey[:], enc); err != nil {
 				return err
 			}
 		}
@@ -0,0 +1,127 @@
+package kv
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+const (
+	performanceIncludedBit = 1 << iota
+	performanceCorrectTargetBit
+	performanceCorrectHeadBit
+)
+
+// Length of an encoded attestation performance: a flags byte, the duty slot and the inclusion slot.
+const attestationPerformanceLength = 1 + 8 + 8
+
+// AttestationPerformance records the outcome of the attestation duty of a validator in an epoch.
+type AttestationPerformance struct {
+	Epoch         uint64
+	Slot          uint64 // Slot of the attestation duty.
+	Included      bool
+	InclusionSlot uint64 // Slot of the first block including the attestation, zero if not included.
+	CorrectTarget bool
+	CorrectHead   bool
+}
+
+// InclusionDistance returns the number of slots between the attestation duty and its inclusion.
+func (p *AttestationPerformance) InclusionDistance() uint64 {
+	if !p.Included {
+		return 0
+	}
+	return p.InclusionSlot - p.Slot
+}
+
+func (p *AttestationPerformance) marshal() []byte {
+	enc := make([]byte, attestationPerformanceLength)
+	if p.Included {
+		enc[0] |= performanceIncludedBit
+	}
+	if p.CorrectTarget {
+		enc[0] |= performanceCorrectTargetBit
+	}
+	if p.CorrectHead {
+		enc[0] |= performanceCorrectHeadBit
+	}
+	binary.LittleEndian.PutUint64(enc[1:9], p.Slot)
+	binary.LittleEndian.PutUint64(enc[9:], p.InclusionSlot)
+	return enc
+}
+
+func unmarshalAttestationPerformance(epoch uint64, enc []byte) (*AttestationPerformance, error) {
+	if len(enc) != attestationPerformanceLength {
+		return nil, fmt.Errorf("wrong attestation performance length, expected %d, received %d", attestationPerformanceLength, len(enc))
+	}
+	return &AttestationPerformance{
+		Epoch:         epoch,
+		Slot:          binary.LittleEndian.Uint64(enc[1:9]),
+		Included:      enc[0]&performanceIncludedBit != 0,
+		InclusionSlot: binary.LittleEndian.Uint64(enc[9:]),
+		CorrectTarget: enc[0]&performanceCorrectTargetBit != 0,
+		CorrectHead:   enc[0]&performanceCorrectHeadBit != 0,
+	}, nil
+}
+
+// SaveAttestationPerformance saves the attestation performance of a validator public key,
+// overwriting any performance already stored for the same epochs.
+func (store *Store) SaveAttestationPerformance(ctx context.Context, pubKey [48]byte, performance []*AttestationPerformance) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.SaveAttestationPerformance")
+	defer span.End()
+
+	return store.update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(attestationPerformanceBucket)
+		valBucket, err := bucket.CreateBucketIfNotExists(pubKey[:])
+		if err != nil {
+			return fmt.Errorf("could not create bucket for public key %#x", pubKey[:])
+		}
+		for _, p := range performance {
+			enc, err := store.encrypt(p.marshal())
+			if err != nil {
+				return err
+			}
+			if err := valBucket.Put(bytesutil.Uint64ToBytesBigEndian(p.Epoch), enc); err != nil {
+				return errors.Wrapf(err, "could not save attestation performance of epoch %d", p.Epoch)
+			}
+		}
+		return nil
+	})
+}
+
+// AttestationPerformance returns the attestation performance of a validator public key stored for
+// the epochs in the inclusive range, in ascending epoch order.
+func (store *Store) AttestationPerformance(ctx context.Context, pubKey [48]byte, startEpoch, endEpoch uint64) ([]*AttestationPerformance, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.AttestationPerformance")
+	defer span.End()
+
+	performance := make([]*AttestationPerformance, 0)
+	err := store.view(func(tx *bolt.Tx) error {
+		valBucket := tx.Bucket(attestationPerformanceBucket).Bucket(pubKey[:])
+		if valBucket == nil {
+			return nil
+		}
+		c := valBucket.Cursor()
+		for k, v := c.Seek(bytesutil.Uint64ToBytesBigEndian(startEpoch)); k != nil; k, v = c.Next() {
+			epoch := bytesutil.BytesToUint64BigEndian(k)
+			if epoch > endEpoch {
+				break
+			}
+			dec, err := store.decrypt(v)
+			if err != nil {
+				return err
+			}
+			p, err := unmarshalAttestationPerformance(epoch, dec)
+			if err != nil {
+				return err
+			}
+			performance = append(performance, p)
+		}
+		return nil
+	})
+	return performance, err
+}
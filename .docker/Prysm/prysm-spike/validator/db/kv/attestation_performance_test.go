@@ -0,0 +1,46 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestSaveAttestationPerformance_OK(t *testing.T) {
+	pubkey := [48]byte{3}
+	db := setupDB(t, [][48]byte{pubkey})
+	ctx := context.Background()
+
+	performance := []*AttestationPerformance{
+		{Epoch: 1, Slot: 40, Included: true, InclusionSlot: 41, CorrectTarget: true, CorrectHead: true},
+		{Epoch: 2, Slot: 70},
+		{Epoch: 3, Slot: 100, Included: true, InclusionSlot: 103, CorrectTarget: true},
+	}
+	require.NoError(t, db.SaveAttestationPerformance(ctx, pubkey, performance))
+
+	received, err := db.AttestationPerformance(ctx, pubkey, 0, 10)
+	require.NoError(t, err)
+	require.DeepEqual(t, performance, received)
+	assert.Equal(t, uint64(3), received[2].InclusionDistance())
+	assert.Equal(t, uint64(0), received[1].InclusionDistance())
+
+	received, err = db.AttestationPerformance(ctx, pubkey, 2, 2)
+	require.NoError(t, err)
+	require.DeepEqual(t, performance[1:2], received)
+
+	// Saving an epoch again overwrites its performance.
+	updated := &AttestationPerformance{Epoch: 2, Slot: 70, Included: true, InclusionSlot: 72}
+	require.NoError(t, db.SaveAttestationPerformance(ctx, pubkey, []*AttestationPerformance{updated}))
+	received, err = db.AttestationPerformance(ctx, pubkey, 2, 3)
+	require.NoError(t, err)
+	require.DeepEqual(t, []*AttestationPerformance{updated, performance[2]}, received)
+}
+
+func TestAttestationPerformance_UnknownPubKey(t *testing.T) {
+	db := setupDB(t, [][48]byte{})
+	received, err := db.AttestationPerformance(context.Background(), [48]byte{1}, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(received))
+}
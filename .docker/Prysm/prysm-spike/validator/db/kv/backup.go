@@ -0,0 +1,125 @@
+package kv
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+	log "github.com/sirupsen/logrus"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+const (
+	backupsDirectoryName = "backups"
+	// BackupFilePrefix is the prefix of the file names of validator database backups.
+	BackupFilePrefix = "prysm_validatordb_"
+	// BackupFileExtension is the extension of validator database backups.
+	BackupFileExtension = ".backup"
+	// BackupHashExtension is the extension of the checksum file written next to every backup.
+	BackupHashExtension = ".sha256"
+	backupTimeFormat    = "20060102T150405Z"
+)
+
+// Backup writes a consistent snapshot of the database to the output directory, or to the
+// backups directory of the datadir if none is given, along with a sha256sum compatible
+// checksum file. It returns the path of the backup.
+// Example for a backup taken on 2 Dec 2020 at 12:00 UTC: $DATADIR/backups/prysm_validatordb_20201202T120000Z.backup
+func (store *Store) Backup(ctx context.Context, outputDir string) (string, error) {
+	ctx, span := trace.StartSpan(ctx, "ValidatorDB.Backup")
+	defer span.End()
+
+	var backupsDir string
+	var err error
+	if outputDir != "" {
+		backupsDir, err = fileutil.ExpandPath(outputDir)
+		if err != nil {
+			return "", err
+		}
+	} else {
+		backupsDir = filepath.Join(store.databasePath, backupsDirectoryName)
+	}
+	if err := fileutil.MkdirAll(backupsDir); err != nil {
+		return "", err
+	}
+	name := BackupFilePrefix + timeutils.Now().UTC().Format(backupTimeFormat) + BackupFileExtension
+	backupPath := filepath.Join(backupsDir, name)
+
+	// Write to a temporary file first so a partially written backup is never mistaken for a complete one.
+	tmpFile, err := ioutil.TempFile(backupsDir, name+".tmp")
+	if err != nil {
+		return "", errors.Wrap(err, "could not create backup file")
+	}
+	defer func() {
+		if err := os.Remove(tmpFile.Name()); err != nil && !os.IsNotExist(err) {
+			log.WithError(err).Error("Could not remove temporary backup file")
+		}
+	}()
+	hasher := sha256.New()
+	if err := store.db.View(func(tx *bolt.Tx) error {
+		_, err := tx.WriteTo(io.MultiWriter(tmpFile, hasher))
+		return err
+	}); err != nil {
+		if closeErr := tmpFile.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Could not close temporary backup file")
+		}
+		return "", errors.Wrap(err, "could not write backup")
+	}
+	if err := tmpFile.Sync(); err != nil {
+		return "", errors.Wrap(err, "could not sync backup")
+	}
+	if err := tmpFile.Close(); err != nil {
+		return "", errors.Wrap(err, "could not close backup")
+	}
+	if err := os.Chmod(tmpFile.Name(), params.BeaconIoConfig().ReadWritePermissions); err != nil {
+		return "", err
+	}
+	if err := os.Rename(tmpFile.Name(), backupPath); err != nil {
+		return "", errors.Wrap(err, "could not move backup into place")
+	}
+	checksum := fmt.Sprintf("%s  %s\n", hex.EncodeToString(hasher.Sum(nil)), name)
+	if err := fileutil.WriteFile(backupPath+BackupHashExtension, []byte(checksum)); err != nil {
+		return "", errors.Wrap(err, "could not write backup checksum")
+	}
+	log.WithField("backup", backupPath).Info("Wrote validator database backup")
+	return backupPath, nil
+}
+
+// VerifyBackup checks the backup at the given path against the checksum file written with it.
+func VerifyBackup(backupPath string) error {
+	checksumFile, err := ioutil.ReadFile(backupPath + BackupHashExtension)
+	if err != nil {
+		return errors.Wrap(err, "could not read backup checksum")
+	}
+	fields := strings.Fields(string(checksumFile))
+	if len(fields) != 2 || fields[1] != filepath.Base(backupPath) {
+		return errors.New("malformed backup checksum file")
+	}
+	f, err := os.Open(backupPath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).Error("Could not close backup file")
+		}
+	}()
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, f); err != nil {
+		return errors.Wrap(err, "could not read backup")
+	}
+	if hex.EncodeToString(hasher.Sum(nil)) != fields[0] {
+		return fmt.Errorf("backup %s does not match its checksum", backupPath)
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package kv
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+func TestStore_Backup(t *testing.T) {
+	pubKey := [48]byte{1}
+	db := setupDB(t, [][48]byte{pubKey})
+	ctx := context.Background()
+	require.NoError(t, db.SaveProposalHistoryForSlot(ctx, pubKey, 10, []byte{1}))
+
+	backupPath, err := db.Backup(ctx, "")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(db.databasePath, backupsDirectoryName), filepath.Dir(backupPath))
+	require.NoError(t, VerifyBackup(backupPath))
+
+	// The backup is a complete database.
+	backupDB, err := bolt.Open(backupPath, 0600, &bolt.Options{ReadOnly: true})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, backupDB.Close())
+	}()
+	require.NoError(t, backupDB.View(func(tx *bolt.Tx) error {
+		assert.NotNil(t, tx.Bucket(newHistoricProposalsBucket).Bucket(pubKey[:]))
+		return nil
+	}))
+
+	files, err := ioutil.ReadDir(filepath.Dir(backupPath))
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(files), "Expected only the backup and its checksum")
+}
+
+func TestVerifyBackup_Corrupted(t *testing.T) {
+	db := setupDB(t, nil)
+	backupPath, err := db.Backup(context.Background(), filepath.Join(t.TempDir(), "backups"))
+	require.NoError(t, err)
+
+	f, err := os.OpenFile(backupPath, os.O_WRONLY|os.O_APPEND, 0600)
+	require.NoError(t, err)
+	_, err = f.Write([]byte("corruption"))
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	assert.ErrorContains(t, "does not match its checksum", VerifyBackup(backupPath))
+}
@@ -2,12 +2,14 @@
 package kv
 
 import (
+	"crypto/cipher"
 	"os"
 	"path/filepath"
 
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	prombolt "github.com/prysmaticlabs/prombbolt"
+	"github.com/prysmaticlabs/prysm/shared/exitcode"
 	"github.com/prysmaticlabs/prysm/shared/fileutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	bolt "go.etcd.io/bbolt"
@@ -21,6 +23,8 @@ var ProtectionDbFileName = "validator.db"
 type Store struct {
 	db           *bolt.DB
 	databasePath string
+	aead         cipher.AEAD
+	encrypted    bool
 }
 
 // Close closes the underlying boltdb database.
@@ -78,13 +82,16 @@ func NewKVStore(dirPath string, pubKeys [][48]byte) (*Store, error) {
 		if errors.Is(err, bolt.ErrTimeout) {
 			return nil, errors.New("cannot obtain database lock, database may be in use by another process")
 		}
+		if errors.Is(err, bolt.ErrInvalid) || errors.Is(err, bolt.ErrChecksum) || errors.Is(err, bolt.ErrVersionMismatch) {
+			return nil, exitcode.Wrap(errors.Wrapf(err, "database file %s is corrupted", datafile), exitcode.DatabaseCorrupted)
+		}
 		return nil, err
 	}
 
 	kv := &Store{db: boltDB, databasePath: dirPath}
 
 	if err := kv.db.Update(func(tx *bolt.Tx) error {
-		return createBuckets(
+		if err := createBuckets(
 			tx,
 			genesisInfoBucket,
 			historicProposalsBucket,
@@ -95,7 +102,17 @@ func NewKVStore(dirPath string, pubKeys [][48]byte) (*Store, error) {
 			lowestSignedTargetBucket,
 			lowestSignedProposalsBucket,
 			highestSignedProposalsBucket,
-		)
+			attestationPerformanceBucket,
+			rewardSummariesBucket,
+			missedDutiesBucket,
+			encryptionBucket,
+			deletedPublicKeysBucket,
+			quarantinedPublicKeysBucket,
+		); err != nil {
+			return err
+		}
+		kv.encrypted = tx.Bucket(encryptionBucket).Get(encryptionSaltKey) != nil
+		return nil
 	}); err != nil {
 		return nil, err
 	}
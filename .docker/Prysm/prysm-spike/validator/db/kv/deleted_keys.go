@@ -0,0 +1,56 @@
+package kv
+
+import (
+	"context"
+
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// SaveDeletedPublicKeys records tombstones for public keys deleted from the wallet, along with the
+// path of the file archiving their slashing protection history.
+func (store *Store) SaveDeletedPublicKeys(ctx context.Context, pubKeys [][48]byte, archivePath string) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.SaveDeletedPublicKeys")
+	defer span.End()
+	return store.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(deletedPublicKeysBucket)
+		for _, pubKey := range pubKeys {
+			if err := bkt.Put(pubKey[:], []byte(archivePath)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// DeletedPublicKeys returns the public keys deleted from the wallet and not imported again, mapped to
+// the path of the file archiving their slashing protection history.
+func (store *Store) DeletedPublicKeys(ctx context.Context) (map[[48]byte]string, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.DeletedPublicKeys")
+	defer span.End()
+	deleted := make(map[[48]byte]string)
+	err := store.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(deletedPublicKeysBucket).ForEach(func(k, v []byte) error {
+			var pubKey [48]byte
+			copy(pubKey[:], k)
+			deleted[pubKey] = string(v)
+			return nil
+		})
+	})
+	return deleted, err
+}
+
+// ClearDeletedPublicKeys removes the tombstones of public keys imported into the wallet again.
+func (store *Store) ClearDeletedPublicKeys(ctx context.Context, pubKeys [][48]byte) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.ClearDeletedPublicKeys")
+	defer span.End()
+	return store.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(deletedPublicKeysBucket)
+		for _, pubKey := range pubKeys {
+			if err := bkt.Delete(pubKey[:]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,33 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_DeletedPublicKeys(t *testing.T) {
+	ctx := context.Background()
+	pubKeys := [][48]byte{{1}, {2}, {3}}
+	db := setupDB(t, pubKeys)
+
+	deleted, err := db.DeletedPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(deleted))
+
+	require.NoError(t, db.SaveDeletedPublicKeys(ctx, pubKeys[:2], "/wallet/archive.json"))
+	deleted, err = db.DeletedPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(deleted))
+	assert.Equal(t, "/wallet/archive.json", deleted[pubKeys[0]])
+	assert.Equal(t, "/wallet/archive.json", deleted[pubKeys[1]])
+
+	require.NoError(t, db.ClearDeletedPublicKeys(ctx, pubKeys[1:]))
+	deleted, err = db.DeletedPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(deleted))
+	_, ok := deleted[pubKeys[0]]
+	assert.Equal(t, true, ok)
+}
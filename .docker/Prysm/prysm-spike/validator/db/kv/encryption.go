@@ -0,0 +1,187 @@
+package kv
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	bolt "go.etcd.io/bbolt"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const (
+	encryptionKeyLength     = 32
+	encryptionSaltLength    = 32
+	encryptionKDFIterations = 262144
+)
+
+var (
+	// ErrDatabaseEncrypted is returned when reading or writing sensitive values of an encrypted
+	// database without its encryption secret.
+	ErrDatabaseEncrypted = errors.New("validator database is encrypted, its encryption secret is required")
+	// ErrWrongEncryptionSecret is returned when enabling encryption with a secret other than the
+	// one the database is encrypted with.
+	ErrWrongEncryptionSecret = errors.New("wrong validator database encryption secret")
+
+	encryptionSaltKey = []byte("salt")
+	// The check value is a known plaintext sealed with the key, verifying the secret on startup.
+	encryptionCheckKey       = []byte("check")
+	encryptionCheckPlaintext = []byte("prysm-validator-db")
+
+	// Buckets whose values are encrypted, including the values of their nested buckets.
+	encryptedBuckets = [][]byte{
+		newHistoricProposalsBucket,
+		newHistoricAttestationsBucket,
+		lowestSignedSourceBucket,
+		lowestSignedTargetBucket,
+		lowestSignedProposalsBucket,
+		highestSignedProposalsBucket,
+		attestationPerformanceBucket,
+		rewardSummariesBucket,
+		missedDutiesBucket,
+	}
+)
+
+// EnableEncryption encrypts the sensitive values of the database, such as signing histories, with
+// AES-GCM using a key derived from the secret. Values of a database which is not encrypted yet are
+// migrated in a single transaction. Changing the secret of an encrypted database is not supported.
+func (store *Store) EnableEncryption(secret []byte) error {
+	if len(secret) == 0 {
+		return errors.New("empty database encryption secret")
+	}
+	return store.update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(encryptionBucket)
+		salt := bkt.Get(encryptionSaltKey)
+		if salt != nil {
+			aead, err := newEncryptionCipher(secret, salt)
+			if err != nil {
+				return err
+			}
+			check, err := openValue(aead, bkt.Get(encryptionCheckKey))
+			if err != nil || !bytes.Equal(check, encryptionCheckPlaintext) {
+				return ErrWrongEncryptionSecret
+			}
+			store.aead = aead
+			return nil
+		}
+
+		salt = make([]byte, encryptionSaltLength)
+		if _, err := rand.Read(salt); err != nil {
+			return err
+		}
+		aead, err := newEncryptionCipher(secret, salt)
+		if err != nil {
+			return err
+		}
+		for _, name := range encryptedBuckets {
+			if err := encryptBucket(aead, tx.Bucket(name)); err != nil {
+				return errors.Wrapf(err, "could not encrypt bucket %s", name)
+			}
+		}
+		check, err := sealValue(aead, encryptionCheckPlaintext)
+		if err != nil {
+			return err
+		}
+		if err := bkt.Put(encryptionSaltKey, salt); err != nil {
+			return err
+		}
+		if err := bkt.Put(encryptionCheckKey, check); err != nil {
+			return err
+		}
+		store.aead = aead
+		store.encrypted = true
+		log.Info("Encrypted existing validator database values")
+		return nil
+	})
+}
+
+// encrypt seals a value to be written to one of the encrypted buckets.
+func (store *Store) encrypt(value []byte) ([]byte, error) {
+	if store.aead == nil {
+		if store.encrypted {
+			return nil, ErrDatabaseEncrypted
+		}
+		return value, nil
+	}
+	return sealValue(store.aead, value)
+}
+
+// decrypt opens a value read from one of the encrypted buckets, nil values are returned as is.
+func (store *Store) decrypt(value []byte) ([]byte, error) {
+	if value == nil {
+		return nil, nil
+	}
+	if store.aead == nil {
+		if store.encrypted {
+			return nil, ErrDatabaseEncrypted
+		}
+		return value, nil
+	}
+	return openValue(store.aead, value)
+}
+
+func newEncryptionCipher(secret, salt []byte) (cipher.AEAD, error) {
+	key := pbkdf2.Key(secret, salt, encryptionKDFIterations, encryptionKeyLength, sha256.New)
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// sealValue encrypts the value with a random nonce, which is prepended to the ciphertext.
+func sealValue(aead cipher.AEAD, value []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(value)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, value, nil), nil
+}
+
+func openValue(aead cipher.AEAD, sealed []byte) ([]byte, error) {
+	if len(sealed) < aead.NonceSize() {
+		return nil, errors.New("encrypted value is too short")
+	}
+	value, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decrypt value")
+	}
+	return value, nil
+}
+
+// encryptBucket encrypts every value of the bucket and of its nested buckets in place.
+func encryptBucket(aead cipher.AEAD, bkt *bolt.Bucket) error {
+	type entry struct{ key, value []byte }
+	var entries []entry
+	var nested [][]byte
+	if err := bkt.ForEach(func(k, v []byte) error {
+		if v == nil {
+			nested = append(nested, append([]byte{}, k...))
+			return nil
+		}
+		sealed, err := sealValue(aead, v)
+		if err != nil {
+			return err
+		}
+		entries = append(entries, entry{key: append([]byte{}, k...), value: sealed})
+		return nil
+	}); err != nil {
+		return err
+	}
+	// Bolt does not allow modifying a bucket while iterating over it.
+	for _, e := range entries {
+		if err := bkt.Put(e.key, e.value); err != nil {
+			return err
+		}
+	}
+	for _, k := range nested {
+		if err := encryptBucket(aead, bkt.Bucket(k)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
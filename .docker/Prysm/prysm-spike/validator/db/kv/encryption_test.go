@@ -0,0 +1,71 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+func TestEnableEncryption_MigratesPlaintextValues(t *testing.T) {
+	pubKey := [48]byte{1}
+	dir := t.TempDir()
+	db, err := NewKVStore(dir, [][48]byte{pubKey})
+	require.NoError(t, err)
+	ctx := context.Background()
+	signingRoot := bytesutil.PadTo([]byte("root"), 32)
+	require.NoError(t, db.SaveProposalHistoryForSlot(ctx, pubKey, 10, signingRoot))
+	require.NoError(t, db.SaveLowestSignedSourceEpoch(ctx, pubKey, 4))
+	history := NewAttestationHistoryArray(2)
+	require.NoError(t, db.SaveAttestationHistoryForPubKeyV2(ctx, pubKey, history))
+
+	require.NoError(t, db.EnableEncryption([]byte("password")))
+
+	// Values are stored encrypted.
+	require.NoError(t, db.view(func(tx *bolt.Tx) error {
+		stored := tx.Bucket(newHistoricProposalsBucket).Bucket(pubKey[:]).Get(bytesutil.Uint64ToBytesBigEndian(10))
+		assert.Equal(t, false, bytes.Contains(stored, signingRoot))
+		stored = tx.Bucket(newHistoricAttestationsBucket).Get(pubKey[:])
+		assert.Equal(t, false, bytes.Equal(stored, history))
+		return nil
+	}))
+	received, exists, err := db.ProposalHistoryForSlot(ctx, pubKey, 10)
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+	assert.DeepEqual(t, signingRoot, received[:])
+	source, err := db.LowestSignedSourceEpoch(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(4), source)
+	require.NoError(t, db.SaveLowestSignedSourceEpoch(ctx, pubKey, 2))
+	source, err = db.LowestSignedSourceEpoch(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), source)
+	histories, err := db.AttestationHistoryForPubKeysV2(ctx, [][48]byte{pubKey})
+	require.NoError(t, err)
+	assert.DeepEqual(t, history, histories[pubKey])
+	require.NoError(t, db.Close())
+
+	// Reopening requires the same secret.
+	db, err = NewKVStore(dir, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+	_, err = db.LowestSignedSourceEpoch(ctx, pubKey)
+	assert.ErrorContains(t, ErrDatabaseEncrypted.Error(), err)
+	assert.ErrorContains(t, ErrDatabaseEncrypted.Error(), db.SaveLowestSignedTargetEpoch(ctx, pubKey, 1))
+	assert.ErrorContains(t, ErrWrongEncryptionSecret.Error(), db.EnableEncryption([]byte("wrong")))
+	require.NoError(t, db.EnableEncryption([]byte("password")))
+	source, err = db.LowestSignedSourceEpoch(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), source)
+}
+
+func TestEnableEncryption_EmptySecret(t *testing.T) {
+	db := setupDB(t, nil)
+	assert.ErrorContains(t, "empty database encryption secret", db.EnableEncryption(nil))
+}
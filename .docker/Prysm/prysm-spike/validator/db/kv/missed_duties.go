@@ -0,0 +1,163 @@
+package kv
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// MaxMissedDuties is the number of entries kept in the missed duty journal. The oldest entries are
+// removed when it is full, keeping months of history for a typical number of keys.
+const MaxMissedDuties = 100000
+
+// DutyType is the kind of validator duty.
+type DutyType string
+
+const (
+	// AttestationDuty is the duty to attest to the head of the chain.
+	AttestationDuty DutyType = "attestation"
+	// ProposalDuty is the duty to propose a block.
+	ProposalDuty DutyType = "proposal"
+	// AggregationDuty is the duty to aggregate the attestations of a committee.
+	AggregationDuty DutyType = "aggregation"
+)
+
+// DutyFailure classifies why a duty was not performed.
+type DutyFailure string
+
+const (
+	// BeaconNodeUnavailable is a beacon node which could not be reached in time.
+	BeaconNodeUnavailable DutyFailure = "beacon_node_unavailable"
+	// BeaconNodeError is a request the beacon node responded to with an error.
+	BeaconNodeError DutyFailure = "beacon_node_error"
+	// SubmissionRejected is a signed message the beacon node did not accept.
+	SubmissionRejected DutyFailure = "submission_rejected"
+	// SlashingProtection is a message slashing protection refused to sign.
+	SlashingProtection DutyFailure = "slashing_protection"
+	// SigningFailure is a message the keymanager could not sign.
+	SigningFailure DutyFailure = "signing_failure"
+	// MissingAssignment is a duty whose assignment was not known in time.
+	MissingAssignment DutyFailure = "missing_assignment"
+	// AttestationDataMismatch is attestation data not matching the secondary beacon node.
+	AttestationDataMismatch DutyFailure = "attestation_data_mismatch"
+)
+
+// MissedDuty is an entry of the missed duty journal.
+type MissedDuty struct {
+	Type      DutyType    `json:"type"`
+	Slot      uint64      `json:"slot"`
+	Epoch     uint64      `json:"epoch"`
+	PubKey    string      `json:"pubkey"`
+	Failure   DutyFailure `json:"failure"`
+	Error     string      `json:"error"`
+	Timestamp int64       `json:"timestamp"` // Unix time the failure was recorded at.
+}
+
+// MissedDutyFilter selects entries of the missed duty journal. Empty fields match any entry, and
+// the epoch range is inclusive.
+type MissedDutyFilter struct {
+	PubKeys    map[string]bool
+	Type       DutyType
+	Failure    DutyFailure
+	StartEpoch uint64
+	EndEpoch   uint64
+	Limit      int // Maximum number of most recent entries to return, all if zero.
+}
+
+func (f *MissedDutyFilter) matches(d *MissedDuty) bool {
+	if len(f.PubKeys) > 0 && !f.PubKeys[d.PubKey] {
+		return false
+	}
+	if f.Type != "" && f.Type != d.Type {
+		return false
+	}
+	if f.Failure != "" && f.Failure != d.Failure {
+		return false
+	}
+	if d.Epoch < f.StartEpoch || (f.EndEpoch != 0 && d.Epoch > f.EndEpoch) {
+		return false
+	}
+	return true
+}
+
+// SaveMissedDuty appends an entry to the missed duty journal, removing the oldest entries beyond
+// MaxMissedDuties.
+func (store *Store) SaveMissedDuty(ctx context.Context, duty *MissedDuty) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.SaveMissedDuty")
+	defer span.End()
+
+	enc, err := json.Marshal(duty)
+	if err != nil {
+		return errors.Wrap(err, "could not encode missed duty")
+	}
+	enc, err = store.encrypt(enc)
+	if err != nil {
+		return err
+	}
+	return store.update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(missedDutiesBucket)
+		seq, err := bucket.NextSequence()
+		if err != nil {
+			return err
+		}
+		if err := bucket.Put(bytesutil.Uint64ToBytesBigEndian(seq), enc); err != nil {
+			return errors.Wrap(err, "could not save missed duty")
+		}
+		// Keys are sequential, so the entries to remove are the first ones.
+		var expired [][]byte
+		c := bucket.Cursor()
+		for k, _ := c.First(); k != nil && seq-bytesutil.BytesToUint64BigEndian(k) >= MaxMissedDuties; k, _ = c.Next() {
+			expired = append(expired, k)
+		}
+		for _, k := range expired {
+			if err := bucket.Delete(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// MissedDuties returns the entries of the missed duty journal matching the filter, from oldest to
+// most recent.
+func (store *Store) MissedDuties(ctx context.Context, filter *MissedDutyFilter) ([]*MissedDuty, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.MissedDuties")
+	defer span.End()
+
+	if filter == nil {
+		filter = &MissedDutyFilter{}
+	}
+	duties := make([]*MissedDuty, 0)
+	err := store.view(func(tx *bolt.Tx) error {
+		c := tx.Bucket(missedDutiesBucket).Cursor()
+		// Walk from the most recent entry so a limit stops the walk early.
+		for k, v := c.Last(); k != nil; k, v = c.Prev() {
+			if filter.Limit > 0 && len(duties) >= filter.Limit {
+				break
+			}
+			dec, err := store.decrypt(v)
+			if err != nil {
+				return err
+			}
+			duty := &MissedDuty{}
+			if err := json.Unmarshal(dec, duty); err != nil {
+				return errors.Wrap(err, "could not decode missed duty")
+			}
+			if filter.matches(duty) {
+				duties = append(duties, duty)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i, j := 0, len(duties)-1; i < j; i, j = i+1, j-1 {
+		duties[i], duties[j] = duties[j], duties[i]
+	}
+	return duties, nil
+}
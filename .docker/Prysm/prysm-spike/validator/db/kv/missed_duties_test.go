@@ -0,0 +1,75 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+func TestMissedDuties_Filters(t *testing.T) {
+	db := setupDB(t, nil)
+	ctx := context.Background()
+
+	duties := []*MissedDuty{
+		{Type: AttestationDuty, Slot: 33, Epoch: 1, PubKey: "0x01", Failure: BeaconNodeUnavailable, Error: "timeout"},
+		{Type: ProposalDuty, Slot: 70, Epoch: 2, PubKey: "0x02", Failure: SlashingProtection},
+		{Type: AttestationDuty, Slot: 101, Epoch: 3, PubKey: "0x02", Failure: SubmissionRejected},
+		{Type: AggregationDuty, Slot: 130, Epoch: 4, PubKey: "0x01", Failure: SigningFailure},
+	}
+	for _, d := range duties {
+		require.NoError(t, db.SaveMissedDuty(ctx, d))
+	}
+
+	received, err := db.MissedDuties(ctx, nil)
+	require.NoError(t, err)
+	require.DeepEqual(t, duties, received)
+
+	received, err = db.MissedDuties(ctx, &MissedDutyFilter{PubKeys: map[string]bool{"0x02": true}})
+	require.NoError(t, err)
+	require.DeepEqual(t, duties[1:3], received)
+
+	received, err = db.MissedDuties(ctx, &MissedDutyFilter{Type: AttestationDuty})
+	require.NoError(t, err)
+	require.DeepEqual(t, []*MissedDuty{duties[0], duties[2]}, received)
+
+	received, err = db.MissedDuties(ctx, &MissedDutyFilter{Failure: SigningFailure})
+	require.NoError(t, err)
+	require.DeepEqual(t, duties[3:], received)
+
+	received, err = db.MissedDuties(ctx, &MissedDutyFilter{StartEpoch: 2, EndEpoch: 3})
+	require.NoError(t, err)
+	require.DeepEqual(t, duties[1:3], received)
+
+	// A limit returns the most recent entries.
+	received, err = db.MissedDuties(ctx, &MissedDutyFilter{Limit: 2})
+	require.NoError(t, err)
+	require.DeepEqual(t, duties[2:], received)
+}
+
+func TestSaveMissedDuty_RemovesOldest(t *testing.T) {
+	db := setupDB(t, nil)
+	ctx := context.Background()
+
+	oldest := &MissedDuty{Type: AttestationDuty, Slot: 1, PubKey: "0x01", Failure: BeaconNodeError}
+	older := &MissedDuty{Type: AttestationDuty, Slot: 2, PubKey: "0x01", Failure: BeaconNodeError}
+	require.NoError(t, db.SaveMissedDuty(ctx, oldest))
+	require.NoError(t, db.SaveMissedDuty(ctx, older))
+	// Pretend the journal is full rather than filling it.
+	require.NoError(t, db.update(func(tx *bolt.Tx) error {
+		return tx.Bucket(missedDutiesBucket).SetSequence(MaxMissedDuties)
+	}))
+
+	newest := &MissedDuty{Type: ProposalDuty, Slot: 3, PubKey: "0x01", Failure: BeaconNodeError}
+	require.NoError(t, db.SaveMissedDuty(ctx, newest))
+	received, err := db.MissedDuties(ctx, nil)
+	require.NoError(t, err)
+	require.DeepEqual(t, []*MissedDuty{older, newest}, received)
+	require.NoError(t, db.view(func(tx *bolt.Tx) error {
+		assert.Equal(t, true, tx.Bucket(missedDutiesBucket).Get(bytesutil.Uint64ToBytesBigEndian(1)) == nil)
+		return nil
+	}))
+}
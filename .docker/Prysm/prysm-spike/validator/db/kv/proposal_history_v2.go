@@ -57,7 +57,10 @@ func (store *Store) ProposalHistoryForSlot(ctx context.Context, publicKey [48]by
 		if err != nil {
 			return fmt.Errorf("could not create bucket for public key %#x", publicKey[:])
 		}
-		signingRootBytes := valBucket.Get(bytesutil.Uint64ToBytesBigEndian(slot))
+		signingRootBytes, err := store.decrypt(valBucket.Get(bytesutil.Uint64ToBytesBigEndian(slot)))
+		if err != nil {
+			return err
+		}
 		if signingRootBytes == nil {
 			return nil
 		}
@@ -84,31 +87,49 @@ func (store *Store) SaveProposalHistoryForSlot(ctx context.Context, pubKey [48]b
 
 		// If the incoming slot is lower than the lowest signed proposal slot, override.
 		lowestSignedBkt := tx.Bucket(lowestSignedProposalsBucket)
-		lowestSignedProposalBytes := lowestSignedBkt.Get(pubKey[:])
+		lowestSignedProposalBytes, err := store.decrypt(lowestSignedBkt.Get(pubKey[:]))
+		if err != nil {
+			return err
+		}
 		var lowestSignedProposalSlot uint64
 		if len(lowestSignedProposalBytes) >= 8 {
 			lowestSignedProposalSlot = bytesutil.BytesToUint64BigEndian(lowestSignedProposalBytes)
 		}
 		if len(lowestSignedProposalBytes) == 0 || slot < lowestSignedProposalSlot {
-			if err := lowestSignedBkt.Put(pubKey[:], bytesutil.Uint64ToBytesBigEndian(slot)); err != nil {
+			enc, err := store.encrypt(bytesutil.Uint64ToBytesBigEndian(slot))
+			if err != nil {
+				return err
+			}
+			if err := lowestSignedBkt.Put(pubKey[:], enc); err != nil {
 				return err
 			}
 		}
 
 		// If the incoming slot is higher than the highest signed proposal slot, override.
 		highestSignedBkt := tx.Bucket(highestSignedProposalsBucket)
-		highestSignedProposalBytes := highestSignedBkt.Get(pubKey[:])
+		highestSignedProposalBytes, err := store.decrypt(highestSignedBkt.Get(pubKey[:]))
+		if err != nil {
+			return err
+		}
 		var highestSignedProposalSlot uint64
 		if len(highestSignedProposalBytes) >= 8 {
 			highestSignedProposalSlot = bytesutil.BytesToUint64BigEndian(highestSignedProposalBytes)
 		}
 		if len(highestSignedProposalBytes) == 0 || slot > highestSignedProposalSlot {
-			if err := highestSignedBkt.Put(pubKey[:], bytesutil.Uint64ToBytesBigEndian(slot)); err != nil {
+			enc, err := store.encrypt(bytesutil.Uint64ToBytesBigEndian(slot))
+			if err != nil {
+				return err
+			}
+			if err := highestSignedBkt.Put(pubKey[:], enc); err != nil {
 				return err
 			}
 		}
 
-		if err := valBucket.Put(bytesutil.Uint64ToBytesBigEndian(slot), signingRoot); err != nil {
+		enc, err := store.encrypt(signingRoot)
+		if err != nil {
+			return err
+		}
+		if err := valBucket.Put(bytesutil.Uint64ToBytesBigEndian(slot), enc); err != nil {
 			return err
 		}
 		return pruneProposalHistoryBySlot(valBucket, slot)
@@ -126,7 +147,10 @@ func (store *Store) LowestSignedProposal(ctx context.Context, publicKey [48]byte
 	var lowestSignedProposalSlot uint64
 	err = store.view(func(tx *bolt.Tx) error {
 		bucket := tx.Bucket(lowestSignedProposalsBucket)
-		lowestSignedProposalBytes := bucket.Get(publicKey[:])
+		lowestSignedProposalBytes, err := store.decrypt(bucket.Get(publicKey[:]))
+		if err != nil {
+			return err
+		}
 		// 8 because bytesutil.BytesToUint64BigEndian will return 0 if input is less than 8 bytes.
 		if len(lowestSignedProposalBytes) < 8 {
 			return nil
@@ -147,7 +171,10 @@ func (store *Store) HighestSignedProposal(ctx context.Context, publicKey [48]byt
 	var highestSignedProposalSlot uint64
 	err = store.view(func(tx *bolt.Tx) error {
 		bucket := tx.Bucket(highestSignedProposalsBucket)
-		highestSignedProposalBytes := bucket.Get(publicKey[:])
+		highestSignedProposalBytes, err := store.decrypt(bucket.Get(publicKey[:]))
+		if err != nil {
+			return err
+		}
 		// 8 because bytesutil.BytesToUint64BigEndian will return 0 if input is less than 8 bytes.
 		if len(highestSignedProposalBytes) < 8 {
 			return nil
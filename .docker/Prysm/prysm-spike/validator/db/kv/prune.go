@@ -0,0 +1,346 @@
+package kv
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	log "github.com/sirupsen/logrus"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// compactTxMaxSize bounds the size of the values copied in one transaction while compacting.
+const compactTxMaxSize = 64 * 1024 * 1024
+
+// PruneResult counts what a pruning of the signing histories removed.
+type PruneResult struct {
+	// NewestEpoch is the newest epoch signed by any key, the retention is counted back from.
+	NewestEpoch uint64
+	// AttestationEntries is the number of attested target epochs removed from the histories kept.
+	AttestationEntries int
+	// AttestationHistories is the number of keys whose whole attestation history was removed.
+	AttestationHistories int
+	// Proposals is the number of proposed slots removed.
+	Proposals int
+}
+
+// PruneHistory removes the attestations and proposals signed more than retentionEpochs before the
+// newest epoch signed by any key. Slashing protection ignores history older than the weak subjectivity
+// period, so retentionEpochs cannot be less than it. The space of the removed values is reused by later
+// writes, Compact shrinks the database file.
+func (store *Store) PruneHistory(ctx context.Context, retentionEpochs uint64) (*PruneResult, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.PruneHistory")
+	defer span.End()
+
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+	if retentionEpochs < wsPeriod {
+		return nil, fmt.Errorf(
+			"cannot prune history of the last %d epochs, less than the weak subjectivity period of %d epochs",
+			retentionEpochs, wsPeriod,
+		)
+	}
+	result := &PruneResult{}
+	err := store.update(func(tx *bolt.Tx) error {
+		newestEpoch, err := store.newestSignedEpoch(ctx, tx)
+		if err != nil {
+			return err
+		}
+		result.NewestEpoch = newestEpoch
+		if newestEpoch <= retentionEpochs {
+			return nil
+		}
+		oldestEpoch := newestEpoch - retentionEpochs
+		if err := store.pruneAttestationHistories(ctx, tx, oldestEpoch, result); err != nil {
+			return errors.Wrap(err, "could not prune attestation history")
+		}
+		return pruneProposalHistories(tx, oldestEpoch, result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// newestSignedEpoch returns the newest target epoch attested or epoch proposed by any key.
+func (store *Store) newestSignedEpoch(ctx context.Context, tx *bolt.Tx) (uint64, error) {
+	var newest uint64
+	if err := tx.Bucket(newHistoricAttestationsBucket).ForEach(func(_, v []byte) error {
+		enc, err := store.decrypt(v)
+		if err != nil {
+			return err
+		}
+		if len(enc) == 0 {
+			return nil
+		}
+		latest, err := EncHistoryData(enc).GetLatestEpochWritten(ctx)
+		if err != nil {
+			return err
+		}
+		if latest > newest {
+			newest = latest
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	if err := tx.Bucket(highestSignedProposalsBucket).ForEach(func(_, v []byte) error {
+		enc, err := store.decrypt(v)
+		if err != nil {
+			return err
+		}
+		if len(enc) < 8 {
+			return nil
+		}
+		if epoch := helpers.SlotToEpoch(bytesutil.BytesToUint64BigEndian(enc)); epoch > newest {
+			newest = epoch
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	return newest, nil
+}
+
+func (store *Store) pruneAttestationHistories(ctx context.Context, tx *bolt.Tx, oldestEpoch uint64, result *PruneResult) error {
+	bkt := tx.Bucket(newHistoricAttestationsBucket)
+	pruned := make(map[string]EncHistoryData)
+	if err := bkt.ForEach(func(k, v []byte) error {
+		enc, err := store.decrypt(v)
+		if err != nil {
+			return err
+		}
+		if len(enc) == 0 {
+			return nil
+		}
+		hist := make(EncHistoryData, len(enc))
+		copy(hist, enc)
+		hist, entries, err := pruneAttestationHistory(ctx, hist, oldestEpoch)
+		if err != nil {
+			return errors.Wrapf(err, "could not prune attestation history of public key %#x", k)
+		}
+		result.AttestationEntries += entries
+		if entries > 0 || hist == nil {
+			pruned[string(k)] = hist
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	for k, hist := range pruned {
+		if hist == nil {
+			result.AttestationHistories++
+			if err := bkt.Delete([]byte(k)); err != nil {
+				return err
+			}
+			continue
+		}
+		enc, err := store.encrypt(hist)
+		if err != nil {
+			return err
+		}
+		if err := bkt.Put([]byte(k), enc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pruneAttestationHistory empties the entries of the history for targets older than the oldest epoch,
+// and drops the emptied entries at the end of the array. It returns the number of entries emptied, and
+// a nil history if the latest target written is older than the oldest epoch.
+func pruneAttestationHistory(ctx context.Context, hist EncHistoryData, oldestEpoch uint64) (EncHistoryData, int, error) {
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+	latest, err := hist.GetLatestEpochWritten(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	entries := uint64(len(hist)-latestEpochWrittenSize) / historySize
+	if latest < oldestEpoch {
+		return nil, 0, nil
+	}
+	latestPosition := latest % wsPeriod
+	var emptied int
+	for position := uint64(0); position < entries; position++ {
+		// The entry holds the newest target up to the latest written epoch at its position of the ring.
+		distance := (latestPosition + wsPeriod - position) % wsPeriod
+		if distance <= latest && latest-distance >= oldestEpoch {
+			continue
+		}
+		data, err := hist.GetTargetData(ctx, position)
+		if err != nil {
+			return nil, 0, err
+		}
+		if data.IsEmpty() {
+			continue
+		}
+		if hist, err = hist.SetTargetData(ctx, position, emptyHistoryData()); err != nil {
+			return nil, 0, err
+		}
+		emptied++
+	}
+	// Entries behind the latest written one are only read again once rewritten.
+	for entries > latestPosition+1 {
+		data, err := hist.GetTargetData(ctx, entries-1)
+		if err != nil {
+			return nil, 0, err
+		}
+		if !data.IsEmpty() {
+			break
+		}
+		entries--
+	}
+	return hist[:latestEpochWrittenSize+entries*historySize], emptied, nil
+}
+
+func pruneProposalHistories(tx *bolt.Tx, oldestEpoch uint64, result *PruneResult) error {
+	bkt := tx.Bucket(newHistoricProposalsBucket)
+	return bkt.ForEach(func(k, _ []byte) error {
+		valBucket := bkt.Bucket(k)
+		if valBucket == nil {
+			return nil
+		}
+		c := valBucket.Cursor()
+		for slot, _ := c.First(); slot != nil; slot, _ = c.First() {
+			if helpers.SlotToEpoch(bytesutil.BytesToUint64BigEndian(slot)) >= oldestEpoch {
+				break
+			}
+			if err := c.Delete(); err != nil {
+				return errors.Wrapf(err, "could not prune proposal history of public key %#x", k)
+			}
+			result.Proposals++
+		}
+		return nil
+	})
+}
+
+// Compact rewrites the validator database in the directory into a new file without the free pages
+// left by deleted values, and replaces the database with it. It returns the size of the file before
+// and after. The database must not be open.
+func Compact(dirPath string) (int64, int64, error) {
+	datafile := filepath.Join(dirPath, ProtectionDbFileName)
+	info, err := os.Stat(datafile)
+	if err != nil {
+		return 0, 0, err
+	}
+	src, err := bolt.Open(datafile, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{
+		Timeout:  params.BeaconIoConfig().BoltTimeout,
+		ReadOnly: true,
+	})
+	if err != nil {
+		if errors.Is(err, bolt.ErrTimeout) {
+			return 0, 0, errors.New("cannot obtain database lock, database may be in use by another process")
+		}
+		return 0, 0, err
+	}
+	defer func() {
+		if err := src.Close(); err != nil {
+			log.WithError(err).Error("Could not close validator database")
+		}
+	}()
+
+	tmpFile, err := ioutil.TempFile(dirPath, ProtectionDbFileName+".compact")
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "could not create compacted database file")
+	}
+	tmpPath := tmpFile.Name()
+	defer func() {
+		if err := os.Remove(tmpPath); err != nil && !os.IsNotExist(err) {
+			log.WithError(err).Error("Could not remove temporary compacted database file")
+		}
+	}()
+	if err := tmpFile.Close(); err != nil {
+		return 0, 0, err
+	}
+	dst, err := bolt.Open(tmpPath, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{
+		Timeout: params.BeaconIoConfig().BoltTimeout,
+	})
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "could not open compacted database file")
+	}
+	if err := copyDB(dst, src); err != nil {
+		if closeErr := dst.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Could not close compacted database file")
+		}
+		return 0, 0, errors.Wrap(err, "could not copy database")
+	}
+	if err := dst.Close(); err != nil {
+		return 0, 0, errors.Wrap(err, "could not close compacted database file")
+	}
+	compacted, err := os.Stat(tmpPath)
+	if err != nil {
+		return 0, 0, err
+	}
+	if err := os.Rename(tmpPath, datafile); err != nil {
+		return 0, 0, errors.Wrap(err, "could not move compacted database into place")
+	}
+	return info.Size(), compacted.Size(), nil
+}
+
+// copyDB copies every bucket, nested bucket and value of the source database into the destination,
+// committing whenever the values copied in a transaction exceed compactTxMaxSize.
+func copyDB(dst, src *bolt.DB) error {
+	tx, err := dst.Begin(true)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// Fails with bolt.ErrTxClosed once committed.
+		_ = tx.Rollback()
+	}()
+	var size int
+	if err := src.View(func(srcTx *bolt.Tx) error {
+		return srcTx.ForEach(func(name []byte, b *bolt.Bucket) error {
+			return walkBucket(b, [][]byte{name}, func(path [][]byte, k, v []byte, seq uint64) error {
+				if size+len(k)+len(v) > compactTxMaxSize {
+					if err := tx.Commit(); err != nil {
+						return err
+					}
+					if tx, err = dst.Begin(true); err != nil {
+						return err
+					}
+					size = 0
+				}
+				size += len(k) + len(v)
+
+				bkt, err := tx.CreateBucketIfNotExists(path[0])
+				if err != nil {
+					return err
+				}
+				for _, name := range path[1:] {
+					if bkt, err = bkt.CreateBucketIfNotExists(name); err != nil {
+						return err
+					}
+				}
+				if k == nil {
+					return bkt.SetSequence(seq)
+				}
+				return bkt.Put(k, v)
+			})
+		})
+	}); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// walkBucket calls fn with a nil key for the bucket at the path, to carry over its sequence, and then
+// for each of its values, recursing into the nested buckets.
+func walkBucket(b *bolt.Bucket, path [][]byte, fn func(path [][]byte, k, v []byte, seq uint64) error) error {
+	if err := fn(path, nil, nil, b.Sequence()); err != nil {
+		return err
+	}
+	return b.ForEach(func(k, v []byte) error {
+		if v == nil {
+			nested := append(append(make([][]byte, 0, len(path)+1), path...), k)
+			return walkBucket(b.Bucket(k), nested, fn)
+		}
+		return fn(path, k, v, 0)
+	})
+}
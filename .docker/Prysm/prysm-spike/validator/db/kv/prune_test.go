@@ -0,0 +1,152 @@
+package kv
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+func attestationHistoryForTargets(t *testing.T, first, latest uint64) EncHistoryData {
+	ctx := context.Background()
+	hist := NewAttestationHistoryArray(params.BeaconConfig().WeakSubjectivityPeriod - 1)
+	var err error
+	for target := first; target <= latest; target++ {
+		hist, err = hist.SetTargetData(ctx, target%params.BeaconConfig().WeakSubjectivityPeriod, &HistoryData{
+			Source:      target - 1,
+			SigningRoot: bytesutil.PadTo([]byte{byte(target)}, 32),
+		})
+		require.NoError(t, err)
+	}
+	hist, err = hist.SetLatestEpochWritten(ctx, latest)
+	require.NoError(t, err)
+	return hist
+}
+
+func TestStore_PruneHistory(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig()
+	cfg.WeakSubjectivityPeriod = 10
+	params.OverrideBeaconConfig(cfg)
+
+	active, lagging, stopped := [48]byte{1}, [48]byte{2}, [48]byte{3}
+	db := setupDB(t, [][48]byte{active, lagging, stopped})
+	ctx := context.Background()
+	require.NoError(t, db.SaveAttestationHistoryForPubKeysV2(ctx, map[[48]byte]EncHistoryData{
+		active:  attestationHistoryForTargets(t, 5, 25),
+		lagging: attestationHistoryForTargets(t, 5, 20),
+		stopped: attestationHistoryForTargets(t, 1, 3),
+	}))
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	require.NoError(t, db.SaveProposalHistoryForSlot(ctx, active, 20*slotsPerEpoch, []byte{1}))
+	require.NoError(t, db.SaveProposalHistoryForSlot(ctx, stopped, 2*slotsPerEpoch, []byte{2}))
+
+	_, err := db.PruneHistory(ctx, 9)
+	assert.ErrorContains(t, "less than the weak subjectivity period", err)
+
+	result, err := db.PruneHistory(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(25), result.NewestEpoch)
+	assert.Equal(t, 4, result.AttestationEntries, "Expected targets 11 to 14 of the lagging key to be pruned")
+	assert.Equal(t, 1, result.AttestationHistories)
+	assert.Equal(t, 1, result.Proposals)
+
+	histories, err := db.AttestationHistoryForPubKeysV2(ctx, [][48]byte{active, lagging, stopped})
+	require.NoError(t, err)
+	for target := uint64(16); target <= 25; target++ {
+		data, err := histories[active].GetTargetData(ctx, target%10)
+		require.NoError(t, err)
+		assert.Equal(t, target-1, data.Source, "Unexpected source of target %d", target)
+	}
+	for target := uint64(11); target <= 20; target++ {
+		data, err := histories[lagging].GetTargetData(ctx, target%10)
+		require.NoError(t, err)
+		assert.Equal(t, target < 15, data.IsEmpty(), "Unexpected history of target %d", target)
+	}
+	latest, err := histories[stopped].GetLatestEpochWritten(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), latest, "Expected the history of the stopped key to be removed")
+
+	_, exists, err := db.ProposalHistoryForSlot(ctx, stopped, 2*slotsPerEpoch)
+	require.NoError(t, err)
+	assert.Equal(t, false, exists)
+	_, exists, err = db.ProposalHistoryForSlot(ctx, active, 20*slotsPerEpoch)
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+	lowest, err := db.LowestSignedProposal(ctx, stopped)
+	require.NoError(t, err)
+	assert.Equal(t, 2*slotsPerEpoch, lowest, "Expected the lowest signed proposal to be kept")
+}
+
+func TestPruneAttestationHistory_TruncatesEmptiedEntries(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig()
+	cfg.WeakSubjectivityPeriod = 10
+	params.OverrideBeaconConfig(cfg)
+
+	ctx := context.Background()
+	hist := attestationHistoryForTargets(t, 5, 8)
+	hist, err := hist.SetLatestEpochWritten(ctx, 12)
+	require.NoError(t, err)
+	hist, err = hist.SetTargetData(ctx, 12%10, &HistoryData{Source: 11, SigningRoot: make([]byte, 32)})
+	require.NoError(t, err)
+
+	pruned, emptied, err := pruneAttestationHistory(ctx, hist, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 4, emptied)
+	assert.Equal(t, latestEpochWrittenSize+3*historySize, len(pruned))
+	data, err := pruned.GetTargetData(ctx, 12%10)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(11), data.Source)
+}
+
+func TestCompact(t *testing.T) {
+	pubKey := [48]byte{1}
+	dir := t.TempDir()
+	db, err := NewKVStore(dir, [][48]byte{pubKey})
+	require.NoError(t, err)
+	ctx := context.Background()
+	for slot := uint64(0); slot < 1000; slot++ {
+		require.NoError(t, db.SaveProposalHistoryForSlot(ctx, pubKey, slot, bytesutil.PadTo([]byte{1}, 32)))
+	}
+	require.NoError(t, db.update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(newHistoricProposalsBucket).Bucket(pubKey[:])
+		c := bkt.Cursor()
+		for k, _ := c.First(); k != nil && bytesutil.BytesToUint64BigEndian(k) < 990; k, _ = c.First() {
+			if err := c.Delete(); err != nil {
+				return err
+			}
+		}
+		_, err := bkt.NextSequence()
+		return err
+	}))
+	require.NoError(t, db.Close())
+
+	before, after, err := Compact(dir)
+	require.NoError(t, err)
+	assert.Equal(t, true, after < before, "Expected the compacted database to be smaller, %d >= %d", after, before)
+	info, err := os.Stat(filepath.Join(dir, ProtectionDbFileName))
+	require.NoError(t, err)
+	assert.Equal(t, after, info.Size())
+
+	db, err = NewKVStore(dir, [][48]byte{pubKey})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+	_, exists, err := db.ProposalHistoryForSlot(ctx, pubKey, 995)
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+	require.NoError(t, db.view(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(newHistoricProposalsBucket).Bucket(pubKey[:])
+		assert.Equal(t, 10, bkt.Stats().KeyN)
+		assert.Equal(t, uint64(1), bkt.Sequence())
+		return nil
+	}))
+}
@@ -0,0 +1,65 @@
+package kv
+
+import (
+	"context"
+	"encoding/json"
+
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// Quarantine records why a public key was taken off duties.
+type Quarantine struct {
+	Failure   DutyFailure `json:"failure"`   // Kind of the last anomaly.
+	Error     string      `json:"error"`     // Error of the last anomaly.
+	Anomalies uint64      `json:"anomalies"` // Consecutive anomalies which led to the quarantine.
+	Timestamp int64       `json:"timestamp"` // Unix time the key was quarantined at.
+}
+
+// SaveQuarantinedPublicKey quarantines a public key, replacing an earlier quarantine of the key.
+func (store *Store) SaveQuarantinedPublicKey(ctx context.Context, pubKey [48]byte, quarantine *Quarantine) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.SaveQuarantinedPublicKey")
+	defer span.End()
+	enc, err := json.Marshal(quarantine)
+	if err != nil {
+		return err
+	}
+	return store.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(quarantinedPublicKeysBucket).Put(pubKey[:], enc)
+	})
+}
+
+// QuarantinedPublicKeys returns the quarantined public keys, mapped to their quarantine.
+func (store *Store) QuarantinedPublicKeys(ctx context.Context) (map[[48]byte]*Quarantine, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.QuarantinedPublicKeys")
+	defer span.End()
+	quarantined := make(map[[48]byte]*Quarantine)
+	err := store.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(quarantinedPublicKeysBucket).ForEach(func(k, v []byte) error {
+			quarantine := &Quarantine{}
+			if err := json.Unmarshal(v, quarantine); err != nil {
+				return err
+			}
+			var pubKey [48]byte
+			copy(pubKey[:], k)
+			quarantined[pubKey] = quarantine
+			return nil
+		})
+	})
+	return quarantined, err
+}
+
+// ClearQuarantinedPublicKeys releases public keys from quarantine, putting them back on duties.
+func (store *Store) ClearQuarantinedPublicKeys(ctx context.Context, pubKeys [][48]byte) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.ClearQuarantinedPublicKeys")
+	defer span.End()
+	return store.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(quarantinedPublicKeysBucket)
+		for _, pubKey := range pubKeys {
+			if err := bkt.Delete(pubKey[:]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
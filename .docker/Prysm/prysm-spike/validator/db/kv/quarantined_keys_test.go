@@ -0,0 +1,39 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_QuarantinedPublicKeys(t *testing.T) {
+	ctx := context.Background()
+	pubKeys := [][48]byte{{1}, {2}, {3}}
+	db := setupDB(t, pubKeys)
+
+	quarantined, err := db.QuarantinedPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(quarantined))
+
+	for _, pubKey := range pubKeys[:2] {
+		require.NoError(t, db.SaveQuarantinedPublicKey(ctx, pubKey, &Quarantine{
+			Failure:   SlashingProtection,
+			Error:     "double vote",
+			Anomalies: 3,
+			Timestamp: 100,
+		}))
+	}
+	quarantined, err = db.QuarantinedPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(quarantined))
+	assert.DeepEqual(t, &Quarantine{Failure: SlashingProtection, Error: "double vote", Anomalies: 3, Timestamp: 100}, quarantined[pubKeys[0]])
+
+	require.NoError(t, db.ClearQuarantinedPublicKeys(ctx, pubKeys[1:]))
+	quarantined, err = db.QuarantinedPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(quarantined))
+	_, ok := quarantined[pubKeys[0]]
+	assert.Equal(t, true, ok)
+}
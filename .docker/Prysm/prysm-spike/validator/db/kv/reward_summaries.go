@@ -0,0 +1,103 @@
+package kv
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// Length of an encoded reward summary: the expected reward and the actual balance change.
+const rewardSummaryLength = 8 + 8
+
+// RewardSummary compares the reward a validator was expected to earn in an epoch with the change
+// of its balance in the epoch transition, both in gwei.
+type RewardSummary struct {
+	Epoch          uint64
+	ExpectedReward uint64
+	ActualChange   int64
+}
+
+// Shortfall returns the part of the expected reward the validator did not earn, negative if the
+// validator earned more than expected, such as by proposing blocks.
+func (s *RewardSummary) Shortfall() int64 {
+	return int64(s.ExpectedReward) - s.ActualChange
+}
+
+func (s *RewardSummary) marshal() []byte {
+	enc := make([]byte, rewardSummaryLength)
+	binary.LittleEndian.PutUint64(enc[:8], s.ExpectedReward)
+	binary.LittleEndian.PutUint64(enc[8:], uint64(s.ActualChange))
+	return enc
+}
+
+func unmarshalRewardSummary(epoch uint64, enc []byte) (*RewardSummary, error) {
+	if len(enc) != rewardSummaryLength {
+		return nil, fmt.Errorf("wrong reward summary length, expected %d, received %d", rewardSummaryLength, len(enc))
+	}
+	return &RewardSummary{
+		Epoch:          epoch,
+		ExpectedReward: binary.LittleEndian.Uint64(enc[:8]),
+		ActualChange:   int64(binary.LittleEndian.Uint64(enc[8:])),
+	}, nil
+}
+
+// SaveRewardSummary saves the reward summary of a validator public key for an epoch, overwriting
+// any summary already stored for the epoch.
+func (store *Store) SaveRewardSummary(ctx context.Context, pubKey [48]byte, summary *RewardSummary) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.SaveRewardSummary")
+	defer span.End()
+
+	return store.update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(rewardSummariesBucket)
+		valBucket, err := bucket.CreateBucketIfNotExists(pubKey[:])
+		if err != nil {
+			return fmt.Errorf("could not create bucket for public key %#x", pubKey[:])
+		}
+		enc, err := store.encrypt(summary.marshal())
+		if err != nil {
+			return err
+		}
+		if err := valBucket.Put(bytesutil.Uint64ToBytesBigEndian(summary.Epoch), enc); err != nil {
+			return errors.Wrapf(err, "could not save reward summary of epoch %d", summary.Epoch)
+		}
+		return nil
+	})
+}
+
+// RewardSummaries returns the reward summaries of a validator public key stored for the epochs in
+// the inclusive range, in ascending epoch order.
+func (store *Store) RewardSummaries(ctx context.Context, pubKey [48]byte, startEpoch, endEpoch uint64) ([]*RewardSummary, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.RewardSummaries")
+	defer span.End()
+
+	summaries := make([]*RewardSummary, 0)
+	err := store.view(func(tx *bolt.Tx) error {
+		valBucket := tx.Bucket(rewardSummariesBucket).Bucket(pubKey[:])
+		if valBucket == nil {
+			return nil
+		}
+		c := valBucket.Cursor()
+		for k, v := c.Seek(bytesutil.Uint64ToBytesBigEndian(startEpoch)); k != nil; k, v = c.Next() {
+			epoch := bytesutil.BytesToUint64BigEndian(k)
+			if epoch > endEpoch {
+				break
+			}
+			dec, err := store.decrypt(v)
+			if err != nil {
+				return err
+			}
+			s, err := unmarshalRewardSummary(epoch, dec)
+			if err != nil {
+				return err
+			}
+			summaries = append(summaries, s)
+		}
+		return nil
+	})
+	return summaries, err
+}
@@ -0,0 +1,39 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestSaveRewardSummary_OK(t *testing.T) {
+	pubkey := [48]byte{3}
+	db := setupDB(t, [][48]byte{pubkey})
+	ctx := context.Background()
+
+	summaries := []*RewardSummary{
+		{Epoch: 1, ExpectedReward: 20000, ActualChange: 19500},
+		{Epoch: 2, ExpectedReward: 20000, ActualChange: -15000},
+		{Epoch: 3, ExpectedReward: 20000, ActualChange: 45000},
+	}
+	for _, s := range summaries {
+		require.NoError(t, db.SaveRewardSummary(ctx, pubkey, s))
+	}
+
+	received, err := db.RewardSummaries(ctx, pubkey, 0, 10)
+	require.NoError(t, err)
+	require.DeepEqual(t, summaries, received)
+	assert.Equal(t, int64(500), received[0].Shortfall())
+	assert.Equal(t, int64(35000), received[1].Shortfall())
+	assert.Equal(t, int64(-25000), received[2].Shortfall())
+
+	received, err = db.RewardSummaries(ctx, pubkey, 2, 2)
+	require.NoError(t, err)
+	require.DeepEqual(t, summaries[1:2], received)
+
+	received, err = db.RewardSummaries(ctx, [48]byte{4}, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(received))
+}
@@ -21,6 +21,24 @@ var (
 	lowestSignedProposalsBucket  = []byte("lowest-signed-proposals-bucket")
 	highestSignedProposalsBucket = []byte("highest-signed-proposals-bucket")
 
+	// Attestation performance of validators, such as inclusion and head and target correctness.
+	attestationPerformanceBucket = []byte("attestation-performance-bucket")
+
+	// Expected rewards of validators compared with their actual balance changes, by epoch.
+	rewardSummariesBucket = []byte("reward-summaries-bucket")
+
+	// Journal of the duties validators missed or failed, keyed by sequence number.
+	missedDutiesBucket = []byte("missed-duties-bucket")
+
+	// Salt and check value of the encryption of sensitive bucket values, empty if not encrypted.
+	encryptionBucket = []byte("encryption-bucket")
+
+	// Public keys deleted from the wallet, mapped to the archive of their slashing protection history.
+	deletedPublicKeysBucket = []byte("deleted-public-keys-bucket")
+
+	// Public keys taken off duties after repeated signing anomalies, until an operator releases them.
+	quarantinedPublicKeysBucket = []byte("quarantined-public-keys-bucket")
+
 	// Genesis validators root bucket key.
 	genesisValidatorsRootKey = []byte("genesis-val-root")
 )
@@ -0,0 +1,121 @@
+// Package prune periodically removes the slashing protection history of the validator database
+// older than a retention, so it does not grow without bound.
+package prune
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "db-prune")
+
+// Database is the subset of the validator database used for pruning.
+type Database interface {
+	PruneHistory(ctx context.Context, retentionEpochs uint64) (*kv.PruneResult, error)
+}
+
+// Config for the prune service.
+type Config struct {
+	DB              Database
+	Interval        time.Duration
+	RetentionEpochs uint64 // Defaults to the weak subjectivity period, which is also the minimum.
+}
+
+// Service prunes the history of the validator database every interval. The file is not compacted
+// while the validator runs, the space of the pruned values is reused by later writes instead.
+type Service struct {
+	ctx             context.Context
+	cancel          context.CancelFunc
+	db              Database
+	interval        time.Duration
+	retentionEpochs uint64
+	lock            sync.RWMutex
+	lastErr         error
+}
+
+// NewService creates a prune service, refusing a retention shorter than the weak subjectivity period.
+func NewService(ctx context.Context, cfg *Config) (*Service, error) {
+	if cfg.Interval <= 0 {
+		return nil, errors.New("prune interval must be greater than 0")
+	}
+	retentionEpochs := cfg.RetentionEpochs
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+	if retentionEpochs == 0 {
+		retentionEpochs = wsPeriod
+	}
+	if retentionEpochs < wsPeriod {
+		return nil, errors.Errorf(
+			"prune retention of %d epochs is less than the weak subjectivity period of %d epochs",
+			retentionEpochs, wsPeriod,
+		)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		ctx:             ctx,
+		cancel:          cancel,
+		db:              cfg.DB,
+		interval:        cfg.Interval,
+		retentionEpochs: retentionEpochs,
+	}, nil
+}
+
+// Start the prune loop.
+func (s *Service) Start() {
+	log.WithFields(logrus.Fields{
+		"interval":        s.interval,
+		"retentionEpochs": s.retentionEpochs,
+	}).Info("Scheduling validator database pruning")
+	go s.run()
+}
+
+// Stop the prune loop.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status returns the error of the latest pruning, if it failed.
+func (s *Service) Status() error {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.lastErr
+}
+
+func (s *Service) run() {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			err := s.prune(s.ctx)
+			if err != nil {
+				log.WithError(err).Error("Could not prune validator database")
+			}
+			s.lock.Lock()
+			s.lastErr = err
+			s.lock.Unlock()
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *Service) prune(ctx context.Context) error {
+	result, err := s.db.PruneHistory(ctx, s.retentionEpochs)
+	if err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"newestEpoch":          result.NewestEpoch,
+		"attestationEntries":   result.AttestationEntries,
+		"attestationHistories": result.AttestationHistories,
+		"proposals":            result.Proposals,
+	}).Debug("Pruned validator database history")
+	return nil
+}
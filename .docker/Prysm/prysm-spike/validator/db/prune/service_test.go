@@ -0,0 +1,71 @@
+package prune
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+)
+
+type fakeDB struct {
+	lock       sync.Mutex
+	retentions []uint64
+	err        error
+}
+
+func (f *fakeDB) PruneHistory(_ context.Context, retentionEpochs uint64) (*kv.PruneResult, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.retentions = append(f.retentions, retentionEpochs)
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &kv.PruneResult{}, nil
+}
+
+func (f *fakeDB) calls() []uint64 {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return append([]uint64{}, f.retentions...)
+}
+
+func TestNewService_Validation(t *testing.T) {
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+	_, err := NewService(context.Background(), &Config{DB: &fakeDB{}})
+	assert.ErrorContains(t, "interval must be greater than 0", err)
+	_, err = NewService(context.Background(), &Config{DB: &fakeDB{}, Interval: time.Hour, RetentionEpochs: wsPeriod - 1})
+	assert.ErrorContains(t, "less than the weak subjectivity period", err)
+
+	s, err := NewService(context.Background(), &Config{DB: &fakeDB{}, Interval: time.Hour})
+	require.NoError(t, err)
+	assert.Equal(t, wsPeriod, s.retentionEpochs)
+}
+
+func TestService_PrunesEveryInterval(t *testing.T) {
+	db := &fakeDB{err: errors.New("bad")}
+	s, err := NewService(context.Background(), &Config{
+		DB:              db,
+		Interval:        10 * time.Millisecond,
+		RetentionEpochs: 2 * params.BeaconConfig().WeakSubjectivityPeriod,
+	})
+	require.NoError(t, err)
+	s.Start()
+	defer func() {
+		require.NoError(t, s.Stop())
+	}()
+
+	deadline := time.Now().Add(time.Second)
+	for len(db.calls()) < 2 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	calls := db.calls()
+	require.Equal(t, true, len(calls) >= 2, "Expected the database to be pruned every interval")
+	assert.Equal(t, 2*params.BeaconConfig().WeakSubjectivityPeriod, calls[0])
+	assert.ErrorContains(t, "bad", s.Status())
+}
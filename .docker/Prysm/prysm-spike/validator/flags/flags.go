@@ -26,10 +26,20 @@ var (
 			"of validating keys may wish to disable granular prometheus metrics as it increases " +
 			"the data cardinality.",
 	}
+	// AccountMetricsLabelFlag defines how the public keys of validator accounts are shown in prometheus metrics.
+	AccountMetricsLabelFlag = &cli.StringFlag{
+		Name: "account-metrics-label",
+		Usage: "Value of the pubkey label of prometheus metrics for validator accounts: full for the public key, " +
+			"truncated for its first bytes, or hashed for a hash of the public key salted with a secret generated " +
+			"in the data directory. The mapping of hashed labels to public keys is exported with " +
+			"`validator accounts metrics-labels`.",
+		Value: "full",
+	}
 	// BeaconRPCProviderFlag defines a beacon node RPC endpoint.
 	BeaconRPCProviderFlag = &cli.StringFlag{
-		Name:  "beacon-rpc-provider",
-		Usage: "Beacon node RPC provider endpoint",
+		Name: "beacon-rpc-provider",
+		Usage: "Beacon node RPC provider endpoint. Several comma separated endpoints are probed every half " +
+			"slot, and the validator uses the first one in the list which is reachable and synced",
 		Value: "127.0.0.1:4000",
 	}
 	// BeaconRPCGatewayProviderFlag defines a beacon node JSON-RPC endpoint.
@@ -38,11 +48,29 @@ var (
 		Usage: "Beacon node RPC gateway provider endpoint",
 		Value: "127.0.0.1:3500",
 	}
+	// BeaconRESTAPIProviderFlag defines a beacon node standard REST API endpoint.
+	BeaconRESTAPIProviderFlag = &cli.StringFlag{
+		Name: "beacon-rest-api-provider",
+		Usage: "Beacon node standard REST API endpoint, such as http://127.0.0.1:3500. When set, aggregation " +
+			"duties use the standard /eth/v1/validator endpoints instead of the Prysm gRPC API",
+	}
 	// CertFlag defines a flag for the node's TLS certificate.
 	CertFlag = &cli.StringFlag{
 		Name:  "tls-cert",
 		Usage: "Certificate for secure gRPC. Pass this and the tls-key flag in order to use gRPC securely.",
 	}
+	// TLSAutoFlag enables secure gRPC to a beacon node serving an automatically issued certificate.
+	TLSAutoFlag = &cli.BoolFlag{
+		Name: "tls-auto",
+		Usage: "Connect to beacon nodes started with --tls-auto over TLS, verifying their certificate and presenting " +
+			"one issued by the certificate authority in tls-auto-ca-dir. Ignored when tls-cert is set.",
+	}
+	// TLSAutoCADirFlag defines the directory of the certificate authority issuing automatic certificates.
+	TLSAutoCADirFlag = &cli.StringFlag{
+		Name: "tls-auto-ca-dir",
+		Usage: "Directory of the certificate authority shared with the beacon nodes started with --tls-auto, " +
+			"e.g. through a docker compose volume. Defaults to tls/ca in the data directory.",
+	}
 	// EnableRPCFlag enables controlling the validator client via gRPC (without web UI).
 	EnableRPCFlag = &cli.BoolFlag{
 		Name:  "rpc",
@@ -61,12 +89,32 @@ var (
 		Usage: "RPC port exposed by a validator client",
 		Value: 7000,
 	}
+	// RPCMutationRateLimitFlag limits the requests changing the wallet or the settings through the RPC server.
+	RPCMutationRateLimitFlag = &cli.Uint64Flag{
+		Name: "rpc-mutation-rate-limit",
+		Usage: "Requests a minute allowed from each client address to each RPC endpoint changing the wallet, " +
+			"its keys or the settings, such as keystore imports and password changes. 0 disables the limit",
+		Value: 5,
+	}
+	// RPCAuditLogFlag defines a file recording the changes made through the RPC server.
+	RPCAuditLogFlag = &cli.StringFlag{
+		Name: "rpc-audit-log",
+		Usage: "File the changes made through the RPC server are appended to as JSON lines, with when, from which " +
+			"address and what was changed. Changes are always logged by the validator",
+	}
 	// SlasherRPCProviderFlag defines a slasher node RPC endpoint.
 	SlasherRPCProviderFlag = &cli.StringFlag{
-		Name:  "slasher-rpc-provider",
-		Usage: "Slasher node RPC provider endpoint",
+		Name: "slasher-rpc-provider",
+		Usage: "Slasher node RPC provider endpoint. Several slasher nodes are queried with a comma-separated list, " +
+			"where each endpoint may be given a weight in the quorum as address=weight, 1 by default",
 		Value: "127.0.0.1:4002",
 	}
+	// SlasherQuorumWeightFlag defines the weight of the slasher nodes required to sign a message.
+	SlasherQuorumWeightFlag = &cli.Uint64Flag{
+		Name: "slasher-quorum-weight",
+		Usage: "Total weight of the slasher nodes which must answer for a message to be signed. A single slasher " +
+			"node finding a message slashable is enough to refuse it. Defaults to more than half of the total weight",
+	}
 	// SlasherCertFlag defines a flag for the slasher node's TLS certificate.
 	SlasherCertFlag = &cli.StringFlag{
 		Name:  "slasher-tls-cert",
@@ -82,6 +130,145 @@ var (
 		Name:  "graffiti",
 		Usage: "String to include in proposed blocks",
 	}
+	// GraffitiFileFlag defines a file with the graffiti of each key, reloaded when it changes.
+	GraffitiFileFlag = &cli.StringFlag{
+		Name: "graffiti-file",
+		Usage: "Path to a YAML or JSON file mapping public keys to the graffiti of their proposed blocks, " +
+			"with a default for the other keys. Changes to the file apply without restarting. Keys " +
+			"without graffiti in the file use --graffiti",
+	}
+	// OrphanedBlockCheckDepthFlag defines how many slots to wait before checking whether a proposed block is canonical.
+	OrphanedBlockCheckDepthFlag = &cli.Uint64Flag{
+		Name: "orphaned-block-check-depth",
+		Usage: "Number of slots to wait after a proposal before checking that the proposed block is canonical " +
+			"on the beacon node. Set to 0 to disable orphaned block detection",
+		Value: 4,
+	}
+	// OrphanedBlockWebhookFlag defines an optional URL notified when a proposed block is orphaned.
+	OrphanedBlockWebhookFlag = &cli.StringFlag{
+		Name:  "orphaned-block-webhook-url",
+		Usage: "URL to send a JSON POST request to whenever one of the validator's proposed blocks is orphaned",
+	}
+	// KeyQuarantineThresholdFlag defines the number of consecutive signing anomalies quarantining a key.
+	KeyQuarantineThresholdFlag = &cli.Uint64Flag{
+		Name: "key-quarantine-threshold",
+		Usage: "Number of consecutive slashing protection rejections or signatures rejected by the beacon node " +
+			"after which a key is quarantined and performs no more duties until released with " +
+			"`validator accounts quarantine --release-public-keys`. Keys the slasher detects a slashable message of " +
+			"are quarantined at once. Set to 0 to only quarantine those",
+		Value: 3,
+	}
+	// KeyQuarantineWebhookFlag defines an optional URL notified when a key is quarantined.
+	KeyQuarantineWebhookFlag = &cli.StringFlag{
+		Name:  "key-quarantine-webhook-url",
+		Usage: "URL to send a JSON POST request to whenever one of the validator's keys is quarantined",
+	}
+	// DoppelgangerProtectionEpochsFlag defines the number of epochs the chain is watched before duties start.
+	DoppelgangerProtectionEpochsFlag = &cli.Uint64Flag{
+		Name: "doppelganger-protection-epochs",
+		Usage: "Number of epochs to watch the beacon chain for attestations and blocks signed with the " +
+			"validator's keys by another validator client before performing duties. The validator refuses " +
+			"to start when any are found. Set to 0 to start duties right away",
+	}
+	// DutiesBatchSizeFlag defines the maximum number of keys in each duties request.
+	DutiesBatchSizeFlag = &cli.Uint64Flag{
+		Name: "duties-batch-size",
+		Usage: "Maximum number of validator keys in each duties and committee subnet subscription request to " +
+			"the beacon node. Requests for more keys are split into batches sent in parallel, keeping responses " +
+			"under the gRPC message size limit. Set to 0 to send a single request for all keys",
+		Value: 512,
+	}
+	// DBBackupIntervalFlag defines how often the validator database is backed up.
+	DBBackupIntervalFlag = &cli.DurationFlag{
+		Name:  "db-backup-interval",
+		Usage: "Interval at which to back up the validator database while the validator runs, e.g. 6h. Backups are disabled when not set",
+	}
+	// DBBackupOutputDirFlag defines the directory validator database backups are written to.
+	DBBackupOutputDirFlag = &cli.StringFlag{
+		Name:  "db-backup-output-dir",
+		Usage: "Directory to write validator database backups to. Defaults to the backups directory of the validator database",
+	}
+	// DBBackupRetentionFlag defines the number of validator database backups kept.
+	DBBackupRetentionFlag = &cli.IntFlag{
+		Name:  "db-backup-retention",
+		Usage: "Number of most recent validator database backups to keep, locally and in S3. 0 keeps every backup",
+		Value: 24,
+	}
+	// DBBackupS3URLFlag defines an optional S3 compatible location validator database backups are uploaded to.
+	DBBackupS3URLFlag = &cli.StringFlag{
+		Name: "db-backup-s3-url",
+		Usage: "S3 compatible location to upload validator database backups to, in the form https://host[:port]/bucket[/prefix]. " +
+			"Credentials are read from the AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY environment variables",
+	}
+	// DBBackupS3RegionFlag defines the region used to sign requests to the S3 endpoint.
+	DBBackupS3RegionFlag = &cli.StringFlag{
+		Name:  "db-backup-s3-region",
+		Usage: "Region of the S3 endpoint validator database backups are uploaded to",
+		Value: "us-east-1",
+	}
+	// DBPruneIntervalFlag defines how often the slashing protection history of the validator database is pruned.
+	DBPruneIntervalFlag = &cli.DurationFlag{
+		Name: "db-prune-interval",
+		Usage: "Interval at which to prune the slashing protection history of the validator database while the " +
+			"validator runs, e.g. 24h. Pruning is disabled when not set",
+	}
+	// DBPruneRetentionEpochsFlag defines the number of epochs of slashing protection history kept when pruning.
+	DBPruneRetentionEpochsFlag = &cli.Uint64Flag{
+		Name: "db-prune-retention-epochs",
+		Usage: "Number of epochs of slashing protection history to keep when pruning the validator database, counted " +
+			"back from the newest epoch signed. Cannot be less than the weak subjectivity period, which is used when set to 0",
+	}
+	// DBEncryptionFlag enables encryption of the signing histories in the validator database.
+	DBEncryptionFlag = &cli.BoolFlag{
+		Name: "db-encryption",
+		Usage: "Encrypt the signing histories in the validator database with a key derived from the wallet password. " +
+			"Existing databases are encrypted on startup. Changing the wallet password afterwards makes the database " +
+			"unreadable, use --db-encryption-keyfile to keep the key independent of the wallet",
+	}
+	// DBEncryptionKeyFileFlag defines a file whose contents the validator database encryption key is derived from.
+	DBEncryptionKeyFileFlag = &cli.StringFlag{
+		Name:  "db-encryption-keyfile",
+		Usage: "Path to a file whose contents the validator database encryption key is derived from, instead of the wallet password. Implies --db-encryption",
+	}
+	// SlashingProtectionPoliciesFlag defines the path to a file of slashing protection policies.
+	SlashingProtectionPoliciesFlag = &cli.StringFlag{
+		Name: "slashing-protection-policies",
+		Usage: "Path to a YAML file of additional rules attestations and blocks have to satisfy before they are signed, " +
+			"such as a maximum distance from the finalized epoch",
+	}
+	// AttestationVerificationProviderFlag defines a secondary beacon node RPC endpoint attestation data is checked against.
+	AttestationVerificationProviderFlag = &cli.StringFlag{
+		Name: "attestation-verification-rpc-provider",
+		Usage: "Secondary beacon node RPC provider endpoint. The attestation data of the beacon node is checked against " +
+			"the attestation data of the secondary beacon node before signing, to catch a malfunctioning beacon node " +
+			"computing bad votes. The attestation data of the beacon node is signed when the secondary beacon node fails",
+	}
+	// AttestationVerificationCertFlag defines a flag for the secondary beacon node's TLS certificate.
+	AttestationVerificationCertFlag = &cli.StringFlag{
+		Name:  "attestation-verification-tls-cert",
+		Usage: "Certificate for secure gRPC connections to the secondary beacon node. Pass this and the key path in order to use gRPC securely",
+	}
+	// AttestationVerificationHeadToleranceFlag defines the number of slots the head blocks of the beacon nodes may differ.
+	AttestationVerificationHeadToleranceFlag = &cli.Uint64Flag{
+		Name: "attestation-verification-head-tolerance",
+		Usage: "Number of slots the head block voted for by the beacon nodes may differ, as a block may reach one " +
+			"beacon node before the other. The source and target checkpoints always have to match",
+		Value: 1,
+	}
+	// AttestationVerificationMismatchFlag defines what to do with attestation data not matching the secondary beacon node.
+	AttestationVerificationMismatchFlag = &cli.StringFlag{
+		Name: "attestation-verification-mismatch",
+		Usage: "What to do when the attestation data of the beacon nodes does not match: skip the attestation, " +
+			"or prefer-primary to sign the attestation data of the beacon node anyway and only log the mismatch",
+		Value: "skip",
+	}
+	// SubmissionSpreadFlag defines the duration bursts of submissions to the beacon node are spread over.
+	SubmissionSpreadFlag = &cli.DurationFlag{
+		Name: "submission-spread",
+		Usage: "Spread bursts of attestation and aggregate submissions to the beacon node over the duration, " +
+			"so a client running many keys does not overload a small beacon node. Block proposals are submitted first. " +
+			"At most a third of a slot, 0 submits everything right away",
+	}
 	// GrpcRetriesFlag defines the number of times to retry a failed gRPC request.
 	GrpcRetriesFlag = &cli.UintFlag{
 		Name:  "grpc-retries",
@@ -91,7 +278,7 @@ var (
 	// GrpcRetryDelayFlag defines the interval to retry a failed gRPC request.
 	GrpcRetryDelayFlag = &cli.DurationFlag{
 		Name:  "grpc-retry-delay",
-		Usage: "The amount of time between gRPC retry requests.",
+		Usage: "The base amount of time between gRPC retry requests, which grows exponentially with jitter on each retry.",
 		Value: 1 * time.Second,
 	}
 	// GrpcHeadersFlag defines a list of headers to send with all gRPC requests.
@@ -160,6 +347,28 @@ var (
 		Name:  "mnemonic-file",
 		Usage: "File to retrieve mnemonic for non-interactively passing a mnemonic phrase into wallet recover.",
 	}
+	// MnemonicLanguageFlag defines the wordlist language of the mnemonic of HD wallets.
+	MnemonicLanguageFlag = &cli.StringFlag{
+		Name: "mnemonic-language",
+		Usage: "Language of the mnemonic for creating or recovering HD wallets, one of english, chinese_simplified, " +
+			"chinese_traditional, french, italian, japanese, korean, spanish. A wallet must be recovered with the language it was created with",
+	}
+	// MnemonicSharesFlag defines the number of SLIP-39 shares to split the mnemonic of a new HD wallet into.
+	MnemonicSharesFlag = &cli.IntFlag{
+		Name: "mnemonic-shares",
+		Usage: "(Advanced) Number of SLIP-39 shares to split the mnemonic of a new HD wallet into, up to 16. " +
+			"The shares are displayed instead of the mnemonic and --mnemonic-threshold of them recover the wallet",
+	}
+	// MnemonicThresholdFlag defines the number of SLIP-39 shares needed to recover the mnemonic of a new HD wallet.
+	MnemonicThresholdFlag = &cli.IntFlag{
+		Name:  "mnemonic-threshold",
+		Usage: "(Advanced) Number of SLIP-39 shares needed to recover the mnemonic, required with --mnemonic-shares",
+	}
+	// MnemonicShareFilesFlag defines the files containing SLIP-39 shares to recover an HD wallet from.
+	MnemonicShareFilesFlag = &cli.StringSliceFlag{
+		Name:  "mnemonic-share-files",
+		Usage: "(Advanced) Comma-separated list of files each containing a SLIP-39 share of the mnemonic, recombined to recover an HD wallet",
+	}
 	// ShowDepositDataFlag for accounts.
 	ShowDepositDataFlag = &cli.BoolFlag{
 		Name:  "show-deposit-data",
@@ -172,6 +381,23 @@ var (
 		Usage: "Display the private keys for validator accounts",
 		Value: false,
 	}
+	// AccountsListOutputFlag defines the format accounts are listed in.
+	AccountsListOutputFlag = &cli.StringFlag{
+		Name: "output",
+		Usage: "Format of the listed accounts, one of text, json or csv. The json and csv formats write a " +
+			"machine-readable inventory of the accounts",
+		Value: "text",
+	}
+	// VerifyKeystoresFlag for accounts.
+	VerifyKeystoresFlag = &cli.BoolFlag{
+		Name:  "verify-keystores",
+		Usage: "Checks the key of each listed account decrypts from the wallet and signs for its public key",
+	}
+	// AccountTagsFlag defines a comma-separated list of tags recorded for imported accounts.
+	AccountTagsFlag = &cli.StringFlag{
+		Name:  "tags",
+		Usage: "Comma-separated list of tags recorded for the imported accounts, shown when listing accounts",
+	}
 	// NumAccountsFlag defines the amount of accounts to generate for derived wallets.
 	NumAccountsFlag = &cli.IntFlag{
 		Name:  "num-accounts",
@@ -206,6 +432,195 @@ var (
 		Usage: "Comma-separated list of public key hex strings to specify which validator accounts to backup",
 		Value: "",
 	}
+	// BackfillPublicKeysFlag defines a comma-separated list of hex string public keys
+	// for accounts whose attestation performance a user wants to backfill.
+	BackfillPublicKeysFlag = &cli.StringFlag{
+		Name:  "backfill-public-keys",
+		Usage: "Comma-separated list of public key hex strings to specify which validator accounts to backfill attestation performance for",
+		Value: "",
+	}
+	// BackfillEpochsFlag defines the number of finalized epochs to backfill attestation performance for.
+	BackfillEpochsFlag = &cli.Uint64Flag{
+		Name:  "backfill-epochs",
+		Usage: "Number of the most recent finalized epochs to backfill attestation performance for",
+		Value: 225,
+	}
+	// ComparePublicKeysFlag defines a comma-separated list of hex string public keys
+	// for accounts whose attestation performance a user wants to compare with the network.
+	ComparePublicKeysFlag = &cli.StringFlag{
+		Name:  "compare-public-keys",
+		Usage: "Comma-separated list of public key hex strings to specify which validator accounts to compare with the network",
+		Value: "",
+	}
+	// CompareEpochsFlag defines the number of finalized epochs to compare attestation performance over.
+	CompareEpochsFlag = &cli.Uint64Flag{
+		Name:  "compare-epochs",
+		Usage: "Number of the most recent finalized epochs to compare attestation performance over. Every validator of the network is evaluated, so large numbers take long",
+		Value: 10,
+	}
+	// ComparePerformanceJSONFlag outputs the performance comparison as JSON.
+	ComparePerformanceJSONFlag = &cli.BoolFlag{
+		Name:  "json",
+		Usage: "Writes the performance comparison as JSON instead of a table",
+	}
+	// WithdrawalCredentialsPublicKeysFlag defines a comma-separated list of hex string public keys
+	// for accounts whose withdrawal credentials a user wants to inspect.
+	WithdrawalCredentialsPublicKeysFlag = &cli.StringFlag{
+		Name:  "public-keys",
+		Usage: "Comma-separated list of public key hex strings to specify which validator accounts to inspect the withdrawal credentials of. Defaults to all accounts",
+		Value: "",
+	}
+	// ExecutionAddressFlag defines the execution address to change BLS withdrawal credentials to.
+	ExecutionAddressFlag = &cli.StringFlag{
+		Name:  "to-execution-address",
+		Usage: "Hex execution address to prepare withdrawal credential changes to, for the accounts with BLS withdrawal credentials",
+		Value: "",
+	}
+	// BLSWithdrawalPublicKeysFileFlag defines a file of the BLS withdrawal public keys of the accounts.
+	BLSWithdrawalPublicKeysFileFlag = &cli.StringFlag{
+		Name:  "bls-withdrawal-public-keys-file",
+		Usage: "Path to a file of the hex BLS withdrawal public keys of the accounts, one per line, to prepare withdrawal credential changes with",
+		Value: "",
+	}
+	// CredentialChangesOutputPathFlag defines the file prepared withdrawal credential changes are written to.
+	CredentialChangesOutputPathFlag = &cli.StringFlag{
+		Name:  "credential-changes-output-path",
+		Usage: "Path of the JSON file to write the prepared withdrawal credential changes to",
+		Value: "bls_to_execution_changes.json",
+	}
+	// WithBLSCredentialsFlag selects the accounts whose validators have BLS withdrawal credentials.
+	WithBLSCredentialsFlag = &cli.BoolFlag{
+		Name:  "with-bls-credentials",
+		Usage: "Only select the accounts whose validators have BLS withdrawal credentials, as known to the beacon node",
+	}
+	// WithEth1CredentialsFlag selects the accounts whose validators have eth1 address withdrawal credentials.
+	WithEth1CredentialsFlag = &cli.BoolFlag{
+		Name:  "with-eth1-credentials",
+		Usage: "Only select the accounts whose validators have eth1 address withdrawal credentials, as known to the beacon node",
+	}
+	// ValidatorStatusFilterFlag selects the accounts whose validators have one of the statuses.
+	ValidatorStatusFilterFlag = &cli.StringSliceFlag{
+		Name: "status",
+		Usage: "Only select the accounts whose validators have one of the statuses, as known to the beacon node: " +
+			"active, exited or pending. Accounts without a deposit processed by the beacon node have none of them",
+	}
+	// DepositDataPublicKeysFlag defines a comma-separated list of hex string public keys
+	// for accounts a user wants to create deposit data for.
+	DepositDataPublicKeysFlag = &cli.StringFlag{
+		Name:  "public-keys",
+		Usage: "Comma-separated list of public key hex strings to specify which validator accounts to create deposit data for. Defaults to all accounts",
+		Value: "",
+	}
+	// DepositAmountGweiFlag defines the amount of each deposit.
+	DepositAmountGweiFlag = &cli.Uint64Flag{
+		Name:  "deposit-amount-gwei",
+		Usage: "Amount of each deposit in gwei, at least the minimum deposit amount. Defaults to the maximum effective balance",
+		Value: 0,
+	}
+	// DepositWithdrawalAddressFlag defines the execution address deposits withdraw to.
+	DepositWithdrawalAddressFlag = &cli.StringFlag{
+		Name:  "withdrawal-address",
+		Usage: "Hex execution address the deposited accounts withdraw to, setting execution address withdrawal credentials",
+		Value: "",
+	}
+	// DepositBLSWithdrawalPublicKeyFlag defines the BLS withdrawal public key of deposits.
+	DepositBLSWithdrawalPublicKeyFlag = &cli.StringFlag{
+		Name:  "bls-withdrawal-public-key",
+		Usage: "Hex BLS withdrawal public key of the deposited accounts, setting BLS withdrawal credentials",
+		Value: "",
+	}
+	// DepositStartIndexFlag defines the index of the first account derived from a mnemonic for deposits.
+	DepositStartIndexFlag = &cli.IntFlag{
+		Name:  "start-index",
+		Usage: "Index of the first account to derive from the mnemonic, to create deposit data for the following --num-accounts accounts",
+		Value: 0,
+	}
+	// DepositDataOutputDirFlag defines the directory deposit data files are written to.
+	DepositDataOutputDirFlag = &cli.StringFlag{
+		Name:  "deposit-data-output-dir",
+		Usage: "Path to the directory to write the deposit_data file to",
+		Value: ".",
+	}
+	// DepositDataFormatFlag defines the format deposit data is written in.
+	DepositDataFormatFlag = &cli.StringFlag{
+		Name:  "format",
+		Usage: "Format of the deposit data, either json for the launchpad or csv",
+		Value: "json",
+	}
+	// MissedDutiesPublicKeysFlag defines a comma-separated list of hex string public keys
+	// for accounts whose missed duties a user wants to list.
+	MissedDutiesPublicKeysFlag = &cli.StringFlag{
+		Name:  "public-keys",
+		Usage: "Comma-separated list of public key hex strings to list the missed duties of. Defaults to all accounts",
+		Value: "",
+	}
+	// MissedDutyTypeFlag filters missed duties by their type.
+	MissedDutyTypeFlag = &cli.StringFlag{
+		Name:  "duty-type",
+		Usage: "Lists only the missed duties of a type, either attestation, proposal, or aggregation",
+		Value: "",
+	}
+	// MissedDutyFailureFlag filters missed duties by the classification of their failure.
+	MissedDutyFailureFlag = &cli.StringFlag{
+		Name: "failure",
+		Usage: "Lists only the missed duties of a failure, either beacon_node_unavailable, beacon_node_error, " +
+			"submission_rejected, slashing_protection, signing_failure, or missing_assignment",
+		Value: "",
+	}
+	// MissedDutiesStartEpochFlag defines the first epoch to list missed duties of.
+	MissedDutiesStartEpochFlag = &cli.Uint64Flag{
+		Name:  "start-epoch",
+		Usage: "First epoch to list missed duties of",
+		Value: 0,
+	}
+	// MissedDutiesEndEpochFlag defines the last epoch to list missed duties of.
+	MissedDutiesEndEpochFlag = &cli.Uint64Flag{
+		Name:  "end-epoch",
+		Usage: "Last epoch to list missed duties of, the most recent if not set",
+		Value: 0,
+	}
+	// MissedDutiesLimitFlag defines the maximum number of missed duties to list.
+	MissedDutiesLimitFlag = &cli.IntFlag{
+		Name:  "limit",
+		Usage: "Maximum number of the most recent missed duties to list, all if 0",
+		Value: 100,
+	}
+	// MissedDutiesJSONFlag outputs missed duties as JSON.
+	MissedDutiesJSONFlag = &cli.BoolFlag{
+		Name:  "json",
+		Usage: "Writes the missed duties as JSON instead of a table",
+	}
+	// QuarantineReleasePublicKeysFlag defines a comma-separated list of hex string public keys
+	// to release from quarantine.
+	QuarantineReleasePublicKeysFlag = &cli.StringFlag{
+		Name:  "release-public-keys",
+		Usage: "Comma-separated list of public key hex strings to release from quarantine, putting them back on duties",
+		Value: "",
+	}
+	// SlashingProtectionImportFileFlag defines the EIP-3076 interchange file to import slashing protection history from.
+	SlashingProtectionImportFileFlag = &cli.StringFlag{
+		Name:  "from",
+		Usage: "Path to an EIP-3076 slashing protection interchange JSON file to import",
+		Value: "",
+	}
+	// SlashingProtectionExportFileFlag defines the EIP-3076 interchange file to export slashing protection history to.
+	SlashingProtectionExportFileFlag = &cli.StringFlag{
+		Name:  "to",
+		Usage: "Path to write the EIP-3076 slashing protection interchange JSON file to",
+		Value: "",
+	}
+	// MaintenanceWindowSlotsFlag defines the minimum length of a maintenance window in slots.
+	MaintenanceWindowSlotsFlag = &cli.Uint64Flag{
+		Name:  "min-slots",
+		Usage: "Minimum number of slots without proposer or aggregator duties a maintenance window must span",
+		Value: 8,
+	}
+	// MaintenanceWindowWaitFlag blocks until the next maintenance window starts.
+	MaintenanceWindowWaitFlag = &cli.BoolFlag{
+		Name: "wait",
+		Usage: "Blocks until the next maintenance window starts before exiting, for restart scripts " +
+			"such as: validator accounts maintenance-window --wait && systemctl restart validator",
+	}
 	// VoluntaryExitPublicKeysFlag defines a comma-separated list of hex string public keys
 	// for accounts on which a user wants to perform a voluntary exit.
 	VoluntaryExitPublicKeysFlag = &cli.StringFlag{
@@ -258,10 +673,16 @@ var (
 		Usage: "/path/to/ca.crt for establishing a secure, TLS gRPC connection to a remote signer server",
 		Value: "",
 	}
+	// Web3SignerURLFlag defines the URLs of the Web3Signer servers of a web3signer keymanager.
+	Web3SignerURLFlag = &cli.StringSliceFlag{
+		Name: "web3signer-url",
+		Usage: "URL of a Web3Signer server for a web3signer keymanager, such as https://web3signer.example.com:9000. " +
+			"Can be set several times. Each url must be https, and the --remote-signer-* certificates are used for each of them",
+	}
 	// KeymanagerKindFlag defines the kind of keymanager desired by a user during wallet creation.
 	KeymanagerKindFlag = &cli.StringFlag{
 		Name:  "keymanager-kind",
-		Usage: "Kind of keymanager, either imported, derived, or remote, specified during wallet creation",
+		Usage: "Kind of keymanager, either imported, derived, remote, or web3signer, specified during wallet creation",
 		Value: "",
 	}
 	// SkipDepositConfirmationFlag skips the y/n confirmation prompt for sending a deposit to the deposit contract.
@@ -0,0 +1,103 @@
+// Package graffiti defines the operator configured graffiti of the blocks proposed by each of the
+// validator's keys. The graffiti file maps public keys to their graffiti, with a default for the
+// other keys, and is reloaded whenever it changes so graffiti can be updated without restarting
+// the validator client.
+package graffiti
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"gopkg.in/yaml.v2"
+)
+
+// maxGraffitiLength is the size of the graffiti field of a beacon block.
+const maxGraffitiLength = 32
+
+// Config is the content of a graffiti file, in YAML or JSON.
+type Config struct {
+	Default    string            `yaml:"default"`
+	PublicKeys map[string]string `yaml:"public_keys"`
+}
+
+// Load reads a graffiti file and returns the graffiti by public key, along with the default
+// graffiti.
+func Load(path string) (map[[48]byte][]byte, []byte, error) {
+	enc, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not read graffiti file")
+	}
+	cfg := &Config{}
+	if err := yaml.UnmarshalStrict(enc, cfg); err != nil {
+		return nil, nil, errors.Wrap(err, "could not parse graffiti file")
+	}
+	if len(cfg.Default) > maxGraffitiLength {
+		return nil, nil, fmt.Errorf("default graffiti is longer than %d bytes", maxGraffitiLength)
+	}
+	graffitiByPubKey := make(map[[48]byte][]byte, len(cfg.PublicKeys))
+	for key, graffiti := range cfg.PublicKeys {
+		pubKey, err := hex.DecodeString(strings.TrimPrefix(key, "0x"))
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "could not decode public key %s", key)
+		}
+		if len(pubKey) != 48 {
+			return nil, nil, fmt.Errorf("public key %s has wrong length", key)
+		}
+		if len(graffiti) > maxGraffitiLength {
+			return nil, nil, fmt.Errorf("graffiti of public key %s is longer than %d bytes", key, maxGraffitiLength)
+		}
+		graffitiByPubKey[bytesutil.ToBytes48(pubKey)] = []byte(graffiti)
+	}
+	var defaultGraffiti []byte
+	if cfg.Default != "" {
+		defaultGraffiti = []byte(cfg.Default)
+	}
+	return graffitiByPubKey, defaultGraffiti, nil
+}
+
+// File holds the latest valid content of a graffiti file.
+type File struct {
+	path             string
+	lock             sync.RWMutex
+	graffitiByPubKey map[[48]byte][]byte
+	defaultGraffiti  []byte
+}
+
+// NewFile loads the graffiti file at the path.
+func NewFile(path string) (*File, error) {
+	f := &File{path: path}
+	if err := f.reload(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// Graffiti returns the graffiti of the public key, or the default graffiti of the file for keys
+// without one. It returns false when the file has neither.
+func (f *File) Graffiti(pubKey [48]byte) ([]byte, bool) {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+	if graffiti, ok := f.graffitiByPubKey[pubKey]; ok {
+		return graffiti, true
+	}
+	return f.defaultGraffiti, f.defaultGraffiti != nil
+}
+
+// reload replaces the graffiti with the content of the file. The current graffiti are kept when
+// the file is not valid.
+func (f *File) reload() error {
+	graffitiByPubKey, defaultGraffiti, err := Load(f.path)
+	if err != nil {
+		return err
+	}
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.graffitiByPubKey = graffitiByPubKey
+	f.defaultGraffiti = defaultGraffiti
+	return nil
+}
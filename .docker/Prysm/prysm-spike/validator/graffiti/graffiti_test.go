@@ -0,0 +1,96 @@
+package graffiti
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func writeFile(t *testing.T, path, content string) {
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), 0600))
+}
+
+func TestLoad(t *testing.T) {
+	pubKey := [48]byte{1}
+	path := filepath.Join(t.TempDir(), "graffiti.yaml")
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{"yaml", fmt.Sprintf("default: pool\npublic_keys:\n  %#x: solo\n", pubKey), ""},
+		{"json", fmt.Sprintf(`{"default": "pool", "public_keys": {"%#x": "solo"}}`, pubKey), ""},
+		{"unknown field", "defaults: pool\n", "could not parse graffiti file"},
+		{"short key", "public_keys:\n  0x01: solo\n", "has wrong length"},
+		{"long graffiti", "default: 123456789012345678901234567890123\n", "longer than 32 bytes"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			writeFile(t, path, tt.content)
+			graffitiByPubKey, defaultGraffiti, err := Load(path)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "pool", string(defaultGraffiti))
+			assert.Equal(t, "solo", string(graffitiByPubKey[pubKey]))
+		})
+	}
+}
+
+func TestFile_Graffiti(t *testing.T) {
+	pubKey := [48]byte{1}
+	path := filepath.Join(t.TempDir(), "graffiti.yaml")
+	writeFile(t, path, fmt.Sprintf("public_keys:\n  %#x: solo\n", pubKey))
+	f, err := NewFile(path)
+	require.NoError(t, err)
+	graffiti, ok := f.Graffiti(pubKey)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, "solo", string(graffiti))
+	_, ok = f.Graffiti([48]byte{2})
+	assert.Equal(t, false, ok, "Keys have no graffiti without a default")
+
+	writeFile(t, path, "default: pool\n")
+	require.NoError(t, f.reload())
+	graffiti, ok = f.Graffiti(pubKey)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, "pool", string(graffiti))
+
+	writeFile(t, path, "default: [pool]\n")
+	assert.ErrorContains(t, "could not parse graffiti file", f.reload())
+	graffiti, _ = f.Graffiti(pubKey)
+	assert.Equal(t, "pool", string(graffiti), "Graffiti are kept when the file is not valid")
+}
+
+func TestFile_Watch(t *testing.T) {
+	interval := debounceFileChangesInterval
+	debounceFileChangesInterval = 10 * time.Millisecond
+	defer func() {
+		debounceFileChangesInterval = interval
+	}()
+	path := filepath.Join(t.TempDir(), "graffiti.yaml")
+	writeFile(t, path, "default: before\n")
+	f, err := NewFile(path)
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go f.Watch(ctx)
+	// Give the watcher time to start.
+	time.Sleep(100 * time.Millisecond)
+
+	writeFile(t, path, "default: after\n")
+	for i := 0; i < 100; i++ {
+		if graffiti, _ := f.Graffiti([48]byte{}); string(graffiti) == "after" {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("Graffiti file was not reloaded")
+}
@@ -0,0 +1,64 @@
+package graffiti
+
+import (
+	"context"
+	"path/filepath"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/prysmaticlabs/prysm/shared/asyncutil"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "graffiti")
+
+var (
+	debounceFileChangesInterval = time.Second
+)
+
+// Watch reloads the graffiti file whenever it changes, until the context is canceled. The
+// directory of the file is watched rather than the file itself, as editors usually replace a file
+// instead of writing to it. Changes leaving the file invalid are logged and ignored.
+func (f *File) Watch(ctx context.Context) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		log.WithError(err).Error("Could not initialize file watcher")
+		return
+	}
+	defer func() {
+		if err := watcher.Close(); err != nil {
+			log.WithError(err).Error("Could not close file watcher")
+		}
+	}()
+	dir := filepath.Dir(f.path)
+	if err := watcher.Add(dir); err != nil {
+		log.WithError(err).Errorf("Could not add directory %s to file watcher", dir)
+		return
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	fileChangesChan := make(chan interface{}, 100)
+	defer close(fileChangesChan)
+
+	// Editors fire several events for a single save, the file is reloaded once they settle.
+	go asyncutil.Debounce(ctx, debounceFileChangesInterval, fileChangesChan, func(_ interface{}) {
+		if err := f.reload(); err != nil {
+			log.WithError(err).Errorf("Could not reload graffiti file %s, keeping the current graffiti", f.path)
+			return
+		}
+		log.WithField("path", f.path).Info("Reloaded graffiti file")
+	})
+	for {
+		select {
+		case event := <-watcher.Events:
+			if filepath.Clean(event.Name) != filepath.Clean(f.path) {
+				continue
+			}
+			fileChangesChan <- event
+		case err := <-watcher.Errors:
+			log.WithError(err).Errorf("Could not watch for file changes for: %s", f.path)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -25,6 +25,9 @@ const (
 	// keys for Prysm eth2 validators. According to EIP-2334, the format is as follows:
 	// m / purpose / coin_type / account_index / withdrawal_key / validating_key
 	ValidatingKeyDerivationPathTemplate = "m/12381/3600/%d/0/0"
+	// WithdrawalKeyDerivationPathTemplate defining the hierarchical path for the withdrawal
+	// key of each account, the parent of its validating key according to EIP-2334.
+	WithdrawalKeyDerivationPathTemplate = "m/12381/3600/%d/0"
 )
 
 // SetupConfig includes configuration values for initializing
@@ -79,6 +82,41 @@ func (dr *Keymanager) RecoverAccountsFromMnemonic(
 	return dr.importedKM.ImportKeypairs(ctx, privKeys, pubKeys)
 }
 
+// DeriveAccountKeys derives the validating and withdrawal keys of numAccounts accounts from a
+// mnemonic, starting at the account index startIndex. The validating keys are the keys
+// RecoverAccountsFromMnemonic recovers for the same indices.
+func DeriveAccountKeys(
+	mnemonic, mnemonicPassphrase string, startIndex, numAccounts int,
+) ([]bls.SecretKey, []bls.SecretKey, error) {
+	seed, err := seedFromMnemonic(mnemonic, mnemonicPassphrase)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not derive seed from mnemonic")
+	}
+	validatingKeys := make([]bls.SecretKey, numAccounts)
+	withdrawalKeys := make([]bls.SecretKey, numAccounts)
+	for i := 0; i < numAccounts; i++ {
+		validatingKey, err := util.PrivateKeyFromSeedAndPath(
+			seed, fmt.Sprintf(ValidatingKeyDerivationPathTemplate, startIndex+i),
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+		withdrawalKey, err := util.PrivateKeyFromSeedAndPath(
+			seed, fmt.Sprintf(WithdrawalKeyDerivationPathTemplate, startIndex+i),
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+		if validatingKeys[i], err = bls.SecretKeyFromBytes(validatingKey.Marshal()); err != nil {
+			return nil, nil, err
+		}
+		if withdrawalKeys[i], err = bls.SecretKeyFromBytes(withdrawalKey.Marshal()); err != nil {
+			return nil, nil, err
+		}
+	}
+	return validatingKeys, withdrawalKeys, nil
+}
+
 // ExtractKeystores retrieves the secret keys for specified public keys
 // in the function input, encrypts them using the specified password,
 // and returns their respective EIP-2335 keystores.
@@ -108,6 +108,28 @@ func TestDerivedKeymanager_FetchValidatingPublicKeys(t *testing.T) {
 	}
 }
 
+func TestDeriveAccountKeys(t *testing.T) {
+	sampleMnemonic := "tumble turn jewel sudden social great water general cabin jacket bounce dry flip monster advance problem social half flee inform century chicken hard reason"
+	derivedSeed, err := seedFromMnemonic(sampleMnemonic, "")
+	require.NoError(t, err)
+
+	validatingKeys, withdrawalKeys, err := DeriveAccountKeys(sampleMnemonic, "", 3, 2)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(validatingKeys))
+	require.Equal(t, 2, len(withdrawalKeys))
+	for i := 0; i < 2; i++ {
+		validatingKey, err := util.PrivateKeyFromSeedAndPath(derivedSeed, fmt.Sprintf(ValidatingKeyDerivationPathTemplate, 3+i))
+		require.NoError(t, err)
+		withdrawalKey, err := util.PrivateKeyFromSeedAndPath(derivedSeed, fmt.Sprintf(WithdrawalKeyDerivationPathTemplate, 3+i))
+		require.NoError(t, err)
+		assert.DeepEqual(t, validatingKey.Marshal(), validatingKeys[i].Marshal())
+		assert.DeepEqual(t, withdrawalKey.Marshal(), withdrawalKeys[i].Marshal())
+	}
+
+	_, _, err = DeriveAccountKeys("not a mnemonic", "", 0, 1)
+	assert.ErrorContains(t, "could not derive seed from mnemonic", err)
+}
+
 func TestDerivedKeymanager_FetchValidatingPrivateKeys(t *testing.T) {
 	sampleMnemonic := "tumble turn jewel sudden social great water general cabin jacket bounce dry flip monster advance problem social half flee inform century chicken hard reason"
 	derivedSeed, err := seedFromMnemonic(sampleMnemonic, "")
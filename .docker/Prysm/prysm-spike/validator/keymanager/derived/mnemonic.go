@@ -3,15 +3,56 @@ package derived
 import (
 	"fmt"
 	"os"
+	"sort"
+	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/shared/promptutil"
 	"github.com/prysmaticlabs/prysm/shared/rand"
+	"github.com/prysmaticlabs/prysm/shared/slip39"
 	"github.com/tyler-smith/go-bip39"
+	"github.com/tyler-smith/go-bip39/wordlists"
 )
 
 const confirmationText = "Confirm you have written down the recovery words somewhere safe (offline) [y|Y]"
 
+// MnemonicLanguages maps the names of the supported mnemonic languages to their wordlists.
+var MnemonicLanguages = map[string][]string{
+	"english":             wordlists.English,
+	"chinese_simplified":  wordlists.ChineseSimplified,
+	"chinese_traditional": wordlists.ChineseTraditional,
+	"french":              wordlists.French,
+	"italian":             wordlists.Italian,
+	"japanese":            wordlists.Japanese,
+	"korean":              wordlists.Korean,
+	"spanish":             wordlists.Spanish,
+}
+
+// MnemonicLanguageNames returns the sorted names of the supported mnemonic languages.
+func MnemonicLanguageNames() []string {
+	languages := make([]string, 0, len(MnemonicLanguages))
+	for k := range MnemonicLanguages {
+		languages = append(languages, k)
+	}
+	sort.Strings(languages)
+	return languages
+}
+
+// SetMnemonicLanguage sets the language of the mnemonics generated and validated from now on.
+// Since the words of a mnemonic are its seed input, a wallet has to be recovered with the
+// language it was created with.
+func SetMnemonicLanguage(language string) error {
+	wordlist, ok := MnemonicLanguages[language]
+	if !ok {
+		return fmt.Errorf(
+			"unsupported mnemonic language %s, expected one of %s",
+			language, strings.Join(MnemonicLanguageNames(), ", "),
+		)
+	}
+	bip39.SetWordList(wordlist)
+	return nil
+}
+
 // EnglishMnemonicGenerator implements methods for creating
 // mnemonic seed phrases in english using a given
 // source of entropy such as a private key.
@@ -39,6 +80,52 @@ func GenerateAndConfirmMnemonic(
 	return phrase, nil
 }
 
+// GenerateAndConfirmMnemonicShares generates a mnemonic and splits it into SLIP-39 shares,
+// any threshold of which recover the mnemonic. Only the shares are displayed to the user.
+func GenerateAndConfirmMnemonicShares(
+	skipMnemonicConfirm bool, threshold, count int,
+) (string, error) {
+	mnemonicRandomness := make([]byte, 32)
+	if _, err := rand.NewGenerator().Read(mnemonicRandomness); err != nil {
+		return "", errors.Wrap(err, "could not initialize mnemonic source of randomness")
+	}
+	m := &EnglishMnemonicGenerator{
+		skipMnemonicConfirm: skipMnemonicConfirm,
+	}
+	phrase, err := m.Generate(mnemonicRandomness)
+	if err != nil {
+		return "", errors.Wrap(err, "could not generate wallet seed")
+	}
+	shares, err := SplitMnemonic(phrase, threshold, count)
+	if err != nil {
+		return "", errors.Wrap(err, "could not split mnemonic into shares")
+	}
+	if err := m.ConfirmSharesAcknowledgement(shares, threshold); err != nil {
+		return "", errors.Wrap(err, "could not confirm mnemonic shares acknowledgement")
+	}
+	return phrase, nil
+}
+
+// SplitMnemonic splits the entropy of a mnemonic into count SLIP-39 shares, any threshold of
+// which recover the mnemonic with MnemonicFromShares.
+func SplitMnemonic(mnemonic string, threshold, count int) ([]string, error) {
+	entropy, err := bip39.EntropyFromMnemonic(mnemonic)
+	if err != nil {
+		return nil, err
+	}
+	return slip39.Split(entropy, "" /* passphrase */, threshold, count)
+}
+
+// MnemonicFromShares recombines SLIP-39 shares created by SplitMnemonic into the mnemonic, in
+// the current mnemonic language.
+func MnemonicFromShares(shares []string) (string, error) {
+	entropy, err := slip39.Combine(shares, "" /* passphrase */)
+	if err != nil {
+		return "", err
+	}
+	return bip39.NewMnemonic(entropy)
+}
+
 // Generate a mnemonic seed phrase in english using a source of
 // entropy given as raw bytes.
 func (m *EnglishMnemonicGenerator) Generate(data []byte) (string, error) {
@@ -71,6 +158,35 @@ func (m *EnglishMnemonicGenerator) ConfirmAcknowledgement(phrase string) error {
 	return nil
 }
 
+// ConfirmSharesAcknowledgement displays the mnemonic shares to the user and confirms the user
+// has written down every share securely offline.
+func (m *EnglishMnemonicGenerator) ConfirmSharesAcknowledgement(shares []string, threshold int) error {
+	log.Infof(
+		"Write down each of the %d shares below and store them apart, any %d of them "+
+			"are your only means of recovering your wallet",
+		len(shares), threshold,
+	)
+	for i, share := range shares {
+		fmt.Printf(
+			`=================Wallet Seed Recovery Share %d/%d==================
+
+%s
+
+===================================================================`,
+			i+1, len(shares), share)
+		fmt.Println("")
+	}
+	if m.skipMnemonicConfirm {
+		return nil
+	}
+	// Confirm the user has written down the shares offline.
+	_, err := promptutil.ValidatePrompt(os.Stdin, confirmationText, promptutil.ValidateConfirmation)
+	if err != nil {
+		log.Errorf("Could not confirm acknowledgement of prompt, please enter y")
+	}
+	return nil
+}
+
 //Uses the provided mnemonic seed phrase to generate the
 //appropriate seed file for recovering a derived wallets.
 func seedFromMnemonic(mnemonic, mnemonicPassphrase string) ([]byte, error) {
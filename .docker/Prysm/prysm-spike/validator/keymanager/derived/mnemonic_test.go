@@ -18,3 +18,38 @@ func TestMnemonic_Generate_CanRecover(t *testing.T) {
 	require.NoError(t, err)
 	assert.DeepEqual(t, data, entropy, "Expected to recover original data")
 }
+
+func TestSplitMnemonic_CanRecoverFromShares(t *testing.T) {
+	generator := &EnglishMnemonicGenerator{}
+	data := make([]byte, 32)
+	copy(data, "hello-world")
+	phrase, err := generator.Generate(data)
+	require.NoError(t, err)
+	shares, err := SplitMnemonic(phrase, 2, 3)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(shares))
+
+	recovered, err := MnemonicFromShares([]string{shares[2], shares[0]})
+	require.NoError(t, err)
+	assert.Equal(t, phrase, recovered)
+	_, err = MnemonicFromShares(shares[:1])
+	assert.ErrorContains(t, "insufficient mnemonic shares", err)
+}
+
+func TestSetMnemonicLanguage(t *testing.T) {
+	defer bip39.SetWordList(MnemonicLanguages["english"])
+	data := make([]byte, 32)
+	copy(data, "hello-world")
+	english, err := bip39.NewMnemonic(data)
+	require.NoError(t, err)
+
+	require.NoError(t, SetMnemonicLanguage("spanish"))
+	spanish, err := bip39.NewMnemonic(data)
+	require.NoError(t, err)
+	assert.NotEqual(t, english, spanish)
+	entropy, err := bip39.EntropyFromMnemonic(spanish)
+	require.NoError(t, err)
+	assert.DeepEqual(t, data, entropy)
+
+	assert.ErrorContains(t, "unsupported mnemonic language", SetMnemonicLanguage("klingon"))
+}
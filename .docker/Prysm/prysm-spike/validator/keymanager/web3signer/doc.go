@@ -0,0 +1,35 @@
+/*
+Package web3signer defines a keymanager implementation which signs with keys held by
+one or more Web3Signer servers, through their eth2 HTTP API. Public keys are listed with
+
+ GET /api/v1/eth2/publicKeys
+
+and each signing request is sent to the server holding the key with
+
+ POST /api/v1/eth2/sign/{public key}
+
+along with the object to sign, its signing root and the fork info of the chain, so
+Web3Signer can verify the signing root and apply its own slashing protection before
+signing. Requests denied by that slashing protection are answered with 412.
+
+Servers are only reached over HTTPS and authenticated with a CA certificate, and the
+validator client authenticates with a client certificate (TLS client auth), which is
+required as any client reaching a server may sign with its keys. The public keys are
+cached for a minute, and listed again at most every 12 seconds for signing requests of
+unknown keys. Each server has its own certificates, configured in a keymanageropts.json
+file with the following schema:
+
+ {
+   "signers": [
+     {
+       "url": "https://web3signer.example.com:9000", // Web3Signer base URL.
+       "remote_cert": {
+         "crt_path": "/home/eth2/certs/client.crt", // Client certificate path.
+         "ca_crt_path": "/home/eth2/certs/ca.crt",  // Certificate authority cert path.
+         "key_path": "/home/eth2/certs/client.key", // Client key path.
+       }
+     }
+   ]
+ }
+*/
+package web3signer
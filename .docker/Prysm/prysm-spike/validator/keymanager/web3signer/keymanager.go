@@ -0,0 +1,381 @@
+package web3signer
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/logrusorgru/aurora"
+	"github.com/pkg/errors"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/remote"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	publicKeysPath = "/api/v1/eth2/publicKeys"
+	signPath       = "/api/v1/eth2/sign/"
+	requestTimeout = 10 * time.Second
+)
+
+var (
+	// publicKeysCacheDuration is how long the public keys listed by the servers are cached, as the
+	// validator client fetches them for each of its duties.
+	publicKeysCacheDuration = time.Minute
+	// minPublicKeysRefreshInterval is the minimum interval between two listings of the public keys,
+	// so signing requests for keys the servers do not serve cannot flood them.
+	minPublicKeysRefreshInterval = 12 * time.Second
+)
+
+var (
+	log = logrus.WithField("prefix", "web3signer-keymanager")
+	// ErrSigningFailed defines a failure from Web3Signer when performing a signing operation.
+	ErrSigningFailed = errors.New("signing failed in web3signer")
+	// ErrSigningDenied defines a signing operation refused by the slashing protection of Web3Signer.
+	ErrSigningDenied = errors.New("signing request was denied by web3signer slashing protection")
+	// ErrNoGenesisValidatorsRoot defines a signing operation requested before the genesis
+	// validators root of the chain is known, which Web3Signer needs to compute signing roots.
+	ErrNoGenesisValidatorsRoot = errors.New("genesis validators root is not known yet")
+)
+
+// KeymanagerOpts for a web3signer keymanager.
+type KeymanagerOpts struct {
+	Signers []*SignerConfig `json:"signers"`
+}
+
+// SignerConfig defines the URL of a Web3Signer server along with the certificates
+// used to authenticate to it over HTTPS.
+type SignerConfig struct {
+	URL               string                    `json:"url"`
+	RemoteCertificate *remote.CertificateConfig `json:"remote_cert,omitempty"`
+}
+
+// SetupConfig includes configuration values for initializing a web3signer keymanager.
+type SetupConfig struct {
+	Opts *KeymanagerOpts
+}
+
+// Keymanager implementation using remote signing keys via the Web3Signer HTTP API.
+type Keymanager struct {
+	opts                  *KeymanagerOpts
+	signers               []*signer
+	refreshLock           sync.Mutex
+	lock                  sync.RWMutex
+	pubKeys               [][48]byte
+	pubKeysFetched        time.Time
+	signersByPubKey       map[[48]byte]*signer
+	genesisValidatorsRoot []byte
+}
+
+// signer is a client of a single Web3Signer server.
+type signer struct {
+	url    string
+	client *http.Client
+}
+
+// NewKeymanager instantiates a new web3signer keymanager from configuration options.
+func NewKeymanager(_ context.Context, cfg *SetupConfig) (*Keymanager, error) {
+	if cfg.Opts == nil || len(cfg.Opts.Signers) == 0 {
+		return nil, errors.New("at least one web3signer url is required")
+	}
+	signers := make([]*signer, len(cfg.Opts.Signers))
+	for i, signerCfg := range cfg.Opts.Signers {
+		s, err := newSigner(signerCfg)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not set up web3signer %s", signerCfg.URL)
+		}
+		signers[i] = s
+	}
+	return &Keymanager{
+		opts:            cfg.Opts,
+		signers:         signers,
+		signersByPubKey: make(map[[48]byte]*signer),
+	}, nil
+}
+
+// newSigner returns a client of the Web3Signer server at the URL of the configuration. Servers are
+// only reached over HTTPS, verified with the CA certificate if given, and the client authenticates
+// with its certificate, as any client reaching a server may sign with its keys.
+func newSigner(cfg *SignerConfig) (*signer, error) {
+	u, err := url.Parse(cfg.URL)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid url")
+	}
+	if u.Scheme != "https" {
+		return nil, fmt.Errorf("url scheme must be https, not %q", u.Scheme)
+	}
+	certs := cfg.RemoteCertificate
+	if certs == nil || certs.ClientCertPath == "" {
+		return nil, errors.New("client certificate is required")
+	}
+	if certs.ClientKeyPath == "" {
+		return nil, errors.New("client key is required")
+	}
+	clientPair, err := tls.LoadX509KeyPair(certs.ClientCertPath, certs.ClientKeyPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain client's certificate and/or key")
+	}
+	tlsCfg := &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{clientPair},
+	}
+	if certs.CACertPath != "" {
+		serverCA, err := ioutil.ReadFile(certs.CACertPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to obtain server's CA certificate")
+		}
+		cp := x509.NewCertPool()
+		if !cp.AppendCertsFromPEM(serverCA) {
+			return nil, errors.New("failed to add server's CA certificate to pool")
+		}
+		tlsCfg.RootCAs = cp
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsCfg
+	return &signer{
+		url:    strings.TrimRight(cfg.URL, "/"),
+		client: &http.Client{Transport: transport, Timeout: requestTimeout},
+	}, nil
+}
+
+// UnmarshalOptionsFile attempts to JSON unmarshal a keymanager
+// options file into a struct.
+func UnmarshalOptionsFile(r io.ReadCloser) (*KeymanagerOpts, error) {
+	enc, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read config")
+	}
+	defer func() {
+		if err := r.Close(); err != nil {
+			log.Errorf("Could not close keymanager config file: %v", err)
+		}
+	}()
+	opts := &KeymanagerOpts{}
+	if err := json.Unmarshal(enc, opts); err != nil {
+		return nil, errors.Wrap(err, "could not JSON unmarshal")
+	}
+	return opts, nil
+}
+
+// MarshalOptionsFile for the keymanager.
+func MarshalOptionsFile(_ context.Context, cfg *KeymanagerOpts) ([]byte, error) {
+	return json.MarshalIndent(cfg, "", "\t")
+}
+
+// String pretty-print of web3signer keymanager options.
+func (opts *KeymanagerOpts) String() string {
+	au := aurora.NewAurora(true)
+	var b strings.Builder
+	for _, s := range opts.Signers {
+		lines := []string{fmt.Sprintf("%s: %s\n", au.BrightMagenta("Web3Signer URL"), s.URL)}
+		if s.RemoteCertificate != nil {
+			lines = append(lines,
+				fmt.Sprintf("%s: %s\n", au.BrightMagenta("Client cert path"), s.RemoteCertificate.ClientCertPath),
+				fmt.Sprintf("%s: %s\n", au.BrightMagenta("Client key path"), s.RemoteCertificate.ClientKeyPath),
+				fmt.Sprintf("%s: %s\n", au.BrightMagenta("CA cert path"), s.RemoteCertificate.CACertPath),
+			)
+		}
+		for _, line := range lines {
+			if _, err := b.WriteString(line); err != nil {
+				log.Error(err)
+				return ""
+			}
+		}
+	}
+	return b.String()
+}
+
+// KeymanagerOpts for the web3signer keymanager.
+func (k *Keymanager) KeymanagerOpts() *KeymanagerOpts {
+	return k.opts
+}
+
+// SetGenesisValidatorsRoot sets the genesis validators root of the chain, which is sent along with
+// the signing requests for Web3Signer to compute their signing domains.
+func (k *Keymanager) SetGenesisValidatorsRoot(root []byte) {
+	k.lock.Lock()
+	defer k.lock.Unlock()
+	k.genesisValidatorsRoot = root
+}
+
+// FetchValidatingPublicKeys fetches the public keys of all Web3Signer servers. Keys served by
+// several servers are signed with by the first one configured. The keys are cached for
+// publicKeysCacheDuration.
+func (k *Keymanager) FetchValidatingPublicKeys(ctx context.Context) ([][48]byte, error) {
+	return k.publicKeys(ctx, publicKeysCacheDuration)
+}
+
+// publicKeys returns the cached public keys of the servers, listing them again when they were
+// fetched more than maxAge ago.
+func (k *Keymanager) publicKeys(ctx context.Context, maxAge time.Duration) ([][48]byte, error) {
+	// Concurrent refreshes wait for the first one, and return the keys it fetched.
+	k.refreshLock.Lock()
+	defer k.refreshLock.Unlock()
+	k.lock.RLock()
+	pubKeys, fetched := k.pubKeys, k.pubKeysFetched
+	k.lock.RUnlock()
+	if !fetched.IsZero() && timeutils.Since(fetched) < maxAge {
+		return append([][48]byte(nil), pubKeys...), nil
+	}
+
+	pubKeys = nil
+	signersByPubKey := make(map[[48]byte]*signer)
+	for _, s := range k.signers {
+		signerKeys, err := s.publicKeys(ctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not list public keys of web3signer %s", s.url)
+		}
+		for _, pubKey := range signerKeys {
+			if other, ok := signersByPubKey[pubKey]; ok {
+				log.WithFields(logrus.Fields{
+					"pubKey":   fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:])),
+					"url":      s.url,
+					"servedBy": other.url,
+				}).Warn("Public key is served by several web3signers, signing with the first one")
+				continue
+			}
+			signersByPubKey[pubKey] = s
+			pubKeys = append(pubKeys, pubKey)
+		}
+	}
+	k.lock.Lock()
+	k.pubKeys = pubKeys
+	k.pubKeysFetched = timeutils.Now()
+	k.signersByPubKey = signersByPubKey
+	k.lock.Unlock()
+	return append([][48]byte(nil), pubKeys...), nil
+}
+
+// FetchAllValidatingPublicKeys fetches the list of all public keys, including disabled ones.
+func (k *Keymanager) FetchAllValidatingPublicKeys(ctx context.Context) ([][48]byte, error) {
+	return k.FetchValidatingPublicKeys(ctx)
+}
+
+// Sign signs a message for a validator key via a Web3Signer signing request.
+func (k *Keymanager) Sign(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+	pubKey := bytesutil.ToBytes48(req.PublicKey)
+	k.lock.RLock()
+	s, ok := k.signersByPubKey[pubKey]
+	genesisValidatorsRoot := k.genesisValidatorsRoot
+	k.lock.RUnlock()
+	if len(genesisValidatorsRoot) == 0 {
+		return nil, ErrNoGenesisValidatorsRoot
+	}
+	if !ok {
+		// The key may have been added to a web3signer since its keys were fetched.
+		if _, err := k.publicKeys(ctx, minPublicKeysRefreshInterval); err != nil {
+			return nil, err
+		}
+		k.lock.RLock()
+		s, ok = k.signersByPubKey[pubKey]
+		k.lock.RUnlock()
+		if !ok {
+			return nil, fmt.Errorf("public key %#x is not served by any web3signer", pubKey)
+		}
+	}
+	body, err := newSignRequest(req, genesisValidatorsRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create web3signer signing request")
+	}
+	sig, err := s.sign(ctx, pubKey, body)
+	if err != nil {
+		return nil, err
+	}
+	return bls.SignatureFromBytes(sig)
+}
+
+func (s *signer) publicKeys(ctx context.Context) ([][48]byte, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url+publicKeysPath, nil)
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Accept", "application/json")
+	resp, err := s.client.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	var hexKeys []string
+	if err := json.NewDecoder(resp.Body).Decode(&hexKeys); err != nil {
+		return nil, errors.Wrap(err, "could not decode public keys")
+	}
+	pubKeys := make([][48]byte, len(hexKeys))
+	for i, hexKey := range hexKeys {
+		pubKey, err := hex.DecodeString(strings.TrimPrefix(hexKey, "0x"))
+		if err != nil || len(pubKey) != 48 {
+			return nil, fmt.Errorf("invalid public key %q", hexKey)
+		}
+		pubKeys[i] = bytesutil.ToBytes48(pubKey)
+	}
+	return pubKeys, nil
+}
+
+func (s *signer) sign(ctx context.Context, pubKey [48]byte, body *signRequest) ([]byte, error) {
+	enc, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	httpReq, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, fmt.Sprintf("%s%s%#x", s.url, signPath, pubKey), bytes.NewReader(enc),
+	)
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Accept", "application/json")
+	resp, err := s.client.Do(httpReq)
+	if err != nil {
+		return nil, errors.Wrapf(ErrSigningFailed, "could not reach %s: %v", s.url, err)
+	}
+	defer closeBody(resp.Body)
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read web3signer response")
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusPreconditionFailed:
+		return nil, ErrSigningDenied
+	default:
+		return nil, errors.Wrapf(ErrSigningFailed, "%s: %s", resp.Status, strings.TrimSpace(string(respBody)))
+	}
+	// Web3Signer answers with a JSON object when asked to, and with the bare signature otherwise.
+	sigHex := strings.TrimSpace(string(respBody))
+	if strings.HasPrefix(sigHex, "{") {
+		var sigResp struct {
+			Signature string `json:"signature"`
+		}
+		if err := json.Unmarshal(respBody, &sigResp); err != nil {
+			return nil, errors.Wrap(err, "could not decode web3signer response")
+		}
+		sigHex = sigResp.Signature
+	}
+	sig, err := hex.DecodeString(strings.TrimPrefix(sigHex, "0x"))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode signature")
+	}
+	return sig, nil
+}
+
+func closeBody(body io.ReadCloser) {
+	if err := body.Close(); err != nil {
+		log.WithError(err).Debug("Could not close response body")
+	}
+}
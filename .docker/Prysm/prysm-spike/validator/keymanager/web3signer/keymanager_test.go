@@ -0,0 +1,289 @@
+package web3signer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/remote"
+)
+
+// mockWeb3Signer serves the public keys and signs the signing roots of the given secret keys over
+// HTTPS, answering requests for denied public keys as denied by slashing protection, and counting
+// the listings of the public keys in listings if not nil. It returns the server and the
+// configuration of a client authenticating with a client certificate.
+func mockWeb3Signer(t *testing.T, keys []bls.SecretKey, denied map[string]bool, received chan<- *signRequest, listings *int32) (*httptest.Server, *SignerConfig) {
+	keysByPubKey := make(map[string]bls.SecretKey, len(keys))
+	pubKeys := make([]string, len(keys))
+	for i, key := range keys {
+		pubKeys[i] = fmt.Sprintf("%#x", key.PublicKey().Marshal())
+		keysByPubKey[pubKeys[i]] = key
+	}
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == publicKeysPath {
+			if listings != nil {
+				atomic.AddInt32(listings, 1)
+			}
+			require.NoError(t, json.NewEncoder(w).Encode(pubKeys))
+			return
+		}
+		pubKey := strings.TrimPrefix(r.URL.Path, signPath)
+		key, ok := keysByPubKey[pubKey]
+		if !ok {
+			http.Error(w, "Public Key not found", http.StatusNotFound)
+			return
+		}
+		if denied[pubKey] {
+			http.Error(w, "Signing operation failed due to slashing protection rules", http.StatusPreconditionFailed)
+			return
+		}
+		req := &signRequest{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(req))
+		if received != nil {
+			received <- req
+		}
+		signingRoot, err := hex.DecodeString(strings.TrimPrefix(req.SigningRoot, "0x"))
+		require.NoError(t, err)
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]string{
+			"signature": fmt.Sprintf("%#x", key.Sign(signingRoot).Marshal()),
+		}))
+	}))
+
+	dir := t.TempDir()
+	clientCert, clientKey := selfSignedCertificate(t)
+	cfg := &SignerConfig{RemoteCertificate: &remote.CertificateConfig{
+		ClientCertPath: filepath.Join(dir, "client.crt"),
+		ClientKeyPath:  filepath.Join(dir, "client.key"),
+		CACertPath:     filepath.Join(dir, "ca.crt"),
+	}}
+	require.NoError(t, ioutil.WriteFile(cfg.RemoteCertificate.ClientCertPath, clientCert, 0600))
+	require.NoError(t, ioutil.WriteFile(cfg.RemoteCertificate.ClientKeyPath, clientKey, 0600))
+	clientCAs := x509.NewCertPool()
+	require.Equal(t, true, clientCAs.AppendCertsFromPEM(clientCert))
+	srv.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs}
+	srv.StartTLS()
+	serverCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+	require.NoError(t, ioutil.WriteFile(cfg.RemoteCertificate.CACertPath, serverCert, 0600))
+	cfg.URL = srv.URL
+	return srv, cfg
+}
+
+// selfSignedCertificate returns a PEM encoded self-signed client certificate and its key.
+func selfSignedCertificate(t *testing.T) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "validator"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func TestKeymanager_FetchValidatingPublicKeys_SeveralSigners(t *testing.T) {
+	keys := make([]bls.SecretKey, 3)
+	for i := range keys {
+		var err error
+		keys[i], err = bls.RandKey()
+		require.NoError(t, err)
+	}
+	first, firstCfg := mockWeb3Signer(t, keys[:2], nil, nil, nil)
+	defer first.Close()
+	second, secondCfg := mockWeb3Signer(t, keys[1:], nil, nil, nil)
+	defer second.Close()
+	secondCfg.URL += "/"
+
+	km, err := NewKeymanager(context.Background(), &SetupConfig{Opts: &KeymanagerOpts{
+		Signers: []*SignerConfig{firstCfg, secondCfg},
+	}})
+	require.NoError(t, err)
+	pubKeys, err := km.FetchValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 3, len(pubKeys), "Keys served by several signers are listed once")
+	for i, key := range keys {
+		assert.DeepEqual(t, key.PublicKey().Marshal(), pubKeys[i][:])
+	}
+	assert.Equal(t, first.URL, km.signersByPubKey[pubKeys[1]].url)
+	assert.Equal(t, second.URL, km.signersByPubKey[pubKeys[2]].url)
+}
+
+func TestKeymanager_Sign(t *testing.T) {
+	key, err := bls.RandKey()
+	require.NoError(t, err)
+	deniedKey, err := bls.RandKey()
+	require.NoError(t, err)
+	received := make(chan *signRequest, 1)
+	srv, cfg := mockWeb3Signer(t, []bls.SecretKey{key, deniedKey}, map[string]bool{
+		fmt.Sprintf("%#x", deniedKey.PublicKey().Marshal()): true,
+	}, received, nil)
+	defer srv.Close()
+
+	ctx := context.Background()
+	km, err := NewKeymanager(ctx, &SetupConfig{Opts: &KeymanagerOpts{Signers: []*SignerConfig{cfg}}})
+	require.NoError(t, err)
+	signingRoot := [32]byte{1}
+	req := &validatorpb.SignRequest{
+		PublicKey:   key.PublicKey().Marshal(),
+		SigningRoot: signingRoot[:],
+		Object: &validatorpb.SignRequest_AttestationData{AttestationData: &ethpb.AttestationData{
+			Slot:            65,
+			CommitteeIndex:  2,
+			BeaconBlockRoot: make([]byte, 32),
+			Source:          &ethpb.Checkpoint{Epoch: 1, Root: make([]byte, 32)},
+			Target:          &ethpb.Checkpoint{Epoch: 2, Root: make([]byte, 32)},
+		}},
+	}
+	_, err = km.Sign(ctx, req)
+	assert.ErrorContains(t, ErrNoGenesisValidatorsRoot.Error(), err)
+
+	genesisValidatorsRoot := [32]byte{2}
+	km.SetGenesisValidatorsRoot(genesisValidatorsRoot[:])
+	// The keys of the signer are fetched on the first signing request.
+	sig, err := km.Sign(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, true, sig.Verify(key.PublicKey(), signingRoot[:]))
+
+	body := <-received
+	assert.Equal(t, attestationType, body.Type)
+	assert.Equal(t, "65", body.Attestation.Slot)
+	assert.Equal(t, "2", body.Attestation.Index)
+	assert.Equal(t, "2", body.Attestation.Target.Epoch)
+	assert.Equal(t, fmt.Sprintf("%#x", genesisValidatorsRoot), body.ForkInfo.GenesisValidatorsRoot)
+	assert.Equal(t, fmt.Sprintf("%#x", params.BeaconConfig().GenesisForkVersion), body.ForkInfo.Fork.CurrentVersion)
+
+	req.PublicKey = deniedKey.PublicKey().Marshal()
+	_, err = km.Sign(ctx, req)
+	assert.Equal(t, ErrSigningDenied, err)
+
+	unknownKey, err := bls.RandKey()
+	require.NoError(t, err)
+	req.PublicKey = unknownKey.PublicKey().Marshal()
+	_, err = km.Sign(ctx, req)
+	assert.ErrorContains(t, "is not served by any web3signer", err)
+}
+
+func TestNewSignRequest_Block(t *testing.T) {
+	blk := &ethpb.BeaconBlock{
+		Slot:          70,
+		ProposerIndex: 3,
+		ParentRoot:    make([]byte, 32),
+		StateRoot:     make([]byte, 32),
+		Body: &ethpb.BeaconBlockBody{
+			RandaoReveal: make([]byte, 96),
+			Eth1Data:     &ethpb.Eth1Data{DepositRoot: make([]byte, 32), BlockHash: make([]byte, 32)},
+			Graffiti:     make([]byte, 32),
+		},
+	}
+	bodyRoot, err := blk.Body.HashTreeRoot()
+	require.NoError(t, err)
+	req, err := newSignRequest(&validatorpb.SignRequest{
+		SigningRoot: make([]byte, 32),
+		Object:      &validatorpb.SignRequest_Block{Block: blk},
+	}, make([]byte, 32))
+	require.NoError(t, err)
+	assert.Equal(t, blockType, req.Type)
+	assert.Equal(t, phase0BlockVersion, req.BeaconBlock.Version)
+	assert.Equal(t, "70", req.BeaconBlock.BlockHeader.Slot)
+	assert.Equal(t, "3", req.BeaconBlock.BlockHeader.ProposerIndex)
+	assert.Equal(t, fmt.Sprintf("%#x", bodyRoot), req.BeaconBlock.BlockHeader.BodyRoot)
+
+	_, err = newSignRequest(&validatorpb.SignRequest{SigningRoot: make([]byte, 32)}, make([]byte, 32))
+	assert.ErrorContains(t, "not supported by web3signer", err)
+}
+
+func TestNewKeymanager_Certificates(t *testing.T) {
+	_, err := NewKeymanager(context.Background(), &SetupConfig{Opts: &KeymanagerOpts{}})
+	assert.ErrorContains(t, "at least one web3signer url is required", err)
+	_, err = NewKeymanager(context.Background(), &SetupConfig{Opts: &KeymanagerOpts{Signers: []*SignerConfig{{
+		URL:               "http://localhost:9000",
+		RemoteCertificate: &remote.CertificateConfig{ClientCertPath: "client.crt", ClientKeyPath: "client.key"},
+	}}}})
+	assert.ErrorContains(t, "url scheme must be https", err)
+	_, err = NewKeymanager(context.Background(), &SetupConfig{Opts: &KeymanagerOpts{Signers: []*SignerConfig{{
+		URL: "https://localhost:9000",
+	}}}})
+	assert.ErrorContains(t, "client certificate is required", err)
+	_, err = NewKeymanager(context.Background(), &SetupConfig{Opts: &KeymanagerOpts{Signers: []*SignerConfig{{
+		URL:               "https://localhost:9000",
+		RemoteCertificate: &remote.CertificateConfig{ClientCertPath: "client.crt"},
+	}}}})
+	assert.ErrorContains(t, "client key is required", err)
+	_, err = NewKeymanager(context.Background(), &SetupConfig{Opts: &KeymanagerOpts{Signers: []*SignerConfig{{
+		URL:               "https://localhost:9000",
+		RemoteCertificate: &remote.CertificateConfig{ClientCertPath: "missing.crt", ClientKeyPath: "missing.key"},
+	}}}})
+	assert.ErrorContains(t, "failed to obtain client's certificate and/or key", err)
+}
+
+func TestKeymanager_PublicKeysCache(t *testing.T) {
+	key, err := bls.RandKey()
+	require.NoError(t, err)
+	var listings int32
+	srv, cfg := mockWeb3Signer(t, []bls.SecretKey{key}, nil, nil, &listings)
+	defer srv.Close()
+
+	ctx := context.Background()
+	km, err := NewKeymanager(ctx, &SetupConfig{Opts: &KeymanagerOpts{Signers: []*SignerConfig{cfg}}})
+	require.NoError(t, err)
+	km.SetGenesisValidatorsRoot(make([]byte, 32))
+	for i := 0; i < 3; i++ {
+		pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(pubKeys))
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&listings), "Public keys were not cached")
+
+	unknownKey, err := bls.RandKey()
+	require.NoError(t, err)
+	req := &validatorpb.SignRequest{
+		PublicKey:   unknownKey.PublicKey().Marshal(),
+		SigningRoot: make([]byte, 32),
+		Object:      &validatorpb.SignRequest_Epoch{Epoch: 1},
+	}
+	for i := 0; i < 3; i++ {
+		_, err = km.Sign(ctx, req)
+		assert.ErrorContains(t, "is not served by any web3signer", err)
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&listings), "Signing requests of unknown keys were not rate limited")
+
+	// Past the minimum interval, a signing request of an unknown key lists the keys again.
+	km.pubKeysFetched = km.pubKeysFetched.Add(-minPublicKeysRefreshInterval)
+	_, err = km.Sign(ctx, req)
+	assert.ErrorContains(t, "is not served by any web3signer", err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&listings))
+	_, err = km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&listings))
+}
@@ -0,0 +1,198 @@
+package web3signer
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/p2putils"
+)
+
+// Signing request types of the Web3Signer eth2 signing API.
+const (
+	blockType             = "BLOCK_V2"
+	attestationType       = "ATTESTATION"
+	aggregateAndProofType = "AGGREGATE_AND_PROOF"
+	aggregationSlotType   = "AGGREGATION_SLOT"
+	randaoRevealType      = "RANDAO_REVEAL"
+	voluntaryExitType     = "VOLUNTARY_EXIT"
+	phase0BlockVersion    = "PHASE0"
+)
+
+// signRequest is the JSON body of a Web3Signer eth2 signing request. Web3Signer computes the
+// signing root from the fork info and the object itself, and checks it against SigningRoot.
+type signRequest struct {
+	Type              string             `json:"type"`
+	ForkInfo          *forkInfo          `json:"fork_info"`
+	SigningRoot       string             `json:"signingRoot"`
+	BeaconBlock       *beaconBlock       `json:"beacon_block,omitempty"`
+	Attestation       *attestationData   `json:"attestation,omitempty"`
+	AggregateAndProof *aggregateAndProof `json:"aggregate_and_proof,omitempty"`
+	AggregationSlot   *aggregationSlot   `json:"aggregation_slot,omitempty"`
+	RandaoReveal      *randaoReveal      `json:"randao_reveal,omitempty"`
+	VoluntaryExit     *voluntaryExit     `json:"voluntary_exit,omitempty"`
+}
+
+type forkInfo struct {
+	Fork                  *fork  `json:"fork"`
+	GenesisValidatorsRoot string `json:"genesis_validators_root"`
+}
+
+type fork struct {
+	PreviousVersion string `json:"previous_version"`
+	CurrentVersion  string `json:"current_version"`
+	Epoch           string `json:"epoch"`
+}
+
+type beaconBlock struct {
+	Version     string       `json:"version"`
+	BlockHeader *blockHeader `json:"block_header"`
+}
+
+type blockHeader struct {
+	Slot          string `json:"slot"`
+	ProposerIndex string `json:"proposer_index"`
+	ParentRoot    string `json:"parent_root"`
+	StateRoot     string `json:"state_root"`
+	BodyRoot      string `json:"body_root"`
+}
+
+type attestationData struct {
+	Slot            string      `json:"slot"`
+	Index           string      `json:"index"`
+	BeaconBlockRoot string      `json:"beacon_block_root"`
+	Source          *checkpoint `json:"source"`
+	Target          *checkpoint `json:"target"`
+}
+
+type checkpoint struct {
+	Epoch string `json:"epoch"`
+	Root  string `json:"root"`
+}
+
+type aggregateAndProof struct {
+	AggregatorIndex string       `json:"aggregator_index"`
+	Aggregate       *attestation `json:"aggregate"`
+	SelectionProof  string       `json:"selection_proof"`
+}
+
+type attestation struct {
+	AggregationBits string           `json:"aggregation_bits"`
+	Data            *attestationData `json:"data"`
+	Signature       string           `json:"signature"`
+}
+
+type aggregationSlot struct {
+	Slot string `json:"slot"`
+}
+
+type randaoReveal struct {
+	Epoch string `json:"epoch"`
+}
+
+type voluntaryExit struct {
+	Epoch          string `json:"epoch"`
+	ValidatorIndex string `json:"validator_index"`
+}
+
+// newSignRequest converts a signing request of the validator client into a Web3Signer signing
+// request, with the fork of the epoch the object is signed in.
+func newSignRequest(req *validatorpb.SignRequest, genesisValidatorsRoot []byte) (*signRequest, error) {
+	r := &signRequest{SigningRoot: fmt.Sprintf("%#x", req.SigningRoot)}
+	var epoch uint64
+	switch obj := req.Object.(type) {
+	case *validatorpb.SignRequest_Block:
+		if obj.Block == nil || obj.Block.Body == nil {
+			return nil, errors.New("nil block")
+		}
+		bodyRoot, err := obj.Block.Body.HashTreeRoot()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not compute block body root")
+		}
+		r.Type = blockType
+		r.BeaconBlock = &beaconBlock{
+			Version: phase0BlockVersion,
+			BlockHeader: &blockHeader{
+				Slot:          uint64String(obj.Block.Slot),
+				ProposerIndex: uint64String(obj.Block.ProposerIndex),
+				ParentRoot:    fmt.Sprintf("%#x", obj.Block.ParentRoot),
+				StateRoot:     fmt.Sprintf("%#x", obj.Block.StateRoot),
+				BodyRoot:      fmt.Sprintf("%#x", bodyRoot),
+			},
+		}
+		epoch = helpers.SlotToEpoch(obj.Block.Slot)
+	case *validatorpb.SignRequest_AttestationData:
+		if obj.AttestationData == nil || obj.AttestationData.Target == nil || obj.AttestationData.Source == nil {
+			return nil, errors.New("nil attestation data")
+		}
+		r.Type = attestationType
+		r.Attestation = newAttestationData(obj.AttestationData)
+		epoch = obj.AttestationData.Target.Epoch
+	case *validatorpb.SignRequest_AggregateAttestationAndProof:
+		agg := obj.AggregateAttestationAndProof
+		if agg == nil || agg.Aggregate == nil || agg.Aggregate.Data == nil ||
+			agg.Aggregate.Data.Target == nil || agg.Aggregate.Data.Source == nil {
+			return nil, errors.New("nil aggregate attestation and proof")
+		}
+		r.Type = aggregateAndProofType
+		r.AggregateAndProof = &aggregateAndProof{
+			AggregatorIndex: uint64String(agg.AggregatorIndex),
+			Aggregate: &attestation{
+				AggregationBits: fmt.Sprintf("%#x", []byte(agg.Aggregate.AggregationBits)),
+				Data:            newAttestationData(agg.Aggregate.Data),
+				Signature:       fmt.Sprintf("%#x", agg.Aggregate.Signature),
+			},
+			SelectionProof: fmt.Sprintf("%#x", agg.SelectionProof),
+		}
+		epoch = helpers.SlotToEpoch(agg.Aggregate.Data.Slot)
+	case *validatorpb.SignRequest_Slot:
+		r.Type = aggregationSlotType
+		r.AggregationSlot = &aggregationSlot{Slot: uint64String(obj.Slot)}
+		epoch = helpers.SlotToEpoch(obj.Slot)
+	case *validatorpb.SignRequest_Epoch:
+		r.Type = randaoRevealType
+		r.RandaoReveal = &randaoReveal{Epoch: uint64String(obj.Epoch)}
+		epoch = obj.Epoch
+	case *validatorpb.SignRequest_Exit:
+		if obj.Exit == nil {
+			return nil, errors.New("nil voluntary exit")
+		}
+		r.Type = voluntaryExitType
+		r.VoluntaryExit = &voluntaryExit{
+			Epoch:          uint64String(obj.Exit.Epoch),
+			ValidatorIndex: uint64String(obj.Exit.ValidatorIndex),
+		}
+		epoch = obj.Exit.Epoch
+	default:
+		return nil, fmt.Errorf("signing requests of type %T are not supported by web3signer", req.Object)
+	}
+	f, err := p2putils.Fork(epoch)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not determine fork of epoch %d", epoch)
+	}
+	r.ForkInfo = &forkInfo{
+		Fork: &fork{
+			PreviousVersion: fmt.Sprintf("%#x", f.PreviousVersion),
+			CurrentVersion:  fmt.Sprintf("%#x", f.CurrentVersion),
+			Epoch:           uint64String(f.Epoch),
+		},
+		GenesisValidatorsRoot: fmt.Sprintf("%#x", genesisValidatorsRoot),
+	}
+	return r, nil
+}
+
+func newAttestationData(data *ethpb.AttestationData) *attestationData {
+	return &attestationData{
+		Slot:            uint64String(data.Slot),
+		Index:           uint64String(data.CommitteeIndex),
+		BeaconBlockRoot: fmt.Sprintf("%#x", data.BeaconBlockRoot),
+		Source:          &checkpoint{Epoch: uint64String(data.Source.Epoch), Root: fmt.Sprintf("%#x", data.Source.Root)},
+		Target:          &checkpoint{Epoch: uint64String(data.Target.Epoch), Root: fmt.Sprintf("%#x", data.Target.Root)},
+	}
+}
+
+func uint64String(i uint64) string {
+	return fmt.Sprintf("%d", i)
+}
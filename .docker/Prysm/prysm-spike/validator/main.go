@@ -11,7 +11,9 @@ import (
 
 	joonix "github.com/joonix/log"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/configdump"
 	"github.com/prysmaticlabs/prysm/shared/debug"
+	"github.com/prysmaticlabs/prysm/shared/exitcode"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/journald"
 	"github.com/prysmaticlabs/prysm/shared/logutil"
@@ -29,6 +31,11 @@ import (
 var log = logrus.WithField("prefix", "main")
 
 func startNode(ctx *cli.Context) error {
+	if ctx.Bool(cmd.PrintConfigFlag.Name) {
+		node.Configure(ctx)
+		return configdump.Print(ctx, os.Stdout)
+	}
+
 	// verify if ToS accepted
 	if err := tos.VerifyTosAcceptedOrPrompt(ctx); err != nil {
 		return err
@@ -45,14 +52,36 @@ func startNode(ctx *cli.Context) error {
 var appFlags = []cli.Flag{
 	flags.BeaconRPCProviderFlag,
 	flags.BeaconRPCGatewayProviderFlag,
+	flags.BeaconRESTAPIProviderFlag,
 	flags.CertFlag,
+	flags.TLSAutoFlag,
+	flags.TLSAutoCADirFlag,
 	flags.GraffitiFlag,
+	flags.GraffitiFileFlag,
+	flags.OrphanedBlockCheckDepthFlag,
+	flags.OrphanedBlockWebhookFlag,
+	flags.KeyQuarantineThresholdFlag,
+	flags.KeyQuarantineWebhookFlag,
+	flags.DoppelgangerProtectionEpochsFlag,
+	flags.DutiesBatchSizeFlag,
+	flags.DBBackupIntervalFlag,
+	flags.DBBackupOutputDirFlag,
+	flags.DBBackupRetentionFlag,
+	flags.DBBackupS3URLFlag,
+	flags.DBBackupS3RegionFlag,
+	flags.DBPruneIntervalFlag,
+	flags.DBPruneRetentionEpochsFlag,
+	flags.DBEncryptionFlag,
+	flags.DBEncryptionKeyFileFlag,
+	flags.SlashingProtectionPoliciesFlag,
 	flags.DisablePenaltyRewardLogFlag,
 	flags.InteropStartIndex,
 	flags.InteropNumValidators,
 	flags.EnableRPCFlag,
 	flags.RPCHost,
 	flags.RPCPort,
+	flags.RPCMutationRateLimitFlag,
+	flags.RPCAuditLogFlag,
 	flags.GRPCGatewayPort,
 	flags.GRPCGatewayHost,
 	flags.GrpcRetriesFlag,
@@ -60,11 +89,18 @@ var appFlags = []cli.Flag{
 	flags.GrpcHeadersFlag,
 	flags.GPRCGatewayCorsDomain,
 	flags.DisableAccountMetricsFlag,
+	flags.AccountMetricsLabelFlag,
 	cmd.MonitoringHostFlag,
 	flags.MonitoringPortFlag,
 	cmd.DisableMonitoringFlag,
 	flags.SlasherRPCProviderFlag,
+	flags.SlasherQuorumWeightFlag,
 	flags.SlasherCertFlag,
+	flags.AttestationVerificationProviderFlag,
+	flags.AttestationVerificationCertFlag,
+	flags.AttestationVerificationHeadToleranceFlag,
+	flags.AttestationVerificationMismatchFlag,
+	flags.SubmissionSpreadFlag,
 	flags.WalletPasswordFileFlag,
 	flags.WalletDirFlag,
 	flags.EnableWebFlag,
@@ -80,9 +116,15 @@ var appFlags = []cli.Flag{
 	cmd.TraceSampleFractionFlag,
 	cmd.LogFormat,
 	cmd.LogFileName,
+	cmd.LogDedup,
 	cmd.ConfigFileFlag,
 	cmd.ChainConfigFileFlag,
+	cmd.PrintConfigFlag,
 	cmd.GrpcMaxCallRecvMsgSizeFlag,
+	cmd.DiskWatchIntervalFlag,
+	cmd.DiskWarningThresholdFlag,
+	cmd.DiskCriticalThresholdFlag,
+	cmd.DiskEmergencyPruneFlag,
 	debug.PProfFlag,
 	debug.PProfAddrFlag,
 	debug.PProfPortFlag,
@@ -106,11 +148,18 @@ func main() {
 	app.Commands = []*cli.Command{
 		accounts.WalletCommands,
 		accounts.AccountCommands,
+		accounts.DBCommands,
+		configdump.SupportBundleCommand(node.Configure, flags.MonitoringPortFlag),
 	}
 
 	app.Flags = appFlags
 
-	app.Before = func(ctx *cli.Context) error {
+	app.OnUsageError = func(_ *cli.Context, err error, _ bool) error {
+		return exitcode.Wrap(err, exitcode.ConfigError)
+	}
+
+	// Failures to set up the process from its flags are config errors.
+	setup := func(ctx *cli.Context) error {
 		// Load flags from config file, if specified.
 		if err := cmd.LoadFlagsFromConfig(ctx, app.Flags); err != nil {
 			return err
@@ -148,10 +197,16 @@ func main() {
 				log.WithError(err).Error("Failed to configuring logging to disk.")
 			}
 		}
+		if err := logutil.ConfigureDeduplication(ctx.StringSlice(cmd.LogDedup.Name)); err != nil {
+			return err
+		}
 
 		runtime.GOMAXPROCS(runtime.NumCPU())
 		return debug.Setup(ctx)
 	}
+	app.Before = func(ctx *cli.Context) error {
+		return exitcode.Wrap(setup(ctx), exitcode.ConfigError)
+	}
 
 	app.After = func(ctx *cli.Context) error {
 		debug.Exit(ctx)
@@ -166,6 +221,8 @@ func main() {
 	}()
 
 	if err := app.Run(os.Args); err != nil {
-		log.Error(err.Error())
+		code := exitcode.FromError(err)
+		log.WithField("exitCode", int(code)).Error(err.Error())
+		os.Exit(int(code))
 	}
 }
@@ -4,6 +4,8 @@
 package node
 
 import (
+	"context"
+	"crypto/tls"
 	"fmt"
 	"os"
 	"os/signal"
@@ -13,26 +15,37 @@ import (
 	"syscall"
 
 	"github.com/pkg/errors"
+	sharedpb "github.com/prysmaticlabs/prysm/proto/shared/v1"
 	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/autotls"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/configdump"
 	"github.com/prysmaticlabs/prysm/shared/debug"
+	"github.com/prysmaticlabs/prysm/shared/diskwatch"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/inventory"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/prereq"
 	"github.com/prysmaticlabs/prysm/shared/prometheus"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
 	"github.com/prysmaticlabs/prysm/shared/tracing"
 	"github.com/prysmaticlabs/prysm/shared/version"
 	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
 	"github.com/prysmaticlabs/prysm/validator/client"
+	vdb "github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/db/backup"
 	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/validator/db/prune"
 	"github.com/prysmaticlabs/prysm/validator/flags"
+	"github.com/prysmaticlabs/prysm/validator/graffiti"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
 	"github.com/prysmaticlabs/prysm/validator/rpc"
 	"github.com/prysmaticlabs/prysm/validator/rpc/gateway"
 	slashing_protection "github.com/prysmaticlabs/prysm/validator/slashing-protection"
+	"github.com/prysmaticlabs/prysm/validator/slashing-protection/policy"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
 )
@@ -44,6 +57,7 @@ var log = logrus.WithField("prefix", "node")
 type ValidatorClient struct {
 	cliCtx            *cli.Context
 	db                *kv.Store
+	diskWatch         *diskwatch.Service
 	services          *shared.ServiceRegistry // Lifecycle and service store.
 	lock              sync.RWMutex
 	wallet            *wallet.Wallet
@@ -81,13 +95,7 @@ func NewValidatorClient(cliCtx *cli.Context) (*ValidatorClient, error) {
 		stop:              make(chan struct{}),
 	}
 
-	featureconfig.ConfigureValidator(cliCtx)
-	cmd.ConfigureValidator(cliCtx)
-
-	if cliCtx.IsSet(cmd.ChainConfigFileFlag.Name) {
-		chainConfigFileName := cliCtx.String(cmd.ChainConfigFileFlag.Name)
-		params.LoadChainConfigFile(chainConfigFileName)
-	}
+	Configure(cliCtx)
 
 	// If the --web flag is enabled to administer the validator
 	// client via a web portal, we start the validator client in a different way.
@@ -104,6 +112,18 @@ func NewValidatorClient(cliCtx *cli.Context) (*ValidatorClient, error) {
 	return ValidatorClient, nil
 }
 
+// Configure applies the global feature flags and chain config of the validator client from the
+// flags.
+func Configure(cliCtx *cli.Context) {
+	featureconfig.ConfigureValidator(cliCtx)
+	cmd.ConfigureValidator(cliCtx)
+
+	if cliCtx.IsSet(cmd.ChainConfigFileFlag.Name) {
+		chainConfigFileName := cliCtx.String(cmd.ChainConfigFileFlag.Name)
+		params.LoadChainConfigFile(chainConfigFileName)
+	}
+}
+
 // Start every service in the validator client.
 func (s *ValidatorClient) Start() {
 	s.lock.Lock()
@@ -214,6 +234,27 @@ func (s *ValidatorClient) initializeFromCLI(cliCtx *cli.Context) error {
 		return errors.Wrap(err, "could not initialize db")
 	}
 	s.db = valDB
+	if err := s.enableDBEncryption(cliCtx, valDB); err != nil {
+		return err
+	}
+	if err := checkDeletedPublicKeys(cliCtx.Context, valDB, keyManager); err != nil {
+		return err
+	}
+	if cliCtx.Duration(cmd.DiskWatchIntervalFlag.Name) > 0 {
+		if err := s.registerDiskWatchService(cliCtx); err != nil {
+			return err
+		}
+	}
+	if cliCtx.Duration(flags.DBBackupIntervalFlag.Name) > 0 {
+		if err := s.registerDBBackupService(cliCtx); err != nil {
+			return err
+		}
+	}
+	if cliCtx.Duration(flags.DBPruneIntervalFlag.Name) > 0 {
+		if err := s.registerDBPruneService(cliCtx); err != nil {
+			return err
+		}
+	}
 	if !cliCtx.Bool(cmd.DisableMonitoringFlag.Name) {
 		if err := s.registerPrometheusService(); err != nil {
 			return err
@@ -297,6 +338,27 @@ func (s *ValidatorClient) initializeForWeb(cliCtx *cli.Context) error {
 		return errors.Wrap(err, "could not initialize db")
 	}
 	s.db = valDB
+	if err := s.enableDBEncryption(cliCtx, valDB); err != nil {
+		return err
+	}
+	if err := checkDeletedPublicKeys(cliCtx.Context, valDB, keyManager); err != nil {
+		return err
+	}
+	if cliCtx.Duration(cmd.DiskWatchIntervalFlag.Name) > 0 {
+		if err := s.registerDiskWatchService(cliCtx); err != nil {
+			return err
+		}
+	}
+	if cliCtx.Duration(flags.DBBackupIntervalFlag.Name) > 0 {
+		if err := s.registerDBBackupService(cliCtx); err != nil {
+			return err
+		}
+	}
+	if cliCtx.Duration(flags.DBPruneIntervalFlag.Name) > 0 {
+		if err := s.registerDBPruneService(cliCtx); err != nil {
+			return err
+		}
+	}
 	if !cliCtx.Bool(cmd.DisableMonitoringFlag.Name) {
 		if err := s.registerPrometheusService(); err != nil {
 			return err
@@ -334,6 +396,54 @@ func (s *ValidatorClient) registerPrometheusService() error {
 	return s.services.RegisterService(service)
 }
 
+// registerDiskWatchService watches the free space of the database volume, pausing the database
+// backups under pressure.
+func (s *ValidatorClient) registerDiskWatchService(cliCtx *cli.Context) error {
+	service, err := diskwatch.NewService(cliCtx.Context, &diskwatch.Config{
+		Dir:            s.db.DatabasePath(),
+		WarningBytes:   cliCtx.Uint64(cmd.DiskWarningThresholdFlag.Name) * 1024 * 1024,
+		CriticalBytes:  cliCtx.Uint64(cmd.DiskCriticalThresholdFlag.Name) * 1024 * 1024,
+		Interval:       cliCtx.Duration(cmd.DiskWatchIntervalFlag.Name),
+		EmergencyPrune: cliCtx.Bool(cmd.DiskEmergencyPruneFlag.Name),
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not initialize disk watch")
+	}
+	s.diskWatch = service
+	return s.services.RegisterService(service)
+}
+
+func (s *ValidatorClient) registerDBBackupService(cliCtx *cli.Context) error {
+	service, err := backup.NewService(cliCtx.Context, &backup.Config{
+		DB:        s.db,
+		Interval:  cliCtx.Duration(flags.DBBackupIntervalFlag.Name),
+		OutputDir: cliCtx.String(flags.DBBackupOutputDirFlag.Name),
+		Retention: cliCtx.Int(flags.DBBackupRetentionFlag.Name),
+		S3URL:     cliCtx.String(flags.DBBackupS3URLFlag.Name),
+		S3Region:  cliCtx.String(flags.DBBackupS3RegionFlag.Name),
+		Paused:    s.diskWatch.UnderPressure,
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not initialize validator database backups")
+	}
+	if s.diskWatch != nil {
+		s.diskWatch.RegisterPruner("db-backups", service.PruneToLatest)
+	}
+	return s.services.RegisterService(service)
+}
+
+func (s *ValidatorClient) registerDBPruneService(cliCtx *cli.Context) error {
+	service, err := prune.NewService(cliCtx.Context, &prune.Config{
+		DB:              s.db,
+		Interval:        cliCtx.Duration(flags.DBPruneIntervalFlag.Name),
+		RetentionEpochs: cliCtx.Uint64(flags.DBPruneRetentionEpochsFlag.Name),
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not initialize validator database pruning")
+	}
+	return s.services.RegisterService(service)
+}
+
 func (s *ValidatorClient) registerClientService(
 	keyManager keymanager.IKeymanager,
 ) error {
@@ -341,8 +451,36 @@ func (s *ValidatorClient) registerClientService(
 	dataDir := s.cliCtx.String(cmd.DataDirFlag.Name)
 	logValidatorBalances := !s.cliCtx.Bool(flags.DisablePenaltyRewardLogFlag.Name)
 	emitAccountMetrics := !s.cliCtx.Bool(flags.DisableAccountMetricsFlag.Name)
+	var metricsLabeler *client.AccountMetricsLabeler
+	if emitAccountMetrics {
+		var err error
+		metricsLabeler, err = client.NewAccountMetricsLabeler(s.cliCtx.String(flags.AccountMetricsLabelFlag.Name), dataDir)
+		if err != nil {
+			return err
+		}
+	}
 	cert := s.cliCtx.String(flags.CertFlag.Name)
-	graffiti := s.cliCtx.String(flags.GraffitiFlag.Name)
+	var tlsConfig *tls.Config
+	if cert == "" && s.cliCtx.Bool(flags.TLSAutoFlag.Name) {
+		var err error
+		caDir := s.cliCtx.String(flags.TLSAutoCADirFlag.Name)
+		if caDir == "" {
+			caDir = filepath.Join(dataDir, "tls", "ca")
+		}
+		tlsConfig, err = autoTLSConfig(filepath.Join(dataDir, "tls"), caDir)
+		if err != nil {
+			return err
+		}
+	}
+	var graffitiFile *graffiti.File
+	if path := s.cliCtx.String(flags.GraffitiFileFlag.Name); path != "" {
+		var err error
+		graffitiFile, err = graffiti.NewFile(path)
+		if err != nil {
+			return errors.Wrap(err, "could not load graffiti file")
+		}
+	}
+	graffitiFlag := s.cliCtx.String(flags.GraffitiFlag.Name)
 	maxCallRecvMsgSize := s.cliCtx.Int(cmd.GrpcMaxCallRecvMsgSizeFlag.Name)
 	grpcRetries := s.cliCtx.Uint(flags.GrpcRetriesFlag.Name)
 	grpcRetryDelay := s.cliCtx.Duration(flags.GrpcRetryDelayFlag.Name)
@@ -351,19 +489,63 @@ func (s *ValidatorClient) registerClientService(
 	if err := s.services.FetchService(&sp); err == nil {
 		protector = sp
 	}
+	var policies *policy.Config
+	if path := s.cliCtx.String(flags.SlashingProtectionPoliciesFlag.Name); path != "" {
+		var err error
+		policies, err = policy.Load(path)
+		if err != nil {
+			return errors.Wrap(err, "could not load slashing protection policies")
+		}
+	}
+	var attVerification *client.AttestationVerificationConfig
+	if verificationEndpoint := s.cliCtx.String(flags.AttestationVerificationProviderFlag.Name); verificationEndpoint != "" {
+		var preferPrimary bool
+		switch mismatch := s.cliCtx.String(flags.AttestationVerificationMismatchFlag.Name); mismatch {
+		case "skip":
+		case "prefer-primary":
+			preferPrimary = true
+		default:
+			return fmt.Errorf("unsupported --%s %q, expected skip or prefer-primary",
+				flags.AttestationVerificationMismatchFlag.Name, mismatch)
+		}
+		attVerification = &client.AttestationVerificationConfig{
+			Endpoint:      verificationEndpoint,
+			CertFlag:      s.cliCtx.String(flags.AttestationVerificationCertFlag.Name),
+			HeadTolerance: s.cliCtx.Uint64(flags.AttestationVerificationHeadToleranceFlag.Name),
+			PreferPrimary: preferPrimary,
+		}
+	}
+	submissionSpread := s.cliCtx.Duration(flags.SubmissionSpreadFlag.Name)
+	if maxSpread := slotutil.DivideSlotBy(3); submissionSpread > maxSpread {
+		return fmt.Errorf("--%s %s exceeds a third of a slot %s, submissions would miss their deadlines",
+			flags.SubmissionSpreadFlag.Name, submissionSpread, maxSpread)
+	}
 	v, err := client.NewValidatorService(s.cliCtx.Context, &client.Config{
 		Endpoint:                   endpoint,
+		BeaconAPIEndpoint:          s.cliCtx.String(flags.BeaconRESTAPIProviderFlag.Name),
+		AttestationVerification:    attVerification,
+		SubmissionSpread:           submissionSpread,
+		OrphanedBlockCheckDepth:    s.cliCtx.Uint64(flags.OrphanedBlockCheckDepthFlag.Name),
+		OrphanedBlockWebhook:       s.cliCtx.String(flags.OrphanedBlockWebhookFlag.Name),
+		KeyQuarantineThreshold:     s.cliCtx.Uint64(flags.KeyQuarantineThresholdFlag.Name),
+		KeyQuarantineWebhook:       s.cliCtx.String(flags.KeyQuarantineWebhookFlag.Name),
+		DoppelgangerEpochs:         s.cliCtx.Uint64(flags.DoppelgangerProtectionEpochsFlag.Name),
+		DutiesBatchSize:            s.cliCtx.Uint64(flags.DutiesBatchSizeFlag.Name),
 		DataDir:                    dataDir,
 		KeyManager:                 keyManager,
 		LogValidatorBalances:       logValidatorBalances,
 		EmitAccountMetrics:         emitAccountMetrics,
+		AccountMetricsLabeler:      metricsLabeler,
 		CertFlag:                   cert,
-		GraffitiFlag:               graffiti,
+		TLSConfig:                  tlsConfig,
+		GraffitiFlag:               graffitiFlag,
+		GraffitiFile:               graffitiFile,
 		GrpcMaxCallRecvMsgSizeFlag: maxCallRecvMsgSize,
 		GrpcRetriesFlag:            grpcRetries,
 		GrpcRetryDelay:             grpcRetryDelay,
 		GrpcHeadersFlag:            s.cliCtx.String(flags.GrpcHeadersFlag.Name),
 		Protector:                  protector,
+		ProtectionPolicies:         policies,
 		ValDB:                      s.db,
 		UseWeb:                     s.cliCtx.Bool(flags.EnableWebFlag.Name),
 		WalletInitializedFeed:      s.walletInitialized,
@@ -374,6 +556,21 @@ func (s *ValidatorClient) registerClientService(
 	}
 	return s.services.RegisterService(v)
 }
+
+// autoTLSConfig loads or issues the client certificate in the directory with the certificate
+// authority shared with the beacon nodes, which verifies their certificates.
+func autoTLSConfig(dir, caDir string) (*tls.Config, error) {
+	ca, err := autotls.LoadOrCreateCA(caDir)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not load TLS certificate authority")
+	}
+	cert, err := autotls.LoadOrCreate(dir, autotls.DefaultHosts(), ca)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not load TLS certificate")
+	}
+	return autotls.ClientConfig(cert, ca), nil
+}
+
 func (s *ValidatorClient) registerSlasherClientService() error {
 	endpoint := s.cliCtx.String(flags.SlasherRPCProviderFlag.Name)
 	if endpoint == "" {
@@ -386,6 +583,7 @@ func (s *ValidatorClient) registerSlasherClientService() error {
 	grpcRetryDelay := s.cliCtx.Duration(flags.GrpcRetryDelayFlag.Name)
 	sp, err := slashing_protection.NewService(s.cliCtx.Context, &slashing_protection.Config{
 		Endpoint:                   endpoint,
+		QuorumWeight:               s.cliCtx.Uint64(flags.SlasherQuorumWeightFlag.Name),
 		CertFlag:                   cert,
 		GrpcMaxCallRecvMsgSizeFlag: maxCallRecvMsgSize,
 		GrpcRetriesFlag:            grpcRetries,
@@ -420,6 +618,7 @@ func (s *ValidatorClient) registerRPCService(cliCtx *cli.Context, km keymanager.
 		SyncChecker:             vs,
 		GenesisFetcher:          vs,
 		BeaconNodeInfoFetcher:   vs,
+		PerformanceFetcher:      vs,
 		NodeGatewayEndpoint:     nodeGatewayEndpoint,
 		WalletDir:               walletDir,
 		Wallet:                  s.wallet,
@@ -428,6 +627,20 @@ func (s *ValidatorClient) registerRPCService(cliCtx *cli.Context, km keymanager.
 		ValidatorGatewayPort:    validatorGatewayPort,
 		ValidatorMonitoringHost: validatorMonitoringHost,
 		ValidatorMonitoringPort: validatorMonitoringPort,
+		MutationRateLimit:       cliCtx.Uint64(flags.RPCMutationRateLimitFlag.Name),
+		AuditLogFile:            cliCtx.String(flags.RPCAuditLogFlag.Name),
+		RunningConfig: func() (*configdump.Config, error) {
+			return configdump.Resolve(cliCtx)
+		},
+		Inventory: func() (*sharedpb.Inventory, error) {
+			return inventory.Build(s.services, &inventory.Config{
+				Binary:  "validator",
+				DataDir: s.db.DatabasePath(),
+				GenesisValidatorsRoot: func() ([]byte, error) {
+					return s.db.GenesisValidatorsRoot(cliCtx.Context)
+				},
+			})
+		},
 	})
 	return s.services.RegisterService(server)
 }
@@ -455,6 +668,54 @@ func (s *ValidatorClient) registerRPCGatewayService(cliCtx *cli.Context) error {
 	return s.services.RegisterService(gatewaySrv)
 }
 
+// enableDBEncryption encrypts the validator database when database encryption is enabled.
+func (s *ValidatorClient) enableDBEncryption(cliCtx *cli.Context, valDB *kv.Store) error {
+	walletPassword := ""
+	if s.wallet != nil {
+		walletPassword = s.wallet.Password()
+	}
+	secret, err := vdb.EncryptionSecret(cliCtx, walletPassword)
+	if err != nil {
+		return err
+	}
+	if secret == nil {
+		return nil
+	}
+	if err := valDB.EnableEncryption(secret); err != nil {
+		return errors.Wrap(err, "could not enable database encryption")
+	}
+	return nil
+}
+
+// checkDeletedPublicKeys refuses to start with accounts which were deleted from the wallet and reappeared
+// without being imported again, for instance after restoring an old copy of the wallet.
+func checkDeletedPublicKeys(ctx context.Context, valDB vdb.Database, km keymanager.IKeymanager) error {
+	if km == nil {
+		return nil
+	}
+	deleted, err := valDB.DeletedPublicKeys(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve deleted accounts")
+	}
+	if len(deleted) == 0 {
+		return nil
+	}
+	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not fetch validating public keys")
+	}
+	for _, pubKey := range pubKeys {
+		if archivePath, ok := deleted[pubKey]; ok {
+			return fmt.Errorf(
+				"account %#x was deleted from the wallet, its slashing protection history was archived to %s. "+
+					"Import the account again with `validator accounts import` to use it",
+				pubKey, archivePath,
+			)
+		}
+	}
+	return nil
+}
+
 func clearDB(dataDir string, force bool) error {
 	var err error
 	clearDBConfirmed := force
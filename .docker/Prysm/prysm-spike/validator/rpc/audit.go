@@ -0,0 +1,208 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kevinms/leakybucket-go"
+	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// auditedPaths maps the methods changing the wallet, its keys or the settings of
+// the validator, and the login guarding them, to a description of the change made
+// by a request, which never includes secrets such as passwords or mnemonics.
+var auditedPaths = map[string]func(req, resp interface{}) string{
+	"/ethereum.validator.accounts.v2.Wallet/CreateWallet": func(req, _ interface{}) string {
+		r, ok := req.(*pb.CreateWalletRequest)
+		if !ok {
+			return "create wallet"
+		}
+		if r.Keymanager == pb.KeymanagerKind_DERIVED {
+			return fmt.Sprintf("create %s wallet with %d accounts", strings.ToLower(r.Keymanager.String()), r.NumAccounts)
+		}
+		return fmt.Sprintf("create %s wallet", strings.ToLower(r.Keymanager.String()))
+	},
+	"/ethereum.validator.accounts.v2.Wallet/ImportKeystores": func(req, resp interface{}) string {
+		if r, ok := resp.(*pb.ImportKeystoresResponse); ok && r != nil {
+			keys := make([]string, len(r.ImportedPublicKeys))
+			for i, key := range r.ImportedPublicKeys {
+				keys[i] = fmt.Sprintf("%#x", key)
+			}
+			return fmt.Sprintf("import keystores %s", strings.Join(keys, ","))
+		}
+		if r, ok := req.(*pb.ImportKeystoresRequest); ok {
+			return fmt.Sprintf("import %d keystores", len(r.KeystoresImported))
+		}
+		return "import keystores"
+	},
+	"/ethereum.validator.accounts.v2.KeyManagement/ImportStandardKeystores": func(req, _ interface{}) string {
+		if r, ok := req.(*pb.ImportStandardKeystoresRequest); ok {
+			return fmt.Sprintf("import %d keystores", len(r.Keystores))
+		}
+		return "import keystores"
+	},
+	"/ethereum.validator.accounts.v2.KeyManagement/DeleteKeystores": func(req, _ interface{}) string {
+		r, ok := req.(*pb.DeleteKeystoresRequest)
+		if !ok {
+			return "delete keystores"
+		}
+		keys := make([]string, len(r.PublicKeys))
+		for i, key := range r.PublicKeys {
+			keys[i] = fmt.Sprintf("%#x", key)
+		}
+		return fmt.Sprintf("delete keystores %s", strings.Join(keys, ","))
+	},
+	"/ethereum.validator.accounts.v2.Accounts/ChangePassword": func(_, _ interface{}) string {
+		return "change web password"
+	},
+	"/ethereum.validator.accounts.v2.Auth/Signup": func(_, _ interface{}) string {
+		return "set web password"
+	},
+	"/ethereum.validator.accounts.v2.Auth/Login": func(_, _ interface{}) string {
+		return "log in"
+	},
+}
+
+// errRateLimited is returned to requests exceeding the rate limit of their method.
+var errRateLimited = status.Error(codes.ResourceExhausted, "Too many requests, try again later")
+
+// auditEntry is a line of the audit log: who requested which change and when,
+// and whether it succeeded.
+type auditEntry struct {
+	Time          time.Time `json:"time"`
+	Method        string    `json:"method"`
+	Peer          string    `json:"peer"`
+	UserAgent     string    `json:"user_agent,omitempty"`
+	Authenticated bool      `json:"authenticated"`
+	Change        string    `json:"change"`
+	Error         string    `json:"error,omitempty"`
+}
+
+// auditLog appends audit entries to a file as JSON lines.
+type auditLog struct {
+	path string
+	lock sync.Mutex
+}
+
+func (l *auditLog) append(entry *auditEntry) error {
+	enc, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	f, err := os.OpenFile(l.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(append(enc, '\n')); err != nil {
+		if closeErr := f.Close(); closeErr != nil {
+			log.WithError(closeErr).Debug("Could not close audit log")
+		}
+		return err
+	}
+	return f.Close()
+}
+
+// newMutationRateLimiter returns a limiter allowing each client a burst of perMinute
+// requests to each audited method, refilled at perMinute requests a minute, or nil
+// when the rate limit is disabled.
+func newMutationRateLimiter(perMinute uint64) *leakybucket.Collector {
+	if perMinute == 0 {
+		return nil
+	}
+	return leakybucket.NewCollector(float64(perMinute)/60, int64(perMinute), false /* deleteEmptyBuckets */)
+}
+
+// AuditInterceptor is a gRPC unary interceptor rate limiting the methods in the
+// auditedPaths configuration map, and recording who called them in the audit trail.
+// It runs before the JWT interceptor, so rejected attempts are recorded as well.
+func (s *Server) AuditInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		describe, ok := auditedPaths[info.FullMethod]
+		if !ok {
+			return handler(ctx, req)
+		}
+		var resp interface{}
+		var err error
+		if s.mutationRateLimiter != nil && s.mutationRateLimiter.Add(rateLimitKey(ctx, info.FullMethod), 1) == 0 {
+			err = errRateLimited
+		} else {
+			resp, err = handler(ctx, req)
+		}
+		s.audit(ctx, info.FullMethod, describe(req, resp), err)
+		return resp, err
+	}
+}
+
+// rateLimitKey returns the key of the rate limit bucket of the method for the host
+// of the client, so a client cannot exhaust the limit of others nor reset its own
+// by reconnecting from another port.
+func rateLimitKey(ctx context.Context, method string) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return method
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+	return host + method
+}
+
+// audit records the request in the log of the validator, and in the audit log file
+// if one is configured.
+func (s *Server) audit(ctx context.Context, method, change string, err error) {
+	entry := &auditEntry{
+		Time:          timeutils.Now(),
+		Method:        method,
+		Authenticated: s.authorize(ctx) == nil,
+		Change:        change,
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		entry.Peer = p.Addr.String()
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if agents := md.Get("user-agent"); len(agents) > 0 {
+			entry.UserAgent = agents[0]
+		}
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+
+	fields := logrus.Fields{
+		"method":        entry.Method,
+		"peer":          entry.Peer,
+		"authenticated": entry.Authenticated,
+		"change":        entry.Change,
+	}
+	if err != nil {
+		log.WithFields(fields).WithError(err).Warn("Rejected audited RPC request")
+	} else {
+		log.WithFields(fields).Info("Audited RPC request")
+	}
+	if s.auditLog != nil {
+		if err := s.auditLog.append(entry); err != nil {
+			log.WithError(err).Error("Could not write to audit log")
+		}
+	}
+}
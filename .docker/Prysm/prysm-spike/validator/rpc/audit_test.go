@@ -0,0 +1,124 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestServer_AuditInterceptor_RateLimit(t *testing.T) {
+	s := &Server{
+		jwtKey:              []byte("testKey"),
+		mutationRateLimiter: newMutationRateLimiter(2),
+	}
+	interceptor := s.AuditInterceptor()
+	calls := 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		calls++
+		return nil, nil
+	}
+	changePassword := &grpc.UnaryServerInfo{FullMethod: "/ethereum.validator.accounts.v2.Accounts/ChangePassword"}
+	for i := 0; i < 2; i++ {
+		_, err := interceptor(context.Background(), &pb.ChangePasswordRequest{}, changePassword, handler)
+		require.NoError(t, err)
+	}
+	_, err := interceptor(context.Background(), &pb.ChangePasswordRequest{}, changePassword, handler)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Equal(t, 2, calls)
+
+	// Each method has its own limit, and other methods have none.
+	signup := &grpc.UnaryServerInfo{FullMethod: "/ethereum.validator.accounts.v2.Auth/Signup"}
+	_, err = interceptor(context.Background(), &pb.AuthRequest{}, signup, handler)
+	require.NoError(t, err)
+	listAccounts := &grpc.UnaryServerInfo{FullMethod: "/ethereum.validator.accounts.v2.Accounts/ListAccounts"}
+	for i := 0; i < 5; i++ {
+		_, err = interceptor(context.Background(), &pb.ListAccountsRequest{}, listAccounts, handler)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 8, calls)
+
+	// Each client has its own limit, whichever port it connects from.
+	client := func(ip net.IP, port int) context.Context {
+		return peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: ip, Port: port}})
+	}
+	for i := 0; i < 2; i++ {
+		_, err = interceptor(client(net.IPv4(10, 0, 0, 1), 7500+i), &pb.ChangePasswordRequest{}, changePassword, handler)
+		require.NoError(t, err)
+	}
+	_, err = interceptor(client(net.IPv4(10, 0, 0, 1), 7502), &pb.ChangePasswordRequest{}, changePassword, handler)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	_, err = interceptor(client(net.IPv4(10, 0, 0, 2), 7500), &pb.ChangePasswordRequest{}, changePassword, handler)
+	require.NoError(t, err)
+	assert.Equal(t, 11, calls)
+}
+
+func TestServer_AuditInterceptor_AuditLog(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	s := &Server{
+		jwtKey:   []byte("testKey"),
+		auditLog: &auditLog{path: path},
+	}
+	interceptor := s.AuditInterceptor()
+	token, _, err := s.createTokenString()
+	require.NoError(t, err)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		"authorization", "Bearer "+token,
+		"user-agent", "test-agent",
+	))
+	ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 7500}})
+
+	importKeystores := &grpc.UnaryServerInfo{FullMethod: "/ethereum.validator.accounts.v2.Wallet/ImportKeystores"}
+	_, err = interceptor(ctx, &pb.ImportKeystoresRequest{KeystoresPassword: "secret"}, importKeystores,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return &pb.ImportKeystoresResponse{ImportedPublicKeys: [][]byte{{1, 2}, {3, 4}}}, nil
+		})
+	require.NoError(t, err)
+	createWallet := &grpc.UnaryServerInfo{FullMethod: "/ethereum.validator.accounts.v2.Wallet/CreateWallet"}
+	_, err = interceptor(context.Background(), &pb.CreateWalletRequest{
+		Keymanager:     pb.KeymanagerKind_DERIVED,
+		NumAccounts:    3,
+		WalletPassword: "secret",
+	}, createWallet, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Error(codes.Unauthenticated, "denied")
+	})
+	require.ErrorContains(t, "denied", err)
+	walletConfig := &grpc.UnaryServerInfo{FullMethod: "/ethereum.validator.accounts.v2.Wallet/WalletConfig"}
+	_, err = interceptor(ctx, nil, walletConfig, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	require.NoError(t, err)
+
+	enc, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, false, strings.Contains(string(enc), "secret"), "Audit log includes a password")
+	lines := strings.Split(strings.TrimSpace(string(enc)), "\n")
+	require.Equal(t, 2, len(lines))
+	entries := make([]*auditEntry, len(lines))
+	for i, line := range lines {
+		entries[i] = &auditEntry{}
+		require.NoError(t, json.Unmarshal([]byte(line), entries[i]))
+	}
+	assert.Equal(t, importKeystores.FullMethod, entries[0].Method)
+	assert.Equal(t, "10.0.0.1:7500", entries[0].Peer)
+	assert.Equal(t, "test-agent", entries[0].UserAgent)
+	assert.Equal(t, true, entries[0].Authenticated)
+	assert.Equal(t, "import keystores 0x0102,0x0304", entries[0].Change)
+	assert.Equal(t, "", entries[0].Error)
+	assert.Equal(t, createWallet.FullMethod, entries[1].Method)
+	assert.Equal(t, false, entries[1].Authenticated)
+	assert.Equal(t, "create derived wallet with 3 accounts", entries[1].Change)
+	assert.Equal(t, "rpc error: code = Unauthenticated desc = denied", entries[1].Error)
+}
@@ -23,6 +23,7 @@ type Gateway struct {
 	gatewayAddr    string
 	remoteAddr     string
 	server         *http.Server
+	conn           *grpc.ClientConn
 	mux            *http.ServeMux
 	allowedOrigins []string
 	startFailure   error
@@ -62,12 +63,28 @@ func (g *Gateway) Start() {
 		pb.RegisterWalletHandlerFromEndpoint,
 		pb.RegisterHealthHandlerFromEndpoint,
 		pb.RegisterAccountsHandlerFromEndpoint,
+		pb.RegisterPerformanceHandlerFromEndpoint,
 	}
 	for _, h := range handlers {
 		if err := h(ctx, gwmux, g.remoteAddr, opts); err != nil {
 			log.Fatalf("Could not register API handler with grpc endpoint: %v", err)
 		}
 	}
+	conn, err := grpc.DialContext(ctx, g.remoteAddr, opts...)
+	if err != nil {
+		log.Fatalf("Could not dial grpc endpoint: %v", err)
+	}
+	g.conn = conn
+	slashingProtectionClient := pb.NewSlashingProtectionClient(conn)
+	g.mux.Handle(slashingProtectionExportPath, g.corsMiddleware(
+		slashingProtectionExportHandler(slashingProtectionClient),
+	))
+	g.mux.Handle(slashingProtectionConflictsPath, g.corsMiddleware(
+		slashingProtectionConflictsHandler(slashingProtectionClient),
+	))
+	g.mux.Handle(keystoresPath, g.corsMiddleware(
+		keystoresHandler(pb.NewKeyManagementClient(conn)),
+	))
 	apiHandler := g.corsMiddleware(gwmux)
 	g.mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
 		if strings.HasPrefix(r.URL.Path, "/api") {
@@ -105,6 +122,11 @@ func (g *Gateway) Stop() error {
 	if err := g.server.Shutdown(g.ctx); err != nil {
 		log.WithError(err).Error("Failed to shut down server")
 	}
+	if g.conn != nil {
+		if err := g.conn.Close(); err != nil {
+			log.WithError(err).Error("Failed to close connection to the gRPC server")
+		}
+	}
 
 	if g.cancel != nil {
 		g.cancel()
@@ -116,7 +138,7 @@ func (g *Gateway) Stop() error {
 func (g *Gateway) corsMiddleware(h http.Handler) http.Handler {
 	c := cors.New(cors.Options{
 		AllowedOrigins:   g.allowedOrigins,
-		AllowedMethods:   []string{http.MethodPost, http.MethodGet, http.MethodOptions},
+		AllowedMethods:   []string{http.MethodPost, http.MethodGet, http.MethodDelete, http.MethodOptions},
 		AllowCredentials: true,
 		MaxAge:           600,
 		AllowedHeaders:   []string{"*"},
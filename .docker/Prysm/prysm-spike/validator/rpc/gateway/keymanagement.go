@@ -0,0 +1,163 @@
+package gateway
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2_gateway"
+	"google.golang.org/grpc/status"
+)
+
+// keystoresPath is the route of the standard key manager API of validator clients.
+const keystoresPath = "/eth/v1/keystores"
+
+type keystoreJSON struct {
+	ValidatingPubkey string `json:"validating_pubkey"`
+	DerivationPath   string `json:"derivation_path,omitempty"`
+	Readonly         bool   `json:"readonly"`
+}
+
+type listKeystoresJSON struct {
+	Data []*keystoreJSON `json:"data"`
+}
+
+type importKeystoresRequestJSON struct {
+	Keystores          []string `json:"keystores"`
+	Passwords          []string `json:"passwords"`
+	SlashingProtection string   `json:"slashing_protection"`
+}
+
+type deleteKeystoresRequestJSON struct {
+	Pubkeys []string `json:"pubkeys"`
+}
+
+type keystoreStatusJSON struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+type importKeystoresResponseJSON struct {
+	Data []*keystoreStatusJSON `json:"data"`
+}
+
+type deleteKeystoresResponseJSON struct {
+	Data               []*keystoreStatusJSON `json:"data"`
+	SlashingProtection string                `json:"slashing_protection"`
+}
+
+// keystoresHandler serves the standard key manager API of validator clients, so external tooling
+// and staking dashboards can list, import and delete the keys of the wallet. It translates between
+// the JSON of the standard, which has hex encoded keys and lower case statuses, and the gRPC
+// server, which authenticates the calls with the forwarded authorization header as any other.
+func keystoresHandler(client pb.KeyManagementClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var resp interface{}
+		var err error
+		switch r.Method {
+		case http.MethodGet:
+			resp, err = listKeystores(r, client)
+		case http.MethodPost:
+			resp, err = importKeystores(w, r, client)
+		case http.MethodDelete:
+			resp, err = deleteKeystores(w, r, client)
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		if err != nil {
+			writeKeystoresError(w, err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			log.WithError(err).Error("Could not write key manager API response")
+		}
+	}
+}
+
+func listKeystores(r *http.Request, client pb.KeyManagementClient) (*listKeystoresJSON, error) {
+	resp, err := client.ListKeystores(outgoingContext(r), &empty.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	keystores := make([]*keystoreJSON, len(resp.Keystores))
+	for i, keystore := range resp.Keystores {
+		keystores[i] = &keystoreJSON{
+			ValidatingPubkey: fmt.Sprintf("%#x", keystore.ValidatingPubkey),
+			DerivationPath:   keystore.DerivationPath,
+			Readonly:         keystore.Readonly,
+		}
+	}
+	return &listKeystoresJSON{Data: keystores}, nil
+}
+
+func importKeystores(w http.ResponseWriter, r *http.Request, client pb.KeyManagementClient) (*importKeystoresResponseJSON, error) {
+	req := &importKeystoresRequestJSON{}
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxInterchangeFileSize)).Decode(req); err != nil {
+		return nil, badRequestError{fmt.Errorf("could not decode request: %v", err)}
+	}
+	resp, err := client.ImportStandardKeystores(outgoingContext(r), &pb.ImportStandardKeystoresRequest{
+		Keystores:          req.Keystores,
+		Passwords:          req.Passwords,
+		SlashingProtection: req.SlashingProtection,
+	})
+	if err != nil {
+		return nil, err
+	}
+	statuses := make([]*keystoreStatusJSON, len(resp.Statuses))
+	for i, s := range resp.Statuses {
+		statuses[i] = &keystoreStatusJSON{Status: strings.ToLower(s.Status.String()), Message: s.Message}
+	}
+	return &importKeystoresResponseJSON{Data: statuses}, nil
+}
+
+func deleteKeystores(w http.ResponseWriter, r *http.Request, client pb.KeyManagementClient) (*deleteKeystoresResponseJSON, error) {
+	req := &deleteKeystoresRequestJSON{}
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxInterchangeFileSize)).Decode(req); err != nil {
+		return nil, badRequestError{fmt.Errorf("could not decode request: %v", err)}
+	}
+	pubKeys := make([][]byte, len(req.Pubkeys))
+	for i, key := range req.Pubkeys {
+		pubKey, err := hex.DecodeString(strings.TrimPrefix(key, "0x"))
+		if err != nil {
+			return nil, badRequestError{fmt.Errorf("could not decode public key %s: %v", key, err)}
+		}
+		pubKeys[i] = pubKey
+	}
+	resp, err := client.DeleteKeystores(outgoingContext(r), &pb.DeleteKeystoresRequest{PublicKeys: pubKeys})
+	if err != nil {
+		return nil, err
+	}
+	statuses := make([]*keystoreStatusJSON, len(resp.Statuses))
+	for i, s := range resp.Statuses {
+		statuses[i] = &keystoreStatusJSON{Status: strings.ToLower(s.Status.String()), Message: s.Message}
+	}
+	return &deleteKeystoresResponseJSON{Data: statuses, SlashingProtection: resp.SlashingProtection}, nil
+}
+
+// badRequestError is an error of the request itself, rather than of the gRPC call.
+type badRequestError struct {
+	error
+}
+
+// writeKeystoresError writes the error in the {"message": ...} form of the standard, with the
+// HTTP status of the gRPC status code.
+func writeKeystoresError(w http.ResponseWriter, err error) {
+	code := http.StatusBadRequest
+	message := err.Error()
+	if _, ok := err.(badRequestError); !ok {
+		s, _ := status.FromError(err)
+		code = gwruntime.HTTPStatusFromCode(s.Code())
+		message = s.Message()
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(map[string]string{"message": message}); err != nil {
+		log.WithError(err).Error("Could not write key manager API error")
+	}
+}
@@ -0,0 +1,96 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2_gateway"
+	interchangeformat "github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// slashingProtectionExportPath is the route serving the slashing protection export as a download.
+	slashingProtectionExportPath = "/api/v2/validator/slashing-protection/export"
+	// slashingProtectionConflictsPath is the route comparing an uploaded export to the slashing protection history.
+	slashingProtectionConflictsPath = "/api/v2/validator/slashing-protection/conflicts"
+	// Interchange files of many keys over a long time span can be large, but they are still bounded.
+	maxInterchangeFileSize = 256 << 20
+)
+
+// slashingProtectionExportHandler serves the EIP-3076 export of the slashing protection history
+// as a JSON file attachment, so backups can be taken with a plain HTTP client. The authorization
+// header of the request is forwarded to the gRPC server, which authenticates the call as any other.
+func slashingProtectionExportHandler(client pb.SlashingProtectionClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		resp, err := client.ExportSlashingProtection(outgoingContext(r), &empty.Empty{})
+		if err != nil {
+			s, _ := status.FromError(err)
+			http.Error(w, s.Message(), gwruntime.HTTPStatusFromCode(s.Code()))
+			return
+		}
+		fileName := fmt.Sprintf("slashing_protection_%d.json", time.Now().Unix())
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fileName))
+		if _, err := w.Write([]byte(resp.File)); err != nil {
+			log.WithError(err).Error("Could not write slashing protection export")
+		}
+	}
+}
+
+// slashingProtectionConflictsHandler compares the EIP-3076 export of another validator client in
+// the request body to the slashing protection history of this one, and responds with the keys both
+// signed with and their slashable records. Operators check it before moving keys between machines.
+func slashingProtectionConflictsHandler(client pb.SlashingProtectionClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		other := &interchangeformat.EIPSlashingProtectionFormat{}
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxInterchangeFileSize)).Decode(other); err != nil {
+			http.Error(w, fmt.Sprintf("Could not decode slashing protection JSON: %v", err), http.StatusBadRequest)
+			return
+		}
+		resp, err := client.ExportSlashingProtection(outgoingContext(r), &empty.Empty{})
+		if err != nil {
+			s, _ := status.FromError(err)
+			http.Error(w, s.Message(), gwruntime.HTTPStatusFromCode(s.Code()))
+			return
+		}
+		local := &interchangeformat.EIPSlashingProtectionFormat{}
+		if err := json.Unmarshal([]byte(resp.File), local); err != nil {
+			http.Error(w, fmt.Sprintf("Could not decode slashing protection history: %v", err), http.StatusInternalServerError)
+			return
+		}
+		report, err := interchangeformat.FindConflicts(local, other)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(report); err != nil {
+			log.WithError(err).Error("Could not write slashing protection conflicts")
+		}
+	}
+}
+
+// outgoingContext forwards the authorization header of the request to the gRPC server, which
+// authenticates the call as any other.
+func outgoingContext(r *http.Request) context.Context {
+	ctx := r.Context()
+	if auth := r.Header.Get("Authorization"); auth != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", auth)
+	}
+	return ctx
+}
@@ -6,7 +6,12 @@ import (
 	"time"
 
 	ptypes "github.com/gogo/protobuf/types"
+	sharedpb "github.com/prysmaticlabs/prysm/proto/shared/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/configdump"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // GetBeaconNodeConnection retrieves the current beacon node connection
@@ -45,3 +50,35 @@ func (s *Server) GetLogsEndpoints(ctx context.Context, _ *ptypes.Empty) (*pb.Log
 		ValidatorLogsEndpoint: fmt.Sprintf("%s:%d/logs", s.validatorMonitoringHost, s.validatorMonitoringPort),
 	}, nil
 }
+
+// ListFeatures returns the feature flags of the validator client with their values and
+// where they were set.
+func (s *Server) ListFeatures(_ context.Context, _ *ptypes.Empty) (*sharedpb.FeaturesResponse, error) {
+	return &sharedpb.FeaturesResponse{Features: featureconfig.FeaturesProto(featureconfig.ActiveFeatures())}, nil
+}
+
+// GetRunningConfig returns the configuration the validator client runs with, resolved from
+// its flags, config file, feature flags and chain config, with secrets redacted.
+func (s *Server) GetRunningConfig(_ context.Context, _ *ptypes.Empty) (*sharedpb.RunningConfig, error) {
+	if s.runningConfig == nil {
+		return nil, status.Error(codes.Unavailable, "Running configuration is not available")
+	}
+	c, err := s.runningConfig()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not resolve configuration: %v", err)
+	}
+	return configdump.RunningConfigProto(c), nil
+}
+
+// GetInventory returns the version, chain config hash, genesis validators root, data directory
+// disk usage, feature flags and service statuses of the validator client.
+func (s *Server) GetInventory(_ context.Context, _ *ptypes.Empty) (*sharedpb.Inventory, error) {
+	if s.inventory == nil {
+		return nil, status.Error(codes.Unavailable, "Node inventory is not available")
+	}
+	inv, err := s.inventory()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not build node inventory: %v", err)
+	}
+	return inv, nil
+}
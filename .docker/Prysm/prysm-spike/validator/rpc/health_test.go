@@ -7,7 +7,10 @@ import (
 
 	ptypes "github.com/gogo/protobuf/types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	sharedpb "github.com/prysmaticlabs/prysm/proto/shared/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/configdump"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 	"github.com/prysmaticlabs/prysm/validator/client"
 )
@@ -79,3 +82,42 @@ func TestServer_GetLogsEndpoints(t *testing.T) {
 	}
 	require.DeepEqual(t, want, got)
 }
+
+func TestServer_GetRunningConfig(t *testing.T) {
+	c := &configdump.Config{
+		Binary:  "validator",
+		Version: "v1.0.0",
+		Flags: []*configdump.Flag{
+			{Name: "graffiti", Value: "prysm", Set: true},
+		},
+	}
+	s := &Server{
+		runningConfig: func() (*configdump.Config, error) {
+			return c, nil
+		},
+	}
+	res, err := s.GetRunningConfig(context.Background(), &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, configdump.RunningConfigProto(c), res)
+
+	_, err = (&Server{}).GetRunningConfig(context.Background(), &ptypes.Empty{})
+	assert.ErrorContains(t, "Running configuration is not available", err)
+}
+
+func TestServer_GetInventory(t *testing.T) {
+	inv := &sharedpb.Inventory{
+		Binary:                "validator",
+		GenesisValidatorsRoot: []byte{0x01, 0x02},
+	}
+	s := &Server{
+		inventory: func() (*sharedpb.Inventory, error) {
+			return inv, nil
+		},
+	}
+	res, err := s.GetInventory(context.Background(), &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, inv, res)
+
+	_, err = (&Server{}).GetInventory(context.Background(), &ptypes.Empty{})
+	assert.ErrorContains(t, "Node inventory is not available", err)
+}
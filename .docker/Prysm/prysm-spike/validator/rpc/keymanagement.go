@@ -0,0 +1,242 @@
+package rpc
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	ptypes "github.com/gogo/protobuf/types"
+	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/validator/accounts"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/derived"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
+	interchangeformat "github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListKeystores lists the validating keys of the wallet. Keys of remote wallets are read only, as
+// they are managed by the remote signer.
+func (s *Server) ListKeystores(ctx context.Context, _ *ptypes.Empty) (*pb.ListKeystoresResponse, error) {
+	if !s.walletInitialized {
+		return nil, status.Error(codes.FailedPrecondition, "Wallet not yet initialized")
+	}
+	keys, err := s.keymanager.FetchAllValidatingPublicKeys(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not list validating keys: %v", err)
+	}
+	kind := s.wallet.KeymanagerKind()
+	keystores := make([]*pb.ListKeystoresResponse_Keystore, len(keys))
+	for i := 0; i < len(keys); i++ {
+		keystores[i] = &pb.ListKeystoresResponse_Keystore{
+			ValidatingPubkey: keys[i][:],
+			Readonly:         kind == keymanager.Remote || kind == keymanager.Web3Signer,
+		}
+		if kind == keymanager.Derived {
+			keystores[i].DerivationPath = fmt.Sprintf(derived.ValidatingKeyDerivationPathTemplate, i)
+		}
+	}
+	return &pb.ListKeystoresResponse{
+		Keystores: keystores,
+	}, nil
+}
+
+// ImportStandardKeystores imports EIP-2335 keystores, each with its own password, into an imported
+// wallet. The slashing protection history in the request is imported first, so the keys never sign
+// without it. Each keystore gets a status rather than failing the whole request, and keystores are
+// decrypted before they reach the keymanager, which would otherwise prompt for wrong passwords.
+func (s *Server) ImportStandardKeystores(
+	ctx context.Context, req *pb.ImportStandardKeystoresRequest,
+) (*pb.ImportStandardKeystoresResponse, error) {
+	if !s.walletInitialized {
+		return nil, status.Error(codes.FailedPrecondition, "Wallet not yet initialized")
+	}
+	km, ok := s.keymanager.(*imported.Keymanager)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "Only imported wallets can import keystores")
+	}
+	if s.valDB == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Validator database is not initialized")
+	}
+	if len(req.Keystores) != len(req.Passwords) {
+		return nil, status.Errorf(
+			codes.InvalidArgument, "Number of keystores and passwords is not equal: %d != %d", len(req.Keystores), len(req.Passwords),
+		)
+	}
+	if req.SlashingProtection != "" {
+		if err := interchangeformat.ImportStandardProtectionJSON(ctx, s.valDB, strings.NewReader(req.SlashingProtection)); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Could not import slashing protection history: %v", err)
+		}
+	}
+	existingKeys, err := km.FetchAllValidatingPublicKeys(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not list validating keys: %v", err)
+	}
+	seen := make(map[[48]byte]bool, len(existingKeys)+len(req.Keystores))
+	for _, key := range existingKeys {
+		seen[key] = true
+	}
+
+	statuses := make([]*pb.ImportedKeystoreStatus, len(req.Keystores))
+	// Keystores sharing a password are imported together, as each import re-encrypts the wallet.
+	keystoresByPassword := make(map[string][]*keymanager.Keystore)
+	indicesByPassword := make(map[string][]int)
+	decryptor := keystorev4.New()
+	for i, encoded := range req.Keystores {
+		keystore := &keymanager.Keystore{}
+		if err := json.Unmarshal([]byte(encoded), keystore); err != nil {
+			statuses[i] = importError("Not a valid EIP-2335 keystore JSON file: %v", err)
+			continue
+		}
+		privKeyBytes, err := decryptor.Decrypt(keystore.Crypto, req.Passwords[i])
+		if err != nil {
+			statuses[i] = importError("Could not decrypt keystore: %v", err)
+			continue
+		}
+		privKey, err := bls.SecretKeyFromBytes(privKeyBytes)
+		if err != nil {
+			statuses[i] = importError("Not a valid BLS private key in keystore: %v", err)
+			continue
+		}
+		pubKey := bytesutil.ToBytes48(privKey.PublicKey().Marshal())
+		if keystore.Pubkey != "" && strings.TrimPrefix(keystore.Pubkey, "0x") != hex.EncodeToString(pubKey[:]) {
+			statuses[i] = importError("Public key of keystore does not match its private key")
+			continue
+		}
+		if seen[pubKey] {
+			statuses[i] = &pb.ImportedKeystoreStatus{
+				Status:  pb.ImportedKeystoreStatus_DUPLICATE,
+				Message: fmt.Sprintf("Key %#x is already in the wallet", pubKey),
+			}
+			continue
+		}
+		seen[pubKey] = true
+		keystore.Pubkey = hex.EncodeToString(pubKey[:])
+		keystoresByPassword[req.Passwords[i]] = append(keystoresByPassword[req.Passwords[i]], keystore)
+		indicesByPassword[req.Passwords[i]] = append(indicesByPassword[req.Passwords[i]], i)
+	}
+
+	importedAny := false
+	for password, keystores := range keystoresByPassword {
+		err := accounts.ImportAccounts(ctx, &accounts.ImportAccountsConfig{
+			Keymanager:      km,
+			Keystores:       keystores,
+			AccountPassword: password,
+			ValidatorDB:     s.valDB,
+		})
+		for _, i := range indicesByPassword[password] {
+			if err != nil {
+				statuses[i] = importError("Could not import keystore: %v", err)
+				continue
+			}
+			statuses[i] = &pb.ImportedKeystoreStatus{Status: pb.ImportedKeystoreStatus_IMPORTED}
+		}
+		importedAny = importedAny || err == nil
+	}
+	if importedAny {
+		s.walletInitializedFeed.Send(s.wallet)
+	}
+	return &pb.ImportStandardKeystoresResponse{
+		Statuses: statuses,
+	}, nil
+}
+
+// DeleteKeystores deletes keys from an imported or derived wallet, and returns the slashing
+// protection history of the requested keys so they can be safely used by another validator
+// client. Keys which are not in the wallet but have a history are reported as not active, the
+// others as not found. The history of deleted keys is archived in the wallet as well.
+func (s *Server) DeleteKeystores(
+	ctx context.Context, req *pb.DeleteKeystoresRequest,
+) (*pb.DeleteKeystoresResponse, error) {
+	if !s.walletInitialized {
+		return nil, status.Error(codes.FailedPrecondition, "Wallet not yet initialized")
+	}
+	if kind := s.wallet.KeymanagerKind(); kind != keymanager.Imported && kind != keymanager.Derived {
+		return nil, status.Error(codes.FailedPrecondition, "Only imported and derived wallets can delete keystores")
+	}
+	if s.valDB == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Validator database is not initialized")
+	}
+	existingKeys, err := s.keymanager.FetchAllValidatingPublicKeys(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not list validating keys: %v", err)
+	}
+	existing := make(map[[48]byte]bool, len(existingKeys))
+	for _, key := range existingKeys {
+		existing[key] = true
+	}
+	var deletePublicKeys [][]byte
+	for i, key := range req.PublicKeys {
+		if len(key) != 48 {
+			return nil, status.Errorf(codes.InvalidArgument, "Public key %d has wrong length", i)
+		}
+		if existing[bytesutil.ToBytes48(key)] {
+			deletePublicKeys = append(deletePublicKeys, key)
+		}
+	}
+	var deleteErr error
+	if len(deletePublicKeys) > 0 {
+		deleteErr = accounts.DeleteAccount(ctx, &accounts.AccountsConfig{
+			Wallet:           s.wallet,
+			Keymanager:       s.keymanager,
+			DeletePublicKeys: deletePublicKeys,
+			ValidatorDB:      s.valDB,
+		})
+	}
+
+	eipJSON, err := interchangeformat.ExportStandardProtectionJSON(ctx, s.valDB)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not export slashing protection history: %v", err)
+	}
+	requested := make(map[string]bool, len(req.PublicKeys))
+	for _, key := range req.PublicKeys {
+		requested[fmt.Sprintf("%#x", key)] = true
+	}
+	withHistory := make(map[string]bool, len(req.PublicKeys))
+	data := make([]*interchangeformat.ProtectionData, 0, len(req.PublicKeys))
+	for _, item := range eipJSON.Data {
+		if requested[item.Pubkey] {
+			withHistory[item.Pubkey] = true
+			data = append(data, item)
+		}
+	}
+	eipJSON.Data = data
+	encoded, err := json.MarshalIndent(eipJSON, "", "\t")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not encode slashing protection history: %v", err)
+	}
+
+	statuses := make([]*pb.DeletedKeystoreStatus, len(req.PublicKeys))
+	for i, key := range req.PublicKeys {
+		switch {
+		case existing[bytesutil.ToBytes48(key)] && deleteErr != nil:
+			statuses[i] = &pb.DeletedKeystoreStatus{
+				Status:  pb.DeletedKeystoreStatus_ERROR,
+				Message: fmt.Sprintf("Could not delete keystore: %v", deleteErr),
+			}
+		case existing[bytesutil.ToBytes48(key)]:
+			statuses[i] = &pb.DeletedKeystoreStatus{Status: pb.DeletedKeystoreStatus_DELETED}
+		case withHistory[fmt.Sprintf("%#x", key)]:
+			statuses[i] = &pb.DeletedKeystoreStatus{Status: pb.DeletedKeystoreStatus_NOT_ACTIVE}
+		default:
+			statuses[i] = &pb.DeletedKeystoreStatus{Status: pb.DeletedKeystoreStatus_NOT_FOUND}
+		}
+	}
+	return &pb.DeleteKeystoresResponse{
+		Statuses:           statuses,
+		SlashingProtection: string(encoded),
+	}, nil
+}
+
+func importError(format string, args ...interface{}) *pb.ImportedKeystoreStatus {
+	return &pb.ImportedKeystoreStatus{
+		Status:  pb.ImportedKeystoreStatus_ERROR,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
@@ -0,0 +1,162 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/accounts"
+	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
+	interchangeformat "github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+func createStandardKeystore(t *testing.T, password string) (string, [48]byte) {
+	encryptor := keystorev4.New()
+	privKey, err := bls.RandKey()
+	require.NoError(t, err)
+	id, err := uuid.NewRandom()
+	require.NoError(t, err)
+	cryptoFields, err := encryptor.Encrypt(privKey.Marshal(), password)
+	require.NoError(t, err)
+	encoded, err := json.Marshal(&keymanager.Keystore{
+		Crypto:  cryptoFields,
+		ID:      id.String(),
+		Version: encryptor.Version(),
+		Pubkey:  fmt.Sprintf("%x", privKey.PublicKey().Marshal()),
+		Name:    encryptor.Name(),
+	})
+	require.NoError(t, err)
+	return string(encoded), bytesutil.ToBytes48(privKey.PublicKey().Marshal())
+}
+
+func setupKeyManagementServer(t *testing.T) *Server {
+	imported.ResetCaches()
+	defaultWalletPath = setupWalletDir(t)
+	ctx := context.Background()
+	w, err := accounts.CreateWalletWithKeymanager(ctx, &accounts.CreateWalletConfig{
+		WalletCfg: &wallet.Config{
+			WalletDir:      defaultWalletPath,
+			KeymanagerKind: keymanager.Imported,
+			WalletPassword: "29384283xasjasd32%%&*@*#*",
+		},
+		SkipMnemonicConfirm: true,
+	})
+	require.NoError(t, err)
+	km, err := w.InitializeKeymanager(ctx)
+	require.NoError(t, err)
+	return &Server{
+		valDB:                 dbtest.SetupDB(t, nil),
+		keymanager:            km,
+		wallet:                w,
+		walletInitialized:     true,
+		walletInitializedFeed: new(event.Feed),
+	}
+}
+
+func TestServer_ImportStandardKeystores(t *testing.T) {
+	ctx := context.Background()
+	s := setupKeyManagementServer(t)
+	first, firstKey := createStandardKeystore(t, "first")
+	second, secondKey := createStandardKeystore(t, "second")
+	wrongPassword, _ := createStandardKeystore(t, "third")
+	slashingProtection := fmt.Sprintf(`{
+		"metadata": {"interchange_format_version": "5", "genesis_validators_root": "%#x"},
+		"data": [{"pubkey": "%#x", "signed_blocks": [{"slot": "5"}], "signed_attestations": []}]
+	}`, make([]byte, 32), firstKey)
+
+	resp, err := s.ImportStandardKeystores(ctx, &pb.ImportStandardKeystoresRequest{
+		Keystores:          []string{first, second, wrongPassword, first, "badjson"},
+		Passwords:          []string{"first", "second", "wrong", "first", ""},
+		SlashingProtection: slashingProtection,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 5, len(resp.Statuses))
+	assert.Equal(t, pb.ImportedKeystoreStatus_IMPORTED, resp.Statuses[0].Status)
+	assert.Equal(t, pb.ImportedKeystoreStatus_IMPORTED, resp.Statuses[1].Status)
+	assert.Equal(t, pb.ImportedKeystoreStatus_ERROR, resp.Statuses[2].Status)
+	assert.Equal(t, pb.ImportedKeystoreStatus_DUPLICATE, resp.Statuses[3].Status)
+	assert.Equal(t, pb.ImportedKeystoreStatus_ERROR, resp.Statuses[4].Status)
+
+	keys, err := s.keymanager.FetchAllValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(keys))
+	walletKeys := map[[48]byte]bool{keys[0]: true, keys[1]: true}
+	assert.Equal(t, true, walletKeys[firstKey])
+	assert.Equal(t, true, walletKeys[secondKey])
+	_, proposed, err := s.valDB.ProposalHistoryForSlot(ctx, firstKey, 5)
+	require.NoError(t, err)
+	assert.Equal(t, true, proposed, "Slashing protection history was not imported")
+
+	resp, err = s.ImportStandardKeystores(ctx, &pb.ImportStandardKeystoresRequest{
+		Keystores: []string{second},
+		Passwords: []string{"second"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, pb.ImportedKeystoreStatus_DUPLICATE, resp.Statuses[0].Status, "Keys in the wallet are duplicates")
+}
+
+func TestServer_ImportStandardKeystores_FailedPreconditions(t *testing.T) {
+	ctx := context.Background()
+	s := setupKeyManagementServer(t)
+	_, err := s.ImportStandardKeystores(ctx, &pb.ImportStandardKeystoresRequest{
+		Keystores: []string{"{}"},
+	})
+	assert.ErrorContains(t, "Number of keystores and passwords is not equal", err)
+	_, err = s.ImportStandardKeystores(ctx, &pb.ImportStandardKeystoresRequest{
+		SlashingProtection: "badjson",
+	})
+	assert.ErrorContains(t, "Could not import slashing protection history", err)
+	s.walletInitialized = false
+	_, err = s.ImportStandardKeystores(ctx, &pb.ImportStandardKeystoresRequest{})
+	assert.ErrorContains(t, "Wallet not yet initialized", err)
+}
+
+func TestServer_DeleteKeystores(t *testing.T) {
+	ctx := context.Background()
+	s := setupKeyManagementServer(t)
+	keystore, pubKey := createStandardKeystore(t, "password")
+	_, err := s.ImportStandardKeystores(ctx, &pb.ImportStandardKeystoresRequest{
+		Keystores: []string{keystore},
+		Passwords: []string{"password"},
+	})
+	require.NoError(t, err)
+	require.NoError(t, s.valDB.SaveGenesisValidatorsRoot(ctx, make([]byte, 32)))
+	require.NoError(t, s.valDB.SaveProposalHistoryForSlot(ctx, pubKey, 5, make([]byte, 32)))
+	inactiveKey := [48]byte{1}
+	require.NoError(t, s.valDB.SaveProposalHistoryForSlot(ctx, inactiveKey, 6, make([]byte, 32)))
+	unknownKey := [48]byte{2}
+
+	resp, err := s.DeleteKeystores(ctx, &pb.DeleteKeystoresRequest{
+		PublicKeys: [][]byte{pubKey[:], inactiveKey[:], unknownKey[:]},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(resp.Statuses))
+	assert.Equal(t, pb.DeletedKeystoreStatus_DELETED, resp.Statuses[0].Status)
+	assert.Equal(t, pb.DeletedKeystoreStatus_NOT_ACTIVE, resp.Statuses[1].Status)
+	assert.Equal(t, pb.DeletedKeystoreStatus_NOT_FOUND, resp.Statuses[2].Status)
+
+	keys, err := s.keymanager.FetchAllValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(keys))
+	exported := &interchangeformat.EIPSlashingProtectionFormat{}
+	require.NoError(t, json.Unmarshal([]byte(resp.SlashingProtection), exported))
+	require.Equal(t, 2, len(exported.Data))
+	exportedKeys := map[string]bool{exported.Data[0].Pubkey: true, exported.Data[1].Pubkey: true}
+	assert.Equal(t, true, exportedKeys[fmt.Sprintf("%#x", pubKey)])
+	assert.Equal(t, true, exportedKeys[fmt.Sprintf("%#x", inactiveKey)])
+
+	_, err = s.DeleteKeystores(ctx, &pb.DeleteKeystoresRequest{PublicKeys: [][]byte{{1}}})
+	assert.ErrorContains(t, "has wrong length", err)
+}
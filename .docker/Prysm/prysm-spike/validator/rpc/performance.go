@@ -0,0 +1,107 @@
+package rpc
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListValidatorPerformance lists the attestation, balance and proposal stats the validator summed up for
+// the requested public keys since it started, or for all keys which had a duty if none are requested.
+func (s *Server) ListValidatorPerformance(
+	ctx context.Context, req *pb.ValidatorPerformanceRequest,
+) (*pb.ValidatorPerformanceResponse, error) {
+	if s.performanceFetcher == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Validator is not running")
+	}
+	requested := make(map[[48]byte]bool, len(req.PublicKeys))
+	for _, key := range req.PublicKeys {
+		if len(key) != 48 {
+			return nil, status.Errorf(codes.InvalidArgument, "Public key %#x is not 48 bytes long", key)
+		}
+		requested[bytesutil.ToBytes48(key)] = true
+	}
+	startEpoch, performances, err := s.performanceFetcher.KeyPerformances()
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Could not retrieve validator performance: %v", err)
+	}
+	resp := &pb.ValidatorPerformanceResponse{
+		StartEpoch:   startEpoch,
+		Performances: make([]*pb.KeyPerformance, 0, len(performances)),
+	}
+	for _, perf := range performances {
+		if len(requested) > 0 && !requested[perf.PublicKey] {
+			continue
+		}
+		var averageDistance float64
+		if perf.IncludedAttestations > 0 {
+			averageDistance = float64(perf.TotalInclusionDistance) / float64(perf.IncludedAttestations)
+		}
+		resp.Performances = append(resp.Performances, &pb.KeyPerformance{
+			PublicKey:                bytesutil.SafeCopyBytes(perf.PublicKey[:]),
+			Epochs:                   perf.Epochs,
+			IncludedAttestations:     perf.IncludedAttestations,
+			MissedAttestations:       perf.MissedAttestations,
+			AverageInclusionDistance: averageDistance,
+			CorrectSources:           perf.CorrectSources,
+			CorrectTargets:           perf.CorrectTargets,
+			CorrectHeads:             perf.CorrectHeads,
+			BalanceChange:            perf.BalanceChange,
+			ProposedBlocks:           perf.ProposedBlocks,
+			MissedProposals:          perf.MissedProposals,
+		})
+	}
+	return resp, nil
+}
+
+// ListMissedDuties lists the entries of the missed duty journal of the validator database matching
+// the request, so operators can audit the duties missed after the logs were rotated.
+func (s *Server) ListMissedDuties(ctx context.Context, req *pb.MissedDutiesRequest) (*pb.MissedDutiesResponse, error) {
+	if s.valDB == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Validator database not found")
+	}
+	filter := &kv.MissedDutyFilter{
+		Type:       kv.DutyType(req.Type),
+		Failure:    kv.DutyFailure(req.Failure),
+		StartEpoch: req.StartEpoch,
+		EndEpoch:   req.EndEpoch,
+		Limit:      int(req.Limit),
+	}
+	if len(req.PublicKeys) > 0 {
+		filter.PubKeys = make(map[string]bool, len(req.PublicKeys))
+		for _, key := range req.PublicKeys {
+			if len(key) != 48 {
+				return nil, status.Errorf(codes.InvalidArgument, "Public key %#x is not 48 bytes long", key)
+			}
+			filter.PubKeys[fmt.Sprintf("%#x", key)] = true
+		}
+	}
+	duties, err := s.valDB.MissedDuties(ctx, filter)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not read missed duties: %v", err)
+	}
+	resp := &pb.MissedDutiesResponse{Duties: make([]*pb.MissedDuty, len(duties))}
+	for i, duty := range duties {
+		pubKey, err := hex.DecodeString(strings.TrimPrefix(duty.PubKey, "0x"))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Invalid public key %s in missed duty journal", duty.PubKey)
+		}
+		resp.Duties[i] = &pb.MissedDuty{
+			Type:      string(duty.Type),
+			Slot:      duty.Slot,
+			Epoch:     duty.Epoch,
+			PublicKey: pubKey,
+			Failure:   string(duty.Failure),
+			Error:     duty.Error,
+			Timestamp: duty.Timestamp,
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,107 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/client"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
+)
+
+type mockPerformanceFetcher struct {
+	startEpoch   uint64
+	performances []*client.KeyPerformance
+}
+
+func (m *mockPerformanceFetcher) KeyPerformances() (uint64, []*client.KeyPerformance, error) {
+	return m.startEpoch, m.performances, nil
+}
+
+func TestServer_ListValidatorPerformance(t *testing.T) {
+	ctx := context.Background()
+	first, second := [48]byte{1}, [48]byte{2}
+	s := &Server{performanceFetcher: &mockPerformanceFetcher{
+		startEpoch: 5,
+		performances: []*client.KeyPerformance{
+			{
+				PublicKey:              first,
+				Epochs:                 4,
+				IncludedAttestations:   3,
+				MissedAttestations:     1,
+				TotalInclusionDistance: 4,
+				CorrectSources:         3,
+				CorrectTargets:         3,
+				CorrectHeads:           2,
+				BalanceChange:          -1000,
+				MissedProposals:        1,
+			},
+			{PublicKey: second, Epochs: 4, IncludedAttestations: 4, TotalInclusionDistance: 4, ProposedBlocks: 2},
+		},
+	}}
+
+	resp, err := s.ListValidatorPerformance(ctx, &pb.ValidatorPerformanceRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), resp.StartEpoch)
+	require.Equal(t, 2, len(resp.Performances))
+	require.DeepEqual(t, &pb.KeyPerformance{
+		PublicKey:                first[:],
+		Epochs:                   4,
+		IncludedAttestations:     3,
+		MissedAttestations:       1,
+		AverageInclusionDistance: 4.0 / 3,
+		CorrectSources:           3,
+		CorrectTargets:           3,
+		CorrectHeads:             2,
+		BalanceChange:            -1000,
+		MissedProposals:          1,
+	}, resp.Performances[0])
+
+	resp, err = s.ListValidatorPerformance(ctx, &pb.ValidatorPerformanceRequest{PublicKeys: [][]byte{second[:]}})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Performances))
+	assert.DeepEqual(t, second[:], resp.Performances[0].PublicKey)
+	assert.Equal(t, uint64(2), resp.Performances[0].ProposedBlocks)
+
+	_, err = s.ListValidatorPerformance(ctx, &pb.ValidatorPerformanceRequest{PublicKeys: [][]byte{{1, 2}}})
+	assert.ErrorContains(t, "not 48 bytes long", err)
+}
+
+func TestServer_ListMissedDuties(t *testing.T) {
+	ctx := context.Background()
+	first, second := [48]byte{1}, [48]byte{2}
+	valDB := dbtest.SetupDB(t, nil)
+	require.NoError(t, valDB.SaveMissedDuty(ctx, &kv.MissedDuty{
+		Type: kv.AttestationDuty, Slot: 32, Epoch: 1, PubKey: fmt.Sprintf("%#x", first), Failure: kv.BeaconNodeUnavailable,
+	}))
+	require.NoError(t, valDB.SaveMissedDuty(ctx, &kv.MissedDuty{
+		Type: kv.ProposalDuty, Slot: 64, Epoch: 2, PubKey: fmt.Sprintf("%#x", second), Failure: kv.SigningFailure,
+		Error: "remote signer down", Timestamp: 100,
+	}))
+	s := &Server{valDB: valDB}
+
+	resp, err := s.ListMissedDuties(ctx, &pb.MissedDutiesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.Duties))
+	assert.DeepEqual(t, first[:], resp.Duties[0].PublicKey)
+
+	resp, err = s.ListMissedDuties(ctx, &pb.MissedDutiesRequest{PublicKeys: [][]byte{second[:]}, StartEpoch: 2})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Duties))
+	assert.DeepEqual(t, &pb.MissedDuty{
+		Type:      "proposal",
+		Slot:      64,
+		Epoch:     2,
+		PublicKey: second[:],
+		Failure:   "signing_failure",
+		Error:     "remote signer down",
+		Timestamp: 100,
+	}, resp.Duties[0])
+
+	_, err = s.ListMissedDuties(ctx, &pb.MissedDutiesRequest{PublicKeys: [][]byte{{1}}})
+	assert.ErrorContains(t, "not 48 bytes long", err)
+}
@@ -0,0 +1,57 @@
+package rpc
+
+import (
+	"context"
+
+	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListRewardSummaries lists the stored per-epoch comparison of expected rewards and actual balance
+// changes for the requested public keys, or for all keys of the wallet if none are requested.
+func (s *Server) ListRewardSummaries(ctx context.Context, req *pb.RewardSummariesRequest) (*pb.RewardSummariesResponse, error) {
+	if s.valDB == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Validator database is not initialized")
+	}
+	if req.EndEpoch < req.StartEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, "End epoch %d is before start epoch %d", req.EndEpoch, req.StartEpoch)
+	}
+	pubKeys := make([][48]byte, 0, len(req.PublicKeys))
+	for _, key := range req.PublicKeys {
+		if len(key) != 48 {
+			return nil, status.Errorf(codes.InvalidArgument, "Public key %#x is not 48 bytes long", key)
+		}
+		pubKeys = append(pubKeys, bytesutil.ToBytes48(key))
+	}
+	if len(pubKeys) == 0 {
+		if s.keymanager == nil {
+			return nil, status.Error(codes.FailedPrecondition, "No public keys requested and wallet not yet initialized")
+		}
+		keys, err := s.keymanager.FetchValidatingPublicKeys(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not retrieve public keys: %v", err)
+		}
+		pubKeys = keys
+	}
+	resp := &pb.RewardSummariesResponse{
+		Summaries: make([]*pb.RewardSummary, 0),
+	}
+	for _, pubKey := range pubKeys {
+		summaries, err := s.valDB.RewardSummaries(ctx, pubKey, req.StartEpoch, req.EndEpoch)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not retrieve reward summaries: %v", err)
+		}
+		for _, summary := range summaries {
+			resp.Summaries = append(resp.Summaries, &pb.RewardSummary{
+				PublicKey:      bytesutil.SafeCopyBytes(pubKey[:]),
+				Epoch:          summary.Epoch,
+				ExpectedReward: summary.ExpectedReward,
+				ActualChange:   summary.ActualChange,
+				Shortfall:      summary.Shortfall(),
+			})
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,44 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
+)
+
+func TestServer_ListRewardSummaries(t *testing.T) {
+	ctx := context.Background()
+	first, second := [48]byte{1}, [48]byte{2}
+	valDB := dbtest.SetupDB(t, [][48]byte{first, second})
+	require.NoError(t, valDB.SaveRewardSummary(ctx, first, &kv.RewardSummary{Epoch: 3, ExpectedReward: 2000, ActualChange: 1500}))
+	require.NoError(t, valDB.SaveRewardSummary(ctx, first, &kv.RewardSummary{Epoch: 4, ExpectedReward: 2000, ActualChange: -500}))
+	require.NoError(t, valDB.SaveRewardSummary(ctx, second, &kv.RewardSummary{Epoch: 4, ExpectedReward: 2000, ActualChange: 2000}))
+	s := &Server{valDB: valDB}
+
+	resp, err := s.ListRewardSummaries(ctx, &pb.RewardSummariesRequest{
+		PublicKeys: [][]byte{first[:], second[:]},
+		StartEpoch: 4,
+		EndEpoch:   10,
+	})
+	require.NoError(t, err)
+	require.DeepEqual(t, []*pb.RewardSummary{
+		{PublicKey: first[:], Epoch: 4, ExpectedReward: 2000, ActualChange: -500, Shortfall: 2500},
+		{PublicKey: second[:], Epoch: 4, ExpectedReward: 2000, ActualChange: 2000, Shortfall: 0},
+	}, resp.Summaries)
+}
+
+func TestServer_ListRewardSummaries_InvalidRequest(t *testing.T) {
+	valDB := dbtest.SetupDB(t, nil)
+	s := &Server{valDB: valDB}
+	_, err := s.ListRewardSummaries(context.Background(), &pb.RewardSummariesRequest{StartEpoch: 2, EndEpoch: 1})
+	assert.ErrorContains(t, "End epoch 1 is before start epoch 2", err)
+	_, err = s.ListRewardSummaries(context.Background(), &pb.RewardSummariesRequest{PublicKeys: [][]byte{{1}}})
+	assert.ErrorContains(t, "is not 48 bytes long", err)
+	_, err = s.ListRewardSummaries(context.Background(), &pb.RewardSummariesRequest{})
+	assert.ErrorContains(t, "wallet not yet initialized", err)
+}
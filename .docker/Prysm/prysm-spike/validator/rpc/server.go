@@ -10,8 +10,12 @@ import (
 	recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
 	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	"github.com/kevinms/leakybucket-go"
+	sharedpb "github.com/prysmaticlabs/prysm/proto/shared/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/configdump"
 	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/exitcode"
 	"github.com/prysmaticlabs/prysm/shared/rand"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
 	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
@@ -47,10 +51,21 @@ type Config struct {
 	SyncChecker             client.SyncChecker
 	GenesisFetcher          client.GenesisFetcher
 	BeaconNodeInfoFetcher   client.BeaconNodeInfoFetcher
+	PerformanceFetcher      client.PerformanceFetcher
 	WalletInitializedFeed   *event.Feed
 	NodeGatewayEndpoint     string
 	Wallet                  *wallet.Wallet
 	Keymanager              keymanager.IKeymanager
+	// MutationRateLimit is the number of requests a minute allowed to each method
+	// changing the wallet or the settings, 0 for no limit.
+	MutationRateLimit uint64
+	// AuditLogFile is the file the changes made through the API are appended to.
+	AuditLogFile string
+	// RunningConfig resolves the configuration the validator client runs with.
+	RunningConfig func() (*configdump.Config, error)
+	// Inventory reports the version, data directory disk usage and service statuses of the
+	// validator client.
+	Inventory func() (*sharedpb.Inventory, error)
 }
 
 // Server defining a gRPC server for the remote signer API.
@@ -71,6 +86,7 @@ type Server struct {
 	syncChecker             client.SyncChecker
 	genesisFetcher          client.GenesisFetcher
 	beaconNodeInfoFetcher   client.BeaconNodeInfoFetcher
+	performanceFetcher      client.PerformanceFetcher
 	walletDir               string
 	wallet                  *wallet.Wallet
 	walletInitializedFeed   *event.Feed
@@ -80,11 +96,19 @@ type Server struct {
 	validatorMonitoringPort int
 	validatorGatewayHost    string
 	validatorGatewayPort    int
+	mutationRateLimiter     *leakybucket.Collector
+	auditLog                *auditLog
+	runningConfig           func() (*configdump.Config, error)
+	inventory               func() (*sharedpb.Inventory, error)
 }
 
 // NewServer instantiates a new gRPC server.
 func NewServer(ctx context.Context, cfg *Config) *Server {
 	ctx, cancel := context.WithCancel(ctx)
+	var audit *auditLog
+	if cfg.AuditLogFile != "" {
+		audit = &auditLog{path: cfg.AuditLogFile}
+	}
 	return &Server{
 		ctx:                     ctx,
 		cancel:                  cancel,
@@ -97,6 +121,7 @@ func NewServer(ctx context.Context, cfg *Config) *Server {
 		syncChecker:             cfg.SyncChecker,
 		beaconNodeInfoFetcher:   cfg.BeaconNodeInfoFetcher,
 		genesisFetcher:          cfg.GenesisFetcher,
+		performanceFetcher:      cfg.PerformanceFetcher,
 		walletDir:               cfg.WalletDir,
 		walletInitializedFeed:   cfg.WalletInitializedFeed,
 		walletInitialized:       cfg.Wallet != nil,
@@ -107,6 +132,10 @@ func NewServer(ctx context.Context, cfg *Config) *Server {
 		validatorMonitoringPort: cfg.ValidatorMonitoringPort,
 		validatorGatewayHost:    cfg.ValidatorGatewayHost,
 		validatorGatewayPort:    cfg.ValidatorGatewayPort,
+		mutationRateLimiter:     newMutationRateLimiter(cfg.MutationRateLimit),
+		auditLog:                audit,
+		runningConfig:           cfg.RunningConfig,
+		inventory:               cfg.Inventory,
 	}
 }
 
@@ -116,12 +145,12 @@ func (s *Server) Start() {
 	address := fmt.Sprintf("%s:%s", s.host, s.port)
 	lis, err := net.Listen("tcp", address)
 	if err != nil {
-		log.Errorf("Could not listen to port in Start() %s: %v", address, err)
+		exitcode.Fatal(log.WithField("address", address), err, "Could not listen to port in Start()")
 	}
 	s.listener = lis
 
 	// Register interceptors for metrics gathering as well as our
-	// own, custom JWT unary interceptor.
+	// own, custom audit and JWT unary interceptors.
 	opts := []grpc.ServerOption{
 		grpc.StatsHandler(&ocgrpc.ServerHandler{}),
 		grpc.UnaryInterceptor(middleware.ChainUnaryServer(
@@ -130,6 +159,7 @@ func (s *Server) Start() {
 			),
 			grpc_prometheus.UnaryServerInterceptor,
 			grpc_opentracing.UnaryServerInterceptor(),
+			s.AuditInterceptor(),
 			s.JWTInterceptor(),
 		)),
 	}
@@ -161,6 +191,9 @@ func (s *Server) Start() {
 	pb.RegisterWalletServer(s.grpcServer, s)
 	pb.RegisterHealthServer(s.grpcServer, s)
 	pb.RegisterAccountsServer(s.grpcServer, s)
+	pb.RegisterSlashingProtectionServer(s.grpcServer, s)
+	pb.RegisterKeyManagementServer(s.grpcServer, s)
+	pb.RegisterPerformanceServer(s.grpcServer, s)
 
 	go func() {
 		if s.listener != nil {
@@ -0,0 +1,31 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+
+	ptypes "github.com/gogo/protobuf/types"
+	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	interchangeformat "github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ExportSlashingProtection exports the slashing protection history in the validator database
+// as an EIP-3076 interchange JSON file, allowing backups to be taken while the validator runs.
+func (s *Server) ExportSlashingProtection(ctx context.Context, _ *ptypes.Empty) (*pb.ExportSlashingProtectionResponse, error) {
+	if s.valDB == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Validator database is not initialized")
+	}
+	eipJSON, err := interchangeformat.ExportStandardProtectionJSON(ctx, s.valDB)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not export slashing protection history: %v", err)
+	}
+	encoded, err := json.MarshalIndent(eipJSON, "", "\t")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not encode slashing protection history: %v", err)
+	}
+	return &pb.ExportSlashingProtectionResponse{
+		File: string(encoded),
+	}, nil
+}
@@ -0,0 +1,41 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
+	interchangeformat "github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format"
+)
+
+func TestServer_ExportSlashingProtection(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [48]byte{1}
+	valDB := dbtest.SetupDB(t, [][48]byte{pubKey})
+	genesisValidatorsRoot := bytesutil.PadTo([]byte{2}, 32)
+	require.NoError(t, valDB.SaveGenesisValidatorsRoot(ctx, genesisValidatorsRoot))
+	require.NoError(t, valDB.SaveProposalHistoryForSlot(ctx, pubKey, 5, bytesutil.PadTo([]byte{3}, 32)))
+	s := &Server{valDB: valDB}
+
+	resp, err := s.ExportSlashingProtection(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+	exported := &interchangeformat.EIPSlashingProtectionFormat{}
+	require.NoError(t, json.Unmarshal([]byte(resp.File), exported))
+	assert.Equal(t, fmt.Sprintf("%#x", genesisValidatorsRoot), exported.Metadata.GenesisValidatorsRoot)
+	require.Equal(t, 1, len(exported.Data))
+	assert.Equal(t, fmt.Sprintf("%#x", pubKey), exported.Data[0].Pubkey)
+	require.Equal(t, 1, len(exported.Data[0].SignedBlocks))
+	assert.Equal(t, "5", exported.Data[0].SignedBlocks[0].Slot)
+}
+
+func TestServer_ExportSlashingProtection_NoDatabase(t *testing.T) {
+	s := &Server{}
+	_, err := s.ExportSlashingProtection(context.Background(), &ptypes.Empty{})
+	assert.ErrorContains(t, "Validator database is not initialized", err)
+}
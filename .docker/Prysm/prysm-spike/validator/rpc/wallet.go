@@ -47,7 +47,7 @@ func (s *Server) CreateWallet(ctx context.Context, req *pb.CreateWalletRequest)
 		switch s.wallet.KeymanagerKind() {
 		case keymanager.Derived:
 			keymanagerKind = pb.KeymanagerKind_DERIVED
-		case keymanager.Remote:
+		case keymanager.Remote, keymanager.Web3Signer:
 			keymanagerKind = pb.KeymanagerKind_REMOTE
 		}
 		return &pb.CreateWalletResponse{
@@ -155,7 +155,7 @@ func (s *Server) WalletConfig(ctx context.Context, _ *ptypes.Empty) (*pb.WalletR
 		keymanagerKind = pb.KeymanagerKind_DERIVED
 	case keymanager.Imported:
 		keymanagerKind = pb.KeymanagerKind_IMPORTED
-	case keymanager.Remote:
+	case keymanager.Remote, keymanager.Web3Signer:
 		keymanagerKind = pb.KeymanagerKind_REMOTE
 	}
 	return &pb.WalletResponse{
@@ -222,6 +222,7 @@ func (s *Server) ImportKeystores(
 		Keymanager:      km,
 		Keystores:       keystores,
 		AccountPassword: req.KeystoresPassword,
+		ValidatorDB:     s.valDB,
 	}); err != nil {
 		return nil, err
 	}
@@ -4,62 +4,77 @@ import (
 	"context"
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	ethsl "github.com/prysmaticlabs/prysm/proto/slashing"
 	log "github.com/sirupsen/logrus"
 )
 
 // CheckBlockSafety this function is part of slashing protection for block proposals it performs
 // validation without db update. To be used before the block is signed.
 func (s *Service) CheckBlockSafety(ctx context.Context, blockHeader *ethpb.BeaconBlockHeader) bool {
-	slashable, err := s.slasherClient.IsSlashableBlockNoUpdate(ctx, blockHeader)
+	slashable, err := s.decide(ctx, "IsSlashableBlockNoUpdate", func(ctx context.Context, c ethsl.SlasherClient) (bool, error) {
+		resp, err := c.IsSlashableBlockNoUpdate(ctx, blockHeader)
+		return resp != nil && resp.Slashable, err
+	})
+	if slashable {
+		log.Warn("External slashing proposal protection found the block to be slashable")
+		return false
+	}
 	if err != nil {
 		log.Errorf("External slashing block protection returned an error: %v", err)
 		return false
 	}
-	if slashable != nil && slashable.Slashable {
-		log.Warn("External slashing proposal protection found the block to be slashable")
-	}
-	return !slashable.Slashable
+	return true
 }
 
 // CommitBlock this function is part of slashing protection for block proposals it performs
 // validation and db update. To be used after the block is proposed.
 func (s *Service) CommitBlock(ctx context.Context, blockHeader *ethpb.SignedBeaconBlockHeader) (bool, error) {
-	ps, err := s.slasherClient.IsSlashableBlock(ctx, blockHeader)
+	slashable, err := s.decide(ctx, "IsSlashableBlock", func(ctx context.Context, c ethsl.SlasherClient) (bool, error) {
+		ps, err := c.IsSlashableBlock(ctx, blockHeader)
+		return ps != nil && ps.ProposerSlashing != nil, err
+	})
+	if slashable {
+		log.Warn("External slashing proposal protection found the block to be slashable")
+		return false, nil
+	}
 	if err != nil {
 		log.Errorf("External slashing block protection returned an error: %v", err)
 		return false, err
 	}
-	if ps != nil && ps.ProposerSlashing != nil {
-		log.Warn("External slashing proposal protection found the block to be slashable")
-		return false, nil
-	}
 	return true, nil
 }
 
 // CheckAttestationSafety implements the slashing protection for attestations without db update.
 // To be used before signing.
 func (s *Service) CheckAttestationSafety(ctx context.Context, attestation *ethpb.IndexedAttestation) bool {
-	slashable, err := s.slasherClient.IsSlashableAttestationNoUpdate(ctx, attestation)
+	slashable, err := s.decide(ctx, "IsSlashableAttestationNoUpdate", func(ctx context.Context, c ethsl.SlasherClient) (bool, error) {
+		resp, err := c.IsSlashableAttestationNoUpdate(ctx, attestation)
+		return resp != nil && resp.Slashable, err
+	})
+	if slashable {
+		log.Warn("External slashing attestation protection found the attestation to be slashable")
+		return false
+	}
 	if err != nil {
 		log.Errorf("External slashing attestation protection returned an error: %v", err)
 		return false
 	}
-	if slashable.Slashable {
-		log.Warn("External slashing attestation protection found the attestation to be slashable")
-	}
-	return !slashable.Slashable
+	return true
 }
 
 // CommitAttestation implements the slashing protection for attestations it performs
 // validation and db update. To be used after the attestation is proposed.
 func (s *Service) CommitAttestation(ctx context.Context, attestation *ethpb.IndexedAttestation) bool {
-	as, err := s.slasherClient.IsSlashableAttestation(ctx, attestation)
-	if err != nil {
-		log.Errorf("External slashing attestation protection returned an error: %v", err)
+	slashable, err := s.decide(ctx, "IsSlashableAttestation", func(ctx context.Context, c ethsl.SlasherClient) (bool, error) {
+		as, err := c.IsSlashableAttestation(ctx, attestation)
+		return as != nil && as.AttesterSlashing != nil, err
+	})
+	if slashable {
+		log.Warn("External slashing attestation protection found the attestation to be slashable")
 		return false
 	}
-	if as != nil && as.AttesterSlashing != nil {
-		log.Warnf("External slashing attestation protection found the attestation to be slashable: %v", as)
+	if err != nil {
+		log.Errorf("External slashing attestation protection returned an error: %v", err)
 		return false
 	}
 	return true
@@ -11,7 +11,7 @@ import (
 )
 
 func TestService_VerifyAttestation(t *testing.T) {
-	s := &Service{slasherClient: mockSlasher.MockSlasher{SlashAttestation: true}}
+	s := testService(mockSlasher.MockSlasher{SlashAttestation: true})
 	att := &eth.IndexedAttestation{
 		AttestingIndices: []uint64{1, 2},
 		Data: &eth.AttestationData{
@@ -29,12 +29,12 @@ func TestService_VerifyAttestation(t *testing.T) {
 		},
 	}
 	assert.Equal(t, false, s.CheckAttestationSafety(context.Background(), att), "Expected verify attestation to fail verification")
-	s = &Service{slasherClient: mockSlasher.MockSlasher{SlashAttestation: false}}
+	s = testService(mockSlasher.MockSlasher{SlashAttestation: false})
 	assert.Equal(t, true, s.CheckAttestationSafety(context.Background(), att), "Expected verify attestation to pass verification")
 }
 
 func TestService_CommitAttestation(t *testing.T) {
-	s := &Service{slasherClient: mockSlasher.MockSlasher{SlashAttestation: true}}
+	s := testService(mockSlasher.MockSlasher{SlashAttestation: true})
 	att := &eth.IndexedAttestation{
 		AttestingIndices: []uint64{1, 2},
 		Data: &eth.AttestationData{
@@ -52,12 +52,12 @@ func TestService_CommitAttestation(t *testing.T) {
 		},
 	}
 	assert.Equal(t, false, s.CommitAttestation(context.Background(), att), "Expected commit attestation to fail verification")
-	s = &Service{slasherClient: mockSlasher.MockSlasher{SlashAttestation: false}}
+	s = testService(mockSlasher.MockSlasher{SlashAttestation: false})
 	assert.Equal(t, true, s.CommitAttestation(context.Background(), att), "Expected commit attestation to pass verification")
 }
 
 func TestService_CommitBlock(t *testing.T) {
-	s := &Service{slasherClient: mockSlasher.MockSlasher{SlashBlock: true}}
+	s := testService(mockSlasher.MockSlasher{SlashBlock: true})
 	blk := &eth.SignedBeaconBlockHeader{
 		Header: &eth.BeaconBlockHeader{
 			Slot:          0,
@@ -70,14 +70,14 @@ func TestService_CommitBlock(t *testing.T) {
 	slashable, err := s.CommitBlock(context.Background(), blk)
 	assert.NoError(t, err)
 	assert.Equal(t, false, slashable, "Expected commit block to fail verification")
-	s = &Service{slasherClient: mockSlasher.MockSlasher{SlashBlock: false}}
+	s = testService(mockSlasher.MockSlasher{SlashBlock: false})
 	slashable, err = s.CommitBlock(context.Background(), blk)
 	assert.NoError(t, err)
 	assert.Equal(t, true, slashable, "Expected commit block to pass verification")
 }
 
 func TestService_VerifyBlock(t *testing.T) {
-	s := &Service{slasherClient: mockSlasher.MockSlasher{SlashBlock: true}}
+	s := testService(mockSlasher.MockSlasher{SlashBlock: true})
 	blk := &eth.BeaconBlockHeader{
 		Slot:          0,
 		ProposerIndex: 0,
@@ -86,6 +86,6 @@ func TestService_VerifyBlock(t *testing.T) {
 		BodyRoot:      bytesutil.PadTo([]byte("body"), 32),
 	}
 	assert.Equal(t, false, s.CheckBlockSafety(context.Background(), blk), "Expected verify block to fail verification")
-	s = &Service{slasherClient: mockSlasher.MockSlasher{SlashBlock: false}}
+	s = testService(mockSlasher.MockSlasher{SlashBlock: false})
 	assert.Equal(t, true, s.CheckBlockSafety(context.Background(), blk), "Expected verify block to pass verification")
 }
@@ -0,0 +1,234 @@
+package interchangeformat
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ConflictKind is the slashable offense two signing records of the same public key amount to.
+type ConflictKind string
+
+const (
+	// DoubleProposal is two different blocks signed for the same slot.
+	DoubleProposal ConflictKind = "double_proposal"
+	// DoubleVote is two different attestations signed for the same target epoch.
+	DoubleVote ConflictKind = "double_vote"
+	// SurroundVote is an attestation whose source and target epochs surround the ones of another.
+	SurroundVote ConflictKind = "surround_vote"
+)
+
+// Conflict is a pair of signing records of the same public key, one from each compared history,
+// which are slashable together. Either the blocks or the attestations are set, depending on the kind.
+type Conflict struct {
+	Pubkey            string             `json:"pubkey"`
+	Kind              ConflictKind       `json:"kind"`
+	FirstBlock        *SignedBlock       `json:"first_block,omitempty"`
+	SecondBlock       *SignedBlock       `json:"second_block,omitempty"`
+	FirstAttestation  *SignedAttestation `json:"first_attestation,omitempty"`
+	SecondAttestation *SignedAttestation `json:"second_attestation,omitempty"`
+}
+
+// ConflictReport lists the public keys two slashing protection histories have in common and the
+// conflicts between their records.
+type ConflictReport struct {
+	OverlappingPubkeys []string    `json:"overlapping_pubkeys"`
+	Conflicts          []*Conflict `json:"conflicts"`
+}
+
+type parsedAttestation struct {
+	source uint64
+	target uint64
+	att    *SignedAttestation
+}
+
+// FindConflicts compares the slashing protection histories of two validator clients, such as
+// before consolidating their keys onto a single machine. Keys which signed on both clients
+// are reported, along with every pair of records which would be slashable together. Records
+// with the same slot or target epoch are only safe to keep when both have the same signing root.
+func FindConflicts(first, second *EIPSlashingProtectionFormat) (*ConflictReport, error) {
+	if first == nil || second == nil {
+		return nil, errors.New("slashing protection histories to compare must not be nil")
+	}
+	firstRoot := strings.ToLower(strings.TrimPrefix(first.Metadata.GenesisValidatorsRoot, "0x"))
+	secondRoot := strings.ToLower(strings.TrimPrefix(second.Metadata.GenesisValidatorsRoot, "0x"))
+	if firstRoot != "" && secondRoot != "" && firstRoot != secondRoot {
+		return nil, errors.New("slashing protection histories have different genesis validators roots, " +
+			"they were created on different chains")
+	}
+	firstBlocks, err := parseUniqueSignedBlocksByPubKey(first.Data)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse first history")
+	}
+	secondBlocks, err := parseUniqueSignedBlocksByPubKey(second.Data)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse second history")
+	}
+	firstAtts, err := parseUniqueSignedAttestationsByPubKey(first.Data)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse first history")
+	}
+	secondAtts, err := parseUniqueSignedAttestationsByPubKey(second.Data)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse second history")
+	}
+
+	overlapping := make(map[[48]byte]bool)
+	for pubKey := range firstBlocks {
+		if len(secondBlocks[pubKey]) > 0 || len(secondAtts[pubKey]) > 0 {
+			overlapping[pubKey] = true
+		}
+	}
+	for pubKey := range firstAtts {
+		if len(secondBlocks[pubKey]) > 0 || len(secondAtts[pubKey]) > 0 {
+			overlapping[pubKey] = true
+		}
+	}
+	report := &ConflictReport{
+		OverlappingPubkeys: make([]string, 0, len(overlapping)),
+		Conflicts:          make([]*Conflict, 0),
+	}
+	for pubKey := range overlapping {
+		report.OverlappingPubkeys = append(report.OverlappingPubkeys, fmt.Sprintf("%#x", pubKey))
+	}
+	sort.Strings(report.OverlappingPubkeys)
+
+	for _, hexKey := range report.OverlappingPubkeys {
+		pubKey, err := pubKeyFromHex(hexKey)
+		if err != nil {
+			return nil, err
+		}
+		blockConflicts, err := findBlockConflicts(hexKey, firstBlocks[pubKey], secondBlocks[pubKey])
+		if err != nil {
+			return nil, err
+		}
+		report.Conflicts = append(report.Conflicts, blockConflicts...)
+		attConflicts, err := findAttestationConflicts(hexKey, firstAtts[pubKey], secondAtts[pubKey])
+		if err != nil {
+			return nil, err
+		}
+		report.Conflicts = append(report.Conflicts, attConflicts...)
+	}
+	return report, nil
+}
+
+func findBlockConflicts(pubKey string, first, second []*SignedBlock) ([]*Conflict, error) {
+	firstBySlot := make(map[uint64][]*SignedBlock)
+	for _, blk := range first {
+		slot, err := uint64FromString(blk.Slot)
+		if err != nil {
+			return nil, fmt.Errorf("%s is not a valid slot: %v", blk.Slot, err)
+		}
+		firstBySlot[slot] = append(firstBySlot[slot], blk)
+	}
+	var conflicts []*Conflict
+	for _, blk := range second {
+		slot, err := uint64FromString(blk.Slot)
+		if err != nil {
+			return nil, fmt.Errorf("%s is not a valid slot: %v", blk.Slot, err)
+		}
+		for _, other := range firstBySlot[slot] {
+			if !sameSigningRoot(other.SigningRoot, blk.SigningRoot) {
+				conflicts = append(conflicts, &Conflict{
+					Pubkey:      pubKey,
+					Kind:        DoubleProposal,
+					FirstBlock:  other,
+					SecondBlock: blk,
+				})
+			}
+		}
+	}
+	return conflicts, nil
+}
+
+func findAttestationConflicts(pubKey string, first, second []*SignedAttestation) ([]*Conflict, error) {
+	firstAtts, err := parseAttestations(first)
+	if err != nil {
+		return nil, err
+	}
+	secondAtts, err := parseAttestations(second)
+	if err != nil {
+		return nil, err
+	}
+	firstByTarget := make(map[uint64][]*parsedAttestation)
+	for _, a := range firstAtts {
+		firstByTarget[a.target] = append(firstByTarget[a.target], a)
+	}
+	// Sorting the first attestations by source epoch finds, for any attestation of the second
+	// history, the one with the highest target among lower sources and the one with the lowest
+	// target among higher sources, which are the only candidates to surround it or be surrounded.
+	sort.Slice(firstAtts, func(i, j int) bool {
+		return firstAtts[i].source < firstAtts[j].source
+	})
+	maxTargetBefore := make([]*parsedAttestation, len(firstAtts))
+	for i, a := range firstAtts {
+		maxTargetBefore[i] = a
+		if i > 0 && maxTargetBefore[i-1].target > a.target {
+			maxTargetBefore[i] = maxTargetBefore[i-1]
+		}
+	}
+	minTargetAfter := make([]*parsedAttestation, len(firstAtts))
+	for i := len(firstAtts) - 1; i >= 0; i-- {
+		minTargetAfter[i] = firstAtts[i]
+		if i < len(firstAtts)-1 && minTargetAfter[i+1].target < firstAtts[i].target {
+			minTargetAfter[i] = minTargetAfter[i+1]
+		}
+	}
+
+	var conflicts []*Conflict
+	newConflict := func(kind ConflictKind, a, b *parsedAttestation) *Conflict {
+		return &Conflict{
+			Pubkey:            pubKey,
+			Kind:              kind,
+			FirstAttestation:  a.att,
+			SecondAttestation: b.att,
+		}
+	}
+	for _, b := range secondAtts {
+		for _, a := range firstByTarget[b.target] {
+			if !sameSigningRoot(a.att.SigningRoot, b.att.SigningRoot) {
+				conflicts = append(conflicts, newConflict(DoubleVote, a, b))
+			}
+		}
+		lower := sort.Search(len(firstAtts), func(i int) bool {
+			return firstAtts[i].source >= b.source
+		})
+		if lower > 0 && maxTargetBefore[lower-1].target > b.target {
+			conflicts = append(conflicts, newConflict(SurroundVote, maxTargetBefore[lower-1], b))
+		}
+		higher := sort.Search(len(firstAtts), func(i int) bool {
+			return firstAtts[i].source > b.source
+		})
+		if higher < len(firstAtts) && minTargetAfter[higher].target < b.target {
+			conflicts = append(conflicts, newConflict(SurroundVote, minTargetAfter[higher], b))
+		}
+	}
+	return conflicts, nil
+}
+
+func parseAttestations(atts []*SignedAttestation) ([]*parsedAttestation, error) {
+	parsed := make([]*parsedAttestation, len(atts))
+	for i, att := range atts {
+		source, err := uint64FromString(att.SourceEpoch)
+		if err != nil {
+			return nil, fmt.Errorf("%s is not a valid epoch: %v", att.SourceEpoch, err)
+		}
+		target, err := uint64FromString(att.TargetEpoch)
+		if err != nil {
+			return nil, fmt.Errorf("%s is not a valid epoch: %v", att.TargetEpoch, err)
+		}
+		parsed[i] = &parsedAttestation{source: source, target: target, att: att}
+	}
+	return parsed, nil
+}
+
+// sameSigningRoot returns true if both signing roots are set and equal. Records without a signing
+// root cannot be proven to be the same, as allowed by EIP-3076.
+func sameSigningRoot(a, b string) bool {
+	if a == "" || b == "" {
+		return false
+	}
+	return strings.EqualFold(strings.TrimPrefix(a, "0x"), strings.TrimPrefix(b, "0x"))
+}
@@ -0,0 +1,78 @@
+package interchangeformat
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestFindConflicts(t *testing.T) {
+	pubKeys := createRandomPubKeys(t, 3)
+	roots := createRandomRoots(t, 2)
+	root0, root1 := fmt.Sprintf("%#x", roots[0]), fmt.Sprintf("%#x", roots[1])
+	first := &EIPSlashingProtectionFormat{
+		Data: []*ProtectionData{
+			{
+				Pubkey: fmt.Sprintf("%#x", pubKeys[0]),
+				SignedBlocks: []*SignedBlock{
+					{Slot: "1", SigningRoot: root0},
+					{Slot: "2", SigningRoot: root0},
+				},
+				SignedAttestations: []*SignedAttestation{
+					{SourceEpoch: "1", TargetEpoch: "2", SigningRoot: root0},
+					{SourceEpoch: "2", TargetEpoch: "6"},
+				},
+			},
+			{
+				Pubkey:       fmt.Sprintf("%#x", pubKeys[1]),
+				SignedBlocks: []*SignedBlock{{Slot: "1", SigningRoot: root0}},
+			},
+		},
+	}
+	second := &EIPSlashingProtectionFormat{
+		Data: []*ProtectionData{
+			{
+				Pubkey: fmt.Sprintf("%#x", pubKeys[0]),
+				SignedBlocks: []*SignedBlock{
+					// The same block is not a conflict, a different one is.
+					{Slot: "1", SigningRoot: root0},
+					{Slot: "2", SigningRoot: root1},
+				},
+				SignedAttestations: []*SignedAttestation{
+					{SourceEpoch: "1", TargetEpoch: "2", SigningRoot: root0},
+					{SourceEpoch: "3", TargetEpoch: "4", SigningRoot: root1},
+					{SourceEpoch: "0", TargetEpoch: "6", SigningRoot: root1},
+				},
+			},
+			{
+				Pubkey:       fmt.Sprintf("%#x", pubKeys[2]),
+				SignedBlocks: []*SignedBlock{{Slot: "1", SigningRoot: root1}},
+			},
+		},
+	}
+	first.Metadata.GenesisValidatorsRoot = root0
+	second.Metadata.GenesisValidatorsRoot = root0
+
+	report, err := FindConflicts(first, second)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []string{fmt.Sprintf("%#x", pubKeys[0])}, report.OverlappingPubkeys)
+	require.Equal(t, 4, len(report.Conflicts))
+	assert.Equal(t, DoubleProposal, report.Conflicts[0].Kind)
+	assert.Equal(t, "2", report.Conflicts[0].SecondBlock.Slot)
+	// The attestation 3 -> 4 is surrounded by 2 -> 6.
+	assert.Equal(t, SurroundVote, report.Conflicts[1].Kind)
+	assert.Equal(t, "6", report.Conflicts[1].FirstAttestation.TargetEpoch)
+	assert.Equal(t, "4", report.Conflicts[1].SecondAttestation.TargetEpoch)
+	// The attestation 0 -> 6 votes twice for epoch 6, and surrounds 1 -> 2.
+	assert.Equal(t, DoubleVote, report.Conflicts[2].Kind)
+	assert.Equal(t, "2", report.Conflicts[2].FirstAttestation.SourceEpoch)
+	assert.Equal(t, SurroundVote, report.Conflicts[3].Kind)
+	assert.Equal(t, "1", report.Conflicts[3].FirstAttestation.SourceEpoch)
+	assert.Equal(t, "0", report.Conflicts[3].SecondAttestation.SourceEpoch)
+
+	second.Metadata.GenesisValidatorsRoot = root1
+	_, err = FindConflicts(first, second)
+	assert.ErrorContains(t, "different genesis validators roots", err)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/validator/db"
 )
 
@@ -46,6 +47,29 @@ func ExportStandardProtectionJSON(ctx context.Context, validatorDB db.Database)
 		}
 	}
 
+	// Extract the signed attestations by public keys.
+	attestedPublicKeys, err := validatorDB.AttestedPublicKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, pubKey := range attestedPublicKeys {
+		signedAtts, err := getSignedAttestationsByPubKey(ctx, validatorDB, pubKey)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := dataByPubKey[pubKey]; !ok {
+			pubKeyHex, err := pubKeyToHexString(pubKey[:])
+			if err != nil {
+				return nil, err
+			}
+			dataByPubKey[pubKey] = &ProtectionData{
+				Pubkey:       pubKeyHex,
+				SignedBlocks: nil,
+			}
+		}
+		dataByPubKey[pubKey].SignedAttestations = signedAtts
+	}
+
 	// Next we turn our map into a slice as expected by the EIP-3076 JSON standard.
 	dataList := make([]*ProtectionData, 0)
 	for _, item := range dataByPubKey {
@@ -86,3 +110,52 @@ func getSignedBlocksByPubKey(ctx context.Context, validatorDB db.Database, pubKe
 	}
 	return signedBlocks, nil
 }
+
+// getSignedAttestationsByPubKey returns the attestations signed by the public key, from its lowest
+// signed target epoch up to the latest epoch written. The attesting history only holds the targets
+// of the last weak subjectivity period, older targets are not exported.
+func getSignedAttestationsByPubKey(ctx context.Context, validatorDB db.Database, pubKey [48]byte) ([]*SignedAttestation, error) {
+	histories, err := validatorDB.AttestationHistoryForPubKeysV2(ctx, [][48]byte{pubKey})
+	if err != nil {
+		return nil, err
+	}
+	history, ok := histories[pubKey]
+	if !ok {
+		return nil, nil
+	}
+	latestEpochWritten, err := history.GetLatestEpochWritten(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lowestSignedTarget, err := validatorDB.LowestSignedTargetEpoch(ctx, pubKey)
+	if err != nil {
+		return nil, err
+	}
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+	if latestEpochWritten >= wsPeriod && lowestSignedTarget <= latestEpochWritten-wsPeriod {
+		lowestSignedTarget = latestEpochWritten - wsPeriod + 1
+	}
+	signedAtts := make([]*SignedAttestation, 0)
+	for target := lowestSignedTarget; target <= latestEpochWritten; target++ {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		historyData, err := history.GetTargetData(ctx, target)
+		if err != nil {
+			return nil, err
+		}
+		if historyData.IsEmpty() {
+			continue
+		}
+		signingRootHex, err := rootToHexString(historyData.SigningRoot)
+		if err != nil {
+			return nil, err
+		}
+		signedAtts = append(signedAtts, &SignedAttestation{
+			SourceEpoch: fmt.Sprintf("%d", historyData.Source),
+			TargetEpoch: fmt.Sprintf("%d", target),
+			SigningRoot: signingRootHex,
+		})
+	}
+	return signedAtts, nil
+}
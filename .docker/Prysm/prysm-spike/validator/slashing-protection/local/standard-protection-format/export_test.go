@@ -7,6 +7,7 @@ import (
 
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
 	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
 )
 
@@ -58,3 +59,47 @@ func Test_getSignedBlocksByPubKey(t *testing.T) {
 		assert.DeepEqual(t, blk, signedBlocks[i])
 	}
 }
+
+func Test_getSignedAttestationsByPubKey(t *testing.T) {
+	pubKeys := [][48]byte{
+		{1},
+	}
+	ctx := context.Background()
+	validatorDB := dbtest.SetupDB(t, pubKeys)
+
+	// No attesting history will return empty.
+	signedAtts, err := getSignedAttestationsByPubKey(ctx, validatorDB, pubKeys[0])
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(signedAtts))
+
+	// We mark target epochs 2 and 4 as attested, leaving target epoch 3 empty.
+	dummyRoot := [32]byte{1}
+	history := kv.NewAttestationHistoryArray(4)
+	history, err = history.SetTargetData(ctx, 2, &kv.HistoryData{Source: 1, SigningRoot: dummyRoot[:]})
+	require.NoError(t, err)
+	history, err = history.SetTargetData(ctx, 4, &kv.HistoryData{Source: 2, SigningRoot: make([]byte, 32)})
+	require.NoError(t, err)
+	history, err = history.SetLatestEpochWritten(ctx, 4)
+	require.NoError(t, err)
+	require.NoError(t, validatorDB.SaveAttestationHistoryForPubKeyV2(ctx, pubKeys[0], history))
+	require.NoError(t, validatorDB.SaveLowestSignedTargetEpoch(ctx, pubKeys[0], 2))
+
+	signedAtts, err = getSignedAttestationsByPubKey(ctx, validatorDB, pubKeys[0])
+	require.NoError(t, err)
+	wanted := []*SignedAttestation{
+		{
+			SourceEpoch: "1",
+			TargetEpoch: "2",
+			SigningRoot: fmt.Sprintf("%#x", dummyRoot),
+		},
+		{
+			SourceEpoch: "2",
+			TargetEpoch: "4",
+			SigningRoot: "0x0000000000000000000000000000000000000000000000000000000000000000",
+		},
+	}
+	require.Equal(t, len(wanted), len(signedAtts))
+	for i, att := range wanted {
+		assert.DeepEqual(t, att, signedAtts[i])
+	}
+}
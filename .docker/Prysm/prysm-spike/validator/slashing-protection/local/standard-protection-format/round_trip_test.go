@@ -4,8 +4,10 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"testing"
 
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
 )
@@ -35,10 +37,16 @@ func TestImportExport_RoundTrip(t *testing.T) {
 	eipStandard, err := ExportStandardProtectionJSON(ctx, validatorDB)
 	require.NoError(t, err)
 
-	// TODO(#7813): We have only implemented the export functionality
-	// for proposals history at the moment, so we do not check attesting history.
+	// Targets without a signed attestation are not exported.
+	farFutureEpoch := fmt.Sprintf("%d", params.BeaconConfig().FarFutureEpoch)
 	for i := range wanted.Data {
-		wanted.Data[i].SignedAttestations = nil
+		signedAtts := make([]*SignedAttestation, 0)
+		for _, att := range wanted.Data[i].SignedAttestations {
+			if att.SourceEpoch != farFutureEpoch {
+				signedAtts = append(signedAtts, att)
+			}
+		}
+		wanted.Data[i].SignedAttestations = signedAtts
 	}
 
 	// We compare the metadata fields from import to export.
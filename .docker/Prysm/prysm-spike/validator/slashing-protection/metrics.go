@@ -0,0 +1,23 @@
+package slashingprotection
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	slasherEndpointRequests = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "slasher_endpoint_requests_total",
+			Help: "The number of slashing protection requests to each slasher endpoint, by method and result.",
+		},
+		[]string{"endpoint", "method", "result"},
+	)
+	slasherEndpointHealthy = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "slasher_endpoint_healthy",
+			Help: "Whether the connection to each slasher endpoint is ready, 1 if it is.",
+		},
+		[]string{"endpoint"},
+	)
+)
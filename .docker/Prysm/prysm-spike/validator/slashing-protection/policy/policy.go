@@ -0,0 +1,132 @@
+// Package policy defines operator configured rules evaluated before the validator client signs an
+// attestation or a block, in addition to the slashing protection history checks. Policies let
+// operators encode safety constraints of their own, such as refusing to sign while the beacon node
+// has not finalized recently.
+package policy
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"gopkg.in/yaml.v2"
+)
+
+// Policy is a rule a message has to satisfy to be signed. Checks return an error describing why
+// the message was denied.
+type Policy interface {
+	Name() string
+	CheckAttestation(ctx context.Context, pubKey [48]byte, data *ethpb.AttestationData) error
+	CheckBlock(ctx context.Context, pubKey [48]byte, block *ethpb.BeaconBlock) error
+}
+
+// FinalityFetcher retrieves the latest finalized epoch of the beacon node.
+type FinalityFetcher interface {
+	FinalizedEpoch(ctx context.Context) (uint64, error)
+}
+
+// ProposalHistory retrieves the proposals signed by a validator.
+type ProposalHistory interface {
+	ProposalHistoryForSlot(ctx context.Context, publicKey [48]byte, slot uint64) ([32]byte, bool, error)
+}
+
+// Dependencies are the sources of data policies are evaluated with.
+type Dependencies struct {
+	Finality  FinalityFetcher
+	Proposals ProposalHistory
+}
+
+// Config lists the rules of a policy file.
+type Config struct {
+	Policies []*RuleConfig `yaml:"policies"`
+}
+
+// RuleConfig configures a single rule. The fields used depend on the type of the rule.
+type RuleConfig struct {
+	Type   string `yaml:"type"`
+	Epoch  uint64 `yaml:"epoch"`
+	Epochs uint64 `yaml:"epochs"`
+	Max    uint64 `yaml:"max"`
+}
+
+// Load reads a policy file.
+func Load(path string) (*Config, error) {
+	enc, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read policy file")
+	}
+	cfg := &Config{}
+	if err := yaml.UnmarshalStrict(enc, cfg); err != nil {
+		return nil, errors.Wrap(err, "could not parse policy file")
+	}
+	for i, rule := range cfg.Policies {
+		if err := rule.validate(); err != nil {
+			return nil, errors.Wrapf(err, "invalid policy %d", i)
+		}
+	}
+	return cfg, nil
+}
+
+// Set is the list of policies every message has to satisfy.
+type Set struct {
+	policies []Policy
+}
+
+// NewSet creates the policies of the config.
+func NewSet(cfg *Config, deps *Dependencies) (*Set, error) {
+	s := &Set{}
+	for i, rule := range cfg.Policies {
+		p, err := newPolicy(rule, deps)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid policy %d", i)
+		}
+		s.policies = append(s.policies, p)
+	}
+	return s, nil
+}
+
+// Names of the policies in the set.
+func (s *Set) Names() []string {
+	names := make([]string, len(s.policies))
+	for i, p := range s.policies {
+		names[i] = p.Name()
+	}
+	return names
+}
+
+// CheckAttestation returns the error of the first policy denying the attestation.
+func (s *Set) CheckAttestation(ctx context.Context, pubKey [48]byte, data *ethpb.AttestationData) error {
+	for _, p := range s.policies {
+		if err := p.CheckAttestation(ctx, pubKey, data); err != nil {
+			return &DeniedError{Policy: p.Name(), err: err}
+		}
+	}
+	return nil
+}
+
+// CheckBlock returns the error of the first policy denying the block.
+func (s *Set) CheckBlock(ctx context.Context, pubKey [48]byte, block *ethpb.BeaconBlock) error {
+	for _, p := range s.policies {
+		if err := p.CheckBlock(ctx, pubKey, block); err != nil {
+			return &DeniedError{Policy: p.Name(), err: err}
+		}
+	}
+	return nil
+}
+
+// DeniedError is returned when a policy denies signing a message.
+type DeniedError struct {
+	Policy string
+	err    error
+}
+
+func (e *DeniedError) Error() string {
+	return fmt.Sprintf("denied by slashing protection policy %s: %v", e.Policy, e.err)
+}
+
+// Unwrap returns the reason the policy denied the message.
+func (e *DeniedError) Unwrap() error {
+	return e.err
+}
@@ -0,0 +1,104 @@
+package policy
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+type fakeFinality struct {
+	epoch uint64
+	err   error
+}
+
+func (f *fakeFinality) FinalizedEpoch(context.Context) (uint64, error) {
+	return f.epoch, f.err
+}
+
+type fakeProposals map[uint64]bool
+
+func (f fakeProposals) ProposalHistoryForSlot(_ context.Context, _ [48]byte, slot uint64) ([32]byte, bool, error) {
+	return [32]byte{}, f[slot], nil
+}
+
+func attestation(source, target uint64) *ethpb.AttestationData {
+	return &ethpb.AttestationData{
+		Source: &ethpb.Checkpoint{Epoch: source},
+		Target: &ethpb.Checkpoint{Epoch: target},
+	}
+}
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy string
+		err    string
+	}{
+		{name: "valid", policy: "policies:\n  - type: min_source_epoch\n    epoch: 3\n  - type: max_finality_lag\n    epochs: 4\n"},
+		{name: "unknown type", policy: "policies:\n  - type: never_sign\n", err: "unknown policy type"},
+		{name: "unknown field", policy: "policies:\n  - type: min_source_epoch\n    epoc: 3\n", err: "could not parse"},
+		{name: "missing max", policy: "policies:\n  - type: max_proposals_per_epoch\n", err: "requires max"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "policies.yaml")
+			require.NoError(t, ioutil.WriteFile(path, []byte(tt.policy), 0600))
+			cfg, err := Load(path)
+			if tt.err != "" {
+				assert.ErrorContains(t, tt.err, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, 2, len(cfg.Policies))
+		})
+	}
+}
+
+func TestNewSet_MissingDependencies(t *testing.T) {
+	_, err := NewSet(&Config{Policies: []*RuleConfig{{Type: MaxFinalityLag, Epochs: 2}}}, &Dependencies{})
+	assert.ErrorContains(t, "requires the beacon node finality", err)
+}
+
+func TestSet_CheckAttestation(t *testing.T) {
+	finality := &fakeFinality{epoch: 10}
+	s, err := NewSet(&Config{Policies: []*RuleConfig{
+		{Type: MinSourceEpoch, Epoch: 5},
+		{Type: MaxFinalityLag, Epochs: 3},
+	}}, &Dependencies{Finality: finality})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []string{"min_source_epoch(5)", "max_finality_lag(3)"}, s.Names())
+
+	ctx := context.Background()
+	require.NoError(t, s.CheckAttestation(ctx, [48]byte{}, attestation(10, 13)))
+
+	err = s.CheckAttestation(ctx, [48]byte{}, attestation(4, 11))
+	var denied *DeniedError
+	require.Equal(t, true, errors.As(err, &denied))
+	assert.Equal(t, "min_source_epoch(5)", denied.Policy)
+
+	err = s.CheckAttestation(ctx, [48]byte{}, attestation(10, 14))
+	assert.ErrorContains(t, "epoch 14 is 4 epochs past finalized epoch 10", err)
+
+	finality.err = errors.New("unavailable")
+	err = s.CheckAttestation(ctx, [48]byte{}, attestation(10, 11))
+	assert.ErrorContains(t, "could not get finalized epoch", err)
+}
+
+func TestSet_CheckBlock(t *testing.T) {
+	s, err := NewSet(&Config{Policies: []*RuleConfig{
+		{Type: MinSourceEpoch, Epoch: 5},
+		{Type: MaxProposalsPerEpoch, Max: 2},
+	}}, &Dependencies{Proposals: fakeProposals{1: true, 3: true, 40: true}})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, s.CheckBlock(ctx, [48]byte{}, &ethpb.BeaconBlock{Slot: 2}))
+	assert.ErrorContains(t, "already proposed 2 blocks in epoch 0", s.CheckBlock(ctx, [48]byte{}, &ethpb.BeaconBlock{Slot: 5}))
+	require.NoError(t, s.CheckBlock(ctx, [48]byte{}, &ethpb.BeaconBlock{Slot: 41}))
+}
@@ -0,0 +1,141 @@
+package policy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+)
+
+// Types of the rules configurable in a policy file.
+const (
+	// MinSourceEpoch denies attestations with a source epoch below the configured epoch.
+	MinSourceEpoch = "min_source_epoch"
+	// MaxProposalsPerEpoch denies proposals once a validator signed the configured number of
+	// blocks in the epoch.
+	MaxProposalsPerEpoch = "max_proposals_per_epoch"
+	// MaxFinalityLag denies signing messages more than the configured number of epochs past the
+	// latest finalized epoch of the beacon node.
+	MaxFinalityLag = "max_finality_lag"
+)
+
+func (r *RuleConfig) validate() error {
+	switch r.Type {
+	case MinSourceEpoch:
+	case MaxProposalsPerEpoch:
+		if r.Max == 0 {
+			return errors.New("max_proposals_per_epoch requires max")
+		}
+	case MaxFinalityLag:
+		if r.Epochs == 0 {
+			return errors.New("max_finality_lag requires epochs")
+		}
+	default:
+		return fmt.Errorf("unknown policy type %q", r.Type)
+	}
+	return nil
+}
+
+func newPolicy(r *RuleConfig, deps *Dependencies) (Policy, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+	switch r.Type {
+	case MinSourceEpoch:
+		return &minSourceEpoch{epoch: r.Epoch}, nil
+	case MaxProposalsPerEpoch:
+		if deps.Proposals == nil {
+			return nil, errors.New("max_proposals_per_epoch requires the proposal history")
+		}
+		return &maxProposalsPerEpoch{max: r.Max, history: deps.Proposals}, nil
+	case MaxFinalityLag:
+		if deps.Finality == nil {
+			return nil, errors.New("max_finality_lag requires the beacon node finality")
+		}
+		return &maxFinalityLag{epochs: r.Epochs, finality: deps.Finality}, nil
+	}
+	return nil, fmt.Errorf("unknown policy type %q", r.Type)
+}
+
+type minSourceEpoch struct {
+	epoch uint64
+}
+
+func (p *minSourceEpoch) Name() string {
+	return fmt.Sprintf("%s(%d)", MinSourceEpoch, p.epoch)
+}
+
+func (p *minSourceEpoch) CheckAttestation(_ context.Context, _ [48]byte, data *ethpb.AttestationData) error {
+	if data.Source.Epoch < p.epoch {
+		return fmt.Errorf("source epoch %d is below %d", data.Source.Epoch, p.epoch)
+	}
+	return nil
+}
+
+func (p *minSourceEpoch) CheckBlock(context.Context, [48]byte, *ethpb.BeaconBlock) error {
+	return nil
+}
+
+type maxProposalsPerEpoch struct {
+	max     uint64
+	history ProposalHistory
+}
+
+func (p *maxProposalsPerEpoch) Name() string {
+	return fmt.Sprintf("%s(%d)", MaxProposalsPerEpoch, p.max)
+}
+
+func (p *maxProposalsPerEpoch) CheckAttestation(context.Context, [48]byte, *ethpb.AttestationData) error {
+	return nil
+}
+
+func (p *maxProposalsPerEpoch) CheckBlock(ctx context.Context, pubKey [48]byte, block *ethpb.BeaconBlock) error {
+	start, err := helpers.StartSlot(helpers.SlotToEpoch(block.Slot))
+	if err != nil {
+		return err
+	}
+	proposals := uint64(0)
+	for slot := start; slot < block.Slot; slot++ {
+		_, exists, err := p.history.ProposalHistoryForSlot(ctx, pubKey, slot)
+		if err != nil {
+			return errors.Wrap(err, "could not get proposal history")
+		}
+		if exists {
+			proposals++
+		}
+	}
+	if proposals >= p.max {
+		return fmt.Errorf("already proposed %d blocks in epoch %d", proposals, helpers.SlotToEpoch(block.Slot))
+	}
+	return nil
+}
+
+type maxFinalityLag struct {
+	epochs   uint64
+	finality FinalityFetcher
+}
+
+func (p *maxFinalityLag) Name() string {
+	return fmt.Sprintf("%s(%d)", MaxFinalityLag, p.epochs)
+}
+
+func (p *maxFinalityLag) CheckAttestation(ctx context.Context, _ [48]byte, data *ethpb.AttestationData) error {
+	return p.check(ctx, data.Target.Epoch)
+}
+
+func (p *maxFinalityLag) CheckBlock(ctx context.Context, _ [48]byte, block *ethpb.BeaconBlock) error {
+	return p.check(ctx, helpers.SlotToEpoch(block.Slot))
+}
+
+func (p *maxFinalityLag) check(ctx context.Context, epoch uint64) error {
+	finalized, err := p.finality.FinalizedEpoch(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized epoch")
+	}
+	if epoch > finalized && epoch-finalized > p.epochs {
+		return fmt.Errorf("epoch %d is %d epochs past finalized epoch %d", epoch, epoch-finalized, finalized)
+	}
+	return nil
+}
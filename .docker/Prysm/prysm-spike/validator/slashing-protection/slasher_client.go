@@ -4,15 +4,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
-	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
 	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	ethsl "github.com/prysmaticlabs/prysm/proto/slashing"
 	"github.com/prysmaticlabs/prysm/shared/grpcutils"
+	"github.com/prysmaticlabs/prysm/shared/retryutil"
 	log "github.com/sirupsen/logrus"
 	"go.opencensus.io/plugin/ocgrpc"
 	"google.golang.org/grpc"
@@ -21,24 +23,41 @@ import (
 	"google.golang.org/grpc/metadata"
 )
 
+// healthCheckInterval is how often the connections to the slasher endpoints are checked.
+var healthCheckInterval = 12 * time.Second
+
 // Service represents a service to manage the validator
 // ￿slashing protection.
 type Service struct {
 	ctx                context.Context
 	cancel             context.CancelFunc
-	conn               *grpc.ClientConn
-	endpoint           string
+	endpoints          []*slasherEndpoint
+	quorumWeight       uint64
 	withCert           string
 	maxCallRecvMsgSize int
 	grpcRetries        uint
 	grpcHeaders        []string
-	slasherClient      ethsl.SlasherClient
 	grpcRetryDelay     time.Duration
 }
 
+// slasherEndpoint is a slasher node the service queries, along with the weight of its answers in
+// the quorum.
+type slasherEndpoint struct {
+	address       string
+	weight        uint64
+	conn          *grpc.ClientConn
+	slasherClient ethsl.SlasherClient
+	healthy       bool
+}
+
 // Config for the validator service.
 type Config struct {
-	Endpoint                   string
+	// Endpoint is a comma-separated list of slasher endpoints, each optionally followed by its
+	// weight as address=weight. Endpoints weigh 1 by default.
+	Endpoint string
+	// QuorumWeight is the total weight of the endpoints which must answer for a message to be
+	// considered safe. Defaults to more than half of the total weight.
+	QuorumWeight               uint64
 	CertFlag                   string
 	GrpcMaxCallRecvMsgSizeFlag int
 	GrpcRetriesFlag            uint
@@ -49,11 +68,27 @@ type Config struct {
 // NewService creates a new validator service for the service
 // registry.
 func NewService(ctx context.Context, cfg *Config) (*Service, error) {
+	endpoints, err := parseEndpoints(cfg.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+	var totalWeight uint64
+	for _, e := range endpoints {
+		totalWeight += e.weight
+	}
+	quorumWeight := cfg.QuorumWeight
+	if quorumWeight == 0 {
+		quorumWeight = totalWeight/2 + 1
+	}
+	if quorumWeight > totalWeight {
+		return nil, fmt.Errorf("slasher quorum weight %d exceeds the total weight %d of the endpoints", quorumWeight, totalWeight)
+	}
 	ctx, cancel := context.WithCancel(ctx)
 	return &Service{
 		ctx:                ctx,
 		cancel:             cancel,
-		endpoint:           cfg.Endpoint,
+		endpoints:          endpoints,
+		quorumWeight:       quorumWeight,
 		withCert:           cfg.CertFlag,
 		maxCallRecvMsgSize: cfg.GrpcMaxCallRecvMsgSizeFlag,
 		grpcRetries:        cfg.GrpcRetriesFlag,
@@ -62,14 +97,40 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 	}, nil
 }
 
+func parseEndpoints(s string) ([]*slasherEndpoint, error) {
+	var endpoints []*slasherEndpoint
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		e := &slasherEndpoint{address: entry, weight: 1}
+		if i := strings.LastIndex(entry, "="); i >= 0 {
+			weight, err := strconv.ParseUint(entry[i+1:], 10, 64)
+			if err != nil || weight == 0 {
+				return nil, fmt.Errorf("invalid weight of slasher endpoint %q", entry)
+			}
+			e.address, e.weight = entry[:i], weight
+		}
+		endpoints = append(endpoints, e)
+	}
+	return endpoints, nil
+}
+
 // Start the slasher protection service and grpc client.
 func (s *Service) Start() {
-	if s.endpoint != "" {
-		s.slasherClient = s.startSlasherClient()
+	for _, e := range s.endpoints {
+		e.conn = s.dialSlasher(e.address)
+		if e.conn != nil {
+			e.slasherClient = ethsl.NewSlasherClient(e.conn)
+		}
+	}
+	if len(s.endpoints) > 0 {
+		go s.checkHealth()
 	}
 }
 
-func (s *Service) startSlasherClient() ethsl.SlasherClient {
+func (s *Service) dialSlasher(endpoint string) *grpc.ClientConn {
 	var dialOpt grpc.DialOption
 
 	if s.withCert != "" {
@@ -96,55 +157,148 @@ func (s *Service) startSlasherClient() ethsl.SlasherClient {
 		}
 	}
 
+	retryPolicy := retryutil.NewPolicy(s.grpcRetries, s.grpcRetryDelay)
 	opts := []grpc.DialOption{
 		dialOpt,
 		grpc.WithDefaultCallOptions(
-			grpc_retry.WithMax(s.grpcRetries),
-			grpc_retry.WithBackoff(grpc_retry.BackoffLinear(s.grpcRetryDelay)),
 			grpc.Header(&md),
 		),
 		grpc.WithStatsHandler(&ocgrpc.ClientHandler{}),
 		grpc.WithStreamInterceptor(middleware.ChainStreamClient(
 			grpc_opentracing.StreamClientInterceptor(),
 			grpc_prometheus.StreamClientInterceptor,
-			grpc_retry.StreamClientInterceptor(),
+			retryutil.StreamClientInterceptor(retryPolicy),
 		)),
 		grpc.WithUnaryInterceptor(middleware.ChainUnaryClient(
 			grpc_opentracing.UnaryClientInterceptor(),
 			grpc_prometheus.UnaryClientInterceptor,
-			grpc_retry.UnaryClientInterceptor(),
+			retryutil.UnaryClientInterceptor(retryPolicy),
 			grpcutils.LogGRPCRequests,
 		)),
 	}
-	conn, err := grpc.DialContext(s.ctx, s.endpoint, opts...)
+	conn, err := grpc.DialContext(s.ctx, endpoint, opts...)
 	if err != nil {
-		log.Errorf("Could not dial slasher endpoint: %s, %v", s.endpoint, err)
+		log.Errorf("Could not dial slasher endpoint: %s, %v", endpoint, err)
 		return nil
 	}
-	log.Debug("Successfully started slasher gRPC connection")
-	s.conn = conn
-	return ethsl.NewSlasherClient(s.conn)
+	log.WithField("endpoint", endpoint).Debug("Successfully started slasher gRPC connection")
+	return conn
+}
 
+// checkHealth updates the health of the slasher endpoints every health check interval, and logs
+// the endpoints which become unhealthy or recover.
+func (s *Service) checkHealth() {
+	ticker := time.NewTicker(healthCheckInterval)
+	defer ticker.Stop()
+	for {
+		for _, e := range s.endpoints {
+			healthy := e.conn != nil && e.conn.GetState() == connectivity.Ready
+			if healthy != e.healthy {
+				if healthy {
+					log.WithField("endpoint", e.address).Info("Slasher endpoint is healthy")
+				} else {
+					log.WithField("endpoint", e.address).Warn("Slasher endpoint is unhealthy")
+				}
+			}
+			e.healthy = healthy
+			if healthy {
+				slasherEndpointHealthy.WithLabelValues(e.address).Set(1)
+			} else {
+				slasherEndpointHealthy.WithLabelValues(e.address).Set(0)
+			}
+		}
+		select {
+		case <-ticker.C:
+		case <-s.ctx.Done():
+			return
+		}
+	}
 }
 
 // Stop the validator service.
 func (s *Service) Stop() error {
 	s.cancel()
 	log.Info("Stopping slashing protection service")
-	if s.conn != nil {
-		return s.conn.Close()
+	var firstErr error
+	for _, e := range s.endpoints {
+		if e.conn != nil {
+			if err := e.conn.Close(); err != nil && firstErr == nil {
+				firstErr = err
+			}
+		}
 	}
-	return nil
+	return firstErr
 }
 
-// Status checks if the connection to slasher server is ready,
+// Status checks if enough slasher endpoints are ready to reach the quorum,
 // returns error otherwise.
 func (s *Service) Status() error {
-	if s.conn == nil {
+	if len(s.endpoints) == 0 {
 		return errors.New("no connection to slasher RPC")
 	}
-	if s.conn.GetState() != connectivity.Ready {
-		return fmt.Errorf("can`t connect to slasher server at: %v connection status: %v ", s.endpoint, s.conn.GetState())
+	var readyWeight uint64
+	var unready []string
+	for _, e := range s.endpoints {
+		if e.conn != nil && e.conn.GetState() == connectivity.Ready {
+			readyWeight += e.weight
+		} else {
+			unready = append(unready, e.address)
+		}
+	}
+	if readyWeight < s.quorumWeight {
+		return fmt.Errorf(
+			"slasher endpoints of weight %d are ready, %d needed for the quorum, can`t connect to: %s",
+			readyWeight, s.quorumWeight, strings.Join(unready, ", "),
+		)
 	}
 	return nil
 }
+
+// slasherCall queries a slasher endpoint about a message, and returns whether the slasher found it
+// slashable.
+type slasherCall func(ctx context.Context, client ethsl.SlasherClient) (bool, error)
+
+// decide queries every slasher endpoint concurrently about a message. The message is slashable as
+// soon as one endpoint finds it slashable. Otherwise, it is safe only if the endpoints which
+// answered reach the quorum weight, an error is returned when they do not.
+func (s *Service) decide(ctx context.Context, method string, call slasherCall) (bool, error) {
+	var lock sync.Mutex
+	var wg sync.WaitGroup
+	var answeredWeight uint64
+	slashable := false
+	for _, e := range s.endpoints {
+		if e.slasherClient == nil {
+			slasherEndpointRequests.WithLabelValues(e.address, method, "unavailable").Inc()
+			continue
+		}
+		wg.Add(1)
+		go func(e *slasherEndpoint) {
+			defer wg.Done()
+			found, err := call(ctx, e.slasherClient)
+			if err != nil {
+				log.WithError(err).WithField("endpoint", e.address).Error("Slasher endpoint returned an error")
+				slasherEndpointRequests.WithLabelValues(e.address, method, "error").Inc()
+				return
+			}
+			result := "safe"
+			if found {
+				result = "slashable"
+			}
+			slasherEndpointRequests.WithLabelValues(e.address, method, result).Inc()
+			lock.Lock()
+			defer lock.Unlock()
+			answeredWeight += e.weight
+			slashable = slashable || found
+		}(e)
+	}
+	wg.Wait()
+	if slashable {
+		return true, nil
+	}
+	if answeredWeight < s.quorumWeight {
+		return false, fmt.Errorf(
+			"slasher endpoints of weight %d answered, %d needed for the quorum", answeredWeight, s.quorumWeight,
+		)
+	}
+	return false, nil
+}
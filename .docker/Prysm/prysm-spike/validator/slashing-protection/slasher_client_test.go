@@ -0,0 +1,118 @@
+package slashingprotection
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	ethsl "github.com/prysmaticlabs/prysm/proto/slashing"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	mockSlasher "github.com/prysmaticlabs/prysm/validator/testing"
+	"google.golang.org/grpc"
+)
+
+// testService returns a service querying the given slasher clients, each of weight 1, with the
+// default quorum.
+func testService(clients ...ethsl.SlasherClient) *Service {
+	s := &Service{}
+	for i, c := range clients {
+		s.endpoints = append(s.endpoints, &slasherEndpoint{address: string(rune('a' + i)), weight: 1, slasherClient: c})
+	}
+	s.quorumWeight = uint64(len(clients))/2 + 1
+	return s
+}
+
+// unavailableSlasher fails every request, like a slasher which cannot be reached.
+type unavailableSlasher struct {
+	mockSlasher.MockSlasher
+}
+
+func (unavailableSlasher) IsSlashableAttestationNoUpdate(_ context.Context, _ *eth.IndexedAttestation, _ ...grpc.CallOption) (*ethsl.Slashable, error) {
+	return nil, errors.New("unavailable")
+}
+
+func (unavailableSlasher) IsSlashableBlockNoUpdate(_ context.Context, _ *eth.BeaconBlockHeader, _ ...grpc.CallOption) (*ethsl.Slashable, error) {
+	return nil, errors.New("unavailable")
+}
+
+func TestParseEndpoints(t *testing.T) {
+	endpoints, err := parseEndpoints("127.0.0.1:4002, 10.0.0.1:4002=3,")
+	require.NoError(t, err)
+	require.Equal(t, 2, len(endpoints))
+	assert.Equal(t, "127.0.0.1:4002", endpoints[0].address)
+	assert.Equal(t, uint64(1), endpoints[0].weight)
+	assert.Equal(t, "10.0.0.1:4002", endpoints[1].address)
+	assert.Equal(t, uint64(3), endpoints[1].weight)
+
+	_, err = parseEndpoints("127.0.0.1:4002=0")
+	assert.ErrorContains(t, "invalid weight", err)
+	_, err = parseEndpoints("127.0.0.1:4002=heavy")
+	assert.ErrorContains(t, "invalid weight", err)
+}
+
+func TestNewService_QuorumWeight(t *testing.T) {
+	s, err := NewService(context.Background(), &Config{Endpoint: "a:1=2,b:1,c:1"})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), s.quorumWeight)
+
+	s, err = NewService(context.Background(), &Config{Endpoint: "a:1=2,b:1,c:1", QuorumWeight: 4})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(4), s.quorumWeight)
+
+	_, err = NewService(context.Background(), &Config{Endpoint: "a:1,b:1", QuorumWeight: 3})
+	assert.ErrorContains(t, "exceeds the total weight", err)
+}
+
+func TestService_Decide_SlashableVetoes(t *testing.T) {
+	s := testService(
+		mockSlasher.MockSlasher{SlashAttestation: false},
+		mockSlasher.MockSlasher{SlashAttestation: false},
+		mockSlasher.MockSlasher{SlashAttestation: true},
+	)
+	att := &eth.IndexedAttestation{Data: &eth.AttestationData{}}
+	assert.Equal(t, false, s.CheckAttestationSafety(context.Background(), att), "Expected a single slasher to veto the attestation")
+
+	// A slasher finding the block slashable vetoes it even when the others are unavailable.
+	s = testService(
+		unavailableSlasher{},
+		unavailableSlasher{},
+		mockSlasher.MockSlasher{SlashBlock: true},
+	)
+	slashable, err := s.decide(context.Background(), "IsSlashableBlockNoUpdate", func(ctx context.Context, c ethsl.SlasherClient) (bool, error) {
+		resp, err := c.IsSlashableBlockNoUpdate(ctx, &eth.BeaconBlockHeader{})
+		return resp != nil && resp.Slashable, err
+	})
+	require.NoError(t, err)
+	assert.Equal(t, true, slashable)
+}
+
+func TestService_Decide_RefusesWithoutQuorum(t *testing.T) {
+	blk := &eth.BeaconBlockHeader{}
+	s := testService(
+		mockSlasher.MockSlasher{SlashBlock: false},
+		unavailableSlasher{},
+		unavailableSlasher{},
+	)
+	assert.Equal(t, false, s.CheckBlockSafety(context.Background(), blk), "Expected the block to be refused without quorum")
+
+	s = testService(
+		mockSlasher.MockSlasher{SlashBlock: false},
+		mockSlasher.MockSlasher{SlashBlock: false},
+		unavailableSlasher{},
+	)
+	assert.Equal(t, true, s.CheckBlockSafety(context.Background(), blk), "Expected the block to be safe with a majority available")
+
+	// Weights count rather than endpoints.
+	s.endpoints[2].weight = 3
+	s.quorumWeight = 3
+	assert.Equal(t, false, s.CheckBlockSafety(context.Background(), blk), "Expected the block to be refused without quorum weight")
+}
+
+func TestService_Status(t *testing.T) {
+	s := testService()
+	assert.ErrorContains(t, "no connection", s.Status())
+	s = testService(mockSlasher.MockSlasher{})
+	assert.ErrorContains(t, "weight 0 are ready, 1 needed", s.Status())
+}
@@ -59,9 +59,15 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.DisableMonitoringFlag,
 			cmd.LogFormat,
 			cmd.LogFileName,
+			cmd.LogDedup,
 			cmd.ConfigFileFlag,
 			cmd.ChainConfigFileFlag,
+			cmd.PrintConfigFlag,
 			cmd.GrpcMaxCallRecvMsgSizeFlag,
+			cmd.DiskWatchIntervalFlag,
+			cmd.DiskWarningThresholdFlag,
+			cmd.DiskCriticalThresholdFlag,
+			cmd.DiskEmergencyPruneFlag,
 			cmd.AcceptTosFlag,
 		},
 	},
@@ -81,13 +87,35 @@ var appHelpFlagGroups = []flagGroup{
 		Flags: []cli.Flag{
 			flags.BeaconRPCProviderFlag,
 			flags.BeaconRPCGatewayProviderFlag,
+			flags.BeaconRESTAPIProviderFlag,
 			flags.CertFlag,
+			flags.TLSAutoFlag,
+			flags.TLSAutoCADirFlag,
 			flags.EnableWebFlag,
 			flags.DisablePenaltyRewardLogFlag,
 			flags.GraffitiFlag,
+			flags.GraffitiFileFlag,
+			flags.OrphanedBlockCheckDepthFlag,
+			flags.OrphanedBlockWebhookFlag,
+			flags.KeyQuarantineThresholdFlag,
+			flags.KeyQuarantineWebhookFlag,
+			flags.DoppelgangerProtectionEpochsFlag,
+			flags.DutiesBatchSizeFlag,
+			flags.DBBackupIntervalFlag,
+			flags.DBBackupOutputDirFlag,
+			flags.DBBackupRetentionFlag,
+			flags.DBBackupS3URLFlag,
+			flags.DBBackupS3RegionFlag,
+			flags.DBPruneIntervalFlag,
+			flags.DBPruneRetentionEpochsFlag,
+			flags.DBEncryptionFlag,
+			flags.DBEncryptionKeyFileFlag,
+			flags.SlashingProtectionPoliciesFlag,
 			flags.EnableRPCFlag,
 			flags.RPCHost,
 			flags.RPCPort,
+			flags.RPCMutationRateLimitFlag,
+			flags.RPCAuditLogFlag,
 			flags.GRPCGatewayPort,
 			flags.GRPCGatewayHost,
 			flags.GrpcRetriesFlag,
@@ -95,8 +123,15 @@ var appHelpFlagGroups = []flagGroup{
 			flags.GPRCGatewayCorsDomain,
 			flags.GrpcHeadersFlag,
 			flags.SlasherRPCProviderFlag,
+			flags.SlasherQuorumWeightFlag,
 			flags.SlasherCertFlag,
+			flags.AttestationVerificationProviderFlag,
+			flags.AttestationVerificationCertFlag,
+			flags.AttestationVerificationHeadToleranceFlag,
+			flags.AttestationVerificationMismatchFlag,
+			flags.SubmissionSpreadFlag,
 			flags.DisableAccountMetricsFlag,
+			flags.AccountMetricsLabelFlag,
 			flags.WalletDirFlag,
 			flags.WalletPasswordFileFlag,
 		},